@@ -0,0 +1,95 @@
+// Package access политика доступа к данным конкретного пациента: карте, документам, записям на приём и приёмам.
+// Шлюз проверяет только право роли на страницу; решение по конкретной записи принимает сервис, которому
+// принадлежат данные. Роль пользователя сервис получает из auth, отношения врача к пациенту — из storage
+package access
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/apperr"
+)
+
+// ErrForbidden пользователь не может работать с данными этого пациента; отдаётся клиенту с кодом PermissionDenied
+var ErrForbidden = apperr.Forbidden("patient_access_denied", "нет доступа к данным пациента")
+
+// Role роль пользователя, идентификаторы совпадают с таблицей roles
+type Role int
+
+const (
+	RoleSuperAdmin Role = 1
+	RoleAdmin      Role = 2
+	RoleDoctor     Role = 3
+	RolePatient    Role = 4
+)
+
+// Action что пользователь делает с данными пациента
+type Action int
+
+const (
+	// ReadRecord просмотр и ведение медицинской карты: история приёмов, заметки врача, анкета, зубная формула,
+	// планы лечения, документы
+	ReadRecord Action = iota
+	// ManageAppointment перенос и отмена записи на приём
+	ManageAppointment
+)
+
+// Subject пользователь, от имени которого выполняется запрос
+type Subject struct {
+	UserID int
+	Role   Role
+}
+
+// Resource данные пациента. PatientID == 0 — запись на приём без карты пациента, DoctorID — врач записи
+// или приёма, 0 у карты и документов
+type Resource struct {
+	PatientID int
+	DoctorID  int
+}
+
+// Relation отношение врача к пациенту
+type Relation struct {
+	// Treating у врача есть подтверждённая клиникой или завершённая запись этого пациента
+	Treating bool
+	// Granted у врача есть действующий допуск к карте, выданный администратором
+	Granted bool
+}
+
+// Relations источник отношений врача к пациенту
+type Relations interface {
+	DoctorRelation(ctx context.Context, doctorID, patientID int) (Relation, error)
+}
+
+// Authorize проверяет, может ли subject выполнить action над resource: пациент — только со своими данными,
+// врач — с картой пациентов, которых лечит или к которым ему выдан допуск, администраторы — по праву роли,
+// которое уже проверил шлюз. Отказ — ErrForbidden; отношения врача к пациенту запрашиваются только
+// для врача и только если он не врач самой записи
+func Authorize(ctx context.Context, subject Subject, action Action, resource Resource, relations Relations) error {
+	switch subject.Role {
+	case RoleSuperAdmin, RoleAdmin:
+		return nil
+	case RolePatient:
+		if resource.PatientID != 0 && resource.PatientID == subject.UserID {
+			return nil
+		}
+		return ErrForbidden
+	case RoleDoctor:
+		if action != ReadRecord {
+			return ErrForbidden
+		}
+		if resource.DoctorID != 0 && resource.DoctorID == subject.UserID {
+			return nil
+		}
+		if resource.PatientID == 0 {
+			return ErrForbidden
+		}
+		rel, err := relations.DoctorRelation(ctx, subject.UserID, resource.PatientID)
+		if err != nil {
+			return fmt.Errorf("не удалось проверить, лечит ли врач пациента: %w", err)
+		}
+		if rel.Treating || rel.Granted {
+			return nil
+		}
+		return ErrForbidden
+	}
+	return ErrForbidden
+}
@@ -0,0 +1,129 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// fakeRelations отношения врачей к пациентам по паре (врач, пациент) с подсчётом обращений
+type fakeRelations struct {
+	relations map[[2]int]Relation
+	err       error
+	calls     int
+}
+
+func (f *fakeRelations) DoctorRelation(_ context.Context, doctorID, patientID int) (Relation, error) {
+	f.calls++
+	return f.relations[[2]int{doctorID, patientID}], f.err
+}
+
+func TestAuthorize(t *testing.T) {
+	relations := &fakeRelations{relations: map[[2]int]Relation{
+		{7, 42}: {Treating: true},
+		{8, 42}: {Granted: true},
+	}}
+	tests := []struct {
+		name     string
+		subject  Subject
+		action   Action
+		resource Resource
+		allowed  bool
+	}{
+		{
+			name:    "пациент: своя карта",
+			subject: Subject{UserID: 42, Role: RolePatient}, action: ReadRecord,
+			resource: Resource{PatientID: 42}, allowed: true,
+		},
+		{
+			name:    "пациент: чужая карта",
+			subject: Subject{UserID: 43, Role: RolePatient}, action: ReadRecord,
+			resource: Resource{PatientID: 42}, allowed: false,
+		},
+		{
+			name:    "пациент: отмена своей записи",
+			subject: Subject{UserID: 42, Role: RolePatient}, action: ManageAppointment,
+			resource: Resource{PatientID: 42, DoctorID: 7}, allowed: true,
+		},
+		{
+			name:    "пациент: отмена чужой записи",
+			subject: Subject{UserID: 43, Role: RolePatient}, action: ManageAppointment,
+			resource: Resource{PatientID: 42, DoctorID: 7}, allowed: false,
+		},
+		{
+			name:    "пациент: запись без карты пациента",
+			subject: Subject{UserID: 42, Role: RolePatient}, action: ManageAppointment,
+			resource: Resource{DoctorID: 7}, allowed: false,
+		},
+		{
+			name:    "врач: лечит пациента",
+			subject: Subject{UserID: 7, Role: RoleDoctor}, action: ReadRecord,
+			resource: Resource{PatientID: 42}, allowed: true,
+		},
+		{
+			name:    "врач: допуск к карте",
+			subject: Subject{UserID: 8, Role: RoleDoctor}, action: ReadRecord,
+			resource: Resource{PatientID: 42}, allowed: true,
+		},
+		{
+			name:    "врач: не лечит и нет допуска",
+			subject: Subject{UserID: 9, Role: RoleDoctor}, action: ReadRecord,
+			resource: Resource{PatientID: 42}, allowed: false,
+		},
+		{
+			name:    "врач: свой приём",
+			subject: Subject{UserID: 9, Role: RoleDoctor}, action: ReadRecord,
+			resource: Resource{PatientID: 42, DoctorID: 9}, allowed: true,
+		},
+		{
+			name:    "врач: отмена записи",
+			subject: Subject{UserID: 7, Role: RoleDoctor}, action: ManageAppointment,
+			resource: Resource{PatientID: 42, DoctorID: 7}, allowed: false,
+		},
+		{
+			name:    "администратор: чужая запись",
+			subject: Subject{UserID: 2, Role: RoleAdmin}, action: ManageAppointment,
+			resource: Resource{PatientID: 42, DoctorID: 7}, allowed: true,
+		},
+		{
+			name:    "старший администратор: карта пациента",
+			subject: Subject{UserID: 1, Role: RoleSuperAdmin}, action: ReadRecord,
+			resource: Resource{PatientID: 42}, allowed: true,
+		},
+		{
+			name:    "неизвестная роль",
+			subject: Subject{UserID: 42, Role: 0}, action: ReadRecord,
+			resource: Resource{PatientID: 42}, allowed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Authorize(context.Background(), tt.subject, tt.action, tt.resource, relations)
+			if tt.allowed && err != nil {
+				t.Errorf("expected access, got %v", err)
+			}
+			if !tt.allowed && !errors.Is(err, ErrForbidden) {
+				t.Errorf("expected ErrForbidden, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAuthorizeRelationLookup(t *testing.T) {
+	relations := &fakeRelations{}
+	ctx := context.Background()
+
+	// пациенту, администратору и врачу своего приёма отношения не нужны
+	_ = Authorize(ctx, Subject{UserID: 42, Role: RolePatient}, ReadRecord, Resource{PatientID: 42}, relations)
+	_ = Authorize(ctx, Subject{UserID: 2, Role: RoleAdmin}, ReadRecord, Resource{PatientID: 42}, relations)
+	_ = Authorize(ctx, Subject{UserID: 7, Role: RoleDoctor}, ReadRecord, Resource{PatientID: 42, DoctorID: 7}, relations)
+	if relations.calls != 0 {
+		t.Errorf("relations looked up %d times, want 0", relations.calls)
+	}
+
+	relations.err = errors.New("storage недоступен")
+	err := Authorize(ctx, Subject{UserID: 7, Role: RoleDoctor}, ReadRecord, Resource{PatientID: 42}, relations)
+	if err == nil || errors.Is(err, ErrForbidden) {
+		t.Errorf("expected the lookup error, got %v", err)
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/DariaTarasek/diplom/services/access
+
+go 1.23.2
+
+require github.com/DariaTarasek/diplom/services/apperr v0.0.0-00010101000000-000000000000
+
+require (
+	golang.org/x/net v0.26.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+	golang.org/x/text v0.16.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240513163218-0867130af1f8 // indirect
+	google.golang.org/grpc v1.64.1 // indirect
+	google.golang.org/protobuf v1.34.2 // indirect
+)
+
+replace github.com/DariaTarasek/diplom/services/apperr => ../apperr
@@ -0,0 +1,292 @@
+// Package billingdoc печатные документы по счетам в PDF: счёт на оплату и кассовый чек на оплату или возврат.
+// Шрифты DejaVu Sans Condensed (https://dejavu-fonts.github.io/License.html) встроены в пакет, чтобы кириллица
+// печаталась без установленных в системе шрифтов
+package billingdoc
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"github.com/jung-kurt/gofpdf"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed fonts/*.ttf
+var fonts embed.FS
+
+const (
+	fontFamily = "DejaVu"
+	// ширина колонок таблицы строк счёта в мм; наименование занимает остаток ширины страницы
+	colNumber   = 10.0
+	colQuantity = 18.0
+	colPrice    = 28.0
+	colTotal    = 30.0
+	lineHeight  = 5.5
+)
+
+// Clinic реквизиты клиники в шапке документов
+type Clinic struct {
+	Name    string
+	Address string
+	INN     string
+	Phone   string
+}
+
+// ClinicFromEnv реквизиты из переменных CLINIC_NAME, CLINIC_ADDRESS, CLINIC_INN и CLINIC_PHONE
+func ClinicFromEnv() Clinic {
+	clinic := Clinic{
+		Name:    os.Getenv("CLINIC_NAME"),
+		Address: os.Getenv("CLINIC_ADDRESS"),
+		INN:     os.Getenv("CLINIC_INN"),
+		Phone:   os.Getenv("CLINIC_PHONE"),
+	}
+	if clinic.Name == "" {
+		clinic.Name = "Стоматологическая клиника"
+	}
+	return clinic
+}
+
+// Line строка счёта; суммы в рублях
+type Line struct {
+	Name         string
+	Quantity     int
+	PricePerUnit int
+	Total        int
+}
+
+// Bill приём и строки счёта, общие для счёта на оплату и чека. DiscountNote — вид и причина скидки,
+// печатается рядом с её суммой
+type Bill struct {
+	VisitDate      time.Time
+	Patient        string
+	Doctor         string
+	Lines          []Line
+	Subtotal       int
+	DiscountAmount int
+	DiscountNote   string
+	Total          int
+}
+
+// Invoice счёт на оплату
+type Invoice struct {
+	Bill
+	Number int
+	Date   time.Time
+}
+
+// Receipt кассовый чек. Refund — чек возврата; Balance — остаток по счёту после этой операции
+type Receipt struct {
+	Bill
+	Number  int
+	Date    time.Time
+	Refund  bool
+	Amount  int
+	Method  string // cash, card, transfer; unknown — оплата до введения счетов
+	Balance int
+}
+
+// Renderer формирует документы с реквизитами клиники
+type Renderer struct {
+	Clinic Clinic
+	// шрифты читаются один раз, документы формируются конкурентно
+	regular []byte
+	bold    []byte
+}
+
+// NewRenderer загружает встроенные шрифты
+func NewRenderer(clinic Clinic) (*Renderer, error) {
+	regular, err := fonts.ReadFile("fonts/DejaVuSansCondensed.ttf")
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать шрифт документов: %w", err)
+	}
+	bold, err := fonts.ReadFile("fonts/DejaVuSansCondensed-Bold.ttf")
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать шрифт документов: %w", err)
+	}
+	return &Renderer{Clinic: clinic, regular: regular, bold: bold}, nil
+}
+
+// RenderInvoice счёт на оплату
+func (r *Renderer) RenderInvoice(inv Invoice) ([]byte, error) {
+	title := fmt.Sprintf("Счёт на оплату № %d от %s", inv.Number, inv.Date.Format("02.01.2006"))
+	pdf := r.newDocument(title, inv.Date)
+
+	r.header(pdf)
+	heading(pdf, title)
+	bill(pdf, inv.Bill, "К оплате")
+
+	pdf.Ln(12)
+	pdf.SetFont(fontFamily, "", 10)
+	pdf.CellFormat(0, lineHeight, "Администратор ____________________", "", 1, "L", false, 0, "")
+	return output(pdf)
+}
+
+// RenderReceipt кассовый чек на оплату или возврат
+func (r *Renderer) RenderReceipt(rc Receipt) ([]byte, error) {
+	kind, operation := "Кассовый чек", "Приход"
+	if rc.Refund {
+		kind, operation = "Кассовый чек возврата", "Возврат прихода"
+	}
+	title := fmt.Sprintf("%s № %d от %s", kind, rc.Number, rc.Date.Format("02.01.2006 15:04"))
+	pdf := r.newDocument(title, rc.Date)
+
+	r.header(pdf)
+	heading(pdf, title)
+	bill(pdf, rc.Bill, "Сумма по счёту")
+
+	pdf.Ln(4)
+	pdf.SetFont(fontFamily, "B", 11)
+	pdf.CellFormat(0, lineHeight+1, fmt.Sprintf("%s, %s: %s", operation, methodName(rc.Method), Money(rc.Amount)), "", 1, "R", false, 0, "")
+	pdf.SetFont(fontFamily, "", 10)
+	pdf.CellFormat(0, lineHeight, "Остаток к оплате по счёту: "+Money(rc.Balance), "", 1, "R", false, 0, "")
+	return output(pdf)
+}
+
+func (r *Renderer) newDocument(title string, created time.Time) *gofpdf.Fpdf {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(fontFamily, "", r.regular)
+	pdf.AddUTF8FontFromBytes(fontFamily, "B", r.bold)
+	pdf.SetTitle(title, true)
+	pdf.SetAuthor(r.Clinic.Name, true)
+	// дата создания из документа, а не текущее время: повторное формирование даёт тот же файл
+	pdf.SetCreationDate(created)
+	pdf.SetCatalogSort(true)
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AddPage()
+	return pdf
+}
+
+func (r *Renderer) header(pdf *gofpdf.Fpdf) {
+	pdf.SetFont(fontFamily, "B", 14)
+	pdf.CellFormat(0, 7, r.Clinic.Name, "", 1, "L", false, 0, "")
+	pdf.SetFont(fontFamily, "", 9)
+	var details []string
+	if r.Clinic.Address != "" {
+		details = append(details, r.Clinic.Address)
+	}
+	if r.Clinic.INN != "" {
+		details = append(details, "ИНН "+r.Clinic.INN)
+	}
+	if r.Clinic.Phone != "" {
+		details = append(details, "тел. "+r.Clinic.Phone)
+	}
+	if len(details) > 0 {
+		pdf.MultiCell(0, 4.5, strings.Join(details, ", "), "", "L", false)
+	}
+	pdf.Ln(6)
+}
+
+func heading(pdf *gofpdf.Fpdf, title string) {
+	pdf.SetFont(fontFamily, "B", 13)
+	pdf.CellFormat(0, 8, title, "", 1, "C", false, 0, "")
+	pdf.Ln(3)
+}
+
+// bill сведения о приёме, таблица строк и итоги; totalLabel — подпись суммы после скидки
+func bill(pdf *gofpdf.Fpdf, b Bill, totalLabel string) {
+	pdf.SetFont(fontFamily, "", 10)
+	pdf.CellFormat(0, lineHeight, "Пациент: "+b.Patient, "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, lineHeight, "Врач: "+b.Doctor, "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, lineHeight, "Дата приёма: "+b.VisitDate.Format("02.01.2006"), "", 1, "L", false, 0, "")
+	pdf.Ln(3)
+	linesTable(pdf, b.Lines)
+	totals(pdf, b, totalLabel)
+}
+
+// linesTable таблица услуг и материалов; длинные наименования переносятся, строка растёт по высоте
+func linesTable(pdf *gofpdf.Fpdf, lines []Line) {
+	pageWidth, _ := pdf.GetPageSize()
+	left, _, right, _ := pdf.GetMargins()
+	colName := pageWidth - left - right - colNumber - colQuantity - colPrice - colTotal
+
+	tableHeader := func() {
+		pdf.SetFont(fontFamily, "B", 9)
+		pdf.SetFillColor(235, 235, 235)
+		pdf.CellFormat(colNumber, 7, "№", "1", 0, "C", true, 0, "")
+		pdf.CellFormat(colName, 7, "Наименование", "1", 0, "C", true, 0, "")
+		pdf.CellFormat(colQuantity, 7, "Кол-во", "1", 0, "C", true, 0, "")
+		pdf.CellFormat(colPrice, 7, "Цена", "1", 0, "C", true, 0, "")
+		pdf.CellFormat(colTotal, 7, "Сумма", "1", 1, "C", true, 0, "")
+		pdf.SetFont(fontFamily, "", 9)
+	}
+	tableHeader()
+
+	_, pageHeight := pdf.GetPageSize()
+	_, _, _, bottom := pdf.GetMargins()
+	for i, line := range lines {
+		nameLines := pdf.SplitText(line.Name, colName-2)
+		if len(nameLines) == 0 {
+			nameLines = []string{""}
+		}
+		h := float64(len(nameLines)) * lineHeight
+		if pdf.GetY()+h > pageHeight-bottom {
+			pdf.AddPage()
+			tableHeader()
+		}
+		x, y := pdf.GetXY()
+		pdf.CellFormat(colNumber, h, strconv.Itoa(i+1), "1", 0, "C", false, 0, "")
+		pdf.MultiCell(colName, lineHeight, strings.Join(nameLines, "\n"), "1", "L", false)
+		pdf.SetXY(x+colNumber+colName, y)
+		pdf.CellFormat(colQuantity, h, strconv.Itoa(line.Quantity), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(colPrice, h, Money(line.PricePerUnit), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(colTotal, h, Money(line.Total), "1", 1, "R", false, 0, "")
+	}
+	pdf.Ln(3)
+}
+
+func totals(pdf *gofpdf.Fpdf, b Bill, totalLabel string) {
+	pdf.SetFont(fontFamily, "", 10)
+	pdf.CellFormat(0, lineHeight, "Итого: "+Money(b.Subtotal), "", 1, "R", false, 0, "")
+	if b.DiscountAmount > 0 {
+		label := "Скидка"
+		if b.DiscountNote != "" {
+			label += " (" + b.DiscountNote + ")"
+		}
+		pdf.CellFormat(0, lineHeight, label+": −"+Money(b.DiscountAmount), "", 1, "R", false, 0, "")
+	}
+	pdf.SetFont(fontFamily, "B", 11)
+	pdf.CellFormat(0, lineHeight+1, totalLabel+": "+Money(b.Total), "", 1, "R", false, 0, "")
+}
+
+func output(pdf *gofpdf.Fpdf) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("не удалось сформировать PDF: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Money сумма в рублях с разделением разрядов: 12 500 руб.
+func Money(amount int) string {
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	digits := strconv.Itoa(amount)
+	var b strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteRune(' ')
+		}
+		b.WriteRune(d)
+	}
+	return sign + b.String() + " руб."
+}
+
+func methodName(method string) string {
+	switch method {
+	case "cash":
+		return "наличными"
+	case "card":
+		return "банковской картой"
+	case "transfer":
+		return "переводом"
+	case "unknown":
+		return "способ не известен"
+	}
+	return method
+}
@@ -0,0 +1,99 @@
+package billingdoc
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMoney(t *testing.T) {
+	cases := map[int]string{
+		0:       "0 руб.",
+		950:     "950 руб.",
+		1000:    "1 000 руб.",
+		12500:   "12 500 руб.",
+		1234567: "1 234 567 руб.",
+		-4300:   "-4 300 руб.",
+	}
+	for amount, want := range cases {
+		if got := Money(amount); got != want {
+			t.Errorf("Money(%d) = %q, want %q", amount, got, want)
+		}
+	}
+}
+
+var pageCount = regexp.MustCompile(`/Count (\d+)`)
+
+func testBill(lines int) Bill {
+	b := Bill{
+		VisitDate: time.Date(2026, 3, 12, 10, 0, 0, 0, time.UTC),
+		Patient:   "Иванова Мария Петровна",
+		Doctor:    "Петров Сергей Иванович",
+	}
+	for i := 0; i < lines; i++ {
+		b.Lines = append(b.Lines, Line{
+			Name:         "Лечение кариеса с применением светоотверждаемой пломбы " + strings.Repeat("и анестезией ", i%3),
+			Quantity:     1 + i%2,
+			PricePerUnit: 2500,
+			Total:        2500 * (1 + i%2),
+		})
+		b.Subtotal += b.Lines[i].Total
+	}
+	b.DiscountAmount = b.Subtotal / 10
+	b.DiscountNote = "10%, программа лояльности"
+	b.Total = b.Subtotal - b.DiscountAmount
+	return b
+}
+
+func TestRenderInvoice(t *testing.T) {
+	r, err := NewRenderer(Clinic{Name: "Клиника", Address: "г. Москва, ул. Ленина, 1", INN: "7701234567"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// много строк: таблица переносится на следующую страницу
+	inv := Invoice{Bill: testBill(80), Number: 15, Date: time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)}
+	first, err := r.RenderInvoice(inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(first, []byte("%PDF-")) {
+		t.Fatalf("not a PDF: %q", first[:min(len(first), 16)])
+	}
+	if m := pageCount.FindSubmatch(first); m == nil || string(m[1]) == "1" {
+		t.Error("expected the lines table to span several pages")
+	}
+
+	second, err := r.RenderInvoice(inv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Error("rendering the same invoice twice gave different files")
+	}
+}
+
+func TestRenderReceipt(t *testing.T) {
+	r, err := NewRenderer(ClinicFromEnv())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, refund := range []bool{false, true} {
+		doc, err := r.RenderReceipt(Receipt{
+			Bill:    testBill(3),
+			Number:  7,
+			Date:    time.Date(2026, 3, 12, 11, 30, 0, 0, time.UTC),
+			Refund:  refund,
+			Amount:  3000,
+			Method:  "card",
+			Balance: 1500,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.HasPrefix(doc, []byte("%PDF-")) {
+			t.Fatalf("refund=%v: not a PDF", refund)
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package clients
 
 import (
+	"context"
 	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+	"github.com/DariaTarasek/diplom/services/audit"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type StorageClient struct {
@@ -22,3 +25,23 @@ func NewStorageClient(address string) (*StorageClient, error) {
 		Client: client,
 	}, nil
 }
+
+// RecordAudit сохраняет запись журнала аудита в storage
+func (c *StorageClient) RecordAudit(ctx context.Context, entry audit.Entry) error {
+	var patientID int32
+	if entry.PatientID != nil {
+		patientID = int32(*entry.PatientID)
+	}
+	_, err := c.Client.AddAuditEntry(ctx, &storagepb.AuditEntry{
+		OccurredAt: timestamppb.New(entry.OccurredAt),
+		Service:    entry.Service,
+		Action:     entry.Action,
+		ActorId:    int32(entry.ActorID),
+		ActorRole:  int32(entry.ActorRole),
+		Resource:   entry.Resource,
+		ResourceId: entry.ResourceID,
+		PatientId:  patientID,
+		Outcome:    entry.Outcome,
+	})
+	return err
+}
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"github.com/DariaTarasek/diplom/services/admin/billingdoc"
 	"github.com/DariaTarasek/diplom/services/admin/clients"
 	grpcserver "github.com/DariaTarasek/diplom/services/admin/grpc"
 	pb "github.com/DariaTarasek/diplom/services/admin/proto/admin"
 	"github.com/DariaTarasek/diplom/services/admin/service"
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"github.com/DariaTarasek/diplom/services/audit"
 	"google.golang.org/grpc"
 	"log"
 	"net"
@@ -16,13 +19,30 @@ func main() {
 		log.Fatalf("Не удалось создать клиент storage: %s", err)
 	}
 
-	adminService := service.NewAdminService(storageClient)
+	documents, err := billingdoc.NewRenderer(billingdoc.ClinicFromEnv())
+	if err != nil {
+		log.Fatalf("Не удалось подготовить печать документов: %s", err)
+	}
+
+	adminService := service.NewAdminService(storageClient, documents)
 	lis, err := net.Listen("tcp", ":50053")
 	if err != nil {
 		log.Fatalf("Не удалось начать слушать: %v", err)
 	}
 
-	s := grpc.NewServer()
+	// вызовы от имени пользователя записываются в журнал аудита с итоговым статусом; ошибки методов
+	// приводятся к доменным статусам, ошибки без доменного смысла — к внутренней без подробностей
+	recorder := audit.RecorderFunc(storageClient.RecordAudit)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			audit.UnaryServerInterceptor("admin", recorder, grpcserver.AuditTargets),
+			apperr.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			audit.StreamServerInterceptor("admin", recorder, grpcserver.AuditTargets),
+			apperr.StreamServerInterceptor(),
+		),
+	)
 
 	server := &grpcserver.Server{
 		Service: adminService,
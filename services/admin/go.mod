@@ -3,6 +3,11 @@ module github.com/DariaTarasek/diplom/services/admin
 go 1.23.9
 
 require (
+	github.com/DariaTarasek/diplom/services/apperr v0.0.0-00010101000000-000000000000
+	github.com/DariaTarasek/diplom/services/audit v0.0.0-00010101000000-000000000000
+	github.com/DariaTarasek/diplom/services/scheduling v0.0.0-00010101000000-000000000000
+	github.com/jung-kurt/gofpdf v1.16.2
+	golang.org/x/text v0.22.0
 	google.golang.org/grpc v1.72.2
 	google.golang.org/protobuf v1.36.6
 )
@@ -10,6 +15,11 @@ require (
 require (
 	golang.org/x/net v0.35.0 // indirect
 	golang.org/x/sys v0.30.0 // indirect
-	golang.org/x/text v0.22.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
 )
+
+replace github.com/DariaTarasek/diplom/services/apperr => ../apperr
+
+replace github.com/DariaTarasek/diplom/services/scheduling => ../scheduling
+
+replace github.com/DariaTarasek/diplom/services/audit => ../audit
@@ -0,0 +1,21 @@
+package grpc
+
+import "github.com/DariaTarasek/diplom/services/audit"
+
+// AuditTargets что затрагивают методы администратора, у которых это не видно по именам полей запроса:
+// id в GetByIdRequest и DeleteRequest — пациент, счёт, визит или справочная запись в зависимости от метода
+var AuditTargets = audit.Targets{
+	"GetPatientBalance":            {Resource: "patient", PatientField: "id"},
+	"GetPatientAccessGrants":       {Resource: "patient", PatientField: "id"},
+	"RevokePatientAccessGrant":     {Resource: "patient_access_grant"},
+	"GetInvoice":                   {Resource: "invoice"},
+	"GetInvoiceDocument":           {Resource: "invoice"},
+	"GetVisitInvoice":              {Resource: "visit"},
+	"GetVisitMaterialsAndServices": {Resource: "visit"},
+	"GetReceiptDocument":           {Resource: "invoice_payment"},
+	"GetMaterialStock":             {Resource: "material"},
+	"DeleteMaterial":               {Resource: "material"},
+	"DeleteService":                {Resource: "service"},
+	"DeleteScheduleRule":           {Resource: "schedule_rule"},
+	"DeleteClinicalAlertRule":      {Resource: "clinical_alert_rule"},
+}
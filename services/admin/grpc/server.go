@@ -2,12 +2,15 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/admin/model"
 	pb "github.com/DariaTarasek/diplom/services/admin/proto/admin"
 	"github.com/DariaTarasek/diplom/services/admin/service"
+	"github.com/DariaTarasek/diplom/services/admin/sharederrors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Server struct {
@@ -35,6 +38,109 @@ func (s *Server) UpdateClinicWeeklySchedule(ctx context.Context, req *pb.UpdateC
 	return &pb.DefaultResponse{}, nil
 }
 
+func (s *Server) GetClinicTimeZone(ctx context.Context, req *pb.EmptyRequest) (*pb.ClinicTimeZone, error) {
+	timeZone, err := s.Service.GetClinicTimeZone(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить часовой пояс клиники: %w", err)
+	}
+	return &pb.ClinicTimeZone{TimeZone: timeZone}, nil
+}
+
+func (s *Server) UpdateClinicTimeZone(ctx context.Context, req *pb.ClinicTimeZone) (*pb.DefaultResponse, error) {
+	err := s.Service.UpdateClinicTimeZone(ctx, req.TimeZone)
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("не удалось изменить часовой пояс клиники: %w", err)
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) GetScheduleRules(ctx context.Context, req *pb.GetScheduleRulesRequest) (*pb.GetScheduleRulesResponse, error) {
+	rules, err := s.Service.GetScheduleRules(ctx, int(req.DoctorId))
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить правила расписания: %w", err)
+	}
+	var pbRules []*pb.ScheduleRule
+	for _, rule := range rules {
+		item := &pb.ScheduleRule{
+			Id:                  int32(rule.ID),
+			DoctorId:            int32(rule.DoctorID),
+			Recurrence:          rule.Recurrence,
+			DateFrom:            timestamppb.New(rule.DateFrom),
+			Weekday:             int32(rule.Weekday),
+			Nth:                 int32(rule.Nth),
+			StartTime:           timestamppb.New(rule.StartTime),
+			EndTime:             timestamppb.New(rule.EndTime),
+			SlotDurationMinutes: int32(rule.SlotDurationMinutes),
+			IsDayOff:            rule.IsDayOff,
+			Title:               rule.Title,
+		}
+		if rule.DateTo != nil {
+			item.DateTo = timestamppb.New(*rule.DateTo)
+		}
+		pbRules = append(pbRules, item)
+	}
+	return &pb.GetScheduleRulesResponse{Rules: pbRules}, nil
+}
+
+func (s *Server) AddScheduleRule(ctx context.Context, req *pb.ScheduleRule) (*pb.AddScheduleRuleResponse, error) {
+	rule := model.ScheduleRule{
+		DoctorID:            int(req.DoctorId),
+		Recurrence:          req.Recurrence,
+		Weekday:             int(req.Weekday),
+		Nth:                 int(req.Nth),
+		StartTime:           req.StartTime.AsTime(),
+		EndTime:             req.EndTime.AsTime(),
+		SlotDurationMinutes: int(req.SlotDurationMinutes),
+		IsDayOff:            req.IsDayOff,
+		Title:               req.Title,
+	}
+	if req.DateFrom != nil {
+		rule.DateFrom = req.DateFrom.AsTime()
+	}
+	if req.DateTo != nil {
+		dateTo := req.DateTo.AsTime()
+		rule.DateTo = &dateTo
+	}
+	id, clashes, err := s.Service.AddScheduleRule(ctx, rule)
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("не удалось добавить правило расписания: %w", err)
+	}
+	return &pb.AddScheduleRuleResponse{Id: int32(id), Clashes: appointmentsToPb(clashes)}, nil
+}
+
+func (s *Server) DeleteScheduleRule(ctx context.Context, req *pb.DeleteRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.DeleteScheduleRule(ctx, int(req.Id))
+	if err != nil {
+		return nil, fmt.Errorf("не удалось удалить правило расписания: %w", err)
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) ImportHolidayCalendar(ctx context.Context, req *pb.ImportHolidayCalendarRequest) (*pb.ImportHolidayCalendarResponse, error) {
+	holidays := make([]model.Holiday, 0, len(req.Holidays))
+	for _, h := range req.Holidays {
+		holiday := model.Holiday{Title: h.Title, Annual: h.Annual}
+		if h.Date != nil {
+			holiday.Date = h.Date.AsTime()
+		}
+		holidays = append(holidays, holiday)
+	}
+	added, clashes, err := s.Service.ImportHolidays(ctx, holidays)
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("не удалось загрузить календарь праздников: %w", err)
+	}
+	return &pb.ImportHolidayCalendarResponse{Added: int32(added), Clashes: appointmentsToPb(clashes)}, nil
+}
+
 func (s *Server) UpdateDoctorWeeklySchedule(ctx context.Context, req *pb.UpdateDoctorWeeklyScheduleRequest) (*pb.DefaultResponse, error) {
 	var reqSchedule []model.DoctorWeeklySchedule
 	for _, item := range req.DoctorSchedule {
@@ -93,9 +199,14 @@ func (s *Server) AddDoctorDailyOverride(ctx context.Context, req *pb.AddDoctorDa
 
 func (s *Server) AddMaterial(ctx context.Context, req *pb.AddMaterialRequest) (*pb.DefaultResponse, error) {
 	err := s.Service.AddMaterial(ctx, model.Material{
-		Name:  req.Name,
-		Price: int(req.Price),
+		Name:              req.Name,
+		Price:             int(req.Price),
+		Unit:              req.Unit,
+		LowStockThreshold: int(req.LowStockThreshold),
 	})
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("не удалось добавить материал: %w", err)
 	}
@@ -104,9 +215,10 @@ func (s *Server) AddMaterial(ctx context.Context, req *pb.AddMaterialRequest) (*
 
 func (s *Server) AddService(ctx context.Context, req *pb.AddServiceRequest) (*pb.DefaultResponse, error) {
 	err := s.Service.AddService(ctx, model.Service{
-		Name:     req.Name,
-		Price:    int(req.Price),
-		Category: int(req.Type),
+		Name:            req.Name,
+		Price:           int(req.Price),
+		Category:        int(req.Type),
+		DurationMinutes: int(req.DurationMinutes),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("не удалось добавить услугу: %w", err)
@@ -116,10 +228,15 @@ func (s *Server) AddService(ctx context.Context, req *pb.AddServiceRequest) (*pb
 
 func (s *Server) UpdateMaterial(ctx context.Context, req *pb.UpdateMaterialRequest) (*pb.DefaultResponse, error) {
 	err := s.Service.UpdateMaterial(ctx, model.Material{
-		ID:    int(req.Id),
-		Name:  req.Name,
-		Price: int(req.Price),
+		ID:                int(req.Id),
+		Name:              req.Name,
+		Price:             int(req.Price),
+		Unit:              req.Unit,
+		LowStockThreshold: int(req.LowStockThreshold),
 	})
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("не удалось обновить материал: %w", err)
 	}
@@ -128,10 +245,11 @@ func (s *Server) UpdateMaterial(ctx context.Context, req *pb.UpdateMaterialReque
 
 func (s *Server) UpdateService(ctx context.Context, req *pb.UpdateServiceRequest) (*pb.DefaultResponse, error) {
 	err := s.Service.UpdateService(ctx, model.Service{
-		ID:       int(req.Id),
-		Name:     req.Name,
-		Price:    int(req.Price),
-		Category: int(req.Type),
+		ID:              int(req.Id),
+		Name:            req.Name,
+		Price:           int(req.Price),
+		Category:        int(req.Type),
+		DurationMinutes: int(req.DurationMinutes),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("не удалось обновить услугу: %w", err)
@@ -147,6 +265,242 @@ func (s *Server) DeleteMaterial(ctx context.Context, req *pb.DeleteRequest) (*pb
 	return &pb.DefaultResponse{}, nil
 }
 
+func (s *Server) ReceiveMaterialBatch(ctx context.Context, req *pb.ReceiveMaterialBatchRequest) (*pb.MaterialBatch, error) {
+	batch := model.MaterialBatch{
+		MaterialID:       int(req.MaterialId),
+		BatchNumber:      req.BatchNumber,
+		QuantityReceived: int(req.Quantity),
+		Comment:          req.Comment,
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		batch.ExpiresAt = &expiresAt
+	}
+	created, err := s.Service.ReceiveMaterialBatch(ctx, batch)
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return materialBatchToPb(created), nil
+}
+
+func (s *Server) AdjustMaterialStock(ctx context.Context, req *pb.AdjustMaterialStockRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.AdjustMaterialStock(ctx, model.StockAdjustment{
+		MaterialID: int(req.MaterialId),
+		BatchID:    int(req.BatchId),
+		Quantity:   int(req.Quantity),
+		Reason:     req.Reason,
+	})
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) GetMaterialStock(ctx context.Context, req *pb.GetByIdRequest) (*pb.MaterialStockResponse, error) {
+	stock, err := s.Service.GetMaterialStock(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.MaterialStockResponse{Material: materialToPb(stock.Material)}
+	for _, batch := range stock.Batches {
+		resp.Batches = append(resp.Batches, materialBatchToPb(batch))
+	}
+	for _, m := range stock.Movements {
+		resp.Movements = append(resp.Movements, &pb.StockMovement{
+			Id:         int32(m.ID),
+			MaterialId: int32(m.MaterialID),
+			BatchId:    int32(m.BatchID),
+			Kind:       m.Kind,
+			Quantity:   int32(m.Quantity),
+			VisitId:    int32(m.VisitID),
+			Reason:     m.Reason,
+			CreatedAt:  timestamppb.New(m.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) GetStockReport(ctx context.Context, req *pb.StockReportRequest) (*pb.StockReportResponse, error) {
+	report, err := s.Service.GetStockReport(ctx, int(req.ExpiringWithinDays))
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.StockReportResponse{}
+	for _, material := range report.LowStock {
+		resp.LowStock = append(resp.LowStock, materialToPb(material))
+	}
+	for _, item := range report.Expiring {
+		resp.Expiring = append(resp.Expiring, &pb.ExpiringBatch{
+			Batch:        materialBatchToPb(item.MaterialBatch),
+			MaterialName: item.MaterialName,
+			Unit:         item.Unit,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) GetClinicalAlertRules(ctx context.Context, req *pb.EmptyRequest) (*pb.GetClinicalAlertRulesResponse, error) {
+	rules, err := s.Service.GetClinicalAlertRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetClinicalAlertRulesResponse{Rules: make([]*pb.ClinicalAlertRule, 0, len(rules))}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, &pb.ClinicalAlertRule{
+			Id:             int32(rule.ID),
+			Title:          rule.Title,
+			ConditionType:  rule.ConditionType,
+			ConditionValue: rule.ConditionValue,
+			TargetType:     rule.TargetType,
+			MaterialId:     int32(rule.MaterialID),
+			ServiceId:      int32(rule.ServiceID),
+			TargetName:     rule.TargetName,
+			Severity:       rule.Severity,
+			Message:        rule.Message,
+			IsActive:       rule.IsActive,
+			CreatedAt:      timestamppb.New(rule.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) AddClinicalAlertRule(ctx context.Context, req *pb.ClinicalAlertRule) (*pb.AddClinicalAlertRuleResponse, error) {
+	id, err := s.Service.AddClinicalAlertRule(ctx, clinicalAlertRuleFromPb(req))
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddClinicalAlertRuleResponse{Id: int32(id)}, nil
+}
+
+func (s *Server) UpdateClinicalAlertRule(ctx context.Context, req *pb.ClinicalAlertRule) (*pb.DefaultResponse, error) {
+	err := s.Service.UpdateClinicalAlertRule(ctx, clinicalAlertRuleFromPb(req))
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) DeleteClinicalAlertRule(ctx context.Context, req *pb.DeleteRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.DeleteClinicalAlertRule(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) GetPatientAccessGrants(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetPatientAccessGrantsResponse, error) {
+	grants, err := s.Service.GetPatientAccessGrants(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetPatientAccessGrantsResponse{Grants: make([]*pb.PatientAccessGrant, 0, len(grants))}
+	for _, g := range grants {
+		item := &pb.PatientAccessGrant{
+			Id:        int32(g.ID),
+			PatientId: int32(g.PatientID),
+			DoctorId:  int32(g.DoctorID),
+			Doctor:    g.Doctor,
+			Reason:    g.Reason,
+			GrantedBy: int32(g.GrantedBy),
+			CreatedAt: timestamppb.New(g.CreatedAt),
+		}
+		if g.ExpiresAt != nil {
+			item.ExpiresAt = timestamppb.New(*g.ExpiresAt)
+		}
+		if g.RevokedAt != nil {
+			item.RevokedAt = timestamppb.New(*g.RevokedAt)
+		}
+		resp.Grants = append(resp.Grants, item)
+	}
+	return resp, nil
+}
+
+func (s *Server) AddPatientAccessGrant(ctx context.Context, req *pb.PatientAccessGrant) (*pb.AddPatientAccessGrantResponse, error) {
+	grant := model.PatientAccessGrant{
+		PatientID: int(req.PatientId),
+		DoctorID:  int(req.DoctorId),
+		Reason:    req.Reason,
+		GrantedBy: int(req.GrantedBy),
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		grant.ExpiresAt = &expiresAt
+	}
+	id, err := s.Service.AddPatientAccessGrant(ctx, grant)
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddPatientAccessGrantResponse{Id: int32(id)}, nil
+}
+
+func (s *Server) RevokePatientAccessGrant(ctx context.Context, req *pb.DeleteRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.RevokePatientAccessGrant(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func clinicalAlertRuleFromPb(req *pb.ClinicalAlertRule) model.ClinicalAlertRule {
+	return model.ClinicalAlertRule{
+		ID:             int(req.Id),
+		Title:          req.Title,
+		ConditionType:  req.ConditionType,
+		ConditionValue: req.ConditionValue,
+		TargetType:     req.TargetType,
+		MaterialID:     int(req.MaterialId),
+		ServiceID:      int(req.ServiceId),
+		Severity:       req.Severity,
+		Message:        req.Message,
+		IsActive:       req.IsActive,
+	}
+}
+
+func materialToPb(material model.Material) *pb.Material {
+	return &pb.Material{
+		Id:                int32(material.ID),
+		Name:              material.Name,
+		Price:             int32(material.Price),
+		Unit:              material.Unit,
+		LowStockThreshold: int32(material.LowStockThreshold),
+		StockQuantity:     int32(material.StockQuantity),
+	}
+}
+
+func materialBatchToPb(batch model.MaterialBatch) *pb.MaterialBatch {
+	item := &pb.MaterialBatch{
+		Id:               int32(batch.ID),
+		MaterialId:       int32(batch.MaterialID),
+		BatchNumber:      batch.BatchNumber,
+		QuantityReceived: int32(batch.QuantityReceived),
+		QuantityLeft:     int32(batch.QuantityLeft),
+		ReceivedAt:       timestamppb.New(batch.ReceivedAt),
+		Comment:          batch.Comment,
+	}
+	if batch.ExpiresAt != nil {
+		item.ExpiresAt = timestamppb.New(*batch.ExpiresAt)
+	}
+	return item
+}
+
 func (s *Server) DeleteService(ctx context.Context, req *pb.DeleteRequest) (*pb.DefaultResponse, error) {
 	err := s.Service.DeleteService(ctx, int(req.Id))
 	if err != nil {
@@ -155,8 +509,82 @@ func (s *Server) DeleteService(ctx context.Context, req *pb.DeleteRequest) (*pb.
 	return &pb.DefaultResponse{}, nil
 }
 
-func (s *Server) GetAdmins(ctx context.Context, req *pb.EmptyRequest) (*pb.GetAdminsResponse, error) {
-	admins, err := s.Service.GetAdmins(ctx)
+func (s *Server) GetPriceHistory(ctx context.Context, req *pb.GetPriceHistoryRequest) (*pb.GetPriceHistoryResponse, error) {
+	entries, err := s.Service.GetPriceHistory(ctx, req.Kind, int(req.ItemId))
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить историю цен: %w", err)
+	}
+	resp := &pb.GetPriceHistoryResponse{Entries: make([]*pb.PriceListEntry, 0, len(entries))}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &pb.PriceListEntry{
+			Id:            int32(entry.ID),
+			ItemId:        int32(entry.ItemID),
+			Price:         int32(entry.Price),
+			EffectiveFrom: timestamppb.New(entry.EffectiveFrom),
+			CreatedAt:     timestamppb.New(entry.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) AddPriceListEntry(ctx context.Context, req *pb.AddPriceListEntryRequest) (*pb.AddPriceListEntryResponse, error) {
+	var entry model.PriceListEntry
+	if req.Entry != nil {
+		entry.ItemID = int(req.Entry.ItemId)
+		entry.Price = int(req.Entry.Price)
+		if req.Entry.EffectiveFrom != nil {
+			entry.EffectiveFrom = req.Entry.EffectiveFrom.AsTime()
+		}
+	}
+	id, err := s.Service.AddPriceListEntry(ctx, req.Kind, entry)
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("не удалось установить цену: %w", err)
+	}
+	return &pb.AddPriceListEntryResponse{Id: int32(id)}, nil
+}
+
+func (s *Server) DeletePriceListEntry(ctx context.Context, req *pb.DeletePriceListEntryRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.DeletePriceListEntry(ctx, req.Kind, int(req.Id))
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("не удалось отменить изменение цены: %w", err)
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) GetPriceOnDate(ctx context.Context, req *pb.GetPriceOnDateRequest) (*pb.GetPriceOnDateResponse, error) {
+	if req.Date == nil {
+		return nil, status.Error(codes.InvalidArgument, "не указана дата")
+	}
+	price, err := s.Service.GetPriceOnDate(ctx, req.Kind, int(req.ItemId), req.Date.AsTime())
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить цену на дату: %w", err)
+	}
+	return &pb.GetPriceOnDateResponse{Price: int32(price)}, nil
+}
+
+// optionalInt32 значение для необязательного поля proto
+func optionalInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	n := int32(*v)
+	return &n
+}
+
+func (s *Server) GetAdmins(ctx context.Context, req *pb.GetAdminsRequest) (*pb.GetAdminsResponse, error) {
+	admins, total, err := s.Service.GetAdmins(ctx, model.PersonFilter{Query: req.Query, Inactive: req.Inactive}, listPageFromPb(req.Page))
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить список администраторов: %w", err)
 	}
@@ -174,7 +602,7 @@ func (s *Server) GetAdmins(ctx context.Context, req *pb.EmptyRequest) (*pb.GetAd
 		}
 		gRPCAdmins = append(gRPCAdmins, &admin)
 	}
-	return &pb.GetAdminsResponse{Admins: gRPCAdmins}, nil
+	return &pb.GetAdminsResponse{Admins: gRPCAdmins, Total: int32(total)}, nil
 }
 func (s *Server) GetSpecs(ctx context.Context, req *pb.EmptyRequest) (*pb.GetSpecsResponse, error) {
 	specs, err := s.Service.GetSpecs(ctx)
@@ -194,8 +622,8 @@ func (s *Server) GetSpecs(ctx context.Context, req *pb.EmptyRequest) (*pb.GetSpe
 	}, nil
 }
 
-func (s *Server) GetDoctors(ctx context.Context, req *pb.EmptyRequest) (*pb.GetDoctorsResponse, error) {
-	doctors, err := s.Service.GetDoctors(ctx)
+func (s *Server) GetDoctors(ctx context.Context, req *pb.GetDoctorsRequest) (*pb.GetDoctorsResponse, error) {
+	doctors, total, err := s.Service.GetDoctors(ctx, model.PersonFilter{Query: req.Query, Inactive: req.Inactive}, listPageFromPb(req.Page))
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить список врачей: %w", err)
 	}
@@ -220,11 +648,11 @@ func (s *Server) GetDoctors(ctx context.Context, req *pb.EmptyRequest) (*pb.GetD
 		}
 		gRPCDoctors = append(gRPCDoctors, &doctor)
 	}
-	return &pb.GetDoctorsResponse{Doctors: gRPCDoctors}, nil
+	return &pb.GetDoctorsResponse{Doctors: gRPCDoctors, Total: int32(total)}, nil
 }
 
-func (s *Server) GetPatients(ctx context.Context, req *pb.EmptyRequest) (*pb.GetPatientsResponse, error) {
-	specs, err := s.Service.GetPatients(ctx)
+func (s *Server) GetPatients(ctx context.Context, req *pb.GetPatientsRequest) (*pb.GetPatientsResponse, error) {
+	specs, total, err := s.Service.GetPatients(ctx, model.PersonFilter{Query: req.Query, Inactive: req.Inactive}, listPageFromPb(req.Page))
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить список пациентов: %w", err)
 	}
@@ -242,7 +670,7 @@ func (s *Server) GetPatients(ctx context.Context, req *pb.EmptyRequest) (*pb.Get
 		}
 		gRPCSPatients = append(gRPCSPatients, &patient)
 	}
-	return &pb.GetPatientsResponse{Patients: gRPCSPatients}, nil
+	return &pb.GetPatientsResponse{Patients: gRPCSPatients, Total: int32(total)}, nil
 }
 
 func (s *Server) UpdatePatient(ctx context.Context, req *pb.UpdatePatientRequest) (*pb.DefaultResponse, error) {
@@ -302,12 +730,20 @@ func (s *Server) UpdateAdmin(ctx context.Context, req *pb.UpdateAdminRequest) (*
 	return &pb.DefaultResponse{}, nil
 }
 
-func (s *Server) DeleteUser(ctx context.Context, req *pb.DeleteRequest) (*pb.DefaultResponse, error) {
-	err := s.Service.DeleteUser(ctx, int(req.Id))
+func (s *Server) RetireUser(ctx context.Context, req *pb.ChangeUserStatusRequest) (*pb.ChangeUserStatusResponse, error) {
+	status, err := s.Service.RetireUser(ctx, int(req.UserId), int(req.ChangedBy))
 	if err != nil {
-		return nil, fmt.Errorf("не удалось удалить пользователя: %w", err)
+		return nil, fmt.Errorf("не удалось вывести пользователя из работы: %w", err)
 	}
-	return &pb.DefaultResponse{}, nil
+	return &pb.ChangeUserStatusResponse{Status: status}, nil
+}
+
+func (s *Server) RestoreUser(ctx context.Context, req *pb.ChangeUserStatusRequest) (*pb.ChangeUserStatusResponse, error) {
+	status, err := s.Service.RestoreUser(ctx, int(req.UserId), int(req.ChangedBy))
+	if err != nil {
+		return nil, fmt.Errorf("не удалось вернуть пользователя в работу: %w", err)
+	}
+	return &pb.ChangeUserStatusResponse{Status: status}, nil
 }
 
 func (s *Server) UpdateEmployeeLogin(ctx context.Context, req *pb.UpdateUserLoginRequest) (*pb.DefaultResponse, error) {
@@ -384,8 +820,8 @@ func convertToProto(resp model.AdminScheduleOverview) *pb.AdminScheduleOverview
 	return protoResp
 }
 
-func (s *Server) GetUnconfirmedVisitPayments(ctx context.Context, req *pb.EmptyRequest) (*pb.UnconfirmedVisitPaymentsResponse, error) {
-	visits, err := s.Service.GetUnconfirmedVisitsPayments(ctx)
+func (s *Server) GetUnconfirmedVisitPayments(ctx context.Context, req *pb.GetUnconfirmedVisitPaymentsRequest) (*pb.UnconfirmedVisitPaymentsResponse, error) {
+	visits, total, err := s.Service.GetUnconfirmedVisitsPayments(ctx, listPageFromPb(req.Page))
 	if err != nil {
 		return &pb.UnconfirmedVisitPaymentsResponse{}, fmt.Errorf("не удалось получить визиты с неподтвержденной суммой к оплате: %w", err)
 	}
@@ -399,10 +835,12 @@ func (s *Server) GetUnconfirmedVisitPayments(ctx context.Context, req *pb.EmptyR
 		var servicesAndMaterials []*pb.GetVisitMaterialsAndServices
 		for _, itemPerVisit := range itemsPerVisit {
 			serviceMaterial := &pb.GetVisitMaterialsAndServices{
-				Id:       int32(itemPerVisit.ID),
-				VisitId:  int32(itemPerVisit.VisitID),
-				Item:     itemPerVisit.Item,
-				Quantity: int32(itemPerVisit.Quantity),
+				Id:           int32(itemPerVisit.ID),
+				VisitId:      int32(itemPerVisit.VisitID),
+				Item:         itemPerVisit.Item,
+				Quantity:     int32(itemPerVisit.Quantity),
+				PricePerUnit: optionalInt32(itemPerVisit.PricePerUnit),
+				TotalPrice:   optionalInt32(itemPerVisit.TotalPrice),
 			}
 			servicesAndMaterials = append(servicesAndMaterials, serviceMaterial)
 		}
@@ -413,22 +851,154 @@ func (s *Server) GetUnconfirmedVisitPayments(ctx context.Context, req *pb.EmptyR
 			CreatedAt:            item.CreatedAt,
 			Price:                int32(item.Price),
 			MaterialsAndServices: servicesAndMaterials,
+			InvoiceId:            int32(item.InvoiceID),
+			Paid:                 int32(item.Paid),
+			Balance:              int32(item.Balance),
+			Status:               item.Status,
 		}
 		visitsResp = append(visitsResp, visit)
 	}
-	return &pb.UnconfirmedVisitPaymentsResponse{VisitPayments: visitsResp}, nil
+	return &pb.UnconfirmedVisitPaymentsResponse{VisitPayments: visitsResp, Total: int32(total)}, nil
+}
+
+func (s *Server) GetVisitInvoice(ctx context.Context, req *pb.GetByIdRequest) (*pb.InvoiceResponse, error) {
+	invoice, err := s.Service.GetVisitInvoice(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InvoiceResponse{Invoice: invoiceToPb(invoice)}, nil
+}
+
+func (s *Server) GetInvoice(ctx context.Context, req *pb.GetByIdRequest) (*pb.InvoiceResponse, error) {
+	invoice, err := s.Service.GetInvoice(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InvoiceResponse{Invoice: invoiceToPb(invoice)}, nil
+}
+
+func (s *Server) SetInvoiceDiscount(ctx context.Context, req *pb.SetInvoiceDiscountRequest) (*pb.InvoiceResponse, error) {
+	var discount *model.Discount
+	if req.Kind != "" {
+		discount = &model.Discount{Kind: req.Kind, Value: int(req.Value), Reason: req.Reason}
+	}
+	invoice, err := s.Service.SetInvoiceDiscount(ctx, int(req.InvoiceId), discount)
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InvoiceResponse{Invoice: invoiceToPb(invoice)}, nil
 }
 
-func (s *Server) UpdateVisitPayment(ctx context.Context, req *pb.UpdateVisitPaymentRequest) (*pb.DefaultResponse, error) {
-	err := s.Service.UpdateVisitPayment(ctx, model.VisitPayment{
-		VisitID: int(req.Payment.VisitId),
-		Price:   int(req.Payment.Price),
-		Status:  req.Payment.Status,
+func (s *Server) AddInvoicePayment(ctx context.Context, req *pb.AddInvoicePaymentRequest) (*pb.InvoiceResponse, error) {
+	invoice, err := s.Service.AddInvoicePayment(ctx, int(req.InvoiceId), model.InvoicePayment{
+		Amount:  int(req.Amount),
+		Method:  req.Method,
+		Comment: req.Comment,
 	})
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
-		return nil, fmt.Errorf("не удалось подтвердить цену приема: %w", err)
+		return nil, err
+	}
+	return &pb.InvoiceResponse{Invoice: invoiceToPb(invoice)}, nil
+}
+
+func (s *Server) RefundInvoicePayment(ctx context.Context, req *pb.RefundInvoicePaymentRequest) (*pb.InvoiceResponse, error) {
+	invoice, err := s.Service.RefundInvoicePayment(ctx, int(req.PaymentId), int(req.Amount), req.Comment)
+	if errors.Is(err, sharederrors.ErrInvalidValue) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InvoiceResponse{Invoice: invoiceToPb(invoice)}, nil
+}
+
+func (s *Server) GetPatientBalance(ctx context.Context, req *pb.GetByIdRequest) (*pb.PatientBalanceResponse, error) {
+	balance, err := s.Service.GetPatientBalance(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.PatientBalanceResponse{
+		PatientId:   int32(balance.PatientID),
+		Invoiced:    int32(balance.Invoiced),
+		Paid:        int32(balance.Paid),
+		Outstanding: int32(balance.Outstanding),
+	}
+	for _, invoice := range balance.OpenInvoices {
+		resp.OpenInvoices = append(resp.OpenInvoices, invoiceToPb(invoice))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetInvoiceDocument(ctx context.Context, req *pb.GetByIdRequest) (*pb.BillingDocumentResponse, error) {
+	doc, err := s.Service.GetInvoiceDocument(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return billingDocumentToPb(doc), nil
+}
+
+func (s *Server) GetReceiptDocument(ctx context.Context, req *pb.GetByIdRequest) (*pb.BillingDocumentResponse, error) {
+	doc, err := s.Service.GetReceiptDocument(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return billingDocumentToPb(doc), nil
+}
+
+func billingDocumentToPb(doc model.BillingDocument) *pb.BillingDocumentResponse {
+	return &pb.BillingDocumentResponse{
+		Number:     int32(doc.Number),
+		DocumentId: doc.DocumentID,
+		FileName:   doc.FileName,
+		Content:    doc.Content,
 	}
-	return &pb.DefaultResponse{}, nil
+}
+
+func invoiceToPb(invoice model.Invoice) *pb.Invoice {
+	item := &pb.Invoice{
+		Id:             int32(invoice.ID),
+		VisitId:        int32(invoice.VisitID),
+		PatientId:      int32(invoice.PatientID),
+		Subtotal:       int32(invoice.Subtotal),
+		DiscountKind:   invoice.DiscountKind,
+		DiscountValue:  int32(invoice.DiscountValue),
+		DiscountReason: invoice.DiscountReason,
+		DiscountAmount: int32(invoice.DiscountAmount),
+		Total:          int32(invoice.Total),
+		Paid:           int32(invoice.Paid),
+		Balance:        int32(invoice.Balance),
+		Status:         invoice.Status,
+		CreatedAt:      timestamppb.New(invoice.CreatedAt),
+	}
+	for _, line := range invoice.Lines {
+		item.Lines = append(item.Lines, &pb.InvoiceLine{
+			Id:           int32(line.ID),
+			Kind:         line.Kind,
+			ItemId:       int32(line.ItemID),
+			Name:         line.Name,
+			Quantity:     int32(line.Quantity),
+			PricePerUnit: int32(line.PricePerUnit),
+			TotalPrice:   int32(line.TotalPrice),
+		})
+	}
+	for _, p := range invoice.Payments {
+		item.Payments = append(item.Payments, &pb.InvoicePayment{
+			Id:        int32(p.ID),
+			Kind:      p.Kind,
+			Amount:    int32(p.Amount),
+			Method:    p.Method,
+			RefundOf:  int32(p.RefundOf),
+			Comment:   p.Comment,
+			CreatedAt: timestamppb.New(p.CreatedAt),
+		})
+	}
+	return item
 }
 
 func (s *Server) GetVisitMaterialsAndServices(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetVisitMaterialsAndServicesResponse, error) {
@@ -439,23 +1009,48 @@ func (s *Server) GetVisitMaterialsAndServices(ctx context.Context, req *pb.GetBy
 	var servicesAndMaterials []*pb.GetVisitMaterialsAndServices
 	for _, item := range resp {
 		serviceMaterial := &pb.GetVisitMaterialsAndServices{
-			Id:       int32(item.ID),
-			VisitId:  int32(item.VisitID),
-			Item:     item.Item,
-			Quantity: int32(item.Quantity),
+			Id:           int32(item.ID),
+			VisitId:      int32(item.VisitID),
+			Item:         item.Item,
+			Quantity:     int32(item.Quantity),
+			PricePerUnit: optionalInt32(item.PricePerUnit),
+			TotalPrice:   optionalInt32(item.TotalPrice),
 		}
 		servicesAndMaterials = append(servicesAndMaterials, serviceMaterial)
 	}
 	return &pb.GetVisitMaterialsAndServicesResponse{VisitMaterialsServices: servicesAndMaterials}, nil
 }
 
-func (s *Server) GetUnconfirmedAppointments(ctx context.Context, req *pb.EmptyRequest) (*pb.GetUnconfirmedAppointmentResponse, error) {
-	resp, err := s.Service.GetUnconfirmedAppointments(ctx)
+func (s *Server) GetUnconfirmedAppointments(ctx context.Context, req *pb.GetUnconfirmedAppointmentsRequest) (*pb.GetUnconfirmedAppointmentResponse, error) {
+	var period model.DatePeriod
+	if req.DateFrom != nil {
+		from := req.DateFrom.AsTime()
+		period.From = &from
+	}
+	if req.DateTo != nil {
+		to := req.DateTo.AsTime()
+		period.To = &to
+	}
+	resp, total, err := s.Service.GetUnconfirmedAppointments(ctx, period, listPageFromPb(req.Page))
 	if err != nil {
 		return &pb.GetUnconfirmedAppointmentResponse{}, err
 	}
+	return &pb.GetUnconfirmedAppointmentResponse{Appointments: appointmentsToPb(resp), Total: int32(total)}, nil
+}
+
+// listPageFromPb страница списка из запроса; без страницы — список целиком
+func listPageFromPb(page *pb.ListPage) model.ListPage {
+	return model.ListPage{
+		Limit:  int(page.GetLimit()),
+		Offset: int(page.GetOffset()),
+		Sort:   page.GetSort(),
+		Desc:   page.GetDesc(),
+	}
+}
+
+func appointmentsToPb(items []model.Appointment) []*pb.Appointment {
 	var appointments []*pb.Appointment
-	for _, item := range resp {
+	for _, item := range items {
 		appt := &pb.Appointment{
 			Id:          int32(item.ID),
 			PatientId:   int32(item.PatientID),
@@ -474,7 +1069,7 @@ func (s *Server) GetUnconfirmedAppointments(ctx context.Context, req *pb.EmptyRe
 		}
 		appointments = append(appointments, appt)
 	}
-	return &pb.GetUnconfirmedAppointmentResponse{Appointments: appointments}, nil
+	return appointments
 }
 
 func (s *Server) UpdateAppointment(ctx context.Context, req *pb.UpdateAppointmentRequest) (*pb.DefaultResponse, error) {
@@ -490,3 +1085,48 @@ func (s *Server) UpdateAppointment(ctx context.Context, req *pb.UpdateAppointmen
 	}
 	return &pb.DefaultResponse{}, nil
 }
+
+func (s *Server) GetAuditLog(ctx context.Context, req *pb.GetAuditLogRequest) (*pb.GetAuditLogResponse, error) {
+	filter := model.AuditFilter{PatientID: int(req.PatientId), ActorID: int(req.ActorId)}
+	if req.DateFrom != nil {
+		from := req.DateFrom.AsTime()
+		filter.Period.From = &from
+	}
+	if req.DateTo != nil {
+		to := req.DateTo.AsTime()
+		filter.Period.To = &to
+	}
+	entries, total, err := s.Service.GetAuditLog(ctx, filter, listPageFromPb(req.Page))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetAuditLogResponse{Entries: make([]*pb.AuditEntry, 0, len(entries)), Total: int32(total)}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &pb.AuditEntry{
+			Id:         e.ID,
+			OccurredAt: timestamppb.New(e.OccurredAt),
+			Service:    e.Service,
+			Action:     e.Action,
+			ActorId:    int32(e.ActorID),
+			ActorRole:  int32(e.ActorRole),
+			Resource:   e.Resource,
+			ResourceId: e.ResourceID,
+			PatientId:  int32(e.PatientID),
+			Outcome:    e.Outcome,
+			Hash:       e.Hash,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) VerifyAuditLog(ctx context.Context, _ *pb.EmptyRequest) (*pb.VerifyAuditLogResponse, error) {
+	check, err := s.Service.VerifyAuditLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VerifyAuditLogResponse{
+		Checked:  int32(check.Checked),
+		BrokenId: check.BrokenID,
+		LastHash: check.LastHash,
+	}, nil
+}
@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+type (
+	// AuditEntry запись журнала обращений к данным: кто (ActorID, ActorRole), каким методом (Service, Action),
+	// к какому ресурсу и чьим данным, с каким результатом (код gRPC-статуса). Hash — звено цепочки в hex
+	AuditEntry struct {
+		ID         int64
+		OccurredAt time.Time
+		Service    string
+		Action     string
+		ActorID    int
+		ActorRole  int
+		Resource   string
+		ResourceID string
+		PatientID  int
+		Outcome    string
+		Hash       string
+	}
+	// AuditFilter отбор записей журнала; нулевые поля не ограничивают отбор
+	AuditFilter struct {
+		PatientID int
+		ActorID   int
+		Period    DatePeriod
+	}
+	// AuditChainCheck результат проверки цепочки хешей: BrokenID — первая запись, хеш которой не сходится,
+	// 0 — цепочка цела
+	AuditChainCheck struct {
+		Checked  int
+		BrokenID int64
+		LastHash string
+	}
+)
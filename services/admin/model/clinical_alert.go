@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// ClinicalAlertRule правило клинического предупреждения. MaterialID задан для TargetType material,
+// ServiceID — для service; TargetName — их название
+type ClinicalAlertRule struct {
+	ID             int
+	Title          string
+	ConditionType  string // allergy, condition, anamnesis_flag
+	ConditionValue string
+	TargetType     string // visit, material, service
+	MaterialID     int
+	ServiceID      int
+	TargetName     string
+	Severity       string // info, warning, critical
+	Message        string
+	IsActive       bool
+	CreatedAt      time.Time
+}
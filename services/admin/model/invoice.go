@@ -0,0 +1,68 @@
+package model
+
+import "time"
+
+// Invoice счёт на приём. Paid — внесено за вычетом возвратов, Balance — остаток к оплате
+type Invoice struct {
+	ID             int
+	VisitID        int
+	PatientID      int
+	Subtotal       int
+	DiscountKind   string // percent, fixed; пусто — без скидки
+	DiscountValue  int
+	DiscountReason string // loyalty, staff, promo
+	DiscountAmount int
+	Total          int
+	Paid           int
+	Balance        int
+	Status         string // issued, partially_paid, paid, refunded
+	CreatedAt      time.Time
+	Lines          []InvoiceLine
+	Payments       []InvoicePayment
+}
+
+// InvoiceLine строка счёта по цене на день приёма
+type InvoiceLine struct {
+	ID           int
+	Kind         string // service, material
+	ItemID       int
+	Name         string
+	Quantity     int
+	PricePerUnit int
+	TotalPrice   int
+}
+
+// InvoicePayment оплата или возврат; RefundOf — оплата, по которой сделан возврат
+type InvoicePayment struct {
+	ID        int
+	Kind      string // payment, refund
+	Amount    int
+	Method    string // cash, card, transfer; unknown — оплата до введения счетов
+	RefundOf  int
+	Comment   string
+	CreatedAt time.Time
+}
+
+// Discount скидка на счёт: процент или фиксированная сумма с причиной
+type Discount struct {
+	Kind   string
+	Value  int
+	Reason string
+}
+
+// PatientBalance расчёты с пациентом по всем счетам
+type PatientBalance struct {
+	PatientID    int
+	Invoiced     int
+	Paid         int
+	Outstanding  int
+	OpenInvoices []Invoice
+}
+
+// BillingDocument печатный документ по счёту: счёт на оплату или чек. DocumentID — документ пациента с этим PDF
+type BillingDocument struct {
+	Number     int
+	DocumentID string
+	FileName   string
+	Content    []byte
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+type (
+	// ListPage страница списка: Limit == 0 — список целиком; Sort — поле сортировки из допустимых
+	// для списка, пустое — сортировка по умолчанию
+	ListPage struct {
+		Limit  int
+		Offset int
+		Sort   string
+		Desc   bool
+	}
+	// PersonFilter отбор пациентов, врачей и администраторов: Query — строка поиска, Inactive — только
+	// выведенные из работы (отключённые сотрудники, архивные пациенты) вместо работающих
+	PersonFilter struct {
+		Query    string
+		Inactive bool
+	}
+	// DatePeriod период дат включительно; nil — без границы
+	DatePeriod struct {
+		From *time.Time
+		To   *time.Time
+	}
+)
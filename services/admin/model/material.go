@@ -1,7 +1,65 @@
 package model
 
+import "time"
+
+// Material материал; StockQuantity — остаток в единицах Unit, отрицательный при списании сверх оприходованного
 type Material struct {
-	ID    int
-	Name  string
-	Price int
+	ID                int
+	Name              string
+	Price             int
+	Unit              string
+	LowStockThreshold int // 0 — без предупреждения о низком остатке
+	StockQuantity     int
+}
+
+// MaterialBatch партия поступления; ExpiresAt nil у материалов без срока годности
+type MaterialBatch struct {
+	ID               int
+	MaterialID       int
+	BatchNumber      string
+	QuantityReceived int
+	QuantityLeft     int
+	ExpiresAt        *time.Time
+	ReceivedAt       time.Time
+	Comment          string
+}
+
+// StockMovement движение материала; Quantity со знаком, BatchID и VisitID равны 0, если не заданы
+type StockMovement struct {
+	ID         int
+	MaterialID int
+	BatchID    int
+	Kind       string // receipt, consumption, adjustment
+	Quantity   int
+	VisitID    int
+	Reason     string
+	CreatedAt  time.Time
+}
+
+// StockAdjustment корректировка остатка; без BatchID уменьшение списывается по партиям с ближайшим сроком годности
+type StockAdjustment struct {
+	MaterialID int
+	BatchID    int
+	Quantity   int
+	Reason     string
+}
+
+// MaterialStock остаток материала с партиями и последними движениями
+type MaterialStock struct {
+	Material  Material
+	Batches   []MaterialBatch
+	Movements []StockMovement
+}
+
+// ExpiringBatch партия с истекающим сроком годности
+type ExpiringBatch struct {
+	MaterialBatch
+	MaterialName string
+	Unit         string
+}
+
+// StockReport материалы с остатком не выше порога и партии, срок годности которых истекает
+type StockReport struct {
+	LowStock []Material
+	Expiring []ExpiringBatch
 }
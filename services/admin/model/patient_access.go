@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// PatientAccessGrant допуск врача к медицинской карте пациента, которого он не лечит. ExpiresAt == nil —
+// бессрочный допуск, RevokedAt != nil — отозванный; GrantedBy — администратор, выдавший допуск
+type PatientAccessGrant struct {
+	ID        int
+	PatientID int
+	DoctorID  int
+	Doctor    string
+	Reason    string
+	GrantedBy int
+	ExpiresAt *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
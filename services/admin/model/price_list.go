@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// Виды позиций прайс-листа
+const (
+	PriceKindService  = "service"
+	PriceKindMaterial = "material"
+)
+
+// PriceListEntry цена услуги или материала, действующая с даты EffectiveFrom до следующей записи
+type PriceListEntry struct {
+	ID            int
+	ItemID        int
+	Price         int
+	EffectiveFrom time.Time
+	CreatedAt     time.Time
+}
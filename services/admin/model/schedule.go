@@ -39,3 +39,27 @@ type DoctorDailyOverride struct {
 	SlotDurationMinutes int
 	IsDayOff            bool
 }
+
+// ScheduleRule исключение из постоянного расписания на диапазон дат или по повторяющемуся правилу.
+// DoctorID == 0 — правило клиники
+type ScheduleRule struct {
+	ID                  int
+	DoctorID            int
+	Recurrence          string // range, monthly_weekday, annual
+	DateFrom            time.Time
+	DateTo              *time.Time // nil — правило бессрочно
+	Weekday             int
+	Nth                 int
+	StartTime           time.Time
+	EndTime             time.Time
+	SlotDurationMinutes int
+	IsDayOff            bool
+	Title               string
+}
+
+// Holiday нерабочий день клиники из календаря праздников
+type Holiday struct {
+	Date   time.Time
+	Title  string
+	Annual bool // повторяется каждый год
+}
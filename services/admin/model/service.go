@@ -6,9 +6,10 @@ type (
 		Name string
 	}
 	Service struct {
-		ID       int
-		Name     string
-		Price    int
-		Category int
+		ID              int
+		Name            string
+		Price           int
+		Category        int
+		DurationMinutes int
 	}
 )
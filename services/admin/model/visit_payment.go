@@ -12,11 +12,17 @@ type UnconfirmedVisitPayment struct {
 	Patient   string
 	CreatedAt string
 	Price     int
+	InvoiceID int
+	Paid      int
+	Balance   int
+	Status    string
 }
 
 type VisitMaterialsServices struct {
-	ID       int
-	VisitID  int
-	Item     string
-	Quantity int
+	ID           int
+	VisitID      int
+	Item         string
+	Quantity     int
+	PricePerUnit *int // цена на день приёма; nil — строка записана до введения истории цен
+	TotalPrice   *int
 }
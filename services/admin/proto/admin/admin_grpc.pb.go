@@ -26,12 +26,33 @@ const (
 	AdminService_UpdateDoctorWeeklySchedule_FullMethodName   = "/admin.AdminService/UpdateDoctorWeeklySchedule"
 	AdminService_AddClinicDailyOverride_FullMethodName       = "/admin.AdminService/AddClinicDailyOverride"
 	AdminService_AddDoctorDailyOverride_FullMethodName       = "/admin.AdminService/AddDoctorDailyOverride"
+	AdminService_GetClinicTimeZone_FullMethodName            = "/admin.AdminService/GetClinicTimeZone"
+	AdminService_UpdateClinicTimeZone_FullMethodName         = "/admin.AdminService/UpdateClinicTimeZone"
+	AdminService_GetScheduleRules_FullMethodName             = "/admin.AdminService/GetScheduleRules"
+	AdminService_AddScheduleRule_FullMethodName              = "/admin.AdminService/AddScheduleRule"
+	AdminService_DeleteScheduleRule_FullMethodName           = "/admin.AdminService/DeleteScheduleRule"
+	AdminService_ImportHolidayCalendar_FullMethodName        = "/admin.AdminService/ImportHolidayCalendar"
 	AdminService_AddMaterial_FullMethodName                  = "/admin.AdminService/AddMaterial"
 	AdminService_AddService_FullMethodName                   = "/admin.AdminService/AddService"
 	AdminService_UpdateMaterial_FullMethodName               = "/admin.AdminService/UpdateMaterial"
 	AdminService_UpdateService_FullMethodName                = "/admin.AdminService/UpdateService"
 	AdminService_DeleteMaterial_FullMethodName               = "/admin.AdminService/DeleteMaterial"
 	AdminService_DeleteService_FullMethodName                = "/admin.AdminService/DeleteService"
+	AdminService_ReceiveMaterialBatch_FullMethodName         = "/admin.AdminService/ReceiveMaterialBatch"
+	AdminService_AdjustMaterialStock_FullMethodName          = "/admin.AdminService/AdjustMaterialStock"
+	AdminService_GetMaterialStock_FullMethodName             = "/admin.AdminService/GetMaterialStock"
+	AdminService_GetStockReport_FullMethodName               = "/admin.AdminService/GetStockReport"
+	AdminService_GetPriceHistory_FullMethodName              = "/admin.AdminService/GetPriceHistory"
+	AdminService_AddPriceListEntry_FullMethodName            = "/admin.AdminService/AddPriceListEntry"
+	AdminService_DeletePriceListEntry_FullMethodName         = "/admin.AdminService/DeletePriceListEntry"
+	AdminService_GetPriceOnDate_FullMethodName               = "/admin.AdminService/GetPriceOnDate"
+	AdminService_GetClinicalAlertRules_FullMethodName        = "/admin.AdminService/GetClinicalAlertRules"
+	AdminService_AddClinicalAlertRule_FullMethodName         = "/admin.AdminService/AddClinicalAlertRule"
+	AdminService_UpdateClinicalAlertRule_FullMethodName      = "/admin.AdminService/UpdateClinicalAlertRule"
+	AdminService_DeleteClinicalAlertRule_FullMethodName      = "/admin.AdminService/DeleteClinicalAlertRule"
+	AdminService_GetPatientAccessGrants_FullMethodName       = "/admin.AdminService/GetPatientAccessGrants"
+	AdminService_AddPatientAccessGrant_FullMethodName        = "/admin.AdminService/AddPatientAccessGrant"
+	AdminService_RevokePatientAccessGrant_FullMethodName     = "/admin.AdminService/RevokePatientAccessGrant"
 	AdminService_GetAdmins_FullMethodName                    = "/admin.AdminService/GetAdmins"
 	AdminService_GetPatients_FullMethodName                  = "/admin.AdminService/GetPatients"
 	AdminService_GetDoctors_FullMethodName                   = "/admin.AdminService/GetDoctors"
@@ -39,15 +60,25 @@ const (
 	AdminService_UpdateDoctor_FullMethodName                 = "/admin.AdminService/UpdateDoctor"
 	AdminService_UpdateAdmin_FullMethodName                  = "/admin.AdminService/UpdateAdmin"
 	AdminService_UpdatePatient_FullMethodName                = "/admin.AdminService/UpdatePatient"
-	AdminService_DeleteUser_FullMethodName                   = "/admin.AdminService/DeleteUser"
+	AdminService_RetireUser_FullMethodName                   = "/admin.AdminService/RetireUser"
+	AdminService_RestoreUser_FullMethodName                  = "/admin.AdminService/RestoreUser"
 	AdminService_UpdateEmployeeLogin_FullMethodName          = "/admin.AdminService/UpdateEmployeeLogin"
 	AdminService_UpdatePatientLogin_FullMethodName           = "/admin.AdminService/UpdatePatientLogin"
 	AdminService_GetUnconfirmedVisitPayments_FullMethodName  = "/admin.AdminService/GetUnconfirmedVisitPayments"
 	AdminService_GetClinicScheduleGrid_FullMethodName        = "/admin.AdminService/GetClinicScheduleGrid"
-	AdminService_UpdateVisitPayment_FullMethodName           = "/admin.AdminService/UpdateVisitPayment"
+	AdminService_GetVisitInvoice_FullMethodName              = "/admin.AdminService/GetVisitInvoice"
+	AdminService_GetInvoice_FullMethodName                   = "/admin.AdminService/GetInvoice"
+	AdminService_SetInvoiceDiscount_FullMethodName           = "/admin.AdminService/SetInvoiceDiscount"
+	AdminService_AddInvoicePayment_FullMethodName            = "/admin.AdminService/AddInvoicePayment"
+	AdminService_RefundInvoicePayment_FullMethodName         = "/admin.AdminService/RefundInvoicePayment"
+	AdminService_GetPatientBalance_FullMethodName            = "/admin.AdminService/GetPatientBalance"
+	AdminService_GetInvoiceDocument_FullMethodName           = "/admin.AdminService/GetInvoiceDocument"
+	AdminService_GetReceiptDocument_FullMethodName           = "/admin.AdminService/GetReceiptDocument"
 	AdminService_GetVisitMaterialsAndServices_FullMethodName = "/admin.AdminService/GetVisitMaterialsAndServices"
 	AdminService_GetUnconfirmedAppointments_FullMethodName   = "/admin.AdminService/GetUnconfirmedAppointments"
 	AdminService_UpdateAppointment_FullMethodName            = "/admin.AdminService/UpdateAppointment"
+	AdminService_GetAuditLog_FullMethodName                  = "/admin.AdminService/GetAuditLog"
+	AdminService_VerifyAuditLog_FullMethodName               = "/admin.AdminService/VerifyAuditLog"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -59,28 +90,59 @@ type AdminServiceClient interface {
 	UpdateDoctorWeeklySchedule(ctx context.Context, in *UpdateDoctorWeeklyScheduleRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	AddClinicDailyOverride(ctx context.Context, in *AddClinicDailyOverrideRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	AddDoctorDailyOverride(ctx context.Context, in *AddDoctorDailyOverrideRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetClinicTimeZone(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ClinicTimeZone, error)
+	UpdateClinicTimeZone(ctx context.Context, in *ClinicTimeZone, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetScheduleRules(ctx context.Context, in *GetScheduleRulesRequest, opts ...grpc.CallOption) (*GetScheduleRulesResponse, error)
+	AddScheduleRule(ctx context.Context, in *ScheduleRule, opts ...grpc.CallOption) (*AddScheduleRuleResponse, error)
+	DeleteScheduleRule(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	ImportHolidayCalendar(ctx context.Context, in *ImportHolidayCalendarRequest, opts ...grpc.CallOption) (*ImportHolidayCalendarResponse, error)
 	AddMaterial(ctx context.Context, in *AddMaterialRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	AddService(ctx context.Context, in *AddServiceRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	UpdateMaterial(ctx context.Context, in *UpdateMaterialRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	UpdateService(ctx context.Context, in *UpdateServiceRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	DeleteMaterial(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	DeleteService(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
-	GetAdmins(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error)
-	GetPatients(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error)
-	GetDoctors(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error)
+	ReceiveMaterialBatch(ctx context.Context, in *ReceiveMaterialBatchRequest, opts ...grpc.CallOption) (*MaterialBatch, error)
+	AdjustMaterialStock(ctx context.Context, in *AdjustMaterialStockRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetMaterialStock(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*MaterialStockResponse, error)
+	GetStockReport(ctx context.Context, in *StockReportRequest, opts ...grpc.CallOption) (*StockReportResponse, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	AddPriceListEntry(ctx context.Context, in *AddPriceListEntryRequest, opts ...grpc.CallOption) (*AddPriceListEntryResponse, error)
+	DeletePriceListEntry(ctx context.Context, in *DeletePriceListEntryRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetPriceOnDate(ctx context.Context, in *GetPriceOnDateRequest, opts ...grpc.CallOption) (*GetPriceOnDateResponse, error)
+	GetClinicalAlertRules(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetClinicalAlertRulesResponse, error)
+	AddClinicalAlertRule(ctx context.Context, in *ClinicalAlertRule, opts ...grpc.CallOption) (*AddClinicalAlertRuleResponse, error)
+	UpdateClinicalAlertRule(ctx context.Context, in *ClinicalAlertRule, opts ...grpc.CallOption) (*DefaultResponse, error)
+	DeleteClinicalAlertRule(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetPatientAccessGrants(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientAccessGrantsResponse, error)
+	AddPatientAccessGrant(ctx context.Context, in *PatientAccessGrant, opts ...grpc.CallOption) (*AddPatientAccessGrantResponse, error)
+	RevokePatientAccessGrant(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetAdmins(ctx context.Context, in *GetAdminsRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error)
+	GetPatients(ctx context.Context, in *GetPatientsRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error)
+	GetDoctors(ctx context.Context, in *GetDoctorsRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error)
 	GetSpecs(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetSpecsResponse, error)
 	UpdateDoctor(ctx context.Context, in *UpdateDoctorRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	UpdateAdmin(ctx context.Context, in *UpdateAdminRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	UpdatePatient(ctx context.Context, in *UpdatePatientRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
-	DeleteUser(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	RetireUser(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error)
+	RestoreUser(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error)
 	UpdateEmployeeLogin(ctx context.Context, in *UpdateUserLoginRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	UpdatePatientLogin(ctx context.Context, in *UpdateUserLoginRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
-	GetUnconfirmedVisitPayments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*UnconfirmedVisitPaymentsResponse, error)
+	GetUnconfirmedVisitPayments(ctx context.Context, in *GetUnconfirmedVisitPaymentsRequest, opts ...grpc.CallOption) (*UnconfirmedVisitPaymentsResponse, error)
 	GetClinicScheduleGrid(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*AdminScheduleOverview, error)
-	UpdateVisitPayment(ctx context.Context, in *UpdateVisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetVisitInvoice(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	GetInvoice(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	SetInvoiceDiscount(ctx context.Context, in *SetInvoiceDiscountRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	AddInvoicePayment(ctx context.Context, in *AddInvoicePaymentRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	RefundInvoicePayment(ctx context.Context, in *RefundInvoicePaymentRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	GetPatientBalance(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*PatientBalanceResponse, error)
+	GetInvoiceDocument(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*BillingDocumentResponse, error)
+	GetReceiptDocument(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*BillingDocumentResponse, error)
 	GetVisitMaterialsAndServices(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetVisitMaterialsAndServicesResponse, error)
-	GetUnconfirmedAppointments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetUnconfirmedAppointmentResponse, error)
+	GetUnconfirmedAppointments(ctx context.Context, in *GetUnconfirmedAppointmentsRequest, opts ...grpc.CallOption) (*GetUnconfirmedAppointmentResponse, error)
 	UpdateAppointment(ctx context.Context, in *UpdateAppointmentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	VerifyAuditLog(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
 }
 
 type adminServiceClient struct {
@@ -141,6 +203,66 @@ func (c *adminServiceClient) AddDoctorDailyOverride(ctx context.Context, in *Add
 	return out, nil
 }
 
+func (c *adminServiceClient) GetClinicTimeZone(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ClinicTimeZone, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClinicTimeZone)
+	err := c.cc.Invoke(ctx, AdminService_GetClinicTimeZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateClinicTimeZone(ctx context.Context, in *ClinicTimeZone, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateClinicTimeZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetScheduleRules(ctx context.Context, in *GetScheduleRulesRequest, opts ...grpc.CallOption) (*GetScheduleRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScheduleRulesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetScheduleRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddScheduleRule(ctx context.Context, in *ScheduleRule, opts ...grpc.CallOption) (*AddScheduleRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddScheduleRuleResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddScheduleRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteScheduleRule(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteScheduleRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ImportHolidayCalendar(ctx context.Context, in *ImportHolidayCalendarRequest, opts ...grpc.CallOption) (*ImportHolidayCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportHolidayCalendarResponse)
+	err := c.cc.Invoke(ctx, AdminService_ImportHolidayCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) AddMaterial(ctx context.Context, in *AddMaterialRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DefaultResponse)
@@ -201,7 +323,157 @@ func (c *adminServiceClient) DeleteService(ctx context.Context, in *DeleteReques
 	return out, nil
 }
 
-func (c *adminServiceClient) GetAdmins(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error) {
+func (c *adminServiceClient) ReceiveMaterialBatch(ctx context.Context, in *ReceiveMaterialBatchRequest, opts ...grpc.CallOption) (*MaterialBatch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaterialBatch)
+	err := c.cc.Invoke(ctx, AdminService_ReceiveMaterialBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdjustMaterialStock(ctx context.Context, in *AdjustMaterialStockRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, AdminService_AdjustMaterialStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetMaterialStock(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*MaterialStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaterialStockResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetMaterialStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetStockReport(ctx context.Context, in *StockReportRequest, opts ...grpc.CallOption) (*StockReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetStockReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddPriceListEntry(ctx context.Context, in *AddPriceListEntryRequest, opts ...grpc.CallOption) (*AddPriceListEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPriceListEntryResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddPriceListEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeletePriceListEntry(ctx context.Context, in *DeletePriceListEntryRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeletePriceListEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPriceOnDate(ctx context.Context, in *GetPriceOnDateRequest, opts ...grpc.CallOption) (*GetPriceOnDateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceOnDateResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetPriceOnDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetClinicalAlertRules(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetClinicalAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClinicalAlertRulesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetClinicalAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddClinicalAlertRule(ctx context.Context, in *ClinicalAlertRule, opts ...grpc.CallOption) (*AddClinicalAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddClinicalAlertRuleResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddClinicalAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateClinicalAlertRule(ctx context.Context, in *ClinicalAlertRule, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateClinicalAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteClinicalAlertRule(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteClinicalAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPatientAccessGrants(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientAccessGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPatientAccessGrantsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetPatientAccessGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddPatientAccessGrant(ctx context.Context, in *PatientAccessGrant, opts ...grpc.CallOption) (*AddPatientAccessGrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPatientAccessGrantResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddPatientAccessGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokePatientAccessGrant(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevokePatientAccessGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetAdmins(ctx context.Context, in *GetAdminsRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAdminsResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetAdmins_FullMethodName, in, out, cOpts...)
@@ -211,7 +483,7 @@ func (c *adminServiceClient) GetAdmins(ctx context.Context, in *EmptyRequest, op
 	return out, nil
 }
 
-func (c *adminServiceClient) GetPatients(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error) {
+func (c *adminServiceClient) GetPatients(ctx context.Context, in *GetPatientsRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPatientsResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetPatients_FullMethodName, in, out, cOpts...)
@@ -221,7 +493,7 @@ func (c *adminServiceClient) GetPatients(ctx context.Context, in *EmptyRequest,
 	return out, nil
 }
 
-func (c *adminServiceClient) GetDoctors(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error) {
+func (c *adminServiceClient) GetDoctors(ctx context.Context, in *GetDoctorsRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDoctorsResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetDoctors_FullMethodName, in, out, cOpts...)
@@ -271,10 +543,20 @@ func (c *adminServiceClient) UpdatePatient(ctx context.Context, in *UpdatePatien
 	return out, nil
 }
 
-func (c *adminServiceClient) DeleteUser(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *adminServiceClient) RetireUser(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, AdminService_DeleteUser_FullMethodName, in, out, cOpts...)
+	out := new(ChangeUserStatusResponse)
+	err := c.cc.Invoke(ctx, AdminService_RetireUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RestoreUser(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeUserStatusResponse)
+	err := c.cc.Invoke(ctx, AdminService_RestoreUser_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -301,7 +583,7 @@ func (c *adminServiceClient) UpdatePatientLogin(ctx context.Context, in *UpdateU
 	return out, nil
 }
 
-func (c *adminServiceClient) GetUnconfirmedVisitPayments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*UnconfirmedVisitPaymentsResponse, error) {
+func (c *adminServiceClient) GetUnconfirmedVisitPayments(ctx context.Context, in *GetUnconfirmedVisitPaymentsRequest, opts ...grpc.CallOption) (*UnconfirmedVisitPaymentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UnconfirmedVisitPaymentsResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetUnconfirmedVisitPayments_FullMethodName, in, out, cOpts...)
@@ -321,10 +603,80 @@ func (c *adminServiceClient) GetClinicScheduleGrid(ctx context.Context, in *Empt
 	return out, nil
 }
 
-func (c *adminServiceClient) UpdateVisitPayment(ctx context.Context, in *UpdateVisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *adminServiceClient) GetVisitInvoice(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, AdminService_UpdateVisitPayment_FullMethodName, in, out, cOpts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetVisitInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetInvoice(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetInvoiceDiscount(ctx context.Context, in *SetInvoiceDiscountRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetInvoiceDiscount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddInvoicePayment(ctx context.Context, in *AddInvoicePaymentRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddInvoicePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RefundInvoicePayment(ctx context.Context, in *RefundInvoicePaymentRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, AdminService_RefundInvoicePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPatientBalance(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*PatientBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatientBalanceResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetPatientBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetInvoiceDocument(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*BillingDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BillingDocumentResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetInvoiceDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetReceiptDocument(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*BillingDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BillingDocumentResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetReceiptDocument_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -341,7 +693,7 @@ func (c *adminServiceClient) GetVisitMaterialsAndServices(ctx context.Context, i
 	return out, nil
 }
 
-func (c *adminServiceClient) GetUnconfirmedAppointments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetUnconfirmedAppointmentResponse, error) {
+func (c *adminServiceClient) GetUnconfirmedAppointments(ctx context.Context, in *GetUnconfirmedAppointmentsRequest, opts ...grpc.CallOption) (*GetUnconfirmedAppointmentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUnconfirmedAppointmentResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetUnconfirmedAppointments_FullMethodName, in, out, cOpts...)
@@ -361,6 +713,26 @@ func (c *adminServiceClient) UpdateAppointment(ctx context.Context, in *UpdateAp
 	return out, nil
 }
 
+func (c *adminServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) VerifyAuditLog(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, AdminService_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -370,28 +742,59 @@ type AdminServiceServer interface {
 	UpdateDoctorWeeklySchedule(context.Context, *UpdateDoctorWeeklyScheduleRequest) (*DefaultResponse, error)
 	AddClinicDailyOverride(context.Context, *AddClinicDailyOverrideRequest) (*DefaultResponse, error)
 	AddDoctorDailyOverride(context.Context, *AddDoctorDailyOverrideRequest) (*DefaultResponse, error)
+	GetClinicTimeZone(context.Context, *EmptyRequest) (*ClinicTimeZone, error)
+	UpdateClinicTimeZone(context.Context, *ClinicTimeZone) (*DefaultResponse, error)
+	GetScheduleRules(context.Context, *GetScheduleRulesRequest) (*GetScheduleRulesResponse, error)
+	AddScheduleRule(context.Context, *ScheduleRule) (*AddScheduleRuleResponse, error)
+	DeleteScheduleRule(context.Context, *DeleteRequest) (*DefaultResponse, error)
+	ImportHolidayCalendar(context.Context, *ImportHolidayCalendarRequest) (*ImportHolidayCalendarResponse, error)
 	AddMaterial(context.Context, *AddMaterialRequest) (*DefaultResponse, error)
 	AddService(context.Context, *AddServiceRequest) (*DefaultResponse, error)
 	UpdateMaterial(context.Context, *UpdateMaterialRequest) (*DefaultResponse, error)
 	UpdateService(context.Context, *UpdateServiceRequest) (*DefaultResponse, error)
 	DeleteMaterial(context.Context, *DeleteRequest) (*DefaultResponse, error)
 	DeleteService(context.Context, *DeleteRequest) (*DefaultResponse, error)
-	GetAdmins(context.Context, *EmptyRequest) (*GetAdminsResponse, error)
-	GetPatients(context.Context, *EmptyRequest) (*GetPatientsResponse, error)
-	GetDoctors(context.Context, *EmptyRequest) (*GetDoctorsResponse, error)
+	ReceiveMaterialBatch(context.Context, *ReceiveMaterialBatchRequest) (*MaterialBatch, error)
+	AdjustMaterialStock(context.Context, *AdjustMaterialStockRequest) (*DefaultResponse, error)
+	GetMaterialStock(context.Context, *GetByIdRequest) (*MaterialStockResponse, error)
+	GetStockReport(context.Context, *StockReportRequest) (*StockReportResponse, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	AddPriceListEntry(context.Context, *AddPriceListEntryRequest) (*AddPriceListEntryResponse, error)
+	DeletePriceListEntry(context.Context, *DeletePriceListEntryRequest) (*DefaultResponse, error)
+	GetPriceOnDate(context.Context, *GetPriceOnDateRequest) (*GetPriceOnDateResponse, error)
+	GetClinicalAlertRules(context.Context, *EmptyRequest) (*GetClinicalAlertRulesResponse, error)
+	AddClinicalAlertRule(context.Context, *ClinicalAlertRule) (*AddClinicalAlertRuleResponse, error)
+	UpdateClinicalAlertRule(context.Context, *ClinicalAlertRule) (*DefaultResponse, error)
+	DeleteClinicalAlertRule(context.Context, *DeleteRequest) (*DefaultResponse, error)
+	GetPatientAccessGrants(context.Context, *GetByIdRequest) (*GetPatientAccessGrantsResponse, error)
+	AddPatientAccessGrant(context.Context, *PatientAccessGrant) (*AddPatientAccessGrantResponse, error)
+	RevokePatientAccessGrant(context.Context, *DeleteRequest) (*DefaultResponse, error)
+	GetAdmins(context.Context, *GetAdminsRequest) (*GetAdminsResponse, error)
+	GetPatients(context.Context, *GetPatientsRequest) (*GetPatientsResponse, error)
+	GetDoctors(context.Context, *GetDoctorsRequest) (*GetDoctorsResponse, error)
 	GetSpecs(context.Context, *EmptyRequest) (*GetSpecsResponse, error)
 	UpdateDoctor(context.Context, *UpdateDoctorRequest) (*DefaultResponse, error)
 	UpdateAdmin(context.Context, *UpdateAdminRequest) (*DefaultResponse, error)
 	UpdatePatient(context.Context, *UpdatePatientRequest) (*DefaultResponse, error)
-	DeleteUser(context.Context, *DeleteRequest) (*DefaultResponse, error)
+	RetireUser(context.Context, *ChangeUserStatusRequest) (*ChangeUserStatusResponse, error)
+	RestoreUser(context.Context, *ChangeUserStatusRequest) (*ChangeUserStatusResponse, error)
 	UpdateEmployeeLogin(context.Context, *UpdateUserLoginRequest) (*DefaultResponse, error)
 	UpdatePatientLogin(context.Context, *UpdateUserLoginRequest) (*DefaultResponse, error)
-	GetUnconfirmedVisitPayments(context.Context, *EmptyRequest) (*UnconfirmedVisitPaymentsResponse, error)
+	GetUnconfirmedVisitPayments(context.Context, *GetUnconfirmedVisitPaymentsRequest) (*UnconfirmedVisitPaymentsResponse, error)
 	GetClinicScheduleGrid(context.Context, *EmptyRequest) (*AdminScheduleOverview, error)
-	UpdateVisitPayment(context.Context, *UpdateVisitPaymentRequest) (*DefaultResponse, error)
+	GetVisitInvoice(context.Context, *GetByIdRequest) (*InvoiceResponse, error)
+	GetInvoice(context.Context, *GetByIdRequest) (*InvoiceResponse, error)
+	SetInvoiceDiscount(context.Context, *SetInvoiceDiscountRequest) (*InvoiceResponse, error)
+	AddInvoicePayment(context.Context, *AddInvoicePaymentRequest) (*InvoiceResponse, error)
+	RefundInvoicePayment(context.Context, *RefundInvoicePaymentRequest) (*InvoiceResponse, error)
+	GetPatientBalance(context.Context, *GetByIdRequest) (*PatientBalanceResponse, error)
+	GetInvoiceDocument(context.Context, *GetByIdRequest) (*BillingDocumentResponse, error)
+	GetReceiptDocument(context.Context, *GetByIdRequest) (*BillingDocumentResponse, error)
 	GetVisitMaterialsAndServices(context.Context, *GetByIdRequest) (*GetVisitMaterialsAndServicesResponse, error)
-	GetUnconfirmedAppointments(context.Context, *EmptyRequest) (*GetUnconfirmedAppointmentResponse, error)
+	GetUnconfirmedAppointments(context.Context, *GetUnconfirmedAppointmentsRequest) (*GetUnconfirmedAppointmentResponse, error)
 	UpdateAppointment(context.Context, *UpdateAppointmentRequest) (*DefaultResponse, error)
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
+	VerifyAuditLog(context.Context, *EmptyRequest) (*VerifyAuditLogResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -417,6 +820,24 @@ func (UnimplementedAdminServiceServer) AddClinicDailyOverride(context.Context, *
 func (UnimplementedAdminServiceServer) AddDoctorDailyOverride(context.Context, *AddDoctorDailyOverrideRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddDoctorDailyOverride not implemented")
 }
+func (UnimplementedAdminServiceServer) GetClinicTimeZone(context.Context, *EmptyRequest) (*ClinicTimeZone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClinicTimeZone not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateClinicTimeZone(context.Context, *ClinicTimeZone) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClinicTimeZone not implemented")
+}
+func (UnimplementedAdminServiceServer) GetScheduleRules(context.Context, *GetScheduleRulesRequest) (*GetScheduleRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduleRules not implemented")
+}
+func (UnimplementedAdminServiceServer) AddScheduleRule(context.Context, *ScheduleRule) (*AddScheduleRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScheduleRule not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteScheduleRule(context.Context, *DeleteRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduleRule not implemented")
+}
+func (UnimplementedAdminServiceServer) ImportHolidayCalendar(context.Context, *ImportHolidayCalendarRequest) (*ImportHolidayCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportHolidayCalendar not implemented")
+}
 func (UnimplementedAdminServiceServer) AddMaterial(context.Context, *AddMaterialRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddMaterial not implemented")
 }
@@ -435,13 +856,58 @@ func (UnimplementedAdminServiceServer) DeleteMaterial(context.Context, *DeleteRe
 func (UnimplementedAdminServiceServer) DeleteService(context.Context, *DeleteRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteService not implemented")
 }
-func (UnimplementedAdminServiceServer) GetAdmins(context.Context, *EmptyRequest) (*GetAdminsResponse, error) {
+func (UnimplementedAdminServiceServer) ReceiveMaterialBatch(context.Context, *ReceiveMaterialBatchRequest) (*MaterialBatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveMaterialBatch not implemented")
+}
+func (UnimplementedAdminServiceServer) AdjustMaterialStock(context.Context, *AdjustMaterialStockRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustMaterialStock not implemented")
+}
+func (UnimplementedAdminServiceServer) GetMaterialStock(context.Context, *GetByIdRequest) (*MaterialStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaterialStock not implemented")
+}
+func (UnimplementedAdminServiceServer) GetStockReport(context.Context, *StockReportRequest) (*StockReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockReport not implemented")
+}
+func (UnimplementedAdminServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedAdminServiceServer) AddPriceListEntry(context.Context, *AddPriceListEntryRequest) (*AddPriceListEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPriceListEntry not implemented")
+}
+func (UnimplementedAdminServiceServer) DeletePriceListEntry(context.Context, *DeletePriceListEntryRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceListEntry not implemented")
+}
+func (UnimplementedAdminServiceServer) GetPriceOnDate(context.Context, *GetPriceOnDateRequest) (*GetPriceOnDateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceOnDate not implemented")
+}
+func (UnimplementedAdminServiceServer) GetClinicalAlertRules(context.Context, *EmptyRequest) (*GetClinicalAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClinicalAlertRules not implemented")
+}
+func (UnimplementedAdminServiceServer) AddClinicalAlertRule(context.Context, *ClinicalAlertRule) (*AddClinicalAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddClinicalAlertRule not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateClinicalAlertRule(context.Context, *ClinicalAlertRule) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClinicalAlertRule not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteClinicalAlertRule(context.Context, *DeleteRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClinicalAlertRule not implemented")
+}
+func (UnimplementedAdminServiceServer) GetPatientAccessGrants(context.Context, *GetByIdRequest) (*GetPatientAccessGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientAccessGrants not implemented")
+}
+func (UnimplementedAdminServiceServer) AddPatientAccessGrant(context.Context, *PatientAccessGrant) (*AddPatientAccessGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPatientAccessGrant not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokePatientAccessGrant(context.Context, *DeleteRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePatientAccessGrant not implemented")
+}
+func (UnimplementedAdminServiceServer) GetAdmins(context.Context, *GetAdminsRequest) (*GetAdminsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAdmins not implemented")
 }
-func (UnimplementedAdminServiceServer) GetPatients(context.Context, *EmptyRequest) (*GetPatientsResponse, error) {
+func (UnimplementedAdminServiceServer) GetPatients(context.Context, *GetPatientsRequest) (*GetPatientsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPatients not implemented")
 }
-func (UnimplementedAdminServiceServer) GetDoctors(context.Context, *EmptyRequest) (*GetDoctorsResponse, error) {
+func (UnimplementedAdminServiceServer) GetDoctors(context.Context, *GetDoctorsRequest) (*GetDoctorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDoctors not implemented")
 }
 func (UnimplementedAdminServiceServer) GetSpecs(context.Context, *EmptyRequest) (*GetSpecsResponse, error) {
@@ -456,8 +922,11 @@ func (UnimplementedAdminServiceServer) UpdateAdmin(context.Context, *UpdateAdmin
 func (UnimplementedAdminServiceServer) UpdatePatient(context.Context, *UpdatePatientRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePatient not implemented")
 }
-func (UnimplementedAdminServiceServer) DeleteUser(context.Context, *DeleteRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+func (UnimplementedAdminServiceServer) RetireUser(context.Context, *ChangeUserStatusRequest) (*ChangeUserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireUser not implemented")
+}
+func (UnimplementedAdminServiceServer) RestoreUser(context.Context, *ChangeUserStatusRequest) (*ChangeUserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
 }
 func (UnimplementedAdminServiceServer) UpdateEmployeeLogin(context.Context, *UpdateUserLoginRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmployeeLogin not implemented")
@@ -465,25 +934,52 @@ func (UnimplementedAdminServiceServer) UpdateEmployeeLogin(context.Context, *Upd
 func (UnimplementedAdminServiceServer) UpdatePatientLogin(context.Context, *UpdateUserLoginRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePatientLogin not implemented")
 }
-func (UnimplementedAdminServiceServer) GetUnconfirmedVisitPayments(context.Context, *EmptyRequest) (*UnconfirmedVisitPaymentsResponse, error) {
+func (UnimplementedAdminServiceServer) GetUnconfirmedVisitPayments(context.Context, *GetUnconfirmedVisitPaymentsRequest) (*UnconfirmedVisitPaymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUnconfirmedVisitPayments not implemented")
 }
 func (UnimplementedAdminServiceServer) GetClinicScheduleGrid(context.Context, *EmptyRequest) (*AdminScheduleOverview, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClinicScheduleGrid not implemented")
 }
-func (UnimplementedAdminServiceServer) UpdateVisitPayment(context.Context, *UpdateVisitPaymentRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVisitPayment not implemented")
+func (UnimplementedAdminServiceServer) GetVisitInvoice(context.Context, *GetByIdRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVisitInvoice not implemented")
+}
+func (UnimplementedAdminServiceServer) GetInvoice(context.Context, *GetByIdRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (UnimplementedAdminServiceServer) SetInvoiceDiscount(context.Context, *SetInvoiceDiscountRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInvoiceDiscount not implemented")
+}
+func (UnimplementedAdminServiceServer) AddInvoicePayment(context.Context, *AddInvoicePaymentRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInvoicePayment not implemented")
+}
+func (UnimplementedAdminServiceServer) RefundInvoicePayment(context.Context, *RefundInvoicePaymentRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundInvoicePayment not implemented")
+}
+func (UnimplementedAdminServiceServer) GetPatientBalance(context.Context, *GetByIdRequest) (*PatientBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientBalance not implemented")
+}
+func (UnimplementedAdminServiceServer) GetInvoiceDocument(context.Context, *GetByIdRequest) (*BillingDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoiceDocument not implemented")
+}
+func (UnimplementedAdminServiceServer) GetReceiptDocument(context.Context, *GetByIdRequest) (*BillingDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceiptDocument not implemented")
 }
 func (UnimplementedAdminServiceServer) GetVisitMaterialsAndServices(context.Context, *GetByIdRequest) (*GetVisitMaterialsAndServicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVisitMaterialsAndServices not implemented")
 }
-func (UnimplementedAdminServiceServer) GetUnconfirmedAppointments(context.Context, *EmptyRequest) (*GetUnconfirmedAppointmentResponse, error) {
+func (UnimplementedAdminServiceServer) GetUnconfirmedAppointments(context.Context, *GetUnconfirmedAppointmentsRequest) (*GetUnconfirmedAppointmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUnconfirmedAppointments not implemented")
 }
 func (UnimplementedAdminServiceServer) UpdateAppointment(context.Context, *UpdateAppointmentRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppointment not implemented")
 }
-func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedAdminServiceServer) VerifyAuditLog(context.Context, *EmptyRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -522,188 +1018,566 @@ func _AdminService_UpdateClinicWeeklySchedule_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_AddDoctorWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDoctorWeeklyScheduleRequest)
+func _AdminService_AddDoctorWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDoctorWeeklyScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddDoctorWeeklySchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddDoctorWeeklySchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddDoctorWeeklySchedule(ctx, req.(*AddDoctorWeeklyScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateDoctorWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDoctorWeeklyScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateDoctorWeeklySchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateDoctorWeeklySchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateDoctorWeeklySchedule(ctx, req.(*UpdateDoctorWeeklyScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddClinicDailyOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddClinicDailyOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddClinicDailyOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddClinicDailyOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddClinicDailyOverride(ctx, req.(*AddClinicDailyOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddDoctorDailyOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDoctorDailyOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddDoctorDailyOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddDoctorDailyOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddDoctorDailyOverride(ctx, req.(*AddDoctorDailyOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetClinicTimeZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetClinicTimeZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetClinicTimeZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetClinicTimeZone(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateClinicTimeZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClinicTimeZone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateClinicTimeZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateClinicTimeZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateClinicTimeZone(ctx, req.(*ClinicTimeZone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetScheduleRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetScheduleRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetScheduleRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetScheduleRules(ctx, req.(*GetScheduleRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddScheduleRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddScheduleRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddScheduleRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddScheduleRule(ctx, req.(*ScheduleRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteScheduleRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteScheduleRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteScheduleRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteScheduleRule(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ImportHolidayCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportHolidayCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ImportHolidayCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ImportHolidayCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ImportHolidayCalendar(ctx, req.(*ImportHolidayCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddMaterial(ctx, req.(*AddMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddService(ctx, req.(*AddServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateMaterial(ctx, req.(*UpdateMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateService(ctx, req.(*UpdateServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteMaterial(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteService(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReceiveMaterialBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveMaterialBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReceiveMaterialBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReceiveMaterialBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReceiveMaterialBatch(ctx, req.(*ReceiveMaterialBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdjustMaterialStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustMaterialStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdjustMaterialStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdjustMaterialStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdjustMaterialStock(ctx, req.(*AdjustMaterialStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetMaterialStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetMaterialStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetMaterialStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetMaterialStock(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetStockReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetStockReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetStockReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetStockReport(ctx, req.(*StockReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddPriceListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPriceListEntryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).AddDoctorWeeklySchedule(ctx, in)
+		return srv.(AdminServiceServer).AddPriceListEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_AddDoctorWeeklySchedule_FullMethodName,
+		FullMethod: AdminService_AddPriceListEntry_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).AddDoctorWeeklySchedule(ctx, req.(*AddDoctorWeeklyScheduleRequest))
+		return srv.(AdminServiceServer).AddPriceListEntry(ctx, req.(*AddPriceListEntryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_UpdateDoctorWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDoctorWeeklyScheduleRequest)
+func _AdminService_DeletePriceListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceListEntryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).UpdateDoctorWeeklySchedule(ctx, in)
+		return srv.(AdminServiceServer).DeletePriceListEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_UpdateDoctorWeeklySchedule_FullMethodName,
+		FullMethod: AdminService_DeletePriceListEntry_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).UpdateDoctorWeeklySchedule(ctx, req.(*UpdateDoctorWeeklyScheduleRequest))
+		return srv.(AdminServiceServer).DeletePriceListEntry(ctx, req.(*DeletePriceListEntryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_AddClinicDailyOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddClinicDailyOverrideRequest)
+func _AdminService_GetPriceOnDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceOnDateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).AddClinicDailyOverride(ctx, in)
+		return srv.(AdminServiceServer).GetPriceOnDate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_AddClinicDailyOverride_FullMethodName,
+		FullMethod: AdminService_GetPriceOnDate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).AddClinicDailyOverride(ctx, req.(*AddClinicDailyOverrideRequest))
+		return srv.(AdminServiceServer).GetPriceOnDate(ctx, req.(*GetPriceOnDateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_AddDoctorDailyOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDoctorDailyOverrideRequest)
+func _AdminService_GetClinicalAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).AddDoctorDailyOverride(ctx, in)
+		return srv.(AdminServiceServer).GetClinicalAlertRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_AddDoctorDailyOverride_FullMethodName,
+		FullMethod: AdminService_GetClinicalAlertRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).AddDoctorDailyOverride(ctx, req.(*AddDoctorDailyOverrideRequest))
+		return srv.(AdminServiceServer).GetClinicalAlertRules(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_AddMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMaterialRequest)
+func _AdminService_AddClinicalAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClinicalAlertRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).AddMaterial(ctx, in)
+		return srv.(AdminServiceServer).AddClinicalAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_AddMaterial_FullMethodName,
+		FullMethod: AdminService_AddClinicalAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).AddMaterial(ctx, req.(*AddMaterialRequest))
+		return srv.(AdminServiceServer).AddClinicalAlertRule(ctx, req.(*ClinicalAlertRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_AddService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddServiceRequest)
+func _AdminService_UpdateClinicalAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClinicalAlertRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).AddService(ctx, in)
+		return srv.(AdminServiceServer).UpdateClinicalAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_AddService_FullMethodName,
+		FullMethod: AdminService_UpdateClinicalAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).AddService(ctx, req.(*AddServiceRequest))
+		return srv.(AdminServiceServer).UpdateClinicalAlertRule(ctx, req.(*ClinicalAlertRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_UpdateMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMaterialRequest)
+func _AdminService_DeleteClinicalAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).UpdateMaterial(ctx, in)
+		return srv.(AdminServiceServer).DeleteClinicalAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_UpdateMaterial_FullMethodName,
+		FullMethod: AdminService_DeleteClinicalAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).UpdateMaterial(ctx, req.(*UpdateMaterialRequest))
+		return srv.(AdminServiceServer).DeleteClinicalAlertRule(ctx, req.(*DeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_UpdateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateServiceRequest)
+func _AdminService_GetPatientAccessGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).UpdateService(ctx, in)
+		return srv.(AdminServiceServer).GetPatientAccessGrants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_UpdateService_FullMethodName,
+		FullMethod: AdminService_GetPatientAccessGrants_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).UpdateService(ctx, req.(*UpdateServiceRequest))
+		return srv.(AdminServiceServer).GetPatientAccessGrants(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DeleteMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _AdminService_AddPatientAccessGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatientAccessGrant)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DeleteMaterial(ctx, in)
+		return srv.(AdminServiceServer).AddPatientAccessGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_DeleteMaterial_FullMethodName,
+		FullMethod: AdminService_AddPatientAccessGrant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DeleteMaterial(ctx, req.(*DeleteRequest))
+		return srv.(AdminServiceServer).AddPatientAccessGrant(ctx, req.(*PatientAccessGrant))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AdminService_RevokePatientAccessGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DeleteService(ctx, in)
+		return srv.(AdminServiceServer).RevokePatientAccessGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_DeleteService_FullMethodName,
+		FullMethod: AdminService_RevokePatientAccessGrant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DeleteService(ctx, req.(*DeleteRequest))
+		return srv.(AdminServiceServer).RevokePatientAccessGrant(ctx, req.(*DeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _AdminService_GetAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetAdminsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -715,13 +1589,13 @@ func _AdminService_GetAdmins_Handler(srv interface{}, ctx context.Context, dec f
 		FullMethod: AdminService_GetAdmins_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetAdmins(ctx, req.(*EmptyRequest))
+		return srv.(AdminServiceServer).GetAdmins(ctx, req.(*GetAdminsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _AdminService_GetPatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetPatientsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -733,13 +1607,13 @@ func _AdminService_GetPatients_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: AdminService_GetPatients_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetPatients(ctx, req.(*EmptyRequest))
+		return srv.(AdminServiceServer).GetPatients(ctx, req.(*GetPatientsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _AdminService_GetDoctors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetDoctorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -751,7 +1625,7 @@ func _AdminService_GetDoctors_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: AdminService_GetDoctors_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetDoctors(ctx, req.(*EmptyRequest))
+		return srv.(AdminServiceServer).GetDoctors(ctx, req.(*GetDoctorsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -828,20 +1702,38 @@ func _AdminService_UpdatePatient_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _AdminService_RetireUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RetireUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RetireUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RetireUser(ctx, req.(*ChangeUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DeleteUser(ctx, in)
+		return srv.(AdminServiceServer).RestoreUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_DeleteUser_FullMethodName,
+		FullMethod: AdminService_RestoreUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DeleteUser(ctx, req.(*DeleteRequest))
+		return srv.(AdminServiceServer).RestoreUser(ctx, req.(*ChangeUserStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -883,7 +1775,7 @@ func _AdminService_UpdatePatientLogin_Handler(srv interface{}, ctx context.Conte
 }
 
 func _AdminService_GetUnconfirmedVisitPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetUnconfirmedVisitPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -895,7 +1787,7 @@ func _AdminService_GetUnconfirmedVisitPayments_Handler(srv interface{}, ctx cont
 		FullMethod: AdminService_GetUnconfirmedVisitPayments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetUnconfirmedVisitPayments(ctx, req.(*EmptyRequest))
+		return srv.(AdminServiceServer).GetUnconfirmedVisitPayments(ctx, req.(*GetUnconfirmedVisitPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -918,20 +1810,146 @@ func _AdminService_GetClinicScheduleGrid_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_UpdateVisitPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateVisitPaymentRequest)
+func _AdminService_GetVisitInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetVisitInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetVisitInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetVisitInvoice(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetInvoice(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetInvoiceDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInvoiceDiscountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetInvoiceDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetInvoiceDiscount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetInvoiceDiscount(ctx, req.(*SetInvoiceDiscountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AddInvoicePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInvoicePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AddInvoicePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AddInvoicePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AddInvoicePayment(ctx, req.(*AddInvoicePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RefundInvoicePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundInvoicePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RefundInvoicePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RefundInvoicePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RefundInvoicePayment(ctx, req.(*RefundInvoicePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPatientBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPatientBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetPatientBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPatientBalance(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetInvoiceDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetInvoiceDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetInvoiceDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetInvoiceDocument(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetReceiptDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).UpdateVisitPayment(ctx, in)
+		return srv.(AdminServiceServer).GetReceiptDocument(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_UpdateVisitPayment_FullMethodName,
+		FullMethod: AdminService_GetReceiptDocument_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).UpdateVisitPayment(ctx, req.(*UpdateVisitPaymentRequest))
+		return srv.(AdminServiceServer).GetReceiptDocument(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -955,7 +1973,7 @@ func _AdminService_GetVisitMaterialsAndServices_Handler(srv interface{}, ctx con
 }
 
 func _AdminService_GetUnconfirmedAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetUnconfirmedAppointmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -967,7 +1985,7 @@ func _AdminService_GetUnconfirmedAppointments_Handler(srv interface{}, ctx conte
 		FullMethod: AdminService_GetUnconfirmedAppointments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetUnconfirmedAppointments(ctx, req.(*EmptyRequest))
+		return srv.(AdminServiceServer).GetUnconfirmedAppointments(ctx, req.(*GetUnconfirmedAppointmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -990,6 +2008,42 @@ func _AdminService_UpdateAppointment_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).VerifyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_VerifyAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).VerifyAuditLog(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1017,6 +2071,30 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddDoctorDailyOverride",
 			Handler:    _AdminService_AddDoctorDailyOverride_Handler,
 		},
+		{
+			MethodName: "GetClinicTimeZone",
+			Handler:    _AdminService_GetClinicTimeZone_Handler,
+		},
+		{
+			MethodName: "UpdateClinicTimeZone",
+			Handler:    _AdminService_UpdateClinicTimeZone_Handler,
+		},
+		{
+			MethodName: "GetScheduleRules",
+			Handler:    _AdminService_GetScheduleRules_Handler,
+		},
+		{
+			MethodName: "AddScheduleRule",
+			Handler:    _AdminService_AddScheduleRule_Handler,
+		},
+		{
+			MethodName: "DeleteScheduleRule",
+			Handler:    _AdminService_DeleteScheduleRule_Handler,
+		},
+		{
+			MethodName: "ImportHolidayCalendar",
+			Handler:    _AdminService_ImportHolidayCalendar_Handler,
+		},
 		{
 			MethodName: "AddMaterial",
 			Handler:    _AdminService_AddMaterial_Handler,
@@ -1041,6 +2119,66 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteService",
 			Handler:    _AdminService_DeleteService_Handler,
 		},
+		{
+			MethodName: "ReceiveMaterialBatch",
+			Handler:    _AdminService_ReceiveMaterialBatch_Handler,
+		},
+		{
+			MethodName: "AdjustMaterialStock",
+			Handler:    _AdminService_AdjustMaterialStock_Handler,
+		},
+		{
+			MethodName: "GetMaterialStock",
+			Handler:    _AdminService_GetMaterialStock_Handler,
+		},
+		{
+			MethodName: "GetStockReport",
+			Handler:    _AdminService_GetStockReport_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _AdminService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "AddPriceListEntry",
+			Handler:    _AdminService_AddPriceListEntry_Handler,
+		},
+		{
+			MethodName: "DeletePriceListEntry",
+			Handler:    _AdminService_DeletePriceListEntry_Handler,
+		},
+		{
+			MethodName: "GetPriceOnDate",
+			Handler:    _AdminService_GetPriceOnDate_Handler,
+		},
+		{
+			MethodName: "GetClinicalAlertRules",
+			Handler:    _AdminService_GetClinicalAlertRules_Handler,
+		},
+		{
+			MethodName: "AddClinicalAlertRule",
+			Handler:    _AdminService_AddClinicalAlertRule_Handler,
+		},
+		{
+			MethodName: "UpdateClinicalAlertRule",
+			Handler:    _AdminService_UpdateClinicalAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteClinicalAlertRule",
+			Handler:    _AdminService_DeleteClinicalAlertRule_Handler,
+		},
+		{
+			MethodName: "GetPatientAccessGrants",
+			Handler:    _AdminService_GetPatientAccessGrants_Handler,
+		},
+		{
+			MethodName: "AddPatientAccessGrant",
+			Handler:    _AdminService_AddPatientAccessGrant_Handler,
+		},
+		{
+			MethodName: "RevokePatientAccessGrant",
+			Handler:    _AdminService_RevokePatientAccessGrant_Handler,
+		},
 		{
 			MethodName: "GetAdmins",
 			Handler:    _AdminService_GetAdmins_Handler,
@@ -1070,8 +2208,12 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _AdminService_UpdatePatient_Handler,
 		},
 		{
-			MethodName: "DeleteUser",
-			Handler:    _AdminService_DeleteUser_Handler,
+			MethodName: "RetireUser",
+			Handler:    _AdminService_RetireUser_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _AdminService_RestoreUser_Handler,
 		},
 		{
 			MethodName: "UpdateEmployeeLogin",
@@ -1090,8 +2232,36 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _AdminService_GetClinicScheduleGrid_Handler,
 		},
 		{
-			MethodName: "UpdateVisitPayment",
-			Handler:    _AdminService_UpdateVisitPayment_Handler,
+			MethodName: "GetVisitInvoice",
+			Handler:    _AdminService_GetVisitInvoice_Handler,
+		},
+		{
+			MethodName: "GetInvoice",
+			Handler:    _AdminService_GetInvoice_Handler,
+		},
+		{
+			MethodName: "SetInvoiceDiscount",
+			Handler:    _AdminService_SetInvoiceDiscount_Handler,
+		},
+		{
+			MethodName: "AddInvoicePayment",
+			Handler:    _AdminService_AddInvoicePayment_Handler,
+		},
+		{
+			MethodName: "RefundInvoicePayment",
+			Handler:    _AdminService_RefundInvoicePayment_Handler,
+		},
+		{
+			MethodName: "GetPatientBalance",
+			Handler:    _AdminService_GetPatientBalance_Handler,
+		},
+		{
+			MethodName: "GetInvoiceDocument",
+			Handler:    _AdminService_GetInvoiceDocument_Handler,
+		},
+		{
+			MethodName: "GetReceiptDocument",
+			Handler:    _AdminService_GetReceiptDocument_Handler,
 		},
 		{
 			MethodName: "GetVisitMaterialsAndServices",
@@ -1105,6 +2275,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAppointment",
 			Handler:    _AdminService_UpdateAppointment_Handler,
 		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _AdminService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _AdminService_VerifyAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/admin/admin.proto",
@@ -594,6 +594,76 @@ func (*EmptyRequest) Descriptor() ([]byte, []int) {
 	return file_proto_storage_storage_proto_rawDescGZIP(), []int{8}
 }
 
+// Страница списка: limit = 0 — список целиком (для внутренних вызовов сервисов);
+// sort — поле сортировки из допустимых для метода, пусто — сортировка метода по умолчанию
+type ListPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Desc          bool                   `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPage) Reset() {
+	*x = ListPage{}
+	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPage) ProtoMessage() {}
+
+func (x *ListPage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPage.ProtoReflect.Descriptor instead.
+func (*ListPage) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPage) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPage) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPage) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListPage) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
 type Specialization struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -604,7 +674,7 @@ type Specialization struct {
 
 func (x *Specialization) Reset() {
 	*x = Specialization{}
-	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	mi := &file_proto_storage_storage_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -616,7 +686,7 @@ func (x *Specialization) String() string {
 func (*Specialization) ProtoMessage() {}
 
 func (x *Specialization) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	mi := &file_proto_storage_storage_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +699,7 @@ func (x *Specialization) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Specialization.ProtoReflect.Descriptor instead.
 func (*Specialization) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{9}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Specialization) GetId() int32 {
@@ -655,7 +725,7 @@ type GetAllSpecsResponse struct {
 
 func (x *GetAllSpecsResponse) Reset() {
 	*x = GetAllSpecsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[10]
+	mi := &file_proto_storage_storage_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -667,7 +737,7 @@ func (x *GetAllSpecsResponse) String() string {
 func (*GetAllSpecsResponse) ProtoMessage() {}
 
 func (x *GetAllSpecsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[10]
+	mi := &file_proto_storage_storage_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,7 +750,7 @@ func (x *GetAllSpecsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllSpecsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllSpecsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{10}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetAllSpecsResponse) GetSpecs() []*Specialization {
@@ -700,7 +770,7 @@ type AddUserRoleRequest struct {
 
 func (x *AddUserRoleRequest) Reset() {
 	*x = AddUserRoleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[11]
+	mi := &file_proto_storage_storage_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +782,7 @@ func (x *AddUserRoleRequest) String() string {
 func (*AddUserRoleRequest) ProtoMessage() {}
 
 func (x *AddUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[11]
+	mi := &file_proto_storage_storage_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +795,7 @@ func (x *AddUserRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddUserRoleRequest.ProtoReflect.Descriptor instead.
 func (*AddUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{11}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AddUserRoleRequest) GetUserId() int32 {
@@ -751,7 +821,7 @@ type AddUserRoleResponse struct {
 
 func (x *AddUserRoleResponse) Reset() {
 	*x = AddUserRoleResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[12]
+	mi := &file_proto_storage_storage_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -763,7 +833,7 @@ func (x *AddUserRoleResponse) String() string {
 func (*AddUserRoleResponse) ProtoMessage() {}
 
 func (x *AddUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[12]
+	mi := &file_proto_storage_storage_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,7 +846,7 @@ func (x *AddUserRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddUserRoleResponse.ProtoReflect.Descriptor instead.
 func (*AddUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{12}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AddUserRoleResponse) GetError() string {
@@ -801,7 +871,7 @@ type WeeklyDoctorSchedule struct {
 
 func (x *WeeklyDoctorSchedule) Reset() {
 	*x = WeeklyDoctorSchedule{}
-	mi := &file_proto_storage_storage_proto_msgTypes[13]
+	mi := &file_proto_storage_storage_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -813,7 +883,7 @@ func (x *WeeklyDoctorSchedule) String() string {
 func (*WeeklyDoctorSchedule) ProtoMessage() {}
 
 func (x *WeeklyDoctorSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[13]
+	mi := &file_proto_storage_storage_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,7 +896,7 @@ func (x *WeeklyDoctorSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeeklyDoctorSchedule.ProtoReflect.Descriptor instead.
 func (*WeeklyDoctorSchedule) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{13}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *WeeklyDoctorSchedule) GetId() int32 {
@@ -887,7 +957,7 @@ type GetScheduleByDoctorIdRequest struct {
 
 func (x *GetScheduleByDoctorIdRequest) Reset() {
 	*x = GetScheduleByDoctorIdRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[14]
+	mi := &file_proto_storage_storage_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -899,7 +969,7 @@ func (x *GetScheduleByDoctorIdRequest) String() string {
 func (*GetScheduleByDoctorIdRequest) ProtoMessage() {}
 
 func (x *GetScheduleByDoctorIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[14]
+	mi := &file_proto_storage_storage_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +982,7 @@ func (x *GetScheduleByDoctorIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScheduleByDoctorIdRequest.ProtoReflect.Descriptor instead.
 func (*GetScheduleByDoctorIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{14}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetScheduleByDoctorIdRequest) GetDoctorId() int32 {
@@ -931,7 +1001,7 @@ type GetScheduleByDoctorIdResponse struct {
 
 func (x *GetScheduleByDoctorIdResponse) Reset() {
 	*x = GetScheduleByDoctorIdResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[15]
+	mi := &file_proto_storage_storage_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -943,7 +1013,7 @@ func (x *GetScheduleByDoctorIdResponse) String() string {
 func (*GetScheduleByDoctorIdResponse) ProtoMessage() {}
 
 func (x *GetScheduleByDoctorIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[15]
+	mi := &file_proto_storage_storage_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +1026,7 @@ func (x *GetScheduleByDoctorIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScheduleByDoctorIdResponse.ProtoReflect.Descriptor instead.
 func (*GetScheduleByDoctorIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{15}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetScheduleByDoctorIdResponse) GetDoctorSchedule() []*WeeklyDoctorSchedule {
@@ -975,7 +1045,7 @@ type GetUserByLoginRequest struct {
 
 func (x *GetUserByLoginRequest) Reset() {
 	*x = GetUserByLoginRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[16]
+	mi := &file_proto_storage_storage_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -987,7 +1057,7 @@ func (x *GetUserByLoginRequest) String() string {
 func (*GetUserByLoginRequest) ProtoMessage() {}
 
 func (x *GetUserByLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[16]
+	mi := &file_proto_storage_storage_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1070,7 @@ func (x *GetUserByLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByLoginRequest.ProtoReflect.Descriptor instead.
 func (*GetUserByLoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{16}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetUserByLoginRequest) GetLogin() string {
@@ -1015,13 +1085,14 @@ type GetUserByLoginResponse struct {
 	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // active, deactivated (сотрудник отключён) или archived (пациент в архиве)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserByLoginResponse) Reset() {
 	*x = GetUserByLoginResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[17]
+	mi := &file_proto_storage_storage_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1033,7 +1104,7 @@ func (x *GetUserByLoginResponse) String() string {
 func (*GetUserByLoginResponse) ProtoMessage() {}
 
 func (x *GetUserByLoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[17]
+	mi := &file_proto_storage_storage_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,7 +1117,7 @@ func (x *GetUserByLoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByLoginResponse.ProtoReflect.Descriptor instead.
 func (*GetUserByLoginResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{17}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetUserByLoginResponse) GetLogin() string {
@@ -1070,6 +1141,13 @@ func (x *GetUserByLoginResponse) GetId() int32 {
 	return 0
 }
 
+func (x *GetUserByLoginResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type UpdateUserPasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1081,7 +1159,7 @@ type UpdateUserPasswordRequest struct {
 
 func (x *UpdateUserPasswordRequest) Reset() {
 	*x = UpdateUserPasswordRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[18]
+	mi := &file_proto_storage_storage_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1093,7 +1171,7 @@ func (x *UpdateUserPasswordRequest) String() string {
 func (*UpdateUserPasswordRequest) ProtoMessage() {}
 
 func (x *UpdateUserPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[18]
+	mi := &file_proto_storage_storage_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1106,7 +1184,7 @@ func (x *UpdateUserPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserPasswordRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{18}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateUserPasswordRequest) GetId() int32 {
@@ -1139,7 +1217,7 @@ type DefaultResponse struct {
 
 func (x *DefaultResponse) Reset() {
 	*x = DefaultResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[19]
+	mi := &file_proto_storage_storage_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1151,7 +1229,7 @@ func (x *DefaultResponse) String() string {
 func (*DefaultResponse) ProtoMessage() {}
 
 func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[19]
+	mi := &file_proto_storage_storage_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,7 +1242,7 @@ func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
 func (*DefaultResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{19}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DefaultResponse) GetError() string {
@@ -1191,7 +1269,7 @@ type Doctor struct {
 
 func (x *Doctor) Reset() {
 	*x = Doctor{}
-	mi := &file_proto_storage_storage_proto_msgTypes[20]
+	mi := &file_proto_storage_storage_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1203,7 +1281,7 @@ func (x *Doctor) String() string {
 func (*Doctor) ProtoMessage() {}
 
 func (x *Doctor) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[20]
+	mi := &file_proto_storage_storage_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,7 +1294,7 @@ func (x *Doctor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Doctor.ProtoReflect.Descriptor instead.
 func (*Doctor) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{20}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Doctor) GetUserId() int32 {
@@ -1299,7 +1377,7 @@ type UpdateDoctorRequest struct {
 
 func (x *UpdateDoctorRequest) Reset() {
 	*x = UpdateDoctorRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[21]
+	mi := &file_proto_storage_storage_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1311,7 +1389,7 @@ func (x *UpdateDoctorRequest) String() string {
 func (*UpdateDoctorRequest) ProtoMessage() {}
 
 func (x *UpdateDoctorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[21]
+	mi := &file_proto_storage_storage_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1324,7 +1402,7 @@ func (x *UpdateDoctorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDoctorRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDoctorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{21}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateDoctorRequest) GetUserId() int32 {
@@ -1400,7 +1478,7 @@ type AddDoctorSpecRequest struct {
 
 func (x *AddDoctorSpecRequest) Reset() {
 	*x = AddDoctorSpecRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[22]
+	mi := &file_proto_storage_storage_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1412,7 +1490,7 @@ func (x *AddDoctorSpecRequest) String() string {
 func (*AddDoctorSpecRequest) ProtoMessage() {}
 
 func (x *AddDoctorSpecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[22]
+	mi := &file_proto_storage_storage_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1425,7 +1503,7 @@ func (x *AddDoctorSpecRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDoctorSpecRequest.ProtoReflect.Descriptor instead.
 func (*AddDoctorSpecRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{22}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AddDoctorSpecRequest) GetDoctorId() int32 {
@@ -1452,7 +1530,7 @@ type DeleteDoctorSpecRequest struct {
 
 func (x *DeleteDoctorSpecRequest) Reset() {
 	*x = DeleteDoctorSpecRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[23]
+	mi := &file_proto_storage_storage_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1464,7 +1542,7 @@ func (x *DeleteDoctorSpecRequest) String() string {
 func (*DeleteDoctorSpecRequest) ProtoMessage() {}
 
 func (x *DeleteDoctorSpecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[23]
+	mi := &file_proto_storage_storage_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +1555,7 @@ func (x *DeleteDoctorSpecRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDoctorSpecRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDoctorSpecRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{23}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DeleteDoctorSpecRequest) GetDoctorId() int32 {
@@ -1494,16 +1572,78 @@ func (x *DeleteDoctorSpecRequest) GetSpecId() int32 {
 	return 0
 }
 
+// sort: name (по умолчанию), experience
+type GetDoctorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // поиск по началам слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только отключённые, для восстановления; без него — только работающие
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoctorsRequest) Reset() {
+	*x = GetDoctorsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoctorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoctorsRequest) ProtoMessage() {}
+
+func (x *GetDoctorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoctorsRequest.ProtoReflect.Descriptor instead.
+func (*GetDoctorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetDoctorsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetDoctorsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetDoctorsRequest) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
 type GetDoctorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Doctors       []*Doctor              `protobuf:"bytes,1,rep,name=doctors,proto3" json:"doctors,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных врачей без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetDoctorsResponse) Reset() {
 	*x = GetDoctorsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[24]
+	mi := &file_proto_storage_storage_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1515,7 +1655,7 @@ func (x *GetDoctorsResponse) String() string {
 func (*GetDoctorsResponse) ProtoMessage() {}
 
 func (x *GetDoctorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[24]
+	mi := &file_proto_storage_storage_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,7 +1668,7 @@ func (x *GetDoctorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorsResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{24}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetDoctorsResponse) GetDoctors() []*Doctor {
@@ -1538,6 +1678,13 @@ func (x *GetDoctorsResponse) GetDoctors() []*Doctor {
 	return nil
 }
 
+func (x *GetDoctorsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type GetDoctorSpecsByDoctorIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Specs         []int32                `protobuf:"varint,1,rep,packed,name=specs,proto3" json:"specs,omitempty"`
@@ -1547,7 +1694,7 @@ type GetDoctorSpecsByDoctorIdResponse struct {
 
 func (x *GetDoctorSpecsByDoctorIdResponse) Reset() {
 	*x = GetDoctorSpecsByDoctorIdResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	mi := &file_proto_storage_storage_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1559,7 +1706,7 @@ func (x *GetDoctorSpecsByDoctorIdResponse) String() string {
 func (*GetDoctorSpecsByDoctorIdResponse) ProtoMessage() {}
 
 func (x *GetDoctorSpecsByDoctorIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	mi := &file_proto_storage_storage_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1572,7 +1719,7 @@ func (x *GetDoctorSpecsByDoctorIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorSpecsByDoctorIdResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorSpecsByDoctorIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{25}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetDoctorSpecsByDoctorIdResponse) GetSpecs() []int32 {
@@ -1597,7 +1744,7 @@ type Admin struct {
 
 func (x *Admin) Reset() {
 	*x = Admin{}
-	mi := &file_proto_storage_storage_proto_msgTypes[26]
+	mi := &file_proto_storage_storage_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1609,7 +1756,7 @@ func (x *Admin) String() string {
 func (*Admin) ProtoMessage() {}
 
 func (x *Admin) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[26]
+	mi := &file_proto_storage_storage_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1622,7 +1769,7 @@ func (x *Admin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Admin.ProtoReflect.Descriptor instead.
 func (*Admin) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{26}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Admin) GetUserId() int32 {
@@ -1689,7 +1836,7 @@ type UpdateAdminRequest struct {
 
 func (x *UpdateAdminRequest) Reset() {
 	*x = UpdateAdminRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[27]
+	mi := &file_proto_storage_storage_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1701,7 +1848,7 @@ func (x *UpdateAdminRequest) String() string {
 func (*UpdateAdminRequest) ProtoMessage() {}
 
 func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[27]
+	mi := &file_proto_storage_storage_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +1861,7 @@ func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAdminRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{27}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateAdminRequest) GetUserId() int32 {
@@ -1776,7 +1923,7 @@ type UpdateAdminRoleRequest struct {
 
 func (x *UpdateAdminRoleRequest) Reset() {
 	*x = UpdateAdminRoleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[28]
+	mi := &file_proto_storage_storage_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1788,7 +1935,7 @@ func (x *UpdateAdminRoleRequest) String() string {
 func (*UpdateAdminRoleRequest) ProtoMessage() {}
 
 func (x *UpdateAdminRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[28]
+	mi := &file_proto_storage_storage_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1801,7 +1948,7 @@ func (x *UpdateAdminRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAdminRoleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAdminRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{28}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateAdminRoleRequest) GetUserId() int32 {
@@ -1818,28 +1965,31 @@ func (x *UpdateAdminRoleRequest) GetRoleId() int32 {
 	return 0
 }
 
-type GetAdminsResponse struct {
+// sort: name (по умолчанию)
+type GetAdminsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Admins        []*Admin               `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // поиск по началам слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только отключённые, для восстановления; без него — только работающие
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAdminsResponse) Reset() {
-	*x = GetAdminsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[29]
+func (x *GetAdminsRequest) Reset() {
+	*x = GetAdminsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAdminsResponse) String() string {
+func (x *GetAdminsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAdminsResponse) ProtoMessage() {}
+func (*GetAdminsRequest) ProtoMessage() {}
 
-func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[29]
+func (x *GetAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1850,35 +2000,101 @@ func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAdminsResponse.ProtoReflect.Descriptor instead.
-func (*GetAdminsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetAdminsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetAdminsResponse) GetAdmins() []*Admin {
+func (x *GetAdminsRequest) GetPage() *ListPage {
 	if x != nil {
-		return x.Admins
+		return x.Page
 	}
 	return nil
 }
 
-type Patient struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
-	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
-	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	BirthDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Gender        string                 `protobuf:"bytes,8,opt,name=gender,proto3" json:"gender,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetAdminsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
 }
 
-func (x *Patient) Reset() {
+func (x *GetAdminsRequest) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
+type GetAdminsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admins        []*Admin               `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных администраторов без учёта страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdminsResponse) Reset() {
+	*x = GetAdminsResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminsResponse) ProtoMessage() {}
+
+func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminsResponse.ProtoReflect.Descriptor instead.
+func (*GetAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetAdminsResponse) GetAdmins() []*Admin {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *GetAdminsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type Patient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	BirthDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Gender        string                 `protobuf:"bytes,8,opt,name=gender,proto3" json:"gender,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Patient) Reset() {
 	*x = Patient{}
-	mi := &file_proto_storage_storage_proto_msgTypes[30]
+	mi := &file_proto_storage_storage_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1890,7 +2106,7 @@ func (x *Patient) String() string {
 func (*Patient) ProtoMessage() {}
 
 func (x *Patient) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[30]
+	mi := &file_proto_storage_storage_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,7 +2119,7 @@ func (x *Patient) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Patient.ProtoReflect.Descriptor instead.
 func (*Patient) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{30}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *Patient) GetUserId() int32 {
@@ -1978,7 +2194,7 @@ type UpdatePatientRequest struct {
 
 func (x *UpdatePatientRequest) Reset() {
 	*x = UpdatePatientRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[31]
+	mi := &file_proto_storage_storage_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1990,7 +2206,7 @@ func (x *UpdatePatientRequest) String() string {
 func (*UpdatePatientRequest) ProtoMessage() {}
 
 func (x *UpdatePatientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[31]
+	mi := &file_proto_storage_storage_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +2219,7 @@ func (x *UpdatePatientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePatientRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePatientRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{31}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *UpdatePatientRequest) GetUserId() int32 {
@@ -2062,16 +2278,78 @@ func (x *UpdatePatientRequest) GetGender() string {
 	return ""
 }
 
+// sort: name (по умолчанию), birth_date
+type GetPatientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // дата рождения (02.01.2006 или 2006-01-02), номер телефона или начала слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только архивные, для восстановления; без него — только пациенты в работе
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientsRequest) Reset() {
+	*x = GetPatientsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientsRequest) ProtoMessage() {}
+
+func (x *GetPatientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientsRequest.ProtoReflect.Descriptor instead.
+func (*GetPatientsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetPatientsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetPatientsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetPatientsRequest) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
 type GetPatientsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Patients      []*Patient             `protobuf:"bytes,1,rep,name=patients,proto3" json:"patients,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных пациентов без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPatientsResponse) Reset() {
 	*x = GetPatientsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[32]
+	mi := &file_proto_storage_storage_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2083,7 +2361,7 @@ func (x *GetPatientsResponse) String() string {
 func (*GetPatientsResponse) ProtoMessage() {}
 
 func (x *GetPatientsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[32]
+	mi := &file_proto_storage_storage_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2096,7 +2374,7 @@ func (x *GetPatientsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientsResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{32}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetPatientsResponse) GetPatients() []*Patient {
@@ -2106,6 +2384,13 @@ func (x *GetPatientsResponse) GetPatients() []*Patient {
 	return nil
 }
 
+func (x *GetPatientsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type WeeklyClinicSchedule struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2120,7 +2405,7 @@ type WeeklyClinicSchedule struct {
 
 func (x *WeeklyClinicSchedule) Reset() {
 	*x = WeeklyClinicSchedule{}
-	mi := &file_proto_storage_storage_proto_msgTypes[33]
+	mi := &file_proto_storage_storage_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2132,7 +2417,7 @@ func (x *WeeklyClinicSchedule) String() string {
 func (*WeeklyClinicSchedule) ProtoMessage() {}
 
 func (x *WeeklyClinicSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[33]
+	mi := &file_proto_storage_storage_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2145,7 +2430,7 @@ func (x *WeeklyClinicSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeeklyClinicSchedule.ProtoReflect.Descriptor instead.
 func (*WeeklyClinicSchedule) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{33}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *WeeklyClinicSchedule) GetId() int32 {
@@ -2199,7 +2484,7 @@ type GetClinicWeeklyScheduleResponse struct {
 
 func (x *GetClinicWeeklyScheduleResponse) Reset() {
 	*x = GetClinicWeeklyScheduleResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[34]
+	mi := &file_proto_storage_storage_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2211,7 +2496,7 @@ func (x *GetClinicWeeklyScheduleResponse) String() string {
 func (*GetClinicWeeklyScheduleResponse) ProtoMessage() {}
 
 func (x *GetClinicWeeklyScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[34]
+	mi := &file_proto_storage_storage_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +2509,7 @@ func (x *GetClinicWeeklyScheduleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClinicWeeklyScheduleResponse.ProtoReflect.Descriptor instead.
 func (*GetClinicWeeklyScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{34}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetClinicWeeklyScheduleResponse) GetClinicSchedule() []*WeeklyClinicSchedule {
@@ -2243,7 +2528,7 @@ type GetUserRoleRequest struct {
 
 func (x *GetUserRoleRequest) Reset() {
 	*x = GetUserRoleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[35]
+	mi := &file_proto_storage_storage_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2255,7 +2540,7 @@ func (x *GetUserRoleRequest) String() string {
 func (*GetUserRoleRequest) ProtoMessage() {}
 
 func (x *GetUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[35]
+	mi := &file_proto_storage_storage_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,7 +2553,7 @@ func (x *GetUserRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRoleRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{35}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetUserRoleRequest) GetUserId() int32 {
@@ -2287,7 +2572,7 @@ type GetUserRoleResponse struct {
 
 func (x *GetUserRoleResponse) Reset() {
 	*x = GetUserRoleResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[36]
+	mi := &file_proto_storage_storage_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2299,7 +2584,7 @@ func (x *GetUserRoleResponse) String() string {
 func (*GetUserRoleResponse) ProtoMessage() {}
 
 func (x *GetUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[36]
+	mi := &file_proto_storage_storage_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2312,7 +2597,7 @@ func (x *GetUserRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRoleResponse.ProtoReflect.Descriptor instead.
 func (*GetUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{36}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetUserRoleResponse) GetRole() int32 {
@@ -2331,7 +2616,7 @@ type UpdateClinicWeeklyScheduleRequest struct {
 
 func (x *UpdateClinicWeeklyScheduleRequest) Reset() {
 	*x = UpdateClinicWeeklyScheduleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[37]
+	mi := &file_proto_storage_storage_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2343,7 +2628,7 @@ func (x *UpdateClinicWeeklyScheduleRequest) String() string {
 func (*UpdateClinicWeeklyScheduleRequest) ProtoMessage() {}
 
 func (x *UpdateClinicWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[37]
+	mi := &file_proto_storage_storage_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2356,7 +2641,7 @@ func (x *UpdateClinicWeeklyScheduleRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateClinicWeeklyScheduleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateClinicWeeklyScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{37}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *UpdateClinicWeeklyScheduleRequest) GetClinicSchedule() []*WeeklyClinicSchedule {
@@ -2375,7 +2660,7 @@ type AddDoctorWeeklyScheduleRequest struct {
 
 func (x *AddDoctorWeeklyScheduleRequest) Reset() {
 	*x = AddDoctorWeeklyScheduleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[38]
+	mi := &file_proto_storage_storage_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2387,7 +2672,7 @@ func (x *AddDoctorWeeklyScheduleRequest) String() string {
 func (*AddDoctorWeeklyScheduleRequest) ProtoMessage() {}
 
 func (x *AddDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[38]
+	mi := &file_proto_storage_storage_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2400,7 +2685,7 @@ func (x *AddDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDoctorWeeklyScheduleRequest.ProtoReflect.Descriptor instead.
 func (*AddDoctorWeeklyScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{38}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AddDoctorWeeklyScheduleRequest) GetDoctorSchedule() []*WeeklyDoctorSchedule {
@@ -2419,7 +2704,7 @@ type UpdateDoctorWeeklyScheduleRequest struct {
 
 func (x *UpdateDoctorWeeklyScheduleRequest) Reset() {
 	*x = UpdateDoctorWeeklyScheduleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[39]
+	mi := &file_proto_storage_storage_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2431,7 +2716,7 @@ func (x *UpdateDoctorWeeklyScheduleRequest) String() string {
 func (*UpdateDoctorWeeklyScheduleRequest) ProtoMessage() {}
 
 func (x *UpdateDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[39]
+	mi := &file_proto_storage_storage_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2444,7 +2729,7 @@ func (x *UpdateDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateDoctorWeeklyScheduleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDoctorWeeklyScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{39}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *UpdateDoctorWeeklyScheduleRequest) GetDoctorSchedule() []*WeeklyDoctorSchedule {
@@ -2464,7 +2749,7 @@ type GetRolePermissionRequest struct {
 
 func (x *GetRolePermissionRequest) Reset() {
 	*x = GetRolePermissionRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[40]
+	mi := &file_proto_storage_storage_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2476,7 +2761,7 @@ func (x *GetRolePermissionRequest) String() string {
 func (*GetRolePermissionRequest) ProtoMessage() {}
 
 func (x *GetRolePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[40]
+	mi := &file_proto_storage_storage_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2489,7 +2774,7 @@ func (x *GetRolePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRolePermissionRequest.ProtoReflect.Descriptor instead.
 func (*GetRolePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{40}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetRolePermissionRequest) GetRoleId() int32 {
@@ -2519,7 +2804,7 @@ type AddClinicDailyOverrideRequest struct {
 
 func (x *AddClinicDailyOverrideRequest) Reset() {
 	*x = AddClinicDailyOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[41]
+	mi := &file_proto_storage_storage_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2531,7 +2816,7 @@ func (x *AddClinicDailyOverrideRequest) String() string {
 func (*AddClinicDailyOverrideRequest) ProtoMessage() {}
 
 func (x *AddClinicDailyOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[41]
+	mi := &file_proto_storage_storage_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,7 +2829,7 @@ func (x *AddClinicDailyOverrideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddClinicDailyOverrideRequest.ProtoReflect.Descriptor instead.
 func (*AddClinicDailyOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{41}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *AddClinicDailyOverrideRequest) GetDate() *timestamppb.Timestamp {
@@ -2596,7 +2881,7 @@ type AddDoctorDailyOverrideRequest struct {
 
 func (x *AddDoctorDailyOverrideRequest) Reset() {
 	*x = AddDoctorDailyOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[42]
+	mi := &file_proto_storage_storage_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2608,7 +2893,7 @@ func (x *AddDoctorDailyOverrideRequest) String() string {
 func (*AddDoctorDailyOverrideRequest) ProtoMessage() {}
 
 func (x *AddDoctorDailyOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[42]
+	mi := &file_proto_storage_storage_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2621,7 +2906,7 @@ func (x *AddDoctorDailyOverrideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDoctorDailyOverrideRequest.ProtoReflect.Descriptor instead.
 func (*AddDoctorDailyOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{42}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *AddDoctorDailyOverrideRequest) GetDoctorId() int32 {
@@ -2675,7 +2960,7 @@ type GetDoctorBySpecIDRequest struct {
 
 func (x *GetDoctorBySpecIDRequest) Reset() {
 	*x = GetDoctorBySpecIDRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[43]
+	mi := &file_proto_storage_storage_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2687,7 +2972,7 @@ func (x *GetDoctorBySpecIDRequest) String() string {
 func (*GetDoctorBySpecIDRequest) ProtoMessage() {}
 
 func (x *GetDoctorBySpecIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[43]
+	mi := &file_proto_storage_storage_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2700,7 +2985,7 @@ func (x *GetDoctorBySpecIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorBySpecIDRequest.ProtoReflect.Descriptor instead.
 func (*GetDoctorBySpecIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{43}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetDoctorBySpecIDRequest) GetSpecId() int32 {
@@ -2719,7 +3004,7 @@ type GetAppointmentsByDoctorIDRequest struct {
 
 func (x *GetAppointmentsByDoctorIDRequest) Reset() {
 	*x = GetAppointmentsByDoctorIDRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[44]
+	mi := &file_proto_storage_storage_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2731,7 +3016,7 @@ func (x *GetAppointmentsByDoctorIDRequest) String() string {
 func (*GetAppointmentsByDoctorIDRequest) ProtoMessage() {}
 
 func (x *GetAppointmentsByDoctorIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[44]
+	mi := &file_proto_storage_storage_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2744,7 +3029,7 @@ func (x *GetAppointmentsByDoctorIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppointmentsByDoctorIDRequest.ProtoReflect.Descriptor instead.
 func (*GetAppointmentsByDoctorIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{44}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetAppointmentsByDoctorIDRequest) GetDoctorId() int32 {
@@ -2755,28 +3040,29 @@ func (x *GetAppointmentsByDoctorIDRequest) GetDoctorId() int32 {
 }
 
 type Appointment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	DoctorId      int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
-	PatientId     int32                  `protobuf:"varint,5,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
-	SecondName    string                 `protobuf:"bytes,6,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
-	FirstName     string                 `protobuf:"bytes,7,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	Surname       string                 `protobuf:"bytes,8,opt,name=surname,proto3" json:"surname,omitempty"`
-	BirthDate     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
-	Gender        string                 `protobuf:"bytes,10,opt,name=gender,proto3" json:"gender,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,11,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DoctorId        int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	PatientId       int32                  `protobuf:"varint,5,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	SecondName      string                 `protobuf:"bytes,6,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
+	FirstName       string                 `protobuf:"bytes,7,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	Surname         string                 `protobuf:"bytes,8,opt,name=surname,proto3" json:"surname,omitempty"`
+	BirthDate       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	Gender          string                 `protobuf:"bytes,10,opt,name=gender,proto3" json:"gender,omitempty"`
+	PhoneNumber     string                 `protobuf:"bytes,11,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Status          string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,15,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Appointment) Reset() {
 	*x = Appointment{}
-	mi := &file_proto_storage_storage_proto_msgTypes[45]
+	mi := &file_proto_storage_storage_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2788,7 +3074,7 @@ func (x *Appointment) String() string {
 func (*Appointment) ProtoMessage() {}
 
 func (x *Appointment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[45]
+	mi := &file_proto_storage_storage_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2801,7 +3087,7 @@ func (x *Appointment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Appointment.ProtoReflect.Descriptor instead.
 func (*Appointment) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{45}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *Appointment) GetId() int32 {
@@ -2902,6 +3188,13 @@ func (x *Appointment) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Appointment) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
 type GetAppointmentsByDoctorIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
@@ -2911,7 +3204,7 @@ type GetAppointmentsByDoctorIDResponse struct {
 
 func (x *GetAppointmentsByDoctorIDResponse) Reset() {
 	*x = GetAppointmentsByDoctorIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[46]
+	mi := &file_proto_storage_storage_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2923,7 +3216,7 @@ func (x *GetAppointmentsByDoctorIDResponse) String() string {
 func (*GetAppointmentsByDoctorIDResponse) ProtoMessage() {}
 
 func (x *GetAppointmentsByDoctorIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[46]
+	mi := &file_proto_storage_storage_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2936,7 +3229,7 @@ func (x *GetAppointmentsByDoctorIDResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAppointmentsByDoctorIDResponse.ProtoReflect.Descriptor instead.
 func (*GetAppointmentsByDoctorIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{46}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetAppointmentsByDoctorIDResponse) GetAppointments() []*Appointment {
@@ -2955,7 +3248,7 @@ type GetByIDRequest struct {
 
 func (x *GetByIDRequest) Reset() {
 	*x = GetByIDRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[47]
+	mi := &file_proto_storage_storage_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2967,7 +3260,7 @@ func (x *GetByIDRequest) String() string {
 func (*GetByIDRequest) ProtoMessage() {}
 
 func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[47]
+	mi := &file_proto_storage_storage_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2980,7 +3273,7 @@ func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{47}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetByIDRequest) GetId() int32 {
@@ -2999,7 +3292,7 @@ type GetPatientByIDResponse struct {
 
 func (x *GetPatientByIDResponse) Reset() {
 	*x = GetPatientByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[48]
+	mi := &file_proto_storage_storage_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3011,7 +3304,7 @@ func (x *GetPatientByIDResponse) String() string {
 func (*GetPatientByIDResponse) ProtoMessage() {}
 
 func (x *GetPatientByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[48]
+	mi := &file_proto_storage_storage_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3024,7 +3317,7 @@ func (x *GetPatientByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{48}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetPatientByIDResponse) GetPatient() *Patient {
@@ -3037,13 +3330,15 @@ func (x *GetPatientByIDResponse) GetPatient() *Patient {
 type AddAppointmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	HoldId        string                 `protobuf:"bytes,2,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`  // удержание слота, которое снимается при создании записи
+	HeldBy        int32                  `protobuf:"varint,3,opt,name=held_by,json=heldBy,proto3" json:"held_by,omitempty"` // кто удерживает слот hold_id
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddAppointmentRequest) Reset() {
 	*x = AddAppointmentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[49]
+	mi := &file_proto_storage_storage_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3055,7 +3350,7 @@ func (x *AddAppointmentRequest) String() string {
 func (*AddAppointmentRequest) ProtoMessage() {}
 
 func (x *AddAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[49]
+	mi := &file_proto_storage_storage_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3068,7 +3363,7 @@ func (x *AddAppointmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddAppointmentRequest.ProtoReflect.Descriptor instead.
 func (*AddAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{49}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *AddAppointmentRequest) GetAppointment() *Appointment {
@@ -3078,28 +3373,8112 @@ func (x *AddAppointmentRequest) GetAppointment() *Appointment {
 	return nil
 }
 
-type UpdateAppointmentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+func (x *AddAppointmentRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *AddAppointmentRequest) GetHeldBy() int32 {
+	if x != nil {
+		return x.HeldBy
+	}
+	return 0
+}
+
+type AppointmentSlotHold struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HoldId          string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	DoctorId        int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	PatientId       int32                  `protobuf:"varint,5,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AppointmentSlotHold) Reset() {
+	*x = AppointmentSlotHold{}
+	mi := &file_proto_storage_storage_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentSlotHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentSlotHold) ProtoMessage() {}
+
+func (x *AppointmentSlotHold) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentSlotHold.ProtoReflect.Descriptor instead.
+func (*AppointmentSlotHold) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AppointmentSlotHold) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *AppointmentSlotHold) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *AppointmentSlotHold) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *AppointmentSlotHold) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *AppointmentSlotHold) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *AppointmentSlotHold) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *AppointmentSlotHold) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type HoldAppointmentSlotRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HoldId          string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"` // при повторном запросе удержание продлевается
+	DoctorId        int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	PatientId       int32                  `protobuf:"varint,5,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	TtlSeconds      int32                  `protobuf:"varint,6,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	HeldBy          int32                  `protobuf:"varint,8,opt,name=held_by,json=heldBy,proto3" json:"held_by,omitempty"` // пользователь, который удерживает слот
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HoldAppointmentSlotRequest) Reset() {
+	*x = HoldAppointmentSlotRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldAppointmentSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldAppointmentSlotRequest) ProtoMessage() {}
+
+func (x *HoldAppointmentSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldAppointmentSlotRequest.ProtoReflect.Descriptor instead.
+func (*HoldAppointmentSlotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *HoldAppointmentSlotRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *HoldAppointmentSlotRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *HoldAppointmentSlotRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *HoldAppointmentSlotRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *HoldAppointmentSlotRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *HoldAppointmentSlotRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *HoldAppointmentSlotRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *HoldAppointmentSlotRequest) GetHeldBy() int32 {
+	if x != nil {
+		return x.HeldBy
+	}
+	return 0
+}
+
+type HoldAppointmentSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *AppointmentSlotHold   `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldAppointmentSlotResponse) Reset() {
+	*x = HoldAppointmentSlotResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldAppointmentSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldAppointmentSlotResponse) ProtoMessage() {}
+
+func (x *HoldAppointmentSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldAppointmentSlotResponse.ProtoReflect.Descriptor instead.
+func (*HoldAppointmentSlotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *HoldAppointmentSlotResponse) GetHold() *AppointmentSlotHold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+type ReleaseAppointmentSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	HeldBy        int32                  `protobuf:"varint,2,opt,name=held_by,json=heldBy,proto3" json:"held_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseAppointmentSlotRequest) Reset() {
+	*x = ReleaseAppointmentSlotRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseAppointmentSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseAppointmentSlotRequest) ProtoMessage() {}
+
+func (x *ReleaseAppointmentSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseAppointmentSlotRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseAppointmentSlotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReleaseAppointmentSlotRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ReleaseAppointmentSlotRequest) GetHeldBy() int32 {
+	if x != nil {
+		return x.HeldBy
+	}
+	return 0
+}
+
+type GetAppointmentSlotHoldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holds         []*AppointmentSlotHold `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentSlotHoldsResponse) Reset() {
+	*x = GetAppointmentSlotHoldsResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentSlotHoldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentSlotHoldsResponse) ProtoMessage() {}
+
+func (x *GetAppointmentSlotHoldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentSlotHoldsResponse.ProtoReflect.Descriptor instead.
+func (*GetAppointmentSlotHoldsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetAppointmentSlotHoldsResponse) GetHolds() []*AppointmentSlotHold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+type UpdateAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAppointmentRequest) Reset() {
+	*x = UpdateAppointmentRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppointmentRequest) ProtoMessage() {}
+
+func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateAppointmentRequest) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+type GetAppointmentsByUserIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointment   []*Appointment         `protobuf:"bytes,1,rep,name=appointment,proto3" json:"appointment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentsByUserIDResponse) Reset() {
+	*x = GetAppointmentsByUserIDResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentsByUserIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentsByUserIDResponse) ProtoMessage() {}
+
+func (x *GetAppointmentsByUserIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentsByUserIDResponse.ProtoReflect.Descriptor instead.
+func (*GetAppointmentsByUserIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetAppointmentsByUserIDResponse) GetAppointment() []*Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+type GetAppointmentByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentByIDResponse) Reset() {
+	*x = GetAppointmentByIDResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentByIDResponse) ProtoMessage() {}
+
+func (x *GetAppointmentByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetAppointmentByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetAppointmentByIDResponse) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
+	}
+	return nil
+}
+
+type GetDoctorByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Doctor        *Doctor                `protobuf:"bytes,1,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoctorByIDResponse) Reset() {
+	*x = GetDoctorByIDResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoctorByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoctorByIDResponse) ProtoMessage() {}
+
+func (x *GetDoctorByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoctorByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetDoctorByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetDoctorByIDResponse) GetDoctor() *Doctor {
+	if x != nil {
+		return x.Doctor
+	}
+	return nil
+}
+
+type GetSpecsByDoctorIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpecId        []int32                `protobuf:"varint,1,rep,packed,name=spec_id,json=specId,proto3" json:"spec_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpecsByDoctorIDResponse) Reset() {
+	*x = GetSpecsByDoctorIDResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpecsByDoctorIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpecsByDoctorIDResponse) ProtoMessage() {}
+
+func (x *GetSpecsByDoctorIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpecsByDoctorIDResponse.ProtoReflect.Descriptor instead.
+func (*GetSpecsByDoctorIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetSpecsByDoctorIDResponse) GetSpecId() []int32 {
+	if x != nil {
+		return x.SpecId
+	}
+	return nil
+}
+
+type GetClinicOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClinicOverrideRequest) Reset() {
+	*x = GetClinicOverrideRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClinicOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClinicOverrideRequest) ProtoMessage() {}
+
+func (x *GetClinicOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClinicOverrideRequest.ProtoReflect.Descriptor instead.
+func (*GetClinicOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetClinicOverrideRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type GetClinicOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	IsDayOff      bool                   `protobuf:"varint,4,opt,name=is_day_off,json=isDayOff,proto3" json:"is_day_off,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClinicOverrideResponse) Reset() {
+	*x = GetClinicOverrideResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClinicOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClinicOverrideResponse) ProtoMessage() {}
+
+func (x *GetClinicOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClinicOverrideResponse.ProtoReflect.Descriptor instead.
+func (*GetClinicOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetClinicOverrideResponse) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *GetClinicOverrideResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetClinicOverrideResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetClinicOverrideResponse) GetIsDayOff() bool {
+	if x != nil {
+		return x.IsDayOff
+	}
+	return false
+}
+
+type GetDoctorOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DoctorId      int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoctorOverrideRequest) Reset() {
+	*x = GetDoctorOverrideRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoctorOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoctorOverrideRequest) ProtoMessage() {}
+
+func (x *GetDoctorOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoctorOverrideRequest.ProtoReflect.Descriptor instead.
+func (*GetDoctorOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetDoctorOverrideRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *GetDoctorOverrideRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type GetDoctorOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DoctorId      int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	IsDayOff      bool                   `protobuf:"varint,5,opt,name=is_day_off,json=isDayOff,proto3" json:"is_day_off,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoctorOverrideResponse) Reset() {
+	*x = GetDoctorOverrideResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoctorOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoctorOverrideResponse) ProtoMessage() {}
+
+func (x *GetDoctorOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoctorOverrideResponse.ProtoReflect.Descriptor instead.
+func (*GetDoctorOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetDoctorOverrideResponse) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *GetDoctorOverrideResponse) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *GetDoctorOverrideResponse) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetDoctorOverrideResponse) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetDoctorOverrideResponse) GetIsDayOff() bool {
+	if x != nil {
+		return x.IsDayOff
+	}
+	return false
+}
+
+type DoctorOverride struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	DoctorId            int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Date                *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	StartTime           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	SlotDurationMinutes int32                  `protobuf:"varint,5,opt,name=slot_duration_minutes,json=slotDurationMinutes,proto3" json:"slot_duration_minutes,omitempty"`
+	IsDayOff            bool                   `protobuf:"varint,6,opt,name=is_day_off,json=isDayOff,proto3" json:"is_day_off,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DoctorOverride) Reset() {
+	*x = DoctorOverride{}
+	mi := &file_proto_storage_storage_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoctorOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoctorOverride) ProtoMessage() {}
+
+func (x *DoctorOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoctorOverride.ProtoReflect.Descriptor instead.
+func (*DoctorOverride) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DoctorOverride) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *DoctorOverride) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *DoctorOverride) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DoctorOverride) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *DoctorOverride) GetSlotDurationMinutes() int32 {
+	if x != nil {
+		return x.SlotDurationMinutes
+	}
+	return 0
+}
+
+func (x *DoctorOverride) GetIsDayOff() bool {
+	if x != nil {
+		return x.IsDayOff
+	}
+	return false
+}
+
+type GetDoctorOverridesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Override      []*DoctorOverride      `protobuf:"bytes,1,rep,name=override,proto3" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoctorOverridesResponse) Reset() {
+	*x = GetDoctorOverridesResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoctorOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoctorOverridesResponse) ProtoMessage() {}
+
+func (x *GetDoctorOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoctorOverridesResponse.ProtoReflect.Descriptor instead.
+func (*GetDoctorOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetDoctorOverridesResponse) GetOverride() []*DoctorOverride {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+type AddMaterialRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price             int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Unit              string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	LowStockThreshold int32                  `protobuf:"varint,4,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddMaterialRequest) Reset() {
+	*x = AddMaterialRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMaterialRequest) ProtoMessage() {}
+
+func (x *AddMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMaterialRequest.ProtoReflect.Descriptor instead.
+func (*AddMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AddMaterialRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddMaterialRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AddMaterialRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *AddMaterialRequest) GetLowStockThreshold() int32 {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return 0
+}
+
+type AddServiceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price           int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Type            int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddServiceRequest) Reset() {
+	*x = AddServiceRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddServiceRequest) ProtoMessage() {}
+
+func (x *AddServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddServiceRequest.ProtoReflect.Descriptor instead.
+func (*AddServiceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AddServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddServiceRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *AddServiceRequest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *AddServiceRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type UpdateMaterialRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price             int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Unit              string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	LowStockThreshold int32                  `protobuf:"varint,5,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateMaterialRequest) Reset() {
+	*x = UpdateMaterialRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMaterialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMaterialRequest) ProtoMessage() {}
+
+func (x *UpdateMaterialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMaterialRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMaterialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpdateMaterialRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateMaterialRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMaterialRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateMaterialRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *UpdateMaterialRequest) GetLowStockThreshold() int32 {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return 0
+}
+
+type UpdateServiceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price           int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Type            int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateServiceRequest) Reset() {
+	*x = UpdateServiceRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceRequest) ProtoMessage() {}
+
+func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServiceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateServiceRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateServiceRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateServiceRequest) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *UpdateServiceRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type Material struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price             int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Unit              string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	LowStockThreshold int32                  `protobuf:"varint,5,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"` // 0 — без предупреждения о низком остатке
+	StockQuantity     int32                  `protobuf:"varint,6,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`               // отрицательный, если списано больше, чем оприходовано
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Material) Reset() {
+	*x = Material{}
+	mi := &file_proto_storage_storage_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Material) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Material) ProtoMessage() {}
+
+func (x *Material) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Material.ProtoReflect.Descriptor instead.
+func (*Material) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Material) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Material) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Material) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Material) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Material) GetLowStockThreshold() int32 {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return 0
+}
+
+func (x *Material) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+// Партия поступления материала; expires_at не задан у материалов без срока годности
+type MaterialBatch struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MaterialId       int32                  `protobuf:"varint,2,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	BatchNumber      string                 `protobuf:"bytes,3,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+	QuantityReceived int32                  `protobuf:"varint,4,opt,name=quantity_received,json=quantityReceived,proto3" json:"quantity_received,omitempty"`
+	QuantityLeft     int32                  `protobuf:"varint,5,opt,name=quantity_left,json=quantityLeft,proto3" json:"quantity_left,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ReceivedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Comment          string                 `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MaterialBatch) Reset() {
+	*x = MaterialBatch{}
+	mi := &file_proto_storage_storage_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterialBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterialBatch) ProtoMessage() {}
+
+func (x *MaterialBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterialBatch.ProtoReflect.Descriptor instead.
+func (*MaterialBatch) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *MaterialBatch) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MaterialBatch) GetMaterialId() int32 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *MaterialBatch) GetBatchNumber() string {
+	if x != nil {
+		return x.BatchNumber
+	}
+	return ""
+}
+
+func (x *MaterialBatch) GetQuantityReceived() int32 {
+	if x != nil {
+		return x.QuantityReceived
+	}
+	return 0
+}
+
+func (x *MaterialBatch) GetQuantityLeft() int32 {
+	if x != nil {
+		return x.QuantityLeft
+	}
+	return 0
+}
+
+func (x *MaterialBatch) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *MaterialBatch) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *MaterialBatch) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// Движение материала: kind — receipt, consumption или adjustment; quantity со знаком
+type StockMovement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MaterialId    int32                  `protobuf:"varint,2,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	BatchId       int32                  `protobuf:"varint,3,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VisitId       int32                  `protobuf:"varint,6,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_proto_storage_storage_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *StockMovement) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockMovement) GetMaterialId() int32 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetBatchId() int32 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *StockMovement) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockMovement) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Позиция плана лечения: услуга на этапе, tooth — номер зуба по FDI (0 — без привязки к зубу).
+// estimated_price — цена за единицу на день составления плана; status — planned, in_progress, done, cancelled
+type TreatmentPlanItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlanId         int32                  `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Stage          int32                  `protobuf:"varint,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	Tooth          int32                  `protobuf:"varint,4,opt,name=tooth,proto3" json:"tooth,omitempty"`
+	ServiceId      int32                  `protobuf:"varint,5,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceName    string                 `protobuf:"bytes,6,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Quantity       int32                  `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	EstimatedPrice int32                  `protobuf:"varint,8,opt,name=estimated_price,json=estimatedPrice,proto3" json:"estimated_price,omitempty"`
+	Status         string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	VisitId        int32                  `protobuf:"varint,10,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	Note           string                 `protobuf:"bytes,11,opt,name=note,proto3" json:"note,omitempty"`
+	CompletedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TreatmentPlanItem) Reset() {
+	*x = TreatmentPlanItem{}
+	mi := &file_proto_storage_storage_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentPlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentPlanItem) ProtoMessage() {}
+
+func (x *TreatmentPlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentPlanItem.ProtoReflect.Descriptor instead.
+func (*TreatmentPlanItem) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *TreatmentPlanItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetPlanId() int32 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetServiceId() int32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *TreatmentPlanItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetEstimatedPrice() int32 {
+	if x != nil {
+		return x.EstimatedPrice
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TreatmentPlanItem) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TreatmentPlanItem) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// План лечения; status — proposed, accepted, in_progress, done, cancelled
+type TreatmentPlan struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId      int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId       int32                  `protobuf:"varint,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Title          string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	AcceptedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Items          []*TreatmentPlanItem   `protobuf:"bytes,9,rep,name=items,proto3" json:"items,omitempty"`
+	EstimatedTotal int32                  `protobuf:"varint,10,opt,name=estimated_total,json=estimatedTotal,proto3" json:"estimated_total,omitempty"` // стоимость неотменённых позиций
+	RemainingCost  int32                  `protobuf:"varint,11,opt,name=remaining_cost,json=remainingCost,proto3" json:"remaining_cost,omitempty"`    // стоимость ещё не выполненных позиций
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TreatmentPlan) Reset() {
+	*x = TreatmentPlan{}
+	mi := &file_proto_storage_storage_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentPlan) ProtoMessage() {}
+
+func (x *TreatmentPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentPlan.ProtoReflect.Descriptor instead.
+func (*TreatmentPlan) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *TreatmentPlan) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TreatmentPlan) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *TreatmentPlan) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *TreatmentPlan) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TreatmentPlan) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TreatmentPlan) GetAcceptedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return nil
+}
+
+func (x *TreatmentPlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TreatmentPlan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TreatmentPlan) GetItems() []*TreatmentPlanItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *TreatmentPlan) GetEstimatedTotal() int32 {
+	if x != nil {
+		return x.EstimatedTotal
+	}
+	return 0
+}
+
+func (x *TreatmentPlan) GetRemainingCost() int32 {
+	if x != nil {
+		return x.RemainingCost
+	}
+	return 0
+}
+
+type NewTreatmentPlanItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stage         int32                  `protobuf:"varint,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Tooth         int32                  `protobuf:"varint,2,opt,name=tooth,proto3" json:"tooth,omitempty"`
+	ServiceId     int32                  `protobuf:"varint,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewTreatmentPlanItem) Reset() {
+	*x = NewTreatmentPlanItem{}
+	mi := &file_proto_storage_storage_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewTreatmentPlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewTreatmentPlanItem) ProtoMessage() {}
+
+func (x *NewTreatmentPlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewTreatmentPlanItem.ProtoReflect.Descriptor instead.
+func (*NewTreatmentPlanItem) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *NewTreatmentPlanItem) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *NewTreatmentPlanItem) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *NewTreatmentPlanItem) GetServiceId() int32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *NewTreatmentPlanItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *NewTreatmentPlanItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CreateTreatmentPlanRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	PatientId     int32                   `protobuf:"varint,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId      int32                   `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Title         string                  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Items         []*NewTreatmentPlanItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTreatmentPlanRequest) Reset() {
+	*x = CreateTreatmentPlanRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTreatmentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTreatmentPlanRequest) ProtoMessage() {}
+
+func (x *CreateTreatmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTreatmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateTreatmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateTreatmentPlanRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *CreateTreatmentPlanRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *CreateTreatmentPlanRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTreatmentPlanRequest) GetItems() []*NewTreatmentPlanItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AddTreatmentPlanItemsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	PlanId        int32                   `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Items         []*NewTreatmentPlanItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTreatmentPlanItemsRequest) Reset() {
+	*x = AddTreatmentPlanItemsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTreatmentPlanItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTreatmentPlanItemsRequest) ProtoMessage() {}
+
+func (x *AddTreatmentPlanItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTreatmentPlanItemsRequest.ProtoReflect.Descriptor instead.
+func (*AddTreatmentPlanItemsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *AddTreatmentPlanItemsRequest) GetPlanId() int32 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *AddTreatmentPlanItemsRequest) GetItems() []*NewTreatmentPlanItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateTreatmentPlanItemStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTreatmentPlanItemStatusRequest) Reset() {
+	*x = UpdateTreatmentPlanItemStatusRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTreatmentPlanItemStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTreatmentPlanItemStatusRequest) ProtoMessage() {}
+
+func (x *UpdateTreatmentPlanItemStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTreatmentPlanItemStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTreatmentPlanItemStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateTreatmentPlanItemStatusRequest) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *UpdateTreatmentPlanItemStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AcceptTreatmentPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        int32                  `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTreatmentPlanRequest) Reset() {
+	*x = AcceptTreatmentPlanRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTreatmentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTreatmentPlanRequest) ProtoMessage() {}
+
+func (x *AcceptTreatmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTreatmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTreatmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AcceptTreatmentPlanRequest) GetPlanId() int32 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *AcceptTreatmentPlanRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+type TreatmentPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *TreatmentPlan         `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreatmentPlanResponse) Reset() {
+	*x = TreatmentPlanResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentPlanResponse) ProtoMessage() {}
+
+func (x *TreatmentPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentPlanResponse.ProtoReflect.Descriptor instead.
+func (*TreatmentPlanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *TreatmentPlanResponse) GetPlan() *TreatmentPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type TreatmentPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*TreatmentPlan       `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreatmentPlansResponse) Reset() {
+	*x = TreatmentPlansResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentPlansResponse) ProtoMessage() {}
+
+func (x *TreatmentPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentPlansResponse.ProtoReflect.Descriptor instead.
+func (*TreatmentPlansResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *TreatmentPlansResponse) GetPlans() []*TreatmentPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// Запись зубной формулы: состояние зуба по FDI — healthy, caries, filled, crown, implant, missing;
+// surfaces — затронутые поверхности буквами MOIDBL, пусто — зуб целиком
+type ToothRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Tooth         int32                  `protobuf:"varint,3,opt,name=tooth,proto3" json:"tooth,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Surfaces      string                 `protobuf:"bytes,5,opt,name=surfaces,proto3" json:"surfaces,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	VisitId       int32                  `protobuf:"varint,7,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"` // 0 — записано вне приёма
+	DoctorId      int32                  `protobuf:"varint,8,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	RecordedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToothRecord) Reset() {
+	*x = ToothRecord{}
+	mi := &file_proto_storage_storage_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToothRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToothRecord) ProtoMessage() {}
+
+func (x *ToothRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToothRecord.ProtoReflect.Descriptor instead.
+func (*ToothRecord) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ToothRecord) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ToothRecord) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *ToothRecord) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *ToothRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ToothRecord) GetSurfaces() string {
+	if x != nil {
+		return x.Surfaces
+	}
+	return ""
+}
+
+func (x *ToothRecord) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ToothRecord) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *ToothRecord) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *ToothRecord) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+type RecordToothStatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PatientId     int32                  `protobuf:"varint,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Records       []*ToothRecord         `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordToothStatesRequest) Reset() {
+	*x = RecordToothStatesRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordToothStatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordToothStatesRequest) ProtoMessage() {}
+
+func (x *RecordToothStatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordToothStatesRequest.ProtoReflect.Descriptor instead.
+func (*RecordToothStatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RecordToothStatesRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *RecordToothStatesRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *RecordToothStatesRequest) GetRecords() []*ToothRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type GetToothHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PatientId     int32                  `protobuf:"varint,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Tooth         int32                  `protobuf:"varint,2,opt,name=tooth,proto3" json:"tooth,omitempty"` // 0 — история по всем зубам
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetToothHistoryRequest) Reset() {
+	*x = GetToothHistoryRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetToothHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetToothHistoryRequest) ProtoMessage() {}
+
+func (x *GetToothHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetToothHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetToothHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetToothHistoryRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *GetToothHistoryRequest) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+type DentalChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teeth         []*ToothRecord         `protobuf:"bytes,1,rep,name=teeth,proto3" json:"teeth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DentalChartResponse) Reset() {
+	*x = DentalChartResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DentalChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DentalChartResponse) ProtoMessage() {}
+
+func (x *DentalChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DentalChartResponse.ProtoReflect.Descriptor instead.
+func (*DentalChartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DentalChartResponse) GetTeeth() []*ToothRecord {
+	if x != nil {
+		return x.Teeth
+	}
+	return nil
+}
+
+// Аллергия из анкеты здоровья; severity — mild, moderate, severe
+type AnamnesisAllergy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allergen      string                 `protobuf:"bytes,1,opt,name=allergen,proto3" json:"allergen,omitempty"`
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	Reaction      string                 `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnamnesisAllergy) Reset() {
+	*x = AnamnesisAllergy{}
+	mi := &file_proto_storage_storage_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnamnesisAllergy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnamnesisAllergy) ProtoMessage() {}
+
+func (x *AnamnesisAllergy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnamnesisAllergy.ProtoReflect.Descriptor instead.
+func (*AnamnesisAllergy) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AnamnesisAllergy) GetAllergen() string {
+	if x != nil {
+		return x.Allergen
+	}
+	return ""
+}
+
+func (x *AnamnesisAllergy) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AnamnesisAllergy) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+// Версия анкеты здоровья пациента; version = 0 — анкета не заполнялась
+type Anamnesis struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId          int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Version            int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Medications        string                 `protobuf:"bytes,4,opt,name=medications,proto3" json:"medications,omitempty"`
+	BloodThinners      bool                   `protobuf:"varint,5,opt,name=blood_thinners,json=bloodThinners,proto3" json:"blood_thinners,omitempty"`
+	CardiacDisease     bool                   `protobuf:"varint,6,opt,name=cardiac_disease,json=cardiacDisease,proto3" json:"cardiac_disease,omitempty"`
+	Hypertension       bool                   `protobuf:"varint,7,opt,name=hypertension,proto3" json:"hypertension,omitempty"`
+	Diabetes           bool                   `protobuf:"varint,8,opt,name=diabetes,proto3" json:"diabetes,omitempty"`
+	BleedingDisorder   bool                   `protobuf:"varint,9,opt,name=bleeding_disorder,json=bleedingDisorder,proto3" json:"bleeding_disorder,omitempty"`
+	InfectiousDisease  bool                   `protobuf:"varint,10,opt,name=infectious_disease,json=infectiousDisease,proto3" json:"infectious_disease,omitempty"`
+	AnesthesiaReaction bool                   `protobuf:"varint,11,opt,name=anesthesia_reaction,json=anesthesiaReaction,proto3" json:"anesthesia_reaction,omitempty"`
+	Pregnant           bool                   `protobuf:"varint,12,opt,name=pregnant,proto3" json:"pregnant,omitempty"`
+	Breastfeeding      bool                   `protobuf:"varint,13,opt,name=breastfeeding,proto3" json:"breastfeeding,omitempty"`
+	Smoker             bool                   `protobuf:"varint,14,opt,name=smoker,proto3" json:"smoker,omitempty"`
+	OtherConditions    string                 `protobuf:"bytes,15,opt,name=other_conditions,json=otherConditions,proto3" json:"other_conditions,omitempty"`
+	Allergies          []*AnamnesisAllergy    `protobuf:"bytes,16,rep,name=allergies,proto3" json:"allergies,omitempty"`
+	ChangedBy          int32                  `protobuf:"varint,17,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	ChangedByRole      string                 `protobuf:"bytes,18,opt,name=changed_by_role,json=changedByRole,proto3" json:"changed_by_role,omitempty"` // patient или doctor
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Anamnesis) Reset() {
+	*x = Anamnesis{}
+	mi := &file_proto_storage_storage_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Anamnesis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anamnesis) ProtoMessage() {}
+
+func (x *Anamnesis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anamnesis.ProtoReflect.Descriptor instead.
+func (*Anamnesis) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Anamnesis) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Anamnesis) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *Anamnesis) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Anamnesis) GetMedications() string {
+	if x != nil {
+		return x.Medications
+	}
+	return ""
+}
+
+func (x *Anamnesis) GetBloodThinners() bool {
+	if x != nil {
+		return x.BloodThinners
+	}
+	return false
+}
+
+func (x *Anamnesis) GetCardiacDisease() bool {
+	if x != nil {
+		return x.CardiacDisease
+	}
+	return false
+}
+
+func (x *Anamnesis) GetHypertension() bool {
+	if x != nil {
+		return x.Hypertension
+	}
+	return false
+}
+
+func (x *Anamnesis) GetDiabetes() bool {
+	if x != nil {
+		return x.Diabetes
+	}
+	return false
+}
+
+func (x *Anamnesis) GetBleedingDisorder() bool {
+	if x != nil {
+		return x.BleedingDisorder
+	}
+	return false
+}
+
+func (x *Anamnesis) GetInfectiousDisease() bool {
+	if x != nil {
+		return x.InfectiousDisease
+	}
+	return false
+}
+
+func (x *Anamnesis) GetAnesthesiaReaction() bool {
+	if x != nil {
+		return x.AnesthesiaReaction
+	}
+	return false
+}
+
+func (x *Anamnesis) GetPregnant() bool {
+	if x != nil {
+		return x.Pregnant
+	}
+	return false
+}
+
+func (x *Anamnesis) GetBreastfeeding() bool {
+	if x != nil {
+		return x.Breastfeeding
+	}
+	return false
+}
+
+func (x *Anamnesis) GetSmoker() bool {
+	if x != nil {
+		return x.Smoker
+	}
+	return false
+}
+
+func (x *Anamnesis) GetOtherConditions() string {
+	if x != nil {
+		return x.OtherConditions
+	}
+	return ""
+}
+
+func (x *Anamnesis) GetAllergies() []*AnamnesisAllergy {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
+
+func (x *Anamnesis) GetChangedBy() int32 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+func (x *Anamnesis) GetChangedByRole() string {
+	if x != nil {
+		return x.ChangedByRole
+	}
+	return ""
+}
+
+func (x *Anamnesis) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SaveAnamnesisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anamnesis     *Anamnesis             `protobuf:"bytes,1,opt,name=anamnesis,proto3" json:"anamnesis,omitempty"`
+	BaseVersion   int32                  `protobuf:"varint,2,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"` // версия, которую видел автор изменений; 0 — анкета заполняется впервые
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveAnamnesisRequest) Reset() {
+	*x = SaveAnamnesisRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveAnamnesisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAnamnesisRequest) ProtoMessage() {}
+
+func (x *SaveAnamnesisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAnamnesisRequest.ProtoReflect.Descriptor instead.
+func (*SaveAnamnesisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *SaveAnamnesisRequest) GetAnamnesis() *Anamnesis {
+	if x != nil {
+		return x.Anamnesis
+	}
+	return nil
+}
+
+func (x *SaveAnamnesisRequest) GetBaseVersion() int32 {
+	if x != nil {
+		return x.BaseVersion
+	}
+	return 0
+}
+
+type AnamnesisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anamnesis     *Anamnesis             `protobuf:"bytes,1,opt,name=anamnesis,proto3" json:"anamnesis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnamnesisResponse) Reset() {
+	*x = AnamnesisResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnamnesisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnamnesisResponse) ProtoMessage() {}
+
+func (x *AnamnesisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnamnesisResponse.ProtoReflect.Descriptor instead.
+func (*AnamnesisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *AnamnesisResponse) GetAnamnesis() *Anamnesis {
+	if x != nil {
+		return x.Anamnesis
+	}
+	return nil
+}
+
+type AnamnesisHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*Anamnesis           `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnamnesisHistoryResponse) Reset() {
+	*x = AnamnesisHistoryResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnamnesisHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnamnesisHistoryResponse) ProtoMessage() {}
+
+func (x *AnamnesisHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnamnesisHistoryResponse.ProtoReflect.Descriptor instead.
+func (*AnamnesisHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AnamnesisHistoryResponse) GetVersions() []*Anamnesis {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// Правило клинического предупреждения. condition_type — allergy, condition или anamnesis_flag;
+// target_type — visit (при открытии приёма), material или service (при выборе материала или услуги)
+type ClinicalAlertRule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ConditionType  string                 `protobuf:"bytes,3,opt,name=condition_type,json=conditionType,proto3" json:"condition_type,omitempty"`
+	ConditionValue string                 `protobuf:"bytes,4,opt,name=condition_value,json=conditionValue,proto3" json:"condition_value,omitempty"`
+	TargetType     string                 `protobuf:"bytes,5,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	MaterialId     int32                  `protobuf:"varint,6,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	ServiceId      int32                  `protobuf:"varint,7,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	TargetName     string                 `protobuf:"bytes,8,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"` // название материала или услуги
+	Severity       string                 `protobuf:"bytes,9,opt,name=severity,proto3" json:"severity,omitempty"`                       // info, warning, critical
+	Message        string                 `protobuf:"bytes,10,opt,name=message,proto3" json:"message,omitempty"`
+	IsActive       bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClinicalAlertRule) Reset() {
+	*x = ClinicalAlertRule{}
+	mi := &file_proto_storage_storage_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClinicalAlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClinicalAlertRule) ProtoMessage() {}
+
+func (x *ClinicalAlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClinicalAlertRule.ProtoReflect.Descriptor instead.
+func (*ClinicalAlertRule) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ClinicalAlertRule) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ClinicalAlertRule) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ClinicalAlertRule) GetConditionType() string {
+	if x != nil {
+		return x.ConditionType
+	}
+	return ""
+}
+
+func (x *ClinicalAlertRule) GetConditionValue() string {
+	if x != nil {
+		return x.ConditionValue
+	}
+	return ""
+}
+
+func (x *ClinicalAlertRule) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *ClinicalAlertRule) GetMaterialId() int32 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *ClinicalAlertRule) GetServiceId() int32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *ClinicalAlertRule) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *ClinicalAlertRule) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ClinicalAlertRule) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ClinicalAlertRule) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ClinicalAlertRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetClinicalAlertRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly    bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClinicalAlertRulesRequest) Reset() {
+	*x = GetClinicalAlertRulesRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClinicalAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClinicalAlertRulesRequest) ProtoMessage() {}
+
+func (x *GetClinicalAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClinicalAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetClinicalAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetClinicalAlertRulesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type GetClinicalAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ClinicalAlertRule   `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClinicalAlertRulesResponse) Reset() {
+	*x = GetClinicalAlertRulesResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClinicalAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClinicalAlertRulesResponse) ProtoMessage() {}
+
+func (x *GetClinicalAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClinicalAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetClinicalAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetClinicalAlertRulesResponse) GetRules() []*ClinicalAlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type AddClinicalAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddClinicalAlertRuleResponse) Reset() {
+	*x = AddClinicalAlertRuleResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddClinicalAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddClinicalAlertRuleResponse) ProtoMessage() {}
+
+func (x *AddClinicalAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddClinicalAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*AddClinicalAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AddClinicalAlertRuleResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Медицинская карта пациента для выгрузки; приёмы — от старых к новым, anamnesis.version = 0 — анкета
+// не заполнялась
+type MedicalRecord struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Patient       *Patient                    `protobuf:"bytes,1,opt,name=patient,proto3" json:"patient,omitempty"`
+	Notes         []*PatientAllergiesChronics `protobuf:"bytes,2,rep,name=notes,proto3" json:"notes,omitempty"`
+	Anamnesis     *Anamnesis                  `protobuf:"bytes,3,opt,name=anamnesis,proto3" json:"anamnesis,omitempty"`
+	Visits        []*MedicalRecordVisit       `protobuf:"bytes,4,rep,name=visits,proto3" json:"visits,omitempty"`
+	Documents     []*DocumentInfo             `protobuf:"bytes,5,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MedicalRecord) Reset() {
+	*x = MedicalRecord{}
+	mi := &file_proto_storage_storage_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MedicalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicalRecord) ProtoMessage() {}
+
+func (x *MedicalRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicalRecord.ProtoReflect.Descriptor instead.
+func (*MedicalRecord) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *MedicalRecord) GetPatient() *Patient {
+	if x != nil {
+		return x.Patient
+	}
+	return nil
+}
+
+func (x *MedicalRecord) GetNotes() []*PatientAllergiesChronics {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *MedicalRecord) GetAnamnesis() *Anamnesis {
+	if x != nil {
+		return x.Anamnesis
+	}
+	return nil
+}
+
+func (x *MedicalRecord) GetVisits() []*MedicalRecordVisit {
+	if x != nil {
+		return x.Visits
+	}
+	return nil
+}
+
+func (x *MedicalRecord) GetDocuments() []*DocumentInfo {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type MedicalRecordVisit struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Id            int32                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DoctorId      int32                     `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Doctor        string                    `protobuf:"bytes,3,opt,name=doctor,proto3" json:"doctor,omitempty"` // ФИО врача
+	Complaints    string                    `protobuf:"bytes,4,opt,name=complaints,proto3" json:"complaints,omitempty"`
+	Treatment     string                    `protobuf:"bytes,5,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp    `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Diagnoses     []*MedicalRecordDiagnosis `protobuf:"bytes,7,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
+	Services      []*MedicalRecordService   `protobuf:"bytes,8,rep,name=services,proto3" json:"services,omitempty"`
+	Materials     []*MedicalRecordMaterial  `protobuf:"bytes,9,rep,name=materials,proto3" json:"materials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MedicalRecordVisit) Reset() {
+	*x = MedicalRecordVisit{}
+	mi := &file_proto_storage_storage_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MedicalRecordVisit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicalRecordVisit) ProtoMessage() {}
+
+func (x *MedicalRecordVisit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicalRecordVisit.ProtoReflect.Descriptor instead.
+func (*MedicalRecordVisit) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *MedicalRecordVisit) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MedicalRecordVisit) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *MedicalRecordVisit) GetDoctor() string {
+	if x != nil {
+		return x.Doctor
+	}
+	return ""
+}
+
+func (x *MedicalRecordVisit) GetComplaints() string {
+	if x != nil {
+		return x.Complaints
+	}
+	return ""
+}
+
+func (x *MedicalRecordVisit) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *MedicalRecordVisit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MedicalRecordVisit) GetDiagnoses() []*MedicalRecordDiagnosis {
+	if x != nil {
+		return x.Diagnoses
+	}
+	return nil
+}
+
+func (x *MedicalRecordVisit) GetServices() []*MedicalRecordService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *MedicalRecordVisit) GetMaterials() []*MedicalRecordMaterial {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+// Диагноз приёма по МКБ-10; tooth = 0 — диагноз не относится к зубу
+type MedicalRecordDiagnosis struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Tooth         int32                  `protobuf:"varint,4,opt,name=tooth,proto3" json:"tooth,omitempty"`
+	Surfaces      string                 `protobuf:"bytes,5,opt,name=surfaces,proto3" json:"surfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MedicalRecordDiagnosis) Reset() {
+	*x = MedicalRecordDiagnosis{}
+	mi := &file_proto_storage_storage_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MedicalRecordDiagnosis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicalRecordDiagnosis) ProtoMessage() {}
+
+func (x *MedicalRecordDiagnosis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicalRecordDiagnosis.ProtoReflect.Descriptor instead.
+func (*MedicalRecordDiagnosis) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *MedicalRecordDiagnosis) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *MedicalRecordDiagnosis) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MedicalRecordDiagnosis) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *MedicalRecordDiagnosis) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *MedicalRecordDiagnosis) GetSurfaces() string {
+	if x != nil {
+		return x.Surfaces
+	}
+	return ""
+}
+
+type MedicalRecordService struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     int32                  `protobuf:"varint,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Tooth         int32                  `protobuf:"varint,4,opt,name=tooth,proto3" json:"tooth,omitempty"`
+	Surfaces      string                 `protobuf:"bytes,5,opt,name=surfaces,proto3" json:"surfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MedicalRecordService) Reset() {
+	*x = MedicalRecordService{}
+	mi := &file_proto_storage_storage_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MedicalRecordService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicalRecordService) ProtoMessage() {}
+
+func (x *MedicalRecordService) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicalRecordService.ProtoReflect.Descriptor instead.
+func (*MedicalRecordService) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *MedicalRecordService) GetServiceId() int32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *MedicalRecordService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MedicalRecordService) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *MedicalRecordService) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *MedicalRecordService) GetSurfaces() string {
+	if x != nil {
+		return x.Surfaces
+	}
+	return ""
+}
+
+type MedicalRecordMaterial struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialId    int32                  `protobuf:"varint,1,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MedicalRecordMaterial) Reset() {
+	*x = MedicalRecordMaterial{}
+	mi := &file_proto_storage_storage_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MedicalRecordMaterial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicalRecordMaterial) ProtoMessage() {}
+
+func (x *MedicalRecordMaterial) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicalRecordMaterial.ProtoReflect.Descriptor instead.
+func (*MedicalRecordMaterial) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *MedicalRecordMaterial) GetMaterialId() int32 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *MedicalRecordMaterial) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MedicalRecordMaterial) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type DoctorPatientRelationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DoctorId      int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoctorPatientRelationRequest) Reset() {
+	*x = DoctorPatientRelationRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoctorPatientRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoctorPatientRelationRequest) ProtoMessage() {}
+
+func (x *DoctorPatientRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoctorPatientRelationRequest.ProtoReflect.Descriptor instead.
+func (*DoctorPatientRelationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DoctorPatientRelationRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *DoctorPatientRelationRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+// Отношение врача к пациенту: treating — у врача есть подтверждённая или завершённая запись пациента,
+// granted — действующий допуск к карте
+type DoctorPatientRelation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Treating      bool                   `protobuf:"varint,1,opt,name=treating,proto3" json:"treating,omitempty"`
+	Granted       bool                   `protobuf:"varint,2,opt,name=granted,proto3" json:"granted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoctorPatientRelation) Reset() {
+	*x = DoctorPatientRelation{}
+	mi := &file_proto_storage_storage_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoctorPatientRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoctorPatientRelation) ProtoMessage() {}
+
+func (x *DoctorPatientRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoctorPatientRelation.ProtoReflect.Descriptor instead.
+func (*DoctorPatientRelation) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DoctorPatientRelation) GetTreating() bool {
+	if x != nil {
+		return x.Treating
+	}
+	return false
+}
+
+func (x *DoctorPatientRelation) GetGranted() bool {
+	if x != nil {
+		return x.Granted
+	}
+	return false
+}
+
+// Допуск врача к медицинской карте пациента; expires_at не задан — бессрочный допуск
+type PatientAccessGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Doctor        string                 `protobuf:"bytes,4,opt,name=doctor,proto3" json:"doctor,omitempty"` // ФИО врача
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	GrantedBy     int32                  `protobuf:"varint,6,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatientAccessGrant) Reset() {
+	*x = PatientAccessGrant{}
+	mi := &file_proto_storage_storage_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatientAccessGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatientAccessGrant) ProtoMessage() {}
+
+func (x *PatientAccessGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatientAccessGrant.ProtoReflect.Descriptor instead.
+func (*PatientAccessGrant) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *PatientAccessGrant) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatientAccessGrant) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *PatientAccessGrant) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *PatientAccessGrant) GetDoctor() string {
+	if x != nil {
+		return x.Doctor
+	}
+	return ""
+}
+
+func (x *PatientAccessGrant) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PatientAccessGrant) GetGrantedBy() int32 {
+	if x != nil {
+		return x.GrantedBy
+	}
+	return 0
+}
+
+func (x *PatientAccessGrant) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PatientAccessGrant) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *PatientAccessGrant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetPatientAccessGrantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grants        []*PatientAccessGrant  `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientAccessGrantsResponse) Reset() {
+	*x = GetPatientAccessGrantsResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientAccessGrantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientAccessGrantsResponse) ProtoMessage() {}
+
+func (x *GetPatientAccessGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientAccessGrantsResponse.ProtoReflect.Descriptor instead.
+func (*GetPatientAccessGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetPatientAccessGrantsResponse) GetGrants() []*PatientAccessGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type AddPatientAccessGrantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPatientAccessGrantResponse) Reset() {
+	*x = AddPatientAccessGrantResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPatientAccessGrantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPatientAccessGrantResponse) ProtoMessage() {}
+
+func (x *AddPatientAccessGrantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPatientAccessGrantResponse.ProtoReflect.Descriptor instead.
+func (*AddPatientAccessGrantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *AddPatientAccessGrantResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReceiveMaterialBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialId    int32                  `protobuf:"varint,1,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	BatchNumber   string                 `protobuf:"bytes,2,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveMaterialBatchRequest) Reset() {
+	*x = ReceiveMaterialBatchRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveMaterialBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveMaterialBatchRequest) ProtoMessage() {}
+
+func (x *ReceiveMaterialBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveMaterialBatchRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveMaterialBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ReceiveMaterialBatchRequest) GetMaterialId() int32 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *ReceiveMaterialBatchRequest) GetBatchNumber() string {
+	if x != nil {
+		return x.BatchNumber
+	}
+	return ""
+}
+
+func (x *ReceiveMaterialBatchRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReceiveMaterialBatchRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ReceiveMaterialBatchRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// Корректировка остатка: quantity со знаком; без batch_id уменьшение списывается по партиям как при приёме
+type AdjustMaterialStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaterialId    int32                  `protobuf:"varint,1,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	BatchId       int32                  `protobuf:"varint,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustMaterialStockRequest) Reset() {
+	*x = AdjustMaterialStockRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustMaterialStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustMaterialStockRequest) ProtoMessage() {}
+
+func (x *AdjustMaterialStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustMaterialStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustMaterialStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AdjustMaterialStockRequest) GetMaterialId() int32 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *AdjustMaterialStockRequest) GetBatchId() int32 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+func (x *AdjustMaterialStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AdjustMaterialStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MaterialStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Material      *Material              `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"`
+	Batches       []*MaterialBatch       `protobuf:"bytes,2,rep,name=batches,proto3" json:"batches,omitempty"`
+	Movements     []*StockMovement       `protobuf:"bytes,3,rep,name=movements,proto3" json:"movements,omitempty"` // последние движения, новые первыми
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaterialStockResponse) Reset() {
+	*x = MaterialStockResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaterialStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaterialStockResponse) ProtoMessage() {}
+
+func (x *MaterialStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaterialStockResponse.ProtoReflect.Descriptor instead.
+func (*MaterialStockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *MaterialStockResponse) GetMaterial() *Material {
+	if x != nil {
+		return x.Material
+	}
+	return nil
+}
+
+func (x *MaterialStockResponse) GetBatches() []*MaterialBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+func (x *MaterialStockResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+type StockReportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExpiringBefore *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expiring_before,json=expiringBefore,proto3" json:"expiring_before,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StockReportRequest) Reset() {
+	*x = StockReportRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReportRequest) ProtoMessage() {}
+
+func (x *StockReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReportRequest.ProtoReflect.Descriptor instead.
+func (*StockReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *StockReportRequest) GetExpiringBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiringBefore
+	}
+	return nil
+}
+
+type ExpiringBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *MaterialBatch         `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	MaterialName  string                 `protobuf:"bytes,2,opt,name=material_name,json=materialName,proto3" json:"material_name,omitempty"`
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpiringBatch) Reset() {
+	*x = ExpiringBatch{}
+	mi := &file_proto_storage_storage_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpiringBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiringBatch) ProtoMessage() {}
+
+func (x *ExpiringBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiringBatch.ProtoReflect.Descriptor instead.
+func (*ExpiringBatch) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ExpiringBatch) GetBatch() *MaterialBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *ExpiringBatch) GetMaterialName() string {
+	if x != nil {
+		return x.MaterialName
+	}
+	return ""
+}
+
+func (x *ExpiringBatch) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type StockReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LowStock      []*Material            `protobuf:"bytes,1,rep,name=low_stock,json=lowStock,proto3" json:"low_stock,omitempty"`
+	Expiring      []*ExpiringBatch       `protobuf:"bytes,2,rep,name=expiring,proto3" json:"expiring,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReportResponse) Reset() {
+	*x = StockReportResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReportResponse) ProtoMessage() {}
+
+func (x *StockReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReportResponse.ProtoReflect.Descriptor instead.
+func (*StockReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *StockReportResponse) GetLowStock() []*Material {
+	if x != nil {
+		return x.LowStock
+	}
+	return nil
+}
+
+func (x *StockReportResponse) GetExpiring() []*ExpiringBatch {
+	if x != nil {
+		return x.Expiring
+	}
+	return nil
+}
+
+type Service struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price           int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Type            int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	mi := &file_proto_storage_storage_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *Service) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Service) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Service) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Service) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Service) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type GetMaterialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Materials     []*Material            `protobuf:"bytes,1,rep,name=materials,proto3" json:"materials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaterialsResponse) Reset() {
+	*x = GetMaterialsResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaterialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaterialsResponse) ProtoMessage() {}
+
+func (x *GetMaterialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaterialsResponse.ProtoReflect.Descriptor instead.
+func (*GetMaterialsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetMaterialsResponse) GetMaterials() []*Material {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+type GetServicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*Service             `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServicesResponse) Reset() {
+	*x = GetServicesResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServicesResponse) ProtoMessage() {}
+
+func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServicesResponse.ProtoReflect.Descriptor instead.
+func (*GetServicesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetServicesResponse) GetServices() []*Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type GetByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByNameRequest) Reset() {
+	*x = GetByNameRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByNameRequest) ProtoMessage() {}
+
+func (x *GetByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetByNameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetByNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIdRequest) Reset() {
+	*x = GetByIdRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdRequest) ProtoMessage() {}
+
+func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetByIdRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetMaterialByNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaterialByNameResponse) Reset() {
+	*x = GetMaterialByNameResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaterialByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaterialByNameResponse) ProtoMessage() {}
+
+func (x *GetMaterialByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaterialByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetMaterialByNameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetMaterialByNameResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetMaterialByNameResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetMaterialByNameResponse) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type GetServiceByNameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Type          int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceByNameResponse) Reset() {
+	*x = GetServiceByNameResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceByNameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceByNameResponse) ProtoMessage() {}
+
+func (x *GetServiceByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceByNameResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetServiceByNameResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetServiceByNameResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetServiceByNameResponse) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *GetServiceByNameResponse) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *DeleteRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ChangeUserStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChangedBy     int32                  `protobuf:"varint,2,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"` // кто меняет статус
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserStatusRequest) Reset() {
+	*x = ChangeUserStatusRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserStatusRequest) ProtoMessage() {}
+
+func (x *ChangeUserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ChangeUserStatusRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangeUserStatusRequest) GetChangedBy() int32 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+type ChangeUserStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // статус пользователя после изменения
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserStatusResponse) Reset() {
+	*x = ChangeUserStatusResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserStatusResponse) ProtoMessage() {}
+
+func (x *ChangeUserStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangeUserStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ChangeUserStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ServiceType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceType) Reset() {
+	*x = ServiceType{}
+	mi := &file_proto_storage_storage_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceType) ProtoMessage() {}
+
+func (x *ServiceType) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceType.ProtoReflect.Descriptor instead.
+func (*ServiceType) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ServiceType) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ServiceType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetServicesTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []*ServiceType         `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServicesTypesResponse) Reset() {
+	*x = GetServicesTypesResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServicesTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServicesTypesResponse) ProtoMessage() {}
+
+func (x *GetServicesTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServicesTypesResponse.ProtoReflect.Descriptor instead.
+func (*GetServicesTypesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetServicesTypesResponse) GetTypes() []*ServiceType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type GetServiceTypeByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceTypeByIdRequest) Reset() {
+	*x = GetServiceTypeByIdRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceTypeByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceTypeByIdRequest) ProtoMessage() {}
+
+func (x *GetServiceTypeByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceTypeByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceTypeByIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetServiceTypeByIdRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetServiceTypeByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceTypeByIdResponse) Reset() {
+	*x = GetServiceTypeByIdResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceTypeByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceTypeByIdResponse) ProtoMessage() {}
+
+func (x *GetServiceTypeByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceTypeByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceTypeByIdResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetServiceTypeByIdResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetServiceTypeByIdResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateUserLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserLoginRequest) Reset() {
+	*x = UpdateUserLoginRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserLoginRequest) ProtoMessage() {}
+
+func (x *UpdateUserLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserLoginRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *UpdateUserLoginRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateUserLoginRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type ICDCode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ICDCode) Reset() {
+	*x = ICDCode{}
+	mi := &file_proto_storage_storage_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ICDCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ICDCode) ProtoMessage() {}
+
+func (x *ICDCode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ICDCode.ProtoReflect.Descriptor instead.
+func (*ICDCode) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ICDCode) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ICDCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ICDCode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Diagnose struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VisitId       int32                  `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	IcdCodeId     int32                  `protobuf:"varint,3,opt,name=icd_code_id,json=icdCodeId,proto3" json:"icd_code_id,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Tooth         int32                  `protobuf:"varint,5,opt,name=tooth,proto3" json:"tooth,omitempty"` // номер зуба по FDI, 0 — диагноз не относится к зубу
+	Surfaces      string                 `protobuf:"bytes,6,opt,name=surfaces,proto3" json:"surfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Diagnose) Reset() {
+	*x = Diagnose{}
+	mi := &file_proto_storage_storage_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Diagnose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnose) ProtoMessage() {}
+
+func (x *Diagnose) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnose.ProtoReflect.Descriptor instead.
+func (*Diagnose) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *Diagnose) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Diagnose) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *Diagnose) GetIcdCodeId() int32 {
+	if x != nil {
+		return x.IcdCodeId
+	}
+	return 0
+}
+
+func (x *Diagnose) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Diagnose) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *Diagnose) GetSurfaces() string {
+	if x != nil {
+		return x.Surfaces
+	}
+	return ""
+}
+
+type Visit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppointmentId int32                  `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Complaints    string                 `protobuf:"bytes,5,opt,name=complaints,proto3" json:"complaints,omitempty"`
+	Treatment     string                 `protobuf:"bytes,6,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Visit) Reset() {
+	*x = Visit{}
+	mi := &file_proto_storage_storage_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Visit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Visit) ProtoMessage() {}
+
+func (x *Visit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Visit.ProtoReflect.Descriptor instead.
+func (*Visit) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *Visit) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Visit) GetAppointmentId() int32 {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return 0
+}
+
+func (x *Visit) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *Visit) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *Visit) GetComplaints() string {
+	if x != nil {
+		return x.Complaints
+	}
+	return ""
+}
+
+func (x *Visit) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *Visit) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PatientAllergiesChronics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatientAllergiesChronics) Reset() {
+	*x = PatientAllergiesChronics{}
+	mi := &file_proto_storage_storage_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatientAllergiesChronics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatientAllergiesChronics) ProtoMessage() {}
+
+func (x *PatientAllergiesChronics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatientAllergiesChronics.ProtoReflect.Descriptor instead.
+func (*PatientAllergiesChronics) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *PatientAllergiesChronics) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PatientAllergiesChronics) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *PatientAllergiesChronics) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PatientAllergiesChronics) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type AddVisitMaterials struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	MaterialId    int32                  `protobuf:"varint,2,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVisitMaterials) Reset() {
+	*x = AddVisitMaterials{}
+	mi := &file_proto_storage_storage_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVisitMaterials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVisitMaterials) ProtoMessage() {}
+
+func (x *AddVisitMaterials) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVisitMaterials.ProtoReflect.Descriptor instead.
+func (*AddVisitMaterials) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *AddVisitMaterials) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *AddVisitMaterials) GetMaterialId() int32 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *AddVisitMaterials) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type AddVisitMaterialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Materials     []*AddVisitMaterials   `protobuf:"bytes,1,rep,name=materials,proto3" json:"materials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVisitMaterialsRequest) Reset() {
+	*x = AddVisitMaterialsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVisitMaterialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVisitMaterialsRequest) ProtoMessage() {}
+
+func (x *AddVisitMaterialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVisitMaterialsRequest.ProtoReflect.Descriptor instead.
+func (*AddVisitMaterialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *AddVisitMaterialsRequest) GetMaterials() []*AddVisitMaterials {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+type AddVisitServices struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	ServiceId     int32                  `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Tooth         int32                  `protobuf:"varint,4,opt,name=tooth,proto3" json:"tooth,omitempty"` // номер зуба по FDI, 0 — услуга не относится к зубу
+	Surfaces      string                 `protobuf:"bytes,5,opt,name=surfaces,proto3" json:"surfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVisitServices) Reset() {
+	*x = AddVisitServices{}
+	mi := &file_proto_storage_storage_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVisitServices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVisitServices) ProtoMessage() {}
+
+func (x *AddVisitServices) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVisitServices.ProtoReflect.Descriptor instead.
+func (*AddVisitServices) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *AddVisitServices) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *AddVisitServices) GetServiceId() int32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *AddVisitServices) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddVisitServices) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *AddVisitServices) GetSurfaces() string {
+	if x != nil {
+		return x.Surfaces
+	}
+	return ""
+}
+
+type AddVisitServicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*AddVisitServices    `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVisitServicesRequest) Reset() {
+	*x = AddVisitServicesRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVisitServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVisitServicesRequest) ProtoMessage() {}
+
+func (x *AddVisitServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVisitServicesRequest.ProtoReflect.Descriptor instead.
+func (*AddVisitServicesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *AddVisitServicesRequest) GetServices() []*AddVisitServices {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type AddPatientAllergiesChronicsRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Notes         []*PatientAllergiesChronics `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPatientAllergiesChronicsRequest) Reset() {
+	*x = AddPatientAllergiesChronicsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPatientAllergiesChronicsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPatientAllergiesChronicsRequest) ProtoMessage() {}
+
+func (x *AddPatientAllergiesChronicsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPatientAllergiesChronicsRequest.ProtoReflect.Descriptor instead.
+func (*AddPatientAllergiesChronicsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *AddPatientAllergiesChronicsRequest) GetNotes() []*PatientAllergiesChronics {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type AddPatientVisitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId int32                  `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Complaints    string                 `protobuf:"bytes,5,opt,name=complaints,proto3" json:"complaints,omitempty"`
+	Treatment     string                 `protobuf:"bytes,6,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPatientVisitRequest) Reset() {
+	*x = AddPatientVisitRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPatientVisitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPatientVisitRequest) ProtoMessage() {}
+
+func (x *AddPatientVisitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPatientVisitRequest.ProtoReflect.Descriptor instead.
+func (*AddPatientVisitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *AddPatientVisitRequest) GetAppointmentId() int32 {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return 0
+}
+
+func (x *AddPatientVisitRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *AddPatientVisitRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *AddPatientVisitRequest) GetComplaints() string {
+	if x != nil {
+		return x.Complaints
+	}
+	return ""
+}
+
+func (x *AddPatientVisitRequest) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *AddPatientVisitRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddPatientDiagnosesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diagnoses     []*Diagnose            `protobuf:"bytes,1,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPatientDiagnosesRequest) Reset() {
+	*x = AddPatientDiagnosesRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPatientDiagnosesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPatientDiagnosesRequest) ProtoMessage() {}
+
+func (x *AddPatientDiagnosesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPatientDiagnosesRequest.ProtoReflect.Descriptor instead.
+func (*AddPatientDiagnosesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *AddPatientDiagnosesRequest) GetDiagnoses() []*Diagnose {
+	if x != nil {
+		return x.Diagnoses
+	}
+	return nil
+}
+
+type GetPatientDiagnosesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diagnoses     []*Diagnose            `protobuf:"bytes,1,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientDiagnosesResponse) Reset() {
+	*x = GetPatientDiagnosesResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientDiagnosesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientDiagnosesResponse) ProtoMessage() {}
+
+func (x *GetPatientDiagnosesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientDiagnosesResponse.ProtoReflect.Descriptor instead.
+func (*GetPatientDiagnosesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GetPatientDiagnosesResponse) GetDiagnoses() []*Diagnose {
+	if x != nil {
+		return x.Diagnoses
+	}
+	return nil
+}
+
+type GetPatientVisitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Visits        []*Visit               `protobuf:"bytes,1,rep,name=visits,proto3" json:"visits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientVisitsResponse) Reset() {
+	*x = GetPatientVisitsResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientVisitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientVisitsResponse) ProtoMessage() {}
+
+func (x *GetPatientVisitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientVisitsResponse.ProtoReflect.Descriptor instead.
+func (*GetPatientVisitsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GetPatientVisitsResponse) GetVisits() []*Visit {
+	if x != nil {
+		return x.Visits
+	}
+	return nil
+}
+
+type GetPatientAllergiesChronicsResponse struct {
+	state                    protoimpl.MessageState      `protogen:"open.v1"`
+	PatientAllergiesChronics []*PatientAllergiesChronics `protobuf:"bytes,1,rep,name=patient_allergies_chronics,json=patientAllergiesChronics,proto3" json:"patient_allergies_chronics,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetPatientAllergiesChronicsResponse) Reset() {
+	*x = GetPatientAllergiesChronicsResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientAllergiesChronicsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientAllergiesChronicsResponse) ProtoMessage() {}
+
+func (x *GetPatientAllergiesChronicsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientAllergiesChronicsResponse.ProtoReflect.Descriptor instead.
+func (*GetPatientAllergiesChronicsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetPatientAllergiesChronicsResponse) GetPatientAllergiesChronics() []*PatientAllergiesChronics {
+	if x != nil {
+		return x.PatientAllergiesChronics
+	}
+	return nil
+}
+
+// sort: code (по умолчанию), name
+type GetICDCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // начало кода или часть названия
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetICDCodesRequest) Reset() {
+	*x = GetICDCodesRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetICDCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetICDCodesRequest) ProtoMessage() {}
+
+func (x *GetICDCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetICDCodesRequest.ProtoReflect.Descriptor instead.
+func (*GetICDCodesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GetICDCodesRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetICDCodesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type GetICDCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IcdCode       []*ICDCode             `protobuf:"bytes,1,rep,name=icd_code,json=icdCode,proto3" json:"icd_code,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных кодов без учёта страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetICDCodesResponse) Reset() {
+	*x = GetICDCodesResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetICDCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetICDCodesResponse) ProtoMessage() {}
+
+func (x *GetICDCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetICDCodesResponse.ProtoReflect.Descriptor instead.
+func (*GetICDCodesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetICDCodesResponse) GetIcdCode() []*ICDCode {
+	if x != nil {
+		return x.IcdCode
+	}
+	return nil
+}
+
+func (x *GetICDCodesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AddVisitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVisitResponse) Reset() {
+	*x = AddVisitResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVisitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVisitResponse) ProtoMessage() {}
+
+func (x *AddVisitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVisitResponse.ProtoReflect.Descriptor instead.
+func (*AddVisitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *AddVisitResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type VisitPayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	Price         int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisitPayment) Reset() {
+	*x = VisitPayment{}
+	mi := &file_proto_storage_storage_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitPayment) ProtoMessage() {}
+
+func (x *VisitPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitPayment.ProtoReflect.Descriptor instead.
+func (*VisitPayment) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *VisitPayment) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *VisitPayment) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *VisitPayment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type VisitPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	Price         int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisitPaymentRequest) Reset() {
+	*x = VisitPaymentRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitPaymentRequest) ProtoMessage() {}
+
+func (x *VisitPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitPaymentRequest.ProtoReflect.Descriptor instead.
+func (*VisitPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *VisitPaymentRequest) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *VisitPaymentRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *VisitPaymentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// sort: visit_id (по умолчанию)
+type GetVisitsPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVisitsPaymentsRequest) Reset() {
+	*x = GetVisitsPaymentsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitsPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitsPaymentsRequest) ProtoMessage() {}
+
+func (x *GetVisitsPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVisitsPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*GetVisitsPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetVisitsPaymentsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetVisitsPaymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VisitPayment  []*VisitPayment        `protobuf:"bytes,1,rep,name=visit_payment,json=visitPayment,proto3" json:"visit_payment,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех неподтверждённых оплат без учёта страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVisitsPaymentsResponse) Reset() {
+	*x = GetVisitsPaymentsResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitsPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitsPaymentsResponse) ProtoMessage() {}
+
+func (x *GetVisitsPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVisitsPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*GetVisitsPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetVisitsPaymentsResponse) GetVisitPayment() []*VisitPayment {
+	if x != nil {
+		return x.VisitPayment
+	}
+	return nil
+}
+
+func (x *GetVisitsPaymentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Запрос на подсчет суммы по визиту
+type CalculateVisitTotalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateVisitTotalRequest) Reset() {
+	*x = CalculateVisitTotalRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateVisitTotalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateVisitTotalRequest) ProtoMessage() {}
+
+func (x *CalculateVisitTotalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateVisitTotalRequest.ProtoReflect.Descriptor instead.
+func (*CalculateVisitTotalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *CalculateVisitTotalRequest) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+// Ответ с итоговой суммой
+type CalculateVisitTotalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateVisitTotalResponse) Reset() {
+	*x = CalculateVisitTotalResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateVisitTotalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateVisitTotalResponse) ProtoMessage() {}
+
+func (x *CalculateVisitTotalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateVisitTotalResponse.ProtoReflect.Descriptor instead.
+func (*CalculateVisitTotalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *CalculateVisitTotalResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Завершение приёма одной транзакцией. Повтор с тем же idempotency_key возвращает уже созданный визит;
+// visit_id в diagnoses, services и materials не заполняется
+type CompleteConsultationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // UUID, который клиент создаёт на одну отправку формы приёма
+	AppointmentId  int32                  `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	PatientId      int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId       int32                  `protobuf:"varint,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Complaints     string                 `protobuf:"bytes,5,opt,name=complaints,proto3" json:"complaints,omitempty"`
+	Treatment      string                 `protobuf:"bytes,6,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	Diagnoses      []*Diagnose            `protobuf:"bytes,7,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
+	Services       []*AddVisitServices    `protobuf:"bytes,8,rep,name=services,proto3" json:"services,omitempty"`
+	Materials      []*AddVisitMaterials   `protobuf:"bytes,9,rep,name=materials,proto3" json:"materials,omitempty"`
+	PlanItemIds    []int32                `protobuf:"varint,10,rep,packed,name=plan_item_ids,json=planItemIds,proto3" json:"plan_item_ids,omitempty"` // позиции принятых планов лечения пациента, выполненные на приёме
+	Chart          []*ToothRecord         `protobuf:"bytes,11,rep,name=chart,proto3" json:"chart,omitempty"`                                          // изменения зубной формулы по итогам приёма
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompleteConsultationRequest) Reset() {
+	*x = CompleteConsultationRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteConsultationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteConsultationRequest) ProtoMessage() {}
+
+func (x *CompleteConsultationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteConsultationRequest.ProtoReflect.Descriptor instead.
+func (*CompleteConsultationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *CompleteConsultationRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CompleteConsultationRequest) GetAppointmentId() int32 {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return 0
+}
+
+func (x *CompleteConsultationRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *CompleteConsultationRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *CompleteConsultationRequest) GetComplaints() string {
+	if x != nil {
+		return x.Complaints
+	}
+	return ""
+}
+
+func (x *CompleteConsultationRequest) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *CompleteConsultationRequest) GetDiagnoses() []*Diagnose {
+	if x != nil {
+		return x.Diagnoses
+	}
+	return nil
+}
+
+func (x *CompleteConsultationRequest) GetServices() []*AddVisitServices {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *CompleteConsultationRequest) GetMaterials() []*AddVisitMaterials {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+func (x *CompleteConsultationRequest) GetPlanItemIds() []int32 {
+	if x != nil {
+		return x.PlanItemIds
+	}
+	return nil
+}
+
+func (x *CompleteConsultationRequest) GetChart() []*ToothRecord {
+	if x != nil {
+		return x.Chart
+	}
+	return nil
+}
+
+type CompleteConsultationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	TotalPrice    int32                  `protobuf:"varint,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteConsultationResponse) Reset() {
+	*x = CompleteConsultationResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteConsultationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteConsultationResponse) ProtoMessage() {}
+
+func (x *CompleteConsultationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteConsultationResponse.ProtoReflect.Descriptor instead.
+func (*CompleteConsultationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *CompleteConsultationResponse) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *CompleteConsultationResponse) GetTotalPrice() int32 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+// Счёт на приём; paid — внесено за вычетом возвратов, balance — остаток к оплате
+type Invoice struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VisitId        int32                  `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	PatientId      int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Subtotal       int32                  `protobuf:"varint,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountKind   string                 `protobuf:"bytes,5,opt,name=discount_kind,json=discountKind,proto3" json:"discount_kind,omitempty"` // percent, fixed; пусто — без скидки
+	DiscountValue  int32                  `protobuf:"varint,6,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	DiscountReason string                 `protobuf:"bytes,7,opt,name=discount_reason,json=discountReason,proto3" json:"discount_reason,omitempty"` // loyalty, staff, promo
+	DiscountAmount int32                  `protobuf:"varint,8,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	Total          int32                  `protobuf:"varint,9,opt,name=total,proto3" json:"total,omitempty"`
+	Paid           int32                  `protobuf:"varint,10,opt,name=paid,proto3" json:"paid,omitempty"`
+	Balance        int32                  `protobuf:"varint,11,opt,name=balance,proto3" json:"balance,omitempty"`
+	Status         string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"` // issued, partially_paid, paid, refunded
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Lines          []*InvoiceLine         `protobuf:"bytes,14,rep,name=lines,proto3" json:"lines,omitempty"`
+	Payments       []*InvoicePayment      `protobuf:"bytes,15,rep,name=payments,proto3" json:"payments,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_proto_storage_storage_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *Invoice) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Invoice) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *Invoice) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *Invoice) GetSubtotal() int32 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *Invoice) GetDiscountKind() string {
+	if x != nil {
+		return x.DiscountKind
+	}
+	return ""
+}
+
+func (x *Invoice) GetDiscountValue() int32 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *Invoice) GetDiscountReason() string {
+	if x != nil {
+		return x.DiscountReason
+	}
+	return ""
+}
+
+func (x *Invoice) GetDiscountAmount() int32 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *Invoice) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Invoice) GetPaid() int32 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *Invoice) GetBalance() int32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Invoice) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invoice) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetLines() []*InvoiceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Invoice) GetPayments() []*InvoicePayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type InvoiceLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // service, material, adjustment
+	ItemId        int32                  `protobuf:"varint,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PricePerUnit  int32                  `protobuf:"varint,6,opt,name=price_per_unit,json=pricePerUnit,proto3" json:"price_per_unit,omitempty"`
+	TotalPrice    int32                  `protobuf:"varint,7,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceLine) Reset() {
+	*x = InvoiceLine{}
+	mi := &file_proto_storage_storage_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLine) ProtoMessage() {}
+
+func (x *InvoiceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLine.ProtoReflect.Descriptor instead.
+func (*InvoiceLine) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *InvoiceLine) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetPricePerUnit() int32 {
+	if x != nil {
+		return x.PricePerUnit
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetTotalPrice() int32 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+type InvoicePayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // payment, refund
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`                      // cash, card, transfer; unknown — оплата до введения счетов
+	RefundOf      int32                  `protobuf:"varint,5,opt,name=refund_of,json=refundOf,proto3" json:"refund_of,omitempty"` // для возврата — оплата, по которой он сделан
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoicePayment) Reset() {
+	*x = InvoicePayment{}
+	mi := &file_proto_storage_storage_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoicePayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoicePayment) ProtoMessage() {}
+
+func (x *InvoicePayment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoicePayment.ProtoReflect.Descriptor instead.
+func (*InvoicePayment) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *InvoicePayment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InvoicePayment) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *InvoicePayment) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *InvoicePayment) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *InvoicePayment) GetRefundOf() int32 {
+	if x != nil {
+		return x.RefundOf
+	}
+	return 0
+}
+
+func (x *InvoicePayment) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *InvoicePayment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type InvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceResponse) Reset() {
+	*x = InvoiceResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceResponse) ProtoMessage() {}
+
+func (x *InvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceResponse.ProtoReflect.Descriptor instead.
+func (*InvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *InvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type SetInvoiceDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     int32                  `protobuf:"varint,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // пусто — снять скидку
+	Value         int32                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInvoiceDiscountRequest) Reset() {
+	*x = SetInvoiceDiscountRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInvoiceDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInvoiceDiscountRequest) ProtoMessage() {}
+
+func (x *SetInvoiceDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInvoiceDiscountRequest.ProtoReflect.Descriptor instead.
+func (*SetInvoiceDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *SetInvoiceDiscountRequest) GetInvoiceId() int32 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *SetInvoiceDiscountRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SetInvoiceDiscountRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SetInvoiceDiscountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AddInvoicePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     int32                  `protobuf:"varint,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddInvoicePaymentRequest) Reset() {
+	*x = AddInvoicePaymentRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddInvoicePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddInvoicePaymentRequest) ProtoMessage() {}
+
+func (x *AddInvoicePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddInvoicePaymentRequest.ProtoReflect.Descriptor instead.
+func (*AddInvoicePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *AddInvoicePaymentRequest) GetInvoiceId() int32 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *AddInvoicePaymentRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddInvoicePaymentRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AddInvoicePaymentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type RefundInvoicePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     int32                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundInvoicePaymentRequest) Reset() {
+	*x = RefundInvoicePaymentRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundInvoicePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundInvoicePaymentRequest) ProtoMessage() {}
+
+func (x *RefundInvoicePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundInvoicePaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundInvoicePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *RefundInvoicePaymentRequest) GetPaymentId() int32 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *RefundInvoicePaymentRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundInvoicePaymentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type PatientBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PatientId     int32                  `protobuf:"varint,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Invoiced      int32                  `protobuf:"varint,2,opt,name=invoiced,proto3" json:"invoiced,omitempty"`
+	Paid          int32                  `protobuf:"varint,3,opt,name=paid,proto3" json:"paid,omitempty"`
+	Outstanding   int32                  `protobuf:"varint,4,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	OpenInvoices  []*Invoice             `protobuf:"bytes,5,rep,name=open_invoices,json=openInvoices,proto3" json:"open_invoices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatientBalanceResponse) Reset() {
+	*x = PatientBalanceResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatientBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatientBalanceResponse) ProtoMessage() {}
+
+func (x *PatientBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatientBalanceResponse.ProtoReflect.Descriptor instead.
+func (*PatientBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *PatientBalanceResponse) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *PatientBalanceResponse) GetInvoiced() int32 {
+	if x != nil {
+		return x.Invoiced
+	}
+	return 0
+}
+
+func (x *PatientBalanceResponse) GetPaid() int32 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *PatientBalanceResponse) GetOutstanding() int32 {
+	if x != nil {
+		return x.Outstanding
+	}
+	return 0
+}
+
+func (x *PatientBalanceResponse) GetOpenInvoices() []*Invoice {
+	if x != nil {
+		return x.OpenInvoices
+	}
+	return nil
+}
+
+// Номер печатного документа по счёту: kind — invoice (счёт на оплату) или receipt (чек на оплату или возврат
+// payment_id). document_id пуст, пока PDF не сохранён в документы пациента
+type BillingDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Number        int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	InvoiceId     int32                  `protobuf:"varint,4,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	PaymentId     int32                  `protobuf:"varint,5,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,6,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BillingDocument) Reset() {
+	*x = BillingDocument{}
+	mi := &file_proto_storage_storage_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BillingDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillingDocument) ProtoMessage() {}
+
+func (x *BillingDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillingDocument.ProtoReflect.Descriptor instead.
+func (*BillingDocument) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *BillingDocument) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BillingDocument) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BillingDocument) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *BillingDocument) GetInvoiceId() int32 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *BillingDocument) GetPaymentId() int32 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *BillingDocument) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *BillingDocument) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AttachBillingDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachBillingDocumentRequest) Reset() {
+	*x = AttachBillingDocumentRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachBillingDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachBillingDocumentRequest) ProtoMessage() {}
+
+func (x *AttachBillingDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachBillingDocumentRequest.ProtoReflect.Descriptor instead.
+func (*AttachBillingDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *AttachBillingDocumentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttachBillingDocumentRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+// Запись истории цен услуги или материала; kind — service или material
+type PriceListEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId        int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceListEntry) Reset() {
+	*x = PriceListEntry{}
+	mi := &file_proto_storage_storage_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceListEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceListEntry) ProtoMessage() {}
+
+func (x *PriceListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceListEntry.ProtoReflect.Descriptor instead.
+func (*PriceListEntry) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *PriceListEntry) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceListEntry) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *PriceListEntry) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceListEntry) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *PriceListEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ItemId        int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *GetPriceHistoryRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetPriceHistoryRequest) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+type GetPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*PriceListEntry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *GetPriceHistoryResponse) GetEntries() []*PriceListEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type AddPriceListEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Entry         *PriceListEntry        `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPriceListEntryRequest) Reset() {
+	*x = AddPriceListEntryRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPriceListEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPriceListEntryRequest) ProtoMessage() {}
+
+func (x *AddPriceListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPriceListEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddPriceListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *AddPriceListEntryRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AddPriceListEntryRequest) GetEntry() *PriceListEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type AddPriceListEntryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPriceListEntryResponse) Reset() {
+	*x = AddPriceListEntryResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPriceListEntryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPriceListEntryResponse) ProtoMessage() {}
+
+func (x *AddPriceListEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPriceListEntryResponse.ProtoReflect.Descriptor instead.
+func (*AddPriceListEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *AddPriceListEntryResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePriceListEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePriceListEntryRequest) Reset() {
+	*x = DeletePriceListEntryRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePriceListEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceListEntryRequest) ProtoMessage() {}
+
+func (x *DeletePriceListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceListEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *DeletePriceListEntryRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DeletePriceListEntryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetPriceOnDateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ItemId        int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceOnDateRequest) Reset() {
+	*x = GetPriceOnDateRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceOnDateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceOnDateRequest) ProtoMessage() {}
+
+func (x *GetPriceOnDateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceOnDateRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceOnDateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *GetPriceOnDateRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GetPriceOnDateRequest) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *GetPriceOnDateRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type GetPriceOnDateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         int32                  `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceOnDateResponse) Reset() {
+	*x = GetPriceOnDateResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceOnDateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceOnDateResponse) ProtoMessage() {}
+
+func (x *GetPriceOnDateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceOnDateResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceOnDateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *GetPriceOnDateResponse) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+// Запрос на добавление или обновление платежа
+type AddOrUpdateVisitPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *VisitPayment          `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddOrUpdateVisitPaymentRequest) Reset() {
+	*x = AddOrUpdateVisitPaymentRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddOrUpdateVisitPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOrUpdateVisitPaymentRequest) ProtoMessage() {}
+
+func (x *AddOrUpdateVisitPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOrUpdateVisitPaymentRequest.ProtoReflect.Descriptor instead.
+func (*AddOrUpdateVisitPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *AddOrUpdateVisitPaymentRequest) GetPayment() *VisitPayment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type GetVisitByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Visit         *Visit                 `protobuf:"bytes,1,opt,name=visit,proto3" json:"visit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVisitByIDResponse) Reset() {
+	*x = GetVisitByIDResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitByIDResponse) ProtoMessage() {}
+
+func (x *GetVisitByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVisitByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetVisitByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *GetVisitByIDResponse) GetVisit() *Visit {
+	if x != nil {
+		return x.Visit
+	}
+	return nil
+}
+
+type ClinicOverride struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Date                *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	StartTime           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime             *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	SlotDurationMinutes int32                  `protobuf:"varint,4,opt,name=slot_duration_minutes,json=slotDurationMinutes,proto3" json:"slot_duration_minutes,omitempty"`
+	IsDayOff            bool                   `protobuf:"varint,5,opt,name=is_day_off,json=isDayOff,proto3" json:"is_day_off,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ClinicOverride) Reset() {
+	*x = ClinicOverride{}
+	mi := &file_proto_storage_storage_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClinicOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClinicOverride) ProtoMessage() {}
+
+func (x *ClinicOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClinicOverride.ProtoReflect.Descriptor instead.
+func (*ClinicOverride) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ClinicOverride) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ClinicOverride) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ClinicOverride) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ClinicOverride) GetSlotDurationMinutes() int32 {
+	if x != nil {
+		return x.SlotDurationMinutes
+	}
+	return 0
+}
+
+func (x *ClinicOverride) GetIsDayOff() bool {
+	if x != nil {
+		return x.IsDayOff
+	}
+	return false
+}
+
+type GetClinicOverridesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overrides     []*ClinicOverride      `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClinicOverridesResponse) Reset() {
+	*x = GetClinicOverridesResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClinicOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClinicOverridesResponse) ProtoMessage() {}
+
+func (x *GetClinicOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClinicOverridesResponse.ProtoReflect.Descriptor instead.
+func (*GetClinicOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *GetClinicOverridesResponse) GetOverrides() []*ClinicOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
 
-func (x *UpdateAppointmentRequest) Reset() {
-	*x = UpdateAppointmentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[50]
+// sort: date (по умолчанию), created_at. Границы периода включительно, учитывается только дата;
+// пустые границы, statuses и doctor_id = 0 — без отбора
+type GetAppointmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	Statuses      []string               `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,5,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentsRequest) Reset() {
+	*x = GetAppointmentsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAppointmentRequest) String() string {
+func (x *GetAppointmentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppointmentRequest) ProtoMessage() {}
+func (*GetAppointmentsRequest) ProtoMessage() {}
 
-func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[50]
+func (x *GetAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *GetAppointmentsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetAppointmentsRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *GetAppointmentsRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *GetAppointmentsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetAppointmentsRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+type GetAppointmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех отобранных записей без учёта страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentsResponse) Reset() {
+	*x = GetAppointmentsResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentsResponse) ProtoMessage() {}
+
+func (x *GetAppointmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentsResponse.ProtoReflect.Descriptor instead.
+func (*GetAppointmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *GetAppointmentsResponse) GetAppointments() []*Appointment {
+	if x != nil {
+		return x.Appointments
+	}
+	return nil
+}
+
+func (x *GetAppointmentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type VisitMaterialAndService struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VisitId       int32                  `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	ItemId        int32                  `protobuf:"varint,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PricePerUnit  *int32                 `protobuf:"varint,5,opt,name=price_per_unit,json=pricePerUnit,proto3,oneof" json:"price_per_unit,omitempty"` // цена, зафиксированная на день приёма; нет — записано до введения истории цен
+	TotalPrice    *int32                 `protobuf:"varint,6,opt,name=total_price,json=totalPrice,proto3,oneof" json:"total_price,omitempty"`
+	Tooth         int32                  `protobuf:"varint,7,opt,name=tooth,proto3" json:"tooth,omitempty"` // только у услуг: зуб по FDI, на котором оказана услуга
+	Surfaces      string                 `protobuf:"bytes,8,opt,name=surfaces,proto3" json:"surfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisitMaterialAndService) Reset() {
+	*x = VisitMaterialAndService{}
+	mi := &file_proto_storage_storage_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitMaterialAndService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitMaterialAndService) ProtoMessage() {}
+
+func (x *VisitMaterialAndService) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitMaterialAndService.ProtoReflect.Descriptor instead.
+func (*VisitMaterialAndService) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *VisitMaterialAndService) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VisitMaterialAndService) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *VisitMaterialAndService) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *VisitMaterialAndService) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *VisitMaterialAndService) GetPricePerUnit() int32 {
+	if x != nil && x.PricePerUnit != nil {
+		return *x.PricePerUnit
+	}
+	return 0
+}
+
+func (x *VisitMaterialAndService) GetTotalPrice() int32 {
+	if x != nil && x.TotalPrice != nil {
+		return *x.TotalPrice
+	}
+	return 0
+}
+
+func (x *VisitMaterialAndService) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *VisitMaterialAndService) GetSurfaces() string {
+	if x != nil {
+		return x.Surfaces
+	}
+	return ""
+}
+
+type GetVisitMaterialsAndServicesResponse struct {
+	state                  protoimpl.MessageState     `protogen:"open.v1"`
+	VisitMaterialsServices []*VisitMaterialAndService `protobuf:"bytes,1,rep,name=visit_materials_services,json=visitMaterialsServices,proto3" json:"visit_materials_services,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetVisitMaterialsAndServicesResponse) Reset() {
+	*x = GetVisitMaterialsAndServicesResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitMaterialsAndServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitMaterialsAndServicesResponse) ProtoMessage() {}
+
+func (x *GetVisitMaterialsAndServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVisitMaterialsAndServicesResponse.ProtoReflect.Descriptor instead.
+func (*GetVisitMaterialsAndServicesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *GetVisitMaterialsAndServicesResponse) GetVisitMaterialsServices() []*VisitMaterialAndService {
+	if x != nil {
+		return x.VisitMaterialsServices
+	}
+	return nil
+}
+
+type GetMaterialServiceByIDResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price           int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"` // только для услуг
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetMaterialServiceByIDResponse) Reset() {
+	*x = GetMaterialServiceByIDResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaterialServiceByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaterialServiceByIDResponse) ProtoMessage() {}
+
+func (x *GetMaterialServiceByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3107,43 +11486,64 @@ func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaterialServiceByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetMaterialServiceByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *GetMaterialServiceByIDResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetMaterialServiceByIDResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-// Deprecated: Use UpdateAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{50}
+func (x *GetMaterialServiceByIDResponse) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (x *UpdateAppointmentRequest) GetAppointment() *Appointment {
+func (x *GetMaterialServiceByIDResponse) GetDurationMinutes() int32 {
 	if x != nil {
-		return x.Appointment
+		return x.DurationMinutes
 	}
-	return nil
+	return 0
 }
 
-type GetAppointmentsByUserIDResponse struct {
+type IntResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Appointment   []*Appointment         `protobuf:"bytes,1,rep,name=appointment,proto3" json:"appointment,omitempty"`
+	Int           int32                  `protobuf:"varint,1,opt,name=int,proto3" json:"int,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAppointmentsByUserIDResponse) Reset() {
-	*x = GetAppointmentsByUserIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[51]
+func (x *IntResponse) Reset() {
+	*x = IntResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAppointmentsByUserIDResponse) String() string {
+func (x *IntResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAppointmentsByUserIDResponse) ProtoMessage() {}
+func (*IntResponse) ProtoMessage() {}
 
-func (x *GetAppointmentsByUserIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[51]
+func (x *IntResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3154,40 +11554,40 @@ func (x *GetAppointmentsByUserIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAppointmentsByUserIDResponse.ProtoReflect.Descriptor instead.
-func (*GetAppointmentsByUserIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use IntResponse.ProtoReflect.Descriptor instead.
+func (*IntResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *GetAppointmentsByUserIDResponse) GetAppointment() []*Appointment {
+func (x *IntResponse) GetInt() int32 {
 	if x != nil {
-		return x.Appointment
+		return x.Int
 	}
-	return nil
+	return 0
 }
 
-type GetAppointmentByIDResponse struct {
+type FloatResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	Float         float32                `protobuf:"fixed32,1,opt,name=float,proto3" json:"float,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAppointmentByIDResponse) Reset() {
-	*x = GetAppointmentByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[52]
+func (x *FloatResponse) Reset() {
+	*x = FloatResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAppointmentByIDResponse) String() string {
+func (x *FloatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAppointmentByIDResponse) ProtoMessage() {}
+func (*FloatResponse) ProtoMessage() {}
 
-func (x *GetAppointmentByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[52]
+func (x *FloatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3198,40 +11598,41 @@ func (x *GetAppointmentByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAppointmentByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetAppointmentByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use FloatResponse.ProtoReflect.Descriptor instead.
+func (*FloatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *GetAppointmentByIDResponse) GetAppointment() *Appointment {
+func (x *FloatResponse) GetFloat() float32 {
 	if x != nil {
-		return x.Appointment
+		return x.Float
 	}
-	return nil
+	return 0
 }
 
-type GetDoctorByIDResponse struct {
+type ServiceStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Doctor        *Doctor                `protobuf:"bytes,1,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UsageCount    int32                  `protobuf:"varint,2,opt,name=usage_count,json=usageCount,proto3" json:"usage_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDoctorByIDResponse) Reset() {
-	*x = GetDoctorByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[53]
+func (x *ServiceStats) Reset() {
+	*x = ServiceStats{}
+	mi := &file_proto_storage_storage_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDoctorByIDResponse) String() string {
+func (x *ServiceStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDoctorByIDResponse) ProtoMessage() {}
+func (*ServiceStats) ProtoMessage() {}
 
-func (x *GetDoctorByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[53]
+func (x *ServiceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3242,40 +11643,47 @@ func (x *GetDoctorByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDoctorByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetDoctorByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use ServiceStats.ProtoReflect.Descriptor instead.
+func (*ServiceStats) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *GetDoctorByIDResponse) GetDoctor() *Doctor {
+func (x *ServiceStats) GetName() string {
 	if x != nil {
-		return x.Doctor
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetSpecsByDoctorIDResponse struct {
+func (x *ServiceStats) GetUsageCount() int32 {
+	if x != nil {
+		return x.UsageCount
+	}
+	return 0
+}
+
+type ServiceStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SpecId        []int32                `protobuf:"varint,1,rep,packed,name=spec_id,json=specId,proto3" json:"spec_id,omitempty"`
+	ServiceStats  []*ServiceStats        `protobuf:"bytes,1,rep,name=service_stats,json=serviceStats,proto3" json:"service_stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSpecsByDoctorIDResponse) Reset() {
-	*x = GetSpecsByDoctorIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[54]
+func (x *ServiceStatsResponse) Reset() {
+	*x = ServiceStatsResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSpecsByDoctorIDResponse) String() string {
+func (x *ServiceStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSpecsByDoctorIDResponse) ProtoMessage() {}
+func (*ServiceStatsResponse) ProtoMessage() {}
 
-func (x *GetSpecsByDoctorIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[54]
+func (x *ServiceStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3286,40 +11694,41 @@ func (x *GetSpecsByDoctorIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSpecsByDoctorIDResponse.ProtoReflect.Descriptor instead.
-func (*GetSpecsByDoctorIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ServiceStatsResponse.ProtoReflect.Descriptor instead.
+func (*ServiceStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *GetSpecsByDoctorIDResponse) GetSpecId() []int32 {
+func (x *ServiceStatsResponse) GetServiceStats() []*ServiceStats {
 	if x != nil {
-		return x.SpecId
+		return x.ServiceStats
 	}
 	return nil
 }
 
-type GetClinicOverrideRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DoctorAvgVisit struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DoctorId        int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	AvgWeeklyVisits float32                `protobuf:"fixed32,2,opt,name=avg_weekly_visits,json=avgWeeklyVisits,proto3" json:"avg_weekly_visits,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetClinicOverrideRequest) Reset() {
-	*x = GetClinicOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[55]
+func (x *DoctorAvgVisit) Reset() {
+	*x = DoctorAvgVisit{}
+	mi := &file_proto_storage_storage_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClinicOverrideRequest) String() string {
+func (x *DoctorAvgVisit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClinicOverrideRequest) ProtoMessage() {}
+func (*DoctorAvgVisit) ProtoMessage() {}
 
-func (x *GetClinicOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[55]
+func (x *DoctorAvgVisit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3330,43 +11739,47 @@ func (x *GetClinicOverrideRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClinicOverrideRequest.ProtoReflect.Descriptor instead.
-func (*GetClinicOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use DoctorAvgVisit.ProtoReflect.Descriptor instead.
+func (*DoctorAvgVisit) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *GetClinicOverrideRequest) GetDate() *timestamppb.Timestamp {
+func (x *DoctorAvgVisit) GetDoctorId() int32 {
 	if x != nil {
-		return x.Date
+		return x.DoctorId
 	}
-	return nil
+	return 0
 }
 
-type GetClinicOverrideResponse struct {
+func (x *DoctorAvgVisit) GetAvgWeeklyVisits() float32 {
+	if x != nil {
+		return x.AvgWeeklyVisits
+	}
+	return 0
+}
+
+type DoctorAvgVisitResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	IsDayOff      bool                   `protobuf:"varint,4,opt,name=is_day_off,json=isDayOff,proto3" json:"is_day_off,omitempty"`
+	Visits        []*DoctorAvgVisit      `protobuf:"bytes,1,rep,name=visits,proto3" json:"visits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClinicOverrideResponse) Reset() {
-	*x = GetClinicOverrideResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[56]
+func (x *DoctorAvgVisitResponse) Reset() {
+	*x = DoctorAvgVisitResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClinicOverrideResponse) String() string {
+func (x *DoctorAvgVisitResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClinicOverrideResponse) ProtoMessage() {}
+func (*DoctorAvgVisitResponse) ProtoMessage() {}
 
-func (x *GetClinicOverrideResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[56]
+func (x *DoctorAvgVisitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3377,62 +11790,41 @@ func (x *GetClinicOverrideResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClinicOverrideResponse.ProtoReflect.Descriptor instead.
-func (*GetClinicOverrideResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{56}
-}
-
-func (x *GetClinicOverrideResponse) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-func (x *GetClinicOverrideResponse) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+// Deprecated: Use DoctorAvgVisitResponse.ProtoReflect.Descriptor instead.
+func (*DoctorAvgVisitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *GetClinicOverrideResponse) GetEndTime() *timestamppb.Timestamp {
+func (x *DoctorAvgVisitResponse) GetVisits() []*DoctorAvgVisit {
 	if x != nil {
-		return x.EndTime
+		return x.Visits
 	}
 	return nil
 }
 
-func (x *GetClinicOverrideResponse) GetIsDayOff() bool {
-	if x != nil {
-		return x.IsDayOff
-	}
-	return false
-}
-
-type GetDoctorOverrideRequest struct {
+type DoctorCheck struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DoctorId      int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	AvgCheck      float32                `protobuf:"fixed32,2,opt,name=avg_check,json=avgCheck,proto3" json:"avg_check,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDoctorOverrideRequest) Reset() {
-	*x = GetDoctorOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[57]
+func (x *DoctorCheck) Reset() {
+	*x = DoctorCheck{}
+	mi := &file_proto_storage_storage_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDoctorOverrideRequest) String() string {
+func (x *DoctorCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDoctorOverrideRequest) ProtoMessage() {}
+func (*DoctorCheck) ProtoMessage() {}
 
-func (x *GetDoctorOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[57]
+func (x *DoctorCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3443,51 +11835,47 @@ func (x *GetDoctorOverrideRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDoctorOverrideRequest.ProtoReflect.Descriptor instead.
-func (*GetDoctorOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use DoctorCheck.ProtoReflect.Descriptor instead.
+func (*DoctorCheck) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *GetDoctorOverrideRequest) GetDoctorId() int32 {
+func (x *DoctorCheck) GetDoctorId() int32 {
 	if x != nil {
 		return x.DoctorId
 	}
 	return 0
 }
 
-func (x *GetDoctorOverrideRequest) GetDate() *timestamppb.Timestamp {
+func (x *DoctorCheck) GetAvgCheck() float32 {
 	if x != nil {
-		return x.Date
+		return x.AvgCheck
 	}
-	return nil
+	return 0
 }
 
-type GetDoctorOverrideResponse struct {
+type DoctorAvgCheckResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DoctorId      int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	IsDayOff      bool                   `protobuf:"varint,5,opt,name=is_day_off,json=isDayOff,proto3" json:"is_day_off,omitempty"`
+	Check         []*DoctorCheck         `protobuf:"bytes,1,rep,name=check,proto3" json:"check,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDoctorOverrideResponse) Reset() {
-	*x = GetDoctorOverrideResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[58]
+func (x *DoctorAvgCheckResponse) Reset() {
+	*x = DoctorAvgCheckResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDoctorOverrideResponse) String() string {
+func (x *DoctorAvgCheckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDoctorOverrideResponse) ProtoMessage() {}
+func (*DoctorAvgCheckResponse) ProtoMessage() {}
 
-func (x *GetDoctorOverrideResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[58]
+func (x *DoctorAvgCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3498,73 +11886,41 @@ func (x *GetDoctorOverrideResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDoctorOverrideResponse.ProtoReflect.Descriptor instead.
-func (*GetDoctorOverrideResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *GetDoctorOverrideResponse) GetDoctorId() int32 {
-	if x != nil {
-		return x.DoctorId
-	}
-	return 0
-}
-
-func (x *GetDoctorOverrideResponse) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-func (x *GetDoctorOverrideResponse) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+// Deprecated: Use DoctorAvgCheckResponse.ProtoReflect.Descriptor instead.
+func (*DoctorAvgCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *GetDoctorOverrideResponse) GetEndTime() *timestamppb.Timestamp {
+func (x *DoctorAvgCheckResponse) GetCheck() []*DoctorCheck {
 	if x != nil {
-		return x.EndTime
+		return x.Check
 	}
 	return nil
 }
 
-func (x *GetDoctorOverrideResponse) GetIsDayOff() bool {
-	if x != nil {
-		return x.IsDayOff
-	}
-	return false
-}
-
-type DoctorOverride struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	DoctorId            int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-	Date                *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	StartTime           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	SlotDurationMinutes int32                  `protobuf:"varint,5,opt,name=slot_duration_minutes,json=slotDurationMinutes,proto3" json:"slot_duration_minutes,omitempty"`
-	IsDayOff            bool                   `protobuf:"varint,6,opt,name=is_day_off,json=isDayOff,proto3" json:"is_day_off,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type DoctorUniquePatient struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DoctorId       int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	UniquePatients int32                  `protobuf:"varint,2,opt,name=unique_patients,json=uniquePatients,proto3" json:"unique_patients,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DoctorOverride) Reset() {
-	*x = DoctorOverride{}
-	mi := &file_proto_storage_storage_proto_msgTypes[59]
+func (x *DoctorUniquePatient) Reset() {
+	*x = DoctorUniquePatient{}
+	mi := &file_proto_storage_storage_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DoctorOverride) String() string {
+func (x *DoctorUniquePatient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DoctorOverride) ProtoMessage() {}
+func (*DoctorUniquePatient) ProtoMessage() {}
 
-func (x *DoctorOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[59]
+func (x *DoctorUniquePatient) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3572,78 +11928,50 @@ func (x *DoctorOverride) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use DoctorOverride.ProtoReflect.Descriptor instead.
-func (*DoctorOverride) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *DoctorOverride) GetDoctorId() int32 {
-	if x != nil {
-		return x.DoctorId
-	}
-	return 0
-}
-
-func (x *DoctorOverride) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-func (x *DoctorOverride) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DoctorOverride) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
+// Deprecated: Use DoctorUniquePatient.ProtoReflect.Descriptor instead.
+func (*DoctorUniquePatient) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *DoctorOverride) GetSlotDurationMinutes() int32 {
+func (x *DoctorUniquePatient) GetDoctorId() int32 {
 	if x != nil {
-		return x.SlotDurationMinutes
+		return x.DoctorId
 	}
 	return 0
 }
 
-func (x *DoctorOverride) GetIsDayOff() bool {
+func (x *DoctorUniquePatient) GetUniquePatients() int32 {
 	if x != nil {
-		return x.IsDayOff
+		return x.UniquePatients
 	}
-	return false
+	return 0
 }
 
-type GetDoctorOverridesResponse struct {
+type DoctorUniquePatientResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Override      []*DoctorOverride      `protobuf:"bytes,1,rep,name=override,proto3" json:"override,omitempty"`
+	Patients      []*DoctorUniquePatient `protobuf:"bytes,1,rep,name=patients,proto3" json:"patients,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDoctorOverridesResponse) Reset() {
-	*x = GetDoctorOverridesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[60]
+func (x *DoctorUniquePatientResponse) Reset() {
+	*x = DoctorUniquePatientResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDoctorOverridesResponse) String() string {
+func (x *DoctorUniquePatientResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDoctorOverridesResponse) ProtoMessage() {}
+func (*DoctorUniquePatientResponse) ProtoMessage() {}
 
-func (x *GetDoctorOverridesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[60]
+func (x *DoctorUniquePatientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3654,41 +11982,41 @@ func (x *GetDoctorOverridesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDoctorOverridesResponse.ProtoReflect.Descriptor instead.
-func (*GetDoctorOverridesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use DoctorUniquePatientResponse.ProtoReflect.Descriptor instead.
+func (*DoctorUniquePatientResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *GetDoctorOverridesResponse) GetOverride() []*DoctorOverride {
+func (x *DoctorUniquePatientResponse) GetPatients() []*DoctorUniquePatient {
 	if x != nil {
-		return x.Override
+		return x.Patients
 	}
 	return nil
 }
 
-type AddMaterialRequest struct {
+type AgeGroupStat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	AgeGroup      int32                  `protobuf:"varint,1,opt,name=age_group,json=ageGroup,proto3" json:"age_group,omitempty"`
+	Percent       float32                `protobuf:"fixed32,2,opt,name=percent,proto3" json:"percent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddMaterialRequest) Reset() {
-	*x = AddMaterialRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[61]
+func (x *AgeGroupStat) Reset() {
+	*x = AgeGroupStat{}
+	mi := &file_proto_storage_storage_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddMaterialRequest) String() string {
+func (x *AgeGroupStat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddMaterialRequest) ProtoMessage() {}
+func (*AgeGroupStat) ProtoMessage() {}
 
-func (x *AddMaterialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[61]
+func (x *AgeGroupStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3699,49 +12027,47 @@ func (x *AddMaterialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddMaterialRequest.ProtoReflect.Descriptor instead.
-func (*AddMaterialRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use AgeGroupStat.ProtoReflect.Descriptor instead.
+func (*AgeGroupStat) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *AddMaterialRequest) GetName() string {
+func (x *AgeGroupStat) GetAgeGroup() int32 {
 	if x != nil {
-		return x.Name
+		return x.AgeGroup
 	}
-	return ""
+	return 0
 }
 
-func (x *AddMaterialRequest) GetPrice() int32 {
+func (x *AgeGroupStat) GetPercent() float32 {
 	if x != nil {
-		return x.Price
+		return x.Percent
 	}
 	return 0
 }
 
-type AddServiceRequest struct {
+type AgeGroupStatResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
-	Type          int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	AgeGroups     []*AgeGroupStat        `protobuf:"bytes,1,rep,name=age_groups,json=ageGroups,proto3" json:"age_groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddServiceRequest) Reset() {
-	*x = AddServiceRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[62]
+func (x *AgeGroupStatResponse) Reset() {
+	*x = AgeGroupStatResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddServiceRequest) String() string {
+func (x *AgeGroupStatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddServiceRequest) ProtoMessage() {}
+func (*AgeGroupStatResponse) ProtoMessage() {}
 
-func (x *AddServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[62]
+func (x *AgeGroupStatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3752,56 +12078,40 @@ func (x *AddServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddServiceRequest.ProtoReflect.Descriptor instead.
-func (*AddServiceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{62}
-}
-
-func (x *AddServiceRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *AddServiceRequest) GetPrice() int32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+// Deprecated: Use AgeGroupStatResponse.ProtoReflect.Descriptor instead.
+func (*AgeGroupStatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *AddServiceRequest) GetType() int32 {
+func (x *AgeGroupStatResponse) GetAgeGroups() []*AgeGroupStat {
 	if x != nil {
-		return x.Type
+		return x.AgeGroups
 	}
-	return 0
+	return nil
 }
 
-type UpdateMaterialRequest struct {
+type GetDiagnoseByVisitIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Diagnose      []*Diagnose            `protobuf:"bytes,1,rep,name=diagnose,proto3" json:"diagnose,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateMaterialRequest) Reset() {
-	*x = UpdateMaterialRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[63]
+func (x *GetDiagnoseByVisitIDResponse) Reset() {
+	*x = GetDiagnoseByVisitIDResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMaterialRequest) String() string {
+func (x *GetDiagnoseByVisitIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMaterialRequest) ProtoMessage() {}
+func (*GetDiagnoseByVisitIDResponse) ProtoMessage() {}
 
-func (x *UpdateMaterialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[63]
+func (x *GetDiagnoseByVisitIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3812,57 +12122,48 @@ func (x *UpdateMaterialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMaterialRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMaterialRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *UpdateMaterialRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdateMaterialRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GetDiagnoseByVisitIDResponse.ProtoReflect.Descriptor instead.
+func (*GetDiagnoseByVisitIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *UpdateMaterialRequest) GetPrice() int32 {
+func (x *GetDiagnoseByVisitIDResponse) GetDiagnose() []*Diagnose {
 	if x != nil {
-		return x.Price
+		return x.Diagnose
 	}
-	return 0
+	return nil
 }
 
-type UpdateServiceRequest struct {
+// Загрузка документа частями: StartDocumentUpload создаёт загрузку, UploadDocument принимает поток частей.
+// Если поток оборвался, загрузку продолжают с received из GetDocumentUploadStatus
+type StartDocumentUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	Type          int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	PatientId     string                 `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"` // полный размер файла в байтах
+	Modality      string                 `protobuf:"bytes,4,opt,name=modality,proto3" json:"modality,omitempty"`
+	StudyDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=study_date,json=studyDate,proto3" json:"study_date,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	PreviewJpeg   []byte                 `protobuf:"bytes,7,opt,name=preview_jpeg,json=previewJpeg,proto3" json:"preview_jpeg,omitempty"` // превью небольшое и передаётся целиком
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateServiceRequest) Reset() {
-	*x = UpdateServiceRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[64]
+func (x *StartDocumentUploadRequest) Reset() {
+	*x = StartDocumentUploadRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateServiceRequest) String() string {
+func (x *StartDocumentUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateServiceRequest) ProtoMessage() {}
+func (*StartDocumentUploadRequest) ProtoMessage() {}
 
-func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[64]
+func (x *StartDocumentUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3873,63 +12174,82 @@ func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateServiceRequest.ProtoReflect.Descriptor instead.
-func (*UpdateServiceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use StartDocumentUploadRequest.ProtoReflect.Descriptor instead.
+func (*StartDocumentUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *UpdateServiceRequest) GetId() int32 {
+func (x *StartDocumentUploadRequest) GetPatientId() string {
 	if x != nil {
-		return x.Id
+		return x.PatientId
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateServiceRequest) GetName() string {
+func (x *StartDocumentUploadRequest) GetFileName() string {
 	if x != nil {
-		return x.Name
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *UpdateServiceRequest) GetPrice() int32 {
+func (x *StartDocumentUploadRequest) GetSize() int64 {
 	if x != nil {
-		return x.Price
+		return x.Size
 	}
 	return 0
 }
 
-func (x *UpdateServiceRequest) GetType() int32 {
+func (x *StartDocumentUploadRequest) GetModality() string {
 	if x != nil {
-		return x.Type
+		return x.Modality
 	}
-	return 0
+	return ""
 }
 
-type Material struct {
+func (x *StartDocumentUploadRequest) GetStudyDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StudyDate
+	}
+	return nil
+}
+
+func (x *StartDocumentUploadRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *StartDocumentUploadRequest) GetPreviewJpeg() []byte {
+	if x != nil {
+		return x.PreviewJpeg
+	}
+	return nil
+}
+
+type StartDocumentUploadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Material) Reset() {
-	*x = Material{}
-	mi := &file_proto_storage_storage_proto_msgTypes[65]
+func (x *StartDocumentUploadResponse) Reset() {
+	*x = StartDocumentUploadResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Material) String() string {
+func (x *StartDocumentUploadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Material) ProtoMessage() {}
+func (*StartDocumentUploadResponse) ProtoMessage() {}
 
-func (x *Material) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[65]
+func (x *StartDocumentUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3940,57 +12260,42 @@ func (x *Material) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Material.ProtoReflect.Descriptor instead.
-func (*Material) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{65}
-}
-
-func (x *Material) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use StartDocumentUploadResponse.ProtoReflect.Descriptor instead.
+func (*StartDocumentUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *Material) GetName() string {
+func (x *StartDocumentUploadResponse) GetUploadId() string {
 	if x != nil {
-		return x.Name
+		return x.UploadId
 	}
 	return ""
 }
 
-func (x *Material) GetPrice() int32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-type Service struct {
+type UploadDocumentChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	Type          int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // смещение data в файле; должно совпадать с уже принятым объёмом
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Service) Reset() {
-	*x = Service{}
-	mi := &file_proto_storage_storage_proto_msgTypes[66]
+func (x *UploadDocumentChunk) Reset() {
+	*x = UploadDocumentChunk{}
+	mi := &file_proto_storage_storage_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Service) String() string {
+func (x *UploadDocumentChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Service) ProtoMessage() {}
+func (*UploadDocumentChunk) ProtoMessage() {}
 
-func (x *Service) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[66]
+func (x *UploadDocumentChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4001,61 +12306,100 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Service.ProtoReflect.Descriptor instead.
-func (*Service) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use UploadDocumentChunk.ProtoReflect.Descriptor instead.
+func (*UploadDocumentChunk) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *Service) GetId() int32 {
+func (x *UploadDocumentChunk) GetUploadId() string {
 	if x != nil {
-		return x.Id
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadDocumentChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *Service) GetName() string {
+func (x *UploadDocumentChunk) GetData() []byte {
 	if x != nil {
-		return x.Name
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *Service) GetPrice() int32 {
+type DocumentUploadStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UploadId      string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DocumentUploadStatusRequest) Reset() {
+	*x = DocumentUploadStatusRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentUploadStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentUploadStatusRequest) ProtoMessage() {}
+
+func (x *DocumentUploadStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[198]
 	if x != nil {
-		return x.Price
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Service) GetType() int32 {
+// Deprecated: Use DocumentUploadStatusRequest.ProtoReflect.Descriptor instead.
+func (*DocumentUploadStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *DocumentUploadStatusRequest) GetUploadId() string {
 	if x != nil {
-		return x.Type
+		return x.UploadId
 	}
-	return 0
+	return ""
 }
 
-type GetMaterialsResponse struct {
+type DocumentUploadStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Materials     []*Material            `protobuf:"bytes,1,rep,name=materials,proto3" json:"materials,omitempty"`
+	Received      int64                  `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"` // сколько байт уже принято
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,3,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"` // заполнен, когда файл принят целиком и документ сохранён
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMaterialsResponse) Reset() {
-	*x = GetMaterialsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[67]
+func (x *DocumentUploadStatus) Reset() {
+	*x = DocumentUploadStatus{}
+	mi := &file_proto_storage_storage_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMaterialsResponse) String() string {
+func (x *DocumentUploadStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMaterialsResponse) ProtoMessage() {}
+func (*DocumentUploadStatus) ProtoMessage() {}
 
-func (x *GetMaterialsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[67]
+func (x *DocumentUploadStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4066,40 +12410,54 @@ func (x *GetMaterialsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMaterialsResponse.ProtoReflect.Descriptor instead.
-func (*GetMaterialsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use DocumentUploadStatus.ProtoReflect.Descriptor instead.
+func (*DocumentUploadStatus) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *DocumentUploadStatus) GetReceived() int64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *DocumentUploadStatus) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
 
-func (x *GetMaterialsResponse) GetMaterials() []*Material {
+func (x *DocumentUploadStatus) GetDocumentId() string {
 	if x != nil {
-		return x.Materials
+		return x.DocumentId
 	}
-	return nil
+	return ""
 }
 
-type GetServicesResponse struct {
+type GetDocumentMetadataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Services      []*Service             `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetServicesResponse) Reset() {
-	*x = GetServicesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[68]
+func (x *GetDocumentMetadataRequest) Reset() {
+	*x = GetDocumentMetadataRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetServicesResponse) String() string {
+func (x *GetDocumentMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServicesResponse) ProtoMessage() {}
+func (*GetDocumentMetadataRequest) ProtoMessage() {}
 
-func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[68]
+func (x *GetDocumentMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4110,40 +12468,48 @@ func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServicesResponse.ProtoReflect.Descriptor instead.
-func (*GetServicesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use GetDocumentMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *GetServicesResponse) GetServices() []*Service {
+func (x *GetDocumentMetadataRequest) GetDocumentId() string {
 	if x != nil {
-		return x.Services
+		return x.DocumentId
 	}
-	return nil
+	return ""
 }
 
-type GetByNameRequest struct {
+type GetDocumentMetadataResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	PatientId     string                 `protobuf:"bytes,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Modality      string                 `protobuf:"bytes,4,opt,name=modality,proto3" json:"modality,omitempty"`
+	StudyDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=study_date,json=studyDate,proto3" json:"study_date,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	StoragePath   string                 `protobuf:"bytes,7,opt,name=storage_path,json=storagePath,proto3" json:"storage_path,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PreviewPath   string                 `protobuf:"bytes,9,opt,name=preview_path,json=previewPath,proto3" json:"preview_path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByNameRequest) Reset() {
-	*x = GetByNameRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[69]
+func (x *GetDocumentMetadataResponse) Reset() {
+	*x = GetDocumentMetadataResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByNameRequest) String() string {
+func (x *GetDocumentMetadataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByNameRequest) ProtoMessage() {}
+func (*GetDocumentMetadataResponse) ProtoMessage() {}
 
-func (x *GetByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[69]
+func (x *GetDocumentMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4154,86 +12520,97 @@ func (x *GetByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetByNameRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GetDocumentMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetDocumentMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *GetByNameRequest) GetName() string {
+func (x *GetDocumentMetadataResponse) GetDocumentId() string {
 	if x != nil {
-		return x.Name
+		return x.DocumentId
 	}
 	return ""
 }
 
-type GetByIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetDocumentMetadataResponse) GetPatientId() string {
+	if x != nil {
+		return x.PatientId
+	}
+	return ""
 }
 
-func (x *GetByIdRequest) Reset() {
-	*x = GetByIdRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[70]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetDocumentMetadataResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
 }
 
-func (x *GetByIdRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetDocumentMetadataResponse) GetModality() string {
+	if x != nil {
+		return x.Modality
+	}
+	return ""
 }
 
-func (*GetByIdRequest) ProtoMessage() {}
+func (x *GetDocumentMetadataResponse) GetStudyDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StudyDate
+	}
+	return nil
+}
 
-func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[70]
+func (x *GetDocumentMetadataResponse) GetDescription() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Description
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetByIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{70}
+func (x *GetDocumentMetadataResponse) GetStoragePath() string {
+	if x != nil {
+		return x.StoragePath
+	}
+	return ""
 }
 
-func (x *GetByIdRequest) GetId() int32 {
+func (x *GetDocumentMetadataResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-type GetMaterialByNameResponse struct {
+func (x *GetDocumentMetadataResponse) GetPreviewPath() string {
+	if x != nil {
+		return x.PreviewPath
+	}
+	return ""
+}
+
+type DownloadDocumentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // с какого байта продолжить скачивание
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMaterialByNameResponse) Reset() {
-	*x = GetMaterialByNameResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[71]
+func (x *DownloadDocumentRequest) Reset() {
+	*x = DownloadDocumentRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMaterialByNameResponse) String() string {
+func (x *DownloadDocumentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMaterialByNameResponse) ProtoMessage() {}
+func (*DownloadDocumentRequest) ProtoMessage() {}
 
-func (x *GetMaterialByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[71]
+func (x *DownloadDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4244,57 +12621,51 @@ func (x *GetMaterialByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMaterialByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetMaterialByNameResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *GetMaterialByNameResponse) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use DownloadDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *GetMaterialByNameResponse) GetName() string {
+func (x *DownloadDocumentRequest) GetDocumentId() string {
 	if x != nil {
-		return x.Name
+		return x.DocumentId
 	}
 	return ""
 }
 
-func (x *GetMaterialByNameResponse) GetPrice() int32 {
+func (x *DownloadDocumentRequest) GetOffset() int64 {
 	if x != nil {
-		return x.Price
+		return x.Offset
 	}
 	return 0
 }
 
-type GetServiceByNameResponse struct {
+// Часть файла; file_name, size и checksum заполнены только в первом сообщении потока
+type DownloadDocumentChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	Type          int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`        // полный размер файла
+	Checksum      string                 `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"` // SHA-256 содержимого в hex, пусто у ещё не перенесённых документов
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetServiceByNameResponse) Reset() {
-	*x = GetServiceByNameResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[72]
+func (x *DownloadDocumentChunk) Reset() {
+	*x = DownloadDocumentChunk{}
+	mi := &file_proto_storage_storage_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetServiceByNameResponse) String() string {
+func (x *DownloadDocumentChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceByNameResponse) ProtoMessage() {}
+func (*DownloadDocumentChunk) ProtoMessage() {}
 
-func (x *GetServiceByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[72]
+func (x *DownloadDocumentChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4305,61 +12676,61 @@ func (x *GetServiceByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetServiceByNameResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use DownloadDocumentChunk.ProtoReflect.Descriptor instead.
+func (*DownloadDocumentChunk) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *GetServiceByNameResponse) GetId() int32 {
+func (x *DownloadDocumentChunk) GetFileName() string {
 	if x != nil {
-		return x.Id
+		return x.FileName
 	}
-	return 0
+	return ""
 }
 
-func (x *GetServiceByNameResponse) GetName() string {
+func (x *DownloadDocumentChunk) GetSize() int64 {
 	if x != nil {
-		return x.Name
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *GetServiceByNameResponse) GetPrice() int32 {
+func (x *DownloadDocumentChunk) GetChecksum() string {
 	if x != nil {
-		return x.Price
+		return x.Checksum
 	}
-	return 0
+	return ""
 }
 
-func (x *GetServiceByNameResponse) GetType() int32 {
+func (x *DownloadDocumentChunk) GetData() []byte {
 	if x != nil {
-		return x.Type
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-type DeleteRequest struct {
+type GetDocumentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId     string                 `protobuf:"bytes,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRequest) Reset() {
-	*x = DeleteRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[73]
+func (x *GetDocumentsRequest) Reset() {
+	*x = GetDocumentsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRequest) String() string {
+func (x *GetDocumentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRequest) ProtoMessage() {}
+func (*GetDocumentsRequest) ProtoMessage() {}
 
-func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[73]
+func (x *GetDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4370,41 +12741,45 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use GetDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *DeleteRequest) GetId() int32 {
+func (x *GetDocumentsRequest) GetPatientId() string {
 	if x != nil {
-		return x.Id
+		return x.PatientId
 	}
-	return 0
+	return ""
 }
 
-type ServiceType struct {
+type DocumentInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Modality      string                 `protobuf:"bytes,4,opt,name=modality,proto3" json:"modality,omitempty"`
+	StudyDate     string                 `protobuf:"bytes,5,opt,name=study_date,json=studyDate,proto3" json:"study_date,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceType) Reset() {
-	*x = ServiceType{}
-	mi := &file_proto_storage_storage_proto_msgTypes[74]
+func (x *DocumentInfo) Reset() {
+	*x = DocumentInfo{}
+	mi := &file_proto_storage_storage_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceType) String() string {
+func (x *DocumentInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceType) ProtoMessage() {}
+func (*DocumentInfo) ProtoMessage() {}
 
-func (x *ServiceType) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[74]
+func (x *DocumentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4415,91 +12790,75 @@ func (x *ServiceType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceType.ProtoReflect.Descriptor instead.
-func (*ServiceType) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use DocumentInfo.ProtoReflect.Descriptor instead.
+func (*DocumentInfo) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *ServiceType) GetId() int32 {
+func (x *DocumentInfo) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ServiceType) GetName() string {
+func (x *DocumentInfo) GetFileName() string {
 	if x != nil {
-		return x.Name
+		return x.FileName
 	}
 	return ""
 }
 
-type GetServicesTypesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Types         []*ServiceType         `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetServicesTypesResponse) Reset() {
-	*x = GetServicesTypesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[75]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetServicesTypesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DocumentInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*GetServicesTypesResponse) ProtoMessage() {}
-
-func (x *GetServicesTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[75]
+func (x *DocumentInfo) GetModality() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Modality
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetServicesTypesResponse.ProtoReflect.Descriptor instead.
-func (*GetServicesTypesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{75}
+func (x *DocumentInfo) GetStudyDate() string {
+	if x != nil {
+		return x.StudyDate
+	}
+	return ""
 }
 
-func (x *GetServicesTypesResponse) GetTypes() []*ServiceType {
+func (x *DocumentInfo) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Types
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type GetServiceTypeByIdRequest struct {
+type GetDocumentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Documents     []*DocumentInfo        `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetServiceTypeByIdRequest) Reset() {
-	*x = GetServiceTypeByIdRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[76]
+func (x *GetDocumentsResponse) Reset() {
+	*x = GetDocumentsResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetServiceTypeByIdRequest) String() string {
+func (x *GetDocumentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceTypeByIdRequest) ProtoMessage() {}
+func (*GetDocumentsResponse) ProtoMessage() {}
 
-func (x *GetServiceTypeByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[76]
+func (x *GetDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4510,41 +12869,40 @@ func (x *GetServiceTypeByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceTypeByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetServiceTypeByIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use GetDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*GetDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *GetServiceTypeByIdRequest) GetId() int32 {
+func (x *GetDocumentsResponse) GetDocuments() []*DocumentInfo {
 	if x != nil {
-		return x.Id
+		return x.Documents
 	}
-	return 0
+	return nil
 }
 
-type GetServiceTypeByIdResponse struct {
+type GetAdminByIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Admin         *Admin                 `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetServiceTypeByIdResponse) Reset() {
-	*x = GetServiceTypeByIdResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[77]
+func (x *GetAdminByIDResponse) Reset() {
+	*x = GetAdminByIDResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetServiceTypeByIdResponse) String() string {
+func (x *GetAdminByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceTypeByIdResponse) ProtoMessage() {}
+func (*GetAdminByIDResponse) ProtoMessage() {}
 
-func (x *GetServiceTypeByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[77]
+func (x *GetAdminByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4555,48 +12913,40 @@ func (x *GetServiceTypeByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceTypeByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetServiceTypeByIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{77}
-}
-
-func (x *GetServiceTypeByIdResponse) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use GetAdminByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetAdminByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *GetServiceTypeByIdResponse) GetName() string {
+func (x *GetAdminByIDResponse) GetAdmin() *Admin {
 	if x != nil {
-		return x.Name
+		return x.Admin
 	}
-	return ""
+	return nil
 }
 
-type UpdateUserLoginRequest struct {
+type ClinicSettings struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // часовой пояс клиники в формате IANA, например Europe/Moscow
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserLoginRequest) Reset() {
-	*x = UpdateUserLoginRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[78]
+func (x *ClinicSettings) Reset() {
+	*x = ClinicSettings{}
+	mi := &file_proto_storage_storage_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserLoginRequest) String() string {
+func (x *ClinicSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserLoginRequest) ProtoMessage() {}
+func (*ClinicSettings) ProtoMessage() {}
 
-func (x *UpdateUserLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[78]
+func (x *ClinicSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4607,49 +12957,40 @@ func (x *UpdateUserLoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserLoginRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserLoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{78}
-}
-
-func (x *UpdateUserLoginRequest) GetUserId() int32 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
+// Deprecated: Use ClinicSettings.ProtoReflect.Descriptor instead.
+func (*ClinicSettings) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *UpdateUserLoginRequest) GetLogin() string {
+func (x *ClinicSettings) GetTimeZone() string {
 	if x != nil {
-		return x.Login
+		return x.TimeZone
 	}
 	return ""
 }
 
-type ICDCode struct {
+type UpdateClinicTimeZoneRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ICDCode) Reset() {
-	*x = ICDCode{}
-	mi := &file_proto_storage_storage_proto_msgTypes[79]
+func (x *UpdateClinicTimeZoneRequest) Reset() {
+	*x = UpdateClinicTimeZoneRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ICDCode) String() string {
+func (x *UpdateClinicTimeZoneRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ICDCode) ProtoMessage() {}
+func (*UpdateClinicTimeZoneRequest) ProtoMessage() {}
 
-func (x *ICDCode) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[79]
+func (x *UpdateClinicTimeZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4660,57 +13001,48 @@ func (x *ICDCode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ICDCode.ProtoReflect.Descriptor instead.
-func (*ICDCode) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{79}
-}
-
-func (x *ICDCode) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ICDCode) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
+// Deprecated: Use UpdateClinicTimeZoneRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClinicTimeZoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *ICDCode) GetName() string {
+func (x *UpdateClinicTimeZoneRequest) GetTimeZone() string {
 	if x != nil {
-		return x.Name
+		return x.TimeZone
 	}
 	return ""
 }
 
-type Diagnose struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	VisitId       int32                  `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	IcdCodeId     int32                  `protobuf:"varint,3,opt,name=icd_code_id,json=icdCodeId,proto3" json:"icd_code_id,omitempty"`
-	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Diagnose) Reset() {
-	*x = Diagnose{}
-	mi := &file_proto_storage_storage_proto_msgTypes[80]
+// запись пациента в листе ожидания: задаётся врач или специальность (тогда подходит любой её врач)
+type WaitingListEntry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId        int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId         int32                  `protobuf:"varint,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	SpecializationId int32                  `protobuf:"varint,4,opt,name=specialization_id,json=specializationId,proto3" json:"specialization_id,omitempty"`
+	DateFrom         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WaitingListEntry) Reset() {
+	*x = WaitingListEntry{}
+	mi := &file_proto_storage_storage_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Diagnose) String() string {
+func (x *WaitingListEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Diagnose) ProtoMessage() {}
+func (*WaitingListEntry) ProtoMessage() {}
 
-func (x *Diagnose) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[80]
+func (x *WaitingListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4721,67 +13053,97 @@ func (x *Diagnose) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Diagnose.ProtoReflect.Descriptor instead.
-func (*Diagnose) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use WaitingListEntry.ProtoReflect.Descriptor instead.
+func (*WaitingListEntry) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *Diagnose) GetId() int32 {
+func (x *WaitingListEntry) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Diagnose) GetVisitId() int32 {
+func (x *WaitingListEntry) GetPatientId() int32 {
 	if x != nil {
-		return x.VisitId
+		return x.PatientId
 	}
 	return 0
 }
 
-func (x *Diagnose) GetIcdCodeId() int32 {
+func (x *WaitingListEntry) GetDoctorId() int32 {
 	if x != nil {
-		return x.IcdCodeId
+		return x.DoctorId
 	}
 	return 0
 }
 
-func (x *Diagnose) GetNote() string {
+func (x *WaitingListEntry) GetSpecializationId() int32 {
 	if x != nil {
-		return x.Note
+		return x.SpecializationId
+	}
+	return 0
+}
+
+func (x *WaitingListEntry) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *WaitingListEntry) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *WaitingListEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-type Visit struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AppointmentId int32                  `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
-	PatientId     int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
-	DoctorId      int32                  `protobuf:"varint,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-	Complaints    string                 `protobuf:"bytes,5,opt,name=complaints,proto3" json:"complaints,omitempty"`
-	Treatment     string                 `protobuf:"bytes,6,opt,name=treatment,proto3" json:"treatment,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WaitingListEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *Visit) Reset() {
-	*x = Visit{}
-	mi := &file_proto_storage_storage_proto_msgTypes[81]
+// предложение освободившегося слота пациенту из листа ожидания
+type WaitingListOffer struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntryId         int32                  `protobuf:"varint,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	DoctorId        int32                  `protobuf:"varint,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Date            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,6,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WaitingListOffer) Reset() {
+	*x = WaitingListOffer{}
+	mi := &file_proto_storage_storage_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Visit) String() string {
+func (x *WaitingListOffer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Visit) ProtoMessage() {}
+func (*WaitingListOffer) ProtoMessage() {}
 
-func (x *Visit) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[81]
+func (x *WaitingListOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4792,85 +13154,89 @@ func (x *Visit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Visit.ProtoReflect.Descriptor instead.
-func (*Visit) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use WaitingListOffer.ProtoReflect.Descriptor instead.
+func (*WaitingListOffer) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *Visit) GetId() int32 {
+func (x *WaitingListOffer) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *Visit) GetAppointmentId() int32 {
+func (x *WaitingListOffer) GetEntryId() int32 {
 	if x != nil {
-		return x.AppointmentId
+		return x.EntryId
 	}
 	return 0
 }
 
-func (x *Visit) GetPatientId() int32 {
+func (x *WaitingListOffer) GetDoctorId() int32 {
 	if x != nil {
-		return x.PatientId
+		return x.DoctorId
 	}
 	return 0
 }
 
-func (x *Visit) GetDoctorId() int32 {
+func (x *WaitingListOffer) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DoctorId
+		return x.Date
 	}
-	return 0
+	return nil
 }
 
-func (x *Visit) GetComplaints() string {
+func (x *WaitingListOffer) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Complaints
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-func (x *Visit) GetTreatment() string {
+func (x *WaitingListOffer) GetDurationMinutes() int32 {
 	if x != nil {
-		return x.Treatment
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *WaitingListOffer) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *Visit) GetCreatedAt() *timestamppb.Timestamp {
+func (x *WaitingListOffer) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type PatientAllergiesChronics struct {
+type AddWaitingListEntryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Entry         *WaitingListEntry      `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PatientAllergiesChronics) Reset() {
-	*x = PatientAllergiesChronics{}
-	mi := &file_proto_storage_storage_proto_msgTypes[82]
+func (x *AddWaitingListEntryRequest) Reset() {
+	*x = AddWaitingListEntryRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PatientAllergiesChronics) String() string {
+func (x *AddWaitingListEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PatientAllergiesChronics) ProtoMessage() {}
+func (*AddWaitingListEntryRequest) ProtoMessage() {}
 
-func (x *PatientAllergiesChronics) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[82]
+func (x *AddWaitingListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4881,63 +13247,40 @@ func (x *PatientAllergiesChronics) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PatientAllergiesChronics.ProtoReflect.Descriptor instead.
-func (*PatientAllergiesChronics) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{82}
-}
-
-func (x *PatientAllergiesChronics) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *PatientAllergiesChronics) GetPatientId() int32 {
-	if x != nil {
-		return x.PatientId
-	}
-	return 0
-}
-
-func (x *PatientAllergiesChronics) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+// Deprecated: Use AddWaitingListEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddWaitingListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *PatientAllergiesChronics) GetTitle() string {
+func (x *AddWaitingListEntryRequest) GetEntry() *WaitingListEntry {
 	if x != nil {
-		return x.Title
+		return x.Entry
 	}
-	return ""
+	return nil
 }
 
-type AddVisitMaterials struct {
+type AddWaitingListEntryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	MaterialId    int32                  `protobuf:"varint,2,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
-	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddVisitMaterials) Reset() {
-	*x = AddVisitMaterials{}
-	mi := &file_proto_storage_storage_proto_msgTypes[83]
+func (x *AddWaitingListEntryResponse) Reset() {
+	*x = AddWaitingListEntryResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddVisitMaterials) String() string {
+func (x *AddWaitingListEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddVisitMaterials) ProtoMessage() {}
+func (*AddWaitingListEntryResponse) ProtoMessage() {}
 
-func (x *AddVisitMaterials) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[83]
+func (x *AddWaitingListEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4948,54 +13291,41 @@ func (x *AddVisitMaterials) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddVisitMaterials.ProtoReflect.Descriptor instead.
-func (*AddVisitMaterials) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{83}
-}
-
-func (x *AddVisitMaterials) GetVisitId() int32 {
-	if x != nil {
-		return x.VisitId
-	}
-	return 0
-}
-
-func (x *AddVisitMaterials) GetMaterialId() int32 {
-	if x != nil {
-		return x.MaterialId
-	}
-	return 0
+// Deprecated: Use AddWaitingListEntryResponse.ProtoReflect.Descriptor instead.
+func (*AddWaitingListEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *AddVisitMaterials) GetAmount() int32 {
+func (x *AddWaitingListEntryResponse) GetId() int32 {
 	if x != nil {
-		return x.Amount
+		return x.Id
 	}
 	return 0
 }
 
-type AddVisitMaterialsRequest struct {
+type GetWaitingListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Materials     []*AddVisitMaterials   `protobuf:"bytes,1,rep,name=materials,proto3" json:"materials,omitempty"`
+	Entries       []*WaitingListEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Offers        []*WaitingListOffer    `protobuf:"bytes,2,rep,name=offers,proto3" json:"offers,omitempty"` // действующие предложения слотов
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddVisitMaterialsRequest) Reset() {
-	*x = AddVisitMaterialsRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[84]
+func (x *GetWaitingListResponse) Reset() {
+	*x = GetWaitingListResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddVisitMaterialsRequest) String() string {
+func (x *GetWaitingListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddVisitMaterialsRequest) ProtoMessage() {}
+func (*GetWaitingListResponse) ProtoMessage() {}
 
-func (x *AddVisitMaterialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[84]
+func (x *GetWaitingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5006,42 +13336,48 @@ func (x *AddVisitMaterialsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddVisitMaterialsRequest.ProtoReflect.Descriptor instead.
-func (*AddVisitMaterialsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{84}
+// Deprecated: Use GetWaitingListResponse.ProtoReflect.Descriptor instead.
+func (*GetWaitingListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *AddVisitMaterialsRequest) GetMaterials() []*AddVisitMaterials {
+func (x *GetWaitingListResponse) GetEntries() []*WaitingListEntry {
 	if x != nil {
-		return x.Materials
+		return x.Entries
 	}
 	return nil
 }
 
-type AddVisitServices struct {
+func (x *GetWaitingListResponse) GetOffers() []*WaitingListOffer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type CancelWaitingListEntryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	ServiceId     int32                  `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AddVisitServices) Reset() {
-	*x = AddVisitServices{}
-	mi := &file_proto_storage_storage_proto_msgTypes[85]
+}
+
+func (x *CancelWaitingListEntryRequest) Reset() {
+	*x = CancelWaitingListEntryRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddVisitServices) String() string {
+func (x *CancelWaitingListEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddVisitServices) ProtoMessage() {}
+func (*CancelWaitingListEntryRequest) ProtoMessage() {}
 
-func (x *AddVisitServices) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[85]
+func (x *CancelWaitingListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5052,54 +13388,48 @@ func (x *AddVisitServices) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddVisitServices.ProtoReflect.Descriptor instead.
-func (*AddVisitServices) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *AddVisitServices) GetVisitId() int32 {
-	if x != nil {
-		return x.VisitId
-	}
-	return 0
+// Deprecated: Use CancelWaitingListEntryRequest.ProtoReflect.Descriptor instead.
+func (*CancelWaitingListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *AddVisitServices) GetServiceId() int32 {
+func (x *CancelWaitingListEntryRequest) GetId() int32 {
 	if x != nil {
-		return x.ServiceId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *AddVisitServices) GetAmount() int32 {
+func (x *CancelWaitingListEntryRequest) GetPatientId() int32 {
 	if x != nil {
-		return x.Amount
+		return x.PatientId
 	}
 	return 0
 }
 
-type AddVisitServicesRequest struct {
+type AcceptWaitingListOfferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Services      []*AddVisitServices    `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	OfferId       string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	Appointment   *Appointment           `protobuf:"bytes,2,opt,name=appointment,proto3" json:"appointment,omitempty"` // данные пациента; врач, дата, время и длительность берутся из предложения
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddVisitServicesRequest) Reset() {
-	*x = AddVisitServicesRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[86]
+func (x *AcceptWaitingListOfferRequest) Reset() {
+	*x = AcceptWaitingListOfferRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddVisitServicesRequest) String() string {
+func (x *AcceptWaitingListOfferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddVisitServicesRequest) ProtoMessage() {}
+func (*AcceptWaitingListOfferRequest) ProtoMessage() {}
 
-func (x *AddVisitServicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[86]
+func (x *AcceptWaitingListOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5110,40 +13440,47 @@ func (x *AddVisitServicesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddVisitServicesRequest.ProtoReflect.Descriptor instead.
-func (*AddVisitServicesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use AcceptWaitingListOfferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptWaitingListOfferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *AddVisitServicesRequest) GetServices() []*AddVisitServices {
+func (x *AcceptWaitingListOfferRequest) GetOfferId() string {
 	if x != nil {
-		return x.Services
+		return x.OfferId
+	}
+	return ""
+}
+
+func (x *AcceptWaitingListOfferRequest) GetAppointment() *Appointment {
+	if x != nil {
+		return x.Appointment
 	}
 	return nil
 }
 
-type AddPatientAllergiesChronicsRequest struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Notes         []*PatientAllergiesChronics `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+type AcceptWaitingListOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId int32                  `protobuf:"varint,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPatientAllergiesChronicsRequest) Reset() {
-	*x = AddPatientAllergiesChronicsRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[87]
+func (x *AcceptWaitingListOfferResponse) Reset() {
+	*x = AcceptWaitingListOfferResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPatientAllergiesChronicsRequest) String() string {
+func (x *AcceptWaitingListOfferResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPatientAllergiesChronicsRequest) ProtoMessage() {}
+func (*AcceptWaitingListOfferResponse) ProtoMessage() {}
 
-func (x *AddPatientAllergiesChronicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[87]
+func (x *AcceptWaitingListOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5154,45 +13491,41 @@ func (x *AddPatientAllergiesChronicsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPatientAllergiesChronicsRequest.ProtoReflect.Descriptor instead.
-func (*AddPatientAllergiesChronicsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use AcceptWaitingListOfferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptWaitingListOfferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{217}
 }
 
-func (x *AddPatientAllergiesChronicsRequest) GetNotes() []*PatientAllergiesChronics {
+func (x *AcceptWaitingListOfferResponse) GetAppointmentId() int32 {
 	if x != nil {
-		return x.Notes
+		return x.AppointmentId
 	}
-	return nil
+	return 0
 }
 
-type AddPatientVisitRequest struct {
+type DeclineWaitingListOfferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppointmentId int32                  `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
-	PatientId     int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
-	DoctorId      int32                  `protobuf:"varint,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-	Complaints    string                 `protobuf:"bytes,5,opt,name=complaints,proto3" json:"complaints,omitempty"`
-	Treatment     string                 `protobuf:"bytes,6,opt,name=treatment,proto3" json:"treatment,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	OfferId       string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPatientVisitRequest) Reset() {
-	*x = AddPatientVisitRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[88]
+func (x *DeclineWaitingListOfferRequest) Reset() {
+	*x = DeclineWaitingListOfferRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPatientVisitRequest) String() string {
+func (x *DeclineWaitingListOfferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPatientVisitRequest) ProtoMessage() {}
+func (*DeclineWaitingListOfferRequest) ProtoMessage() {}
 
-func (x *AddPatientVisitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[88]
+func (x *DeclineWaitingListOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5203,75 +13536,58 @@ func (x *AddPatientVisitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPatientVisitRequest.ProtoReflect.Descriptor instead.
-func (*AddPatientVisitRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use DeclineWaitingListOfferRequest.ProtoReflect.Descriptor instead.
+func (*DeclineWaitingListOfferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *AddPatientVisitRequest) GetAppointmentId() int32 {
+func (x *DeclineWaitingListOfferRequest) GetOfferId() string {
 	if x != nil {
-		return x.AppointmentId
+		return x.OfferId
 	}
-	return 0
+	return ""
 }
 
-func (x *AddPatientVisitRequest) GetPatientId() int32 {
+func (x *DeclineWaitingListOfferRequest) GetPatientId() int32 {
 	if x != nil {
 		return x.PatientId
 	}
 	return 0
 }
 
-func (x *AddPatientVisitRequest) GetDoctorId() int32 {
-	if x != nil {
-		return x.DoctorId
-	}
-	return 0
-}
-
-func (x *AddPatientVisitRequest) GetComplaints() string {
-	if x != nil {
-		return x.Complaints
-	}
-	return ""
-}
-
-func (x *AddPatientVisitRequest) GetTreatment() string {
-	if x != nil {
-		return x.Treatment
-	}
-	return ""
-}
-
-func (x *AddPatientVisitRequest) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-type AddPatientDiagnosesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Diagnoses     []*Diagnose            `protobuf:"bytes,1,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ScheduleRule struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DoctorId            int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"` // 0 — правило клиники
+	Recurrence          string                 `protobuf:"bytes,3,opt,name=recurrence,proto3" json:"recurrence,omitempty"`              // range, monthly_weekday, annual
+	DateFrom            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"` // не задана — правило бессрочно
+	Weekday             int32                  `protobuf:"varint,6,opt,name=weekday,proto3" json:"weekday,omitempty"`            // для monthly_weekday: 0 — воскресенье
+	Nth                 int32                  `protobuf:"varint,7,opt,name=nth,proto3" json:"nth,omitempty"`                    // для monthly_weekday: 1..5, -1 — последний в месяце
+	StartTime           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	SlotDurationMinutes int32                  `protobuf:"varint,10,opt,name=slot_duration_minutes,json=slotDurationMinutes,proto3" json:"slot_duration_minutes,omitempty"`
+	IsDayOff            bool                   `protobuf:"varint,11,opt,name=is_day_off,json=isDayOff,proto3" json:"is_day_off,omitempty"`
+	Title               string                 `protobuf:"bytes,12,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *AddPatientDiagnosesRequest) Reset() {
-	*x = AddPatientDiagnosesRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[89]
+func (x *ScheduleRule) Reset() {
+	*x = ScheduleRule{}
+	mi := &file_proto_storage_storage_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPatientDiagnosesRequest) String() string {
+func (x *ScheduleRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPatientDiagnosesRequest) ProtoMessage() {}
+func (*ScheduleRule) ProtoMessage() {}
 
-func (x *AddPatientDiagnosesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[89]
+func (x *ScheduleRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5282,84 +13598,117 @@ func (x *AddPatientDiagnosesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPatientDiagnosesRequest.ProtoReflect.Descriptor instead.
-func (*AddPatientDiagnosesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use ScheduleRule.ProtoReflect.Descriptor instead.
+func (*ScheduleRule) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *AddPatientDiagnosesRequest) GetDiagnoses() []*Diagnose {
+func (x *ScheduleRule) GetId() int32 {
 	if x != nil {
-		return x.Diagnoses
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type GetPatientDiagnosesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Diagnoses     []*Diagnose            `protobuf:"bytes,1,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ScheduleRule) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
 }
 
-func (x *GetPatientDiagnosesResponse) Reset() {
-	*x = GetPatientDiagnosesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[90]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScheduleRule) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
 }
 
-func (x *GetPatientDiagnosesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScheduleRule) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
 }
 
-func (*GetPatientDiagnosesResponse) ProtoMessage() {}
+func (x *ScheduleRule) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
 
-func (x *GetPatientDiagnosesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[90]
+func (x *ScheduleRule) GetWeekday() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Weekday
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetPatientDiagnosesResponse.ProtoReflect.Descriptor instead.
-func (*GetPatientDiagnosesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{90}
+func (x *ScheduleRule) GetNth() int32 {
+	if x != nil {
+		return x.Nth
+	}
+	return 0
 }
 
-func (x *GetPatientDiagnosesResponse) GetDiagnoses() []*Diagnose {
+func (x *ScheduleRule) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Diagnoses
+		return x.StartTime
 	}
 	return nil
 }
 
-type GetPatientVisitsResponse struct {
+func (x *ScheduleRule) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ScheduleRule) GetSlotDurationMinutes() int32 {
+	if x != nil {
+		return x.SlotDurationMinutes
+	}
+	return 0
+}
+
+func (x *ScheduleRule) GetIsDayOff() bool {
+	if x != nil {
+		return x.IsDayOff
+	}
+	return false
+}
+
+func (x *ScheduleRule) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type GetScheduleRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Visits        []*Visit               `protobuf:"bytes,1,rep,name=visits,proto3" json:"visits,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"` // 0 — правила клиники
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPatientVisitsResponse) Reset() {
-	*x = GetPatientVisitsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[91]
+func (x *GetScheduleRulesRequest) Reset() {
+	*x = GetScheduleRulesRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPatientVisitsResponse) String() string {
+func (x *GetScheduleRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPatientVisitsResponse) ProtoMessage() {}
+func (*GetScheduleRulesRequest) ProtoMessage() {}
 
-func (x *GetPatientVisitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[91]
+func (x *GetScheduleRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5370,40 +13719,40 @@ func (x *GetPatientVisitsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPatientVisitsResponse.ProtoReflect.Descriptor instead.
-func (*GetPatientVisitsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use GetScheduleRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{220}
 }
 
-func (x *GetPatientVisitsResponse) GetVisits() []*Visit {
+func (x *GetScheduleRulesRequest) GetDoctorId() int32 {
 	if x != nil {
-		return x.Visits
+		return x.DoctorId
 	}
-	return nil
+	return 0
 }
 
-type GetPatientAllergiesChronicsResponse struct {
-	state                    protoimpl.MessageState      `protogen:"open.v1"`
-	PatientAllergiesChronics []*PatientAllergiesChronics `protobuf:"bytes,1,rep,name=patient_allergies_chronics,json=patientAllergiesChronics,proto3" json:"patient_allergies_chronics,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type GetScheduleRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ScheduleRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPatientAllergiesChronicsResponse) Reset() {
-	*x = GetPatientAllergiesChronicsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[92]
+func (x *GetScheduleRulesResponse) Reset() {
+	*x = GetScheduleRulesResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPatientAllergiesChronicsResponse) String() string {
+func (x *GetScheduleRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPatientAllergiesChronicsResponse) ProtoMessage() {}
+func (*GetScheduleRulesResponse) ProtoMessage() {}
 
-func (x *GetPatientAllergiesChronicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[92]
+func (x *GetScheduleRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5414,40 +13763,40 @@ func (x *GetPatientAllergiesChronicsResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPatientAllergiesChronicsResponse.ProtoReflect.Descriptor instead.
-func (*GetPatientAllergiesChronicsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use GetScheduleRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *GetPatientAllergiesChronicsResponse) GetPatientAllergiesChronics() []*PatientAllergiesChronics {
+func (x *GetScheduleRulesResponse) GetRules() []*ScheduleRule {
 	if x != nil {
-		return x.PatientAllergiesChronics
+		return x.Rules
 	}
 	return nil
 }
 
-type GetICDCodesResponse struct {
+type AddScheduleRuleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IcdCode       []*ICDCode             `protobuf:"bytes,1,rep,name=icd_code,json=icdCode,proto3" json:"icd_code,omitempty"`
+	Rule          *ScheduleRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetICDCodesResponse) Reset() {
-	*x = GetICDCodesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[93]
+func (x *AddScheduleRuleRequest) Reset() {
+	*x = AddScheduleRuleRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetICDCodesResponse) String() string {
+func (x *AddScheduleRuleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetICDCodesResponse) ProtoMessage() {}
+func (*AddScheduleRuleRequest) ProtoMessage() {}
 
-func (x *GetICDCodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[93]
+func (x *AddScheduleRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5457,41 +13806,41 @@ func (x *GetICDCodesResponse) ProtoReflect() protoreflect.Message {
 	}
 	return mi.MessageOf(x)
 }
-
-// Deprecated: Use GetICDCodesResponse.ProtoReflect.Descriptor instead.
-func (*GetICDCodesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{93}
+
+// Deprecated: Use AddScheduleRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddScheduleRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{222}
 }
 
-func (x *GetICDCodesResponse) GetIcdCode() []*ICDCode {
+func (x *AddScheduleRuleRequest) GetRule() *ScheduleRule {
 	if x != nil {
-		return x.IcdCode
+		return x.Rule
 	}
 	return nil
 }
 
-type AddVisitResponse struct {
+type AddScheduleRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddVisitResponse) Reset() {
-	*x = AddVisitResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[94]
+func (x *AddScheduleRuleResponse) Reset() {
+	*x = AddScheduleRuleResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddVisitResponse) String() string {
+func (x *AddScheduleRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddVisitResponse) ProtoMessage() {}
+func (*AddScheduleRuleResponse) ProtoMessage() {}
 
-func (x *AddVisitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[94]
+func (x *AddScheduleRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5502,42 +13851,40 @@ func (x *AddVisitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddVisitResponse.ProtoReflect.Descriptor instead.
-func (*AddVisitResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use AddScheduleRuleResponse.ProtoReflect.Descriptor instead.
+func (*AddScheduleRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *AddVisitResponse) GetId() int32 {
+func (x *AddScheduleRuleResponse) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type VisitPayment struct {
+type ImportScheduleRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	Price         int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Rules         []*ScheduleRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VisitPayment) Reset() {
-	*x = VisitPayment{}
-	mi := &file_proto_storage_storage_proto_msgTypes[95]
+func (x *ImportScheduleRulesRequest) Reset() {
+	*x = ImportScheduleRulesRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VisitPayment) String() string {
+func (x *ImportScheduleRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VisitPayment) ProtoMessage() {}
+func (*ImportScheduleRulesRequest) ProtoMessage() {}
 
-func (x *VisitPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[95]
+func (x *ImportScheduleRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5548,56 +13895,40 @@ func (x *VisitPayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VisitPayment.ProtoReflect.Descriptor instead.
-func (*VisitPayment) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{95}
-}
-
-func (x *VisitPayment) GetVisitId() int32 {
-	if x != nil {
-		return x.VisitId
-	}
-	return 0
-}
-
-func (x *VisitPayment) GetPrice() int32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+// Deprecated: Use ImportScheduleRulesRequest.ProtoReflect.Descriptor instead.
+func (*ImportScheduleRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *VisitPayment) GetStatus() string {
+func (x *ImportScheduleRulesRequest) GetRules() []*ScheduleRule {
 	if x != nil {
-		return x.Status
+		return x.Rules
 	}
-	return ""
+	return nil
 }
 
-type VisitPaymentRequest struct {
+type ImportScheduleRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	Price         int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Added         int32                  `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"` // уже существующие правила пропускаются
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VisitPaymentRequest) Reset() {
-	*x = VisitPaymentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[96]
+func (x *ImportScheduleRulesResponse) Reset() {
+	*x = ImportScheduleRulesResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VisitPaymentRequest) String() string {
+func (x *ImportScheduleRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VisitPaymentRequest) ProtoMessage() {}
+func (*ImportScheduleRulesResponse) ProtoMessage() {}
 
-func (x *VisitPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[96]
+func (x *ImportScheduleRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5608,54 +13939,47 @@ func (x *VisitPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VisitPaymentRequest.ProtoReflect.Descriptor instead.
-func (*VisitPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{96}
-}
-
-func (x *VisitPaymentRequest) GetVisitId() int32 {
-	if x != nil {
-		return x.VisitId
-	}
-	return 0
+// Deprecated: Use ImportScheduleRulesResponse.ProtoReflect.Descriptor instead.
+func (*ImportScheduleRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *VisitPaymentRequest) GetPrice() int32 {
+func (x *ImportScheduleRulesResponse) GetAdded() int32 {
 	if x != nil {
-		return x.Price
+		return x.Added
 	}
 	return 0
 }
 
-func (x *VisitPaymentRequest) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-type GetVisitsPaymentsResponse struct {
+type Notification struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VisitPayment  []*VisitPayment        `protobuf:"bytes,1,rep,name=visit_payment,json=visitPayment,proto3" json:"visit_payment,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`       // booking_confirmed, appointment_rescheduled, reminder_24h, reminder_2h, slot_offered
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"` // sms, email
+	PatientId     int32                  `protobuf:"varint,4,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Recipient     string                 `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"` // номер телефона или email
+	Payload       string                 `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`     // JSON с данными для шаблона
+	SendAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	Attempts      int32                  `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVisitsPaymentsResponse) Reset() {
-	*x = GetVisitsPaymentsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[97]
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_proto_storage_storage_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVisitsPaymentsResponse) String() string {
+func (x *Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVisitsPaymentsResponse) ProtoMessage() {}
+func (*Notification) ProtoMessage() {}
 
-func (x *GetVisitsPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[97]
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5666,86 +13990,91 @@ func (x *GetVisitsPaymentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVisitsPaymentsResponse.ProtoReflect.Descriptor instead.
-func (*GetVisitsPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{226}
 }
 
-func (x *GetVisitsPaymentsResponse) GetVisitPayment() []*VisitPayment {
+func (x *Notification) GetId() int64 {
 	if x != nil {
-		return x.VisitPayment
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// Запрос на подсчет суммы по визиту
-type CalculateVisitTotalRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Notification) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
 }
 
-func (x *CalculateVisitTotalRequest) Reset() {
-	*x = CalculateVisitTotalRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[98]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Notification) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
 }
 
-func (x *CalculateVisitTotalRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Notification) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
 }
 
-func (*CalculateVisitTotalRequest) ProtoMessage() {}
+func (x *Notification) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
 
-func (x *CalculateVisitTotalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[98]
+func (x *Notification) GetPayload() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Payload
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CalculateVisitTotalRequest.ProtoReflect.Descriptor instead.
-func (*CalculateVisitTotalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{98}
+func (x *Notification) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
 }
 
-func (x *CalculateVisitTotalRequest) GetVisitId() int32 {
+func (x *Notification) GetAttempts() int32 {
 	if x != nil {
-		return x.VisitId
+		return x.Attempts
 	}
 	return 0
 }
 
-// Ответ с итоговой суммой
-type CalculateVisitTotalResponse struct {
+type ClaimNotificationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Channels      []string               `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	LeaseSeconds  int32                  `protobuf:"varint,3,opt,name=lease_seconds,json=leaseSeconds,proto3" json:"lease_seconds,omitempty"` // за это время отправка должна завершиться, иначе уведомление не будет повторено
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateVisitTotalResponse) Reset() {
-	*x = CalculateVisitTotalResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[99]
+func (x *ClaimNotificationsRequest) Reset() {
+	*x = ClaimNotificationsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateVisitTotalResponse) String() string {
+func (x *ClaimNotificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateVisitTotalResponse) ProtoMessage() {}
+func (*ClaimNotificationsRequest) ProtoMessage() {}
 
-func (x *CalculateVisitTotalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[99]
+func (x *ClaimNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5756,41 +14085,54 @@ func (x *CalculateVisitTotalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateVisitTotalResponse.ProtoReflect.Descriptor instead.
-func (*CalculateVisitTotalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use ClaimNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ClaimNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{227}
 }
 
-func (x *CalculateVisitTotalResponse) GetTotal() int32 {
+func (x *ClaimNotificationsRequest) GetChannels() []string {
 	if x != nil {
-		return x.Total
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *ClaimNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-// Запрос на добавление или обновление платежа
-type AddOrUpdateVisitPaymentRequest struct {
+func (x *ClaimNotificationsRequest) GetLeaseSeconds() int32 {
+	if x != nil {
+		return x.LeaseSeconds
+	}
+	return 0
+}
+
+type ClaimNotificationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payment       *VisitPayment          `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddOrUpdateVisitPaymentRequest) Reset() {
-	*x = AddOrUpdateVisitPaymentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[100]
+func (x *ClaimNotificationsResponse) Reset() {
+	*x = ClaimNotificationsResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddOrUpdateVisitPaymentRequest) String() string {
+func (x *ClaimNotificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddOrUpdateVisitPaymentRequest) ProtoMessage() {}
+func (*ClaimNotificationsResponse) ProtoMessage() {}
 
-func (x *AddOrUpdateVisitPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[100]
+func (x *ClaimNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5801,40 +14143,41 @@ func (x *AddOrUpdateVisitPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddOrUpdateVisitPaymentRequest.ProtoReflect.Descriptor instead.
-func (*AddOrUpdateVisitPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use ClaimNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ClaimNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{228}
 }
 
-func (x *AddOrUpdateVisitPaymentRequest) GetPayment() *VisitPayment {
+func (x *ClaimNotificationsResponse) GetNotifications() []*Notification {
 	if x != nil {
-		return x.Payment
+		return x.Notifications
 	}
 	return nil
 }
 
-type GetVisitByIDResponse struct {
+type CompleteNotificationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Visit         *Visit                 `protobuf:"bytes,1,opt,name=visit,proto3" json:"visit,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // пусто при успешной отправке
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVisitByIDResponse) Reset() {
-	*x = GetVisitByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[101]
+func (x *CompleteNotificationRequest) Reset() {
+	*x = CompleteNotificationRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVisitByIDResponse) String() string {
+func (x *CompleteNotificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVisitByIDResponse) ProtoMessage() {}
+func (*CompleteNotificationRequest) ProtoMessage() {}
 
-func (x *GetVisitByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[101]
+func (x *CompleteNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5845,44 +14188,48 @@ func (x *GetVisitByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVisitByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetVisitByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use CompleteNotificationRequest.ProtoReflect.Descriptor instead.
+func (*CompleteNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{229}
 }
 
-func (x *GetVisitByIDResponse) GetVisit() *Visit {
+func (x *CompleteNotificationRequest) GetId() int64 {
 	if x != nil {
-		return x.Visit
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type ClinicOverride struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Date                *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	StartTime           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime             *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	SlotDurationMinutes int32                  `protobuf:"varint,4,opt,name=slot_duration_minutes,json=slotDurationMinutes,proto3" json:"slot_duration_minutes,omitempty"`
-	IsDayOff            bool                   `protobuf:"varint,5,opt,name=is_day_off,json=isDayOff,proto3" json:"is_day_off,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *CompleteNotificationRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *ClinicOverride) Reset() {
-	*x = ClinicOverride{}
-	mi := &file_proto_storage_storage_proto_msgTypes[102]
+type PatientNotificationSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PatientId     int32                  `protobuf:"varint,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	OptOut        bool                   `protobuf:"varint,2,opt,name=opt_out,json=optOut,proto3" json:"opt_out,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatientNotificationSettings) Reset() {
+	*x = PatientNotificationSettings{}
+	mi := &file_proto_storage_storage_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClinicOverride) String() string {
+func (x *PatientNotificationSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClinicOverride) ProtoMessage() {}
+func (*PatientNotificationSettings) ProtoMessage() {}
 
-func (x *ClinicOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[102]
+func (x *PatientNotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5893,68 +14240,59 @@ func (x *ClinicOverride) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClinicOverride.ProtoReflect.Descriptor instead.
-func (*ClinicOverride) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{102}
-}
-
-func (x *ClinicOverride) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-func (x *ClinicOverride) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
-}
-
-func (x *ClinicOverride) GetEndTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndTime
-	}
-	return nil
+// Deprecated: Use PatientNotificationSettings.ProtoReflect.Descriptor instead.
+func (*PatientNotificationSettings) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *ClinicOverride) GetSlotDurationMinutes() int32 {
+func (x *PatientNotificationSettings) GetPatientId() int32 {
 	if x != nil {
-		return x.SlotDurationMinutes
+		return x.PatientId
 	}
 	return 0
 }
 
-func (x *ClinicOverride) GetIsDayOff() bool {
+func (x *PatientNotificationSettings) GetOptOut() bool {
 	if x != nil {
-		return x.IsDayOff
+		return x.OptOut
 	}
 	return false
 }
 
-type GetClinicOverridesResponse struct {
+// Запись журнала аудита: кто (actor_id, actor_role), каким методом какого сервиса, к какому ресурсу
+// и чьим данным, с каким результатом (код gRPC-статуса) и когда. hash — звено цепочки хешей журнала
+type AuditEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Overrides     []*ClinicOverride      `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	ActorId       int32                  `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorRole     int32                  `protobuf:"varint,6,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	Resource      string                 `protobuf:"bytes,7,opt,name=resource,proto3" json:"resource,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,8,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,9,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"` // 0 — вызов не относится к конкретному пациенту
+	Outcome       string                 `protobuf:"bytes,10,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Hash          []byte                 `protobuf:"bytes,11,opt,name=hash,proto3" json:"hash,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClinicOverridesResponse) Reset() {
-	*x = GetClinicOverridesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[103]
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_proto_storage_storage_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClinicOverridesResponse) String() string {
+func (x *AuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClinicOverridesResponse) ProtoMessage() {}
+func (*AuditEntry) ProtoMessage() {}
 
-func (x *GetClinicOverridesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[103]
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5965,87 +14303,114 @@ func (x *GetClinicOverridesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClinicOverridesResponse.ProtoReflect.Descriptor instead.
-func (*GetClinicOverridesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{231}
 }
 
-func (x *GetClinicOverridesResponse) GetOverrides() []*ClinicOverride {
+func (x *AuditEntry) GetId() int64 {
 	if x != nil {
-		return x.Overrides
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
 	}
 	return nil
 }
 
-type GetAppointmentsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AuditEntry) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
 }
 
-func (x *GetAppointmentsResponse) Reset() {
-	*x = GetAppointmentsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[104]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (x *GetAppointmentsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditEntry) GetActorId() int32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
 }
 
-func (*GetAppointmentsResponse) ProtoMessage() {}
+func (x *AuditEntry) GetActorRole() int32 {
+	if x != nil {
+		return x.ActorRole
+	}
+	return 0
+}
 
-func (x *GetAppointmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[104]
+func (x *AuditEntry) GetResource() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Resource
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetAppointmentsResponse.ProtoReflect.Descriptor instead.
-func (*GetAppointmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{104}
+func (x *AuditEntry) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
 }
 
-func (x *GetAppointmentsResponse) GetAppointments() []*Appointment {
+func (x *AuditEntry) GetPatientId() int32 {
 	if x != nil {
-		return x.Appointments
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetHash() []byte {
+	if x != nil {
+		return x.Hash
 	}
 	return nil
 }
 
-type VisitMaterialAndService struct {
+type GetAuditLogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	VisitId       int32                  `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	ItemId        int32                  `protobuf:"varint,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	ActorId       int32                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // дни в часовом поясе клиники включительно
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VisitMaterialAndService) Reset() {
-	*x = VisitMaterialAndService{}
-	mi := &file_proto_storage_storage_proto_msgTypes[105]
+func (x *GetAuditLogRequest) Reset() {
+	*x = GetAuditLogRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VisitMaterialAndService) String() string {
+func (x *GetAuditLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VisitMaterialAndService) ProtoMessage() {}
+func (*GetAuditLogRequest) ProtoMessage() {}
 
-func (x *VisitMaterialAndService) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[105]
+func (x *GetAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6056,61 +14421,69 @@ func (x *VisitMaterialAndService) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VisitMaterialAndService.ProtoReflect.Descriptor instead.
-func (*VisitMaterialAndService) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use GetAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *VisitMaterialAndService) GetId() int32 {
+func (x *GetAuditLogRequest) GetPage() *ListPage {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
-	return 0
+	return nil
 }
 
-func (x *VisitMaterialAndService) GetVisitId() int32 {
+func (x *GetAuditLogRequest) GetPatientId() int32 {
 	if x != nil {
-		return x.VisitId
+		return x.PatientId
 	}
 	return 0
 }
 
-func (x *VisitMaterialAndService) GetItemId() int32 {
+func (x *GetAuditLogRequest) GetActorId() int32 {
 	if x != nil {
-		return x.ItemId
+		return x.ActorId
 	}
 	return 0
 }
 
-func (x *VisitMaterialAndService) GetQuantity() int32 {
+func (x *GetAuditLogRequest) GetDateFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Quantity
+		return x.DateFrom
 	}
-	return 0
+	return nil
 }
 
-type GetVisitMaterialsAndServicesResponse struct {
-	state                  protoimpl.MessageState     `protogen:"open.v1"`
-	VisitMaterialsServices []*VisitMaterialAndService `protobuf:"bytes,1,rep,name=visit_materials_services,json=visitMaterialsServices,proto3" json:"visit_materials_services,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *GetAuditLogRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
 }
 
-func (x *GetVisitMaterialsAndServicesResponse) Reset() {
-	*x = GetVisitMaterialsAndServicesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[106]
+type GetAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditLogResponse) Reset() {
+	*x = GetAuditLogResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVisitMaterialsAndServicesResponse) String() string {
+func (x *GetAuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVisitMaterialsAndServicesResponse) ProtoMessage() {}
+func (*GetAuditLogResponse) ProtoMessage() {}
 
-func (x *GetVisitMaterialsAndServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[106]
+func (x *GetAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6121,42 +14494,49 @@ func (x *GetVisitMaterialsAndServicesResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVisitMaterialsAndServicesResponse.ProtoReflect.Descriptor instead.
-func (*GetVisitMaterialsAndServicesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use GetAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{233}
 }
 
-func (x *GetVisitMaterialsAndServicesResponse) GetVisitMaterialsServices() []*VisitMaterialAndService {
+func (x *GetAuditLogResponse) GetEntries() []*AuditEntry {
 	if x != nil {
-		return x.VisitMaterialsServices
+		return x.Entries
 	}
 	return nil
 }
 
-type GetMaterialServiceByIDResponse struct {
+func (x *GetAuditLogResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type VerifyAuditLogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Checked       int32                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`                   // сколько записей проверено
+	BrokenId      int64                  `protobuf:"varint,2,opt,name=broken_id,json=brokenId,proto3" json:"broken_id,omitempty"` // первая запись, хеш которой не сходится; 0 — цепочка цела
+	LastHash      []byte                 `protobuf:"bytes,3,opt,name=last_hash,json=lastHash,proto3" json:"last_hash,omitempty"`  // сводный хеш последних записей цепочек журнала
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMaterialServiceByIDResponse) Reset() {
-	*x = GetMaterialServiceByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[107]
+func (x *VerifyAuditLogResponse) Reset() {
+	*x = VerifyAuditLogResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMaterialServiceByIDResponse) String() string {
+func (x *VerifyAuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMaterialServiceByIDResponse) ProtoMessage() {}
+func (*VerifyAuditLogResponse) ProtoMessage() {}
 
-func (x *GetMaterialServiceByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[107]
+func (x *VerifyAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6167,30 +14547,30 @@ func (x *GetMaterialServiceByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMaterialServiceByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetMaterialServiceByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use VerifyAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{234}
 }
 
-func (x *GetMaterialServiceByIDResponse) GetId() int32 {
+func (x *VerifyAuditLogResponse) GetChecked() int32 {
 	if x != nil {
-		return x.Id
+		return x.Checked
 	}
 	return 0
 }
 
-func (x *GetMaterialServiceByIDResponse) GetName() string {
+func (x *VerifyAuditLogResponse) GetBrokenId() int64 {
 	if x != nil {
-		return x.Name
+		return x.BrokenId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMaterialServiceByIDResponse) GetPrice() int32 {
+func (x *VerifyAuditLogResponse) GetLastHash() []byte {
 	if x != nil {
-		return x.Price
+		return x.LastHash
 	}
-	return 0
+	return nil
 }
 
 var File_proto_storage_storage_proto protoreflect.FileDescriptor
@@ -6246,7 +14626,12 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x06gender\x18\b \x01(\tR\x06gender\"*\n" +
 	"\x12AddPatientResponse\x12\x14\n" +
 	"\x05error\x18\x01 \x01(\tR\x05error\"\x0e\n" +
-	"\fEmptyRequest\"4\n" +
+	"\fEmptyRequest\"`\n" +
+	"\bListPage\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\bR\x04desc\"4\n" +
 	"\x0eSpecialization\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"D\n" +
@@ -6272,11 +14657,12 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x1dGetScheduleByDoctorIdResponse\x12F\n" +
 	"\x0fdoctor_schedule\x18\x01 \x03(\v2\x1d.storage.WeeklyDoctorScheduleR\x0edoctorSchedule\"-\n" +
 	"\x15GetUserByLoginRequest\x12\x14\n" +
-	"\x05login\x18\x01 \x01(\tR\x05login\"Z\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\"r\n" +
 	"\x16GetUserByLoginResponse\x12\x14\n" +
 	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x0e\n" +
-	"\x02id\x18\x03 \x01(\x05R\x02id\"]\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"]\n" +
 	"\x19UpdateUserPasswordRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05login\x18\x02 \x01(\tR\x05login\x12\x1a\n" +
@@ -6316,9 +14702,14 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\aspec_id\x18\x02 \x01(\x05R\x06specId\"O\n" +
 	"\x17DeleteDoctorSpecRequest\x12\x1b\n" +
 	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\x12\x17\n" +
-	"\aspec_id\x18\x02 \x01(\x05R\x06specId\"?\n" +
+	"\aspec_id\x18\x02 \x01(\x05R\x06specId\"l\n" +
+	"\x11GetDoctorsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"U\n" +
 	"\x12GetDoctorsResponse\x12)\n" +
-	"\adoctors\x18\x01 \x03(\v2\x0f.storage.DoctorR\adoctors\"8\n" +
+	"\adoctors\x18\x01 \x03(\v2\x0f.storage.DoctorR\adoctors\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"8\n" +
 	" GetDoctorSpecsByDoctorIdResponse\x12\x14\n" +
 	"\x05specs\x18\x01 \x03(\x05R\x05specs\"\xcb\x01\n" +
 	"\x05Admin\x12\x17\n" +
@@ -6343,9 +14734,14 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x06gender\x18\a \x01(\tR\x06gender\"J\n" +
 	"\x16UpdateAdminRoleRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
-	"\arole_id\x18\x02 \x01(\x05R\x06roleId\";\n" +
+	"\arole_id\x18\x02 \x01(\x05R\x06roleId\"k\n" +
+	"\x10GetAdminsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"Q\n" +
 	"\x11GetAdminsResponse\x12&\n" +
-	"\x06admins\x18\x01 \x03(\v2\x0e.storage.AdminR\x06admins\"\x88\x02\n" +
+	"\x06admins\x18\x01 \x03(\v2\x0e.storage.AdminR\x06admins\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x88\x02\n" +
 	"\aPatient\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
 	"\n" +
@@ -6369,9 +14765,14 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\n" +
 	"birth_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tbirthDate\x12!\n" +
 	"\fphone_number\x18\a \x01(\tR\vphoneNumber\x12\x16\n" +
-	"\x06gender\x18\b \x01(\tR\x06gender\"C\n" +
+	"\x06gender\x18\b \x01(\tR\x06gender\"m\n" +
+	"\x12GetPatientsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"Y\n" +
 	"\x13GetPatientsResponse\x12,\n" +
-	"\bpatients\x18\x01 \x03(\v2\x10.storage.PatientR\bpatients\"\x84\x02\n" +
+	"\bpatients\x18\x01 \x03(\v2\x10.storage.PatientR\bpatients\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x84\x02\n" +
 	"\x14WeeklyClinicSchedule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
 	"\aweekday\x18\x02 \x01(\x05R\aweekday\x129\n" +
@@ -6416,7 +14817,7 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x18GetDoctorBySpecIDRequest\x12\x17\n" +
 	"\aspec_id\x18\x01 \x01(\x05R\x06specId\"?\n" +
 	" GetAppointmentsByDoctorIDRequest\x12\x1b\n" +
-	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\"\x97\x04\n" +
+	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\"\xc2\x04\n" +
 	"\vAppointment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
 	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12.\n" +
@@ -6438,15 +14839,46 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"]\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12)\n" +
+	"\x10duration_minutes\x18\x0f \x01(\x05R\x0fdurationMinutes\"]\n" +
 	"!GetAppointmentsByDoctorIDResponse\x128\n" +
 	"\fappointments\x18\x01 \x03(\v2\x14.storage.AppointmentR\fappointments\" \n" +
 	"\x0eGetByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"D\n" +
 	"\x16GetPatientByIDResponse\x12*\n" +
-	"\apatient\x18\x01 \x01(\v2\x10.storage.PatientR\apatient\"O\n" +
+	"\apatient\x18\x01 \x01(\v2\x10.storage.PatientR\apatient\"\x81\x01\n" +
 	"\x15AddAppointmentRequest\x126\n" +
-	"\vappointment\x18\x01 \x01(\v2\x14.storage.AppointmentR\vappointment\"R\n" +
+	"\vappointment\x18\x01 \x01(\v2\x14.storage.AppointmentR\vappointment\x12\x17\n" +
+	"\ahold_id\x18\x02 \x01(\tR\x06holdId\x12\x17\n" +
+	"\aheld_by\x18\x03 \x01(\x05R\x06heldBy\"\xb0\x02\n" +
+	"\x13AppointmentSlotHold\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x05 \x01(\x05R\tpatientId\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12)\n" +
+	"\x10duration_minutes\x18\a \x01(\x05R\x0fdurationMinutes\"\xb6\x02\n" +
+	"\x1aHoldAppointmentSlotRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x05 \x01(\x05R\tpatientId\x12\x1f\n" +
+	"\vttl_seconds\x18\x06 \x01(\x05R\n" +
+	"ttlSeconds\x12)\n" +
+	"\x10duration_minutes\x18\a \x01(\x05R\x0fdurationMinutes\x12\x17\n" +
+	"\aheld_by\x18\b \x01(\x05R\x06heldBy\"O\n" +
+	"\x1bHoldAppointmentSlotResponse\x120\n" +
+	"\x04hold\x18\x01 \x01(\v2\x1c.storage.AppointmentSlotHoldR\x04hold\"Q\n" +
+	"\x1dReleaseAppointmentSlotRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x17\n" +
+	"\aheld_by\x18\x02 \x01(\x05R\x06heldBy\"U\n" +
+	"\x1fGetAppointmentSlotHoldsResponse\x122\n" +
+	"\x05holds\x18\x01 \x03(\v2\x1c.storage.AppointmentSlotHoldR\x05holds\"R\n" +
 	"\x18UpdateAppointmentRequest\x126\n" +
 	"\vappointment\x18\x01 \x01(\v2\x14.storage.AppointmentR\vappointment\"Y\n" +
 	"\x1fGetAppointmentsByUserIDResponse\x126\n" +
@@ -6487,32 +14919,298 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\n" +
 	"is_day_off\x18\x06 \x01(\bR\bisDayOff\"Q\n" +
 	"\x1aGetDoctorOverridesResponse\x123\n" +
-	"\boverride\x18\x01 \x03(\v2\x17.storage.DoctorOverrideR\boverride\">\n" +
+	"\boverride\x18\x01 \x03(\v2\x17.storage.DoctorOverrideR\boverride\"\x82\x01\n" +
 	"\x12AddMaterialRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x02 \x01(\x05R\x05price\"Q\n" +
+	"\x05price\x18\x02 \x01(\x05R\x05price\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12.\n" +
+	"\x13low_stock_threshold\x18\x04 \x01(\x05R\x11lowStockThreshold\"|\n" +
 	"\x11AddServiceRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x05R\x05price\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\x05R\x04type\"Q\n" +
+	"\x04type\x18\x03 \x01(\x05R\x04type\x12)\n" +
+	"\x10duration_minutes\x18\x04 \x01(\x05R\x0fdurationMinutes\"\x95\x01\n" +
 	"\x15UpdateMaterialRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x05R\x05price\"d\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12.\n" +
+	"\x13low_stock_threshold\x18\x05 \x01(\x05R\x11lowStockThreshold\"\x8f\x01\n" +
 	"\x14UpdateServiceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\x05R\x04type\"D\n" +
+	"\x04type\x18\x04 \x01(\x05R\x04type\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\"\xaf\x01\n" +
 	"\bMaterial\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x05R\x05price\"W\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12.\n" +
+	"\x13low_stock_threshold\x18\x05 \x01(\x05R\x11lowStockThreshold\x12%\n" +
+	"\x0estock_quantity\x18\x06 \x01(\x05R\rstockQuantity\"\xc7\x02\n" +
+	"\rMaterialBatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vmaterial_id\x18\x02 \x01(\x05R\n" +
+	"materialId\x12!\n" +
+	"\fbatch_number\x18\x03 \x01(\tR\vbatchNumber\x12+\n" +
+	"\x11quantity_received\x18\x04 \x01(\x05R\x10quantityReceived\x12#\n" +
+	"\rquantity_left\x18\x05 \x01(\x05R\fquantityLeft\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12;\n" +
+	"\vreceived_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x12\x18\n" +
+	"\acomment\x18\b \x01(\tR\acomment\"\xf9\x01\n" +
+	"\rStockMovement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vmaterial_id\x18\x02 \x01(\x05R\n" +
+	"materialId\x12\x19\n" +
+	"\bbatch_id\x18\x03 \x01(\x05R\abatchId\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x19\n" +
+	"\bvisit_id\x18\x06 \x01(\x05R\avisitId\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf5\x02\n" +
+	"\x11TreatmentPlanItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\aplan_id\x18\x02 \x01(\x05R\x06planId\x12\x14\n" +
+	"\x05stage\x18\x03 \x01(\x05R\x05stage\x12\x14\n" +
+	"\x05tooth\x18\x04 \x01(\x05R\x05tooth\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x05 \x01(\x05R\tserviceId\x12!\n" +
+	"\fservice_name\x18\x06 \x01(\tR\vserviceName\x12\x1a\n" +
+	"\bquantity\x18\a \x01(\x05R\bquantity\x12'\n" +
+	"\x0festimated_price\x18\b \x01(\x05R\x0eestimatedPrice\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x19\n" +
+	"\bvisit_id\x18\n" +
+	" \x01(\x05R\avisitId\x12\x12\n" +
+	"\x04note\x18\v \x01(\tR\x04note\x12=\n" +
+	"\fcompleted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xbe\x03\n" +
+	"\rTreatmentPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x03 \x01(\x05R\bdoctorId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12;\n" +
+	"\vaccepted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"acceptedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\x05items\x18\t \x03(\v2\x1a.storage.TreatmentPlanItemR\x05items\x12'\n" +
+	"\x0festimated_total\x18\n" +
+	" \x01(\x05R\x0eestimatedTotal\x12%\n" +
+	"\x0eremaining_cost\x18\v \x01(\x05R\rremainingCost\"\x91\x01\n" +
+	"\x14NewTreatmentPlanItem\x12\x14\n" +
+	"\x05stage\x18\x01 \x01(\x05R\x05stage\x12\x14\n" +
+	"\x05tooth\x18\x02 \x01(\x05R\x05tooth\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x03 \x01(\x05R\tserviceId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"\xa3\x01\n" +
+	"\x1aCreateTreatmentPlanRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\x05R\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x123\n" +
+	"\x05items\x18\x04 \x03(\v2\x1d.storage.NewTreatmentPlanItemR\x05items\"l\n" +
+	"\x1cAddTreatmentPlanItemsRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x05R\x06planId\x123\n" +
+	"\x05items\x18\x02 \x03(\v2\x1d.storage.NewTreatmentPlanItemR\x05items\"W\n" +
+	"$UpdateTreatmentPlanItemStatusRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x05R\x06itemId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"T\n" +
+	"\x1aAcceptTreatmentPlanRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x05R\x06planId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\"C\n" +
+	"\x15TreatmentPlanResponse\x12*\n" +
+	"\x04plan\x18\x01 \x01(\v2\x16.storage.TreatmentPlanR\x04plan\"F\n" +
+	"\x16TreatmentPlansResponse\x12,\n" +
+	"\x05plans\x18\x01 \x03(\v2\x16.storage.TreatmentPlanR\x05plans\"\x8f\x02\n" +
+	"\vToothRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\x14\n" +
+	"\x05tooth\x18\x03 \x01(\x05R\x05tooth\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\bsurfaces\x18\x05 \x01(\tR\bsurfaces\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x19\n" +
+	"\bvisit_id\x18\a \x01(\x05R\avisitId\x12\x1b\n" +
+	"\tdoctor_id\x18\b \x01(\x05R\bdoctorId\x12;\n" +
+	"\vrecorded_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\"\x86\x01\n" +
+	"\x18RecordToothStatesRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\x05R\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12.\n" +
+	"\arecords\x18\x03 \x03(\v2\x14.storage.ToothRecordR\arecords\"M\n" +
+	"\x16GetToothHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\x05R\tpatientId\x12\x14\n" +
+	"\x05tooth\x18\x02 \x01(\x05R\x05tooth\"A\n" +
+	"\x13DentalChartResponse\x12*\n" +
+	"\x05teeth\x18\x01 \x03(\v2\x14.storage.ToothRecordR\x05teeth\"f\n" +
+	"\x10AnamnesisAllergy\x12\x1a\n" +
+	"\ballergen\x18\x01 \x01(\tR\ballergen\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x1a\n" +
+	"\breaction\x18\x03 \x01(\tR\breaction\"\xd3\x05\n" +
+	"\tAnamnesis\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12 \n" +
+	"\vmedications\x18\x04 \x01(\tR\vmedications\x12%\n" +
+	"\x0eblood_thinners\x18\x05 \x01(\bR\rbloodThinners\x12'\n" +
+	"\x0fcardiac_disease\x18\x06 \x01(\bR\x0ecardiacDisease\x12\"\n" +
+	"\fhypertension\x18\a \x01(\bR\fhypertension\x12\x1a\n" +
+	"\bdiabetes\x18\b \x01(\bR\bdiabetes\x12+\n" +
+	"\x11bleeding_disorder\x18\t \x01(\bR\x10bleedingDisorder\x12-\n" +
+	"\x12infectious_disease\x18\n" +
+	" \x01(\bR\x11infectiousDisease\x12/\n" +
+	"\x13anesthesia_reaction\x18\v \x01(\bR\x12anesthesiaReaction\x12\x1a\n" +
+	"\bpregnant\x18\f \x01(\bR\bpregnant\x12$\n" +
+	"\rbreastfeeding\x18\r \x01(\bR\rbreastfeeding\x12\x16\n" +
+	"\x06smoker\x18\x0e \x01(\bR\x06smoker\x12)\n" +
+	"\x10other_conditions\x18\x0f \x01(\tR\x0fotherConditions\x127\n" +
+	"\tallergies\x18\x10 \x03(\v2\x19.storage.AnamnesisAllergyR\tallergies\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x11 \x01(\x05R\tchangedBy\x12&\n" +
+	"\x0fchanged_by_role\x18\x12 \x01(\tR\rchangedByRole\x129\n" +
+	"\n" +
+	"created_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"k\n" +
+	"\x14SaveAnamnesisRequest\x120\n" +
+	"\tanamnesis\x18\x01 \x01(\v2\x12.storage.AnamnesisR\tanamnesis\x12!\n" +
+	"\fbase_version\x18\x02 \x01(\x05R\vbaseVersion\"E\n" +
+	"\x11AnamnesisResponse\x120\n" +
+	"\tanamnesis\x18\x01 \x01(\v2\x12.storage.AnamnesisR\tanamnesis\"J\n" +
+	"\x18AnamnesisHistoryResponse\x12.\n" +
+	"\bversions\x18\x01 \x03(\v2\x12.storage.AnamnesisR\bversions\"\x99\x03\n" +
+	"\x11ClinicalAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
+	"\x0econdition_type\x18\x03 \x01(\tR\rconditionType\x12'\n" +
+	"\x0fcondition_value\x18\x04 \x01(\tR\x0econditionValue\x12\x1f\n" +
+	"\vtarget_type\x18\x05 \x01(\tR\n" +
+	"targetType\x12\x1f\n" +
+	"\vmaterial_id\x18\x06 \x01(\x05R\n" +
+	"materialId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\a \x01(\x05R\tserviceId\x12\x1f\n" +
+	"\vtarget_name\x18\b \x01(\tR\n" +
+	"targetName\x12\x1a\n" +
+	"\bseverity\x18\t \x01(\tR\bseverity\x12\x18\n" +
+	"\amessage\x18\n" +
+	" \x01(\tR\amessage\x12\x1b\n" +
+	"\tis_active\x18\v \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"?\n" +
+	"\x1cGetClinicalAlertRulesRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\"Q\n" +
+	"\x1dGetClinicalAlertRulesResponse\x120\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1a.storage.ClinicalAlertRuleR\x05rules\".\n" +
+	"\x1cAddClinicalAlertRuleResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x90\x02\n" +
+	"\rMedicalRecord\x12*\n" +
+	"\apatient\x18\x01 \x01(\v2\x10.storage.PatientR\apatient\x127\n" +
+	"\x05notes\x18\x02 \x03(\v2!.storage.PatientAllergiesChronicsR\x05notes\x120\n" +
+	"\tanamnesis\x18\x03 \x01(\v2\x12.storage.AnamnesisR\tanamnesis\x123\n" +
+	"\x06visits\x18\x04 \x03(\v2\x1b.storage.MedicalRecordVisitR\x06visits\x123\n" +
+	"\tdocuments\x18\x05 \x03(\v2\x15.storage.DocumentInfoR\tdocuments\"\x8a\x03\n" +
+	"\x12MedicalRecordVisit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12\x16\n" +
+	"\x06doctor\x18\x03 \x01(\tR\x06doctor\x12\x1e\n" +
+	"\n" +
+	"complaints\x18\x04 \x01(\tR\n" +
+	"complaints\x12\x1c\n" +
+	"\ttreatment\x18\x05 \x01(\tR\ttreatment\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\tdiagnoses\x18\a \x03(\v2\x1f.storage.MedicalRecordDiagnosisR\tdiagnoses\x129\n" +
+	"\bservices\x18\b \x03(\v2\x1d.storage.MedicalRecordServiceR\bservices\x12<\n" +
+	"\tmaterials\x18\t \x03(\v2\x1e.storage.MedicalRecordMaterialR\tmaterials\"\x86\x01\n" +
+	"\x16MedicalRecordDiagnosis\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x14\n" +
+	"\x05tooth\x18\x04 \x01(\x05R\x05tooth\x12\x1a\n" +
+	"\bsurfaces\x18\x05 \x01(\tR\bsurfaces\"\x97\x01\n" +
+	"\x14MedicalRecordService\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\x05R\tserviceId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05tooth\x18\x04 \x01(\x05R\x05tooth\x12\x1a\n" +
+	"\bsurfaces\x18\x05 \x01(\tR\bsurfaces\"h\n" +
+	"\x15MedicalRecordMaterial\x12\x1f\n" +
+	"\vmaterial_id\x18\x01 \x01(\x05R\n" +
+	"materialId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"Z\n" +
+	"\x1cDoctorPatientRelationRequest\x12\x1b\n" +
+	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\"M\n" +
+	"\x15DoctorPatientRelation\x12\x1a\n" +
+	"\btreating\x18\x01 \x01(\bR\btreating\x12\x18\n" +
+	"\agranted\x18\x02 \x01(\bR\agranted\"\xe0\x02\n" +
+	"\x12PatientAccessGrant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x03 \x01(\x05R\bdoctorId\x12\x16\n" +
+	"\x06doctor\x18\x04 \x01(\tR\x06doctor\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x06 \x01(\x05R\tgrantedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"U\n" +
+	"\x1eGetPatientAccessGrantsResponse\x123\n" +
+	"\x06grants\x18\x01 \x03(\v2\x1b.storage.PatientAccessGrantR\x06grants\"/\n" +
+	"\x1dAddPatientAccessGrantResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xd2\x01\n" +
+	"\x1bReceiveMaterialBatchRequest\x12\x1f\n" +
+	"\vmaterial_id\x18\x01 \x01(\x05R\n" +
+	"materialId\x12!\n" +
+	"\fbatch_number\x18\x02 \x01(\tR\vbatchNumber\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"\x8c\x01\n" +
+	"\x1aAdjustMaterialStockRequest\x12\x1f\n" +
+	"\vmaterial_id\x18\x01 \x01(\x05R\n" +
+	"materialId\x12\x19\n" +
+	"\bbatch_id\x18\x02 \x01(\x05R\abatchId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xae\x01\n" +
+	"\x15MaterialStockResponse\x12-\n" +
+	"\bmaterial\x18\x01 \x01(\v2\x11.storage.MaterialR\bmaterial\x120\n" +
+	"\abatches\x18\x02 \x03(\v2\x16.storage.MaterialBatchR\abatches\x124\n" +
+	"\tmovements\x18\x03 \x03(\v2\x16.storage.StockMovementR\tmovements\"Y\n" +
+	"\x12StockReportRequest\x12C\n" +
+	"\x0fexpiring_before\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0eexpiringBefore\"v\n" +
+	"\rExpiringBatch\x12,\n" +
+	"\x05batch\x18\x01 \x01(\v2\x16.storage.MaterialBatchR\x05batch\x12#\n" +
+	"\rmaterial_name\x18\x02 \x01(\tR\fmaterialName\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\"y\n" +
+	"\x13StockReportResponse\x12.\n" +
+	"\tlow_stock\x18\x01 \x03(\v2\x11.storage.MaterialR\blowStock\x122\n" +
+	"\bexpiring\x18\x02 \x03(\v2\x16.storage.ExpiringBatchR\bexpiring\"\x82\x01\n" +
 	"\aService\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\x05R\x04type\"G\n" +
+	"\x04type\x18\x04 \x01(\x05R\x04type\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\"G\n" +
 	"\x14GetMaterialsResponse\x12/\n" +
 	"\tmaterials\x18\x01 \x03(\v2\x11.storage.MaterialR\tmaterials\"C\n" +
 	"\x13GetServicesResponse\x12,\n" +
@@ -6531,7 +15229,13 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
 	"\x04type\x18\x04 \x01(\x05R\x04type\"\x1f\n" +
 	"\rDeleteRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"Q\n" +
+	"\x17ChangeUserStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x02 \x01(\x05R\tchangedBy\"2\n" +
+	"\x18ChangeUserStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"1\n" +
 	"\vServiceType\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"F\n" +
@@ -6548,12 +15252,14 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\aICDCode\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\"i\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x9b\x01\n" +
 	"\bDiagnose\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\bvisit_id\x18\x02 \x01(\x05R\avisitId\x12\x1e\n" +
 	"\vicd_code_id\x18\x03 \x01(\x05R\ticdCodeId\x12\x12\n" +
-	"\x04note\x18\x04 \x01(\tR\x04note\"\xf3\x01\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x14\n" +
+	"\x05tooth\x18\x05 \x01(\x05R\x05tooth\x12\x1a\n" +
+	"\bsurfaces\x18\x06 \x01(\tR\bsurfaces\"\xf3\x01\n" +
 	"\x05Visit\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12%\n" +
 	"\x0eappointment_id\x18\x02 \x01(\x05R\rappointmentId\x12\x1d\n" +
@@ -6578,12 +15284,14 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"materialId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x05R\x06amount\"T\n" +
 	"\x18AddVisitMaterialsRequest\x128\n" +
-	"\tmaterials\x18\x01 \x03(\v2\x1a.storage.AddVisitMaterialsR\tmaterials\"d\n" +
+	"\tmaterials\x18\x01 \x03(\v2\x1a.storage.AddVisitMaterialsR\tmaterials\"\x96\x01\n" +
 	"\x10AddVisitServices\x12\x19\n" +
 	"\bvisit_id\x18\x01 \x01(\x05R\avisitId\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\x02 \x01(\x05R\tserviceId\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x05R\x06amount\"P\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x14\n" +
+	"\x05tooth\x18\x04 \x01(\x05R\x05tooth\x12\x1a\n" +
+	"\bsurfaces\x18\x05 \x01(\tR\bsurfaces\"P\n" +
 	"\x17AddVisitServicesRequest\x125\n" +
 	"\bservices\x18\x01 \x03(\v2\x19.storage.AddVisitServicesR\bservices\"]\n" +
 	"\"AddPatientAllergiesChronicsRequest\x127\n" +
@@ -6606,9 +15314,13 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x18GetPatientVisitsResponse\x12&\n" +
 	"\x06visits\x18\x01 \x03(\v2\x0e.storage.VisitR\x06visits\"\x86\x01\n" +
 	"#GetPatientAllergiesChronicsResponse\x12_\n" +
-	"\x1apatient_allergies_chronics\x18\x01 \x03(\v2!.storage.PatientAllergiesChronicsR\x18patientAllergiesChronics\"B\n" +
+	"\x1apatient_allergies_chronics\x18\x01 \x03(\v2!.storage.PatientAllergiesChronicsR\x18patientAllergiesChronics\"Q\n" +
+	"\x12GetICDCodesRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\"X\n" +
 	"\x13GetICDCodesResponse\x12+\n" +
-	"\bicd_code\x18\x01 \x03(\v2\x10.storage.ICDCodeR\aicdCode\"\"\n" +
+	"\bicd_code\x18\x01 \x03(\v2\x10.storage.ICDCodeR\aicdCode\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\"\n" +
 	"\x10AddVisitResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"W\n" +
 	"\fVisitPayment\x12\x19\n" +
@@ -6618,13 +15330,141 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x13VisitPaymentRequest\x12\x19\n" +
 	"\bvisit_id\x18\x01 \x01(\x05R\avisitId\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x05R\x05price\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"W\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"A\n" +
+	"\x18GetVisitsPaymentsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\"m\n" +
 	"\x19GetVisitsPaymentsResponse\x12:\n" +
-	"\rvisit_payment\x18\x01 \x03(\v2\x15.storage.VisitPaymentR\fvisitPayment\"7\n" +
+	"\rvisit_payment\x18\x01 \x03(\v2\x15.storage.VisitPaymentR\fvisitPayment\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"7\n" +
 	"\x1aCalculateVisitTotalRequest\x12\x19\n" +
 	"\bvisit_id\x18\x01 \x01(\x05R\avisitId\"3\n" +
 	"\x1bCalculateVisitTotalResponse\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x05R\x05total\"Q\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\"\xd9\x03\n" +
+	"\x1bCompleteConsultationRequest\x12'\n" +
+	"\x0fidempotency_key\x18\x01 \x01(\tR\x0eidempotencyKey\x12%\n" +
+	"\x0eappointment_id\x18\x02 \x01(\x05R\rappointmentId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x03 \x01(\x05R\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x04 \x01(\x05R\bdoctorId\x12\x1e\n" +
+	"\n" +
+	"complaints\x18\x05 \x01(\tR\n" +
+	"complaints\x12\x1c\n" +
+	"\ttreatment\x18\x06 \x01(\tR\ttreatment\x12/\n" +
+	"\tdiagnoses\x18\a \x03(\v2\x11.storage.DiagnoseR\tdiagnoses\x125\n" +
+	"\bservices\x18\b \x03(\v2\x19.storage.AddVisitServicesR\bservices\x128\n" +
+	"\tmaterials\x18\t \x03(\v2\x1a.storage.AddVisitMaterialsR\tmaterials\x12\"\n" +
+	"\rplan_item_ids\x18\n" +
+	" \x03(\x05R\vplanItemIds\x12*\n" +
+	"\x05chart\x18\v \x03(\v2\x14.storage.ToothRecordR\x05chart\"Z\n" +
+	"\x1cCompleteConsultationResponse\x12\x19\n" +
+	"\bvisit_id\x18\x01 \x01(\x05R\avisitId\x12\x1f\n" +
+	"\vtotal_price\x18\x02 \x01(\x05R\n" +
+	"totalPrice\"\x85\x04\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bvisit_id\x18\x02 \x01(\x05R\avisitId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x03 \x01(\x05R\tpatientId\x12\x1a\n" +
+	"\bsubtotal\x18\x04 \x01(\x05R\bsubtotal\x12#\n" +
+	"\rdiscount_kind\x18\x05 \x01(\tR\fdiscountKind\x12%\n" +
+	"\x0ediscount_value\x18\x06 \x01(\x05R\rdiscountValue\x12'\n" +
+	"\x0fdiscount_reason\x18\a \x01(\tR\x0ediscountReason\x12'\n" +
+	"\x0fdiscount_amount\x18\b \x01(\x05R\x0ediscountAmount\x12\x14\n" +
+	"\x05total\x18\t \x01(\x05R\x05total\x12\x12\n" +
+	"\x04paid\x18\n" +
+	" \x01(\x05R\x04paid\x12\x18\n" +
+	"\abalance\x18\v \x01(\x05R\abalance\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12*\n" +
+	"\x05lines\x18\x0e \x03(\v2\x14.storage.InvoiceLineR\x05lines\x123\n" +
+	"\bpayments\x18\x0f \x03(\v2\x17.storage.InvoicePaymentR\bpayments\"\xc1\x01\n" +
+	"\vInvoiceLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\x05R\x06itemId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12$\n" +
+	"\x0eprice_per_unit\x18\x06 \x01(\x05R\fpricePerUnit\x12\x1f\n" +
+	"\vtotal_price\x18\a \x01(\x05R\n" +
+	"totalPrice\"\xd6\x01\n" +
+	"\x0eInvoicePayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x1b\n" +
+	"\trefund_of\x18\x05 \x01(\x05R\brefundOf\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"=\n" +
+	"\x0fInvoiceResponse\x12*\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x10.storage.InvoiceR\ainvoice\"|\n" +
+	"\x19SetInvoiceDiscountRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\x05R\tinvoiceId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x05R\x05value\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x83\x01\n" +
+	"\x18AddInvoicePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\x05R\tinvoiceId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x05R\x06amount\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"n\n" +
+	"\x1bRefundInvoicePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x05R\tpaymentId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x05R\x06amount\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\xc0\x01\n" +
+	"\x16PatientBalanceResponse\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\x05R\tpatientId\x12\x1a\n" +
+	"\binvoiced\x18\x02 \x01(\x05R\binvoiced\x12\x12\n" +
+	"\x04paid\x18\x03 \x01(\x05R\x04paid\x12 \n" +
+	"\voutstanding\x18\x04 \x01(\x05R\voutstanding\x125\n" +
+	"\ropen_invoices\x18\x05 \x03(\v2\x10.storage.InvoiceR\fopenInvoices\"\xe7\x01\n" +
+	"\x0fBillingDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\x05R\x06number\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x04 \x01(\x05R\tinvoiceId\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x05 \x01(\x05R\tpaymentId\x12\x1f\n" +
+	"\vdocument_id\x18\x06 \x01(\tR\n" +
+	"documentId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"O\n" +
+	"\x1cAttachBillingDocumentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\tR\n" +
+	"documentId\"\xcd\x01\n" +
+	"\x0ePriceListEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"E\n" +
+	"\x16GetPriceHistoryRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\"L\n" +
+	"\x17GetPriceHistoryResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.storage.PriceListEntryR\aentries\"]\n" +
+	"\x18AddPriceListEntryRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12-\n" +
+	"\x05entry\x18\x02 \x01(\v2\x17.storage.PriceListEntryR\x05entry\"+\n" +
+	"\x19AddPriceListEntryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"A\n" +
+	"\x1bDeletePriceListEntryRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"t\n" +
+	"\x15GetPriceOnDateRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\".\n" +
+	"\x16GetPriceOnDateResponse\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x05R\x05price\"Q\n" +
 	"\x1eAddOrUpdateVisitPaymentRequest\x12/\n" +
 	"\apayment\x18\x01 \x01(\v2\x15.storage.VisitPaymentR\apayment\"<\n" +
 	"\x14GetVisitByIDResponse\x12$\n" +
@@ -6638,30 +15478,273 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\n" +
 	"is_day_off\x18\x05 \x01(\bR\bisDayOff\"S\n" +
 	"\x1aGetClinicOverridesResponse\x125\n" +
-	"\toverrides\x18\x01 \x03(\v2\x17.storage.ClinicOverrideR\toverrides\"S\n" +
+	"\toverrides\x18\x01 \x03(\v2\x17.storage.ClinicOverrideR\toverrides\"\xe6\x01\n" +
+	"\x16GetAppointmentsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x127\n" +
+	"\tdate_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\x1a\n" +
+	"\bstatuses\x18\x04 \x03(\tR\bstatuses\x12\x1b\n" +
+	"\tdoctor_id\x18\x05 \x01(\x05R\bdoctorId\"i\n" +
 	"\x17GetAppointmentsResponse\x128\n" +
-	"\fappointments\x18\x01 \x03(\v2\x14.storage.AppointmentR\fappointments\"y\n" +
+	"\fappointments\x18\x01 \x03(\v2\x14.storage.AppointmentR\fappointments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x9f\x02\n" +
 	"\x17VisitMaterialAndService\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\bvisit_id\x18\x02 \x01(\x05R\avisitId\x12\x17\n" +
 	"\aitem_id\x18\x03 \x01(\x05R\x06itemId\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x05R\bquantity\"\x82\x01\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12)\n" +
+	"\x0eprice_per_unit\x18\x05 \x01(\x05H\x00R\fpricePerUnit\x88\x01\x01\x12$\n" +
+	"\vtotal_price\x18\x06 \x01(\x05H\x01R\n" +
+	"totalPrice\x88\x01\x01\x12\x14\n" +
+	"\x05tooth\x18\a \x01(\x05R\x05tooth\x12\x1a\n" +
+	"\bsurfaces\x18\b \x01(\tR\bsurfacesB\x11\n" +
+	"\x0f_price_per_unitB\x0e\n" +
+	"\f_total_price\"\x82\x01\n" +
 	"$GetVisitMaterialsAndServicesResponse\x12Z\n" +
-	"\x18visit_materials_services\x18\x01 \x03(\v2 .storage.VisitMaterialAndServiceR\x16visitMaterialsServices\"Z\n" +
+	"\x18visit_materials_services\x18\x01 \x03(\v2 .storage.VisitMaterialAndServiceR\x16visitMaterialsServices\"\x85\x01\n" +
 	"\x1eGetMaterialServiceByIDResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x05R\x05price2\xdf-\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12)\n" +
+	"\x10duration_minutes\x18\x04 \x01(\x05R\x0fdurationMinutes\"\x1f\n" +
+	"\vIntResponse\x12\x10\n" +
+	"\x03int\x18\x01 \x01(\x05R\x03int\"%\n" +
+	"\rFloatResponse\x12\x14\n" +
+	"\x05float\x18\x01 \x01(\x02R\x05float\"C\n" +
+	"\fServiceStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vusage_count\x18\x02 \x01(\x05R\n" +
+	"usageCount\"R\n" +
+	"\x14ServiceStatsResponse\x12:\n" +
+	"\rservice_stats\x18\x01 \x03(\v2\x15.storage.ServiceStatsR\fserviceStats\"Y\n" +
+	"\x0eDoctorAvgVisit\x12\x1b\n" +
+	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\x12*\n" +
+	"\x11avg_weekly_visits\x18\x02 \x01(\x02R\x0favgWeeklyVisits\"I\n" +
+	"\x16DoctorAvgVisitResponse\x12/\n" +
+	"\x06visits\x18\x01 \x03(\v2\x17.storage.DoctorAvgVisitR\x06visits\"G\n" +
+	"\vDoctorCheck\x12\x1b\n" +
+	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\x12\x1b\n" +
+	"\tavg_check\x18\x02 \x01(\x02R\bavgCheck\"D\n" +
+	"\x16DoctorAvgCheckResponse\x12*\n" +
+	"\x05check\x18\x01 \x03(\v2\x14.storage.DoctorCheckR\x05check\"[\n" +
+	"\x13DoctorUniquePatient\x12\x1b\n" +
+	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\x12'\n" +
+	"\x0funique_patients\x18\x02 \x01(\x05R\x0euniquePatients\"W\n" +
+	"\x1bDoctorUniquePatientResponse\x128\n" +
+	"\bpatients\x18\x01 \x03(\v2\x1c.storage.DoctorUniquePatientR\bpatients\"E\n" +
+	"\fAgeGroupStat\x12\x1b\n" +
+	"\tage_group\x18\x01 \x01(\x05R\bageGroup\x12\x18\n" +
+	"\apercent\x18\x02 \x01(\x02R\apercent\"L\n" +
+	"\x14AgeGroupStatResponse\x124\n" +
+	"\n" +
+	"age_groups\x18\x01 \x03(\v2\x15.storage.AgeGroupStatR\tageGroups\"M\n" +
+	"\x1cGetDiagnoseByVisitIDResponse\x12-\n" +
+	"\bdiagnose\x18\x01 \x03(\v2\x11.storage.DiagnoseR\bdiagnose\"\x88\x02\n" +
+	"\x1aStartDocumentUploadRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\tR\tpatientId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bmodality\x18\x04 \x01(\tR\bmodality\x129\n" +
+	"\n" +
+	"study_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstudyDate\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12!\n" +
+	"\fpreview_jpeg\x18\a \x01(\fR\vpreviewJpeg\":\n" +
+	"\x1bStartDocumentUploadResponse\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\"^\n" +
+	"\x13UploadDocumentChunk\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\":\n" +
+	"\x1bDocumentUploadStatusRequest\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\"g\n" +
+	"\x14DocumentUploadStatus\x12\x1a\n" +
+	"\breceived\x18\x01 \x01(\x03R\breceived\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x1f\n" +
+	"\vdocument_id\x18\x03 \x01(\tR\n" +
+	"documentId\"=\n" +
+	"\x1aGetDocumentMetadataRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\"\xf4\x02\n" +
+	"\x1bGetDocumentMetadataResponse\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\tR\tpatientId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x1a\n" +
+	"\bmodality\x18\x04 \x01(\tR\bmodality\x129\n" +
+	"\n" +
+	"study_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstudyDate\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12!\n" +
+	"\fstorage_path\x18\a \x01(\tR\vstoragePath\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fpreview_path\x18\t \x01(\tR\vpreviewPath\"R\n" +
+	"\x17DownloadDocumentRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\"x\n" +
+	"\x15DownloadDocumentChunk\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bchecksum\x18\x03 \x01(\tR\bchecksum\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"4\n" +
+	"\x13GetDocumentsRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\tR\tpatientId\"\xd3\x01\n" +
+	"\fDocumentInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bmodality\x18\x04 \x01(\tR\bmodality\x12\x1d\n" +
+	"\n" +
+	"study_date\x18\x05 \x01(\tR\tstudyDate\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"K\n" +
+	"\x14GetDocumentsResponse\x123\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x15.storage.DocumentInfoR\tdocuments\"<\n" +
+	"\x14GetAdminByIDResponse\x12$\n" +
+	"\x05admin\x18\x01 \x01(\v2\x0e.storage.AdminR\x05admin\"-\n" +
+	"\x0eClinicSettings\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\":\n" +
+	"\x1bUpdateClinicTimeZoneRequest\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\"\xcc\x02\n" +
+	"\x10WaitingListEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x03 \x01(\x05R\bdoctorId\x12+\n" +
+	"\x11specialization_id\x18\x04 \x01(\x05R\x10specializationId\x127\n" +
+	"\tdate_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb8\x02\n" +
+	"\x10WaitingListOffer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bentry_id\x18\x02 \x01(\x05R\aentryId\x12\x1b\n" +
+	"\tdoctor_id\x18\x03 \x01(\x05R\bdoctorId\x12.\n" +
+	"\x04date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12.\n" +
+	"\x04time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12)\n" +
+	"\x10duration_minutes\x18\x06 \x01(\x05R\x0fdurationMinutes\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"M\n" +
+	"\x1aAddWaitingListEntryRequest\x12/\n" +
+	"\x05entry\x18\x01 \x01(\v2\x19.storage.WaitingListEntryR\x05entry\"-\n" +
+	"\x1bAddWaitingListEntryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x80\x01\n" +
+	"\x16GetWaitingListResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.storage.WaitingListEntryR\aentries\x121\n" +
+	"\x06offers\x18\x02 \x03(\v2\x19.storage.WaitingListOfferR\x06offers\"N\n" +
+	"\x1dCancelWaitingListEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\"r\n" +
+	"\x1dAcceptWaitingListOfferRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\x126\n" +
+	"\vappointment\x18\x02 \x01(\v2\x14.storage.AppointmentR\vappointment\"G\n" +
+	"\x1eAcceptWaitingListOfferResponse\x12%\n" +
+	"\x0eappointment_id\x18\x01 \x01(\x05R\rappointmentId\"Z\n" +
+	"\x1eDeclineWaitingListOfferRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\"\xcf\x03\n" +
+	"\fScheduleRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x03 \x01(\tR\n" +
+	"recurrence\x127\n" +
+	"\tdate_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\x18\n" +
+	"\aweekday\x18\x06 \x01(\x05R\aweekday\x12\x10\n" +
+	"\x03nth\x18\a \x01(\x05R\x03nth\x129\n" +
+	"\n" +
+	"start_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x122\n" +
+	"\x15slot_duration_minutes\x18\n" +
+	" \x01(\x05R\x13slotDurationMinutes\x12\x1c\n" +
+	"\n" +
+	"is_day_off\x18\v \x01(\bR\bisDayOff\x12\x14\n" +
+	"\x05title\x18\f \x01(\tR\x05title\"6\n" +
+	"\x17GetScheduleRulesRequest\x12\x1b\n" +
+	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\"G\n" +
+	"\x18GetScheduleRulesResponse\x12+\n" +
+	"\x05rules\x18\x01 \x03(\v2\x15.storage.ScheduleRuleR\x05rules\"C\n" +
+	"\x16AddScheduleRuleRequest\x12)\n" +
+	"\x04rule\x18\x01 \x01(\v2\x15.storage.ScheduleRuleR\x04rule\")\n" +
+	"\x17AddScheduleRuleResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"I\n" +
+	"\x1aImportScheduleRulesRequest\x12+\n" +
+	"\x05rules\x18\x01 \x03(\v2\x15.storage.ScheduleRuleR\x05rules\"3\n" +
+	"\x1bImportScheduleRulesResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x05R\x05added\"\xf4\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x04 \x01(\x05R\tpatientId\x12\x1c\n" +
+	"\trecipient\x18\x05 \x01(\tR\trecipient\x12\x18\n" +
+	"\apayload\x18\x06 \x01(\tR\apayload\x123\n" +
+	"\asend_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06sendAt\x12\x1a\n" +
+	"\battempts\x18\b \x01(\x05R\battempts\"r\n" +
+	"\x19ClaimNotificationsRequest\x12\x1a\n" +
+	"\bchannels\x18\x01 \x03(\tR\bchannels\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12#\n" +
+	"\rlease_seconds\x18\x03 \x01(\x05R\fleaseSeconds\"Y\n" +
+	"\x1aClaimNotificationsResponse\x12;\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x15.storage.NotificationR\rnotifications\"C\n" +
+	"\x1bCompleteNotificationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"U\n" +
+	"\x1bPatientNotificationSettings\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\x05R\tpatientId\x12\x17\n" +
+	"\aopt_out\x18\x02 \x01(\bR\x06optOut\"\xcf\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\x05R\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_role\x18\x06 \x01(\x05R\tactorRole\x12\x1a\n" +
+	"\bresource\x18\a \x01(\tR\bresource\x12\x1f\n" +
+	"\vresource_id\x18\b \x01(\tR\n" +
+	"resourceId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\t \x01(\x05R\tpatientId\x12\x18\n" +
+	"\aoutcome\x18\n" +
+	" \x01(\tR\aoutcome\x12\x12\n" +
+	"\x04hash\x18\v \x01(\fR\x04hash\"\xe3\x01\n" +
+	"\x12GetAuditLogRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x05R\aactorId\x127\n" +
+	"\tdate_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\"Z\n" +
+	"\x13GetAuditLogResponse\x12-\n" +
+	"\aentries\x18\x01 \x03(\v2\x13.storage.AuditEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"l\n" +
+	"\x16VerifyAuditLogResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x05R\achecked\x12\x1b\n" +
+	"\tbroken_id\x18\x02 \x01(\x03R\bbrokenId\x12\x1b\n" +
+	"\tlast_hash\x18\x03 \x01(\fR\blastHash2\xabe\n" +
 	"\x0eStorageService\x12<\n" +
 	"\aAddUser\x12\x17.storage.AddUserRequest\x1a\x18.storage.AddUserResponse\x12B\n" +
 	"\tAddDoctor\x12\x19.storage.AddDoctorRequest\x1a\x1a.storage.AddDoctorResponse\x12?\n" +
 	"\bAddAdmin\x12\x18.storage.AddAdminRequest\x1a\x19.storage.AddAdminResponse\x12E\n" +
 	"\n" +
-	"AddPatient\x12\x1a.storage.AddPatientRequest\x1a\x1b.storage.AddPatientResponse\x12@\n" +
+	"AddPatient\x12\x1a.storage.AddPatientRequest\x1a\x1b.storage.AddPatientResponse\x12E\n" +
 	"\n" +
-	"GetDoctors\x12\x15.storage.EmptyRequest\x1a\x1b.storage.GetDoctorsResponse\x12>\n" +
-	"\tGetAdmins\x12\x15.storage.EmptyRequest\x1a\x1a.storage.GetAdminsResponse\x12B\n" +
-	"\vGetPatients\x12\x15.storage.EmptyRequest\x1a\x1c.storage.GetPatientsResponse\x12^\n" +
+	"GetDoctors\x12\x1a.storage.GetDoctorsRequest\x1a\x1b.storage.GetDoctorsResponse\x12B\n" +
+	"\tGetAdmins\x12\x19.storage.GetAdminsRequest\x1a\x1a.storage.GetAdminsResponse\x12H\n" +
+	"\vGetPatients\x12\x1b.storage.GetPatientsRequest\x1a\x1c.storage.GetPatientsResponse\x12^\n" +
 	"\x18GetDoctorSpecsByDoctorId\x12\x17.storage.GetByIdRequest\x1a).storage.GetDoctorSpecsByDoctorIdResponse\x12F\n" +
 	"\fUpdateDoctor\x12\x1c.storage.UpdateDoctorRequest\x1a\x18.storage.DefaultResponse\x12H\n" +
 	"\rAddDoctorSpec\x12\x1d.storage.AddDoctorSpecRequest\x1a\x18.storage.DefaultResponse\x12N\n" +
@@ -6670,7 +15753,10 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x0fUpdateAdminRole\x12\x1f.storage.UpdateAdminRoleRequest\x1a\x18.storage.DefaultResponse\x12H\n" +
 	"\rUpdatePatient\x12\x1d.storage.UpdatePatientRequest\x1a\x18.storage.DefaultResponse\x12>\n" +
 	"\n" +
-	"DeleteUser\x12\x16.storage.DeleteRequest\x1a\x18.storage.DefaultResponse\x12L\n" +
+	"DeleteUser\x12\x16.storage.DeleteRequest\x1a\x18.storage.DefaultResponse\x12Q\n" +
+	"\n" +
+	"RetireUser\x12 .storage.ChangeUserStatusRequest\x1a!.storage.ChangeUserStatusResponse\x12R\n" +
+	"\vRestoreUser\x12 .storage.ChangeUserStatusRequest\x1a!.storage.ChangeUserStatusResponse\x12L\n" +
 	"\x0fUpdateUserLogin\x12\x1f.storage.UpdateUserLoginRequest\x1a\x18.storage.DefaultResponse\x12B\n" +
 	"\vGetAllSpecs\x12\x15.storage.EmptyRequest\x1a\x1c.storage.GetAllSpecsResponse\x12H\n" +
 	"\vAddUserRole\x12\x1b.storage.AddUserRoleRequest\x1a\x1c.storage.AddUserRoleResponse\x12Q\n" +
@@ -6685,17 +15771,35 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x11GetRolePermission\x12!.storage.GetRolePermissionRequest\x1a\x18.storage.DefaultResponse\x12T\n" +
 	"\x12GetDoctorsBySpecID\x12!.storage.GetDoctorBySpecIDRequest\x1a\x1b.storage.GetDoctorsResponse\x12r\n" +
 	"\x19GetAppointmentsByDoctorID\x12).storage.GetAppointmentsByDoctorIDRequest\x1a*.storage.GetAppointmentsByDoctorIDResponse\x12J\n" +
+	"\x0eGetPatientByID\x12\x17.storage.GetByIDRequest\x1a\x1f.storage.GetPatientByIDResponse\x12J\n" +
 	"\x0eAddAppointment\x12\x1e.storage.AddAppointmentRequest\x1a\x18.storage.DefaultResponse\x12\\\n" +
 	"\x17GetAppointmentsByUserID\x12\x17.storage.GetByIDRequest\x1a(.storage.GetAppointmentsByUserIDResponse\x12R\n" +
 	"\x12GetSpecsByDoctorID\x12\x17.storage.GetByIDRequest\x1a#.storage.GetSpecsByDoctorIDResponse\x12H\n" +
 	"\rGetDoctorByID\x12\x17.storage.GetByIDRequest\x1a\x1e.storage.GetDoctorByIDResponse\x12P\n" +
-	"\x11UpdateAppointment\x12!.storage.UpdateAppointmentRequest\x1a\x18.storage.DefaultResponse\x12J\n" +
-	"\x0eGetPatientByID\x12\x17.storage.GetByIDRequest\x1a\x1f.storage.GetPatientByIDResponse\x12R\n" +
-	"\x12GetAppointmentByID\x12\x17.storage.GetByIDRequest\x1a#.storage.GetAppointmentByIDResponse\x12Z\n" +
+	"\x11UpdateAppointment\x12!.storage.UpdateAppointmentRequest\x1a\x18.storage.DefaultResponse\x12R\n" +
+	"\x12GetAppointmentByID\x12\x17.storage.GetByIDRequest\x1a#.storage.GetAppointmentByIDResponse\x12`\n" +
+	"\x13HoldAppointmentSlot\x12#.storage.HoldAppointmentSlotRequest\x1a$.storage.HoldAppointmentSlotResponse\x12Z\n" +
+	"\x16ReleaseAppointmentSlot\x12&.storage.ReleaseAppointmentSlotRequest\x1a\x18.storage.DefaultResponse\x12n\n" +
+	"\x17GetAppointmentSlotHolds\x12).storage.GetAppointmentsByDoctorIDRequest\x1a(.storage.GetAppointmentSlotHoldsResponse\x12Z\n" +
 	"\x16AddClinicDailyOverride\x12&.storage.AddClinicDailyOverrideRequest\x1a\x18.storage.DefaultResponse\x12Z\n" +
 	"\x16AddDoctorDailyOverride\x12&.storage.AddDoctorDailyOverrideRequest\x1a\x18.storage.DefaultResponse\x12Z\n" +
 	"\x11GetClinicOverride\x12!.storage.GetClinicOverrideRequest\x1a\".storage.GetClinicOverrideResponse\x12Z\n" +
-	"\x11GetDoctorOverride\x12!.storage.GetDoctorOverrideRequest\x1a\".storage.GetDoctorOverrideResponse\x12D\n" +
+	"\x11GetDoctorOverride\x12!.storage.GetDoctorOverrideRequest\x1a\".storage.GetDoctorOverrideResponse\x12C\n" +
+	"\x11GetClinicSettings\x12\x15.storage.EmptyRequest\x1a\x17.storage.ClinicSettings\x12V\n" +
+	"\x14UpdateClinicTimeZone\x12$.storage.UpdateClinicTimeZoneRequest\x1a\x18.storage.DefaultResponse\x12`\n" +
+	"\x13AddWaitingListEntry\x12#.storage.AddWaitingListEntryRequest\x1a$.storage.AddWaitingListEntryResponse\x12U\n" +
+	"\x19GetWaitingListByPatientID\x12\x17.storage.GetByIDRequest\x1a\x1f.storage.GetWaitingListResponse\x12Z\n" +
+	"\x16CancelWaitingListEntry\x12&.storage.CancelWaitingListEntryRequest\x1a\x18.storage.DefaultResponse\x12i\n" +
+	"\x16AcceptWaitingListOffer\x12&.storage.AcceptWaitingListOfferRequest\x1a'.storage.AcceptWaitingListOfferResponse\x12\\\n" +
+	"\x17DeclineWaitingListOffer\x12'.storage.DeclineWaitingListOfferRequest\x1a\x18.storage.DefaultResponse\x12W\n" +
+	"\x10GetScheduleRules\x12 .storage.GetScheduleRulesRequest\x1a!.storage.GetScheduleRulesResponse\x12T\n" +
+	"\x0fAddScheduleRule\x12\x1f.storage.AddScheduleRuleRequest\x1a .storage.AddScheduleRuleResponse\x12`\n" +
+	"\x13ImportScheduleRules\x12#.storage.ImportScheduleRulesRequest\x1a$.storage.ImportScheduleRulesResponse\x12F\n" +
+	"\x12DeleteScheduleRule\x12\x16.storage.DeleteRequest\x1a\x18.storage.DefaultResponse\x12]\n" +
+	"\x12ClaimNotifications\x12\".storage.ClaimNotificationsRequest\x1a#.storage.ClaimNotificationsResponse\x12V\n" +
+	"\x14CompleteNotification\x12$.storage.CompleteNotificationRequest\x1a\x18.storage.DefaultResponse\x12_\n" +
+	"\x1eGetPatientNotificationSettings\x12\x17.storage.GetByIDRequest\x1a$.storage.PatientNotificationSettings\x12c\n" +
+	"!UpdatePatientNotificationSettings\x12$.storage.PatientNotificationSettings\x1a\x18.storage.DefaultResponse\x12D\n" +
 	"\vAddMaterial\x12\x1b.storage.AddMaterialRequest\x1a\x18.storage.DefaultResponse\x12B\n" +
 	"\n" +
 	"AddService\x12\x1a.storage.AddServiceRequest\x1a\x18.storage.DefaultResponse\x12J\n" +
@@ -6705,13 +15809,44 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\vGetServices\x12\x15.storage.EmptyRequest\x1a\x1c.storage.GetServicesResponse\x12L\n" +
 	"\x10GetServicesTypes\x12\x15.storage.EmptyRequest\x1a!.storage.GetServicesTypesResponse\x12]\n" +
 	"\x12GetServiceTypeById\x12\".storage.GetServiceTypeByIdRequest\x1a#.storage.GetServiceTypeByIdResponse\x12B\n" +
-	"\x0eDeleteMaterial\x12\x16.storage.DeleteRequest\x1a\x18.storage.DefaultResponse\x12A\n" +
-	"\rDeleteService\x12\x16.storage.DeleteRequest\x1a\x18.storage.DefaultResponse\x12R\n" +
+	"\x0eDeleteMaterial\x12\x16.storage.DeleteRequest\x1a\x18.storage.DefaultResponse\x12T\n" +
+	"\x14ReceiveMaterialBatch\x12$.storage.ReceiveMaterialBatchRequest\x1a\x16.storage.MaterialBatch\x12T\n" +
+	"\x13AdjustMaterialStock\x12#.storage.AdjustMaterialStockRequest\x1a\x18.storage.DefaultResponse\x12K\n" +
+	"\x10GetMaterialStock\x12\x17.storage.GetByIdRequest\x1a\x1e.storage.MaterialStockResponse\x12K\n" +
+	"\x0eGetStockReport\x12\x1b.storage.StockReportRequest\x1a\x1c.storage.StockReportResponse\x12Z\n" +
+	"\x13CreateTreatmentPlan\x12#.storage.CreateTreatmentPlanRequest\x1a\x1e.storage.TreatmentPlanResponse\x12^\n" +
+	"\x15AddTreatmentPlanItems\x12%.storage.AddTreatmentPlanItemsRequest\x1a\x1e.storage.TreatmentPlanResponse\x12n\n" +
+	"\x1dUpdateTreatmentPlanItemStatus\x12-.storage.UpdateTreatmentPlanItemStatusRequest\x1a\x1e.storage.TreatmentPlanResponse\x12Z\n" +
+	"\x13AcceptTreatmentPlan\x12#.storage.AcceptTreatmentPlanRequest\x1a\x1e.storage.TreatmentPlanResponse\x12N\n" +
+	"\x13CancelTreatmentPlan\x12\x17.storage.GetByIdRequest\x1a\x1e.storage.TreatmentPlanResponse\x12K\n" +
+	"\x10GetTreatmentPlan\x12\x17.storage.GetByIdRequest\x1a\x1e.storage.TreatmentPlanResponse\x12S\n" +
+	"\x18GetTreatmentPlanByItemID\x12\x17.storage.GetByIdRequest\x1a\x1e.storage.TreatmentPlanResponse\x12T\n" +
+	"\x18GetPatientTreatmentPlans\x12\x17.storage.GetByIdRequest\x1a\x1f.storage.TreatmentPlansResponse\x12T\n" +
+	"\x11RecordToothStates\x12!.storage.RecordToothStatesRequest\x1a\x1c.storage.DentalChartResponse\x12G\n" +
+	"\x0eGetDentalChart\x12\x17.storage.GetByIdRequest\x1a\x1c.storage.DentalChartResponse\x12P\n" +
+	"\x0fGetToothHistory\x12\x1f.storage.GetToothHistoryRequest\x1a\x1c.storage.DentalChartResponse\x12J\n" +
+	"\rSaveAnamnesis\x12\x1d.storage.SaveAnamnesisRequest\x1a\x1a.storage.AnamnesisResponse\x12C\n" +
+	"\fGetAnamnesis\x12\x17.storage.GetByIdRequest\x1a\x1a.storage.AnamnesisResponse\x12Q\n" +
+	"\x13GetAnamnesisHistory\x12\x17.storage.GetByIdRequest\x1a!.storage.AnamnesisHistoryResponse\x12f\n" +
+	"\x15GetClinicalAlertRules\x12%.storage.GetClinicalAlertRulesRequest\x1a&.storage.GetClinicalAlertRulesResponse\x12Y\n" +
+	"\x14AddClinicalAlertRule\x12\x1a.storage.ClinicalAlertRule\x1a%.storage.AddClinicalAlertRuleResponse\x12O\n" +
+	"\x17UpdateClinicalAlertRule\x12\x1a.storage.ClinicalAlertRule\x1a\x18.storage.DefaultResponse\x12K\n" +
+	"\x17DeleteClinicalAlertRule\x12\x16.storage.DeleteRequest\x1a\x18.storage.DefaultResponse\x12C\n" +
+	"\x10GetMedicalRecord\x12\x17.storage.GetByIdRequest\x1a\x16.storage.MedicalRecord\x12a\n" +
+	"\x18GetDoctorPatientRelation\x12%.storage.DoctorPatientRelationRequest\x1a\x1e.storage.DoctorPatientRelation\x12Z\n" +
+	"\x16GetPatientAccessGrants\x12\x17.storage.GetByIdRequest\x1a'.storage.GetPatientAccessGrantsResponse\x12\\\n" +
+	"\x15AddPatientAccessGrant\x12\x1b.storage.PatientAccessGrant\x1a&.storage.AddPatientAccessGrantResponse\x12L\n" +
+	"\x18RevokePatientAccessGrant\x12\x16.storage.DeleteRequest\x1a\x18.storage.DefaultResponse\x12A\n" +
+	"\rDeleteService\x12\x16.storage.DeleteRequest\x1a\x18.storage.DefaultResponse\x12T\n" +
+	"\x0fGetPriceHistory\x12\x1f.storage.GetPriceHistoryRequest\x1a .storage.GetPriceHistoryResponse\x12Z\n" +
+	"\x11AddPriceListEntry\x12!.storage.AddPriceListEntryRequest\x1a\".storage.AddPriceListEntryResponse\x12V\n" +
+	"\x14DeletePriceListEntry\x12$.storage.DeletePriceListEntryRequest\x1a\x18.storage.DefaultResponse\x12Q\n" +
+	"\x0eGetPriceOnDate\x12\x1e.storage.GetPriceOnDateRequest\x1a\x1f.storage.GetPriceOnDateResponse\x12R\n" +
 	"\x12GetDoctorOverrides\x12\x17.storage.GetByIDRequest\x1a#.storage.GetDoctorOverridesResponse\x12T\n" +
 	"\x13GetPatientDiagnoses\x12\x17.storage.GetByIdRequest\x1a$.storage.GetPatientDiagnosesResponse\x12N\n" +
 	"\x10GetPatientVisits\x12\x17.storage.GetByIdRequest\x1a!.storage.GetPatientVisitsResponse\x12d\n" +
-	"\x1bGetPatientAllergiesChronics\x12\x17.storage.GetByIdRequest\x1a,.storage.GetPatientAllergiesChronicsResponse\x12B\n" +
-	"\vGetICDCodes\x12\x15.storage.EmptyRequest\x1a\x1c.storage.GetICDCodesResponse\x12d\n" +
+	"\x1bGetPatientAllergiesChronics\x12\x17.storage.GetByIdRequest\x1a,.storage.GetPatientAllergiesChronicsResponse\x12H\n" +
+	"\vGetICDCodes\x12\x1b.storage.GetICDCodesRequest\x1a\x1c.storage.GetICDCodesResponse\x12d\n" +
 	"\x1bAddPatientAllergiesChronics\x12+.storage.AddPatientAllergiesChronicsRequest\x1a\x18.storage.DefaultResponse\x12M\n" +
 	"\x0fAddPatientVisit\x12\x1f.storage.AddPatientVisitRequest\x1a\x19.storage.AddVisitResponse\x12P\n" +
 	"\x11AddVisitMaterials\x12!.storage.AddVisitMaterialsRequest\x1a\x18.storage.DefaultResponse\x12N\n" +
@@ -6721,14 +15856,48 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x12UpdateVisitPayment\x12\x1c.storage.VisitPaymentRequest\x1a\x18.storage.DefaultResponse\x12F\n" +
 	"\fGetVisitByID\x12\x17.storage.GetByIdRequest\x1a\x1d.storage.GetVisitByIDResponse\x12`\n" +
 	"\x13CalculateVisitTotal\x12#.storage.CalculateVisitTotalRequest\x1a$.storage.CalculateVisitTotalResponse\x12\\\n" +
-	"\x17AddOrUpdateVisitPayment\x12'.storage.AddOrUpdateVisitPaymentRequest\x1a\x18.storage.DefaultResponse\x12N\n" +
-	"\x11GetVisitsPayments\x12\x15.storage.EmptyRequest\x1a\".storage.GetVisitsPaymentsResponse\x12P\n" +
-	"\x12GetClinicOverrides\x12\x15.storage.EmptyRequest\x1a#.storage.GetClinicOverridesResponse\x12J\n" +
-	"\x0fGetAppointments\x12\x15.storage.EmptyRequest\x1a .storage.GetAppointmentsResponse\x12[\n" +
+	"\x17AddOrUpdateVisitPayment\x12'.storage.AddOrUpdateVisitPaymentRequest\x1a\x18.storage.DefaultResponse\x12c\n" +
+	"\x14CompleteConsultation\x12$.storage.CompleteConsultationRequest\x1a%.storage.CompleteConsultationResponse\x12A\n" +
+	"\fIssueInvoice\x12\x17.storage.GetByIdRequest\x1a\x18.storage.InvoiceResponse\x12?\n" +
+	"\n" +
+	"GetInvoice\x12\x17.storage.GetByIdRequest\x1a\x18.storage.InvoiceResponse\x12R\n" +
+	"\x12SetInvoiceDiscount\x12\".storage.SetInvoiceDiscountRequest\x1a\x18.storage.InvoiceResponse\x12P\n" +
+	"\x11AddInvoicePayment\x12!.storage.AddInvoicePaymentRequest\x1a\x18.storage.InvoiceResponse\x12V\n" +
+	"\x14RefundInvoicePayment\x12$.storage.RefundInvoicePaymentRequest\x1a\x18.storage.InvoiceResponse\x12M\n" +
+	"\x11GetPatientBalance\x12\x17.storage.GetByIdRequest\x1a\x1f.storage.PatientBalanceResponse\x12K\n" +
+	"\x16ReserveInvoiceDocument\x12\x17.storage.GetByIdRequest\x1a\x18.storage.BillingDocument\x12K\n" +
+	"\x16ReserveReceiptDocument\x12\x17.storage.GetByIdRequest\x1a\x18.storage.BillingDocument\x12X\n" +
+	"\x15AttachBillingDocument\x12%.storage.AttachBillingDocumentRequest\x1a\x18.storage.DefaultResponse\x12Z\n" +
+	"\x11GetVisitsPayments\x12!.storage.GetVisitsPaymentsRequest\x1a\".storage.GetVisitsPaymentsResponse\x12P\n" +
+	"\x12GetClinicOverrides\x12\x15.storage.EmptyRequest\x1a#.storage.GetClinicOverridesResponse\x12T\n" +
+	"\x0fGetAppointments\x12\x1f.storage.GetAppointmentsRequest\x1a .storage.GetAppointmentsResponse\x12[\n" +
 	"\x11GetVisitMaterials\x12\x17.storage.GetByIdRequest\x1a-.storage.GetVisitMaterialsAndServicesResponse\x12Z\n" +
 	"\x10GetVisitServices\x12\x17.storage.GetByIdRequest\x1a-.storage.GetVisitMaterialsAndServicesResponse\x12S\n" +
 	"\x0fGetMaterialByID\x12\x17.storage.GetByIdRequest\x1a'.storage.GetMaterialServiceByIDResponse\x12R\n" +
-	"\x0eGetServiceByID\x12\x17.storage.GetByIdRequest\x1a'.storage.GetMaterialServiceByIDResponseB\x19Z\x17storage/proto;storagepbb\x06proto3"
+	"\x0eGetServiceByID\x12\x17.storage.GetByIdRequest\x1a'.storage.GetMaterialServiceByIDResponse\x12?\n" +
+	"\x10GetTotalPatients\x12\x15.storage.EmptyRequest\x1a\x14.storage.IntResponse\x12=\n" +
+	"\x0eGetTotalVisits\x12\x15.storage.EmptyRequest\x1a\x14.storage.IntResponse\x12F\n" +
+	"\x0eGetTopServices\x12\x15.storage.EmptyRequest\x1a\x1d.storage.ServiceStatsResponse\x12K\n" +
+	"\x11GetDoctorAvgVisit\x12\x15.storage.EmptyRequest\x1a\x1f.storage.DoctorAvgVisitResponse\x12K\n" +
+	"\x11GetDoctorAvgCheck\x12\x15.storage.EmptyRequest\x1a\x1f.storage.DoctorAvgCheckResponse\x12U\n" +
+	"\x16GetDoctorUniquePatient\x12\x15.storage.EmptyRequest\x1a$.storage.DoctorUniquePatientResponse\x12G\n" +
+	"\x0fGetAgeGroupStat\x12\x15.storage.EmptyRequest\x1a\x1d.storage.AgeGroupStatResponse\x12F\n" +
+	"\x17GetNewPatientsThisMonth\x12\x15.storage.EmptyRequest\x1a\x14.storage.IntResponse\x12G\n" +
+	"\x16GetAvgVisitsPerPatient\x12\x15.storage.EmptyRequest\x1a\x16.storage.FloatResponse\x12?\n" +
+	"\x0eGetTotalIncome\x12\x15.storage.EmptyRequest\x1a\x16.storage.FloatResponse\x12A\n" +
+	"\x10GetMonthlyIncome\x12\x15.storage.EmptyRequest\x1a\x16.storage.FloatResponse\x12F\n" +
+	"\x15GetClinicAverageCheck\x12\x15.storage.EmptyRequest\x1a\x16.storage.FloatResponse\x12V\n" +
+	"\x14GetDiagnoseByVisitID\x12\x17.storage.GetByIDRequest\x1a%.storage.GetDiagnoseByVisitIDResponse\x12`\n" +
+	"\x13StartDocumentUpload\x12#.storage.StartDocumentUploadRequest\x1a$.storage.StartDocumentUploadResponse\x12O\n" +
+	"\x0eUploadDocument\x12\x1c.storage.UploadDocumentChunk\x1a\x1d.storage.DocumentUploadStatus(\x01\x12^\n" +
+	"\x17GetDocumentUploadStatus\x12$.storage.DocumentUploadStatusRequest\x1a\x1d.storage.DocumentUploadStatus\x12`\n" +
+	"\x13GetDocumentMetadata\x12#.storage.GetDocumentMetadataRequest\x1a$.storage.GetDocumentMetadataResponse\x12V\n" +
+	"\x10DownloadDocument\x12 .storage.DownloadDocumentRequest\x1a\x1e.storage.DownloadDocumentChunk0\x01\x12V\n" +
+	"\x17GetDocumentsByPatientID\x12\x1c.storage.GetDocumentsRequest\x1a\x1d.storage.GetDocumentsResponse\x12F\n" +
+	"\fGetAdminByID\x12\x17.storage.GetByIDRequest\x1a\x1d.storage.GetAdminByIDResponse\x12>\n" +
+	"\rAddAuditEntry\x12\x13.storage.AuditEntry\x1a\x18.storage.DefaultResponse\x12H\n" +
+	"\vGetAuditLog\x12\x1b.storage.GetAuditLogRequest\x1a\x1c.storage.GetAuditLogResponse\x12H\n" +
+	"\x0eVerifyAuditLog\x12\x15.storage.EmptyRequest\x1a\x1f.storage.VerifyAuditLogResponseB\x19Z\x17storage/proto;storagepbb\x06proto3"
 
 var (
 	file_proto_storage_storage_proto_rawDescOnce sync.Once
@@ -6742,7 +15911,7 @@ func file_proto_storage_storage_proto_rawDescGZIP() []byte {
 	return file_proto_storage_storage_proto_rawDescData
 }
 
-var file_proto_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 108)
+var file_proto_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 235)
 var file_proto_storage_storage_proto_goTypes = []any{
 	(*AddUserRequest)(nil),                       // 0: storage.AddUserRequest
 	(*AddUserResponse)(nil),                      // 1: storage.AddUserResponse
@@ -6753,325 +15922,730 @@ var file_proto_storage_storage_proto_goTypes = []any{
 	(*AddPatientRequest)(nil),                    // 6: storage.AddPatientRequest
 	(*AddPatientResponse)(nil),                   // 7: storage.AddPatientResponse
 	(*EmptyRequest)(nil),                         // 8: storage.EmptyRequest
-	(*Specialization)(nil),                       // 9: storage.Specialization
-	(*GetAllSpecsResponse)(nil),                  // 10: storage.GetAllSpecsResponse
-	(*AddUserRoleRequest)(nil),                   // 11: storage.AddUserRoleRequest
-	(*AddUserRoleResponse)(nil),                  // 12: storage.AddUserRoleResponse
-	(*WeeklyDoctorSchedule)(nil),                 // 13: storage.WeeklyDoctorSchedule
-	(*GetScheduleByDoctorIdRequest)(nil),         // 14: storage.GetScheduleByDoctorIdRequest
-	(*GetScheduleByDoctorIdResponse)(nil),        // 15: storage.GetScheduleByDoctorIdResponse
-	(*GetUserByLoginRequest)(nil),                // 16: storage.GetUserByLoginRequest
-	(*GetUserByLoginResponse)(nil),               // 17: storage.GetUserByLoginResponse
-	(*UpdateUserPasswordRequest)(nil),            // 18: storage.UpdateUserPasswordRequest
-	(*DefaultResponse)(nil),                      // 19: storage.DefaultResponse
-	(*Doctor)(nil),                               // 20: storage.Doctor
-	(*UpdateDoctorRequest)(nil),                  // 21: storage.UpdateDoctorRequest
-	(*AddDoctorSpecRequest)(nil),                 // 22: storage.AddDoctorSpecRequest
-	(*DeleteDoctorSpecRequest)(nil),              // 23: storage.DeleteDoctorSpecRequest
-	(*GetDoctorsResponse)(nil),                   // 24: storage.GetDoctorsResponse
-	(*GetDoctorSpecsByDoctorIdResponse)(nil),     // 25: storage.GetDoctorSpecsByDoctorIdResponse
-	(*Admin)(nil),                                // 26: storage.Admin
-	(*UpdateAdminRequest)(nil),                   // 27: storage.UpdateAdminRequest
-	(*UpdateAdminRoleRequest)(nil),               // 28: storage.UpdateAdminRoleRequest
-	(*GetAdminsResponse)(nil),                    // 29: storage.GetAdminsResponse
-	(*Patient)(nil),                              // 30: storage.Patient
-	(*UpdatePatientRequest)(nil),                 // 31: storage.UpdatePatientRequest
-	(*GetPatientsResponse)(nil),                  // 32: storage.GetPatientsResponse
-	(*WeeklyClinicSchedule)(nil),                 // 33: storage.WeeklyClinicSchedule
-	(*GetClinicWeeklyScheduleResponse)(nil),      // 34: storage.GetClinicWeeklyScheduleResponse
-	(*GetUserRoleRequest)(nil),                   // 35: storage.GetUserRoleRequest
-	(*GetUserRoleResponse)(nil),                  // 36: storage.GetUserRoleResponse
-	(*UpdateClinicWeeklyScheduleRequest)(nil),    // 37: storage.UpdateClinicWeeklyScheduleRequest
-	(*AddDoctorWeeklyScheduleRequest)(nil),       // 38: storage.AddDoctorWeeklyScheduleRequest
-	(*UpdateDoctorWeeklyScheduleRequest)(nil),    // 39: storage.UpdateDoctorWeeklyScheduleRequest
-	(*GetRolePermissionRequest)(nil),             // 40: storage.GetRolePermissionRequest
-	(*AddClinicDailyOverrideRequest)(nil),        // 41: storage.AddClinicDailyOverrideRequest
-	(*AddDoctorDailyOverrideRequest)(nil),        // 42: storage.AddDoctorDailyOverrideRequest
-	(*GetDoctorBySpecIDRequest)(nil),             // 43: storage.GetDoctorBySpecIDRequest
-	(*GetAppointmentsByDoctorIDRequest)(nil),     // 44: storage.GetAppointmentsByDoctorIDRequest
-	(*Appointment)(nil),                          // 45: storage.Appointment
-	(*GetAppointmentsByDoctorIDResponse)(nil),    // 46: storage.GetAppointmentsByDoctorIDResponse
-	(*GetByIDRequest)(nil),                       // 47: storage.GetByIDRequest
-	(*GetPatientByIDResponse)(nil),               // 48: storage.GetPatientByIDResponse
-	(*AddAppointmentRequest)(nil),                // 49: storage.AddAppointmentRequest
-	(*UpdateAppointmentRequest)(nil),             // 50: storage.UpdateAppointmentRequest
-	(*GetAppointmentsByUserIDResponse)(nil),      // 51: storage.GetAppointmentsByUserIDResponse
-	(*GetAppointmentByIDResponse)(nil),           // 52: storage.GetAppointmentByIDResponse
-	(*GetDoctorByIDResponse)(nil),                // 53: storage.GetDoctorByIDResponse
-	(*GetSpecsByDoctorIDResponse)(nil),           // 54: storage.GetSpecsByDoctorIDResponse
-	(*GetClinicOverrideRequest)(nil),             // 55: storage.GetClinicOverrideRequest
-	(*GetClinicOverrideResponse)(nil),            // 56: storage.GetClinicOverrideResponse
-	(*GetDoctorOverrideRequest)(nil),             // 57: storage.GetDoctorOverrideRequest
-	(*GetDoctorOverrideResponse)(nil),            // 58: storage.GetDoctorOverrideResponse
-	(*DoctorOverride)(nil),                       // 59: storage.DoctorOverride
-	(*GetDoctorOverridesResponse)(nil),           // 60: storage.GetDoctorOverridesResponse
-	(*AddMaterialRequest)(nil),                   // 61: storage.AddMaterialRequest
-	(*AddServiceRequest)(nil),                    // 62: storage.AddServiceRequest
-	(*UpdateMaterialRequest)(nil),                // 63: storage.UpdateMaterialRequest
-	(*UpdateServiceRequest)(nil),                 // 64: storage.UpdateServiceRequest
-	(*Material)(nil),                             // 65: storage.Material
-	(*Service)(nil),                              // 66: storage.Service
-	(*GetMaterialsResponse)(nil),                 // 67: storage.GetMaterialsResponse
-	(*GetServicesResponse)(nil),                  // 68: storage.GetServicesResponse
-	(*GetByNameRequest)(nil),                     // 69: storage.GetByNameRequest
-	(*GetByIdRequest)(nil),                       // 70: storage.GetByIdRequest
-	(*GetMaterialByNameResponse)(nil),            // 71: storage.GetMaterialByNameResponse
-	(*GetServiceByNameResponse)(nil),             // 72: storage.GetServiceByNameResponse
-	(*DeleteRequest)(nil),                        // 73: storage.DeleteRequest
-	(*ServiceType)(nil),                          // 74: storage.ServiceType
-	(*GetServicesTypesResponse)(nil),             // 75: storage.GetServicesTypesResponse
-	(*GetServiceTypeByIdRequest)(nil),            // 76: storage.GetServiceTypeByIdRequest
-	(*GetServiceTypeByIdResponse)(nil),           // 77: storage.GetServiceTypeByIdResponse
-	(*UpdateUserLoginRequest)(nil),               // 78: storage.UpdateUserLoginRequest
-	(*ICDCode)(nil),                              // 79: storage.ICDCode
-	(*Diagnose)(nil),                             // 80: storage.Diagnose
-	(*Visit)(nil),                                // 81: storage.Visit
-	(*PatientAllergiesChronics)(nil),             // 82: storage.PatientAllergiesChronics
-	(*AddVisitMaterials)(nil),                    // 83: storage.AddVisitMaterials
-	(*AddVisitMaterialsRequest)(nil),             // 84: storage.AddVisitMaterialsRequest
-	(*AddVisitServices)(nil),                     // 85: storage.AddVisitServices
-	(*AddVisitServicesRequest)(nil),              // 86: storage.AddVisitServicesRequest
-	(*AddPatientAllergiesChronicsRequest)(nil),   // 87: storage.AddPatientAllergiesChronicsRequest
-	(*AddPatientVisitRequest)(nil),               // 88: storage.AddPatientVisitRequest
-	(*AddPatientDiagnosesRequest)(nil),           // 89: storage.AddPatientDiagnosesRequest
-	(*GetPatientDiagnosesResponse)(nil),          // 90: storage.GetPatientDiagnosesResponse
-	(*GetPatientVisitsResponse)(nil),             // 91: storage.GetPatientVisitsResponse
-	(*GetPatientAllergiesChronicsResponse)(nil),  // 92: storage.GetPatientAllergiesChronicsResponse
-	(*GetICDCodesResponse)(nil),                  // 93: storage.GetICDCodesResponse
-	(*AddVisitResponse)(nil),                     // 94: storage.AddVisitResponse
-	(*VisitPayment)(nil),                         // 95: storage.VisitPayment
-	(*VisitPaymentRequest)(nil),                  // 96: storage.VisitPaymentRequest
-	(*GetVisitsPaymentsResponse)(nil),            // 97: storage.GetVisitsPaymentsResponse
-	(*CalculateVisitTotalRequest)(nil),           // 98: storage.CalculateVisitTotalRequest
-	(*CalculateVisitTotalResponse)(nil),          // 99: storage.CalculateVisitTotalResponse
-	(*AddOrUpdateVisitPaymentRequest)(nil),       // 100: storage.AddOrUpdateVisitPaymentRequest
-	(*GetVisitByIDResponse)(nil),                 // 101: storage.GetVisitByIDResponse
-	(*ClinicOverride)(nil),                       // 102: storage.ClinicOverride
-	(*GetClinicOverridesResponse)(nil),           // 103: storage.GetClinicOverridesResponse
-	(*GetAppointmentsResponse)(nil),              // 104: storage.GetAppointmentsResponse
-	(*VisitMaterialAndService)(nil),              // 105: storage.VisitMaterialAndService
-	(*GetVisitMaterialsAndServicesResponse)(nil), // 106: storage.GetVisitMaterialsAndServicesResponse
-	(*GetMaterialServiceByIDResponse)(nil),       // 107: storage.GetMaterialServiceByIDResponse
-	(*timestamppb.Timestamp)(nil),                // 108: google.protobuf.Timestamp
+	(*ListPage)(nil),                             // 9: storage.ListPage
+	(*Specialization)(nil),                       // 10: storage.Specialization
+	(*GetAllSpecsResponse)(nil),                  // 11: storage.GetAllSpecsResponse
+	(*AddUserRoleRequest)(nil),                   // 12: storage.AddUserRoleRequest
+	(*AddUserRoleResponse)(nil),                  // 13: storage.AddUserRoleResponse
+	(*WeeklyDoctorSchedule)(nil),                 // 14: storage.WeeklyDoctorSchedule
+	(*GetScheduleByDoctorIdRequest)(nil),         // 15: storage.GetScheduleByDoctorIdRequest
+	(*GetScheduleByDoctorIdResponse)(nil),        // 16: storage.GetScheduleByDoctorIdResponse
+	(*GetUserByLoginRequest)(nil),                // 17: storage.GetUserByLoginRequest
+	(*GetUserByLoginResponse)(nil),               // 18: storage.GetUserByLoginResponse
+	(*UpdateUserPasswordRequest)(nil),            // 19: storage.UpdateUserPasswordRequest
+	(*DefaultResponse)(nil),                      // 20: storage.DefaultResponse
+	(*Doctor)(nil),                               // 21: storage.Doctor
+	(*UpdateDoctorRequest)(nil),                  // 22: storage.UpdateDoctorRequest
+	(*AddDoctorSpecRequest)(nil),                 // 23: storage.AddDoctorSpecRequest
+	(*DeleteDoctorSpecRequest)(nil),              // 24: storage.DeleteDoctorSpecRequest
+	(*GetDoctorsRequest)(nil),                    // 25: storage.GetDoctorsRequest
+	(*GetDoctorsResponse)(nil),                   // 26: storage.GetDoctorsResponse
+	(*GetDoctorSpecsByDoctorIdResponse)(nil),     // 27: storage.GetDoctorSpecsByDoctorIdResponse
+	(*Admin)(nil),                                // 28: storage.Admin
+	(*UpdateAdminRequest)(nil),                   // 29: storage.UpdateAdminRequest
+	(*UpdateAdminRoleRequest)(nil),               // 30: storage.UpdateAdminRoleRequest
+	(*GetAdminsRequest)(nil),                     // 31: storage.GetAdminsRequest
+	(*GetAdminsResponse)(nil),                    // 32: storage.GetAdminsResponse
+	(*Patient)(nil),                              // 33: storage.Patient
+	(*UpdatePatientRequest)(nil),                 // 34: storage.UpdatePatientRequest
+	(*GetPatientsRequest)(nil),                   // 35: storage.GetPatientsRequest
+	(*GetPatientsResponse)(nil),                  // 36: storage.GetPatientsResponse
+	(*WeeklyClinicSchedule)(nil),                 // 37: storage.WeeklyClinicSchedule
+	(*GetClinicWeeklyScheduleResponse)(nil),      // 38: storage.GetClinicWeeklyScheduleResponse
+	(*GetUserRoleRequest)(nil),                   // 39: storage.GetUserRoleRequest
+	(*GetUserRoleResponse)(nil),                  // 40: storage.GetUserRoleResponse
+	(*UpdateClinicWeeklyScheduleRequest)(nil),    // 41: storage.UpdateClinicWeeklyScheduleRequest
+	(*AddDoctorWeeklyScheduleRequest)(nil),       // 42: storage.AddDoctorWeeklyScheduleRequest
+	(*UpdateDoctorWeeklyScheduleRequest)(nil),    // 43: storage.UpdateDoctorWeeklyScheduleRequest
+	(*GetRolePermissionRequest)(nil),             // 44: storage.GetRolePermissionRequest
+	(*AddClinicDailyOverrideRequest)(nil),        // 45: storage.AddClinicDailyOverrideRequest
+	(*AddDoctorDailyOverrideRequest)(nil),        // 46: storage.AddDoctorDailyOverrideRequest
+	(*GetDoctorBySpecIDRequest)(nil),             // 47: storage.GetDoctorBySpecIDRequest
+	(*GetAppointmentsByDoctorIDRequest)(nil),     // 48: storage.GetAppointmentsByDoctorIDRequest
+	(*Appointment)(nil),                          // 49: storage.Appointment
+	(*GetAppointmentsByDoctorIDResponse)(nil),    // 50: storage.GetAppointmentsByDoctorIDResponse
+	(*GetByIDRequest)(nil),                       // 51: storage.GetByIDRequest
+	(*GetPatientByIDResponse)(nil),               // 52: storage.GetPatientByIDResponse
+	(*AddAppointmentRequest)(nil),                // 53: storage.AddAppointmentRequest
+	(*AppointmentSlotHold)(nil),                  // 54: storage.AppointmentSlotHold
+	(*HoldAppointmentSlotRequest)(nil),           // 55: storage.HoldAppointmentSlotRequest
+	(*HoldAppointmentSlotResponse)(nil),          // 56: storage.HoldAppointmentSlotResponse
+	(*ReleaseAppointmentSlotRequest)(nil),        // 57: storage.ReleaseAppointmentSlotRequest
+	(*GetAppointmentSlotHoldsResponse)(nil),      // 58: storage.GetAppointmentSlotHoldsResponse
+	(*UpdateAppointmentRequest)(nil),             // 59: storage.UpdateAppointmentRequest
+	(*GetAppointmentsByUserIDResponse)(nil),      // 60: storage.GetAppointmentsByUserIDResponse
+	(*GetAppointmentByIDResponse)(nil),           // 61: storage.GetAppointmentByIDResponse
+	(*GetDoctorByIDResponse)(nil),                // 62: storage.GetDoctorByIDResponse
+	(*GetSpecsByDoctorIDResponse)(nil),           // 63: storage.GetSpecsByDoctorIDResponse
+	(*GetClinicOverrideRequest)(nil),             // 64: storage.GetClinicOverrideRequest
+	(*GetClinicOverrideResponse)(nil),            // 65: storage.GetClinicOverrideResponse
+	(*GetDoctorOverrideRequest)(nil),             // 66: storage.GetDoctorOverrideRequest
+	(*GetDoctorOverrideResponse)(nil),            // 67: storage.GetDoctorOverrideResponse
+	(*DoctorOverride)(nil),                       // 68: storage.DoctorOverride
+	(*GetDoctorOverridesResponse)(nil),           // 69: storage.GetDoctorOverridesResponse
+	(*AddMaterialRequest)(nil),                   // 70: storage.AddMaterialRequest
+	(*AddServiceRequest)(nil),                    // 71: storage.AddServiceRequest
+	(*UpdateMaterialRequest)(nil),                // 72: storage.UpdateMaterialRequest
+	(*UpdateServiceRequest)(nil),                 // 73: storage.UpdateServiceRequest
+	(*Material)(nil),                             // 74: storage.Material
+	(*MaterialBatch)(nil),                        // 75: storage.MaterialBatch
+	(*StockMovement)(nil),                        // 76: storage.StockMovement
+	(*TreatmentPlanItem)(nil),                    // 77: storage.TreatmentPlanItem
+	(*TreatmentPlan)(nil),                        // 78: storage.TreatmentPlan
+	(*NewTreatmentPlanItem)(nil),                 // 79: storage.NewTreatmentPlanItem
+	(*CreateTreatmentPlanRequest)(nil),           // 80: storage.CreateTreatmentPlanRequest
+	(*AddTreatmentPlanItemsRequest)(nil),         // 81: storage.AddTreatmentPlanItemsRequest
+	(*UpdateTreatmentPlanItemStatusRequest)(nil), // 82: storage.UpdateTreatmentPlanItemStatusRequest
+	(*AcceptTreatmentPlanRequest)(nil),           // 83: storage.AcceptTreatmentPlanRequest
+	(*TreatmentPlanResponse)(nil),                // 84: storage.TreatmentPlanResponse
+	(*TreatmentPlansResponse)(nil),               // 85: storage.TreatmentPlansResponse
+	(*ToothRecord)(nil),                          // 86: storage.ToothRecord
+	(*RecordToothStatesRequest)(nil),             // 87: storage.RecordToothStatesRequest
+	(*GetToothHistoryRequest)(nil),               // 88: storage.GetToothHistoryRequest
+	(*DentalChartResponse)(nil),                  // 89: storage.DentalChartResponse
+	(*AnamnesisAllergy)(nil),                     // 90: storage.AnamnesisAllergy
+	(*Anamnesis)(nil),                            // 91: storage.Anamnesis
+	(*SaveAnamnesisRequest)(nil),                 // 92: storage.SaveAnamnesisRequest
+	(*AnamnesisResponse)(nil),                    // 93: storage.AnamnesisResponse
+	(*AnamnesisHistoryResponse)(nil),             // 94: storage.AnamnesisHistoryResponse
+	(*ClinicalAlertRule)(nil),                    // 95: storage.ClinicalAlertRule
+	(*GetClinicalAlertRulesRequest)(nil),         // 96: storage.GetClinicalAlertRulesRequest
+	(*GetClinicalAlertRulesResponse)(nil),        // 97: storage.GetClinicalAlertRulesResponse
+	(*AddClinicalAlertRuleResponse)(nil),         // 98: storage.AddClinicalAlertRuleResponse
+	(*MedicalRecord)(nil),                        // 99: storage.MedicalRecord
+	(*MedicalRecordVisit)(nil),                   // 100: storage.MedicalRecordVisit
+	(*MedicalRecordDiagnosis)(nil),               // 101: storage.MedicalRecordDiagnosis
+	(*MedicalRecordService)(nil),                 // 102: storage.MedicalRecordService
+	(*MedicalRecordMaterial)(nil),                // 103: storage.MedicalRecordMaterial
+	(*DoctorPatientRelationRequest)(nil),         // 104: storage.DoctorPatientRelationRequest
+	(*DoctorPatientRelation)(nil),                // 105: storage.DoctorPatientRelation
+	(*PatientAccessGrant)(nil),                   // 106: storage.PatientAccessGrant
+	(*GetPatientAccessGrantsResponse)(nil),       // 107: storage.GetPatientAccessGrantsResponse
+	(*AddPatientAccessGrantResponse)(nil),        // 108: storage.AddPatientAccessGrantResponse
+	(*ReceiveMaterialBatchRequest)(nil),          // 109: storage.ReceiveMaterialBatchRequest
+	(*AdjustMaterialStockRequest)(nil),           // 110: storage.AdjustMaterialStockRequest
+	(*MaterialStockResponse)(nil),                // 111: storage.MaterialStockResponse
+	(*StockReportRequest)(nil),                   // 112: storage.StockReportRequest
+	(*ExpiringBatch)(nil),                        // 113: storage.ExpiringBatch
+	(*StockReportResponse)(nil),                  // 114: storage.StockReportResponse
+	(*Service)(nil),                              // 115: storage.Service
+	(*GetMaterialsResponse)(nil),                 // 116: storage.GetMaterialsResponse
+	(*GetServicesResponse)(nil),                  // 117: storage.GetServicesResponse
+	(*GetByNameRequest)(nil),                     // 118: storage.GetByNameRequest
+	(*GetByIdRequest)(nil),                       // 119: storage.GetByIdRequest
+	(*GetMaterialByNameResponse)(nil),            // 120: storage.GetMaterialByNameResponse
+	(*GetServiceByNameResponse)(nil),             // 121: storage.GetServiceByNameResponse
+	(*DeleteRequest)(nil),                        // 122: storage.DeleteRequest
+	(*ChangeUserStatusRequest)(nil),              // 123: storage.ChangeUserStatusRequest
+	(*ChangeUserStatusResponse)(nil),             // 124: storage.ChangeUserStatusResponse
+	(*ServiceType)(nil),                          // 125: storage.ServiceType
+	(*GetServicesTypesResponse)(nil),             // 126: storage.GetServicesTypesResponse
+	(*GetServiceTypeByIdRequest)(nil),            // 127: storage.GetServiceTypeByIdRequest
+	(*GetServiceTypeByIdResponse)(nil),           // 128: storage.GetServiceTypeByIdResponse
+	(*UpdateUserLoginRequest)(nil),               // 129: storage.UpdateUserLoginRequest
+	(*ICDCode)(nil),                              // 130: storage.ICDCode
+	(*Diagnose)(nil),                             // 131: storage.Diagnose
+	(*Visit)(nil),                                // 132: storage.Visit
+	(*PatientAllergiesChronics)(nil),             // 133: storage.PatientAllergiesChronics
+	(*AddVisitMaterials)(nil),                    // 134: storage.AddVisitMaterials
+	(*AddVisitMaterialsRequest)(nil),             // 135: storage.AddVisitMaterialsRequest
+	(*AddVisitServices)(nil),                     // 136: storage.AddVisitServices
+	(*AddVisitServicesRequest)(nil),              // 137: storage.AddVisitServicesRequest
+	(*AddPatientAllergiesChronicsRequest)(nil),   // 138: storage.AddPatientAllergiesChronicsRequest
+	(*AddPatientVisitRequest)(nil),               // 139: storage.AddPatientVisitRequest
+	(*AddPatientDiagnosesRequest)(nil),           // 140: storage.AddPatientDiagnosesRequest
+	(*GetPatientDiagnosesResponse)(nil),          // 141: storage.GetPatientDiagnosesResponse
+	(*GetPatientVisitsResponse)(nil),             // 142: storage.GetPatientVisitsResponse
+	(*GetPatientAllergiesChronicsResponse)(nil),  // 143: storage.GetPatientAllergiesChronicsResponse
+	(*GetICDCodesRequest)(nil),                   // 144: storage.GetICDCodesRequest
+	(*GetICDCodesResponse)(nil),                  // 145: storage.GetICDCodesResponse
+	(*AddVisitResponse)(nil),                     // 146: storage.AddVisitResponse
+	(*VisitPayment)(nil),                         // 147: storage.VisitPayment
+	(*VisitPaymentRequest)(nil),                  // 148: storage.VisitPaymentRequest
+	(*GetVisitsPaymentsRequest)(nil),             // 149: storage.GetVisitsPaymentsRequest
+	(*GetVisitsPaymentsResponse)(nil),            // 150: storage.GetVisitsPaymentsResponse
+	(*CalculateVisitTotalRequest)(nil),           // 151: storage.CalculateVisitTotalRequest
+	(*CalculateVisitTotalResponse)(nil),          // 152: storage.CalculateVisitTotalResponse
+	(*CompleteConsultationRequest)(nil),          // 153: storage.CompleteConsultationRequest
+	(*CompleteConsultationResponse)(nil),         // 154: storage.CompleteConsultationResponse
+	(*Invoice)(nil),                              // 155: storage.Invoice
+	(*InvoiceLine)(nil),                          // 156: storage.InvoiceLine
+	(*InvoicePayment)(nil),                       // 157: storage.InvoicePayment
+	(*InvoiceResponse)(nil),                      // 158: storage.InvoiceResponse
+	(*SetInvoiceDiscountRequest)(nil),            // 159: storage.SetInvoiceDiscountRequest
+	(*AddInvoicePaymentRequest)(nil),             // 160: storage.AddInvoicePaymentRequest
+	(*RefundInvoicePaymentRequest)(nil),          // 161: storage.RefundInvoicePaymentRequest
+	(*PatientBalanceResponse)(nil),               // 162: storage.PatientBalanceResponse
+	(*BillingDocument)(nil),                      // 163: storage.BillingDocument
+	(*AttachBillingDocumentRequest)(nil),         // 164: storage.AttachBillingDocumentRequest
+	(*PriceListEntry)(nil),                       // 165: storage.PriceListEntry
+	(*GetPriceHistoryRequest)(nil),               // 166: storage.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),              // 167: storage.GetPriceHistoryResponse
+	(*AddPriceListEntryRequest)(nil),             // 168: storage.AddPriceListEntryRequest
+	(*AddPriceListEntryResponse)(nil),            // 169: storage.AddPriceListEntryResponse
+	(*DeletePriceListEntryRequest)(nil),          // 170: storage.DeletePriceListEntryRequest
+	(*GetPriceOnDateRequest)(nil),                // 171: storage.GetPriceOnDateRequest
+	(*GetPriceOnDateResponse)(nil),               // 172: storage.GetPriceOnDateResponse
+	(*AddOrUpdateVisitPaymentRequest)(nil),       // 173: storage.AddOrUpdateVisitPaymentRequest
+	(*GetVisitByIDResponse)(nil),                 // 174: storage.GetVisitByIDResponse
+	(*ClinicOverride)(nil),                       // 175: storage.ClinicOverride
+	(*GetClinicOverridesResponse)(nil),           // 176: storage.GetClinicOverridesResponse
+	(*GetAppointmentsRequest)(nil),               // 177: storage.GetAppointmentsRequest
+	(*GetAppointmentsResponse)(nil),              // 178: storage.GetAppointmentsResponse
+	(*VisitMaterialAndService)(nil),              // 179: storage.VisitMaterialAndService
+	(*GetVisitMaterialsAndServicesResponse)(nil), // 180: storage.GetVisitMaterialsAndServicesResponse
+	(*GetMaterialServiceByIDResponse)(nil),       // 181: storage.GetMaterialServiceByIDResponse
+	(*IntResponse)(nil),                          // 182: storage.IntResponse
+	(*FloatResponse)(nil),                        // 183: storage.FloatResponse
+	(*ServiceStats)(nil),                         // 184: storage.ServiceStats
+	(*ServiceStatsResponse)(nil),                 // 185: storage.ServiceStatsResponse
+	(*DoctorAvgVisit)(nil),                       // 186: storage.DoctorAvgVisit
+	(*DoctorAvgVisitResponse)(nil),               // 187: storage.DoctorAvgVisitResponse
+	(*DoctorCheck)(nil),                          // 188: storage.DoctorCheck
+	(*DoctorAvgCheckResponse)(nil),               // 189: storage.DoctorAvgCheckResponse
+	(*DoctorUniquePatient)(nil),                  // 190: storage.DoctorUniquePatient
+	(*DoctorUniquePatientResponse)(nil),          // 191: storage.DoctorUniquePatientResponse
+	(*AgeGroupStat)(nil),                         // 192: storage.AgeGroupStat
+	(*AgeGroupStatResponse)(nil),                 // 193: storage.AgeGroupStatResponse
+	(*GetDiagnoseByVisitIDResponse)(nil),         // 194: storage.GetDiagnoseByVisitIDResponse
+	(*StartDocumentUploadRequest)(nil),           // 195: storage.StartDocumentUploadRequest
+	(*StartDocumentUploadResponse)(nil),          // 196: storage.StartDocumentUploadResponse
+	(*UploadDocumentChunk)(nil),                  // 197: storage.UploadDocumentChunk
+	(*DocumentUploadStatusRequest)(nil),          // 198: storage.DocumentUploadStatusRequest
+	(*DocumentUploadStatus)(nil),                 // 199: storage.DocumentUploadStatus
+	(*GetDocumentMetadataRequest)(nil),           // 200: storage.GetDocumentMetadataRequest
+	(*GetDocumentMetadataResponse)(nil),          // 201: storage.GetDocumentMetadataResponse
+	(*DownloadDocumentRequest)(nil),              // 202: storage.DownloadDocumentRequest
+	(*DownloadDocumentChunk)(nil),                // 203: storage.DownloadDocumentChunk
+	(*GetDocumentsRequest)(nil),                  // 204: storage.GetDocumentsRequest
+	(*DocumentInfo)(nil),                         // 205: storage.DocumentInfo
+	(*GetDocumentsResponse)(nil),                 // 206: storage.GetDocumentsResponse
+	(*GetAdminByIDResponse)(nil),                 // 207: storage.GetAdminByIDResponse
+	(*ClinicSettings)(nil),                       // 208: storage.ClinicSettings
+	(*UpdateClinicTimeZoneRequest)(nil),          // 209: storage.UpdateClinicTimeZoneRequest
+	(*WaitingListEntry)(nil),                     // 210: storage.WaitingListEntry
+	(*WaitingListOffer)(nil),                     // 211: storage.WaitingListOffer
+	(*AddWaitingListEntryRequest)(nil),           // 212: storage.AddWaitingListEntryRequest
+	(*AddWaitingListEntryResponse)(nil),          // 213: storage.AddWaitingListEntryResponse
+	(*GetWaitingListResponse)(nil),               // 214: storage.GetWaitingListResponse
+	(*CancelWaitingListEntryRequest)(nil),        // 215: storage.CancelWaitingListEntryRequest
+	(*AcceptWaitingListOfferRequest)(nil),        // 216: storage.AcceptWaitingListOfferRequest
+	(*AcceptWaitingListOfferResponse)(nil),       // 217: storage.AcceptWaitingListOfferResponse
+	(*DeclineWaitingListOfferRequest)(nil),       // 218: storage.DeclineWaitingListOfferRequest
+	(*ScheduleRule)(nil),                         // 219: storage.ScheduleRule
+	(*GetScheduleRulesRequest)(nil),              // 220: storage.GetScheduleRulesRequest
+	(*GetScheduleRulesResponse)(nil),             // 221: storage.GetScheduleRulesResponse
+	(*AddScheduleRuleRequest)(nil),               // 222: storage.AddScheduleRuleRequest
+	(*AddScheduleRuleResponse)(nil),              // 223: storage.AddScheduleRuleResponse
+	(*ImportScheduleRulesRequest)(nil),           // 224: storage.ImportScheduleRulesRequest
+	(*ImportScheduleRulesResponse)(nil),          // 225: storage.ImportScheduleRulesResponse
+	(*Notification)(nil),                         // 226: storage.Notification
+	(*ClaimNotificationsRequest)(nil),            // 227: storage.ClaimNotificationsRequest
+	(*ClaimNotificationsResponse)(nil),           // 228: storage.ClaimNotificationsResponse
+	(*CompleteNotificationRequest)(nil),          // 229: storage.CompleteNotificationRequest
+	(*PatientNotificationSettings)(nil),          // 230: storage.PatientNotificationSettings
+	(*AuditEntry)(nil),                           // 231: storage.AuditEntry
+	(*GetAuditLogRequest)(nil),                   // 232: storage.GetAuditLogRequest
+	(*GetAuditLogResponse)(nil),                  // 233: storage.GetAuditLogResponse
+	(*VerifyAuditLogResponse)(nil),               // 234: storage.VerifyAuditLogResponse
+	(*timestamppb.Timestamp)(nil),                // 235: google.protobuf.Timestamp
 }
 var file_proto_storage_storage_proto_depIdxs = []int32{
-	108, // 0: storage.AddPatientRequest.birth_date:type_name -> google.protobuf.Timestamp
-	9,   // 1: storage.GetAllSpecsResponse.specs:type_name -> storage.Specialization
-	108, // 2: storage.WeeklyDoctorSchedule.start_time:type_name -> google.protobuf.Timestamp
-	108, // 3: storage.WeeklyDoctorSchedule.end_time:type_name -> google.protobuf.Timestamp
-	13,  // 4: storage.GetScheduleByDoctorIdResponse.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
-	20,  // 5: storage.GetDoctorsResponse.doctors:type_name -> storage.Doctor
-	26,  // 6: storage.GetAdminsResponse.admins:type_name -> storage.Admin
-	108, // 7: storage.Patient.birth_date:type_name -> google.protobuf.Timestamp
-	108, // 8: storage.UpdatePatientRequest.birth_date:type_name -> google.protobuf.Timestamp
-	30,  // 9: storage.GetPatientsResponse.patients:type_name -> storage.Patient
-	108, // 10: storage.WeeklyClinicSchedule.start_time:type_name -> google.protobuf.Timestamp
-	108, // 11: storage.WeeklyClinicSchedule.end_time:type_name -> google.protobuf.Timestamp
-	33,  // 12: storage.GetClinicWeeklyScheduleResponse.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
-	33,  // 13: storage.UpdateClinicWeeklyScheduleRequest.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
-	13,  // 14: storage.AddDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
-	13,  // 15: storage.UpdateDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
-	108, // 16: storage.AddClinicDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	108, // 17: storage.AddClinicDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
-	108, // 18: storage.AddClinicDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
-	108, // 19: storage.AddDoctorDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	108, // 20: storage.AddDoctorDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
-	108, // 21: storage.AddDoctorDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
-	108, // 22: storage.Appointment.date:type_name -> google.protobuf.Timestamp
-	108, // 23: storage.Appointment.time:type_name -> google.protobuf.Timestamp
-	108, // 24: storage.Appointment.birth_date:type_name -> google.protobuf.Timestamp
-	108, // 25: storage.Appointment.created_at:type_name -> google.protobuf.Timestamp
-	108, // 26: storage.Appointment.updated_at:type_name -> google.protobuf.Timestamp
-	45,  // 27: storage.GetAppointmentsByDoctorIDResponse.appointments:type_name -> storage.Appointment
-	30,  // 28: storage.GetPatientByIDResponse.patient:type_name -> storage.Patient
-	45,  // 29: storage.AddAppointmentRequest.appointment:type_name -> storage.Appointment
-	45,  // 30: storage.UpdateAppointmentRequest.appointment:type_name -> storage.Appointment
-	45,  // 31: storage.GetAppointmentsByUserIDResponse.appointment:type_name -> storage.Appointment
-	45,  // 32: storage.GetAppointmentByIDResponse.appointment:type_name -> storage.Appointment
-	20,  // 33: storage.GetDoctorByIDResponse.doctor:type_name -> storage.Doctor
-	108, // 34: storage.GetClinicOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	108, // 35: storage.GetClinicOverrideResponse.date:type_name -> google.protobuf.Timestamp
-	108, // 36: storage.GetClinicOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
-	108, // 37: storage.GetClinicOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
-	108, // 38: storage.GetDoctorOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	108, // 39: storage.GetDoctorOverrideResponse.date:type_name -> google.protobuf.Timestamp
-	108, // 40: storage.GetDoctorOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
-	108, // 41: storage.GetDoctorOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
-	108, // 42: storage.DoctorOverride.date:type_name -> google.protobuf.Timestamp
-	108, // 43: storage.DoctorOverride.start_time:type_name -> google.protobuf.Timestamp
-	108, // 44: storage.DoctorOverride.end_time:type_name -> google.protobuf.Timestamp
-	59,  // 45: storage.GetDoctorOverridesResponse.override:type_name -> storage.DoctorOverride
-	65,  // 46: storage.GetMaterialsResponse.materials:type_name -> storage.Material
-	66,  // 47: storage.GetServicesResponse.services:type_name -> storage.Service
-	74,  // 48: storage.GetServicesTypesResponse.types:type_name -> storage.ServiceType
-	108, // 49: storage.Visit.created_at:type_name -> google.protobuf.Timestamp
-	83,  // 50: storage.AddVisitMaterialsRequest.materials:type_name -> storage.AddVisitMaterials
-	85,  // 51: storage.AddVisitServicesRequest.services:type_name -> storage.AddVisitServices
-	82,  // 52: storage.AddPatientAllergiesChronicsRequest.notes:type_name -> storage.PatientAllergiesChronics
-	108, // 53: storage.AddPatientVisitRequest.created_at:type_name -> google.protobuf.Timestamp
-	80,  // 54: storage.AddPatientDiagnosesRequest.diagnoses:type_name -> storage.Diagnose
-	80,  // 55: storage.GetPatientDiagnosesResponse.diagnoses:type_name -> storage.Diagnose
-	81,  // 56: storage.GetPatientVisitsResponse.visits:type_name -> storage.Visit
-	82,  // 57: storage.GetPatientAllergiesChronicsResponse.patient_allergies_chronics:type_name -> storage.PatientAllergiesChronics
-	79,  // 58: storage.GetICDCodesResponse.icd_code:type_name -> storage.ICDCode
-	95,  // 59: storage.GetVisitsPaymentsResponse.visit_payment:type_name -> storage.VisitPayment
-	95,  // 60: storage.AddOrUpdateVisitPaymentRequest.payment:type_name -> storage.VisitPayment
-	81,  // 61: storage.GetVisitByIDResponse.visit:type_name -> storage.Visit
-	108, // 62: storage.ClinicOverride.date:type_name -> google.protobuf.Timestamp
-	108, // 63: storage.ClinicOverride.start_time:type_name -> google.protobuf.Timestamp
-	108, // 64: storage.ClinicOverride.end_time:type_name -> google.protobuf.Timestamp
-	102, // 65: storage.GetClinicOverridesResponse.overrides:type_name -> storage.ClinicOverride
-	45,  // 66: storage.GetAppointmentsResponse.appointments:type_name -> storage.Appointment
-	105, // 67: storage.GetVisitMaterialsAndServicesResponse.visit_materials_services:type_name -> storage.VisitMaterialAndService
-	0,   // 68: storage.StorageService.AddUser:input_type -> storage.AddUserRequest
-	2,   // 69: storage.StorageService.AddDoctor:input_type -> storage.AddDoctorRequest
-	4,   // 70: storage.StorageService.AddAdmin:input_type -> storage.AddAdminRequest
-	6,   // 71: storage.StorageService.AddPatient:input_type -> storage.AddPatientRequest
-	8,   // 72: storage.StorageService.GetDoctors:input_type -> storage.EmptyRequest
-	8,   // 73: storage.StorageService.GetAdmins:input_type -> storage.EmptyRequest
-	8,   // 74: storage.StorageService.GetPatients:input_type -> storage.EmptyRequest
-	70,  // 75: storage.StorageService.GetDoctorSpecsByDoctorId:input_type -> storage.GetByIdRequest
-	21,  // 76: storage.StorageService.UpdateDoctor:input_type -> storage.UpdateDoctorRequest
-	22,  // 77: storage.StorageService.AddDoctorSpec:input_type -> storage.AddDoctorSpecRequest
-	23,  // 78: storage.StorageService.DeleteDoctorSpec:input_type -> storage.DeleteDoctorSpecRequest
-	27,  // 79: storage.StorageService.UpdateAdmin:input_type -> storage.UpdateAdminRequest
-	28,  // 80: storage.StorageService.UpdateAdminRole:input_type -> storage.UpdateAdminRoleRequest
-	31,  // 81: storage.StorageService.UpdatePatient:input_type -> storage.UpdatePatientRequest
-	73,  // 82: storage.StorageService.DeleteUser:input_type -> storage.DeleteRequest
-	78,  // 83: storage.StorageService.UpdateUserLogin:input_type -> storage.UpdateUserLoginRequest
-	8,   // 84: storage.StorageService.GetAllSpecs:input_type -> storage.EmptyRequest
-	11,  // 85: storage.StorageService.AddUserRole:input_type -> storage.AddUserRoleRequest
-	16,  // 86: storage.StorageService.GetUserByLogin:input_type -> storage.GetUserByLoginRequest
-	18,  // 87: storage.StorageService.UpdateUserPassword:input_type -> storage.UpdateUserPasswordRequest
-	8,   // 88: storage.StorageService.GetClinicWeeklySchedule:input_type -> storage.EmptyRequest
-	35,  // 89: storage.StorageService.GetUserRole:input_type -> storage.GetUserRoleRequest
-	14,  // 90: storage.StorageService.GetDoctorWeeklySchedule:input_type -> storage.GetScheduleByDoctorIdRequest
-	37,  // 91: storage.StorageService.UpdateClinicWeeklySchedule:input_type -> storage.UpdateClinicWeeklyScheduleRequest
-	38,  // 92: storage.StorageService.AddDoctorWeeklySchedule:input_type -> storage.AddDoctorWeeklyScheduleRequest
-	39,  // 93: storage.StorageService.UpdateDoctorWeeklySchedule:input_type -> storage.UpdateDoctorWeeklyScheduleRequest
-	40,  // 94: storage.StorageService.GetRolePermission:input_type -> storage.GetRolePermissionRequest
-	43,  // 95: storage.StorageService.GetDoctorsBySpecID:input_type -> storage.GetDoctorBySpecIDRequest
-	44,  // 96: storage.StorageService.GetAppointmentsByDoctorID:input_type -> storage.GetAppointmentsByDoctorIDRequest
-	49,  // 97: storage.StorageService.AddAppointment:input_type -> storage.AddAppointmentRequest
-	47,  // 98: storage.StorageService.GetAppointmentsByUserID:input_type -> storage.GetByIDRequest
-	47,  // 99: storage.StorageService.GetSpecsByDoctorID:input_type -> storage.GetByIDRequest
-	47,  // 100: storage.StorageService.GetDoctorByID:input_type -> storage.GetByIDRequest
-	50,  // 101: storage.StorageService.UpdateAppointment:input_type -> storage.UpdateAppointmentRequest
-	47,  // 102: storage.StorageService.GetPatientByID:input_type -> storage.GetByIDRequest
-	47,  // 103: storage.StorageService.GetAppointmentByID:input_type -> storage.GetByIDRequest
-	41,  // 104: storage.StorageService.AddClinicDailyOverride:input_type -> storage.AddClinicDailyOverrideRequest
-	42,  // 105: storage.StorageService.AddDoctorDailyOverride:input_type -> storage.AddDoctorDailyOverrideRequest
-	55,  // 106: storage.StorageService.GetClinicOverride:input_type -> storage.GetClinicOverrideRequest
-	57,  // 107: storage.StorageService.GetDoctorOverride:input_type -> storage.GetDoctorOverrideRequest
-	61,  // 108: storage.StorageService.AddMaterial:input_type -> storage.AddMaterialRequest
-	62,  // 109: storage.StorageService.AddService:input_type -> storage.AddServiceRequest
-	63,  // 110: storage.StorageService.UpdateMaterial:input_type -> storage.UpdateMaterialRequest
-	64,  // 111: storage.StorageService.UpdateService:input_type -> storage.UpdateServiceRequest
-	8,   // 112: storage.StorageService.GetMaterials:input_type -> storage.EmptyRequest
-	8,   // 113: storage.StorageService.GetServices:input_type -> storage.EmptyRequest
-	8,   // 114: storage.StorageService.GetServicesTypes:input_type -> storage.EmptyRequest
-	76,  // 115: storage.StorageService.GetServiceTypeById:input_type -> storage.GetServiceTypeByIdRequest
-	73,  // 116: storage.StorageService.DeleteMaterial:input_type -> storage.DeleteRequest
-	73,  // 117: storage.StorageService.DeleteService:input_type -> storage.DeleteRequest
-	47,  // 118: storage.StorageService.GetDoctorOverrides:input_type -> storage.GetByIDRequest
-	70,  // 119: storage.StorageService.GetPatientDiagnoses:input_type -> storage.GetByIdRequest
-	70,  // 120: storage.StorageService.GetPatientVisits:input_type -> storage.GetByIdRequest
-	70,  // 121: storage.StorageService.GetPatientAllergiesChronics:input_type -> storage.GetByIdRequest
-	8,   // 122: storage.StorageService.GetICDCodes:input_type -> storage.EmptyRequest
-	87,  // 123: storage.StorageService.AddPatientAllergiesChronics:input_type -> storage.AddPatientAllergiesChronicsRequest
-	88,  // 124: storage.StorageService.AddPatientVisit:input_type -> storage.AddPatientVisitRequest
-	84,  // 125: storage.StorageService.AddVisitMaterials:input_type -> storage.AddVisitMaterialsRequest
-	86,  // 126: storage.StorageService.AddVisitServices:input_type -> storage.AddVisitServicesRequest
-	89,  // 127: storage.StorageService.AddPatientDiagnoses:input_type -> storage.AddPatientDiagnosesRequest
-	96,  // 128: storage.StorageService.AddVisitPayment:input_type -> storage.VisitPaymentRequest
-	96,  // 129: storage.StorageService.UpdateVisitPayment:input_type -> storage.VisitPaymentRequest
-	70,  // 130: storage.StorageService.GetVisitByID:input_type -> storage.GetByIdRequest
-	98,  // 131: storage.StorageService.CalculateVisitTotal:input_type -> storage.CalculateVisitTotalRequest
-	100, // 132: storage.StorageService.AddOrUpdateVisitPayment:input_type -> storage.AddOrUpdateVisitPaymentRequest
-	8,   // 133: storage.StorageService.GetVisitsPayments:input_type -> storage.EmptyRequest
-	8,   // 134: storage.StorageService.GetClinicOverrides:input_type -> storage.EmptyRequest
-	8,   // 135: storage.StorageService.GetAppointments:input_type -> storage.EmptyRequest
-	70,  // 136: storage.StorageService.GetVisitMaterials:input_type -> storage.GetByIdRequest
-	70,  // 137: storage.StorageService.GetVisitServices:input_type -> storage.GetByIdRequest
-	70,  // 138: storage.StorageService.GetMaterialByID:input_type -> storage.GetByIdRequest
-	70,  // 139: storage.StorageService.GetServiceByID:input_type -> storage.GetByIdRequest
-	1,   // 140: storage.StorageService.AddUser:output_type -> storage.AddUserResponse
-	3,   // 141: storage.StorageService.AddDoctor:output_type -> storage.AddDoctorResponse
-	5,   // 142: storage.StorageService.AddAdmin:output_type -> storage.AddAdminResponse
-	7,   // 143: storage.StorageService.AddPatient:output_type -> storage.AddPatientResponse
-	24,  // 144: storage.StorageService.GetDoctors:output_type -> storage.GetDoctorsResponse
-	29,  // 145: storage.StorageService.GetAdmins:output_type -> storage.GetAdminsResponse
-	32,  // 146: storage.StorageService.GetPatients:output_type -> storage.GetPatientsResponse
-	25,  // 147: storage.StorageService.GetDoctorSpecsByDoctorId:output_type -> storage.GetDoctorSpecsByDoctorIdResponse
-	19,  // 148: storage.StorageService.UpdateDoctor:output_type -> storage.DefaultResponse
-	19,  // 149: storage.StorageService.AddDoctorSpec:output_type -> storage.DefaultResponse
-	19,  // 150: storage.StorageService.DeleteDoctorSpec:output_type -> storage.DefaultResponse
-	19,  // 151: storage.StorageService.UpdateAdmin:output_type -> storage.DefaultResponse
-	19,  // 152: storage.StorageService.UpdateAdminRole:output_type -> storage.DefaultResponse
-	19,  // 153: storage.StorageService.UpdatePatient:output_type -> storage.DefaultResponse
-	19,  // 154: storage.StorageService.DeleteUser:output_type -> storage.DefaultResponse
-	19,  // 155: storage.StorageService.UpdateUserLogin:output_type -> storage.DefaultResponse
-	10,  // 156: storage.StorageService.GetAllSpecs:output_type -> storage.GetAllSpecsResponse
-	12,  // 157: storage.StorageService.AddUserRole:output_type -> storage.AddUserRoleResponse
-	17,  // 158: storage.StorageService.GetUserByLogin:output_type -> storage.GetUserByLoginResponse
-	19,  // 159: storage.StorageService.UpdateUserPassword:output_type -> storage.DefaultResponse
-	34,  // 160: storage.StorageService.GetClinicWeeklySchedule:output_type -> storage.GetClinicWeeklyScheduleResponse
-	36,  // 161: storage.StorageService.GetUserRole:output_type -> storage.GetUserRoleResponse
-	15,  // 162: storage.StorageService.GetDoctorWeeklySchedule:output_type -> storage.GetScheduleByDoctorIdResponse
-	19,  // 163: storage.StorageService.UpdateClinicWeeklySchedule:output_type -> storage.DefaultResponse
-	19,  // 164: storage.StorageService.AddDoctorWeeklySchedule:output_type -> storage.DefaultResponse
-	19,  // 165: storage.StorageService.UpdateDoctorWeeklySchedule:output_type -> storage.DefaultResponse
-	19,  // 166: storage.StorageService.GetRolePermission:output_type -> storage.DefaultResponse
-	24,  // 167: storage.StorageService.GetDoctorsBySpecID:output_type -> storage.GetDoctorsResponse
-	46,  // 168: storage.StorageService.GetAppointmentsByDoctorID:output_type -> storage.GetAppointmentsByDoctorIDResponse
-	19,  // 169: storage.StorageService.AddAppointment:output_type -> storage.DefaultResponse
-	51,  // 170: storage.StorageService.GetAppointmentsByUserID:output_type -> storage.GetAppointmentsByUserIDResponse
-	54,  // 171: storage.StorageService.GetSpecsByDoctorID:output_type -> storage.GetSpecsByDoctorIDResponse
-	53,  // 172: storage.StorageService.GetDoctorByID:output_type -> storage.GetDoctorByIDResponse
-	19,  // 173: storage.StorageService.UpdateAppointment:output_type -> storage.DefaultResponse
-	48,  // 174: storage.StorageService.GetPatientByID:output_type -> storage.GetPatientByIDResponse
-	52,  // 175: storage.StorageService.GetAppointmentByID:output_type -> storage.GetAppointmentByIDResponse
-	19,  // 176: storage.StorageService.AddClinicDailyOverride:output_type -> storage.DefaultResponse
-	19,  // 177: storage.StorageService.AddDoctorDailyOverride:output_type -> storage.DefaultResponse
-	56,  // 178: storage.StorageService.GetClinicOverride:output_type -> storage.GetClinicOverrideResponse
-	58,  // 179: storage.StorageService.GetDoctorOverride:output_type -> storage.GetDoctorOverrideResponse
-	19,  // 180: storage.StorageService.AddMaterial:output_type -> storage.DefaultResponse
-	19,  // 181: storage.StorageService.AddService:output_type -> storage.DefaultResponse
-	19,  // 182: storage.StorageService.UpdateMaterial:output_type -> storage.DefaultResponse
-	19,  // 183: storage.StorageService.UpdateService:output_type -> storage.DefaultResponse
-	67,  // 184: storage.StorageService.GetMaterials:output_type -> storage.GetMaterialsResponse
-	68,  // 185: storage.StorageService.GetServices:output_type -> storage.GetServicesResponse
-	75,  // 186: storage.StorageService.GetServicesTypes:output_type -> storage.GetServicesTypesResponse
-	77,  // 187: storage.StorageService.GetServiceTypeById:output_type -> storage.GetServiceTypeByIdResponse
-	19,  // 188: storage.StorageService.DeleteMaterial:output_type -> storage.DefaultResponse
-	19,  // 189: storage.StorageService.DeleteService:output_type -> storage.DefaultResponse
-	60,  // 190: storage.StorageService.GetDoctorOverrides:output_type -> storage.GetDoctorOverridesResponse
-	90,  // 191: storage.StorageService.GetPatientDiagnoses:output_type -> storage.GetPatientDiagnosesResponse
-	91,  // 192: storage.StorageService.GetPatientVisits:output_type -> storage.GetPatientVisitsResponse
-	92,  // 193: storage.StorageService.GetPatientAllergiesChronics:output_type -> storage.GetPatientAllergiesChronicsResponse
-	93,  // 194: storage.StorageService.GetICDCodes:output_type -> storage.GetICDCodesResponse
-	19,  // 195: storage.StorageService.AddPatientAllergiesChronics:output_type -> storage.DefaultResponse
-	94,  // 196: storage.StorageService.AddPatientVisit:output_type -> storage.AddVisitResponse
-	19,  // 197: storage.StorageService.AddVisitMaterials:output_type -> storage.DefaultResponse
-	19,  // 198: storage.StorageService.AddVisitServices:output_type -> storage.DefaultResponse
-	19,  // 199: storage.StorageService.AddPatientDiagnoses:output_type -> storage.DefaultResponse
-	19,  // 200: storage.StorageService.AddVisitPayment:output_type -> storage.DefaultResponse
-	19,  // 201: storage.StorageService.UpdateVisitPayment:output_type -> storage.DefaultResponse
-	101, // 202: storage.StorageService.GetVisitByID:output_type -> storage.GetVisitByIDResponse
-	99,  // 203: storage.StorageService.CalculateVisitTotal:output_type -> storage.CalculateVisitTotalResponse
-	19,  // 204: storage.StorageService.AddOrUpdateVisitPayment:output_type -> storage.DefaultResponse
-	97,  // 205: storage.StorageService.GetVisitsPayments:output_type -> storage.GetVisitsPaymentsResponse
-	103, // 206: storage.StorageService.GetClinicOverrides:output_type -> storage.GetClinicOverridesResponse
-	104, // 207: storage.StorageService.GetAppointments:output_type -> storage.GetAppointmentsResponse
-	106, // 208: storage.StorageService.GetVisitMaterials:output_type -> storage.GetVisitMaterialsAndServicesResponse
-	106, // 209: storage.StorageService.GetVisitServices:output_type -> storage.GetVisitMaterialsAndServicesResponse
-	107, // 210: storage.StorageService.GetMaterialByID:output_type -> storage.GetMaterialServiceByIDResponse
-	107, // 211: storage.StorageService.GetServiceByID:output_type -> storage.GetMaterialServiceByIDResponse
-	140, // [140:212] is the sub-list for method output_type
-	68,  // [68:140] is the sub-list for method input_type
-	68,  // [68:68] is the sub-list for extension type_name
-	68,  // [68:68] is the sub-list for extension extendee
-	0,   // [0:68] is the sub-list for field type_name
+	235, // 0: storage.AddPatientRequest.birth_date:type_name -> google.protobuf.Timestamp
+	10,  // 1: storage.GetAllSpecsResponse.specs:type_name -> storage.Specialization
+	235, // 2: storage.WeeklyDoctorSchedule.start_time:type_name -> google.protobuf.Timestamp
+	235, // 3: storage.WeeklyDoctorSchedule.end_time:type_name -> google.protobuf.Timestamp
+	14,  // 4: storage.GetScheduleByDoctorIdResponse.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
+	9,   // 5: storage.GetDoctorsRequest.page:type_name -> storage.ListPage
+	21,  // 6: storage.GetDoctorsResponse.doctors:type_name -> storage.Doctor
+	9,   // 7: storage.GetAdminsRequest.page:type_name -> storage.ListPage
+	28,  // 8: storage.GetAdminsResponse.admins:type_name -> storage.Admin
+	235, // 9: storage.Patient.birth_date:type_name -> google.protobuf.Timestamp
+	235, // 10: storage.UpdatePatientRequest.birth_date:type_name -> google.protobuf.Timestamp
+	9,   // 11: storage.GetPatientsRequest.page:type_name -> storage.ListPage
+	33,  // 12: storage.GetPatientsResponse.patients:type_name -> storage.Patient
+	235, // 13: storage.WeeklyClinicSchedule.start_time:type_name -> google.protobuf.Timestamp
+	235, // 14: storage.WeeklyClinicSchedule.end_time:type_name -> google.protobuf.Timestamp
+	37,  // 15: storage.GetClinicWeeklyScheduleResponse.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
+	37,  // 16: storage.UpdateClinicWeeklyScheduleRequest.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
+	14,  // 17: storage.AddDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
+	14,  // 18: storage.UpdateDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
+	235, // 19: storage.AddClinicDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	235, // 20: storage.AddClinicDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
+	235, // 21: storage.AddClinicDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
+	235, // 22: storage.AddDoctorDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	235, // 23: storage.AddDoctorDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
+	235, // 24: storage.AddDoctorDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
+	235, // 25: storage.Appointment.date:type_name -> google.protobuf.Timestamp
+	235, // 26: storage.Appointment.time:type_name -> google.protobuf.Timestamp
+	235, // 27: storage.Appointment.birth_date:type_name -> google.protobuf.Timestamp
+	235, // 28: storage.Appointment.created_at:type_name -> google.protobuf.Timestamp
+	235, // 29: storage.Appointment.updated_at:type_name -> google.protobuf.Timestamp
+	49,  // 30: storage.GetAppointmentsByDoctorIDResponse.appointments:type_name -> storage.Appointment
+	33,  // 31: storage.GetPatientByIDResponse.patient:type_name -> storage.Patient
+	49,  // 32: storage.AddAppointmentRequest.appointment:type_name -> storage.Appointment
+	235, // 33: storage.AppointmentSlotHold.date:type_name -> google.protobuf.Timestamp
+	235, // 34: storage.AppointmentSlotHold.time:type_name -> google.protobuf.Timestamp
+	235, // 35: storage.AppointmentSlotHold.expires_at:type_name -> google.protobuf.Timestamp
+	235, // 36: storage.HoldAppointmentSlotRequest.date:type_name -> google.protobuf.Timestamp
+	235, // 37: storage.HoldAppointmentSlotRequest.time:type_name -> google.protobuf.Timestamp
+	54,  // 38: storage.HoldAppointmentSlotResponse.hold:type_name -> storage.AppointmentSlotHold
+	54,  // 39: storage.GetAppointmentSlotHoldsResponse.holds:type_name -> storage.AppointmentSlotHold
+	49,  // 40: storage.UpdateAppointmentRequest.appointment:type_name -> storage.Appointment
+	49,  // 41: storage.GetAppointmentsByUserIDResponse.appointment:type_name -> storage.Appointment
+	49,  // 42: storage.GetAppointmentByIDResponse.appointment:type_name -> storage.Appointment
+	21,  // 43: storage.GetDoctorByIDResponse.doctor:type_name -> storage.Doctor
+	235, // 44: storage.GetClinicOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	235, // 45: storage.GetClinicOverrideResponse.date:type_name -> google.protobuf.Timestamp
+	235, // 46: storage.GetClinicOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
+	235, // 47: storage.GetClinicOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
+	235, // 48: storage.GetDoctorOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	235, // 49: storage.GetDoctorOverrideResponse.date:type_name -> google.protobuf.Timestamp
+	235, // 50: storage.GetDoctorOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
+	235, // 51: storage.GetDoctorOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
+	235, // 52: storage.DoctorOverride.date:type_name -> google.protobuf.Timestamp
+	235, // 53: storage.DoctorOverride.start_time:type_name -> google.protobuf.Timestamp
+	235, // 54: storage.DoctorOverride.end_time:type_name -> google.protobuf.Timestamp
+	68,  // 55: storage.GetDoctorOverridesResponse.override:type_name -> storage.DoctorOverride
+	235, // 56: storage.MaterialBatch.expires_at:type_name -> google.protobuf.Timestamp
+	235, // 57: storage.MaterialBatch.received_at:type_name -> google.protobuf.Timestamp
+	235, // 58: storage.StockMovement.created_at:type_name -> google.protobuf.Timestamp
+	235, // 59: storage.TreatmentPlanItem.completed_at:type_name -> google.protobuf.Timestamp
+	235, // 60: storage.TreatmentPlan.accepted_at:type_name -> google.protobuf.Timestamp
+	235, // 61: storage.TreatmentPlan.created_at:type_name -> google.protobuf.Timestamp
+	235, // 62: storage.TreatmentPlan.updated_at:type_name -> google.protobuf.Timestamp
+	77,  // 63: storage.TreatmentPlan.items:type_name -> storage.TreatmentPlanItem
+	79,  // 64: storage.CreateTreatmentPlanRequest.items:type_name -> storage.NewTreatmentPlanItem
+	79,  // 65: storage.AddTreatmentPlanItemsRequest.items:type_name -> storage.NewTreatmentPlanItem
+	78,  // 66: storage.TreatmentPlanResponse.plan:type_name -> storage.TreatmentPlan
+	78,  // 67: storage.TreatmentPlansResponse.plans:type_name -> storage.TreatmentPlan
+	235, // 68: storage.ToothRecord.recorded_at:type_name -> google.protobuf.Timestamp
+	86,  // 69: storage.RecordToothStatesRequest.records:type_name -> storage.ToothRecord
+	86,  // 70: storage.DentalChartResponse.teeth:type_name -> storage.ToothRecord
+	90,  // 71: storage.Anamnesis.allergies:type_name -> storage.AnamnesisAllergy
+	235, // 72: storage.Anamnesis.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 73: storage.SaveAnamnesisRequest.anamnesis:type_name -> storage.Anamnesis
+	91,  // 74: storage.AnamnesisResponse.anamnesis:type_name -> storage.Anamnesis
+	91,  // 75: storage.AnamnesisHistoryResponse.versions:type_name -> storage.Anamnesis
+	235, // 76: storage.ClinicalAlertRule.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 77: storage.GetClinicalAlertRulesResponse.rules:type_name -> storage.ClinicalAlertRule
+	33,  // 78: storage.MedicalRecord.patient:type_name -> storage.Patient
+	133, // 79: storage.MedicalRecord.notes:type_name -> storage.PatientAllergiesChronics
+	91,  // 80: storage.MedicalRecord.anamnesis:type_name -> storage.Anamnesis
+	100, // 81: storage.MedicalRecord.visits:type_name -> storage.MedicalRecordVisit
+	205, // 82: storage.MedicalRecord.documents:type_name -> storage.DocumentInfo
+	235, // 83: storage.MedicalRecordVisit.created_at:type_name -> google.protobuf.Timestamp
+	101, // 84: storage.MedicalRecordVisit.diagnoses:type_name -> storage.MedicalRecordDiagnosis
+	102, // 85: storage.MedicalRecordVisit.services:type_name -> storage.MedicalRecordService
+	103, // 86: storage.MedicalRecordVisit.materials:type_name -> storage.MedicalRecordMaterial
+	235, // 87: storage.PatientAccessGrant.expires_at:type_name -> google.protobuf.Timestamp
+	235, // 88: storage.PatientAccessGrant.revoked_at:type_name -> google.protobuf.Timestamp
+	235, // 89: storage.PatientAccessGrant.created_at:type_name -> google.protobuf.Timestamp
+	106, // 90: storage.GetPatientAccessGrantsResponse.grants:type_name -> storage.PatientAccessGrant
+	235, // 91: storage.ReceiveMaterialBatchRequest.expires_at:type_name -> google.protobuf.Timestamp
+	74,  // 92: storage.MaterialStockResponse.material:type_name -> storage.Material
+	75,  // 93: storage.MaterialStockResponse.batches:type_name -> storage.MaterialBatch
+	76,  // 94: storage.MaterialStockResponse.movements:type_name -> storage.StockMovement
+	235, // 95: storage.StockReportRequest.expiring_before:type_name -> google.protobuf.Timestamp
+	75,  // 96: storage.ExpiringBatch.batch:type_name -> storage.MaterialBatch
+	74,  // 97: storage.StockReportResponse.low_stock:type_name -> storage.Material
+	113, // 98: storage.StockReportResponse.expiring:type_name -> storage.ExpiringBatch
+	74,  // 99: storage.GetMaterialsResponse.materials:type_name -> storage.Material
+	115, // 100: storage.GetServicesResponse.services:type_name -> storage.Service
+	125, // 101: storage.GetServicesTypesResponse.types:type_name -> storage.ServiceType
+	235, // 102: storage.Visit.created_at:type_name -> google.protobuf.Timestamp
+	134, // 103: storage.AddVisitMaterialsRequest.materials:type_name -> storage.AddVisitMaterials
+	136, // 104: storage.AddVisitServicesRequest.services:type_name -> storage.AddVisitServices
+	133, // 105: storage.AddPatientAllergiesChronicsRequest.notes:type_name -> storage.PatientAllergiesChronics
+	235, // 106: storage.AddPatientVisitRequest.created_at:type_name -> google.protobuf.Timestamp
+	131, // 107: storage.AddPatientDiagnosesRequest.diagnoses:type_name -> storage.Diagnose
+	131, // 108: storage.GetPatientDiagnosesResponse.diagnoses:type_name -> storage.Diagnose
+	132, // 109: storage.GetPatientVisitsResponse.visits:type_name -> storage.Visit
+	133, // 110: storage.GetPatientAllergiesChronicsResponse.patient_allergies_chronics:type_name -> storage.PatientAllergiesChronics
+	9,   // 111: storage.GetICDCodesRequest.page:type_name -> storage.ListPage
+	130, // 112: storage.GetICDCodesResponse.icd_code:type_name -> storage.ICDCode
+	9,   // 113: storage.GetVisitsPaymentsRequest.page:type_name -> storage.ListPage
+	147, // 114: storage.GetVisitsPaymentsResponse.visit_payment:type_name -> storage.VisitPayment
+	131, // 115: storage.CompleteConsultationRequest.diagnoses:type_name -> storage.Diagnose
+	136, // 116: storage.CompleteConsultationRequest.services:type_name -> storage.AddVisitServices
+	134, // 117: storage.CompleteConsultationRequest.materials:type_name -> storage.AddVisitMaterials
+	86,  // 118: storage.CompleteConsultationRequest.chart:type_name -> storage.ToothRecord
+	235, // 119: storage.Invoice.created_at:type_name -> google.protobuf.Timestamp
+	156, // 120: storage.Invoice.lines:type_name -> storage.InvoiceLine
+	157, // 121: storage.Invoice.payments:type_name -> storage.InvoicePayment
+	235, // 122: storage.InvoicePayment.created_at:type_name -> google.protobuf.Timestamp
+	155, // 123: storage.InvoiceResponse.invoice:type_name -> storage.Invoice
+	155, // 124: storage.PatientBalanceResponse.open_invoices:type_name -> storage.Invoice
+	235, // 125: storage.BillingDocument.created_at:type_name -> google.protobuf.Timestamp
+	235, // 126: storage.PriceListEntry.effective_from:type_name -> google.protobuf.Timestamp
+	235, // 127: storage.PriceListEntry.created_at:type_name -> google.protobuf.Timestamp
+	165, // 128: storage.GetPriceHistoryResponse.entries:type_name -> storage.PriceListEntry
+	165, // 129: storage.AddPriceListEntryRequest.entry:type_name -> storage.PriceListEntry
+	235, // 130: storage.GetPriceOnDateRequest.date:type_name -> google.protobuf.Timestamp
+	147, // 131: storage.AddOrUpdateVisitPaymentRequest.payment:type_name -> storage.VisitPayment
+	132, // 132: storage.GetVisitByIDResponse.visit:type_name -> storage.Visit
+	235, // 133: storage.ClinicOverride.date:type_name -> google.protobuf.Timestamp
+	235, // 134: storage.ClinicOverride.start_time:type_name -> google.protobuf.Timestamp
+	235, // 135: storage.ClinicOverride.end_time:type_name -> google.protobuf.Timestamp
+	175, // 136: storage.GetClinicOverridesResponse.overrides:type_name -> storage.ClinicOverride
+	9,   // 137: storage.GetAppointmentsRequest.page:type_name -> storage.ListPage
+	235, // 138: storage.GetAppointmentsRequest.date_from:type_name -> google.protobuf.Timestamp
+	235, // 139: storage.GetAppointmentsRequest.date_to:type_name -> google.protobuf.Timestamp
+	49,  // 140: storage.GetAppointmentsResponse.appointments:type_name -> storage.Appointment
+	179, // 141: storage.GetVisitMaterialsAndServicesResponse.visit_materials_services:type_name -> storage.VisitMaterialAndService
+	184, // 142: storage.ServiceStatsResponse.service_stats:type_name -> storage.ServiceStats
+	186, // 143: storage.DoctorAvgVisitResponse.visits:type_name -> storage.DoctorAvgVisit
+	188, // 144: storage.DoctorAvgCheckResponse.check:type_name -> storage.DoctorCheck
+	190, // 145: storage.DoctorUniquePatientResponse.patients:type_name -> storage.DoctorUniquePatient
+	192, // 146: storage.AgeGroupStatResponse.age_groups:type_name -> storage.AgeGroupStat
+	131, // 147: storage.GetDiagnoseByVisitIDResponse.diagnose:type_name -> storage.Diagnose
+	235, // 148: storage.StartDocumentUploadRequest.study_date:type_name -> google.protobuf.Timestamp
+	235, // 149: storage.GetDocumentMetadataResponse.study_date:type_name -> google.protobuf.Timestamp
+	235, // 150: storage.GetDocumentMetadataResponse.created_at:type_name -> google.protobuf.Timestamp
+	235, // 151: storage.DocumentInfo.created_at:type_name -> google.protobuf.Timestamp
+	205, // 152: storage.GetDocumentsResponse.documents:type_name -> storage.DocumentInfo
+	28,  // 153: storage.GetAdminByIDResponse.admin:type_name -> storage.Admin
+	235, // 154: storage.WaitingListEntry.date_from:type_name -> google.protobuf.Timestamp
+	235, // 155: storage.WaitingListEntry.date_to:type_name -> google.protobuf.Timestamp
+	235, // 156: storage.WaitingListEntry.created_at:type_name -> google.protobuf.Timestamp
+	235, // 157: storage.WaitingListOffer.date:type_name -> google.protobuf.Timestamp
+	235, // 158: storage.WaitingListOffer.time:type_name -> google.protobuf.Timestamp
+	235, // 159: storage.WaitingListOffer.expires_at:type_name -> google.protobuf.Timestamp
+	210, // 160: storage.AddWaitingListEntryRequest.entry:type_name -> storage.WaitingListEntry
+	210, // 161: storage.GetWaitingListResponse.entries:type_name -> storage.WaitingListEntry
+	211, // 162: storage.GetWaitingListResponse.offers:type_name -> storage.WaitingListOffer
+	49,  // 163: storage.AcceptWaitingListOfferRequest.appointment:type_name -> storage.Appointment
+	235, // 164: storage.ScheduleRule.date_from:type_name -> google.protobuf.Timestamp
+	235, // 165: storage.ScheduleRule.date_to:type_name -> google.protobuf.Timestamp
+	235, // 166: storage.ScheduleRule.start_time:type_name -> google.protobuf.Timestamp
+	235, // 167: storage.ScheduleRule.end_time:type_name -> google.protobuf.Timestamp
+	219, // 168: storage.GetScheduleRulesResponse.rules:type_name -> storage.ScheduleRule
+	219, // 169: storage.AddScheduleRuleRequest.rule:type_name -> storage.ScheduleRule
+	219, // 170: storage.ImportScheduleRulesRequest.rules:type_name -> storage.ScheduleRule
+	235, // 171: storage.Notification.send_at:type_name -> google.protobuf.Timestamp
+	226, // 172: storage.ClaimNotificationsResponse.notifications:type_name -> storage.Notification
+	235, // 173: storage.AuditEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	9,   // 174: storage.GetAuditLogRequest.page:type_name -> storage.ListPage
+	235, // 175: storage.GetAuditLogRequest.date_from:type_name -> google.protobuf.Timestamp
+	235, // 176: storage.GetAuditLogRequest.date_to:type_name -> google.protobuf.Timestamp
+	231, // 177: storage.GetAuditLogResponse.entries:type_name -> storage.AuditEntry
+	0,   // 178: storage.StorageService.AddUser:input_type -> storage.AddUserRequest
+	2,   // 179: storage.StorageService.AddDoctor:input_type -> storage.AddDoctorRequest
+	4,   // 180: storage.StorageService.AddAdmin:input_type -> storage.AddAdminRequest
+	6,   // 181: storage.StorageService.AddPatient:input_type -> storage.AddPatientRequest
+	25,  // 182: storage.StorageService.GetDoctors:input_type -> storage.GetDoctorsRequest
+	31,  // 183: storage.StorageService.GetAdmins:input_type -> storage.GetAdminsRequest
+	35,  // 184: storage.StorageService.GetPatients:input_type -> storage.GetPatientsRequest
+	119, // 185: storage.StorageService.GetDoctorSpecsByDoctorId:input_type -> storage.GetByIdRequest
+	22,  // 186: storage.StorageService.UpdateDoctor:input_type -> storage.UpdateDoctorRequest
+	23,  // 187: storage.StorageService.AddDoctorSpec:input_type -> storage.AddDoctorSpecRequest
+	24,  // 188: storage.StorageService.DeleteDoctorSpec:input_type -> storage.DeleteDoctorSpecRequest
+	29,  // 189: storage.StorageService.UpdateAdmin:input_type -> storage.UpdateAdminRequest
+	30,  // 190: storage.StorageService.UpdateAdminRole:input_type -> storage.UpdateAdminRoleRequest
+	34,  // 191: storage.StorageService.UpdatePatient:input_type -> storage.UpdatePatientRequest
+	122, // 192: storage.StorageService.DeleteUser:input_type -> storage.DeleteRequest
+	123, // 193: storage.StorageService.RetireUser:input_type -> storage.ChangeUserStatusRequest
+	123, // 194: storage.StorageService.RestoreUser:input_type -> storage.ChangeUserStatusRequest
+	129, // 195: storage.StorageService.UpdateUserLogin:input_type -> storage.UpdateUserLoginRequest
+	8,   // 196: storage.StorageService.GetAllSpecs:input_type -> storage.EmptyRequest
+	12,  // 197: storage.StorageService.AddUserRole:input_type -> storage.AddUserRoleRequest
+	17,  // 198: storage.StorageService.GetUserByLogin:input_type -> storage.GetUserByLoginRequest
+	19,  // 199: storage.StorageService.UpdateUserPassword:input_type -> storage.UpdateUserPasswordRequest
+	8,   // 200: storage.StorageService.GetClinicWeeklySchedule:input_type -> storage.EmptyRequest
+	39,  // 201: storage.StorageService.GetUserRole:input_type -> storage.GetUserRoleRequest
+	15,  // 202: storage.StorageService.GetDoctorWeeklySchedule:input_type -> storage.GetScheduleByDoctorIdRequest
+	41,  // 203: storage.StorageService.UpdateClinicWeeklySchedule:input_type -> storage.UpdateClinicWeeklyScheduleRequest
+	42,  // 204: storage.StorageService.AddDoctorWeeklySchedule:input_type -> storage.AddDoctorWeeklyScheduleRequest
+	43,  // 205: storage.StorageService.UpdateDoctorWeeklySchedule:input_type -> storage.UpdateDoctorWeeklyScheduleRequest
+	44,  // 206: storage.StorageService.GetRolePermission:input_type -> storage.GetRolePermissionRequest
+	47,  // 207: storage.StorageService.GetDoctorsBySpecID:input_type -> storage.GetDoctorBySpecIDRequest
+	48,  // 208: storage.StorageService.GetAppointmentsByDoctorID:input_type -> storage.GetAppointmentsByDoctorIDRequest
+	51,  // 209: storage.StorageService.GetPatientByID:input_type -> storage.GetByIDRequest
+	53,  // 210: storage.StorageService.AddAppointment:input_type -> storage.AddAppointmentRequest
+	51,  // 211: storage.StorageService.GetAppointmentsByUserID:input_type -> storage.GetByIDRequest
+	51,  // 212: storage.StorageService.GetSpecsByDoctorID:input_type -> storage.GetByIDRequest
+	51,  // 213: storage.StorageService.GetDoctorByID:input_type -> storage.GetByIDRequest
+	59,  // 214: storage.StorageService.UpdateAppointment:input_type -> storage.UpdateAppointmentRequest
+	51,  // 215: storage.StorageService.GetAppointmentByID:input_type -> storage.GetByIDRequest
+	55,  // 216: storage.StorageService.HoldAppointmentSlot:input_type -> storage.HoldAppointmentSlotRequest
+	57,  // 217: storage.StorageService.ReleaseAppointmentSlot:input_type -> storage.ReleaseAppointmentSlotRequest
+	48,  // 218: storage.StorageService.GetAppointmentSlotHolds:input_type -> storage.GetAppointmentsByDoctorIDRequest
+	45,  // 219: storage.StorageService.AddClinicDailyOverride:input_type -> storage.AddClinicDailyOverrideRequest
+	46,  // 220: storage.StorageService.AddDoctorDailyOverride:input_type -> storage.AddDoctorDailyOverrideRequest
+	64,  // 221: storage.StorageService.GetClinicOverride:input_type -> storage.GetClinicOverrideRequest
+	66,  // 222: storage.StorageService.GetDoctorOverride:input_type -> storage.GetDoctorOverrideRequest
+	8,   // 223: storage.StorageService.GetClinicSettings:input_type -> storage.EmptyRequest
+	209, // 224: storage.StorageService.UpdateClinicTimeZone:input_type -> storage.UpdateClinicTimeZoneRequest
+	212, // 225: storage.StorageService.AddWaitingListEntry:input_type -> storage.AddWaitingListEntryRequest
+	51,  // 226: storage.StorageService.GetWaitingListByPatientID:input_type -> storage.GetByIDRequest
+	215, // 227: storage.StorageService.CancelWaitingListEntry:input_type -> storage.CancelWaitingListEntryRequest
+	216, // 228: storage.StorageService.AcceptWaitingListOffer:input_type -> storage.AcceptWaitingListOfferRequest
+	218, // 229: storage.StorageService.DeclineWaitingListOffer:input_type -> storage.DeclineWaitingListOfferRequest
+	220, // 230: storage.StorageService.GetScheduleRules:input_type -> storage.GetScheduleRulesRequest
+	222, // 231: storage.StorageService.AddScheduleRule:input_type -> storage.AddScheduleRuleRequest
+	224, // 232: storage.StorageService.ImportScheduleRules:input_type -> storage.ImportScheduleRulesRequest
+	122, // 233: storage.StorageService.DeleteScheduleRule:input_type -> storage.DeleteRequest
+	227, // 234: storage.StorageService.ClaimNotifications:input_type -> storage.ClaimNotificationsRequest
+	229, // 235: storage.StorageService.CompleteNotification:input_type -> storage.CompleteNotificationRequest
+	51,  // 236: storage.StorageService.GetPatientNotificationSettings:input_type -> storage.GetByIDRequest
+	230, // 237: storage.StorageService.UpdatePatientNotificationSettings:input_type -> storage.PatientNotificationSettings
+	70,  // 238: storage.StorageService.AddMaterial:input_type -> storage.AddMaterialRequest
+	71,  // 239: storage.StorageService.AddService:input_type -> storage.AddServiceRequest
+	72,  // 240: storage.StorageService.UpdateMaterial:input_type -> storage.UpdateMaterialRequest
+	73,  // 241: storage.StorageService.UpdateService:input_type -> storage.UpdateServiceRequest
+	8,   // 242: storage.StorageService.GetMaterials:input_type -> storage.EmptyRequest
+	8,   // 243: storage.StorageService.GetServices:input_type -> storage.EmptyRequest
+	8,   // 244: storage.StorageService.GetServicesTypes:input_type -> storage.EmptyRequest
+	127, // 245: storage.StorageService.GetServiceTypeById:input_type -> storage.GetServiceTypeByIdRequest
+	122, // 246: storage.StorageService.DeleteMaterial:input_type -> storage.DeleteRequest
+	109, // 247: storage.StorageService.ReceiveMaterialBatch:input_type -> storage.ReceiveMaterialBatchRequest
+	110, // 248: storage.StorageService.AdjustMaterialStock:input_type -> storage.AdjustMaterialStockRequest
+	119, // 249: storage.StorageService.GetMaterialStock:input_type -> storage.GetByIdRequest
+	112, // 250: storage.StorageService.GetStockReport:input_type -> storage.StockReportRequest
+	80,  // 251: storage.StorageService.CreateTreatmentPlan:input_type -> storage.CreateTreatmentPlanRequest
+	81,  // 252: storage.StorageService.AddTreatmentPlanItems:input_type -> storage.AddTreatmentPlanItemsRequest
+	82,  // 253: storage.StorageService.UpdateTreatmentPlanItemStatus:input_type -> storage.UpdateTreatmentPlanItemStatusRequest
+	83,  // 254: storage.StorageService.AcceptTreatmentPlan:input_type -> storage.AcceptTreatmentPlanRequest
+	119, // 255: storage.StorageService.CancelTreatmentPlan:input_type -> storage.GetByIdRequest
+	119, // 256: storage.StorageService.GetTreatmentPlan:input_type -> storage.GetByIdRequest
+	119, // 257: storage.StorageService.GetTreatmentPlanByItemID:input_type -> storage.GetByIdRequest
+	119, // 258: storage.StorageService.GetPatientTreatmentPlans:input_type -> storage.GetByIdRequest
+	87,  // 259: storage.StorageService.RecordToothStates:input_type -> storage.RecordToothStatesRequest
+	119, // 260: storage.StorageService.GetDentalChart:input_type -> storage.GetByIdRequest
+	88,  // 261: storage.StorageService.GetToothHistory:input_type -> storage.GetToothHistoryRequest
+	92,  // 262: storage.StorageService.SaveAnamnesis:input_type -> storage.SaveAnamnesisRequest
+	119, // 263: storage.StorageService.GetAnamnesis:input_type -> storage.GetByIdRequest
+	119, // 264: storage.StorageService.GetAnamnesisHistory:input_type -> storage.GetByIdRequest
+	96,  // 265: storage.StorageService.GetClinicalAlertRules:input_type -> storage.GetClinicalAlertRulesRequest
+	95,  // 266: storage.StorageService.AddClinicalAlertRule:input_type -> storage.ClinicalAlertRule
+	95,  // 267: storage.StorageService.UpdateClinicalAlertRule:input_type -> storage.ClinicalAlertRule
+	122, // 268: storage.StorageService.DeleteClinicalAlertRule:input_type -> storage.DeleteRequest
+	119, // 269: storage.StorageService.GetMedicalRecord:input_type -> storage.GetByIdRequest
+	104, // 270: storage.StorageService.GetDoctorPatientRelation:input_type -> storage.DoctorPatientRelationRequest
+	119, // 271: storage.StorageService.GetPatientAccessGrants:input_type -> storage.GetByIdRequest
+	106, // 272: storage.StorageService.AddPatientAccessGrant:input_type -> storage.PatientAccessGrant
+	122, // 273: storage.StorageService.RevokePatientAccessGrant:input_type -> storage.DeleteRequest
+	122, // 274: storage.StorageService.DeleteService:input_type -> storage.DeleteRequest
+	166, // 275: storage.StorageService.GetPriceHistory:input_type -> storage.GetPriceHistoryRequest
+	168, // 276: storage.StorageService.AddPriceListEntry:input_type -> storage.AddPriceListEntryRequest
+	170, // 277: storage.StorageService.DeletePriceListEntry:input_type -> storage.DeletePriceListEntryRequest
+	171, // 278: storage.StorageService.GetPriceOnDate:input_type -> storage.GetPriceOnDateRequest
+	51,  // 279: storage.StorageService.GetDoctorOverrides:input_type -> storage.GetByIDRequest
+	119, // 280: storage.StorageService.GetPatientDiagnoses:input_type -> storage.GetByIdRequest
+	119, // 281: storage.StorageService.GetPatientVisits:input_type -> storage.GetByIdRequest
+	119, // 282: storage.StorageService.GetPatientAllergiesChronics:input_type -> storage.GetByIdRequest
+	144, // 283: storage.StorageService.GetICDCodes:input_type -> storage.GetICDCodesRequest
+	138, // 284: storage.StorageService.AddPatientAllergiesChronics:input_type -> storage.AddPatientAllergiesChronicsRequest
+	139, // 285: storage.StorageService.AddPatientVisit:input_type -> storage.AddPatientVisitRequest
+	135, // 286: storage.StorageService.AddVisitMaterials:input_type -> storage.AddVisitMaterialsRequest
+	137, // 287: storage.StorageService.AddVisitServices:input_type -> storage.AddVisitServicesRequest
+	140, // 288: storage.StorageService.AddPatientDiagnoses:input_type -> storage.AddPatientDiagnosesRequest
+	148, // 289: storage.StorageService.AddVisitPayment:input_type -> storage.VisitPaymentRequest
+	148, // 290: storage.StorageService.UpdateVisitPayment:input_type -> storage.VisitPaymentRequest
+	119, // 291: storage.StorageService.GetVisitByID:input_type -> storage.GetByIdRequest
+	151, // 292: storage.StorageService.CalculateVisitTotal:input_type -> storage.CalculateVisitTotalRequest
+	173, // 293: storage.StorageService.AddOrUpdateVisitPayment:input_type -> storage.AddOrUpdateVisitPaymentRequest
+	153, // 294: storage.StorageService.CompleteConsultation:input_type -> storage.CompleteConsultationRequest
+	119, // 295: storage.StorageService.IssueInvoice:input_type -> storage.GetByIdRequest
+	119, // 296: storage.StorageService.GetInvoice:input_type -> storage.GetByIdRequest
+	159, // 297: storage.StorageService.SetInvoiceDiscount:input_type -> storage.SetInvoiceDiscountRequest
+	160, // 298: storage.StorageService.AddInvoicePayment:input_type -> storage.AddInvoicePaymentRequest
+	161, // 299: storage.StorageService.RefundInvoicePayment:input_type -> storage.RefundInvoicePaymentRequest
+	119, // 300: storage.StorageService.GetPatientBalance:input_type -> storage.GetByIdRequest
+	119, // 301: storage.StorageService.ReserveInvoiceDocument:input_type -> storage.GetByIdRequest
+	119, // 302: storage.StorageService.ReserveReceiptDocument:input_type -> storage.GetByIdRequest
+	164, // 303: storage.StorageService.AttachBillingDocument:input_type -> storage.AttachBillingDocumentRequest
+	149, // 304: storage.StorageService.GetVisitsPayments:input_type -> storage.GetVisitsPaymentsRequest
+	8,   // 305: storage.StorageService.GetClinicOverrides:input_type -> storage.EmptyRequest
+	177, // 306: storage.StorageService.GetAppointments:input_type -> storage.GetAppointmentsRequest
+	119, // 307: storage.StorageService.GetVisitMaterials:input_type -> storage.GetByIdRequest
+	119, // 308: storage.StorageService.GetVisitServices:input_type -> storage.GetByIdRequest
+	119, // 309: storage.StorageService.GetMaterialByID:input_type -> storage.GetByIdRequest
+	119, // 310: storage.StorageService.GetServiceByID:input_type -> storage.GetByIdRequest
+	8,   // 311: storage.StorageService.GetTotalPatients:input_type -> storage.EmptyRequest
+	8,   // 312: storage.StorageService.GetTotalVisits:input_type -> storage.EmptyRequest
+	8,   // 313: storage.StorageService.GetTopServices:input_type -> storage.EmptyRequest
+	8,   // 314: storage.StorageService.GetDoctorAvgVisit:input_type -> storage.EmptyRequest
+	8,   // 315: storage.StorageService.GetDoctorAvgCheck:input_type -> storage.EmptyRequest
+	8,   // 316: storage.StorageService.GetDoctorUniquePatient:input_type -> storage.EmptyRequest
+	8,   // 317: storage.StorageService.GetAgeGroupStat:input_type -> storage.EmptyRequest
+	8,   // 318: storage.StorageService.GetNewPatientsThisMonth:input_type -> storage.EmptyRequest
+	8,   // 319: storage.StorageService.GetAvgVisitsPerPatient:input_type -> storage.EmptyRequest
+	8,   // 320: storage.StorageService.GetTotalIncome:input_type -> storage.EmptyRequest
+	8,   // 321: storage.StorageService.GetMonthlyIncome:input_type -> storage.EmptyRequest
+	8,   // 322: storage.StorageService.GetClinicAverageCheck:input_type -> storage.EmptyRequest
+	51,  // 323: storage.StorageService.GetDiagnoseByVisitID:input_type -> storage.GetByIDRequest
+	195, // 324: storage.StorageService.StartDocumentUpload:input_type -> storage.StartDocumentUploadRequest
+	197, // 325: storage.StorageService.UploadDocument:input_type -> storage.UploadDocumentChunk
+	198, // 326: storage.StorageService.GetDocumentUploadStatus:input_type -> storage.DocumentUploadStatusRequest
+	200, // 327: storage.StorageService.GetDocumentMetadata:input_type -> storage.GetDocumentMetadataRequest
+	202, // 328: storage.StorageService.DownloadDocument:input_type -> storage.DownloadDocumentRequest
+	204, // 329: storage.StorageService.GetDocumentsByPatientID:input_type -> storage.GetDocumentsRequest
+	51,  // 330: storage.StorageService.GetAdminByID:input_type -> storage.GetByIDRequest
+	231, // 331: storage.StorageService.AddAuditEntry:input_type -> storage.AuditEntry
+	232, // 332: storage.StorageService.GetAuditLog:input_type -> storage.GetAuditLogRequest
+	8,   // 333: storage.StorageService.VerifyAuditLog:input_type -> storage.EmptyRequest
+	1,   // 334: storage.StorageService.AddUser:output_type -> storage.AddUserResponse
+	3,   // 335: storage.StorageService.AddDoctor:output_type -> storage.AddDoctorResponse
+	5,   // 336: storage.StorageService.AddAdmin:output_type -> storage.AddAdminResponse
+	7,   // 337: storage.StorageService.AddPatient:output_type -> storage.AddPatientResponse
+	26,  // 338: storage.StorageService.GetDoctors:output_type -> storage.GetDoctorsResponse
+	32,  // 339: storage.StorageService.GetAdmins:output_type -> storage.GetAdminsResponse
+	36,  // 340: storage.StorageService.GetPatients:output_type -> storage.GetPatientsResponse
+	27,  // 341: storage.StorageService.GetDoctorSpecsByDoctorId:output_type -> storage.GetDoctorSpecsByDoctorIdResponse
+	20,  // 342: storage.StorageService.UpdateDoctor:output_type -> storage.DefaultResponse
+	20,  // 343: storage.StorageService.AddDoctorSpec:output_type -> storage.DefaultResponse
+	20,  // 344: storage.StorageService.DeleteDoctorSpec:output_type -> storage.DefaultResponse
+	20,  // 345: storage.StorageService.UpdateAdmin:output_type -> storage.DefaultResponse
+	20,  // 346: storage.StorageService.UpdateAdminRole:output_type -> storage.DefaultResponse
+	20,  // 347: storage.StorageService.UpdatePatient:output_type -> storage.DefaultResponse
+	20,  // 348: storage.StorageService.DeleteUser:output_type -> storage.DefaultResponse
+	124, // 349: storage.StorageService.RetireUser:output_type -> storage.ChangeUserStatusResponse
+	124, // 350: storage.StorageService.RestoreUser:output_type -> storage.ChangeUserStatusResponse
+	20,  // 351: storage.StorageService.UpdateUserLogin:output_type -> storage.DefaultResponse
+	11,  // 352: storage.StorageService.GetAllSpecs:output_type -> storage.GetAllSpecsResponse
+	13,  // 353: storage.StorageService.AddUserRole:output_type -> storage.AddUserRoleResponse
+	18,  // 354: storage.StorageService.GetUserByLogin:output_type -> storage.GetUserByLoginResponse
+	20,  // 355: storage.StorageService.UpdateUserPassword:output_type -> storage.DefaultResponse
+	38,  // 356: storage.StorageService.GetClinicWeeklySchedule:output_type -> storage.GetClinicWeeklyScheduleResponse
+	40,  // 357: storage.StorageService.GetUserRole:output_type -> storage.GetUserRoleResponse
+	16,  // 358: storage.StorageService.GetDoctorWeeklySchedule:output_type -> storage.GetScheduleByDoctorIdResponse
+	20,  // 359: storage.StorageService.UpdateClinicWeeklySchedule:output_type -> storage.DefaultResponse
+	20,  // 360: storage.StorageService.AddDoctorWeeklySchedule:output_type -> storage.DefaultResponse
+	20,  // 361: storage.StorageService.UpdateDoctorWeeklySchedule:output_type -> storage.DefaultResponse
+	20,  // 362: storage.StorageService.GetRolePermission:output_type -> storage.DefaultResponse
+	26,  // 363: storage.StorageService.GetDoctorsBySpecID:output_type -> storage.GetDoctorsResponse
+	50,  // 364: storage.StorageService.GetAppointmentsByDoctorID:output_type -> storage.GetAppointmentsByDoctorIDResponse
+	52,  // 365: storage.StorageService.GetPatientByID:output_type -> storage.GetPatientByIDResponse
+	20,  // 366: storage.StorageService.AddAppointment:output_type -> storage.DefaultResponse
+	60,  // 367: storage.StorageService.GetAppointmentsByUserID:output_type -> storage.GetAppointmentsByUserIDResponse
+	63,  // 368: storage.StorageService.GetSpecsByDoctorID:output_type -> storage.GetSpecsByDoctorIDResponse
+	62,  // 369: storage.StorageService.GetDoctorByID:output_type -> storage.GetDoctorByIDResponse
+	20,  // 370: storage.StorageService.UpdateAppointment:output_type -> storage.DefaultResponse
+	61,  // 371: storage.StorageService.GetAppointmentByID:output_type -> storage.GetAppointmentByIDResponse
+	56,  // 372: storage.StorageService.HoldAppointmentSlot:output_type -> storage.HoldAppointmentSlotResponse
+	20,  // 373: storage.StorageService.ReleaseAppointmentSlot:output_type -> storage.DefaultResponse
+	58,  // 374: storage.StorageService.GetAppointmentSlotHolds:output_type -> storage.GetAppointmentSlotHoldsResponse
+	20,  // 375: storage.StorageService.AddClinicDailyOverride:output_type -> storage.DefaultResponse
+	20,  // 376: storage.StorageService.AddDoctorDailyOverride:output_type -> storage.DefaultResponse
+	65,  // 377: storage.StorageService.GetClinicOverride:output_type -> storage.GetClinicOverrideResponse
+	67,  // 378: storage.StorageService.GetDoctorOverride:output_type -> storage.GetDoctorOverrideResponse
+	208, // 379: storage.StorageService.GetClinicSettings:output_type -> storage.ClinicSettings
+	20,  // 380: storage.StorageService.UpdateClinicTimeZone:output_type -> storage.DefaultResponse
+	213, // 381: storage.StorageService.AddWaitingListEntry:output_type -> storage.AddWaitingListEntryResponse
+	214, // 382: storage.StorageService.GetWaitingListByPatientID:output_type -> storage.GetWaitingListResponse
+	20,  // 383: storage.StorageService.CancelWaitingListEntry:output_type -> storage.DefaultResponse
+	217, // 384: storage.StorageService.AcceptWaitingListOffer:output_type -> storage.AcceptWaitingListOfferResponse
+	20,  // 385: storage.StorageService.DeclineWaitingListOffer:output_type -> storage.DefaultResponse
+	221, // 386: storage.StorageService.GetScheduleRules:output_type -> storage.GetScheduleRulesResponse
+	223, // 387: storage.StorageService.AddScheduleRule:output_type -> storage.AddScheduleRuleResponse
+	225, // 388: storage.StorageService.ImportScheduleRules:output_type -> storage.ImportScheduleRulesResponse
+	20,  // 389: storage.StorageService.DeleteScheduleRule:output_type -> storage.DefaultResponse
+	228, // 390: storage.StorageService.ClaimNotifications:output_type -> storage.ClaimNotificationsResponse
+	20,  // 391: storage.StorageService.CompleteNotification:output_type -> storage.DefaultResponse
+	230, // 392: storage.StorageService.GetPatientNotificationSettings:output_type -> storage.PatientNotificationSettings
+	20,  // 393: storage.StorageService.UpdatePatientNotificationSettings:output_type -> storage.DefaultResponse
+	20,  // 394: storage.StorageService.AddMaterial:output_type -> storage.DefaultResponse
+	20,  // 395: storage.StorageService.AddService:output_type -> storage.DefaultResponse
+	20,  // 396: storage.StorageService.UpdateMaterial:output_type -> storage.DefaultResponse
+	20,  // 397: storage.StorageService.UpdateService:output_type -> storage.DefaultResponse
+	116, // 398: storage.StorageService.GetMaterials:output_type -> storage.GetMaterialsResponse
+	117, // 399: storage.StorageService.GetServices:output_type -> storage.GetServicesResponse
+	126, // 400: storage.StorageService.GetServicesTypes:output_type -> storage.GetServicesTypesResponse
+	128, // 401: storage.StorageService.GetServiceTypeById:output_type -> storage.GetServiceTypeByIdResponse
+	20,  // 402: storage.StorageService.DeleteMaterial:output_type -> storage.DefaultResponse
+	75,  // 403: storage.StorageService.ReceiveMaterialBatch:output_type -> storage.MaterialBatch
+	20,  // 404: storage.StorageService.AdjustMaterialStock:output_type -> storage.DefaultResponse
+	111, // 405: storage.StorageService.GetMaterialStock:output_type -> storage.MaterialStockResponse
+	114, // 406: storage.StorageService.GetStockReport:output_type -> storage.StockReportResponse
+	84,  // 407: storage.StorageService.CreateTreatmentPlan:output_type -> storage.TreatmentPlanResponse
+	84,  // 408: storage.StorageService.AddTreatmentPlanItems:output_type -> storage.TreatmentPlanResponse
+	84,  // 409: storage.StorageService.UpdateTreatmentPlanItemStatus:output_type -> storage.TreatmentPlanResponse
+	84,  // 410: storage.StorageService.AcceptTreatmentPlan:output_type -> storage.TreatmentPlanResponse
+	84,  // 411: storage.StorageService.CancelTreatmentPlan:output_type -> storage.TreatmentPlanResponse
+	84,  // 412: storage.StorageService.GetTreatmentPlan:output_type -> storage.TreatmentPlanResponse
+	84,  // 413: storage.StorageService.GetTreatmentPlanByItemID:output_type -> storage.TreatmentPlanResponse
+	85,  // 414: storage.StorageService.GetPatientTreatmentPlans:output_type -> storage.TreatmentPlansResponse
+	89,  // 415: storage.StorageService.RecordToothStates:output_type -> storage.DentalChartResponse
+	89,  // 416: storage.StorageService.GetDentalChart:output_type -> storage.DentalChartResponse
+	89,  // 417: storage.StorageService.GetToothHistory:output_type -> storage.DentalChartResponse
+	93,  // 418: storage.StorageService.SaveAnamnesis:output_type -> storage.AnamnesisResponse
+	93,  // 419: storage.StorageService.GetAnamnesis:output_type -> storage.AnamnesisResponse
+	94,  // 420: storage.StorageService.GetAnamnesisHistory:output_type -> storage.AnamnesisHistoryResponse
+	97,  // 421: storage.StorageService.GetClinicalAlertRules:output_type -> storage.GetClinicalAlertRulesResponse
+	98,  // 422: storage.StorageService.AddClinicalAlertRule:output_type -> storage.AddClinicalAlertRuleResponse
+	20,  // 423: storage.StorageService.UpdateClinicalAlertRule:output_type -> storage.DefaultResponse
+	20,  // 424: storage.StorageService.DeleteClinicalAlertRule:output_type -> storage.DefaultResponse
+	99,  // 425: storage.StorageService.GetMedicalRecord:output_type -> storage.MedicalRecord
+	105, // 426: storage.StorageService.GetDoctorPatientRelation:output_type -> storage.DoctorPatientRelation
+	107, // 427: storage.StorageService.GetPatientAccessGrants:output_type -> storage.GetPatientAccessGrantsResponse
+	108, // 428: storage.StorageService.AddPatientAccessGrant:output_type -> storage.AddPatientAccessGrantResponse
+	20,  // 429: storage.StorageService.RevokePatientAccessGrant:output_type -> storage.DefaultResponse
+	20,  // 430: storage.StorageService.DeleteService:output_type -> storage.DefaultResponse
+	167, // 431: storage.StorageService.GetPriceHistory:output_type -> storage.GetPriceHistoryResponse
+	169, // 432: storage.StorageService.AddPriceListEntry:output_type -> storage.AddPriceListEntryResponse
+	20,  // 433: storage.StorageService.DeletePriceListEntry:output_type -> storage.DefaultResponse
+	172, // 434: storage.StorageService.GetPriceOnDate:output_type -> storage.GetPriceOnDateResponse
+	69,  // 435: storage.StorageService.GetDoctorOverrides:output_type -> storage.GetDoctorOverridesResponse
+	141, // 436: storage.StorageService.GetPatientDiagnoses:output_type -> storage.GetPatientDiagnosesResponse
+	142, // 437: storage.StorageService.GetPatientVisits:output_type -> storage.GetPatientVisitsResponse
+	143, // 438: storage.StorageService.GetPatientAllergiesChronics:output_type -> storage.GetPatientAllergiesChronicsResponse
+	145, // 439: storage.StorageService.GetICDCodes:output_type -> storage.GetICDCodesResponse
+	20,  // 440: storage.StorageService.AddPatientAllergiesChronics:output_type -> storage.DefaultResponse
+	146, // 441: storage.StorageService.AddPatientVisit:output_type -> storage.AddVisitResponse
+	20,  // 442: storage.StorageService.AddVisitMaterials:output_type -> storage.DefaultResponse
+	20,  // 443: storage.StorageService.AddVisitServices:output_type -> storage.DefaultResponse
+	20,  // 444: storage.StorageService.AddPatientDiagnoses:output_type -> storage.DefaultResponse
+	20,  // 445: storage.StorageService.AddVisitPayment:output_type -> storage.DefaultResponse
+	20,  // 446: storage.StorageService.UpdateVisitPayment:output_type -> storage.DefaultResponse
+	174, // 447: storage.StorageService.GetVisitByID:output_type -> storage.GetVisitByIDResponse
+	152, // 448: storage.StorageService.CalculateVisitTotal:output_type -> storage.CalculateVisitTotalResponse
+	20,  // 449: storage.StorageService.AddOrUpdateVisitPayment:output_type -> storage.DefaultResponse
+	154, // 450: storage.StorageService.CompleteConsultation:output_type -> storage.CompleteConsultationResponse
+	158, // 451: storage.StorageService.IssueInvoice:output_type -> storage.InvoiceResponse
+	158, // 452: storage.StorageService.GetInvoice:output_type -> storage.InvoiceResponse
+	158, // 453: storage.StorageService.SetInvoiceDiscount:output_type -> storage.InvoiceResponse
+	158, // 454: storage.StorageService.AddInvoicePayment:output_type -> storage.InvoiceResponse
+	158, // 455: storage.StorageService.RefundInvoicePayment:output_type -> storage.InvoiceResponse
+	162, // 456: storage.StorageService.GetPatientBalance:output_type -> storage.PatientBalanceResponse
+	163, // 457: storage.StorageService.ReserveInvoiceDocument:output_type -> storage.BillingDocument
+	163, // 458: storage.StorageService.ReserveReceiptDocument:output_type -> storage.BillingDocument
+	20,  // 459: storage.StorageService.AttachBillingDocument:output_type -> storage.DefaultResponse
+	150, // 460: storage.StorageService.GetVisitsPayments:output_type -> storage.GetVisitsPaymentsResponse
+	176, // 461: storage.StorageService.GetClinicOverrides:output_type -> storage.GetClinicOverridesResponse
+	178, // 462: storage.StorageService.GetAppointments:output_type -> storage.GetAppointmentsResponse
+	180, // 463: storage.StorageService.GetVisitMaterials:output_type -> storage.GetVisitMaterialsAndServicesResponse
+	180, // 464: storage.StorageService.GetVisitServices:output_type -> storage.GetVisitMaterialsAndServicesResponse
+	181, // 465: storage.StorageService.GetMaterialByID:output_type -> storage.GetMaterialServiceByIDResponse
+	181, // 466: storage.StorageService.GetServiceByID:output_type -> storage.GetMaterialServiceByIDResponse
+	182, // 467: storage.StorageService.GetTotalPatients:output_type -> storage.IntResponse
+	182, // 468: storage.StorageService.GetTotalVisits:output_type -> storage.IntResponse
+	185, // 469: storage.StorageService.GetTopServices:output_type -> storage.ServiceStatsResponse
+	187, // 470: storage.StorageService.GetDoctorAvgVisit:output_type -> storage.DoctorAvgVisitResponse
+	189, // 471: storage.StorageService.GetDoctorAvgCheck:output_type -> storage.DoctorAvgCheckResponse
+	191, // 472: storage.StorageService.GetDoctorUniquePatient:output_type -> storage.DoctorUniquePatientResponse
+	193, // 473: storage.StorageService.GetAgeGroupStat:output_type -> storage.AgeGroupStatResponse
+	182, // 474: storage.StorageService.GetNewPatientsThisMonth:output_type -> storage.IntResponse
+	183, // 475: storage.StorageService.GetAvgVisitsPerPatient:output_type -> storage.FloatResponse
+	183, // 476: storage.StorageService.GetTotalIncome:output_type -> storage.FloatResponse
+	183, // 477: storage.StorageService.GetMonthlyIncome:output_type -> storage.FloatResponse
+	183, // 478: storage.StorageService.GetClinicAverageCheck:output_type -> storage.FloatResponse
+	194, // 479: storage.StorageService.GetDiagnoseByVisitID:output_type -> storage.GetDiagnoseByVisitIDResponse
+	196, // 480: storage.StorageService.StartDocumentUpload:output_type -> storage.StartDocumentUploadResponse
+	199, // 481: storage.StorageService.UploadDocument:output_type -> storage.DocumentUploadStatus
+	199, // 482: storage.StorageService.GetDocumentUploadStatus:output_type -> storage.DocumentUploadStatus
+	201, // 483: storage.StorageService.GetDocumentMetadata:output_type -> storage.GetDocumentMetadataResponse
+	203, // 484: storage.StorageService.DownloadDocument:output_type -> storage.DownloadDocumentChunk
+	206, // 485: storage.StorageService.GetDocumentsByPatientID:output_type -> storage.GetDocumentsResponse
+	207, // 486: storage.StorageService.GetAdminByID:output_type -> storage.GetAdminByIDResponse
+	20,  // 487: storage.StorageService.AddAuditEntry:output_type -> storage.DefaultResponse
+	233, // 488: storage.StorageService.GetAuditLog:output_type -> storage.GetAuditLogResponse
+	234, // 489: storage.StorageService.VerifyAuditLog:output_type -> storage.VerifyAuditLogResponse
+	334, // [334:490] is the sub-list for method output_type
+	178, // [178:334] is the sub-list for method input_type
+	178, // [178:178] is the sub-list for extension type_name
+	178, // [178:178] is the sub-list for extension extendee
+	0,   // [0:178] is the sub-list for field type_name
 }
 
 func init() { file_proto_storage_storage_proto_init() }
@@ -7079,13 +16653,14 @@ func file_proto_storage_storage_proto_init() {
 	if File_proto_storage_storage_proto != nil {
 		return
 	}
+	file_proto_storage_storage_proto_msgTypes[179].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_storage_storage_proto_rawDesc), len(file_proto_storage_storage_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   108,
+			NumMessages:   235,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
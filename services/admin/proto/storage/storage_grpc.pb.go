@@ -19,78 +19,162 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StorageService_AddUser_FullMethodName                     = "/storage.StorageService/AddUser"
-	StorageService_AddDoctor_FullMethodName                   = "/storage.StorageService/AddDoctor"
-	StorageService_AddAdmin_FullMethodName                    = "/storage.StorageService/AddAdmin"
-	StorageService_AddPatient_FullMethodName                  = "/storage.StorageService/AddPatient"
-	StorageService_GetDoctors_FullMethodName                  = "/storage.StorageService/GetDoctors"
-	StorageService_GetAdmins_FullMethodName                   = "/storage.StorageService/GetAdmins"
-	StorageService_GetPatients_FullMethodName                 = "/storage.StorageService/GetPatients"
-	StorageService_GetDoctorSpecsByDoctorId_FullMethodName    = "/storage.StorageService/GetDoctorSpecsByDoctorId"
-	StorageService_UpdateDoctor_FullMethodName                = "/storage.StorageService/UpdateDoctor"
-	StorageService_AddDoctorSpec_FullMethodName               = "/storage.StorageService/AddDoctorSpec"
-	StorageService_DeleteDoctorSpec_FullMethodName            = "/storage.StorageService/DeleteDoctorSpec"
-	StorageService_UpdateAdmin_FullMethodName                 = "/storage.StorageService/UpdateAdmin"
-	StorageService_UpdateAdminRole_FullMethodName             = "/storage.StorageService/UpdateAdminRole"
-	StorageService_UpdatePatient_FullMethodName               = "/storage.StorageService/UpdatePatient"
-	StorageService_DeleteUser_FullMethodName                  = "/storage.StorageService/DeleteUser"
-	StorageService_UpdateUserLogin_FullMethodName             = "/storage.StorageService/UpdateUserLogin"
-	StorageService_GetAllSpecs_FullMethodName                 = "/storage.StorageService/GetAllSpecs"
-	StorageService_AddUserRole_FullMethodName                 = "/storage.StorageService/AddUserRole"
-	StorageService_GetUserByLogin_FullMethodName              = "/storage.StorageService/GetUserByLogin"
-	StorageService_UpdateUserPassword_FullMethodName          = "/storage.StorageService/UpdateUserPassword"
-	StorageService_GetClinicWeeklySchedule_FullMethodName     = "/storage.StorageService/GetClinicWeeklySchedule"
-	StorageService_GetUserRole_FullMethodName                 = "/storage.StorageService/GetUserRole"
-	StorageService_GetDoctorWeeklySchedule_FullMethodName     = "/storage.StorageService/GetDoctorWeeklySchedule"
-	StorageService_UpdateClinicWeeklySchedule_FullMethodName  = "/storage.StorageService/UpdateClinicWeeklySchedule"
-	StorageService_AddDoctorWeeklySchedule_FullMethodName     = "/storage.StorageService/AddDoctorWeeklySchedule"
-	StorageService_UpdateDoctorWeeklySchedule_FullMethodName  = "/storage.StorageService/UpdateDoctorWeeklySchedule"
-	StorageService_GetRolePermission_FullMethodName           = "/storage.StorageService/GetRolePermission"
-	StorageService_GetDoctorsBySpecID_FullMethodName          = "/storage.StorageService/GetDoctorsBySpecID"
-	StorageService_GetAppointmentsByDoctorID_FullMethodName   = "/storage.StorageService/GetAppointmentsByDoctorID"
-	StorageService_AddAppointment_FullMethodName              = "/storage.StorageService/AddAppointment"
-	StorageService_GetAppointmentsByUserID_FullMethodName     = "/storage.StorageService/GetAppointmentsByUserID"
-	StorageService_GetSpecsByDoctorID_FullMethodName          = "/storage.StorageService/GetSpecsByDoctorID"
-	StorageService_GetDoctorByID_FullMethodName               = "/storage.StorageService/GetDoctorByID"
-	StorageService_UpdateAppointment_FullMethodName           = "/storage.StorageService/UpdateAppointment"
-	StorageService_GetPatientByID_FullMethodName              = "/storage.StorageService/GetPatientByID"
-	StorageService_GetAppointmentByID_FullMethodName          = "/storage.StorageService/GetAppointmentByID"
-	StorageService_AddClinicDailyOverride_FullMethodName      = "/storage.StorageService/AddClinicDailyOverride"
-	StorageService_AddDoctorDailyOverride_FullMethodName      = "/storage.StorageService/AddDoctorDailyOverride"
-	StorageService_GetClinicOverride_FullMethodName           = "/storage.StorageService/GetClinicOverride"
-	StorageService_GetDoctorOverride_FullMethodName           = "/storage.StorageService/GetDoctorOverride"
-	StorageService_AddMaterial_FullMethodName                 = "/storage.StorageService/AddMaterial"
-	StorageService_AddService_FullMethodName                  = "/storage.StorageService/AddService"
-	StorageService_UpdateMaterial_FullMethodName              = "/storage.StorageService/UpdateMaterial"
-	StorageService_UpdateService_FullMethodName               = "/storage.StorageService/UpdateService"
-	StorageService_GetMaterials_FullMethodName                = "/storage.StorageService/GetMaterials"
-	StorageService_GetServices_FullMethodName                 = "/storage.StorageService/GetServices"
-	StorageService_GetServicesTypes_FullMethodName            = "/storage.StorageService/GetServicesTypes"
-	StorageService_GetServiceTypeById_FullMethodName          = "/storage.StorageService/GetServiceTypeById"
-	StorageService_DeleteMaterial_FullMethodName              = "/storage.StorageService/DeleteMaterial"
-	StorageService_DeleteService_FullMethodName               = "/storage.StorageService/DeleteService"
-	StorageService_GetDoctorOverrides_FullMethodName          = "/storage.StorageService/GetDoctorOverrides"
-	StorageService_GetPatientDiagnoses_FullMethodName         = "/storage.StorageService/GetPatientDiagnoses"
-	StorageService_GetPatientVisits_FullMethodName            = "/storage.StorageService/GetPatientVisits"
-	StorageService_GetPatientAllergiesChronics_FullMethodName = "/storage.StorageService/GetPatientAllergiesChronics"
-	StorageService_GetICDCodes_FullMethodName                 = "/storage.StorageService/GetICDCodes"
-	StorageService_AddPatientAllergiesChronics_FullMethodName = "/storage.StorageService/AddPatientAllergiesChronics"
-	StorageService_AddPatientVisit_FullMethodName             = "/storage.StorageService/AddPatientVisit"
-	StorageService_AddVisitMaterials_FullMethodName           = "/storage.StorageService/AddVisitMaterials"
-	StorageService_AddVisitServices_FullMethodName            = "/storage.StorageService/AddVisitServices"
-	StorageService_AddPatientDiagnoses_FullMethodName         = "/storage.StorageService/AddPatientDiagnoses"
-	StorageService_AddVisitPayment_FullMethodName             = "/storage.StorageService/AddVisitPayment"
-	StorageService_UpdateVisitPayment_FullMethodName          = "/storage.StorageService/UpdateVisitPayment"
-	StorageService_GetVisitByID_FullMethodName                = "/storage.StorageService/GetVisitByID"
-	StorageService_CalculateVisitTotal_FullMethodName         = "/storage.StorageService/CalculateVisitTotal"
-	StorageService_AddOrUpdateVisitPayment_FullMethodName     = "/storage.StorageService/AddOrUpdateVisitPayment"
-	StorageService_GetVisitsPayments_FullMethodName           = "/storage.StorageService/GetVisitsPayments"
-	StorageService_GetClinicOverrides_FullMethodName          = "/storage.StorageService/GetClinicOverrides"
-	StorageService_GetAppointments_FullMethodName             = "/storage.StorageService/GetAppointments"
-	StorageService_GetVisitMaterials_FullMethodName           = "/storage.StorageService/GetVisitMaterials"
-	StorageService_GetVisitServices_FullMethodName            = "/storage.StorageService/GetVisitServices"
-	StorageService_GetMaterialByID_FullMethodName             = "/storage.StorageService/GetMaterialByID"
-	StorageService_GetServiceByID_FullMethodName              = "/storage.StorageService/GetServiceByID"
+	StorageService_AddUser_FullMethodName                           = "/storage.StorageService/AddUser"
+	StorageService_AddDoctor_FullMethodName                         = "/storage.StorageService/AddDoctor"
+	StorageService_AddAdmin_FullMethodName                          = "/storage.StorageService/AddAdmin"
+	StorageService_AddPatient_FullMethodName                        = "/storage.StorageService/AddPatient"
+	StorageService_GetDoctors_FullMethodName                        = "/storage.StorageService/GetDoctors"
+	StorageService_GetAdmins_FullMethodName                         = "/storage.StorageService/GetAdmins"
+	StorageService_GetPatients_FullMethodName                       = "/storage.StorageService/GetPatients"
+	StorageService_GetDoctorSpecsByDoctorId_FullMethodName          = "/storage.StorageService/GetDoctorSpecsByDoctorId"
+	StorageService_UpdateDoctor_FullMethodName                      = "/storage.StorageService/UpdateDoctor"
+	StorageService_AddDoctorSpec_FullMethodName                     = "/storage.StorageService/AddDoctorSpec"
+	StorageService_DeleteDoctorSpec_FullMethodName                  = "/storage.StorageService/DeleteDoctorSpec"
+	StorageService_UpdateAdmin_FullMethodName                       = "/storage.StorageService/UpdateAdmin"
+	StorageService_UpdateAdminRole_FullMethodName                   = "/storage.StorageService/UpdateAdminRole"
+	StorageService_UpdatePatient_FullMethodName                     = "/storage.StorageService/UpdatePatient"
+	StorageService_DeleteUser_FullMethodName                        = "/storage.StorageService/DeleteUser"
+	StorageService_RetireUser_FullMethodName                        = "/storage.StorageService/RetireUser"
+	StorageService_RestoreUser_FullMethodName                       = "/storage.StorageService/RestoreUser"
+	StorageService_UpdateUserLogin_FullMethodName                   = "/storage.StorageService/UpdateUserLogin"
+	StorageService_GetAllSpecs_FullMethodName                       = "/storage.StorageService/GetAllSpecs"
+	StorageService_AddUserRole_FullMethodName                       = "/storage.StorageService/AddUserRole"
+	StorageService_GetUserByLogin_FullMethodName                    = "/storage.StorageService/GetUserByLogin"
+	StorageService_UpdateUserPassword_FullMethodName                = "/storage.StorageService/UpdateUserPassword"
+	StorageService_GetClinicWeeklySchedule_FullMethodName           = "/storage.StorageService/GetClinicWeeklySchedule"
+	StorageService_GetUserRole_FullMethodName                       = "/storage.StorageService/GetUserRole"
+	StorageService_GetDoctorWeeklySchedule_FullMethodName           = "/storage.StorageService/GetDoctorWeeklySchedule"
+	StorageService_UpdateClinicWeeklySchedule_FullMethodName        = "/storage.StorageService/UpdateClinicWeeklySchedule"
+	StorageService_AddDoctorWeeklySchedule_FullMethodName           = "/storage.StorageService/AddDoctorWeeklySchedule"
+	StorageService_UpdateDoctorWeeklySchedule_FullMethodName        = "/storage.StorageService/UpdateDoctorWeeklySchedule"
+	StorageService_GetRolePermission_FullMethodName                 = "/storage.StorageService/GetRolePermission"
+	StorageService_GetDoctorsBySpecID_FullMethodName                = "/storage.StorageService/GetDoctorsBySpecID"
+	StorageService_GetAppointmentsByDoctorID_FullMethodName         = "/storage.StorageService/GetAppointmentsByDoctorID"
+	StorageService_GetPatientByID_FullMethodName                    = "/storage.StorageService/GetPatientByID"
+	StorageService_AddAppointment_FullMethodName                    = "/storage.StorageService/AddAppointment"
+	StorageService_GetAppointmentsByUserID_FullMethodName           = "/storage.StorageService/GetAppointmentsByUserID"
+	StorageService_GetSpecsByDoctorID_FullMethodName                = "/storage.StorageService/GetSpecsByDoctorID"
+	StorageService_GetDoctorByID_FullMethodName                     = "/storage.StorageService/GetDoctorByID"
+	StorageService_UpdateAppointment_FullMethodName                 = "/storage.StorageService/UpdateAppointment"
+	StorageService_GetAppointmentByID_FullMethodName                = "/storage.StorageService/GetAppointmentByID"
+	StorageService_HoldAppointmentSlot_FullMethodName               = "/storage.StorageService/HoldAppointmentSlot"
+	StorageService_ReleaseAppointmentSlot_FullMethodName            = "/storage.StorageService/ReleaseAppointmentSlot"
+	StorageService_GetAppointmentSlotHolds_FullMethodName           = "/storage.StorageService/GetAppointmentSlotHolds"
+	StorageService_AddClinicDailyOverride_FullMethodName            = "/storage.StorageService/AddClinicDailyOverride"
+	StorageService_AddDoctorDailyOverride_FullMethodName            = "/storage.StorageService/AddDoctorDailyOverride"
+	StorageService_GetClinicOverride_FullMethodName                 = "/storage.StorageService/GetClinicOverride"
+	StorageService_GetDoctorOverride_FullMethodName                 = "/storage.StorageService/GetDoctorOverride"
+	StorageService_GetClinicSettings_FullMethodName                 = "/storage.StorageService/GetClinicSettings"
+	StorageService_UpdateClinicTimeZone_FullMethodName              = "/storage.StorageService/UpdateClinicTimeZone"
+	StorageService_AddWaitingListEntry_FullMethodName               = "/storage.StorageService/AddWaitingListEntry"
+	StorageService_GetWaitingListByPatientID_FullMethodName         = "/storage.StorageService/GetWaitingListByPatientID"
+	StorageService_CancelWaitingListEntry_FullMethodName            = "/storage.StorageService/CancelWaitingListEntry"
+	StorageService_AcceptWaitingListOffer_FullMethodName            = "/storage.StorageService/AcceptWaitingListOffer"
+	StorageService_DeclineWaitingListOffer_FullMethodName           = "/storage.StorageService/DeclineWaitingListOffer"
+	StorageService_GetScheduleRules_FullMethodName                  = "/storage.StorageService/GetScheduleRules"
+	StorageService_AddScheduleRule_FullMethodName                   = "/storage.StorageService/AddScheduleRule"
+	StorageService_ImportScheduleRules_FullMethodName               = "/storage.StorageService/ImportScheduleRules"
+	StorageService_DeleteScheduleRule_FullMethodName                = "/storage.StorageService/DeleteScheduleRule"
+	StorageService_ClaimNotifications_FullMethodName                = "/storage.StorageService/ClaimNotifications"
+	StorageService_CompleteNotification_FullMethodName              = "/storage.StorageService/CompleteNotification"
+	StorageService_GetPatientNotificationSettings_FullMethodName    = "/storage.StorageService/GetPatientNotificationSettings"
+	StorageService_UpdatePatientNotificationSettings_FullMethodName = "/storage.StorageService/UpdatePatientNotificationSettings"
+	StorageService_AddMaterial_FullMethodName                       = "/storage.StorageService/AddMaterial"
+	StorageService_AddService_FullMethodName                        = "/storage.StorageService/AddService"
+	StorageService_UpdateMaterial_FullMethodName                    = "/storage.StorageService/UpdateMaterial"
+	StorageService_UpdateService_FullMethodName                     = "/storage.StorageService/UpdateService"
+	StorageService_GetMaterials_FullMethodName                      = "/storage.StorageService/GetMaterials"
+	StorageService_GetServices_FullMethodName                       = "/storage.StorageService/GetServices"
+	StorageService_GetServicesTypes_FullMethodName                  = "/storage.StorageService/GetServicesTypes"
+	StorageService_GetServiceTypeById_FullMethodName                = "/storage.StorageService/GetServiceTypeById"
+	StorageService_DeleteMaterial_FullMethodName                    = "/storage.StorageService/DeleteMaterial"
+	StorageService_ReceiveMaterialBatch_FullMethodName              = "/storage.StorageService/ReceiveMaterialBatch"
+	StorageService_AdjustMaterialStock_FullMethodName               = "/storage.StorageService/AdjustMaterialStock"
+	StorageService_GetMaterialStock_FullMethodName                  = "/storage.StorageService/GetMaterialStock"
+	StorageService_GetStockReport_FullMethodName                    = "/storage.StorageService/GetStockReport"
+	StorageService_CreateTreatmentPlan_FullMethodName               = "/storage.StorageService/CreateTreatmentPlan"
+	StorageService_AddTreatmentPlanItems_FullMethodName             = "/storage.StorageService/AddTreatmentPlanItems"
+	StorageService_UpdateTreatmentPlanItemStatus_FullMethodName     = "/storage.StorageService/UpdateTreatmentPlanItemStatus"
+	StorageService_AcceptTreatmentPlan_FullMethodName               = "/storage.StorageService/AcceptTreatmentPlan"
+	StorageService_CancelTreatmentPlan_FullMethodName               = "/storage.StorageService/CancelTreatmentPlan"
+	StorageService_GetTreatmentPlan_FullMethodName                  = "/storage.StorageService/GetTreatmentPlan"
+	StorageService_GetTreatmentPlanByItemID_FullMethodName          = "/storage.StorageService/GetTreatmentPlanByItemID"
+	StorageService_GetPatientTreatmentPlans_FullMethodName          = "/storage.StorageService/GetPatientTreatmentPlans"
+	StorageService_RecordToothStates_FullMethodName                 = "/storage.StorageService/RecordToothStates"
+	StorageService_GetDentalChart_FullMethodName                    = "/storage.StorageService/GetDentalChart"
+	StorageService_GetToothHistory_FullMethodName                   = "/storage.StorageService/GetToothHistory"
+	StorageService_SaveAnamnesis_FullMethodName                     = "/storage.StorageService/SaveAnamnesis"
+	StorageService_GetAnamnesis_FullMethodName                      = "/storage.StorageService/GetAnamnesis"
+	StorageService_GetAnamnesisHistory_FullMethodName               = "/storage.StorageService/GetAnamnesisHistory"
+	StorageService_GetClinicalAlertRules_FullMethodName             = "/storage.StorageService/GetClinicalAlertRules"
+	StorageService_AddClinicalAlertRule_FullMethodName              = "/storage.StorageService/AddClinicalAlertRule"
+	StorageService_UpdateClinicalAlertRule_FullMethodName           = "/storage.StorageService/UpdateClinicalAlertRule"
+	StorageService_DeleteClinicalAlertRule_FullMethodName           = "/storage.StorageService/DeleteClinicalAlertRule"
+	StorageService_GetMedicalRecord_FullMethodName                  = "/storage.StorageService/GetMedicalRecord"
+	StorageService_GetDoctorPatientRelation_FullMethodName          = "/storage.StorageService/GetDoctorPatientRelation"
+	StorageService_GetPatientAccessGrants_FullMethodName            = "/storage.StorageService/GetPatientAccessGrants"
+	StorageService_AddPatientAccessGrant_FullMethodName             = "/storage.StorageService/AddPatientAccessGrant"
+	StorageService_RevokePatientAccessGrant_FullMethodName          = "/storage.StorageService/RevokePatientAccessGrant"
+	StorageService_DeleteService_FullMethodName                     = "/storage.StorageService/DeleteService"
+	StorageService_GetPriceHistory_FullMethodName                   = "/storage.StorageService/GetPriceHistory"
+	StorageService_AddPriceListEntry_FullMethodName                 = "/storage.StorageService/AddPriceListEntry"
+	StorageService_DeletePriceListEntry_FullMethodName              = "/storage.StorageService/DeletePriceListEntry"
+	StorageService_GetPriceOnDate_FullMethodName                    = "/storage.StorageService/GetPriceOnDate"
+	StorageService_GetDoctorOverrides_FullMethodName                = "/storage.StorageService/GetDoctorOverrides"
+	StorageService_GetPatientDiagnoses_FullMethodName               = "/storage.StorageService/GetPatientDiagnoses"
+	StorageService_GetPatientVisits_FullMethodName                  = "/storage.StorageService/GetPatientVisits"
+	StorageService_GetPatientAllergiesChronics_FullMethodName       = "/storage.StorageService/GetPatientAllergiesChronics"
+	StorageService_GetICDCodes_FullMethodName                       = "/storage.StorageService/GetICDCodes"
+	StorageService_AddPatientAllergiesChronics_FullMethodName       = "/storage.StorageService/AddPatientAllergiesChronics"
+	StorageService_AddPatientVisit_FullMethodName                   = "/storage.StorageService/AddPatientVisit"
+	StorageService_AddVisitMaterials_FullMethodName                 = "/storage.StorageService/AddVisitMaterials"
+	StorageService_AddVisitServices_FullMethodName                  = "/storage.StorageService/AddVisitServices"
+	StorageService_AddPatientDiagnoses_FullMethodName               = "/storage.StorageService/AddPatientDiagnoses"
+	StorageService_AddVisitPayment_FullMethodName                   = "/storage.StorageService/AddVisitPayment"
+	StorageService_UpdateVisitPayment_FullMethodName                = "/storage.StorageService/UpdateVisitPayment"
+	StorageService_GetVisitByID_FullMethodName                      = "/storage.StorageService/GetVisitByID"
+	StorageService_CalculateVisitTotal_FullMethodName               = "/storage.StorageService/CalculateVisitTotal"
+	StorageService_AddOrUpdateVisitPayment_FullMethodName           = "/storage.StorageService/AddOrUpdateVisitPayment"
+	StorageService_CompleteConsultation_FullMethodName              = "/storage.StorageService/CompleteConsultation"
+	StorageService_IssueInvoice_FullMethodName                      = "/storage.StorageService/IssueInvoice"
+	StorageService_GetInvoice_FullMethodName                        = "/storage.StorageService/GetInvoice"
+	StorageService_SetInvoiceDiscount_FullMethodName                = "/storage.StorageService/SetInvoiceDiscount"
+	StorageService_AddInvoicePayment_FullMethodName                 = "/storage.StorageService/AddInvoicePayment"
+	StorageService_RefundInvoicePayment_FullMethodName              = "/storage.StorageService/RefundInvoicePayment"
+	StorageService_GetPatientBalance_FullMethodName                 = "/storage.StorageService/GetPatientBalance"
+	StorageService_ReserveInvoiceDocument_FullMethodName            = "/storage.StorageService/ReserveInvoiceDocument"
+	StorageService_ReserveReceiptDocument_FullMethodName            = "/storage.StorageService/ReserveReceiptDocument"
+	StorageService_AttachBillingDocument_FullMethodName             = "/storage.StorageService/AttachBillingDocument"
+	StorageService_GetVisitsPayments_FullMethodName                 = "/storage.StorageService/GetVisitsPayments"
+	StorageService_GetClinicOverrides_FullMethodName                = "/storage.StorageService/GetClinicOverrides"
+	StorageService_GetAppointments_FullMethodName                   = "/storage.StorageService/GetAppointments"
+	StorageService_GetVisitMaterials_FullMethodName                 = "/storage.StorageService/GetVisitMaterials"
+	StorageService_GetVisitServices_FullMethodName                  = "/storage.StorageService/GetVisitServices"
+	StorageService_GetMaterialByID_FullMethodName                   = "/storage.StorageService/GetMaterialByID"
+	StorageService_GetServiceByID_FullMethodName                    = "/storage.StorageService/GetServiceByID"
+	StorageService_GetTotalPatients_FullMethodName                  = "/storage.StorageService/GetTotalPatients"
+	StorageService_GetTotalVisits_FullMethodName                    = "/storage.StorageService/GetTotalVisits"
+	StorageService_GetTopServices_FullMethodName                    = "/storage.StorageService/GetTopServices"
+	StorageService_GetDoctorAvgVisit_FullMethodName                 = "/storage.StorageService/GetDoctorAvgVisit"
+	StorageService_GetDoctorAvgCheck_FullMethodName                 = "/storage.StorageService/GetDoctorAvgCheck"
+	StorageService_GetDoctorUniquePatient_FullMethodName            = "/storage.StorageService/GetDoctorUniquePatient"
+	StorageService_GetAgeGroupStat_FullMethodName                   = "/storage.StorageService/GetAgeGroupStat"
+	StorageService_GetNewPatientsThisMonth_FullMethodName           = "/storage.StorageService/GetNewPatientsThisMonth"
+	StorageService_GetAvgVisitsPerPatient_FullMethodName            = "/storage.StorageService/GetAvgVisitsPerPatient"
+	StorageService_GetTotalIncome_FullMethodName                    = "/storage.StorageService/GetTotalIncome"
+	StorageService_GetMonthlyIncome_FullMethodName                  = "/storage.StorageService/GetMonthlyIncome"
+	StorageService_GetClinicAverageCheck_FullMethodName             = "/storage.StorageService/GetClinicAverageCheck"
+	StorageService_GetDiagnoseByVisitID_FullMethodName              = "/storage.StorageService/GetDiagnoseByVisitID"
+	StorageService_StartDocumentUpload_FullMethodName               = "/storage.StorageService/StartDocumentUpload"
+	StorageService_UploadDocument_FullMethodName                    = "/storage.StorageService/UploadDocument"
+	StorageService_GetDocumentUploadStatus_FullMethodName           = "/storage.StorageService/GetDocumentUploadStatus"
+	StorageService_GetDocumentMetadata_FullMethodName               = "/storage.StorageService/GetDocumentMetadata"
+	StorageService_DownloadDocument_FullMethodName                  = "/storage.StorageService/DownloadDocument"
+	StorageService_GetDocumentsByPatientID_FullMethodName           = "/storage.StorageService/GetDocumentsByPatientID"
+	StorageService_GetAdminByID_FullMethodName                      = "/storage.StorageService/GetAdminByID"
+	StorageService_AddAuditEntry_FullMethodName                     = "/storage.StorageService/AddAuditEntry"
+	StorageService_GetAuditLog_FullMethodName                       = "/storage.StorageService/GetAuditLog"
+	StorageService_VerifyAuditLog_FullMethodName                    = "/storage.StorageService/VerifyAuditLog"
 )
 
 // StorageServiceClient is the client API for StorageService service.
@@ -102,9 +186,9 @@ type StorageServiceClient interface {
 	AddDoctor(ctx context.Context, in *AddDoctorRequest, opts ...grpc.CallOption) (*AddDoctorResponse, error)
 	AddAdmin(ctx context.Context, in *AddAdminRequest, opts ...grpc.CallOption) (*AddAdminResponse, error)
 	AddPatient(ctx context.Context, in *AddPatientRequest, opts ...grpc.CallOption) (*AddPatientResponse, error)
-	GetDoctors(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error)
-	GetAdmins(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error)
-	GetPatients(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error)
+	GetDoctors(ctx context.Context, in *GetDoctorsRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error)
+	GetAdmins(ctx context.Context, in *GetAdminsRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error)
+	GetPatients(ctx context.Context, in *GetPatientsRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error)
 	GetDoctorSpecsByDoctorId(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetDoctorSpecsByDoctorIdResponse, error)
 	UpdateDoctor(ctx context.Context, in *UpdateDoctorRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	AddDoctorSpec(ctx context.Context, in *AddDoctorSpecRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
@@ -113,6 +197,8 @@ type StorageServiceClient interface {
 	UpdateAdminRole(ctx context.Context, in *UpdateAdminRoleRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	UpdatePatient(ctx context.Context, in *UpdatePatientRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	DeleteUser(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	RetireUser(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error)
+	RestoreUser(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error)
 	UpdateUserLogin(ctx context.Context, in *UpdateUserLoginRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	GetAllSpecs(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAllSpecsResponse, error)
 	AddUserRole(ctx context.Context, in *AddUserRoleRequest, opts ...grpc.CallOption) (*AddUserRoleResponse, error)
@@ -127,17 +213,38 @@ type StorageServiceClient interface {
 	GetRolePermission(ctx context.Context, in *GetRolePermissionRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	GetDoctorsBySpecID(ctx context.Context, in *GetDoctorBySpecIDRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error)
 	GetAppointmentsByDoctorID(ctx context.Context, in *GetAppointmentsByDoctorIDRequest, opts ...grpc.CallOption) (*GetAppointmentsByDoctorIDResponse, error)
+	GetPatientByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetPatientByIDResponse, error)
 	AddAppointment(ctx context.Context, in *AddAppointmentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	GetAppointmentsByUserID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetAppointmentsByUserIDResponse, error)
 	GetSpecsByDoctorID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetSpecsByDoctorIDResponse, error)
 	GetDoctorByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetDoctorByIDResponse, error)
 	UpdateAppointment(ctx context.Context, in *UpdateAppointmentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
-	GetPatientByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetPatientByIDResponse, error)
 	GetAppointmentByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetAppointmentByIDResponse, error)
+	HoldAppointmentSlot(ctx context.Context, in *HoldAppointmentSlotRequest, opts ...grpc.CallOption) (*HoldAppointmentSlotResponse, error)
+	ReleaseAppointmentSlot(ctx context.Context, in *ReleaseAppointmentSlotRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetAppointmentSlotHolds(ctx context.Context, in *GetAppointmentsByDoctorIDRequest, opts ...grpc.CallOption) (*GetAppointmentSlotHoldsResponse, error)
 	AddClinicDailyOverride(ctx context.Context, in *AddClinicDailyOverrideRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	AddDoctorDailyOverride(ctx context.Context, in *AddDoctorDailyOverrideRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	GetClinicOverride(ctx context.Context, in *GetClinicOverrideRequest, opts ...grpc.CallOption) (*GetClinicOverrideResponse, error)
 	GetDoctorOverride(ctx context.Context, in *GetDoctorOverrideRequest, opts ...grpc.CallOption) (*GetDoctorOverrideResponse, error)
+	GetClinicSettings(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ClinicSettings, error)
+	UpdateClinicTimeZone(ctx context.Context, in *UpdateClinicTimeZoneRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	// лист ожидания
+	AddWaitingListEntry(ctx context.Context, in *AddWaitingListEntryRequest, opts ...grpc.CallOption) (*AddWaitingListEntryResponse, error)
+	GetWaitingListByPatientID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetWaitingListResponse, error)
+	CancelWaitingListEntry(ctx context.Context, in *CancelWaitingListEntryRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	AcceptWaitingListOffer(ctx context.Context, in *AcceptWaitingListOfferRequest, opts ...grpc.CallOption) (*AcceptWaitingListOfferResponse, error)
+	DeclineWaitingListOffer(ctx context.Context, in *DeclineWaitingListOfferRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	// правила расписания: диапазоны и повторяющиеся исключения
+	GetScheduleRules(ctx context.Context, in *GetScheduleRulesRequest, opts ...grpc.CallOption) (*GetScheduleRulesResponse, error)
+	AddScheduleRule(ctx context.Context, in *AddScheduleRuleRequest, opts ...grpc.CallOption) (*AddScheduleRuleResponse, error)
+	ImportScheduleRules(ctx context.Context, in *ImportScheduleRulesRequest, opts ...grpc.CallOption) (*ImportScheduleRulesResponse, error)
+	DeleteScheduleRule(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	// уведомления
+	ClaimNotifications(ctx context.Context, in *ClaimNotificationsRequest, opts ...grpc.CallOption) (*ClaimNotificationsResponse, error)
+	CompleteNotification(ctx context.Context, in *CompleteNotificationRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetPatientNotificationSettings(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*PatientNotificationSettings, error)
+	UpdatePatientNotificationSettings(ctx context.Context, in *PatientNotificationSettings, opts ...grpc.CallOption) (*DefaultResponse, error)
 	// управление услугами и материалами
 	AddMaterial(ctx context.Context, in *AddMaterialRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	AddService(ctx context.Context, in *AddServiceRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
@@ -148,13 +255,44 @@ type StorageServiceClient interface {
 	GetServicesTypes(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetServicesTypesResponse, error)
 	GetServiceTypeById(ctx context.Context, in *GetServiceTypeByIdRequest, opts ...grpc.CallOption) (*GetServiceTypeByIdResponse, error)
 	DeleteMaterial(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	ReceiveMaterialBatch(ctx context.Context, in *ReceiveMaterialBatchRequest, opts ...grpc.CallOption) (*MaterialBatch, error)
+	AdjustMaterialStock(ctx context.Context, in *AdjustMaterialStockRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetMaterialStock(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*MaterialStockResponse, error)
+	GetStockReport(ctx context.Context, in *StockReportRequest, opts ...grpc.CallOption) (*StockReportResponse, error)
+	CreateTreatmentPlan(ctx context.Context, in *CreateTreatmentPlanRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error)
+	AddTreatmentPlanItems(ctx context.Context, in *AddTreatmentPlanItemsRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error)
+	UpdateTreatmentPlanItemStatus(ctx context.Context, in *UpdateTreatmentPlanItemStatusRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error)
+	AcceptTreatmentPlan(ctx context.Context, in *AcceptTreatmentPlanRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error)
+	CancelTreatmentPlan(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error)
+	GetTreatmentPlan(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error)
+	GetTreatmentPlanByItemID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error)
+	GetPatientTreatmentPlans(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*TreatmentPlansResponse, error)
+	RecordToothStates(ctx context.Context, in *RecordToothStatesRequest, opts ...grpc.CallOption) (*DentalChartResponse, error)
+	GetDentalChart(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*DentalChartResponse, error)
+	GetToothHistory(ctx context.Context, in *GetToothHistoryRequest, opts ...grpc.CallOption) (*DentalChartResponse, error)
+	SaveAnamnesis(ctx context.Context, in *SaveAnamnesisRequest, opts ...grpc.CallOption) (*AnamnesisResponse, error)
+	GetAnamnesis(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*AnamnesisResponse, error)
+	GetAnamnesisHistory(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*AnamnesisHistoryResponse, error)
+	GetClinicalAlertRules(ctx context.Context, in *GetClinicalAlertRulesRequest, opts ...grpc.CallOption) (*GetClinicalAlertRulesResponse, error)
+	AddClinicalAlertRule(ctx context.Context, in *ClinicalAlertRule, opts ...grpc.CallOption) (*AddClinicalAlertRuleResponse, error)
+	UpdateClinicalAlertRule(ctx context.Context, in *ClinicalAlertRule, opts ...grpc.CallOption) (*DefaultResponse, error)
+	DeleteClinicalAlertRule(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetMedicalRecord(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*MedicalRecord, error)
+	GetDoctorPatientRelation(ctx context.Context, in *DoctorPatientRelationRequest, opts ...grpc.CallOption) (*DoctorPatientRelation, error)
+	GetPatientAccessGrants(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientAccessGrantsResponse, error)
+	AddPatientAccessGrant(ctx context.Context, in *PatientAccessGrant, opts ...grpc.CallOption) (*AddPatientAccessGrantResponse, error)
+	RevokePatientAccessGrant(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	DeleteService(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	AddPriceListEntry(ctx context.Context, in *AddPriceListEntryRequest, opts ...grpc.CallOption) (*AddPriceListEntryResponse, error)
+	DeletePriceListEntry(ctx context.Context, in *DeletePriceListEntryRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetPriceOnDate(ctx context.Context, in *GetPriceOnDateRequest, opts ...grpc.CallOption) (*GetPriceOnDateResponse, error)
 	GetDoctorOverrides(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetDoctorOverridesResponse, error)
 	// проведение приема
 	GetPatientDiagnoses(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientDiagnosesResponse, error)
 	GetPatientVisits(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientVisitsResponse, error)
 	GetPatientAllergiesChronics(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientAllergiesChronicsResponse, error)
-	GetICDCodes(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error)
+	GetICDCodes(ctx context.Context, in *GetICDCodesRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error)
 	AddPatientAllergiesChronics(ctx context.Context, in *AddPatientAllergiesChronicsRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	AddPatientVisit(ctx context.Context, in *AddPatientVisitRequest, opts ...grpc.CallOption) (*AddVisitResponse, error)
 	AddVisitMaterials(ctx context.Context, in *AddVisitMaterialsRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
@@ -165,13 +303,48 @@ type StorageServiceClient interface {
 	GetVisitByID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetVisitByIDResponse, error)
 	CalculateVisitTotal(ctx context.Context, in *CalculateVisitTotalRequest, opts ...grpc.CallOption) (*CalculateVisitTotalResponse, error)
 	AddOrUpdateVisitPayment(ctx context.Context, in *AddOrUpdateVisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
-	GetVisitsPayments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetVisitsPaymentsResponse, error)
+	CompleteConsultation(ctx context.Context, in *CompleteConsultationRequest, opts ...grpc.CallOption) (*CompleteConsultationResponse, error)
+	IssueInvoice(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	GetInvoice(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	SetInvoiceDiscount(ctx context.Context, in *SetInvoiceDiscountRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	AddInvoicePayment(ctx context.Context, in *AddInvoicePaymentRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	RefundInvoicePayment(ctx context.Context, in *RefundInvoicePaymentRequest, opts ...grpc.CallOption) (*InvoiceResponse, error)
+	GetPatientBalance(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*PatientBalanceResponse, error)
+	ReserveInvoiceDocument(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*BillingDocument, error)
+	ReserveReceiptDocument(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*BillingDocument, error)
+	AttachBillingDocument(ctx context.Context, in *AttachBillingDocumentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetVisitsPayments(ctx context.Context, in *GetVisitsPaymentsRequest, opts ...grpc.CallOption) (*GetVisitsPaymentsResponse, error)
 	GetClinicOverrides(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetClinicOverridesResponse, error)
-	GetAppointments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAppointmentsResponse, error)
+	GetAppointments(ctx context.Context, in *GetAppointmentsRequest, opts ...grpc.CallOption) (*GetAppointmentsResponse, error)
 	GetVisitMaterials(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetVisitMaterialsAndServicesResponse, error)
 	GetVisitServices(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetVisitMaterialsAndServicesResponse, error)
 	GetMaterialByID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetMaterialServiceByIDResponse, error)
 	GetServiceByID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetMaterialServiceByIDResponse, error)
+	// статистика
+	GetTotalPatients(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*IntResponse, error)
+	GetTotalVisits(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*IntResponse, error)
+	GetTopServices(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ServiceStatsResponse, error)
+	GetDoctorAvgVisit(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DoctorAvgVisitResponse, error)
+	GetDoctorAvgCheck(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DoctorAvgCheckResponse, error)
+	GetDoctorUniquePatient(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DoctorUniquePatientResponse, error)
+	GetAgeGroupStat(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*AgeGroupStatResponse, error)
+	GetNewPatientsThisMonth(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*IntResponse, error)
+	GetAvgVisitsPerPatient(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*FloatResponse, error)
+	GetTotalIncome(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*FloatResponse, error)
+	GetMonthlyIncome(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*FloatResponse, error)
+	GetClinicAverageCheck(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*FloatResponse, error)
+	GetDiagnoseByVisitID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetDiagnoseByVisitIDResponse, error)
+	StartDocumentUpload(ctx context.Context, in *StartDocumentUploadRequest, opts ...grpc.CallOption) (*StartDocumentUploadResponse, error)
+	UploadDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadDocumentChunk, DocumentUploadStatus], error)
+	GetDocumentUploadStatus(ctx context.Context, in *DocumentUploadStatusRequest, opts ...grpc.CallOption) (*DocumentUploadStatus, error)
+	GetDocumentMetadata(ctx context.Context, in *GetDocumentMetadataRequest, opts ...grpc.CallOption) (*GetDocumentMetadataResponse, error)
+	DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDocumentChunk], error)
+	GetDocumentsByPatientID(ctx context.Context, in *GetDocumentsRequest, opts ...grpc.CallOption) (*GetDocumentsResponse, error)
+	GetAdminByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetAdminByIDResponse, error)
+	// журнал аудита
+	AddAuditEntry(ctx context.Context, in *AuditEntry, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	VerifyAuditLog(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
 }
 
 type storageServiceClient struct {
@@ -222,7 +395,7 @@ func (c *storageServiceClient) AddPatient(ctx context.Context, in *AddPatientReq
 	return out, nil
 }
 
-func (c *storageServiceClient) GetDoctors(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error) {
+func (c *storageServiceClient) GetDoctors(ctx context.Context, in *GetDoctorsRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDoctorsResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetDoctors_FullMethodName, in, out, cOpts...)
@@ -232,7 +405,7 @@ func (c *storageServiceClient) GetDoctors(ctx context.Context, in *EmptyRequest,
 	return out, nil
 }
 
-func (c *storageServiceClient) GetAdmins(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error) {
+func (c *storageServiceClient) GetAdmins(ctx context.Context, in *GetAdminsRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAdminsResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetAdmins_FullMethodName, in, out, cOpts...)
@@ -242,7 +415,7 @@ func (c *storageServiceClient) GetAdmins(ctx context.Context, in *EmptyRequest,
 	return out, nil
 }
 
-func (c *storageServiceClient) GetPatients(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error) {
+func (c *storageServiceClient) GetPatients(ctx context.Context, in *GetPatientsRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPatientsResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetPatients_FullMethodName, in, out, cOpts...)
@@ -332,6 +505,26 @@ func (c *storageServiceClient) DeleteUser(ctx context.Context, in *DeleteRequest
 	return out, nil
 }
 
+func (c *storageServiceClient) RetireUser(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeUserStatusResponse)
+	err := c.cc.Invoke(ctx, StorageService_RetireUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) RestoreUser(ctx context.Context, in *ChangeUserStatusRequest, opts ...grpc.CallOption) (*ChangeUserStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeUserStatusResponse)
+	err := c.cc.Invoke(ctx, StorageService_RestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageServiceClient) UpdateUserLogin(ctx context.Context, in *UpdateUserLoginRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DefaultResponse)
@@ -472,6 +665,16 @@ func (c *storageServiceClient) GetAppointmentsByDoctorID(ctx context.Context, in
 	return out, nil
 }
 
+func (c *storageServiceClient) GetPatientByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetPatientByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPatientByIDResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetPatientByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageServiceClient) AddAppointment(ctx context.Context, in *AddAppointmentRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DefaultResponse)
@@ -522,20 +725,40 @@ func (c *storageServiceClient) UpdateAppointment(ctx context.Context, in *Update
 	return out, nil
 }
 
-func (c *storageServiceClient) GetPatientByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetPatientByIDResponse, error) {
+func (c *storageServiceClient) GetAppointmentByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetAppointmentByIDResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPatientByIDResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetPatientByID_FullMethodName, in, out, cOpts...)
+	out := new(GetAppointmentByIDResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetAppointmentByID_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetAppointmentByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetAppointmentByIDResponse, error) {
+func (c *storageServiceClient) HoldAppointmentSlot(ctx context.Context, in *HoldAppointmentSlotRequest, opts ...grpc.CallOption) (*HoldAppointmentSlotResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAppointmentByIDResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetAppointmentByID_FullMethodName, in, out, cOpts...)
+	out := new(HoldAppointmentSlotResponse)
+	err := c.cc.Invoke(ctx, StorageService_HoldAppointmentSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) ReleaseAppointmentSlot(ctx context.Context, in *ReleaseAppointmentSlotRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_ReleaseAppointmentSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetAppointmentSlotHolds(ctx context.Context, in *GetAppointmentsByDoctorIDRequest, opts ...grpc.CallOption) (*GetAppointmentSlotHoldsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppointmentSlotHoldsResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetAppointmentSlotHolds_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -582,6 +805,156 @@ func (c *storageServiceClient) GetDoctorOverride(ctx context.Context, in *GetDoc
 	return out, nil
 }
 
+func (c *storageServiceClient) GetClinicSettings(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ClinicSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClinicSettings)
+	err := c.cc.Invoke(ctx, StorageService_GetClinicSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) UpdateClinicTimeZone(ctx context.Context, in *UpdateClinicTimeZoneRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_UpdateClinicTimeZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) AddWaitingListEntry(ctx context.Context, in *AddWaitingListEntryRequest, opts ...grpc.CallOption) (*AddWaitingListEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWaitingListEntryResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddWaitingListEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetWaitingListByPatientID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetWaitingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWaitingListResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetWaitingListByPatientID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) CancelWaitingListEntry(ctx context.Context, in *CancelWaitingListEntryRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_CancelWaitingListEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) AcceptWaitingListOffer(ctx context.Context, in *AcceptWaitingListOfferRequest, opts ...grpc.CallOption) (*AcceptWaitingListOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptWaitingListOfferResponse)
+	err := c.cc.Invoke(ctx, StorageService_AcceptWaitingListOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) DeclineWaitingListOffer(ctx context.Context, in *DeclineWaitingListOfferRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_DeclineWaitingListOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetScheduleRules(ctx context.Context, in *GetScheduleRulesRequest, opts ...grpc.CallOption) (*GetScheduleRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScheduleRulesResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetScheduleRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) AddScheduleRule(ctx context.Context, in *AddScheduleRuleRequest, opts ...grpc.CallOption) (*AddScheduleRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddScheduleRuleResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddScheduleRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) ImportScheduleRules(ctx context.Context, in *ImportScheduleRulesRequest, opts ...grpc.CallOption) (*ImportScheduleRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportScheduleRulesResponse)
+	err := c.cc.Invoke(ctx, StorageService_ImportScheduleRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) DeleteScheduleRule(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_DeleteScheduleRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) ClaimNotifications(ctx context.Context, in *ClaimNotificationsRequest, opts ...grpc.CallOption) (*ClaimNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClaimNotificationsResponse)
+	err := c.cc.Invoke(ctx, StorageService_ClaimNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) CompleteNotification(ctx context.Context, in *CompleteNotificationRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_CompleteNotification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) GetPatientNotificationSettings(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*PatientNotificationSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatientNotificationSettings)
+	err := c.cc.Invoke(ctx, StorageService_GetPatientNotificationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) UpdatePatientNotificationSettings(ctx context.Context, in *PatientNotificationSettings, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_UpdatePatientNotificationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *storageServiceClient) AddMaterial(ctx context.Context, in *AddMaterialRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DefaultResponse)
@@ -672,1854 +1045,4341 @@ func (c *storageServiceClient) DeleteMaterial(ctx context.Context, in *DeleteReq
 	return out, nil
 }
 
-func (c *storageServiceClient) DeleteService(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *storageServiceClient) ReceiveMaterialBatch(ctx context.Context, in *ReceiveMaterialBatchRequest, opts ...grpc.CallOption) (*MaterialBatch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaterialBatch)
+	err := c.cc.Invoke(ctx, StorageService_ReceiveMaterialBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storageServiceClient) AdjustMaterialStock(ctx context.Context, in *AdjustMaterialStockRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, StorageService_DeleteService_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, StorageService_AdjustMaterialStock_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetDoctorOverrides(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetDoctorOverridesResponse, error) {
+func (c *storageServiceClient) GetMaterialStock(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*MaterialStockResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetDoctorOverridesResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetDoctorOverrides_FullMethodName, in, out, cOpts...)
+	out := new(MaterialStockResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetMaterialStock_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetPatientDiagnoses(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientDiagnosesResponse, error) {
+func (c *storageServiceClient) GetStockReport(ctx context.Context, in *StockReportRequest, opts ...grpc.CallOption) (*StockReportResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPatientDiagnosesResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetPatientDiagnoses_FullMethodName, in, out, cOpts...)
+	out := new(StockReportResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetStockReport_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetPatientVisits(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientVisitsResponse, error) {
+func (c *storageServiceClient) CreateTreatmentPlan(ctx context.Context, in *CreateTreatmentPlanRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPatientVisitsResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetPatientVisits_FullMethodName, in, out, cOpts...)
+	out := new(TreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, StorageService_CreateTreatmentPlan_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetPatientAllergiesChronics(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientAllergiesChronicsResponse, error) {
+func (c *storageServiceClient) AddTreatmentPlanItems(ctx context.Context, in *AddTreatmentPlanItemsRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetPatientAllergiesChronicsResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetPatientAllergiesChronics_FullMethodName, in, out, cOpts...)
+	out := new(TreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddTreatmentPlanItems_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetICDCodes(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error) {
+func (c *storageServiceClient) UpdateTreatmentPlanItemStatus(ctx context.Context, in *UpdateTreatmentPlanItemStatusRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetICDCodesResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetICDCodes_FullMethodName, in, out, cOpts...)
+	out := new(TreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, StorageService_UpdateTreatmentPlanItemStatus_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) AddPatientAllergiesChronics(ctx context.Context, in *AddPatientAllergiesChronicsRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *storageServiceClient) AcceptTreatmentPlan(ctx context.Context, in *AcceptTreatmentPlanRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, StorageService_AddPatientAllergiesChronics_FullMethodName, in, out, cOpts...)
+	out := new(TreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, StorageService_AcceptTreatmentPlan_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) AddPatientVisit(ctx context.Context, in *AddPatientVisitRequest, opts ...grpc.CallOption) (*AddVisitResponse, error) {
+func (c *storageServiceClient) CancelTreatmentPlan(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AddVisitResponse)
-	err := c.cc.Invoke(ctx, StorageService_AddPatientVisit_FullMethodName, in, out, cOpts...)
+	out := new(TreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, StorageService_CancelTreatmentPlan_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) AddVisitMaterials(ctx context.Context, in *AddVisitMaterialsRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *storageServiceClient) GetTreatmentPlan(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, StorageService_AddVisitMaterials_FullMethodName, in, out, cOpts...)
+	out := new(TreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetTreatmentPlan_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) AddVisitServices(ctx context.Context, in *AddVisitServicesRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *storageServiceClient) GetTreatmentPlanByItemID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, StorageService_AddVisitServices_FullMethodName, in, out, cOpts...)
+	out := new(TreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetTreatmentPlanByItemID_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) AddPatientDiagnoses(ctx context.Context, in *AddPatientDiagnosesRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *storageServiceClient) GetPatientTreatmentPlans(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*TreatmentPlansResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, StorageService_AddPatientDiagnoses_FullMethodName, in, out, cOpts...)
+	out := new(TreatmentPlansResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetPatientTreatmentPlans_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) AddVisitPayment(ctx context.Context, in *VisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *storageServiceClient) RecordToothStates(ctx context.Context, in *RecordToothStatesRequest, opts ...grpc.CallOption) (*DentalChartResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, StorageService_AddVisitPayment_FullMethodName, in, out, cOpts...)
+	out := new(DentalChartResponse)
+	err := c.cc.Invoke(ctx, StorageService_RecordToothStates_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) UpdateVisitPayment(ctx context.Context, in *VisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *storageServiceClient) GetDentalChart(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*DentalChartResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, StorageService_UpdateVisitPayment_FullMethodName, in, out, cOpts...)
+	out := new(DentalChartResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetDentalChart_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetVisitByID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetVisitByIDResponse, error) {
+func (c *storageServiceClient) GetToothHistory(ctx context.Context, in *GetToothHistoryRequest, opts ...grpc.CallOption) (*DentalChartResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetVisitByIDResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetVisitByID_FullMethodName, in, out, cOpts...)
+	out := new(DentalChartResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetToothHistory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) CalculateVisitTotal(ctx context.Context, in *CalculateVisitTotalRequest, opts ...grpc.CallOption) (*CalculateVisitTotalResponse, error) {
+func (c *storageServiceClient) SaveAnamnesis(ctx context.Context, in *SaveAnamnesisRequest, opts ...grpc.CallOption) (*AnamnesisResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CalculateVisitTotalResponse)
-	err := c.cc.Invoke(ctx, StorageService_CalculateVisitTotal_FullMethodName, in, out, cOpts...)
+	out := new(AnamnesisResponse)
+	err := c.cc.Invoke(ctx, StorageService_SaveAnamnesis_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) AddOrUpdateVisitPayment(ctx context.Context, in *AddOrUpdateVisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *storageServiceClient) GetAnamnesis(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*AnamnesisResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DefaultResponse)
-	err := c.cc.Invoke(ctx, StorageService_AddOrUpdateVisitPayment_FullMethodName, in, out, cOpts...)
+	out := new(AnamnesisResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetAnamnesis_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetVisitsPayments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetVisitsPaymentsResponse, error) {
+func (c *storageServiceClient) GetAnamnesisHistory(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*AnamnesisHistoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetVisitsPaymentsResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetVisitsPayments_FullMethodName, in, out, cOpts...)
+	out := new(AnamnesisHistoryResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetAnamnesisHistory_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetClinicOverrides(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetClinicOverridesResponse, error) {
+func (c *storageServiceClient) GetClinicalAlertRules(ctx context.Context, in *GetClinicalAlertRulesRequest, opts ...grpc.CallOption) (*GetClinicalAlertRulesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetClinicOverridesResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetClinicOverrides_FullMethodName, in, out, cOpts...)
+	out := new(GetClinicalAlertRulesResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetClinicalAlertRules_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetAppointments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAppointmentsResponse, error) {
+func (c *storageServiceClient) AddClinicalAlertRule(ctx context.Context, in *ClinicalAlertRule, opts ...grpc.CallOption) (*AddClinicalAlertRuleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAppointmentsResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetAppointments_FullMethodName, in, out, cOpts...)
+	out := new(AddClinicalAlertRuleResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddClinicalAlertRule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetVisitMaterials(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetVisitMaterialsAndServicesResponse, error) {
+func (c *storageServiceClient) UpdateClinicalAlertRule(ctx context.Context, in *ClinicalAlertRule, opts ...grpc.CallOption) (*DefaultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetVisitMaterialsAndServicesResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetVisitMaterials_FullMethodName, in, out, cOpts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_UpdateClinicalAlertRule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetVisitServices(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetVisitMaterialsAndServicesResponse, error) {
+func (c *storageServiceClient) DeleteClinicalAlertRule(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetVisitMaterialsAndServicesResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetVisitServices_FullMethodName, in, out, cOpts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_DeleteClinicalAlertRule_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetMaterialByID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetMaterialServiceByIDResponse, error) {
+func (c *storageServiceClient) GetMedicalRecord(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*MedicalRecord, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetMaterialServiceByIDResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetMaterialByID_FullMethodName, in, out, cOpts...)
+	out := new(MedicalRecord)
+	err := c.cc.Invoke(ctx, StorageService_GetMedicalRecord_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *storageServiceClient) GetServiceByID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetMaterialServiceByIDResponse, error) {
+func (c *storageServiceClient) GetDoctorPatientRelation(ctx context.Context, in *DoctorPatientRelationRequest, opts ...grpc.CallOption) (*DoctorPatientRelation, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetMaterialServiceByIDResponse)
-	err := c.cc.Invoke(ctx, StorageService_GetServiceByID_FullMethodName, in, out, cOpts...)
+	out := new(DoctorPatientRelation)
+	err := c.cc.Invoke(ctx, StorageService_GetDoctorPatientRelation_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// StorageServiceServer is the server API for StorageService service.
-// All implementations must embed UnimplementedStorageServiceServer
-// for forward compatibility.
-type StorageServiceServer interface {
-	// управление аккаунтами
-	AddUser(context.Context, *AddUserRequest) (*AddUserResponse, error)
-	AddDoctor(context.Context, *AddDoctorRequest) (*AddDoctorResponse, error)
-	AddAdmin(context.Context, *AddAdminRequest) (*AddAdminResponse, error)
-	AddPatient(context.Context, *AddPatientRequest) (*AddPatientResponse, error)
-	GetDoctors(context.Context, *EmptyRequest) (*GetDoctorsResponse, error)
-	GetAdmins(context.Context, *EmptyRequest) (*GetAdminsResponse, error)
-	GetPatients(context.Context, *EmptyRequest) (*GetPatientsResponse, error)
-	GetDoctorSpecsByDoctorId(context.Context, *GetByIdRequest) (*GetDoctorSpecsByDoctorIdResponse, error)
-	UpdateDoctor(context.Context, *UpdateDoctorRequest) (*DefaultResponse, error)
-	AddDoctorSpec(context.Context, *AddDoctorSpecRequest) (*DefaultResponse, error)
-	DeleteDoctorSpec(context.Context, *DeleteDoctorSpecRequest) (*DefaultResponse, error)
-	UpdateAdmin(context.Context, *UpdateAdminRequest) (*DefaultResponse, error)
-	UpdateAdminRole(context.Context, *UpdateAdminRoleRequest) (*DefaultResponse, error)
-	UpdatePatient(context.Context, *UpdatePatientRequest) (*DefaultResponse, error)
-	DeleteUser(context.Context, *DeleteRequest) (*DefaultResponse, error)
-	UpdateUserLogin(context.Context, *UpdateUserLoginRequest) (*DefaultResponse, error)
-	GetAllSpecs(context.Context, *EmptyRequest) (*GetAllSpecsResponse, error)
-	AddUserRole(context.Context, *AddUserRoleRequest) (*AddUserRoleResponse, error)
-	GetUserByLogin(context.Context, *GetUserByLoginRequest) (*GetUserByLoginResponse, error)
-	UpdateUserPassword(context.Context, *UpdateUserPasswordRequest) (*DefaultResponse, error)
-	GetClinicWeeklySchedule(context.Context, *EmptyRequest) (*GetClinicWeeklyScheduleResponse, error)
-	GetUserRole(context.Context, *GetUserRoleRequest) (*GetUserRoleResponse, error)
-	GetDoctorWeeklySchedule(context.Context, *GetScheduleByDoctorIdRequest) (*GetScheduleByDoctorIdResponse, error)
-	UpdateClinicWeeklySchedule(context.Context, *UpdateClinicWeeklyScheduleRequest) (*DefaultResponse, error)
-	AddDoctorWeeklySchedule(context.Context, *AddDoctorWeeklyScheduleRequest) (*DefaultResponse, error)
-	UpdateDoctorWeeklySchedule(context.Context, *UpdateDoctorWeeklyScheduleRequest) (*DefaultResponse, error)
-	GetRolePermission(context.Context, *GetRolePermissionRequest) (*DefaultResponse, error)
-	GetDoctorsBySpecID(context.Context, *GetDoctorBySpecIDRequest) (*GetDoctorsResponse, error)
-	GetAppointmentsByDoctorID(context.Context, *GetAppointmentsByDoctorIDRequest) (*GetAppointmentsByDoctorIDResponse, error)
-	AddAppointment(context.Context, *AddAppointmentRequest) (*DefaultResponse, error)
-	GetAppointmentsByUserID(context.Context, *GetByIDRequest) (*GetAppointmentsByUserIDResponse, error)
-	GetSpecsByDoctorID(context.Context, *GetByIDRequest) (*GetSpecsByDoctorIDResponse, error)
-	GetDoctorByID(context.Context, *GetByIDRequest) (*GetDoctorByIDResponse, error)
-	UpdateAppointment(context.Context, *UpdateAppointmentRequest) (*DefaultResponse, error)
-	GetPatientByID(context.Context, *GetByIDRequest) (*GetPatientByIDResponse, error)
-	GetAppointmentByID(context.Context, *GetByIDRequest) (*GetAppointmentByIDResponse, error)
-	AddClinicDailyOverride(context.Context, *AddClinicDailyOverrideRequest) (*DefaultResponse, error)
-	AddDoctorDailyOverride(context.Context, *AddDoctorDailyOverrideRequest) (*DefaultResponse, error)
-	GetClinicOverride(context.Context, *GetClinicOverrideRequest) (*GetClinicOverrideResponse, error)
-	GetDoctorOverride(context.Context, *GetDoctorOverrideRequest) (*GetDoctorOverrideResponse, error)
-	// управление услугами и материалами
-	AddMaterial(context.Context, *AddMaterialRequest) (*DefaultResponse, error)
-	AddService(context.Context, *AddServiceRequest) (*DefaultResponse, error)
-	UpdateMaterial(context.Context, *UpdateMaterialRequest) (*DefaultResponse, error)
-	UpdateService(context.Context, *UpdateServiceRequest) (*DefaultResponse, error)
-	GetMaterials(context.Context, *EmptyRequest) (*GetMaterialsResponse, error)
-	GetServices(context.Context, *EmptyRequest) (*GetServicesResponse, error)
-	GetServicesTypes(context.Context, *EmptyRequest) (*GetServicesTypesResponse, error)
-	GetServiceTypeById(context.Context, *GetServiceTypeByIdRequest) (*GetServiceTypeByIdResponse, error)
-	DeleteMaterial(context.Context, *DeleteRequest) (*DefaultResponse, error)
-	DeleteService(context.Context, *DeleteRequest) (*DefaultResponse, error)
-	GetDoctorOverrides(context.Context, *GetByIDRequest) (*GetDoctorOverridesResponse, error)
-	// проведение приема
-	GetPatientDiagnoses(context.Context, *GetByIdRequest) (*GetPatientDiagnosesResponse, error)
-	GetPatientVisits(context.Context, *GetByIdRequest) (*GetPatientVisitsResponse, error)
-	GetPatientAllergiesChronics(context.Context, *GetByIdRequest) (*GetPatientAllergiesChronicsResponse, error)
-	GetICDCodes(context.Context, *EmptyRequest) (*GetICDCodesResponse, error)
-	AddPatientAllergiesChronics(context.Context, *AddPatientAllergiesChronicsRequest) (*DefaultResponse, error)
-	AddPatientVisit(context.Context, *AddPatientVisitRequest) (*AddVisitResponse, error)
-	AddVisitMaterials(context.Context, *AddVisitMaterialsRequest) (*DefaultResponse, error)
-	AddVisitServices(context.Context, *AddVisitServicesRequest) (*DefaultResponse, error)
-	AddPatientDiagnoses(context.Context, *AddPatientDiagnosesRequest) (*DefaultResponse, error)
-	AddVisitPayment(context.Context, *VisitPaymentRequest) (*DefaultResponse, error)
-	UpdateVisitPayment(context.Context, *VisitPaymentRequest) (*DefaultResponse, error)
-	GetVisitByID(context.Context, *GetByIdRequest) (*GetVisitByIDResponse, error)
-	CalculateVisitTotal(context.Context, *CalculateVisitTotalRequest) (*CalculateVisitTotalResponse, error)
-	AddOrUpdateVisitPayment(context.Context, *AddOrUpdateVisitPaymentRequest) (*DefaultResponse, error)
-	GetVisitsPayments(context.Context, *EmptyRequest) (*GetVisitsPaymentsResponse, error)
-	GetClinicOverrides(context.Context, *EmptyRequest) (*GetClinicOverridesResponse, error)
-	GetAppointments(context.Context, *EmptyRequest) (*GetAppointmentsResponse, error)
-	GetVisitMaterials(context.Context, *GetByIdRequest) (*GetVisitMaterialsAndServicesResponse, error)
-	GetVisitServices(context.Context, *GetByIdRequest) (*GetVisitMaterialsAndServicesResponse, error)
-	GetMaterialByID(context.Context, *GetByIdRequest) (*GetMaterialServiceByIDResponse, error)
-	GetServiceByID(context.Context, *GetByIdRequest) (*GetMaterialServiceByIDResponse, error)
-	mustEmbedUnimplementedStorageServiceServer()
+func (c *storageServiceClient) GetPatientAccessGrants(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientAccessGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPatientAccessGrantsResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetPatientAccessGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedStorageServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedStorageServiceServer struct{}
-
-func (UnimplementedStorageServiceServer) AddUser(context.Context, *AddUserRequest) (*AddUserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddUser not implemented")
-}
-func (UnimplementedStorageServiceServer) AddDoctor(context.Context, *AddDoctorRequest) (*AddDoctorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddDoctor not implemented")
+func (c *storageServiceClient) AddPatientAccessGrant(ctx context.Context, in *PatientAccessGrant, opts ...grpc.CallOption) (*AddPatientAccessGrantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPatientAccessGrantResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddPatientAccessGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddAdmin(context.Context, *AddAdminRequest) (*AddAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAdmin not implemented")
+
+func (c *storageServiceClient) RevokePatientAccessGrant(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_RevokePatientAccessGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddPatient(context.Context, *AddPatientRequest) (*AddPatientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPatient not implemented")
+
+func (c *storageServiceClient) DeleteService(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_DeleteService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetDoctors(context.Context, *EmptyRequest) (*GetDoctorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDoctors not implemented")
+
+func (c *storageServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetAdmins(context.Context, *EmptyRequest) (*GetAdminsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAdmins not implemented")
+
+func (c *storageServiceClient) AddPriceListEntry(ctx context.Context, in *AddPriceListEntryRequest, opts ...grpc.CallOption) (*AddPriceListEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddPriceListEntryResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddPriceListEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetPatients(context.Context, *EmptyRequest) (*GetPatientsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPatients not implemented")
+
+func (c *storageServiceClient) DeletePriceListEntry(ctx context.Context, in *DeletePriceListEntryRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_DeletePriceListEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetDoctorSpecsByDoctorId(context.Context, *GetByIdRequest) (*GetDoctorSpecsByDoctorIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorSpecsByDoctorId not implemented")
+
+func (c *storageServiceClient) GetPriceOnDate(ctx context.Context, in *GetPriceOnDateRequest, opts ...grpc.CallOption) (*GetPriceOnDateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceOnDateResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetPriceOnDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdateDoctor(context.Context, *UpdateDoctorRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDoctor not implemented")
+
+func (c *storageServiceClient) GetDoctorOverrides(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetDoctorOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDoctorOverridesResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetDoctorOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddDoctorSpec(context.Context, *AddDoctorSpecRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddDoctorSpec not implemented")
+
+func (c *storageServiceClient) GetPatientDiagnoses(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientDiagnosesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPatientDiagnosesResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetPatientDiagnoses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) DeleteDoctorSpec(context.Context, *DeleteDoctorSpecRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDoctorSpec not implemented")
+
+func (c *storageServiceClient) GetPatientVisits(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientVisitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPatientVisitsResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetPatientVisits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdateAdmin(context.Context, *UpdateAdminRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAdmin not implemented")
+
+func (c *storageServiceClient) GetPatientAllergiesChronics(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientAllergiesChronicsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPatientAllergiesChronicsResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetPatientAllergiesChronics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdateAdminRole(context.Context, *UpdateAdminRoleRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAdminRole not implemented")
+
+func (c *storageServiceClient) GetICDCodes(ctx context.Context, in *GetICDCodesRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetICDCodesResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetICDCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdatePatient(context.Context, *UpdatePatientRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePatient not implemented")
+
+func (c *storageServiceClient) AddPatientAllergiesChronics(ctx context.Context, in *AddPatientAllergiesChronicsRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddPatientAllergiesChronics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) DeleteUser(context.Context, *DeleteRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+func (c *storageServiceClient) AddPatientVisit(ctx context.Context, in *AddPatientVisitRequest, opts ...grpc.CallOption) (*AddVisitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddVisitResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddPatientVisit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdateUserLogin(context.Context, *UpdateUserLoginRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserLogin not implemented")
+
+func (c *storageServiceClient) AddVisitMaterials(ctx context.Context, in *AddVisitMaterialsRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddVisitMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetAllSpecs(context.Context, *EmptyRequest) (*GetAllSpecsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllSpecs not implemented")
+
+func (c *storageServiceClient) AddVisitServices(ctx context.Context, in *AddVisitServicesRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddVisitServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddUserRole(context.Context, *AddUserRoleRequest) (*AddUserRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddUserRole not implemented")
+
+func (c *storageServiceClient) AddPatientDiagnoses(ctx context.Context, in *AddPatientDiagnosesRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddPatientDiagnoses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetUserByLogin(context.Context, *GetUserByLoginRequest) (*GetUserByLoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByLogin not implemented")
+
+func (c *storageServiceClient) AddVisitPayment(ctx context.Context, in *VisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddVisitPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdateUserPassword(context.Context, *UpdateUserPasswordRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPassword not implemented")
+
+func (c *storageServiceClient) UpdateVisitPayment(ctx context.Context, in *VisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_UpdateVisitPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetClinicWeeklySchedule(context.Context, *EmptyRequest) (*GetClinicWeeklyScheduleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetClinicWeeklySchedule not implemented")
-}
-func (UnimplementedStorageServiceServer) GetUserRole(context.Context, *GetUserRoleRequest) (*GetUserRoleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserRole not implemented")
+
+func (c *storageServiceClient) GetVisitByID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetVisitByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVisitByIDResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetVisitByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetDoctorWeeklySchedule(context.Context, *GetScheduleByDoctorIdRequest) (*GetScheduleByDoctorIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorWeeklySchedule not implemented")
+
+func (c *storageServiceClient) CalculateVisitTotal(ctx context.Context, in *CalculateVisitTotalRequest, opts ...grpc.CallOption) (*CalculateVisitTotalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateVisitTotalResponse)
+	err := c.cc.Invoke(ctx, StorageService_CalculateVisitTotal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdateClinicWeeklySchedule(context.Context, *UpdateClinicWeeklyScheduleRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateClinicWeeklySchedule not implemented")
+
+func (c *storageServiceClient) AddOrUpdateVisitPayment(ctx context.Context, in *AddOrUpdateVisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddOrUpdateVisitPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddDoctorWeeklySchedule(context.Context, *AddDoctorWeeklyScheduleRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddDoctorWeeklySchedule not implemented")
+
+func (c *storageServiceClient) CompleteConsultation(ctx context.Context, in *CompleteConsultationRequest, opts ...grpc.CallOption) (*CompleteConsultationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteConsultationResponse)
+	err := c.cc.Invoke(ctx, StorageService_CompleteConsultation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdateDoctorWeeklySchedule(context.Context, *UpdateDoctorWeeklyScheduleRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDoctorWeeklySchedule not implemented")
+
+func (c *storageServiceClient) IssueInvoice(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, StorageService_IssueInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetRolePermission(context.Context, *GetRolePermissionRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRolePermission not implemented")
+
+func (c *storageServiceClient) GetInvoice(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetInvoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetDoctorsBySpecID(context.Context, *GetDoctorBySpecIDRequest) (*GetDoctorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorsBySpecID not implemented")
+
+func (c *storageServiceClient) SetInvoiceDiscount(ctx context.Context, in *SetInvoiceDiscountRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, StorageService_SetInvoiceDiscount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetAppointmentsByDoctorID(context.Context, *GetAppointmentsByDoctorIDRequest) (*GetAppointmentsByDoctorIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentsByDoctorID not implemented")
+
+func (c *storageServiceClient) AddInvoicePayment(ctx context.Context, in *AddInvoicePaymentRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddInvoicePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddAppointment(context.Context, *AddAppointmentRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAppointment not implemented")
+
+func (c *storageServiceClient) RefundInvoicePayment(ctx context.Context, in *RefundInvoicePaymentRequest, opts ...grpc.CallOption) (*InvoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvoiceResponse)
+	err := c.cc.Invoke(ctx, StorageService_RefundInvoicePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetAppointmentsByUserID(context.Context, *GetByIDRequest) (*GetAppointmentsByUserIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentsByUserID not implemented")
+
+func (c *storageServiceClient) GetPatientBalance(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*PatientBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatientBalanceResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetPatientBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetSpecsByDoctorID(context.Context, *GetByIDRequest) (*GetSpecsByDoctorIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSpecsByDoctorID not implemented")
+
+func (c *storageServiceClient) ReserveInvoiceDocument(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*BillingDocument, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BillingDocument)
+	err := c.cc.Invoke(ctx, StorageService_ReserveInvoiceDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetDoctorByID(context.Context, *GetByIDRequest) (*GetDoctorByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorByID not implemented")
+
+func (c *storageServiceClient) ReserveReceiptDocument(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*BillingDocument, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BillingDocument)
+	err := c.cc.Invoke(ctx, StorageService_ReserveReceiptDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdateAppointment(context.Context, *UpdateAppointmentRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppointment not implemented")
+
+func (c *storageServiceClient) AttachBillingDocument(ctx context.Context, in *AttachBillingDocumentRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_AttachBillingDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetPatientByID(context.Context, *GetByIDRequest) (*GetPatientByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPatientByID not implemented")
+
+func (c *storageServiceClient) GetVisitsPayments(ctx context.Context, in *GetVisitsPaymentsRequest, opts ...grpc.CallOption) (*GetVisitsPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVisitsPaymentsResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetVisitsPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetAppointmentByID(context.Context, *GetByIDRequest) (*GetAppointmentByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentByID not implemented")
+
+func (c *storageServiceClient) GetClinicOverrides(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetClinicOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetClinicOverridesResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetClinicOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddClinicDailyOverride(context.Context, *AddClinicDailyOverrideRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddClinicDailyOverride not implemented")
+
+func (c *storageServiceClient) GetAppointments(ctx context.Context, in *GetAppointmentsRequest, opts ...grpc.CallOption) (*GetAppointmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAppointmentsResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetAppointments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddDoctorDailyOverride(context.Context, *AddDoctorDailyOverrideRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddDoctorDailyOverride not implemented")
+
+func (c *storageServiceClient) GetVisitMaterials(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetVisitMaterialsAndServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVisitMaterialsAndServicesResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetVisitMaterials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetClinicOverride(context.Context, *GetClinicOverrideRequest) (*GetClinicOverrideResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetClinicOverride not implemented")
+
+func (c *storageServiceClient) GetVisitServices(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetVisitMaterialsAndServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVisitMaterialsAndServicesResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetVisitServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetDoctorOverride(context.Context, *GetDoctorOverrideRequest) (*GetDoctorOverrideResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorOverride not implemented")
+
+func (c *storageServiceClient) GetMaterialByID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetMaterialServiceByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaterialServiceByIDResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetMaterialByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddMaterial(context.Context, *AddMaterialRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMaterial not implemented")
+
+func (c *storageServiceClient) GetServiceByID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetMaterialServiceByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMaterialServiceByIDResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetServiceByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddService(context.Context, *AddServiceRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddService not implemented")
+
+func (c *storageServiceClient) GetTotalPatients(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*IntResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetTotalPatients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdateMaterial(context.Context, *UpdateMaterialRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaterial not implemented")
+
+func (c *storageServiceClient) GetTotalVisits(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*IntResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetTotalVisits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdateService(context.Context, *UpdateServiceRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateService not implemented")
+
+func (c *storageServiceClient) GetTopServices(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ServiceStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceStatsResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetTopServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetMaterials(context.Context, *EmptyRequest) (*GetMaterialsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMaterials not implemented")
+
+func (c *storageServiceClient) GetDoctorAvgVisit(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DoctorAvgVisitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoctorAvgVisitResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetDoctorAvgVisit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetServices(context.Context, *EmptyRequest) (*GetServicesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetServices not implemented")
+
+func (c *storageServiceClient) GetDoctorAvgCheck(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DoctorAvgCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoctorAvgCheckResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetDoctorAvgCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetServicesTypes(context.Context, *EmptyRequest) (*GetServicesTypesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetServicesTypes not implemented")
+
+func (c *storageServiceClient) GetDoctorUniquePatient(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DoctorUniquePatientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DoctorUniquePatientResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetDoctorUniquePatient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetServiceTypeById(context.Context, *GetServiceTypeByIdRequest) (*GetServiceTypeByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetServiceTypeById not implemented")
+
+func (c *storageServiceClient) GetAgeGroupStat(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*AgeGroupStatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AgeGroupStatResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetAgeGroupStat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) DeleteMaterial(context.Context, *DeleteRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteMaterial not implemented")
+
+func (c *storageServiceClient) GetNewPatientsThisMonth(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*IntResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetNewPatientsThisMonth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) DeleteService(context.Context, *DeleteRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteService not implemented")
+
+func (c *storageServiceClient) GetAvgVisitsPerPatient(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*FloatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FloatResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetAvgVisitsPerPatient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetDoctorOverrides(context.Context, *GetByIDRequest) (*GetDoctorOverridesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorOverrides not implemented")
+
+func (c *storageServiceClient) GetTotalIncome(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*FloatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FloatResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetTotalIncome_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetPatientDiagnoses(context.Context, *GetByIdRequest) (*GetPatientDiagnosesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPatientDiagnoses not implemented")
+
+func (c *storageServiceClient) GetMonthlyIncome(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*FloatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FloatResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetMonthlyIncome_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetPatientVisits(context.Context, *GetByIdRequest) (*GetPatientVisitsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPatientVisits not implemented")
+
+func (c *storageServiceClient) GetClinicAverageCheck(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*FloatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FloatResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetClinicAverageCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetPatientAllergiesChronics(context.Context, *GetByIdRequest) (*GetPatientAllergiesChronicsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPatientAllergiesChronics not implemented")
+
+func (c *storageServiceClient) GetDiagnoseByVisitID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetDiagnoseByVisitIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDiagnoseByVisitIDResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetDiagnoseByVisitID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetICDCodes(context.Context, *EmptyRequest) (*GetICDCodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetICDCodes not implemented")
+
+func (c *storageServiceClient) StartDocumentUpload(ctx context.Context, in *StartDocumentUploadRequest, opts ...grpc.CallOption) (*StartDocumentUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartDocumentUploadResponse)
+	err := c.cc.Invoke(ctx, StorageService_StartDocumentUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddPatientAllergiesChronics(context.Context, *AddPatientAllergiesChronicsRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPatientAllergiesChronics not implemented")
+
+func (c *storageServiceClient) UploadDocument(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadDocumentChunk, DocumentUploadStatus], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorageService_ServiceDesc.Streams[0], StorageService_UploadDocument_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadDocumentChunk, DocumentUploadStatus]{ClientStream: stream}
+	return x, nil
 }
-func (UnimplementedStorageServiceServer) AddPatientVisit(context.Context, *AddPatientVisitRequest) (*AddVisitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPatientVisit not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageService_UploadDocumentClient = grpc.ClientStreamingClient[UploadDocumentChunk, DocumentUploadStatus]
+
+func (c *storageServiceClient) GetDocumentUploadStatus(ctx context.Context, in *DocumentUploadStatusRequest, opts ...grpc.CallOption) (*DocumentUploadStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DocumentUploadStatus)
+	err := c.cc.Invoke(ctx, StorageService_GetDocumentUploadStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddVisitMaterials(context.Context, *AddVisitMaterialsRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddVisitMaterials not implemented")
+
+func (c *storageServiceClient) GetDocumentMetadata(ctx context.Context, in *GetDocumentMetadataRequest, opts ...grpc.CallOption) (*GetDocumentMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentMetadataResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetDocumentMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddVisitServices(context.Context, *AddVisitServicesRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddVisitServices not implemented")
+
+func (c *storageServiceClient) DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDocumentChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StorageService_ServiceDesc.Streams[1], StorageService_DownloadDocument_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadDocumentRequest, DownloadDocumentChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedStorageServiceServer) AddPatientDiagnoses(context.Context, *AddPatientDiagnosesRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPatientDiagnoses not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageService_DownloadDocumentClient = grpc.ServerStreamingClient[DownloadDocumentChunk]
+
+func (c *storageServiceClient) GetDocumentsByPatientID(ctx context.Context, in *GetDocumentsRequest, opts ...grpc.CallOption) (*GetDocumentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDocumentsResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetDocumentsByPatientID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddVisitPayment(context.Context, *VisitPaymentRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddVisitPayment not implemented")
+
+func (c *storageServiceClient) GetAdminByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetAdminByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAdminByIDResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetAdminByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) UpdateVisitPayment(context.Context, *VisitPaymentRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateVisitPayment not implemented")
+
+func (c *storageServiceClient) AddAuditEntry(ctx context.Context, in *AuditEntry, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddAuditEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) GetVisitByID(context.Context, *GetByIdRequest) (*GetVisitByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVisitByID not implemented")
+
+func (c *storageServiceClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, StorageService_GetAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) CalculateVisitTotal(context.Context, *CalculateVisitTotalRequest) (*CalculateVisitTotalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculateVisitTotal not implemented")
+
+func (c *storageServiceClient) VerifyAuditLog(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, StorageService_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedStorageServiceServer) AddOrUpdateVisitPayment(context.Context, *AddOrUpdateVisitPaymentRequest) (*DefaultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddOrUpdateVisitPayment not implemented")
+
+// StorageServiceServer is the server API for StorageService service.
+// All implementations must embed UnimplementedStorageServiceServer
+// for forward compatibility.
+type StorageServiceServer interface {
+	// управление аккаунтами
+	AddUser(context.Context, *AddUserRequest) (*AddUserResponse, error)
+	AddDoctor(context.Context, *AddDoctorRequest) (*AddDoctorResponse, error)
+	AddAdmin(context.Context, *AddAdminRequest) (*AddAdminResponse, error)
+	AddPatient(context.Context, *AddPatientRequest) (*AddPatientResponse, error)
+	GetDoctors(context.Context, *GetDoctorsRequest) (*GetDoctorsResponse, error)
+	GetAdmins(context.Context, *GetAdminsRequest) (*GetAdminsResponse, error)
+	GetPatients(context.Context, *GetPatientsRequest) (*GetPatientsResponse, error)
+	GetDoctorSpecsByDoctorId(context.Context, *GetByIdRequest) (*GetDoctorSpecsByDoctorIdResponse, error)
+	UpdateDoctor(context.Context, *UpdateDoctorRequest) (*DefaultResponse, error)
+	AddDoctorSpec(context.Context, *AddDoctorSpecRequest) (*DefaultResponse, error)
+	DeleteDoctorSpec(context.Context, *DeleteDoctorSpecRequest) (*DefaultResponse, error)
+	UpdateAdmin(context.Context, *UpdateAdminRequest) (*DefaultResponse, error)
+	UpdateAdminRole(context.Context, *UpdateAdminRoleRequest) (*DefaultResponse, error)
+	UpdatePatient(context.Context, *UpdatePatientRequest) (*DefaultResponse, error)
+	DeleteUser(context.Context, *DeleteRequest) (*DefaultResponse, error)
+	RetireUser(context.Context, *ChangeUserStatusRequest) (*ChangeUserStatusResponse, error)
+	RestoreUser(context.Context, *ChangeUserStatusRequest) (*ChangeUserStatusResponse, error)
+	UpdateUserLogin(context.Context, *UpdateUserLoginRequest) (*DefaultResponse, error)
+	GetAllSpecs(context.Context, *EmptyRequest) (*GetAllSpecsResponse, error)
+	AddUserRole(context.Context, *AddUserRoleRequest) (*AddUserRoleResponse, error)
+	GetUserByLogin(context.Context, *GetUserByLoginRequest) (*GetUserByLoginResponse, error)
+	UpdateUserPassword(context.Context, *UpdateUserPasswordRequest) (*DefaultResponse, error)
+	GetClinicWeeklySchedule(context.Context, *EmptyRequest) (*GetClinicWeeklyScheduleResponse, error)
+	GetUserRole(context.Context, *GetUserRoleRequest) (*GetUserRoleResponse, error)
+	GetDoctorWeeklySchedule(context.Context, *GetScheduleByDoctorIdRequest) (*GetScheduleByDoctorIdResponse, error)
+	UpdateClinicWeeklySchedule(context.Context, *UpdateClinicWeeklyScheduleRequest) (*DefaultResponse, error)
+	AddDoctorWeeklySchedule(context.Context, *AddDoctorWeeklyScheduleRequest) (*DefaultResponse, error)
+	UpdateDoctorWeeklySchedule(context.Context, *UpdateDoctorWeeklyScheduleRequest) (*DefaultResponse, error)
+	GetRolePermission(context.Context, *GetRolePermissionRequest) (*DefaultResponse, error)
+	GetDoctorsBySpecID(context.Context, *GetDoctorBySpecIDRequest) (*GetDoctorsResponse, error)
+	GetAppointmentsByDoctorID(context.Context, *GetAppointmentsByDoctorIDRequest) (*GetAppointmentsByDoctorIDResponse, error)
+	GetPatientByID(context.Context, *GetByIDRequest) (*GetPatientByIDResponse, error)
+	AddAppointment(context.Context, *AddAppointmentRequest) (*DefaultResponse, error)
+	GetAppointmentsByUserID(context.Context, *GetByIDRequest) (*GetAppointmentsByUserIDResponse, error)
+	GetSpecsByDoctorID(context.Context, *GetByIDRequest) (*GetSpecsByDoctorIDResponse, error)
+	GetDoctorByID(context.Context, *GetByIDRequest) (*GetDoctorByIDResponse, error)
+	UpdateAppointment(context.Context, *UpdateAppointmentRequest) (*DefaultResponse, error)
+	GetAppointmentByID(context.Context, *GetByIDRequest) (*GetAppointmentByIDResponse, error)
+	HoldAppointmentSlot(context.Context, *HoldAppointmentSlotRequest) (*HoldAppointmentSlotResponse, error)
+	ReleaseAppointmentSlot(context.Context, *ReleaseAppointmentSlotRequest) (*DefaultResponse, error)
+	GetAppointmentSlotHolds(context.Context, *GetAppointmentsByDoctorIDRequest) (*GetAppointmentSlotHoldsResponse, error)
+	AddClinicDailyOverride(context.Context, *AddClinicDailyOverrideRequest) (*DefaultResponse, error)
+	AddDoctorDailyOverride(context.Context, *AddDoctorDailyOverrideRequest) (*DefaultResponse, error)
+	GetClinicOverride(context.Context, *GetClinicOverrideRequest) (*GetClinicOverrideResponse, error)
+	GetDoctorOverride(context.Context, *GetDoctorOverrideRequest) (*GetDoctorOverrideResponse, error)
+	GetClinicSettings(context.Context, *EmptyRequest) (*ClinicSettings, error)
+	UpdateClinicTimeZone(context.Context, *UpdateClinicTimeZoneRequest) (*DefaultResponse, error)
+	// лист ожидания
+	AddWaitingListEntry(context.Context, *AddWaitingListEntryRequest) (*AddWaitingListEntryResponse, error)
+	GetWaitingListByPatientID(context.Context, *GetByIDRequest) (*GetWaitingListResponse, error)
+	CancelWaitingListEntry(context.Context, *CancelWaitingListEntryRequest) (*DefaultResponse, error)
+	AcceptWaitingListOffer(context.Context, *AcceptWaitingListOfferRequest) (*AcceptWaitingListOfferResponse, error)
+	DeclineWaitingListOffer(context.Context, *DeclineWaitingListOfferRequest) (*DefaultResponse, error)
+	// правила расписания: диапазоны и повторяющиеся исключения
+	GetScheduleRules(context.Context, *GetScheduleRulesRequest) (*GetScheduleRulesResponse, error)
+	AddScheduleRule(context.Context, *AddScheduleRuleRequest) (*AddScheduleRuleResponse, error)
+	ImportScheduleRules(context.Context, *ImportScheduleRulesRequest) (*ImportScheduleRulesResponse, error)
+	DeleteScheduleRule(context.Context, *DeleteRequest) (*DefaultResponse, error)
+	// уведомления
+	ClaimNotifications(context.Context, *ClaimNotificationsRequest) (*ClaimNotificationsResponse, error)
+	CompleteNotification(context.Context, *CompleteNotificationRequest) (*DefaultResponse, error)
+	GetPatientNotificationSettings(context.Context, *GetByIDRequest) (*PatientNotificationSettings, error)
+	UpdatePatientNotificationSettings(context.Context, *PatientNotificationSettings) (*DefaultResponse, error)
+	// управление услугами и материалами
+	AddMaterial(context.Context, *AddMaterialRequest) (*DefaultResponse, error)
+	AddService(context.Context, *AddServiceRequest) (*DefaultResponse, error)
+	UpdateMaterial(context.Context, *UpdateMaterialRequest) (*DefaultResponse, error)
+	UpdateService(context.Context, *UpdateServiceRequest) (*DefaultResponse, error)
+	GetMaterials(context.Context, *EmptyRequest) (*GetMaterialsResponse, error)
+	GetServices(context.Context, *EmptyRequest) (*GetServicesResponse, error)
+	GetServicesTypes(context.Context, *EmptyRequest) (*GetServicesTypesResponse, error)
+	GetServiceTypeById(context.Context, *GetServiceTypeByIdRequest) (*GetServiceTypeByIdResponse, error)
+	DeleteMaterial(context.Context, *DeleteRequest) (*DefaultResponse, error)
+	ReceiveMaterialBatch(context.Context, *ReceiveMaterialBatchRequest) (*MaterialBatch, error)
+	AdjustMaterialStock(context.Context, *AdjustMaterialStockRequest) (*DefaultResponse, error)
+	GetMaterialStock(context.Context, *GetByIdRequest) (*MaterialStockResponse, error)
+	GetStockReport(context.Context, *StockReportRequest) (*StockReportResponse, error)
+	CreateTreatmentPlan(context.Context, *CreateTreatmentPlanRequest) (*TreatmentPlanResponse, error)
+	AddTreatmentPlanItems(context.Context, *AddTreatmentPlanItemsRequest) (*TreatmentPlanResponse, error)
+	UpdateTreatmentPlanItemStatus(context.Context, *UpdateTreatmentPlanItemStatusRequest) (*TreatmentPlanResponse, error)
+	AcceptTreatmentPlan(context.Context, *AcceptTreatmentPlanRequest) (*TreatmentPlanResponse, error)
+	CancelTreatmentPlan(context.Context, *GetByIdRequest) (*TreatmentPlanResponse, error)
+	GetTreatmentPlan(context.Context, *GetByIdRequest) (*TreatmentPlanResponse, error)
+	GetTreatmentPlanByItemID(context.Context, *GetByIdRequest) (*TreatmentPlanResponse, error)
+	GetPatientTreatmentPlans(context.Context, *GetByIdRequest) (*TreatmentPlansResponse, error)
+	RecordToothStates(context.Context, *RecordToothStatesRequest) (*DentalChartResponse, error)
+	GetDentalChart(context.Context, *GetByIdRequest) (*DentalChartResponse, error)
+	GetToothHistory(context.Context, *GetToothHistoryRequest) (*DentalChartResponse, error)
+	SaveAnamnesis(context.Context, *SaveAnamnesisRequest) (*AnamnesisResponse, error)
+	GetAnamnesis(context.Context, *GetByIdRequest) (*AnamnesisResponse, error)
+	GetAnamnesisHistory(context.Context, *GetByIdRequest) (*AnamnesisHistoryResponse, error)
+	GetClinicalAlertRules(context.Context, *GetClinicalAlertRulesRequest) (*GetClinicalAlertRulesResponse, error)
+	AddClinicalAlertRule(context.Context, *ClinicalAlertRule) (*AddClinicalAlertRuleResponse, error)
+	UpdateClinicalAlertRule(context.Context, *ClinicalAlertRule) (*DefaultResponse, error)
+	DeleteClinicalAlertRule(context.Context, *DeleteRequest) (*DefaultResponse, error)
+	GetMedicalRecord(context.Context, *GetByIdRequest) (*MedicalRecord, error)
+	GetDoctorPatientRelation(context.Context, *DoctorPatientRelationRequest) (*DoctorPatientRelation, error)
+	GetPatientAccessGrants(context.Context, *GetByIdRequest) (*GetPatientAccessGrantsResponse, error)
+	AddPatientAccessGrant(context.Context, *PatientAccessGrant) (*AddPatientAccessGrantResponse, error)
+	RevokePatientAccessGrant(context.Context, *DeleteRequest) (*DefaultResponse, error)
+	DeleteService(context.Context, *DeleteRequest) (*DefaultResponse, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	AddPriceListEntry(context.Context, *AddPriceListEntryRequest) (*AddPriceListEntryResponse, error)
+	DeletePriceListEntry(context.Context, *DeletePriceListEntryRequest) (*DefaultResponse, error)
+	GetPriceOnDate(context.Context, *GetPriceOnDateRequest) (*GetPriceOnDateResponse, error)
+	GetDoctorOverrides(context.Context, *GetByIDRequest) (*GetDoctorOverridesResponse, error)
+	// проведение приема
+	GetPatientDiagnoses(context.Context, *GetByIdRequest) (*GetPatientDiagnosesResponse, error)
+	GetPatientVisits(context.Context, *GetByIdRequest) (*GetPatientVisitsResponse, error)
+	GetPatientAllergiesChronics(context.Context, *GetByIdRequest) (*GetPatientAllergiesChronicsResponse, error)
+	GetICDCodes(context.Context, *GetICDCodesRequest) (*GetICDCodesResponse, error)
+	AddPatientAllergiesChronics(context.Context, *AddPatientAllergiesChronicsRequest) (*DefaultResponse, error)
+	AddPatientVisit(context.Context, *AddPatientVisitRequest) (*AddVisitResponse, error)
+	AddVisitMaterials(context.Context, *AddVisitMaterialsRequest) (*DefaultResponse, error)
+	AddVisitServices(context.Context, *AddVisitServicesRequest) (*DefaultResponse, error)
+	AddPatientDiagnoses(context.Context, *AddPatientDiagnosesRequest) (*DefaultResponse, error)
+	AddVisitPayment(context.Context, *VisitPaymentRequest) (*DefaultResponse, error)
+	UpdateVisitPayment(context.Context, *VisitPaymentRequest) (*DefaultResponse, error)
+	GetVisitByID(context.Context, *GetByIdRequest) (*GetVisitByIDResponse, error)
+	CalculateVisitTotal(context.Context, *CalculateVisitTotalRequest) (*CalculateVisitTotalResponse, error)
+	AddOrUpdateVisitPayment(context.Context, *AddOrUpdateVisitPaymentRequest) (*DefaultResponse, error)
+	CompleteConsultation(context.Context, *CompleteConsultationRequest) (*CompleteConsultationResponse, error)
+	IssueInvoice(context.Context, *GetByIdRequest) (*InvoiceResponse, error)
+	GetInvoice(context.Context, *GetByIdRequest) (*InvoiceResponse, error)
+	SetInvoiceDiscount(context.Context, *SetInvoiceDiscountRequest) (*InvoiceResponse, error)
+	AddInvoicePayment(context.Context, *AddInvoicePaymentRequest) (*InvoiceResponse, error)
+	RefundInvoicePayment(context.Context, *RefundInvoicePaymentRequest) (*InvoiceResponse, error)
+	GetPatientBalance(context.Context, *GetByIdRequest) (*PatientBalanceResponse, error)
+	ReserveInvoiceDocument(context.Context, *GetByIdRequest) (*BillingDocument, error)
+	ReserveReceiptDocument(context.Context, *GetByIdRequest) (*BillingDocument, error)
+	AttachBillingDocument(context.Context, *AttachBillingDocumentRequest) (*DefaultResponse, error)
+	GetVisitsPayments(context.Context, *GetVisitsPaymentsRequest) (*GetVisitsPaymentsResponse, error)
+	GetClinicOverrides(context.Context, *EmptyRequest) (*GetClinicOverridesResponse, error)
+	GetAppointments(context.Context, *GetAppointmentsRequest) (*GetAppointmentsResponse, error)
+	GetVisitMaterials(context.Context, *GetByIdRequest) (*GetVisitMaterialsAndServicesResponse, error)
+	GetVisitServices(context.Context, *GetByIdRequest) (*GetVisitMaterialsAndServicesResponse, error)
+	GetMaterialByID(context.Context, *GetByIdRequest) (*GetMaterialServiceByIDResponse, error)
+	GetServiceByID(context.Context, *GetByIdRequest) (*GetMaterialServiceByIDResponse, error)
+	// статистика
+	GetTotalPatients(context.Context, *EmptyRequest) (*IntResponse, error)
+	GetTotalVisits(context.Context, *EmptyRequest) (*IntResponse, error)
+	GetTopServices(context.Context, *EmptyRequest) (*ServiceStatsResponse, error)
+	GetDoctorAvgVisit(context.Context, *EmptyRequest) (*DoctorAvgVisitResponse, error)
+	GetDoctorAvgCheck(context.Context, *EmptyRequest) (*DoctorAvgCheckResponse, error)
+	GetDoctorUniquePatient(context.Context, *EmptyRequest) (*DoctorUniquePatientResponse, error)
+	GetAgeGroupStat(context.Context, *EmptyRequest) (*AgeGroupStatResponse, error)
+	GetNewPatientsThisMonth(context.Context, *EmptyRequest) (*IntResponse, error)
+	GetAvgVisitsPerPatient(context.Context, *EmptyRequest) (*FloatResponse, error)
+	GetTotalIncome(context.Context, *EmptyRequest) (*FloatResponse, error)
+	GetMonthlyIncome(context.Context, *EmptyRequest) (*FloatResponse, error)
+	GetClinicAverageCheck(context.Context, *EmptyRequest) (*FloatResponse, error)
+	GetDiagnoseByVisitID(context.Context, *GetByIDRequest) (*GetDiagnoseByVisitIDResponse, error)
+	StartDocumentUpload(context.Context, *StartDocumentUploadRequest) (*StartDocumentUploadResponse, error)
+	UploadDocument(grpc.ClientStreamingServer[UploadDocumentChunk, DocumentUploadStatus]) error
+	GetDocumentUploadStatus(context.Context, *DocumentUploadStatusRequest) (*DocumentUploadStatus, error)
+	GetDocumentMetadata(context.Context, *GetDocumentMetadataRequest) (*GetDocumentMetadataResponse, error)
+	DownloadDocument(*DownloadDocumentRequest, grpc.ServerStreamingServer[DownloadDocumentChunk]) error
+	GetDocumentsByPatientID(context.Context, *GetDocumentsRequest) (*GetDocumentsResponse, error)
+	GetAdminByID(context.Context, *GetByIDRequest) (*GetAdminByIDResponse, error)
+	// журнал аудита
+	AddAuditEntry(context.Context, *AuditEntry) (*DefaultResponse, error)
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
+	VerifyAuditLog(context.Context, *EmptyRequest) (*VerifyAuditLogResponse, error)
+	mustEmbedUnimplementedStorageServiceServer()
 }
-func (UnimplementedStorageServiceServer) GetVisitsPayments(context.Context, *EmptyRequest) (*GetVisitsPaymentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVisitsPayments not implemented")
+
+// UnimplementedStorageServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStorageServiceServer struct{}
+
+func (UnimplementedStorageServiceServer) AddUser(context.Context, *AddUserRequest) (*AddUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUser not implemented")
 }
-func (UnimplementedStorageServiceServer) GetClinicOverrides(context.Context, *EmptyRequest) (*GetClinicOverridesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetClinicOverrides not implemented")
+func (UnimplementedStorageServiceServer) AddDoctor(context.Context, *AddDoctorRequest) (*AddDoctorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDoctor not implemented")
 }
-func (UnimplementedStorageServiceServer) GetAppointments(context.Context, *EmptyRequest) (*GetAppointmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppointments not implemented")
+func (UnimplementedStorageServiceServer) AddAdmin(context.Context, *AddAdminRequest) (*AddAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAdmin not implemented")
+}
+func (UnimplementedStorageServiceServer) AddPatient(context.Context, *AddPatientRequest) (*AddPatientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPatient not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDoctors(context.Context, *GetDoctorsRequest) (*GetDoctorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctors not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAdmins(context.Context, *GetAdminsRequest) (*GetAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdmins not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPatients(context.Context, *GetPatientsRequest) (*GetPatientsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatients not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDoctorSpecsByDoctorId(context.Context, *GetByIdRequest) (*GetDoctorSpecsByDoctorIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorSpecsByDoctorId not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateDoctor(context.Context, *UpdateDoctorRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDoctor not implemented")
+}
+func (UnimplementedStorageServiceServer) AddDoctorSpec(context.Context, *AddDoctorSpecRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDoctorSpec not implemented")
+}
+func (UnimplementedStorageServiceServer) DeleteDoctorSpec(context.Context, *DeleteDoctorSpecRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDoctorSpec not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateAdmin(context.Context, *UpdateAdminRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAdmin not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateAdminRole(context.Context, *UpdateAdminRoleRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAdminRole not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdatePatient(context.Context, *UpdatePatientRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePatient not implemented")
+}
+func (UnimplementedStorageServiceServer) DeleteUser(context.Context, *DeleteRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedStorageServiceServer) RetireUser(context.Context, *ChangeUserStatusRequest) (*ChangeUserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireUser not implemented")
+}
+func (UnimplementedStorageServiceServer) RestoreUser(context.Context, *ChangeUserStatusRequest) (*ChangeUserStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateUserLogin(context.Context, *UpdateUserLoginRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserLogin not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAllSpecs(context.Context, *EmptyRequest) (*GetAllSpecsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllSpecs not implemented")
+}
+func (UnimplementedStorageServiceServer) AddUserRole(context.Context, *AddUserRoleRequest) (*AddUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserRole not implemented")
+}
+func (UnimplementedStorageServiceServer) GetUserByLogin(context.Context, *GetUserByLoginRequest) (*GetUserByLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByLogin not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateUserPassword(context.Context, *UpdateUserPasswordRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPassword not implemented")
+}
+func (UnimplementedStorageServiceServer) GetClinicWeeklySchedule(context.Context, *EmptyRequest) (*GetClinicWeeklyScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClinicWeeklySchedule not implemented")
+}
+func (UnimplementedStorageServiceServer) GetUserRole(context.Context, *GetUserRoleRequest) (*GetUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserRole not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDoctorWeeklySchedule(context.Context, *GetScheduleByDoctorIdRequest) (*GetScheduleByDoctorIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorWeeklySchedule not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateClinicWeeklySchedule(context.Context, *UpdateClinicWeeklyScheduleRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClinicWeeklySchedule not implemented")
+}
+func (UnimplementedStorageServiceServer) AddDoctorWeeklySchedule(context.Context, *AddDoctorWeeklyScheduleRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDoctorWeeklySchedule not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateDoctorWeeklySchedule(context.Context, *UpdateDoctorWeeklyScheduleRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDoctorWeeklySchedule not implemented")
+}
+func (UnimplementedStorageServiceServer) GetRolePermission(context.Context, *GetRolePermissionRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRolePermission not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDoctorsBySpecID(context.Context, *GetDoctorBySpecIDRequest) (*GetDoctorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorsBySpecID not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAppointmentsByDoctorID(context.Context, *GetAppointmentsByDoctorIDRequest) (*GetAppointmentsByDoctorIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentsByDoctorID not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPatientByID(context.Context, *GetByIDRequest) (*GetPatientByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientByID not implemented")
+}
+func (UnimplementedStorageServiceServer) AddAppointment(context.Context, *AddAppointmentRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAppointment not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAppointmentsByUserID(context.Context, *GetByIDRequest) (*GetAppointmentsByUserIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentsByUserID not implemented")
+}
+func (UnimplementedStorageServiceServer) GetSpecsByDoctorID(context.Context, *GetByIDRequest) (*GetSpecsByDoctorIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpecsByDoctorID not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDoctorByID(context.Context, *GetByIDRequest) (*GetDoctorByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorByID not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateAppointment(context.Context, *UpdateAppointmentRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppointment not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAppointmentByID(context.Context, *GetByIDRequest) (*GetAppointmentByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentByID not implemented")
+}
+func (UnimplementedStorageServiceServer) HoldAppointmentSlot(context.Context, *HoldAppointmentSlotRequest) (*HoldAppointmentSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldAppointmentSlot not implemented")
+}
+func (UnimplementedStorageServiceServer) ReleaseAppointmentSlot(context.Context, *ReleaseAppointmentSlotRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseAppointmentSlot not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAppointmentSlotHolds(context.Context, *GetAppointmentsByDoctorIDRequest) (*GetAppointmentSlotHoldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointmentSlotHolds not implemented")
+}
+func (UnimplementedStorageServiceServer) AddClinicDailyOverride(context.Context, *AddClinicDailyOverrideRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddClinicDailyOverride not implemented")
+}
+func (UnimplementedStorageServiceServer) AddDoctorDailyOverride(context.Context, *AddDoctorDailyOverrideRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDoctorDailyOverride not implemented")
+}
+func (UnimplementedStorageServiceServer) GetClinicOverride(context.Context, *GetClinicOverrideRequest) (*GetClinicOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClinicOverride not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDoctorOverride(context.Context, *GetDoctorOverrideRequest) (*GetDoctorOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorOverride not implemented")
+}
+func (UnimplementedStorageServiceServer) GetClinicSettings(context.Context, *EmptyRequest) (*ClinicSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClinicSettings not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateClinicTimeZone(context.Context, *UpdateClinicTimeZoneRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClinicTimeZone not implemented")
+}
+func (UnimplementedStorageServiceServer) AddWaitingListEntry(context.Context, *AddWaitingListEntryRequest) (*AddWaitingListEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWaitingListEntry not implemented")
+}
+func (UnimplementedStorageServiceServer) GetWaitingListByPatientID(context.Context, *GetByIDRequest) (*GetWaitingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitingListByPatientID not implemented")
+}
+func (UnimplementedStorageServiceServer) CancelWaitingListEntry(context.Context, *CancelWaitingListEntryRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWaitingListEntry not implemented")
+}
+func (UnimplementedStorageServiceServer) AcceptWaitingListOffer(context.Context, *AcceptWaitingListOfferRequest) (*AcceptWaitingListOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptWaitingListOffer not implemented")
+}
+func (UnimplementedStorageServiceServer) DeclineWaitingListOffer(context.Context, *DeclineWaitingListOfferRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineWaitingListOffer not implemented")
+}
+func (UnimplementedStorageServiceServer) GetScheduleRules(context.Context, *GetScheduleRulesRequest) (*GetScheduleRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduleRules not implemented")
+}
+func (UnimplementedStorageServiceServer) AddScheduleRule(context.Context, *AddScheduleRuleRequest) (*AddScheduleRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScheduleRule not implemented")
+}
+func (UnimplementedStorageServiceServer) ImportScheduleRules(context.Context, *ImportScheduleRulesRequest) (*ImportScheduleRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportScheduleRules not implemented")
+}
+func (UnimplementedStorageServiceServer) DeleteScheduleRule(context.Context, *DeleteRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduleRule not implemented")
+}
+func (UnimplementedStorageServiceServer) ClaimNotifications(context.Context, *ClaimNotificationsRequest) (*ClaimNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimNotifications not implemented")
+}
+func (UnimplementedStorageServiceServer) CompleteNotification(context.Context, *CompleteNotificationRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteNotification not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPatientNotificationSettings(context.Context, *GetByIDRequest) (*PatientNotificationSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientNotificationSettings not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdatePatientNotificationSettings(context.Context, *PatientNotificationSettings) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePatientNotificationSettings not implemented")
+}
+func (UnimplementedStorageServiceServer) AddMaterial(context.Context, *AddMaterialRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMaterial not implemented")
+}
+func (UnimplementedStorageServiceServer) AddService(context.Context, *AddServiceRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddService not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateMaterial(context.Context, *UpdateMaterialRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaterial not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateService(context.Context, *UpdateServiceRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateService not implemented")
+}
+func (UnimplementedStorageServiceServer) GetMaterials(context.Context, *EmptyRequest) (*GetMaterialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaterials not implemented")
+}
+func (UnimplementedStorageServiceServer) GetServices(context.Context, *EmptyRequest) (*GetServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServices not implemented")
+}
+func (UnimplementedStorageServiceServer) GetServicesTypes(context.Context, *EmptyRequest) (*GetServicesTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServicesTypes not implemented")
+}
+func (UnimplementedStorageServiceServer) GetServiceTypeById(context.Context, *GetServiceTypeByIdRequest) (*GetServiceTypeByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceTypeById not implemented")
+}
+func (UnimplementedStorageServiceServer) DeleteMaterial(context.Context, *DeleteRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMaterial not implemented")
+}
+func (UnimplementedStorageServiceServer) ReceiveMaterialBatch(context.Context, *ReceiveMaterialBatchRequest) (*MaterialBatch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveMaterialBatch not implemented")
+}
+func (UnimplementedStorageServiceServer) AdjustMaterialStock(context.Context, *AdjustMaterialStockRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustMaterialStock not implemented")
+}
+func (UnimplementedStorageServiceServer) GetMaterialStock(context.Context, *GetByIdRequest) (*MaterialStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaterialStock not implemented")
+}
+func (UnimplementedStorageServiceServer) GetStockReport(context.Context, *StockReportRequest) (*StockReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockReport not implemented")
+}
+func (UnimplementedStorageServiceServer) CreateTreatmentPlan(context.Context, *CreateTreatmentPlanRequest) (*TreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTreatmentPlan not implemented")
+}
+func (UnimplementedStorageServiceServer) AddTreatmentPlanItems(context.Context, *AddTreatmentPlanItemsRequest) (*TreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTreatmentPlanItems not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateTreatmentPlanItemStatus(context.Context, *UpdateTreatmentPlanItemStatusRequest) (*TreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTreatmentPlanItemStatus not implemented")
+}
+func (UnimplementedStorageServiceServer) AcceptTreatmentPlan(context.Context, *AcceptTreatmentPlanRequest) (*TreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTreatmentPlan not implemented")
+}
+func (UnimplementedStorageServiceServer) CancelTreatmentPlan(context.Context, *GetByIdRequest) (*TreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTreatmentPlan not implemented")
+}
+func (UnimplementedStorageServiceServer) GetTreatmentPlan(context.Context, *GetByIdRequest) (*TreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTreatmentPlan not implemented")
+}
+func (UnimplementedStorageServiceServer) GetTreatmentPlanByItemID(context.Context, *GetByIdRequest) (*TreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTreatmentPlanByItemID not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPatientTreatmentPlans(context.Context, *GetByIdRequest) (*TreatmentPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientTreatmentPlans not implemented")
+}
+func (UnimplementedStorageServiceServer) RecordToothStates(context.Context, *RecordToothStatesRequest) (*DentalChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordToothStates not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDentalChart(context.Context, *GetByIdRequest) (*DentalChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDentalChart not implemented")
+}
+func (UnimplementedStorageServiceServer) GetToothHistory(context.Context, *GetToothHistoryRequest) (*DentalChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToothHistory not implemented")
+}
+func (UnimplementedStorageServiceServer) SaveAnamnesis(context.Context, *SaveAnamnesisRequest) (*AnamnesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAnamnesis not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAnamnesis(context.Context, *GetByIdRequest) (*AnamnesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnamnesis not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAnamnesisHistory(context.Context, *GetByIdRequest) (*AnamnesisHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnamnesisHistory not implemented")
+}
+func (UnimplementedStorageServiceServer) GetClinicalAlertRules(context.Context, *GetClinicalAlertRulesRequest) (*GetClinicalAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClinicalAlertRules not implemented")
+}
+func (UnimplementedStorageServiceServer) AddClinicalAlertRule(context.Context, *ClinicalAlertRule) (*AddClinicalAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddClinicalAlertRule not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateClinicalAlertRule(context.Context, *ClinicalAlertRule) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClinicalAlertRule not implemented")
+}
+func (UnimplementedStorageServiceServer) DeleteClinicalAlertRule(context.Context, *DeleteRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClinicalAlertRule not implemented")
+}
+func (UnimplementedStorageServiceServer) GetMedicalRecord(context.Context, *GetByIdRequest) (*MedicalRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMedicalRecord not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDoctorPatientRelation(context.Context, *DoctorPatientRelationRequest) (*DoctorPatientRelation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorPatientRelation not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPatientAccessGrants(context.Context, *GetByIdRequest) (*GetPatientAccessGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientAccessGrants not implemented")
+}
+func (UnimplementedStorageServiceServer) AddPatientAccessGrant(context.Context, *PatientAccessGrant) (*AddPatientAccessGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPatientAccessGrant not implemented")
+}
+func (UnimplementedStorageServiceServer) RevokePatientAccessGrant(context.Context, *DeleteRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePatientAccessGrant not implemented")
+}
+func (UnimplementedStorageServiceServer) DeleteService(context.Context, *DeleteRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteService not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedStorageServiceServer) AddPriceListEntry(context.Context, *AddPriceListEntryRequest) (*AddPriceListEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPriceListEntry not implemented")
+}
+func (UnimplementedStorageServiceServer) DeletePriceListEntry(context.Context, *DeletePriceListEntryRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceListEntry not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPriceOnDate(context.Context, *GetPriceOnDateRequest) (*GetPriceOnDateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceOnDate not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDoctorOverrides(context.Context, *GetByIDRequest) (*GetDoctorOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorOverrides not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPatientDiagnoses(context.Context, *GetByIdRequest) (*GetPatientDiagnosesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientDiagnoses not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPatientVisits(context.Context, *GetByIdRequest) (*GetPatientVisitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientVisits not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPatientAllergiesChronics(context.Context, *GetByIdRequest) (*GetPatientAllergiesChronicsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientAllergiesChronics not implemented")
+}
+func (UnimplementedStorageServiceServer) GetICDCodes(context.Context, *GetICDCodesRequest) (*GetICDCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetICDCodes not implemented")
+}
+func (UnimplementedStorageServiceServer) AddPatientAllergiesChronics(context.Context, *AddPatientAllergiesChronicsRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPatientAllergiesChronics not implemented")
+}
+func (UnimplementedStorageServiceServer) AddPatientVisit(context.Context, *AddPatientVisitRequest) (*AddVisitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPatientVisit not implemented")
+}
+func (UnimplementedStorageServiceServer) AddVisitMaterials(context.Context, *AddVisitMaterialsRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVisitMaterials not implemented")
+}
+func (UnimplementedStorageServiceServer) AddVisitServices(context.Context, *AddVisitServicesRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVisitServices not implemented")
+}
+func (UnimplementedStorageServiceServer) AddPatientDiagnoses(context.Context, *AddPatientDiagnosesRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPatientDiagnoses not implemented")
+}
+func (UnimplementedStorageServiceServer) AddVisitPayment(context.Context, *VisitPaymentRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVisitPayment not implemented")
+}
+func (UnimplementedStorageServiceServer) UpdateVisitPayment(context.Context, *VisitPaymentRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVisitPayment not implemented")
+}
+func (UnimplementedStorageServiceServer) GetVisitByID(context.Context, *GetByIdRequest) (*GetVisitByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVisitByID not implemented")
+}
+func (UnimplementedStorageServiceServer) CalculateVisitTotal(context.Context, *CalculateVisitTotalRequest) (*CalculateVisitTotalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateVisitTotal not implemented")
+}
+func (UnimplementedStorageServiceServer) AddOrUpdateVisitPayment(context.Context, *AddOrUpdateVisitPaymentRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrUpdateVisitPayment not implemented")
+}
+func (UnimplementedStorageServiceServer) CompleteConsultation(context.Context, *CompleteConsultationRequest) (*CompleteConsultationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteConsultation not implemented")
+}
+func (UnimplementedStorageServiceServer) IssueInvoice(context.Context, *GetByIdRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueInvoice not implemented")
+}
+func (UnimplementedStorageServiceServer) GetInvoice(context.Context, *GetByIdRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (UnimplementedStorageServiceServer) SetInvoiceDiscount(context.Context, *SetInvoiceDiscountRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInvoiceDiscount not implemented")
+}
+func (UnimplementedStorageServiceServer) AddInvoicePayment(context.Context, *AddInvoicePaymentRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInvoicePayment not implemented")
+}
+func (UnimplementedStorageServiceServer) RefundInvoicePayment(context.Context, *RefundInvoicePaymentRequest) (*InvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundInvoicePayment not implemented")
+}
+func (UnimplementedStorageServiceServer) GetPatientBalance(context.Context, *GetByIdRequest) (*PatientBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientBalance not implemented")
+}
+func (UnimplementedStorageServiceServer) ReserveInvoiceDocument(context.Context, *GetByIdRequest) (*BillingDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveInvoiceDocument not implemented")
+}
+func (UnimplementedStorageServiceServer) ReserveReceiptDocument(context.Context, *GetByIdRequest) (*BillingDocument, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveReceiptDocument not implemented")
+}
+func (UnimplementedStorageServiceServer) AttachBillingDocument(context.Context, *AttachBillingDocumentRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachBillingDocument not implemented")
+}
+func (UnimplementedStorageServiceServer) GetVisitsPayments(context.Context, *GetVisitsPaymentsRequest) (*GetVisitsPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVisitsPayments not implemented")
+}
+func (UnimplementedStorageServiceServer) GetClinicOverrides(context.Context, *EmptyRequest) (*GetClinicOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClinicOverrides not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAppointments(context.Context, *GetAppointmentsRequest) (*GetAppointmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppointments not implemented")
+}
+func (UnimplementedStorageServiceServer) GetVisitMaterials(context.Context, *GetByIdRequest) (*GetVisitMaterialsAndServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVisitMaterials not implemented")
+}
+func (UnimplementedStorageServiceServer) GetVisitServices(context.Context, *GetByIdRequest) (*GetVisitMaterialsAndServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVisitServices not implemented")
+}
+func (UnimplementedStorageServiceServer) GetMaterialByID(context.Context, *GetByIdRequest) (*GetMaterialServiceByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaterialByID not implemented")
+}
+func (UnimplementedStorageServiceServer) GetServiceByID(context.Context, *GetByIdRequest) (*GetMaterialServiceByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceByID not implemented")
+}
+func (UnimplementedStorageServiceServer) GetTotalPatients(context.Context, *EmptyRequest) (*IntResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalPatients not implemented")
+}
+func (UnimplementedStorageServiceServer) GetTotalVisits(context.Context, *EmptyRequest) (*IntResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalVisits not implemented")
+}
+func (UnimplementedStorageServiceServer) GetTopServices(context.Context, *EmptyRequest) (*ServiceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopServices not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDoctorAvgVisit(context.Context, *EmptyRequest) (*DoctorAvgVisitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorAvgVisit not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDoctorAvgCheck(context.Context, *EmptyRequest) (*DoctorAvgCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorAvgCheck not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDoctorUniquePatient(context.Context, *EmptyRequest) (*DoctorUniquePatientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorUniquePatient not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAgeGroupStat(context.Context, *EmptyRequest) (*AgeGroupStatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgeGroupStat not implemented")
+}
+func (UnimplementedStorageServiceServer) GetNewPatientsThisMonth(context.Context, *EmptyRequest) (*IntResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNewPatientsThisMonth not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAvgVisitsPerPatient(context.Context, *EmptyRequest) (*FloatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvgVisitsPerPatient not implemented")
+}
+func (UnimplementedStorageServiceServer) GetTotalIncome(context.Context, *EmptyRequest) (*FloatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalIncome not implemented")
+}
+func (UnimplementedStorageServiceServer) GetMonthlyIncome(context.Context, *EmptyRequest) (*FloatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMonthlyIncome not implemented")
+}
+func (UnimplementedStorageServiceServer) GetClinicAverageCheck(context.Context, *EmptyRequest) (*FloatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClinicAverageCheck not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDiagnoseByVisitID(context.Context, *GetByIDRequest) (*GetDiagnoseByVisitIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiagnoseByVisitID not implemented")
+}
+func (UnimplementedStorageServiceServer) StartDocumentUpload(context.Context, *StartDocumentUploadRequest) (*StartDocumentUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDocumentUpload not implemented")
+}
+func (UnimplementedStorageServiceServer) UploadDocument(grpc.ClientStreamingServer[UploadDocumentChunk, DocumentUploadStatus]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadDocument not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDocumentUploadStatus(context.Context, *DocumentUploadStatusRequest) (*DocumentUploadStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentUploadStatus not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDocumentMetadata(context.Context, *GetDocumentMetadataRequest) (*GetDocumentMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentMetadata not implemented")
+}
+func (UnimplementedStorageServiceServer) DownloadDocument(*DownloadDocumentRequest, grpc.ServerStreamingServer[DownloadDocumentChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadDocument not implemented")
+}
+func (UnimplementedStorageServiceServer) GetDocumentsByPatientID(context.Context, *GetDocumentsRequest) (*GetDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentsByPatientID not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAdminByID(context.Context, *GetByIDRequest) (*GetAdminByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdminByID not implemented")
+}
+func (UnimplementedStorageServiceServer) AddAuditEntry(context.Context, *AuditEntry) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuditEntry not implemented")
+}
+func (UnimplementedStorageServiceServer) GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedStorageServiceServer) VerifyAuditLog(context.Context, *EmptyRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedStorageServiceServer) mustEmbedUnimplementedStorageServiceServer() {}
+func (UnimplementedStorageServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeStorageServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StorageServiceServer will
+// result in compilation errors.
+type UnsafeStorageServiceServer interface {
+	mustEmbedUnimplementedStorageServiceServer()
+}
+
+func RegisterStorageServiceServer(s grpc.ServiceRegistrar, srv StorageServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStorageServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StorageService_ServiceDesc, srv)
+}
+
+func _StorageService_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddUser(ctx, req.(*AddUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDoctorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddDoctor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddDoctor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddDoctor(ctx, req.(*AddDoctorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddAdmin(ctx, req.(*AddAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddPatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddPatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddPatient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddPatient(ctx, req.(*AddPatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetDoctors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDoctorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetDoctors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetDoctors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetDoctors(ctx, req.(*GetDoctorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetAdmins(ctx, req.(*GetAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetPatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPatientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetPatients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetPatients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetPatients(ctx, req.(*GetPatientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetDoctorSpecsByDoctorId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetDoctorSpecsByDoctorId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetDoctorSpecsByDoctorId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetDoctorSpecsByDoctorId(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDoctorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateDoctor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateDoctor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateDoctor(ctx, req.(*UpdateDoctorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddDoctorSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDoctorSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddDoctorSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddDoctorSpec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddDoctorSpec(ctx, req.(*AddDoctorSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_DeleteDoctorSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDoctorSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).DeleteDoctorSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_DeleteDoctorSpec_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).DeleteDoctorSpec(ctx, req.(*DeleteDoctorSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateAdmin(ctx, req.(*UpdateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAdminRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateAdminRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateAdminRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateAdminRole(ctx, req.(*UpdateAdminRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdatePatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePatientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdatePatient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdatePatient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdatePatient(ctx, req.(*UpdatePatientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).DeleteUser(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_RetireUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).RetireUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_RetireUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).RetireUser(ctx, req.(*ChangeUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).RestoreUser(ctx, req.(*ChangeUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateUserLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateUserLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateUserLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateUserLogin(ctx, req.(*UpdateUserLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetAllSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetAllSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetAllSpecs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetAllSpecs(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddUserRole(ctx, req.(*AddUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetUserByLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetUserByLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetUserByLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetUserByLogin(ctx, req.(*GetUserByLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateUserPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateUserPassword(ctx, req.(*UpdateUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetClinicWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetClinicWeeklySchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetClinicWeeklySchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetClinicWeeklySchedule(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetUserRole(ctx, req.(*GetUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetDoctorWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleByDoctorIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetDoctorWeeklySchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetDoctorWeeklySchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetDoctorWeeklySchedule(ctx, req.(*GetScheduleByDoctorIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateClinicWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClinicWeeklyScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateClinicWeeklySchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateClinicWeeklySchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateClinicWeeklySchedule(ctx, req.(*UpdateClinicWeeklyScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddDoctorWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDoctorWeeklyScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddDoctorWeeklySchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddDoctorWeeklySchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddDoctorWeeklySchedule(ctx, req.(*AddDoctorWeeklyScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateDoctorWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDoctorWeeklyScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateDoctorWeeklySchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateDoctorWeeklySchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateDoctorWeeklySchedule(ctx, req.(*UpdateDoctorWeeklyScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetRolePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRolePermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetRolePermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetRolePermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetRolePermission(ctx, req.(*GetRolePermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetDoctorsBySpecID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDoctorBySpecIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetDoctorsBySpecID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetDoctorsBySpecID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetDoctorsBySpecID(ctx, req.(*GetDoctorBySpecIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetAppointmentsByDoctorID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppointmentsByDoctorIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetAppointmentsByDoctorID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetAppointmentsByDoctorID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetAppointmentsByDoctorID(ctx, req.(*GetAppointmentsByDoctorIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetPatientByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetPatientByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetPatientByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetPatientByID(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddAppointment(ctx, req.(*AddAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetAppointmentsByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetAppointmentsByUserID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetAppointmentsByUserID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetAppointmentsByUserID(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetSpecsByDoctorID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetSpecsByDoctorID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetSpecsByDoctorID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetSpecsByDoctorID(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetDoctorByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetDoctorByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetDoctorByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetDoctorByID(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppointmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateAppointment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateAppointment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateAppointment(ctx, req.(*UpdateAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetAppointmentByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetAppointmentByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetAppointmentByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetAppointmentByID(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_HoldAppointmentSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldAppointmentSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).HoldAppointmentSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_HoldAppointmentSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).HoldAppointmentSlot(ctx, req.(*HoldAppointmentSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_ReleaseAppointmentSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseAppointmentSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).ReleaseAppointmentSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_ReleaseAppointmentSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).ReleaseAppointmentSlot(ctx, req.(*ReleaseAppointmentSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetAppointmentSlotHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppointmentsByDoctorIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetAppointmentSlotHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetAppointmentSlotHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetAppointmentSlotHolds(ctx, req.(*GetAppointmentsByDoctorIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddClinicDailyOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddClinicDailyOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddClinicDailyOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddClinicDailyOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddClinicDailyOverride(ctx, req.(*AddClinicDailyOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddDoctorDailyOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDoctorDailyOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddDoctorDailyOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddDoctorDailyOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddDoctorDailyOverride(ctx, req.(*AddDoctorDailyOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetClinicOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClinicOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetClinicOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetClinicOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetClinicOverride(ctx, req.(*GetClinicOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetDoctorOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDoctorOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetDoctorOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetDoctorOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetDoctorOverride(ctx, req.(*GetDoctorOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetClinicSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetClinicSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetClinicSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetClinicSettings(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateClinicTimeZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClinicTimeZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateClinicTimeZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateClinicTimeZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateClinicTimeZone(ctx, req.(*UpdateClinicTimeZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddWaitingListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWaitingListEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddWaitingListEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddWaitingListEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddWaitingListEntry(ctx, req.(*AddWaitingListEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetWaitingListByPatientID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetWaitingListByPatientID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetWaitingListByPatientID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetWaitingListByPatientID(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_CancelWaitingListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelWaitingListEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).CancelWaitingListEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_CancelWaitingListEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).CancelWaitingListEntry(ctx, req.(*CancelWaitingListEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AcceptWaitingListOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptWaitingListOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AcceptWaitingListOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AcceptWaitingListOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AcceptWaitingListOffer(ctx, req.(*AcceptWaitingListOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_DeclineWaitingListOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineWaitingListOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).DeclineWaitingListOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_DeclineWaitingListOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).DeclineWaitingListOffer(ctx, req.(*DeclineWaitingListOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetScheduleRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetScheduleRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetScheduleRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetScheduleRules(ctx, req.(*GetScheduleRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddScheduleRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddScheduleRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddScheduleRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddScheduleRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddScheduleRule(ctx, req.(*AddScheduleRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_ImportScheduleRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportScheduleRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).ImportScheduleRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_ImportScheduleRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).ImportScheduleRules(ctx, req.(*ImportScheduleRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_DeleteScheduleRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).DeleteScheduleRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_DeleteScheduleRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).DeleteScheduleRule(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_ClaimNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).ClaimNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_ClaimNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).ClaimNotifications(ctx, req.(*ClaimNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_CompleteNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).CompleteNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_CompleteNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).CompleteNotification(ctx, req.(*CompleteNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetPatientNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetPatientNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetPatientNotificationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetPatientNotificationSettings(ctx, req.(*GetByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdatePatientNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatientNotificationSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdatePatientNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdatePatientNotificationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdatePatientNotificationSettings(ctx, req.(*PatientNotificationSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddMaterial(ctx, req.(*AddMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddService(ctx, req.(*AddServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaterialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateMaterial(ctx, req.(*UpdateMaterialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateService(ctx, req.(*UpdateServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetMaterials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetMaterials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetMaterials(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetServices(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetServicesTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetServicesTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetServicesTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetServicesTypes(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetServiceTypeById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceTypeByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetServiceTypeById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetServiceTypeById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetServiceTypeById(ctx, req.(*GetServiceTypeByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_DeleteMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).DeleteMaterial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_DeleteMaterial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).DeleteMaterial(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_ReceiveMaterialBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveMaterialBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).ReceiveMaterialBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_ReceiveMaterialBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).ReceiveMaterialBatch(ctx, req.(*ReceiveMaterialBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AdjustMaterialStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustMaterialStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AdjustMaterialStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AdjustMaterialStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AdjustMaterialStock(ctx, req.(*AdjustMaterialStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetMaterialStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetMaterialStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetMaterialStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetMaterialStock(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_GetStockReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetStockReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetStockReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetStockReport(ctx, req.(*StockReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_CreateTreatmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTreatmentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).CreateTreatmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_CreateTreatmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).CreateTreatmentPlan(ctx, req.(*CreateTreatmentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_AddTreatmentPlanItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTreatmentPlanItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddTreatmentPlanItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddTreatmentPlanItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddTreatmentPlanItems(ctx, req.(*AddTreatmentPlanItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StorageService_UpdateTreatmentPlanItemStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTreatmentPlanItemStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).UpdateTreatmentPlanItemStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_UpdateTreatmentPlanItemStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).UpdateTreatmentPlanItemStatus(ctx, req.(*UpdateTreatmentPlanItemStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStorageServiceServer) GetVisitMaterials(context.Context, *GetByIdRequest) (*GetVisitMaterialsAndServicesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVisitMaterials not implemented")
+
+func _StorageService_AcceptTreatmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTreatmentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AcceptTreatmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AcceptTreatmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AcceptTreatmentPlan(ctx, req.(*AcceptTreatmentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStorageServiceServer) GetVisitServices(context.Context, *GetByIdRequest) (*GetVisitMaterialsAndServicesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVisitServices not implemented")
+
+func _StorageService_CancelTreatmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).CancelTreatmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_CancelTreatmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).CancelTreatmentPlan(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStorageServiceServer) GetMaterialByID(context.Context, *GetByIdRequest) (*GetMaterialServiceByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMaterialByID not implemented")
+
+func _StorageService_GetTreatmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetTreatmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetTreatmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetTreatmentPlan(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStorageServiceServer) GetServiceByID(context.Context, *GetByIdRequest) (*GetMaterialServiceByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetServiceByID not implemented")
+
+func _StorageService_GetTreatmentPlanByItemID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetTreatmentPlanByItemID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetTreatmentPlanByItemID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetTreatmentPlanByItemID(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStorageServiceServer) mustEmbedUnimplementedStorageServiceServer() {}
-func (UnimplementedStorageServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeStorageServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to StorageServiceServer will
-// result in compilation errors.
-type UnsafeStorageServiceServer interface {
-	mustEmbedUnimplementedStorageServiceServer()
+func _StorageService_GetPatientTreatmentPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).GetPatientTreatmentPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_GetPatientTreatmentPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).GetPatientTreatmentPlans(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterStorageServiceServer(s grpc.ServiceRegistrar, srv StorageServiceServer) {
-	// If the following call pancis, it indicates UnimplementedStorageServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _StorageService_RecordToothStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordToothStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&StorageService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(StorageServiceServer).RecordToothStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_RecordToothStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).RecordToothStates(ctx, req.(*RecordToothStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddUserRequest)
+func _StorageService_GetDentalChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddUser(ctx, in)
+		return srv.(StorageServiceServer).GetDentalChart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddUser_FullMethodName,
+		FullMethod: StorageService_GetDentalChart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddUser(ctx, req.(*AddUserRequest))
+		return srv.(StorageServiceServer).GetDentalChart(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDoctorRequest)
+func _StorageService_GetToothHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetToothHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddDoctor(ctx, in)
+		return srv.(StorageServiceServer).GetToothHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddDoctor_FullMethodName,
+		FullMethod: StorageService_GetToothHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddDoctor(ctx, req.(*AddDoctorRequest))
+		return srv.(StorageServiceServer).GetToothHistory(ctx, req.(*GetToothHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddAdminRequest)
+func _StorageService_SaveAnamnesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveAnamnesisRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddAdmin(ctx, in)
+		return srv.(StorageServiceServer).SaveAnamnesis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddAdmin_FullMethodName,
+		FullMethod: StorageService_SaveAnamnesis_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddAdmin(ctx, req.(*AddAdminRequest))
+		return srv.(StorageServiceServer).SaveAnamnesis(ctx, req.(*SaveAnamnesisRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddPatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPatientRequest)
+func _StorageService_GetAnamnesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddPatient(ctx, in)
+		return srv.(StorageServiceServer).GetAnamnesis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddPatient_FullMethodName,
+		FullMethod: StorageService_GetAnamnesis_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddPatient(ctx, req.(*AddPatientRequest))
+		return srv.(StorageServiceServer).GetAnamnesis(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetDoctors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _StorageService_GetAnamnesisHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetDoctors(ctx, in)
+		return srv.(StorageServiceServer).GetAnamnesisHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetDoctors_FullMethodName,
+		FullMethod: StorageService_GetAnamnesisHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetDoctors(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetAnamnesisHistory(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _StorageService_GetClinicalAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClinicalAlertRulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetAdmins(ctx, in)
+		return srv.(StorageServiceServer).GetClinicalAlertRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetAdmins_FullMethodName,
+		FullMethod: StorageService_GetClinicalAlertRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetAdmins(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetClinicalAlertRules(ctx, req.(*GetClinicalAlertRulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetPatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _StorageService_AddClinicalAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClinicalAlertRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetPatients(ctx, in)
+		return srv.(StorageServiceServer).AddClinicalAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetPatients_FullMethodName,
+		FullMethod: StorageService_AddClinicalAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetPatients(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).AddClinicalAlertRule(ctx, req.(*ClinicalAlertRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetDoctorSpecsByDoctorId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIdRequest)
+func _StorageService_UpdateClinicalAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClinicalAlertRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetDoctorSpecsByDoctorId(ctx, in)
+		return srv.(StorageServiceServer).UpdateClinicalAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetDoctorSpecsByDoctorId_FullMethodName,
+		FullMethod: StorageService_UpdateClinicalAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetDoctorSpecsByDoctorId(ctx, req.(*GetByIdRequest))
+		return srv.(StorageServiceServer).UpdateClinicalAlertRule(ctx, req.(*ClinicalAlertRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdateDoctor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDoctorRequest)
+func _StorageService_DeleteClinicalAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdateDoctor(ctx, in)
+		return srv.(StorageServiceServer).DeleteClinicalAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdateDoctor_FullMethodName,
+		FullMethod: StorageService_DeleteClinicalAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdateDoctor(ctx, req.(*UpdateDoctorRequest))
+		return srv.(StorageServiceServer).DeleteClinicalAlertRule(ctx, req.(*DeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddDoctorSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDoctorSpecRequest)
+func _StorageService_GetMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddDoctorSpec(ctx, in)
+		return srv.(StorageServiceServer).GetMedicalRecord(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddDoctorSpec_FullMethodName,
+		FullMethod: StorageService_GetMedicalRecord_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddDoctorSpec(ctx, req.(*AddDoctorSpecRequest))
+		return srv.(StorageServiceServer).GetMedicalRecord(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_DeleteDoctorSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDoctorSpecRequest)
+func _StorageService_GetDoctorPatientRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoctorPatientRelationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).DeleteDoctorSpec(ctx, in)
+		return srv.(StorageServiceServer).GetDoctorPatientRelation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_DeleteDoctorSpec_FullMethodName,
+		FullMethod: StorageService_GetDoctorPatientRelation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).DeleteDoctorSpec(ctx, req.(*DeleteDoctorSpecRequest))
+		return srv.(StorageServiceServer).GetDoctorPatientRelation(ctx, req.(*DoctorPatientRelationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAdminRequest)
+func _StorageService_GetPatientAccessGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdateAdmin(ctx, in)
+		return srv.(StorageServiceServer).GetPatientAccessGrants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdateAdmin_FullMethodName,
+		FullMethod: StorageService_GetPatientAccessGrants_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdateAdmin(ctx, req.(*UpdateAdminRequest))
+		return srv.(StorageServiceServer).GetPatientAccessGrants(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdateAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAdminRoleRequest)
+func _StorageService_AddPatientAccessGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatientAccessGrant)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdateAdminRole(ctx, in)
+		return srv.(StorageServiceServer).AddPatientAccessGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdateAdminRole_FullMethodName,
+		FullMethod: StorageService_AddPatientAccessGrant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdateAdminRole(ctx, req.(*UpdateAdminRoleRequest))
+		return srv.(StorageServiceServer).AddPatientAccessGrant(ctx, req.(*PatientAccessGrant))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdatePatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePatientRequest)
+func _StorageService_RevokePatientAccessGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdatePatient(ctx, in)
+		return srv.(StorageServiceServer).RevokePatientAccessGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdatePatient_FullMethodName,
+		FullMethod: StorageService_RevokePatientAccessGrant_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdatePatient(ctx, req.(*UpdatePatientRequest))
+		return srv.(StorageServiceServer).RevokePatientAccessGrant(ctx, req.(*DeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _StorageService_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).DeleteUser(ctx, in)
+		return srv.(StorageServiceServer).DeleteService(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_DeleteUser_FullMethodName,
+		FullMethod: StorageService_DeleteService_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).DeleteUser(ctx, req.(*DeleteRequest))
+		return srv.(StorageServiceServer).DeleteService(ctx, req.(*DeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdateUserLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserLoginRequest)
+func _StorageService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdateUserLogin(ctx, in)
+		return srv.(StorageServiceServer).GetPriceHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdateUserLogin_FullMethodName,
+		FullMethod: StorageService_GetPriceHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdateUserLogin(ctx, req.(*UpdateUserLoginRequest))
+		return srv.(StorageServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetAllSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _StorageService_AddPriceListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPriceListEntryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetAllSpecs(ctx, in)
+		return srv.(StorageServiceServer).AddPriceListEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetAllSpecs_FullMethodName,
+		FullMethod: StorageService_AddPriceListEntry_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetAllSpecs(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).AddPriceListEntry(ctx, req.(*AddPriceListEntryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddUserRoleRequest)
+func _StorageService_DeletePriceListEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceListEntryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddUserRole(ctx, in)
+		return srv.(StorageServiceServer).DeletePriceListEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddUserRole_FullMethodName,
+		FullMethod: StorageService_DeletePriceListEntry_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddUserRole(ctx, req.(*AddUserRoleRequest))
+		return srv.(StorageServiceServer).DeletePriceListEntry(ctx, req.(*DeletePriceListEntryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetUserByLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserByLoginRequest)
+func _StorageService_GetPriceOnDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceOnDateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetUserByLogin(ctx, in)
+		return srv.(StorageServiceServer).GetPriceOnDate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetUserByLogin_FullMethodName,
+		FullMethod: StorageService_GetPriceOnDate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetUserByLogin(ctx, req.(*GetUserByLoginRequest))
+		return srv.(StorageServiceServer).GetPriceOnDate(ctx, req.(*GetPriceOnDateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdateUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserPasswordRequest)
+func _StorageService_GetDoctorOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdateUserPassword(ctx, in)
+		return srv.(StorageServiceServer).GetDoctorOverrides(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdateUserPassword_FullMethodName,
+		FullMethod: StorageService_GetDoctorOverrides_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdateUserPassword(ctx, req.(*UpdateUserPasswordRequest))
+		return srv.(StorageServiceServer).GetDoctorOverrides(ctx, req.(*GetByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetClinicWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _StorageService_GetPatientDiagnoses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetClinicWeeklySchedule(ctx, in)
+		return srv.(StorageServiceServer).GetPatientDiagnoses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetClinicWeeklySchedule_FullMethodName,
+		FullMethod: StorageService_GetPatientDiagnoses_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetClinicWeeklySchedule(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetPatientDiagnoses(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRoleRequest)
+func _StorageService_GetPatientVisits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetUserRole(ctx, in)
+		return srv.(StorageServiceServer).GetPatientVisits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetUserRole_FullMethodName,
+		FullMethod: StorageService_GetPatientVisits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetUserRole(ctx, req.(*GetUserRoleRequest))
+		return srv.(StorageServiceServer).GetPatientVisits(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetDoctorWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetScheduleByDoctorIdRequest)
+func _StorageService_GetPatientAllergiesChronics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetDoctorWeeklySchedule(ctx, in)
+		return srv.(StorageServiceServer).GetPatientAllergiesChronics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetDoctorWeeklySchedule_FullMethodName,
+		FullMethod: StorageService_GetPatientAllergiesChronics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetDoctorWeeklySchedule(ctx, req.(*GetScheduleByDoctorIdRequest))
+		return srv.(StorageServiceServer).GetPatientAllergiesChronics(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdateClinicWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateClinicWeeklyScheduleRequest)
+func _StorageService_GetICDCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetICDCodesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdateClinicWeeklySchedule(ctx, in)
+		return srv.(StorageServiceServer).GetICDCodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdateClinicWeeklySchedule_FullMethodName,
+		FullMethod: StorageService_GetICDCodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdateClinicWeeklySchedule(ctx, req.(*UpdateClinicWeeklyScheduleRequest))
+		return srv.(StorageServiceServer).GetICDCodes(ctx, req.(*GetICDCodesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddDoctorWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDoctorWeeklyScheduleRequest)
+func _StorageService_AddPatientAllergiesChronics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPatientAllergiesChronicsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddDoctorWeeklySchedule(ctx, in)
+		return srv.(StorageServiceServer).AddPatientAllergiesChronics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddDoctorWeeklySchedule_FullMethodName,
+		FullMethod: StorageService_AddPatientAllergiesChronics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddDoctorWeeklySchedule(ctx, req.(*AddDoctorWeeklyScheduleRequest))
+		return srv.(StorageServiceServer).AddPatientAllergiesChronics(ctx, req.(*AddPatientAllergiesChronicsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdateDoctorWeeklySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDoctorWeeklyScheduleRequest)
+func _StorageService_AddPatientVisit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPatientVisitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdateDoctorWeeklySchedule(ctx, in)
+		return srv.(StorageServiceServer).AddPatientVisit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdateDoctorWeeklySchedule_FullMethodName,
+		FullMethod: StorageService_AddPatientVisit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdateDoctorWeeklySchedule(ctx, req.(*UpdateDoctorWeeklyScheduleRequest))
+		return srv.(StorageServiceServer).AddPatientVisit(ctx, req.(*AddPatientVisitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetRolePermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRolePermissionRequest)
+func _StorageService_AddVisitMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVisitMaterialsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetRolePermission(ctx, in)
+		return srv.(StorageServiceServer).AddVisitMaterials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetRolePermission_FullMethodName,
+		FullMethod: StorageService_AddVisitMaterials_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetRolePermission(ctx, req.(*GetRolePermissionRequest))
+		return srv.(StorageServiceServer).AddVisitMaterials(ctx, req.(*AddVisitMaterialsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetDoctorsBySpecID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDoctorBySpecIDRequest)
+func _StorageService_AddVisitServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVisitServicesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetDoctorsBySpecID(ctx, in)
+		return srv.(StorageServiceServer).AddVisitServices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetDoctorsBySpecID_FullMethodName,
+		FullMethod: StorageService_AddVisitServices_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetDoctorsBySpecID(ctx, req.(*GetDoctorBySpecIDRequest))
+		return srv.(StorageServiceServer).AddVisitServices(ctx, req.(*AddVisitServicesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetAppointmentsByDoctorID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppointmentsByDoctorIDRequest)
+func _StorageService_AddPatientDiagnoses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPatientDiagnosesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetAppointmentsByDoctorID(ctx, in)
+		return srv.(StorageServiceServer).AddPatientDiagnoses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetAppointmentsByDoctorID_FullMethodName,
+		FullMethod: StorageService_AddPatientDiagnoses_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetAppointmentsByDoctorID(ctx, req.(*GetAppointmentsByDoctorIDRequest))
+		return srv.(StorageServiceServer).AddPatientDiagnoses(ctx, req.(*AddPatientDiagnosesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddAppointmentRequest)
+func _StorageService_AddVisitPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddAppointment(ctx, in)
+		return srv.(StorageServiceServer).AddVisitPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddAppointment_FullMethodName,
+		FullMethod: StorageService_AddVisitPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddAppointment(ctx, req.(*AddAppointmentRequest))
+		return srv.(StorageServiceServer).AddVisitPayment(ctx, req.(*VisitPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetAppointmentsByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIDRequest)
+func _StorageService_UpdateVisitPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisitPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetAppointmentsByUserID(ctx, in)
+		return srv.(StorageServiceServer).UpdateVisitPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetAppointmentsByUserID_FullMethodName,
+		FullMethod: StorageService_UpdateVisitPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetAppointmentsByUserID(ctx, req.(*GetByIDRequest))
+		return srv.(StorageServiceServer).UpdateVisitPayment(ctx, req.(*VisitPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetSpecsByDoctorID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIDRequest)
+func _StorageService_GetVisitByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetSpecsByDoctorID(ctx, in)
+		return srv.(StorageServiceServer).GetVisitByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetSpecsByDoctorID_FullMethodName,
+		FullMethod: StorageService_GetVisitByID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetSpecsByDoctorID(ctx, req.(*GetByIDRequest))
+		return srv.(StorageServiceServer).GetVisitByID(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetDoctorByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIDRequest)
+func _StorageService_CalculateVisitTotal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateVisitTotalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetDoctorByID(ctx, in)
+		return srv.(StorageServiceServer).CalculateVisitTotal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetDoctorByID_FullMethodName,
+		FullMethod: StorageService_CalculateVisitTotal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetDoctorByID(ctx, req.(*GetByIDRequest))
+		return srv.(StorageServiceServer).CalculateVisitTotal(ctx, req.(*CalculateVisitTotalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdateAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppointmentRequest)
+func _StorageService_AddOrUpdateVisitPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrUpdateVisitPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdateAppointment(ctx, in)
+		return srv.(StorageServiceServer).AddOrUpdateVisitPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdateAppointment_FullMethodName,
+		FullMethod: StorageService_AddOrUpdateVisitPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdateAppointment(ctx, req.(*UpdateAppointmentRequest))
+		return srv.(StorageServiceServer).AddOrUpdateVisitPayment(ctx, req.(*AddOrUpdateVisitPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetPatientByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIDRequest)
+func _StorageService_CompleteConsultation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteConsultationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetPatientByID(ctx, in)
+		return srv.(StorageServiceServer).CompleteConsultation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetPatientByID_FullMethodName,
+		FullMethod: StorageService_CompleteConsultation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetPatientByID(ctx, req.(*GetByIDRequest))
+		return srv.(StorageServiceServer).CompleteConsultation(ctx, req.(*CompleteConsultationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetAppointmentByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIDRequest)
+func _StorageService_IssueInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetAppointmentByID(ctx, in)
+		return srv.(StorageServiceServer).IssueInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetAppointmentByID_FullMethodName,
+		FullMethod: StorageService_IssueInvoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetAppointmentByID(ctx, req.(*GetByIDRequest))
+		return srv.(StorageServiceServer).IssueInvoice(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddClinicDailyOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddClinicDailyOverrideRequest)
+func _StorageService_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddClinicDailyOverride(ctx, in)
+		return srv.(StorageServiceServer).GetInvoice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddClinicDailyOverride_FullMethodName,
+		FullMethod: StorageService_GetInvoice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddClinicDailyOverride(ctx, req.(*AddClinicDailyOverrideRequest))
+		return srv.(StorageServiceServer).GetInvoice(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddDoctorDailyOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddDoctorDailyOverrideRequest)
+func _StorageService_SetInvoiceDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInvoiceDiscountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddDoctorDailyOverride(ctx, in)
+		return srv.(StorageServiceServer).SetInvoiceDiscount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddDoctorDailyOverride_FullMethodName,
+		FullMethod: StorageService_SetInvoiceDiscount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddDoctorDailyOverride(ctx, req.(*AddDoctorDailyOverrideRequest))
+		return srv.(StorageServiceServer).SetInvoiceDiscount(ctx, req.(*SetInvoiceDiscountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetClinicOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetClinicOverrideRequest)
+func _StorageService_AddInvoicePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInvoicePaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetClinicOverride(ctx, in)
+		return srv.(StorageServiceServer).AddInvoicePayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetClinicOverride_FullMethodName,
+		FullMethod: StorageService_AddInvoicePayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetClinicOverride(ctx, req.(*GetClinicOverrideRequest))
+		return srv.(StorageServiceServer).AddInvoicePayment(ctx, req.(*AddInvoicePaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetDoctorOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDoctorOverrideRequest)
+func _StorageService_RefundInvoicePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundInvoicePaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetDoctorOverride(ctx, in)
+		return srv.(StorageServiceServer).RefundInvoicePayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetDoctorOverride_FullMethodName,
+		FullMethod: StorageService_RefundInvoicePayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetDoctorOverride(ctx, req.(*GetDoctorOverrideRequest))
+		return srv.(StorageServiceServer).RefundInvoicePayment(ctx, req.(*RefundInvoicePaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddMaterialRequest)
+func _StorageService_GetPatientBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddMaterial(ctx, in)
+		return srv.(StorageServiceServer).GetPatientBalance(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddMaterial_FullMethodName,
+		FullMethod: StorageService_GetPatientBalance_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddMaterial(ctx, req.(*AddMaterialRequest))
+		return srv.(StorageServiceServer).GetPatientBalance(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddServiceRequest)
+func _StorageService_ReserveInvoiceDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddService(ctx, in)
+		return srv.(StorageServiceServer).ReserveInvoiceDocument(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddService_FullMethodName,
+		FullMethod: StorageService_ReserveInvoiceDocument_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddService(ctx, req.(*AddServiceRequest))
+		return srv.(StorageServiceServer).ReserveInvoiceDocument(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdateMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMaterialRequest)
+func _StorageService_ReserveReceiptDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdateMaterial(ctx, in)
+		return srv.(StorageServiceServer).ReserveReceiptDocument(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdateMaterial_FullMethodName,
+		FullMethod: StorageService_ReserveReceiptDocument_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdateMaterial(ctx, req.(*UpdateMaterialRequest))
+		return srv.(StorageServiceServer).ReserveReceiptDocument(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateServiceRequest)
+func _StorageService_AttachBillingDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachBillingDocumentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdateService(ctx, in)
+		return srv.(StorageServiceServer).AttachBillingDocument(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdateService_FullMethodName,
+		FullMethod: StorageService_AttachBillingDocument_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdateService(ctx, req.(*UpdateServiceRequest))
+		return srv.(StorageServiceServer).AttachBillingDocument(ctx, req.(*AttachBillingDocumentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _StorageService_GetVisitsPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVisitsPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetMaterials(ctx, in)
+		return srv.(StorageServiceServer).GetVisitsPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetMaterials_FullMethodName,
+		FullMethod: StorageService_GetVisitsPayments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetMaterials(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetVisitsPayments(ctx, req.(*GetVisitsPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _StorageService_GetClinicOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetServices(ctx, in)
+		return srv.(StorageServiceServer).GetClinicOverrides(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetServices_FullMethodName,
+		FullMethod: StorageService_GetClinicOverrides_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetServices(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetClinicOverrides(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetServicesTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _StorageService_GetAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppointmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetServicesTypes(ctx, in)
+		return srv.(StorageServiceServer).GetAppointments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetServicesTypes_FullMethodName,
+		FullMethod: StorageService_GetAppointments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetServicesTypes(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetAppointments(ctx, req.(*GetAppointmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetServiceTypeById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetServiceTypeByIdRequest)
+func _StorageService_GetVisitMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetServiceTypeById(ctx, in)
+		return srv.(StorageServiceServer).GetVisitMaterials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetServiceTypeById_FullMethodName,
+		FullMethod: StorageService_GetVisitMaterials_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetServiceTypeById(ctx, req.(*GetServiceTypeByIdRequest))
+		return srv.(StorageServiceServer).GetVisitMaterials(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_DeleteMaterial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _StorageService_GetVisitServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).DeleteMaterial(ctx, in)
+		return srv.(StorageServiceServer).GetVisitServices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_DeleteMaterial_FullMethodName,
+		FullMethod: StorageService_GetVisitServices_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).DeleteMaterial(ctx, req.(*DeleteRequest))
+		return srv.(StorageServiceServer).GetVisitServices(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _StorageService_GetMaterialByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).DeleteService(ctx, in)
+		return srv.(StorageServiceServer).GetMaterialByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_DeleteService_FullMethodName,
+		FullMethod: StorageService_GetMaterialByID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).DeleteService(ctx, req.(*DeleteRequest))
+		return srv.(StorageServiceServer).GetMaterialByID(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetDoctorOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIDRequest)
+func _StorageService_GetServiceByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetDoctorOverrides(ctx, in)
+		return srv.(StorageServiceServer).GetServiceByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetDoctorOverrides_FullMethodName,
+		FullMethod: StorageService_GetServiceByID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetDoctorOverrides(ctx, req.(*GetByIDRequest))
+		return srv.(StorageServiceServer).GetServiceByID(ctx, req.(*GetByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetPatientDiagnoses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIdRequest)
+func _StorageService_GetTotalPatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetPatientDiagnoses(ctx, in)
+		return srv.(StorageServiceServer).GetTotalPatients(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetPatientDiagnoses_FullMethodName,
+		FullMethod: StorageService_GetTotalPatients_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetPatientDiagnoses(ctx, req.(*GetByIdRequest))
+		return srv.(StorageServiceServer).GetTotalPatients(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetPatientVisits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIdRequest)
+func _StorageService_GetTotalVisits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetPatientVisits(ctx, in)
+		return srv.(StorageServiceServer).GetTotalVisits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetPatientVisits_FullMethodName,
+		FullMethod: StorageService_GetTotalVisits_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetPatientVisits(ctx, req.(*GetByIdRequest))
+		return srv.(StorageServiceServer).GetTotalVisits(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetPatientAllergiesChronics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIdRequest)
+func _StorageService_GetTopServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetPatientAllergiesChronics(ctx, in)
+		return srv.(StorageServiceServer).GetTopServices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetPatientAllergiesChronics_FullMethodName,
+		FullMethod: StorageService_GetTopServices_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetPatientAllergiesChronics(ctx, req.(*GetByIdRequest))
+		return srv.(StorageServiceServer).GetTopServices(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetICDCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _StorageService_GetDoctorAvgVisit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetICDCodes(ctx, in)
+		return srv.(StorageServiceServer).GetDoctorAvgVisit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetICDCodes_FullMethodName,
+		FullMethod: StorageService_GetDoctorAvgVisit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetICDCodes(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetDoctorAvgVisit(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddPatientAllergiesChronics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPatientAllergiesChronicsRequest)
+func _StorageService_GetDoctorAvgCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddPatientAllergiesChronics(ctx, in)
+		return srv.(StorageServiceServer).GetDoctorAvgCheck(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddPatientAllergiesChronics_FullMethodName,
+		FullMethod: StorageService_GetDoctorAvgCheck_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddPatientAllergiesChronics(ctx, req.(*AddPatientAllergiesChronicsRequest))
+		return srv.(StorageServiceServer).GetDoctorAvgCheck(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddPatientVisit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPatientVisitRequest)
+func _StorageService_GetDoctorUniquePatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddPatientVisit(ctx, in)
+		return srv.(StorageServiceServer).GetDoctorUniquePatient(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddPatientVisit_FullMethodName,
+		FullMethod: StorageService_GetDoctorUniquePatient_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddPatientVisit(ctx, req.(*AddPatientVisitRequest))
+		return srv.(StorageServiceServer).GetDoctorUniquePatient(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddVisitMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddVisitMaterialsRequest)
+func _StorageService_GetAgeGroupStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddVisitMaterials(ctx, in)
+		return srv.(StorageServiceServer).GetAgeGroupStat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddVisitMaterials_FullMethodName,
+		FullMethod: StorageService_GetAgeGroupStat_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddVisitMaterials(ctx, req.(*AddVisitMaterialsRequest))
+		return srv.(StorageServiceServer).GetAgeGroupStat(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddVisitServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddVisitServicesRequest)
+func _StorageService_GetNewPatientsThisMonth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddVisitServices(ctx, in)
+		return srv.(StorageServiceServer).GetNewPatientsThisMonth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddVisitServices_FullMethodName,
+		FullMethod: StorageService_GetNewPatientsThisMonth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddVisitServices(ctx, req.(*AddVisitServicesRequest))
+		return srv.(StorageServiceServer).GetNewPatientsThisMonth(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddPatientDiagnoses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPatientDiagnosesRequest)
+func _StorageService_GetAvgVisitsPerPatient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddPatientDiagnoses(ctx, in)
+		return srv.(StorageServiceServer).GetAvgVisitsPerPatient(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddPatientDiagnoses_FullMethodName,
+		FullMethod: StorageService_GetAvgVisitsPerPatient_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddPatientDiagnoses(ctx, req.(*AddPatientDiagnosesRequest))
+		return srv.(StorageServiceServer).GetAvgVisitsPerPatient(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddVisitPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VisitPaymentRequest)
+func _StorageService_GetTotalIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddVisitPayment(ctx, in)
+		return srv.(StorageServiceServer).GetTotalIncome(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddVisitPayment_FullMethodName,
+		FullMethod: StorageService_GetTotalIncome_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddVisitPayment(ctx, req.(*VisitPaymentRequest))
+		return srv.(StorageServiceServer).GetTotalIncome(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_UpdateVisitPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VisitPaymentRequest)
+func _StorageService_GetMonthlyIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).UpdateVisitPayment(ctx, in)
+		return srv.(StorageServiceServer).GetMonthlyIncome(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_UpdateVisitPayment_FullMethodName,
+		FullMethod: StorageService_GetMonthlyIncome_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).UpdateVisitPayment(ctx, req.(*VisitPaymentRequest))
+		return srv.(StorageServiceServer).GetMonthlyIncome(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetVisitByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIdRequest)
+func _StorageService_GetClinicAverageCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetVisitByID(ctx, in)
+		return srv.(StorageServiceServer).GetClinicAverageCheck(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetVisitByID_FullMethodName,
+		FullMethod: StorageService_GetClinicAverageCheck_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetVisitByID(ctx, req.(*GetByIdRequest))
+		return srv.(StorageServiceServer).GetClinicAverageCheck(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_CalculateVisitTotal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalculateVisitTotalRequest)
+func _StorageService_GetDiagnoseByVisitID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).CalculateVisitTotal(ctx, in)
+		return srv.(StorageServiceServer).GetDiagnoseByVisitID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_CalculateVisitTotal_FullMethodName,
+		FullMethod: StorageService_GetDiagnoseByVisitID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).CalculateVisitTotal(ctx, req.(*CalculateVisitTotalRequest))
+		return srv.(StorageServiceServer).GetDiagnoseByVisitID(ctx, req.(*GetByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_AddOrUpdateVisitPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddOrUpdateVisitPaymentRequest)
+func _StorageService_StartDocumentUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDocumentUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).AddOrUpdateVisitPayment(ctx, in)
+		return srv.(StorageServiceServer).StartDocumentUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_AddOrUpdateVisitPayment_FullMethodName,
+		FullMethod: StorageService_StartDocumentUpload_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).AddOrUpdateVisitPayment(ctx, req.(*AddOrUpdateVisitPaymentRequest))
+		return srv.(StorageServiceServer).StartDocumentUpload(ctx, req.(*StartDocumentUploadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetVisitsPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _StorageService_UploadDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(StorageServiceServer).UploadDocument(&grpc.GenericServerStream[UploadDocumentChunk, DocumentUploadStatus]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageService_UploadDocumentServer = grpc.ClientStreamingServer[UploadDocumentChunk, DocumentUploadStatus]
+
+func _StorageService_GetDocumentUploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DocumentUploadStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetVisitsPayments(ctx, in)
+		return srv.(StorageServiceServer).GetDocumentUploadStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetVisitsPayments_FullMethodName,
+		FullMethod: StorageService_GetDocumentUploadStatus_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetVisitsPayments(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetDocumentUploadStatus(ctx, req.(*DocumentUploadStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetClinicOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _StorageService_GetDocumentMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentMetadataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetClinicOverrides(ctx, in)
+		return srv.(StorageServiceServer).GetDocumentMetadata(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetClinicOverrides_FullMethodName,
+		FullMethod: StorageService_GetDocumentMetadata_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetClinicOverrides(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetDocumentMetadata(ctx, req.(*GetDocumentMetadataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _StorageService_DownloadDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDocumentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StorageServiceServer).DownloadDocument(m, &grpc.GenericServerStream[DownloadDocumentRequest, DownloadDocumentChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StorageService_DownloadDocumentServer = grpc.ServerStreamingServer[DownloadDocumentChunk]
+
+func _StorageService_GetDocumentsByPatientID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetAppointments(ctx, in)
+		return srv.(StorageServiceServer).GetDocumentsByPatientID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetAppointments_FullMethodName,
+		FullMethod: StorageService_GetDocumentsByPatientID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetAppointments(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetDocumentsByPatientID(ctx, req.(*GetDocumentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetVisitMaterials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIdRequest)
+func _StorageService_GetAdminByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetVisitMaterials(ctx, in)
+		return srv.(StorageServiceServer).GetAdminByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetVisitMaterials_FullMethodName,
+		FullMethod: StorageService_GetAdminByID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetVisitMaterials(ctx, req.(*GetByIdRequest))
+		return srv.(StorageServiceServer).GetAdminByID(ctx, req.(*GetByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetVisitServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIdRequest)
+func _StorageService_AddAuditEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditEntry)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetVisitServices(ctx, in)
+		return srv.(StorageServiceServer).AddAuditEntry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetVisitServices_FullMethodName,
+		FullMethod: StorageService_AddAuditEntry_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetVisitServices(ctx, req.(*GetByIdRequest))
+		return srv.(StorageServiceServer).AddAuditEntry(ctx, req.(*AuditEntry))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetMaterialByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIdRequest)
+func _StorageService_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetMaterialByID(ctx, in)
+		return srv.(StorageServiceServer).GetAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetMaterialByID_FullMethodName,
+		FullMethod: StorageService_GetAuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetMaterialByID(ctx, req.(*GetByIdRequest))
+		return srv.(StorageServiceServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StorageService_GetServiceByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIdRequest)
+func _StorageService_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StorageServiceServer).GetServiceByID(ctx, in)
+		return srv.(StorageServiceServer).VerifyAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StorageService_GetServiceByID_FullMethodName,
+		FullMethod: StorageService_VerifyAuditLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetServiceByID(ctx, req.(*GetByIdRequest))
+		return srv.(StorageServiceServer).VerifyAuditLog(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2591,6 +5451,14 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _StorageService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "RetireUser",
+			Handler:    _StorageService_RetireUser_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _StorageService_RestoreUser_Handler,
+		},
 		{
 			MethodName: "UpdateUserLogin",
 			Handler:    _StorageService_UpdateUserLogin_Handler,
@@ -2647,6 +5515,10 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAppointmentsByDoctorID",
 			Handler:    _StorageService_GetAppointmentsByDoctorID_Handler,
 		},
+		{
+			MethodName: "GetPatientByID",
+			Handler:    _StorageService_GetPatientByID_Handler,
+		},
 		{
 			MethodName: "AddAppointment",
 			Handler:    _StorageService_AddAppointment_Handler,
@@ -2667,14 +5539,22 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAppointment",
 			Handler:    _StorageService_UpdateAppointment_Handler,
 		},
-		{
-			MethodName: "GetPatientByID",
-			Handler:    _StorageService_GetPatientByID_Handler,
-		},
 		{
 			MethodName: "GetAppointmentByID",
 			Handler:    _StorageService_GetAppointmentByID_Handler,
 		},
+		{
+			MethodName: "HoldAppointmentSlot",
+			Handler:    _StorageService_HoldAppointmentSlot_Handler,
+		},
+		{
+			MethodName: "ReleaseAppointmentSlot",
+			Handler:    _StorageService_ReleaseAppointmentSlot_Handler,
+		},
+		{
+			MethodName: "GetAppointmentSlotHolds",
+			Handler:    _StorageService_GetAppointmentSlotHolds_Handler,
+		},
 		{
 			MethodName: "AddClinicDailyOverride",
 			Handler:    _StorageService_AddClinicDailyOverride_Handler,
@@ -2691,6 +5571,66 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDoctorOverride",
 			Handler:    _StorageService_GetDoctorOverride_Handler,
 		},
+		{
+			MethodName: "GetClinicSettings",
+			Handler:    _StorageService_GetClinicSettings_Handler,
+		},
+		{
+			MethodName: "UpdateClinicTimeZone",
+			Handler:    _StorageService_UpdateClinicTimeZone_Handler,
+		},
+		{
+			MethodName: "AddWaitingListEntry",
+			Handler:    _StorageService_AddWaitingListEntry_Handler,
+		},
+		{
+			MethodName: "GetWaitingListByPatientID",
+			Handler:    _StorageService_GetWaitingListByPatientID_Handler,
+		},
+		{
+			MethodName: "CancelWaitingListEntry",
+			Handler:    _StorageService_CancelWaitingListEntry_Handler,
+		},
+		{
+			MethodName: "AcceptWaitingListOffer",
+			Handler:    _StorageService_AcceptWaitingListOffer_Handler,
+		},
+		{
+			MethodName: "DeclineWaitingListOffer",
+			Handler:    _StorageService_DeclineWaitingListOffer_Handler,
+		},
+		{
+			MethodName: "GetScheduleRules",
+			Handler:    _StorageService_GetScheduleRules_Handler,
+		},
+		{
+			MethodName: "AddScheduleRule",
+			Handler:    _StorageService_AddScheduleRule_Handler,
+		},
+		{
+			MethodName: "ImportScheduleRules",
+			Handler:    _StorageService_ImportScheduleRules_Handler,
+		},
+		{
+			MethodName: "DeleteScheduleRule",
+			Handler:    _StorageService_DeleteScheduleRule_Handler,
+		},
+		{
+			MethodName: "ClaimNotifications",
+			Handler:    _StorageService_ClaimNotifications_Handler,
+		},
+		{
+			MethodName: "CompleteNotification",
+			Handler:    _StorageService_CompleteNotification_Handler,
+		},
+		{
+			MethodName: "GetPatientNotificationSettings",
+			Handler:    _StorageService_GetPatientNotificationSettings_Handler,
+		},
+		{
+			MethodName: "UpdatePatientNotificationSettings",
+			Handler:    _StorageService_UpdatePatientNotificationSettings_Handler,
+		},
 		{
 			MethodName: "AddMaterial",
 			Handler:    _StorageService_AddMaterial_Handler,
@@ -2727,10 +5667,134 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMaterial",
 			Handler:    _StorageService_DeleteMaterial_Handler,
 		},
+		{
+			MethodName: "ReceiveMaterialBatch",
+			Handler:    _StorageService_ReceiveMaterialBatch_Handler,
+		},
+		{
+			MethodName: "AdjustMaterialStock",
+			Handler:    _StorageService_AdjustMaterialStock_Handler,
+		},
+		{
+			MethodName: "GetMaterialStock",
+			Handler:    _StorageService_GetMaterialStock_Handler,
+		},
+		{
+			MethodName: "GetStockReport",
+			Handler:    _StorageService_GetStockReport_Handler,
+		},
+		{
+			MethodName: "CreateTreatmentPlan",
+			Handler:    _StorageService_CreateTreatmentPlan_Handler,
+		},
+		{
+			MethodName: "AddTreatmentPlanItems",
+			Handler:    _StorageService_AddTreatmentPlanItems_Handler,
+		},
+		{
+			MethodName: "UpdateTreatmentPlanItemStatus",
+			Handler:    _StorageService_UpdateTreatmentPlanItemStatus_Handler,
+		},
+		{
+			MethodName: "AcceptTreatmentPlan",
+			Handler:    _StorageService_AcceptTreatmentPlan_Handler,
+		},
+		{
+			MethodName: "CancelTreatmentPlan",
+			Handler:    _StorageService_CancelTreatmentPlan_Handler,
+		},
+		{
+			MethodName: "GetTreatmentPlan",
+			Handler:    _StorageService_GetTreatmentPlan_Handler,
+		},
+		{
+			MethodName: "GetTreatmentPlanByItemID",
+			Handler:    _StorageService_GetTreatmentPlanByItemID_Handler,
+		},
+		{
+			MethodName: "GetPatientTreatmentPlans",
+			Handler:    _StorageService_GetPatientTreatmentPlans_Handler,
+		},
+		{
+			MethodName: "RecordToothStates",
+			Handler:    _StorageService_RecordToothStates_Handler,
+		},
+		{
+			MethodName: "GetDentalChart",
+			Handler:    _StorageService_GetDentalChart_Handler,
+		},
+		{
+			MethodName: "GetToothHistory",
+			Handler:    _StorageService_GetToothHistory_Handler,
+		},
+		{
+			MethodName: "SaveAnamnesis",
+			Handler:    _StorageService_SaveAnamnesis_Handler,
+		},
+		{
+			MethodName: "GetAnamnesis",
+			Handler:    _StorageService_GetAnamnesis_Handler,
+		},
+		{
+			MethodName: "GetAnamnesisHistory",
+			Handler:    _StorageService_GetAnamnesisHistory_Handler,
+		},
+		{
+			MethodName: "GetClinicalAlertRules",
+			Handler:    _StorageService_GetClinicalAlertRules_Handler,
+		},
+		{
+			MethodName: "AddClinicalAlertRule",
+			Handler:    _StorageService_AddClinicalAlertRule_Handler,
+		},
+		{
+			MethodName: "UpdateClinicalAlertRule",
+			Handler:    _StorageService_UpdateClinicalAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteClinicalAlertRule",
+			Handler:    _StorageService_DeleteClinicalAlertRule_Handler,
+		},
+		{
+			MethodName: "GetMedicalRecord",
+			Handler:    _StorageService_GetMedicalRecord_Handler,
+		},
+		{
+			MethodName: "GetDoctorPatientRelation",
+			Handler:    _StorageService_GetDoctorPatientRelation_Handler,
+		},
+		{
+			MethodName: "GetPatientAccessGrants",
+			Handler:    _StorageService_GetPatientAccessGrants_Handler,
+		},
+		{
+			MethodName: "AddPatientAccessGrant",
+			Handler:    _StorageService_AddPatientAccessGrant_Handler,
+		},
+		{
+			MethodName: "RevokePatientAccessGrant",
+			Handler:    _StorageService_RevokePatientAccessGrant_Handler,
+		},
 		{
 			MethodName: "DeleteService",
 			Handler:    _StorageService_DeleteService_Handler,
 		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _StorageService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "AddPriceListEntry",
+			Handler:    _StorageService_AddPriceListEntry_Handler,
+		},
+		{
+			MethodName: "DeletePriceListEntry",
+			Handler:    _StorageService_DeletePriceListEntry_Handler,
+		},
+		{
+			MethodName: "GetPriceOnDate",
+			Handler:    _StorageService_GetPriceOnDate_Handler,
+		},
 		{
 			MethodName: "GetDoctorOverrides",
 			Handler:    _StorageService_GetDoctorOverrides_Handler,
@@ -2791,6 +5855,46 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddOrUpdateVisitPayment",
 			Handler:    _StorageService_AddOrUpdateVisitPayment_Handler,
 		},
+		{
+			MethodName: "CompleteConsultation",
+			Handler:    _StorageService_CompleteConsultation_Handler,
+		},
+		{
+			MethodName: "IssueInvoice",
+			Handler:    _StorageService_IssueInvoice_Handler,
+		},
+		{
+			MethodName: "GetInvoice",
+			Handler:    _StorageService_GetInvoice_Handler,
+		},
+		{
+			MethodName: "SetInvoiceDiscount",
+			Handler:    _StorageService_SetInvoiceDiscount_Handler,
+		},
+		{
+			MethodName: "AddInvoicePayment",
+			Handler:    _StorageService_AddInvoicePayment_Handler,
+		},
+		{
+			MethodName: "RefundInvoicePayment",
+			Handler:    _StorageService_RefundInvoicePayment_Handler,
+		},
+		{
+			MethodName: "GetPatientBalance",
+			Handler:    _StorageService_GetPatientBalance_Handler,
+		},
+		{
+			MethodName: "ReserveInvoiceDocument",
+			Handler:    _StorageService_ReserveInvoiceDocument_Handler,
+		},
+		{
+			MethodName: "ReserveReceiptDocument",
+			Handler:    _StorageService_ReserveReceiptDocument_Handler,
+		},
+		{
+			MethodName: "AttachBillingDocument",
+			Handler:    _StorageService_AttachBillingDocument_Handler,
+		},
 		{
 			MethodName: "GetVisitsPayments",
 			Handler:    _StorageService_GetVisitsPayments_Handler,
@@ -2819,7 +5923,102 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetServiceByID",
 			Handler:    _StorageService_GetServiceByID_Handler,
 		},
+		{
+			MethodName: "GetTotalPatients",
+			Handler:    _StorageService_GetTotalPatients_Handler,
+		},
+		{
+			MethodName: "GetTotalVisits",
+			Handler:    _StorageService_GetTotalVisits_Handler,
+		},
+		{
+			MethodName: "GetTopServices",
+			Handler:    _StorageService_GetTopServices_Handler,
+		},
+		{
+			MethodName: "GetDoctorAvgVisit",
+			Handler:    _StorageService_GetDoctorAvgVisit_Handler,
+		},
+		{
+			MethodName: "GetDoctorAvgCheck",
+			Handler:    _StorageService_GetDoctorAvgCheck_Handler,
+		},
+		{
+			MethodName: "GetDoctorUniquePatient",
+			Handler:    _StorageService_GetDoctorUniquePatient_Handler,
+		},
+		{
+			MethodName: "GetAgeGroupStat",
+			Handler:    _StorageService_GetAgeGroupStat_Handler,
+		},
+		{
+			MethodName: "GetNewPatientsThisMonth",
+			Handler:    _StorageService_GetNewPatientsThisMonth_Handler,
+		},
+		{
+			MethodName: "GetAvgVisitsPerPatient",
+			Handler:    _StorageService_GetAvgVisitsPerPatient_Handler,
+		},
+		{
+			MethodName: "GetTotalIncome",
+			Handler:    _StorageService_GetTotalIncome_Handler,
+		},
+		{
+			MethodName: "GetMonthlyIncome",
+			Handler:    _StorageService_GetMonthlyIncome_Handler,
+		},
+		{
+			MethodName: "GetClinicAverageCheck",
+			Handler:    _StorageService_GetClinicAverageCheck_Handler,
+		},
+		{
+			MethodName: "GetDiagnoseByVisitID",
+			Handler:    _StorageService_GetDiagnoseByVisitID_Handler,
+		},
+		{
+			MethodName: "StartDocumentUpload",
+			Handler:    _StorageService_StartDocumentUpload_Handler,
+		},
+		{
+			MethodName: "GetDocumentUploadStatus",
+			Handler:    _StorageService_GetDocumentUploadStatus_Handler,
+		},
+		{
+			MethodName: "GetDocumentMetadata",
+			Handler:    _StorageService_GetDocumentMetadata_Handler,
+		},
+		{
+			MethodName: "GetDocumentsByPatientID",
+			Handler:    _StorageService_GetDocumentsByPatientID_Handler,
+		},
+		{
+			MethodName: "GetAdminByID",
+			Handler:    _StorageService_GetAdminByID_Handler,
+		},
+		{
+			MethodName: "AddAuditEntry",
+			Handler:    _StorageService_AddAuditEntry_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _StorageService_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _StorageService_VerifyAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadDocument",
+			Handler:       _StorageService_UploadDocument_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadDocument",
+			Handler:       _StorageService_DownloadDocument_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/storage/storage.proto",
 }
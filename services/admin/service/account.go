@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/admin/model"
 	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+	"github.com/DariaTarasek/diplom/services/scheduling"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"log"
+	"sort"
 	"time"
 	"unicode"
 )
@@ -13,25 +16,17 @@ import (
 const weeksAhead = 4
 
 func (s *AdminService) GetClinicScheduleGrid(ctx context.Context) (model.AdminScheduleOverview, error) {
-	scheduleResp, err := s.StorageClient.Client.GetClinicWeeklySchedule(ctx, &storagepb.EmptyRequest{})
+	schedule, err := s.loadClinicSchedule(ctx)
 	if err != nil {
-		return model.AdminScheduleOverview{}, fmt.Errorf("не удалось получить расписание клиники: %w", err)
+		return model.AdminScheduleOverview{}, err
 	}
 
-	overrideResp, err := s.StorageClient.Client.GetClinicOverrides(ctx, &storagepb.EmptyRequest{})
-	if err != nil {
-		return model.AdminScheduleOverview{}, fmt.Errorf("не удалось получить перегрузки клиники: %w", err)
-	}
-	overridesMap := make(map[string]*storagepb.ClinicOverride)
-	for _, override := range overrideResp.Overrides {
-		if override.Date == nil {
-			continue
-		}
-		dateStr := override.Date.AsTime().Format("02.01.2006")
-		overridesMap[dateStr] = override
-	}
-
-	appointmentsResp, err := s.StorageClient.Client.GetAppointments(ctx, &storagepb.EmptyRequest{})
+	// записи только за показываемые недели; даты приёмов хранятся без пояса и передаются полночью UTC
+	monday := scheduling.WeekStart(time.Now().In(schedule.Location))
+	appointmentsResp, err := s.StorageClient.Client.GetAppointments(ctx, &storagepb.GetAppointmentsRequest{
+		DateFrom: timestamppb.New(scheduling.Day(monday, time.UTC)),
+		DateTo:   timestamppb.New(scheduling.Day(monday.AddDate(0, 0, weeksAhead*7-1), time.UTC)),
+	})
 	if err != nil {
 		return model.AdminScheduleOverview{}, fmt.Errorf("не удалось получить записи клиники: %w", err)
 	}
@@ -84,128 +79,51 @@ func (s *AdminService) GetClinicScheduleGrid(ctx context.Context) (model.AdminSc
 		Appointments: map[string]map[string][]model.AdminAppointment{},
 	}
 
-	// Получаем slotDuration из первого расписания (все одинаковые)
-	var defaultSlotDuration int32 = 30
-	if len(scheduleResp.ClinicSchedule) > 0 {
-		if scheduleResp.ClinicSchedule[0].SlotDurationMinutes > 0 {
-			defaultSlotDuration = scheduleResp.ClinicSchedule[0].SlotDurationMinutes
-		}
-	}
-
-	today := time.Now()
-	weekdayToday := int(today.Weekday())
-	if weekdayToday == 0 {
-		weekdayToday = 7
-	}
-	monday := today.AddDate(0, 0, -weekdayToday+1)
-	totalDays := weeksAhead * 7
-
-	clinicSchedule := map[int]*storagepb.WeeklyClinicSchedule{}
-	for _, sched := range scheduleResp.ClinicSchedule {
-		clinicSchedule[int(sched.Weekday)] = sched
-	}
-
-	var globalStart, globalEnd *time.Time
-	updateGlobalTimeRange := func(start, end time.Time) {
-		if globalStart == nil || start.Before(*globalStart) {
-			globalStart = &start
-		}
-		if globalEnd == nil || end.After(*globalEnd) {
-			globalEnd = &end
-		}
-	}
-
-	for i := 0; i < totalDays; i++ {
-		date := monday.AddDate(0, 0, i)
-		weekday := int(date.Weekday())
-		if weekday == 0 {
-			weekday = 7
-		}
-		dateStr := date.Format("02.01.2006")
-		weekdayStr := weekdayToRus(date.Weekday())
+	uniqueTimes := map[string]bool{}
+	for i := 0; i < weeksAhead*7; i++ {
+		hours := schedule.HoursOn(monday.AddDate(0, 0, i))
+		dateStr := hours.Date.Format("02.01.2006")
 		result.Schedule.Days = append(result.Schedule.Days, model.ScheduleDay{
 			Date:    dateStr,
-			Weekday: weekdayStr,
+			Weekday: weekdayToRus(hours.Date.Weekday()),
 		})
 		result.Appointments[dateStr] = map[string][]model.AdminAppointment{}
 
-		if override, ok := overridesMap[dateStr]; ok {
-			if override.IsDayOff {
-				continue
-			}
-			start := override.StartTime.AsTime()
-			end := override.EndTime.AsTime()
-			updateGlobalTimeRange(start, end)
-
-			for t := start; t.Before(end); t = t.Add(time.Duration(defaultSlotDuration) * time.Minute) {
-				timeStr := t.Format("15:04")
-				entries := []model.AdminAppointment{}
-				if apps, ok := appointmentsMap[dateStr][timeStr]; ok {
-					for _, app := range apps {
-						doctor, _ := doctorInfoMap[int(app.DoctorId)]
-						entries = append(entries, model.AdminAppointment{
-							ID:     int(app.Id),
-							Doctor: doctor,
-							Patient: model.Person{
-								ID:         model.UserID(app.PatientId),
-								SecondName: app.SecondName,
-								FirstName:  app.FirstName,
-								Surname:    app.Surname,
-								BirthDate:  app.BirthDate.AsTime().Format("02.01.2006"),
-								Gender:     app.Gender,
-								Phone:      app.PhoneNumber,
-							},
-						})
-					}
-				}
-				result.Appointments[dateStr][timeStr] = entries
-			}
-			continue
-		}
-
-		sched, ok := clinicSchedule[weekday]
-		if !ok || sched.IsDayOff {
-			continue
-		}
-		start := sched.StartTime.AsTime()
-		end := sched.EndTime.AsTime()
-		updateGlobalTimeRange(start, end)
-
-		for t := start; t.Before(end); t = t.Add(time.Duration(defaultSlotDuration) * time.Minute) {
+		for _, t := range hours.SlotStarts() {
 			timeStr := t.Format("15:04")
+			uniqueTimes[timeStr] = true
 			entries := []model.AdminAppointment{}
-			if apps, ok := appointmentsMap[dateStr][timeStr]; ok {
-				for _, app := range apps {
-					doctor, _ := doctorInfoMap[int(app.DoctorId)]
-					entries = append(entries, model.AdminAppointment{
-						ID:     int(app.Id),
-						Doctor: doctor,
-						Patient: model.Person{
-							ID:         model.UserID(app.PatientId),
-							SecondName: app.SecondName,
-							FirstName:  app.FirstName,
-							Surname:    app.Surname,
-							BirthDate:  app.BirthDate.AsTime().Format("02.01.2006"),
-							Gender:     app.Gender,
-							Phone:      app.PhoneNumber,
-						},
-					})
-				}
+			for _, app := range appointmentsMap[dateStr][timeStr] {
+				entries = append(entries, model.AdminAppointment{
+					ID:     int(app.Id),
+					Doctor: doctorInfoMap[int(app.DoctorId)],
+					Patient: model.Person{
+						ID:         model.UserID(app.PatientId),
+						SecondName: app.SecondName,
+						FirstName:  app.FirstName,
+						Surname:    app.Surname,
+						BirthDate:  app.BirthDate.AsTime().Format("02.01.2006"),
+						Gender:     app.Gender,
+						Phone:      app.PhoneNumber,
+					},
+				})
 			}
 			result.Appointments[dateStr][timeStr] = entries
 		}
 	}
 
-	// Генерация всех возможных слотов
-	if globalStart == nil || globalEnd == nil {
+	// Строки сетки — все начала слотов, встречающиеся в рассчитанных днях
+	if len(uniqueTimes) == 0 {
 		start, _ := time.Parse("15:04", "08:00")
 		end, _ := time.Parse("15:04", "20:00")
-		globalStart = &start
-		globalEnd = &end
+		for t := start; !t.After(end); t = t.Add(scheduling.DefaultSlotMinutes * time.Minute) {
+			uniqueTimes[t.Format("15:04")] = true
+		}
 	}
-	for t := *globalStart; !t.After(*globalEnd); t = t.Add(time.Duration(defaultSlotDuration) * time.Minute) {
-		result.Schedule.TimeSlots = append(result.Schedule.TimeSlots, t.Format("15:04"))
+	for timeStr := range uniqueTimes {
+		result.Schedule.TimeSlots = append(result.Schedule.TimeSlots, timeStr)
 	}
+	sort.Strings(result.Schedule.TimeSlots)
 
 	return result, nil
 }
@@ -1,15 +1,18 @@
 package service
 
 import (
+	"github.com/DariaTarasek/diplom/services/admin/billingdoc"
 	"github.com/DariaTarasek/diplom/services/admin/clients"
 )
 
 type AdminService struct {
 	StorageClient *clients.StorageClient
+	Documents     *billingdoc.Renderer
 }
 
-func NewAdminService(client *clients.StorageClient) *AdminService {
+func NewAdminService(client *clients.StorageClient, documents *billingdoc.Renderer) *AdminService {
 	return &AdminService{
 		StorageClient: client,
+		Documents:     documents,
 	}
 }
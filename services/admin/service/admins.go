@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/admin/model"
 	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
-	"sort"
 	"strings"
 )
 
@@ -36,17 +35,18 @@ func RoleToId(role string) int {
 	}
 }
 
-func (s *AdminService) GetAdmins(ctx context.Context) ([]model.Admin, error) {
-	resp, err := s.StorageClient.Client.GetAdmins(ctx, &storagepb.EmptyRequest{})
+// GetAdmins страница списка администраторов, найденных по query, и число всех найденных
+func (s *AdminService) GetAdmins(ctx context.Context, filter model.PersonFilter, page model.ListPage) ([]model.Admin, int, error) {
+	resp, err := s.StorageClient.Client.GetAdmins(ctx, &storagepb.GetAdminsRequest{Page: listPageToPb(page), Query: filter.Query, Inactive: filter.Inactive})
 	if err != nil {
-		return nil, fmt.Errorf("не удалось получить список админов: %w", err)
+		return nil, 0, fmt.Errorf("не удалось получить список админов: %w", err)
 	}
 	var admins []model.Admin
 	for _, item := range resp.Admins {
 		roleResp, err := s.StorageClient.Client.GetUserRole(ctx, &storagepb.GetUserRoleRequest{UserId: item.UserId})
 		role := roleResp.Role
 		if err != nil {
-			return nil, fmt.Errorf("не удалось получить роль администратора: %w", err)
+			return nil, 0, fmt.Errorf("не удалось получить роль администратора: %w", err)
 		}
 		admin := model.Admin{
 			ID:          int(item.UserId),
@@ -60,11 +60,7 @@ func (s *AdminService) GetAdmins(ctx context.Context) ([]model.Admin, error) {
 		}
 		admins = append(admins, admin)
 	}
-	sort.Slice(admins, func(i, j int) bool {
-		return admins[i].SecondName < admins[j].SecondName
-	})
-
-	return admins, nil
+	return admins, int(resp.Total), nil
 }
 
 func (s *AdminService) UpdateAdmin(ctx context.Context, admin model.Admin) error {
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/admin/model"
+	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+)
+
+// GetAuditLog страница журнала обращений к данным, отобранная по filter, и число всех найденных записей
+func (s *AdminService) GetAuditLog(ctx context.Context, filter model.AuditFilter, page model.ListPage) ([]model.AuditEntry, int, error) {
+	resp, err := s.StorageClient.Client.GetAuditLog(ctx, &storagepb.GetAuditLogRequest{
+		Page:      listPageToPb(page),
+		PatientId: int32(filter.PatientID),
+		ActorId:   int32(filter.ActorID),
+		DateFrom:  optionalDate(filter.Period.From),
+		DateTo:    optionalDate(filter.Period.To),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("не удалось получить журнал аудита через gRPC: %w", err)
+	}
+	entries := make([]model.AuditEntry, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		entries = append(entries, model.AuditEntry{
+			ID:         e.Id,
+			OccurredAt: e.OccurredAt.AsTime(),
+			Service:    e.Service,
+			Action:     e.Action,
+			ActorID:    int(e.ActorId),
+			ActorRole:  int(e.ActorRole),
+			Resource:   e.Resource,
+			ResourceID: e.ResourceId,
+			PatientID:  int(e.PatientId),
+			Outcome:    e.Outcome,
+			Hash:       hex.EncodeToString(e.Hash),
+		})
+	}
+	return entries, int(resp.Total), nil
+}
+
+// VerifyAuditLog проверяет цепочку хешей журнала от первой записи до последней
+func (s *AdminService) VerifyAuditLog(ctx context.Context) (model.AuditChainCheck, error) {
+	resp, err := s.StorageClient.Client.VerifyAuditLog(ctx, &storagepb.EmptyRequest{})
+	if err != nil {
+		return model.AuditChainCheck{}, fmt.Errorf("не удалось проверить журнал аудита через gRPC: %w", err)
+	}
+	return model.AuditChainCheck{
+		Checked:  int(resp.Checked),
+		BrokenID: resp.BrokenId,
+		LastHash: hex.EncodeToString(resp.LastHash),
+	}, nil
+}
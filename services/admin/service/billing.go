@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/admin/model"
+	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+	"github.com/DariaTarasek/diplom/services/admin/sharederrors"
+)
+
+// maxPaymentAmount наибольшая сумма одной оплаты или возврата, как и предел цены приёма
+const maxPaymentAmount = 1000000
+
+// GetVisitInvoice счёт на приём; для приёмов, проведённых до введения счетов, выставляется при первом запросе
+func (s *AdminService) GetVisitInvoice(ctx context.Context, visitID int) (model.Invoice, error) {
+	resp, err := s.StorageClient.Client.IssueInvoice(ctx, &storagepb.GetByIdRequest{Id: int32(visitID)})
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось получить счёт на приём: %w", err)
+	}
+	return invoiceFromPb(resp.Invoice), nil
+}
+
+// GetInvoice счёт со строками, оплатами и возвратами
+func (s *AdminService) GetInvoice(ctx context.Context, id int) (model.Invoice, error) {
+	resp, err := s.StorageClient.Client.GetInvoice(ctx, &storagepb.GetByIdRequest{Id: int32(id)})
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось получить счёт: %w", err)
+	}
+	return invoiceFromPb(resp.Invoice), nil
+}
+
+// SetInvoiceDiscount устанавливает скидку на счёт до первой оплаты; nil снимает скидку
+func (s *AdminService) SetInvoiceDiscount(ctx context.Context, invoiceID int, discount *model.Discount) (model.Invoice, error) {
+	req := &storagepb.SetInvoiceDiscountRequest{InvoiceId: int32(invoiceID)}
+	if discount != nil {
+		if err := validateDiscount(*discount); err != nil {
+			return model.Invoice{}, err
+		}
+		req.Kind = discount.Kind
+		req.Value = int32(discount.Value)
+		req.Reason = discount.Reason
+	}
+	resp, err := s.StorageClient.Client.SetInvoiceDiscount(ctx, req)
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось установить скидку: %w", err)
+	}
+	return invoiceFromPb(resp.Invoice), nil
+}
+
+// AddInvoicePayment принимает оплату по счёту, в том числе частичную, и формирует на неё чек
+func (s *AdminService) AddInvoicePayment(ctx context.Context, invoiceID int, payment model.InvoicePayment) (model.Invoice, error) {
+	if err := validatePaymentAmount(payment.Amount); err != nil {
+		return model.Invoice{}, err
+	}
+	switch payment.Method {
+	case "cash", "card", "transfer":
+	default:
+		return model.Invoice{}, fmt.Errorf("неизвестный способ оплаты %q: %w", payment.Method, sharederrors.ErrInvalidValue)
+	}
+	resp, err := s.StorageClient.Client.AddInvoicePayment(ctx, &storagepb.AddInvoicePaymentRequest{
+		InvoiceId: int32(invoiceID),
+		Amount:    int32(payment.Amount),
+		Method:    payment.Method,
+		Comment:   payment.Comment,
+	})
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось принять оплату: %w", err)
+	}
+	invoice := invoiceFromPb(resp.Invoice)
+	s.issueReceipt(ctx, invoice)
+	return invoice, nil
+}
+
+// RefundInvoicePayment оформляет возврат по оплате тем же способом, которым она была внесена, и формирует чек возврата
+func (s *AdminService) RefundInvoicePayment(ctx context.Context, paymentID, amount int, comment string) (model.Invoice, error) {
+	if err := validatePaymentAmount(amount); err != nil {
+		return model.Invoice{}, err
+	}
+	resp, err := s.StorageClient.Client.RefundInvoicePayment(ctx, &storagepb.RefundInvoicePaymentRequest{
+		PaymentId: int32(paymentID),
+		Amount:    int32(amount),
+		Comment:   comment,
+	})
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось оформить возврат: %w", err)
+	}
+	invoice := invoiceFromPb(resp.Invoice)
+	s.issueReceipt(ctx, invoice)
+	return invoice, nil
+}
+
+// GetPatientBalance расчёты с пациентом: выставлено, внесено, остаток и неоплаченные счета
+func (s *AdminService) GetPatientBalance(ctx context.Context, patientID int) (model.PatientBalance, error) {
+	resp, err := s.StorageClient.Client.GetPatientBalance(ctx, &storagepb.GetByIdRequest{Id: int32(patientID)})
+	if err != nil {
+		return model.PatientBalance{}, fmt.Errorf("не удалось получить баланс пациента: %w", err)
+	}
+	balance := model.PatientBalance{
+		PatientID:   int(resp.PatientId),
+		Invoiced:    int(resp.Invoiced),
+		Paid:        int(resp.Paid),
+		Outstanding: int(resp.Outstanding),
+	}
+	for _, item := range resp.OpenInvoices {
+		balance.OpenInvoices = append(balance.OpenInvoices, invoiceFromPb(item))
+	}
+	return balance, nil
+}
+
+func validateDiscount(discount model.Discount) error {
+	switch discount.Reason {
+	case "loyalty", "staff", "promo":
+	default:
+		return fmt.Errorf("неизвестная причина скидки %q: %w", discount.Reason, sharederrors.ErrInvalidValue)
+	}
+	switch discount.Kind {
+	case "percent":
+		if discount.Value < 1 || discount.Value > 100 {
+			return fmt.Errorf("процент скидки должен быть от 1 до 100: %w", sharederrors.ErrInvalidValue)
+		}
+	case "fixed":
+		if discount.Value < 1 || discount.Value > maxPaymentAmount {
+			return fmt.Errorf("некорректная сумма скидки: %w", sharederrors.ErrInvalidValue)
+		}
+	default:
+		return fmt.Errorf("неизвестный вид скидки %q: %w", discount.Kind, sharederrors.ErrInvalidValue)
+	}
+	return nil
+}
+
+func validatePaymentAmount(amount int) error {
+	if amount < 1 || amount > maxPaymentAmount {
+		return fmt.Errorf("некорректная сумма: %w", sharederrors.ErrInvalidValue)
+	}
+	return nil
+}
+
+func invoiceFromPb(item *storagepb.Invoice) model.Invoice {
+	invoice := model.Invoice{
+		ID:             int(item.Id),
+		VisitID:        int(item.VisitId),
+		PatientID:      int(item.PatientId),
+		Subtotal:       int(item.Subtotal),
+		DiscountKind:   item.DiscountKind,
+		DiscountValue:  int(item.DiscountValue),
+		DiscountReason: item.DiscountReason,
+		DiscountAmount: int(item.DiscountAmount),
+		Total:          int(item.Total),
+		Paid:           int(item.Paid),
+		Balance:        int(item.Balance),
+		Status:         item.Status,
+		CreatedAt:      item.CreatedAt.AsTime(),
+	}
+	for _, line := range item.Lines {
+		invoice.Lines = append(invoice.Lines, model.InvoiceLine{
+			ID:           int(line.Id),
+			Kind:         line.Kind,
+			ItemID:       int(line.ItemId),
+			Name:         line.Name,
+			Quantity:     int(line.Quantity),
+			PricePerUnit: int(line.PricePerUnit),
+			TotalPrice:   int(line.TotalPrice),
+		})
+	}
+	for _, p := range item.Payments {
+		invoice.Payments = append(invoice.Payments, model.InvoicePayment{
+			ID:        int(p.Id),
+			Kind:      p.Kind,
+			Amount:    int(p.Amount),
+			Method:    p.Method,
+			RefundOf:  int(p.RefundOf),
+			Comment:   p.Comment,
+			CreatedAt: p.CreatedAt.AsTime(),
+		})
+	}
+	return invoice
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/admin/billingdoc"
+	"github.com/DariaTarasek/diplom/services/admin/model"
+	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"log"
+	"strconv"
+	"time"
+)
+
+// GetInvoiceDocument счёт на оплату в PDF. При первом запросе счёту выдаётся номер, а PDF сохраняется
+// в документы пациента; дальше отдаётся сохранённый файл, пока не изменится скидка
+func (s *AdminService) GetInvoiceDocument(ctx context.Context, invoiceID int) (model.BillingDocument, error) {
+	reserved, err := s.StorageClient.Client.ReserveInvoiceDocument(ctx, &storagepb.GetByIdRequest{Id: int32(invoiceID)})
+	if err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось получить номер счёта на оплату: %w", err)
+	}
+	if reserved.DocumentId != "" {
+		return s.downloadBillingDocument(ctx, reserved)
+	}
+
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return model.BillingDocument{}, err
+	}
+	bill, err := s.billOf(ctx, invoice)
+	if err != nil {
+		return model.BillingDocument{}, err
+	}
+	content, err := s.Documents.RenderInvoice(billingdoc.Invoice{
+		Bill:   bill,
+		Number: int(reserved.Number),
+		Date:   reserved.CreatedAt.AsTime(),
+	})
+	if err != nil {
+		return model.BillingDocument{}, err
+	}
+	doc := model.BillingDocument{
+		Number:   int(reserved.Number),
+		FileName: fmt.Sprintf("invoice-%06d.pdf", reserved.Number),
+		Content:  content,
+	}
+	description := fmt.Sprintf("Счёт на оплату № %d", reserved.Number)
+	return s.saveBillingDocument(ctx, reserved, invoice.PatientID, bill.VisitDate, doc, description)
+}
+
+// GetReceiptDocument кассовый чек на оплату или возврат в PDF; номер выдаётся при первом запросе
+func (s *AdminService) GetReceiptDocument(ctx context.Context, paymentID int) (model.BillingDocument, error) {
+	reserved, err := s.StorageClient.Client.ReserveReceiptDocument(ctx, &storagepb.GetByIdRequest{Id: int32(paymentID)})
+	if err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось получить номер чека: %w", err)
+	}
+	if reserved.DocumentId != "" {
+		return s.downloadBillingDocument(ctx, reserved)
+	}
+
+	invoice, err := s.GetInvoice(ctx, int(reserved.InvoiceId))
+	if err != nil {
+		return model.BillingDocument{}, err
+	}
+	bill, err := s.billOf(ctx, invoice)
+	if err != nil {
+		return model.BillingDocument{}, err
+	}
+	// остаток по счёту сразу после этой операции: движения идут в порядке проведения
+	var payment model.InvoicePayment
+	paid := 0
+	for _, p := range invoice.Payments {
+		if p.Kind == "refund" {
+			paid -= p.Amount
+		} else {
+			paid += p.Amount
+		}
+		if p.ID == paymentID {
+			payment = p
+			break
+		}
+	}
+	if payment.ID == 0 {
+		return model.BillingDocument{}, fmt.Errorf("оплата %d не найдена в счёте %d", paymentID, invoice.ID)
+	}
+
+	content, err := s.Documents.RenderReceipt(billingdoc.Receipt{
+		Bill:    bill,
+		Number:  int(reserved.Number),
+		Date:    payment.CreatedAt,
+		Refund:  payment.Kind == "refund",
+		Amount:  payment.Amount,
+		Method:  payment.Method,
+		Balance: invoice.Total - paid,
+	})
+	if err != nil {
+		return model.BillingDocument{}, err
+	}
+	doc := model.BillingDocument{
+		Number:   int(reserved.Number),
+		FileName: fmt.Sprintf("receipt-%06d.pdf", reserved.Number),
+		Content:  content,
+	}
+	description := fmt.Sprintf("Кассовый чек № %d", reserved.Number)
+	if payment.Kind == "refund" {
+		description = fmt.Sprintf("Кассовый чек возврата № %d", reserved.Number)
+	}
+	return s.saveBillingDocument(ctx, reserved, invoice.PatientID, bill.VisitDate, doc, description)
+}
+
+// issueReceipt формирует чек на последнюю операцию по счёту сразу после её проведения, чтобы он появился
+// в документах пациента. Ошибка не отменяет проведённую операцию: чек сформируется при следующем запросе
+func (s *AdminService) issueReceipt(ctx context.Context, invoice model.Invoice) {
+	if len(invoice.Payments) == 0 {
+		return
+	}
+	last := invoice.Payments[0]
+	for _, p := range invoice.Payments {
+		if p.ID > last.ID {
+			last = p
+		}
+	}
+	if _, err := s.GetReceiptDocument(ctx, last.ID); err != nil {
+		log.Printf("не удалось сформировать чек по оплате %d: %v", last.ID, err)
+	}
+}
+
+// billOf данные приёма для печати: врач, пациент, строки и итоги счёта
+func (s *AdminService) billOf(ctx context.Context, invoice model.Invoice) (billingdoc.Bill, error) {
+	visitResp, err := s.StorageClient.Client.GetVisitByID(ctx, &storagepb.GetByIdRequest{Id: int32(invoice.VisitID)})
+	if err != nil {
+		return billingdoc.Bill{}, fmt.Errorf("не удалось получить приём: %w", err)
+	}
+	visit := visitResp.Visit
+	doctorResp, err := s.StorageClient.Client.GetDoctorByID(ctx, &storagepb.GetByIDRequest{Id: visit.DoctorId})
+	if err != nil {
+		return billingdoc.Bill{}, fmt.Errorf("не удалось получить врача: %w", err)
+	}
+	patientResp, err := s.StorageClient.Client.GetPatientByID(ctx, &storagepb.GetByIDRequest{Id: visit.PatientId})
+	if err != nil {
+		return billingdoc.Bill{}, fmt.Errorf("не удалось получить пациента: %w", err)
+	}
+	doctor, patient := doctorResp.Doctor, patientResp.Patient
+
+	bill := billingdoc.Bill{
+		VisitDate:      visit.CreatedAt.AsTime(),
+		Patient:        fmt.Sprintf("%s %s %s", patient.SecondName, patient.FirstName, patient.Surname),
+		Doctor:         fmt.Sprintf("%s %s %s", doctor.SecondName, doctor.FirstName, doctor.Surname),
+		Subtotal:       invoice.Subtotal,
+		DiscountAmount: invoice.DiscountAmount,
+		DiscountNote:   discountNote(invoice),
+		Total:          invoice.Total,
+	}
+	for _, line := range invoice.Lines {
+		bill.Lines = append(bill.Lines, billingdoc.Line{
+			Name:         line.Name,
+			Quantity:     line.Quantity,
+			PricePerUnit: line.PricePerUnit,
+			Total:        line.TotalPrice,
+		})
+	}
+	return bill, nil
+}
+
+// saveBillingDocument сохраняет PDF в документы пациента и связывает его с номером документа
+func (s *AdminService) saveBillingDocument(ctx context.Context, reserved *storagepb.BillingDocument, patientID int,
+	visitDate time.Time, doc model.BillingDocument, description string) (model.BillingDocument, error) {
+	upload, err := s.StorageClient.Client.StartDocumentUpload(ctx, &storagepb.StartDocumentUploadRequest{
+		PatientId:   strconv.Itoa(patientID),
+		FileName:    doc.FileName,
+		Size:        int64(len(doc.Content)),
+		StudyDate:   timestamppb.New(visitDate),
+		Description: description,
+	})
+	if err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось сохранить документ пациента: %w", err)
+	}
+	stream, err := s.StorageClient.Client.UploadDocument(ctx)
+	if err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось сохранить документ пациента: %w", err)
+	}
+	// документы по счетам занимают десятки килобайт и передаются одной частью
+	if err := stream.Send(&storagepb.UploadDocumentChunk{UploadId: upload.UploadId, Data: doc.Content}); err != nil && !errors.Is(err, io.EOF) {
+		return model.BillingDocument{}, fmt.Errorf("не удалось сохранить документ пациента: %w", err)
+	}
+	uploaded, err := stream.CloseAndRecv()
+	if err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось сохранить документ пациента: %w", err)
+	}
+	if uploaded.DocumentId == "" {
+		return model.BillingDocument{}, fmt.Errorf("storage принял %d из %d байт документа", uploaded.Received, uploaded.Size)
+	}
+
+	_, err = s.StorageClient.Client.AttachBillingDocument(ctx, &storagepb.AttachBillingDocumentRequest{
+		Id:         reserved.Id,
+		DocumentId: uploaded.DocumentId,
+	})
+	if err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось связать документ с номером: %w", err)
+	}
+	doc.DocumentID = uploaded.DocumentId
+	return doc, nil
+}
+
+// downloadBillingDocument уже сохранённый PDF документа по счёту
+func (s *AdminService) downloadBillingDocument(ctx context.Context, reserved *storagepb.BillingDocument) (model.BillingDocument, error) {
+	stream, err := s.StorageClient.Client.DownloadDocument(ctx, &storagepb.DownloadDocumentRequest{DocumentId: reserved.DocumentId})
+	if err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось получить документ: %w", err)
+	}
+	doc := model.BillingDocument{Number: int(reserved.Number), DocumentID: reserved.DocumentId}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return doc, nil
+		}
+		if err != nil {
+			return model.BillingDocument{}, fmt.Errorf("не удалось получить документ: %w", err)
+		}
+		if chunk.FileName != "" {
+			doc.FileName = chunk.FileName
+		}
+		doc.Content = append(doc.Content, chunk.Data...)
+	}
+}
+
+// discountNote вид и причина скидки для печати
+func discountNote(invoice model.Invoice) string {
+	var note string
+	if invoice.DiscountKind == "percent" {
+		note = strconv.Itoa(invoice.DiscountValue) + "%, "
+	}
+	switch invoice.DiscountReason {
+	case "loyalty":
+		note += "программа лояльности"
+	case "staff":
+		note += "для сотрудников"
+	case "promo":
+		note += "промоакция"
+	}
+	return note
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/admin/model"
+	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+	"github.com/DariaTarasek/diplom/services/admin/sharederrors"
+	"strings"
+)
+
+// maxAlertMessageLength наибольшая длина текста предупреждения
+const maxAlertMessageLength = 1000
+
+// anamnesisFlags пункты анкеты здоровья, на которые может ссылаться правило вида anamnesis_flag
+var anamnesisFlags = map[string]bool{
+	"blood_thinners":      true,
+	"cardiac_disease":     true,
+	"hypertension":        true,
+	"diabetes":            true,
+	"bleeding_disorder":   true,
+	"infectious_disease":  true,
+	"anesthesia_reaction": true,
+	"pregnant":            true,
+	"breastfeeding":       true,
+	"smoker":              true,
+}
+
+// GetClinicalAlertRules все правила клинических предупреждений, в том числе выключенные
+func (s *AdminService) GetClinicalAlertRules(ctx context.Context) ([]model.ClinicalAlertRule, error) {
+	resp, err := s.StorageClient.Client.GetClinicalAlertRules(ctx, &storagepb.GetClinicalAlertRulesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить правила предупреждений через gRPC: %w", err)
+	}
+	rules := make([]model.ClinicalAlertRule, 0, len(resp.Rules))
+	for _, r := range resp.Rules {
+		rules = append(rules, model.ClinicalAlertRule{
+			ID:             int(r.Id),
+			Title:          r.Title,
+			ConditionType:  r.ConditionType,
+			ConditionValue: r.ConditionValue,
+			TargetType:     r.TargetType,
+			MaterialID:     int(r.MaterialId),
+			ServiceID:      int(r.ServiceId),
+			TargetName:     r.TargetName,
+			Severity:       r.Severity,
+			Message:        r.Message,
+			IsActive:       r.IsActive,
+			CreatedAt:      r.CreatedAt.AsTime(),
+		})
+	}
+	return rules, nil
+}
+
+// AddClinicalAlertRule добавляет правило клинического предупреждения
+func (s *AdminService) AddClinicalAlertRule(ctx context.Context, rule model.ClinicalAlertRule) (int, error) {
+	if err := validateClinicalAlertRule(&rule); err != nil {
+		return 0, err
+	}
+	resp, err := s.StorageClient.Client.AddClinicalAlertRule(ctx, clinicalAlertRuleToPb(rule))
+	if err != nil {
+		return 0, fmt.Errorf("не удалось добавить правило предупреждения через gRPC: %w", err)
+	}
+	return int(resp.Id), nil
+}
+
+// UpdateClinicalAlertRule изменяет правило клинического предупреждения, в том числе включает и выключает его
+func (s *AdminService) UpdateClinicalAlertRule(ctx context.Context, rule model.ClinicalAlertRule) error {
+	if err := validateClinicalAlertRule(&rule); err != nil {
+		return err
+	}
+	_, err := s.StorageClient.Client.UpdateClinicalAlertRule(ctx, clinicalAlertRuleToPb(rule))
+	if err != nil {
+		return fmt.Errorf("не удалось изменить правило предупреждения через gRPC: %w", err)
+	}
+	return nil
+}
+
+// DeleteClinicalAlertRule удаляет правило клинического предупреждения
+func (s *AdminService) DeleteClinicalAlertRule(ctx context.Context, id int) error {
+	_, err := s.StorageClient.Client.DeleteClinicalAlertRule(ctx, &storagepb.DeleteRequest{Id: int32(id)})
+	if err != nil {
+		return fmt.Errorf("не удалось удалить правило предупреждения через gRPC: %w", err)
+	}
+	return nil
+}
+
+// validateClinicalAlertRule проверяет условие, цель и важность правила и обрезает пробелы в текстовых полях
+func validateClinicalAlertRule(rule *model.ClinicalAlertRule) error {
+	rule.Title = strings.TrimSpace(rule.Title)
+	rule.ConditionValue = strings.TrimSpace(rule.ConditionValue)
+	rule.Message = strings.TrimSpace(rule.Message)
+	if rule.Title == "" || len([]rune(rule.Title)) > 200 {
+		return fmt.Errorf("название правила должно содержать от 1 до 200 символов: %w", sharederrors.ErrInvalidValue)
+	}
+	if rule.ConditionValue == "" || len([]rune(rule.ConditionValue)) > 100 {
+		return fmt.Errorf("условие правила должно содержать от 1 до 100 символов: %w", sharederrors.ErrInvalidValue)
+	}
+	if len([]rune(rule.Message)) > maxAlertMessageLength {
+		return fmt.Errorf("текст предупреждения не должен превышать %d символов: %w", maxAlertMessageLength, sharederrors.ErrInvalidValue)
+	}
+	switch rule.ConditionType {
+	case "allergy", "condition":
+	case "anamnesis_flag":
+		if !anamnesisFlags[rule.ConditionValue] {
+			return fmt.Errorf("неизвестный пункт анкеты %q: %w", rule.ConditionValue, sharederrors.ErrInvalidValue)
+		}
+	default:
+		return fmt.Errorf("неизвестный вид условия %q: %w", rule.ConditionType, sharederrors.ErrInvalidValue)
+	}
+	switch rule.TargetType {
+	case "visit":
+		rule.MaterialID, rule.ServiceID = 0, 0
+	case "material":
+		if rule.MaterialID <= 0 {
+			return fmt.Errorf("не указан материал правила: %w", sharederrors.ErrInvalidValue)
+		}
+		rule.ServiceID = 0
+	case "service":
+		if rule.ServiceID <= 0 {
+			return fmt.Errorf("не указана услуга правила: %w", sharederrors.ErrInvalidValue)
+		}
+		rule.MaterialID = 0
+	default:
+		return fmt.Errorf("неизвестная цель правила %q: %w", rule.TargetType, sharederrors.ErrInvalidValue)
+	}
+	switch rule.Severity {
+	case "info", "warning", "critical":
+	default:
+		return fmt.Errorf("неизвестная важность предупреждения %q: %w", rule.Severity, sharederrors.ErrInvalidValue)
+	}
+	return nil
+}
+
+func clinicalAlertRuleToPb(rule model.ClinicalAlertRule) *storagepb.ClinicalAlertRule {
+	return &storagepb.ClinicalAlertRule{
+		Id:             int32(rule.ID),
+		Title:          rule.Title,
+		ConditionType:  rule.ConditionType,
+		ConditionValue: rule.ConditionValue,
+		TargetType:     rule.TargetType,
+		MaterialId:     int32(rule.MaterialID),
+		ServiceId:      int32(rule.ServiceID),
+		Severity:       rule.Severity,
+		Message:        rule.Message,
+		IsActive:       rule.IsActive,
+	}
+}
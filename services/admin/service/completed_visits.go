@@ -5,14 +5,14 @@ import (
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/admin/model"
 	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
-	"github.com/DariaTarasek/diplom/services/admin/sharederrors"
 )
 
-func (s *AdminService) GetUnconfirmedVisitsPayments(ctx context.Context) ([]model.UnconfirmedVisitPayment, error) {
+// GetUnconfirmedVisitsPayments страница неподтверждённых оплат приёмов и число всех таких оплат
+func (s *AdminService) GetUnconfirmedVisitsPayments(ctx context.Context, page model.ListPage) ([]model.UnconfirmedVisitPayment, int, error) {
 	// получение счетов на визиты со статусом unconfirmed
-	resp, err := s.StorageClient.Client.GetVisitsPayments(ctx, &storagepb.EmptyRequest{})
+	resp, err := s.StorageClient.Client.GetVisitsPayments(ctx, &storagepb.GetVisitsPaymentsRequest{Page: listPageToPb(page)})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	var visitPayments []model.VisitPayment
 	for _, item := range resp.VisitPayment {
@@ -29,13 +29,13 @@ func (s *AdminService) GetUnconfirmedVisitsPayments(ctx context.Context) ([]mode
 	for _, item := range visitPayments {
 		visitsResp, err := s.StorageClient.Client.GetVisitByID(ctx, &storagepb.GetByIdRequest{Id: int32(item.VisitID)})
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		// получение ФИО врача по айди
 		doctorResp, err := s.StorageClient.Client.GetDoctorByID(ctx, &storagepb.GetByIDRequest{Id: visitsResp.Visit.DoctorId})
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		doctor := doctorResp.Doctor
 		doctorName := fmt.Sprintf("%s %s %s", doctor.SecondName, doctor.FirstName, doctor.Surname)
@@ -43,39 +43,32 @@ func (s *AdminService) GetUnconfirmedVisitsPayments(ctx context.Context) ([]mode
 		// получение ФИО пациента по айди
 		patientResp, err := s.StorageClient.Client.GetPatientByID(ctx, &storagepb.GetByIDRequest{Id: visitsResp.Visit.PatientId})
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 		patient := patientResp.Patient
 		patientName := fmt.Sprintf("%s %s %s", patient.SecondName, patient.FirstName, patient.Surname)
 
+		// счёт на приём: для приёмов до введения счетов выставляется здесь
+		invoice, err := s.GetVisitInvoice(ctx, item.VisitID)
+		if err != nil {
+			return nil, 0, err
+		}
+
 		// заполнение структуры неподтвержденной оплаты
 		unconfVP := model.UnconfirmedVisitPayment{
 			VisitID:   item.VisitID,
 			Doctor:    doctorName,
 			Patient:   patientName,
 			CreatedAt: visitsResp.Visit.CreatedAt.AsTime().Format("02.01.2006 15:04"),
-			Price:     item.Price,
+			Price:     invoice.Total,
+			InvoiceID: invoice.ID,
+			Paid:      invoice.Paid,
+			Balance:   invoice.Balance,
+			Status:    invoice.Status,
 		}
 		unconfirmedVisitPayments = append(unconfirmedVisitPayments, unconfVP)
 	}
-	return unconfirmedVisitPayments, nil
-}
-
-func (s *AdminService) UpdateVisitPayment(ctx context.Context, payment model.VisitPayment) error {
-	price := payment.Price
-	if price < 0 || price > 1000000 {
-		return fmt.Errorf("некорректное значение стоимости: %w", sharederrors.ErrInvalidValue)
-	}
-	paymentReq := &storagepb.VisitPayment{
-		VisitId: int32(payment.VisitID),
-		Price:   int32(payment.Price),
-		Status:  payment.Status,
-	}
-	_, err := s.StorageClient.Client.AddOrUpdateVisitPayment(ctx, &storagepb.AddOrUpdateVisitPaymentRequest{Payment: paymentReq})
-	if err != nil {
-		return fmt.Errorf("не удалось подтвердить стоимость приема: %w", err)
-	}
-	return nil
+	return unconfirmedVisitPayments, int(resp.Total), nil
 }
 
 func (s *AdminService) GetVisitMaterialsAndServices(ctx context.Context, visitID int) ([]model.VisitMaterialsServices, error) {
@@ -96,10 +89,12 @@ func (s *AdminService) GetVisitMaterialsAndServices(ctx context.Context, visitID
 			return nil, fmt.Errorf("не удалось получить название материала: %w", err)
 		}
 		material := model.VisitMaterialsServices{
-			ID:       int(item.Id),
-			VisitID:  int(item.VisitId),
-			Item:     materialName.Name,
-			Quantity: int(item.Quantity),
+			ID:           int(item.Id),
+			VisitID:      int(item.VisitId),
+			Item:         materialName.Name,
+			Quantity:     int(item.Quantity),
+			PricePerUnit: optionalInt(item.PricePerUnit),
+			TotalPrice:   optionalInt(item.TotalPrice),
 		}
 		materialsServices = append(materialsServices, material)
 	}
@@ -109,12 +104,23 @@ func (s *AdminService) GetVisitMaterialsAndServices(ctx context.Context, visitID
 			return nil, fmt.Errorf("не удалось получить название услуги: %w", err)
 		}
 		service := model.VisitMaterialsServices{
-			ID:       int(item.Id),
-			VisitID:  int(item.VisitId),
-			Item:     serviceName.Name,
-			Quantity: int(item.Quantity),
+			ID:           int(item.Id),
+			VisitID:      int(item.VisitId),
+			Item:         serviceName.Name,
+			Quantity:     int(item.Quantity),
+			PricePerUnit: optionalInt(item.PricePerUnit),
+			TotalPrice:   optionalInt(item.TotalPrice),
 		}
 		materialsServices = append(materialsServices, service)
 	}
 	return materialsServices, nil
 }
+
+// optionalInt значение необязательного поля proto
+func optionalInt(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/DariaTarasek/diplom/services/admin/model"
 	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
 	"slices"
-	"sort"
 	"strings"
 )
 
@@ -26,16 +25,17 @@ func (s *AdminService) GetSpecs(ctx context.Context) ([]model.Spec, error) {
 	return specs, nil
 }
 
-func (s *AdminService) GetDoctors(ctx context.Context) ([]model.Doctor, error) {
-	resp, err := s.StorageClient.Client.GetDoctors(ctx, &storagepb.EmptyRequest{})
+// GetDoctors страница списка врачей, найденных по query, и число всех найденных
+func (s *AdminService) GetDoctors(ctx context.Context, filter model.PersonFilter, page model.ListPage) ([]model.Doctor, int, error) {
+	resp, err := s.StorageClient.Client.GetDoctors(ctx, &storagepb.GetDoctorsRequest{Page: listPageToPb(page), Query: filter.Query, Inactive: filter.Inactive})
 	if err != nil {
-		return nil, fmt.Errorf("не удалось получить список врачей: %w", err)
+		return nil, 0, fmt.Errorf("не удалось получить список врачей: %w", err)
 	}
 	var doctors []model.Doctor
 	for _, item := range resp.Doctors {
 		specsResp, err := s.StorageClient.Client.GetDoctorSpecsByDoctorId(ctx, &storagepb.GetByIdRequest{Id: item.UserId})
 		if err != nil {
-			return nil, fmt.Errorf("не удалось получить специальности врача: %w", err)
+			return nil, 0, fmt.Errorf("не удалось получить специальности врача: %w", err)
 		}
 		var specs []int
 		for _, specItem := range specsResp.Specs {
@@ -57,11 +57,7 @@ func (s *AdminService) GetDoctors(ctx context.Context) ([]model.Doctor, error) {
 		doctors = append(doctors, doctor)
 	}
 
-	sort.Slice(doctors, func(i, j int) bool {
-		return doctors[i].SecondName < doctors[j].SecondName
-	})
-
-	return doctors, nil
+	return doctors, int(resp.Total), nil
 }
 
 func (s *AdminService) UpdateDoctor(ctx context.Context, doctor model.Doctor) error {
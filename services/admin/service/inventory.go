@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/admin/model"
+	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+	"github.com/DariaTarasek/diplom/services/admin/sharederrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultMaterialUnit единица учёта материала, если она не указана
+	defaultMaterialUnit = "шт"
+	// maxStockQuantity наибольшее количество в одной партии или корректировке
+	maxStockQuantity = 100000
+	// defaultExpiringWithinDays за сколько дней до истечения срока годности партия попадает в отчёт
+	defaultExpiringWithinDays = 30
+	maxExpiringWithinDays     = 365
+)
+
+// validateMaterialStockSettings проверяет единицу учёта и порог низкого остатка, пустая единица заменяется на «шт»
+func validateMaterialStockSettings(material *model.Material) error {
+	material.Unit = strings.TrimSpace(material.Unit)
+	if material.Unit == "" {
+		material.Unit = defaultMaterialUnit
+	}
+	if len([]rune(material.Unit)) > 20 {
+		return fmt.Errorf("слишком длинная единица учёта материала: %w", sharederrors.ErrInvalidValue)
+	}
+	if material.LowStockThreshold < 0 || material.LowStockThreshold > maxStockQuantity {
+		return fmt.Errorf("некорректный порог низкого остатка материала: %w", sharederrors.ErrInvalidValue)
+	}
+	return nil
+}
+
+// ReceiveMaterialBatch оприходование партии материала
+func (s *AdminService) ReceiveMaterialBatch(ctx context.Context, batch model.MaterialBatch) (model.MaterialBatch, error) {
+	if batch.QuantityReceived <= 0 || batch.QuantityReceived > maxStockQuantity {
+		return model.MaterialBatch{}, fmt.Errorf("некорректное количество в партии: %w", sharederrors.ErrInvalidValue)
+	}
+	req := &storagepb.ReceiveMaterialBatchRequest{
+		MaterialId:  int32(batch.MaterialID),
+		BatchNumber: strings.TrimSpace(batch.BatchNumber),
+		Quantity:    int32(batch.QuantityReceived),
+		Comment:     batch.Comment,
+	}
+	if batch.ExpiresAt != nil {
+		req.ExpiresAt = timestamppb.New(*batch.ExpiresAt)
+	}
+	resp, err := s.StorageClient.Client.ReceiveMaterialBatch(ctx, req)
+	if err != nil {
+		return model.MaterialBatch{}, fmt.Errorf("не удалось оприходовать партию материала: %w", err)
+	}
+	return materialBatchFromPb(resp), nil
+}
+
+// AdjustMaterialStock корректировка остатка по итогам инвентаризации или списание брака; причина обязательна
+func (s *AdminService) AdjustMaterialStock(ctx context.Context, adj model.StockAdjustment) error {
+	if adj.Quantity == 0 || adj.Quantity < -maxStockQuantity || adj.Quantity > maxStockQuantity {
+		return fmt.Errorf("некорректное количество для корректировки остатка: %w", sharederrors.ErrInvalidValue)
+	}
+	adj.Reason = strings.TrimSpace(adj.Reason)
+	if adj.Reason == "" {
+		return fmt.Errorf("не указана причина корректировки остатка: %w", sharederrors.ErrInvalidValue)
+	}
+	_, err := s.StorageClient.Client.AdjustMaterialStock(ctx, &storagepb.AdjustMaterialStockRequest{
+		MaterialId: int32(adj.MaterialID),
+		BatchId:    int32(adj.BatchID),
+		Quantity:   int32(adj.Quantity),
+		Reason:     adj.Reason,
+	})
+	if err != nil {
+		return fmt.Errorf("не удалось скорректировать остаток материала: %w", err)
+	}
+	return nil
+}
+
+// GetMaterialStock остаток материала с партиями и последними движениями
+func (s *AdminService) GetMaterialStock(ctx context.Context, materialID int) (model.MaterialStock, error) {
+	resp, err := s.StorageClient.Client.GetMaterialStock(ctx, &storagepb.GetByIdRequest{Id: int32(materialID)})
+	if err != nil {
+		return model.MaterialStock{}, fmt.Errorf("не удалось получить остаток материала: %w", err)
+	}
+	stock := model.MaterialStock{Material: materialFromPb(resp.Material)}
+	for _, batch := range resp.Batches {
+		stock.Batches = append(stock.Batches, materialBatchFromPb(batch))
+	}
+	for _, m := range resp.Movements {
+		stock.Movements = append(stock.Movements, model.StockMovement{
+			ID:         int(m.Id),
+			MaterialID: int(m.MaterialId),
+			BatchID:    int(m.BatchId),
+			Kind:       m.Kind,
+			Quantity:   int(m.Quantity),
+			VisitID:    int(m.VisitId),
+			Reason:     m.Reason,
+			CreatedAt:  m.CreatedAt.AsTime(),
+		})
+	}
+	return stock, nil
+}
+
+// GetStockReport материалы с низким остатком и партии, срок годности которых истекает в ближайшие days дней
+// по календарю клиники; 0 — 30 дней
+func (s *AdminService) GetStockReport(ctx context.Context, days int) (model.StockReport, error) {
+	if days == 0 {
+		days = defaultExpiringWithinDays
+	}
+	if days < 0 || days > maxExpiringWithinDays {
+		return model.StockReport{}, fmt.Errorf("некорректный срок для проверки годности: %w", sharederrors.ErrInvalidValue)
+	}
+	loc, err := s.clinicLocation(ctx)
+	if err != nil {
+		return model.StockReport{}, err
+	}
+	now := time.Now().In(loc)
+	expiringBefore := time.Date(now.Year(), now.Month(), now.Day()+days, 0, 0, 0, 0, time.UTC)
+	resp, err := s.StorageClient.Client.GetStockReport(ctx, &storagepb.StockReportRequest{
+		ExpiringBefore: timestamppb.New(expiringBefore),
+	})
+	if err != nil {
+		return model.StockReport{}, fmt.Errorf("не удалось получить отчёт по складу: %w", err)
+	}
+	var report model.StockReport
+	for _, material := range resp.LowStock {
+		report.LowStock = append(report.LowStock, materialFromPb(material))
+	}
+	for _, item := range resp.Expiring {
+		report.Expiring = append(report.Expiring, model.ExpiringBatch{
+			MaterialBatch: materialBatchFromPb(item.Batch),
+			MaterialName:  item.MaterialName,
+			Unit:          item.Unit,
+		})
+	}
+	return report, nil
+}
+
+func materialFromPb(m *storagepb.Material) model.Material {
+	return model.Material{
+		ID:                int(m.Id),
+		Name:              m.Name,
+		Price:             int(m.Price),
+		Unit:              m.Unit,
+		LowStockThreshold: int(m.LowStockThreshold),
+		StockQuantity:     int(m.StockQuantity),
+	}
+}
+
+func materialBatchFromPb(b *storagepb.MaterialBatch) model.MaterialBatch {
+	batch := model.MaterialBatch{
+		ID:               int(b.Id),
+		MaterialID:       int(b.MaterialId),
+		BatchNumber:      b.BatchNumber,
+		QuantityReceived: int(b.QuantityReceived),
+		QuantityLeft:     int(b.QuantityLeft),
+		ReceivedAt:       b.ReceivedAt.AsTime(),
+		Comment:          b.Comment,
+	}
+	if b.ExpiresAt != nil {
+		expiresAt := b.ExpiresAt.AsTime()
+		batch.ExpiresAt = &expiresAt
+	}
+	return batch
+}
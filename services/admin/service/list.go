@@ -0,0 +1,26 @@
+package service
+
+import (
+	"github.com/DariaTarasek/diplom/services/admin/model"
+	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// listPageToPb страница списка для запроса к хранилищу
+func listPageToPb(page model.ListPage) *storagepb.ListPage {
+	return &storagepb.ListPage{
+		Limit:  int32(page.Limit),
+		Offset: int32(page.Offset),
+		Sort:   page.Sort,
+		Desc:   page.Desc,
+	}
+}
+
+// optionalDate граница периода для запроса к хранилищу; nil — без границы
+func optionalDate(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
@@ -13,9 +13,14 @@ func (s *AdminService) AddMaterial(ctx context.Context, material model.Material)
 	if price < 0 || price > 1000000 {
 		return fmt.Errorf("некорректное значение цены материала: %w", sharederrors.ErrInvalidValue)
 	}
+	if err := validateMaterialStockSettings(&material); err != nil {
+		return err
+	}
 	_, err := s.StorageClient.Client.AddMaterial(ctx, &storagepb.AddMaterialRequest{
-		Name:  material.Name,
-		Price: int32(material.Price),
+		Name:              material.Name,
+		Price:             int32(material.Price),
+		Unit:              material.Unit,
+		LowStockThreshold: int32(material.LowStockThreshold),
 	})
 	if err != nil {
 		return fmt.Errorf("не удалось добавить материал: %w", err)
@@ -28,10 +33,14 @@ func (s *AdminService) AddService(ctx context.Context, service model.Service) er
 	if price < 0 || price > 1000000 {
 		return fmt.Errorf("некорректное значение цены услуги: %w", sharederrors.ErrInvalidValue)
 	}
+	if err := validateServiceDuration(service.DurationMinutes); err != nil {
+		return err
+	}
 	_, err := s.StorageClient.Client.AddService(ctx, &storagepb.AddServiceRequest{
-		Name:  service.Name,
-		Price: int32(service.Price),
-		Type:  int32(service.Category),
+		Name:            service.Name,
+		Price:           int32(service.Price),
+		Type:            int32(service.Category),
+		DurationMinutes: int32(service.DurationMinutes),
 	})
 	if err != nil {
 		return fmt.Errorf("не удалось добавить услугу: %w", err)
@@ -44,10 +53,15 @@ func (s *AdminService) UpdateMaterial(ctx context.Context, material model.Materi
 	if price < 0 || price > 1000000 {
 		return fmt.Errorf("некорректное значение цены материала: %w", sharederrors.ErrInvalidValue)
 	}
+	if err := validateMaterialStockSettings(&material); err != nil {
+		return err
+	}
 	_, err := s.StorageClient.Client.UpdateMaterial(ctx, &storagepb.UpdateMaterialRequest{
-		Id:    int32(material.ID),
-		Name:  material.Name,
-		Price: int32(price),
+		Id:                int32(material.ID),
+		Name:              material.Name,
+		Price:             int32(price),
+		Unit:              material.Unit,
+		LowStockThreshold: int32(material.LowStockThreshold),
 	})
 	if err != nil {
 		return fmt.Errorf("не удалось обновить материал: %w", err)
@@ -60,11 +74,15 @@ func (s *AdminService) UpdateService(ctx context.Context, service model.Service)
 	if price < 0 || price > 1000000 {
 		return fmt.Errorf("некорректное значение цены услуги: %w", sharederrors.ErrInvalidValue)
 	}
+	if err := validateServiceDuration(service.DurationMinutes); err != nil {
+		return err
+	}
 	_, err := s.StorageClient.Client.UpdateService(ctx, &storagepb.UpdateServiceRequest{
-		Id:    int32(service.ID),
-		Name:  service.Name,
-		Price: int32(service.Price),
-		Type:  int32(service.Category),
+		Id:              int32(service.ID),
+		Name:            service.Name,
+		Price:           int32(service.Price),
+		Type:            int32(service.Category),
+		DurationMinutes: int32(service.DurationMinutes),
 	})
 	if err != nil {
 		return fmt.Errorf("не удалось обновить услугу: %w", err)
@@ -87,3 +105,14 @@ func (s *AdminService) DeleteService(ctx context.Context, id int) error {
 	}
 	return nil
 }
+
+// validateServiceDuration проверяет длительность услуги; 0 означает длительность по умолчанию
+func validateServiceDuration(minutes int) error {
+	if minutes == 0 {
+		return nil
+	}
+	if minutes < minDurationMinutes || minutes > maxDurationMinutes {
+		return fmt.Errorf("некорректная длительность услуги: %w", sharederrors.ErrInvalidValue)
+	}
+	return nil
+}
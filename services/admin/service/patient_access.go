@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/admin/model"
+	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+	"github.com/DariaTarasek/diplom/services/admin/sharederrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"time"
+)
+
+// maxAccessGrantReasonLength наибольшая длина причины выдачи допуска
+const maxAccessGrantReasonLength = 500
+
+// GetPatientAccessGrants допуски врачей к карте пациента, новые первыми, включая истёкшие и отозванные
+func (s *AdminService) GetPatientAccessGrants(ctx context.Context, patientID int) ([]model.PatientAccessGrant, error) {
+	resp, err := s.StorageClient.Client.GetPatientAccessGrants(ctx, &storagepb.GetByIdRequest{Id: int32(patientID)})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить допуски к карте пациента через gRPC: %w", err)
+	}
+	grants := make([]model.PatientAccessGrant, 0, len(resp.Grants))
+	for _, g := range resp.Grants {
+		grant := model.PatientAccessGrant{
+			ID:        int(g.Id),
+			PatientID: int(g.PatientId),
+			DoctorID:  int(g.DoctorId),
+			Doctor:    g.Doctor,
+			Reason:    g.Reason,
+			GrantedBy: int(g.GrantedBy),
+			CreatedAt: g.CreatedAt.AsTime(),
+		}
+		if g.ExpiresAt != nil {
+			expiresAt := g.ExpiresAt.AsTime()
+			grant.ExpiresAt = &expiresAt
+		}
+		if g.RevokedAt != nil {
+			revokedAt := g.RevokedAt.AsTime()
+			grant.RevokedAt = &revokedAt
+		}
+		grants = append(grants, grant)
+	}
+	return grants, nil
+}
+
+// AddPatientAccessGrant выдаёт врачу допуск к карте пациента, которого он не лечит. Пока допуск действует,
+// врач видит историю приёмов, документы и остальную карту пациента так же, как лечащий врач
+func (s *AdminService) AddPatientAccessGrant(ctx context.Context, grant model.PatientAccessGrant) (int, error) {
+	grant.Reason = strings.TrimSpace(grant.Reason)
+	if grant.PatientID <= 0 || grant.DoctorID <= 0 {
+		return 0, fmt.Errorf("не указан пациент или врач допуска: %w", sharederrors.ErrInvalidValue)
+	}
+	if grant.Reason == "" || len([]rune(grant.Reason)) > maxAccessGrantReasonLength {
+		return 0, fmt.Errorf("причина допуска должна содержать от 1 до %d символов: %w", maxAccessGrantReasonLength, sharederrors.ErrInvalidValue)
+	}
+	if grant.ExpiresAt != nil && !grant.ExpiresAt.After(time.Now()) {
+		return 0, fmt.Errorf("срок действия допуска уже прошёл: %w", sharederrors.ErrInvalidValue)
+	}
+	req := &storagepb.PatientAccessGrant{
+		PatientId: int32(grant.PatientID),
+		DoctorId:  int32(grant.DoctorID),
+		Reason:    grant.Reason,
+		GrantedBy: int32(grant.GrantedBy),
+	}
+	if grant.ExpiresAt != nil {
+		req.ExpiresAt = timestamppb.New(*grant.ExpiresAt)
+	}
+	resp, err := s.StorageClient.Client.AddPatientAccessGrant(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось выдать допуск к карте через gRPC: %w", err)
+	}
+	return int(resp.Id), nil
+}
+
+// RevokePatientAccessGrant отзывает допуск к карте; запись о нём остаётся в списке допусков пациента
+func (s *AdminService) RevokePatientAccessGrant(ctx context.Context, id int) error {
+	_, err := s.StorageClient.Client.RevokePatientAccessGrant(ctx, &storagepb.DeleteRequest{Id: int32(id)})
+	if err != nil {
+		return fmt.Errorf("не удалось отозвать допуск к карте через gRPC: %w", err)
+	}
+	return nil
+}
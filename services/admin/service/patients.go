@@ -9,7 +9,6 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"sort"
 	"strings"
 	"time"
 )
@@ -19,10 +18,11 @@ func NormalizeWord(input string) string {
 	return caser.String(strings.ToLower(strings.TrimSpace(input)))
 }
 
-func (s *AdminService) GetPatients(ctx context.Context) ([]model.Patient, error) {
-	resp, err := s.StorageClient.Client.GetPatients(ctx, &storagepb.EmptyRequest{})
+// GetPatients страница списка пациентов, найденных по query, и число всех найденных
+func (s *AdminService) GetPatients(ctx context.Context, filter model.PersonFilter, page model.ListPage) ([]model.Patient, int, error) {
+	resp, err := s.StorageClient.Client.GetPatients(ctx, &storagepb.GetPatientsRequest{Page: listPageToPb(page), Query: filter.Query, Inactive: filter.Inactive})
 	if err != nil {
-		return nil, fmt.Errorf("не удалось получить список пациентов: %w", err)
+		return nil, 0, fmt.Errorf("не удалось получить список пациентов: %w", err)
 	}
 	var patients []model.Patient
 	for _, item := range resp.Patients {
@@ -38,11 +38,7 @@ func (s *AdminService) GetPatients(ctx context.Context) ([]model.Patient, error)
 		}
 		patients = append(patients, patient)
 	}
-	sort.Slice(patients, func(i, j int) bool {
-		return patients[i].SecondName < patients[j].SecondName
-	})
-
-	return patients, nil
+	return patients, int(resp.Total), nil
 }
 
 func (s *AdminService) UpdatePatient(ctx context.Context, patient model.Patient) error {
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/admin/model"
+	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+	"github.com/DariaTarasek/diplom/services/admin/sharederrors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// GetPriceHistory история цен услуги или материала, включая запланированные изменения
+func (s *AdminService) GetPriceHistory(ctx context.Context, kind string, itemID int) ([]model.PriceListEntry, error) {
+	if err := validatePriceKind(kind); err != nil {
+		return nil, err
+	}
+	resp, err := s.StorageClient.Client.GetPriceHistory(ctx, &storagepb.GetPriceHistoryRequest{Kind: kind, ItemId: int32(itemID)})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить историю цен через gRPC: %w", err)
+	}
+	entries := make([]model.PriceListEntry, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		entries = append(entries, model.PriceListEntry{
+			ID:            int(e.Id),
+			ItemID:        int(e.ItemId),
+			Price:         int(e.Price),
+			EffectiveFrom: e.EffectiveFrom.AsTime(),
+			CreatedAt:     e.CreatedAt.AsTime(),
+		})
+	}
+	return entries, nil
+}
+
+// AddPriceListEntry устанавливает цену услуги или материала с даты entry.EffectiveFrom: сегодня или позже.
+// Визиты, записанные до этой даты, сохраняют прежнюю цену
+func (s *AdminService) AddPriceListEntry(ctx context.Context, kind string, entry model.PriceListEntry) (int, error) {
+	if err := validatePriceKind(kind); err != nil {
+		return 0, err
+	}
+	if entry.Price < 0 || entry.Price > 1000000 {
+		return 0, fmt.Errorf("некорректное значение цены: %w", sharederrors.ErrInvalidValue)
+	}
+	if entry.EffectiveFrom.IsZero() {
+		return 0, fmt.Errorf("не указана дата начала действия цены: %w", sharederrors.ErrInvalidValue)
+	}
+	resp, err := s.StorageClient.Client.AddPriceListEntry(ctx, &storagepb.AddPriceListEntryRequest{
+		Kind: kind,
+		Entry: &storagepb.PriceListEntry{
+			ItemId:        int32(entry.ItemID),
+			Price:         int32(entry.Price),
+			EffectiveFrom: timestamppb.New(entry.EffectiveFrom),
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("не удалось установить цену через gRPC: %w", err)
+	}
+	return int(resp.Id), nil
+}
+
+// DeletePriceListEntry отменяет запланированное изменение цены
+func (s *AdminService) DeletePriceListEntry(ctx context.Context, kind string, id int) error {
+	if err := validatePriceKind(kind); err != nil {
+		return err
+	}
+	_, err := s.StorageClient.Client.DeletePriceListEntry(ctx, &storagepb.DeletePriceListEntryRequest{Kind: kind, Id: int32(id)})
+	if err != nil {
+		return fmt.Errorf("не удалось отменить изменение цены через gRPC: %w", err)
+	}
+	return nil
+}
+
+// GetPriceOnDate цена услуги или материала, действовавшая в указанный день
+func (s *AdminService) GetPriceOnDate(ctx context.Context, kind string, itemID int, date time.Time) (int, error) {
+	if err := validatePriceKind(kind); err != nil {
+		return 0, err
+	}
+	resp, err := s.StorageClient.Client.GetPriceOnDate(ctx, &storagepb.GetPriceOnDateRequest{
+		Kind:   kind,
+		ItemId: int32(itemID),
+		Date:   timestamppb.New(date),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("не удалось получить цену на дату через gRPC: %w", err)
+	}
+	return int(resp.Price), nil
+}
+
+func validatePriceKind(kind string) error {
+	if kind != model.PriceKindService && kind != model.PriceKindMaterial {
+		return fmt.Errorf("неизвестный прайс-лист %q: %w", kind, sharederrors.ErrInvalidValue)
+	}
+	return nil
+}
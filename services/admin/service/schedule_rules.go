@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/admin/model"
+	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+	"github.com/DariaTarasek/diplom/services/admin/sharederrors"
+	"github.com/DariaTarasek/diplom/services/scheduling"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// GetScheduleRules правила расписания клиники (doctorID == 0) или врача
+func (s *AdminService) GetScheduleRules(ctx context.Context, doctorID int) ([]model.ScheduleRule, error) {
+	resp, err := s.StorageClient.Client.GetScheduleRules(ctx, &storagepb.GetScheduleRulesRequest{DoctorId: int32(doctorID)})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить правила расписания через gRPC: %w", err)
+	}
+	rules := make([]model.ScheduleRule, 0, len(resp.Rules))
+	for _, r := range resp.Rules {
+		rule := model.ScheduleRule{
+			ID:                  int(r.Id),
+			DoctorID:            int(r.DoctorId),
+			Recurrence:          r.Recurrence,
+			DateFrom:            r.DateFrom.AsTime(),
+			Weekday:             int(r.Weekday),
+			Nth:                 int(r.Nth),
+			StartTime:           r.StartTime.AsTime(),
+			EndTime:             r.EndTime.AsTime(),
+			SlotDurationMinutes: int(r.SlotDurationMinutes),
+			IsDayOff:            r.IsDayOff,
+			Title:               r.Title,
+		}
+		if r.DateTo != nil {
+			dateTo := r.DateTo.AsTime()
+			rule.DateTo = &dateTo
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// AddScheduleRule добавляет правило расписания (отпуск, сокращённые дни, праздник) и возвращает будущие записи,
+// которые после этого оказались вне рабочих часов и требуют переноса
+func (s *AdminService) AddScheduleRule(ctx context.Context, rule model.ScheduleRule) (int, []model.Appointment, error) {
+	if err := validateScheduleRule(rule); err != nil {
+		return 0, nil, err
+	}
+	resp, err := s.StorageClient.Client.AddScheduleRule(ctx, &storagepb.AddScheduleRuleRequest{Rule: scheduleRuleToPb(rule)})
+	if err != nil {
+		return 0, nil, fmt.Errorf("не удалось добавить правило расписания через gRPC: %w", err)
+	}
+	clashes, err := s.findScheduleClashes(ctx, rule.DoctorID)
+	if err != nil {
+		return 0, nil, err
+	}
+	return int(resp.Id), clashes, nil
+}
+
+// DeleteScheduleRule удаляет правило расписания
+func (s *AdminService) DeleteScheduleRule(ctx context.Context, id int) error {
+	_, err := s.StorageClient.Client.DeleteScheduleRule(ctx, &storagepb.DeleteRequest{Id: int32(id)})
+	if err != nil {
+		return fmt.Errorf("не удалось удалить правило расписания через gRPC: %w", err)
+	}
+	return nil
+}
+
+// ImportHolidays загружает календарь праздников как выходные дни клиники. Уже загруженные праздники пропускаются.
+// Возвращает количество добавленных праздников и будущие записи, попавшие на праздники
+func (s *AdminService) ImportHolidays(ctx context.Context, holidays []model.Holiday) (int, []model.Appointment, error) {
+	if len(holidays) == 0 {
+		return 0, nil, fmt.Errorf("календарь праздников пуст: %w", sharederrors.ErrInvalidValue)
+	}
+	rules := make([]*storagepb.ScheduleRule, 0, len(holidays))
+	for _, h := range holidays {
+		if h.Date.IsZero() {
+			return 0, nil, fmt.Errorf("у праздника %q не указана дата: %w", h.Title, sharederrors.ErrInvalidValue)
+		}
+		rule := model.ScheduleRule{
+			Recurrence: "annual",
+			DateFrom:   h.Date,
+			IsDayOff:   true,
+			Title:      h.Title,
+		}
+		if !h.Annual {
+			rule.Recurrence = "range"
+			rule.DateTo = &h.Date
+		}
+		rules = append(rules, scheduleRuleToPb(rule))
+	}
+	resp, err := s.StorageClient.Client.ImportScheduleRules(ctx, &storagepb.ImportScheduleRulesRequest{Rules: rules})
+	if err != nil {
+		return 0, nil, fmt.Errorf("не удалось загрузить календарь праздников через gRPC: %w", err)
+	}
+	clashes, err := s.findScheduleClashes(ctx, 0)
+	if err != nil {
+		return 0, nil, err
+	}
+	return int(resp.Added), clashes, nil
+}
+
+// findScheduleClashes будущие записи врача (doctorID == 0 — всех врачей), которые не помещаются в рабочие часы
+// по текущему расписанию: день стал выходным или часы сократились
+func (s *AdminService) findScheduleClashes(ctx context.Context, doctorID int) ([]model.Appointment, error) {
+	// прошедшие записи отбрасываются по дате с запасом в сутки на разницу пояса клиники и UTC, остальные — по времени начала ниже
+	resp, err := s.StorageClient.Client.GetAppointments(ctx, &storagepb.GetAppointmentsRequest{
+		DateFrom: timestamppb.New(scheduling.Day(time.Now().AddDate(0, 0, -1), time.UTC)),
+		DoctorId: int32(doctorID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить записи: %w", err)
+	}
+	appointments := resp.Appointments
+
+	schedules := make(map[int32]scheduling.Schedule)
+	doctorNames := make(map[int32]string)
+	clashes := []model.Appointment{}
+	for _, app := range appointments {
+		if app.Status == "cancelled" || app.Status == "completed" {
+			continue
+		}
+		schedule, ok := schedules[app.DoctorId]
+		if !ok {
+			var err error
+			schedule, err = s.loadDoctorSchedule(ctx, app.DoctorId)
+			if err != nil {
+				return nil, err
+			}
+			schedules[app.DoctorId] = schedule
+		}
+		start := scheduling.Wall(app.Date.AsTime(), app.Time.AsTime(), schedule.Location)
+		if start.Before(time.Now()) || schedule.Fits(start, int(app.DurationMinutes)) {
+			continue
+		}
+
+		name, ok := doctorNames[app.DoctorId]
+		if !ok {
+			doctorResp, err := s.StorageClient.Client.GetDoctorByID(ctx, &storagepb.GetByIDRequest{Id: app.DoctorId})
+			if err != nil {
+				return nil, fmt.Errorf("не удалось получить врача записи: %w", err)
+			}
+			name = fmt.Sprintf("%s %s %s", doctorResp.Doctor.SecondName, doctorResp.Doctor.FirstName, doctorResp.Doctor.Surname)
+			doctorNames[app.DoctorId] = name
+		}
+		clashes = append(clashes, model.Appointment{
+			ID:                int(app.Id),
+			Doctor:            name,
+			PatientID:         int(app.PatientId),
+			Date:              app.Date.AsTime().Format("02.01.2006"),
+			Time:              app.Time.AsTime().Format("15:04"),
+			PatientFirstName:  app.FirstName,
+			PatientSecondName: app.SecondName,
+			PatientSurname:    app.Surname,
+			PatientBirthDate:  app.BirthDate.AsTime().Format("02.01.2006"),
+			Gender:            app.Gender,
+			PhoneNumber:       app.PhoneNumber,
+			Status:            app.Status,
+			CreatedAt:         app.CreatedAt.AsTime().Format("02.01.2006"),
+			UpdatedAt:         app.UpdatedAt.AsTime().Format("02.01.2006"),
+		})
+	}
+	return clashes, nil
+}
+
+func validateScheduleRule(rule model.ScheduleRule) error {
+	if _, ok := scheduling.ParseRecurrence(rule.Recurrence); !ok {
+		return fmt.Errorf("неизвестный вид правила %q: %w", rule.Recurrence, sharederrors.ErrInvalidValue)
+	}
+	if rule.DateFrom.IsZero() {
+		return fmt.Errorf("не указана дата начала действия правила: %w", sharederrors.ErrInvalidValue)
+	}
+	if rule.Recurrence == "range" && rule.DateTo == nil {
+		return fmt.Errorf("у диапазона не указана дата окончания: %w", sharederrors.ErrInvalidValue)
+	}
+	if rule.DateTo != nil && rule.DateTo.Before(rule.DateFrom) {
+		return fmt.Errorf("дата окончания раньше даты начала: %w", sharederrors.ErrInvalidValue)
+	}
+	if rule.Recurrence == "monthly_weekday" {
+		if rule.Weekday < 0 || rule.Weekday > 6 {
+			return fmt.Errorf("некорректный день недели: %w", sharederrors.ErrInvalidValue)
+		}
+		if rule.Nth != -1 && (rule.Nth < 1 || rule.Nth > 5) {
+			return fmt.Errorf("некорректный номер недели месяца: %w", sharederrors.ErrInvalidValue)
+		}
+	}
+	if rule.IsDayOff {
+		return nil
+	}
+	if !rule.StartTime.Before(rule.EndTime) {
+		return fmt.Errorf("некорректный диапазон рабочего времени: %w", sharederrors.ErrInvalidValue)
+	}
+	if rule.SlotDurationMinutes != 0 && (rule.SlotDurationMinutes < minDurationMinutes || rule.SlotDurationMinutes > maxDurationMinutes) {
+		return fmt.Errorf("некорректная продолжительность приема: %w", sharederrors.ErrInvalidValue)
+	}
+	return nil
+}
+
+func scheduleRuleToPb(rule model.ScheduleRule) *storagepb.ScheduleRule {
+	res := &storagepb.ScheduleRule{
+		DoctorId:            int32(rule.DoctorID),
+		Recurrence:          rule.Recurrence,
+		DateFrom:            timestamppb.New(rule.DateFrom),
+		Weekday:             int32(rule.Weekday),
+		Nth:                 int32(rule.Nth),
+		StartTime:           timestamppb.New(rule.StartTime),
+		EndTime:             timestamppb.New(rule.EndTime),
+		SlotDurationMinutes: int32(rule.SlotDurationMinutes),
+		IsDayOff:            rule.IsDayOff,
+		Title:               rule.Title,
+	}
+	if rule.DateTo != nil {
+		res.DateTo = timestamppb.New(*rule.DateTo)
+	}
+	return res
+}
@@ -8,41 +8,44 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (s *AdminService) GetUnconfirmedAppointments(ctx context.Context) ([]model.Appointment, error) {
-	resp, err := s.StorageClient.Client.GetAppointments(ctx, &storagepb.EmptyRequest{})
+// GetUnconfirmedAppointments страница неподтверждённых записей с датой приёма в периоде period и число всех таких записей
+func (s *AdminService) GetUnconfirmedAppointments(ctx context.Context, period model.DatePeriod, page model.ListPage) ([]model.Appointment, int, error) {
+	resp, err := s.StorageClient.Client.GetAppointments(ctx, &storagepb.GetAppointmentsRequest{
+		Page:     listPageToPb(page),
+		DateFrom: optionalDate(period.From),
+		DateTo:   optionalDate(period.To),
+		Statuses: []string{"unconfirmed"},
+	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	var unconfirmedAppts []model.Appointment
 	for _, item := range resp.Appointments {
-		if item.Status == "unconfirmed" {
-
-			doctorResp, err := s.StorageClient.Client.GetDoctorByID(ctx, &storagepb.GetByIDRequest{Id: item.DoctorId})
-			if err != nil {
-				return nil, err
-			}
-			doctorName := fmt.Sprintf("%s %s %s", doctorResp.Doctor.SecondName, doctorResp.Doctor.FirstName, doctorResp.Doctor.Surname)
+		doctorResp, err := s.StorageClient.Client.GetDoctorByID(ctx, &storagepb.GetByIDRequest{Id: item.DoctorId})
+		if err != nil {
+			return nil, 0, err
+		}
+		doctorName := fmt.Sprintf("%s %s %s", doctorResp.Doctor.SecondName, doctorResp.Doctor.FirstName, doctorResp.Doctor.Surname)
 
-			appt := model.Appointment{
-				ID:                int(item.Id),
-				Doctor:            doctorName,
-				PatientID:         int(item.PatientId),
-				Date:              item.Date.AsTime().Format("02.01.2006"),
-				Time:              item.Time.AsTime().Format("15:04"),
-				PatientFirstName:  item.FirstName,
-				PatientSecondName: item.SecondName,
-				PatientSurname:    item.Surname,
-				PatientBirthDate:  item.BirthDate.AsTime().Format("02.01.2006"),
-				Gender:            item.Gender,
-				PhoneNumber:       item.PhoneNumber,
-				Status:            item.Status,
-				CreatedAt:         item.CreatedAt.AsTime().Format("02.01.2006"),
-				UpdatedAt:         item.UpdatedAt.AsTime().Format("02.01.2006"),
-			}
-			unconfirmedAppts = append(unconfirmedAppts, appt)
+		appt := model.Appointment{
+			ID:                int(item.Id),
+			Doctor:            doctorName,
+			PatientID:         int(item.PatientId),
+			Date:              item.Date.AsTime().Format("02.01.2006"),
+			Time:              item.Time.AsTime().Format("15:04"),
+			PatientFirstName:  item.FirstName,
+			PatientSecondName: item.SecondName,
+			PatientSurname:    item.Surname,
+			PatientBirthDate:  item.BirthDate.AsTime().Format("02.01.2006"),
+			Gender:            item.Gender,
+			PhoneNumber:       item.PhoneNumber,
+			Status:            item.Status,
+			CreatedAt:         item.CreatedAt.AsTime().Format("02.01.2006"),
+			UpdatedAt:         item.UpdatedAt.AsTime().Format("02.01.2006"),
 		}
+		unconfirmedAppts = append(unconfirmedAppts, appt)
 	}
-	return unconfirmedAppts, nil
+	return unconfirmedAppts, int(resp.Total), nil
 }
 
 func (s *AdminService) UpdateAppointment(ctx context.Context, appt model.UpdateAppointment) error {
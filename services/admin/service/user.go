@@ -4,16 +4,37 @@ import (
 	"context"
 	"fmt"
 	storagepb "github.com/DariaTarasek/diplom/services/admin/proto/storage"
+	"github.com/DariaTarasek/diplom/services/admin/sharederrors"
 	"regexp"
 	"strings"
 )
 
-func (s *AdminService) DeleteUser(ctx context.Context, id int) error {
-	_, err := s.StorageClient.Client.DeleteUser(ctx, &storagepb.DeleteRequest{Id: int32(id)})
+// RetireUser выводит пользователя из работы вместо удаления: пациент переводится в архив, сотрудник
+// отключается. Медицинская карта, приёмы и документы сохраняются. Возвращает новый статус пользователя
+func (s *AdminService) RetireUser(ctx context.Context, id, changedBy int) (string, error) {
+	if id == changedBy {
+		return "", sharederrors.ErrSelfRetire
+	}
+	resp, err := s.StorageClient.Client.RetireUser(ctx, &storagepb.ChangeUserStatusRequest{
+		UserId:    int32(id),
+		ChangedBy: int32(changedBy),
+	})
 	if err != nil {
-		return fmt.Errorf("не удалось удалить пользователя: %w", err)
+		return "", fmt.Errorf("не удалось вывести пользователя из работы: %w", err)
 	}
-	return nil
+	return resp.Status, nil
+}
+
+// RestoreUser возвращает в работу отключённого сотрудника или архивного пациента
+func (s *AdminService) RestoreUser(ctx context.Context, id, changedBy int) (string, error) {
+	resp, err := s.StorageClient.Client.RestoreUser(ctx, &storagepb.ChangeUserStatusRequest{
+		UserId:    int32(id),
+		ChangedBy: int32(changedBy),
+	})
+	if err != nil {
+		return "", fmt.Errorf("не удалось вернуть пользователя в работу: %w", err)
+	}
+	return resp.Status, nil
 }
 
 func (s *AdminService) UpdateEmployeeLogin(ctx context.Context, id int, login string) error {
@@ -1,7 +1,8 @@
 package sharederrors
 
-import "errors"
+import "github.com/DariaTarasek/diplom/services/apperr"
 
 var (
-	ErrInvalidValue = errors.New("некорректные данные")
+	ErrInvalidValue = apperr.Invalid("invalid_value", "некорректные данные")
+	ErrSelfRetire   = apperr.Conflict("self_retire", "нельзя отключить собственную учётную запись")
 )
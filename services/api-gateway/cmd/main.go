@@ -56,7 +56,7 @@ func main() {
 
 	patientClient, err := clients.NewPatientClient("localhost:50054")
 	if err != nil {
-		log.Fatalf("Не удалось создать patient клиент: %w", err)
+		log.Fatalf("Не удалось создать patient клиент: %s", err)
 	}
 
 	doctorClient, err := clients.NewDoctorClient("localhost:50055")
@@ -0,0 +1,85 @@
+// Package filestream отдача документов, которые приходят из сервисов gRPC-потоком частей.
+// Файл не собирается в памяти шлюза: каждая часть сразу пишется в ответ. Поддерживается
+// продолжение скачивания по заголовку Range вида bytes=N-
+package filestream
+
+import (
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Chunk часть документа в потоке; имя файла и полный размер заполнены только в первой части
+type Chunk interface {
+	GetFileName() string
+	GetSize() int64
+	GetChunk() []byte
+}
+
+// Offset смещение из заголовка Range. Поддерживается только форма bytes=N-: для остальных
+// форм и без заголовка файл отдаётся целиком, что допускает RFC 9110
+func Offset(c *gin.Context) int64 {
+	spec, ok := strings.CutPrefix(c.GetHeader("Range"), "bytes=")
+	if !ok {
+		return 0
+	}
+	start, ok := strings.CutSuffix(spec, "-")
+	if !ok {
+		return 0
+	}
+	offset, err := strconv.ParseInt(start, 10, 64)
+	if err != nil || offset < 0 {
+		return 0
+	}
+	return offset
+}
+
+// Write отдаёт клиенту документ, начиная с байта offset, читая части через recv до io.EOF.
+// Ошибка до первой части возвращается JSON-ответом; обрыв после начала передачи прерывает ответ,
+// и клиент может докачать файл по Range
+func Write[T Chunk](c *gin.Context, offset int64, recv func() (T, error)) {
+	first, err := recv()
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	size := first.GetSize()
+	if offset > 0 && offset >= size {
+		c.Header("Content-Range", fmt.Sprintf("bytes */%d", size))
+		httperror.Respond(c, http.StatusRequestedRangeNotSatisfiable, "запрошенный диапазон за пределами файла")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", first.GetFileName()))
+	c.Header("Content-Type", "application/octet-stream")
+	c.Header("Accept-Ranges", "bytes")
+	c.Header("Content-Length", strconv.FormatInt(size-offset, 10))
+	code := http.StatusOK
+	if offset > 0 {
+		code = http.StatusPartialContent
+		c.Header("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, size-1, size))
+	}
+	c.Status(code)
+
+	for chunk, err := first, error(nil); ; chunk, err = recv() {
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			log.Printf("скачивание %s прервано: %v", first.GetFileName(), err)
+			c.Abort()
+			return
+		}
+		if _, err := c.Writer.Write(chunk.GetChunk()); err != nil {
+			c.Abort()
+			return
+		}
+		c.Writer.Flush()
+	}
+}
@@ -3,6 +3,8 @@ module github.com/DariaTarasek/diplom/services/api-gateway
 go 1.23.2
 
 require (
+	github.com/DariaTarasek/diplom/services/apperr v0.0.0-00010101000000-000000000000
+	github.com/DariaTarasek/diplom/services/audit v0.0.0-00010101000000-000000000000
 	github.com/gin-gonic/gin v1.10.1
 	github.com/stretchr/testify v1.10.0
 	github.com/swaggo/files v1.0.1
@@ -49,3 +51,7 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/DariaTarasek/diplom/services/apperr => ../apperr
+
+replace github.com/DariaTarasek/diplom/services/audit => ../audit
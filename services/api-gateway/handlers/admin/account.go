@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
 	"github.com/gin-gonic/gin"
@@ -13,13 +14,13 @@ import (
 // @Description Возвращает таблицу расписания с приёмами, сгруппированную по дням и временным слотам
 // @Produce json
 // @Success 200 {object} model.AdminScheduleOverview
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/schedule-admin [get]
 func (h *Handler) GetScheduleGrid(c *gin.Context) {
 	gridResp, err := h.AdminClient.Client.GetClinicScheduleGrid(c.Request.Context(), &adminpb.EmptyRequest{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 
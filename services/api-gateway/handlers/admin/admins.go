@@ -1,6 +1,8 @@
 package admin
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/listquery"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
 	"github.com/gin-gonic/gin"
@@ -11,16 +13,34 @@ import (
 // GetAdmins godoc
 // @Summary Получить список администраторов
 // @Tags Администратор
-// @Description Возвращает список всех администраторов
+// @Description Возвращает администраторов, отсортированных по ФИО; без limit — первые 50
 // @Produce json
+// @Param q query string false "Начала слов ФИО"
+// @Param inactive query bool false "Только отключённые администраторы, для восстановления"
+// @Param limit query int false "Размер страницы, по умолчанию 50, не больше 200"
+// @Param offset query int false "Сколько записей пропустить"
+// @Param sort query string false "Поле сортировки: name"
+// @Param order query string false "Порядок сортировки: asc или desc"
 // @Success 200 {array} model.AdminForAdminList
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Header 200 {integer} X-Total-Count "Число всех найденных записей"
+// @Failure 400 {object} httperror.Response "Некорректные параметры списка"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/staff-admin [get]
 func (h *Handler) GetAdmins(c *gin.Context) {
-	items, err := h.AdminClient.Client.GetAdmins(c.Request.Context(), &adminpb.EmptyRequest{})
+	params, err := listquery.Parse(c, listquery.DefaultLimit)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	inactive, err := listquery.Inactive(c)
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	items, err := h.AdminClient.Client.GetAdmins(c.Request.Context(), &adminpb.GetAdminsRequest{Page: params.Admin(), Query: params.Query, Inactive: inactive})
+	if err != nil {
+		httperror.Write(c, err)
 		return
 	}
 	var admins []model.AdminForAdminList
@@ -37,6 +57,7 @@ func (h *Handler) GetAdmins(c *gin.Context) {
 		}
 		admins = append(admins, admin)
 	}
+	listquery.SetTotal(c, items.Total)
 	c.JSON(http.StatusOK, admins)
 }
 
@@ -48,22 +69,22 @@ func (h *Handler) GetAdmins(c *gin.Context) {
 // @Produce json
 // @Param admin body model.AdminForAdminList true "Данные администратора"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Некорректный ввод"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/save-admin [put]
 func (h *Handler) UpdateAdmin(c *gin.Context) {
 	//id, err := strconv.Atoi(c.Param("id"))
 	//if err != nil {
 	//	log.Println(err.Error())
-	//	c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+	//	httperror.Respond(c, http.StatusBadRequest, "Invalid input: " + err.Error())
 	//	return
 	//}
 
 	var adminReq model.AdminForAdminList
 	if err := c.ShouldBindJSON(&adminReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
@@ -80,8 +101,7 @@ func (h *Handler) UpdateAdmin(c *gin.Context) {
 
 	_, err := h.AdminClient.Client.UpdateAdmin(c.Request.Context(), UpdateAdminRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
@@ -0,0 +1,131 @@
+package admin
+
+import (
+	"errors"
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/listquery"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// auditLogLimit размер страницы журнала по умолчанию
+const auditLogLimit = 50
+
+// @Summary Получить журнал обращений к данным
+// @Tags Администратор
+// @Description Возвращает записи журнала аудита: кто, когда, каким методом и к чьим данным обращался и с каким результатом. По умолчанию новые первыми
+// @Produce json
+// @Param patient_id query int false "Пациент, к чьим данным обращались"
+// @Param user_id query int false "Пользователь, который обращался"
+// @Param date_from query string false "Первый день, ГГГГ-ММ-ДД, в часовом поясе клиники"
+// @Param date_to query string false "Последний день, ГГГГ-ММ-ДД, в часовом поясе клиники"
+// @Param limit query int false "Размер страницы, по умолчанию 50, не больше 200"
+// @Param offset query int false "Сколько записей пропустить"
+// @Param sort query string false "Поле сортировки: occurred_at"
+// @Param order query string false "Порядок сортировки: asc или desc, по умолчанию desc"
+// @Success 200 {array} model.AuditEntry
+// @Header 200 {integer} X-Total-Count "Число всех найденных записей"
+// @Failure 400 {object} httperror.Response "Некорректные параметры списка"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/audit-log [get]
+func (h *Handler) GetAuditLog(c *gin.Context) {
+	params, err := listquery.Parse(c, auditLogLimit)
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	// журнал читают с конца: без явного порядка новые записи первыми
+	if c.Query("order") == "" {
+		params.Desc = true
+	}
+	patientID, err := optionalID(c, "patient_id")
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	actorID, err := optionalID(c, "user_id")
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	dateFrom, err := listquery.Date(c, "date_from")
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	dateTo, err := listquery.Date(c, "date_to")
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.AdminClient.Client.GetAuditLog(c.Request.Context(), &adminpb.GetAuditLogRequest{
+		Page:      params.Admin(),
+		PatientId: patientID,
+		ActorId:   actorID,
+		DateFrom:  dateFrom,
+		DateTo:    dateTo,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	entries := make([]model.AuditEntry, 0, len(resp.Entries))
+	for _, e := range resp.Entries {
+		entries = append(entries, model.AuditEntry{
+			ID:         e.Id,
+			OccurredAt: e.OccurredAt.AsTime().Format(time.RFC3339),
+			Service:    e.Service,
+			Action:     e.Action,
+			ActorID:    int(e.ActorId),
+			ActorRole:  int(e.ActorRole),
+			Resource:   e.Resource,
+			ResourceID: e.ResourceId,
+			PatientID:  int(e.PatientId),
+			Outcome:    e.Outcome,
+			Hash:       e.Hash,
+		})
+	}
+	listquery.SetTotal(c, resp.Total)
+	c.JSON(http.StatusOK, entries)
+}
+
+// @Summary Проверить целостность журнала обращений
+// @Tags Администратор
+// @Description Пересчитывает цепочку хешей журнала аудита и возвращает первую запись, после которой журнал изменён
+// @Produce json
+// @Success 200 {object} model.AuditLogCheck
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/audit-log/verify [get]
+func (h *Handler) VerifyAuditLog(c *gin.Context) {
+	resp, err := h.AdminClient.Client.VerifyAuditLog(c.Request.Context(), &adminpb.EmptyRequest{})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, model.AuditLogCheck{
+		Checked:  int(resp.Checked),
+		Intact:   resp.BrokenId == 0,
+		BrokenID: resp.BrokenId,
+		LastHash: resp.LastHash,
+	})
+}
+
+// optionalID идентификатор из параметра строки запроса; 0, если параметра нет
+func optionalID(c *gin.Context, name string) (int32, error) {
+	raw := c.Query(name)
+	if raw == "" {
+		return 0, nil
+	}
+	id, err := strconv.Atoi(raw)
+	if err != nil || id < 1 {
+		return 0, errors.New(name + " должен быть положительным числом")
+	}
+	return int32(id), nil
+}
@@ -0,0 +1,353 @@
+package admin
+
+import (
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// GetVisitInvoice godoc
+// @Summary Получить счёт на приём
+// @Tags Администратор
+// @Description Возвращает счёт на проведённый приём со строками, оплатами и возвратами. Для приёмов,
+// @Description проведённых до введения счетов, счёт выставляется при первом запросе
+// @Produce json
+// @Param id path int true "ID приёма"
+// @Success 200 {object} model.Invoice
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Приём не найден"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/completed-visits/{id}/invoice [get]
+func (h *Handler) GetVisitInvoice(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	resp, err := h.AdminClient.Client.GetVisitInvoice(c.Request.Context(), &adminpb.GetByIdRequest{Id: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, invoiceFromPb(resp.Invoice))
+}
+
+// GetInvoice godoc
+// @Summary Получить счёт
+// @Tags Администратор
+// @Produce json
+// @Param id path int true "ID счёта"
+// @Success 200 {object} model.Invoice
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Счёт не найден"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/invoices/{id} [get]
+func (h *Handler) GetInvoice(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	resp, err := h.AdminClient.Client.GetInvoice(c.Request.Context(), &adminpb.GetByIdRequest{Id: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, invoiceFromPb(resp.Invoice))
+}
+
+// SetInvoiceDiscount godoc
+// @Summary Установить скидку на счёт
+// @Tags Администратор
+// @Description Скидка в процентах (percent, 1..100) или фиксированной суммой (fixed) по программе лояльности,
+// @Description для сотрудников или по промоакции. Менять скидку можно только до первой оплаты
+// @Accept json
+// @Produce json
+// @Param id path int true "ID счёта"
+// @Param discount body model.InvoiceDiscountRequest true "Скидка"
+// @Success 200 {object} model.Invoice
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Счёт не найден"
+// @Failure 409 {object} httperror.Response "По счёту уже есть оплаты"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/invoices/{id}/discount [put]
+func (h *Handler) SetInvoiceDiscount(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req model.InvoiceDiscountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	resp, err := h.AdminClient.Client.SetInvoiceDiscount(c.Request.Context(), &adminpb.SetInvoiceDiscountRequest{
+		InvoiceId: int32(id),
+		Kind:      req.Kind,
+		Value:     int32(req.Value),
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, invoiceFromPb(resp.Invoice))
+}
+
+// RemoveInvoiceDiscount godoc
+// @Summary Снять скидку со счёта
+// @Tags Администратор
+// @Description Снять скидку можно только до первой оплаты
+// @Produce json
+// @Param id path int true "ID счёта"
+// @Success 200 {object} model.Invoice
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Счёт не найден"
+// @Failure 409 {object} httperror.Response "По счёту уже есть оплаты"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/invoices/{id}/discount [delete]
+func (h *Handler) RemoveInvoiceDiscount(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	resp, err := h.AdminClient.Client.SetInvoiceDiscount(c.Request.Context(), &adminpb.SetInvoiceDiscountRequest{InvoiceId: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, invoiceFromPb(resp.Invoice))
+}
+
+// AddInvoicePayment godoc
+// @Summary Принять оплату по счёту
+// @Tags Администратор
+// @Description Оплата наличными (cash), картой (card) или переводом (transfer). Допускается частичная оплата;
+// @Description сумма больше остатка не принимается. Полностью оплаченный приём пропадает из списка неоплаченных
+// @Accept json
+// @Produce json
+// @Param id path int true "ID счёта"
+// @Param payment body model.InvoicePaymentRequest true "Оплата"
+// @Success 201 {object} model.Invoice
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Счёт не найден"
+// @Failure 409 {object} httperror.Response "Сумма больше остатка по счёту"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/invoices/{id}/payments [post]
+func (h *Handler) AddInvoicePayment(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req model.InvoicePaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	resp, err := h.AdminClient.Client.AddInvoicePayment(c.Request.Context(), &adminpb.AddInvoicePaymentRequest{
+		InvoiceId: int32(id),
+		Amount:    int32(req.Amount),
+		Method:    req.Method,
+		Comment:   req.Comment,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, invoiceFromPb(resp.Invoice))
+}
+
+// RefundInvoicePayment godoc
+// @Summary Оформить возврат по оплате
+// @Tags Администратор
+// @Description Возврат проводится тем же способом, что и оплата. По одной оплате можно сделать несколько
+// @Description возвратов, в сумме не больше самой оплаты
+// @Accept json
+// @Produce json
+// @Param id path int true "ID оплаты"
+// @Param refund body model.InvoiceRefundRequest true "Возврат"
+// @Success 201 {object} model.Invoice
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Оплата не найдена"
+// @Failure 409 {object} httperror.Response "Сумма больше невозвращённой части оплаты"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/invoice-payments/{id}/refunds [post]
+func (h *Handler) RefundInvoicePayment(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req model.InvoiceRefundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	resp, err := h.AdminClient.Client.RefundInvoicePayment(c.Request.Context(), &adminpb.RefundInvoicePaymentRequest{
+		PaymentId: int32(id),
+		Amount:    int32(req.Amount),
+		Comment:   req.Comment,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, invoiceFromPb(resp.Invoice))
+}
+
+// GetPatientBalance godoc
+// @Summary Получить баланс пациента
+// @Tags Администратор
+// @Description Возвращает сумму выставленных счетов, внесённую сумму, остаток к оплате и неоплаченные счета
+// @Produce json
+// @Param id path int true "ID пациента"
+// @Success 200 {object} model.PatientBalance
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/patients/{id}/balance [get]
+func (h *Handler) GetPatientBalance(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	resp, err := h.AdminClient.Client.GetPatientBalance(c.Request.Context(), &adminpb.GetByIdRequest{Id: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	balance := model.PatientBalance{
+		PatientID:    int(resp.PatientId),
+		Invoiced:     int(resp.Invoiced),
+		Paid:         int(resp.Paid),
+		Outstanding:  int(resp.Outstanding),
+		OpenInvoices: []model.Invoice{},
+	}
+	for _, item := range resp.OpenInvoices {
+		balance.OpenInvoices = append(balance.OpenInvoices, invoiceFromPb(item))
+	}
+	c.JSON(http.StatusOK, balance)
+}
+
+// GetInvoiceDocument godoc
+// @Summary Скачать счёт на оплату в PDF
+// @Tags Администратор
+// @Description Счёт с реквизитами клиники и сквозным номером. При первом запросе PDF сохраняется в документы
+// @Description пациента, откуда его может скачать и сам пациент. После изменения скидки выдаётся счёт с новым номером
+// @Produce application/pdf
+// @Param id path int true "ID счёта"
+// @Success 200 {file} file
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Счёт не найден"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/invoices/{id}/document [get]
+func (h *Handler) GetInvoiceDocument(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	resp, err := h.AdminClient.Client.GetInvoiceDocument(c.Request.Context(), &adminpb.GetByIdRequest{Id: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	writePDF(c, resp)
+}
+
+// GetReceiptDocument godoc
+// @Summary Скачать кассовый чек в PDF
+// @Tags Администратор
+// @Description Чек на оплату или возврат со сквозным номером чеков. Чек формируется сразу при проведении операции
+// @Description и сохраняется в документы пациента
+// @Produce application/pdf
+// @Param id path int true "ID оплаты или возврата"
+// @Success 200 {file} file
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Оплата не найдена"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/invoice-payments/{id}/receipt [get]
+func (h *Handler) GetReceiptDocument(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	resp, err := h.AdminClient.Client.GetReceiptDocument(c.Request.Context(), &adminpb.GetByIdRequest{Id: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	writePDF(c, resp)
+}
+
+func writePDF(c *gin.Context, doc *adminpb.BillingDocumentResponse) {
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", doc.FileName))
+	c.Data(http.StatusOK, "application/pdf", doc.Content)
+}
+
+// pathID числовой параметр пути; при ошибке ответ 400 уже отправлен
+func pathID(c *gin.Context, name string) (int, bool) {
+	id, err := strconv.Atoi(c.Param(name))
+	if err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return 0, false
+	}
+	return id, true
+}
+
+func invoiceFromPb(item *adminpb.Invoice) model.Invoice {
+	invoice := model.Invoice{
+		ID:             int(item.Id),
+		VisitID:        int(item.VisitId),
+		PatientID:      int(item.PatientId),
+		Subtotal:       int(item.Subtotal),
+		DiscountKind:   item.DiscountKind,
+		DiscountValue:  int(item.DiscountValue),
+		DiscountReason: item.DiscountReason,
+		DiscountAmount: int(item.DiscountAmount),
+		Total:          int(item.Total),
+		Paid:           int(item.Paid),
+		Balance:        int(item.Balance),
+		Status:         item.Status,
+		CreatedAt:      item.CreatedAt.AsTime().Format(time.RFC3339),
+		Lines:          []model.InvoiceLine{},
+		Payments:       []model.InvoicePayment{},
+	}
+	for _, line := range item.Lines {
+		invoice.Lines = append(invoice.Lines, model.InvoiceLine{
+			ID:           int(line.Id),
+			Kind:         line.Kind,
+			ItemID:       int(line.ItemId),
+			Name:         line.Name,
+			Quantity:     int(line.Quantity),
+			PricePerUnit: int(line.PricePerUnit),
+			TotalPrice:   int(line.TotalPrice),
+		})
+	}
+	for _, p := range item.Payments {
+		invoice.Payments = append(invoice.Payments, model.InvoicePayment{
+			ID:        int(p.Id),
+			Kind:      p.Kind,
+			Amount:    int(p.Amount),
+			Method:    p.Method,
+			RefundOf:  int(p.RefundOf),
+			Comment:   p.Comment,
+			CreatedAt: p.CreatedAt.AsTime().Format(time.RFC3339),
+		})
+	}
+	return invoice
+}
@@ -0,0 +1,59 @@
+package admin
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+)
+
+type clinicTimeZoneRequest struct {
+	TimeZone string `json:"time_zone" binding:"required"`
+}
+
+// GetClinicTimeZone godoc
+// @Summary Получить часовой пояс клиники
+// @Tags Администратор
+// @Description Возвращает часовой пояс клиники (IANA), в котором трактуются расписания и записи
+// @Produce json
+// @Success 200 {object} gin.H
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/clinic-settings/timezone [get]
+func (h *Handler) GetClinicTimeZone(c *gin.Context) {
+	resp, err := h.AdminClient.Client.GetClinicTimeZone(c.Request.Context(), &adminpb.EmptyRequest{})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"time_zone": resp.TimeZone})
+}
+
+// UpdateClinicTimeZone godoc
+// @Summary Изменить часовой пояс клиники
+// @Tags Администратор
+// @Description Меняет часовой пояс клиники. Время в расписаниях и записях остаётся прежним по часам клиники
+// @Accept json
+// @Produce json
+// @Param timezone body clinicTimeZoneRequest true "Часовой пояс IANA, например Europe/Moscow"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/clinic-settings/timezone [put]
+func (h *Handler) UpdateClinicTimeZone(c *gin.Context) {
+	var req clinicTimeZoneRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+
+	_, err := h.AdminClient.Client.UpdateClinicTimeZone(c.Request.Context(), &adminpb.ClinicTimeZone{TimeZone: req.TimeZone})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
@@ -0,0 +1,150 @@
+package admin
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
+	"github.com/gin-gonic/gin"
+	"log"
+	"net/http"
+	"time"
+)
+
+// GetClinicalAlertRules godoc
+// @Summary Получить правила клинических предупреждений
+// @Tags Администратор
+// @Description Возвращает все правила, в том числе выключенные
+// @Produce json
+// @Success 200 {array} model.ClinicalAlertRule
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/clinical-alert-rules [get]
+func (h *Handler) GetClinicalAlertRules(c *gin.Context) {
+	resp, err := h.AdminClient.Client.GetClinicalAlertRules(c.Request.Context(), &adminpb.EmptyRequest{})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	rules := make([]model.ClinicalAlertRule, 0, len(resp.Rules))
+	for _, r := range resp.Rules {
+		rules = append(rules, model.ClinicalAlertRule{
+			ID:             int(r.Id),
+			Title:          r.Title,
+			ConditionType:  r.ConditionType,
+			ConditionValue: r.ConditionValue,
+			TargetType:     r.TargetType,
+			MaterialID:     int(r.MaterialId),
+			ServiceID:      int(r.ServiceId),
+			TargetName:     r.TargetName,
+			Severity:       r.Severity,
+			Message:        r.Message,
+			IsActive:       r.IsActive,
+			CreatedAt:      r.CreatedAt.AsTime().Format(time.RFC3339),
+		})
+	}
+	c.JSON(http.StatusOK, rules)
+}
+
+// AddClinicalAlertRule godoc
+// @Summary Добавить правило клинического предупреждения
+// @Tags Администратор
+// @Description Например, аллергия с condition_value «лидокаин» и материал-анестетик на основе лидокаина:
+// @Description при выборе материала на приёме врач увидит предупреждение
+// @Accept json
+// @Produce json
+// @Param rule body model.ClinicalAlertRuleRequest true "Правило"
+// @Success 201 {object} gin.H "id правила"
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Материал или услуга не найдены"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/clinical-alert-rules [post]
+func (h *Handler) AddClinicalAlertRule(c *gin.Context) {
+	var req model.ClinicalAlertRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	resp, err := h.AdminClient.Client.AddClinicalAlertRule(c.Request.Context(), clinicalAlertRuleToPb(0, req))
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"id": resp.Id})
+}
+
+// UpdateClinicalAlertRule godoc
+// @Summary Изменить правило клинического предупреждения
+// @Tags Администратор
+// @Description Изменяет условие, цель или текст правила; is_active = false выключает правило без удаления
+// @Accept json
+// @Produce json
+// @Param id path int true "ID правила"
+// @Param rule body model.ClinicalAlertRuleRequest true "Правило"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Правило, материал или услуга не найдены"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/clinical-alert-rules/{id} [put]
+func (h *Handler) UpdateClinicalAlertRule(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req model.ClinicalAlertRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	_, err := h.AdminClient.Client.UpdateClinicalAlertRule(c.Request.Context(), clinicalAlertRuleToPb(id, req))
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// DeleteClinicalAlertRule godoc
+// @Summary Удалить правило клинического предупреждения
+// @Tags Администратор
+// @Param id path int true "ID правила"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Правило не найдено"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/clinical-alert-rules/{id} [delete]
+func (h *Handler) DeleteClinicalAlertRule(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	_, err := h.AdminClient.Client.DeleteClinicalAlertRule(c.Request.Context(), &adminpb.DeleteRequest{Id: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func clinicalAlertRuleToPb(id int, req model.ClinicalAlertRuleRequest) *adminpb.ClinicalAlertRule {
+	rule := &adminpb.ClinicalAlertRule{
+		Id:             int32(id),
+		Title:          req.Title,
+		ConditionType:  req.ConditionType,
+		ConditionValue: req.ConditionValue,
+		TargetType:     req.TargetType,
+		MaterialId:     int32(req.MaterialID),
+		ServiceId:      int32(req.ServiceID),
+		Severity:       req.Severity,
+		Message:        req.Message,
+		IsActive:       true,
+	}
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+	return rule
+}
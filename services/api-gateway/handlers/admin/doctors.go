@@ -1,6 +1,8 @@
 package admin
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/listquery"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
 	"github.com/gin-gonic/gin"
@@ -14,13 +16,13 @@ import (
 // @Description Возвращает все доступные специализации
 // @Produce json
 // @Success 200 {array} model.Spec
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/specialties [get]
 func (h *Handler) GetSpecs(c *gin.Context) {
 	items, err := h.AdminClient.Client.GetSpecs(c.Request.Context(), &adminpb.EmptyRequest{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	var specs []model.Spec
@@ -37,16 +39,34 @@ func (h *Handler) GetSpecs(c *gin.Context) {
 // GetDoctors godoc
 // @Summary Получить список докторов
 // @Tags Администратор
-// @Description Возвращает всех докторов с их специализациями
+// @Description Возвращает докторов с их специализациями, отсортированных по ФИО; без limit — первые 50
 // @Produce json
+// @Param q query string false "Начала слов ФИО"
+// @Param inactive query bool false "Только отключённые врачи, для восстановления"
+// @Param limit query int false "Размер страницы, по умолчанию 50, не больше 200"
+// @Param offset query int false "Сколько записей пропустить"
+// @Param sort query string false "Поле сортировки: name, experience"
+// @Param order query string false "Порядок сортировки: asc или desc"
 // @Success 200 {array} model.DoctorWithSpecs
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Header 200 {integer} X-Total-Count "Число всех найденных записей"
+// @Failure 400 {object} httperror.Response "Некорректные параметры списка"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/staff-doctors [get]
 func (h *Handler) GetDoctors(c *gin.Context) {
-	items, err := h.AdminClient.Client.GetDoctors(c.Request.Context(), &adminpb.EmptyRequest{})
+	params, err := listquery.Parse(c, listquery.DefaultLimit)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	inactive, err := listquery.Inactive(c)
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	items, err := h.AdminClient.Client.GetDoctors(c.Request.Context(), &adminpb.GetDoctorsRequest{Page: params.Admin(), Query: params.Query, Inactive: inactive})
+	if err != nil {
+		httperror.Write(c, err)
 		return
 	}
 	var doctors []model.DoctorWithSpecs
@@ -70,6 +90,7 @@ func (h *Handler) GetDoctors(c *gin.Context) {
 		}
 		doctors = append(doctors, doctor)
 	}
+	listquery.SetTotal(c, items.Total)
 	c.JSON(http.StatusOK, doctors)
 }
 
@@ -81,21 +102,21 @@ func (h *Handler) GetDoctors(c *gin.Context) {
 // @Produce json
 // @Param doctor body model.DoctorWithSpecs true "Данные доктора"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Некорректный ввод"
-// @Failure 403 {object} gin.H "Доступ запрещён"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Доступ запрещён"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/save-doctor [put]
 func (h *Handler) UpdateDoctor(c *gin.Context) {
 	//id, err := strconv.Atoi(c.Param("id"))
 	//if err != nil {
 	//	log.Println(err.Error())
-	//	c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+	//	httperror.Respond(c, http.StatusBadRequest, "Invalid input: " + err.Error())
 	//	return
 	//}
 	var doctorReq model.DoctorWithSpecs
 	if err := c.ShouldBindJSON(&doctorReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
@@ -119,8 +140,7 @@ func (h *Handler) UpdateDoctor(c *gin.Context) {
 
 	_, err := h.AdminClient.Client.UpdateDoctor(c.Request.Context(), UpdateDoctorRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
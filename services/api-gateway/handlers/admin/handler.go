@@ -25,21 +25,44 @@ func RegisterRoutes(rg *gin.RouterGroup, h *Handler) {
 	rg.POST("/doctor-schedule/:selectedDoctor", h.AccessMiddleware(5), h.UpdateDoctorSchedule)
 	rg.POST("/clinic-overrides", h.AccessMiddleware(7), h.AddClinicDailyOverride)
 	rg.POST("/doctor-overrides", h.AccessMiddleware(7), h.AddDoctorDailyOverride)
+	rg.GET("/clinic-settings/timezone", h.AccessMiddleware(1), h.GetClinicTimeZone)
+	rg.PUT("/clinic-settings/timezone", h.AccessMiddleware(5), h.UpdateClinicTimeZone)
+	rg.GET("/schedule/rules", h.AccessMiddleware(1), h.GetScheduleRules)
+	rg.POST("/schedule/rules", h.AccessMiddleware(7), h.AddScheduleRule)
+	rg.DELETE("/schedule/rules/:id", h.AccessMiddleware(7), h.DeleteScheduleRule)
+	rg.POST("/schedule/holidays/import", h.AccessMiddleware(7), h.ImportHolidayCalendar)
 	rg.POST("/materials", h.AccessMiddleware(18), h.AddMaterial)
 	rg.POST("/services", h.AccessMiddleware(18), h.AddService)
 	rg.PUT("/materials/:id", h.AccessMiddleware(18), h.UpdateMaterial)
 	rg.PUT("/services/:id", h.AccessMiddleware(18), h.UpdateService)
 	rg.DELETE("/materials/:id", h.AccessMiddleware(18), h.DeleteMaterial)
 	rg.DELETE("/services/:id", h.AccessMiddleware(18), h.DeleteService)
+	rg.GET("/services/:id/prices", h.AccessMiddleware(1), h.GetServicePriceHistory)
+	rg.GET("/services/:id/prices/on", h.AccessMiddleware(1), h.GetServicePriceOnDate)
+	rg.POST("/services/:id/prices", h.AccessMiddleware(18), h.AddServicePrice)
+	rg.DELETE("/services/:id/prices/:entryId", h.AccessMiddleware(18), h.DeleteServicePrice)
+	rg.GET("/materials/:id/prices", h.AccessMiddleware(1), h.GetMaterialPriceHistory)
+	rg.GET("/materials/:id/prices/on", h.AccessMiddleware(1), h.GetMaterialPriceOnDate)
+	rg.POST("/materials/:id/prices", h.AccessMiddleware(18), h.AddMaterialPrice)
+	rg.DELETE("/materials/:id/prices/:entryId", h.AccessMiddleware(18), h.DeleteMaterialPrice)
+	rg.GET("/materials/stock-report", h.AccessMiddleware(18), h.GetStockReport)
+	rg.GET("/materials/:id/stock", h.AccessMiddleware(18), h.GetMaterialStock)
+	rg.POST("/materials/:id/batches", h.AccessMiddleware(18), h.ReceiveMaterialBatch)
+	rg.POST("/materials/:id/adjustments", h.AccessMiddleware(18), h.AdjustMaterialStock)
+	rg.GET("/clinical-alert-rules", h.AccessMiddleware(18), h.GetClinicalAlertRules)
+	rg.POST("/clinical-alert-rules", h.AccessMiddleware(18), h.AddClinicalAlertRule)
+	rg.PUT("/clinical-alert-rules/:id", h.AccessMiddleware(18), h.UpdateClinicalAlertRule)
+	rg.DELETE("/clinical-alert-rules/:id", h.AccessMiddleware(18), h.DeleteClinicalAlertRule)
 	rg.GET("/staff-admins", h.AccessMiddleware(1), h.GetAdmins)
 	rg.GET("/staff-doctors", h.AccessMiddleware(1), h.GetDoctors)
 	rg.GET("/patients", h.AccessMiddleware(1), h.GetPatients)
 	rg.POST("/save-admin", h.AccessMiddleware(9), h.UpdateAdmin)
 	rg.POST("save-doctor", h.AccessMiddleware(9), h.UpdateDoctor)
 	rg.PUT("/patients/:id", h.AccessMiddleware(8), h.UpdatePatient)
-	rg.DELETE("/patients/:id", h.AccessMiddleware(19), h.DeleteUser)
-	rg.DELETE("/admins/:id", h.AccessMiddleware(19), h.DeleteUser)
-	rg.DELETE("/doctors/:id", h.AccessMiddleware(19), h.DeleteUser)
+	rg.DELETE("/patients/:id", h.AccessMiddleware(19), h.RetireUser)
+	rg.DELETE("/admins/:id", h.AccessMiddleware(19), h.RetireUser)
+	rg.DELETE("/doctors/:id", h.AccessMiddleware(19), h.RetireUser)
+	rg.POST("/users/:id/restore", h.AccessMiddleware(22), h.RestoreUser)
 	rg.PUT("/admins-login/:id", h.AccessMiddleware(9), h.UpdateEmployeeLogin)
 	rg.PUT("/doctors-login/:id", h.AccessMiddleware(9), h.UpdateEmployeeLogin)
 	rg.PUT("/patients-login/:id", h.AccessMiddleware(8), h.UpdatePatientLogin)
@@ -47,8 +70,21 @@ func RegisterRoutes(rg *gin.RouterGroup, h *Handler) {
 	rg.GET("/schedule-admin", h.AccessMiddleware(1), h.GetScheduleGrid)
 	rg.GET("/admin/me", h.AccessMiddleware(1), h.getAdminProfile)
 	rg.GET("/unconfirmed-appointments", h.AccessMiddleware(1), h.GetUnconfirmedAppointments)
-	rg.PUT("/completed-visits/:id", h.AccessMiddleware(10), h.UpdateVisitPayment)
+	rg.GET("/completed-visits/:id/invoice", h.AccessMiddleware(1), h.GetVisitInvoice)
+	rg.GET("/invoices/:id", h.AccessMiddleware(1), h.GetInvoice)
+	rg.GET("/invoices/:id/document", h.AccessMiddleware(1), h.GetInvoiceDocument)
+	rg.PUT("/invoices/:id/discount", h.AccessMiddleware(10), h.SetInvoiceDiscount)
+	rg.DELETE("/invoices/:id/discount", h.AccessMiddleware(10), h.RemoveInvoiceDiscount)
+	rg.POST("/invoices/:id/payments", h.AccessMiddleware(10), h.AddInvoicePayment)
+	rg.POST("/invoice-payments/:id/refunds", h.AccessMiddleware(10), h.RefundInvoicePayment)
+	rg.GET("/invoice-payments/:id/receipt", h.AccessMiddleware(1), h.GetReceiptDocument)
+	rg.GET("/patients/:id/balance", h.AccessMiddleware(1), h.GetPatientBalance)
+	rg.GET("/patients/:id/access-grants", h.AccessMiddleware(1), h.GetPatientAccessGrants)
+	rg.POST("/patients/:id/access-grants", h.AccessMiddleware(8), h.AddPatientAccessGrant)
+	rg.DELETE("/access-grants/:id", h.AccessMiddleware(8), h.RevokePatientAccessGrant)
 	rg.PUT("/unconfirmed-appointments/:id", h.AccessMiddleware(14), h.UpdateAppointment)
+	rg.GET("/audit-log", h.AccessMiddleware(23), h.GetAuditLog)
+	rg.GET("/audit-log/verify", h.AccessMiddleware(23), h.VerifyAuditLog)
 
 	//	rg.GET("/specialties", h.GetSpecs)
 }
@@ -0,0 +1,216 @@
+package admin
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// GetMaterialStock godoc
+// @Summary Получить остаток материала
+// @Tags Администратор
+// @Description Возвращает остаток материала, партии с ненулевым остатком и последние движения: поступления,
+// @Description расход на приёмах и корректировки
+// @Produce json
+// @Param id path int true "ID материала"
+// @Success 200 {object} model.MaterialStock
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Материал не найден"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/materials/{id}/stock [get]
+func (h *Handler) GetMaterialStock(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	resp, err := h.AdminClient.Client.GetMaterialStock(c.Request.Context(), &adminpb.GetByIdRequest{Id: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	stock := model.MaterialStock{
+		Material:  materialStockLevelFromPb(resp.Material),
+		Batches:   []model.MaterialBatch{},
+		Movements: []model.StockMovement{},
+	}
+	for _, batch := range resp.Batches {
+		stock.Batches = append(stock.Batches, materialBatchFromPb(batch))
+	}
+	for _, m := range resp.Movements {
+		stock.Movements = append(stock.Movements, model.StockMovement{
+			ID:        int(m.Id),
+			BatchID:   int(m.BatchId),
+			Kind:      m.Kind,
+			Quantity:  int(m.Quantity),
+			VisitID:   int(m.VisitId),
+			Reason:    m.Reason,
+			CreatedAt: m.CreatedAt.AsTime().Format(time.RFC3339),
+		})
+	}
+	c.JSON(http.StatusOK, stock)
+}
+
+// ReceiveMaterialBatch godoc
+// @Summary Оприходовать партию материала
+// @Tags Администратор
+// @Description Добавляет партию с номером и сроком годности (YYYY-MM-DD, не обязателен) и увеличивает остаток
+// @Accept json
+// @Produce json
+// @Param id path int true "ID материала"
+// @Param batch body model.MaterialBatchRequest true "Партия"
+// @Success 201 {object} model.MaterialBatch
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Материал не найден"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/materials/{id}/batches [post]
+func (h *Handler) ReceiveMaterialBatch(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req model.MaterialBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	batchReq := &adminpb.ReceiveMaterialBatchRequest{
+		MaterialId:  int32(id),
+		BatchNumber: req.BatchNumber,
+		Quantity:    int32(req.Quantity),
+		Comment:     req.Comment,
+	}
+	if req.ExpiresAt != "" {
+		expiresAt, err := time.Parse("2006-01-02", req.ExpiresAt)
+		if err != nil {
+			httperror.Respond(c, http.StatusBadRequest, "Некорректный срок годности, ожидается YYYY-MM-DD")
+			return
+		}
+		batchReq.ExpiresAt = timestamppb.New(expiresAt)
+	}
+	resp, err := h.AdminClient.Client.ReceiveMaterialBatch(c.Request.Context(), batchReq)
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, materialBatchFromPb(resp))
+}
+
+// AdjustMaterialStock godoc
+// @Summary Скорректировать остаток материала
+// @Tags Администратор
+// @Description Излишек или недостача по итогам инвентаризации, списание брака и просрочки. Количество со знаком,
+// @Description причина обязательна. Без batch_id уменьшение списывается по партиям с ближайшим сроком годности
+// @Accept json
+// @Produce json
+// @Param id path int true "ID материала"
+// @Param adjustment body model.StockAdjustmentRequest true "Корректировка"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Материал или партия не найдены"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/materials/{id}/adjustments [post]
+func (h *Handler) AdjustMaterialStock(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req model.StockAdjustmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	_, err := h.AdminClient.Client.AdjustMaterialStock(c.Request.Context(), &adminpb.AdjustMaterialStockRequest{
+		MaterialId: int32(id),
+		BatchId:    int32(req.BatchID),
+		Quantity:   int32(req.Quantity),
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// GetStockReport godoc
+// @Summary Отчёт по складу
+// @Tags Администратор
+// @Description Материалы с остатком не выше порога (или ушедшим в минус) и партии, срок годности которых
+// @Description истёк или истекает в ближайшие days дней (по умолчанию 30)
+// @Produce json
+// @Param days query int false "Горизонт проверки сроков годности в днях"
+// @Success 200 {object} model.StockReport
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/materials/stock-report [get]
+func (h *Handler) GetStockReport(c *gin.Context) {
+	days := 0
+	if value := c.Query("days"); value != "" {
+		var err error
+		if days, err = strconv.Atoi(value); err != nil {
+			httperror.Respond(c, http.StatusBadRequest, "Некорректное количество дней")
+			return
+		}
+	}
+	resp, err := h.AdminClient.Client.GetStockReport(c.Request.Context(), &adminpb.StockReportRequest{ExpiringWithinDays: int32(days)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	report := model.StockReport{
+		LowStock: []model.MaterialStockLevel{},
+		Expiring: []model.ExpiringBatch{},
+	}
+	for _, material := range resp.LowStock {
+		report.LowStock = append(report.LowStock, materialStockLevelFromPb(material))
+	}
+	for _, item := range resp.Expiring {
+		report.Expiring = append(report.Expiring, model.ExpiringBatch{
+			MaterialBatch: materialBatchFromPb(item.Batch),
+			MaterialName:  item.MaterialName,
+			Unit:          item.Unit,
+		})
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+func materialStockLevelFromPb(m *adminpb.Material) model.MaterialStockLevel {
+	return model.MaterialStockLevel{
+		Material: model.Material{
+			ID:                int(m.Id),
+			Name:              m.Name,
+			Price:             int(m.Price),
+			Unit:              m.Unit,
+			LowStockThreshold: int(m.LowStockThreshold),
+		},
+		StockQuantity: int(m.StockQuantity),
+	}
+}
+
+func materialBatchFromPb(b *adminpb.MaterialBatch) model.MaterialBatch {
+	batch := model.MaterialBatch{
+		ID:               int(b.Id),
+		MaterialID:       int(b.MaterialId),
+		BatchNumber:      b.BatchNumber,
+		QuantityReceived: int(b.QuantityReceived),
+		QuantityLeft:     int(b.QuantityLeft),
+		ReceivedAt:       b.ReceivedAt.AsTime().Format(time.RFC3339),
+		Comment:          b.Comment,
+	}
+	if b.ExpiresAt != nil {
+		batch.ExpiresAt = b.ExpiresAt.AsTime().Format("2006-01-02")
+	}
+	return batch
+}
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
 	"github.com/gin-gonic/gin"
@@ -17,27 +18,28 @@ import (
 // @Produce json
 // @Param material body model.Material true "Информация о материале"
 // @Success 201 {object} gin.H
-// @Failure 400 {object} gin.H "Неверные входные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Неверные входные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/materials [post]
 func (h *Handler) AddMaterial(c *gin.Context) {
 	var materialReq model.Material
 	if err := c.ShouldBindJSON(&materialReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
 	AddMaterialRequest := &adminpb.AddMaterialRequest{
-		Name:  materialReq.Name,
-		Price: int32(materialReq.Price),
+		Name:              materialReq.Name,
+		Price:             int32(materialReq.Price),
+		Unit:              materialReq.Unit,
+		LowStockThreshold: int32(materialReq.LowStockThreshold),
 	}
 
 	_, err := h.AdminClient.Client.AddMaterial(c.Request.Context(), AddMaterialRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusCreated, gin.H{})
@@ -51,28 +53,28 @@ func (h *Handler) AddMaterial(c *gin.Context) {
 // @Produce json
 // @Param service body model.Service true "Информация об услуге"
 // @Success 201 {object} gin.H
-// @Failure 400 {object} gin.H "Неверные входные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Неверные входные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/services [post]
 func (h *Handler) AddService(c *gin.Context) {
 	var serviceReq model.Service
 	if err := c.ShouldBindJSON(&serviceReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
 	AddServiceRequest := &adminpb.AddServiceRequest{
-		Name:  serviceReq.Name,
-		Price: int32(serviceReq.Price),
-		Type:  int32(serviceReq.ServiceTypeId),
+		Name:            serviceReq.Name,
+		Price:           int32(serviceReq.Price),
+		Type:            int32(serviceReq.ServiceTypeId),
+		DurationMinutes: int32(serviceReq.DurationMinutes),
 	}
 
 	_, err := h.AdminClient.Client.AddService(c.Request.Context(), AddServiceRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusCreated, gin.H{})
@@ -87,35 +89,36 @@ func (h *Handler) AddService(c *gin.Context) {
 // @Param id path int true "ID материала"
 // @Param material body model.Material true "Новые данные материала"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Неверные входные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Неверные входные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/materials/{id} [put]
 func (h *Handler) UpdateMaterial(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
 	var materialReq model.Material
 	if err := c.ShouldBindJSON(&materialReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
 	UpdateMaterialRequest := &adminpb.UpdateMaterialRequest{
-		Id:    int32(id),
-		Name:  materialReq.Name,
-		Price: int32(materialReq.Price),
+		Id:                int32(id),
+		Name:              materialReq.Name,
+		Price:             int32(materialReq.Price),
+		Unit:              materialReq.Unit,
+		LowStockThreshold: int32(materialReq.LowStockThreshold),
 	}
 
 	_, err = h.AdminClient.Client.UpdateMaterial(c.Request.Context(), UpdateMaterialRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
@@ -130,35 +133,35 @@ func (h *Handler) UpdateMaterial(c *gin.Context) {
 // @Param id path int true "ID услуги"
 // @Param service body model.Service true "Новые данные услуги"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Неверные входные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Неверные входные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/services/{id} [put]
 func (h *Handler) UpdateService(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 	var serviceReq model.Service
 	if err := c.ShouldBindJSON(&serviceReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
 	UpdateServiceRequest := &adminpb.UpdateServiceRequest{
-		Id:    int32(id),
-		Name:  serviceReq.Name,
-		Price: int32(serviceReq.Price),
-		Type:  int32(serviceReq.ServiceTypeId),
+		Id:              int32(id),
+		Name:            serviceReq.Name,
+		Price:           int32(serviceReq.Price),
+		Type:            int32(serviceReq.ServiceTypeId),
+		DurationMinutes: int32(serviceReq.DurationMinutes),
 	}
 
 	_, err = h.AdminClient.Client.UpdateService(c.Request.Context(), UpdateServiceRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
@@ -171,15 +174,15 @@ func (h *Handler) UpdateService(c *gin.Context) {
 // @Produce json
 // @Param id path int true "ID материала"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Неверные входные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Неверные входные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/materials/{id} [delete]
 func (h *Handler) DeleteMaterial(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
@@ -187,8 +190,7 @@ func (h *Handler) DeleteMaterial(c *gin.Context) {
 
 	_, err = h.AdminClient.Client.DeleteMaterial(c.Request.Context(), DeleteRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
@@ -201,16 +203,16 @@ func (h *Handler) DeleteMaterial(c *gin.Context) {
 // @Produce json
 // @Param id path int true "ID услуги"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Неверные входные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Неверные входные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/services/{id} [delete]
 
 func (h *Handler) DeleteService(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
@@ -218,8 +220,7 @@ func (h *Handler) DeleteService(c *gin.Context) {
 
 	_, err = h.AdminClient.Client.DeleteService(c.Request.Context(), DeleteRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
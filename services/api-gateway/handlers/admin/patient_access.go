@@ -0,0 +1,134 @@
+package admin
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
+	authpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/auth"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"net/http"
+	"time"
+)
+
+// GetPatientAccessGrants godoc
+// @Summary Допуски врачей к медицинской карте пациента
+// @Tags Администратор
+// @Description Новые первыми, включая истёкшие и отозванные
+// @Produce json
+// @Param id path int true "ID пациента"
+// @Success 200 {array} model.PatientAccessGrant
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/patients/{id}/access-grants [get]
+func (h *Handler) GetPatientAccessGrants(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	resp, err := h.AdminClient.Client.GetPatientAccessGrants(c.Request.Context(), &adminpb.GetByIdRequest{Id: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	grants := make([]model.PatientAccessGrant, 0, len(resp.Grants))
+	for _, g := range resp.Grants {
+		grant := model.PatientAccessGrant{
+			ID:        int(g.Id),
+			PatientID: int(g.PatientId),
+			DoctorID:  int(g.DoctorId),
+			Doctor:    g.Doctor,
+			Reason:    g.Reason,
+			GrantedBy: int(g.GrantedBy),
+			CreatedAt: g.CreatedAt.AsTime().Format(time.RFC3339),
+		}
+		if g.ExpiresAt != nil {
+			grant.ExpiresAt = g.ExpiresAt.AsTime().Format(time.RFC3339)
+		}
+		if g.RevokedAt != nil {
+			grant.RevokedAt = g.RevokedAt.AsTime().Format(time.RFC3339)
+		}
+		grants = append(grants, grant)
+	}
+	c.JSON(http.StatusOK, grants)
+}
+
+// AddPatientAccessGrant godoc
+// @Summary Выдать врачу допуск к медицинской карте пациента
+// @Tags Администратор
+// @Description Допуск нужен врачу, у которого нет записей пациента: консультация коллеги, второе мнение, замена
+// @Description лечащего врача. Пока допуск действует, врач видит карту пациента так же, как лечащий врач
+// @Accept json
+// @Produce json
+// @Param id path int true "ID пациента"
+// @Param grant body model.PatientAccessGrantRequest true "Допуск"
+// @Success 201 {object} gin.H "id допуска"
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 401 {object} httperror.Response "Необходима авторизация"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Пациент или врач не найдены"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/patients/{id}/access-grants [post]
+func (h *Handler) AddPatientAccessGrant(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil || token == "" {
+		httperror.Respond(c, http.StatusUnauthorized, "необходима авторизация")
+		return
+	}
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	var req model.PatientAccessGrantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	admin, err := h.AuthClient.Client.GetUserID(c.Request.Context(), &authpb.GetUserIDRequest{Token: token})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	grant := &adminpb.PatientAccessGrant{
+		PatientId: int32(id),
+		DoctorId:  int32(req.DoctorID),
+		Reason:    req.Reason,
+		GrantedBy: admin.UserId,
+	}
+	if req.ExpiresAt != nil {
+		grant.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+	resp, err := h.AdminClient.Client.AddPatientAccessGrant(c.Request.Context(), grant)
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"id": resp.Id})
+}
+
+// RevokePatientAccessGrant godoc
+// @Summary Отозвать допуск врача к медицинской карте пациента
+// @Tags Администратор
+// @Description Отозванный допуск остаётся в списке допусков пациента
+// @Param id path int true "ID допуска"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Допуск не найден или уже отозван"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/access-grants/{id} [delete]
+func (h *Handler) RevokePatientAccessGrant(c *gin.Context) {
+	id, ok := pathID(c, "id")
+	if !ok {
+		return
+	}
+	_, err := h.AdminClient.Client.RevokePatientAccessGrant(c.Request.Context(), &adminpb.DeleteRequest{Id: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
@@ -1,6 +1,8 @@
 package admin
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/listquery"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
 	"github.com/gin-gonic/gin"
@@ -9,19 +11,40 @@ import (
 	"strconv"
 )
 
+// patientsPageSize размер страницы списка пациентов, если клиент его не указал
+const patientsPageSize = 50
+
 // GetPatients godoc
 // @Summary Получить список пациентов
 // @Tags Администратор
-// @Description Возвращает всех зарегистрированных пациентов
+// @Description Возвращает страницу зарегистрированных пациентов, отсортированных по ФИО
 // @Produce json
+// @Param q query string false "Дата рождения (ДД.ММ.ГГГГ или ГГГГ-ММ-ДД), номер телефона или начала слов ФИО"
+// @Param inactive query bool false "Только архивные пациенты, для восстановления"
+// @Param limit query int false "Размер страницы, не больше 200 (по умолчанию 50)"
+// @Param offset query int false "Сколько записей пропустить"
+// @Param sort query string false "Поле сортировки: name, birth_date"
+// @Param order query string false "Порядок сортировки: asc или desc"
 // @Success 200 {array} model.PatientWithoutPassword
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Header 200 {integer} X-Total-Count "Число всех найденных записей"
+// @Failure 400 {object} httperror.Response "Некорректные параметры списка"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/patients [get]
 func (h *Handler) GetPatients(c *gin.Context) {
-	items, err := h.AdminClient.Client.GetPatients(c.Request.Context(), &adminpb.EmptyRequest{})
+	params, err := listquery.Parse(c, patientsPageSize)
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	inactive, err := listquery.Inactive(c)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	items, err := h.AdminClient.Client.GetPatients(c.Request.Context(), &adminpb.GetPatientsRequest{Page: params.Admin(), Query: params.Query, Inactive: inactive})
+	if err != nil {
+		httperror.Write(c, err)
 		return
 	}
 	var patients []model.PatientWithoutPassword
@@ -38,6 +61,7 @@ func (h *Handler) GetPatients(c *gin.Context) {
 		}
 		patients = append(patients, patient)
 	}
+	listquery.SetTotal(c, items.Total)
 	c.JSON(http.StatusOK, patients)
 }
 
@@ -50,22 +74,22 @@ func (h *Handler) GetPatients(c *gin.Context) {
 // @Param id path int true "ID пациента"
 // @Param patient body model.Patient true "Новые данные пациента"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Неверные входные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Неверные входные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/patients/{id} [put]
 func (h *Handler) UpdatePatient(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
 	var patientReq model.Patient
 	if err := c.ShouldBindJSON(&patientReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
@@ -82,8 +106,7 @@ func (h *Handler) UpdatePatient(c *gin.Context) {
 
 	_, err = h.AdminClient.Client.UpdatePatient(c.Request.Context(), UpdatePatientRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
@@ -0,0 +1,255 @@
+package admin
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Виды позиций прайс-листа, как их понимает сервис администратора
+const (
+	priceKindService  = "service"
+	priceKindMaterial = "material"
+)
+
+// GetServicePriceHistory godoc
+// @Summary Получить историю цен услуги
+// @Tags Администратор
+// @Description Возвращает все цены услуги по датам начала действия, включая запланированные изменения
+// @Produce json
+// @Param id path int true "ID услуги"
+// @Success 200 {array} model.PriceListEntry
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/services/{id}/prices [get]
+func (h *Handler) GetServicePriceHistory(c *gin.Context) {
+	h.getPriceHistory(c, priceKindService)
+}
+
+// GetMaterialPriceHistory godoc
+// @Summary Получить историю цен материала
+// @Tags Администратор
+// @Description Возвращает все цены материала по датам начала действия, включая запланированные изменения
+// @Produce json
+// @Param id path int true "ID материала"
+// @Success 200 {array} model.PriceListEntry
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/materials/{id}/prices [get]
+func (h *Handler) GetMaterialPriceHistory(c *gin.Context) {
+	h.getPriceHistory(c, priceKindMaterial)
+}
+
+// AddServicePrice godoc
+// @Summary Установить цену услуги с даты
+// @Tags Администратор
+// @Description Цена действует с effective_from (сегодня или позже) до следующего изменения. Уже проведённые
+// @Description приёмы сохраняют цену на день приёма
+// @Accept json
+// @Produce json
+// @Param id path int true "ID услуги"
+// @Param price body model.PriceListEntryRequest true "Цена и дата начала действия"
+// @Success 201 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Услуга не найдена"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/services/{id}/prices [post]
+func (h *Handler) AddServicePrice(c *gin.Context) {
+	h.addPrice(c, priceKindService)
+}
+
+// AddMaterialPrice godoc
+// @Summary Установить цену материала с даты
+// @Tags Администратор
+// @Description Цена действует с effective_from (сегодня или позже) до следующего изменения. Уже проведённые
+// @Description приёмы сохраняют цену на день приёма
+// @Accept json
+// @Produce json
+// @Param id path int true "ID материала"
+// @Param price body model.PriceListEntryRequest true "Цена и дата начала действия"
+// @Success 201 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Материал не найден"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/materials/{id}/prices [post]
+func (h *Handler) AddMaterialPrice(c *gin.Context) {
+	h.addPrice(c, priceKindMaterial)
+}
+
+// DeleteServicePrice godoc
+// @Summary Отменить запланированную цену услуги
+// @Tags Администратор
+// @Description Удалить можно только цену, которая ещё не вступила в силу
+// @Produce json
+// @Param id path int true "ID услуги"
+// @Param entryId path int true "ID записи истории цен"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Цена не найдена"
+// @Failure 409 {object} httperror.Response "Цена уже действует"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/services/{id}/prices/{entryId} [delete]
+func (h *Handler) DeleteServicePrice(c *gin.Context) {
+	h.deletePrice(c, priceKindService)
+}
+
+// DeleteMaterialPrice godoc
+// @Summary Отменить запланированную цену материала
+// @Tags Администратор
+// @Description Удалить можно только цену, которая ещё не вступила в силу
+// @Produce json
+// @Param id path int true "ID материала"
+// @Param entryId path int true "ID записи истории цен"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Цена не найдена"
+// @Failure 409 {object} httperror.Response "Цена уже действует"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/materials/{id}/prices/{entryId} [delete]
+func (h *Handler) DeleteMaterialPrice(c *gin.Context) {
+	h.deletePrice(c, priceKindMaterial)
+}
+
+// GetServicePriceOnDate godoc
+// @Summary Получить цену услуги на дату
+// @Tags Администратор
+// @Produce json
+// @Param id path int true "ID услуги"
+// @Param date query string true "Дата в формате YYYY-MM-DD"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "На эту дату цена не задана"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/services/{id}/prices/on [get]
+func (h *Handler) GetServicePriceOnDate(c *gin.Context) {
+	h.getPriceOnDate(c, priceKindService)
+}
+
+// GetMaterialPriceOnDate godoc
+// @Summary Получить цену материала на дату
+// @Tags Администратор
+// @Produce json
+// @Param id path int true "ID материала"
+// @Param date query string true "Дата в формате YYYY-MM-DD"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "На эту дату цена не задана"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/materials/{id}/prices/on [get]
+func (h *Handler) GetMaterialPriceOnDate(c *gin.Context) {
+	h.getPriceOnDate(c, priceKindMaterial)
+}
+
+func (h *Handler) getPriceHistory(c *gin.Context, kind string) {
+	itemID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	resp, err := h.AdminClient.Client.GetPriceHistory(c.Request.Context(), &adminpb.GetPriceHistoryRequest{Kind: kind, ItemId: int32(itemID)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	entries := []model.PriceListEntry{}
+	for _, e := range resp.Entries {
+		entries = append(entries, model.PriceListEntry{
+			ID:            int(e.Id),
+			ItemID:        int(e.ItemId),
+			Price:         int(e.Price),
+			EffectiveFrom: e.EffectiveFrom.AsTime().Format("2006-01-02"),
+			CreatedAt:     e.CreatedAt.AsTime().Format(time.RFC3339),
+		})
+	}
+	c.JSON(http.StatusOK, entries)
+}
+
+func (h *Handler) addPrice(c *gin.Context, kind string) {
+	itemID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	var req model.PriceListEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	effectiveFrom, err := time.Parse("2006-01-02", req.EffectiveFrom)
+	if err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Не удалось преобразовать дату: "+err.Error())
+		return
+	}
+
+	resp, err := h.AdminClient.Client.AddPriceListEntry(c.Request.Context(), &adminpb.AddPriceListEntryRequest{
+		Kind: kind,
+		Entry: &adminpb.PriceListEntry{
+			ItemId:        int32(itemID),
+			Price:         int32(*req.Price),
+			EffectiveFrom: timestamppb.New(effectiveFrom),
+		},
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{"id": resp.Id})
+}
+
+func (h *Handler) deletePrice(c *gin.Context, kind string) {
+	entryID, err := strconv.Atoi(c.Param("entryId"))
+	if err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	_, err = h.AdminClient.Client.DeletePriceListEntry(c.Request.Context(), &adminpb.DeletePriceListEntryRequest{Kind: kind, Id: int32(entryID)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+func (h *Handler) getPriceOnDate(c *gin.Context, kind string) {
+	itemID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	date, err := time.Parse("2006-01-02", c.Query("date"))
+	if err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Не удалось преобразовать дату: "+err.Error())
+		return
+	}
+	resp, err := h.AdminClient.Client.GetPriceOnDate(c.Request.Context(), &adminpb.GetPriceOnDateRequest{
+		Kind:   kind,
+		ItemId: int32(itemID),
+		Date:   timestamppb.New(date),
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"price": resp.Price, "date": date.Format("2006-01-02")})
+}
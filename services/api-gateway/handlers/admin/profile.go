@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	authpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/auth"
 	"github.com/gin-gonic/gin"
@@ -13,20 +14,20 @@ import (
 // @Description Возвращает информацию о текущем администраторе по токену
 // @Produce json
 // @Success 200 {object} model.AdminWithRole
-// @Failure 401 {object} gin.H "Необходима авторизация"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 401 {object} httperror.Response "Необходима авторизация"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/admin/me [get]
 func (h *Handler) getAdminProfile(c *gin.Context) {
 	token, err := c.Cookie("access_token")
 	if err != nil || token == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "необходима авторизация"})
+		httperror.Respond(c, http.StatusUnauthorized, "необходима авторизация")
 		return
 	}
 
 	resp, err := h.AuthClient.Client.GetAdminProfile(c.Request.Context(), &authpb.GetProfileRequest{Token: token})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	admin := model.AdminWithRole{
@@ -1,6 +1,7 @@
 package admin
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
 	"github.com/gin-gonic/gin"
@@ -29,15 +30,15 @@ type updateDoctorScheduleRequest struct {
 // @Produce json
 // @Param schedule body updateClinicScheduleRequest true "Новое расписание клиники"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Некорректные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/clinic-schedule [put]
 func (h *Handler) UpdateClinicSchedule(c *gin.Context) {
 	var reqSchedule updateClinicScheduleRequest
 	if err := c.ShouldBindJSON(&reqSchedule); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Некорректные данные: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
 		return
 	}
 
@@ -49,7 +50,7 @@ func (h *Handler) UpdateClinicSchedule(c *gin.Context) {
 		start, err := time.Parse("15:04", item.StartTime)
 		if err != nil {
 			log.Println(err.Error())
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Не удалось преобразовать время: " + err.Error()})
+			httperror.Respond(c, http.StatusBadRequest, "Не удалось преобразовать время: "+err.Error())
 			return
 		}
 		if item.EndTime == "" {
@@ -58,7 +59,7 @@ func (h *Handler) UpdateClinicSchedule(c *gin.Context) {
 		end, err := time.Parse("15:04", item.EndTime)
 		if err != nil {
 			log.Println(err.Error())
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Не удалось преобразовать время: " + err.Error()})
+			httperror.Respond(c, http.StatusBadRequest, "Не удалось преобразовать время: "+err.Error())
 			return
 		}
 		day := &adminpb.WeeklyClinicSchedule{
@@ -74,8 +75,7 @@ func (h *Handler) UpdateClinicSchedule(c *gin.Context) {
 	newSchedule := &adminpb.UpdateClinicWeeklyScheduleRequest{ClinicSchedule: schedule}
 	_, err := h.AdminClient.Client.UpdateClinicWeeklySchedule(c.Request.Context(), newSchedule)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
@@ -90,21 +90,21 @@ func (h *Handler) UpdateClinicSchedule(c *gin.Context) {
 // @Param selectedDoctor path int true "ID врача"
 // @Param schedule body updateDoctorScheduleRequest true "Новое расписание врача"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Некорректные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/doctor-schedule/{selectedDoctor} [put]
 func (h *Handler) UpdateDoctorSchedule(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("selectedDoctor"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Некорректные данные: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
 		return
 	}
 	var reqSchedule updateDoctorScheduleRequest
 	if err := c.ShouldBindJSON(&reqSchedule); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Некорректные данные: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
 		return
 	}
 
@@ -116,7 +116,7 @@ func (h *Handler) UpdateDoctorSchedule(c *gin.Context) {
 		start, err := time.Parse("15:04", item.StartTime)
 		if err != nil {
 			log.Println(err.Error())
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Не удалось преобразовать время: " + err.Error()})
+			httperror.Respond(c, http.StatusBadRequest, "Не удалось преобразовать время: "+err.Error())
 			return
 		}
 		if item.EndTime == "" {
@@ -125,7 +125,7 @@ func (h *Handler) UpdateDoctorSchedule(c *gin.Context) {
 		end, err := time.Parse("15:04", item.EndTime)
 		if err != nil {
 			log.Println(err.Error())
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Не удалось преобразовать время: " + err.Error()})
+			httperror.Respond(c, http.StatusBadRequest, "Не удалось преобразовать время: "+err.Error())
 			return
 		}
 		day := &adminpb.WeeklyDoctorSchedule{
@@ -142,8 +142,7 @@ func (h *Handler) UpdateDoctorSchedule(c *gin.Context) {
 	newSchedule := &adminpb.UpdateDoctorWeeklyScheduleRequest{DoctorSchedule: schedule}
 	_, err = h.AdminClient.Client.UpdateDoctorWeeklySchedule(c.Request.Context(), newSchedule)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
@@ -157,15 +156,15 @@ func (h *Handler) UpdateDoctorSchedule(c *gin.Context) {
 // @Produce json
 // @Param override body model.ClinicDailyOverride true "Переопределение дня"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Некорректные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/clinic-overrides [post]
 func (h *Handler) AddClinicDailyOverride(c *gin.Context) {
 	var reqOverride model.ClinicDailyOverride
 	if err := c.ShouldBindJSON(&reqOverride); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Некорректные данные: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
 		return
 	}
 	var isDayOff bool
@@ -176,8 +175,7 @@ func (h *Handler) AddClinicDailyOverride(c *gin.Context) {
 	}
 	date, err := time.Parse("2006-01-02", reqOverride.Date)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 	}
 	if reqOverride.StartTime == "" {
 		reqOverride.StartTime = "00:00"
@@ -187,13 +185,11 @@ func (h *Handler) AddClinicDailyOverride(c *gin.Context) {
 	}
 	start, err := time.Parse("15:04", reqOverride.StartTime)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 	}
 	end, err := time.Parse("15:04", reqOverride.EndTime)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 	}
 	_, err = h.AdminClient.Client.AddClinicDailyOverride(c.Request.Context(), &adminpb.AddClinicDailyOverrideRequest{
 		Date:      timestamppb.New(date),
@@ -202,8 +198,7 @@ func (h *Handler) AddClinicDailyOverride(c *gin.Context) {
 		IsDayOff:  isDayOff,
 	})
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
@@ -217,15 +212,15 @@ func (h *Handler) AddClinicDailyOverride(c *gin.Context) {
 // @Produce json
 // @Param override body model.DoctorDailyOverride true "Переопределение дня врача"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Некорректные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/doctor-overrides [post]
 func (h *Handler) AddDoctorDailyOverride(c *gin.Context) {
 	var reqOverride model.DoctorDailyOverride
 	if err := c.ShouldBindJSON(&reqOverride); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Некорректные данные: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
 		return
 	}
 	var isDayOff bool
@@ -242,18 +237,15 @@ func (h *Handler) AddDoctorDailyOverride(c *gin.Context) {
 	}
 	date, err := time.Parse("2006-01-02", reqOverride.Date)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 	}
 	start, err := time.Parse("15:04", reqOverride.StartTime)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 	}
 	end, err := time.Parse("15:04", reqOverride.EndTime)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 	}
 	_, err = h.AdminClient.Client.AddDoctorDailyOverride(c.Request.Context(), &adminpb.AddDoctorDailyOverrideRequest{
 		DoctorId:  int32(reqOverride.DoctorId),
@@ -263,8 +255,7 @@ func (h *Handler) AddDoctorDailyOverride(c *gin.Context) {
 		IsDayOff:  isDayOff,
 	})
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
@@ -0,0 +1,212 @@
+package admin
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// GetScheduleRules godoc
+// @Summary Получить правила-исключения расписания
+// @Tags Администратор
+// @Description Возвращает отпуска, сокращённые дни и праздники клиники или врача
+// @Produce json
+// @Param doctor_id query int false "ID врача; не указан — правила клиники"
+// @Success 200 {array} model.ScheduleRule
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/schedule/rules [get]
+func (h *Handler) GetScheduleRules(c *gin.Context) {
+	doctorID := 0
+	if param := c.Query("doctor_id"); param != "" {
+		id, err := strconv.Atoi(param)
+		if err != nil {
+			log.Println(err.Error())
+			httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+			return
+		}
+		doctorID = id
+	}
+
+	resp, err := h.AdminClient.Client.GetScheduleRules(c.Request.Context(), &adminpb.GetScheduleRulesRequest{DoctorId: int32(doctorID)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	rules := []model.ScheduleRule{}
+	for _, r := range resp.Rules {
+		rule := model.ScheduleRule{
+			ID:                  int(r.Id),
+			DoctorID:            int(r.DoctorId),
+			Recurrence:          r.Recurrence,
+			DateFrom:            r.DateFrom.AsTime().Format("2006-01-02"),
+			Weekday:             int(r.Weekday),
+			Nth:                 int(r.Nth),
+			SlotDurationMinutes: int(r.SlotDurationMinutes),
+			IsDayOff:            r.IsDayOff,
+			Title:               r.Title,
+		}
+		if r.DateTo != nil {
+			rule.DateTo = r.DateTo.AsTime().Format("2006-01-02")
+		}
+		if !r.IsDayOff {
+			rule.StartTime = r.StartTime.AsTime().Format("15:04")
+			rule.EndTime = r.EndTime.AsTime().Format("15:04")
+		}
+		rules = append(rules, rule)
+	}
+	c.JSON(http.StatusOK, rules)
+}
+
+// AddScheduleRule godoc
+// @Summary Добавить правило-исключение расписания
+// @Tags Администратор
+// @Description Добавляет отпуск или сокращённые дни на диапазон дат (range), правило вида «каждый первый понедельник»
+// @Description (monthly_weekday, nth = -1 — последний) или ежегодный праздник (annual). Возвращает будущие записи,
+// @Description которые оказались вне нового расписания и требуют переноса
+// @Accept json
+// @Produce json
+// @Param rule body model.ScheduleRule true "Правило расписания"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/schedule/rules [post]
+func (h *Handler) AddScheduleRule(c *gin.Context) {
+	var req model.ScheduleRule
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+
+	rule := &adminpb.ScheduleRule{
+		DoctorId:            int32(req.DoctorID),
+		Recurrence:          req.Recurrence,
+		Weekday:             int32(req.Weekday),
+		Nth:                 int32(req.Nth),
+		SlotDurationMinutes: int32(req.SlotDurationMinutes),
+		IsDayOff:            req.IsDayOff,
+		Title:               req.Title,
+	}
+	dateFrom, err := time.Parse("2006-01-02", req.DateFrom)
+	if err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Не удалось преобразовать дату: "+err.Error())
+		return
+	}
+	rule.DateFrom = timestamppb.New(dateFrom)
+	if req.DateTo != "" {
+		dateTo, err := time.Parse("2006-01-02", req.DateTo)
+		if err != nil {
+			log.Println(err.Error())
+			httperror.Respond(c, http.StatusBadRequest, "Не удалось преобразовать дату: "+err.Error())
+			return
+		}
+		rule.DateTo = timestamppb.New(dateTo)
+	}
+	if !req.IsDayOff {
+		start, err := time.Parse("15:04", req.StartTime)
+		if err != nil {
+			log.Println(err.Error())
+			httperror.Respond(c, http.StatusBadRequest, "Не удалось преобразовать время: "+err.Error())
+			return
+		}
+		end, err := time.Parse("15:04", req.EndTime)
+		if err != nil {
+			log.Println(err.Error())
+			httperror.Respond(c, http.StatusBadRequest, "Не удалось преобразовать время: "+err.Error())
+			return
+		}
+		rule.StartTime = timestamppb.New(start)
+		rule.EndTime = timestamppb.New(end)
+	}
+
+	resp, err := h.AdminClient.Client.AddScheduleRule(c.Request.Context(), rule)
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"id": resp.Id, "clashes": clashes(resp.Clashes)})
+}
+
+// DeleteScheduleRule godoc
+// @Summary Удалить правило-исключение расписания
+// @Tags Администратор
+// @Produce json
+// @Param id path int true "ID правила"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/schedule/rules/{id} [delete]
+func (h *Handler) DeleteScheduleRule(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	_, err = h.AdminClient.Client.DeleteScheduleRule(c.Request.Context(), &adminpb.DeleteRequest{Id: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// ImportHolidayCalendar godoc
+// @Summary Загрузить календарь праздников
+// @Tags Администратор
+// @Description Добавляет праздники как выходные дни клиники; уже загруженные пропускаются. Праздники с annual = true
+// @Description повторяются каждый год. Возвращает количество добавленных праздников и записи, попавшие на праздники
+// @Accept json
+// @Produce json
+// @Param calendar body model.HolidayCalendar true "Календарь праздников"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/schedule/holidays/import [post]
+func (h *Handler) ImportHolidayCalendar(c *gin.Context) {
+	var req model.HolidayCalendar
+	if err := c.ShouldBindJSON(&req); err != nil {
+		log.Println(err.Error())
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+
+	var holidays []*adminpb.Holiday
+	for _, item := range req.Holidays {
+		date, err := time.Parse("2006-01-02", item.Date)
+		if err != nil {
+			log.Println(err.Error())
+			httperror.Respond(c, http.StatusBadRequest, "Не удалось преобразовать дату: "+err.Error())
+			return
+		}
+		holidays = append(holidays, &adminpb.Holiday{Date: timestamppb.New(date), Title: item.Title, Annual: item.Annual})
+	}
+
+	resp, err := h.AdminClient.Client.ImportHolidayCalendar(c.Request.Context(), &adminpb.ImportHolidayCalendarRequest{Holidays: holidays})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"added": resp.Added, "clashes": clashes(resp.Clashes)})
+}
+
+// clashes записи, требующие переноса; пустой список, а не null, чтобы фронтенду не приходилось проверять
+func clashes(items []*adminpb.Appointment) []model.UnconfirmedAppointment {
+	appointments := unconfirmedAppointments(items)
+	if appointments == nil {
+		return []model.UnconfirmedAppointment{}
+	}
+	return appointments
+}
@@ -1,6 +1,8 @@
 package admin
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/listquery"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
 	"github.com/gin-gonic/gin"
@@ -13,20 +15,52 @@ import (
 
 // @Summary Получить неподтверждённые записи
 // @Tags Администратор
-// @Description Возвращает список неподтверждённых записей на приём
+// @Description Возвращает неподтверждённые записи на приём, отсортированные по дате и времени приёма
 // @Produce json
+// @Param date_from query string false "Первая дата приёма, ГГГГ-ММ-ДД"
+// @Param date_to query string false "Последняя дата приёма, ГГГГ-ММ-ДД"
+// @Param limit query int false "Размер страницы, по умолчанию 50, не больше 200"
+// @Param offset query int false "Сколько записей пропустить"
+// @Param sort query string false "Поле сортировки: date, created_at"
+// @Param order query string false "Порядок сортировки: asc или desc"
 // @Success 200 {array} model.UnconfirmedAppointment
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Header 200 {integer} X-Total-Count "Число всех найденных записей"
+// @Failure 400 {object} httperror.Response "Некорректные параметры списка"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/unconfirmed-appointments [get]
 func (h *Handler) GetUnconfirmedAppointments(c *gin.Context) {
-	items, err := h.AdminClient.Client.GetUnconfirmedAppointments(c.Request.Context(), &adminpb.EmptyRequest{})
+	params, err := listquery.Parse(c, listquery.DefaultLimit)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
+	dateFrom, err := listquery.Date(c, "date_from")
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	dateTo, err := listquery.Date(c, "date_to")
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	items, err := h.AdminClient.Client.GetUnconfirmedAppointments(c.Request.Context(), &adminpb.GetUnconfirmedAppointmentsRequest{
+		Page:     params.Admin(),
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	listquery.SetTotal(c, items.Total)
+	c.JSON(http.StatusOK, unconfirmedAppointments(items.Appointments))
+}
+
+func unconfirmedAppointments(items []*adminpb.Appointment) []model.UnconfirmedAppointment {
 	var appointments []model.UnconfirmedAppointment
-	for _, item := range items.Appointments {
+	for _, item := range items {
 		appt := model.UnconfirmedAppointment{
 			ID:                int(item.Id),
 			Doctor:            item.Doctor,
@@ -45,7 +79,7 @@ func (h *Handler) GetUnconfirmedAppointments(c *gin.Context) {
 		}
 		appointments = append(appointments, appt)
 	}
-	c.JSON(http.StatusOK, appointments)
+	return appointments
 }
 
 // @Summary Обновить запись на приём
@@ -56,34 +90,35 @@ func (h *Handler) GetUnconfirmedAppointments(c *gin.Context) {
 // @Param id path int true "ID записи"
 // @Param appointment body model.UpdateAppointment true "Новая информация о записи"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Неверный ввод"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Неверный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 409 {object} httperror.Response "Выбранное время уже занято"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/unconfirmed-appointments/{id} [put]
 func (h *Handler) UpdateAppointment(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
 	var appointment model.UpdateAppointment
 	if err := c.ShouldBindJSON(&appointment); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 	date, err := time.Parse("02.01.2006", appointment.Date)
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 	apptTime, err := time.Parse("15:04", appointment.Time)
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
@@ -99,8 +134,7 @@ func (h *Handler) UpdateAppointment(c *gin.Context) {
 
 	_, err = h.AdminClient.Client.UpdateAppointment(c.Request.Context(), updateReq)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
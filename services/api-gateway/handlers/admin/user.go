@@ -1,7 +1,10 @@
 package admin
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
+	authpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/auth"
 	"github.com/gin-gonic/gin"
 	"log"
 	"net/http"
@@ -16,49 +19,104 @@ type patientLoginRequest struct {
 	Phone string `json:"phone"`
 }
 
-// @Summary Удалить пользователя
+// RetireUser godoc
+// @Summary Вывести пользователя из работы
 // @Tags Администратор
-// @Description Удаляет пользователя по ID
+// @Description Пользователь не удаляется: пациент переводится в архив и скрывается из списков, сотрудник
+// @Description отключается и больше не может войти. Все сессии пользователя завершаются, медицинская карта,
+// @Description приёмы и документы сохраняются. Вернуть пользователя можно через /api/users/{id}/restore
 // @Produce json
 // @Param id path int true "ID пользователя"
-// @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Неверный ввод"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Success 200 {object} model.UserStatusResponse
+// @Failure 400 {object} httperror.Response "Неверный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Пользователь не найден"
+// @Failure 409 {object} httperror.Response "Нельзя отключить собственную учётную запись"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/doctors/{id} [delete]
 // @Router /api/admins/{id} [delete]
 // @Router /api/patients/{id} [delete]
-func (h *Handler) DeleteUser(c *gin.Context) {
-	id, err := strconv.Atoi(c.Param("id"))
+func (h *Handler) RetireUser(c *gin.Context) {
+	id, changedBy, ok := h.userStatusChange(c)
+	if !ok {
+		return
+	}
+	resp, err := h.AdminClient.Client.RetireUser(c.Request.Context(), &adminpb.ChangeUserStatusRequest{
+		UserId:    int32(id),
+		ChangedBy: changedBy,
+	})
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Write(c, err)
 		return
 	}
+	// статус уже изменён, поэтому при ошибке запрос можно повторить: повторный вывод из работы ничего не меняет
+	_, err = h.AuthClient.Client.RevokeUserSessions(c.Request.Context(), &authpb.RevokeUserSessionsRequest{UserId: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, model.UserStatusResponse{Status: resp.Status})
+}
 
-	DeleteRequest := &adminpb.DeleteRequest{Id: int32(id)}
-
-	_, err = h.AdminClient.Client.DeleteUser(c.Request.Context(), DeleteRequest)
+// RestoreUser godoc
+// @Summary Вернуть пользователя в работу
+// @Tags Администратор
+// @Description Возвращает отключённого сотрудника или архивного пациента: пользователь снова виден в списках и может войти
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Success 200 {object} model.UserStatusResponse
+// @Failure 400 {object} httperror.Response "Неверный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Пользователь не найден"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
+// @Router /api/users/{id}/restore [post]
+func (h *Handler) RestoreUser(c *gin.Context) {
+	id, changedBy, ok := h.userStatusChange(c)
+	if !ok {
+		return
+	}
+	resp, err := h.AdminClient.Client.RestoreUser(c.Request.Context(), &adminpb.ChangeUserStatusRequest{
+		UserId:    int32(id),
+		ChangedBy: changedBy,
+	})
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{})
+	c.JSON(http.StatusOK, model.UserStatusResponse{Status: resp.Status})
+}
+
+// userStatusChange пользователь из пути запроса и администратор, который меняет его статус
+func (h *Handler) userStatusChange(c *gin.Context) (int, int32, bool) {
+	token, err := c.Cookie("access_token")
+	if err != nil || token == "" {
+		httperror.Respond(c, http.StatusUnauthorized, "необходима авторизация")
+		return 0, 0, false
+	}
+	id, ok := pathID(c, "id")
+	if !ok {
+		return 0, 0, false
+	}
+	admin, err := h.AuthClient.Client.GetUserID(c.Request.Context(), &authpb.GetUserIDRequest{Token: token})
+	if err != nil {
+		httperror.Write(c, err)
+		return 0, 0, false
+	}
+	return id, admin.UserId, true
 }
 
 func (h *Handler) UpdateEmployeeLogin(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
 	var loginReq employeeLoginRequest
 	if err := c.ShouldBindJSON(&loginReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
@@ -68,8 +126,7 @@ func (h *Handler) UpdateEmployeeLogin(c *gin.Context) {
 	}
 	_, err = h.AdminClient.Client.UpdateEmployeeLogin(c.Request.Context(), UpdateEmployeeLoginRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
@@ -83,22 +140,22 @@ func (h *Handler) UpdateEmployeeLogin(c *gin.Context) {
 // @Param id path int true "ID сотрудника"
 // @Param login body employeeLoginRequest true "Новый логин"
 // @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Неверный ввод"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Неверный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/patients-login/{id} [put]
 func (h *Handler) UpdatePatientLogin(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
 	var loginReq patientLoginRequest
 	if err := c.ShouldBindJSON(&loginReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
@@ -108,8 +165,7 @@ func (h *Handler) UpdatePatientLogin(c *gin.Context) {
 	}
 	_, err = h.AdminClient.Client.UpdatePatientLogin(c.Request.Context(), UpdatePatientLoginRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{})
@@ -1,26 +1,37 @@
 package admin
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/listquery"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
 	"github.com/gin-gonic/gin"
-	"log"
 	"net/http"
-	"strconv"
 )
 
 // @Summary Получить список неоплаченных посещений
 // @Tags Администратор
-// @Description Возвращает список неоплаченных посещений с материалами и услугами
+// @Description Возвращает список неоплаченных посещений с материалами и услугами, счётом и остатком к оплате
 // @Produce json
+// @Param limit query int false "Размер страницы, по умолчанию 50, не больше 200"
+// @Param offset query int false "Сколько записей пропустить"
+// @Param sort query string false "Поле сортировки: visit_id"
+// @Param order query string false "Порядок сортировки: asc или desc"
 // @Success 200 {array} model.VisitPayment
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Header 200 {integer} X-Total-Count "Число всех найденных записей"
+// @Failure 400 {object} httperror.Response "Некорректные параметры списка"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/completed-visits [get]
 func (h *Handler) GetVisitPayments(c *gin.Context) {
-	items, err := h.AdminClient.Client.GetUnconfirmedVisitPayments(c.Request.Context(), &adminpb.EmptyRequest{})
+	params, err := listquery.Parse(c, listquery.DefaultLimit)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	items, err := h.AdminClient.Client.GetUnconfirmedVisitPayments(c.Request.Context(), &adminpb.GetUnconfirmedVisitPaymentsRequest{Page: params.Admin()})
+	if err != nil {
+		httperror.Write(c, err)
 		return
 	}
 	var visitPayments []model.VisitPayment
@@ -28,10 +39,12 @@ func (h *Handler) GetVisitPayments(c *gin.Context) {
 		var materialsAndServices []model.MaterialsAndServices
 		for _, mss := range item.MaterialsAndServices {
 			ms := model.MaterialsAndServices{
-				ID:       int(mss.Id),
-				VisitID:  int(mss.VisitId),
-				Item:     mss.Item,
-				Quantity: int(mss.Quantity),
+				ID:           int(mss.Id),
+				VisitID:      int(mss.VisitId),
+				Item:         mss.Item,
+				Quantity:     int(mss.Quantity),
+				PricePerUnit: mss.PricePerUnit,
+				TotalPrice:   mss.TotalPrice,
 			}
 			materialsAndServices = append(materialsAndServices, ms)
 		}
@@ -42,51 +55,13 @@ func (h *Handler) GetVisitPayments(c *gin.Context) {
 			CreatedAt:            item.CreatedAt,
 			Price:                int(item.Price),
 			MaterialsAndServices: materialsAndServices,
+			InvoiceID:            int(item.InvoiceId),
+			Paid:                 int(item.Paid),
+			Balance:              int(item.Balance),
+			Status:               item.Status,
 		}
 		visitPayments = append(visitPayments, payment)
 	}
+	listquery.SetTotal(c, items.Total)
 	c.JSON(http.StatusOK, visitPayments)
 }
-
-// @Summary Обновить оплату за посещение
-// @Tags Администратор
-// @Description Обновляет информацию об оплате посещения по ID
-// @Accept json
-// @Produce json
-// @Param id path int true "ID посещения"
-// @Param payment body model.VisitPaymentUpdate true "Данные об оплате"
-// @Success 200 {object} gin.H
-// @Failure 400 {object} gin.H "Неверный ввод"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
-// @Router /api/completed-visits/{id} [put]
-func (h *Handler) UpdateVisitPayment(c *gin.Context) {
-	id, err := strconv.Atoi(c.Param("id"))
-	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
-		return
-	}
-
-	var payment model.VisitPaymentUpdate
-	if err := c.ShouldBindJSON(&payment); err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
-		return
-	}
-	updateVisitPayment := &adminpb.VisitPayment{
-		VisitId: int32(id),
-		Price:   int32(payment.Price),
-		Status:  payment.Status,
-	}
-
-	updateReq := &adminpb.UpdateVisitPaymentRequest{Payment: updateVisitPayment}
-
-	_, err = h.AdminClient.Client.UpdateVisitPayment(c.Request.Context(), updateReq)
-	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-	c.JSON(http.StatusOK, gin.H{})
-}
@@ -1,11 +1,14 @@
 package auth
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/middleware"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	authpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/auth"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
-	"time"
 )
 
 type roleResponse struct {
@@ -19,13 +22,13 @@ type roleResponse struct {
 // @Tags Авторизация
 // @Param input body model.RegisterRequest true "Данные для авторизации"
 // @Success 200 {object} roleResponse
-// @Failure 400 {object} gin.H
-// @Failure 401 {object} gin.H
+// @Failure 400 {object} httperror.Response
+// @Failure 401 {object} httperror.Response
 // @Router /api/login [post]
 func (h *Handler) authorize(c *gin.Context) {
 	var req model.RegisterRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 	resp, err := h.AuthClient.Client.Auth(c.Request.Context(), &authpb.AuthRequest{
@@ -33,23 +36,87 @@ func (h *Handler) authorize(c *gin.Context) {
 		Password: req.Password,
 	})
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": err.Error()})
+		httperror.Respond(c, http.StatusUnauthorized, err.Error())
 		return
 	}
 
-	setTokenCookie(c, resp.Token)
+	middleware.SetTokenCookies(c, resp.Token, resp.RefreshToken)
 
 	c.JSON(http.StatusOK, roleResponse{Role: resp.Role})
 }
 
-func setTokenCookie(c *gin.Context, token string) {
-	http.SetCookie(c.Writer, &http.Cookie{
-		Name:     "access_token",
-		Value:    token,
-		Path:     "/",
-		Expires:  time.Now().Add(2 * time.Hour),
-		Secure:   false,
-		HttpOnly: true,
-		SameSite: http.SameSiteLaxMode,
-	})
+// @Summary Обновление сессии по refresh-токену
+// @Tags Авторизация
+// @Produce json
+// @Success 200 {object} gin.H
+// @Failure 401 {object} httperror.Response
+// @Router /api/refresh [post]
+func (h *Handler) refresh(c *gin.Context) {
+	refreshToken, err := c.Cookie(middleware.RefreshTokenCookie)
+	if err != nil || refreshToken == "" {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	resp, err := h.AuthClient.Client.RefreshToken(c.Request.Context(), &authpb.RefreshTokenRequest{RefreshToken: refreshToken})
+	if err != nil {
+		middleware.ClearTokenCookies(c)
+		httperror.Respond(c, http.StatusUnauthorized, "Сессия завершена")
+		return
+	}
+
+	middleware.SetTokenCookies(c, resp.Token, resp.RefreshToken)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Сессия обновлена"})
+}
+
+// @Summary Выход из текущей сессии
+// @Tags Авторизация
+// @Produce json
+// @Success 200 {object} gin.H
+// @Failure 500 {object} httperror.Response
+// @Router /api/logout [post]
+func (h *Handler) logout(c *gin.Context) {
+	token, _ := c.Cookie(middleware.AccessTokenCookie)
+	refreshToken, _ := c.Cookie(middleware.RefreshTokenCookie)
+	if token != "" || refreshToken != "" {
+		_, err := h.AuthClient.Client.Logout(c.Request.Context(), &authpb.LogoutRequest{
+			Token:        token,
+			RefreshToken: refreshToken,
+		})
+		if err != nil && status.Code(err) != codes.Unauthenticated {
+			httperror.Write(c, err)
+			return
+		}
+	}
+
+	middleware.ClearTokenCookies(c)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Выход выполнен"})
+}
+
+// @Summary Выход из всех сессий пользователя
+// @Tags Авторизация
+// @Produce json
+// @Success 200 {object} gin.H
+// @Failure 401,500 {object} httperror.Response
+// @Router /api/logout-all [post]
+func (h *Handler) logoutAll(c *gin.Context) {
+	token, err := c.Cookie(middleware.AccessTokenCookie)
+	if err != nil || token == "" {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	_, err = h.AuthClient.Client.LogoutAllSessions(c.Request.Context(), &authpb.LogoutRequest{Token: token})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			httperror.Respond(c, http.StatusUnauthorized, "Сессия завершена")
+			return
+		}
+		httperror.Write(c, err)
+		return
+	}
+
+	middleware.ClearTokenCookies(c)
+
+	c.JSON(http.StatusOK, gin.H{"message": "Все сессии завершены"})
 }
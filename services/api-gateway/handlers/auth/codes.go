@@ -1,9 +1,8 @@
 package auth
 
 import (
-	"errors"
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	authpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/auth"
-	"github.com/DariaTarasek/diplom/services/api-gateway/sharederrors"
 	"github.com/gin-gonic/gin"
 	"net/http"
 )
@@ -25,18 +24,18 @@ type (
 // @Tags Авторизация
 // @Param input body requestCode true "Телефон"
 // @Success 200 {object} gin.H
-// @Failure 400,500 {object} gin.H
+// @Failure 400,500 {object} httperror.Response
 // @Router /auth/request-code [post]
 func (h *Handler) requestCode(c *gin.Context) {
 	var req requestCode
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	_, err := h.AuthClient.Client.RequestCode(c.Request.Context(), &authpb.GenerateCodeRequest{Phone: req.Phone})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 
@@ -50,12 +49,12 @@ func (h *Handler) requestCode(c *gin.Context) {
 // @Tags Авторизация
 // @Param input body verifyCode true "Телефон и код"
 // @Success 200 {object} gin.H
-// @Failure 400,404,500 {object} gin.H
+// @Failure 400,404,500 {object} httperror.Response
 // @Router /auth/verify-code [post]
 func (h *Handler) verifyCode(c *gin.Context) {
 	var req verifyCode
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -64,11 +63,7 @@ func (h *Handler) verifyCode(c *gin.Context) {
 		Code:  req.Code,
 	})
 	if err != nil {
-		if errors.Is(err, sharederrors.ErrCodeInvalid) {
-			c.JSON(http.StatusNotFound, gin.H{"error": "неверный введенный код"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 
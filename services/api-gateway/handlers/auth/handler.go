@@ -26,6 +26,9 @@ func RegisterRoutes(rg *gin.RouterGroup, h *Handler) {
 	rg.POST("/request-code", h.requestCode)
 	rg.POST("/verify-code", h.verifyCode)
 	rg.POST("/login", h.authorize)
+	rg.POST("/refresh", h.refresh)
+	rg.POST("/logout", h.logout)
+	rg.POST("/logout-all", h.logoutAll)
 	rg.GET("/patient/me", h.AccessMiddleware(3), h.getPatient)
 	//  сюда остальные
 }
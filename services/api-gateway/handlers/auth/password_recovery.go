@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	authpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/auth"
 	"github.com/gin-gonic/gin"
 	"net/http"
@@ -16,17 +17,17 @@ type recoveryRequest struct {
 // @Tags Авторизация
 // @Param input body recoveryRequest true "Логин"
 // @Success 200 {object} gin.H
-// @Failure 400,500 {object} gin.H
+// @Failure 400,500 {object} httperror.Response
 // @Router /api/employee-password-recovery [post]
 func (h *Handler) EmployeePasswordRecovery(c *gin.Context) {
 	var req recoveryRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 	_, err := h.AuthClient.Client.EmployeePasswordRecovery(c.Request.Context(), &authpb.EmployeePasswordRecoveryRequest{Login: req.Login})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "Пароль изменен!"})
@@ -38,17 +39,17 @@ func (h *Handler) EmployeePasswordRecovery(c *gin.Context) {
 // @Produce json
 // @Param input body recoveryRequest true "Логин"
 // @Success 200 {object} gin.H
-// @Failure 400,500 {object} gin.H
+// @Failure 400,500 {object} httperror.Response
 // @Router /api/password-recovery [post]
 func (h *Handler) PatientPasswordRecovery(c *gin.Context) {
 	var req recoveryRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 	_, err := h.AuthClient.Client.PatientPasswordRecovery(c.Request.Context(), &authpb.PatientPasswordRecoveryRequest{Login: req.Login})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "Пароль изменен!"})
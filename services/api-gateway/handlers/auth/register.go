@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	authpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/auth"
 	"github.com/gin-gonic/gin"
@@ -16,13 +17,13 @@ import (
 // @Produce json
 // @Param input body model.Employee true "Данные сотрудника"
 // @Success 201 {object} gin.H
-// @Failure 400,500 {object} gin.H
+// @Failure 400,500 {object} httperror.Response
 // @Router /api/employee-registe [post]
 func (h *Handler) EmployeeRegister(c *gin.Context) {
 	var employeeReq model.Employee
 	if err := c.ShouldBindJSON(&employeeReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 	gRPCUser := &authpb.UserData{
@@ -64,8 +65,7 @@ func (h *Handler) EmployeeRegister(c *gin.Context) {
 
 	resp, err := h.AuthClient.Client.EmployeeRegister(c.Request.Context(), gRPCEmployeeRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 
@@ -78,13 +78,13 @@ func (h *Handler) EmployeeRegister(c *gin.Context) {
 // @Produce json
 // @Param input body model.Patient true "Данные пациента"
 // @Success 201 {object} gin.H
-// @Failure 400,500 {object} gin.H
+// @Failure 400,500 {object} httperror.Response
 // @Router /api/register [post]
 func (h *Handler) PatientRegister(c *gin.Context) {
 	var patientReq model.Patient
 	if err := c.ShouldBindJSON(&patientReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 	gRPCUser := &authpb.UserData{
@@ -94,7 +94,7 @@ func (h *Handler) PatientRegister(c *gin.Context) {
 
 	birthDate, err := time.Parse("2006-01-02", patientReq.BirthDate)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 	gRPCPatient := &authpb.PatientData{
@@ -114,8 +114,7 @@ func (h *Handler) PatientRegister(c *gin.Context) {
 
 	resp, err := h.AuthClient.Client.PatientRegister(c.Request.Context(), gRPCPatientRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusCreated, gin.H{"user_id": resp.UserId})
@@ -127,13 +126,13 @@ func (h *Handler) PatientRegister(c *gin.Context) {
 // @Produce json
 // @Param input body model.PatientWithoutPassword true "Данные пациента"
 // @Success 201 {object} gin.H
-// @Failure 400,500 {object} gin.H
+// @Failure 400,500 {object} httperror.Response
 // @Router /api/register-in-clinic [post]
 func (h *Handler) PatientRegisterInClinic(c *gin.Context) {
 	var patientReq model.PatientWithoutPassword
 	if err := c.ShouldBindJSON(&patientReq); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 	gRPCUser := &authpb.UserData{
@@ -143,7 +142,7 @@ func (h *Handler) PatientRegisterInClinic(c *gin.Context) {
 
 	birthDate, err := time.Parse("2006-01-02", patientReq.BirthDate)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 	gRPCPatient := &authpb.PatientData{
@@ -163,8 +162,7 @@ func (h *Handler) PatientRegisterInClinic(c *gin.Context) {
 
 	resp, err := h.AuthClient.Client.PatientRegister(c.Request.Context(), gRPCPatientRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusCreated, gin.H{"user_id": resp.UserId})
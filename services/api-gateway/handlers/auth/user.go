@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	authpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/auth"
 	"github.com/gin-gonic/gin"
@@ -13,18 +14,18 @@ import (
 // @Tags patient
 // @Security ApiCookieAuth
 // @Success 200 {object} model.PatientWithoutPassword
-// @Failure 401 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Failure 401 {object} httperror.Response
+// @Failure 500 {object} httperror.Response
 // @Router /api/patient/me [get]
 func (h *Handler) getPatient(c *gin.Context) {
 	token, err := c.Cookie("access_token")
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": "Токен не найден"})
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
 		return
 	}
 	pbPatient, err := h.AuthClient.Client.GetPatient(c.Request.Context(), &authpb.GetPatientRequest{Token: token})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "не удалось получить пользователя"})
+		httperror.Write(c, err)
 		return
 	}
 	patient := model.PatientWithoutPassword{
@@ -0,0 +1,175 @@
+package doctor
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	doctorpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/doctor"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetPatientAnamnesis godoc
+// @Summary Анкета здоровья пациента
+// @Tags Анкета здоровья
+// @Description Текущая версия анкеты: принимаемые препараты, аллергии, сопутствующие заболевания. version = 0 — пациент анкету не заполнял
+// @Produce json
+// @Param id path int true "ID пациента"
+// @Success 200 {object} model.Anamnesis
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при получении данных"
+// @Router /api/patient-notes/{id}/anamnesis [get]
+func (h *DoctorHandler) GetPatientAnamnesis(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	resp, err := h.DoctorClient.Client.GetPatientAllergiesChronics(c.Request.Context(), &doctorpb.GetByIdRequest{Id: int32(id), Token: token})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, anamnesisFromPb(resp.Anamnesis))
+}
+
+// SavePatientAnamnesis godoc
+// @Summary Изменение анкеты здоровья пациента врачом
+// @Tags Анкета здоровья
+// @Description Сохраняет анкету новой версией; прежние версии остаются в истории. Если пациент или другой врач изменили анкету после base_version, возвращается 409
+// @Accept json
+// @Produce json
+// @Param id path int true "ID пациента"
+// @Param request body model.AnamnesisRequest true "Ответы анкеты"
+// @Success 200 {object} model.Anamnesis
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Пациент не найден"
+// @Failure 409 {object} httperror.Response "Анкета уже изменена"
+// @Failure 500 {object} httperror.Response "Ошибка при сохранении"
+// @Router /api/patient-notes/{id}/anamnesis [put]
+func (h *DoctorHandler) SavePatientAnamnesis(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	var req model.AnamnesisRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	resp, err := h.DoctorClient.Client.SavePatientAnamnesis(c.Request.Context(), &doctorpb.SavePatientAnamnesisRequest{
+		Token:       token,
+		PatientId:   int32(id),
+		Anamnesis:   anamnesisToPb(req),
+		BaseVersion: int32(req.BaseVersion),
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, anamnesisFromPb(resp.Anamnesis))
+}
+
+// GetPatientAnamnesisHistory godoc
+// @Summary История анкеты здоровья пациента
+// @Tags Анкета здоровья
+// @Description Все версии анкеты, новые первыми, с указанием, кто и когда их сохранил
+// @Produce json
+// @Param id path int true "ID пациента"
+// @Success 200 {array} model.Anamnesis
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при получении данных"
+// @Router /api/patient-notes/{id}/anamnesis/history [get]
+func (h *DoctorHandler) GetPatientAnamnesisHistory(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	resp, err := h.DoctorClient.Client.GetPatientAnamnesisHistory(c.Request.Context(), &doctorpb.GetByIdRequest{Id: int32(id), Token: token})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	versions := make([]model.Anamnesis, 0, len(resp.Versions))
+	for _, item := range resp.Versions {
+		versions = append(versions, anamnesisFromPb(item))
+	}
+	c.JSON(http.StatusOK, versions)
+}
+
+func anamnesisToPb(req model.AnamnesisRequest) *doctorpb.Anamnesis {
+	item := &doctorpb.Anamnesis{
+		Medications:        req.Medications,
+		BloodThinners:      req.BloodThinners,
+		CardiacDisease:     req.CardiacDisease,
+		Hypertension:       req.Hypertension,
+		Diabetes:           req.Diabetes,
+		BleedingDisorder:   req.BleedingDisorder,
+		InfectiousDisease:  req.InfectiousDisease,
+		AnesthesiaReaction: req.AnesthesiaReaction,
+		Pregnant:           req.Pregnant,
+		Breastfeeding:      req.Breastfeeding,
+		Smoker:             req.Smoker,
+		OtherConditions:    req.OtherConditions,
+	}
+	for _, a := range req.Allergies {
+		item.Allergies = append(item.Allergies, &doctorpb.AnamnesisAllergy{
+			Allergen: a.Allergen,
+			Severity: a.Severity,
+			Reaction: a.Reaction,
+		})
+	}
+	return item
+}
+
+func anamnesisFromPb(item *doctorpb.Anamnesis) model.Anamnesis {
+	if item == nil {
+		return model.Anamnesis{Allergies: []model.AnamnesisAllergy{}}
+	}
+	anamnesis := model.Anamnesis{
+		Version:            int(item.Version),
+		Medications:        item.Medications,
+		BloodThinners:      item.BloodThinners,
+		CardiacDisease:     item.CardiacDisease,
+		Hypertension:       item.Hypertension,
+		Diabetes:           item.Diabetes,
+		BleedingDisorder:   item.BleedingDisorder,
+		InfectiousDisease:  item.InfectiousDisease,
+		AnesthesiaReaction: item.AnesthesiaReaction,
+		Pregnant:           item.Pregnant,
+		Breastfeeding:      item.Breastfeeding,
+		Smoker:             item.Smoker,
+		OtherConditions:    item.OtherConditions,
+		Allergies:          make([]model.AnamnesisAllergy, 0, len(item.Allergies)),
+		ChangedByRole:      item.ChangedByRole,
+		ChangedBy:          item.ChangedBy,
+		ChangedAt:          item.ChangedAt,
+	}
+	for _, a := range item.Allergies {
+		anamnesis.Allergies = append(anamnesis.Allergies, model.AnamnesisAllergy{
+			Allergen: a.Allergen,
+			Severity: a.Severity,
+			Reaction: a.Reaction,
+		})
+	}
+	return anamnesis
+}
@@ -1,6 +1,7 @@
 package doctor
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	doctorpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/doctor"
 	"github.com/gin-gonic/gin"
@@ -13,18 +14,18 @@ import (
 // @Tags Врач
 // @Security ApiCookieAuth
 // @Success 200 {array} model.TodayAppointment
-// @Failure 401 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Failure 401 {object} httperror.Response
+// @Failure 500 {object} httperror.Response
 // @Router /api/appointments-today [get]
 func (h *DoctorHandler) GetTodayAppointments(c *gin.Context) {
 	token, err := c.Cookie("access_token")
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": "Токен не найден"})
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
 		return
 	}
 	apps, err := h.DoctorClient.Client.GetTodayAppointments(c.Request.Context(), &doctorpb.GetTodayAppointmentsRequest{Token: token})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	todays := make([]model.TodayAppointment, 0, len(apps.Appointments))
@@ -48,18 +49,18 @@ func (h *DoctorHandler) GetTodayAppointments(c *gin.Context) {
 // @Tags Врач
 // @Security ApiCookieAuth
 // @Success 200 {object} model.ScheduleTable
-// @Failure 401 {object} gin.H
-// @Failure 500 {object} gin.H
+// @Failure 401 {object} httperror.Response
+// @Failure 500 {object} httperror.Response
 // @Router /api/schedule-with-appointments [get]
 func (h *DoctorHandler) GetUpcomingAppointments(c *gin.Context) {
 	token, err := c.Cookie("access_token")
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": "Токен не найден"})
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
 		return
 	}
 	apps, err := h.DoctorClient.Client.GetUpcomingAppointments(c.Request.Context(), &doctorpb.GetUpcomingAppointmentsRequest{Token: token})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	res := convertRpcScheduleTable(apps.Schedule)
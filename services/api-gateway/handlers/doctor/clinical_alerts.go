@@ -0,0 +1,65 @@
+package doctor
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	doctorpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/doctor"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// CheckClinicalAlerts godoc
+// @Summary Проверка материалов и услуг по карте пациента
+// @Tags Врач
+// @Description Возвращает предупреждения по выбранным материалам и услугам, противоречащим аллергиям, заболеваниям
+// @Description и анкете здоровья пациента, критичные первыми. Правила предупреждений ведёт старший администратор
+// @Accept json
+// @Produce json
+// @Param request body model.ClinicalAlertCheckRequest true "Пациент, материалы и услуги"
+// @Success 200 {array} model.ClinicalAlert
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при проверке"
+// @Router /api/clinical-alerts/check [post]
+func (h *DoctorHandler) CheckClinicalAlerts(c *gin.Context) {
+	var req model.ClinicalAlertCheckRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	checkReq := &doctorpb.CheckClinicalAlertsRequest{PatientId: int32(req.PatientID), Token: token}
+	for _, id := range req.MaterialIDs {
+		checkReq.MaterialIds = append(checkReq.MaterialIds, int32(id))
+	}
+	for _, id := range req.ServiceIDs {
+		checkReq.ServiceIds = append(checkReq.ServiceIds, int32(id))
+	}
+	resp, err := h.DoctorClient.Client.CheckClinicalAlerts(c.Request.Context(), checkReq)
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, clinicalAlertsFromPb(resp.Alerts))
+}
+
+func clinicalAlertsFromPb(items []*doctorpb.ClinicalAlert) []model.ClinicalAlert {
+	alerts := make([]model.ClinicalAlert, 0, len(items))
+	for _, item := range items {
+		alerts = append(alerts, model.ClinicalAlert{
+			RuleID:     int(item.RuleId),
+			Severity:   item.Severity,
+			Title:      item.Title,
+			Message:    item.Message,
+			Reason:     item.Reason,
+			MaterialID: int(item.MaterialId),
+			ServiceID:  int(item.ServiceId),
+			TargetName: item.TargetName,
+		})
+	}
+	return alerts
+}
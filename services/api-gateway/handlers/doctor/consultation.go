@@ -2,6 +2,8 @@ package doctor
 
 import (
 	"fmt"
+	"github.com/DariaTarasek/diplom/services/api-gateway/filestream"
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	doctorpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/doctor"
 	"github.com/gin-gonic/gin"
@@ -15,21 +17,24 @@ import (
 // @Tags Врач
 // @Param id path int true "ID пациента"
 // @Success 200 {array} model.AllergiesChronics
-// @Failure 400 {object} gin.H "Некорректный ввод"
-// @Failure 403 {object} gin.H "Недостаточно прав"
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
 // @Router /api/patient-notes/{id} [get]
 func (h *DoctorHandler) GetPatientAllergiesChronics(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
-	notesResp, err := h.DoctorClient.Client.GetPatientAllergiesChronics(c.Request.Context(), &doctorpb.GetByIdRequest{Id: int32(id)})
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	notesResp, err := h.DoctorClient.Client.GetPatientAllergiesChronics(c.Request.Context(), &doctorpb.GetByIdRequest{Id: int32(id), Token: token})
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	var notes []model.AllergiesChronics
@@ -47,24 +52,28 @@ func (h *DoctorHandler) GetPatientAllergiesChronics(c *gin.Context) {
 
 // GetAppointmentByID godoc
 // @Summary Получение информации о приёме по ID
+// @Description alerts — предупреждения по карте пациента (аллергии, анкета здоровья), критичные первыми
 // @Tags Врач
 // @Param id path int true "ID приёма"
 // @Success 200 {object} model.Appointment
-// @Failure 400 {object} gin.H "Некорректный ввод"
-// @Failure 403 {object} gin.H "Недостаточно прав"
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
 // @Router /api/appointments/{id} [get]
 func (h *DoctorHandler) GetAppointmentByID(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
-	apptResp, err := h.DoctorClient.Client.GetAppointmentByID(c.Request.Context(), &doctorpb.GetByIdRequest{Id: int32(id)})
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	apptResp, err := h.DoctorClient.Client.GetAppointmentByID(c.Request.Context(), &doctorpb.GetByIdRequest{Id: int32(id), Token: token})
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	patientID := model.UserID(apptResp.Appt.PatientId)
@@ -83,6 +92,7 @@ func (h *DoctorHandler) GetAppointmentByID(c *gin.Context) {
 		Status:             apptResp.Appt.Status,
 		CreatedAt:          apptResp.Appt.CreatedAt.AsTime().Format("2006.01.02 15:04"),
 		UpdatedAt:          apptResp.Appt.UpdatedAt.AsTime().Format("2006.01.02 15:04"),
+		Alerts:             clinicalAlertsFromPb(apptResp.Alerts),
 	}
 
 	c.JSON(http.StatusOK, appt)
@@ -93,21 +103,24 @@ func (h *DoctorHandler) GetAppointmentByID(c *gin.Context) {
 // @Tags Врач
 // @Param id path int true "ID пациента"
 // @Success 200 {array} model.Visit
-// @Failure 400 {object} gin.H "Некорректный ввод"
-// @Failure 403 {object} gin.H "Недостаточно прав"
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
 // @Router /api/patient-history/{id} [get]
 func (h *DoctorHandler) GetPatientVisits(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
-	visitsResp, err := h.DoctorClient.Client.GetPatientVisits(c.Request.Context(), &doctorpb.GetByIdRequest{Id: int32(id)})
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	visitsResp, err := h.DoctorClient.Client.GetPatientVisits(c.Request.Context(), &doctorpb.GetByIdRequest{Id: int32(id), Token: token})
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	var visits []model.Visit
@@ -133,8 +146,10 @@ func DerefDiagnoses(src []*doctorpb.Diagnose) []model.Diagnose {
 	for _, d := range src {
 		if d != nil {
 			result = append(result, model.Diagnose{
-				ICDCode: d.IcdCode,
-				Notes:   d.Notes,
+				ICDCode:  d.IcdCode,
+				Notes:    d.Notes,
+				Tooth:    int(d.Tooth),
+				Surfaces: d.Surfaces,
 			})
 		}
 	}
@@ -147,22 +162,22 @@ func DerefDiagnoses(src []*doctorpb.Diagnose) []model.Diagnose {
 // @Param id path int true "ID пациента"
 // @Param body body []model.AllergiesChronics true "Список аллергий и хронических заболеваний"
 // @Success 201 {object} gin.H
-// @Failure 400 {object} gin.H "Некорректный ввод"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Ошибка сервера"
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка сервера"
 // @Router /api/patient-notes/{id} [post]
 func (h *DoctorHandler) AddPatientAllergiesChronics(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
 	var notes []model.AllergiesChronics
 	if err := c.ShouldBindJSON(&notes); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 	var notesReq []*doctorpb.PatientAllergiesChronics
@@ -174,11 +189,14 @@ func (h *DoctorHandler) AddPatientAllergiesChronics(c *gin.Context) {
 		}
 		notesReq = append(notesReq, note)
 	}
-	AddNotesRequest := &doctorpb.AddPatientAllergiesChronicsRequest{Notes: notesReq}
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	AddNotesRequest := &doctorpb.AddPatientAllergiesChronicsRequest{Notes: notesReq, Token: token}
 	_, err = h.DoctorClient.Client.AddPatientAllergiesChronics(c.Request.Context(), AddNotesRequest)
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	c.JSON(http.StatusCreated, gin.H{})
@@ -186,23 +204,30 @@ func (h *DoctorHandler) AddPatientAllergiesChronics(c *gin.Context) {
 
 // AddConsultation godoc
 // @Summary Добавление консультации
+// @Description Приём сохраняется целиком или не сохраняется вовсе. Повтор запроса с тем же Idempotency-Key возвращает уже созданный визит.
+// @Description Позиции принятых планов лечения из plan_item_ids отмечаются выполненными на этом приёме.
+// @Description Диагнозы и услуги могут ссылаться на зуб по FDI и его поверхности, dental_chart обновляет зубную формулу.
+// @Description alerts — предупреждения по материалам и услугам, противоречащим карте пациента; сохранению они не мешают
 // @Tags Врач
+// @Param Idempotency-Key header string false "UUID отправки формы приёма"
 // @Param body body model.VisitSaveRequest true "Данные консультации"
-// @Success 201 {object} gin.H
-// @Failure 400 {object} gin.H "Некорректный ввод"
-// @Failure 403 {object} gin.H "Недостаточно прав"
+// @Success 201 {object} gin.H "visit_id, total_price и alerts"
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Запись не найдена"
+// @Failure 409 {object} httperror.Response "Приём по записи уже сохранён, запись отменена или позиция плана недоступна"
 // @Router /api/visits [post]
 func (h *DoctorHandler) AddConsultation(c *gin.Context) {
 	token, err := c.Cookie("access_token")
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": "Токен не найден"})
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
 		return
 	}
 
 	var visit model.VisitSaveRequest
 	if err := c.ShouldBindJSON(&visit); err != nil {
 		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
 		return
 	}
 
@@ -220,6 +245,8 @@ func (h *DoctorHandler) AddConsultation(c *gin.Context) {
 		service := &doctorpb.AddVisitServices{
 			ServiceId: int32(item.ID),
 			Amount:    int32(item.Quantity),
+			Tooth:     int32(item.Tooth),
+			Surfaces:  item.Surfaces,
 		}
 		servicesReq = append(servicesReq, service)
 	}
@@ -229,27 +256,40 @@ func (h *DoctorHandler) AddConsultation(c *gin.Context) {
 		diagnose := &doctorpb.VisitDiagnose{
 			IcdCodeId: int32(item.CodeID),
 			Note:      item.Comment,
+			Tooth:     int32(item.Tooth),
+			Surfaces:  item.Surfaces,
 		}
 		diagnosesReq = append(diagnosesReq, diagnose)
 	}
 
-	_, err = h.DoctorClient.Client.AddConsultation(c.Request.Context(), &doctorpb.AddConsultationRequest{
-		AppointmentId: int32(visit.AppointmentID),
-		PatientId:     int32(visit.PatientID),
-		DoctorId:      int32(visit.DoctorID),
-		Complaints:    visit.Complaints,
-		Treatment:     visit.Treatment,
-		Diagnoses:     diagnosesReq,
-		Services:      servicesReq,
-		Materials:     materialsReq,
-		Token:         token,
+	planItemIDs := make([]int32, 0, len(visit.PlanItemIDs))
+	for _, id := range visit.PlanItemIDs {
+		planItemIDs = append(planItemIDs, int32(id))
+	}
+
+	resp, err := h.DoctorClient.Client.AddConsultation(c.Request.Context(), &doctorpb.AddConsultationRequest{
+		AppointmentId:  int32(visit.AppointmentID),
+		PatientId:      int32(visit.PatientID),
+		DoctorId:       int32(visit.DoctorID),
+		Complaints:     visit.Complaints,
+		Treatment:      visit.Treatment,
+		Diagnoses:      diagnosesReq,
+		Services:       servicesReq,
+		Materials:      materialsReq,
+		Token:          token,
+		IdempotencyKey: c.GetHeader("Idempotency-Key"),
+		PlanItemIds:    planItemIDs,
+		Chart:          toothStatesToPb(visit.DentalChart),
 	})
 	if err != nil {
-		log.Println(err.Error())
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input: " + err.Error()})
+		httperror.Write(c, err)
 		return
 	}
-	c.JSON(http.StatusCreated, gin.H{})
+	c.JSON(http.StatusCreated, gin.H{
+		"visit_id":    resp.VisitId,
+		"total_price": resp.TotalPrice,
+		"alerts":      clinicalAlertsFromPb(resp.Alerts),
+	})
 }
 
 // getPatientDocs godoc
@@ -257,20 +297,24 @@ func (h *DoctorHandler) AddConsultation(c *gin.Context) {
 // @Tags Врач
 // @Param id path int true "ID пациента"
 // @Success 200 {array} model.DocumentInfo
-// @Failure 400 {object} gin.H
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H
+// @Failure 400 {object} httperror.Response
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response
 // @Router /admin/patient/{id}/documents [get]
 func (h *DoctorHandler) getPatientDocs(c *gin.Context) {
 	patientId, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	resp, err := h.DoctorClient.Client.GetDocumentsByPatientID(c.Request.Context(), &doctorpb.GetDocumentsRequest{PatientID: int32(patientId)})
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	resp, err := h.DoctorClient.Client.GetDocumentsByPatientID(c.Request.Context(), &doctorpb.GetDocumentsRequest{PatientID: int32(patientId), Token: token})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	docs := make([]model.DocumentInfo, 0, len(resp.Documents))
@@ -288,25 +332,33 @@ func (h *DoctorHandler) getPatientDocs(c *gin.Context) {
 
 // DownloadDocument godoc
 // @Summary Скачивание документа пациента
+// @Description Файл передаётся потоком; заголовок Range вида bytes=N- продолжает скачивание с байта N
 // @Tags Врач
 // @Param id path string true "ID документа"
+// @Param Range header string false "Продолжение скачивания, bytes=N-"
 // @Success 200 {file} file
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H
+// @Success 206 {file} file "Часть файла с байта N"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Документ не найден"
+// @Failure 416 {object} httperror.Response "Диапазон за пределами файла"
+// @Failure 500 {object} httperror.Response
 // @Router /api/doctor/consultation/patient-tests/{id} [get]
 func (h *DoctorHandler) DownloadDocument(c *gin.Context) {
-	// Получаем токен и ID документа из запроса
-	documentID := c.Param("id") // например: /documents/:id
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	documentID := c.Param("id")
+	offset := filestream.Offset(c)
 
-	// Получаем файл через gRPC
-	doc, err := h.DoctorClient.Client.DownloadDocument(c.Request.Context(), &doctorpb.DownloadDocumentRequest{DocumentId: documentID})
+	stream, err := h.DoctorClient.Client.DownloadDocument(c.Request.Context(), &doctorpb.DownloadDocumentRequest{
+		DocumentId: documentID,
+		Offset:     offset,
+		Token:      token,
+	})
 	if err != nil {
-		fmt.Println(err.Error())
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
-
-	// Отдаем файл пользователю
-	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", doc.FileName))
-	c.Data(http.StatusOK, "application/octet-stream", doc.FileContent)
+	filestream.Write(c, offset, stream.Recv)
 }
@@ -0,0 +1,154 @@
+package doctor
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	doctorpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/doctor"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetDentalChart godoc
+// @Summary Зубная формула пациента
+// @Tags Зубная формула
+// @Description Текущее состояние зубов по FDI: последняя запись по каждому зубу. Зубов без записей в ответе нет, они считаются здоровыми
+// @Produce json
+// @Param id path int true "ID пациента"
+// @Success 200 {array} model.ToothState
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при получении данных"
+// @Router /api/dental-chart/{id} [get]
+func (h *DoctorHandler) GetDentalChart(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	resp, err := h.DoctorClient.Client.GetDentalChart(c.Request.Context(), &doctorpb.GetByIdRequest{Id: int32(id), Token: token})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toothStatesFromPb(resp.Teeth))
+}
+
+// UpdateDentalChart godoc
+// @Summary Изменение зубной формулы вне приёма
+// @Tags Зубная формула
+// @Description Записывает состояние указанных зубов, например по результатам осмотра. Прежние записи остаются в истории
+// @Accept json
+// @Produce json
+// @Param id path int true "ID пациента"
+// @Param request body model.DentalChartRequest true "Состояние зубов"
+// @Success 200 {array} model.ToothState
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Пациент не найден"
+// @Failure 500 {object} httperror.Response "Ошибка при сохранении"
+// @Router /api/dental-chart/{id} [put]
+func (h *DoctorHandler) UpdateDentalChart(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	var req model.DentalChartRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	resp, err := h.DoctorClient.Client.UpdateDentalChart(c.Request.Context(), &doctorpb.UpdateDentalChartRequest{
+		Token:     token,
+		PatientId: int32(id),
+		Teeth:     toothStatesToPb(req.Teeth),
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toothStatesFromPb(resp.Teeth))
+}
+
+// GetToothHistory godoc
+// @Summary История зубной формулы пациента
+// @Tags Зубная формула
+// @Description Все записи о состоянии зубов, новые первыми. Параметр tooth ограничивает историю одним зубом
+// @Produce json
+// @Param id path int true "ID пациента"
+// @Param tooth query int false "Номер зуба по FDI"
+// @Success 200 {array} model.ToothState
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при получении данных"
+// @Router /api/dental-chart/{id}/history [get]
+func (h *DoctorHandler) GetToothHistory(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	tooth := 0
+	if raw := c.Query("tooth"); raw != "" {
+		tooth, err = strconv.Atoi(raw)
+		if err != nil {
+			httperror.Respond(c, http.StatusBadRequest, "Некорректный номер зуба")
+			return
+		}
+	}
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	resp, err := h.DoctorClient.Client.GetToothHistory(c.Request.Context(), &doctorpb.GetToothHistoryRequest{
+		PatientId: int32(id),
+		Tooth:     int32(tooth),
+		Token:     token,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toothStatesFromPb(resp.Teeth))
+}
+
+func toothStatesToPb(teeth []model.ToothStateInput) []*doctorpb.ToothState {
+	result := make([]*doctorpb.ToothState, 0, len(teeth))
+	for _, item := range teeth {
+		result = append(result, &doctorpb.ToothState{
+			Tooth:    int32(item.Tooth),
+			Status:   item.Status,
+			Surfaces: item.Surfaces,
+			Note:     item.Note,
+		})
+	}
+	return result
+}
+
+func toothStatesFromPb(teeth []*doctorpb.ToothState) []model.ToothState {
+	result := make([]model.ToothState, 0, len(teeth))
+	for _, item := range teeth {
+		result = append(result, model.ToothState{
+			Tooth:      int(item.Tooth),
+			Status:     item.Status,
+			Surfaces:   item.Surfaces,
+			Note:       item.Note,
+			VisitID:    int(item.VisitId),
+			DoctorID:   int(item.DoctorId),
+			Doctor:     item.Doctor,
+			RecordedAt: item.RecordedAt,
+		})
+	}
+	return result
+}
@@ -2,7 +2,10 @@ package doctor
 
 import (
 	"github.com/DariaTarasek/diplom/services/api-gateway/clients"
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/perm"
 	"github.com/gin-gonic/gin"
+	"net/http"
 )
 
 type DoctorHandler struct {
@@ -26,9 +29,32 @@ func RegisterRoutes(rg *gin.RouterGroup, h *DoctorHandler) {
 	rg.GET("/appointments/:id", h.AccessMiddleware(2), h.GetAppointmentByID)
 	rg.GET("/patient-history/:id", h.AccessMiddleware(12), h.GetPatientVisits)
 	rg.POST("/visits", h.AccessMiddleware(13), h.AddConsultation)
+	rg.POST("/clinical-alerts/check", h.AccessMiddleware(13), h.CheckClinicalAlerts)
 	rg.POST("/patient-notes/:id", h.AccessMiddleware(11), h.AddPatientAllergiesChronics)
+	rg.GET("/patient-notes/:id/anamnesis", h.AccessMiddleware(11), h.GetPatientAnamnesis)
+	rg.PUT("/patient-notes/:id/anamnesis", h.AccessMiddleware(11), h.SavePatientAnamnesis)
+	rg.GET("/patient-notes/:id/anamnesis/history", h.AccessMiddleware(11), h.GetPatientAnamnesisHistory)
+	rg.GET("/medical-records/:id/export", h.AccessMiddleware(perm.PermMedicalRecordExport), h.ExportMedicalRecord)
 	rg.GET("/doctor/consultation/patient-tests/:id", h.AccessMiddleware(2), h.getPatientDocs)
 	rg.GET("/doctor/consultation/patient-tests/download/:id", h.AccessMiddleware(16), h.DownloadDocument)
+	rg.GET("/patient-treatment-plans/:id", h.AccessMiddleware(12), h.GetPatientTreatmentPlans)
+	rg.POST("/treatment-plans", h.AccessMiddleware(13), h.CreateTreatmentPlan)
+	rg.POST("/treatment-plans/:id/items", h.AccessMiddleware(13), h.AddTreatmentPlanItems)
+	rg.PUT("/treatment-plan-items/:id/status", h.AccessMiddleware(13), h.UpdateTreatmentPlanItemStatus)
+	rg.POST("/treatment-plans/:id/cancel", h.AccessMiddleware(13), h.CancelTreatmentPlan)
+	rg.GET("/dental-chart/:id", h.AccessMiddleware(12), h.GetDentalChart)
+	rg.PUT("/dental-chart/:id", h.AccessMiddleware(13), h.UpdateDentalChart)
+	rg.GET("/dental-chart/:id/history", h.AccessMiddleware(12), h.GetToothHistory)
 	rg.GET("/doctor/me", h.AccessMiddleware(2), h.getDoctorProfile)
 	//  сюда остальные
 }
+
+// accessToken токен пользователя из cookie; по нему сервис врача проверяет доступ к карте пациента
+func accessToken(c *gin.Context) (string, bool) {
+	token, err := c.Cookie("access_token")
+	if err != nil || token == "" {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return "", false
+	}
+	return token, true
+}
@@ -0,0 +1,48 @@
+package doctor
+
+import (
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	doctorpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/doctor"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// ExportMedicalRecord godoc
+// @Summary Выгрузка медицинской карты пациента
+// @Tags Медицинская карта
+// @Description Данные пациента, аллергии и заболевания, анкета здоровья, приёмы с диагнозами МКБ-10, услугами
+// @Description и материалами и список документов. format=pdf — печатная форма, format=json — пакет FHIR R4 (collection)
+// @Produce application/pdf
+// @Produce application/fhir+json
+// @Param id path int true "ID пациента"
+// @Param format query string false "pdf (по умолчанию) или json"
+// @Success 200 {file} file
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Пациент не найден"
+// @Failure 500 {object} httperror.Response "Ошибка при формировании выгрузки"
+// @Router /api/medical-records/{id}/export [get]
+func (h *DoctorHandler) ExportMedicalRecord(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	resp, err := h.DoctorClient.Client.ExportMedicalRecord(c.Request.Context(), &doctorpb.ExportMedicalRecordRequest{
+		PatientId: int32(id),
+		Format:    c.DefaultQuery("format", "pdf"),
+		Token:     token,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.FileName))
+	c.Data(http.StatusOK, resp.ContentType, resp.Content)
+}
@@ -1,6 +1,7 @@
 package doctor
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	authpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/auth"
 	"github.com/gin-gonic/gin"
@@ -11,20 +12,20 @@ import (
 // @Summary Получение профиля врача
 // @Tags Врач
 // @Success 200 {object} model.Doctor
-// @Failure 401 {object} gin.H "Необходима авторизация"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H
+// @Failure 401 {object} httperror.Response "Необходима авторизация"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response
 // @Router /doctor/me [get]
 func (h *DoctorHandler) getDoctorProfile(c *gin.Context) {
 	token, err := c.Cookie("access_token")
 	if err != nil || token == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "необходима авторизация"})
+		httperror.Respond(c, http.StatusUnauthorized, "необходима авторизация")
 		return
 	}
 
 	resp, err := h.AuthClient.Client.GetDoctorProfile(c.Request.Context(), &authpb.GetProfileRequest{Token: token})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	exp := int(resp.Doctor.Experience)
@@ -0,0 +1,240 @@
+package doctor
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	doctorpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/doctor"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// GetPatientTreatmentPlans godoc
+// @Summary Планы лечения пациента
+// @Tags Планы лечения
+// @Param id path int true "ID пациента"
+// @Success 200 {array} model.TreatmentPlan
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при получении данных"
+// @Router /api/patient-treatment-plans/{id} [get]
+func (h *DoctorHandler) GetPatientTreatmentPlans(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	resp, err := h.DoctorClient.Client.GetPatientTreatmentPlans(c.Request.Context(), &doctorpb.GetByIdRequest{Id: int32(id), Token: token})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	plans := make([]model.TreatmentPlan, 0, len(resp.Plans))
+	for _, item := range resp.Plans {
+		plans = append(plans, treatmentPlanFromPb(item))
+	}
+	c.JSON(http.StatusOK, plans)
+}
+
+// CreateTreatmentPlan godoc
+// @Summary Составление плана лечения
+// @Tags Планы лечения
+// @Description Позиции оцениваются по действующему прейскуранту. План ждёт принятия пациентом в личном кабинете
+// @Accept json
+// @Produce json
+// @Param request body model.TreatmentPlanRequest true "Пациент, название и позиции плана"
+// @Success 201 {object} model.TreatmentPlan
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Пациент или услуга не найдены"
+// @Failure 500 {object} httperror.Response "Ошибка при сохранении"
+// @Router /api/treatment-plans [post]
+func (h *DoctorHandler) CreateTreatmentPlan(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	var req model.TreatmentPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	resp, err := h.DoctorClient.Client.CreateTreatmentPlan(c.Request.Context(), &doctorpb.CreateTreatmentPlanRequest{
+		Token:     token,
+		PatientId: int32(req.PatientID),
+		Title:     req.Title,
+		Items:     treatmentPlanItemsToPb(req.Items),
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, treatmentPlanFromPb(resp.Plan))
+}
+
+// AddTreatmentPlanItems godoc
+// @Summary Добавление позиций в план лечения
+// @Tags Планы лечения
+// @Description Позиции добавляются, пока пациент не принял план
+// @Accept json
+// @Produce json
+// @Param id path int true "ID плана"
+// @Param request body model.TreatmentPlanItemsRequest true "Новые позиции"
+// @Success 200 {object} model.TreatmentPlan
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "План не найден"
+// @Failure 409 {object} httperror.Response "План уже принят или отменён"
+// @Failure 500 {object} httperror.Response "Ошибка при сохранении"
+// @Router /api/treatment-plans/{id}/items [post]
+func (h *DoctorHandler) AddTreatmentPlanItems(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	var req model.TreatmentPlanItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	resp, err := h.DoctorClient.Client.AddTreatmentPlanItems(c.Request.Context(), &doctorpb.AddTreatmentPlanItemsRequest{
+		PlanId: int32(id),
+		Items:  treatmentPlanItemsToPb(req.Items),
+		Token:  token,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, treatmentPlanFromPb(resp.Plan))
+}
+
+// UpdateTreatmentPlanItemStatus godoc
+// @Summary Изменение статуса позиции плана лечения
+// @Tags Планы лечения
+// @Description Начать или выполнить позицию можно только в принятом пациентом плане
+// @Accept json
+// @Produce json
+// @Param id path int true "ID позиции плана"
+// @Param request body model.TreatmentPlanItemStatusRequest true "Новый статус"
+// @Success 200 {object} model.TreatmentPlan
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Позиция не найдена"
+// @Failure 409 {object} httperror.Response "План не принят или закрыт"
+// @Failure 500 {object} httperror.Response "Ошибка при сохранении"
+// @Router /api/treatment-plan-items/{id}/status [put]
+func (h *DoctorHandler) UpdateTreatmentPlanItemStatus(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	var req model.TreatmentPlanItemStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	resp, err := h.DoctorClient.Client.UpdateTreatmentPlanItemStatus(c.Request.Context(), &doctorpb.UpdateTreatmentPlanItemStatusRequest{
+		ItemId: int32(id),
+		Status: req.Status,
+		Token:  token,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, treatmentPlanFromPb(resp.Plan))
+}
+
+// CancelTreatmentPlan godoc
+// @Summary Отмена плана лечения
+// @Tags Планы лечения
+// @Description Невыполненные позиции отменяются вместе с планом
+// @Produce json
+// @Param id path int true "ID плана"
+// @Success 200 {object} model.TreatmentPlan
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "План не найден"
+// @Failure 409 {object} httperror.Response "План уже завершён или отменён"
+// @Failure 500 {object} httperror.Response "Ошибка при сохранении"
+// @Router /api/treatment-plans/{id}/cancel [post]
+func (h *DoctorHandler) CancelTreatmentPlan(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Invalid input: "+err.Error())
+		return
+	}
+	token, ok := accessToken(c)
+	if !ok {
+		return
+	}
+	resp, err := h.DoctorClient.Client.CancelTreatmentPlan(c.Request.Context(), &doctorpb.GetByIdRequest{Id: int32(id), Token: token})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, treatmentPlanFromPb(resp.Plan))
+}
+
+func treatmentPlanItemsToPb(items []model.TreatmentPlanItemInput) []*doctorpb.TreatmentPlanItem {
+	result := make([]*doctorpb.TreatmentPlanItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, &doctorpb.TreatmentPlanItem{
+			Stage:     int32(item.Stage),
+			Tooth:     int32(item.Tooth),
+			ServiceId: int32(item.ServiceID),
+			Quantity:  int32(item.Quantity),
+			Note:      item.Note,
+		})
+	}
+	return result
+}
+
+func treatmentPlanFromPb(item *doctorpb.TreatmentPlan) model.TreatmentPlan {
+	plan := model.TreatmentPlan{
+		ID:             int(item.Id),
+		PatientID:      int(item.PatientId),
+		DoctorID:       int(item.DoctorId),
+		Doctor:         item.Doctor,
+		Title:          item.Title,
+		Status:         item.Status,
+		AcceptedAt:     item.AcceptedAt,
+		CreatedAt:      item.CreatedAt,
+		Items:          make([]model.TreatmentPlanItem, 0, len(item.Items)),
+		EstimatedTotal: int(item.EstimatedTotal),
+		RemainingCost:  int(item.RemainingCost),
+	}
+	for _, i := range item.Items {
+		plan.Items = append(plan.Items, model.TreatmentPlanItem{
+			ID:             int(i.Id),
+			Stage:          int(i.Stage),
+			Tooth:          int(i.Tooth),
+			ServiceID:      int(i.ServiceId),
+			ServiceName:    i.ServiceName,
+			Quantity:       int(i.Quantity),
+			EstimatedPrice: int(i.EstimatedPrice),
+			Status:         i.Status,
+			VisitID:        int(i.VisitId),
+			Note:           i.Note,
+			CompletedAt:    i.CompletedAt,
+		})
+	}
+	return plan
+}
@@ -1,6 +1,8 @@
 package info
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/listquery"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	storagepb "github.com/DariaTarasek/diplom/services/api-gateway/proto/storage"
 	"github.com/gin-gonic/gin"
@@ -9,16 +11,29 @@ import (
 )
 
 // GetDoctors godoc
-// @Summary Получить всех врачей
+// @Summary Получить врачей
+// @Description Возвращает врачей, отсортированных по ФИО; без limit — первые 50
 // @Tags info
 // @Produce json
+// @Param q query string false "Начала слов ФИО"
+// @Param limit query int false "Размер страницы, по умолчанию 50, не больше 200"
+// @Param offset query int false "Сколько записей пропустить"
+// @Param sort query string false "Поле сортировки: name, experience"
+// @Param order query string false "Порядок сортировки: asc или desc"
 // @Success 200 {array} model.Doctor
-// @Failure 500 {object} map[string]string "Внутренняя ошибка"
+// @Header 200 {integer} X-Total-Count "Число всех найденных записей"
+// @Failure 400 {object} httperror.Response "Некорректные параметры списка"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка"
 // @Router /api/doctors [get]
 func (h *InfoHandler) GetDoctors(c *gin.Context) {
-	items, err := h.store.Client.GetDoctors(c.Request.Context(), &storagepb.EmptyRequest{})
+	params, err := listquery.Parse(c, listquery.DefaultLimit)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	items, err := h.store.Client.GetDoctors(c.Request.Context(), &storagepb.GetDoctorsRequest{Page: params.Storage(), Query: params.Query})
+	if err != nil {
+		httperror.Write(c, err)
 		return
 	}
 	var doctors []model.Doctor
@@ -37,6 +52,7 @@ func (h *InfoHandler) GetDoctors(c *gin.Context) {
 		}
 		doctors = append(doctors, doctor)
 	}
+	listquery.SetTotal(c, items.Total)
 	c.JSON(http.StatusOK, doctors)
 }
 
@@ -46,18 +62,18 @@ func (h *InfoHandler) GetDoctors(c *gin.Context) {
 // @Produce json
 // @Param specialty path int true "ID специальности"
 // @Success 200 {array} model.Doctor
-// @Failure 400 {object} map[string]string "Некорректный ID специальности"
-// @Failure 500 {object} map[string]string "Ошибка получения данных"
+// @Failure 400 {object} httperror.Response "Некорректный ID специальности"
+// @Failure 500 {object} httperror.Response "Ошибка получения данных"
 // @Router /api/doctors/{specialty} [get]
 func (h *InfoHandler) GetDoctorsBySpecialty(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("specialty"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Некорректный запрос " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Некорректный запрос "+err.Error())
 		return
 	}
 	items, err := h.store.Client.GetDoctorsBySpecID(c.Request.Context(), &storagepb.GetDoctorBySpecIDRequest{SpecId: int32(id)})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Не удалось получить список врачей по специальности " + err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	var doctors []model.Doctor
@@ -1,6 +1,8 @@
 package info
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/listquery"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	storagepb "github.com/DariaTarasek/diplom/services/api-gateway/proto/storage"
 	"github.com/gin-gonic/gin"
@@ -9,15 +11,28 @@ import (
 
 // GetICDCodes godoc
 // @Summary Получить коды МКБ
+// @Description Возвращает коды МКБ, отсортированные по коду; без limit — первые 50
 // @Tags info
 // @Produce json
+// @Param q query string false "Начало кода или часть названия"
+// @Param limit query int false "Размер страницы, по умолчанию 50, не больше 200"
+// @Param offset query int false "Сколько записей пропустить"
+// @Param sort query string false "Поле сортировки: code, name"
+// @Param order query string false "Порядок сортировки: asc или desc"
 // @Success 200 {array} model.ICDCode
-// @Failure 500 {object} map[string]string "Внутренняя ошибка"
+// @Header 200 {integer} X-Total-Count "Число всех найденных записей"
+// @Failure 400 {object} httperror.Response "Некорректные параметры списка"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка"
 // @Router /api/icd-codes [get]
 func (h *InfoHandler) GetICDCodes(c *gin.Context) {
-	items, err := h.store.Client.GetICDCodes(c.Request.Context(), &storagepb.EmptyRequest{})
+	params, err := listquery.Parse(c, listquery.DefaultLimit)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	items, err := h.store.Client.GetICDCodes(c.Request.Context(), &storagepb.GetICDCodesRequest{Page: params.Storage(), Query: params.Query})
+	if err != nil {
+		httperror.Write(c, err)
 		return
 	}
 	var codes []model.ICDCode
@@ -29,5 +44,6 @@ func (h *InfoHandler) GetICDCodes(c *gin.Context) {
 		}
 		codes = append(codes, code)
 	}
+	listquery.SetTotal(c, items.Total)
 	c.JSON(http.StatusOK, codes)
 }
@@ -1,6 +1,7 @@
 package info
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	storagepb "github.com/DariaTarasek/diplom/services/api-gateway/proto/storage"
 	"github.com/gin-gonic/gin"
@@ -12,12 +13,12 @@ import (
 // @Tags info
 // @Produce json
 // @Success 200 {object} map[string][]model.Material
-// @Failure 500 {object} map[string]string "Внутренняя ошибка"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка"
 // @Router /api/materials [get]
 func (h *InfoHandler) GetMaterials(c *gin.Context) {
 	items, err := h.store.Client.GetMaterials(c.Request.Context(), &storagepb.EmptyRequest{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	var materials []model.Material
@@ -26,6 +27,7 @@ func (h *InfoHandler) GetMaterials(c *gin.Context) {
 			ID:    int(item.Id),
 			Name:  item.Name,
 			Price: int(item.Price),
+			Unit:  item.Unit,
 		}
 		materials = append(materials, material)
 	}
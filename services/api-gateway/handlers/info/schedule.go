@@ -1,6 +1,7 @@
 package info
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	storagepb "github.com/DariaTarasek/diplom/services/api-gateway/proto/storage"
 	"github.com/gin-gonic/gin"
@@ -30,12 +31,12 @@ func FormatTime(t time.Time) string {
 // @Tags info
 // @Produce json
 // @Success 200 {object} ClinicScheduleResponse
-// @Failure 500 {object} map[string]string "Внутренняя ошибка"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка"
 // @Router /api/clinic-schedule [get]
 func (h *InfoHandler) GetClinicWeeklySchedule(c *gin.Context) {
 	items, err := h.store.Client.GetClinicWeeklySchedule(c.Request.Context(), &storagepb.EmptyRequest{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 
@@ -68,13 +69,13 @@ func (h *InfoHandler) GetClinicWeeklySchedule(c *gin.Context) {
 // @Produce json
 // @Param selectedDoctor path int true "ID врача"
 // @Success 200 {object} DoctorScheduleResponse
-// @Failure 500 {object} map[string]string "Внутренняя ошибка"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка"
 // @Router /api/doctor-schedule/{selectedDoctor} [get]
 func (h *InfoHandler) GetDoctorWeeklySchedule(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("selectedDoctor"))
 	items, err := h.store.Client.GetDoctorWeeklySchedule(c.Request.Context(), &storagepb.GetScheduleByDoctorIdRequest{DoctorId: int32(id)})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 
@@ -107,21 +108,21 @@ func (h *InfoHandler) GetDoctorWeeklySchedule(c *gin.Context) {
 // @Produce json
 // @Param date path string true "Дата в формате YYYY-MM-DD"
 // @Success 200 {object} model.ClinicDailyOverride
-// @Failure 400 {object} map[string]string "Неверный формат даты"
-// @Failure 404 {object} map[string]string "Переопределение не найдено"
+// @Failure 400 {object} httperror.Response "Неверный формат даты"
+// @Failure 404 {object} httperror.Response "Переопределение не найдено"
 // @Router /api/clinic-overrides/{date} [get]
 func (h *InfoHandler) GetClinicOverride(c *gin.Context) {
 	dateStr := c.Param("date")
 	date, err := time.Parse("2006-01-02", dateStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный формат даты. Ожидается YYYY-MM-DD"})
+		httperror.Respond(c, http.StatusBadRequest, "Неверный формат даты. Ожидается YYYY-MM-DD")
 		return
 	}
 
 	respOverride, err := h.store.Client.GetClinicOverride(c.Request.Context(),
 		&storagepb.GetClinicOverrideRequest{Date: timestamppb.New(date)})
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Переопределение не найдено"})
+		httperror.Respond(c, http.StatusNotFound, "Переопределение не найдено")
 		return
 	}
 
@@ -151,21 +152,21 @@ func (h *InfoHandler) GetClinicOverride(c *gin.Context) {
 // @Param doctor_id path int true "ID врача"
 // @Param date path string true "Дата в формате YYYY-MM-DD"
 // @Success 200 {object} model.DoctorDailyOverride
-// @Failure 400 {object} map[string]string "Неверный формат даты или ID"
-// @Failure 404 {object} map[string]string "Переопределение не найдено"
+// @Failure 400 {object} httperror.Response "Неверный формат даты или ID"
+// @Failure 404 {object} httperror.Response "Переопределение не найдено"
 // @Router /api/doctor-overrides/{doctor_id}/{date} [get]
 func (h *InfoHandler) GetDoctorOverride(c *gin.Context) {
 	dateStr := c.Param("date")
 	date, err := time.Parse("2006-01-02", dateStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный формат даты. Ожидается YYYY-MM-DD"})
+		httperror.Respond(c, http.StatusBadRequest, "Неверный формат даты. Ожидается YYYY-MM-DD")
 		return
 	}
 
 	doctorIDStr := c.Param("doctor_id")
 	id, err := strconv.Atoi(doctorIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Неверный идентификатор врача"})
+		httperror.Respond(c, http.StatusBadRequest, "Неверный идентификатор врача")
 		return
 	}
 
@@ -175,7 +176,7 @@ func (h *InfoHandler) GetDoctorOverride(c *gin.Context) {
 			Date:     timestamppb.New(date),
 		})
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Переопределение для указанной даты и врача не найдено"})
+		httperror.Respond(c, http.StatusNotFound, "Переопределение для указанной даты и врача не найдено")
 		return
 	}
 
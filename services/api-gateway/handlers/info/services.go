@@ -2,6 +2,7 @@ package info
 
 import (
 	"fmt"
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	storagepb "github.com/DariaTarasek/diplom/services/api-gateway/proto/storage"
 	"github.com/gin-gonic/gin"
@@ -14,21 +15,22 @@ import (
 // @Tags info
 // @Produce json
 // @Success 200 {object} map[string][]model.Service
-// @Failure 500 {object} map[string]string "Внутренняя ошибка"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка"
 // @Router /api/services [get]
 func (h *InfoHandler) GetServices(c *gin.Context) {
 	items, err := h.store.Client.GetServices(c.Request.Context(), &storagepb.EmptyRequest{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	var services []model.Service
 	for _, item := range items.Services {
 		service := model.Service{
-			ID:            int(item.Id),
-			Name:          item.Name,
-			Price:         int(item.Price),
-			ServiceTypeId: int(item.Type),
+			ID:              int(item.Id),
+			Name:            item.Name,
+			Price:           int(item.Price),
+			ServiceTypeId:   int(item.Type),
+			DurationMinutes: int(item.DurationMinutes),
 		}
 		services = append(services, service)
 	}
@@ -40,12 +42,12 @@ func (h *InfoHandler) GetServices(c *gin.Context) {
 // @Tags info
 // @Produce json
 // @Success 200 {object} map[string][]model.ServiceType
-// @Failure 500 {object} map[string]string "Внутренняя ошибка"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка"
 // @Router /api/service-categories [get]
 func (h *InfoHandler) GetServicesTypes(c *gin.Context) {
 	items, err := h.store.Client.GetServicesTypes(c.Request.Context(), &storagepb.EmptyRequest{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	var types []model.ServiceType
@@ -62,14 +64,14 @@ func (h *InfoHandler) GetServicesTypes(c *gin.Context) {
 func (h *InfoHandler) GetServiceTypeById(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("category_id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Некорректный запрос " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "Некорректный запрос "+err.Error())
 		return
 	}
 
 	fmt.Println(id)
 	item, err := h.store.Client.GetServiceTypeById(c.Request.Context(), &storagepb.GetServiceTypeByIdRequest{Id: int32(id)})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Не удалось получить список врачей по специальности " + err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	sType := model.ServiceType{
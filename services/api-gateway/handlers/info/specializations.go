@@ -1,6 +1,7 @@
 package info
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	storagepb "github.com/DariaTarasek/diplom/services/api-gateway/proto/storage"
 	"github.com/gin-gonic/gin"
@@ -12,12 +13,12 @@ import (
 // @Tags info
 // @Produce json
 // @Success 200 {array} model.Specialization
-// @Failure 500 {object} map[string]string "Внутренняя ошибка"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка"
 // @Router /api/specialties [get]
 func (h *InfoHandler) GetAllSpecs(c *gin.Context) {
 	items, err := h.store.Client.GetAllSpecs(c.Request.Context(), &storagepb.EmptyRequest{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	var specs []model.Specialization
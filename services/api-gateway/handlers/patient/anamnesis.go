@@ -0,0 +1,120 @@
+package patient
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	patientpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/patient"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// getAnamnesis godoc
+// @Summary Анкета здоровья
+// @Tags Анкета здоровья
+// @Description Текущая версия анкеты пациента; version = 0 — анкета ещё не заполнялась
+// @Produce json
+// @Success 200 {object} model.Anamnesis
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при получении данных"
+// @Router /api/patient/anamnesis [get]
+func (h *PatientHandler) getAnamnesis(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	resp, err := h.PatientClient.Client.GetAnamnesis(c.Request.Context(), &patientpb.GetAnamnesisRequest{Token: token})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, anamnesisFromPb(resp.Anamnesis))
+}
+
+// saveAnamnesis godoc
+// @Summary Заполнение анкеты здоровья
+// @Tags Анкета здоровья
+// @Description Сохраняет анкету новой версией. Если врач изменил анкету после base_version, возвращается 409 — анкету нужно открыть заново
+// @Accept json
+// @Produce json
+// @Param request body model.AnamnesisRequest true "Ответы анкеты"
+// @Success 200 {object} model.Anamnesis
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 409 {object} httperror.Response "Анкета уже изменена"
+// @Failure 500 {object} httperror.Response "Ошибка при сохранении"
+// @Router /api/patient/anamnesis [put]
+func (h *PatientHandler) saveAnamnesis(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	var req model.AnamnesisRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	anamnesis := &patientpb.Anamnesis{
+		Medications:        req.Medications,
+		BloodThinners:      req.BloodThinners,
+		CardiacDisease:     req.CardiacDisease,
+		Hypertension:       req.Hypertension,
+		Diabetes:           req.Diabetes,
+		BleedingDisorder:   req.BleedingDisorder,
+		InfectiousDisease:  req.InfectiousDisease,
+		AnesthesiaReaction: req.AnesthesiaReaction,
+		Pregnant:           req.Pregnant,
+		Breastfeeding:      req.Breastfeeding,
+		Smoker:             req.Smoker,
+		OtherConditions:    req.OtherConditions,
+	}
+	for _, a := range req.Allergies {
+		anamnesis.Allergies = append(anamnesis.Allergies, &patientpb.AnamnesisAllergy{
+			Allergen: a.Allergen,
+			Severity: a.Severity,
+			Reaction: a.Reaction,
+		})
+	}
+	resp, err := h.PatientClient.Client.SaveAnamnesis(c.Request.Context(), &patientpb.SaveAnamnesisRequest{
+		Token:       token,
+		Anamnesis:   anamnesis,
+		BaseVersion: int32(req.BaseVersion),
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, anamnesisFromPb(resp.Anamnesis))
+}
+
+func anamnesisFromPb(item *patientpb.Anamnesis) model.Anamnesis {
+	anamnesis := model.Anamnesis{
+		Version:            int(item.Version),
+		Medications:        item.Medications,
+		BloodThinners:      item.BloodThinners,
+		CardiacDisease:     item.CardiacDisease,
+		Hypertension:       item.Hypertension,
+		Diabetes:           item.Diabetes,
+		BleedingDisorder:   item.BleedingDisorder,
+		InfectiousDisease:  item.InfectiousDisease,
+		AnesthesiaReaction: item.AnesthesiaReaction,
+		Pregnant:           item.Pregnant,
+		Breastfeeding:      item.Breastfeeding,
+		Smoker:             item.Smoker,
+		OtherConditions:    item.OtherConditions,
+		Allergies:          make([]model.AnamnesisAllergy, 0, len(item.Allergies)),
+		ChangedByRole:      item.ChangedByRole,
+		ChangedBy:          item.ChangedBy,
+		ChangedAt:          item.ChangedAt,
+	}
+	for _, a := range item.Allergies {
+		anamnesis.Allergies = append(anamnesis.Allergies, model.AnamnesisAllergy{
+			Allergen: a.Allergen,
+			Severity: a.Severity,
+			Reaction: a.Reaction,
+		})
+	}
+	return anamnesis
+}
@@ -1,6 +1,7 @@
 package patient
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	patientpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/patient"
 	"github.com/gin-gonic/gin"
@@ -16,21 +17,31 @@ import (
 // @Tags Запись
 // @Produce json
 // @Param doctorId path int true "ID врача"
+// @Param services query string false "ID услуг через запятую; слоты подбираются по их суммарной длительности"
 // @Success 200 {array} model.ScheduleEntry
-// @Failure 400 {object} gin.H "Некорректный ID"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Некорректный ID"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/appointment-doctor-schedule/{doctorId} [get]
 func (h *PatientHandler) getAppointmentSlots(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("doctorId"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	slots, err := h.PatientClient.Client.GetAppointmentSlots(c.Request.Context(), &patientpb.GetAppointmentSlotsRequest{DoctorId: int32(id)})
+	serviceIDs, err := parseIDList(c.Query("services"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	slots, err := h.PatientClient.Client.GetAppointmentSlots(c.Request.Context(), &patientpb.GetAppointmentSlotsRequest{
+		DoctorId:   int32(id),
+		ServiceIds: serviceIDs,
+	})
+	if err != nil {
+		httperror.Write(c, err)
 		return
 	}
 	scheduleSlots := make([]model.ScheduleEntry, 0, len(slots.Slots))
@@ -49,33 +60,34 @@ func (h *PatientHandler) getAppointmentSlots(c *gin.Context) {
 // @Tags Запись
 // @Accept json
 // @Produce json
-// @Param appointment body model.Appointment true "Данные записи"
+// @Param appointment body model.Appointment true "Данные записи; user_id не используется — пациент берётся из токена"
 // @Success 200 {object} gin.H "Запись добавлена"
-// @Failure 400 {object} gin.H "Неверные входные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Ошибка при создании записи"
+// @Failure 400 {object} httperror.Response "Неверные входные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 409 {object} httperror.Response "Выбранное время уже занято"
+// @Failure 500 {object} httperror.Response "Ошибка при создании записи"
 // @Router /api/appointments [post]
 func (h *PatientHandler) addAppointment(c *gin.Context) {
 	var req model.Appointment
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 	dateStr := strings.Split(req.Date, "\n")
 	date, err := time.Parse("02.01.2006", dateStr[0])
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 	appTime, err := time.Parse("15:04", req.Time)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 	log.Println("dr: " + req.PatientBirthDate)
 	birthDate, err := time.Parse("2006-01-02", req.PatientBirthDate)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -83,7 +95,6 @@ func (h *PatientHandler) addAppointment(c *gin.Context) {
 		DoctorId:    int32(req.DoctorID),
 		Date:        timestamppb.New(date),
 		Time:        timestamppb.New(appTime),
-		PatientId:   int32(derefUserID(req.PatientID)),
 		SecondName:  req.PatientSecondName,
 		FirstName:   req.PatientFirstName,
 		Surname:     deref(req.PatientSurname),
@@ -91,33 +102,129 @@ func (h *PatientHandler) addAppointment(c *gin.Context) {
 		Gender:      req.PatientGender,
 		PhoneNumber: req.PatientPhoneNumber,
 	}
-	_, err = h.PatientClient.Client.AddAppointment(c.Request.Context(), &patientpb.AddAppointmentRequest{Appointment: appointment})
+	// карту пациента сервис берёт из токена, а не из тела; запись по удержанию слота — только от имени того,
+	// кто его удерживает, без удержания запись доступна и гостю
+	token, err := c.Cookie("access_token")
+	if err != nil && req.HoldID != "" {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	_, err = h.PatientClient.Client.AddAppointment(c.Request.Context(), &patientpb.AddAppointmentRequest{
+		Appointment: appointment,
+		HoldId:      req.HoldID,
+		ServiceIds:  toInt32s(req.ServiceIDs),
+		Token:       token,
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, gin.H{"message": "Вы успешно записаны на прием!"})
 }
 
+// holdAppointmentSlot godoc
+// @Summary Временно закрепить слот за пациентом на время оформления записи
+// @Tags Запись
+// @Accept json
+// @Produce json
+// @Param hold body model.AppointmentSlotHold true "Слот для удержания; hold_id передаётся для продления, patient_id пациента берётся из токена"
+// @Success 200 {object} model.AppointmentSlotHold
+// @Failure 400 {object} httperror.Response "Неверные входные данные"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Удержание hold_id принадлежит другому пользователю"
+// @Failure 409 {object} httperror.Response "Выбранное время уже занято"
+// @Failure 500 {object} httperror.Response "Ошибка при удержании слота"
+// @Router /api/appointments/hold [post]
+func (h *PatientHandler) holdAppointmentSlot(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	var req model.AppointmentSlotHold
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	dateStr := strings.Split(req.Date, "\n")
+	date, err := time.Parse("02.01.2006", dateStr[0])
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	slotTime, err := time.Parse("15:04", req.Time)
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.PatientClient.Client.HoldAppointmentSlot(c.Request.Context(), &patientpb.HoldAppointmentSlotRequest{
+		HoldId:     req.ID,
+		DoctorId:   int32(req.DoctorID),
+		Date:       timestamppb.New(date),
+		Time:       timestamppb.New(slotTime),
+		PatientId:  int32(derefUserID(req.PatientID)),
+		ServiceIds: toInt32s(req.ServiceIDs),
+		Token:      token,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	req.ID = resp.HoldId
+	req.ExpiresAt = resp.ExpiresAt.AsTime().Format(time.RFC3339)
+	c.JSON(http.StatusOK, req)
+}
+
+// releaseAppointmentSlot godoc
+// @Summary Снять удержание слота
+// @Tags Запись
+// @Produce json
+// @Param holdId path string true "ID удержания"
+// @Success 200 {object} gin.H "Удержание снято"
+// @Failure 400 {object} httperror.Response "Некорректный ID"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 404 {object} httperror.Response "Удержание принадлежит другому пользователю"
+// @Failure 500 {object} httperror.Response "Ошибка при снятии удержания"
+// @Router /api/appointments/hold/{holdId} [delete]
+func (h *PatientHandler) releaseAppointmentSlot(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	_, err = h.PatientClient.Client.ReleaseAppointmentSlot(c.Request.Context(), &patientpb.ReleaseAppointmentSlotRequest{
+		HoldId: c.Param("holdId"),
+		Token:  token,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Удержание снято"})
+}
+
 // getUpcomingAppointments godoc
 // @Summary Получить список предстоящих записей пациента
 // @Tags Запись
 // @Produce json
 // @Success 200 {array} model.UpcomingAppointment
-// @Failure 401 {object} gin.H "Токен не найден"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Ошибка при получении данных"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при получении данных"
 // @Router /api/patient/upcoming [get]
 func (h *PatientHandler) getUpcomingAppointments(c *gin.Context) {
 	token, err := c.Cookie("access_token")
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": "Токен не найден"})
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
 		return
 	}
 	apps, err := h.PatientClient.Client.GetUpcomingAppointments(c.Request.Context(), &patientpb.GetUpcomingAppointmentsRequest{Token: token})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	upcoming := make([]model.UpcomingAppointment, 0, len(apps.Appointments))
@@ -142,25 +249,31 @@ func (h *PatientHandler) getUpcomingAppointments(c *gin.Context) {
 // @Produce json
 // @Param appointment body model.Appointment true "Обновленные данные записи"
 // @Success 200 {object} gin.H "Запись успешно обновлена"
-// @Failure 400 {object} gin.H "Неверные входные данные"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Ошибка при обновлении записи"
+// @Failure 400 {object} httperror.Response "Неверные входные данные"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 409 {object} httperror.Response "Выбранное время уже занято"
+// @Failure 500 {object} httperror.Response "Ошибка при обновлении записи"
 // @Router /api/appointments/transfer [put]
 func (h *PatientHandler) UpdateAppointment(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil || token == "" {
+		httperror.Respond(c, http.StatusUnauthorized, "необходима авторизация")
+		return
+	}
 	var req model.Appointment
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 	updateDateStr := strings.Split(req.Date, "\n")
 	updateDate, err := time.Parse("02.01.2006", updateDateStr[0])
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 	updateTime, err := time.Parse("15:04", req.Time)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -169,9 +282,9 @@ func (h *PatientHandler) UpdateAppointment(c *gin.Context) {
 		Date: timestamppb.New(updateDate),
 		Time: timestamppb.New(updateTime),
 	}
-	_, err = h.PatientClient.Client.UpdateAppointment(c.Request.Context(), &patientpb.UpdateAppointmentRequest{Appointment: updateApp})
+	_, err = h.PatientClient.Client.UpdateAppointment(c.Request.Context(), &patientpb.UpdateAppointmentRequest{Appointment: updateApp, Token: token})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 
@@ -184,20 +297,25 @@ func (h *PatientHandler) UpdateAppointment(c *gin.Context) {
 // @Produce json
 // @Param id path int true "ID записи"
 // @Success 200 {object} gin.H "Запись отменена"
-// @Failure 400 {object} gin.H "Некорректный ID"
-// @Failure 403 {object} gin.H "Недостаточно прав"
-// @Failure 500 {object} gin.H "Ошибка при отмене записи"
+// @Failure 400 {object} httperror.Response "Некорректный ID"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при отмене записи"
 // @Router /api/appointments/cancel/{id} [get]
 func (h *PatientHandler) CancelAppointment(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil || token == "" {
+		httperror.Respond(c, http.StatusUnauthorized, "необходима авторизация")
+		return
+	}
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	_, err = h.PatientClient.Client.CancelAppointment(c.Request.Context(), &patientpb.GetByIDRequest{Id: int32(id)})
+	_, err = h.PatientClient.Client.CancelAppointment(c.Request.Context(), &patientpb.CancelAppointmentRequest{Id: int32(id), Token: token})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 
@@ -217,3 +335,27 @@ func deref(s *string) string {
 	}
 	return *s
 }
+
+// parseIDList разбирает список ID через запятую; пустая строка — пустой список
+func parseIDList(value string) ([]int32, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var ids []int32
+	for _, part := range strings.Split(value, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, int32(id))
+	}
+	return ids, nil
+}
+
+func toInt32s(ids []int) []int32 {
+	result := make([]int32, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, int32(id))
+	}
+	return result
+}
@@ -1,7 +1,11 @@
 package patient
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"github.com/DariaTarasek/diplom/services/api-gateway/filestream"
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	patientpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/patient"
 	"github.com/gin-gonic/gin"
@@ -10,62 +14,105 @@ import (
 	"net/http"
 )
 
+const (
+	// maxTestUploadSize предел тела запроса загрузки: снимок до 4 ГБ и поля формы
+	maxTestUploadSize = 4<<30 + 1<<20
+	// maxTestDescriptionSize предел поля description
+	maxTestDescriptionSize = 4 << 10
+	// testChunkSize размер части файла в потоке к patient
+	testChunkSize = 1 << 20
+)
+
 // UploadTest godoc
 // @Summary Загрузить документ
+// @Description Файл до 4 ГБ передаётся в сервис потоком, не собираясь в памяти шлюза
 // @Tags Пациент
 // @Accept multipart/form-data
 // @Produce json
 // @Param file formData file true "Файл документа"
 // @Param description formData string false "Описание файла"
 // @Success 200 {object} map[string]interface{} "Документ добавлен"
-// @Failure 400 {object} map[string]string "Неверный запрос или ошибка файла"
-// @Failure 401 {object} map[string]string "Необходима авторизация"
-// @Failure 403 {object} map[string]string "Недостаточно прав"
-// @Failure 500 {object} map[string]string "Внутренняя ошибка сервера"
+// @Failure 400 {object} httperror.Response "Неверный запрос или ошибка файла"
+// @Failure 401 {object} httperror.Response "Необходима авторизация"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 413 {object} httperror.Response "Файл слишком большой"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка сервера"
 // @Router /api/patient/tests/upload [post]
 func (h *PatientHandler) UploadTest(c *gin.Context) {
 	// 1. Получаем токен из куки
 	token, err := c.Cookie("access_token")
 	if err != nil || token == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "необходима авторизация"})
+		httperror.Respond(c, http.StatusUnauthorized, "необходима авторизация")
 		return
 	}
 
-	// 2. Читаем multipart/form-data
-	fileHeader, err := c.FormFile("file")
+	// 2. Читаем multipart/form-data по частям: файл сразу уходит в поток gRPC
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxTestUploadSize)
+	reader, err := c.Request.MultipartReader()
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "файл не найден в запросе " + err.Error()})
+		httperror.Respond(c, http.StatusBadRequest, "ожидается multipart/form-data: "+err.Error())
 		return
 	}
 
-	file, err := fileHeader.Open()
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "не удалось открыть файл " + err.Error()})
-		return
-	}
-	defer file.Close()
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	var stream patientpb.PatientService_UploadTestClient
+	var description string
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			writeRequestBodyError(c, err)
+			return
+		}
 
-	fileBytes, err := io.ReadAll(file)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "не удалось прочитать файл " + err.Error()})
+		switch part.FormName() {
+		case "description":
+			value, err := io.ReadAll(io.LimitReader(part, maxTestDescriptionSize))
+			if err != nil {
+				writeRequestBodyError(c, err)
+				return
+			}
+			description = string(value)
+			// описание пришло после файла — досылаем его отдельным сообщением
+			if stream != nil {
+				_ = stream.Send(&patientpb.UploadTestRequest{Description: description})
+			}
+		case "file":
+			if stream != nil {
+				httperror.Respond(c, http.StatusBadRequest, "в запросе больше одного файла")
+				return
+			}
+			// 3. Вызов gRPC метода UploadTest
+			stream, err = h.PatientClient.Client.UploadTest(ctx)
+			if err != nil {
+				httperror.Write(c, err)
+				return
+			}
+			first := &patientpb.UploadTestRequest{Token: token, FileName: part.FileName(), Description: description}
+			if err := sendTestFile(stream, first, part); err != nil {
+				// недочитанный файл не должен сохраниться: отменяем поток
+				cancel()
+				writeRequestBodyError(c, err)
+				return
+			}
+		}
+		part.Close()
+	}
+	if stream == nil {
+		httperror.Respond(c, http.StatusBadRequest, "файл не найден в запросе")
 		return
 	}
 
-	description := c.PostForm("description")
-
-	// 3. Вызов gRPC метода UploadTest
-	resp, err := h.PatientClient.Client.UploadTest(c.Request.Context(), &patientpb.UploadTestRequest{
-		Token:       token,
-		FileName:    fileHeader.Filename,
-		FileContent: fileBytes,
-		Description: description,
-	})
+	resp, err := stream.CloseAndRecv()
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok {
-			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			httperror.Respond(c, http.StatusBadRequest, st.Message())
 		} else {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "внутренняя ошибка " + err.Error()})
+			httperror.Write(c, err)
 		}
 		return
 	}
@@ -74,25 +121,58 @@ func (h *PatientHandler) UploadTest(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"document_id": resp.DocumentId})
 }
 
+// sendTestFile передаёт файл в поток частями; first — первое сообщение с токеном и именем файла.
+// Возвращает ошибку чтения тела запроса. Если оборвался сам поток, чтение прекращается, а причину
+// вернёт CloseAndRecv
+func sendTestFile(stream patientpb.PatientService_UploadTestClient, first *patientpb.UploadTestRequest, r io.Reader) error {
+	buf := make([]byte, testChunkSize)
+	msg := first
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 || msg == first {
+			msg.Chunk = buf[:n]
+			if sendErr := stream.Send(msg); sendErr != nil {
+				return nil
+			}
+			msg = &patientpb.UploadTestRequest{}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func writeRequestBodyError(c *gin.Context, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		httperror.Respond(c, http.StatusRequestEntityTooLarge, fmt.Sprintf("файл больше %d МБ", maxTestUploadSize>>20))
+		return
+	}
+	httperror.Respond(c, http.StatusBadRequest, "не удалось прочитать запрос: "+err.Error())
+}
+
 // getDocuments godoc
 // @Summary Получить документы пациента
 // @Tags Пациент
 // @Produce json
 // @Success 200 {array} model.DocumentInfo
-// @Failure 401 {object} map[string]string "Необходима авторизация"
-// @Failure 403 {object} map[string]string "Недостаточно прав"
-// @Failure 500 {object} map[string]string "Внутренняя ошибка"
+// @Failure 401 {object} httperror.Response "Необходима авторизация"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка"
 // @Router /api/patient/tests [get]
 func (h *PatientHandler) getDocuments(c *gin.Context) {
 	token, err := c.Cookie("access_token")
 	if err != nil || token == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "необходима авторизация"})
+		httperror.Respond(c, http.StatusUnauthorized, "необходима авторизация")
 		return
 	}
 
 	resp, err := h.PatientClient.Client.GetDocumentsByPatientID(c.Request.Context(), &patientpb.GetDocumentsRequest{Token: token})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	docs := make([]model.DocumentInfo, 0, len(resp.Documents))
@@ -110,26 +190,36 @@ func (h *PatientHandler) getDocuments(c *gin.Context) {
 
 // DownloadDocument godoc
 // @Summary Скачать документ
+// @Description Файл передаётся потоком; заголовок Range вида bytes=N- продолжает скачивание с байта N
 // @Tags Пациент
 // @Produce application/octet-stream
 // @Param id path string true "ID документа"
+// @Param Range header string false "Продолжение скачивания, bytes=N-"
 // @Success 200 {file} file "Файл"
-// @Failure 401 {object} map[string]string "Необходима авторизация"
-// @Failure 403 {object} map[string]string "Доступ запрещён"
-// @Failure 500 {object} map[string]string "Внутренняя ошибка"
+// @Success 206 {file} file "Часть файла с байта N"
+// @Failure 401 {object} httperror.Response "Необходима авторизация"
+// @Failure 403 {object} httperror.Response "Доступ запрещён"
+// @Failure 404 {object} httperror.Response "Документ не найден"
+// @Failure 416 {object} httperror.Response "Диапазон за пределами файла"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка"
 // @Router /patient/tests/{id}/download [get]
 func (h *PatientHandler) DownloadDocument(c *gin.Context) {
-	// Получаем токен и ID документа из запроса
-	documentID := c.Param("id") // например: /documents/:id
+	token, err := c.Cookie("access_token")
+	if err != nil || token == "" {
+		httperror.Respond(c, http.StatusUnauthorized, "необходима авторизация")
+		return
+	}
+	documentID := c.Param("id")
+	offset := filestream.Offset(c)
 
-	// Получаем файл через gRPC
-	doc, err := h.PatientClient.Client.DownloadDocument(c.Request.Context(), &patientpb.DownloadDocumentRequest{DocumentId: documentID})
+	stream, err := h.PatientClient.Client.DownloadDocument(c.Request.Context(), &patientpb.DownloadDocumentRequest{
+		DocumentId: documentID,
+		Offset:     offset,
+		Token:      token,
+	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
-
-	// Отдаем файл пользователю
-	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", doc.FileName))
-	c.Data(http.StatusOK, "application/octet-stream", doc.FileContent)
+	filestream.Write(c, offset, stream.Recv)
 }
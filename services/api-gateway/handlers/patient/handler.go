@@ -20,11 +20,25 @@ func NewHandler(patientClient *clients.PatientClient, accessMiddleware func(requ
 func RegisterRoutes(rg *gin.RouterGroup, h *PatientHandler) {
 	rg.GET("/appointment-doctor-schedule/:doctorId", h.getAppointmentSlots)
 	rg.POST("/appointments", h.addAppointment)
+	rg.POST("/appointments/hold", h.AccessMiddleware(14), h.holdAppointmentSlot)
+	rg.DELETE("/appointments/hold/:holdId", h.AccessMiddleware(14), h.releaseAppointmentSlot)
 	rg.GET("/patient/upcoming", h.AccessMiddleware(3), h.getUpcomingAppointments)
 	rg.GET("/patient/history", h.AccessMiddleware(3), h.getHistoryVisits)
 	rg.GET("/patient/tests", h.AccessMiddleware(16), h.getDocuments)
 	rg.PUT("/appointments/transfer", h.AccessMiddleware(14), h.UpdateAppointment)
 	rg.GET("/appointments/cancel/:id", h.AccessMiddleware(14), h.CancelAppointment)
+	rg.GET("/patient/waiting-list", h.AccessMiddleware(3), h.getWaitingList)
+	rg.POST("/patient/waiting-list", h.AccessMiddleware(14), h.joinWaitingList)
+	rg.DELETE("/patient/waiting-list/:id", h.AccessMiddleware(14), h.leaveWaitingList)
+	rg.POST("/patient/waiting-list/offers/:offerId/accept", h.AccessMiddleware(14), h.acceptWaitingListOffer)
+	rg.POST("/patient/waiting-list/offers/:offerId/decline", h.AccessMiddleware(14), h.declineWaitingListOffer)
+	rg.GET("/patient/treatment-plans", h.AccessMiddleware(3), h.getTreatmentPlans)
+	rg.POST("/patient/treatment-plans/:id/accept", h.AccessMiddleware(14), h.acceptTreatmentPlan)
+	rg.GET("/patient/anamnesis", h.AccessMiddleware(3), h.getAnamnesis)
+	rg.PUT("/patient/anamnesis", h.AccessMiddleware(14), h.saveAnamnesis)
+	rg.GET("/patient/medical-record/export", h.AccessMiddleware(3), h.exportMedicalRecord)
+	rg.GET("/patient/notifications", h.AccessMiddleware(3), h.getNotificationSettings)
+	rg.PUT("/patient/notifications", h.AccessMiddleware(14), h.updateNotificationSettings)
 	rg.POST("/patient/tests/upload", h.AccessMiddleware(15), h.UploadTest)
 	rg.GET("/patient/tests/:id/download", h.AccessMiddleware(16), h.DownloadDocument)
 }
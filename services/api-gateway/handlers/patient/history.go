@@ -1,6 +1,7 @@
 package patient
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	patientpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/patient"
 	"github.com/gin-gonic/gin"
@@ -12,19 +13,19 @@ import (
 // @Tags Пациент
 // @Produce json
 // @Success 200 {array} model.HistoryVisits
-// @Failure 401 {object} map[string]string "Необходима авторизация"
-// @Failure 403 {object} map[string]string "Недостаточно прав"
-// @Failure 500 {object} map[string]string "Внутренняя ошибка"
+// @Failure 401 {object} httperror.Response "Необходима авторизация"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Внутренняя ошибка"
 // @Router /api/patient/history [get]
 func (h *PatientHandler) getHistoryVisits(c *gin.Context) {
 	token, err := c.Cookie("access_token")
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"message": "Токен не найден"})
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
 		return
 	}
 	resp, err := h.PatientClient.Client.GetHistoryVisits(c.Request.Context(), &patientpb.GetHistoryVisitsRequest{Token: token})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 	historyVisits := make([]model.HistoryVisits, 0, len(resp.Visits))
@@ -0,0 +1,41 @@
+package patient
+
+import (
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	patientpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/patient"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// exportMedicalRecord godoc
+// @Summary Выгрузка своей медицинской карты
+// @Tags Медицинская карта
+// @Description Данные пациента, аллергии и заболевания, анкета здоровья, приёмы с диагнозами МКБ-10, услугами
+// @Description и материалами и список документов. format=pdf — печатная форма, format=json — пакет FHIR R4 (collection)
+// @Produce application/pdf
+// @Produce application/fhir+json
+// @Param format query string false "pdf (по умолчанию) или json"
+// @Success 200 {file} file
+// @Failure 400 {object} httperror.Response "Некорректный формат"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при формировании выгрузки"
+// @Router /api/patient/medical-record/export [get]
+func (h *PatientHandler) exportMedicalRecord(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	resp, err := h.PatientClient.Client.ExportMedicalRecord(c.Request.Context(), &patientpb.ExportMedicalRecordRequest{
+		Token:  token,
+		Format: c.DefaultQuery("format", "pdf"),
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.FileName))
+	c.Data(http.StatusOK, resp.ContentType, resp.Content)
+}
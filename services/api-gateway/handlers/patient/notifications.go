@@ -0,0 +1,68 @@
+package patient
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	patientpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/patient"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+// getNotificationSettings godoc
+// @Summary Настройки уведомлений пациента
+// @Tags Уведомления
+// @Description Отказался ли пациент от СМС и писем о записях и напоминаний о приёмах
+// @Produce json
+// @Success 200 {object} model.NotificationSettings
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при получении данных"
+// @Router /api/patient/notifications [get]
+func (h *PatientHandler) getNotificationSettings(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	resp, err := h.PatientClient.Client.GetNotificationSettings(c.Request.Context(), &patientpb.NotificationSettingsRequest{Token: token})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, model.NotificationSettings{OptOut: &resp.OptOut})
+}
+
+// updateNotificationSettings godoc
+// @Summary Изменить настройки уведомлений
+// @Tags Уведомления
+// @Description Отказ от уведомлений действует и на уже запланированные напоминания
+// @Accept json
+// @Produce json
+// @Param request body model.NotificationSettings true "Настройки уведомлений"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при изменении"
+// @Router /api/patient/notifications [put]
+func (h *PatientHandler) updateNotificationSettings(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	var req model.NotificationSettings
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	_, err = h.PatientClient.Client.UpdateNotificationSettings(c.Request.Context(), &patientpb.NotificationSettings{
+		Token:  token,
+		OptOut: *req.OptOut,
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"message": "Настройки уведомлений сохранены"})
+}
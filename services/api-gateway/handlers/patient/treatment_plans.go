@@ -0,0 +1,105 @@
+package patient
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	patientpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/patient"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+)
+
+// getTreatmentPlans godoc
+// @Summary Планы лечения пациента
+// @Tags Планы лечения
+// @Description Возвращает планы лечения пациента и сколько осталось оплатить по принятым планам
+// @Produce json
+// @Success 200 {object} model.PatientTreatmentPlans
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при получении данных"
+// @Router /api/patient/treatment-plans [get]
+func (h *PatientHandler) getTreatmentPlans(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	resp, err := h.PatientClient.Client.GetTreatmentPlans(c.Request.Context(), &patientpb.GetTreatmentPlansRequest{Token: token})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	plans := model.PatientTreatmentPlans{
+		Plans:         make([]model.TreatmentPlan, 0, len(resp.Plans)),
+		RemainingCost: int(resp.RemainingCost),
+	}
+	for _, item := range resp.Plans {
+		plans.Plans = append(plans.Plans, treatmentPlanFromPb(item))
+	}
+	c.JSON(http.StatusOK, plans)
+}
+
+// acceptTreatmentPlan godoc
+// @Summary Принять план лечения
+// @Tags Планы лечения
+// @Description Пациент соглашается с предложенным врачом планом и его предварительной стоимостью
+// @Produce json
+// @Param id path int true "ID плана"
+// @Success 200 {object} model.TreatmentPlan
+// @Failure 400 {object} httperror.Response "Некорректный ввод"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 404 {object} httperror.Response "План не найден"
+// @Failure 409 {object} httperror.Response "План уже принят или отменён"
+// @Failure 500 {object} httperror.Response "Ошибка при сохранении"
+// @Router /api/patient/treatment-plans/{id}/accept [post]
+func (h *PatientHandler) acceptTreatmentPlan(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Некорректный ID плана")
+		return
+	}
+	resp, err := h.PatientClient.Client.AcceptTreatmentPlan(c.Request.Context(), &patientpb.AcceptTreatmentPlanRequest{
+		Token:  token,
+		PlanId: int32(id),
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, treatmentPlanFromPb(resp.Plan))
+}
+
+func treatmentPlanFromPb(item *patientpb.TreatmentPlan) model.TreatmentPlan {
+	plan := model.TreatmentPlan{
+		ID:             int(item.Id),
+		DoctorID:       int(item.DoctorId),
+		Doctor:         item.Doctor,
+		Title:          item.Title,
+		Status:         item.Status,
+		AcceptedAt:     item.AcceptedAt,
+		CreatedAt:      item.CreatedAt,
+		Items:          make([]model.TreatmentPlanItem, 0, len(item.Items)),
+		EstimatedTotal: int(item.EstimatedTotal),
+		RemainingCost:  int(item.RemainingCost),
+	}
+	for _, i := range item.Items {
+		plan.Items = append(plan.Items, model.TreatmentPlanItem{
+			ID:             int(i.Id),
+			Stage:          int(i.Stage),
+			Tooth:          int(i.Tooth),
+			ServiceName:    i.ServiceName,
+			Quantity:       int(i.Quantity),
+			EstimatedPrice: int(i.EstimatedPrice),
+			Status:         i.Status,
+			Note:           i.Note,
+			CompletedAt:    i.CompletedAt,
+		})
+	}
+	return plan
+}
@@ -0,0 +1,199 @@
+package patient
+
+import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
+	"github.com/DariaTarasek/diplom/services/api-gateway/model"
+	patientpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/patient"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// getWaitingList godoc
+// @Summary Лист ожидания пациента
+// @Tags Лист ожидания
+// @Description Возвращает заявки пациента в листе ожидания и действующие предложения освободившихся слотов
+// @Produce json
+// @Success 200 {object} model.WaitingList
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при получении данных"
+// @Router /api/patient/waiting-list [get]
+func (h *PatientHandler) getWaitingList(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	resp, err := h.PatientClient.Client.GetWaitingList(c.Request.Context(), &patientpb.GetWaitingListRequest{Token: token})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	list := model.WaitingList{
+		Entries: make([]model.WaitingListEntry, 0, len(resp.Entries)),
+		Offers:  make([]model.WaitingListOffer, 0, len(resp.Offers)),
+	}
+	for _, e := range resp.Entries {
+		list.Entries = append(list.Entries, model.WaitingListEntry{
+			ID:               int(e.Id),
+			DoctorID:         model.UserID(e.DoctorId),
+			Doctor:           e.Doctor,
+			SpecializationID: int(e.SpecializationId),
+			Specialty:        e.Specialty,
+			DateFrom:         e.DateFrom,
+			DateTo:           e.DateTo,
+			Status:           e.Status,
+		})
+	}
+	for _, o := range resp.Offers {
+		list.Offers = append(list.Offers, model.WaitingListOffer{
+			ID:        o.Id,
+			DoctorID:  model.UserID(o.DoctorId),
+			Doctor:    o.Doctor,
+			Date:      o.Date,
+			Time:      o.Time,
+			ExpiresAt: o.ExpiresAt,
+		})
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+// joinWaitingList godoc
+// @Summary Встать в лист ожидания
+// @Tags Лист ожидания
+// @Description Пациент ждёт освободившийся слот у врача или у любого врача специальности в пределах дат
+// @Accept json
+// @Produce json
+// @Param request body model.WaitingListRequest true "Врач или специальность и диапазон дат"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректные данные"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 403 {object} httperror.Response "Недостаточно прав"
+// @Failure 500 {object} httperror.Response "Ошибка при добавлении"
+// @Router /api/patient/waiting-list [post]
+func (h *PatientHandler) joinWaitingList(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	var req model.WaitingListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Некорректные данные: "+err.Error())
+		return
+	}
+	dateFrom, err := time.Parse("2006-01-02", req.DateFrom)
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Неверный формат даты начала")
+		return
+	}
+	dateTo, err := time.Parse("2006-01-02", req.DateTo)
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, "Неверный формат даты окончания")
+		return
+	}
+
+	resp, err := h.PatientClient.Client.JoinWaitingList(c.Request.Context(), &patientpb.JoinWaitingListRequest{
+		Token:            token,
+		DoctorId:         int32(req.DoctorID),
+		SpecializationId: int32(req.SpecializationID),
+		DateFrom:         timestamppb.New(dateFrom),
+		DateTo:           timestamppb.New(dateTo),
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"id": resp.EntryId})
+}
+
+// leaveWaitingList godoc
+// @Summary Выйти из листа ожидания
+// @Tags Лист ожидания
+// @Produce json
+// @Param id path int true "ID заявки в листе ожидания"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} httperror.Response "Некорректный ID"
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 404 {object} httperror.Response "Заявка не найдена"
+// @Failure 500 {object} httperror.Response "Ошибка при удалении"
+// @Router /api/patient/waiting-list/{id} [delete]
+func (h *PatientHandler) leaveWaitingList(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		httperror.Respond(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	_, err = h.PatientClient.Client.LeaveWaitingList(c.Request.Context(), &patientpb.LeaveWaitingListRequest{Token: token, EntryId: int32(id)})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
+
+// acceptWaitingListOffer godoc
+// @Summary Принять предложенный слот
+// @Tags Лист ожидания
+// @Description Записывает пациента на освободившийся слот из предложения листа ожидания
+// @Produce json
+// @Param offerId path string true "ID предложения"
+// @Success 200 {object} gin.H
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 404 {object} httperror.Response "Предложение не найдено"
+// @Failure 409 {object} httperror.Response "Предложение истекло или слот уже занят"
+// @Failure 500 {object} httperror.Response "Ошибка при записи"
+// @Router /api/patient/waiting-list/offers/{offerId}/accept [post]
+func (h *PatientHandler) acceptWaitingListOffer(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	resp, err := h.PatientClient.Client.AcceptWaitingListOffer(c.Request.Context(), &patientpb.WaitingListOfferRequest{
+		Token:   token,
+		OfferId: c.Param("offerId"),
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"appointment_id": resp.AppointmentId})
+}
+
+// declineWaitingListOffer godoc
+// @Summary Отказаться от предложенного слота
+// @Tags Лист ожидания
+// @Description Слот переходит следующему пациенту в очереди, заявка отказавшегося остаётся в листе ожидания
+// @Produce json
+// @Param offerId path string true "ID предложения"
+// @Success 200 {object} gin.H
+// @Failure 401 {object} httperror.Response "Токен не найден"
+// @Failure 404 {object} httperror.Response "Предложение не найдено"
+// @Failure 409 {object} httperror.Response "Предложение истекло"
+// @Failure 500 {object} httperror.Response "Ошибка при отказе"
+// @Router /api/patient/waiting-list/offers/{offerId}/decline [post]
+func (h *PatientHandler) declineWaitingListOffer(c *gin.Context) {
+	token, err := c.Cookie("access_token")
+	if err != nil {
+		httperror.Respond(c, http.StatusUnauthorized, "Токен не найден")
+		return
+	}
+	_, err = h.PatientClient.Client.DeclineWaitingListOffer(c.Request.Context(), &patientpb.WaitingListOfferRequest{
+		Token:   token,
+		OfferId: c.Param("offerId"),
+	})
+	if err != nil {
+		httperror.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{})
+}
@@ -1,6 +1,7 @@
 package statistics
 
 import (
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	"github.com/DariaTarasek/diplom/services/api-gateway/model"
 	statspb "github.com/DariaTarasek/diplom/services/api-gateway/proto/statistics"
 	"github.com/gin-gonic/gin"
@@ -18,7 +19,7 @@ import (
 func (h *Handler) GetAllStats(c *gin.Context) {
 	items, err := h.StatisticsClient.Client.GetAllStats(c.Request.Context(), &statspb.EmptyRequest{})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		httperror.Write(c, err)
 		return
 	}
 
@@ -0,0 +1,113 @@
+// Package httperror перевод ошибок в HTTP-ответы шлюза. У любой ошибки одно тело Response: текст для
+// пользователя в error, машиночитаемая причина в code и, для некорректных данных, нарушения по полям в fields.
+// Ошибки сервисов переводятся по gRPC-коду и деталям статуса (см. apperr); у ответов 5xx текст заменяется
+// общим, исходная ошибка остаётся только в журнале
+package httperror
+
+import (
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"log"
+	"net/http"
+)
+
+// internalMessage текст ответа 5xx: подробности внутренних ошибок клиенту не отдаются
+const internalMessage = "Внутренняя ошибка сервера"
+
+// FieldError нарушение в поле запроса
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Response тело ответа с ошибкой
+type Response struct {
+	Error  string       `json:"error" example:"пациент не найден"`
+	Code   string       `json:"code" example:"patient_not_found"`
+	Fields []FieldError `json:"fields,omitempty"`
+}
+
+// Write ответ на ошибку вызова сервиса
+func Write(c *gin.Context, err error) {
+	c.JSON(response(err))
+}
+
+// Abort ответ на ошибку вызова сервиса с прерыванием цепочки обработчиков
+func Abort(c *gin.Context, err error) {
+	c.AbortWithStatusJSON(response(err))
+}
+
+// Respond ответ на ошибку, найденную самим шлюзом: некорректный запрос, нет токена, нет права роли
+func Respond(c *gin.Context, httpStatus int, message string) {
+	c.JSON(httpStatus, Response{Error: message, Code: reason(httpStatus)})
+}
+
+// AbortRespond то же, что Respond, с прерыванием цепочки обработчиков
+func AbortRespond(c *gin.Context, httpStatus int, message string) {
+	c.AbortWithStatusJSON(httpStatus, Response{Error: message, Code: reason(httpStatus)})
+}
+
+func response(err error) (int, Response) {
+	log.Println(err.Error())
+	e := apperr.FromError(err)
+	httpStatus := Status(e.Code)
+	if httpStatus >= http.StatusInternalServerError {
+		return httpStatus, Response{Error: internalMessage, Code: e.Reason}
+	}
+	resp := Response{Error: e.Message, Code: e.Reason}
+	for _, f := range e.Fields {
+		resp.Fields = append(resp.Fields, FieldError{Field: f.Name, Message: f.Message})
+	}
+	return httpStatus, resp
+}
+
+// Status HTTP-статус для gRPC-кода ошибки
+func Status(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+		return http.StatusConflict
+	case codes.OutOfRange:
+		return http.StatusRequestedRangeNotSatisfiable
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return http.StatusRequestTimeout
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
+
+// reason причина для ошибок самого шлюза, согласованная с причинами по умолчанию из apperr
+func reason(httpStatus int) string {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return apperr.DefaultReason(codes.InvalidArgument)
+	case http.StatusUnauthorized:
+		return apperr.DefaultReason(codes.Unauthenticated)
+	case http.StatusForbidden:
+		return apperr.DefaultReason(codes.PermissionDenied)
+	case http.StatusNotFound:
+		return apperr.DefaultReason(codes.NotFound)
+	case http.StatusConflict:
+		return apperr.DefaultReason(codes.FailedPrecondition)
+	case http.StatusRequestEntityTooLarge:
+		return "payload_too_large"
+	case http.StatusRequestedRangeNotSatisfiable:
+		return apperr.DefaultReason(codes.OutOfRange)
+	case http.StatusTooManyRequests:
+		return apperr.DefaultReason(codes.ResourceExhausted)
+	}
+	return apperr.DefaultReason(codes.Internal)
+}
@@ -0,0 +1,111 @@
+// Package listquery параметры постраничных списков шлюза: limit, offset, sort, order (asc или desc) и поиск q.
+// Тело ответа списка остаётся массивом, число всех найденных записей передаётся в заголовке X-Total-Count
+package listquery
+
+import (
+	"errors"
+	adminpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/admin"
+	storagepb "github.com/DariaTarasek/diplom/services/api-gateway/proto/storage"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// MaxLimit наибольший размер страницы, который может запросить клиент
+	MaxLimit = 200
+	// DefaultLimit размер страницы, если ни клиент, ни обработчик его не задали
+	DefaultLimit = 50
+	// TotalHeader заголовок ответа с числом всех найденных записей
+	TotalHeader = "X-Total-Count"
+)
+
+// Params параметры списка из строки запроса. Limit == 0 — список целиком; Parse так не отдаёт
+type Params struct {
+	Limit  int
+	Offset int
+	Sort   string
+	Desc   bool
+	Query  string
+}
+
+// Parse параметры списка из строки запроса. Без limit отдаётся defaultLimit записей (при defaultLimit <= 0 —
+// DefaultLimit): список целиком одним ответом не отдаётся. limit и defaultLimit больше MaxLimit уменьшаются
+// до него. Допустимость sort проверяет хранилище
+func Parse(c *gin.Context, defaultLimit int) (Params, error) {
+	if defaultLimit <= 0 {
+		defaultLimit = DefaultLimit
+	}
+	p := Params{
+		Limit: min(defaultLimit, MaxLimit),
+		Sort:  c.Query("sort"),
+		Query: strings.TrimSpace(c.Query("q")),
+	}
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 {
+			return Params{}, errors.New("limit должен быть положительным числом")
+		}
+		p.Limit = min(limit, MaxLimit)
+	}
+	if raw := c.Query("offset"); raw != "" {
+		offset, err := strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			return Params{}, errors.New("offset должен быть неотрицательным числом")
+		}
+		p.Offset = offset
+	}
+	switch c.DefaultQuery("order", "asc") {
+	case "asc":
+	case "desc":
+		p.Desc = true
+	default:
+		return Params{}, errors.New("order должен быть asc или desc")
+	}
+	return p, nil
+}
+
+// Date дата из параметра строки запроса в формате 2006-01-02 как полночь UTC: так передаются даты
+// без пояса. nil, если параметра нет
+func Date(c *gin.Context, name string) (*timestamppb.Timestamp, error) {
+	raw := c.Query(name)
+	if raw == "" {
+		return nil, nil
+	}
+	date, err := time.Parse(time.DateOnly, raw)
+	if err != nil {
+		return nil, errors.New(name + " должен быть датой в формате ГГГГ-ММ-ДД")
+	}
+	return timestamppb.New(date), nil
+}
+
+// Inactive параметр inactive списков пользователей: true — только отключённые сотрудники и архивные
+// пациенты, чтобы их можно было восстановить; без параметра — только работающие
+func Inactive(c *gin.Context) (bool, error) {
+	raw := c.Query("inactive")
+	if raw == "" {
+		return false, nil
+	}
+	inactive, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, errors.New("inactive должен быть true или false")
+	}
+	return inactive, nil
+}
+
+// SetTotal записывает в ответ число всех найденных записей
+func SetTotal(c *gin.Context, total int32) {
+	c.Header(TotalHeader, strconv.Itoa(int(total)))
+}
+
+// Admin страница для запроса к admin-сервису
+func (p Params) Admin() *adminpb.ListPage {
+	return &adminpb.ListPage{Limit: int32(p.Limit), Offset: int32(p.Offset), Sort: p.Sort, Desc: p.Desc}
+}
+
+// Storage страница для запроса к хранилищу
+func (p Params) Storage() *storagepb.ListPage {
+	return &storagepb.ListPage{Limit: int32(p.Limit), Offset: int32(p.Offset), Sort: p.Sort, Desc: p.Desc}
+}
@@ -2,29 +2,87 @@ package middleware
 
 import (
 	"github.com/DariaTarasek/diplom/services/api-gateway/clients"
+	"github.com/DariaTarasek/diplom/services/api-gateway/httperror"
 	authpb "github.com/DariaTarasek/diplom/services/api-gateway/proto/auth"
+	"github.com/DariaTarasek/diplom/services/audit"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
 )
 
 func MakeAccessMiddleware(authClient *clients.AuthClient) func(requiredPermission int32) gin.HandlerFunc {
 	return func(requiredPermission int32) gin.HandlerFunc {
 		return func(c *gin.Context) {
-			token, err := c.Cookie("access_token")
-			if err != nil {
-				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Токен не найден"})
-				return
+			token, _ := c.Cookie(AccessTokenCookie)
+			if token == "" {
+				if !refreshSession(c, authClient) {
+					httperror.AbortRespond(c, http.StatusUnauthorized, "Токен не найден")
+					return
+				}
+				token, _ = c.Cookie(AccessTokenCookie)
 			}
-			_, err = authClient.Client.PermissionCheck(c.Request.Context(), &authpb.PermissionCheckRequest{
+			resp, err := authClient.Client.PermissionCheck(c.Request.Context(), &authpb.PermissionCheckRequest{
 				Token:  token,
 				PermId: requiredPermission,
 			})
+			// access-токен истёк или сессия отозвана — пробуем продлить сессию и повторяем проверку
+			if status.Code(err) == codes.Unauthenticated {
+				if !refreshSession(c, authClient) {
+					httperror.AbortRespond(c, http.StatusUnauthorized, "Сессия завершена")
+					return
+				}
+				token, _ = c.Cookie(AccessTokenCookie)
+				resp, err = authClient.Client.PermissionCheck(c.Request.Context(), &authpb.PermissionCheckRequest{
+					Token:  token,
+					PermId: requiredPermission,
+				})
+			}
 
 			if err != nil {
-				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Недостаточно прав"})
+				httperror.AbortRespond(c, http.StatusForbidden, "Недостаточно прав")
 				return
 			}
+			// вызовы сервисов из обработчика идут от имени проверенного пользователя и попадают в журнал аудита
+			c.Request = c.Request.WithContext(audit.WithActor(c.Request.Context(), audit.Actor{
+				UserID: int(resp.UserId),
+				Role:   int(resp.Role),
+			}))
 			c.Next()
 		}
 	}
 }
+
+// refreshSession обменивает refresh-токен из cookie на новую пару токенов. Новые токены записываются и в ответ,
+// и в cookie текущего запроса, чтобы обработчики, читающие access_token, получили уже действующий токен
+func refreshSession(c *gin.Context, authClient *clients.AuthClient) bool {
+	refreshToken, err := c.Cookie(RefreshTokenCookie)
+	if err != nil || refreshToken == "" {
+		return false
+	}
+	resp, err := authClient.Client.RefreshToken(c.Request.Context(), &authpb.RefreshTokenRequest{RefreshToken: refreshToken})
+	if err != nil {
+		ClearTokenCookies(c)
+		return false
+	}
+	SetTokenCookies(c, resp.Token, resp.RefreshToken)
+	replaceRequestCookies(c.Request, map[string]string{
+		AccessTokenCookie:  resp.Token,
+		RefreshTokenCookie: resp.RefreshToken,
+	})
+	return true
+}
+
+func replaceRequestCookies(r *http.Request, values map[string]string) {
+	cookies := r.Cookies()
+	r.Header.Del("Cookie")
+	for _, cookie := range cookies {
+		if _, ok := values[cookie.Name]; ok {
+			continue
+		}
+		r.AddCookie(cookie)
+	}
+	for name, value := range values {
+		r.AddCookie(&http.Cookie{Name: name, Value: value})
+	}
+}
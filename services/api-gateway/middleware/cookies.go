@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"time"
+)
+
+const (
+	AccessTokenCookie  = "access_token"
+	RefreshTokenCookie = "refresh_token"
+
+	// refreshCookieTTL совпадает со временем жизни refresh-токена в сервисе авторизации
+	refreshCookieTTL = 7 * 24 * time.Hour
+)
+
+// SetTokenCookies записывает пару токенов в cookie. Access-токен живёт столько же, сколько refresh,
+// чтобы middleware могло обменять просроченный токен, а не разлогинивать пользователя
+func SetTokenCookies(c *gin.Context, token, refreshToken string) {
+	expires := time.Now().Add(refreshCookieTTL)
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     AccessTokenCookie,
+		Value:    token,
+		Path:     "/",
+		Expires:  expires,
+		Secure:   false,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     RefreshTokenCookie,
+		Value:    refreshToken,
+		Path:     "/",
+		Expires:  expires,
+		Secure:   false,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func ClearTokenCookies(c *gin.Context) {
+	for _, name := range []string{AccessTokenCookie, RefreshTokenCookie} {
+		http.SetCookie(c.Writer, &http.Cookie{
+			Name:     name,
+			Value:    "",
+			Path:     "/",
+			MaxAge:   -1,
+			HttpOnly: true,
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package model
+
+type (
+	// Anamnesis версия анкеты здоровья пациента; version = 0 — анкета ещё не заполнялась.
+	// changed_by_role — patient или doctor, changed_by — ФИО врача, если версию сохранил врач
+	Anamnesis struct {
+		Version            int                `json:"version"`
+		Medications        string             `json:"medications"`
+		BloodThinners      bool               `json:"blood_thinners"`
+		CardiacDisease     bool               `json:"cardiac_disease"`
+		Hypertension       bool               `json:"hypertension"`
+		Diabetes           bool               `json:"diabetes"`
+		BleedingDisorder   bool               `json:"bleeding_disorder"`
+		InfectiousDisease  bool               `json:"infectious_disease"`
+		AnesthesiaReaction bool               `json:"anesthesia_reaction"`
+		Pregnant           bool               `json:"pregnant"`
+		Breastfeeding      bool               `json:"breastfeeding"`
+		Smoker             bool               `json:"smoker"`
+		OtherConditions    string             `json:"other_conditions"`
+		Allergies          []AnamnesisAllergy `json:"allergies"`
+		ChangedByRole      string             `json:"changed_by_role,omitempty"`
+		ChangedBy          string             `json:"changed_by,omitempty"`
+		ChangedAt          string             `json:"changed_at,omitempty"`
+	}
+	// AnamnesisAllergy аллергия из анкеты; severity — mild, moderate или severe
+	AnamnesisAllergy struct {
+		Allergen string `json:"allergen" binding:"required"`
+		Severity string `json:"severity" binding:"required"`
+		Reaction string `json:"reaction"`
+	}
+	// AnamnesisRequest ответы анкеты; base_version — версия, которую открыл пользователь (0 — анкета заполняется впервые).
+	// Если анкету с тех пор изменили, сохранение отклоняется с кодом 409
+	AnamnesisRequest struct {
+		BaseVersion        int                `json:"base_version"`
+		Medications        string             `json:"medications"`
+		BloodThinners      bool               `json:"blood_thinners"`
+		CardiacDisease     bool               `json:"cardiac_disease"`
+		Hypertension       bool               `json:"hypertension"`
+		Diabetes           bool               `json:"diabetes"`
+		BleedingDisorder   bool               `json:"bleeding_disorder"`
+		InfectiousDisease  bool               `json:"infectious_disease"`
+		AnesthesiaReaction bool               `json:"anesthesia_reaction"`
+		Pregnant           bool               `json:"pregnant"`
+		Breastfeeding      bool               `json:"breastfeeding"`
+		Smoker             bool               `json:"smoker"`
+		OtherConditions    string             `json:"other_conditions"`
+		Allergies          []AnamnesisAllergy `json:"allergies" binding:"dive"`
+	}
+)
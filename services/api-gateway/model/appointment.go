@@ -10,20 +10,32 @@ type (
 	AppointmentID int
 	UserID        int
 	Appointment   struct {
-		ID                 AppointmentID `json:"id"`
-		DoctorID           UserID        `json:"doctor_id"`
-		PatientID          *UserID       `json:"user_id"`
-		Date               string        `json:"date"`
-		Time               string        `json:"time"`
-		PatientSecondName  string        `json:"secondName"`
-		PatientFirstName   string        `json:"firstName"`
-		PatientSurname     *string       `json:"surname"`
-		PatientBirthDate   string        `json:"birthDate"`
-		PatientGender      string        `json:"gender"`
-		PatientPhoneNumber string        `json:"phone"`
-		Status             string        `json:"status"`
-		CreatedAt          string        `json:"createdAt"`
-		UpdatedAt          string        `json:"updatedAt"`
+		ID                 AppointmentID   `json:"id"`
+		DoctorID           UserID          `json:"doctor_id"`
+		PatientID          *UserID         `json:"user_id"`
+		Date               string          `json:"date"`
+		Time               string          `json:"time"`
+		PatientSecondName  string          `json:"secondName"`
+		PatientFirstName   string          `json:"firstName"`
+		PatientSurname     *string         `json:"surname"`
+		PatientBirthDate   string          `json:"birthDate"`
+		PatientGender      string          `json:"gender"`
+		PatientPhoneNumber string          `json:"phone"`
+		Status             string          `json:"status"`
+		CreatedAt          string          `json:"createdAt"`
+		UpdatedAt          string          `json:"updatedAt"`
+		HoldID             string          `json:"hold_id"`
+		ServiceIDs         []int           `json:"service_ids"`
+		Alerts             []ClinicalAlert `json:"alerts,omitempty"`
+	}
+	AppointmentSlotHold struct {
+		ID         string  `json:"hold_id"`
+		DoctorID   UserID  `json:"doctor_id"`
+		PatientID  *UserID `json:"user_id"`
+		Date       string  `json:"date"`
+		Time       string  `json:"time"`
+		ExpiresAt  string  `json:"expires_at"`
+		ServiceIDs []int   `json:"service_ids"`
 	}
 	UnconfirmedAppointment struct {
 		ID                int    `json:"id"`
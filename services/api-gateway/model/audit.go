@@ -0,0 +1,27 @@
+package model
+
+type (
+	// AuditEntry запись журнала обращений к данным: кто (actor_id, actor_role), каким методом какого сервиса,
+	// к какому ресурсу и чьим данным, с каким кодом gRPC-статуса. hash — звено цепочки хешей в hex
+	AuditEntry struct {
+		ID         int64  `json:"id"`
+		OccurredAt string `json:"occurred_at"`
+		Service    string `json:"service"`
+		Action     string `json:"action"`
+		ActorID    int    `json:"actor_id"`
+		ActorRole  int    `json:"actor_role"`
+		Resource   string `json:"resource,omitempty"`
+		ResourceID string `json:"resource_id,omitempty"`
+		PatientID  int    `json:"patient_id,omitempty"`
+		Outcome    string `json:"outcome"`
+		Hash       string `json:"hash"`
+	}
+	// AuditLogCheck результат проверки цепочки: broken_id — первая запись, хеш которой не сходится
+	// (после неё записи изменены, удалены или переставлены); пустой — журнал цел
+	AuditLogCheck struct {
+		Checked  int    `json:"checked"`
+		Intact   bool   `json:"intact"`
+		BrokenID int64  `json:"broken_id,omitempty"`
+		LastHash string `json:"last_hash,omitempty"`
+	}
+)
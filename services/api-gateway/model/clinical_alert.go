@@ -0,0 +1,54 @@
+package model
+
+type (
+	// ClinicalAlert предупреждение по карте пациента; reason — что в карте его вызвало, material_id или service_id
+	// заданы, если предупреждение относится к выбранному материалу или услуге
+	ClinicalAlert struct {
+		RuleID     int    `json:"rule_id"`
+		Severity   string `json:"severity"`
+		Title      string `json:"title"`
+		Message    string `json:"message"`
+		Reason     string `json:"reason"`
+		MaterialID int    `json:"material_id,omitempty"`
+		ServiceID  int    `json:"service_id,omitempty"`
+		TargetName string `json:"target_name,omitempty"`
+	}
+	// ClinicalAlertCheckRequest материалы и услуги, выбранные врачом на приёме
+	ClinicalAlertCheckRequest struct {
+		PatientID   int   `json:"patient_id" binding:"required"`
+		MaterialIDs []int `json:"material_ids"`
+		ServiceIDs  []int `json:"service_ids"`
+	}
+	// ClinicalAlertRule правило клинического предупреждения.
+	// condition_type: allergy — аллергия пациента содержит condition_value; condition — хроническое или сопутствующее
+	// заболевание либо принимаемый препарат содержит condition_value; anamnesis_flag — в анкете отмечен пункт
+	// condition_value (blood_thinners, cardiac_disease, hypertension, diabetes, bleeding_disorder, infectious_disease,
+	// anesthesia_reaction, pregnant, breastfeeding, smoker).
+	// target_type: visit — показывается при открытии приёма, material или service — при выборе material_id или service_id
+	ClinicalAlertRule struct {
+		ID             int    `json:"id"`
+		Title          string `json:"title"`
+		ConditionType  string `json:"condition_type"`
+		ConditionValue string `json:"condition_value"`
+		TargetType     string `json:"target_type"`
+		MaterialID     int    `json:"material_id,omitempty"`
+		ServiceID      int    `json:"service_id,omitempty"`
+		TargetName     string `json:"target_name,omitempty"`
+		Severity       string `json:"severity"`
+		Message        string `json:"message"`
+		IsActive       bool   `json:"is_active"`
+		CreatedAt      string `json:"created_at"`
+	}
+	// ClinicalAlertRuleRequest добавление или изменение правила; без is_active правило включено
+	ClinicalAlertRuleRequest struct {
+		Title          string `json:"title" binding:"required"`
+		ConditionType  string `json:"condition_type" binding:"required"`
+		ConditionValue string `json:"condition_value" binding:"required"`
+		TargetType     string `json:"target_type" binding:"required"`
+		MaterialID     int    `json:"material_id"`
+		ServiceID      int    `json:"service_id"`
+		Severity       string `json:"severity" binding:"required"`
+		Message        string `json:"message"`
+		IsActive       *bool  `json:"is_active"`
+	}
+)
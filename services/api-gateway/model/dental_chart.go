@@ -0,0 +1,25 @@
+package model
+
+type (
+	// ToothState состояние зуба по FDI: healthy, caries, filled, crown, implant или missing;
+	// surfaces — затронутые поверхности буквами MOIDBL
+	ToothState struct {
+		Tooth      int    `json:"tooth"`
+		Status     string `json:"status"`
+		Surfaces   string `json:"surfaces,omitempty"`
+		Note       string `json:"note,omitempty"`
+		VisitID    int    `json:"visit_id,omitempty"`
+		DoctorID   int    `json:"doctor_id,omitempty"`
+		Doctor     string `json:"doctor,omitempty"`
+		RecordedAt string `json:"recorded_at"`
+	}
+	ToothStateInput struct {
+		Tooth    int    `json:"tooth" binding:"required"`
+		Status   string `json:"status" binding:"required"`
+		Surfaces string `json:"surfaces"`
+		Note     string `json:"note"`
+	}
+	DentalChartRequest struct {
+		Teeth []ToothStateInput `json:"teeth" binding:"required"`
+	}
+)
@@ -0,0 +1,66 @@
+package model
+
+// MaterialStockLevel материал с текущим остатком; остаток отрицательный, если списано больше, чем оприходовано
+type MaterialStockLevel struct {
+	Material
+	StockQuantity int `json:"stock_quantity"`
+}
+
+// MaterialBatch партия поступления материала; expires_at (YYYY-MM-DD) пуст у материалов без срока годности
+type MaterialBatch struct {
+	ID               int    `json:"id"`
+	MaterialID       int    `json:"material_id"`
+	BatchNumber      string `json:"batch_number,omitempty"`
+	QuantityReceived int    `json:"quantity_received"`
+	QuantityLeft     int    `json:"quantity_left"`
+	ExpiresAt        string `json:"expires_at,omitempty"`
+	ReceivedAt       string `json:"received_at"`
+	Comment          string `json:"comment,omitempty"`
+}
+
+// StockMovement движение материала: receipt — поступление, consumption — расход на приёме, adjustment — корректировка
+type StockMovement struct {
+	ID        int    `json:"id"`
+	BatchID   int    `json:"batch_id,omitempty"`
+	Kind      string `json:"kind"`
+	Quantity  int    `json:"quantity"`
+	VisitID   int    `json:"visit_id,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+// MaterialStock остаток материала, партии с остатком и последние движения
+type MaterialStock struct {
+	Material  MaterialStockLevel `json:"material"`
+	Batches   []MaterialBatch    `json:"batches"`
+	Movements []StockMovement    `json:"movements"`
+}
+
+// MaterialBatchRequest оприходование партии; expires_at (YYYY-MM-DD) не обязателен
+type MaterialBatchRequest struct {
+	BatchNumber string `json:"batch_number"`
+	Quantity    int    `json:"quantity" binding:"required"`
+	ExpiresAt   string `json:"expires_at"`
+	Comment     string `json:"comment"`
+}
+
+// StockAdjustmentRequest корректировка остатка: quantity со знаком, причина обязательна.
+// Без batch_id уменьшение списывается по партиям с ближайшим сроком годности
+type StockAdjustmentRequest struct {
+	BatchID  int    `json:"batch_id"`
+	Quantity int    `json:"quantity" binding:"required"`
+	Reason   string `json:"reason" binding:"required"`
+}
+
+// ExpiringBatch партия, срок годности которой истёк или скоро истечёт
+type ExpiringBatch struct {
+	MaterialBatch
+	MaterialName string `json:"material_name"`
+	Unit         string `json:"unit"`
+}
+
+// StockReport материалы с остатком не выше порога и партии с истекающим сроком годности
+type StockReport struct {
+	LowStock []MaterialStockLevel `json:"low_stock"`
+	Expiring []ExpiringBatch      `json:"expiring"`
+}
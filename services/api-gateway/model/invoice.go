@@ -0,0 +1,67 @@
+package model
+
+// Invoice счёт на приём: paid — внесено за вычетом возвратов, balance — остаток к оплате
+type Invoice struct {
+	ID             int              `json:"id"`
+	VisitID        int              `json:"visit_id"`
+	PatientID      int              `json:"patient_id"`
+	Subtotal       int              `json:"subtotal"`
+	DiscountKind   string           `json:"discount_kind,omitempty"`
+	DiscountValue  int              `json:"discount_value,omitempty"`
+	DiscountReason string           `json:"discount_reason,omitempty"`
+	DiscountAmount int              `json:"discount_amount"`
+	Total          int              `json:"total"`
+	Paid           int              `json:"paid"`
+	Balance        int              `json:"balance"`
+	Status         string           `json:"status"` // issued, partially_paid, paid, refunded
+	CreatedAt      string           `json:"created_at"`
+	Lines          []InvoiceLine    `json:"lines"`
+	Payments       []InvoicePayment `json:"payments"`
+}
+
+type InvoiceLine struct {
+	ID           int    `json:"id"`
+	Kind         string `json:"kind"` // service, material, adjustment
+	ItemID       int    `json:"item_id"`
+	Name         string `json:"name"`
+	Quantity     int    `json:"quantity"`
+	PricePerUnit int    `json:"price_per_unit"`
+	TotalPrice   int    `json:"total_price"`
+}
+
+type InvoicePayment struct {
+	ID        int    `json:"id"`
+	Kind      string `json:"kind"` // payment, refund
+	Amount    int    `json:"amount"`
+	Method    string `json:"method"` // cash, card, transfer; unknown — оплата до введения счетов
+	RefundOf  int    `json:"refund_of,omitempty"`
+	Comment   string `json:"comment,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+// InvoiceDiscountRequest скидка на счёт: процент (1..100) или фиксированная сумма
+type InvoiceDiscountRequest struct {
+	Kind   string `json:"kind" binding:"required,oneof=percent fixed"`
+	Value  int    `json:"value" binding:"required,min=1"`
+	Reason string `json:"reason" binding:"required,oneof=loyalty staff promo"`
+}
+
+type InvoicePaymentRequest struct {
+	Amount  int    `json:"amount" binding:"required,min=1"`
+	Method  string `json:"method" binding:"required,oneof=cash card transfer"`
+	Comment string `json:"comment"`
+}
+
+type InvoiceRefundRequest struct {
+	Amount  int    `json:"amount" binding:"required,min=1"`
+	Comment string `json:"comment"`
+}
+
+// PatientBalance расчёты с пациентом по всем счетам
+type PatientBalance struct {
+	PatientID    int       `json:"patient_id"`
+	Invoiced     int       `json:"invoiced"`
+	Paid         int       `json:"paid"`
+	Outstanding  int       `json:"outstanding"`
+	OpenInvoices []Invoice `json:"open_invoices"`
+}
@@ -1,7 +1,10 @@
 package model
 
+// Material материал; unit — единица учёта, low_stock_threshold — порог предупреждения о низком остатке (0 — без предупреждения)
 type Material struct {
-	ID    int    `json:"id"`
-	Name  string `json:"name"`
-	Price int    `json:"price"`
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	Price             int    `json:"price"`
+	Unit              string `json:"unit,omitempty"`
+	LowStockThreshold int    `json:"low_stock_threshold,omitempty"`
 }
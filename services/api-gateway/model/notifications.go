@@ -0,0 +1,6 @@
+package model
+
+// NotificationSettings настройки уведомлений пациента о записях и напоминаний о приёмах
+type NotificationSettings struct {
+	OptOut *bool `json:"opt_out" binding:"required"`
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+type (
+	// PatientAccessGrant допуск врача к медицинской карте пациента, которого он не лечит. Пустой expires_at —
+	// бессрочный допуск, непустой revoked_at — отозванный
+	PatientAccessGrant struct {
+		ID        int    `json:"id"`
+		PatientID int    `json:"patient_id"`
+		DoctorID  int    `json:"doctor_id"`
+		Doctor    string `json:"doctor"`
+		Reason    string `json:"reason"`
+		GrantedBy int    `json:"granted_by,omitempty"`
+		ExpiresAt string `json:"expires_at,omitempty"`
+		RevokedAt string `json:"revoked_at,omitempty"`
+		CreatedAt string `json:"created_at"`
+	}
+	// PatientAccessGrantRequest выдача допуска; expires_at в RFC 3339, без него допуск бессрочный
+	PatientAccessGrantRequest struct {
+		DoctorID  int        `json:"doctor_id" binding:"required"`
+		Reason    string     `json:"reason" binding:"required"`
+		ExpiresAt *time.Time `json:"expires_at"`
+	}
+)
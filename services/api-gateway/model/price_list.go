@@ -0,0 +1,16 @@
+package model
+
+// PriceListEntry цена услуги или материала, действующая с даты effective_from до следующей записи
+type PriceListEntry struct {
+	ID            int    `json:"id"`
+	ItemID        int    `json:"item_id"`
+	Price         int    `json:"price"`
+	EffectiveFrom string `json:"effective_from"`
+	CreatedAt     string `json:"created_at,omitempty"`
+}
+
+// PriceListEntryRequest новая цена: с даты effective_from (YYYY-MM-DD), сегодня или позже
+type PriceListEntryRequest struct {
+	Price         *int   `json:"price" binding:"required"`
+	EffectiveFrom string `json:"effective_from" binding:"required"`
+}
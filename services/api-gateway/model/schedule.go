@@ -35,3 +35,30 @@ type DoctorDailyOverride struct {
 	EndTime   string `json:"end_time"`
 	IsDayOff  string `json:"type"`
 }
+
+// ScheduleRule исключение из постоянного расписания: отпуск или сокращённые дни на диапазон дат,
+// повторяющееся правило («каждый первый понедельник»), ежегодный праздник. DoctorID == 0 — правило клиники
+type ScheduleRule struct {
+	ID                  int    `json:"id"`
+	DoctorID            int    `json:"doctor_id"`
+	Recurrence          string `json:"recurrence" binding:"required,oneof=range monthly_weekday annual"`
+	DateFrom            string `json:"date_from" binding:"required"`
+	DateTo              string `json:"date_to"`
+	Weekday             int    `json:"weekday"`
+	Nth                 int    `json:"nth"`
+	StartTime           string `json:"start_time"`
+	EndTime             string `json:"end_time"`
+	SlotDurationMinutes int    `json:"slot_minutes"`
+	IsDayOff            bool   `json:"is_day_off"`
+	Title               string `json:"title"`
+}
+
+type Holiday struct {
+	Date   string `json:"date" binding:"required"`
+	Title  string `json:"title"`
+	Annual bool   `json:"annual"`
+}
+
+type HolidayCalendar struct {
+	Holidays []Holiday `json:"holidays" binding:"required,dive"`
+}
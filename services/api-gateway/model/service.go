@@ -6,8 +6,9 @@ type ServiceType struct {
 }
 
 type Service struct {
-	ID            int    `json:"id"`
-	Name          string `json:"name"`
-	Price         int    `json:"price"`
-	ServiceTypeId int    `json:"category_id"`
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	Price           int    `json:"price"`
+	ServiceTypeId   int    `json:"category_id"`
+	DurationMinutes int    `json:"duration_minutes"`
 }
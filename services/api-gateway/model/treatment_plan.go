@@ -0,0 +1,57 @@
+package model
+
+type (
+	// TreatmentPlanItem позиция плана лечения; tooth — номер зуба по FDI, 0 — позиция не привязана к зубу
+	TreatmentPlanItem struct {
+		ID             int    `json:"id"`
+		Stage          int    `json:"stage"`
+		Tooth          int    `json:"tooth,omitempty"`
+		ServiceID      int    `json:"service_id,omitempty"`
+		ServiceName    string `json:"service_name"`
+		Quantity       int    `json:"quantity"`
+		EstimatedPrice int    `json:"estimated_price"`
+		Status         string `json:"status"`
+		VisitID        int    `json:"visit_id,omitempty"`
+		Note           string `json:"note,omitempty"`
+		CompletedAt    string `json:"completed_at,omitempty"`
+	}
+	// TreatmentPlan план лечения; status — proposed, accepted, in_progress, done, cancelled
+	TreatmentPlan struct {
+		ID             int                 `json:"id"`
+		PatientID      int                 `json:"patient_id,omitempty"`
+		DoctorID       int                 `json:"doctor_id"`
+		Doctor         string              `json:"doctor"`
+		Title          string              `json:"title"`
+		Status         string              `json:"status"`
+		AcceptedAt     string              `json:"accepted_at,omitempty"`
+		CreatedAt      string              `json:"created_at"`
+		Items          []TreatmentPlanItem `json:"items"`
+		EstimatedTotal int                 `json:"estimated_total"`
+		RemainingCost  int                 `json:"remaining_cost"`
+	}
+	// PatientTreatmentPlans планы пациента и остаток к оплате по принятым планам
+	PatientTreatmentPlans struct {
+		Plans         []TreatmentPlan `json:"plans"`
+		RemainingCost int             `json:"remaining_cost"`
+	}
+	// TreatmentPlanItemInput новая позиция плана; не указанные этап и количество считаются равными 1
+	TreatmentPlanItemInput struct {
+		Stage     int    `json:"stage"`
+		Tooth     int    `json:"tooth"`
+		ServiceID int    `json:"service_id" binding:"required"`
+		Quantity  int    `json:"quantity"`
+		Note      string `json:"note"`
+	}
+	TreatmentPlanRequest struct {
+		PatientID int                      `json:"patient_id" binding:"required"`
+		Title     string                   `json:"title" binding:"required"`
+		Items     []TreatmentPlanItemInput `json:"items"`
+	}
+	TreatmentPlanItemsRequest struct {
+		Items []TreatmentPlanItemInput `json:"items" binding:"required"`
+	}
+	// TreatmentPlanItemStatusRequest новый статус позиции: planned, in_progress, done или cancelled
+	TreatmentPlanItemStatusRequest struct {
+		Status string `json:"status" binding:"required"`
+	}
+)
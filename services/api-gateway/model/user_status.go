@@ -0,0 +1,7 @@
+package model
+
+// UserStatusResponse статус учётной записи после изменения: active — в работе, deactivated — сотрудник
+// отключён, archived — пациент в архиве
+type UserStatusResponse struct {
+	Status string `json:"status" example:"archived"`
+}
@@ -1,8 +1,10 @@
 package model
 
 type Diagnose struct {
-	ICDCode string `json:"icd_code"`
-	Notes   string `json:"notes"`
+	ICDCode  string `json:"icd_code"`
+	Notes    string `json:"notes"`
+	Tooth    int    `json:"tooth,omitempty"`
+	Surfaces string `json:"surfaces,omitempty"`
 }
 
 type Visit struct {
@@ -25,6 +27,8 @@ type VisitSaveRequest struct {
 	Services      []VisitServiceInput  `json:"manipulations"`
 	Materials     []VisitMaterialInput `json:"materials"`
 	ICDCodes      []ICDCodeInput       `json:"icd_codes"`
+	PlanItemIDs   []int                `json:"plan_item_ids"`
+	DentalChart   []ToothStateInput    `json:"dental_chart"`
 }
 
 type VisitMaterialInput struct {
@@ -32,21 +36,29 @@ type VisitMaterialInput struct {
 	Quantity int `json:"quantity"`
 }
 
+// VisitServiceInput оказанная услуга; tooth — номер зуба по FDI, surfaces — его поверхности буквами MOIDBL
 type VisitServiceInput struct {
-	ID       int `json:"id"`
-	Quantity int `json:"quantity"`
+	ID       int    `json:"id"`
+	Quantity int    `json:"quantity"`
+	Tooth    int    `json:"tooth"`
+	Surfaces string `json:"surfaces"`
 }
 
+// ICDCodeInput диагноз по МКБ-10; tooth — номер зуба по FDI, surfaces — его поверхности буквами MOIDBL
 type ICDCodeInput struct {
-	CodeID  int    `json:"code"`
-	Comment string `json:"comment"`
+	CodeID   int    `json:"code"`
+	Comment  string `json:"comment"`
+	Tooth    int    `json:"tooth"`
+	Surfaces string `json:"surfaces"`
 }
 
 type MaterialsAndServices struct {
-	ID       int
-	VisitID  int
-	Item     string
-	Quantity int
+	ID           int
+	VisitID      int
+	Item         string
+	Quantity     int
+	PricePerUnit *int32 `json:"PricePerUnit,omitempty"` // цена на день приёма
+	TotalPrice   *int32 `json:"TotalPrice,omitempty"`
 }
 
 type VisitPayment struct {
@@ -56,10 +68,8 @@ type VisitPayment struct {
 	CreatedAt            string                 `json:"created_at"`
 	Price                int                    `json:"price"`
 	MaterialsAndServices []MaterialsAndServices `json:"materials_and_services"`
-}
-
-type VisitPaymentUpdate struct {
-	VisitID int    `json:"visit_id"`
-	Price   int    `json:"price"`
-	Status  string `json:"status"`
+	InvoiceID            int                    `json:"invoice_id"`
+	Paid                 int                    `json:"paid"`
+	Balance              int                    `json:"balance"`
+	Status               string                 `json:"status"`
 }
@@ -0,0 +1,33 @@
+package model
+
+type (
+	// WaitingListRequest заявка в лист ожидания: врач или специальность и даты в формате 2006-01-02
+	WaitingListRequest struct {
+		DoctorID         UserID `json:"doctor_id"`
+		SpecializationID int    `json:"specialization_id"`
+		DateFrom         string `json:"date_from" binding:"required"`
+		DateTo           string `json:"date_to" binding:"required"`
+	}
+	WaitingListEntry struct {
+		ID               int    `json:"id"`
+		DoctorID         UserID `json:"doctor_id"`
+		Doctor           string `json:"doctor"`
+		SpecializationID int    `json:"specialization_id"`
+		Specialty        string `json:"specialty"`
+		DateFrom         string `json:"date_from"`
+		DateTo           string `json:"date_to"`
+		Status           string `json:"status"`
+	}
+	WaitingListOffer struct {
+		ID        string `json:"id"`
+		DoctorID  UserID `json:"doctor_id"`
+		Doctor    string `json:"doctor"`
+		Date      string `json:"date"`
+		Time      string `json:"time"`
+		ExpiresAt string `json:"expires_at"`
+	}
+	WaitingList struct {
+		Entries []WaitingListEntry `json:"entries"`
+		Offers  []WaitingListOffer `json:"offers"`
+	}
+)
@@ -21,4 +21,7 @@ const (
 	PermManageMaterialsAndServices
 	PermPatientDelete
 	PermGetMaterialsAndServices
+	PermMedicalRecordExport
+	PermUserRestore
+	PermAuditLogView
 )
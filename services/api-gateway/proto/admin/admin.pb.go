@@ -537,11 +537,13 @@ func (x *AddDoctorDailyOverrideRequest) GetIsDayOff() bool {
 }
 
 type AddMaterialRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price             int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Unit              string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`                                                       // единица учёта; по умолчанию «шт»
+	LowStockThreshold int32                  `protobuf:"varint,4,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"` // 0 — без предупреждения о низком остатке
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *AddMaterialRequest) Reset() {
@@ -588,13 +590,28 @@ func (x *AddMaterialRequest) GetPrice() int32 {
 	return 0
 }
 
+func (x *AddMaterialRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *AddMaterialRequest) GetLowStockThreshold() int32 {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return 0
+}
+
 type AddServiceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
-	Type          int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price           int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Type            int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,4,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AddServiceRequest) Reset() {
@@ -648,13 +665,22 @@ func (x *AddServiceRequest) GetType() int32 {
 	return 0
 }
 
+func (x *AddServiceRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
 type UpdateMaterialRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price             int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Unit              string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	LowStockThreshold int32                  `protobuf:"varint,5,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *UpdateMaterialRequest) Reset() {
@@ -708,14 +734,29 @@ func (x *UpdateMaterialRequest) GetPrice() int32 {
 	return 0
 }
 
+func (x *UpdateMaterialRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *UpdateMaterialRequest) GetLowStockThreshold() int32 {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return 0
+}
+
 type UpdateServiceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	Type          int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price           int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Type            int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateServiceRequest) Reset() {
@@ -776,13 +817,23 @@ func (x *UpdateServiceRequest) GetType() int32 {
 	return 0
 }
 
+func (x *UpdateServiceRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
 type Material struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price             int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Unit              string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	LowStockThreshold int32                  `protobuf:"varint,5,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"`
+	StockQuantity     int32                  `protobuf:"varint,6,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Material) Reset() {
@@ -836,30 +887,55 @@ func (x *Material) GetPrice() int32 {
 	return 0
 }
 
-type Service struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	Type          int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Material) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
 }
 
-func (x *Service) Reset() {
-	*x = Service{}
+func (x *Material) GetLowStockThreshold() int32 {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return 0
+}
+
+func (x *Material) GetStockQuantity() int32 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+type MaterialBatch struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MaterialId       int32                  `protobuf:"varint,2,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	BatchNumber      string                 `protobuf:"bytes,3,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+	QuantityReceived int32                  `protobuf:"varint,4,opt,name=quantity_received,json=quantityReceived,proto3" json:"quantity_received,omitempty"`
+	QuantityLeft     int32                  `protobuf:"varint,5,opt,name=quantity_left,json=quantityLeft,proto3" json:"quantity_left,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // не задан, если у материала нет срока годности
+	ReceivedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Comment          string                 `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MaterialBatch) Reset() {
+	*x = MaterialBatch{}
 	mi := &file_proto_admin_admin_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Service) String() string {
+func (x *MaterialBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Service) ProtoMessage() {}
+func (*MaterialBatch) ProtoMessage() {}
 
-func (x *Service) ProtoReflect() protoreflect.Message {
+func (x *MaterialBatch) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -871,60 +947,95 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Service.ProtoReflect.Descriptor instead.
-func (*Service) Descriptor() ([]byte, []int) {
+// Deprecated: Use MaterialBatch.ProtoReflect.Descriptor instead.
+func (*MaterialBatch) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Service) GetId() int32 {
+func (x *MaterialBatch) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Service) GetName() string {
+func (x *MaterialBatch) GetMaterialId() int32 {
 	if x != nil {
-		return x.Name
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *MaterialBatch) GetBatchNumber() string {
+	if x != nil {
+		return x.BatchNumber
 	}
 	return ""
 }
 
-func (x *Service) GetPrice() int32 {
+func (x *MaterialBatch) GetQuantityReceived() int32 {
 	if x != nil {
-		return x.Price
+		return x.QuantityReceived
 	}
 	return 0
 }
 
-func (x *Service) GetType() int32 {
+func (x *MaterialBatch) GetQuantityLeft() int32 {
 	if x != nil {
-		return x.Type
+		return x.QuantityLeft
 	}
 	return 0
 }
 
-type DeleteRequest struct {
+func (x *MaterialBatch) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *MaterialBatch) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *MaterialBatch) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type StockMovement struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MaterialId    int32                  `protobuf:"varint,2,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	BatchId       int32                  `protobuf:"varint,3,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"` // receipt, consumption, adjustment
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VisitId       int32                  `protobuf:"varint,6,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRequest) Reset() {
-	*x = DeleteRequest{}
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
 	mi := &file_proto_admin_admin_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRequest) String() string {
+func (x *StockMovement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRequest) ProtoMessage() {}
+func (*StockMovement) ProtoMessage() {}
 
-func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -936,40 +1047,92 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *DeleteRequest) GetId() int32 {
+func (x *StockMovement) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type ServiceType struct {
+func (x *StockMovement) GetMaterialId() int32 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetBatchId() int32 {
+	if x != nil {
+		return x.BatchId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *StockMovement) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockMovement) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ReceiveMaterialBatchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MaterialId    int32                  `protobuf:"varint,1,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	BatchNumber   string                 `protobuf:"bytes,2,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServiceType) Reset() {
-	*x = ServiceType{}
+func (x *ReceiveMaterialBatchRequest) Reset() {
+	*x = ReceiveMaterialBatchRequest{}
 	mi := &file_proto_admin_admin_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServiceType) String() string {
+func (x *ReceiveMaterialBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServiceType) ProtoMessage() {}
+func (*ReceiveMaterialBatchRequest) ProtoMessage() {}
 
-func (x *ServiceType) ProtoReflect() protoreflect.Message {
+func (x *ReceiveMaterialBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -981,53 +1144,70 @@ func (x *ServiceType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServiceType.ProtoReflect.Descriptor instead.
-func (*ServiceType) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReceiveMaterialBatchRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveMaterialBatchRequest) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ServiceType) GetId() int32 {
+func (x *ReceiveMaterialBatchRequest) GetMaterialId() int32 {
 	if x != nil {
-		return x.Id
+		return x.MaterialId
 	}
 	return 0
 }
 
-func (x *ServiceType) GetName() string {
+func (x *ReceiveMaterialBatchRequest) GetBatchNumber() string {
 	if x != nil {
-		return x.Name
+		return x.BatchNumber
 	}
 	return ""
 }
 
-type Admin struct {
+func (x *ReceiveMaterialBatchRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReceiveMaterialBatchRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ReceiveMaterialBatchRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type AdjustMaterialStockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
-	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	Gender        string                 `protobuf:"bytes,7,opt,name=gender,proto3" json:"gender,omitempty"`
-	Role          string                 `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`
+	MaterialId    int32                  `protobuf:"varint,1,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	BatchId       int32                  `protobuf:"varint,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"` // 0 — уменьшение списывается по партиям с ближайшим сроком годности
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`              // со знаком: излишек при инвентаризации или списание
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Admin) Reset() {
-	*x = Admin{}
+func (x *AdjustMaterialStockRequest) Reset() {
+	*x = AdjustMaterialStockRequest{}
 	mi := &file_proto_admin_admin_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Admin) String() string {
+func (x *AdjustMaterialStockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Admin) ProtoMessage() {}
+func (*AdjustMaterialStockRequest) ProtoMessage() {}
 
-func (x *Admin) ProtoReflect() protoreflect.Message {
+func (x *AdjustMaterialStockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1039,88 +1219,62 @@ func (x *Admin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Admin.ProtoReflect.Descriptor instead.
-func (*Admin) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdjustMaterialStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustMaterialStockRequest) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Admin) GetUserId() int32 {
+func (x *AdjustMaterialStockRequest) GetMaterialId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.MaterialId
 	}
 	return 0
 }
 
-func (x *Admin) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
-}
-
-func (x *Admin) GetSecondName() string {
-	if x != nil {
-		return x.SecondName
-	}
-	return ""
-}
-
-func (x *Admin) GetSurname() string {
-	if x != nil {
-		return x.Surname
-	}
-	return ""
-}
-
-func (x *Admin) GetPhoneNumber() string {
-	if x != nil {
-		return x.PhoneNumber
-	}
-	return ""
-}
-
-func (x *Admin) GetEmail() string {
+func (x *AdjustMaterialStockRequest) GetBatchId() int32 {
 	if x != nil {
-		return x.Email
+		return x.BatchId
 	}
-	return ""
+	return 0
 }
 
-func (x *Admin) GetGender() string {
+func (x *AdjustMaterialStockRequest) GetQuantity() int32 {
 	if x != nil {
-		return x.Gender
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
-func (x *Admin) GetRole() string {
+func (x *AdjustMaterialStockRequest) GetReason() string {
 	if x != nil {
-		return x.Role
+		return x.Reason
 	}
 	return ""
 }
 
-type GetAdminsResponse struct {
+type MaterialStockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Admins        []*Admin               `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	Material      *Material              `protobuf:"bytes,1,opt,name=material,proto3" json:"material,omitempty"`
+	Batches       []*MaterialBatch       `protobuf:"bytes,2,rep,name=batches,proto3" json:"batches,omitempty"`
+	Movements     []*StockMovement       `protobuf:"bytes,3,rep,name=movements,proto3" json:"movements,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAdminsResponse) Reset() {
-	*x = GetAdminsResponse{}
+func (x *MaterialStockResponse) Reset() {
+	*x = MaterialStockResponse{}
 	mi := &file_proto_admin_admin_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAdminsResponse) String() string {
+func (x *MaterialStockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAdminsResponse) ProtoMessage() {}
+func (*MaterialStockResponse) ProtoMessage() {}
 
-func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
+func (x *MaterialStockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1132,48 +1286,53 @@ func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAdminsResponse.ProtoReflect.Descriptor instead.
-func (*GetAdminsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MaterialStockResponse.ProtoReflect.Descriptor instead.
+func (*MaterialStockResponse) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetAdminsResponse) GetAdmins() []*Admin {
+func (x *MaterialStockResponse) GetMaterial() *Material {
 	if x != nil {
-		return x.Admins
+		return x.Material
 	}
 	return nil
 }
 
-type DoctorWithSpecs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
-	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	Education     string                 `protobuf:"bytes,7,opt,name=education,proto3" json:"education,omitempty"`
-	Experience    int32                  `protobuf:"varint,8,opt,name=experience,proto3" json:"experience,omitempty"`
-	Gender        string                 `protobuf:"bytes,9,opt,name=gender,proto3" json:"gender,omitempty"`
-	Specs         []int32                `protobuf:"varint,10,rep,packed,name=specs,proto3" json:"specs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MaterialStockResponse) GetBatches() []*MaterialBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
 }
 
-func (x *DoctorWithSpecs) Reset() {
-	*x = DoctorWithSpecs{}
+func (x *MaterialStockResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+type StockReportRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ExpiringWithinDays int32                  `protobuf:"varint,1,opt,name=expiring_within_days,json=expiringWithinDays,proto3" json:"expiring_within_days,omitempty"` // 0 — 30 дней
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StockReportRequest) Reset() {
+	*x = StockReportRequest{}
 	mi := &file_proto_admin_admin_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DoctorWithSpecs) String() string {
+func (x *StockReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DoctorWithSpecs) ProtoMessage() {}
+func (*StockReportRequest) ProtoMessage() {}
 
-func (x *DoctorWithSpecs) ProtoReflect() protoreflect.Message {
+func (x *StockReportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_admin_admin_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1185,103 +1344,101 @@ func (x *DoctorWithSpecs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DoctorWithSpecs.ProtoReflect.Descriptor instead.
-func (*DoctorWithSpecs) Descriptor() ([]byte, []int) {
+// Deprecated: Use StockReportRequest.ProtoReflect.Descriptor instead.
+func (*StockReportRequest) Descriptor() ([]byte, []int) {
 	return file_proto_admin_admin_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DoctorWithSpecs) GetUserId() int32 {
+func (x *StockReportRequest) GetExpiringWithinDays() int32 {
 	if x != nil {
-		return x.UserId
+		return x.ExpiringWithinDays
 	}
 	return 0
 }
 
-func (x *DoctorWithSpecs) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+type ExpiringBatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *MaterialBatch         `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	MaterialName  string                 `protobuf:"bytes,2,opt,name=material_name,json=materialName,proto3" json:"material_name,omitempty"`
+	Unit          string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DoctorWithSpecs) GetSecondName() string {
-	if x != nil {
-		return x.SecondName
-	}
-	return ""
+func (x *ExpiringBatch) Reset() {
+	*x = ExpiringBatch{}
+	mi := &file_proto_admin_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DoctorWithSpecs) GetSurname() string {
-	if x != nil {
-		return x.Surname
-	}
-	return ""
+func (x *ExpiringBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DoctorWithSpecs) GetPhoneNumber() string {
-	if x != nil {
-		return x.PhoneNumber
-	}
-	return ""
-}
+func (*ExpiringBatch) ProtoMessage() {}
 
-func (x *DoctorWithSpecs) GetEmail() string {
+func (x *ExpiringBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[19]
 	if x != nil {
-		return x.Email
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DoctorWithSpecs) GetEducation() string {
-	if x != nil {
-		return x.Education
-	}
-	return ""
+// Deprecated: Use ExpiringBatch.ProtoReflect.Descriptor instead.
+func (*ExpiringBatch) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DoctorWithSpecs) GetExperience() int32 {
+func (x *ExpiringBatch) GetBatch() *MaterialBatch {
 	if x != nil {
-		return x.Experience
+		return x.Batch
 	}
-	return 0
+	return nil
 }
 
-func (x *DoctorWithSpecs) GetGender() string {
+func (x *ExpiringBatch) GetMaterialName() string {
 	if x != nil {
-		return x.Gender
+		return x.MaterialName
 	}
 	return ""
 }
 
-func (x *DoctorWithSpecs) GetSpecs() []int32 {
+func (x *ExpiringBatch) GetUnit() string {
 	if x != nil {
-		return x.Specs
+		return x.Unit
 	}
-	return nil
+	return ""
 }
 
-type GetDoctorsResponse struct {
+type StockReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Doctors       []*DoctorWithSpecs     `protobuf:"bytes,1,rep,name=doctors,proto3" json:"doctors,omitempty"`
+	LowStock      []*Material            `protobuf:"bytes,1,rep,name=low_stock,json=lowStock,proto3" json:"low_stock,omitempty"`
+	Expiring      []*ExpiringBatch       `protobuf:"bytes,2,rep,name=expiring,proto3" json:"expiring,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDoctorsResponse) Reset() {
-	*x = GetDoctorsResponse{}
-	mi := &file_proto_admin_admin_proto_msgTypes[19]
+func (x *StockReportResponse) Reset() {
+	*x = StockReportResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDoctorsResponse) String() string {
+func (x *StockReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDoctorsResponse) ProtoMessage() {}
+func (*StockReportResponse) ProtoMessage() {}
 
-func (x *GetDoctorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[19]
+func (x *StockReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,49 +1449,61 @@ func (x *GetDoctorsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDoctorsResponse.ProtoReflect.Descriptor instead.
-func (*GetDoctorsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use StockReportResponse.ProtoReflect.Descriptor instead.
+func (*StockReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetDoctorsResponse) GetDoctors() []*DoctorWithSpecs {
+func (x *StockReportResponse) GetLowStock() []*Material {
 	if x != nil {
-		return x.Doctors
+		return x.LowStock
 	}
 	return nil
 }
 
-type UpdateDoctorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
-	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	Education     string                 `protobuf:"bytes,7,opt,name=education,proto3" json:"education,omitempty"`
-	Experience    int32                  `protobuf:"varint,8,opt,name=experience,proto3" json:"experience,omitempty"`
-	Gender        string                 `protobuf:"bytes,9,opt,name=gender,proto3" json:"gender,omitempty"`
-	Specs         []int32                `protobuf:"varint,10,rep,packed,name=specs,proto3" json:"specs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StockReportResponse) GetExpiring() []*ExpiringBatch {
+	if x != nil {
+		return x.Expiring
+	}
+	return nil
 }
 
-func (x *UpdateDoctorRequest) Reset() {
-	*x = UpdateDoctorRequest{}
-	mi := &file_proto_admin_admin_proto_msgTypes[20]
+// Правило клинического предупреждения. condition_type — allergy (аллергия содержит condition_value),
+// condition (заболевание или препарат содержит condition_value) или anamnesis_flag (отмечен пункт анкеты
+// condition_value). target_type — visit (при открытии приёма), material или service (при выборе материала или услуги)
+type ClinicalAlertRule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ConditionType  string                 `protobuf:"bytes,3,opt,name=condition_type,json=conditionType,proto3" json:"condition_type,omitempty"`
+	ConditionValue string                 `protobuf:"bytes,4,opt,name=condition_value,json=conditionValue,proto3" json:"condition_value,omitempty"`
+	TargetType     string                 `protobuf:"bytes,5,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	MaterialId     int32                  `protobuf:"varint,6,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	ServiceId      int32                  `protobuf:"varint,7,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	TargetName     string                 `protobuf:"bytes,8,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	Severity       string                 `protobuf:"bytes,9,opt,name=severity,proto3" json:"severity,omitempty"` // info, warning, critical
+	Message        string                 `protobuf:"bytes,10,opt,name=message,proto3" json:"message,omitempty"`
+	IsActive       bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClinicalAlertRule) Reset() {
+	*x = ClinicalAlertRule{}
+	mi := &file_proto_admin_admin_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDoctorRequest) String() string {
+func (x *ClinicalAlertRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDoctorRequest) ProtoMessage() {}
+func (*ClinicalAlertRule) ProtoMessage() {}
 
-func (x *UpdateDoctorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[20]
+func (x *ClinicalAlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1345,110 +1514,117 @@ func (x *UpdateDoctorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDoctorRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDoctorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ClinicalAlertRule.ProtoReflect.Descriptor instead.
+func (*ClinicalAlertRule) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpdateDoctorRequest) GetUserId() int32 {
+func (x *ClinicalAlertRule) GetId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateDoctorRequest) GetFirstName() string {
+func (x *ClinicalAlertRule) GetTitle() string {
 	if x != nil {
-		return x.FirstName
+		return x.Title
 	}
 	return ""
 }
 
-func (x *UpdateDoctorRequest) GetSecondName() string {
+func (x *ClinicalAlertRule) GetConditionType() string {
 	if x != nil {
-		return x.SecondName
+		return x.ConditionType
 	}
 	return ""
 }
 
-func (x *UpdateDoctorRequest) GetSurname() string {
+func (x *ClinicalAlertRule) GetConditionValue() string {
 	if x != nil {
-		return x.Surname
+		return x.ConditionValue
 	}
 	return ""
 }
 
-func (x *UpdateDoctorRequest) GetPhoneNumber() string {
+func (x *ClinicalAlertRule) GetTargetType() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.TargetType
 	}
 	return ""
 }
 
-func (x *UpdateDoctorRequest) GetEmail() string {
+func (x *ClinicalAlertRule) GetMaterialId() int32 {
 	if x != nil {
-		return x.Email
+		return x.MaterialId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateDoctorRequest) GetEducation() string {
+func (x *ClinicalAlertRule) GetServiceId() int32 {
 	if x != nil {
-		return x.Education
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *ClinicalAlertRule) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
 	}
 	return ""
 }
 
-func (x *UpdateDoctorRequest) GetExperience() int32 {
+func (x *ClinicalAlertRule) GetSeverity() string {
 	if x != nil {
-		return x.Experience
+		return x.Severity
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateDoctorRequest) GetGender() string {
+func (x *ClinicalAlertRule) GetMessage() string {
 	if x != nil {
-		return x.Gender
+		return x.Message
 	}
 	return ""
 }
 
-func (x *UpdateDoctorRequest) GetSpecs() []int32 {
+func (x *ClinicalAlertRule) GetIsActive() bool {
 	if x != nil {
-		return x.Specs
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ClinicalAlertRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type UpdateAdminRequest struct {
+type GetClinicalAlertRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
-	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	Gender        string                 `protobuf:"bytes,7,opt,name=gender,proto3" json:"gender,omitempty"`
-	Role          string                 `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`
+	Rules         []*ClinicalAlertRule   `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAdminRequest) Reset() {
-	*x = UpdateAdminRequest{}
-	mi := &file_proto_admin_admin_proto_msgTypes[21]
+func (x *GetClinicalAlertRulesResponse) Reset() {
+	*x = GetClinicalAlertRulesResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAdminRequest) String() string {
+func (x *GetClinicalAlertRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAdminRequest) ProtoMessage() {}
+func (*GetClinicalAlertRulesResponse) ProtoMessage() {}
 
-func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[21]
+func (x *GetClinicalAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,96 +1635,94 @@ func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAdminRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetClinicalAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetClinicalAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateAdminRequest) GetUserId() int32 {
+func (x *GetClinicalAlertRulesResponse) GetRules() []*ClinicalAlertRule {
 	if x != nil {
-		return x.UserId
+		return x.Rules
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateAdminRequest) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+type AddClinicalAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAdminRequest) GetSecondName() string {
-	if x != nil {
-		return x.SecondName
-	}
-	return ""
+func (x *AddClinicalAlertRuleResponse) Reset() {
+	*x = AddClinicalAlertRuleResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAdminRequest) GetSurname() string {
-	if x != nil {
-		return x.Surname
-	}
-	return ""
+func (x *AddClinicalAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateAdminRequest) GetPhoneNumber() string {
-	if x != nil {
-		return x.PhoneNumber
-	}
-	return ""
-}
+func (*AddClinicalAlertRuleResponse) ProtoMessage() {}
 
-func (x *UpdateAdminRequest) GetEmail() string {
+func (x *AddClinicalAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[23]
 	if x != nil {
-		return x.Email
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateAdminRequest) GetGender() string {
-	if x != nil {
-		return x.Gender
-	}
-	return ""
+// Deprecated: Use AddClinicalAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*AddClinicalAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpdateAdminRequest) GetRole() string {
+func (x *AddClinicalAlertRuleResponse) GetId() int32 {
 	if x != nil {
-		return x.Role
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type UpdatePatientRequest struct {
+// Допуск врача к медицинской карте пациента, которого он не лечит: консультация коллеги, второе мнение.
+// expires_at не задан — бессрочный допуск; revoked_at задан у отозванных
+type PatientAccessGrant struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
-	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
-	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	BirthDate     string                 `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Gender        string                 `protobuf:"bytes,8,opt,name=gender,proto3" json:"gender,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Doctor        string                 `protobuf:"bytes,4,opt,name=doctor,proto3" json:"doctor,omitempty"` // ФИО врача
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	GrantedBy     int32                  `protobuf:"varint,6,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePatientRequest) Reset() {
-	*x = UpdatePatientRequest{}
-	mi := &file_proto_admin_admin_proto_msgTypes[22]
+func (x *PatientAccessGrant) Reset() {
+	*x = PatientAccessGrant{}
+	mi := &file_proto_admin_admin_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePatientRequest) String() string {
+func (x *PatientAccessGrant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePatientRequest) ProtoMessage() {}
+func (*PatientAccessGrant) ProtoMessage() {}
 
-func (x *UpdatePatientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[22]
+func (x *PatientAccessGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,96 +1733,96 @@ func (x *UpdatePatientRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePatientRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePatientRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use PatientAccessGrant.ProtoReflect.Descriptor instead.
+func (*PatientAccessGrant) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpdatePatientRequest) GetUserId() int32 {
+func (x *PatientAccessGrant) GetId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdatePatientRequest) GetFirstName() string {
+func (x *PatientAccessGrant) GetPatientId() int32 {
 	if x != nil {
-		return x.FirstName
+		return x.PatientId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdatePatientRequest) GetSurname() string {
+func (x *PatientAccessGrant) GetDoctorId() int32 {
 	if x != nil {
-		return x.Surname
+		return x.DoctorId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdatePatientRequest) GetSecondName() string {
+func (x *PatientAccessGrant) GetDoctor() string {
 	if x != nil {
-		return x.SecondName
+		return x.Doctor
 	}
 	return ""
 }
 
-func (x *UpdatePatientRequest) GetEmail() string {
+func (x *PatientAccessGrant) GetReason() string {
 	if x != nil {
-		return x.Email
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *UpdatePatientRequest) GetBirthDate() string {
+func (x *PatientAccessGrant) GetGrantedBy() int32 {
 	if x != nil {
-		return x.BirthDate
+		return x.GrantedBy
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdatePatientRequest) GetPhoneNumber() string {
+func (x *PatientAccessGrant) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PhoneNumber
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdatePatientRequest) GetGender() string {
+func (x *PatientAccessGrant) GetRevokedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Gender
+		return x.RevokedAt
 	}
-	return ""
+	return nil
 }
 
-type Patient struct {
+func (x *PatientAccessGrant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetPatientAccessGrantsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
-	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
-	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	BirthDate     string                 `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Gender        string                 `protobuf:"bytes,8,opt,name=gender,proto3" json:"gender,omitempty"`
+	Grants        []*PatientAccessGrant  `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Patient) Reset() {
-	*x = Patient{}
-	mi := &file_proto_admin_admin_proto_msgTypes[23]
+func (x *GetPatientAccessGrantsResponse) Reset() {
+	*x = GetPatientAccessGrantsResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Patient) String() string {
+func (x *GetPatientAccessGrantsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Patient) ProtoMessage() {}
+func (*GetPatientAccessGrantsResponse) ProtoMessage() {}
 
-func (x *Patient) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[23]
+func (x *GetPatientAccessGrantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,89 +1833,3313 @@ func (x *Patient) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Patient.ProtoReflect.Descriptor instead.
-func (*Patient) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetPatientAccessGrantsResponse.ProtoReflect.Descriptor instead.
+func (*GetPatientAccessGrantsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Patient) GetUserId() int32 {
+func (x *GetPatientAccessGrantsResponse) GetGrants() []*PatientAccessGrant {
 	if x != nil {
-		return x.UserId
+		return x.Grants
+	}
+	return nil
+}
+
+type AddPatientAccessGrantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPatientAccessGrantResponse) Reset() {
+	*x = AddPatientAccessGrantResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPatientAccessGrantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPatientAccessGrantResponse) ProtoMessage() {}
+
+func (x *AddPatientAccessGrantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPatientAccessGrantResponse.ProtoReflect.Descriptor instead.
+func (*AddPatientAccessGrantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddPatientAccessGrantResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Patient) GetFirstName() string {
+type Service struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price           int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Type            int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Service) Reset() {
+	*x = Service{}
+	mi := &file_proto_admin_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Service) ProtoMessage() {}
+
+func (x *Service) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[27]
 	if x != nil {
-		return x.FirstName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Patient) GetSurname() string {
+// Deprecated: Use Service.ProtoReflect.Descriptor instead.
+func (*Service) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Service) GetId() int32 {
 	if x != nil {
-		return x.Surname
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Patient) GetSecondName() string {
+func (x *Service) GetName() string {
 	if x != nil {
-		return x.SecondName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Patient) GetEmail() string {
+func (x *Service) GetPrice() int32 {
 	if x != nil {
-		return x.Email
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-func (x *Patient) GetBirthDate() string {
+func (x *Service) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Service) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+type DeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRequest) Reset() {
+	*x = DeleteRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRequest) ProtoMessage() {}
+
+func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ChangeUserStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChangedBy     int32                  `protobuf:"varint,2,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"` // администратор, который меняет статус
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserStatusRequest) Reset() {
+	*x = ChangeUserStatusRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserStatusRequest) ProtoMessage() {}
+
+func (x *ChangeUserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChangeUserStatusRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangeUserStatusRequest) GetChangedBy() int32 {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return 0
+}
+
+type ChangeUserStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // active, deactivated (сотрудник отключён) или archived (пациент в архиве)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserStatusResponse) Reset() {
+	*x = ChangeUserStatusResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserStatusResponse) ProtoMessage() {}
+
+func (x *ChangeUserStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangeUserStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ChangeUserStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ServiceType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceType) Reset() {
+	*x = ServiceType{}
+	mi := &file_proto_admin_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceType) ProtoMessage() {}
+
+func (x *ServiceType) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceType.ProtoReflect.Descriptor instead.
+func (*ServiceType) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ServiceType) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ServiceType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Admin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
+	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Gender        string                 `protobuf:"bytes,7,opt,name=gender,proto3" json:"gender,omitempty"`
+	Role          string                 `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Admin) Reset() {
+	*x = Admin{}
+	mi := &file_proto_admin_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin) ProtoMessage() {}
+
+func (x *Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin.ProtoReflect.Descriptor instead.
+func (*Admin) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Admin) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Admin) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Admin) GetSecondName() string {
+	if x != nil {
+		return x.SecondName
+	}
+	return ""
+}
+
+func (x *Admin) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *Admin) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Admin) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Admin) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *Admin) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// Страница списка: limit = 0 — список целиком; sort — поле сортировки из допустимых для метода,
+// пусто — сортировка метода по умолчанию
+type ListPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Desc          bool                   `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPage) Reset() {
+	*x = ListPage{}
+	mi := &file_proto_admin_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPage) ProtoMessage() {}
+
+func (x *ListPage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPage.ProtoReflect.Descriptor instead.
+func (*ListPage) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPage) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPage) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPage) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListPage) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+// sort: name (по умолчанию)
+type GetAdminsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // поиск по началам слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только отключённые, для восстановления; без него — только работающие
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdminsRequest) Reset() {
+	*x = GetAdminsRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminsRequest) ProtoMessage() {}
+
+func (x *GetAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetAdminsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetAdminsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetAdminsRequest) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
+type GetAdminsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admins        []*Admin               `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных администраторов без учёта страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdminsResponse) Reset() {
+	*x = GetAdminsResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminsResponse) ProtoMessage() {}
+
+func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminsResponse.ProtoReflect.Descriptor instead.
+func (*GetAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetAdminsResponse) GetAdmins() []*Admin {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+func (x *GetAdminsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DoctorWithSpecs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
+	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Education     string                 `protobuf:"bytes,7,opt,name=education,proto3" json:"education,omitempty"`
+	Experience    int32                  `protobuf:"varint,8,opt,name=experience,proto3" json:"experience,omitempty"`
+	Gender        string                 `protobuf:"bytes,9,opt,name=gender,proto3" json:"gender,omitempty"`
+	Specs         []int32                `protobuf:"varint,10,rep,packed,name=specs,proto3" json:"specs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoctorWithSpecs) Reset() {
+	*x = DoctorWithSpecs{}
+	mi := &file_proto_admin_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoctorWithSpecs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoctorWithSpecs) ProtoMessage() {}
+
+func (x *DoctorWithSpecs) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoctorWithSpecs.ProtoReflect.Descriptor instead.
+func (*DoctorWithSpecs) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DoctorWithSpecs) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DoctorWithSpecs) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *DoctorWithSpecs) GetSecondName() string {
+	if x != nil {
+		return x.SecondName
+	}
+	return ""
+}
+
+func (x *DoctorWithSpecs) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *DoctorWithSpecs) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *DoctorWithSpecs) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *DoctorWithSpecs) GetEducation() string {
+	if x != nil {
+		return x.Education
+	}
+	return ""
+}
+
+func (x *DoctorWithSpecs) GetExperience() int32 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+func (x *DoctorWithSpecs) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *DoctorWithSpecs) GetSpecs() []int32 {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+// sort: name (по умолчанию), experience
+type GetDoctorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // поиск по началам слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только отключённые, для восстановления; без него — только работающие
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoctorsRequest) Reset() {
+	*x = GetDoctorsRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoctorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoctorsRequest) ProtoMessage() {}
+
+func (x *GetDoctorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoctorsRequest.ProtoReflect.Descriptor instead.
+func (*GetDoctorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetDoctorsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetDoctorsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetDoctorsRequest) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
+type GetDoctorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Doctors       []*DoctorWithSpecs     `protobuf:"bytes,1,rep,name=doctors,proto3" json:"doctors,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных врачей без учёта страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoctorsResponse) Reset() {
+	*x = GetDoctorsResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoctorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoctorsResponse) ProtoMessage() {}
+
+func (x *GetDoctorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoctorsResponse.ProtoReflect.Descriptor instead.
+func (*GetDoctorsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetDoctorsResponse) GetDoctors() []*DoctorWithSpecs {
+	if x != nil {
+		return x.Doctors
+	}
+	return nil
+}
+
+func (x *GetDoctorsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type UpdateDoctorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
+	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Education     string                 `protobuf:"bytes,7,opt,name=education,proto3" json:"education,omitempty"`
+	Experience    int32                  `protobuf:"varint,8,opt,name=experience,proto3" json:"experience,omitempty"`
+	Gender        string                 `protobuf:"bytes,9,opt,name=gender,proto3" json:"gender,omitempty"`
+	Specs         []int32                `protobuf:"varint,10,rep,packed,name=specs,proto3" json:"specs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDoctorRequest) Reset() {
+	*x = UpdateDoctorRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDoctorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDoctorRequest) ProtoMessage() {}
+
+func (x *UpdateDoctorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDoctorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDoctorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateDoctorRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateDoctorRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetSecondName() string {
+	if x != nil {
+		return x.SecondName
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetEducation() string {
+	if x != nil {
+		return x.Education
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetExperience() int32 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+func (x *UpdateDoctorRequest) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *UpdateDoctorRequest) GetSpecs() []int32 {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+type UpdateAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
+	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Gender        string                 `protobuf:"bytes,7,opt,name=gender,proto3" json:"gender,omitempty"`
+	Role          string                 `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAdminRequest) Reset() {
+	*x = UpdateAdminRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAdminRequest) ProtoMessage() {}
+
+func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAdminRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateAdminRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateAdminRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetSecondName() string {
+	if x != nil {
+		return x.SecondName
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *UpdateAdminRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdatePatientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	BirthDate     string                 `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Gender        string                 `protobuf:"bytes,8,opt,name=gender,proto3" json:"gender,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePatientRequest) Reset() {
+	*x = UpdatePatientRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePatientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePatientRequest) ProtoMessage() {}
+
+func (x *UpdatePatientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePatientRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePatientRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdatePatientRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdatePatientRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetSecondName() string {
+	if x != nil {
+		return x.SecondName
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+type Patient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	BirthDate     string                 `protobuf:"bytes,6,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,7,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Gender        string                 `protobuf:"bytes,8,opt,name=gender,proto3" json:"gender,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Patient) Reset() {
+	*x = Patient{}
+	mi := &file_proto_admin_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Patient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Patient) ProtoMessage() {}
+
+func (x *Patient) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Patient.ProtoReflect.Descriptor instead.
+func (*Patient) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Patient) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Patient) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Patient) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *Patient) GetSecondName() string {
+	if x != nil {
+		return x.SecondName
+	}
+	return ""
+}
+
+func (x *Patient) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Patient) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *Patient) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Patient) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+// sort: name (по умолчанию), birth_date
+type GetPatientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // дата рождения (02.01.2006 или 2006-01-02), номер телефона или начала слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только архивные, для восстановления; без него — только пациенты в работе
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientsRequest) Reset() {
+	*x = GetPatientsRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientsRequest) ProtoMessage() {}
+
+func (x *GetPatientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientsRequest.ProtoReflect.Descriptor instead.
+func (*GetPatientsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetPatientsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetPatientsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetPatientsRequest) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
+type GetPatientsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patients      []*Patient             `protobuf:"bytes,1,rep,name=patients,proto3" json:"patients,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных пациентов без учёта страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientsResponse) Reset() {
+	*x = GetPatientsResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientsResponse) ProtoMessage() {}
+
+func (x *GetPatientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientsResponse.ProtoReflect.Descriptor instead.
+func (*GetPatientsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetPatientsResponse) GetPatients() []*Patient {
+	if x != nil {
+		return x.Patients
+	}
+	return nil
+}
+
+func (x *GetPatientsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type EmptyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyRequest) Reset() {
+	*x = EmptyRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyRequest) ProtoMessage() {}
+
+func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
+func (*EmptyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{45}
+}
+
+type Spec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spec) Reset() {
+	*x = Spec{}
+	mi := &file_proto_admin_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spec) ProtoMessage() {}
+
+func (x *Spec) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spec.ProtoReflect.Descriptor instead.
+func (*Spec) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Spec) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Spec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetSpecsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Specs         []*Spec                `protobuf:"bytes,1,rep,name=specs,proto3" json:"specs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpecsResponse) Reset() {
+	*x = GetSpecsResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpecsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpecsResponse) ProtoMessage() {}
+
+func (x *GetSpecsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpecsResponse.ProtoReflect.Descriptor instead.
+func (*GetSpecsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSpecsResponse) GetSpecs() []*Spec {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+type UpdateUserLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserLoginRequest) Reset() {
+	*x = UpdateUserLoginRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserLoginRequest) ProtoMessage() {}
+
+func (x *UpdateUserLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserLoginRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserLoginRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateUserLoginRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateUserLoginRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+type UnconfirmedVisitPayment struct {
+	state                protoimpl.MessageState          `protogen:"open.v1"`
+	VisitId              int32                           `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	Doctor               string                          `protobuf:"bytes,2,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	Patient              string                          `protobuf:"bytes,3,opt,name=patient,proto3" json:"patient,omitempty"`
+	CreatedAt            string                          `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Price                int32                           `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"` // сумма счёта с учётом скидки
+	MaterialsAndServices []*GetVisitMaterialsAndServices `protobuf:"bytes,6,rep,name=materials_and_services,json=materialsAndServices,proto3" json:"materials_and_services,omitempty"`
+	InvoiceId            int32                           `protobuf:"varint,7,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Paid                 int32                           `protobuf:"varint,8,opt,name=paid,proto3" json:"paid,omitempty"`
+	Balance              int32                           `protobuf:"varint,9,opt,name=balance,proto3" json:"balance,omitempty"`
+	Status               string                          `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"` // статус счёта
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UnconfirmedVisitPayment) Reset() {
+	*x = UnconfirmedVisitPayment{}
+	mi := &file_proto_admin_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnconfirmedVisitPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnconfirmedVisitPayment) ProtoMessage() {}
+
+func (x *UnconfirmedVisitPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnconfirmedVisitPayment.ProtoReflect.Descriptor instead.
+func (*UnconfirmedVisitPayment) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UnconfirmedVisitPayment) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *UnconfirmedVisitPayment) GetDoctor() string {
+	if x != nil {
+		return x.Doctor
+	}
+	return ""
+}
+
+func (x *UnconfirmedVisitPayment) GetPatient() string {
+	if x != nil {
+		return x.Patient
+	}
+	return ""
+}
+
+func (x *UnconfirmedVisitPayment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UnconfirmedVisitPayment) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UnconfirmedVisitPayment) GetMaterialsAndServices() []*GetVisitMaterialsAndServices {
+	if x != nil {
+		return x.MaterialsAndServices
+	}
+	return nil
+}
+
+func (x *UnconfirmedVisitPayment) GetInvoiceId() int32 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *UnconfirmedVisitPayment) GetPaid() int32 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *UnconfirmedVisitPayment) GetBalance() int32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *UnconfirmedVisitPayment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// sort: visit_id (по умолчанию)
+type GetUnconfirmedVisitPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnconfirmedVisitPaymentsRequest) Reset() {
+	*x = GetUnconfirmedVisitPaymentsRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnconfirmedVisitPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnconfirmedVisitPaymentsRequest) ProtoMessage() {}
+
+func (x *GetUnconfirmedVisitPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnconfirmedVisitPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*GetUnconfirmedVisitPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetUnconfirmedVisitPaymentsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type UnconfirmedVisitPaymentsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	VisitPayments []*UnconfirmedVisitPayment `protobuf:"bytes,1,rep,name=visit_payments,json=visitPayments,proto3" json:"visit_payments,omitempty"`
+	Total         int32                      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех неподтверждённых оплат без учёта страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnconfirmedVisitPaymentsResponse) Reset() {
+	*x = UnconfirmedVisitPaymentsResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnconfirmedVisitPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnconfirmedVisitPaymentsResponse) ProtoMessage() {}
+
+func (x *UnconfirmedVisitPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnconfirmedVisitPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*UnconfirmedVisitPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UnconfirmedVisitPaymentsResponse) GetVisitPayments() []*UnconfirmedVisitPayment {
+	if x != nil {
+		return x.VisitPayments
+	}
+	return nil
+}
+
+func (x *UnconfirmedVisitPaymentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AdminScheduleOverview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*ScheduleDay         `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	TimeSlots     []string               `protobuf:"bytes,2,rep,name=time_slots,json=timeSlots,proto3" json:"time_slots,omitempty"`
+	Appointments  []*AppointmentEntry    `protobuf:"bytes,3,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminScheduleOverview) Reset() {
+	*x = AdminScheduleOverview{}
+	mi := &file_proto_admin_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminScheduleOverview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminScheduleOverview) ProtoMessage() {}
+
+func (x *AdminScheduleOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminScheduleOverview.ProtoReflect.Descriptor instead.
+func (*AdminScheduleOverview) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AdminScheduleOverview) GetDays() []*ScheduleDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *AdminScheduleOverview) GetTimeSlots() []string {
+	if x != nil {
+		return x.TimeSlots
+	}
+	return nil
+}
+
+func (x *AdminScheduleOverview) GetAppointments() []*AppointmentEntry {
+	if x != nil {
+		return x.Appointments
+	}
+	return nil
+}
+
+type ScheduleDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`       // формат: "02.01.2006"
+	Weekday       string                 `protobuf:"bytes,2,opt,name=weekday,proto3" json:"weekday,omitempty"` // "Пн", "Вт", и т.д.
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleDay) Reset() {
+	*x = ScheduleDay{}
+	mi := &file_proto_admin_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleDay) ProtoMessage() {}
+
+func (x *ScheduleDay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleDay.ProtoReflect.Descriptor instead.
+func (*ScheduleDay) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ScheduleDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ScheduleDay) GetWeekday() string {
+	if x != nil {
+		return x.Weekday
+	}
+	return ""
+}
+
+type AppointmentEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // "02.01.2006"
+	Time          string                 `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"` // "15:00"
+	Doctor        *Person                `protobuf:"bytes,4,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	Patient       *Person                `protobuf:"bytes,5,opt,name=patient,proto3" json:"patient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppointmentEntry) Reset() {
+	*x = AppointmentEntry{}
+	mi := &file_proto_admin_admin_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentEntry) ProtoMessage() {}
+
+func (x *AppointmentEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentEntry.ProtoReflect.Descriptor instead.
+func (*AppointmentEntry) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AppointmentEntry) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AppointmentEntry) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AppointmentEntry) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *AppointmentEntry) GetDoctor() *Person {
+	if x != nil {
+		return x.Doctor
+	}
+	return nil
+}
+
+func (x *AppointmentEntry) GetPatient() *Person {
+	if x != nil {
+		return x.Patient
+	}
+	return nil
+}
+
+type Appointment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Doctor        string                 `protobuf:"bytes,3,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Time          string                 `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	FirstName     string                 `protobuf:"bytes,6,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	SecondName    string                 `protobuf:"bytes,7,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
+	Surname       string                 `protobuf:"bytes,8,opt,name=surname,proto3" json:"surname,omitempty"`
+	BirthDate     string                 `protobuf:"bytes,9,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	Gender        string                 `protobuf:"bytes,10,opt,name=gender,proto3" json:"gender,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,11,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Appointment) Reset() {
+	*x = Appointment{}
+	mi := &file_proto_admin_admin_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Appointment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Appointment) ProtoMessage() {}
+
+func (x *Appointment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Appointment.ProtoReflect.Descriptor instead.
+func (*Appointment) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Appointment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Appointment) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *Appointment) GetDoctor() string {
+	if x != nil {
+		return x.Doctor
+	}
+	return ""
+}
+
+func (x *Appointment) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Appointment) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *Appointment) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Appointment) GetSecondName() string {
+	if x != nil {
+		return x.SecondName
+	}
+	return ""
+}
+
+func (x *Appointment) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *Appointment) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *Appointment) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *Appointment) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Appointment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Appointment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Appointment) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// sort: date (по умолчанию), created_at. Границы периода дат приёма включительно, пустые — без границы
+type GetUnconfirmedAppointmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnconfirmedAppointmentsRequest) Reset() {
+	*x = GetUnconfirmedAppointmentsRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnconfirmedAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnconfirmedAppointmentsRequest) ProtoMessage() {}
+
+func (x *GetUnconfirmedAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnconfirmedAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetUnconfirmedAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetUnconfirmedAppointmentsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetUnconfirmedAppointmentsRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *GetUnconfirmedAppointmentsRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+type GetUnconfirmedAppointmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех отобранных записей без учёта страницы
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnconfirmedAppointmentResponse) Reset() {
+	*x = GetUnconfirmedAppointmentResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnconfirmedAppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnconfirmedAppointmentResponse) ProtoMessage() {}
+
+func (x *GetUnconfirmedAppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnconfirmedAppointmentResponse.ProtoReflect.Descriptor instead.
+func (*GetUnconfirmedAppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetUnconfirmedAppointmentResponse) GetAppointments() []*Appointment {
+	if x != nil {
+		return x.Appointments
+	}
+	return nil
+}
+
+func (x *GetUnconfirmedAppointmentResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type Person struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
+	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
+	BirthDate     string                 `protobuf:"bytes,5,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
+	Gender        string                 `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender,omitempty"`
+	Phone         string                 `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
+	Specialty     string                 `protobuf:"bytes,8,opt,name=specialty,proto3" json:"specialty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Person) Reset() {
+	*x = Person{}
+	mi := &file_proto_admin_admin_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Person) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person) ProtoMessage() {}
+
+func (x *Person) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *Person) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Person) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Person) GetSecondName() string {
+	if x != nil {
+		return x.SecondName
+	}
+	return ""
+}
+
+func (x *Person) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *Person) GetBirthDate() string {
+	if x != nil {
+		return x.BirthDate
+	}
+	return ""
+}
+
+func (x *Person) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *Person) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Person) GetSpecialty() string {
+	if x != nil {
+		return x.Specialty
+	}
+	return ""
+}
+
+// Счёт на приём; paid — внесено за вычетом возвратов, balance — остаток к оплате
+type Invoice struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VisitId        int32                  `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	PatientId      int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Subtotal       int32                  `protobuf:"varint,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DiscountKind   string                 `protobuf:"bytes,5,opt,name=discount_kind,json=discountKind,proto3" json:"discount_kind,omitempty"` // percent, fixed; пусто — без скидки
+	DiscountValue  int32                  `protobuf:"varint,6,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	DiscountReason string                 `protobuf:"bytes,7,opt,name=discount_reason,json=discountReason,proto3" json:"discount_reason,omitempty"` // loyalty, staff, promo
+	DiscountAmount int32                  `protobuf:"varint,8,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"`
+	Total          int32                  `protobuf:"varint,9,opt,name=total,proto3" json:"total,omitempty"`
+	Paid           int32                  `protobuf:"varint,10,opt,name=paid,proto3" json:"paid,omitempty"`
+	Balance        int32                  `protobuf:"varint,11,opt,name=balance,proto3" json:"balance,omitempty"`
+	Status         string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"` // issued, partially_paid, paid, refunded
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Lines          []*InvoiceLine         `protobuf:"bytes,14,rep,name=lines,proto3" json:"lines,omitempty"`
+	Payments       []*InvoicePayment      `protobuf:"bytes,15,rep,name=payments,proto3" json:"payments,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	mi := &file_proto_admin_admin_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Invoice) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Invoice) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *Invoice) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *Invoice) GetSubtotal() int32 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *Invoice) GetDiscountKind() string {
+	if x != nil {
+		return x.DiscountKind
+	}
+	return ""
+}
+
+func (x *Invoice) GetDiscountValue() int32 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *Invoice) GetDiscountReason() string {
+	if x != nil {
+		return x.DiscountReason
+	}
+	return ""
+}
+
+func (x *Invoice) GetDiscountAmount() int32 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *Invoice) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Invoice) GetPaid() int32 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *Invoice) GetBalance() int32 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Invoice) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invoice) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invoice) GetLines() []*InvoiceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Invoice) GetPayments() []*InvoicePayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type InvoiceLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // service, material, adjustment
+	ItemId        int32                  `protobuf:"varint,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PricePerUnit  int32                  `protobuf:"varint,6,opt,name=price_per_unit,json=pricePerUnit,proto3" json:"price_per_unit,omitempty"`
+	TotalPrice    int32                  `protobuf:"varint,7,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceLine) Reset() {
+	*x = InvoiceLine{}
+	mi := &file_proto_admin_admin_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLine) ProtoMessage() {}
+
+func (x *InvoiceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLine.ProtoReflect.Descriptor instead.
+func (*InvoiceLine) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *InvoiceLine) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetPricePerUnit() int32 {
+	if x != nil {
+		return x.PricePerUnit
+	}
+	return 0
+}
+
+func (x *InvoiceLine) GetTotalPrice() int32 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+type InvoicePayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // payment, refund
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"` // cash, card, transfer; unknown — оплата до введения счетов
+	RefundOf      int32                  `protobuf:"varint,5,opt,name=refund_of,json=refundOf,proto3" json:"refund_of,omitempty"`
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoicePayment) Reset() {
+	*x = InvoicePayment{}
+	mi := &file_proto_admin_admin_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoicePayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoicePayment) ProtoMessage() {}
+
+func (x *InvoicePayment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoicePayment.ProtoReflect.Descriptor instead.
+func (*InvoicePayment) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *InvoicePayment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InvoicePayment) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *InvoicePayment) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *InvoicePayment) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *InvoicePayment) GetRefundOf() int32 {
+	if x != nil {
+		return x.RefundOf
+	}
+	return 0
+}
+
+func (x *InvoicePayment) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *InvoicePayment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type InvoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invoice       *Invoice               `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvoiceResponse) Reset() {
+	*x = InvoiceResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceResponse) ProtoMessage() {}
+
+func (x *InvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceResponse.ProtoReflect.Descriptor instead.
+func (*InvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *InvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+type SetInvoiceDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     int32                  `protobuf:"varint,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // пусто — снять скидку
+	Value         int32                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetInvoiceDiscountRequest) Reset() {
+	*x = SetInvoiceDiscountRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInvoiceDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInvoiceDiscountRequest) ProtoMessage() {}
+
+func (x *SetInvoiceDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInvoiceDiscountRequest.ProtoReflect.Descriptor instead.
+func (*SetInvoiceDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetInvoiceDiscountRequest) GetInvoiceId() int32 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *SetInvoiceDiscountRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SetInvoiceDiscountRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *SetInvoiceDiscountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AddInvoicePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvoiceId     int32                  `protobuf:"varint,1,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddInvoicePaymentRequest) Reset() {
+	*x = AddInvoicePaymentRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddInvoicePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddInvoicePaymentRequest) ProtoMessage() {}
+
+func (x *AddInvoicePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddInvoicePaymentRequest.ProtoReflect.Descriptor instead.
+func (*AddInvoicePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AddInvoicePaymentRequest) GetInvoiceId() int32 {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return 0
+}
+
+func (x *AddInvoicePaymentRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddInvoicePaymentRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AddInvoicePaymentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type RefundInvoicePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     int32                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundInvoicePaymentRequest) Reset() {
+	*x = RefundInvoicePaymentRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundInvoicePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundInvoicePaymentRequest) ProtoMessage() {}
+
+func (x *RefundInvoicePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundInvoicePaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundInvoicePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RefundInvoicePaymentRequest) GetPaymentId() int32 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *RefundInvoicePaymentRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundInvoicePaymentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// PDF счёта на оплату или чека; document_id — документ пациента, в котором он сохранён
+type BillingDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	DocumentId    string                 `protobuf:"bytes,2,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Content       []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BillingDocumentResponse) Reset() {
+	*x = BillingDocumentResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BillingDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BillingDocumentResponse) ProtoMessage() {}
+
+func (x *BillingDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BillingDocumentResponse.ProtoReflect.Descriptor instead.
+func (*BillingDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *BillingDocumentResponse) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *BillingDocumentResponse) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+func (x *BillingDocumentResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *BillingDocumentResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type PatientBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PatientId     int32                  `protobuf:"varint,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Invoiced      int32                  `protobuf:"varint,2,opt,name=invoiced,proto3" json:"invoiced,omitempty"`
+	Paid          int32                  `protobuf:"varint,3,opt,name=paid,proto3" json:"paid,omitempty"`
+	Outstanding   int32                  `protobuf:"varint,4,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	OpenInvoices  []*Invoice             `protobuf:"bytes,5,rep,name=open_invoices,json=openInvoices,proto3" json:"open_invoices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatientBalanceResponse) Reset() {
+	*x = PatientBalanceResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatientBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatientBalanceResponse) ProtoMessage() {}
+
+func (x *PatientBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatientBalanceResponse.ProtoReflect.Descriptor instead.
+func (*PatientBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PatientBalanceResponse) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *PatientBalanceResponse) GetInvoiced() int32 {
+	if x != nil {
+		return x.Invoiced
+	}
+	return 0
+}
+
+func (x *PatientBalanceResponse) GetPaid() int32 {
+	if x != nil {
+		return x.Paid
+	}
+	return 0
+}
+
+func (x *PatientBalanceResponse) GetOutstanding() int32 {
+	if x != nil {
+		return x.Outstanding
+	}
+	return 0
+}
+
+func (x *PatientBalanceResponse) GetOpenInvoices() []*Invoice {
+	if x != nil {
+		return x.OpenInvoices
+	}
+	return nil
+}
+
+type GetVisitMaterialsAndServices struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VisitId       int32                  `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	Item          string                 `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PricePerUnit  *int32                 `protobuf:"varint,5,opt,name=price_per_unit,json=pricePerUnit,proto3,oneof" json:"price_per_unit,omitempty"` // цена на день приёма
+	TotalPrice    *int32                 `protobuf:"varint,6,opt,name=total_price,json=totalPrice,proto3,oneof" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVisitMaterialsAndServices) Reset() {
+	*x = GetVisitMaterialsAndServices{}
+	mi := &file_proto_admin_admin_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitMaterialsAndServices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitMaterialsAndServices) ProtoMessage() {}
+
+func (x *GetVisitMaterialsAndServices) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVisitMaterialsAndServices.ProtoReflect.Descriptor instead.
+func (*GetVisitMaterialsAndServices) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetVisitMaterialsAndServices) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetVisitMaterialsAndServices) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *GetVisitMaterialsAndServices) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *GetVisitMaterialsAndServices) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GetVisitMaterialsAndServices) GetPricePerUnit() int32 {
+	if x != nil && x.PricePerUnit != nil {
+		return *x.PricePerUnit
+	}
+	return 0
+}
+
+func (x *GetVisitMaterialsAndServices) GetTotalPrice() int32 {
+	if x != nil && x.TotalPrice != nil {
+		return *x.TotalPrice
+	}
+	return 0
+}
+
+type GetVisitMaterialsAndServicesResponse struct {
+	state                  protoimpl.MessageState          `protogen:"open.v1"`
+	VisitMaterialsServices []*GetVisitMaterialsAndServices `protobuf:"bytes,1,rep,name=visit_materials_services,json=visitMaterialsServices,proto3" json:"visit_materials_services,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetVisitMaterialsAndServicesResponse) Reset() {
+	*x = GetVisitMaterialsAndServicesResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitMaterialsAndServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitMaterialsAndServicesResponse) ProtoMessage() {}
+
+func (x *GetVisitMaterialsAndServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVisitMaterialsAndServicesResponse.ProtoReflect.Descriptor instead.
+func (*GetVisitMaterialsAndServicesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetVisitMaterialsAndServicesResponse) GetVisitMaterialsServices() []*GetVisitMaterialsAndServices {
+	if x != nil {
+		return x.VisitMaterialsServices
+	}
+	return nil
+}
+
+type GetByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIdRequest) Reset() {
+	*x = GetByIdRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdRequest) ProtoMessage() {}
+
+func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetByIdRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateAppointment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAppointment) Reset() {
+	*x = UpdateAppointment{}
+	mi := &file_proto_admin_admin_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAppointment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppointment) ProtoMessage() {}
+
+func (x *UpdateAppointment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppointment.ProtoReflect.Descriptor instead.
+func (*UpdateAppointment) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateAppointment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateAppointment) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *UpdateAppointment) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BirthDate
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-func (x *Patient) GetPhoneNumber() string {
+func (x *UpdateAppointment) GetStatus() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Status
 	}
 	return ""
 }
 
-func (x *Patient) GetGender() string {
+func (x *UpdateAppointment) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Gender
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type GetPatientsResponse struct {
+type UpdateAppointmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Patients      []*Patient             `protobuf:"bytes,1,rep,name=patients,proto3" json:"patients,omitempty"`
+	Appt          *UpdateAppointment     `protobuf:"bytes,1,opt,name=appt,proto3" json:"appt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPatientsResponse) Reset() {
-	*x = GetPatientsResponse{}
-	mi := &file_proto_admin_admin_proto_msgTypes[24]
+func (x *UpdateAppointmentRequest) Reset() {
+	*x = UpdateAppointmentRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPatientsResponse) String() string {
+func (x *UpdateAppointmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPatientsResponse) ProtoMessage() {}
+func (*UpdateAppointmentRequest) ProtoMessage() {}
 
-func (x *GetPatientsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[24]
+func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1752,39 +5150,40 @@ func (x *GetPatientsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPatientsResponse.ProtoReflect.Descriptor instead.
-func (*GetPatientsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use UpdateAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GetPatientsResponse) GetPatients() []*Patient {
+func (x *UpdateAppointmentRequest) GetAppt() *UpdateAppointment {
 	if x != nil {
-		return x.Patients
+		return x.Appt
 	}
 	return nil
 }
 
-type EmptyRequest struct {
+type ClinicTimeZone struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	TimeZone      string                 `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // часовой пояс клиники в формате IANA, например Europe/Moscow
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmptyRequest) Reset() {
-	*x = EmptyRequest{}
-	mi := &file_proto_admin_admin_proto_msgTypes[25]
+func (x *ClinicTimeZone) Reset() {
+	*x = ClinicTimeZone{}
+	mi := &file_proto_admin_admin_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmptyRequest) String() string {
+func (x *ClinicTimeZone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmptyRequest) ProtoMessage() {}
+func (*ClinicTimeZone) ProtoMessage() {}
 
-func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[25]
+func (x *ClinicTimeZone) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1795,34 +5194,51 @@ func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
-func (*EmptyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ClinicTimeZone.ProtoReflect.Descriptor instead.
+func (*ClinicTimeZone) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{73}
 }
 
-type Spec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ClinicTimeZone) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
 }
 
-func (x *Spec) Reset() {
-	*x = Spec{}
-	mi := &file_proto_admin_admin_proto_msgTypes[26]
+type ScheduleRule struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DoctorId            int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"` // 0 — правило клиники
+	Recurrence          string                 `protobuf:"bytes,3,opt,name=recurrence,proto3" json:"recurrence,omitempty"`              // range, monthly_weekday, annual
+	DateFrom            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"` // не задана — правило бессрочно
+	Weekday             int32                  `protobuf:"varint,6,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	Nth                 int32                  `protobuf:"varint,7,opt,name=nth,proto3" json:"nth,omitempty"` // -1 — последний такой день месяца
+	StartTime           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	SlotDurationMinutes int32                  `protobuf:"varint,10,opt,name=slot_duration_minutes,json=slotDurationMinutes,proto3" json:"slot_duration_minutes,omitempty"`
+	IsDayOff            bool                   `protobuf:"varint,11,opt,name=is_day_off,json=isDayOff,proto3" json:"is_day_off,omitempty"`
+	Title               string                 `protobuf:"bytes,12,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ScheduleRule) Reset() {
+	*x = ScheduleRule{}
+	mi := &file_proto_admin_admin_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Spec) String() string {
+func (x *ScheduleRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Spec) ProtoMessage() {}
+func (*ScheduleRule) ProtoMessage() {}
 
-func (x *Spec) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[26]
+func (x *ScheduleRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1833,92 +5249,117 @@ func (x *Spec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Spec.ProtoReflect.Descriptor instead.
-func (*Spec) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ScheduleRule.ProtoReflect.Descriptor instead.
+func (*ScheduleRule) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *Spec) GetId() int32 {
+func (x *ScheduleRule) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Spec) GetName() string {
+func (x *ScheduleRule) GetDoctorId() int32 {
 	if x != nil {
-		return x.Name
+		return x.DoctorId
 	}
-	return ""
+	return 0
 }
 
-type GetSpecsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Specs         []*Spec                `protobuf:"bytes,1,rep,name=specs,proto3" json:"specs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ScheduleRule) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
 }
 
-func (x *GetSpecsResponse) Reset() {
-	*x = GetSpecsResponse{}
-	mi := &file_proto_admin_admin_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScheduleRule) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
 }
 
-func (x *GetSpecsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScheduleRule) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
 }
 
-func (*GetSpecsResponse) ProtoMessage() {}
+func (x *ScheduleRule) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
 
-func (x *GetSpecsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[27]
+func (x *ScheduleRule) GetNth() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Nth
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetSpecsResponse.ProtoReflect.Descriptor instead.
-func (*GetSpecsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{27}
+func (x *ScheduleRule) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
 }
 
-func (x *GetSpecsResponse) GetSpecs() []*Spec {
+func (x *ScheduleRule) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Specs
+		return x.EndTime
 	}
 	return nil
 }
 
-type UpdateUserLoginRequest struct {
+func (x *ScheduleRule) GetSlotDurationMinutes() int32 {
+	if x != nil {
+		return x.SlotDurationMinutes
+	}
+	return 0
+}
+
+func (x *ScheduleRule) GetIsDayOff() bool {
+	if x != nil {
+		return x.IsDayOff
+	}
+	return false
+}
+
+func (x *ScheduleRule) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type GetScheduleRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"` // 0 — правила клиники
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserLoginRequest) Reset() {
-	*x = UpdateUserLoginRequest{}
-	mi := &file_proto_admin_admin_proto_msgTypes[28]
+func (x *GetScheduleRulesRequest) Reset() {
+	*x = GetScheduleRulesRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserLoginRequest) String() string {
+func (x *GetScheduleRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserLoginRequest) ProtoMessage() {}
+func (*GetScheduleRulesRequest) ProtoMessage() {}
 
-func (x *UpdateUserLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[28]
+func (x *GetScheduleRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1929,52 +5370,40 @@ func (x *UpdateUserLoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserLoginRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserLoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetScheduleRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *UpdateUserLoginRequest) GetUserId() int32 {
+func (x *GetScheduleRulesRequest) GetDoctorId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.DoctorId
 	}
 	return 0
 }
 
-func (x *UpdateUserLoginRequest) GetLogin() string {
-	if x != nil {
-		return x.Login
-	}
-	return ""
-}
-
-type UnconfirmedVisitPayment struct {
-	state                protoimpl.MessageState          `protogen:"open.v1"`
-	VisitId              int32                           `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	Doctor               string                          `protobuf:"bytes,2,opt,name=doctor,proto3" json:"doctor,omitempty"`
-	Patient              string                          `protobuf:"bytes,3,opt,name=patient,proto3" json:"patient,omitempty"`
-	CreatedAt            string                          `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Price                int32                           `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	MaterialsAndServices []*GetVisitMaterialsAndServices `protobuf:"bytes,6,rep,name=materials_and_services,json=materialsAndServices,proto3" json:"materials_and_services,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type GetScheduleRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*ScheduleRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnconfirmedVisitPayment) Reset() {
-	*x = UnconfirmedVisitPayment{}
-	mi := &file_proto_admin_admin_proto_msgTypes[29]
+func (x *GetScheduleRulesResponse) Reset() {
+	*x = GetScheduleRulesResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnconfirmedVisitPayment) String() string {
+func (x *GetScheduleRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnconfirmedVisitPayment) ProtoMessage() {}
+func (*GetScheduleRulesResponse) ProtoMessage() {}
 
-func (x *UnconfirmedVisitPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[29]
+func (x *GetScheduleRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1985,75 +5414,41 @@ func (x *UnconfirmedVisitPayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnconfirmedVisitPayment.ProtoReflect.Descriptor instead.
-func (*UnconfirmedVisitPayment) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *UnconfirmedVisitPayment) GetVisitId() int32 {
-	if x != nil {
-		return x.VisitId
-	}
-	return 0
-}
-
-func (x *UnconfirmedVisitPayment) GetDoctor() string {
-	if x != nil {
-		return x.Doctor
-	}
-	return ""
-}
-
-func (x *UnconfirmedVisitPayment) GetPatient() string {
-	if x != nil {
-		return x.Patient
-	}
-	return ""
-}
-
-func (x *UnconfirmedVisitPayment) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *UnconfirmedVisitPayment) GetPrice() int32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+// Deprecated: Use GetScheduleRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *UnconfirmedVisitPayment) GetMaterialsAndServices() []*GetVisitMaterialsAndServices {
+func (x *GetScheduleRulesResponse) GetRules() []*ScheduleRule {
 	if x != nil {
-		return x.MaterialsAndServices
+		return x.Rules
 	}
 	return nil
 }
 
-type UnconfirmedVisitPaymentsResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	VisitPayments []*UnconfirmedVisitPayment `protobuf:"bytes,1,rep,name=visit_payments,json=visitPayments,proto3" json:"visit_payments,omitempty"`
+type AddScheduleRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Clashes       []*Appointment         `protobuf:"bytes,2,rep,name=clashes,proto3" json:"clashes,omitempty"` // будущие записи вне нового расписания, требующие переноса
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnconfirmedVisitPaymentsResponse) Reset() {
-	*x = UnconfirmedVisitPaymentsResponse{}
-	mi := &file_proto_admin_admin_proto_msgTypes[30]
+func (x *AddScheduleRuleResponse) Reset() {
+	*x = AddScheduleRuleResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnconfirmedVisitPaymentsResponse) String() string {
+func (x *AddScheduleRuleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnconfirmedVisitPaymentsResponse) ProtoMessage() {}
+func (*AddScheduleRuleResponse) ProtoMessage() {}
 
-func (x *UnconfirmedVisitPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[30]
+func (x *AddScheduleRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2064,42 +5459,49 @@ func (x *UnconfirmedVisitPaymentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnconfirmedVisitPaymentsResponse.ProtoReflect.Descriptor instead.
-func (*UnconfirmedVisitPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use AddScheduleRuleResponse.ProtoReflect.Descriptor instead.
+func (*AddScheduleRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *UnconfirmedVisitPaymentsResponse) GetVisitPayments() []*UnconfirmedVisitPayment {
+func (x *AddScheduleRuleResponse) GetId() int32 {
 	if x != nil {
-		return x.VisitPayments
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AddScheduleRuleResponse) GetClashes() []*Appointment {
+	if x != nil {
+		return x.Clashes
 	}
 	return nil
 }
 
-type AdminScheduleOverview struct {
+type Holiday struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Days          []*ScheduleDay         `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
-	TimeSlots     []string               `protobuf:"bytes,2,rep,name=time_slots,json=timeSlots,proto3" json:"time_slots,omitempty"`
-	Appointments  []*AppointmentEntry    `protobuf:"bytes,3,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Annual        bool                   `protobuf:"varint,3,opt,name=annual,proto3" json:"annual,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AdminScheduleOverview) Reset() {
-	*x = AdminScheduleOverview{}
-	mi := &file_proto_admin_admin_proto_msgTypes[31]
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_proto_admin_admin_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdminScheduleOverview) String() string {
+func (x *Holiday) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminScheduleOverview) ProtoMessage() {}
+func (*Holiday) ProtoMessage() {}
 
-func (x *AdminScheduleOverview) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[31]
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2110,55 +5512,54 @@ func (x *AdminScheduleOverview) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminScheduleOverview.ProtoReflect.Descriptor instead.
-func (*AdminScheduleOverview) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *AdminScheduleOverview) GetDays() []*ScheduleDay {
+func (x *Holiday) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Days
+		return x.Date
 	}
 	return nil
 }
 
-func (x *AdminScheduleOverview) GetTimeSlots() []string {
+func (x *Holiday) GetTitle() string {
 	if x != nil {
-		return x.TimeSlots
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *AdminScheduleOverview) GetAppointments() []*AppointmentEntry {
+func (x *Holiday) GetAnnual() bool {
 	if x != nil {
-		return x.Appointments
+		return x.Annual
 	}
-	return nil
+	return false
 }
 
-type ScheduleDay struct {
+type ImportHolidayCalendarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`       // формат: "02.01.2006"
-	Weekday       string                 `protobuf:"bytes,2,opt,name=weekday,proto3" json:"weekday,omitempty"` // "Пн", "Вт", и т.д.
+	Holidays      []*Holiday             `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScheduleDay) Reset() {
-	*x = ScheduleDay{}
-	mi := &file_proto_admin_admin_proto_msgTypes[32]
+func (x *ImportHolidayCalendarRequest) Reset() {
+	*x = ImportHolidayCalendarRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScheduleDay) String() string {
+func (x *ImportHolidayCalendarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScheduleDay) ProtoMessage() {}
+func (*ImportHolidayCalendarRequest) ProtoMessage() {}
 
-func (x *ScheduleDay) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[32]
+func (x *ImportHolidayCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2169,51 +5570,41 @@ func (x *ScheduleDay) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScheduleDay.ProtoReflect.Descriptor instead.
-func (*ScheduleDay) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *ScheduleDay) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
+// Deprecated: Use ImportHolidayCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ImportHolidayCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ScheduleDay) GetWeekday() string {
+func (x *ImportHolidayCalendarRequest) GetHolidays() []*Holiday {
 	if x != nil {
-		return x.Weekday
+		return x.Holidays
 	}
-	return ""
+	return nil
 }
 
-type AppointmentEntry struct {
+type ImportHolidayCalendarResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // "02.01.2006"
-	Time          string                 `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"` // "15:00"
-	Doctor        *Person                `protobuf:"bytes,4,opt,name=doctor,proto3" json:"doctor,omitempty"`
-	Patient       *Person                `protobuf:"bytes,5,opt,name=patient,proto3" json:"patient,omitempty"`
+	Added         int32                  `protobuf:"varint,1,opt,name=added,proto3" json:"added,omitempty"`
+	Clashes       []*Appointment         `protobuf:"bytes,2,rep,name=clashes,proto3" json:"clashes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AppointmentEntry) Reset() {
-	*x = AppointmentEntry{}
-	mi := &file_proto_admin_admin_proto_msgTypes[33]
+func (x *ImportHolidayCalendarResponse) Reset() {
+	*x = ImportHolidayCalendarResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppointmentEntry) String() string {
+func (x *ImportHolidayCalendarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppointmentEntry) ProtoMessage() {}
+func (*ImportHolidayCalendarResponse) ProtoMessage() {}
 
-func (x *AppointmentEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[33]
+func (x *ImportHolidayCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,81 +5615,52 @@ func (x *AppointmentEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppointmentEntry.ProtoReflect.Descriptor instead.
-func (*AppointmentEntry) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ImportHolidayCalendarResponse.ProtoReflect.Descriptor instead.
+func (*ImportHolidayCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *AppointmentEntry) GetId() int32 {
+func (x *ImportHolidayCalendarResponse) GetAdded() int32 {
 	if x != nil {
-		return x.Id
+		return x.Added
 	}
 	return 0
 }
 
-func (x *AppointmentEntry) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
-}
-
-func (x *AppointmentEntry) GetTime() string {
-	if x != nil {
-		return x.Time
-	}
-	return ""
-}
-
-func (x *AppointmentEntry) GetDoctor() *Person {
-	if x != nil {
-		return x.Doctor
-	}
-	return nil
-}
-
-func (x *AppointmentEntry) GetPatient() *Person {
+func (x *ImportHolidayCalendarResponse) GetClashes() []*Appointment {
 	if x != nil {
-		return x.Patient
+		return x.Clashes
 	}
 	return nil
 }
 
-type Appointment struct {
+// Запись истории цен; kind — service или material
+type PriceListEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
-	Doctor        string                 `protobuf:"bytes,3,opt,name=doctor,proto3" json:"doctor,omitempty"`
-	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
-	Time          string                 `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
-	FirstName     string                 `protobuf:"bytes,6,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	SecondName    string                 `protobuf:"bytes,7,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
-	Surname       string                 `protobuf:"bytes,8,opt,name=surname,proto3" json:"surname,omitempty"`
-	BirthDate     string                 `protobuf:"bytes,9,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
-	Gender        string                 `protobuf:"bytes,10,opt,name=gender,proto3" json:"gender,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,11,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
-	Status        string                 `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ItemId        int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Appointment) Reset() {
-	*x = Appointment{}
-	mi := &file_proto_admin_admin_proto_msgTypes[34]
+func (x *PriceListEntry) Reset() {
+	*x = PriceListEntry{}
+	mi := &file_proto_admin_admin_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Appointment) String() string {
+func (x *PriceListEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Appointment) ProtoMessage() {}
+func (*PriceListEntry) ProtoMessage() {}
 
-func (x *Appointment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[34]
+func (x *PriceListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2309,131 +5671,165 @@ func (x *Appointment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Appointment.ProtoReflect.Descriptor instead.
-func (*Appointment) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use PriceListEntry.ProtoReflect.Descriptor instead.
+func (*PriceListEntry) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *Appointment) GetId() int32 {
+func (x *PriceListEntry) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Appointment) GetPatientId() int32 {
+func (x *PriceListEntry) GetItemId() int32 {
 	if x != nil {
-		return x.PatientId
+		return x.ItemId
 	}
 	return 0
 }
 
-func (x *Appointment) GetDoctor() string {
+func (x *PriceListEntry) GetPrice() int32 {
 	if x != nil {
-		return x.Doctor
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-func (x *Appointment) GetDate() string {
+func (x *PriceListEntry) GetEffectiveFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Date
+		return x.EffectiveFrom
 	}
-	return ""
+	return nil
 }
 
-func (x *Appointment) GetTime() string {
+func (x *PriceListEntry) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Time
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *Appointment) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ItemId        int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Appointment) GetSecondName() string {
-	if x != nil {
-		return x.SecondName
-	}
-	return ""
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Appointment) GetSurname() string {
-	if x != nil {
-		return x.Surname
-	}
-	return ""
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Appointment) GetBirthDate() string {
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[82]
 	if x != nil {
-		return x.BirthDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Appointment) GetGender() string {
-	if x != nil {
-		return x.Gender
-	}
-	return ""
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *Appointment) GetPhoneNumber() string {
+func (x *GetPriceHistoryRequest) GetKind() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *Appointment) GetStatus() string {
+func (x *GetPriceHistoryRequest) GetItemId() int32 {
 	if x != nil {
-		return x.Status
+		return x.ItemId
 	}
-	return ""
+	return 0
 }
 
-func (x *Appointment) GetCreatedAt() string {
+type GetPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*PriceListEntry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[83]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Appointment) GetUpdatedAt() string {
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetPriceHistoryResponse) GetEntries() []*PriceListEntry {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type GetUnconfirmedAppointmentResponse struct {
+type AddPriceListEntryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Entry         *PriceListEntry        `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUnconfirmedAppointmentResponse) Reset() {
-	*x = GetUnconfirmedAppointmentResponse{}
-	mi := &file_proto_admin_admin_proto_msgTypes[35]
+func (x *AddPriceListEntryRequest) Reset() {
+	*x = AddPriceListEntryRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUnconfirmedAppointmentResponse) String() string {
+func (x *AddPriceListEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUnconfirmedAppointmentResponse) ProtoMessage() {}
+func (*AddPriceListEntryRequest) ProtoMessage() {}
 
-func (x *GetUnconfirmedAppointmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[35]
+func (x *AddPriceListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2444,47 +5840,47 @@ func (x *GetUnconfirmedAppointmentResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUnconfirmedAppointmentResponse.ProtoReflect.Descriptor instead.
-func (*GetUnconfirmedAppointmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use AddPriceListEntryRequest.ProtoReflect.Descriptor instead.
+func (*AddPriceListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetUnconfirmedAppointmentResponse) GetAppointments() []*Appointment {
+func (x *AddPriceListEntryRequest) GetKind() string {
 	if x != nil {
-		return x.Appointments
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AddPriceListEntryRequest) GetEntry() *PriceListEntry {
+	if x != nil {
+		return x.Entry
 	}
 	return nil
 }
 
-type Person struct {
+type AddPriceListEntryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FirstName     string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	SecondName    string                 `protobuf:"bytes,3,opt,name=second_name,json=secondName,proto3" json:"second_name,omitempty"`
-	Surname       string                 `protobuf:"bytes,4,opt,name=surname,proto3" json:"surname,omitempty"`
-	BirthDate     string                 `protobuf:"bytes,5,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"`
-	Gender        string                 `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender,omitempty"`
-	Phone         string                 `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
-	Specialty     string                 `protobuf:"bytes,8,opt,name=specialty,proto3" json:"specialty,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Person) Reset() {
-	*x = Person{}
-	mi := &file_proto_admin_admin_proto_msgTypes[36]
+func (x *AddPriceListEntryResponse) Reset() {
+	*x = AddPriceListEntryResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Person) String() string {
+func (x *AddPriceListEntryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Person) ProtoMessage() {}
+func (*AddPriceListEntryResponse) ProtoMessage() {}
 
-func (x *Person) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[36]
+func (x *AddPriceListEntryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2495,91 +5891,94 @@ func (x *Person) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Person.ProtoReflect.Descriptor instead.
-func (*Person) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use AddPriceListEntryResponse.ProtoReflect.Descriptor instead.
+func (*AddPriceListEntryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *Person) GetId() int64 {
+func (x *AddPriceListEntryResponse) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Person) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+type DeletePriceListEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Person) GetSecondName() string {
-	if x != nil {
-		return x.SecondName
-	}
-	return ""
+func (x *DeletePriceListEntryRequest) Reset() {
+	*x = DeletePriceListEntryRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-
-func (x *Person) GetSurname() string {
-	if x != nil {
-		return x.Surname
-	}
-	return ""
+
+func (x *DeletePriceListEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Person) GetBirthDate() string {
+func (*DeletePriceListEntryRequest) ProtoMessage() {}
+
+func (x *DeletePriceListEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[86]
 	if x != nil {
-		return x.BirthDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Person) GetGender() string {
-	if x != nil {
-		return x.Gender
-	}
-	return ""
+// Deprecated: Use DeletePriceListEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceListEntryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *Person) GetPhone() string {
+func (x *DeletePriceListEntryRequest) GetKind() string {
 	if x != nil {
-		return x.Phone
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *Person) GetSpecialty() string {
+func (x *DeletePriceListEntryRequest) GetId() int32 {
 	if x != nil {
-		return x.Specialty
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type VisitPayment struct {
+type GetPriceOnDateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	Price         int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ItemId        int32                  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VisitPayment) Reset() {
-	*x = VisitPayment{}
-	mi := &file_proto_admin_admin_proto_msgTypes[37]
+func (x *GetPriceOnDateRequest) Reset() {
+	*x = GetPriceOnDateRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VisitPayment) String() string {
+func (x *GetPriceOnDateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VisitPayment) ProtoMessage() {}
+func (*GetPriceOnDateRequest) ProtoMessage() {}
 
-func (x *VisitPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[37]
+func (x *GetPriceOnDateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,54 +5989,54 @@ func (x *VisitPayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VisitPayment.ProtoReflect.Descriptor instead.
-func (*VisitPayment) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetPriceOnDateRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceOnDateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *VisitPayment) GetVisitId() int32 {
+func (x *GetPriceOnDateRequest) GetKind() string {
 	if x != nil {
-		return x.VisitId
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *VisitPayment) GetPrice() int32 {
+func (x *GetPriceOnDateRequest) GetItemId() int32 {
 	if x != nil {
-		return x.Price
+		return x.ItemId
 	}
 	return 0
 }
 
-func (x *VisitPayment) GetStatus() string {
+func (x *GetPriceOnDateRequest) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.Date
 	}
-	return ""
+	return nil
 }
 
-type UpdateVisitPaymentRequest struct {
+type GetPriceOnDateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payment       *VisitPayment          `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Price         int32                  `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateVisitPaymentRequest) Reset() {
-	*x = UpdateVisitPaymentRequest{}
-	mi := &file_proto_admin_admin_proto_msgTypes[38]
+func (x *GetPriceOnDateResponse) Reset() {
+	*x = GetPriceOnDateResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateVisitPaymentRequest) String() string {
+func (x *GetPriceOnDateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVisitPaymentRequest) ProtoMessage() {}
+func (*GetPriceOnDateResponse) ProtoMessage() {}
 
-func (x *UpdateVisitPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[38]
+func (x *GetPriceOnDateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2648,43 +6047,50 @@ func (x *UpdateVisitPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVisitPaymentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateVisitPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetPriceOnDateResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceOnDateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *UpdateVisitPaymentRequest) GetPayment() *VisitPayment {
+func (x *GetPriceOnDateResponse) GetPrice() int32 {
 	if x != nil {
-		return x.Payment
+		return x.Price
 	}
-	return nil
+	return 0
 }
 
-type GetVisitMaterialsAndServices struct {
+type AuditEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	VisitId       int32                  `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	Item          string                 `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // полное имя gRPC-метода
+	ActorId       int32                  `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorRole     int32                  `protobuf:"varint,6,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	Resource      string                 `protobuf:"bytes,7,opt,name=resource,proto3" json:"resource,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,8,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,9,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"` // 0 — вызов не относится к конкретному пациенту
+	Outcome       string                 `protobuf:"bytes,10,opt,name=outcome,proto3" json:"outcome,omitempty"`                      // код gRPC-статуса ответа
+	Hash          string                 `protobuf:"bytes,11,opt,name=hash,proto3" json:"hash,omitempty"`                            // звено цепочки хешей в hex
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVisitMaterialsAndServices) Reset() {
-	*x = GetVisitMaterialsAndServices{}
-	mi := &file_proto_admin_admin_proto_msgTypes[39]
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_proto_admin_admin_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVisitMaterialsAndServices) String() string {
+func (x *AuditEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVisitMaterialsAndServices) ProtoMessage() {}
+func (*AuditEntry) ProtoMessage() {}
 
-func (x *GetVisitMaterialsAndServices) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[39]
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2695,105 +6101,114 @@ func (x *GetVisitMaterialsAndServices) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVisitMaterialsAndServices.ProtoReflect.Descriptor instead.
-func (*GetVisitMaterialsAndServices) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *GetVisitMaterialsAndServices) GetId() int32 {
+func (x *AuditEntry) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *GetVisitMaterialsAndServices) GetVisitId() int32 {
+func (x *AuditEntry) GetOccurredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.VisitId
+		return x.OccurredAt
 	}
-	return 0
+	return nil
 }
 
-func (x *GetVisitMaterialsAndServices) GetItem() string {
+func (x *AuditEntry) GetService() string {
 	if x != nil {
-		return x.Item
+		return x.Service
 	}
 	return ""
 }
 
-func (x *GetVisitMaterialsAndServices) GetQuantity() int32 {
+func (x *AuditEntry) GetAction() string {
 	if x != nil {
-		return x.Quantity
+		return x.Action
 	}
-	return 0
+	return ""
 }
 
-type GetVisitMaterialsAndServicesResponse struct {
-	state                  protoimpl.MessageState          `protogen:"open.v1"`
-	VisitMaterialsServices []*GetVisitMaterialsAndServices `protobuf:"bytes,1,rep,name=visit_materials_services,json=visitMaterialsServices,proto3" json:"visit_materials_services,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *AuditEntry) GetActorId() int32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
 }
 
-func (x *GetVisitMaterialsAndServicesResponse) Reset() {
-	*x = GetVisitMaterialsAndServicesResponse{}
-	mi := &file_proto_admin_admin_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AuditEntry) GetActorRole() int32 {
+	if x != nil {
+		return x.ActorRole
+	}
+	return 0
 }
 
-func (x *GetVisitMaterialsAndServicesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditEntry) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
 }
 
-func (*GetVisitMaterialsAndServicesResponse) ProtoMessage() {}
+func (x *AuditEntry) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
 
-func (x *GetVisitMaterialsAndServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[40]
+func (x *AuditEntry) GetPatientId() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PatientId
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetVisitMaterialsAndServicesResponse.ProtoReflect.Descriptor instead.
-func (*GetVisitMaterialsAndServicesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{40}
+func (x *AuditEntry) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
 }
 
-func (x *GetVisitMaterialsAndServicesResponse) GetVisitMaterialsServices() []*GetVisitMaterialsAndServices {
+func (x *AuditEntry) GetHash() string {
 	if x != nil {
-		return x.VisitMaterialsServices
+		return x.Hash
 	}
-	return nil
+	return ""
 }
 
-type GetByIdRequest struct {
+type GetAuditLogRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	ActorId       int32                  `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // дни в часовом поясе клиники включительно
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByIdRequest) Reset() {
-	*x = GetByIdRequest{}
-	mi := &file_proto_admin_admin_proto_msgTypes[41]
+func (x *GetAuditLogRequest) Reset() {
+	*x = GetAuditLogRequest{}
+	mi := &file_proto_admin_admin_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByIdRequest) String() string {
+func (x *GetAuditLogRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByIdRequest) ProtoMessage() {}
+func (*GetAuditLogRequest) ProtoMessage() {}
 
-func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[41]
+func (x *GetAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2804,44 +6219,69 @@ func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetByIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use GetAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetByIdRequest) GetId() int32 {
+func (x *GetAuditLogRequest) GetPage() *ListPage {
 	if x != nil {
-		return x.Id
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetAuditLogRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
 	}
 	return 0
 }
 
-type UpdateAppointment struct {
+func (x *GetAuditLogRequest) GetActorId() int32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *GetAuditLogRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *GetAuditLogRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+type GetAuditLogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных записей без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAppointment) Reset() {
-	*x = UpdateAppointment{}
-	mi := &file_proto_admin_admin_proto_msgTypes[42]
+func (x *GetAuditLogResponse) Reset() {
+	*x = GetAuditLogResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAppointment) String() string {
+func (x *GetAuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppointment) ProtoMessage() {}
+func (*GetAuditLogResponse) ProtoMessage() {}
 
-func (x *UpdateAppointment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[42]
+func (x *GetAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2852,68 +6292,49 @@ func (x *UpdateAppointment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAppointment.ProtoReflect.Descriptor instead.
-func (*UpdateAppointment) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *UpdateAppointment) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdateAppointment) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
+// Deprecated: Use GetAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *UpdateAppointment) GetTime() *timestamppb.Timestamp {
+func (x *GetAuditLogResponse) GetEntries() []*AuditEntry {
 	if x != nil {
-		return x.Time
+		return x.Entries
 	}
 	return nil
 }
 
-func (x *UpdateAppointment) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *UpdateAppointment) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *GetAuditLogResponse) GetTotal() int32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-type UpdateAppointmentRequest struct {
+type VerifyAuditLogResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Appt          *UpdateAppointment     `protobuf:"bytes,1,opt,name=appt,proto3" json:"appt,omitempty"`
+	Checked       int32                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`                   // сколько записей проверено
+	BrokenId      int64                  `protobuf:"varint,2,opt,name=broken_id,json=brokenId,proto3" json:"broken_id,omitempty"` // первая запись, хеш которой не сходится; 0 — цепочка цела
+	LastHash      string                 `protobuf:"bytes,3,opt,name=last_hash,json=lastHash,proto3" json:"last_hash,omitempty"`  // сводный хеш последних записей цепочек журнала в hex
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAppointmentRequest) Reset() {
-	*x = UpdateAppointmentRequest{}
-	mi := &file_proto_admin_admin_proto_msgTypes[43]
+func (x *VerifyAuditLogResponse) Reset() {
+	*x = VerifyAuditLogResponse{}
+	mi := &file_proto_admin_admin_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAppointmentRequest) String() string {
+func (x *VerifyAuditLogResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppointmentRequest) ProtoMessage() {}
+func (*VerifyAuditLogResponse) ProtoMessage() {}
 
-func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_admin_proto_msgTypes[43]
+func (x *VerifyAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_admin_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2924,16 +6345,30 @@ func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAppointmentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_admin_admin_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use VerifyAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_admin_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *UpdateAppointmentRequest) GetAppt() *UpdateAppointment {
+func (x *VerifyAuditLogResponse) GetChecked() int32 {
 	if x != nil {
-		return x.Appt
+		return x.Checked
 	}
-	return nil
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetBrokenId() int64 {
+	if x != nil {
+		return x.BrokenId
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetLastHash() string {
+	if x != nil {
+		return x.LastHash
+	}
+	return ""
 }
 
 var File_proto_admin_admin_proto protoreflect.FileDescriptor
@@ -2984,34 +6419,142 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x122\n" +
 	"\x15slot_duration_minutes\x18\x05 \x01(\x05R\x13slotDurationMinutes\x12\x1c\n" +
 	"\n" +
-	"is_day_off\x18\x06 \x01(\bR\bisDayOff\">\n" +
+	"is_day_off\x18\x06 \x01(\bR\bisDayOff\"\x82\x01\n" +
 	"\x12AddMaterialRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x02 \x01(\x05R\x05price\"Q\n" +
+	"\x05price\x18\x02 \x01(\x05R\x05price\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12.\n" +
+	"\x13low_stock_threshold\x18\x04 \x01(\x05R\x11lowStockThreshold\"|\n" +
 	"\x11AddServiceRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x05R\x05price\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\x05R\x04type\"Q\n" +
+	"\x04type\x18\x03 \x01(\x05R\x04type\x12)\n" +
+	"\x10duration_minutes\x18\x04 \x01(\x05R\x0fdurationMinutes\"\x95\x01\n" +
 	"\x15UpdateMaterialRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x05R\x05price\"d\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12.\n" +
+	"\x13low_stock_threshold\x18\x05 \x01(\x05R\x11lowStockThreshold\"\x8f\x01\n" +
 	"\x14UpdateServiceRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\x05R\x04type\"D\n" +
+	"\x04type\x18\x04 \x01(\x05R\x04type\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\"\xaf\x01\n" +
 	"\bMaterial\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x05R\x05price\"W\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12.\n" +
+	"\x13low_stock_threshold\x18\x05 \x01(\x05R\x11lowStockThreshold\x12%\n" +
+	"\x0estock_quantity\x18\x06 \x01(\x05R\rstockQuantity\"\xc7\x02\n" +
+	"\rMaterialBatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vmaterial_id\x18\x02 \x01(\x05R\n" +
+	"materialId\x12!\n" +
+	"\fbatch_number\x18\x03 \x01(\tR\vbatchNumber\x12+\n" +
+	"\x11quantity_received\x18\x04 \x01(\x05R\x10quantityReceived\x12#\n" +
+	"\rquantity_left\x18\x05 \x01(\x05R\fquantityLeft\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12;\n" +
+	"\vreceived_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x12\x18\n" +
+	"\acomment\x18\b \x01(\tR\acomment\"\xf9\x01\n" +
+	"\rStockMovement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1f\n" +
+	"\vmaterial_id\x18\x02 \x01(\x05R\n" +
+	"materialId\x12\x19\n" +
+	"\bbatch_id\x18\x03 \x01(\x05R\abatchId\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x19\n" +
+	"\bvisit_id\x18\x06 \x01(\x05R\avisitId\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd2\x01\n" +
+	"\x1bReceiveMaterialBatchRequest\x12\x1f\n" +
+	"\vmaterial_id\x18\x01 \x01(\x05R\n" +
+	"materialId\x12!\n" +
+	"\fbatch_number\x18\x02 \x01(\tR\vbatchNumber\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"\x8c\x01\n" +
+	"\x1aAdjustMaterialStockRequest\x12\x1f\n" +
+	"\vmaterial_id\x18\x01 \x01(\x05R\n" +
+	"materialId\x12\x19\n" +
+	"\bbatch_id\x18\x02 \x01(\x05R\abatchId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xa8\x01\n" +
+	"\x15MaterialStockResponse\x12+\n" +
+	"\bmaterial\x18\x01 \x01(\v2\x0f.admin.MaterialR\bmaterial\x12.\n" +
+	"\abatches\x18\x02 \x03(\v2\x14.admin.MaterialBatchR\abatches\x122\n" +
+	"\tmovements\x18\x03 \x03(\v2\x14.admin.StockMovementR\tmovements\"F\n" +
+	"\x12StockReportRequest\x120\n" +
+	"\x14expiring_within_days\x18\x01 \x01(\x05R\x12expiringWithinDays\"t\n" +
+	"\rExpiringBatch\x12*\n" +
+	"\x05batch\x18\x01 \x01(\v2\x14.admin.MaterialBatchR\x05batch\x12#\n" +
+	"\rmaterial_name\x18\x02 \x01(\tR\fmaterialName\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\"u\n" +
+	"\x13StockReportResponse\x12,\n" +
+	"\tlow_stock\x18\x01 \x03(\v2\x0f.admin.MaterialR\blowStock\x120\n" +
+	"\bexpiring\x18\x02 \x03(\v2\x14.admin.ExpiringBatchR\bexpiring\"\x99\x03\n" +
+	"\x11ClinicalAlertRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
+	"\x0econdition_type\x18\x03 \x01(\tR\rconditionType\x12'\n" +
+	"\x0fcondition_value\x18\x04 \x01(\tR\x0econditionValue\x12\x1f\n" +
+	"\vtarget_type\x18\x05 \x01(\tR\n" +
+	"targetType\x12\x1f\n" +
+	"\vmaterial_id\x18\x06 \x01(\x05R\n" +
+	"materialId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\a \x01(\x05R\tserviceId\x12\x1f\n" +
+	"\vtarget_name\x18\b \x01(\tR\n" +
+	"targetName\x12\x1a\n" +
+	"\bseverity\x18\t \x01(\tR\bseverity\x12\x18\n" +
+	"\amessage\x18\n" +
+	" \x01(\tR\amessage\x12\x1b\n" +
+	"\tis_active\x18\v \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"O\n" +
+	"\x1dGetClinicalAlertRulesResponse\x12.\n" +
+	"\x05rules\x18\x01 \x03(\v2\x18.admin.ClinicalAlertRuleR\x05rules\".\n" +
+	"\x1cAddClinicalAlertRuleResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\xe0\x02\n" +
+	"\x12PatientAccessGrant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x03 \x01(\x05R\bdoctorId\x12\x16\n" +
+	"\x06doctor\x18\x04 \x01(\tR\x06doctor\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"granted_by\x18\x06 \x01(\x05R\tgrantedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"S\n" +
+	"\x1eGetPatientAccessGrantsResponse\x121\n" +
+	"\x06grants\x18\x01 \x03(\v2\x19.admin.PatientAccessGrantR\x06grants\"/\n" +
+	"\x1dAddPatientAccessGrantResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x82\x01\n" +
 	"\aService\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\x05R\x04type\"\x1f\n" +
+	"\x04type\x18\x04 \x01(\x05R\x04type\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\"\x1f\n" +
 	"\rDeleteRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"1\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"Q\n" +
+	"\x17ChangeUserStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x02 \x01(\x05R\tchangedBy\"2\n" +
+	"\x18ChangeUserStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"1\n" +
 	"\vServiceType\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"\xdf\x01\n" +
@@ -3025,9 +6568,19 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"\fphone_number\x18\x05 \x01(\tR\vphoneNumber\x12\x14\n" +
 	"\x05email\x18\x06 \x01(\tR\x05email\x12\x16\n" +
 	"\x06gender\x18\a \x01(\tR\x06gender\x12\x12\n" +
-	"\x04role\x18\b \x01(\tR\x04role\"9\n" +
+	"\x04role\x18\b \x01(\tR\x04role\"`\n" +
+	"\bListPage\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\bR\x04desc\"i\n" +
+	"\x10GetAdminsRequest\x12#\n" +
+	"\x04page\x18\x01 \x01(\v2\x0f.admin.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"O\n" +
 	"\x11GetAdminsResponse\x12$\n" +
-	"\x06admins\x18\x01 \x03(\v2\f.admin.AdminR\x06admins\"\xa9\x02\n" +
+	"\x06admins\x18\x01 \x03(\v2\f.admin.AdminR\x06admins\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xa9\x02\n" +
 	"\x0fDoctorWithSpecs\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
 	"\n" +
@@ -3043,9 +6596,14 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"experience\x12\x16\n" +
 	"\x06gender\x18\t \x01(\tR\x06gender\x12\x14\n" +
 	"\x05specs\x18\n" +
-	" \x03(\x05R\x05specs\"F\n" +
+	" \x03(\x05R\x05specs\"j\n" +
+	"\x11GetDoctorsRequest\x12#\n" +
+	"\x04page\x18\x01 \x01(\v2\x0f.admin.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"\\\n" +
 	"\x12GetDoctorsResponse\x120\n" +
-	"\adoctors\x18\x01 \x03(\v2\x16.admin.DoctorWithSpecsR\adoctors\"\xad\x02\n" +
+	"\adoctors\x18\x01 \x03(\v2\x16.admin.DoctorWithSpecsR\adoctors\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xad\x02\n" +
 	"\x13UpdateDoctorRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
 	"\n" +
@@ -3096,9 +6654,14 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"\n" +
 	"birth_date\x18\x06 \x01(\tR\tbirthDate\x12!\n" +
 	"\fphone_number\x18\a \x01(\tR\vphoneNumber\x12\x16\n" +
-	"\x06gender\x18\b \x01(\tR\x06gender\"A\n" +
+	"\x06gender\x18\b \x01(\tR\x06gender\"k\n" +
+	"\x12GetPatientsRequest\x12#\n" +
+	"\x04page\x18\x01 \x01(\v2\x0f.admin.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"W\n" +
 	"\x13GetPatientsResponse\x12*\n" +
-	"\bpatients\x18\x01 \x03(\v2\x0e.admin.PatientR\bpatients\"\x0e\n" +
+	"\bpatients\x18\x01 \x03(\v2\x0e.admin.PatientR\bpatients\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x0e\n" +
 	"\fEmptyRequest\"*\n" +
 	"\x04Spec\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
@@ -3107,7 +6670,7 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"\x05specs\x18\x01 \x03(\v2\v.admin.SpecR\x05specs\"G\n" +
 	"\x16UpdateUserLoginRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x14\n" +
-	"\x05login\x18\x02 \x01(\tR\x05login\"\xf6\x01\n" +
+	"\x05login\x18\x02 \x01(\tR\x05login\"\xdb\x02\n" +
 	"\x17UnconfirmedVisitPayment\x12\x19\n" +
 	"\bvisit_id\x18\x01 \x01(\x05R\avisitId\x12\x16\n" +
 	"\x06doctor\x18\x02 \x01(\tR\x06doctor\x12\x18\n" +
@@ -3115,9 +6678,18 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12\x14\n" +
 	"\x05price\x18\x05 \x01(\x05R\x05price\x12Y\n" +
-	"\x16materials_and_services\x18\x06 \x03(\v2#.admin.GetVisitMaterialsAndServicesR\x14materialsAndServices\"i\n" +
+	"\x16materials_and_services\x18\x06 \x03(\v2#.admin.GetVisitMaterialsAndServicesR\x14materialsAndServices\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\a \x01(\x05R\tinvoiceId\x12\x12\n" +
+	"\x04paid\x18\b \x01(\x05R\x04paid\x12\x18\n" +
+	"\abalance\x18\t \x01(\x05R\abalance\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\"I\n" +
+	"\"GetUnconfirmedVisitPaymentsRequest\x12#\n" +
+	"\x04page\x18\x01 \x01(\v2\x0f.admin.ListPageR\x04page\"\x7f\n" +
 	" UnconfirmedVisitPaymentsResponse\x12E\n" +
-	"\x0evisit_payments\x18\x01 \x03(\v2\x1e.admin.UnconfirmedVisitPaymentR\rvisitPayments\"\x9b\x01\n" +
+	"\x0evisit_payments\x18\x01 \x03(\v2\x1e.admin.UnconfirmedVisitPaymentR\rvisitPayments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x9b\x01\n" +
 	"\x15AdminScheduleOverview\x12&\n" +
 	"\x04days\x18\x01 \x03(\v2\x12.admin.ScheduleDayR\x04days\x12\x1d\n" +
 	"\n" +
@@ -3153,9 +6725,14 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\tR\tupdatedAt\"[\n" +
+	"updated_at\x18\x0e \x01(\tR\tupdatedAt\"\xb6\x01\n" +
+	"!GetUnconfirmedAppointmentsRequest\x12#\n" +
+	"\x04page\x18\x01 \x01(\v2\x0f.admin.ListPageR\x04page\x127\n" +
+	"\tdate_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\"q\n" +
 	"!GetUnconfirmedAppointmentResponse\x126\n" +
-	"\fappointments\x18\x01 \x03(\v2\x12.admin.AppointmentR\fappointments\"\xdd\x01\n" +
+	"\fappointments\x18\x01 \x03(\v2\x12.admin.AppointmentR\fappointments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xdd\x01\n" +
 	"\x06Person\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
@@ -3167,18 +6744,86 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"birth_date\x18\x05 \x01(\tR\tbirthDate\x12\x16\n" +
 	"\x06gender\x18\x06 \x01(\tR\x06gender\x12\x14\n" +
 	"\x05phone\x18\a \x01(\tR\x05phone\x12\x1c\n" +
-	"\tspecialty\x18\b \x01(\tR\tspecialty\"W\n" +
-	"\fVisitPayment\x12\x19\n" +
-	"\bvisit_id\x18\x01 \x01(\x05R\avisitId\x12\x14\n" +
-	"\x05price\x18\x02 \x01(\x05R\x05price\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"J\n" +
-	"\x19UpdateVisitPaymentRequest\x12-\n" +
-	"\apayment\x18\x01 \x01(\v2\x13.admin.VisitPaymentR\apayment\"y\n" +
+	"\tspecialty\x18\b \x01(\tR\tspecialty\"\x81\x04\n" +
+	"\aInvoice\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\bvisit_id\x18\x02 \x01(\x05R\avisitId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x03 \x01(\x05R\tpatientId\x12\x1a\n" +
+	"\bsubtotal\x18\x04 \x01(\x05R\bsubtotal\x12#\n" +
+	"\rdiscount_kind\x18\x05 \x01(\tR\fdiscountKind\x12%\n" +
+	"\x0ediscount_value\x18\x06 \x01(\x05R\rdiscountValue\x12'\n" +
+	"\x0fdiscount_reason\x18\a \x01(\tR\x0ediscountReason\x12'\n" +
+	"\x0fdiscount_amount\x18\b \x01(\x05R\x0ediscountAmount\x12\x14\n" +
+	"\x05total\x18\t \x01(\x05R\x05total\x12\x12\n" +
+	"\x04paid\x18\n" +
+	" \x01(\x05R\x04paid\x12\x18\n" +
+	"\abalance\x18\v \x01(\x05R\abalance\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12(\n" +
+	"\x05lines\x18\x0e \x03(\v2\x12.admin.InvoiceLineR\x05lines\x121\n" +
+	"\bpayments\x18\x0f \x03(\v2\x15.admin.InvoicePaymentR\bpayments\"\xc1\x01\n" +
+	"\vInvoiceLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x17\n" +
+	"\aitem_id\x18\x03 \x01(\x05R\x06itemId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12$\n" +
+	"\x0eprice_per_unit\x18\x06 \x01(\x05R\fpricePerUnit\x12\x1f\n" +
+	"\vtotal_price\x18\a \x01(\x05R\n" +
+	"totalPrice\"\xd6\x01\n" +
+	"\x0eInvoicePayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x1b\n" +
+	"\trefund_of\x18\x05 \x01(\x05R\brefundOf\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\";\n" +
+	"\x0fInvoiceResponse\x12(\n" +
+	"\ainvoice\x18\x01 \x01(\v2\x0e.admin.InvoiceR\ainvoice\"|\n" +
+	"\x19SetInvoiceDiscountRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\x05R\tinvoiceId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x05R\x05value\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x83\x01\n" +
+	"\x18AddInvoicePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"invoice_id\x18\x01 \x01(\x05R\tinvoiceId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x05R\x06amount\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"n\n" +
+	"\x1bRefundInvoicePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x05R\tpaymentId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x05R\x06amount\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\x89\x01\n" +
+	"\x17BillingDocumentResponse\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x1f\n" +
+	"\vdocument_id\x18\x02 \x01(\tR\n" +
+	"documentId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\"\xbe\x01\n" +
+	"\x16PatientBalanceResponse\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\x05R\tpatientId\x12\x1a\n" +
+	"\binvoiced\x18\x02 \x01(\x05R\binvoiced\x12\x12\n" +
+	"\x04paid\x18\x03 \x01(\x05R\x04paid\x12 \n" +
+	"\voutstanding\x18\x04 \x01(\x05R\voutstanding\x123\n" +
+	"\ropen_invoices\x18\x05 \x03(\v2\x0e.admin.InvoiceR\fopenInvoices\"\xed\x01\n" +
 	"\x1cGetVisitMaterialsAndServices\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\bvisit_id\x18\x02 \x01(\x05R\avisitId\x12\x12\n" +
 	"\x04item\x18\x03 \x01(\tR\x04item\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x05R\bquantity\"\x85\x01\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12)\n" +
+	"\x0eprice_per_unit\x18\x05 \x01(\x05H\x00R\fpricePerUnit\x88\x01\x01\x12$\n" +
+	"\vtotal_price\x18\x06 \x01(\x05H\x01R\n" +
+	"totalPrice\x88\x01\x01B\x11\n" +
+	"\x0f_price_per_unitB\x0e\n" +
+	"\f_total_price\"\x85\x01\n" +
 	"$GetVisitMaterialsAndServicesResponse\x12]\n" +
 	"\x18visit_materials_services\x18\x01 \x03(\v2#.admin.GetVisitMaterialsAndServicesR\x16visitMaterialsServices\" \n" +
 	"\x0eGetByIdRequest\x12\x0e\n" +
@@ -3191,38 +6836,164 @@ const file_proto_admin_admin_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"H\n" +
 	"\x18UpdateAppointmentRequest\x12,\n" +
-	"\x04appt\x18\x01 \x01(\v2\x18.admin.UpdateAppointmentR\x04appt2\x86\x10\n" +
+	"\x04appt\x18\x01 \x01(\v2\x18.admin.UpdateAppointmentR\x04appt\"-\n" +
+	"\x0eClinicTimeZone\x12\x1b\n" +
+	"\ttime_zone\x18\x01 \x01(\tR\btimeZone\"\xcf\x03\n" +
+	"\fScheduleRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12\x1e\n" +
+	"\n" +
+	"recurrence\x18\x03 \x01(\tR\n" +
+	"recurrence\x127\n" +
+	"\tdate_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\x18\n" +
+	"\aweekday\x18\x06 \x01(\x05R\aweekday\x12\x10\n" +
+	"\x03nth\x18\a \x01(\x05R\x03nth\x129\n" +
+	"\n" +
+	"start_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x122\n" +
+	"\x15slot_duration_minutes\x18\n" +
+	" \x01(\x05R\x13slotDurationMinutes\x12\x1c\n" +
+	"\n" +
+	"is_day_off\x18\v \x01(\bR\bisDayOff\x12\x14\n" +
+	"\x05title\x18\f \x01(\tR\x05title\"6\n" +
+	"\x17GetScheduleRulesRequest\x12\x1b\n" +
+	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\"E\n" +
+	"\x18GetScheduleRulesResponse\x12)\n" +
+	"\x05rules\x18\x01 \x03(\v2\x13.admin.ScheduleRuleR\x05rules\"W\n" +
+	"\x17AddScheduleRuleResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12,\n" +
+	"\aclashes\x18\x02 \x03(\v2\x12.admin.AppointmentR\aclashes\"g\n" +
+	"\aHoliday\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06annual\x18\x03 \x01(\bR\x06annual\"J\n" +
+	"\x1cImportHolidayCalendarRequest\x12*\n" +
+	"\bholidays\x18\x01 \x03(\v2\x0e.admin.HolidayR\bholidays\"c\n" +
+	"\x1dImportHolidayCalendarResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x01(\x05R\x05added\x12,\n" +
+	"\aclashes\x18\x02 \x03(\v2\x12.admin.AppointmentR\aclashes\"\xcd\x01\n" +
+	"\x0ePriceListEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"E\n" +
+	"\x16GetPriceHistoryRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\"J\n" +
+	"\x17GetPriceHistoryResponse\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.admin.PriceListEntryR\aentries\"[\n" +
+	"\x18AddPriceListEntryRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12+\n" +
+	"\x05entry\x18\x02 \x01(\v2\x15.admin.PriceListEntryR\x05entry\"+\n" +
+	"\x19AddPriceListEntryResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"A\n" +
+	"\x1bDeletePriceListEntryRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"t\n" +
+	"\x15GetPriceOnDateRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x05R\x06itemId\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\".\n" +
+	"\x16GetPriceOnDateResponse\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x05R\x05price\"\xcf\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\x05R\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_role\x18\x06 \x01(\x05R\tactorRole\x12\x1a\n" +
+	"\bresource\x18\a \x01(\tR\bresource\x12\x1f\n" +
+	"\vresource_id\x18\b \x01(\tR\n" +
+	"resourceId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\t \x01(\x05R\tpatientId\x12\x18\n" +
+	"\aoutcome\x18\n" +
+	" \x01(\tR\aoutcome\x12\x12\n" +
+	"\x04hash\x18\v \x01(\tR\x04hash\"\xe1\x01\n" +
+	"\x12GetAuditLogRequest\x12#\n" +
+	"\x04page\x18\x01 \x01(\v2\x0f.admin.ListPageR\x04page\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x05R\aactorId\x127\n" +
+	"\tdate_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\"X\n" +
+	"\x13GetAuditLogResponse\x12+\n" +
+	"\aentries\x18\x01 \x03(\v2\x11.admin.AuditEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"l\n" +
+	"\x16VerifyAuditLogResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x05R\achecked\x12\x1b\n" +
+	"\tbroken_id\x18\x02 \x01(\x03R\bbrokenId\x12\x1b\n" +
+	"\tlast_hash\x18\x03 \x01(\tR\blastHash2\xc2#\n" +
 	"\fAdminService\x12^\n" +
 	"\x1aUpdateClinicWeeklySchedule\x12(.admin.UpdateClinicWeeklyScheduleRequest\x1a\x16.admin.DefaultResponse\x12X\n" +
 	"\x17AddDoctorWeeklySchedule\x12%.admin.AddDoctorWeeklyScheduleRequest\x1a\x16.admin.DefaultResponse\x12^\n" +
 	"\x1aUpdateDoctorWeeklySchedule\x12(.admin.UpdateDoctorWeeklyScheduleRequest\x1a\x16.admin.DefaultResponse\x12V\n" +
 	"\x16AddClinicDailyOverride\x12$.admin.AddClinicDailyOverrideRequest\x1a\x16.admin.DefaultResponse\x12V\n" +
-	"\x16AddDoctorDailyOverride\x12$.admin.AddDoctorDailyOverrideRequest\x1a\x16.admin.DefaultResponse\x12@\n" +
+	"\x16AddDoctorDailyOverride\x12$.admin.AddDoctorDailyOverrideRequest\x1a\x16.admin.DefaultResponse\x12?\n" +
+	"\x11GetClinicTimeZone\x12\x13.admin.EmptyRequest\x1a\x15.admin.ClinicTimeZone\x12E\n" +
+	"\x14UpdateClinicTimeZone\x12\x15.admin.ClinicTimeZone\x1a\x16.admin.DefaultResponse\x12S\n" +
+	"\x10GetScheduleRules\x12\x1e.admin.GetScheduleRulesRequest\x1a\x1f.admin.GetScheduleRulesResponse\x12F\n" +
+	"\x0fAddScheduleRule\x12\x13.admin.ScheduleRule\x1a\x1e.admin.AddScheduleRuleResponse\x12B\n" +
+	"\x12DeleteScheduleRule\x12\x14.admin.DeleteRequest\x1a\x16.admin.DefaultResponse\x12b\n" +
+	"\x15ImportHolidayCalendar\x12#.admin.ImportHolidayCalendarRequest\x1a$.admin.ImportHolidayCalendarResponse\x12@\n" +
 	"\vAddMaterial\x12\x19.admin.AddMaterialRequest\x1a\x16.admin.DefaultResponse\x12>\n" +
 	"\n" +
 	"AddService\x12\x18.admin.AddServiceRequest\x1a\x16.admin.DefaultResponse\x12F\n" +
 	"\x0eUpdateMaterial\x12\x1c.admin.UpdateMaterialRequest\x1a\x16.admin.DefaultResponse\x12D\n" +
 	"\rUpdateService\x12\x1b.admin.UpdateServiceRequest\x1a\x16.admin.DefaultResponse\x12>\n" +
 	"\x0eDeleteMaterial\x12\x14.admin.DeleteRequest\x1a\x16.admin.DefaultResponse\x12=\n" +
-	"\rDeleteService\x12\x14.admin.DeleteRequest\x1a\x16.admin.DefaultResponse\x12:\n" +
-	"\tGetAdmins\x12\x13.admin.EmptyRequest\x1a\x18.admin.GetAdminsResponse\x12>\n" +
-	"\vGetPatients\x12\x13.admin.EmptyRequest\x1a\x1a.admin.GetPatientsResponse\x12<\n" +
+	"\rDeleteService\x12\x14.admin.DeleteRequest\x1a\x16.admin.DefaultResponse\x12P\n" +
+	"\x14ReceiveMaterialBatch\x12\".admin.ReceiveMaterialBatchRequest\x1a\x14.admin.MaterialBatch\x12P\n" +
+	"\x13AdjustMaterialStock\x12!.admin.AdjustMaterialStockRequest\x1a\x16.admin.DefaultResponse\x12G\n" +
+	"\x10GetMaterialStock\x12\x15.admin.GetByIdRequest\x1a\x1c.admin.MaterialStockResponse\x12G\n" +
+	"\x0eGetStockReport\x12\x19.admin.StockReportRequest\x1a\x1a.admin.StockReportResponse\x12P\n" +
+	"\x0fGetPriceHistory\x12\x1d.admin.GetPriceHistoryRequest\x1a\x1e.admin.GetPriceHistoryResponse\x12V\n" +
+	"\x11AddPriceListEntry\x12\x1f.admin.AddPriceListEntryRequest\x1a .admin.AddPriceListEntryResponse\x12R\n" +
+	"\x14DeletePriceListEntry\x12\".admin.DeletePriceListEntryRequest\x1a\x16.admin.DefaultResponse\x12M\n" +
+	"\x0eGetPriceOnDate\x12\x1c.admin.GetPriceOnDateRequest\x1a\x1d.admin.GetPriceOnDateResponse\x12R\n" +
+	"\x15GetClinicalAlertRules\x12\x13.admin.EmptyRequest\x1a$.admin.GetClinicalAlertRulesResponse\x12U\n" +
+	"\x14AddClinicalAlertRule\x12\x18.admin.ClinicalAlertRule\x1a#.admin.AddClinicalAlertRuleResponse\x12K\n" +
+	"\x17UpdateClinicalAlertRule\x12\x18.admin.ClinicalAlertRule\x1a\x16.admin.DefaultResponse\x12G\n" +
+	"\x17DeleteClinicalAlertRule\x12\x14.admin.DeleteRequest\x1a\x16.admin.DefaultResponse\x12V\n" +
+	"\x16GetPatientAccessGrants\x12\x15.admin.GetByIdRequest\x1a%.admin.GetPatientAccessGrantsResponse\x12X\n" +
+	"\x15AddPatientAccessGrant\x12\x19.admin.PatientAccessGrant\x1a$.admin.AddPatientAccessGrantResponse\x12H\n" +
+	"\x18RevokePatientAccessGrant\x12\x14.admin.DeleteRequest\x1a\x16.admin.DefaultResponse\x12>\n" +
+	"\tGetAdmins\x12\x17.admin.GetAdminsRequest\x1a\x18.admin.GetAdminsResponse\x12D\n" +
+	"\vGetPatients\x12\x19.admin.GetPatientsRequest\x1a\x1a.admin.GetPatientsResponse\x12A\n" +
 	"\n" +
-	"GetDoctors\x12\x13.admin.EmptyRequest\x1a\x19.admin.GetDoctorsResponse\x128\n" +
+	"GetDoctors\x12\x18.admin.GetDoctorsRequest\x1a\x19.admin.GetDoctorsResponse\x128\n" +
 	"\bGetSpecs\x12\x13.admin.EmptyRequest\x1a\x17.admin.GetSpecsResponse\x12B\n" +
 	"\fUpdateDoctor\x12\x1a.admin.UpdateDoctorRequest\x1a\x16.admin.DefaultResponse\x12@\n" +
 	"\vUpdateAdmin\x12\x19.admin.UpdateAdminRequest\x1a\x16.admin.DefaultResponse\x12D\n" +
-	"\rUpdatePatient\x12\x1b.admin.UpdatePatientRequest\x1a\x16.admin.DefaultResponse\x12:\n" +
+	"\rUpdatePatient\x12\x1b.admin.UpdatePatientRequest\x1a\x16.admin.DefaultResponse\x12M\n" +
 	"\n" +
-	"DeleteUser\x12\x14.admin.DeleteRequest\x1a\x16.admin.DefaultResponse\x12L\n" +
+	"RetireUser\x12\x1e.admin.ChangeUserStatusRequest\x1a\x1f.admin.ChangeUserStatusResponse\x12N\n" +
+	"\vRestoreUser\x12\x1e.admin.ChangeUserStatusRequest\x1a\x1f.admin.ChangeUserStatusResponse\x12L\n" +
 	"\x13UpdateEmployeeLogin\x12\x1d.admin.UpdateUserLoginRequest\x1a\x16.admin.DefaultResponse\x12K\n" +
-	"\x12UpdatePatientLogin\x12\x1d.admin.UpdateUserLoginRequest\x1a\x16.admin.DefaultResponse\x12[\n" +
-	"\x1bGetUnconfirmedVisitPayments\x12\x13.admin.EmptyRequest\x1a'.admin.UnconfirmedVisitPaymentsResponse\x12J\n" +
-	"\x15GetClinicScheduleGrid\x12\x13.admin.EmptyRequest\x1a\x1c.admin.AdminScheduleOverview\x12N\n" +
-	"\x12UpdateVisitPayment\x12 .admin.UpdateVisitPaymentRequest\x1a\x16.admin.DefaultResponse\x12b\n" +
-	"\x1cGetVisitMaterialsAndServices\x12\x15.admin.GetByIdRequest\x1a+.admin.GetVisitMaterialsAndServicesResponse\x12[\n" +
-	"\x1aGetUnconfirmedAppointments\x12\x13.admin.EmptyRequest\x1a(.admin.GetUnconfirmedAppointmentResponse\x12L\n" +
-	"\x11UpdateAppointment\x12\x1f.admin.UpdateAppointmentRequest\x1a\x16.admin.DefaultResponseB\x15Z\x13admin/proto;adminpbb\x06proto3"
+	"\x12UpdatePatientLogin\x12\x1d.admin.UpdateUserLoginRequest\x1a\x16.admin.DefaultResponse\x12q\n" +
+	"\x1bGetUnconfirmedVisitPayments\x12).admin.GetUnconfirmedVisitPaymentsRequest\x1a'.admin.UnconfirmedVisitPaymentsResponse\x12J\n" +
+	"\x15GetClinicScheduleGrid\x12\x13.admin.EmptyRequest\x1a\x1c.admin.AdminScheduleOverview\x12@\n" +
+	"\x0fGetVisitInvoice\x12\x15.admin.GetByIdRequest\x1a\x16.admin.InvoiceResponse\x12;\n" +
+	"\n" +
+	"GetInvoice\x12\x15.admin.GetByIdRequest\x1a\x16.admin.InvoiceResponse\x12N\n" +
+	"\x12SetInvoiceDiscount\x12 .admin.SetInvoiceDiscountRequest\x1a\x16.admin.InvoiceResponse\x12L\n" +
+	"\x11AddInvoicePayment\x12\x1f.admin.AddInvoicePaymentRequest\x1a\x16.admin.InvoiceResponse\x12R\n" +
+	"\x14RefundInvoicePayment\x12\".admin.RefundInvoicePaymentRequest\x1a\x16.admin.InvoiceResponse\x12I\n" +
+	"\x11GetPatientBalance\x12\x15.admin.GetByIdRequest\x1a\x1d.admin.PatientBalanceResponse\x12K\n" +
+	"\x12GetInvoiceDocument\x12\x15.admin.GetByIdRequest\x1a\x1e.admin.BillingDocumentResponse\x12K\n" +
+	"\x12GetReceiptDocument\x12\x15.admin.GetByIdRequest\x1a\x1e.admin.BillingDocumentResponse\x12b\n" +
+	"\x1cGetVisitMaterialsAndServices\x12\x15.admin.GetByIdRequest\x1a+.admin.GetVisitMaterialsAndServicesResponse\x12p\n" +
+	"\x1aGetUnconfirmedAppointments\x12(.admin.GetUnconfirmedAppointmentsRequest\x1a(.admin.GetUnconfirmedAppointmentResponse\x12L\n" +
+	"\x11UpdateAppointment\x12\x1f.admin.UpdateAppointmentRequest\x1a\x16.admin.DefaultResponse\x12D\n" +
+	"\vGetAuditLog\x12\x19.admin.GetAuditLogRequest\x1a\x1a.admin.GetAuditLogResponse\x12D\n" +
+	"\x0eVerifyAuditLog\x12\x13.admin.EmptyRequest\x1a\x1d.admin.VerifyAuditLogResponseB\x15Z\x13admin/proto;adminpbb\x06proto3"
 
 var (
 	file_proto_admin_admin_proto_rawDescOnce sync.Once
@@ -3236,7 +7007,7 @@ func file_proto_admin_admin_proto_rawDescGZIP() []byte {
 	return file_proto_admin_admin_proto_rawDescData
 }
 
-var file_proto_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_proto_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 93)
 var file_proto_admin_admin_proto_goTypes = []any{
 	(*WeeklyClinicSchedule)(nil),                 // 0: admin.WeeklyClinicSchedule
 	(*UpdateClinicWeeklyScheduleRequest)(nil),    // 1: admin.UpdateClinicWeeklyScheduleRequest
@@ -3251,129 +7022,287 @@ var file_proto_admin_admin_proto_goTypes = []any{
 	(*UpdateMaterialRequest)(nil),                // 10: admin.UpdateMaterialRequest
 	(*UpdateServiceRequest)(nil),                 // 11: admin.UpdateServiceRequest
 	(*Material)(nil),                             // 12: admin.Material
-	(*Service)(nil),                              // 13: admin.Service
-	(*DeleteRequest)(nil),                        // 14: admin.DeleteRequest
-	(*ServiceType)(nil),                          // 15: admin.ServiceType
-	(*Admin)(nil),                                // 16: admin.Admin
-	(*GetAdminsResponse)(nil),                    // 17: admin.GetAdminsResponse
-	(*DoctorWithSpecs)(nil),                      // 18: admin.DoctorWithSpecs
-	(*GetDoctorsResponse)(nil),                   // 19: admin.GetDoctorsResponse
-	(*UpdateDoctorRequest)(nil),                  // 20: admin.UpdateDoctorRequest
-	(*UpdateAdminRequest)(nil),                   // 21: admin.UpdateAdminRequest
-	(*UpdatePatientRequest)(nil),                 // 22: admin.UpdatePatientRequest
-	(*Patient)(nil),                              // 23: admin.Patient
-	(*GetPatientsResponse)(nil),                  // 24: admin.GetPatientsResponse
-	(*EmptyRequest)(nil),                         // 25: admin.EmptyRequest
-	(*Spec)(nil),                                 // 26: admin.Spec
-	(*GetSpecsResponse)(nil),                     // 27: admin.GetSpecsResponse
-	(*UpdateUserLoginRequest)(nil),               // 28: admin.UpdateUserLoginRequest
-	(*UnconfirmedVisitPayment)(nil),              // 29: admin.UnconfirmedVisitPayment
-	(*UnconfirmedVisitPaymentsResponse)(nil),     // 30: admin.UnconfirmedVisitPaymentsResponse
-	(*AdminScheduleOverview)(nil),                // 31: admin.AdminScheduleOverview
-	(*ScheduleDay)(nil),                          // 32: admin.ScheduleDay
-	(*AppointmentEntry)(nil),                     // 33: admin.AppointmentEntry
-	(*Appointment)(nil),                          // 34: admin.Appointment
-	(*GetUnconfirmedAppointmentResponse)(nil),    // 35: admin.GetUnconfirmedAppointmentResponse
-	(*Person)(nil),                               // 36: admin.Person
-	(*VisitPayment)(nil),                         // 37: admin.VisitPayment
-	(*UpdateVisitPaymentRequest)(nil),            // 38: admin.UpdateVisitPaymentRequest
-	(*GetVisitMaterialsAndServices)(nil),         // 39: admin.GetVisitMaterialsAndServices
-	(*GetVisitMaterialsAndServicesResponse)(nil), // 40: admin.GetVisitMaterialsAndServicesResponse
-	(*GetByIdRequest)(nil),                       // 41: admin.GetByIdRequest
-	(*UpdateAppointment)(nil),                    // 42: admin.UpdateAppointment
-	(*UpdateAppointmentRequest)(nil),             // 43: admin.UpdateAppointmentRequest
-	(*timestamppb.Timestamp)(nil),                // 44: google.protobuf.Timestamp
+	(*MaterialBatch)(nil),                        // 13: admin.MaterialBatch
+	(*StockMovement)(nil),                        // 14: admin.StockMovement
+	(*ReceiveMaterialBatchRequest)(nil),          // 15: admin.ReceiveMaterialBatchRequest
+	(*AdjustMaterialStockRequest)(nil),           // 16: admin.AdjustMaterialStockRequest
+	(*MaterialStockResponse)(nil),                // 17: admin.MaterialStockResponse
+	(*StockReportRequest)(nil),                   // 18: admin.StockReportRequest
+	(*ExpiringBatch)(nil),                        // 19: admin.ExpiringBatch
+	(*StockReportResponse)(nil),                  // 20: admin.StockReportResponse
+	(*ClinicalAlertRule)(nil),                    // 21: admin.ClinicalAlertRule
+	(*GetClinicalAlertRulesResponse)(nil),        // 22: admin.GetClinicalAlertRulesResponse
+	(*AddClinicalAlertRuleResponse)(nil),         // 23: admin.AddClinicalAlertRuleResponse
+	(*PatientAccessGrant)(nil),                   // 24: admin.PatientAccessGrant
+	(*GetPatientAccessGrantsResponse)(nil),       // 25: admin.GetPatientAccessGrantsResponse
+	(*AddPatientAccessGrantResponse)(nil),        // 26: admin.AddPatientAccessGrantResponse
+	(*Service)(nil),                              // 27: admin.Service
+	(*DeleteRequest)(nil),                        // 28: admin.DeleteRequest
+	(*ChangeUserStatusRequest)(nil),              // 29: admin.ChangeUserStatusRequest
+	(*ChangeUserStatusResponse)(nil),             // 30: admin.ChangeUserStatusResponse
+	(*ServiceType)(nil),                          // 31: admin.ServiceType
+	(*Admin)(nil),                                // 32: admin.Admin
+	(*ListPage)(nil),                             // 33: admin.ListPage
+	(*GetAdminsRequest)(nil),                     // 34: admin.GetAdminsRequest
+	(*GetAdminsResponse)(nil),                    // 35: admin.GetAdminsResponse
+	(*DoctorWithSpecs)(nil),                      // 36: admin.DoctorWithSpecs
+	(*GetDoctorsRequest)(nil),                    // 37: admin.GetDoctorsRequest
+	(*GetDoctorsResponse)(nil),                   // 38: admin.GetDoctorsResponse
+	(*UpdateDoctorRequest)(nil),                  // 39: admin.UpdateDoctorRequest
+	(*UpdateAdminRequest)(nil),                   // 40: admin.UpdateAdminRequest
+	(*UpdatePatientRequest)(nil),                 // 41: admin.UpdatePatientRequest
+	(*Patient)(nil),                              // 42: admin.Patient
+	(*GetPatientsRequest)(nil),                   // 43: admin.GetPatientsRequest
+	(*GetPatientsResponse)(nil),                  // 44: admin.GetPatientsResponse
+	(*EmptyRequest)(nil),                         // 45: admin.EmptyRequest
+	(*Spec)(nil),                                 // 46: admin.Spec
+	(*GetSpecsResponse)(nil),                     // 47: admin.GetSpecsResponse
+	(*UpdateUserLoginRequest)(nil),               // 48: admin.UpdateUserLoginRequest
+	(*UnconfirmedVisitPayment)(nil),              // 49: admin.UnconfirmedVisitPayment
+	(*GetUnconfirmedVisitPaymentsRequest)(nil),   // 50: admin.GetUnconfirmedVisitPaymentsRequest
+	(*UnconfirmedVisitPaymentsResponse)(nil),     // 51: admin.UnconfirmedVisitPaymentsResponse
+	(*AdminScheduleOverview)(nil),                // 52: admin.AdminScheduleOverview
+	(*ScheduleDay)(nil),                          // 53: admin.ScheduleDay
+	(*AppointmentEntry)(nil),                     // 54: admin.AppointmentEntry
+	(*Appointment)(nil),                          // 55: admin.Appointment
+	(*GetUnconfirmedAppointmentsRequest)(nil),    // 56: admin.GetUnconfirmedAppointmentsRequest
+	(*GetUnconfirmedAppointmentResponse)(nil),    // 57: admin.GetUnconfirmedAppointmentResponse
+	(*Person)(nil),                               // 58: admin.Person
+	(*Invoice)(nil),                              // 59: admin.Invoice
+	(*InvoiceLine)(nil),                          // 60: admin.InvoiceLine
+	(*InvoicePayment)(nil),                       // 61: admin.InvoicePayment
+	(*InvoiceResponse)(nil),                      // 62: admin.InvoiceResponse
+	(*SetInvoiceDiscountRequest)(nil),            // 63: admin.SetInvoiceDiscountRequest
+	(*AddInvoicePaymentRequest)(nil),             // 64: admin.AddInvoicePaymentRequest
+	(*RefundInvoicePaymentRequest)(nil),          // 65: admin.RefundInvoicePaymentRequest
+	(*BillingDocumentResponse)(nil),              // 66: admin.BillingDocumentResponse
+	(*PatientBalanceResponse)(nil),               // 67: admin.PatientBalanceResponse
+	(*GetVisitMaterialsAndServices)(nil),         // 68: admin.GetVisitMaterialsAndServices
+	(*GetVisitMaterialsAndServicesResponse)(nil), // 69: admin.GetVisitMaterialsAndServicesResponse
+	(*GetByIdRequest)(nil),                       // 70: admin.GetByIdRequest
+	(*UpdateAppointment)(nil),                    // 71: admin.UpdateAppointment
+	(*UpdateAppointmentRequest)(nil),             // 72: admin.UpdateAppointmentRequest
+	(*ClinicTimeZone)(nil),                       // 73: admin.ClinicTimeZone
+	(*ScheduleRule)(nil),                         // 74: admin.ScheduleRule
+	(*GetScheduleRulesRequest)(nil),              // 75: admin.GetScheduleRulesRequest
+	(*GetScheduleRulesResponse)(nil),             // 76: admin.GetScheduleRulesResponse
+	(*AddScheduleRuleResponse)(nil),              // 77: admin.AddScheduleRuleResponse
+	(*Holiday)(nil),                              // 78: admin.Holiday
+	(*ImportHolidayCalendarRequest)(nil),         // 79: admin.ImportHolidayCalendarRequest
+	(*ImportHolidayCalendarResponse)(nil),        // 80: admin.ImportHolidayCalendarResponse
+	(*PriceListEntry)(nil),                       // 81: admin.PriceListEntry
+	(*GetPriceHistoryRequest)(nil),               // 82: admin.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),              // 83: admin.GetPriceHistoryResponse
+	(*AddPriceListEntryRequest)(nil),             // 84: admin.AddPriceListEntryRequest
+	(*AddPriceListEntryResponse)(nil),            // 85: admin.AddPriceListEntryResponse
+	(*DeletePriceListEntryRequest)(nil),          // 86: admin.DeletePriceListEntryRequest
+	(*GetPriceOnDateRequest)(nil),                // 87: admin.GetPriceOnDateRequest
+	(*GetPriceOnDateResponse)(nil),               // 88: admin.GetPriceOnDateResponse
+	(*AuditEntry)(nil),                           // 89: admin.AuditEntry
+	(*GetAuditLogRequest)(nil),                   // 90: admin.GetAuditLogRequest
+	(*GetAuditLogResponse)(nil),                  // 91: admin.GetAuditLogResponse
+	(*VerifyAuditLogResponse)(nil),               // 92: admin.VerifyAuditLogResponse
+	(*timestamppb.Timestamp)(nil),                // 93: google.protobuf.Timestamp
 }
 var file_proto_admin_admin_proto_depIdxs = []int32{
-	44, // 0: admin.WeeklyClinicSchedule.start_time:type_name -> google.protobuf.Timestamp
-	44, // 1: admin.WeeklyClinicSchedule.end_time:type_name -> google.protobuf.Timestamp
-	0,  // 2: admin.UpdateClinicWeeklyScheduleRequest.clinic_schedule:type_name -> admin.WeeklyClinicSchedule
-	44, // 3: admin.WeeklyDoctorSchedule.start_time:type_name -> google.protobuf.Timestamp
-	44, // 4: admin.WeeklyDoctorSchedule.end_time:type_name -> google.protobuf.Timestamp
-	2,  // 5: admin.AddDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> admin.WeeklyDoctorSchedule
-	2,  // 6: admin.UpdateDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> admin.WeeklyDoctorSchedule
-	44, // 7: admin.AddClinicDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	44, // 8: admin.AddClinicDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
-	44, // 9: admin.AddClinicDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
-	44, // 10: admin.AddDoctorDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	44, // 11: admin.AddDoctorDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
-	44, // 12: admin.AddDoctorDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
-	16, // 13: admin.GetAdminsResponse.admins:type_name -> admin.Admin
-	18, // 14: admin.GetDoctorsResponse.doctors:type_name -> admin.DoctorWithSpecs
-	23, // 15: admin.GetPatientsResponse.patients:type_name -> admin.Patient
-	26, // 16: admin.GetSpecsResponse.specs:type_name -> admin.Spec
-	39, // 17: admin.UnconfirmedVisitPayment.materials_and_services:type_name -> admin.GetVisitMaterialsAndServices
-	29, // 18: admin.UnconfirmedVisitPaymentsResponse.visit_payments:type_name -> admin.UnconfirmedVisitPayment
-	32, // 19: admin.AdminScheduleOverview.days:type_name -> admin.ScheduleDay
-	33, // 20: admin.AdminScheduleOverview.appointments:type_name -> admin.AppointmentEntry
-	36, // 21: admin.AppointmentEntry.doctor:type_name -> admin.Person
-	36, // 22: admin.AppointmentEntry.patient:type_name -> admin.Person
-	34, // 23: admin.GetUnconfirmedAppointmentResponse.appointments:type_name -> admin.Appointment
-	37, // 24: admin.UpdateVisitPaymentRequest.payment:type_name -> admin.VisitPayment
-	39, // 25: admin.GetVisitMaterialsAndServicesResponse.visit_materials_services:type_name -> admin.GetVisitMaterialsAndServices
-	44, // 26: admin.UpdateAppointment.date:type_name -> google.protobuf.Timestamp
-	44, // 27: admin.UpdateAppointment.time:type_name -> google.protobuf.Timestamp
-	44, // 28: admin.UpdateAppointment.updated_at:type_name -> google.protobuf.Timestamp
-	42, // 29: admin.UpdateAppointmentRequest.appt:type_name -> admin.UpdateAppointment
-	1,  // 30: admin.AdminService.UpdateClinicWeeklySchedule:input_type -> admin.UpdateClinicWeeklyScheduleRequest
-	3,  // 31: admin.AdminService.AddDoctorWeeklySchedule:input_type -> admin.AddDoctorWeeklyScheduleRequest
-	4,  // 32: admin.AdminService.UpdateDoctorWeeklySchedule:input_type -> admin.UpdateDoctorWeeklyScheduleRequest
-	6,  // 33: admin.AdminService.AddClinicDailyOverride:input_type -> admin.AddClinicDailyOverrideRequest
-	7,  // 34: admin.AdminService.AddDoctorDailyOverride:input_type -> admin.AddDoctorDailyOverrideRequest
-	8,  // 35: admin.AdminService.AddMaterial:input_type -> admin.AddMaterialRequest
-	9,  // 36: admin.AdminService.AddService:input_type -> admin.AddServiceRequest
-	10, // 37: admin.AdminService.UpdateMaterial:input_type -> admin.UpdateMaterialRequest
-	11, // 38: admin.AdminService.UpdateService:input_type -> admin.UpdateServiceRequest
-	14, // 39: admin.AdminService.DeleteMaterial:input_type -> admin.DeleteRequest
-	14, // 40: admin.AdminService.DeleteService:input_type -> admin.DeleteRequest
-	25, // 41: admin.AdminService.GetAdmins:input_type -> admin.EmptyRequest
-	25, // 42: admin.AdminService.GetPatients:input_type -> admin.EmptyRequest
-	25, // 43: admin.AdminService.GetDoctors:input_type -> admin.EmptyRequest
-	25, // 44: admin.AdminService.GetSpecs:input_type -> admin.EmptyRequest
-	20, // 45: admin.AdminService.UpdateDoctor:input_type -> admin.UpdateDoctorRequest
-	21, // 46: admin.AdminService.UpdateAdmin:input_type -> admin.UpdateAdminRequest
-	22, // 47: admin.AdminService.UpdatePatient:input_type -> admin.UpdatePatientRequest
-	14, // 48: admin.AdminService.DeleteUser:input_type -> admin.DeleteRequest
-	28, // 49: admin.AdminService.UpdateEmployeeLogin:input_type -> admin.UpdateUserLoginRequest
-	28, // 50: admin.AdminService.UpdatePatientLogin:input_type -> admin.UpdateUserLoginRequest
-	25, // 51: admin.AdminService.GetUnconfirmedVisitPayments:input_type -> admin.EmptyRequest
-	25, // 52: admin.AdminService.GetClinicScheduleGrid:input_type -> admin.EmptyRequest
-	38, // 53: admin.AdminService.UpdateVisitPayment:input_type -> admin.UpdateVisitPaymentRequest
-	41, // 54: admin.AdminService.GetVisitMaterialsAndServices:input_type -> admin.GetByIdRequest
-	25, // 55: admin.AdminService.GetUnconfirmedAppointments:input_type -> admin.EmptyRequest
-	43, // 56: admin.AdminService.UpdateAppointment:input_type -> admin.UpdateAppointmentRequest
-	5,  // 57: admin.AdminService.UpdateClinicWeeklySchedule:output_type -> admin.DefaultResponse
-	5,  // 58: admin.AdminService.AddDoctorWeeklySchedule:output_type -> admin.DefaultResponse
-	5,  // 59: admin.AdminService.UpdateDoctorWeeklySchedule:output_type -> admin.DefaultResponse
-	5,  // 60: admin.AdminService.AddClinicDailyOverride:output_type -> admin.DefaultResponse
-	5,  // 61: admin.AdminService.AddDoctorDailyOverride:output_type -> admin.DefaultResponse
-	5,  // 62: admin.AdminService.AddMaterial:output_type -> admin.DefaultResponse
-	5,  // 63: admin.AdminService.AddService:output_type -> admin.DefaultResponse
-	5,  // 64: admin.AdminService.UpdateMaterial:output_type -> admin.DefaultResponse
-	5,  // 65: admin.AdminService.UpdateService:output_type -> admin.DefaultResponse
-	5,  // 66: admin.AdminService.DeleteMaterial:output_type -> admin.DefaultResponse
-	5,  // 67: admin.AdminService.DeleteService:output_type -> admin.DefaultResponse
-	17, // 68: admin.AdminService.GetAdmins:output_type -> admin.GetAdminsResponse
-	24, // 69: admin.AdminService.GetPatients:output_type -> admin.GetPatientsResponse
-	19, // 70: admin.AdminService.GetDoctors:output_type -> admin.GetDoctorsResponse
-	27, // 71: admin.AdminService.GetSpecs:output_type -> admin.GetSpecsResponse
-	5,  // 72: admin.AdminService.UpdateDoctor:output_type -> admin.DefaultResponse
-	5,  // 73: admin.AdminService.UpdateAdmin:output_type -> admin.DefaultResponse
-	5,  // 74: admin.AdminService.UpdatePatient:output_type -> admin.DefaultResponse
-	5,  // 75: admin.AdminService.DeleteUser:output_type -> admin.DefaultResponse
-	5,  // 76: admin.AdminService.UpdateEmployeeLogin:output_type -> admin.DefaultResponse
-	5,  // 77: admin.AdminService.UpdatePatientLogin:output_type -> admin.DefaultResponse
-	30, // 78: admin.AdminService.GetUnconfirmedVisitPayments:output_type -> admin.UnconfirmedVisitPaymentsResponse
-	31, // 79: admin.AdminService.GetClinicScheduleGrid:output_type -> admin.AdminScheduleOverview
-	5,  // 80: admin.AdminService.UpdateVisitPayment:output_type -> admin.DefaultResponse
-	40, // 81: admin.AdminService.GetVisitMaterialsAndServices:output_type -> admin.GetVisitMaterialsAndServicesResponse
-	35, // 82: admin.AdminService.GetUnconfirmedAppointments:output_type -> admin.GetUnconfirmedAppointmentResponse
-	5,  // 83: admin.AdminService.UpdateAppointment:output_type -> admin.DefaultResponse
-	57, // [57:84] is the sub-list for method output_type
-	30, // [30:57] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	93,  // 0: admin.WeeklyClinicSchedule.start_time:type_name -> google.protobuf.Timestamp
+	93,  // 1: admin.WeeklyClinicSchedule.end_time:type_name -> google.protobuf.Timestamp
+	0,   // 2: admin.UpdateClinicWeeklyScheduleRequest.clinic_schedule:type_name -> admin.WeeklyClinicSchedule
+	93,  // 3: admin.WeeklyDoctorSchedule.start_time:type_name -> google.protobuf.Timestamp
+	93,  // 4: admin.WeeklyDoctorSchedule.end_time:type_name -> google.protobuf.Timestamp
+	2,   // 5: admin.AddDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> admin.WeeklyDoctorSchedule
+	2,   // 6: admin.UpdateDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> admin.WeeklyDoctorSchedule
+	93,  // 7: admin.AddClinicDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	93,  // 8: admin.AddClinicDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
+	93,  // 9: admin.AddClinicDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
+	93,  // 10: admin.AddDoctorDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	93,  // 11: admin.AddDoctorDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
+	93,  // 12: admin.AddDoctorDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
+	93,  // 13: admin.MaterialBatch.expires_at:type_name -> google.protobuf.Timestamp
+	93,  // 14: admin.MaterialBatch.received_at:type_name -> google.protobuf.Timestamp
+	93,  // 15: admin.StockMovement.created_at:type_name -> google.protobuf.Timestamp
+	93,  // 16: admin.ReceiveMaterialBatchRequest.expires_at:type_name -> google.protobuf.Timestamp
+	12,  // 17: admin.MaterialStockResponse.material:type_name -> admin.Material
+	13,  // 18: admin.MaterialStockResponse.batches:type_name -> admin.MaterialBatch
+	14,  // 19: admin.MaterialStockResponse.movements:type_name -> admin.StockMovement
+	13,  // 20: admin.ExpiringBatch.batch:type_name -> admin.MaterialBatch
+	12,  // 21: admin.StockReportResponse.low_stock:type_name -> admin.Material
+	19,  // 22: admin.StockReportResponse.expiring:type_name -> admin.ExpiringBatch
+	93,  // 23: admin.ClinicalAlertRule.created_at:type_name -> google.protobuf.Timestamp
+	21,  // 24: admin.GetClinicalAlertRulesResponse.rules:type_name -> admin.ClinicalAlertRule
+	93,  // 25: admin.PatientAccessGrant.expires_at:type_name -> google.protobuf.Timestamp
+	93,  // 26: admin.PatientAccessGrant.revoked_at:type_name -> google.protobuf.Timestamp
+	93,  // 27: admin.PatientAccessGrant.created_at:type_name -> google.protobuf.Timestamp
+	24,  // 28: admin.GetPatientAccessGrantsResponse.grants:type_name -> admin.PatientAccessGrant
+	33,  // 29: admin.GetAdminsRequest.page:type_name -> admin.ListPage
+	32,  // 30: admin.GetAdminsResponse.admins:type_name -> admin.Admin
+	33,  // 31: admin.GetDoctorsRequest.page:type_name -> admin.ListPage
+	36,  // 32: admin.GetDoctorsResponse.doctors:type_name -> admin.DoctorWithSpecs
+	33,  // 33: admin.GetPatientsRequest.page:type_name -> admin.ListPage
+	42,  // 34: admin.GetPatientsResponse.patients:type_name -> admin.Patient
+	46,  // 35: admin.GetSpecsResponse.specs:type_name -> admin.Spec
+	68,  // 36: admin.UnconfirmedVisitPayment.materials_and_services:type_name -> admin.GetVisitMaterialsAndServices
+	33,  // 37: admin.GetUnconfirmedVisitPaymentsRequest.page:type_name -> admin.ListPage
+	49,  // 38: admin.UnconfirmedVisitPaymentsResponse.visit_payments:type_name -> admin.UnconfirmedVisitPayment
+	53,  // 39: admin.AdminScheduleOverview.days:type_name -> admin.ScheduleDay
+	54,  // 40: admin.AdminScheduleOverview.appointments:type_name -> admin.AppointmentEntry
+	58,  // 41: admin.AppointmentEntry.doctor:type_name -> admin.Person
+	58,  // 42: admin.AppointmentEntry.patient:type_name -> admin.Person
+	33,  // 43: admin.GetUnconfirmedAppointmentsRequest.page:type_name -> admin.ListPage
+	93,  // 44: admin.GetUnconfirmedAppointmentsRequest.date_from:type_name -> google.protobuf.Timestamp
+	93,  // 45: admin.GetUnconfirmedAppointmentsRequest.date_to:type_name -> google.protobuf.Timestamp
+	55,  // 46: admin.GetUnconfirmedAppointmentResponse.appointments:type_name -> admin.Appointment
+	93,  // 47: admin.Invoice.created_at:type_name -> google.protobuf.Timestamp
+	60,  // 48: admin.Invoice.lines:type_name -> admin.InvoiceLine
+	61,  // 49: admin.Invoice.payments:type_name -> admin.InvoicePayment
+	93,  // 50: admin.InvoicePayment.created_at:type_name -> google.protobuf.Timestamp
+	59,  // 51: admin.InvoiceResponse.invoice:type_name -> admin.Invoice
+	59,  // 52: admin.PatientBalanceResponse.open_invoices:type_name -> admin.Invoice
+	68,  // 53: admin.GetVisitMaterialsAndServicesResponse.visit_materials_services:type_name -> admin.GetVisitMaterialsAndServices
+	93,  // 54: admin.UpdateAppointment.date:type_name -> google.protobuf.Timestamp
+	93,  // 55: admin.UpdateAppointment.time:type_name -> google.protobuf.Timestamp
+	93,  // 56: admin.UpdateAppointment.updated_at:type_name -> google.protobuf.Timestamp
+	71,  // 57: admin.UpdateAppointmentRequest.appt:type_name -> admin.UpdateAppointment
+	93,  // 58: admin.ScheduleRule.date_from:type_name -> google.protobuf.Timestamp
+	93,  // 59: admin.ScheduleRule.date_to:type_name -> google.protobuf.Timestamp
+	93,  // 60: admin.ScheduleRule.start_time:type_name -> google.protobuf.Timestamp
+	93,  // 61: admin.ScheduleRule.end_time:type_name -> google.protobuf.Timestamp
+	74,  // 62: admin.GetScheduleRulesResponse.rules:type_name -> admin.ScheduleRule
+	55,  // 63: admin.AddScheduleRuleResponse.clashes:type_name -> admin.Appointment
+	93,  // 64: admin.Holiday.date:type_name -> google.protobuf.Timestamp
+	78,  // 65: admin.ImportHolidayCalendarRequest.holidays:type_name -> admin.Holiday
+	55,  // 66: admin.ImportHolidayCalendarResponse.clashes:type_name -> admin.Appointment
+	93,  // 67: admin.PriceListEntry.effective_from:type_name -> google.protobuf.Timestamp
+	93,  // 68: admin.PriceListEntry.created_at:type_name -> google.protobuf.Timestamp
+	81,  // 69: admin.GetPriceHistoryResponse.entries:type_name -> admin.PriceListEntry
+	81,  // 70: admin.AddPriceListEntryRequest.entry:type_name -> admin.PriceListEntry
+	93,  // 71: admin.GetPriceOnDateRequest.date:type_name -> google.protobuf.Timestamp
+	93,  // 72: admin.AuditEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	33,  // 73: admin.GetAuditLogRequest.page:type_name -> admin.ListPage
+	93,  // 74: admin.GetAuditLogRequest.date_from:type_name -> google.protobuf.Timestamp
+	93,  // 75: admin.GetAuditLogRequest.date_to:type_name -> google.protobuf.Timestamp
+	89,  // 76: admin.GetAuditLogResponse.entries:type_name -> admin.AuditEntry
+	1,   // 77: admin.AdminService.UpdateClinicWeeklySchedule:input_type -> admin.UpdateClinicWeeklyScheduleRequest
+	3,   // 78: admin.AdminService.AddDoctorWeeklySchedule:input_type -> admin.AddDoctorWeeklyScheduleRequest
+	4,   // 79: admin.AdminService.UpdateDoctorWeeklySchedule:input_type -> admin.UpdateDoctorWeeklyScheduleRequest
+	6,   // 80: admin.AdminService.AddClinicDailyOverride:input_type -> admin.AddClinicDailyOverrideRequest
+	7,   // 81: admin.AdminService.AddDoctorDailyOverride:input_type -> admin.AddDoctorDailyOverrideRequest
+	45,  // 82: admin.AdminService.GetClinicTimeZone:input_type -> admin.EmptyRequest
+	73,  // 83: admin.AdminService.UpdateClinicTimeZone:input_type -> admin.ClinicTimeZone
+	75,  // 84: admin.AdminService.GetScheduleRules:input_type -> admin.GetScheduleRulesRequest
+	74,  // 85: admin.AdminService.AddScheduleRule:input_type -> admin.ScheduleRule
+	28,  // 86: admin.AdminService.DeleteScheduleRule:input_type -> admin.DeleteRequest
+	79,  // 87: admin.AdminService.ImportHolidayCalendar:input_type -> admin.ImportHolidayCalendarRequest
+	8,   // 88: admin.AdminService.AddMaterial:input_type -> admin.AddMaterialRequest
+	9,   // 89: admin.AdminService.AddService:input_type -> admin.AddServiceRequest
+	10,  // 90: admin.AdminService.UpdateMaterial:input_type -> admin.UpdateMaterialRequest
+	11,  // 91: admin.AdminService.UpdateService:input_type -> admin.UpdateServiceRequest
+	28,  // 92: admin.AdminService.DeleteMaterial:input_type -> admin.DeleteRequest
+	28,  // 93: admin.AdminService.DeleteService:input_type -> admin.DeleteRequest
+	15,  // 94: admin.AdminService.ReceiveMaterialBatch:input_type -> admin.ReceiveMaterialBatchRequest
+	16,  // 95: admin.AdminService.AdjustMaterialStock:input_type -> admin.AdjustMaterialStockRequest
+	70,  // 96: admin.AdminService.GetMaterialStock:input_type -> admin.GetByIdRequest
+	18,  // 97: admin.AdminService.GetStockReport:input_type -> admin.StockReportRequest
+	82,  // 98: admin.AdminService.GetPriceHistory:input_type -> admin.GetPriceHistoryRequest
+	84,  // 99: admin.AdminService.AddPriceListEntry:input_type -> admin.AddPriceListEntryRequest
+	86,  // 100: admin.AdminService.DeletePriceListEntry:input_type -> admin.DeletePriceListEntryRequest
+	87,  // 101: admin.AdminService.GetPriceOnDate:input_type -> admin.GetPriceOnDateRequest
+	45,  // 102: admin.AdminService.GetClinicalAlertRules:input_type -> admin.EmptyRequest
+	21,  // 103: admin.AdminService.AddClinicalAlertRule:input_type -> admin.ClinicalAlertRule
+	21,  // 104: admin.AdminService.UpdateClinicalAlertRule:input_type -> admin.ClinicalAlertRule
+	28,  // 105: admin.AdminService.DeleteClinicalAlertRule:input_type -> admin.DeleteRequest
+	70,  // 106: admin.AdminService.GetPatientAccessGrants:input_type -> admin.GetByIdRequest
+	24,  // 107: admin.AdminService.AddPatientAccessGrant:input_type -> admin.PatientAccessGrant
+	28,  // 108: admin.AdminService.RevokePatientAccessGrant:input_type -> admin.DeleteRequest
+	34,  // 109: admin.AdminService.GetAdmins:input_type -> admin.GetAdminsRequest
+	43,  // 110: admin.AdminService.GetPatients:input_type -> admin.GetPatientsRequest
+	37,  // 111: admin.AdminService.GetDoctors:input_type -> admin.GetDoctorsRequest
+	45,  // 112: admin.AdminService.GetSpecs:input_type -> admin.EmptyRequest
+	39,  // 113: admin.AdminService.UpdateDoctor:input_type -> admin.UpdateDoctorRequest
+	40,  // 114: admin.AdminService.UpdateAdmin:input_type -> admin.UpdateAdminRequest
+	41,  // 115: admin.AdminService.UpdatePatient:input_type -> admin.UpdatePatientRequest
+	29,  // 116: admin.AdminService.RetireUser:input_type -> admin.ChangeUserStatusRequest
+	29,  // 117: admin.AdminService.RestoreUser:input_type -> admin.ChangeUserStatusRequest
+	48,  // 118: admin.AdminService.UpdateEmployeeLogin:input_type -> admin.UpdateUserLoginRequest
+	48,  // 119: admin.AdminService.UpdatePatientLogin:input_type -> admin.UpdateUserLoginRequest
+	50,  // 120: admin.AdminService.GetUnconfirmedVisitPayments:input_type -> admin.GetUnconfirmedVisitPaymentsRequest
+	45,  // 121: admin.AdminService.GetClinicScheduleGrid:input_type -> admin.EmptyRequest
+	70,  // 122: admin.AdminService.GetVisitInvoice:input_type -> admin.GetByIdRequest
+	70,  // 123: admin.AdminService.GetInvoice:input_type -> admin.GetByIdRequest
+	63,  // 124: admin.AdminService.SetInvoiceDiscount:input_type -> admin.SetInvoiceDiscountRequest
+	64,  // 125: admin.AdminService.AddInvoicePayment:input_type -> admin.AddInvoicePaymentRequest
+	65,  // 126: admin.AdminService.RefundInvoicePayment:input_type -> admin.RefundInvoicePaymentRequest
+	70,  // 127: admin.AdminService.GetPatientBalance:input_type -> admin.GetByIdRequest
+	70,  // 128: admin.AdminService.GetInvoiceDocument:input_type -> admin.GetByIdRequest
+	70,  // 129: admin.AdminService.GetReceiptDocument:input_type -> admin.GetByIdRequest
+	70,  // 130: admin.AdminService.GetVisitMaterialsAndServices:input_type -> admin.GetByIdRequest
+	56,  // 131: admin.AdminService.GetUnconfirmedAppointments:input_type -> admin.GetUnconfirmedAppointmentsRequest
+	72,  // 132: admin.AdminService.UpdateAppointment:input_type -> admin.UpdateAppointmentRequest
+	90,  // 133: admin.AdminService.GetAuditLog:input_type -> admin.GetAuditLogRequest
+	45,  // 134: admin.AdminService.VerifyAuditLog:input_type -> admin.EmptyRequest
+	5,   // 135: admin.AdminService.UpdateClinicWeeklySchedule:output_type -> admin.DefaultResponse
+	5,   // 136: admin.AdminService.AddDoctorWeeklySchedule:output_type -> admin.DefaultResponse
+	5,   // 137: admin.AdminService.UpdateDoctorWeeklySchedule:output_type -> admin.DefaultResponse
+	5,   // 138: admin.AdminService.AddClinicDailyOverride:output_type -> admin.DefaultResponse
+	5,   // 139: admin.AdminService.AddDoctorDailyOverride:output_type -> admin.DefaultResponse
+	73,  // 140: admin.AdminService.GetClinicTimeZone:output_type -> admin.ClinicTimeZone
+	5,   // 141: admin.AdminService.UpdateClinicTimeZone:output_type -> admin.DefaultResponse
+	76,  // 142: admin.AdminService.GetScheduleRules:output_type -> admin.GetScheduleRulesResponse
+	77,  // 143: admin.AdminService.AddScheduleRule:output_type -> admin.AddScheduleRuleResponse
+	5,   // 144: admin.AdminService.DeleteScheduleRule:output_type -> admin.DefaultResponse
+	80,  // 145: admin.AdminService.ImportHolidayCalendar:output_type -> admin.ImportHolidayCalendarResponse
+	5,   // 146: admin.AdminService.AddMaterial:output_type -> admin.DefaultResponse
+	5,   // 147: admin.AdminService.AddService:output_type -> admin.DefaultResponse
+	5,   // 148: admin.AdminService.UpdateMaterial:output_type -> admin.DefaultResponse
+	5,   // 149: admin.AdminService.UpdateService:output_type -> admin.DefaultResponse
+	5,   // 150: admin.AdminService.DeleteMaterial:output_type -> admin.DefaultResponse
+	5,   // 151: admin.AdminService.DeleteService:output_type -> admin.DefaultResponse
+	13,  // 152: admin.AdminService.ReceiveMaterialBatch:output_type -> admin.MaterialBatch
+	5,   // 153: admin.AdminService.AdjustMaterialStock:output_type -> admin.DefaultResponse
+	17,  // 154: admin.AdminService.GetMaterialStock:output_type -> admin.MaterialStockResponse
+	20,  // 155: admin.AdminService.GetStockReport:output_type -> admin.StockReportResponse
+	83,  // 156: admin.AdminService.GetPriceHistory:output_type -> admin.GetPriceHistoryResponse
+	85,  // 157: admin.AdminService.AddPriceListEntry:output_type -> admin.AddPriceListEntryResponse
+	5,   // 158: admin.AdminService.DeletePriceListEntry:output_type -> admin.DefaultResponse
+	88,  // 159: admin.AdminService.GetPriceOnDate:output_type -> admin.GetPriceOnDateResponse
+	22,  // 160: admin.AdminService.GetClinicalAlertRules:output_type -> admin.GetClinicalAlertRulesResponse
+	23,  // 161: admin.AdminService.AddClinicalAlertRule:output_type -> admin.AddClinicalAlertRuleResponse
+	5,   // 162: admin.AdminService.UpdateClinicalAlertRule:output_type -> admin.DefaultResponse
+	5,   // 163: admin.AdminService.DeleteClinicalAlertRule:output_type -> admin.DefaultResponse
+	25,  // 164: admin.AdminService.GetPatientAccessGrants:output_type -> admin.GetPatientAccessGrantsResponse
+	26,  // 165: admin.AdminService.AddPatientAccessGrant:output_type -> admin.AddPatientAccessGrantResponse
+	5,   // 166: admin.AdminService.RevokePatientAccessGrant:output_type -> admin.DefaultResponse
+	35,  // 167: admin.AdminService.GetAdmins:output_type -> admin.GetAdminsResponse
+	44,  // 168: admin.AdminService.GetPatients:output_type -> admin.GetPatientsResponse
+	38,  // 169: admin.AdminService.GetDoctors:output_type -> admin.GetDoctorsResponse
+	47,  // 170: admin.AdminService.GetSpecs:output_type -> admin.GetSpecsResponse
+	5,   // 171: admin.AdminService.UpdateDoctor:output_type -> admin.DefaultResponse
+	5,   // 172: admin.AdminService.UpdateAdmin:output_type -> admin.DefaultResponse
+	5,   // 173: admin.AdminService.UpdatePatient:output_type -> admin.DefaultResponse
+	30,  // 174: admin.AdminService.RetireUser:output_type -> admin.ChangeUserStatusResponse
+	30,  // 175: admin.AdminService.RestoreUser:output_type -> admin.ChangeUserStatusResponse
+	5,   // 176: admin.AdminService.UpdateEmployeeLogin:output_type -> admin.DefaultResponse
+	5,   // 177: admin.AdminService.UpdatePatientLogin:output_type -> admin.DefaultResponse
+	51,  // 178: admin.AdminService.GetUnconfirmedVisitPayments:output_type -> admin.UnconfirmedVisitPaymentsResponse
+	52,  // 179: admin.AdminService.GetClinicScheduleGrid:output_type -> admin.AdminScheduleOverview
+	62,  // 180: admin.AdminService.GetVisitInvoice:output_type -> admin.InvoiceResponse
+	62,  // 181: admin.AdminService.GetInvoice:output_type -> admin.InvoiceResponse
+	62,  // 182: admin.AdminService.SetInvoiceDiscount:output_type -> admin.InvoiceResponse
+	62,  // 183: admin.AdminService.AddInvoicePayment:output_type -> admin.InvoiceResponse
+	62,  // 184: admin.AdminService.RefundInvoicePayment:output_type -> admin.InvoiceResponse
+	67,  // 185: admin.AdminService.GetPatientBalance:output_type -> admin.PatientBalanceResponse
+	66,  // 186: admin.AdminService.GetInvoiceDocument:output_type -> admin.BillingDocumentResponse
+	66,  // 187: admin.AdminService.GetReceiptDocument:output_type -> admin.BillingDocumentResponse
+	69,  // 188: admin.AdminService.GetVisitMaterialsAndServices:output_type -> admin.GetVisitMaterialsAndServicesResponse
+	57,  // 189: admin.AdminService.GetUnconfirmedAppointments:output_type -> admin.GetUnconfirmedAppointmentResponse
+	5,   // 190: admin.AdminService.UpdateAppointment:output_type -> admin.DefaultResponse
+	91,  // 191: admin.AdminService.GetAuditLog:output_type -> admin.GetAuditLogResponse
+	92,  // 192: admin.AdminService.VerifyAuditLog:output_type -> admin.VerifyAuditLogResponse
+	135, // [135:193] is the sub-list for method output_type
+	77,  // [77:135] is the sub-list for method input_type
+	77,  // [77:77] is the sub-list for extension type_name
+	77,  // [77:77] is the sub-list for extension extendee
+	0,   // [0:77] is the sub-list for field type_name
 }
 
 func init() { file_proto_admin_admin_proto_init() }
@@ -3381,13 +7310,14 @@ func file_proto_admin_admin_proto_init() {
 	if File_proto_admin_admin_proto != nil {
 		return
 	}
+	file_proto_admin_admin_proto_msgTypes[68].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_admin_admin_proto_rawDesc), len(file_proto_admin_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   44,
+			NumMessages:   93,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
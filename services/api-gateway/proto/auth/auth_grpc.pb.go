@@ -32,8 +32,13 @@ const (
 	AuthService_PermissionCheck_FullMethodName          = "/auth.AuthService/PermissionCheck"
 	AuthService_GetPatient_FullMethodName               = "/auth.AuthService/GetPatient"
 	AuthService_GetUserID_FullMethodName                = "/auth.AuthService/GetUserID"
+	AuthService_GetUserRole_FullMethodName              = "/auth.AuthService/GetUserRole"
 	AuthService_GetDoctorProfile_FullMethodName         = "/auth.AuthService/GetDoctorProfile"
 	AuthService_GetAdminProfile_FullMethodName          = "/auth.AuthService/GetAdminProfile"
+	AuthService_RefreshToken_FullMethodName             = "/auth.AuthService/RefreshToken"
+	AuthService_Logout_FullMethodName                   = "/auth.AuthService/Logout"
+	AuthService_LogoutAllSessions_FullMethodName        = "/auth.AuthService/LogoutAllSessions"
+	AuthService_RevokeUserSessions_FullMethodName       = "/auth.AuthService/RevokeUserSessions"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -48,11 +53,16 @@ type AuthServiceClient interface {
 	RequestCode(ctx context.Context, in *GenerateCodeRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	VerifyCode(ctx context.Context, in *VerifyCodeRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
-	PermissionCheck(ctx context.Context, in *PermissionCheckRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	PermissionCheck(ctx context.Context, in *PermissionCheckRequest, opts ...grpc.CallOption) (*PermissionCheckResponse, error)
 	GetPatient(ctx context.Context, in *GetPatientRequest, opts ...grpc.CallOption) (*GetPatientResponse, error)
 	GetUserID(ctx context.Context, in *GetUserIDRequest, opts ...grpc.CallOption) (*GetUserIDResponse, error)
+	GetUserRole(ctx context.Context, in *GetUserIDRequest, opts ...grpc.CallOption) (*GetUserRoleResponse, error)
 	GetDoctorProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetDoctorResponse, error)
 	GetAdminProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetAdminWithRoleResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	LogoutAllSessions(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	RevokeUserSessions(ctx context.Context, in *RevokeUserSessionsRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 }
 
 type authServiceClient struct {
@@ -143,9 +153,9 @@ func (c *authServiceClient) Auth(ctx context.Context, in *AuthRequest, opts ...g
 	return out, nil
 }
 
-func (c *authServiceClient) PermissionCheck(ctx context.Context, in *PermissionCheckRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *authServiceClient) PermissionCheck(ctx context.Context, in *PermissionCheckRequest, opts ...grpc.CallOption) (*PermissionCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DefaultResponse)
+	out := new(PermissionCheckResponse)
 	err := c.cc.Invoke(ctx, AuthService_PermissionCheck_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -173,6 +183,16 @@ func (c *authServiceClient) GetUserID(ctx context.Context, in *GetUserIDRequest,
 	return out, nil
 }
 
+func (c *authServiceClient) GetUserRole(ctx context.Context, in *GetUserIDRequest, opts ...grpc.CallOption) (*GetUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserRoleResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) GetDoctorProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetDoctorResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDoctorResponse)
@@ -193,6 +213,46 @@ func (c *authServiceClient) GetAdminProfile(ctx context.Context, in *GetProfileR
 	return out, nil
 }
 
+func (c *authServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, AuthService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, AuthService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) LogoutAllSessions(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, AuthService_LogoutAllSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) RevokeUserSessions(ctx context.Context, in *RevokeUserSessionsRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, AuthService_RevokeUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -205,11 +265,16 @@ type AuthServiceServer interface {
 	RequestCode(context.Context, *GenerateCodeRequest) (*DefaultResponse, error)
 	VerifyCode(context.Context, *VerifyCodeRequest) (*DefaultResponse, error)
 	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
-	PermissionCheck(context.Context, *PermissionCheckRequest) (*DefaultResponse, error)
+	PermissionCheck(context.Context, *PermissionCheckRequest) (*PermissionCheckResponse, error)
 	GetPatient(context.Context, *GetPatientRequest) (*GetPatientResponse, error)
 	GetUserID(context.Context, *GetUserIDRequest) (*GetUserIDResponse, error)
+	GetUserRole(context.Context, *GetUserIDRequest) (*GetUserRoleResponse, error)
 	GetDoctorProfile(context.Context, *GetProfileRequest) (*GetDoctorResponse, error)
 	GetAdminProfile(context.Context, *GetProfileRequest) (*GetAdminWithRoleResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	Logout(context.Context, *LogoutRequest) (*DefaultResponse, error)
+	LogoutAllSessions(context.Context, *LogoutRequest) (*DefaultResponse, error)
+	RevokeUserSessions(context.Context, *RevokeUserSessionsRequest) (*DefaultResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -244,7 +309,7 @@ func (UnimplementedAuthServiceServer) VerifyCode(context.Context, *VerifyCodeReq
 func (UnimplementedAuthServiceServer) Auth(context.Context, *AuthRequest) (*AuthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
 }
-func (UnimplementedAuthServiceServer) PermissionCheck(context.Context, *PermissionCheckRequest) (*DefaultResponse, error) {
+func (UnimplementedAuthServiceServer) PermissionCheck(context.Context, *PermissionCheckRequest) (*PermissionCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PermissionCheck not implemented")
 }
 func (UnimplementedAuthServiceServer) GetPatient(context.Context, *GetPatientRequest) (*GetPatientResponse, error) {
@@ -253,12 +318,27 @@ func (UnimplementedAuthServiceServer) GetPatient(context.Context, *GetPatientReq
 func (UnimplementedAuthServiceServer) GetUserID(context.Context, *GetUserIDRequest) (*GetUserIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserID not implemented")
 }
+func (UnimplementedAuthServiceServer) GetUserRole(context.Context, *GetUserIDRequest) (*GetUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserRole not implemented")
+}
 func (UnimplementedAuthServiceServer) GetDoctorProfile(context.Context, *GetProfileRequest) (*GetDoctorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorProfile not implemented")
 }
 func (UnimplementedAuthServiceServer) GetAdminProfile(context.Context, *GetProfileRequest) (*GetAdminWithRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAdminProfile not implemented")
 }
+func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedAuthServiceServer) Logout(context.Context, *LogoutRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedAuthServiceServer) LogoutAllSessions(context.Context, *LogoutRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogoutAllSessions not implemented")
+}
+func (UnimplementedAuthServiceServer) RevokeUserSessions(context.Context, *RevokeUserSessionsRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSessions not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -478,6 +558,24 @@ func _AuthService_GetUserID_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_GetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetUserRole(ctx, req.(*GetUserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_GetDoctorProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProfileRequest)
 	if err := dec(in); err != nil {
@@ -514,6 +612,78 @@ func _AuthService_GetAdminProfile_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_LogoutAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).LogoutAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_LogoutAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).LogoutAllSessions(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_RevokeUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RevokeUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RevokeUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RevokeUserSessions(ctx, req.(*RevokeUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -565,6 +735,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserID",
 			Handler:    _AuthService_GetUserID_Handler,
 		},
+		{
+			MethodName: "GetUserRole",
+			Handler:    _AuthService_GetUserRole_Handler,
+		},
 		{
 			MethodName: "GetDoctorProfile",
 			Handler:    _AuthService_GetDoctorProfile_Handler,
@@ -573,6 +747,22 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAdminProfile",
 			Handler:    _AuthService_GetAdminProfile_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _AuthService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _AuthService_Logout_Handler,
+		},
+		{
+			MethodName: "LogoutAllSessions",
+			Handler:    _AuthService_LogoutAllSessions_Handler,
+		},
+		{
+			MethodName: "RevokeUserSessions",
+			Handler:    _AuthService_RevokeUserSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/auth/auth.proto",
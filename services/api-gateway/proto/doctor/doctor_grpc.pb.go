@@ -19,21 +19,33 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DoctorService_GetTodayAppointments_FullMethodName        = "/doctor.DoctorService/GetTodayAppointments"
-	DoctorService_GetUpcomingAppointments_FullMethodName     = "/doctor.DoctorService/GetUpcomingAppointments"
-	DoctorService_GetPatientAllergiesChronics_FullMethodName = "/doctor.DoctorService/GetPatientAllergiesChronics"
-	DoctorService_GetAppointmentByID_FullMethodName          = "/doctor.DoctorService/GetAppointmentByID"
-	DoctorService_GetPatientVisits_FullMethodName            = "/doctor.DoctorService/GetPatientVisits"
-	DoctorService_AddPatientAllergiesChronics_FullMethodName = "/doctor.DoctorService/AddPatientAllergiesChronics"
-	DoctorService_AddPatientVisit_FullMethodName             = "/doctor.DoctorService/AddPatientVisit"
-	DoctorService_AddVisitMaterials_FullMethodName           = "/doctor.DoctorService/AddVisitMaterials"
-	DoctorService_AddVisitServices_FullMethodName            = "/doctor.DoctorService/AddVisitServices"
-	DoctorService_AddPatientDiagnoses_FullMethodName         = "/doctor.DoctorService/AddPatientDiagnoses"
-	DoctorService_AddVisitPayment_FullMethodName             = "/doctor.DoctorService/AddVisitPayment"
-	DoctorService_UpdateVisitPayment_FullMethodName          = "/doctor.DoctorService/UpdateVisitPayment"
-	DoctorService_AddConsultation_FullMethodName             = "/doctor.DoctorService/AddConsultation"
-	DoctorService_GetDocumentsByPatientID_FullMethodName     = "/doctor.DoctorService/GetDocumentsByPatientID"
-	DoctorService_DownloadDocument_FullMethodName            = "/doctor.DoctorService/DownloadDocument"
+	DoctorService_GetTodayAppointments_FullMethodName          = "/doctor.DoctorService/GetTodayAppointments"
+	DoctorService_GetUpcomingAppointments_FullMethodName       = "/doctor.DoctorService/GetUpcomingAppointments"
+	DoctorService_GetPatientAllergiesChronics_FullMethodName   = "/doctor.DoctorService/GetPatientAllergiesChronics"
+	DoctorService_GetAppointmentByID_FullMethodName            = "/doctor.DoctorService/GetAppointmentByID"
+	DoctorService_GetPatientVisits_FullMethodName              = "/doctor.DoctorService/GetPatientVisits"
+	DoctorService_AddPatientAllergiesChronics_FullMethodName   = "/doctor.DoctorService/AddPatientAllergiesChronics"
+	DoctorService_AddPatientVisit_FullMethodName               = "/doctor.DoctorService/AddPatientVisit"
+	DoctorService_AddVisitMaterials_FullMethodName             = "/doctor.DoctorService/AddVisitMaterials"
+	DoctorService_AddVisitServices_FullMethodName              = "/doctor.DoctorService/AddVisitServices"
+	DoctorService_AddPatientDiagnoses_FullMethodName           = "/doctor.DoctorService/AddPatientDiagnoses"
+	DoctorService_AddVisitPayment_FullMethodName               = "/doctor.DoctorService/AddVisitPayment"
+	DoctorService_UpdateVisitPayment_FullMethodName            = "/doctor.DoctorService/UpdateVisitPayment"
+	DoctorService_AddConsultation_FullMethodName               = "/doctor.DoctorService/AddConsultation"
+	DoctorService_CheckClinicalAlerts_FullMethodName           = "/doctor.DoctorService/CheckClinicalAlerts"
+	DoctorService_GetPatientTreatmentPlans_FullMethodName      = "/doctor.DoctorService/GetPatientTreatmentPlans"
+	DoctorService_CreateTreatmentPlan_FullMethodName           = "/doctor.DoctorService/CreateTreatmentPlan"
+	DoctorService_AddTreatmentPlanItems_FullMethodName         = "/doctor.DoctorService/AddTreatmentPlanItems"
+	DoctorService_UpdateTreatmentPlanItemStatus_FullMethodName = "/doctor.DoctorService/UpdateTreatmentPlanItemStatus"
+	DoctorService_CancelTreatmentPlan_FullMethodName           = "/doctor.DoctorService/CancelTreatmentPlan"
+	DoctorService_GetDentalChart_FullMethodName                = "/doctor.DoctorService/GetDentalChart"
+	DoctorService_UpdateDentalChart_FullMethodName             = "/doctor.DoctorService/UpdateDentalChart"
+	DoctorService_GetToothHistory_FullMethodName               = "/doctor.DoctorService/GetToothHistory"
+	DoctorService_SavePatientAnamnesis_FullMethodName          = "/doctor.DoctorService/SavePatientAnamnesis"
+	DoctorService_GetPatientAnamnesisHistory_FullMethodName    = "/doctor.DoctorService/GetPatientAnamnesisHistory"
+	DoctorService_ExportMedicalRecord_FullMethodName           = "/doctor.DoctorService/ExportMedicalRecord"
+	DoctorService_GetDocumentsByPatientID_FullMethodName       = "/doctor.DoctorService/GetDocumentsByPatientID"
+	DoctorService_DownloadDocument_FullMethodName              = "/doctor.DoctorService/DownloadDocument"
 )
 
 // DoctorServiceClient is the client API for DoctorService service.
@@ -53,8 +65,20 @@ type DoctorServiceClient interface {
 	AddVisitPayment(ctx context.Context, in *VisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	UpdateVisitPayment(ctx context.Context, in *VisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	AddConsultation(ctx context.Context, in *AddConsultationRequest, opts ...grpc.CallOption) (*AddConsultationResponse, error)
+	CheckClinicalAlerts(ctx context.Context, in *CheckClinicalAlertsRequest, opts ...grpc.CallOption) (*ClinicalAlertsResponse, error)
+	GetPatientTreatmentPlans(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientTreatmentPlansResponse, error)
+	CreateTreatmentPlan(ctx context.Context, in *CreateTreatmentPlanRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error)
+	AddTreatmentPlanItems(ctx context.Context, in *AddTreatmentPlanItemsRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error)
+	UpdateTreatmentPlanItemStatus(ctx context.Context, in *UpdateTreatmentPlanItemStatusRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error)
+	CancelTreatmentPlan(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error)
+	GetDentalChart(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*DentalChartResponse, error)
+	UpdateDentalChart(ctx context.Context, in *UpdateDentalChartRequest, opts ...grpc.CallOption) (*DentalChartResponse, error)
+	GetToothHistory(ctx context.Context, in *GetToothHistoryRequest, opts ...grpc.CallOption) (*DentalChartResponse, error)
+	SavePatientAnamnesis(ctx context.Context, in *SavePatientAnamnesisRequest, opts ...grpc.CallOption) (*AnamnesisResponse, error)
+	GetPatientAnamnesisHistory(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*AnamnesisHistoryResponse, error)
+	ExportMedicalRecord(ctx context.Context, in *ExportMedicalRecordRequest, opts ...grpc.CallOption) (*MedicalRecordExportResponse, error)
 	GetDocumentsByPatientID(ctx context.Context, in *GetDocumentsRequest, opts ...grpc.CallOption) (*GetDocumentsResponse, error)
-	DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (*DownloadDocumentResponse, error)
+	DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDocumentResponse], error)
 }
 
 type doctorServiceClient struct {
@@ -195,6 +219,126 @@ func (c *doctorServiceClient) AddConsultation(ctx context.Context, in *AddConsul
 	return out, nil
 }
 
+func (c *doctorServiceClient) CheckClinicalAlerts(ctx context.Context, in *CheckClinicalAlertsRequest, opts ...grpc.CallOption) (*ClinicalAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClinicalAlertsResponse)
+	err := c.cc.Invoke(ctx, DoctorService_CheckClinicalAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) GetPatientTreatmentPlans(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientTreatmentPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPatientTreatmentPlansResponse)
+	err := c.cc.Invoke(ctx, DoctorService_GetPatientTreatmentPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) CreateTreatmentPlan(ctx context.Context, in *CreateTreatmentPlanRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, DoctorService_CreateTreatmentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) AddTreatmentPlanItems(ctx context.Context, in *AddTreatmentPlanItemsRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, DoctorService_AddTreatmentPlanItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) UpdateTreatmentPlanItemStatus(ctx context.Context, in *UpdateTreatmentPlanItemStatusRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, DoctorService_UpdateTreatmentPlanItemStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) CancelTreatmentPlan(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*TreatmentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, DoctorService_CancelTreatmentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) GetDentalChart(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*DentalChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DentalChartResponse)
+	err := c.cc.Invoke(ctx, DoctorService_GetDentalChart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) UpdateDentalChart(ctx context.Context, in *UpdateDentalChartRequest, opts ...grpc.CallOption) (*DentalChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DentalChartResponse)
+	err := c.cc.Invoke(ctx, DoctorService_UpdateDentalChart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) GetToothHistory(ctx context.Context, in *GetToothHistoryRequest, opts ...grpc.CallOption) (*DentalChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DentalChartResponse)
+	err := c.cc.Invoke(ctx, DoctorService_GetToothHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) SavePatientAnamnesis(ctx context.Context, in *SavePatientAnamnesisRequest, opts ...grpc.CallOption) (*AnamnesisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnamnesisResponse)
+	err := c.cc.Invoke(ctx, DoctorService_SavePatientAnamnesis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) GetPatientAnamnesisHistory(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*AnamnesisHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnamnesisHistoryResponse)
+	err := c.cc.Invoke(ctx, DoctorService_GetPatientAnamnesisHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *doctorServiceClient) ExportMedicalRecord(ctx context.Context, in *ExportMedicalRecordRequest, opts ...grpc.CallOption) (*MedicalRecordExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MedicalRecordExportResponse)
+	err := c.cc.Invoke(ctx, DoctorService_ExportMedicalRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *doctorServiceClient) GetDocumentsByPatientID(ctx context.Context, in *GetDocumentsRequest, opts ...grpc.CallOption) (*GetDocumentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDocumentsResponse)
@@ -205,16 +349,25 @@ func (c *doctorServiceClient) GetDocumentsByPatientID(ctx context.Context, in *G
 	return out, nil
 }
 
-func (c *doctorServiceClient) DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (*DownloadDocumentResponse, error) {
+func (c *doctorServiceClient) DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDocumentResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DownloadDocumentResponse)
-	err := c.cc.Invoke(ctx, DoctorService_DownloadDocument_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DoctorService_ServiceDesc.Streams[0], DoctorService_DownloadDocument_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[DownloadDocumentRequest, DownloadDocumentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DoctorService_DownloadDocumentClient = grpc.ServerStreamingClient[DownloadDocumentResponse]
+
 // DoctorServiceServer is the server API for DoctorService service.
 // All implementations must embed UnimplementedDoctorServiceServer
 // for forward compatibility.
@@ -232,8 +385,20 @@ type DoctorServiceServer interface {
 	AddVisitPayment(context.Context, *VisitPaymentRequest) (*DefaultResponse, error)
 	UpdateVisitPayment(context.Context, *VisitPaymentRequest) (*DefaultResponse, error)
 	AddConsultation(context.Context, *AddConsultationRequest) (*AddConsultationResponse, error)
+	CheckClinicalAlerts(context.Context, *CheckClinicalAlertsRequest) (*ClinicalAlertsResponse, error)
+	GetPatientTreatmentPlans(context.Context, *GetByIdRequest) (*GetPatientTreatmentPlansResponse, error)
+	CreateTreatmentPlan(context.Context, *CreateTreatmentPlanRequest) (*TreatmentPlanResponse, error)
+	AddTreatmentPlanItems(context.Context, *AddTreatmentPlanItemsRequest) (*TreatmentPlanResponse, error)
+	UpdateTreatmentPlanItemStatus(context.Context, *UpdateTreatmentPlanItemStatusRequest) (*TreatmentPlanResponse, error)
+	CancelTreatmentPlan(context.Context, *GetByIdRequest) (*TreatmentPlanResponse, error)
+	GetDentalChart(context.Context, *GetByIdRequest) (*DentalChartResponse, error)
+	UpdateDentalChart(context.Context, *UpdateDentalChartRequest) (*DentalChartResponse, error)
+	GetToothHistory(context.Context, *GetToothHistoryRequest) (*DentalChartResponse, error)
+	SavePatientAnamnesis(context.Context, *SavePatientAnamnesisRequest) (*AnamnesisResponse, error)
+	GetPatientAnamnesisHistory(context.Context, *GetByIdRequest) (*AnamnesisHistoryResponse, error)
+	ExportMedicalRecord(context.Context, *ExportMedicalRecordRequest) (*MedicalRecordExportResponse, error)
 	GetDocumentsByPatientID(context.Context, *GetDocumentsRequest) (*GetDocumentsResponse, error)
-	DownloadDocument(context.Context, *DownloadDocumentRequest) (*DownloadDocumentResponse, error)
+	DownloadDocument(*DownloadDocumentRequest, grpc.ServerStreamingServer[DownloadDocumentResponse]) error
 	mustEmbedUnimplementedDoctorServiceServer()
 }
 
@@ -283,11 +448,47 @@ func (UnimplementedDoctorServiceServer) UpdateVisitPayment(context.Context, *Vis
 func (UnimplementedDoctorServiceServer) AddConsultation(context.Context, *AddConsultationRequest) (*AddConsultationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddConsultation not implemented")
 }
+func (UnimplementedDoctorServiceServer) CheckClinicalAlerts(context.Context, *CheckClinicalAlertsRequest) (*ClinicalAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckClinicalAlerts not implemented")
+}
+func (UnimplementedDoctorServiceServer) GetPatientTreatmentPlans(context.Context, *GetByIdRequest) (*GetPatientTreatmentPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientTreatmentPlans not implemented")
+}
+func (UnimplementedDoctorServiceServer) CreateTreatmentPlan(context.Context, *CreateTreatmentPlanRequest) (*TreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTreatmentPlan not implemented")
+}
+func (UnimplementedDoctorServiceServer) AddTreatmentPlanItems(context.Context, *AddTreatmentPlanItemsRequest) (*TreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTreatmentPlanItems not implemented")
+}
+func (UnimplementedDoctorServiceServer) UpdateTreatmentPlanItemStatus(context.Context, *UpdateTreatmentPlanItemStatusRequest) (*TreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTreatmentPlanItemStatus not implemented")
+}
+func (UnimplementedDoctorServiceServer) CancelTreatmentPlan(context.Context, *GetByIdRequest) (*TreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTreatmentPlan not implemented")
+}
+func (UnimplementedDoctorServiceServer) GetDentalChart(context.Context, *GetByIdRequest) (*DentalChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDentalChart not implemented")
+}
+func (UnimplementedDoctorServiceServer) UpdateDentalChart(context.Context, *UpdateDentalChartRequest) (*DentalChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDentalChart not implemented")
+}
+func (UnimplementedDoctorServiceServer) GetToothHistory(context.Context, *GetToothHistoryRequest) (*DentalChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToothHistory not implemented")
+}
+func (UnimplementedDoctorServiceServer) SavePatientAnamnesis(context.Context, *SavePatientAnamnesisRequest) (*AnamnesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePatientAnamnesis not implemented")
+}
+func (UnimplementedDoctorServiceServer) GetPatientAnamnesisHistory(context.Context, *GetByIdRequest) (*AnamnesisHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPatientAnamnesisHistory not implemented")
+}
+func (UnimplementedDoctorServiceServer) ExportMedicalRecord(context.Context, *ExportMedicalRecordRequest) (*MedicalRecordExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMedicalRecord not implemented")
+}
 func (UnimplementedDoctorServiceServer) GetDocumentsByPatientID(context.Context, *GetDocumentsRequest) (*GetDocumentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentsByPatientID not implemented")
 }
-func (UnimplementedDoctorServiceServer) DownloadDocument(context.Context, *DownloadDocumentRequest) (*DownloadDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownloadDocument not implemented")
+func (UnimplementedDoctorServiceServer) DownloadDocument(*DownloadDocumentRequest, grpc.ServerStreamingServer[DownloadDocumentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadDocument not implemented")
 }
 func (UnimplementedDoctorServiceServer) mustEmbedUnimplementedDoctorServiceServer() {}
 func (UnimplementedDoctorServiceServer) testEmbeddedByValue()                       {}
@@ -544,42 +745,251 @@ func _DoctorService_AddConsultation_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DoctorService_GetDocumentsByPatientID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDocumentsRequest)
+func _DoctorService_CheckClinicalAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckClinicalAlertsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DoctorServiceServer).GetDocumentsByPatientID(ctx, in)
+		return srv.(DoctorServiceServer).CheckClinicalAlerts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DoctorService_GetDocumentsByPatientID_FullMethodName,
+		FullMethod: DoctorService_CheckClinicalAlerts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).GetDocumentsByPatientID(ctx, req.(*GetDocumentsRequest))
+		return srv.(DoctorServiceServer).CheckClinicalAlerts(ctx, req.(*CheckClinicalAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_GetPatientTreatmentPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).GetPatientTreatmentPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_GetPatientTreatmentPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).GetPatientTreatmentPlans(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_CreateTreatmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTreatmentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).CreateTreatmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_CreateTreatmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).CreateTreatmentPlan(ctx, req.(*CreateTreatmentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_AddTreatmentPlanItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTreatmentPlanItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).AddTreatmentPlanItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_AddTreatmentPlanItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).AddTreatmentPlanItems(ctx, req.(*AddTreatmentPlanItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_UpdateTreatmentPlanItemStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTreatmentPlanItemStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).UpdateTreatmentPlanItemStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_UpdateTreatmentPlanItemStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).UpdateTreatmentPlanItemStatus(ctx, req.(*UpdateTreatmentPlanItemStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_CancelTreatmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).CancelTreatmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_CancelTreatmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).CancelTreatmentPlan(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_GetDentalChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).GetDentalChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_GetDentalChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).GetDentalChart(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_UpdateDentalChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDentalChartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).UpdateDentalChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_UpdateDentalChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).UpdateDentalChart(ctx, req.(*UpdateDentalChartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_GetToothHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetToothHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).GetToothHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_GetToothHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).GetToothHistory(ctx, req.(*GetToothHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_SavePatientAnamnesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SavePatientAnamnesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).SavePatientAnamnesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_SavePatientAnamnesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).SavePatientAnamnesis(ctx, req.(*SavePatientAnamnesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_GetPatientAnamnesisHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).GetPatientAnamnesisHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_GetPatientAnamnesisHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).GetPatientAnamnesisHistory(ctx, req.(*GetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DoctorService_ExportMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DoctorServiceServer).ExportMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DoctorService_ExportMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DoctorServiceServer).ExportMedicalRecord(ctx, req.(*ExportMedicalRecordRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DoctorService_DownloadDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownloadDocumentRequest)
+func _DoctorService_GetDocumentsByPatientID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DoctorServiceServer).DownloadDocument(ctx, in)
+		return srv.(DoctorServiceServer).GetDocumentsByPatientID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DoctorService_DownloadDocument_FullMethodName,
+		FullMethod: DoctorService_GetDocumentsByPatientID_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DoctorServiceServer).DownloadDocument(ctx, req.(*DownloadDocumentRequest))
+		return srv.(DoctorServiceServer).GetDocumentsByPatientID(ctx, req.(*GetDocumentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DoctorService_DownloadDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDocumentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DoctorServiceServer).DownloadDocument(m, &grpc.GenericServerStream[DownloadDocumentRequest, DownloadDocumentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DoctorService_DownloadDocumentServer = grpc.ServerStreamingServer[DownloadDocumentResponse]
+
 // DoctorService_ServiceDesc is the grpc.ServiceDesc for DoctorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -639,15 +1049,65 @@ var DoctorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddConsultation",
 			Handler:    _DoctorService_AddConsultation_Handler,
 		},
+		{
+			MethodName: "CheckClinicalAlerts",
+			Handler:    _DoctorService_CheckClinicalAlerts_Handler,
+		},
+		{
+			MethodName: "GetPatientTreatmentPlans",
+			Handler:    _DoctorService_GetPatientTreatmentPlans_Handler,
+		},
+		{
+			MethodName: "CreateTreatmentPlan",
+			Handler:    _DoctorService_CreateTreatmentPlan_Handler,
+		},
+		{
+			MethodName: "AddTreatmentPlanItems",
+			Handler:    _DoctorService_AddTreatmentPlanItems_Handler,
+		},
+		{
+			MethodName: "UpdateTreatmentPlanItemStatus",
+			Handler:    _DoctorService_UpdateTreatmentPlanItemStatus_Handler,
+		},
+		{
+			MethodName: "CancelTreatmentPlan",
+			Handler:    _DoctorService_CancelTreatmentPlan_Handler,
+		},
+		{
+			MethodName: "GetDentalChart",
+			Handler:    _DoctorService_GetDentalChart_Handler,
+		},
+		{
+			MethodName: "UpdateDentalChart",
+			Handler:    _DoctorService_UpdateDentalChart_Handler,
+		},
+		{
+			MethodName: "GetToothHistory",
+			Handler:    _DoctorService_GetToothHistory_Handler,
+		},
+		{
+			MethodName: "SavePatientAnamnesis",
+			Handler:    _DoctorService_SavePatientAnamnesis_Handler,
+		},
+		{
+			MethodName: "GetPatientAnamnesisHistory",
+			Handler:    _DoctorService_GetPatientAnamnesisHistory_Handler,
+		},
+		{
+			MethodName: "ExportMedicalRecord",
+			Handler:    _DoctorService_ExportMedicalRecord_Handler,
+		},
 		{
 			MethodName: "GetDocumentsByPatientID",
 			Handler:    _DoctorService_GetDocumentsByPatientID_Handler,
 		},
+	},
+	Streams: []grpc.StreamDesc{
 		{
-			MethodName: "DownloadDocument",
-			Handler:    _DoctorService_DownloadDocument_Handler,
+			StreamName:    "DownloadDocument",
+			Handler:       _DoctorService_DownloadDocument_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/doctor/doctor.proto",
 }
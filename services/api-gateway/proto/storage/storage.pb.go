@@ -594,6 +594,76 @@ func (*EmptyRequest) Descriptor() ([]byte, []int) {
 	return file_proto_storage_storage_proto_rawDescGZIP(), []int{8}
 }
 
+// Страница списка: limit = 0 — список целиком (для внутренних вызовов сервисов);
+// sort — поле сортировки из допустимых для метода, пусто — сортировка метода по умолчанию
+type ListPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Desc          bool                   `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPage) Reset() {
+	*x = ListPage{}
+	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPage) ProtoMessage() {}
+
+func (x *ListPage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPage.ProtoReflect.Descriptor instead.
+func (*ListPage) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPage) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPage) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPage) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListPage) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
 type Specialization struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -604,7 +674,7 @@ type Specialization struct {
 
 func (x *Specialization) Reset() {
 	*x = Specialization{}
-	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	mi := &file_proto_storage_storage_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -616,7 +686,7 @@ func (x *Specialization) String() string {
 func (*Specialization) ProtoMessage() {}
 
 func (x *Specialization) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	mi := &file_proto_storage_storage_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +699,7 @@ func (x *Specialization) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Specialization.ProtoReflect.Descriptor instead.
 func (*Specialization) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{9}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Specialization) GetId() int32 {
@@ -655,7 +725,7 @@ type GetAllSpecsResponse struct {
 
 func (x *GetAllSpecsResponse) Reset() {
 	*x = GetAllSpecsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[10]
+	mi := &file_proto_storage_storage_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -667,7 +737,7 @@ func (x *GetAllSpecsResponse) String() string {
 func (*GetAllSpecsResponse) ProtoMessage() {}
 
 func (x *GetAllSpecsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[10]
+	mi := &file_proto_storage_storage_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,7 +750,7 @@ func (x *GetAllSpecsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllSpecsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllSpecsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{10}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetAllSpecsResponse) GetSpecs() []*Specialization {
@@ -700,7 +770,7 @@ type AddUserRoleRequest struct {
 
 func (x *AddUserRoleRequest) Reset() {
 	*x = AddUserRoleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[11]
+	mi := &file_proto_storage_storage_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +782,7 @@ func (x *AddUserRoleRequest) String() string {
 func (*AddUserRoleRequest) ProtoMessage() {}
 
 func (x *AddUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[11]
+	mi := &file_proto_storage_storage_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +795,7 @@ func (x *AddUserRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddUserRoleRequest.ProtoReflect.Descriptor instead.
 func (*AddUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{11}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AddUserRoleRequest) GetUserId() int32 {
@@ -751,7 +821,7 @@ type AddUserRoleResponse struct {
 
 func (x *AddUserRoleResponse) Reset() {
 	*x = AddUserRoleResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[12]
+	mi := &file_proto_storage_storage_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -763,7 +833,7 @@ func (x *AddUserRoleResponse) String() string {
 func (*AddUserRoleResponse) ProtoMessage() {}
 
 func (x *AddUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[12]
+	mi := &file_proto_storage_storage_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,7 +846,7 @@ func (x *AddUserRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddUserRoleResponse.ProtoReflect.Descriptor instead.
 func (*AddUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{12}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AddUserRoleResponse) GetError() string {
@@ -801,7 +871,7 @@ type WeeklyDoctorSchedule struct {
 
 func (x *WeeklyDoctorSchedule) Reset() {
 	*x = WeeklyDoctorSchedule{}
-	mi := &file_proto_storage_storage_proto_msgTypes[13]
+	mi := &file_proto_storage_storage_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -813,7 +883,7 @@ func (x *WeeklyDoctorSchedule) String() string {
 func (*WeeklyDoctorSchedule) ProtoMessage() {}
 
 func (x *WeeklyDoctorSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[13]
+	mi := &file_proto_storage_storage_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,7 +896,7 @@ func (x *WeeklyDoctorSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeeklyDoctorSchedule.ProtoReflect.Descriptor instead.
 func (*WeeklyDoctorSchedule) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{13}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *WeeklyDoctorSchedule) GetId() int32 {
@@ -887,7 +957,7 @@ type GetScheduleByDoctorIdRequest struct {
 
 func (x *GetScheduleByDoctorIdRequest) Reset() {
 	*x = GetScheduleByDoctorIdRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[14]
+	mi := &file_proto_storage_storage_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -899,7 +969,7 @@ func (x *GetScheduleByDoctorIdRequest) String() string {
 func (*GetScheduleByDoctorIdRequest) ProtoMessage() {}
 
 func (x *GetScheduleByDoctorIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[14]
+	mi := &file_proto_storage_storage_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +982,7 @@ func (x *GetScheduleByDoctorIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScheduleByDoctorIdRequest.ProtoReflect.Descriptor instead.
 func (*GetScheduleByDoctorIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{14}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetScheduleByDoctorIdRequest) GetDoctorId() int32 {
@@ -931,7 +1001,7 @@ type GetScheduleByDoctorIdResponse struct {
 
 func (x *GetScheduleByDoctorIdResponse) Reset() {
 	*x = GetScheduleByDoctorIdResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[15]
+	mi := &file_proto_storage_storage_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -943,7 +1013,7 @@ func (x *GetScheduleByDoctorIdResponse) String() string {
 func (*GetScheduleByDoctorIdResponse) ProtoMessage() {}
 
 func (x *GetScheduleByDoctorIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[15]
+	mi := &file_proto_storage_storage_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +1026,7 @@ func (x *GetScheduleByDoctorIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScheduleByDoctorIdResponse.ProtoReflect.Descriptor instead.
 func (*GetScheduleByDoctorIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{15}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetScheduleByDoctorIdResponse) GetDoctorSchedule() []*WeeklyDoctorSchedule {
@@ -975,7 +1045,7 @@ type GetUserByLoginRequest struct {
 
 func (x *GetUserByLoginRequest) Reset() {
 	*x = GetUserByLoginRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[16]
+	mi := &file_proto_storage_storage_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -987,7 +1057,7 @@ func (x *GetUserByLoginRequest) String() string {
 func (*GetUserByLoginRequest) ProtoMessage() {}
 
 func (x *GetUserByLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[16]
+	mi := &file_proto_storage_storage_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1070,7 @@ func (x *GetUserByLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByLoginRequest.ProtoReflect.Descriptor instead.
 func (*GetUserByLoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{16}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetUserByLoginRequest) GetLogin() string {
@@ -1015,13 +1085,14 @@ type GetUserByLoginResponse struct {
 	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // active, deactivated (сотрудник отключён) или archived (пациент в архиве)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserByLoginResponse) Reset() {
 	*x = GetUserByLoginResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[17]
+	mi := &file_proto_storage_storage_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1033,7 +1104,7 @@ func (x *GetUserByLoginResponse) String() string {
 func (*GetUserByLoginResponse) ProtoMessage() {}
 
 func (x *GetUserByLoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[17]
+	mi := &file_proto_storage_storage_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,7 +1117,7 @@ func (x *GetUserByLoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByLoginResponse.ProtoReflect.Descriptor instead.
 func (*GetUserByLoginResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{17}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetUserByLoginResponse) GetLogin() string {
@@ -1070,6 +1141,13 @@ func (x *GetUserByLoginResponse) GetId() int32 {
 	return 0
 }
 
+func (x *GetUserByLoginResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type UpdateUserPasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1081,7 +1159,7 @@ type UpdateUserPasswordRequest struct {
 
 func (x *UpdateUserPasswordRequest) Reset() {
 	*x = UpdateUserPasswordRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[18]
+	mi := &file_proto_storage_storage_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1093,7 +1171,7 @@ func (x *UpdateUserPasswordRequest) String() string {
 func (*UpdateUserPasswordRequest) ProtoMessage() {}
 
 func (x *UpdateUserPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[18]
+	mi := &file_proto_storage_storage_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1106,7 +1184,7 @@ func (x *UpdateUserPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserPasswordRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{18}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateUserPasswordRequest) GetId() int32 {
@@ -1139,7 +1217,7 @@ type DefaultResponse struct {
 
 func (x *DefaultResponse) Reset() {
 	*x = DefaultResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[19]
+	mi := &file_proto_storage_storage_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1151,7 +1229,7 @@ func (x *DefaultResponse) String() string {
 func (*DefaultResponse) ProtoMessage() {}
 
 func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[19]
+	mi := &file_proto_storage_storage_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,7 +1242,7 @@ func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
 func (*DefaultResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{19}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DefaultResponse) GetError() string {
@@ -1191,7 +1269,7 @@ type Doctor struct {
 
 func (x *Doctor) Reset() {
 	*x = Doctor{}
-	mi := &file_proto_storage_storage_proto_msgTypes[20]
+	mi := &file_proto_storage_storage_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1203,7 +1281,7 @@ func (x *Doctor) String() string {
 func (*Doctor) ProtoMessage() {}
 
 func (x *Doctor) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[20]
+	mi := &file_proto_storage_storage_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,7 +1294,7 @@ func (x *Doctor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Doctor.ProtoReflect.Descriptor instead.
 func (*Doctor) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{20}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Doctor) GetUserId() int32 {
@@ -1299,7 +1377,7 @@ type UpdateDoctorRequest struct {
 
 func (x *UpdateDoctorRequest) Reset() {
 	*x = UpdateDoctorRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[21]
+	mi := &file_proto_storage_storage_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1311,7 +1389,7 @@ func (x *UpdateDoctorRequest) String() string {
 func (*UpdateDoctorRequest) ProtoMessage() {}
 
 func (x *UpdateDoctorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[21]
+	mi := &file_proto_storage_storage_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1324,7 +1402,7 @@ func (x *UpdateDoctorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDoctorRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDoctorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{21}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateDoctorRequest) GetUserId() int32 {
@@ -1400,7 +1478,7 @@ type AddDoctorSpecRequest struct {
 
 func (x *AddDoctorSpecRequest) Reset() {
 	*x = AddDoctorSpecRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[22]
+	mi := &file_proto_storage_storage_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1412,7 +1490,7 @@ func (x *AddDoctorSpecRequest) String() string {
 func (*AddDoctorSpecRequest) ProtoMessage() {}
 
 func (x *AddDoctorSpecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[22]
+	mi := &file_proto_storage_storage_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1425,7 +1503,7 @@ func (x *AddDoctorSpecRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDoctorSpecRequest.ProtoReflect.Descriptor instead.
 func (*AddDoctorSpecRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{22}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AddDoctorSpecRequest) GetDoctorId() int32 {
@@ -1452,7 +1530,7 @@ type DeleteDoctorSpecRequest struct {
 
 func (x *DeleteDoctorSpecRequest) Reset() {
 	*x = DeleteDoctorSpecRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[23]
+	mi := &file_proto_storage_storage_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1464,7 +1542,7 @@ func (x *DeleteDoctorSpecRequest) String() string {
 func (*DeleteDoctorSpecRequest) ProtoMessage() {}
 
 func (x *DeleteDoctorSpecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[23]
+	mi := &file_proto_storage_storage_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +1555,7 @@ func (x *DeleteDoctorSpecRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDoctorSpecRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDoctorSpecRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{23}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DeleteDoctorSpecRequest) GetDoctorId() int32 {
@@ -1494,16 +1572,78 @@ func (x *DeleteDoctorSpecRequest) GetSpecId() int32 {
 	return 0
 }
 
+// sort: name (по умолчанию), experience
+type GetDoctorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // поиск по началам слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только отключённые, для восстановления; без него — только работающие
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoctorsRequest) Reset() {
+	*x = GetDoctorsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoctorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoctorsRequest) ProtoMessage() {}
+
+func (x *GetDoctorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoctorsRequest.ProtoReflect.Descriptor instead.
+func (*GetDoctorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetDoctorsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetDoctorsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetDoctorsRequest) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
 type GetDoctorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Doctors       []*Doctor              `protobuf:"bytes,1,rep,name=doctors,proto3" json:"doctors,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных врачей без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetDoctorsResponse) Reset() {
 	*x = GetDoctorsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[24]
+	mi := &file_proto_storage_storage_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1515,7 +1655,7 @@ func (x *GetDoctorsResponse) String() string {
 func (*GetDoctorsResponse) ProtoMessage() {}
 
 func (x *GetDoctorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[24]
+	mi := &file_proto_storage_storage_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,7 +1668,7 @@ func (x *GetDoctorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorsResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{24}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetDoctorsResponse) GetDoctors() []*Doctor {
@@ -1538,6 +1678,13 @@ func (x *GetDoctorsResponse) GetDoctors() []*Doctor {
 	return nil
 }
 
+func (x *GetDoctorsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type GetDoctorSpecsByDoctorIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Specs         []int32                `protobuf:"varint,1,rep,packed,name=specs,proto3" json:"specs,omitempty"`
@@ -1547,7 +1694,7 @@ type GetDoctorSpecsByDoctorIdResponse struct {
 
 func (x *GetDoctorSpecsByDoctorIdResponse) Reset() {
 	*x = GetDoctorSpecsByDoctorIdResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	mi := &file_proto_storage_storage_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1559,7 +1706,7 @@ func (x *GetDoctorSpecsByDoctorIdResponse) String() string {
 func (*GetDoctorSpecsByDoctorIdResponse) ProtoMessage() {}
 
 func (x *GetDoctorSpecsByDoctorIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	mi := &file_proto_storage_storage_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1572,7 +1719,7 @@ func (x *GetDoctorSpecsByDoctorIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorSpecsByDoctorIdResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorSpecsByDoctorIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{25}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetDoctorSpecsByDoctorIdResponse) GetSpecs() []int32 {
@@ -1597,7 +1744,7 @@ type Admin struct {
 
 func (x *Admin) Reset() {
 	*x = Admin{}
-	mi := &file_proto_storage_storage_proto_msgTypes[26]
+	mi := &file_proto_storage_storage_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1609,7 +1756,7 @@ func (x *Admin) String() string {
 func (*Admin) ProtoMessage() {}
 
 func (x *Admin) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[26]
+	mi := &file_proto_storage_storage_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1622,7 +1769,7 @@ func (x *Admin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Admin.ProtoReflect.Descriptor instead.
 func (*Admin) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{26}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Admin) GetUserId() int32 {
@@ -1689,7 +1836,7 @@ type UpdateAdminRequest struct {
 
 func (x *UpdateAdminRequest) Reset() {
 	*x = UpdateAdminRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[27]
+	mi := &file_proto_storage_storage_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1701,7 +1848,7 @@ func (x *UpdateAdminRequest) String() string {
 func (*UpdateAdminRequest) ProtoMessage() {}
 
 func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[27]
+	mi := &file_proto_storage_storage_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +1861,7 @@ func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAdminRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{27}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateAdminRequest) GetUserId() int32 {
@@ -1776,7 +1923,7 @@ type UpdateAdminRoleRequest struct {
 
 func (x *UpdateAdminRoleRequest) Reset() {
 	*x = UpdateAdminRoleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[28]
+	mi := &file_proto_storage_storage_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1788,7 +1935,7 @@ func (x *UpdateAdminRoleRequest) String() string {
 func (*UpdateAdminRoleRequest) ProtoMessage() {}
 
 func (x *UpdateAdminRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[28]
+	mi := &file_proto_storage_storage_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1801,7 +1948,7 @@ func (x *UpdateAdminRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAdminRoleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAdminRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{28}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateAdminRoleRequest) GetUserId() int32 {
@@ -1818,16 +1965,78 @@ func (x *UpdateAdminRoleRequest) GetRoleId() int32 {
 	return 0
 }
 
+// sort: name (по умолчанию)
+type GetAdminsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // поиск по началам слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только отключённые, для восстановления; без него — только работающие
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdminsRequest) Reset() {
+	*x = GetAdminsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminsRequest) ProtoMessage() {}
+
+func (x *GetAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAdminsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetAdminsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetAdminsRequest) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
 type GetAdminsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Admins        []*Admin               `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных администраторов без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAdminsResponse) Reset() {
 	*x = GetAdminsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[29]
+	mi := &file_proto_storage_storage_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1839,7 +2048,7 @@ func (x *GetAdminsResponse) String() string {
 func (*GetAdminsResponse) ProtoMessage() {}
 
 func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[29]
+	mi := &file_proto_storage_storage_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1852,7 +2061,7 @@ func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAdminsResponse.ProtoReflect.Descriptor instead.
 func (*GetAdminsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{29}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetAdminsResponse) GetAdmins() []*Admin {
@@ -1862,6 +2071,13 @@ func (x *GetAdminsResponse) GetAdmins() []*Admin {
 	return nil
 }
 
+func (x *GetAdminsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type Patient struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1878,7 +2094,7 @@ type Patient struct {
 
 func (x *Patient) Reset() {
 	*x = Patient{}
-	mi := &file_proto_storage_storage_proto_msgTypes[30]
+	mi := &file_proto_storage_storage_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1890,7 +2106,7 @@ func (x *Patient) String() string {
 func (*Patient) ProtoMessage() {}
 
 func (x *Patient) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[30]
+	mi := &file_proto_storage_storage_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,7 +2119,7 @@ func (x *Patient) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Patient.ProtoReflect.Descriptor instead.
 func (*Patient) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{30}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *Patient) GetUserId() int32 {
@@ -1978,7 +2194,7 @@ type UpdatePatientRequest struct {
 
 func (x *UpdatePatientRequest) Reset() {
 	*x = UpdatePatientRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[31]
+	mi := &file_proto_storage_storage_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1990,7 +2206,7 @@ func (x *UpdatePatientRequest) String() string {
 func (*UpdatePatientRequest) ProtoMessage() {}
 
 func (x *UpdatePatientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[31]
+	mi := &file_proto_storage_storage_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +2219,7 @@ func (x *UpdatePatientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePatientRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePatientRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{31}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *UpdatePatientRequest) GetUserId() int32 {
@@ -2062,16 +2278,78 @@ func (x *UpdatePatientRequest) GetGender() string {
 	return ""
 }
 
+// sort: name (по умолчанию), birth_date
+type GetPatientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // дата рождения (02.01.2006 или 2006-01-02), номер телефона или начала слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только архивные, для восстановления; без него — только пациенты в работе
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientsRequest) Reset() {
+	*x = GetPatientsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientsRequest) ProtoMessage() {}
+
+func (x *GetPatientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientsRequest.ProtoReflect.Descriptor instead.
+func (*GetPatientsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetPatientsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetPatientsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetPatientsRequest) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
 type GetPatientsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Patients      []*Patient             `protobuf:"bytes,1,rep,name=patients,proto3" json:"patients,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных пациентов без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPatientsResponse) Reset() {
 	*x = GetPatientsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[32]
+	mi := &file_proto_storage_storage_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2083,7 +2361,7 @@ func (x *GetPatientsResponse) String() string {
 func (*GetPatientsResponse) ProtoMessage() {}
 
 func (x *GetPatientsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[32]
+	mi := &file_proto_storage_storage_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2096,7 +2374,7 @@ func (x *GetPatientsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientsResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{32}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetPatientsResponse) GetPatients() []*Patient {
@@ -2106,6 +2384,13 @@ func (x *GetPatientsResponse) GetPatients() []*Patient {
 	return nil
 }
 
+func (x *GetPatientsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type WeeklyClinicSchedule struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2120,7 +2405,7 @@ type WeeklyClinicSchedule struct {
 
 func (x *WeeklyClinicSchedule) Reset() {
 	*x = WeeklyClinicSchedule{}
-	mi := &file_proto_storage_storage_proto_msgTypes[33]
+	mi := &file_proto_storage_storage_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2132,7 +2417,7 @@ func (x *WeeklyClinicSchedule) String() string {
 func (*WeeklyClinicSchedule) ProtoMessage() {}
 
 func (x *WeeklyClinicSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[33]
+	mi := &file_proto_storage_storage_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2145,7 +2430,7 @@ func (x *WeeklyClinicSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeeklyClinicSchedule.ProtoReflect.Descriptor instead.
 func (*WeeklyClinicSchedule) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{33}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *WeeklyClinicSchedule) GetId() int32 {
@@ -2199,7 +2484,7 @@ type GetClinicWeeklyScheduleResponse struct {
 
 func (x *GetClinicWeeklyScheduleResponse) Reset() {
 	*x = GetClinicWeeklyScheduleResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[34]
+	mi := &file_proto_storage_storage_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2211,7 +2496,7 @@ func (x *GetClinicWeeklyScheduleResponse) String() string {
 func (*GetClinicWeeklyScheduleResponse) ProtoMessage() {}
 
 func (x *GetClinicWeeklyScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[34]
+	mi := &file_proto_storage_storage_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +2509,7 @@ func (x *GetClinicWeeklyScheduleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClinicWeeklyScheduleResponse.ProtoReflect.Descriptor instead.
 func (*GetClinicWeeklyScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{34}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetClinicWeeklyScheduleResponse) GetClinicSchedule() []*WeeklyClinicSchedule {
@@ -2243,7 +2528,7 @@ type GetUserRoleRequest struct {
 
 func (x *GetUserRoleRequest) Reset() {
 	*x = GetUserRoleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[35]
+	mi := &file_proto_storage_storage_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2255,7 +2540,7 @@ func (x *GetUserRoleRequest) String() string {
 func (*GetUserRoleRequest) ProtoMessage() {}
 
 func (x *GetUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[35]
+	mi := &file_proto_storage_storage_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,7 +2553,7 @@ func (x *GetUserRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRoleRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{35}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetUserRoleRequest) GetUserId() int32 {
@@ -2287,7 +2572,7 @@ type GetUserRoleResponse struct {
 
 func (x *GetUserRoleResponse) Reset() {
 	*x = GetUserRoleResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[36]
+	mi := &file_proto_storage_storage_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2299,7 +2584,7 @@ func (x *GetUserRoleResponse) String() string {
 func (*GetUserRoleResponse) ProtoMessage() {}
 
 func (x *GetUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[36]
+	mi := &file_proto_storage_storage_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2312,7 +2597,7 @@ func (x *GetUserRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRoleResponse.ProtoReflect.Descriptor instead.
 func (*GetUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{36}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetUserRoleResponse) GetRole() int32 {
@@ -2331,7 +2616,7 @@ type UpdateClinicWeeklyScheduleRequest struct {
 
 func (x *UpdateClinicWeeklyScheduleRequest) Reset() {
 	*x = UpdateClinicWeeklyScheduleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[37]
+	mi := &file_proto_storage_storage_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2343,7 +2628,7 @@ func (x *UpdateClinicWeeklyScheduleRequest) String() string {
 func (*UpdateClinicWeeklyScheduleRequest) ProtoMessage() {}
 
 func (x *UpdateClinicWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[37]
+	mi := &file_proto_storage_storage_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2356,7 +2641,7 @@ func (x *UpdateClinicWeeklyScheduleRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateClinicWeeklyScheduleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateClinicWeeklyScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{37}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *UpdateClinicWeeklyScheduleRequest) GetClinicSchedule() []*WeeklyClinicSchedule {
@@ -2375,7 +2660,7 @@ type AddDoctorWeeklyScheduleRequest struct {
 
 func (x *AddDoctorWeeklyScheduleRequest) Reset() {
 	*x = AddDoctorWeeklyScheduleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[38]
+	mi := &file_proto_storage_storage_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2387,7 +2672,7 @@ func (x *AddDoctorWeeklyScheduleRequest) String() string {
 func (*AddDoctorWeeklyScheduleRequest) ProtoMessage() {}
 
 func (x *AddDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[38]
+	mi := &file_proto_storage_storage_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2400,7 +2685,7 @@ func (x *AddDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDoctorWeeklyScheduleRequest.ProtoReflect.Descriptor instead.
 func (*AddDoctorWeeklyScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{38}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AddDoctorWeeklyScheduleRequest) GetDoctorSchedule() []*WeeklyDoctorSchedule {
@@ -2419,7 +2704,7 @@ type UpdateDoctorWeeklyScheduleRequest struct {
 
 func (x *UpdateDoctorWeeklyScheduleRequest) Reset() {
 	*x = UpdateDoctorWeeklyScheduleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[39]
+	mi := &file_proto_storage_storage_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2431,7 +2716,7 @@ func (x *UpdateDoctorWeeklyScheduleRequest) String() string {
 func (*UpdateDoctorWeeklyScheduleRequest) ProtoMessage() {}
 
 func (x *UpdateDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[39]
+	mi := &file_proto_storage_storage_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2444,7 +2729,7 @@ func (x *UpdateDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateDoctorWeeklyScheduleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDoctorWeeklyScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{39}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *UpdateDoctorWeeklyScheduleRequest) GetDoctorSchedule() []*WeeklyDoctorSchedule {
@@ -2464,7 +2749,7 @@ type GetRolePermissionRequest struct {
 
 func (x *GetRolePermissionRequest) Reset() {
 	*x = GetRolePermissionRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[40]
+	mi := &file_proto_storage_storage_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2476,7 +2761,7 @@ func (x *GetRolePermissionRequest) String() string {
 func (*GetRolePermissionRequest) ProtoMessage() {}
 
 func (x *GetRolePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[40]
+	mi := &file_proto_storage_storage_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2489,7 +2774,7 @@ func (x *GetRolePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRolePermissionRequest.ProtoReflect.Descriptor instead.
 func (*GetRolePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{40}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetRolePermissionRequest) GetRoleId() int32 {
@@ -2519,7 +2804,7 @@ type AddClinicDailyOverrideRequest struct {
 
 func (x *AddClinicDailyOverrideRequest) Reset() {
 	*x = AddClinicDailyOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[41]
+	mi := &file_proto_storage_storage_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2531,7 +2816,7 @@ func (x *AddClinicDailyOverrideRequest) String() string {
 func (*AddClinicDailyOverrideRequest) ProtoMessage() {}
 
 func (x *AddClinicDailyOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[41]
+	mi := &file_proto_storage_storage_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,7 +2829,7 @@ func (x *AddClinicDailyOverrideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddClinicDailyOverrideRequest.ProtoReflect.Descriptor instead.
 func (*AddClinicDailyOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{41}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *AddClinicDailyOverrideRequest) GetDate() *timestamppb.Timestamp {
@@ -2596,7 +2881,7 @@ type AddDoctorDailyOverrideRequest struct {
 
 func (x *AddDoctorDailyOverrideRequest) Reset() {
 	*x = AddDoctorDailyOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[42]
+	mi := &file_proto_storage_storage_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2608,7 +2893,7 @@ func (x *AddDoctorDailyOverrideRequest) String() string {
 func (*AddDoctorDailyOverrideRequest) ProtoMessage() {}
 
 func (x *AddDoctorDailyOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[42]
+	mi := &file_proto_storage_storage_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2621,7 +2906,7 @@ func (x *AddDoctorDailyOverrideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDoctorDailyOverrideRequest.ProtoReflect.Descriptor instead.
 func (*AddDoctorDailyOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{42}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *AddDoctorDailyOverrideRequest) GetDoctorId() int32 {
@@ -2675,7 +2960,7 @@ type GetDoctorBySpecIDRequest struct {
 
 func (x *GetDoctorBySpecIDRequest) Reset() {
 	*x = GetDoctorBySpecIDRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[43]
+	mi := &file_proto_storage_storage_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2687,7 +2972,7 @@ func (x *GetDoctorBySpecIDRequest) String() string {
 func (*GetDoctorBySpecIDRequest) ProtoMessage() {}
 
 func (x *GetDoctorBySpecIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[43]
+	mi := &file_proto_storage_storage_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2700,7 +2985,7 @@ func (x *GetDoctorBySpecIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorBySpecIDRequest.ProtoReflect.Descriptor instead.
 func (*GetDoctorBySpecIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{43}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetDoctorBySpecIDRequest) GetSpecId() int32 {
@@ -2719,7 +3004,7 @@ type GetAppointmentsByDoctorIDRequest struct {
 
 func (x *GetAppointmentsByDoctorIDRequest) Reset() {
 	*x = GetAppointmentsByDoctorIDRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[44]
+	mi := &file_proto_storage_storage_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2731,7 +3016,7 @@ func (x *GetAppointmentsByDoctorIDRequest) String() string {
 func (*GetAppointmentsByDoctorIDRequest) ProtoMessage() {}
 
 func (x *GetAppointmentsByDoctorIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[44]
+	mi := &file_proto_storage_storage_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2744,7 +3029,7 @@ func (x *GetAppointmentsByDoctorIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppointmentsByDoctorIDRequest.ProtoReflect.Descriptor instead.
 func (*GetAppointmentsByDoctorIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{44}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetAppointmentsByDoctorIDRequest) GetDoctorId() int32 {
@@ -2776,7 +3061,7 @@ type Appointment struct {
 
 func (x *Appointment) Reset() {
 	*x = Appointment{}
-	mi := &file_proto_storage_storage_proto_msgTypes[45]
+	mi := &file_proto_storage_storage_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2788,7 +3073,7 @@ func (x *Appointment) String() string {
 func (*Appointment) ProtoMessage() {}
 
 func (x *Appointment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[45]
+	mi := &file_proto_storage_storage_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2801,7 +3086,7 @@ func (x *Appointment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Appointment.ProtoReflect.Descriptor instead.
 func (*Appointment) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{45}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *Appointment) GetId() int32 {
@@ -2911,7 +3196,7 @@ type GetAppointmentsByDoctorIDResponse struct {
 
 func (x *GetAppointmentsByDoctorIDResponse) Reset() {
 	*x = GetAppointmentsByDoctorIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[46]
+	mi := &file_proto_storage_storage_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2923,7 +3208,7 @@ func (x *GetAppointmentsByDoctorIDResponse) String() string {
 func (*GetAppointmentsByDoctorIDResponse) ProtoMessage() {}
 
 func (x *GetAppointmentsByDoctorIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[46]
+	mi := &file_proto_storage_storage_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2936,7 +3221,7 @@ func (x *GetAppointmentsByDoctorIDResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAppointmentsByDoctorIDResponse.ProtoReflect.Descriptor instead.
 func (*GetAppointmentsByDoctorIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{46}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetAppointmentsByDoctorIDResponse) GetAppointments() []*Appointment {
@@ -2955,7 +3240,7 @@ type GetByIDRequest struct {
 
 func (x *GetByIDRequest) Reset() {
 	*x = GetByIDRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[47]
+	mi := &file_proto_storage_storage_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2967,7 +3252,7 @@ func (x *GetByIDRequest) String() string {
 func (*GetByIDRequest) ProtoMessage() {}
 
 func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[47]
+	mi := &file_proto_storage_storage_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2980,7 +3265,7 @@ func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{47}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetByIDRequest) GetId() int32 {
@@ -2999,7 +3284,7 @@ type GetPatientByIDResponse struct {
 
 func (x *GetPatientByIDResponse) Reset() {
 	*x = GetPatientByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[48]
+	mi := &file_proto_storage_storage_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3011,7 +3296,7 @@ func (x *GetPatientByIDResponse) String() string {
 func (*GetPatientByIDResponse) ProtoMessage() {}
 
 func (x *GetPatientByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[48]
+	mi := &file_proto_storage_storage_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3024,7 +3309,7 @@ func (x *GetPatientByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{48}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetPatientByIDResponse) GetPatient() *Patient {
@@ -3043,7 +3328,7 @@ type AddAppointmentRequest struct {
 
 func (x *AddAppointmentRequest) Reset() {
 	*x = AddAppointmentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[49]
+	mi := &file_proto_storage_storage_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3055,7 +3340,7 @@ func (x *AddAppointmentRequest) String() string {
 func (*AddAppointmentRequest) ProtoMessage() {}
 
 func (x *AddAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[49]
+	mi := &file_proto_storage_storage_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3068,7 +3353,7 @@ func (x *AddAppointmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddAppointmentRequest.ProtoReflect.Descriptor instead.
 func (*AddAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{49}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *AddAppointmentRequest) GetAppointment() *Appointment {
@@ -3087,7 +3372,7 @@ type UpdateAppointmentRequest struct {
 
 func (x *UpdateAppointmentRequest) Reset() {
 	*x = UpdateAppointmentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[50]
+	mi := &file_proto_storage_storage_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3099,7 +3384,7 @@ func (x *UpdateAppointmentRequest) String() string {
 func (*UpdateAppointmentRequest) ProtoMessage() {}
 
 func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[50]
+	mi := &file_proto_storage_storage_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3112,7 +3397,7 @@ func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAppointmentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{50}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *UpdateAppointmentRequest) GetAppointment() *Appointment {
@@ -3131,7 +3416,7 @@ type GetAppointmentsByUserIDResponse struct {
 
 func (x *GetAppointmentsByUserIDResponse) Reset() {
 	*x = GetAppointmentsByUserIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[51]
+	mi := &file_proto_storage_storage_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3143,7 +3428,7 @@ func (x *GetAppointmentsByUserIDResponse) String() string {
 func (*GetAppointmentsByUserIDResponse) ProtoMessage() {}
 
 func (x *GetAppointmentsByUserIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[51]
+	mi := &file_proto_storage_storage_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3156,7 +3441,7 @@ func (x *GetAppointmentsByUserIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppointmentsByUserIDResponse.ProtoReflect.Descriptor instead.
 func (*GetAppointmentsByUserIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{51}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetAppointmentsByUserIDResponse) GetAppointment() []*Appointment {
@@ -3175,7 +3460,7 @@ type GetAppointmentByIDResponse struct {
 
 func (x *GetAppointmentByIDResponse) Reset() {
 	*x = GetAppointmentByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[52]
+	mi := &file_proto_storage_storage_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3187,7 +3472,7 @@ func (x *GetAppointmentByIDResponse) String() string {
 func (*GetAppointmentByIDResponse) ProtoMessage() {}
 
 func (x *GetAppointmentByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[52]
+	mi := &file_proto_storage_storage_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3200,7 +3485,7 @@ func (x *GetAppointmentByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppointmentByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetAppointmentByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{52}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetAppointmentByIDResponse) GetAppointment() *Appointment {
@@ -3219,7 +3504,7 @@ type GetDoctorByIDResponse struct {
 
 func (x *GetDoctorByIDResponse) Reset() {
 	*x = GetDoctorByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[53]
+	mi := &file_proto_storage_storage_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3231,7 +3516,7 @@ func (x *GetDoctorByIDResponse) String() string {
 func (*GetDoctorByIDResponse) ProtoMessage() {}
 
 func (x *GetDoctorByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[53]
+	mi := &file_proto_storage_storage_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3244,7 +3529,7 @@ func (x *GetDoctorByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{53}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetDoctorByIDResponse) GetDoctor() *Doctor {
@@ -3263,7 +3548,7 @@ type GetSpecsByDoctorIDResponse struct {
 
 func (x *GetSpecsByDoctorIDResponse) Reset() {
 	*x = GetSpecsByDoctorIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[54]
+	mi := &file_proto_storage_storage_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3275,7 +3560,7 @@ func (x *GetSpecsByDoctorIDResponse) String() string {
 func (*GetSpecsByDoctorIDResponse) ProtoMessage() {}
 
 func (x *GetSpecsByDoctorIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[54]
+	mi := &file_proto_storage_storage_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3288,7 +3573,7 @@ func (x *GetSpecsByDoctorIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSpecsByDoctorIDResponse.ProtoReflect.Descriptor instead.
 func (*GetSpecsByDoctorIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{54}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetSpecsByDoctorIDResponse) GetSpecId() []int32 {
@@ -3307,7 +3592,7 @@ type GetClinicOverrideRequest struct {
 
 func (x *GetClinicOverrideRequest) Reset() {
 	*x = GetClinicOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[55]
+	mi := &file_proto_storage_storage_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3319,7 +3604,7 @@ func (x *GetClinicOverrideRequest) String() string {
 func (*GetClinicOverrideRequest) ProtoMessage() {}
 
 func (x *GetClinicOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[55]
+	mi := &file_proto_storage_storage_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3332,7 +3617,7 @@ func (x *GetClinicOverrideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClinicOverrideRequest.ProtoReflect.Descriptor instead.
 func (*GetClinicOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{55}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetClinicOverrideRequest) GetDate() *timestamppb.Timestamp {
@@ -3354,7 +3639,7 @@ type GetClinicOverrideResponse struct {
 
 func (x *GetClinicOverrideResponse) Reset() {
 	*x = GetClinicOverrideResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[56]
+	mi := &file_proto_storage_storage_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3366,7 +3651,7 @@ func (x *GetClinicOverrideResponse) String() string {
 func (*GetClinicOverrideResponse) ProtoMessage() {}
 
 func (x *GetClinicOverrideResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[56]
+	mi := &file_proto_storage_storage_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3379,7 +3664,7 @@ func (x *GetClinicOverrideResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClinicOverrideResponse.ProtoReflect.Descriptor instead.
 func (*GetClinicOverrideResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{56}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetClinicOverrideResponse) GetDate() *timestamppb.Timestamp {
@@ -3420,7 +3705,7 @@ type GetDoctorOverrideRequest struct {
 
 func (x *GetDoctorOverrideRequest) Reset() {
 	*x = GetDoctorOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[57]
+	mi := &file_proto_storage_storage_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3432,7 +3717,7 @@ func (x *GetDoctorOverrideRequest) String() string {
 func (*GetDoctorOverrideRequest) ProtoMessage() {}
 
 func (x *GetDoctorOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[57]
+	mi := &file_proto_storage_storage_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3445,7 +3730,7 @@ func (x *GetDoctorOverrideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorOverrideRequest.ProtoReflect.Descriptor instead.
 func (*GetDoctorOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{57}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetDoctorOverrideRequest) GetDoctorId() int32 {
@@ -3475,7 +3760,7 @@ type GetDoctorOverrideResponse struct {
 
 func (x *GetDoctorOverrideResponse) Reset() {
 	*x = GetDoctorOverrideResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[58]
+	mi := &file_proto_storage_storage_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3487,7 +3772,7 @@ func (x *GetDoctorOverrideResponse) String() string {
 func (*GetDoctorOverrideResponse) ProtoMessage() {}
 
 func (x *GetDoctorOverrideResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[58]
+	mi := &file_proto_storage_storage_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3500,7 +3785,7 @@ func (x *GetDoctorOverrideResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorOverrideResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorOverrideResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{58}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetDoctorOverrideResponse) GetDoctorId() int32 {
@@ -3551,7 +3836,7 @@ type DoctorOverride struct {
 
 func (x *DoctorOverride) Reset() {
 	*x = DoctorOverride{}
-	mi := &file_proto_storage_storage_proto_msgTypes[59]
+	mi := &file_proto_storage_storage_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3563,7 +3848,7 @@ func (x *DoctorOverride) String() string {
 func (*DoctorOverride) ProtoMessage() {}
 
 func (x *DoctorOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[59]
+	mi := &file_proto_storage_storage_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3576,7 +3861,7 @@ func (x *DoctorOverride) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoctorOverride.ProtoReflect.Descriptor instead.
 func (*DoctorOverride) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{59}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *DoctorOverride) GetDoctorId() int32 {
@@ -3623,7 +3908,7 @@ type GetDoctorOverridesResponse struct {
 
 func (x *GetDoctorOverridesResponse) Reset() {
 	*x = GetDoctorOverridesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[60]
+	mi := &file_proto_storage_storage_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3635,7 +3920,7 @@ func (x *GetDoctorOverridesResponse) String() string {
 func (*GetDoctorOverridesResponse) ProtoMessage() {}
 
 func (x *GetDoctorOverridesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[60]
+	mi := &file_proto_storage_storage_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3648,7 +3933,7 @@ func (x *GetDoctorOverridesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorOverridesResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorOverridesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{60}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetDoctorOverridesResponse) GetOverride() []*DoctorOverride {
@@ -3668,7 +3953,7 @@ type AddMaterialRequest struct {
 
 func (x *AddMaterialRequest) Reset() {
 	*x = AddMaterialRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[61]
+	mi := &file_proto_storage_storage_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3680,7 +3965,7 @@ func (x *AddMaterialRequest) String() string {
 func (*AddMaterialRequest) ProtoMessage() {}
 
 func (x *AddMaterialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[61]
+	mi := &file_proto_storage_storage_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3693,7 +3978,7 @@ func (x *AddMaterialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddMaterialRequest.ProtoReflect.Descriptor instead.
 func (*AddMaterialRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{61}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *AddMaterialRequest) GetName() string {
@@ -3721,7 +4006,7 @@ type AddServiceRequest struct {
 
 func (x *AddServiceRequest) Reset() {
 	*x = AddServiceRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[62]
+	mi := &file_proto_storage_storage_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3733,7 +4018,7 @@ func (x *AddServiceRequest) String() string {
 func (*AddServiceRequest) ProtoMessage() {}
 
 func (x *AddServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[62]
+	mi := &file_proto_storage_storage_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3746,7 +4031,7 @@ func (x *AddServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddServiceRequest.ProtoReflect.Descriptor instead.
 func (*AddServiceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{62}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *AddServiceRequest) GetName() string {
@@ -3781,7 +4066,7 @@ type UpdateMaterialRequest struct {
 
 func (x *UpdateMaterialRequest) Reset() {
 	*x = UpdateMaterialRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[63]
+	mi := &file_proto_storage_storage_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3793,7 +4078,7 @@ func (x *UpdateMaterialRequest) String() string {
 func (*UpdateMaterialRequest) ProtoMessage() {}
 
 func (x *UpdateMaterialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[63]
+	mi := &file_proto_storage_storage_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3806,7 +4091,7 @@ func (x *UpdateMaterialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMaterialRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMaterialRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{63}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *UpdateMaterialRequest) GetId() int32 {
@@ -3842,7 +4127,7 @@ type UpdateServiceRequest struct {
 
 func (x *UpdateServiceRequest) Reset() {
 	*x = UpdateServiceRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[64]
+	mi := &file_proto_storage_storage_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3854,7 +4139,7 @@ func (x *UpdateServiceRequest) String() string {
 func (*UpdateServiceRequest) ProtoMessage() {}
 
 func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[64]
+	mi := &file_proto_storage_storage_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3867,7 +4152,7 @@ func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServiceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServiceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{64}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *UpdateServiceRequest) GetId() int32 {
@@ -3903,13 +4188,14 @@ type Material struct {
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Material) Reset() {
 	*x = Material{}
-	mi := &file_proto_storage_storage_proto_msgTypes[65]
+	mi := &file_proto_storage_storage_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3921,7 +4207,7 @@ func (x *Material) String() string {
 func (*Material) ProtoMessage() {}
 
 func (x *Material) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[65]
+	mi := &file_proto_storage_storage_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3934,7 +4220,7 @@ func (x *Material) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Material.ProtoReflect.Descriptor instead.
 func (*Material) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{65}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *Material) GetId() int32 {
@@ -3958,19 +4244,27 @@ func (x *Material) GetPrice() int32 {
 	return 0
 }
 
+func (x *Material) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 type Service struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
-	Type          int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price           int32                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	Type            int32                  `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	DurationMinutes int32                  `protobuf:"varint,5,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Service) Reset() {
 	*x = Service{}
-	mi := &file_proto_storage_storage_proto_msgTypes[66]
+	mi := &file_proto_storage_storage_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3982,7 +4276,7 @@ func (x *Service) String() string {
 func (*Service) ProtoMessage() {}
 
 func (x *Service) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[66]
+	mi := &file_proto_storage_storage_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3995,7 +4289,7 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Service.ProtoReflect.Descriptor instead.
 func (*Service) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{66}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *Service) GetId() int32 {
@@ -4026,6 +4320,13 @@ func (x *Service) GetType() int32 {
 	return 0
 }
 
+func (x *Service) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
 type GetMaterialsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Materials     []*Material            `protobuf:"bytes,1,rep,name=materials,proto3" json:"materials,omitempty"`
@@ -4035,7 +4336,7 @@ type GetMaterialsResponse struct {
 
 func (x *GetMaterialsResponse) Reset() {
 	*x = GetMaterialsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[67]
+	mi := &file_proto_storage_storage_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4047,7 +4348,7 @@ func (x *GetMaterialsResponse) String() string {
 func (*GetMaterialsResponse) ProtoMessage() {}
 
 func (x *GetMaterialsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[67]
+	mi := &file_proto_storage_storage_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4060,7 +4361,7 @@ func (x *GetMaterialsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMaterialsResponse.ProtoReflect.Descriptor instead.
 func (*GetMaterialsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{67}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *GetMaterialsResponse) GetMaterials() []*Material {
@@ -4079,7 +4380,7 @@ type GetServicesResponse struct {
 
 func (x *GetServicesResponse) Reset() {
 	*x = GetServicesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[68]
+	mi := &file_proto_storage_storage_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4091,7 +4392,7 @@ func (x *GetServicesResponse) String() string {
 func (*GetServicesResponse) ProtoMessage() {}
 
 func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[68]
+	mi := &file_proto_storage_storage_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4104,7 +4405,7 @@ func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServicesResponse.ProtoReflect.Descriptor instead.
 func (*GetServicesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{68}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetServicesResponse) GetServices() []*Service {
@@ -4123,7 +4424,7 @@ type GetByNameRequest struct {
 
 func (x *GetByNameRequest) Reset() {
 	*x = GetByNameRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[69]
+	mi := &file_proto_storage_storage_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4135,7 +4436,7 @@ func (x *GetByNameRequest) String() string {
 func (*GetByNameRequest) ProtoMessage() {}
 
 func (x *GetByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[69]
+	mi := &file_proto_storage_storage_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4148,7 +4449,7 @@ func (x *GetByNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByNameRequest.ProtoReflect.Descriptor instead.
 func (*GetByNameRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{69}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *GetByNameRequest) GetName() string {
@@ -4167,7 +4468,7 @@ type GetByIdRequest struct {
 
 func (x *GetByIdRequest) Reset() {
 	*x = GetByIdRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[70]
+	mi := &file_proto_storage_storage_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4179,7 +4480,7 @@ func (x *GetByIdRequest) String() string {
 func (*GetByIdRequest) ProtoMessage() {}
 
 func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[70]
+	mi := &file_proto_storage_storage_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4192,7 +4493,7 @@ func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetByIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{70}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *GetByIdRequest) GetId() int32 {
@@ -4213,7 +4514,7 @@ type GetMaterialByNameResponse struct {
 
 func (x *GetMaterialByNameResponse) Reset() {
 	*x = GetMaterialByNameResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[71]
+	mi := &file_proto_storage_storage_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4225,7 +4526,7 @@ func (x *GetMaterialByNameResponse) String() string {
 func (*GetMaterialByNameResponse) ProtoMessage() {}
 
 func (x *GetMaterialByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[71]
+	mi := &file_proto_storage_storage_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4238,7 +4539,7 @@ func (x *GetMaterialByNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMaterialByNameResponse.ProtoReflect.Descriptor instead.
 func (*GetMaterialByNameResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{71}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *GetMaterialByNameResponse) GetId() int32 {
@@ -4274,7 +4575,7 @@ type GetServiceByNameResponse struct {
 
 func (x *GetServiceByNameResponse) Reset() {
 	*x = GetServiceByNameResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[72]
+	mi := &file_proto_storage_storage_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4286,7 +4587,7 @@ func (x *GetServiceByNameResponse) String() string {
 func (*GetServiceByNameResponse) ProtoMessage() {}
 
 func (x *GetServiceByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[72]
+	mi := &file_proto_storage_storage_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4299,7 +4600,7 @@ func (x *GetServiceByNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceByNameResponse.ProtoReflect.Descriptor instead.
 func (*GetServiceByNameResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{72}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetServiceByNameResponse) GetId() int32 {
@@ -4339,7 +4640,7 @@ type DeleteRequest struct {
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[73]
+	mi := &file_proto_storage_storage_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4351,7 +4652,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[73]
+	mi := &file_proto_storage_storage_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4364,7 +4665,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{73}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *DeleteRequest) GetId() int32 {
@@ -4384,7 +4685,7 @@ type ServiceType struct {
 
 func (x *ServiceType) Reset() {
 	*x = ServiceType{}
-	mi := &file_proto_storage_storage_proto_msgTypes[74]
+	mi := &file_proto_storage_storage_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4396,7 +4697,7 @@ func (x *ServiceType) String() string {
 func (*ServiceType) ProtoMessage() {}
 
 func (x *ServiceType) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[74]
+	mi := &file_proto_storage_storage_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4409,7 +4710,7 @@ func (x *ServiceType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceType.ProtoReflect.Descriptor instead.
 func (*ServiceType) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{74}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ServiceType) GetId() int32 {
@@ -4435,7 +4736,7 @@ type GetServicesTypesResponse struct {
 
 func (x *GetServicesTypesResponse) Reset() {
 	*x = GetServicesTypesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[75]
+	mi := &file_proto_storage_storage_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4447,7 +4748,7 @@ func (x *GetServicesTypesResponse) String() string {
 func (*GetServicesTypesResponse) ProtoMessage() {}
 
 func (x *GetServicesTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[75]
+	mi := &file_proto_storage_storage_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4460,7 +4761,7 @@ func (x *GetServicesTypesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServicesTypesResponse.ProtoReflect.Descriptor instead.
 func (*GetServicesTypesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{75}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GetServicesTypesResponse) GetTypes() []*ServiceType {
@@ -4479,7 +4780,7 @@ type GetServiceTypeByIdRequest struct {
 
 func (x *GetServiceTypeByIdRequest) Reset() {
 	*x = GetServiceTypeByIdRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[76]
+	mi := &file_proto_storage_storage_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4491,7 +4792,7 @@ func (x *GetServiceTypeByIdRequest) String() string {
 func (*GetServiceTypeByIdRequest) ProtoMessage() {}
 
 func (x *GetServiceTypeByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[76]
+	mi := &file_proto_storage_storage_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4504,7 +4805,7 @@ func (x *GetServiceTypeByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceTypeByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetServiceTypeByIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{76}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *GetServiceTypeByIdRequest) GetId() int32 {
@@ -4524,7 +4825,7 @@ type GetServiceTypeByIdResponse struct {
 
 func (x *GetServiceTypeByIdResponse) Reset() {
 	*x = GetServiceTypeByIdResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[77]
+	mi := &file_proto_storage_storage_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4536,7 +4837,7 @@ func (x *GetServiceTypeByIdResponse) String() string {
 func (*GetServiceTypeByIdResponse) ProtoMessage() {}
 
 func (x *GetServiceTypeByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[77]
+	mi := &file_proto_storage_storage_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4549,7 +4850,7 @@ func (x *GetServiceTypeByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceTypeByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetServiceTypeByIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{77}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *GetServiceTypeByIdResponse) GetId() int32 {
@@ -4576,7 +4877,7 @@ type UpdateUserLoginRequest struct {
 
 func (x *UpdateUserLoginRequest) Reset() {
 	*x = UpdateUserLoginRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[78]
+	mi := &file_proto_storage_storage_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4588,7 +4889,7 @@ func (x *UpdateUserLoginRequest) String() string {
 func (*UpdateUserLoginRequest) ProtoMessage() {}
 
 func (x *UpdateUserLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[78]
+	mi := &file_proto_storage_storage_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4601,7 +4902,7 @@ func (x *UpdateUserLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserLoginRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserLoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{78}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *UpdateUserLoginRequest) GetUserId() int32 {
@@ -4629,7 +4930,7 @@ type ICDCode struct {
 
 func (x *ICDCode) Reset() {
 	*x = ICDCode{}
-	mi := &file_proto_storage_storage_proto_msgTypes[79]
+	mi := &file_proto_storage_storage_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4641,7 +4942,7 @@ func (x *ICDCode) String() string {
 func (*ICDCode) ProtoMessage() {}
 
 func (x *ICDCode) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[79]
+	mi := &file_proto_storage_storage_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4654,7 +4955,7 @@ func (x *ICDCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ICDCode.ProtoReflect.Descriptor instead.
 func (*ICDCode) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{79}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *ICDCode) GetId() int32 {
@@ -4690,7 +4991,7 @@ type Diagnose struct {
 
 func (x *Diagnose) Reset() {
 	*x = Diagnose{}
-	mi := &file_proto_storage_storage_proto_msgTypes[80]
+	mi := &file_proto_storage_storage_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4702,7 +5003,7 @@ func (x *Diagnose) String() string {
 func (*Diagnose) ProtoMessage() {}
 
 func (x *Diagnose) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[80]
+	mi := &file_proto_storage_storage_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4715,7 +5016,7 @@ func (x *Diagnose) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Diagnose.ProtoReflect.Descriptor instead.
 func (*Diagnose) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{80}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *Diagnose) GetId() int32 {
@@ -4759,7 +5060,7 @@ type Visit struct {
 
 func (x *Visit) Reset() {
 	*x = Visit{}
-	mi := &file_proto_storage_storage_proto_msgTypes[81]
+	mi := &file_proto_storage_storage_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4771,7 +5072,7 @@ func (x *Visit) String() string {
 func (*Visit) ProtoMessage() {}
 
 func (x *Visit) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[81]
+	mi := &file_proto_storage_storage_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4784,7 +5085,7 @@ func (x *Visit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Visit.ProtoReflect.Descriptor instead.
 func (*Visit) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{81}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *Visit) GetId() int32 {
@@ -4834,7 +5135,7 @@ type PatientAllergiesChronics struct {
 
 func (x *PatientAllergiesChronics) Reset() {
 	*x = PatientAllergiesChronics{}
-	mi := &file_proto_storage_storage_proto_msgTypes[82]
+	mi := &file_proto_storage_storage_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4846,7 +5147,7 @@ func (x *PatientAllergiesChronics) String() string {
 func (*PatientAllergiesChronics) ProtoMessage() {}
 
 func (x *PatientAllergiesChronics) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[82]
+	mi := &file_proto_storage_storage_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4859,7 +5160,7 @@ func (x *PatientAllergiesChronics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatientAllergiesChronics.ProtoReflect.Descriptor instead.
 func (*PatientAllergiesChronics) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{82}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *PatientAllergiesChronics) GetId() int32 {
@@ -4899,7 +5200,7 @@ type GetPatientDiagnosesResponse struct {
 
 func (x *GetPatientDiagnosesResponse) Reset() {
 	*x = GetPatientDiagnosesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[83]
+	mi := &file_proto_storage_storage_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4911,7 +5212,7 @@ func (x *GetPatientDiagnosesResponse) String() string {
 func (*GetPatientDiagnosesResponse) ProtoMessage() {}
 
 func (x *GetPatientDiagnosesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[83]
+	mi := &file_proto_storage_storage_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4924,7 +5225,7 @@ func (x *GetPatientDiagnosesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientDiagnosesResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientDiagnosesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{83}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetPatientDiagnosesResponse) GetDiagnoses() []*Diagnose {
@@ -4943,7 +5244,7 @@ type GetPatientVisitsResponse struct {
 
 func (x *GetPatientVisitsResponse) Reset() {
 	*x = GetPatientVisitsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[84]
+	mi := &file_proto_storage_storage_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4955,7 +5256,7 @@ func (x *GetPatientVisitsResponse) String() string {
 func (*GetPatientVisitsResponse) ProtoMessage() {}
 
 func (x *GetPatientVisitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[84]
+	mi := &file_proto_storage_storage_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4968,7 +5269,7 @@ func (x *GetPatientVisitsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientVisitsResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientVisitsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{84}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *GetPatientVisitsResponse) GetVisits() []*Visit {
@@ -4987,7 +5288,7 @@ type GetPatientAllergiesChronicsResponse struct {
 
 func (x *GetPatientAllergiesChronicsResponse) Reset() {
 	*x = GetPatientAllergiesChronicsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[85]
+	mi := &file_proto_storage_storage_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4999,7 +5300,7 @@ func (x *GetPatientAllergiesChronicsResponse) String() string {
 func (*GetPatientAllergiesChronicsResponse) ProtoMessage() {}
 
 func (x *GetPatientAllergiesChronicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[85]
+	mi := &file_proto_storage_storage_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5012,7 +5313,7 @@ func (x *GetPatientAllergiesChronicsResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetPatientAllergiesChronicsResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientAllergiesChronicsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{85}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *GetPatientAllergiesChronicsResponse) GetPatientAllergiesChronics() []*PatientAllergiesChronics {
@@ -5022,16 +5323,70 @@ func (x *GetPatientAllergiesChronicsResponse) GetPatientAllergiesChronics() []*P
 	return nil
 }
 
+// sort: code (по умолчанию), name
+type GetICDCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // начало кода или часть названия
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetICDCodesRequest) Reset() {
+	*x = GetICDCodesRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetICDCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetICDCodesRequest) ProtoMessage() {}
+
+func (x *GetICDCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetICDCodesRequest.ProtoReflect.Descriptor instead.
+func (*GetICDCodesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetICDCodesRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetICDCodesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
 type GetICDCodesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IcdCode       []*ICDCode             `protobuf:"bytes,1,rep,name=icd_code,json=icdCode,proto3" json:"icd_code,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных кодов без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetICDCodesResponse) Reset() {
 	*x = GetICDCodesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[86]
+	mi := &file_proto_storage_storage_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5043,7 +5398,7 @@ func (x *GetICDCodesResponse) String() string {
 func (*GetICDCodesResponse) ProtoMessage() {}
 
 func (x *GetICDCodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[86]
+	mi := &file_proto_storage_storage_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5056,7 +5411,7 @@ func (x *GetICDCodesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetICDCodesResponse.ProtoReflect.Descriptor instead.
 func (*GetICDCodesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{86}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *GetICDCodesResponse) GetIcdCode() []*ICDCode {
@@ -5066,6 +5421,13 @@ func (x *GetICDCodesResponse) GetIcdCode() []*ICDCode {
 	return nil
 }
 
+func (x *GetICDCodesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 var File_proto_storage_storage_proto protoreflect.FileDescriptor
 
 const file_proto_storage_storage_proto_rawDesc = "" +
@@ -5119,7 +5481,12 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x06gender\x18\b \x01(\tR\x06gender\"*\n" +
 	"\x12AddPatientResponse\x12\x14\n" +
 	"\x05error\x18\x01 \x01(\tR\x05error\"\x0e\n" +
-	"\fEmptyRequest\"4\n" +
+	"\fEmptyRequest\"`\n" +
+	"\bListPage\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\bR\x04desc\"4\n" +
 	"\x0eSpecialization\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"D\n" +
@@ -5145,11 +5512,12 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x1dGetScheduleByDoctorIdResponse\x12F\n" +
 	"\x0fdoctor_schedule\x18\x01 \x03(\v2\x1d.storage.WeeklyDoctorScheduleR\x0edoctorSchedule\"-\n" +
 	"\x15GetUserByLoginRequest\x12\x14\n" +
-	"\x05login\x18\x01 \x01(\tR\x05login\"Z\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\"r\n" +
 	"\x16GetUserByLoginResponse\x12\x14\n" +
 	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x0e\n" +
-	"\x02id\x18\x03 \x01(\x05R\x02id\"]\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"]\n" +
 	"\x19UpdateUserPasswordRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05login\x18\x02 \x01(\tR\x05login\x12\x1a\n" +
@@ -5189,9 +5557,14 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\aspec_id\x18\x02 \x01(\x05R\x06specId\"O\n" +
 	"\x17DeleteDoctorSpecRequest\x12\x1b\n" +
 	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\x12\x17\n" +
-	"\aspec_id\x18\x02 \x01(\x05R\x06specId\"?\n" +
+	"\aspec_id\x18\x02 \x01(\x05R\x06specId\"l\n" +
+	"\x11GetDoctorsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"U\n" +
 	"\x12GetDoctorsResponse\x12)\n" +
-	"\adoctors\x18\x01 \x03(\v2\x0f.storage.DoctorR\adoctors\"8\n" +
+	"\adoctors\x18\x01 \x03(\v2\x0f.storage.DoctorR\adoctors\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"8\n" +
 	" GetDoctorSpecsByDoctorIdResponse\x12\x14\n" +
 	"\x05specs\x18\x01 \x03(\x05R\x05specs\"\xcb\x01\n" +
 	"\x05Admin\x12\x17\n" +
@@ -5216,9 +5589,14 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x06gender\x18\a \x01(\tR\x06gender\"J\n" +
 	"\x16UpdateAdminRoleRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
-	"\arole_id\x18\x02 \x01(\x05R\x06roleId\";\n" +
+	"\arole_id\x18\x02 \x01(\x05R\x06roleId\"k\n" +
+	"\x10GetAdminsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"Q\n" +
 	"\x11GetAdminsResponse\x12&\n" +
-	"\x06admins\x18\x01 \x03(\v2\x0e.storage.AdminR\x06admins\"\x88\x02\n" +
+	"\x06admins\x18\x01 \x03(\v2\x0e.storage.AdminR\x06admins\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x88\x02\n" +
 	"\aPatient\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
 	"\n" +
@@ -5242,9 +5620,14 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\n" +
 	"birth_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tbirthDate\x12!\n" +
 	"\fphone_number\x18\a \x01(\tR\vphoneNumber\x12\x16\n" +
-	"\x06gender\x18\b \x01(\tR\x06gender\"C\n" +
+	"\x06gender\x18\b \x01(\tR\x06gender\"m\n" +
+	"\x12GetPatientsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"Y\n" +
 	"\x13GetPatientsResponse\x12,\n" +
-	"\bpatients\x18\x01 \x03(\v2\x10.storage.PatientR\bpatients\"\x84\x02\n" +
+	"\bpatients\x18\x01 \x03(\v2\x10.storage.PatientR\bpatients\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x84\x02\n" +
 	"\x14WeeklyClinicSchedule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
 	"\aweekday\x18\x02 \x01(\x05R\aweekday\x129\n" +
@@ -5375,16 +5758,18 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\x05R\x04type\"D\n" +
+	"\x04type\x18\x04 \x01(\x05R\x04type\"X\n" +
 	"\bMaterial\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x05R\x05price\"W\n" +
+	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\"\x82\x01\n" +
 	"\aService\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05price\x18\x03 \x01(\x05R\x05price\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\x05R\x04type\"G\n" +
+	"\x04type\x18\x04 \x01(\x05R\x04type\x12)\n" +
+	"\x10duration_minutes\x18\x05 \x01(\x05R\x0fdurationMinutes\"G\n" +
 	"\x14GetMaterialsResponse\x12/\n" +
 	"\tmaterials\x18\x01 \x03(\v2\x11.storage.MaterialR\tmaterials\"C\n" +
 	"\x13GetServicesResponse\x12,\n" +
@@ -5446,19 +5831,23 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x18GetPatientVisitsResponse\x12&\n" +
 	"\x06visits\x18\x01 \x03(\v2\x0e.storage.VisitR\x06visits\"\x86\x01\n" +
 	"#GetPatientAllergiesChronicsResponse\x12_\n" +
-	"\x1apatient_allergies_chronics\x18\x01 \x03(\v2!.storage.PatientAllergiesChronicsR\x18patientAllergiesChronics\"B\n" +
+	"\x1apatient_allergies_chronics\x18\x01 \x03(\v2!.storage.PatientAllergiesChronicsR\x18patientAllergiesChronics\"Q\n" +
+	"\x12GetICDCodesRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\"X\n" +
 	"\x13GetICDCodesResponse\x12+\n" +
-	"\bicd_code\x18\x01 \x03(\v2\x10.storage.ICDCodeR\aicdCode2\xc1\"\n" +
+	"\bicd_code\x18\x01 \x03(\v2\x10.storage.ICDCodeR\aicdCode\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total2\xd6\"\n" +
 	"\x0eStorageService\x12<\n" +
 	"\aAddUser\x12\x17.storage.AddUserRequest\x1a\x18.storage.AddUserResponse\x12B\n" +
 	"\tAddDoctor\x12\x19.storage.AddDoctorRequest\x1a\x1a.storage.AddDoctorResponse\x12?\n" +
 	"\bAddAdmin\x12\x18.storage.AddAdminRequest\x1a\x19.storage.AddAdminResponse\x12E\n" +
 	"\n" +
-	"AddPatient\x12\x1a.storage.AddPatientRequest\x1a\x1b.storage.AddPatientResponse\x12@\n" +
+	"AddPatient\x12\x1a.storage.AddPatientRequest\x1a\x1b.storage.AddPatientResponse\x12E\n" +
 	"\n" +
-	"GetDoctors\x12\x15.storage.EmptyRequest\x1a\x1b.storage.GetDoctorsResponse\x12>\n" +
-	"\tGetAdmins\x12\x15.storage.EmptyRequest\x1a\x1a.storage.GetAdminsResponse\x12B\n" +
-	"\vGetPatients\x12\x15.storage.EmptyRequest\x1a\x1c.storage.GetPatientsResponse\x12^\n" +
+	"GetDoctors\x12\x1a.storage.GetDoctorsRequest\x1a\x1b.storage.GetDoctorsResponse\x12B\n" +
+	"\tGetAdmins\x12\x19.storage.GetAdminsRequest\x1a\x1a.storage.GetAdminsResponse\x12H\n" +
+	"\vGetPatients\x12\x1b.storage.GetPatientsRequest\x1a\x1c.storage.GetPatientsResponse\x12^\n" +
 	"\x18GetDoctorSpecsByDoctorId\x12\x17.storage.GetByIdRequest\x1a).storage.GetDoctorSpecsByDoctorIdResponse\x12F\n" +
 	"\fUpdateDoctor\x12\x1c.storage.UpdateDoctorRequest\x1a\x18.storage.DefaultResponse\x12H\n" +
 	"\rAddDoctorSpec\x12\x1d.storage.AddDoctorSpecRequest\x1a\x18.storage.DefaultResponse\x12N\n" +
@@ -5505,8 +5894,8 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x12GetDoctorOverrides\x12\x17.storage.GetByIDRequest\x1a#.storage.GetDoctorOverridesResponse\x12T\n" +
 	"\x13GetPatientDiagnoses\x12\x17.storage.GetByIdRequest\x1a$.storage.GetPatientDiagnosesResponse\x12N\n" +
 	"\x10GetPatientVisits\x12\x17.storage.GetByIdRequest\x1a!.storage.GetPatientVisitsResponse\x12d\n" +
-	"\x1bGetPatientAllergiesChronics\x12\x17.storage.GetByIdRequest\x1a,.storage.GetPatientAllergiesChronicsResponse\x12B\n" +
-	"\vGetICDCodes\x12\x15.storage.EmptyRequest\x1a\x1c.storage.GetICDCodesResponse\x12J\n" +
+	"\x1bGetPatientAllergiesChronics\x12\x17.storage.GetByIdRequest\x1a,.storage.GetPatientAllergiesChronicsResponse\x12H\n" +
+	"\vGetICDCodes\x12\x1b.storage.GetICDCodesRequest\x1a\x1c.storage.GetICDCodesResponse\x12J\n" +
 	"\x0eGetPatientByID\x12\x17.storage.GetByIDRequest\x1a\x1f.storage.GetPatientByIDResponse\x12R\n" +
 	"\x12GetAppointmentByID\x12\x17.storage.GetByIDRequest\x1a#.storage.GetAppointmentByIDResponseB\x19Z\x17storage/proto;storagepbb\x06proto3"
 
@@ -5522,7 +5911,7 @@ func file_proto_storage_storage_proto_rawDescGZIP() []byte {
 	return file_proto_storage_storage_proto_rawDescData
 }
 
-var file_proto_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 87)
+var file_proto_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
 var file_proto_storage_storage_proto_goTypes = []any{
 	(*AddUserRequest)(nil),                      // 0: storage.AddUserRequest
 	(*AddUserResponse)(nil),                     // 1: storage.AddUserResponse
@@ -5533,256 +5922,265 @@ var file_proto_storage_storage_proto_goTypes = []any{
 	(*AddPatientRequest)(nil),                   // 6: storage.AddPatientRequest
 	(*AddPatientResponse)(nil),                  // 7: storage.AddPatientResponse
 	(*EmptyRequest)(nil),                        // 8: storage.EmptyRequest
-	(*Specialization)(nil),                      // 9: storage.Specialization
-	(*GetAllSpecsResponse)(nil),                 // 10: storage.GetAllSpecsResponse
-	(*AddUserRoleRequest)(nil),                  // 11: storage.AddUserRoleRequest
-	(*AddUserRoleResponse)(nil),                 // 12: storage.AddUserRoleResponse
-	(*WeeklyDoctorSchedule)(nil),                // 13: storage.WeeklyDoctorSchedule
-	(*GetScheduleByDoctorIdRequest)(nil),        // 14: storage.GetScheduleByDoctorIdRequest
-	(*GetScheduleByDoctorIdResponse)(nil),       // 15: storage.GetScheduleByDoctorIdResponse
-	(*GetUserByLoginRequest)(nil),               // 16: storage.GetUserByLoginRequest
-	(*GetUserByLoginResponse)(nil),              // 17: storage.GetUserByLoginResponse
-	(*UpdateUserPasswordRequest)(nil),           // 18: storage.UpdateUserPasswordRequest
-	(*DefaultResponse)(nil),                     // 19: storage.DefaultResponse
-	(*Doctor)(nil),                              // 20: storage.Doctor
-	(*UpdateDoctorRequest)(nil),                 // 21: storage.UpdateDoctorRequest
-	(*AddDoctorSpecRequest)(nil),                // 22: storage.AddDoctorSpecRequest
-	(*DeleteDoctorSpecRequest)(nil),             // 23: storage.DeleteDoctorSpecRequest
-	(*GetDoctorsResponse)(nil),                  // 24: storage.GetDoctorsResponse
-	(*GetDoctorSpecsByDoctorIdResponse)(nil),    // 25: storage.GetDoctorSpecsByDoctorIdResponse
-	(*Admin)(nil),                               // 26: storage.Admin
-	(*UpdateAdminRequest)(nil),                  // 27: storage.UpdateAdminRequest
-	(*UpdateAdminRoleRequest)(nil),              // 28: storage.UpdateAdminRoleRequest
-	(*GetAdminsResponse)(nil),                   // 29: storage.GetAdminsResponse
-	(*Patient)(nil),                             // 30: storage.Patient
-	(*UpdatePatientRequest)(nil),                // 31: storage.UpdatePatientRequest
-	(*GetPatientsResponse)(nil),                 // 32: storage.GetPatientsResponse
-	(*WeeklyClinicSchedule)(nil),                // 33: storage.WeeklyClinicSchedule
-	(*GetClinicWeeklyScheduleResponse)(nil),     // 34: storage.GetClinicWeeklyScheduleResponse
-	(*GetUserRoleRequest)(nil),                  // 35: storage.GetUserRoleRequest
-	(*GetUserRoleResponse)(nil),                 // 36: storage.GetUserRoleResponse
-	(*UpdateClinicWeeklyScheduleRequest)(nil),   // 37: storage.UpdateClinicWeeklyScheduleRequest
-	(*AddDoctorWeeklyScheduleRequest)(nil),      // 38: storage.AddDoctorWeeklyScheduleRequest
-	(*UpdateDoctorWeeklyScheduleRequest)(nil),   // 39: storage.UpdateDoctorWeeklyScheduleRequest
-	(*GetRolePermissionRequest)(nil),            // 40: storage.GetRolePermissionRequest
-	(*AddClinicDailyOverrideRequest)(nil),       // 41: storage.AddClinicDailyOverrideRequest
-	(*AddDoctorDailyOverrideRequest)(nil),       // 42: storage.AddDoctorDailyOverrideRequest
-	(*GetDoctorBySpecIDRequest)(nil),            // 43: storage.GetDoctorBySpecIDRequest
-	(*GetAppointmentsByDoctorIDRequest)(nil),    // 44: storage.GetAppointmentsByDoctorIDRequest
-	(*Appointment)(nil),                         // 45: storage.Appointment
-	(*GetAppointmentsByDoctorIDResponse)(nil),   // 46: storage.GetAppointmentsByDoctorIDResponse
-	(*GetByIDRequest)(nil),                      // 47: storage.GetByIDRequest
-	(*GetPatientByIDResponse)(nil),              // 48: storage.GetPatientByIDResponse
-	(*AddAppointmentRequest)(nil),               // 49: storage.AddAppointmentRequest
-	(*UpdateAppointmentRequest)(nil),            // 50: storage.UpdateAppointmentRequest
-	(*GetAppointmentsByUserIDResponse)(nil),     // 51: storage.GetAppointmentsByUserIDResponse
-	(*GetAppointmentByIDResponse)(nil),          // 52: storage.GetAppointmentByIDResponse
-	(*GetDoctorByIDResponse)(nil),               // 53: storage.GetDoctorByIDResponse
-	(*GetSpecsByDoctorIDResponse)(nil),          // 54: storage.GetSpecsByDoctorIDResponse
-	(*GetClinicOverrideRequest)(nil),            // 55: storage.GetClinicOverrideRequest
-	(*GetClinicOverrideResponse)(nil),           // 56: storage.GetClinicOverrideResponse
-	(*GetDoctorOverrideRequest)(nil),            // 57: storage.GetDoctorOverrideRequest
-	(*GetDoctorOverrideResponse)(nil),           // 58: storage.GetDoctorOverrideResponse
-	(*DoctorOverride)(nil),                      // 59: storage.DoctorOverride
-	(*GetDoctorOverridesResponse)(nil),          // 60: storage.GetDoctorOverridesResponse
-	(*AddMaterialRequest)(nil),                  // 61: storage.AddMaterialRequest
-	(*AddServiceRequest)(nil),                   // 62: storage.AddServiceRequest
-	(*UpdateMaterialRequest)(nil),               // 63: storage.UpdateMaterialRequest
-	(*UpdateServiceRequest)(nil),                // 64: storage.UpdateServiceRequest
-	(*Material)(nil),                            // 65: storage.Material
-	(*Service)(nil),                             // 66: storage.Service
-	(*GetMaterialsResponse)(nil),                // 67: storage.GetMaterialsResponse
-	(*GetServicesResponse)(nil),                 // 68: storage.GetServicesResponse
-	(*GetByNameRequest)(nil),                    // 69: storage.GetByNameRequest
-	(*GetByIdRequest)(nil),                      // 70: storage.GetByIdRequest
-	(*GetMaterialByNameResponse)(nil),           // 71: storage.GetMaterialByNameResponse
-	(*GetServiceByNameResponse)(nil),            // 72: storage.GetServiceByNameResponse
-	(*DeleteRequest)(nil),                       // 73: storage.DeleteRequest
-	(*ServiceType)(nil),                         // 74: storage.ServiceType
-	(*GetServicesTypesResponse)(nil),            // 75: storage.GetServicesTypesResponse
-	(*GetServiceTypeByIdRequest)(nil),           // 76: storage.GetServiceTypeByIdRequest
-	(*GetServiceTypeByIdResponse)(nil),          // 77: storage.GetServiceTypeByIdResponse
-	(*UpdateUserLoginRequest)(nil),              // 78: storage.UpdateUserLoginRequest
-	(*ICDCode)(nil),                             // 79: storage.ICDCode
-	(*Diagnose)(nil),                            // 80: storage.Diagnose
-	(*Visit)(nil),                               // 81: storage.Visit
-	(*PatientAllergiesChronics)(nil),            // 82: storage.PatientAllergiesChronics
-	(*GetPatientDiagnosesResponse)(nil),         // 83: storage.GetPatientDiagnosesResponse
-	(*GetPatientVisitsResponse)(nil),            // 84: storage.GetPatientVisitsResponse
-	(*GetPatientAllergiesChronicsResponse)(nil), // 85: storage.GetPatientAllergiesChronicsResponse
-	(*GetICDCodesResponse)(nil),                 // 86: storage.GetICDCodesResponse
-	(*timestamppb.Timestamp)(nil),               // 87: google.protobuf.Timestamp
+	(*ListPage)(nil),                            // 9: storage.ListPage
+	(*Specialization)(nil),                      // 10: storage.Specialization
+	(*GetAllSpecsResponse)(nil),                 // 11: storage.GetAllSpecsResponse
+	(*AddUserRoleRequest)(nil),                  // 12: storage.AddUserRoleRequest
+	(*AddUserRoleResponse)(nil),                 // 13: storage.AddUserRoleResponse
+	(*WeeklyDoctorSchedule)(nil),                // 14: storage.WeeklyDoctorSchedule
+	(*GetScheduleByDoctorIdRequest)(nil),        // 15: storage.GetScheduleByDoctorIdRequest
+	(*GetScheduleByDoctorIdResponse)(nil),       // 16: storage.GetScheduleByDoctorIdResponse
+	(*GetUserByLoginRequest)(nil),               // 17: storage.GetUserByLoginRequest
+	(*GetUserByLoginResponse)(nil),              // 18: storage.GetUserByLoginResponse
+	(*UpdateUserPasswordRequest)(nil),           // 19: storage.UpdateUserPasswordRequest
+	(*DefaultResponse)(nil),                     // 20: storage.DefaultResponse
+	(*Doctor)(nil),                              // 21: storage.Doctor
+	(*UpdateDoctorRequest)(nil),                 // 22: storage.UpdateDoctorRequest
+	(*AddDoctorSpecRequest)(nil),                // 23: storage.AddDoctorSpecRequest
+	(*DeleteDoctorSpecRequest)(nil),             // 24: storage.DeleteDoctorSpecRequest
+	(*GetDoctorsRequest)(nil),                   // 25: storage.GetDoctorsRequest
+	(*GetDoctorsResponse)(nil),                  // 26: storage.GetDoctorsResponse
+	(*GetDoctorSpecsByDoctorIdResponse)(nil),    // 27: storage.GetDoctorSpecsByDoctorIdResponse
+	(*Admin)(nil),                               // 28: storage.Admin
+	(*UpdateAdminRequest)(nil),                  // 29: storage.UpdateAdminRequest
+	(*UpdateAdminRoleRequest)(nil),              // 30: storage.UpdateAdminRoleRequest
+	(*GetAdminsRequest)(nil),                    // 31: storage.GetAdminsRequest
+	(*GetAdminsResponse)(nil),                   // 32: storage.GetAdminsResponse
+	(*Patient)(nil),                             // 33: storage.Patient
+	(*UpdatePatientRequest)(nil),                // 34: storage.UpdatePatientRequest
+	(*GetPatientsRequest)(nil),                  // 35: storage.GetPatientsRequest
+	(*GetPatientsResponse)(nil),                 // 36: storage.GetPatientsResponse
+	(*WeeklyClinicSchedule)(nil),                // 37: storage.WeeklyClinicSchedule
+	(*GetClinicWeeklyScheduleResponse)(nil),     // 38: storage.GetClinicWeeklyScheduleResponse
+	(*GetUserRoleRequest)(nil),                  // 39: storage.GetUserRoleRequest
+	(*GetUserRoleResponse)(nil),                 // 40: storage.GetUserRoleResponse
+	(*UpdateClinicWeeklyScheduleRequest)(nil),   // 41: storage.UpdateClinicWeeklyScheduleRequest
+	(*AddDoctorWeeklyScheduleRequest)(nil),      // 42: storage.AddDoctorWeeklyScheduleRequest
+	(*UpdateDoctorWeeklyScheduleRequest)(nil),   // 43: storage.UpdateDoctorWeeklyScheduleRequest
+	(*GetRolePermissionRequest)(nil),            // 44: storage.GetRolePermissionRequest
+	(*AddClinicDailyOverrideRequest)(nil),       // 45: storage.AddClinicDailyOverrideRequest
+	(*AddDoctorDailyOverrideRequest)(nil),       // 46: storage.AddDoctorDailyOverrideRequest
+	(*GetDoctorBySpecIDRequest)(nil),            // 47: storage.GetDoctorBySpecIDRequest
+	(*GetAppointmentsByDoctorIDRequest)(nil),    // 48: storage.GetAppointmentsByDoctorIDRequest
+	(*Appointment)(nil),                         // 49: storage.Appointment
+	(*GetAppointmentsByDoctorIDResponse)(nil),   // 50: storage.GetAppointmentsByDoctorIDResponse
+	(*GetByIDRequest)(nil),                      // 51: storage.GetByIDRequest
+	(*GetPatientByIDResponse)(nil),              // 52: storage.GetPatientByIDResponse
+	(*AddAppointmentRequest)(nil),               // 53: storage.AddAppointmentRequest
+	(*UpdateAppointmentRequest)(nil),            // 54: storage.UpdateAppointmentRequest
+	(*GetAppointmentsByUserIDResponse)(nil),     // 55: storage.GetAppointmentsByUserIDResponse
+	(*GetAppointmentByIDResponse)(nil),          // 56: storage.GetAppointmentByIDResponse
+	(*GetDoctorByIDResponse)(nil),               // 57: storage.GetDoctorByIDResponse
+	(*GetSpecsByDoctorIDResponse)(nil),          // 58: storage.GetSpecsByDoctorIDResponse
+	(*GetClinicOverrideRequest)(nil),            // 59: storage.GetClinicOverrideRequest
+	(*GetClinicOverrideResponse)(nil),           // 60: storage.GetClinicOverrideResponse
+	(*GetDoctorOverrideRequest)(nil),            // 61: storage.GetDoctorOverrideRequest
+	(*GetDoctorOverrideResponse)(nil),           // 62: storage.GetDoctorOverrideResponse
+	(*DoctorOverride)(nil),                      // 63: storage.DoctorOverride
+	(*GetDoctorOverridesResponse)(nil),          // 64: storage.GetDoctorOverridesResponse
+	(*AddMaterialRequest)(nil),                  // 65: storage.AddMaterialRequest
+	(*AddServiceRequest)(nil),                   // 66: storage.AddServiceRequest
+	(*UpdateMaterialRequest)(nil),               // 67: storage.UpdateMaterialRequest
+	(*UpdateServiceRequest)(nil),                // 68: storage.UpdateServiceRequest
+	(*Material)(nil),                            // 69: storage.Material
+	(*Service)(nil),                             // 70: storage.Service
+	(*GetMaterialsResponse)(nil),                // 71: storage.GetMaterialsResponse
+	(*GetServicesResponse)(nil),                 // 72: storage.GetServicesResponse
+	(*GetByNameRequest)(nil),                    // 73: storage.GetByNameRequest
+	(*GetByIdRequest)(nil),                      // 74: storage.GetByIdRequest
+	(*GetMaterialByNameResponse)(nil),           // 75: storage.GetMaterialByNameResponse
+	(*GetServiceByNameResponse)(nil),            // 76: storage.GetServiceByNameResponse
+	(*DeleteRequest)(nil),                       // 77: storage.DeleteRequest
+	(*ServiceType)(nil),                         // 78: storage.ServiceType
+	(*GetServicesTypesResponse)(nil),            // 79: storage.GetServicesTypesResponse
+	(*GetServiceTypeByIdRequest)(nil),           // 80: storage.GetServiceTypeByIdRequest
+	(*GetServiceTypeByIdResponse)(nil),          // 81: storage.GetServiceTypeByIdResponse
+	(*UpdateUserLoginRequest)(nil),              // 82: storage.UpdateUserLoginRequest
+	(*ICDCode)(nil),                             // 83: storage.ICDCode
+	(*Diagnose)(nil),                            // 84: storage.Diagnose
+	(*Visit)(nil),                               // 85: storage.Visit
+	(*PatientAllergiesChronics)(nil),            // 86: storage.PatientAllergiesChronics
+	(*GetPatientDiagnosesResponse)(nil),         // 87: storage.GetPatientDiagnosesResponse
+	(*GetPatientVisitsResponse)(nil),            // 88: storage.GetPatientVisitsResponse
+	(*GetPatientAllergiesChronicsResponse)(nil), // 89: storage.GetPatientAllergiesChronicsResponse
+	(*GetICDCodesRequest)(nil),                  // 90: storage.GetICDCodesRequest
+	(*GetICDCodesResponse)(nil),                 // 91: storage.GetICDCodesResponse
+	(*timestamppb.Timestamp)(nil),               // 92: google.protobuf.Timestamp
 }
 var file_proto_storage_storage_proto_depIdxs = []int32{
-	87,  // 0: storage.AddPatientRequest.birth_date:type_name -> google.protobuf.Timestamp
-	9,   // 1: storage.GetAllSpecsResponse.specs:type_name -> storage.Specialization
-	87,  // 2: storage.WeeklyDoctorSchedule.start_time:type_name -> google.protobuf.Timestamp
-	87,  // 3: storage.WeeklyDoctorSchedule.end_time:type_name -> google.protobuf.Timestamp
-	13,  // 4: storage.GetScheduleByDoctorIdResponse.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
-	20,  // 5: storage.GetDoctorsResponse.doctors:type_name -> storage.Doctor
-	26,  // 6: storage.GetAdminsResponse.admins:type_name -> storage.Admin
-	87,  // 7: storage.Patient.birth_date:type_name -> google.protobuf.Timestamp
-	87,  // 8: storage.UpdatePatientRequest.birth_date:type_name -> google.protobuf.Timestamp
-	30,  // 9: storage.GetPatientsResponse.patients:type_name -> storage.Patient
-	87,  // 10: storage.WeeklyClinicSchedule.start_time:type_name -> google.protobuf.Timestamp
-	87,  // 11: storage.WeeklyClinicSchedule.end_time:type_name -> google.protobuf.Timestamp
-	33,  // 12: storage.GetClinicWeeklyScheduleResponse.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
-	33,  // 13: storage.UpdateClinicWeeklyScheduleRequest.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
-	13,  // 14: storage.AddDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
-	13,  // 15: storage.UpdateDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
-	87,  // 16: storage.AddClinicDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	87,  // 17: storage.AddClinicDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
-	87,  // 18: storage.AddClinicDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
-	87,  // 19: storage.AddDoctorDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	87,  // 20: storage.AddDoctorDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
-	87,  // 21: storage.AddDoctorDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
-	87,  // 22: storage.Appointment.date:type_name -> google.protobuf.Timestamp
-	87,  // 23: storage.Appointment.time:type_name -> google.protobuf.Timestamp
-	87,  // 24: storage.Appointment.birth_date:type_name -> google.protobuf.Timestamp
-	87,  // 25: storage.Appointment.created_at:type_name -> google.protobuf.Timestamp
-	87,  // 26: storage.Appointment.updated_at:type_name -> google.protobuf.Timestamp
-	45,  // 27: storage.GetAppointmentsByDoctorIDResponse.appointments:type_name -> storage.Appointment
-	30,  // 28: storage.GetPatientByIDResponse.patient:type_name -> storage.Patient
-	45,  // 29: storage.AddAppointmentRequest.appointment:type_name -> storage.Appointment
-	45,  // 30: storage.UpdateAppointmentRequest.appointment:type_name -> storage.Appointment
-	45,  // 31: storage.GetAppointmentsByUserIDResponse.appointment:type_name -> storage.Appointment
-	45,  // 32: storage.GetAppointmentByIDResponse.appointment:type_name -> storage.Appointment
-	20,  // 33: storage.GetDoctorByIDResponse.doctor:type_name -> storage.Doctor
-	87,  // 34: storage.GetClinicOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	87,  // 35: storage.GetClinicOverrideResponse.date:type_name -> google.protobuf.Timestamp
-	87,  // 36: storage.GetClinicOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
-	87,  // 37: storage.GetClinicOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
-	87,  // 38: storage.GetDoctorOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	87,  // 39: storage.GetDoctorOverrideResponse.date:type_name -> google.protobuf.Timestamp
-	87,  // 40: storage.GetDoctorOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
-	87,  // 41: storage.GetDoctorOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
-	87,  // 42: storage.DoctorOverride.date:type_name -> google.protobuf.Timestamp
-	87,  // 43: storage.DoctorOverride.start_time:type_name -> google.protobuf.Timestamp
-	87,  // 44: storage.DoctorOverride.end_time:type_name -> google.protobuf.Timestamp
-	59,  // 45: storage.GetDoctorOverridesResponse.override:type_name -> storage.DoctorOverride
-	65,  // 46: storage.GetMaterialsResponse.materials:type_name -> storage.Material
-	66,  // 47: storage.GetServicesResponse.services:type_name -> storage.Service
-	74,  // 48: storage.GetServicesTypesResponse.types:type_name -> storage.ServiceType
-	87,  // 49: storage.Visit.created_at:type_name -> google.protobuf.Timestamp
-	80,  // 50: storage.GetPatientDiagnosesResponse.diagnoses:type_name -> storage.Diagnose
-	81,  // 51: storage.GetPatientVisitsResponse.visits:type_name -> storage.Visit
-	82,  // 52: storage.GetPatientAllergiesChronicsResponse.patient_allergies_chronics:type_name -> storage.PatientAllergiesChronics
-	79,  // 53: storage.GetICDCodesResponse.icd_code:type_name -> storage.ICDCode
-	0,   // 54: storage.StorageService.AddUser:input_type -> storage.AddUserRequest
-	2,   // 55: storage.StorageService.AddDoctor:input_type -> storage.AddDoctorRequest
-	4,   // 56: storage.StorageService.AddAdmin:input_type -> storage.AddAdminRequest
-	6,   // 57: storage.StorageService.AddPatient:input_type -> storage.AddPatientRequest
-	8,   // 58: storage.StorageService.GetDoctors:input_type -> storage.EmptyRequest
-	8,   // 59: storage.StorageService.GetAdmins:input_type -> storage.EmptyRequest
-	8,   // 60: storage.StorageService.GetPatients:input_type -> storage.EmptyRequest
-	70,  // 61: storage.StorageService.GetDoctorSpecsByDoctorId:input_type -> storage.GetByIdRequest
-	21,  // 62: storage.StorageService.UpdateDoctor:input_type -> storage.UpdateDoctorRequest
-	22,  // 63: storage.StorageService.AddDoctorSpec:input_type -> storage.AddDoctorSpecRequest
-	23,  // 64: storage.StorageService.DeleteDoctorSpec:input_type -> storage.DeleteDoctorSpecRequest
-	27,  // 65: storage.StorageService.UpdateAdmin:input_type -> storage.UpdateAdminRequest
-	28,  // 66: storage.StorageService.UpdateAdminRole:input_type -> storage.UpdateAdminRoleRequest
-	31,  // 67: storage.StorageService.UpdatePatient:input_type -> storage.UpdatePatientRequest
-	73,  // 68: storage.StorageService.DeleteUser:input_type -> storage.DeleteRequest
-	78,  // 69: storage.StorageService.UpdateUserLogin:input_type -> storage.UpdateUserLoginRequest
-	8,   // 70: storage.StorageService.GetAllSpecs:input_type -> storage.EmptyRequest
-	11,  // 71: storage.StorageService.AddUserRole:input_type -> storage.AddUserRoleRequest
-	16,  // 72: storage.StorageService.GetUserByLogin:input_type -> storage.GetUserByLoginRequest
-	18,  // 73: storage.StorageService.UpdateUserPassword:input_type -> storage.UpdateUserPasswordRequest
-	8,   // 74: storage.StorageService.GetClinicWeeklySchedule:input_type -> storage.EmptyRequest
-	35,  // 75: storage.StorageService.GetUserRole:input_type -> storage.GetUserRoleRequest
-	14,  // 76: storage.StorageService.GetDoctorWeeklySchedule:input_type -> storage.GetScheduleByDoctorIdRequest
-	37,  // 77: storage.StorageService.UpdateClinicWeeklySchedule:input_type -> storage.UpdateClinicWeeklyScheduleRequest
-	38,  // 78: storage.StorageService.AddDoctorWeeklySchedule:input_type -> storage.AddDoctorWeeklyScheduleRequest
-	39,  // 79: storage.StorageService.UpdateDoctorWeeklySchedule:input_type -> storage.UpdateDoctorWeeklyScheduleRequest
-	40,  // 80: storage.StorageService.GetRolePermission:input_type -> storage.GetRolePermissionRequest
-	43,  // 81: storage.StorageService.GetDoctorsBySpecID:input_type -> storage.GetDoctorBySpecIDRequest
-	44,  // 82: storage.StorageService.GetAppointmentsByDoctorID:input_type -> storage.GetAppointmentsByDoctorIDRequest
-	49,  // 83: storage.StorageService.AddAppointment:input_type -> storage.AddAppointmentRequest
-	47,  // 84: storage.StorageService.GetAppointmentsByUserID:input_type -> storage.GetByIDRequest
-	47,  // 85: storage.StorageService.GetSpecsByDoctorID:input_type -> storage.GetByIDRequest
-	47,  // 86: storage.StorageService.GetDoctorByID:input_type -> storage.GetByIDRequest
-	50,  // 87: storage.StorageService.UpdateAppointment:input_type -> storage.UpdateAppointmentRequest
-	41,  // 88: storage.StorageService.AddClinicDailyOverride:input_type -> storage.AddClinicDailyOverrideRequest
-	42,  // 89: storage.StorageService.AddDoctorDailyOverride:input_type -> storage.AddDoctorDailyOverrideRequest
-	55,  // 90: storage.StorageService.GetClinicOverride:input_type -> storage.GetClinicOverrideRequest
-	57,  // 91: storage.StorageService.GetDoctorOverride:input_type -> storage.GetDoctorOverrideRequest
-	61,  // 92: storage.StorageService.AddMaterial:input_type -> storage.AddMaterialRequest
-	62,  // 93: storage.StorageService.AddService:input_type -> storage.AddServiceRequest
-	63,  // 94: storage.StorageService.UpdateMaterial:input_type -> storage.UpdateMaterialRequest
-	64,  // 95: storage.StorageService.UpdateService:input_type -> storage.UpdateServiceRequest
-	8,   // 96: storage.StorageService.GetMaterials:input_type -> storage.EmptyRequest
-	8,   // 97: storage.StorageService.GetServices:input_type -> storage.EmptyRequest
-	8,   // 98: storage.StorageService.GetServicesTypes:input_type -> storage.EmptyRequest
-	76,  // 99: storage.StorageService.GetServiceTypeById:input_type -> storage.GetServiceTypeByIdRequest
-	73,  // 100: storage.StorageService.DeleteMaterial:input_type -> storage.DeleteRequest
-	73,  // 101: storage.StorageService.DeleteService:input_type -> storage.DeleteRequest
-	47,  // 102: storage.StorageService.GetDoctorOverrides:input_type -> storage.GetByIDRequest
-	70,  // 103: storage.StorageService.GetPatientDiagnoses:input_type -> storage.GetByIdRequest
-	70,  // 104: storage.StorageService.GetPatientVisits:input_type -> storage.GetByIdRequest
-	70,  // 105: storage.StorageService.GetPatientAllergiesChronics:input_type -> storage.GetByIdRequest
-	8,   // 106: storage.StorageService.GetICDCodes:input_type -> storage.EmptyRequest
-	47,  // 107: storage.StorageService.GetPatientByID:input_type -> storage.GetByIDRequest
-	47,  // 108: storage.StorageService.GetAppointmentByID:input_type -> storage.GetByIDRequest
-	1,   // 109: storage.StorageService.AddUser:output_type -> storage.AddUserResponse
-	3,   // 110: storage.StorageService.AddDoctor:output_type -> storage.AddDoctorResponse
-	5,   // 111: storage.StorageService.AddAdmin:output_type -> storage.AddAdminResponse
-	7,   // 112: storage.StorageService.AddPatient:output_type -> storage.AddPatientResponse
-	24,  // 113: storage.StorageService.GetDoctors:output_type -> storage.GetDoctorsResponse
-	29,  // 114: storage.StorageService.GetAdmins:output_type -> storage.GetAdminsResponse
-	32,  // 115: storage.StorageService.GetPatients:output_type -> storage.GetPatientsResponse
-	25,  // 116: storage.StorageService.GetDoctorSpecsByDoctorId:output_type -> storage.GetDoctorSpecsByDoctorIdResponse
-	19,  // 117: storage.StorageService.UpdateDoctor:output_type -> storage.DefaultResponse
-	19,  // 118: storage.StorageService.AddDoctorSpec:output_type -> storage.DefaultResponse
-	19,  // 119: storage.StorageService.DeleteDoctorSpec:output_type -> storage.DefaultResponse
-	19,  // 120: storage.StorageService.UpdateAdmin:output_type -> storage.DefaultResponse
-	19,  // 121: storage.StorageService.UpdateAdminRole:output_type -> storage.DefaultResponse
-	19,  // 122: storage.StorageService.UpdatePatient:output_type -> storage.DefaultResponse
-	19,  // 123: storage.StorageService.DeleteUser:output_type -> storage.DefaultResponse
-	19,  // 124: storage.StorageService.UpdateUserLogin:output_type -> storage.DefaultResponse
-	10,  // 125: storage.StorageService.GetAllSpecs:output_type -> storage.GetAllSpecsResponse
-	12,  // 126: storage.StorageService.AddUserRole:output_type -> storage.AddUserRoleResponse
-	17,  // 127: storage.StorageService.GetUserByLogin:output_type -> storage.GetUserByLoginResponse
-	19,  // 128: storage.StorageService.UpdateUserPassword:output_type -> storage.DefaultResponse
-	34,  // 129: storage.StorageService.GetClinicWeeklySchedule:output_type -> storage.GetClinicWeeklyScheduleResponse
-	36,  // 130: storage.StorageService.GetUserRole:output_type -> storage.GetUserRoleResponse
-	15,  // 131: storage.StorageService.GetDoctorWeeklySchedule:output_type -> storage.GetScheduleByDoctorIdResponse
-	19,  // 132: storage.StorageService.UpdateClinicWeeklySchedule:output_type -> storage.DefaultResponse
-	19,  // 133: storage.StorageService.AddDoctorWeeklySchedule:output_type -> storage.DefaultResponse
-	19,  // 134: storage.StorageService.UpdateDoctorWeeklySchedule:output_type -> storage.DefaultResponse
-	19,  // 135: storage.StorageService.GetRolePermission:output_type -> storage.DefaultResponse
-	24,  // 136: storage.StorageService.GetDoctorsBySpecID:output_type -> storage.GetDoctorsResponse
-	46,  // 137: storage.StorageService.GetAppointmentsByDoctorID:output_type -> storage.GetAppointmentsByDoctorIDResponse
-	19,  // 138: storage.StorageService.AddAppointment:output_type -> storage.DefaultResponse
-	51,  // 139: storage.StorageService.GetAppointmentsByUserID:output_type -> storage.GetAppointmentsByUserIDResponse
-	54,  // 140: storage.StorageService.GetSpecsByDoctorID:output_type -> storage.GetSpecsByDoctorIDResponse
-	53,  // 141: storage.StorageService.GetDoctorByID:output_type -> storage.GetDoctorByIDResponse
-	19,  // 142: storage.StorageService.UpdateAppointment:output_type -> storage.DefaultResponse
-	19,  // 143: storage.StorageService.AddClinicDailyOverride:output_type -> storage.DefaultResponse
-	19,  // 144: storage.StorageService.AddDoctorDailyOverride:output_type -> storage.DefaultResponse
-	56,  // 145: storage.StorageService.GetClinicOverride:output_type -> storage.GetClinicOverrideResponse
-	58,  // 146: storage.StorageService.GetDoctorOverride:output_type -> storage.GetDoctorOverrideResponse
-	19,  // 147: storage.StorageService.AddMaterial:output_type -> storage.DefaultResponse
-	19,  // 148: storage.StorageService.AddService:output_type -> storage.DefaultResponse
-	19,  // 149: storage.StorageService.UpdateMaterial:output_type -> storage.DefaultResponse
-	19,  // 150: storage.StorageService.UpdateService:output_type -> storage.DefaultResponse
-	67,  // 151: storage.StorageService.GetMaterials:output_type -> storage.GetMaterialsResponse
-	68,  // 152: storage.StorageService.GetServices:output_type -> storage.GetServicesResponse
-	75,  // 153: storage.StorageService.GetServicesTypes:output_type -> storage.GetServicesTypesResponse
-	77,  // 154: storage.StorageService.GetServiceTypeById:output_type -> storage.GetServiceTypeByIdResponse
-	19,  // 155: storage.StorageService.DeleteMaterial:output_type -> storage.DefaultResponse
-	19,  // 156: storage.StorageService.DeleteService:output_type -> storage.DefaultResponse
-	60,  // 157: storage.StorageService.GetDoctorOverrides:output_type -> storage.GetDoctorOverridesResponse
-	83,  // 158: storage.StorageService.GetPatientDiagnoses:output_type -> storage.GetPatientDiagnosesResponse
-	84,  // 159: storage.StorageService.GetPatientVisits:output_type -> storage.GetPatientVisitsResponse
-	85,  // 160: storage.StorageService.GetPatientAllergiesChronics:output_type -> storage.GetPatientAllergiesChronicsResponse
-	86,  // 161: storage.StorageService.GetICDCodes:output_type -> storage.GetICDCodesResponse
-	48,  // 162: storage.StorageService.GetPatientByID:output_type -> storage.GetPatientByIDResponse
-	52,  // 163: storage.StorageService.GetAppointmentByID:output_type -> storage.GetAppointmentByIDResponse
-	109, // [109:164] is the sub-list for method output_type
-	54,  // [54:109] is the sub-list for method input_type
-	54,  // [54:54] is the sub-list for extension type_name
-	54,  // [54:54] is the sub-list for extension extendee
-	0,   // [0:54] is the sub-list for field type_name
+	92,  // 0: storage.AddPatientRequest.birth_date:type_name -> google.protobuf.Timestamp
+	10,  // 1: storage.GetAllSpecsResponse.specs:type_name -> storage.Specialization
+	92,  // 2: storage.WeeklyDoctorSchedule.start_time:type_name -> google.protobuf.Timestamp
+	92,  // 3: storage.WeeklyDoctorSchedule.end_time:type_name -> google.protobuf.Timestamp
+	14,  // 4: storage.GetScheduleByDoctorIdResponse.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
+	9,   // 5: storage.GetDoctorsRequest.page:type_name -> storage.ListPage
+	21,  // 6: storage.GetDoctorsResponse.doctors:type_name -> storage.Doctor
+	9,   // 7: storage.GetAdminsRequest.page:type_name -> storage.ListPage
+	28,  // 8: storage.GetAdminsResponse.admins:type_name -> storage.Admin
+	92,  // 9: storage.Patient.birth_date:type_name -> google.protobuf.Timestamp
+	92,  // 10: storage.UpdatePatientRequest.birth_date:type_name -> google.protobuf.Timestamp
+	9,   // 11: storage.GetPatientsRequest.page:type_name -> storage.ListPage
+	33,  // 12: storage.GetPatientsResponse.patients:type_name -> storage.Patient
+	92,  // 13: storage.WeeklyClinicSchedule.start_time:type_name -> google.protobuf.Timestamp
+	92,  // 14: storage.WeeklyClinicSchedule.end_time:type_name -> google.protobuf.Timestamp
+	37,  // 15: storage.GetClinicWeeklyScheduleResponse.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
+	37,  // 16: storage.UpdateClinicWeeklyScheduleRequest.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
+	14,  // 17: storage.AddDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
+	14,  // 18: storage.UpdateDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
+	92,  // 19: storage.AddClinicDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	92,  // 20: storage.AddClinicDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
+	92,  // 21: storage.AddClinicDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
+	92,  // 22: storage.AddDoctorDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	92,  // 23: storage.AddDoctorDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
+	92,  // 24: storage.AddDoctorDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
+	92,  // 25: storage.Appointment.date:type_name -> google.protobuf.Timestamp
+	92,  // 26: storage.Appointment.time:type_name -> google.protobuf.Timestamp
+	92,  // 27: storage.Appointment.birth_date:type_name -> google.protobuf.Timestamp
+	92,  // 28: storage.Appointment.created_at:type_name -> google.protobuf.Timestamp
+	92,  // 29: storage.Appointment.updated_at:type_name -> google.protobuf.Timestamp
+	49,  // 30: storage.GetAppointmentsByDoctorIDResponse.appointments:type_name -> storage.Appointment
+	33,  // 31: storage.GetPatientByIDResponse.patient:type_name -> storage.Patient
+	49,  // 32: storage.AddAppointmentRequest.appointment:type_name -> storage.Appointment
+	49,  // 33: storage.UpdateAppointmentRequest.appointment:type_name -> storage.Appointment
+	49,  // 34: storage.GetAppointmentsByUserIDResponse.appointment:type_name -> storage.Appointment
+	49,  // 35: storage.GetAppointmentByIDResponse.appointment:type_name -> storage.Appointment
+	21,  // 36: storage.GetDoctorByIDResponse.doctor:type_name -> storage.Doctor
+	92,  // 37: storage.GetClinicOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	92,  // 38: storage.GetClinicOverrideResponse.date:type_name -> google.protobuf.Timestamp
+	92,  // 39: storage.GetClinicOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
+	92,  // 40: storage.GetClinicOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
+	92,  // 41: storage.GetDoctorOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	92,  // 42: storage.GetDoctorOverrideResponse.date:type_name -> google.protobuf.Timestamp
+	92,  // 43: storage.GetDoctorOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
+	92,  // 44: storage.GetDoctorOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
+	92,  // 45: storage.DoctorOverride.date:type_name -> google.protobuf.Timestamp
+	92,  // 46: storage.DoctorOverride.start_time:type_name -> google.protobuf.Timestamp
+	92,  // 47: storage.DoctorOverride.end_time:type_name -> google.protobuf.Timestamp
+	63,  // 48: storage.GetDoctorOverridesResponse.override:type_name -> storage.DoctorOverride
+	69,  // 49: storage.GetMaterialsResponse.materials:type_name -> storage.Material
+	70,  // 50: storage.GetServicesResponse.services:type_name -> storage.Service
+	78,  // 51: storage.GetServicesTypesResponse.types:type_name -> storage.ServiceType
+	92,  // 52: storage.Visit.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 53: storage.GetPatientDiagnosesResponse.diagnoses:type_name -> storage.Diagnose
+	85,  // 54: storage.GetPatientVisitsResponse.visits:type_name -> storage.Visit
+	86,  // 55: storage.GetPatientAllergiesChronicsResponse.patient_allergies_chronics:type_name -> storage.PatientAllergiesChronics
+	9,   // 56: storage.GetICDCodesRequest.page:type_name -> storage.ListPage
+	83,  // 57: storage.GetICDCodesResponse.icd_code:type_name -> storage.ICDCode
+	0,   // 58: storage.StorageService.AddUser:input_type -> storage.AddUserRequest
+	2,   // 59: storage.StorageService.AddDoctor:input_type -> storage.AddDoctorRequest
+	4,   // 60: storage.StorageService.AddAdmin:input_type -> storage.AddAdminRequest
+	6,   // 61: storage.StorageService.AddPatient:input_type -> storage.AddPatientRequest
+	25,  // 62: storage.StorageService.GetDoctors:input_type -> storage.GetDoctorsRequest
+	31,  // 63: storage.StorageService.GetAdmins:input_type -> storage.GetAdminsRequest
+	35,  // 64: storage.StorageService.GetPatients:input_type -> storage.GetPatientsRequest
+	74,  // 65: storage.StorageService.GetDoctorSpecsByDoctorId:input_type -> storage.GetByIdRequest
+	22,  // 66: storage.StorageService.UpdateDoctor:input_type -> storage.UpdateDoctorRequest
+	23,  // 67: storage.StorageService.AddDoctorSpec:input_type -> storage.AddDoctorSpecRequest
+	24,  // 68: storage.StorageService.DeleteDoctorSpec:input_type -> storage.DeleteDoctorSpecRequest
+	29,  // 69: storage.StorageService.UpdateAdmin:input_type -> storage.UpdateAdminRequest
+	30,  // 70: storage.StorageService.UpdateAdminRole:input_type -> storage.UpdateAdminRoleRequest
+	34,  // 71: storage.StorageService.UpdatePatient:input_type -> storage.UpdatePatientRequest
+	77,  // 72: storage.StorageService.DeleteUser:input_type -> storage.DeleteRequest
+	82,  // 73: storage.StorageService.UpdateUserLogin:input_type -> storage.UpdateUserLoginRequest
+	8,   // 74: storage.StorageService.GetAllSpecs:input_type -> storage.EmptyRequest
+	12,  // 75: storage.StorageService.AddUserRole:input_type -> storage.AddUserRoleRequest
+	17,  // 76: storage.StorageService.GetUserByLogin:input_type -> storage.GetUserByLoginRequest
+	19,  // 77: storage.StorageService.UpdateUserPassword:input_type -> storage.UpdateUserPasswordRequest
+	8,   // 78: storage.StorageService.GetClinicWeeklySchedule:input_type -> storage.EmptyRequest
+	39,  // 79: storage.StorageService.GetUserRole:input_type -> storage.GetUserRoleRequest
+	15,  // 80: storage.StorageService.GetDoctorWeeklySchedule:input_type -> storage.GetScheduleByDoctorIdRequest
+	41,  // 81: storage.StorageService.UpdateClinicWeeklySchedule:input_type -> storage.UpdateClinicWeeklyScheduleRequest
+	42,  // 82: storage.StorageService.AddDoctorWeeklySchedule:input_type -> storage.AddDoctorWeeklyScheduleRequest
+	43,  // 83: storage.StorageService.UpdateDoctorWeeklySchedule:input_type -> storage.UpdateDoctorWeeklyScheduleRequest
+	44,  // 84: storage.StorageService.GetRolePermission:input_type -> storage.GetRolePermissionRequest
+	47,  // 85: storage.StorageService.GetDoctorsBySpecID:input_type -> storage.GetDoctorBySpecIDRequest
+	48,  // 86: storage.StorageService.GetAppointmentsByDoctorID:input_type -> storage.GetAppointmentsByDoctorIDRequest
+	53,  // 87: storage.StorageService.AddAppointment:input_type -> storage.AddAppointmentRequest
+	51,  // 88: storage.StorageService.GetAppointmentsByUserID:input_type -> storage.GetByIDRequest
+	51,  // 89: storage.StorageService.GetSpecsByDoctorID:input_type -> storage.GetByIDRequest
+	51,  // 90: storage.StorageService.GetDoctorByID:input_type -> storage.GetByIDRequest
+	54,  // 91: storage.StorageService.UpdateAppointment:input_type -> storage.UpdateAppointmentRequest
+	45,  // 92: storage.StorageService.AddClinicDailyOverride:input_type -> storage.AddClinicDailyOverrideRequest
+	46,  // 93: storage.StorageService.AddDoctorDailyOverride:input_type -> storage.AddDoctorDailyOverrideRequest
+	59,  // 94: storage.StorageService.GetClinicOverride:input_type -> storage.GetClinicOverrideRequest
+	61,  // 95: storage.StorageService.GetDoctorOverride:input_type -> storage.GetDoctorOverrideRequest
+	65,  // 96: storage.StorageService.AddMaterial:input_type -> storage.AddMaterialRequest
+	66,  // 97: storage.StorageService.AddService:input_type -> storage.AddServiceRequest
+	67,  // 98: storage.StorageService.UpdateMaterial:input_type -> storage.UpdateMaterialRequest
+	68,  // 99: storage.StorageService.UpdateService:input_type -> storage.UpdateServiceRequest
+	8,   // 100: storage.StorageService.GetMaterials:input_type -> storage.EmptyRequest
+	8,   // 101: storage.StorageService.GetServices:input_type -> storage.EmptyRequest
+	8,   // 102: storage.StorageService.GetServicesTypes:input_type -> storage.EmptyRequest
+	80,  // 103: storage.StorageService.GetServiceTypeById:input_type -> storage.GetServiceTypeByIdRequest
+	77,  // 104: storage.StorageService.DeleteMaterial:input_type -> storage.DeleteRequest
+	77,  // 105: storage.StorageService.DeleteService:input_type -> storage.DeleteRequest
+	51,  // 106: storage.StorageService.GetDoctorOverrides:input_type -> storage.GetByIDRequest
+	74,  // 107: storage.StorageService.GetPatientDiagnoses:input_type -> storage.GetByIdRequest
+	74,  // 108: storage.StorageService.GetPatientVisits:input_type -> storage.GetByIdRequest
+	74,  // 109: storage.StorageService.GetPatientAllergiesChronics:input_type -> storage.GetByIdRequest
+	90,  // 110: storage.StorageService.GetICDCodes:input_type -> storage.GetICDCodesRequest
+	51,  // 111: storage.StorageService.GetPatientByID:input_type -> storage.GetByIDRequest
+	51,  // 112: storage.StorageService.GetAppointmentByID:input_type -> storage.GetByIDRequest
+	1,   // 113: storage.StorageService.AddUser:output_type -> storage.AddUserResponse
+	3,   // 114: storage.StorageService.AddDoctor:output_type -> storage.AddDoctorResponse
+	5,   // 115: storage.StorageService.AddAdmin:output_type -> storage.AddAdminResponse
+	7,   // 116: storage.StorageService.AddPatient:output_type -> storage.AddPatientResponse
+	26,  // 117: storage.StorageService.GetDoctors:output_type -> storage.GetDoctorsResponse
+	32,  // 118: storage.StorageService.GetAdmins:output_type -> storage.GetAdminsResponse
+	36,  // 119: storage.StorageService.GetPatients:output_type -> storage.GetPatientsResponse
+	27,  // 120: storage.StorageService.GetDoctorSpecsByDoctorId:output_type -> storage.GetDoctorSpecsByDoctorIdResponse
+	20,  // 121: storage.StorageService.UpdateDoctor:output_type -> storage.DefaultResponse
+	20,  // 122: storage.StorageService.AddDoctorSpec:output_type -> storage.DefaultResponse
+	20,  // 123: storage.StorageService.DeleteDoctorSpec:output_type -> storage.DefaultResponse
+	20,  // 124: storage.StorageService.UpdateAdmin:output_type -> storage.DefaultResponse
+	20,  // 125: storage.StorageService.UpdateAdminRole:output_type -> storage.DefaultResponse
+	20,  // 126: storage.StorageService.UpdatePatient:output_type -> storage.DefaultResponse
+	20,  // 127: storage.StorageService.DeleteUser:output_type -> storage.DefaultResponse
+	20,  // 128: storage.StorageService.UpdateUserLogin:output_type -> storage.DefaultResponse
+	11,  // 129: storage.StorageService.GetAllSpecs:output_type -> storage.GetAllSpecsResponse
+	13,  // 130: storage.StorageService.AddUserRole:output_type -> storage.AddUserRoleResponse
+	18,  // 131: storage.StorageService.GetUserByLogin:output_type -> storage.GetUserByLoginResponse
+	20,  // 132: storage.StorageService.UpdateUserPassword:output_type -> storage.DefaultResponse
+	38,  // 133: storage.StorageService.GetClinicWeeklySchedule:output_type -> storage.GetClinicWeeklyScheduleResponse
+	40,  // 134: storage.StorageService.GetUserRole:output_type -> storage.GetUserRoleResponse
+	16,  // 135: storage.StorageService.GetDoctorWeeklySchedule:output_type -> storage.GetScheduleByDoctorIdResponse
+	20,  // 136: storage.StorageService.UpdateClinicWeeklySchedule:output_type -> storage.DefaultResponse
+	20,  // 137: storage.StorageService.AddDoctorWeeklySchedule:output_type -> storage.DefaultResponse
+	20,  // 138: storage.StorageService.UpdateDoctorWeeklySchedule:output_type -> storage.DefaultResponse
+	20,  // 139: storage.StorageService.GetRolePermission:output_type -> storage.DefaultResponse
+	26,  // 140: storage.StorageService.GetDoctorsBySpecID:output_type -> storage.GetDoctorsResponse
+	50,  // 141: storage.StorageService.GetAppointmentsByDoctorID:output_type -> storage.GetAppointmentsByDoctorIDResponse
+	20,  // 142: storage.StorageService.AddAppointment:output_type -> storage.DefaultResponse
+	55,  // 143: storage.StorageService.GetAppointmentsByUserID:output_type -> storage.GetAppointmentsByUserIDResponse
+	58,  // 144: storage.StorageService.GetSpecsByDoctorID:output_type -> storage.GetSpecsByDoctorIDResponse
+	57,  // 145: storage.StorageService.GetDoctorByID:output_type -> storage.GetDoctorByIDResponse
+	20,  // 146: storage.StorageService.UpdateAppointment:output_type -> storage.DefaultResponse
+	20,  // 147: storage.StorageService.AddClinicDailyOverride:output_type -> storage.DefaultResponse
+	20,  // 148: storage.StorageService.AddDoctorDailyOverride:output_type -> storage.DefaultResponse
+	60,  // 149: storage.StorageService.GetClinicOverride:output_type -> storage.GetClinicOverrideResponse
+	62,  // 150: storage.StorageService.GetDoctorOverride:output_type -> storage.GetDoctorOverrideResponse
+	20,  // 151: storage.StorageService.AddMaterial:output_type -> storage.DefaultResponse
+	20,  // 152: storage.StorageService.AddService:output_type -> storage.DefaultResponse
+	20,  // 153: storage.StorageService.UpdateMaterial:output_type -> storage.DefaultResponse
+	20,  // 154: storage.StorageService.UpdateService:output_type -> storage.DefaultResponse
+	71,  // 155: storage.StorageService.GetMaterials:output_type -> storage.GetMaterialsResponse
+	72,  // 156: storage.StorageService.GetServices:output_type -> storage.GetServicesResponse
+	79,  // 157: storage.StorageService.GetServicesTypes:output_type -> storage.GetServicesTypesResponse
+	81,  // 158: storage.StorageService.GetServiceTypeById:output_type -> storage.GetServiceTypeByIdResponse
+	20,  // 159: storage.StorageService.DeleteMaterial:output_type -> storage.DefaultResponse
+	20,  // 160: storage.StorageService.DeleteService:output_type -> storage.DefaultResponse
+	64,  // 161: storage.StorageService.GetDoctorOverrides:output_type -> storage.GetDoctorOverridesResponse
+	87,  // 162: storage.StorageService.GetPatientDiagnoses:output_type -> storage.GetPatientDiagnosesResponse
+	88,  // 163: storage.StorageService.GetPatientVisits:output_type -> storage.GetPatientVisitsResponse
+	89,  // 164: storage.StorageService.GetPatientAllergiesChronics:output_type -> storage.GetPatientAllergiesChronicsResponse
+	91,  // 165: storage.StorageService.GetICDCodes:output_type -> storage.GetICDCodesResponse
+	52,  // 166: storage.StorageService.GetPatientByID:output_type -> storage.GetPatientByIDResponse
+	56,  // 167: storage.StorageService.GetAppointmentByID:output_type -> storage.GetAppointmentByIDResponse
+	113, // [113:168] is the sub-list for method output_type
+	58,  // [58:113] is the sub-list for method input_type
+	58,  // [58:58] is the sub-list for extension type_name
+	58,  // [58:58] is the sub-list for extension extendee
+	0,   // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_proto_storage_storage_proto_init() }
@@ -5796,7 +6194,7 @@ func file_proto_storage_storage_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_storage_storage_proto_rawDesc), len(file_proto_storage_storage_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   87,
+			NumMessages:   92,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
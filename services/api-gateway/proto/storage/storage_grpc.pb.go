@@ -85,9 +85,9 @@ type StorageServiceClient interface {
 	AddDoctor(ctx context.Context, in *AddDoctorRequest, opts ...grpc.CallOption) (*AddDoctorResponse, error)
 	AddAdmin(ctx context.Context, in *AddAdminRequest, opts ...grpc.CallOption) (*AddAdminResponse, error)
 	AddPatient(ctx context.Context, in *AddPatientRequest, opts ...grpc.CallOption) (*AddPatientResponse, error)
-	GetDoctors(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error)
-	GetAdmins(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error)
-	GetPatients(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error)
+	GetDoctors(ctx context.Context, in *GetDoctorsRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error)
+	GetAdmins(ctx context.Context, in *GetAdminsRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error)
+	GetPatients(ctx context.Context, in *GetPatientsRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error)
 	GetDoctorSpecsByDoctorId(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetDoctorSpecsByDoctorIdResponse, error)
 	UpdateDoctor(ctx context.Context, in *UpdateDoctorRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	AddDoctorSpec(ctx context.Context, in *AddDoctorSpecRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
@@ -135,7 +135,7 @@ type StorageServiceClient interface {
 	GetPatientDiagnoses(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientDiagnosesResponse, error)
 	GetPatientVisits(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientVisitsResponse, error)
 	GetPatientAllergiesChronics(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientAllergiesChronicsResponse, error)
-	GetICDCodes(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error)
+	GetICDCodes(ctx context.Context, in *GetICDCodesRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error)
 	GetPatientByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetPatientByIDResponse, error)
 	GetAppointmentByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetAppointmentByIDResponse, error)
 }
@@ -188,7 +188,7 @@ func (c *storageServiceClient) AddPatient(ctx context.Context, in *AddPatientReq
 	return out, nil
 }
 
-func (c *storageServiceClient) GetDoctors(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error) {
+func (c *storageServiceClient) GetDoctors(ctx context.Context, in *GetDoctorsRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDoctorsResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetDoctors_FullMethodName, in, out, cOpts...)
@@ -198,7 +198,7 @@ func (c *storageServiceClient) GetDoctors(ctx context.Context, in *EmptyRequest,
 	return out, nil
 }
 
-func (c *storageServiceClient) GetAdmins(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error) {
+func (c *storageServiceClient) GetAdmins(ctx context.Context, in *GetAdminsRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAdminsResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetAdmins_FullMethodName, in, out, cOpts...)
@@ -208,7 +208,7 @@ func (c *storageServiceClient) GetAdmins(ctx context.Context, in *EmptyRequest,
 	return out, nil
 }
 
-func (c *storageServiceClient) GetPatients(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error) {
+func (c *storageServiceClient) GetPatients(ctx context.Context, in *GetPatientsRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPatientsResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetPatients_FullMethodName, in, out, cOpts...)
@@ -668,7 +668,7 @@ func (c *storageServiceClient) GetPatientAllergiesChronics(ctx context.Context,
 	return out, nil
 }
 
-func (c *storageServiceClient) GetICDCodes(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error) {
+func (c *storageServiceClient) GetICDCodes(ctx context.Context, in *GetICDCodesRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetICDCodesResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetICDCodes_FullMethodName, in, out, cOpts...)
@@ -707,9 +707,9 @@ type StorageServiceServer interface {
 	AddDoctor(context.Context, *AddDoctorRequest) (*AddDoctorResponse, error)
 	AddAdmin(context.Context, *AddAdminRequest) (*AddAdminResponse, error)
 	AddPatient(context.Context, *AddPatientRequest) (*AddPatientResponse, error)
-	GetDoctors(context.Context, *EmptyRequest) (*GetDoctorsResponse, error)
-	GetAdmins(context.Context, *EmptyRequest) (*GetAdminsResponse, error)
-	GetPatients(context.Context, *EmptyRequest) (*GetPatientsResponse, error)
+	GetDoctors(context.Context, *GetDoctorsRequest) (*GetDoctorsResponse, error)
+	GetAdmins(context.Context, *GetAdminsRequest) (*GetAdminsResponse, error)
+	GetPatients(context.Context, *GetPatientsRequest) (*GetPatientsResponse, error)
 	GetDoctorSpecsByDoctorId(context.Context, *GetByIdRequest) (*GetDoctorSpecsByDoctorIdResponse, error)
 	UpdateDoctor(context.Context, *UpdateDoctorRequest) (*DefaultResponse, error)
 	AddDoctorSpec(context.Context, *AddDoctorSpecRequest) (*DefaultResponse, error)
@@ -757,7 +757,7 @@ type StorageServiceServer interface {
 	GetPatientDiagnoses(context.Context, *GetByIdRequest) (*GetPatientDiagnosesResponse, error)
 	GetPatientVisits(context.Context, *GetByIdRequest) (*GetPatientVisitsResponse, error)
 	GetPatientAllergiesChronics(context.Context, *GetByIdRequest) (*GetPatientAllergiesChronicsResponse, error)
-	GetICDCodes(context.Context, *EmptyRequest) (*GetICDCodesResponse, error)
+	GetICDCodes(context.Context, *GetICDCodesRequest) (*GetICDCodesResponse, error)
 	GetPatientByID(context.Context, *GetByIDRequest) (*GetPatientByIDResponse, error)
 	GetAppointmentByID(context.Context, *GetByIDRequest) (*GetAppointmentByIDResponse, error)
 	mustEmbedUnimplementedStorageServiceServer()
@@ -782,13 +782,13 @@ func (UnimplementedStorageServiceServer) AddAdmin(context.Context, *AddAdminRequ
 func (UnimplementedStorageServiceServer) AddPatient(context.Context, *AddPatientRequest) (*AddPatientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPatient not implemented")
 }
-func (UnimplementedStorageServiceServer) GetDoctors(context.Context, *EmptyRequest) (*GetDoctorsResponse, error) {
+func (UnimplementedStorageServiceServer) GetDoctors(context.Context, *GetDoctorsRequest) (*GetDoctorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDoctors not implemented")
 }
-func (UnimplementedStorageServiceServer) GetAdmins(context.Context, *EmptyRequest) (*GetAdminsResponse, error) {
+func (UnimplementedStorageServiceServer) GetAdmins(context.Context, *GetAdminsRequest) (*GetAdminsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAdmins not implemented")
 }
-func (UnimplementedStorageServiceServer) GetPatients(context.Context, *EmptyRequest) (*GetPatientsResponse, error) {
+func (UnimplementedStorageServiceServer) GetPatients(context.Context, *GetPatientsRequest) (*GetPatientsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPatients not implemented")
 }
 func (UnimplementedStorageServiceServer) GetDoctorSpecsByDoctorId(context.Context, *GetByIdRequest) (*GetDoctorSpecsByDoctorIdResponse, error) {
@@ -926,7 +926,7 @@ func (UnimplementedStorageServiceServer) GetPatientVisits(context.Context, *GetB
 func (UnimplementedStorageServiceServer) GetPatientAllergiesChronics(context.Context, *GetByIdRequest) (*GetPatientAllergiesChronicsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPatientAllergiesChronics not implemented")
 }
-func (UnimplementedStorageServiceServer) GetICDCodes(context.Context, *EmptyRequest) (*GetICDCodesResponse, error) {
+func (UnimplementedStorageServiceServer) GetICDCodes(context.Context, *GetICDCodesRequest) (*GetICDCodesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetICDCodes not implemented")
 }
 func (UnimplementedStorageServiceServer) GetPatientByID(context.Context, *GetByIDRequest) (*GetPatientByIDResponse, error) {
@@ -1029,7 +1029,7 @@ func _StorageService_AddPatient_Handler(srv interface{}, ctx context.Context, de
 }
 
 func _StorageService_GetDoctors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetDoctorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1041,13 +1041,13 @@ func _StorageService_GetDoctors_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: StorageService_GetDoctors_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetDoctors(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetDoctors(ctx, req.(*GetDoctorsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _StorageService_GetAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetAdminsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1059,13 +1059,13 @@ func _StorageService_GetAdmins_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: StorageService_GetAdmins_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetAdmins(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetAdmins(ctx, req.(*GetAdminsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _StorageService_GetPatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetPatientsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1077,7 +1077,7 @@ func _StorageService_GetPatients_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: StorageService_GetPatients_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetPatients(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetPatients(ctx, req.(*GetPatientsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1893,7 +1893,7 @@ func _StorageService_GetPatientAllergiesChronics_Handler(srv interface{}, ctx co
 }
 
 func _StorageService_GetICDCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetICDCodesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1905,7 +1905,7 @@ func _StorageService_GetICDCodes_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: StorageService_GetICDCodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetICDCodes(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetICDCodes(ctx, req.(*GetICDCodesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
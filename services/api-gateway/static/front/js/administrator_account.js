@@ -120,9 +120,7 @@ createApp({
 
         async fetchCompletedVisits() {
             try {
-                const res = await fetch('/api/completed-visits');
-                const data = await res.json();
-                this.completed = data || [];
+                this.completed = await fetchAllPages('/api/completed-visits');
             } catch (err) {
                 console.error('Ошибка при загрузке завершённых приёмов:', err);
             }
@@ -270,24 +268,24 @@ createApp({
 
         async confirmVisit(entry) {
             try {
-                const res = await fetch(`/api/completed-visits/${entry.visit_id}`, {
-                    method: 'PUT',
+                const res = await fetch(`/api/invoices/${entry.invoice_id}/payments`, {
+                    method: 'POST',
                     headers: {
                         'Content-Type': 'application/json'
                     },
                     body: JSON.stringify({
-                        price: entry.price,
-                        status: 'confirmed'
+                        amount: entry.amount || entry.balance,
+                        method: entry.method || 'cash'
                     })
                 });
 
-                if (!res.ok) throw new Error('Ошибка при подтверждении');
+                if (!res.ok) throw new Error('Ошибка при приёме оплаты');
 
-                alert('Приём подтверждён');
+                alert('Оплата принята');
                 await this.fetchCompletedVisits(); // Обновить данные
             } catch (err) {
                 console.error(err);
-                alert('Не удалось подтвердить приём');
+                alert('Не удалось принять оплату');
             }
         },
 
@@ -325,8 +323,7 @@ createApp({
 
         async fetchUnconfirmedAppointments() {
             try {
-                const res = await fetch('/api/unconfirmed-appointments');
-                const rawData = await res.json();
+                const rawData = await fetchAllPages('/api/unconfirmed-appointments');
 
                 if (!Array.isArray(rawData)) {
                     this.pending = [];
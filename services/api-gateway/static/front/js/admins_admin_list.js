@@ -67,8 +67,7 @@ createApp({
       if (filters.role) params.append('role', filters.role);
 
       // ?${params}
-      const res = await fetch(`/api/staff-admins`);
-      staff.value = await res.json();
+      staff.value = await fetchAllPages('/api/staff-admins');
     }
 
     const filteredStaff = computed(() => {
@@ -272,7 +271,7 @@ createApp({
       async function deleteAdmin() {
             if (!selectedAdmin.value) return;
 
-            const confirmed = confirm(`Вы уверены, что хотите удалить пользователя: ${selectedAdmin.value.first_name} ${selectedAdmin.value.second_name}?`);
+            const confirmed = confirm(`Отключить сотрудника: ${selectedAdmin.value.first_name} ${selectedAdmin.value.second_name}?`);
             if (!confirmed) return;
 
             try {
@@ -283,9 +282,9 @@ createApp({
                 if (res.ok) {
                 modalChoice?.hide();
                 await loadStaff();
-                alert('Пользователь удалён.');
+                alert('Сотрудник отключён.');
                 } else {
-                alert('Ошибка при удалении пользователя.');
+                alert('Ошибка при отключении сотрудника.');
                 }
             } catch (e) {
                 console.error(e);
@@ -61,8 +61,7 @@ createApp({
         }
 
         async function loadStaff() {
-            const res = await fetch('/api/staff-doctors');
-            allStaff.value = await res.json();
+            allStaff.value = await fetchAllPages('/api/staff-doctors');
             applyFilters();
         }
 
@@ -273,7 +272,7 @@ createApp({
         async function deleteDoctor() {
             if (!selectedDoctor.value) return;
 
-            const confirmed = confirm(`Вы уверены, что хотите удалить пользователя: ${selectedDoctor.value.firstName} ${selectedDoctor.value.secondName}?`);
+            const confirmed = confirm(`Отключить сотрудника: ${selectedDoctor.value.firstName} ${selectedDoctor.value.secondName}?`);
             if (!confirmed) return;
 
             try {
@@ -284,9 +283,9 @@ createApp({
                 if (res.ok) {
                     modalChoice?.hide();
                     await loadStaff();
-                    alert('Пользователь удалён.');
+                    alert('Сотрудник отключён.');
                 } else {
-                    alert('Ошибка при удалении пользователя.');
+                    alert('Ошибка при отключении сотрудника.');
                 }
             } catch (e) {
                 console.error(e);
@@ -14,7 +14,8 @@ createApp({
       phone: '',
       email: ''
     });
-      const allPatients = ref([]);
+      const totalPatients = ref(0);
+      const pageSize = 50;
       const admin = ref({
     secondName: '',
     firstName: '',
@@ -86,29 +87,20 @@ createApp({
 
 
 
-    async function loadPatients() {
-            const res = await fetch(`/api/patients`);
-            allPatients.value = await res.json();
-            applyFilters();
+    // поиск и постраничная загрузка идут на сервере; more — дописать следующую страницу к уже показанным
+    async function loadPatients(more) {
+            const append = more === true;
+            const params = new URLSearchParams({
+                q: search.value.trim(),
+                limit: pageSize,
+                offset: append ? patients.value.length : 0
+            });
+            const res = await fetch(`/api/patients?${params}`);
+            const page = (await res.json()) || [];
+            totalPatients.value = Number(res.headers.get('X-Total-Count')) || page.length;
+            patients.value = append ? patients.value.concat(page) : page;
     }
 
-      function applyFilters() {
-          const searchTerm = search.value.trim().toLowerCase();
-
-          patients.value = allPatients.value.filter(s => {
-              const fullName = `${s.secondName} ${s.firstName} ${s.surname}`.toLowerCase();
-              const phone = (s.phone || '').trim().replace(/\\D/g, '');
-
-              const matchesSearch =
-                  !searchTerm ||
-                  fullName.includes(searchTerm) ||
-                  phone.includes(searchTerm);
-
-
-              return matchesSearch;
-          });
-      }
-
 
       function onRowClick(p) {
         selectedPatient.value = p;
@@ -370,7 +362,7 @@ createApp({
     async function deletePatient() {
   if (!selectedPatient.value) return;
 
-  const confirmed = confirm(`Вы уверены, что хотите удалить пользователя: ${selectedPatient.value.firstName} ${selectedPatient.value.secondName}?`);
+  const confirmed = confirm(`Перевести пациента в архив: ${selectedPatient.value.firstName} ${selectedPatient.value.secondName}? Медицинская карта сохранится.`);
   if (!confirmed) return;
 
   try {
@@ -383,9 +375,9 @@ createApp({
       selectedPatient.value = null;
       selectedPatientId.value = null;
       await loadPatients();
-      alert('Пользователь удалён.');
+      alert('Пациент переведён в архив.');
     } else {
-      alert('Ошибка при удалении пользователя.');
+      alert('Ошибка при переводе пациента в архив.');
     }
   } catch (e) {
     console.error(e);
@@ -431,6 +423,7 @@ createApp({
 
     return {
       patients,
+      totalPatients,
       search,
       form,
       phoneError,
@@ -280,8 +280,7 @@ createApp({
     },
     async loadDoctors() {
       try {
-        const res = await fetch('/api/doctors');
-        this.doctors = await res.json();
+        this.doctors = await fetchAllPages('/api/doctors');
       } catch (err) {
         console.error('Ошибка при загрузке списка врачей', err);
       }
@@ -1,6 +1,5 @@
 document.addEventListener("DOMContentLoaded", () => {
-  fetch("/api/doctors")
-    .then(res => res.json())
+  fetchAllPages("/api/doctors")
     .then(doctors => {
       const container = document.getElementById("doctorsContainer");
       const template = document.getElementById("doctorTemplate");
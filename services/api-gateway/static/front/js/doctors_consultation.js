@@ -10,6 +10,8 @@ createApp({
                 { id: 'materials', label: 'Материалы' }
             ],
             activeTab: 'patient',
+            // один ключ на приём: повторная отправка формы после сбоя не создаст второй визит
+            idempotencyKey: crypto.randomUUID(),
             patient: {
                 id: null,
                 first_name: '',
@@ -296,10 +298,12 @@ createApp({
             this.selectedICD = this.selectedICD.filter(item => item.code !== code);
         },
 
+        // справочник МКБ большой: поиск идёт на сервере, показывается первая страница найденного
         async fetchICDCodes() {
             try {
-                const res = await fetch(`/api/icd-codes`);
-                this.icdCodes = await res.json();
+                const params = new URLSearchParams({ q: this.icdSearch.trim(), limit: 50 });
+                const res = await fetch(`/api/icd-codes?${params}`);
+                this.icdCodes = (await res.json()) || [];
             } catch (err) {
                 console.error('Ошибка загрузки МКБ кодов:', err);
             }
@@ -424,7 +428,10 @@ createApp({
 
                 const res = await fetch(`/api/visits`, {
                     method: 'POST',
-                    headers: { 'Content-Type': 'application/json' },
+                    headers: {
+                        'Content-Type': 'application/json',
+                        'Idempotency-Key': this.idempotencyKey
+                    },
                     body: JSON.stringify(payload)
                 });
 
@@ -452,6 +459,13 @@ createApp({
         }
     },
 
+    watch: {
+        icdSearch() {
+            clearTimeout(this.icdSearchTimer);
+            this.icdSearchTimer = setTimeout(() => this.fetchICDCodes(), 300);
+        },
+    },
+
     async mounted() {
         await this.loadData();
         this.fetchDoctorData();
@@ -0,0 +1,20 @@
+// Списки API отдаются постранично: без limit — первая страница, число всех записей — в заголовке X-Total-Count.
+// fetchAllPages загружает список целиком страницами наибольшего размера
+const LIST_PAGE_LIMIT = 200;
+
+async function fetchAllPages(url) {
+    const sep = url.includes('?') ? '&' : '?';
+    let items = [];
+    for (;;) {
+        const res = await fetch(`${url}${sep}limit=${LIST_PAGE_LIMIT}&offset=${items.length}`);
+        if (!res.ok) {
+            throw new Error(`${url}: ${res.status}`);
+        }
+        const page = (await res.json()) || [];
+        items = items.concat(page);
+        const total = Number(res.headers.get('X-Total-Count'));
+        if (page.length < LIST_PAGE_LIMIT || (total && items.length >= total)) {
+            return items;
+        }
+    }
+}
@@ -0,0 +1,173 @@
+// Package apperr доменные ошибки сервисов: «не найдено», «конфликт», «некорректные данные» с указанием полей,
+// «нет доступа». Ошибка сама превращается в gRPC-статус с деталями ErrorInfo (машиночитаемая причина)
+// и BadRequest (поля), поэтому её можно возвращать из хранилища и сервисов как обычную ошибку, в том числе
+// обёрнутую через %w. Перехватчики сервера отдают клиенту статус исходной доменной ошибки без обёрток,
+// а всё остальное — как внутреннюю ошибку без подробностей. Шлюз разбирает статус обратно через FromError
+package apperr
+
+import (
+	"errors"
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain домен причин ошибок в ErrorInfo
+const Domain = "diplom"
+
+// Field нарушение в конкретном поле запроса
+type Field struct {
+	Name    string
+	Message string
+}
+
+// Error доменная ошибка: gRPC-код, машиночитаемая причина вида snake_case, сообщение для пользователя и поля
+type Error struct {
+	Code    codes.Code
+	Reason  string
+	Message string
+	Fields  []Field
+}
+
+// New доменная ошибка с произвольным кодом
+func New(code codes.Code, reason, message string) *Error {
+	return &Error{Code: code, Reason: reason, Message: message}
+}
+
+// NotFound запись не найдена или принадлежит другому пользователю
+func NotFound(reason, message string) *Error {
+	return New(codes.NotFound, reason, message)
+}
+
+// Invalid некорректные данные запроса
+func Invalid(reason, message string, fields ...Field) *Error {
+	return &Error{Code: codes.InvalidArgument, Reason: reason, Message: message, Fields: fields}
+}
+
+// Conflict операция невозможна в текущем состоянии записи
+func Conflict(reason, message string) *Error {
+	return New(codes.FailedPrecondition, reason, message)
+}
+
+// AlreadyExists запись уже существует или ресурс уже занят
+func AlreadyExists(reason, message string) *Error {
+	return New(codes.AlreadyExists, reason, message)
+}
+
+// Forbidden у пользователя нет доступа к записи
+func Forbidden(reason, message string) *Error {
+	return New(codes.PermissionDenied, reason, message)
+}
+
+// Unauthenticated пользователь не определён: токен невалиден, сессия завершена, пароль неверный
+func Unauthenticated(reason, message string) *Error {
+	return New(codes.Unauthenticated, reason, message)
+}
+
+// TooManyRequests превышено ограничение частоты или числа попыток
+func TooManyRequests(reason, message string) *Error {
+	return New(codes.ResourceExhausted, reason, message)
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is ошибки равны по коду и причине, поэтому копия с полями (WithFields) совпадает с исходной через errors.Is
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code && t.Reason == e.Reason
+}
+
+// WithFields копия ошибки с нарушениями в полях; исходная переменная-образец не меняется
+func (e *Error) WithFields(fields ...Field) *Error {
+	cp := *e
+	cp.Fields = append(append([]Field(nil), e.Fields...), fields...)
+	return &cp
+}
+
+// Detailf копия ошибки с уточнением после двоеточия, например «сумма оплаты больше остатка по счёту: остаток 500».
+// Уточнение уходит клиенту, в отличие от текста обёрток через %w
+func (e *Error) Detailf(format string, args ...any) *Error {
+	cp := *e
+	cp.Message = e.Message + ": " + fmt.Sprintf(format, args...)
+	return &cp
+}
+
+// GRPCStatus статус с деталями ErrorInfo и, если заданы поля, BadRequest; вызывается пакетом status
+// и для ошибок, обёрнутых через %w
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(e.Code, e.Message)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: e.Reason, Domain: Domain}}
+	if len(e.Fields) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(e.Fields))
+		for _, f := range e.Fields {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: f.Name, Description: f.Message})
+		}
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// FromError доменная ошибка из ответа gRPC-клиента. Причина и поля берутся из деталей статуса, а если
+// их нет (статус создан без apperr), причина выводится из кода. nil для nil
+func FromError(err error) *Error {
+	if err == nil {
+		return nil
+	}
+	var domain *Error
+	if errors.As(err, &domain) {
+		return domain
+	}
+	st := status.Convert(err)
+	e := &Error{Code: st.Code(), Message: st.Message()}
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if d.Domain == Domain {
+				e.Reason = d.Reason
+			}
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				e.Fields = append(e.Fields, Field{Name: v.Field, Message: v.Description})
+			}
+		}
+	}
+	if e.Reason == "" {
+		e.Reason = DefaultReason(e.Code)
+	}
+	return e
+}
+
+// DefaultReason причина для статуса без ErrorInfo
+func DefaultReason(code codes.Code) string {
+	switch code {
+	case codes.InvalidArgument:
+		return "invalid_argument"
+	case codes.OutOfRange:
+		return "out_of_range"
+	case codes.NotFound:
+		return "not_found"
+	case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+		return "conflict"
+	case codes.PermissionDenied:
+		return "forbidden"
+	case codes.Unauthenticated:
+		return "unauthenticated"
+	case codes.ResourceExhausted:
+		return "too_many_requests"
+	case codes.Canceled:
+		return "canceled"
+	case codes.DeadlineExceeded:
+		return "timeout"
+	case codes.Unavailable:
+		return "unavailable"
+	}
+	return "internal"
+}
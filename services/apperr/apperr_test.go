@@ -0,0 +1,82 @@
+package apperr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+var errSlotTaken = AlreadyExists("slot_taken", "выбранное время уже занято")
+
+func TestStatusRoundTrip(t *testing.T) {
+	src := Invalid("invalid_grant", "некорректный допуск", Field{Name: "reason", Message: "пустая причина"})
+	got := FromError(status.Convert(src).Err())
+
+	if got.Code != codes.InvalidArgument || got.Reason != "invalid_grant" || got.Message != "некорректный допуск" {
+		t.Fatalf("unexpected error %+v", got)
+	}
+	if len(got.Fields) != 1 || got.Fields[0] != (Field{Name: "reason", Message: "пустая причина"}) {
+		t.Errorf("unexpected fields %+v", got.Fields)
+	}
+}
+
+func TestFromErrorWithoutDetails(t *testing.T) {
+	got := FromError(status.Error(codes.NotFound, "пациент не найден"))
+	if got.Reason != "not_found" || got.Message != "пациент не найден" {
+		t.Errorf("unexpected error %+v", got)
+	}
+}
+
+func TestWithFieldsKeepsIdentity(t *testing.T) {
+	err := fmt.Errorf("не удалось записать: %w", errSlotTaken.WithFields(Field{Name: "start_time"}))
+	if !errors.Is(err, errSlotTaken) {
+		t.Errorf("WithFields copy does not match the sentinel")
+	}
+	if len(errSlotTaken.Fields) != 0 {
+		t.Errorf("sentinel was modified: %+v", errSlotTaken.Fields)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	notFound := NotFound("not_found", "запись не найдена")
+	noRows := func(err error) error {
+		if errors.Is(err, sql.ErrNoRows) {
+			return notFound
+		}
+		return err
+	}
+	upstream := fmt.Errorf("не удалось получить запись: %w", errSlotTaken.GRPCStatus().Err())
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		reason  string
+		message string
+	}{
+		{"уточнение доменной ошибки", fmt.Errorf("не удалось записать: %w", errSlotTaken.Detailf("врач %d", 7)),
+			codes.AlreadyExists, "slot_taken", "выбранное время уже занято: врач 7"},
+		{"доменная ошибка в обёртке", fmt.Errorf("не удалось записать: %w", errSlotTaken),
+			codes.AlreadyExists, "slot_taken", "выбранное время уже занято"},
+		{"статус вышестоящего сервиса", upstream, codes.AlreadyExists, "slot_taken", "выбранное время уже занято"},
+		{"ошибка SQL", errors.New(`pq: relation "users" does not exist`), codes.Internal, "internal", ErrInternal.Message},
+		{"неизвестный статус", status.Error(codes.Unknown, "pq: ошибка"), codes.Internal, "internal", ErrInternal.Message},
+		{"перевод sql.ErrNoRows", fmt.Errorf("не удалось получить пациента: %w", sql.ErrNoRows),
+			codes.NotFound, "not_found", "запись не найдена"},
+		{"таймаут", fmt.Errorf("запрос: %w", context.DeadlineExceeded), codes.DeadlineExceeded, "timeout", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromError(Normalize(tt.err, noRows))
+			if got.Code != tt.code || got.Reason != tt.reason {
+				t.Errorf("got %s/%s, want %s/%s", got.Code, got.Reason, tt.code, tt.reason)
+			}
+			if tt.message != "" && got.Message != tt.message {
+				t.Errorf("got message %q, want %q", got.Message, tt.message)
+			}
+		})
+	}
+}
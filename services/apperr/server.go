@@ -0,0 +1,74 @@
+package apperr
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+)
+
+// ErrInternal ответ клиенту на ошибку без доменного смысла: SQL, сеть, файловая система
+var ErrInternal = New(codes.Internal, "internal", "внутренняя ошибка сервиса")
+
+// Translator переводит ошибку, которую сервис не типизировал сам (например, sql.ErrNoRows), в доменную;
+// остальные ошибки возвращает без изменений
+type Translator func(error) error
+
+// Normalize ошибка для ответа клиенту. Доменная ошибка или gRPC-статус вышестоящего сервиса, в том числе
+// обёрнутые через %w, отдаются своим статусом без текста обёрток; отмена и таймаут запроса — статусами
+// Canceled и DeadlineExceeded; всё остальное — ErrInternal
+func Normalize(err error, translators ...Translator) error {
+	if err == nil {
+		return nil
+	}
+	for _, translate := range translators {
+		err = translate(err)
+	}
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		st := grpcErr.GRPCStatus()
+		if st.Code() != codes.Unknown {
+			return st.Err()
+		}
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "запрос отменён")
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "превышено время ожидания ответа")
+	}
+	return ErrInternal.GRPCStatus().Err()
+}
+
+// UnaryServerInterceptor перехватчик, приводящий ошибки методов к Normalize. Исходная ошибка пишется
+// в журнал, если клиенту уходит не её текст
+func UnaryServerInterceptor(translators ...Translator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, normalizeAndLog(info.FullMethod, err, translators)
+		}
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor то же для потоковых методов
+func StreamServerInterceptor(translators ...Translator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err != nil {
+			return normalizeAndLog(info.FullMethod, err, translators)
+		}
+		return nil
+	}
+}
+
+func normalizeAndLog(method string, err error, translators []Translator) error {
+	normalized := Normalize(err, translators...)
+	if status.Convert(normalized).Message() != err.Error() {
+		log.Printf("%s: %v", method, err)
+	}
+	return normalized
+}
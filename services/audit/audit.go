@@ -0,0 +1,82 @@
+// Package audit журнал аудита обращений к медицинским и персональным данным. Шлюз после проверки права
+// передаёт в метаданных вызова пользователя и его роль (WithActor); перехватчик сервиса записывает каждый
+// такой вызов: кто, какой метод, какой ресурс и чьи данные, с каким результатом и когда. Внутренние вызовы
+// сервисов друг к другу пользователя не несут и не записываются — их покрывает запись внешнего вызова.
+// Записи хранятся в storage в таблице только для добавления и связаны цепочкой хешей (Hash)
+package audit
+
+import (
+	"context"
+	"google.golang.org/grpc/metadata"
+	"strconv"
+	"time"
+)
+
+// Метаданные вызова с пользователем, от имени которого он выполняется
+const (
+	actorIDKey   = "x-actor-id"
+	actorRoleKey = "x-actor-role"
+)
+
+// Actor пользователь, от имени которого выполняется вызов; Role — идентификатор из таблицы roles
+type Actor struct {
+	UserID int
+	Role   int
+}
+
+// rolePatient роль пациента: если в запросе пациента нет, его данные — свои собственные
+const rolePatient = 4
+
+// Entry запись журнала аудита
+type Entry struct {
+	OccurredAt time.Time
+	Service    string // сервис, который выполнил вызов
+	Action     string // полное имя gRPC-метода
+	ActorID    int
+	ActorRole  int
+	Resource   string // вид ресурса: patient, visit, document, user и т.д.; пустой, если не определён
+	ResourceID string
+	PatientID  *int   // пациент, чьи данные затронуты; nil — вызов не относится к конкретному пациенту
+	Outcome    string // код gRPC-статуса ответа: OK, NotFound, PermissionDenied и т.д.
+}
+
+// WithActor контекст исходящих вызовов от имени пользователя
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return metadata.AppendToOutgoingContext(ctx,
+		actorIDKey, strconv.Itoa(actor.UserID),
+		actorRoleKey, strconv.Itoa(actor.Role),
+	)
+}
+
+// ActorFromContext пользователь входящего вызова; false, если вызов пришёл не от пользователя
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Actor{}, false
+	}
+	id, err := strconv.Atoi(first(md.Get(actorIDKey)))
+	if err != nil || id <= 0 {
+		return Actor{}, false
+	}
+	role, _ := strconv.Atoi(first(md.Get(actorRoleKey)))
+	return Actor{UserID: id, Role: role}, true
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// Recorder сохраняет записи журнала
+type Recorder interface {
+	Record(ctx context.Context, entry Entry) error
+}
+
+// RecorderFunc функция как Recorder
+type RecorderFunc func(ctx context.Context, entry Entry) error
+
+func (f RecorderFunc) Record(ctx context.Context, entry Entry) error {
+	return f(ctx, entry)
+}
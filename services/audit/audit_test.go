@@ -0,0 +1,227 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"testing"
+	"time"
+)
+
+// testMessages сообщения вида запросов сервисов: GetByIdRequest{id, token}, DownloadDocumentRequest{document_id, token}
+// и PatientAccessGrant с patient_id, вложенный в AddGrantRequest
+func testMessages(t *testing.T) map[string]protoreflect.MessageDescriptor {
+	t.Helper()
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Type:   typ.Enum(),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	int32Type, stringType, messageType := descriptorpb.FieldDescriptorProto_TYPE_INT32,
+		descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("audit_test.proto"),
+		Package: proto.String("audittest"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("GetByIdRequest"), Field: []*descriptorpb.FieldDescriptorProto{
+				field("id", 1, int32Type, ""), field("token", 2, stringType, ""),
+			}},
+			{Name: proto.String("DownloadDocumentRequest"), Field: []*descriptorpb.FieldDescriptorProto{
+				field("document_id", 1, stringType, ""), field("token", 2, stringType, ""),
+			}},
+			{Name: proto.String("PatientAccessGrant"), Field: []*descriptorpb.FieldDescriptorProto{
+				field("patient_id", 1, int32Type, ""), field("doctor_id", 2, int32Type, ""),
+			}},
+			{Name: proto.String("AddGrantRequest"), Field: []*descriptorpb.FieldDescriptorProto{
+				field("grant", 1, messageType, ".audittest.PatientAccessGrant"),
+			}},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	messages := map[string]protoreflect.MessageDescriptor{}
+	for i := 0; i < file.Messages().Len(); i++ {
+		md := file.Messages().Get(i)
+		messages[string(md.Name())] = md
+	}
+	return messages
+}
+
+func newMessage(md protoreflect.MessageDescriptor, values map[string]any) *dynamicpb.Message {
+	m := dynamicpb.NewMessage(md)
+	for name, v := range values {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		switch v := v.(type) {
+		case int:
+			m.Set(fd, protoreflect.ValueOfInt32(int32(v)))
+		case string:
+			m.Set(fd, protoreflect.ValueOfString(v))
+		case *dynamicpb.Message:
+			m.Set(fd, protoreflect.ValueOfMessage(v))
+		}
+	}
+	return m
+}
+
+func TestResolve(t *testing.T) {
+	messages := testMessages(t)
+	targets := Targets{
+		"GetPatientVisits": {Resource: "patient", PatientField: "id"},
+		"GetInvoice":       {Resource: "invoice"},
+	}
+	doctor := Actor{UserID: 7, Role: 3}
+	tests := []struct {
+		name       string
+		method     string
+		req        any
+		actor      Actor
+		resource   string
+		resourceID string
+		patientID  int
+	}{
+		{"id запроса — пациент", "/doctor.DoctorService/GetPatientVisits",
+			newMessage(messages["GetByIdRequest"], map[string]any{"id": 42, "token": "секрет"}), doctor, "patient", "42", 42},
+		{"вид ресурса из таблицы целей", "/admin.AdminService/GetInvoice",
+			newMessage(messages["GetByIdRequest"], map[string]any{"id": 5}), doctor, "invoice", "5", 0},
+		{"ресурс по имени поля", "/doctor.DoctorService/DownloadDocument",
+			newMessage(messages["DownloadDocumentRequest"], map[string]any{"document_id": "c0ffee", "token": "секрет"}), doctor, "document", "c0ffee", 0},
+		{"пациент во вложенном сообщении", "/admin.AdminService/AddPatientAccessGrant",
+			newMessage(messages["AddGrantRequest"], map[string]any{
+				"grant": newMessage(messages["PatientAccessGrant"], map[string]any{"patient_id": 42, "doctor_id": 7}),
+			}), doctor, "doctor", "7", 42},
+		{"пациент работает со своими данными", "/patient.PatientService/GetHistoryVisits",
+			newMessage(messages["DownloadDocumentRequest"], map[string]any{"token": "секрет"}), Actor{UserID: 42, Role: 4}, "", "", 42},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource, resourceID, patientID := targets.resolve(tt.method, tt.req, tt.actor)
+			if resource != tt.resource || resourceID != tt.resourceID || patientID != tt.patientID {
+				t.Errorf("got %q/%q/%d, want %q/%q/%d", resource, resourceID, patientID, tt.resource, tt.resourceID, tt.patientID)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	messages := testMessages(t)
+	var entries []Entry
+	recorder := RecorderFunc(func(_ context.Context, entry Entry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	interceptor := UnaryServerInterceptor("doctor", recorder, Targets{"GetPatientVisits": {Resource: "patient", PatientField: "id"}})
+	info := &grpc.UnaryServerInfo{FullMethod: "/doctor.DoctorService/GetPatientVisits"}
+	handler := func(ctx context.Context, req any) (any, error) { return nil, nil }
+	req := newMessage(messages["GetByIdRequest"], map[string]any{"id": 42})
+
+	if _, err := interceptor(context.Background(), req, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("вызов без пользователя записан в журнал: %+v", entries)
+	}
+
+	// пользователь из исходящих метаданных шлюза приходит сервису во входящих
+	md, _ := metadata.FromOutgoingContext(WithActor(context.Background(), Actor{UserID: 7, Role: 3}))
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	if _, err := interceptor(ctx, req, info, handler); err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	got := entries[0]
+	if got.Service != "doctor" || got.Action != info.FullMethod || got.ActorID != 7 || got.ActorRole != 3 ||
+		got.PatientID == nil || *got.PatientID != 42 || got.Outcome != "OK" || got.OccurredAt.IsZero() {
+		t.Errorf("unexpected entry %+v", got)
+	}
+}
+
+// Обращение к данным пациента, которое не удалось записать, не отдаёт ответ; прочие вызовы отдают
+func TestUnaryServerInterceptorFailsClosed(t *testing.T) {
+	messages := testMessages(t)
+	recorder := RecorderFunc(func(context.Context, Entry) error {
+		return status.Error(codes.Unavailable, "storage недоступен")
+	})
+	interceptor := UnaryServerInterceptor("doctor", recorder, Targets{
+		"GetPatientVisits": {Resource: "patient", PatientField: "id"},
+		"GetInvoice":       {Resource: "invoice"},
+	})
+	md, _ := metadata.FromOutgoingContext(WithActor(context.Background(), Actor{UserID: 7, Role: 3}))
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	handler := func(ctx context.Context, req any) (any, error) { return "ответ", nil }
+
+	resp, err := interceptor(ctx, newMessage(messages["GetByIdRequest"], map[string]any{"id": 42}),
+		&grpc.UnaryServerInfo{FullMethod: "/doctor.DoctorService/GetPatientVisits"}, handler)
+	if status.Code(err) != codes.Unavailable || resp != nil {
+		t.Errorf("patient data: got %v, %v; want no response and Unavailable", resp, err)
+	}
+
+	resp, err = interceptor(ctx, newMessage(messages["GetByIdRequest"], map[string]any{"id": 5}),
+		&grpc.UnaryServerInfo{FullMethod: "/admin.AdminService/GetInvoice"}, handler)
+	if err != nil || resp != "ответ" {
+		t.Errorf("call without a patient: got %v, %v; want the response", resp, err)
+	}
+}
+
+func TestHashChain(t *testing.T) {
+	patientID := 42
+	occurred := time.Date(2026, 3, 2, 10, 15, 0, 123456789, time.FixedZone("MSK", 3*60*60))
+	entries := []Entry{
+		{OccurredAt: occurred, Service: "doctor", Action: "/doctor.DoctorService/GetPatientVisits", ActorID: 7, ActorRole: 3, Resource: "patient", ResourceID: "42", PatientID: &patientID, Outcome: "OK"},
+		{OccurredAt: occurred.Add(time.Second), Service: "admin", Action: "/admin.AdminService/UpdateEmployeeLogin", ActorID: 1, ActorRole: 1, Resource: "user", ResourceID: "7", Outcome: "OK"},
+	}
+	var chain [][]byte
+	var prev []byte
+	for _, e := range entries {
+		prev = Hash(prev, e)
+		chain = append(chain, prev)
+	}
+
+	// время после чтения из БД: UTC и микросекунды
+	stored := entries[0]
+	stored.OccurredAt = occurred.UTC().Truncate(time.Microsecond)
+	if !bytes.Equal(Hash(nil, stored), chain[0]) {
+		t.Errorf("хеш зависит от пояса и наносекунд времени записи")
+	}
+
+	tampered := entries[0]
+	tampered.ActorID = 8
+	if bytes.Equal(Hash(nil, tampered), chain[0]) {
+		t.Errorf("изменение записи не изменило хеш")
+	}
+	if bytes.Equal(Hash(nil, entries[1]), chain[1]) {
+		t.Errorf("хеш не зависит от предыдущей записи")
+	}
+
+	// сдвиг границы между полями
+	shifted := entries[1]
+	shifted.Resource, shifted.ResourceID = "user7", ""
+	if bytes.Equal(Hash(chain[0], shifted), chain[1]) {
+		t.Errorf("сдвиг границы полей не изменил хеш")
+	}
+
+	zero := 0
+	withZero := entries[1]
+	withZero.PatientID = &zero
+	if bytes.Equal(Hash(chain[0], withZero), chain[1]) {
+		t.Errorf("запись с patient_id 0 хешируется как запись без пациента")
+	}
+}
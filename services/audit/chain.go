@@ -0,0 +1,43 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"strconv"
+	"time"
+)
+
+// Hash звено цепочки журнала: SHA-256 от хеша предыдущей записи (nil у первой) и содержимого записи.
+// Изменение, удаление или перестановка любой записи меняет хеши всех следующих, поэтому подмена
+// видна при проверке цепочки. Время берётся с точностью до микросекунды, как его хранит PostgreSQL.
+// Запись без пациента хешируется с пустым полем пациента, чтобы её нельзя было выдать за запись с patient_id 0
+func Hash(prev []byte, entry Entry) []byte {
+	var patientID string
+	if entry.PatientID != nil {
+		patientID = strconv.Itoa(*entry.PatientID)
+	}
+	h := sha256.New()
+	writeField(h, prev)
+	for _, field := range []string{
+		entry.OccurredAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+		entry.Service,
+		entry.Action,
+		strconv.Itoa(entry.ActorID),
+		strconv.Itoa(entry.ActorRole),
+		entry.Resource,
+		entry.ResourceID,
+		patientID,
+		entry.Outcome,
+	} {
+		writeField(h, []byte(field))
+	}
+	return h.Sum(nil)
+}
+
+// writeField пишет поле с длиной впереди, чтобы границы полей нельзя было сдвинуть, не изменив хеш
+func writeField(h interface{ Write([]byte) (int, error) }, field []byte) {
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(field)))
+	h.Write(size[:])
+	h.Write(field)
+}
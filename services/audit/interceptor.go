@@ -0,0 +1,125 @@
+package audit
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"time"
+)
+
+// recordTimeout сколько ждать сохранения записи; запись не зависит от отмены самого вызова
+const recordTimeout = 5 * time.Second
+
+// errNotRecorded ответ на обращение к данным пациента, которое не удалось записать в журнал
+var errNotRecorded = status.Error(codes.Unavailable, "не удалось записать обращение в журнал аудита")
+
+// UnaryServerInterceptor перехватчик, записывающий в журнал вызовы от имени пользователя. Ставится первым
+// в цепочке, чтобы видеть итоговый статус ответа. Если не удалось записать обращение к данным пациента,
+// ответ не отдаётся и вызов завершается ошибкой Unavailable: медицинские данные не выдаются без записи
+// в журнале. Ошибки записи остальных вызовов только пишутся в журнал сервиса
+func UnaryServerInterceptor(service string, recorder Recorder, targets Targets) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		actor, ok := ActorFromContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+		started := time.Now()
+		resp, err := handler(ctx, req)
+		resource, resourceID, patientID := targets.resolve(info.FullMethod, req, actor)
+		recErr := record(ctx, recorder, Entry{
+			OccurredAt: started,
+			Service:    service,
+			Action:     info.FullMethod,
+			ActorID:    actor.UserID,
+			ActorRole:  actor.Role,
+			Resource:   resource,
+			ResourceID: resourceID,
+			PatientID:  patientRef(patientID),
+			Outcome:    status.Code(err).String(),
+		})
+		if recErr != nil && patientID != 0 {
+			return nil, errNotRecorded
+		}
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor то же для потоковых методов: ресурс определяется по первому сообщению клиента.
+// Сообщения потока к моменту записи уже отправлены, поэтому при неудачной записи обращения к данным
+// пациента ошибкой Unavailable завершается только статус вызова
+func StreamServerInterceptor(service string, recorder Recorder, targets Targets) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		actor, ok := ActorFromContext(ss.Context())
+		if !ok {
+			return handler(srv, ss)
+		}
+		started := time.Now()
+		stream := &firstMessageStream{ServerStream: ss, targets: targets, method: info.FullMethod, actor: actor}
+		err := handler(srv, stream)
+		resource, resourceID, patientID := stream.resource, stream.resourceID, stream.patientID
+		if !stream.received {
+			resource, resourceID, patientID = targets.resolve(info.FullMethod, nil, actor)
+		}
+		recErr := record(ss.Context(), recorder, Entry{
+			OccurredAt: started,
+			Service:    service,
+			Action:     info.FullMethod,
+			ActorID:    actor.UserID,
+			ActorRole:  actor.Role,
+			Resource:   resource,
+			ResourceID: resourceID,
+			PatientID:  patientRef(patientID),
+			Outcome:    status.Code(err).String(),
+		})
+		if recErr != nil && patientID != 0 {
+			return errNotRecorded
+		}
+		return err
+	}
+}
+
+// firstMessageStream поток, определяющий ресурс по первому сообщению клиента. Само сообщение не хранится:
+// в загрузках файлов оно может быть большим
+type firstMessageStream struct {
+	grpc.ServerStream
+	targets  Targets
+	method   string
+	actor    Actor
+	received bool
+
+	resource   string
+	resourceID string
+	patientID  int
+}
+
+func (s *firstMessageStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && !s.received {
+		if msg, ok := m.(proto.Message); ok {
+			s.received = true
+			s.resource, s.resourceID, s.patientID = s.targets.resolve(s.method, msg, s.actor)
+		}
+	}
+	return err
+}
+
+// patientRef пациент записи журнала: nil, если вызов не относится к конкретному пациенту
+func patientRef(patientID int) *int {
+	if patientID == 0 {
+		return nil
+	}
+	return &patientID
+}
+
+func record(ctx context.Context, recorder Recorder, entry Entry) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recordTimeout)
+	defer cancel()
+	err := recorder.Record(ctx, entry)
+	if err != nil {
+		log.Printf("не удалось записать в журнал аудита %s пользователя %d: %v", entry.Action, entry.ActorID, err)
+	}
+	return err
+}
@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strconv"
+	"strings"
+)
+
+// Target где в запросе метода искать затронутый ресурс. Resource — вид ресурса, IDField — поле запроса
+// с его идентификатором, PatientField — поле с идентификатором пациента. Незаданные поля ищутся
+// по умолчанию: пациент — в patient_id, ресурс — в первом заполненном поле из idFields
+type Target struct {
+	Resource     string
+	IDField      string
+	PatientField string
+}
+
+// Targets цели методов сервиса по короткому имени метода (без сервиса), например GetPatientVisits
+type Targets map[string]Target
+
+// patientFields поля запроса с идентификатором пациента
+var patientFields = []string{"patient_id", "patientID"}
+
+// idFields поля запроса с идентификатором ресурса в порядке поиска. Вид ресурса — имя поля без _id
+var idFields = []string{"id", "visit_id", "appointment_id", "document_id", "invoice_id", "plan_id", "user_id", "doctor_id"}
+
+// resolve ресурс и пациент вызова method с запросом req от имени actor
+func (t Targets) resolve(method string, req any, actor Actor) (resource, resourceID string, patientID int) {
+	target := t[method[strings.LastIndex(method, "/")+1:]]
+	resource = target.Resource
+	if msg, ok := req.(proto.Message); ok && msg != nil {
+		m := msg.ProtoReflect()
+		names := patientFields
+		if target.PatientField != "" {
+			names = []string{target.PatientField}
+		}
+		for _, name := range names {
+			if v := fieldValue(m, name); v != "" {
+				patientID, _ = strconv.Atoi(v)
+				break
+			}
+		}
+
+		names = idFields
+		if target.IDField != "" {
+			names = []string{target.IDField}
+		}
+		for _, name := range names {
+			if v := fieldValue(m, name); v != "" {
+				resourceID = v
+				if resource == "" && name != "id" {
+					resource = strings.TrimSuffix(name, "_id")
+				}
+				break
+			}
+		}
+	}
+	if patientID == 0 && actor.Role == rolePatient {
+		patientID = actor.UserID
+	}
+	if resource == "patient" && resourceID == "" && patientID != 0 {
+		resourceID = strconv.Itoa(patientID)
+	}
+	return resource, resourceID, patientID
+}
+
+// fieldValue непустое значение целого или строкового поля name запроса либо вложенного в него сообщения
+// (например, patient_id в PatientAccessGrant); "" — поля нет или оно не заполнено
+func fieldValue(m protoreflect.Message, name string) string {
+	fields := m.Descriptor().Fields()
+	if fd := fields.ByName(protoreflect.Name(name)); fd != nil && !fd.IsList() && !fd.IsMap() {
+		if v := scalar(fd, m.Get(fd)); v != "" {
+			return v
+		}
+	}
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || !m.Has(fd) {
+			continue
+		}
+		nested := m.Get(fd).Message()
+		if nf := nested.Descriptor().Fields().ByName(protoreflect.Name(name)); nf != nil && !nf.IsList() && !nf.IsMap() {
+			if v := scalar(nf, nested.Get(nf)); v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+func scalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		if v.Int() != 0 {
+			return strconv.FormatInt(v.Int(), 10)
+		}
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		if v.Uint() != 0 {
+			return strconv.FormatUint(v.Uint(), 10)
+		}
+	case protoreflect.StringKind:
+		return v.String()
+	}
+	return ""
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"github.com/DariaTarasek/diplom/services/audit"
 	"github.com/DariaTarasek/diplom/services/auth/clients"
 	grpcserver "github.com/DariaTarasek/diplom/services/auth/grpc"
 	pb "github.com/DariaTarasek/diplom/services/auth/proto/auth"
@@ -16,7 +18,7 @@ func main() {
 	ctx := context.Background()
 	err := godotenv.Load()
 	if err != nil {
-		log.Fatalf("Не удалось получить переменные среды: %w", err)
+		log.Fatalf("Не удалось получить переменные среды: %s", err)
 	}
 	storageClient, err := clients.NewStorageClient("localhost:50051")
 	if err != nil {
@@ -35,7 +37,19 @@ func main() {
 		log.Fatalf("Не удалось начать слушать: %v", err)
 	}
 
-	s := grpc.NewServer()
+	// вызовы от имени пользователя записываются в журнал аудита с итоговым статусом; ошибки методов
+	// приводятся к доменным статусам, ошибки без доменного смысла — к внутренней без подробностей
+	recorder := audit.RecorderFunc(storageClient.RecordAudit)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			audit.UnaryServerInterceptor("auth", recorder, nil),
+			apperr.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			audit.StreamServerInterceptor("auth", recorder, nil),
+			apperr.StreamServerInterceptor(),
+		),
+	)
 
 	server := &grpcserver.Server{
 		Service: authService,
@@ -167,7 +167,7 @@ func (s *Server) VerifyCode(ctx context.Context, req *pb.VerifyCodeRequest) (*pb
 }
 
 func (s *Server) Auth(ctx context.Context, req *pb.AuthRequest) (*pb.AuthResponse, error) {
-	token, role, err := s.Service.UserAuth(ctx, model.User{
+	tokens, role, err := s.Service.UserAuth(ctx, model.User{
 		Login:    &req.Login,
 		Password: &req.Password,
 	})
@@ -175,13 +175,49 @@ func (s *Server) Auth(ctx context.Context, req *pb.AuthRequest) (*pb.AuthRespons
 		return nil, err
 	}
 	return &pb.AuthResponse{
-		Token: token,
-		Role:  role,
+		Token:        tokens.AccessToken,
+		Role:         role,
+		RefreshToken: tokens.RefreshToken,
 	}, nil
 }
 
-func (s *Server) PermissionCheck(ctx context.Context, req *pb.PermissionCheckRequest) (*pb.DefaultResponse, error) {
-	err := s.Service.PermissionCheck(ctx, req.Token, model.PermissionID(req.PermId))
+func (s *Server) PermissionCheck(ctx context.Context, req *pb.PermissionCheckRequest) (*pb.PermissionCheckResponse, error) {
+	userID, role, err := s.Service.PermissionCheck(ctx, req.Token, model.PermissionID(req.PermId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PermissionCheckResponse{UserId: userID, Role: role}, nil
+}
+
+func (s *Server) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	tokens, err := s.Service.RefreshSession(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RefreshTokenResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
+
+func (s *Server) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.Logout(ctx, req.Token, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) LogoutAllSessions(ctx context.Context, req *pb.LogoutRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.LogoutAllSessions(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) RevokeUserSessions(ctx context.Context, req *pb.RevokeUserSessionsRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.RevokeUserSessions(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +250,14 @@ func (s *Server) GetUserID(ctx context.Context, req *pb.GetUserIDRequest) (*pb.G
 	return &pb.GetUserIDResponse{UserId: int32(userId)}, nil
 }
 
+func (s *Server) GetUserRole(ctx context.Context, req *pb.GetUserIDRequest) (*pb.GetUserRoleResponse, error) {
+	userID, role, err := s.Service.GetUserRole(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetUserRoleResponse{UserId: int32(userID), Role: int32(role)}, nil
+}
+
 func (s *Server) GetDoctorProfile(ctx context.Context, request *pb.GetProfileRequest) (*pb.GetDoctorResponse, error) {
 	resp, err := s.Service.GetDoctorByID(ctx, request.Token)
 	if err != nil {
@@ -888,6 +888,7 @@ type AuthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -936,6 +937,205 @@ func (x *AuthResponse) GetRole() string {
 	return ""
 }
 
+func (x *AuthResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RefreshTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LogoutRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RevokeUserSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeUserSessionsRequest) Reset() {
+	*x = RevokeUserSessionsRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RevokeUserSessionsRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 type PermissionCheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -946,7 +1146,7 @@ type PermissionCheckRequest struct {
 
 func (x *PermissionCheckRequest) Reset() {
 	*x = PermissionCheckRequest{}
-	mi := &file_proto_auth_auth_proto_msgTypes[16]
+	mi := &file_proto_auth_auth_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -958,7 +1158,7 @@ func (x *PermissionCheckRequest) String() string {
 func (*PermissionCheckRequest) ProtoMessage() {}
 
 func (x *PermissionCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[16]
+	mi := &file_proto_auth_auth_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,7 +1171,7 @@ func (x *PermissionCheckRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PermissionCheckRequest.ProtoReflect.Descriptor instead.
 func (*PermissionCheckRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{16}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PermissionCheckRequest) GetToken() string {
@@ -988,6 +1188,58 @@ func (x *PermissionCheckRequest) GetPermId() int32 {
 	return 0
 }
 
+type PermissionCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // пользователь, от имени которого шлюз вызывает сервисы
+	Role          int32                  `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionCheckResponse) Reset() {
+	*x = PermissionCheckResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionCheckResponse) ProtoMessage() {}
+
+func (x *PermissionCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionCheckResponse.ProtoReflect.Descriptor instead.
+func (*PermissionCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PermissionCheckResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PermissionCheckResponse) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
 type GetPatientRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -997,7 +1249,7 @@ type GetPatientRequest struct {
 
 func (x *GetPatientRequest) Reset() {
 	*x = GetPatientRequest{}
-	mi := &file_proto_auth_auth_proto_msgTypes[17]
+	mi := &file_proto_auth_auth_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1009,7 +1261,7 @@ func (x *GetPatientRequest) String() string {
 func (*GetPatientRequest) ProtoMessage() {}
 
 func (x *GetPatientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[17]
+	mi := &file_proto_auth_auth_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +1274,7 @@ func (x *GetPatientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientRequest.ProtoReflect.Descriptor instead.
 func (*GetPatientRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{17}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetPatientRequest) GetToken() string {
@@ -1041,7 +1293,7 @@ type GetUserIDRequest struct {
 
 func (x *GetUserIDRequest) Reset() {
 	*x = GetUserIDRequest{}
-	mi := &file_proto_auth_auth_proto_msgTypes[18]
+	mi := &file_proto_auth_auth_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1053,7 +1305,7 @@ func (x *GetUserIDRequest) String() string {
 func (*GetUserIDRequest) ProtoMessage() {}
 
 func (x *GetUserIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[18]
+	mi := &file_proto_auth_auth_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,7 +1318,7 @@ func (x *GetUserIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserIDRequest.ProtoReflect.Descriptor instead.
 func (*GetUserIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{18}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetUserIDRequest) GetToken() string {
@@ -1085,7 +1337,7 @@ type GetUserIDResponse struct {
 
 func (x *GetUserIDResponse) Reset() {
 	*x = GetUserIDResponse{}
-	mi := &file_proto_auth_auth_proto_msgTypes[19]
+	mi := &file_proto_auth_auth_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1097,7 +1349,7 @@ func (x *GetUserIDResponse) String() string {
 func (*GetUserIDResponse) ProtoMessage() {}
 
 func (x *GetUserIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[19]
+	mi := &file_proto_auth_auth_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1110,7 +1362,7 @@ func (x *GetUserIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserIDResponse.ProtoReflect.Descriptor instead.
 func (*GetUserIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{19}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetUserIDResponse) GetUserId() int32 {
@@ -1120,6 +1372,58 @@ func (x *GetUserIDResponse) GetUserId() int32 {
 	return 0
 }
 
+type GetUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          int32                  `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"` // идентификатор роли из таблицы roles
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRoleResponse) Reset() {
+	*x = GetUserRoleResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRoleResponse) ProtoMessage() {}
+
+func (x *GetUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*GetUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetUserRoleResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserRoleResponse) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
 type GetPatientResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Patient       *PatientData           `protobuf:"bytes,1,opt,name=patient,proto3" json:"patient,omitempty"`
@@ -1129,7 +1433,7 @@ type GetPatientResponse struct {
 
 func (x *GetPatientResponse) Reset() {
 	*x = GetPatientResponse{}
-	mi := &file_proto_auth_auth_proto_msgTypes[20]
+	mi := &file_proto_auth_auth_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1141,7 +1445,7 @@ func (x *GetPatientResponse) String() string {
 func (*GetPatientResponse) ProtoMessage() {}
 
 func (x *GetPatientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[20]
+	mi := &file_proto_auth_auth_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1154,7 +1458,7 @@ func (x *GetPatientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{20}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetPatientResponse) GetPatient() *PatientData {
@@ -1179,7 +1483,7 @@ type Admin struct {
 
 func (x *Admin) Reset() {
 	*x = Admin{}
-	mi := &file_proto_auth_auth_proto_msgTypes[21]
+	mi := &file_proto_auth_auth_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1191,7 +1495,7 @@ func (x *Admin) String() string {
 func (*Admin) ProtoMessage() {}
 
 func (x *Admin) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[21]
+	mi := &file_proto_auth_auth_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,7 +1508,7 @@ func (x *Admin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Admin.ProtoReflect.Descriptor instead.
 func (*Admin) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{21}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *Admin) GetUserId() int32 {
@@ -1272,7 +1576,7 @@ type AdminWithRole struct {
 
 func (x *AdminWithRole) Reset() {
 	*x = AdminWithRole{}
-	mi := &file_proto_auth_auth_proto_msgTypes[22]
+	mi := &file_proto_auth_auth_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1284,7 +1588,7 @@ func (x *AdminWithRole) String() string {
 func (*AdminWithRole) ProtoMessage() {}
 
 func (x *AdminWithRole) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[22]
+	mi := &file_proto_auth_auth_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1297,7 +1601,7 @@ func (x *AdminWithRole) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminWithRole.ProtoReflect.Descriptor instead.
 func (*AdminWithRole) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{22}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *AdminWithRole) GetUserId() int32 {
@@ -1373,7 +1677,7 @@ type Doctor struct {
 
 func (x *Doctor) Reset() {
 	*x = Doctor{}
-	mi := &file_proto_auth_auth_proto_msgTypes[23]
+	mi := &file_proto_auth_auth_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1385,7 +1689,7 @@ func (x *Doctor) String() string {
 func (*Doctor) ProtoMessage() {}
 
 func (x *Doctor) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[23]
+	mi := &file_proto_auth_auth_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1398,7 +1702,7 @@ func (x *Doctor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Doctor.ProtoReflect.Descriptor instead.
 func (*Doctor) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{23}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Doctor) GetUserId() int32 {
@@ -1473,7 +1777,7 @@ type GetDoctorResponse struct {
 
 func (x *GetDoctorResponse) Reset() {
 	*x = GetDoctorResponse{}
-	mi := &file_proto_auth_auth_proto_msgTypes[24]
+	mi := &file_proto_auth_auth_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1485,7 +1789,7 @@ func (x *GetDoctorResponse) String() string {
 func (*GetDoctorResponse) ProtoMessage() {}
 
 func (x *GetDoctorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[24]
+	mi := &file_proto_auth_auth_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1498,7 +1802,7 @@ func (x *GetDoctorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{24}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetDoctorResponse) GetDoctor() *Doctor {
@@ -1517,7 +1821,7 @@ type GetAdminWithRoleResponse struct {
 
 func (x *GetAdminWithRoleResponse) Reset() {
 	*x = GetAdminWithRoleResponse{}
-	mi := &file_proto_auth_auth_proto_msgTypes[25]
+	mi := &file_proto_auth_auth_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1529,7 +1833,7 @@ func (x *GetAdminWithRoleResponse) String() string {
 func (*GetAdminWithRoleResponse) ProtoMessage() {}
 
 func (x *GetAdminWithRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[25]
+	mi := &file_proto_auth_auth_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,7 +1846,7 @@ func (x *GetAdminWithRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAdminWithRoleResponse.ProtoReflect.Descriptor instead.
 func (*GetAdminWithRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{25}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetAdminWithRoleResponse) GetAdmin() *AdminWithRole {
@@ -1561,7 +1865,7 @@ type GetProfileRequest struct {
 
 func (x *GetProfileRequest) Reset() {
 	*x = GetProfileRequest{}
-	mi := &file_proto_auth_auth_proto_msgTypes[26]
+	mi := &file_proto_auth_auth_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1573,7 +1877,7 @@ func (x *GetProfileRequest) String() string {
 func (*GetProfileRequest) ProtoMessage() {}
 
 func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[26]
+	mi := &file_proto_auth_auth_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1586,7 +1890,7 @@ func (x *GetProfileRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProfileRequest.ProtoReflect.Descriptor instead.
 func (*GetProfileRequest) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{26}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetProfileRequest) GetToken() string {
@@ -1663,19 +1967,36 @@ const file_proto_auth_auth_proto_rawDesc = "" +
 	"\x04code\x18\x02 \x01(\tR\x04code\"?\n" +
 	"\vAuthRequest\x12\x14\n" +
 	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"8\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"]\n" +
 	"\fAuthResponse\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
-	"\x04role\x18\x02 \x01(\tR\x04role\"G\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"Q\n" +
+	"\x14RefreshTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"J\n" +
+	"\rLogoutRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"4\n" +
+	"\x19RevokeUserSessionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"G\n" +
 	"\x16PermissionCheckRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
-	"\aperm_id\x18\x02 \x01(\x05R\x06permId\")\n" +
+	"\aperm_id\x18\x02 \x01(\x05R\x06permId\"F\n" +
+	"\x17PermissionCheckResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\x05R\x04role\")\n" +
 	"\x11GetPatientRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"(\n" +
 	"\x10GetUserIDRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\",\n" +
 	"\x11GetUserIDResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\"A\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"B\n" +
+	"\x13GetUserRoleResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\x05R\x04role\"A\n" +
 	"\x12GetPatientResponse\x12+\n" +
 	"\apatient\x18\x01 \x01(\v2\x11.auth.PatientDataR\apatient\"\xcb\x01\n" +
 	"\x05Admin\x12\x17\n" +
@@ -1718,7 +2039,8 @@ const file_proto_auth_auth_proto_rawDesc = "" +
 	"\x18GetAdminWithRoleResponse\x12)\n" +
 	"\x05admin\x18\x01 \x01(\v2\x13.auth.AdminWithRoleR\x05admin\")\n" +
 	"\x11GetProfileRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token2\xd1\a\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token2\xa7\n" +
+	"\n" +
 	"\vAuthService\x12Q\n" +
 	"\x10EmployeeRegister\x12\x1d.auth.EmployeeRegisterRequest\x1a\x1e.auth.EmployeeRegisterResponse\x12N\n" +
 	"\x0fPatientRegister\x12\x1c.auth.PatientRegisterRequest\x1a\x1d.auth.PatientRegisterResponse\x12f\n" +
@@ -1728,13 +2050,18 @@ const file_proto_auth_auth_proto_rawDesc = "" +
 	"\vRequestCode\x12\x19.auth.GenerateCodeRequest\x1a\x15.auth.DefaultResponse\x12<\n" +
 	"\n" +
 	"VerifyCode\x12\x17.auth.VerifyCodeRequest\x1a\x15.auth.DefaultResponse\x12-\n" +
-	"\x04Auth\x12\x11.auth.AuthRequest\x1a\x12.auth.AuthResponse\x12F\n" +
-	"\x0fPermissionCheck\x12\x1c.auth.PermissionCheckRequest\x1a\x15.auth.DefaultResponse\x12?\n" +
+	"\x04Auth\x12\x11.auth.AuthRequest\x1a\x12.auth.AuthResponse\x12N\n" +
+	"\x0fPermissionCheck\x12\x1c.auth.PermissionCheckRequest\x1a\x1d.auth.PermissionCheckResponse\x12?\n" +
 	"\n" +
 	"GetPatient\x12\x17.auth.GetPatientRequest\x1a\x18.auth.GetPatientResponse\x12<\n" +
-	"\tGetUserID\x12\x16.auth.GetUserIDRequest\x1a\x17.auth.GetUserIDResponse\x12D\n" +
+	"\tGetUserID\x12\x16.auth.GetUserIDRequest\x1a\x17.auth.GetUserIDResponse\x12@\n" +
+	"\vGetUserRole\x12\x16.auth.GetUserIDRequest\x1a\x19.auth.GetUserRoleResponse\x12D\n" +
 	"\x10GetDoctorProfile\x12\x17.auth.GetProfileRequest\x1a\x17.auth.GetDoctorResponse\x12J\n" +
-	"\x0fGetAdminProfile\x12\x17.auth.GetProfileRequest\x1a\x1e.auth.GetAdminWithRoleResponseB\x13Z\x11auth/proto;authpbb\x06proto3"
+	"\x0fGetAdminProfile\x12\x17.auth.GetProfileRequest\x1a\x1e.auth.GetAdminWithRoleResponse\x12E\n" +
+	"\fRefreshToken\x12\x19.auth.RefreshTokenRequest\x1a\x1a.auth.RefreshTokenResponse\x124\n" +
+	"\x06Logout\x12\x13.auth.LogoutRequest\x1a\x15.auth.DefaultResponse\x12?\n" +
+	"\x11LogoutAllSessions\x12\x13.auth.LogoutRequest\x1a\x15.auth.DefaultResponse\x12L\n" +
+	"\x12RevokeUserSessions\x12\x1f.auth.RevokeUserSessionsRequest\x1a\x15.auth.DefaultResponseB\x13Z\x11auth/proto;authpbb\x06proto3"
 
 var (
 	file_proto_auth_auth_proto_rawDescOnce sync.Once
@@ -1748,7 +2075,7 @@ func file_proto_auth_auth_proto_rawDescGZIP() []byte {
 	return file_proto_auth_auth_proto_rawDescData
 }
 
-var file_proto_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_proto_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_proto_auth_auth_proto_goTypes = []any{
 	(*UserData)(nil),                        // 0: auth.UserData
 	(*EmployeeRegisterResponse)(nil),        // 1: auth.EmployeeRegisterResponse
@@ -1766,30 +2093,36 @@ var file_proto_auth_auth_proto_goTypes = []any{
 	(*VerifyCodeRequest)(nil),               // 13: auth.VerifyCodeRequest
 	(*AuthRequest)(nil),                     // 14: auth.AuthRequest
 	(*AuthResponse)(nil),                    // 15: auth.AuthResponse
-	(*PermissionCheckRequest)(nil),          // 16: auth.PermissionCheckRequest
-	(*GetPatientRequest)(nil),               // 17: auth.GetPatientRequest
-	(*GetUserIDRequest)(nil),                // 18: auth.GetUserIDRequest
-	(*GetUserIDResponse)(nil),               // 19: auth.GetUserIDResponse
-	(*GetPatientResponse)(nil),              // 20: auth.GetPatientResponse
-	(*Admin)(nil),                           // 21: auth.Admin
-	(*AdminWithRole)(nil),                   // 22: auth.AdminWithRole
-	(*Doctor)(nil),                          // 23: auth.Doctor
-	(*GetDoctorResponse)(nil),               // 24: auth.GetDoctorResponse
-	(*GetAdminWithRoleResponse)(nil),        // 25: auth.GetAdminWithRoleResponse
-	(*GetProfileRequest)(nil),               // 26: auth.GetProfileRequest
-	(*timestamppb.Timestamp)(nil),           // 27: google.protobuf.Timestamp
+	(*RefreshTokenRequest)(nil),             // 16: auth.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),            // 17: auth.RefreshTokenResponse
+	(*LogoutRequest)(nil),                   // 18: auth.LogoutRequest
+	(*RevokeUserSessionsRequest)(nil),       // 19: auth.RevokeUserSessionsRequest
+	(*PermissionCheckRequest)(nil),          // 20: auth.PermissionCheckRequest
+	(*PermissionCheckResponse)(nil),         // 21: auth.PermissionCheckResponse
+	(*GetPatientRequest)(nil),               // 22: auth.GetPatientRequest
+	(*GetUserIDRequest)(nil),                // 23: auth.GetUserIDRequest
+	(*GetUserIDResponse)(nil),               // 24: auth.GetUserIDResponse
+	(*GetUserRoleResponse)(nil),             // 25: auth.GetUserRoleResponse
+	(*GetPatientResponse)(nil),              // 26: auth.GetPatientResponse
+	(*Admin)(nil),                           // 27: auth.Admin
+	(*AdminWithRole)(nil),                   // 28: auth.AdminWithRole
+	(*Doctor)(nil),                          // 29: auth.Doctor
+	(*GetDoctorResponse)(nil),               // 30: auth.GetDoctorResponse
+	(*GetAdminWithRoleResponse)(nil),        // 31: auth.GetAdminWithRoleResponse
+	(*GetProfileRequest)(nil),               // 32: auth.GetProfileRequest
+	(*timestamppb.Timestamp)(nil),           // 33: google.protobuf.Timestamp
 }
 var file_proto_auth_auth_proto_depIdxs = []int32{
 	0,  // 0: auth.EmployeeRegisterRequest.user:type_name -> auth.UserData
 	2,  // 1: auth.EmployeeRegisterRequest.employee:type_name -> auth.EmployeeData
-	27, // 2: auth.PatientData.birth_date:type_name -> google.protobuf.Timestamp
+	33, // 2: auth.PatientData.birth_date:type_name -> google.protobuf.Timestamp
 	0,  // 3: auth.PatientRegisterRequest.user:type_name -> auth.UserData
 	4,  // 4: auth.PatientRegisterRequest.patient:type_name -> auth.PatientData
 	0,  // 5: auth.PatientRegisterInClinicRequest.user:type_name -> auth.UserData
 	4,  // 6: auth.PatientRegisterInClinicRequest.patient:type_name -> auth.PatientData
 	4,  // 7: auth.GetPatientResponse.patient:type_name -> auth.PatientData
-	23, // 8: auth.GetDoctorResponse.doctor:type_name -> auth.Doctor
-	22, // 9: auth.GetAdminWithRoleResponse.admin:type_name -> auth.AdminWithRole
+	29, // 8: auth.GetDoctorResponse.doctor:type_name -> auth.Doctor
+	28, // 9: auth.GetAdminWithRoleResponse.admin:type_name -> auth.AdminWithRole
 	3,  // 10: auth.AuthService.EmployeeRegister:input_type -> auth.EmployeeRegisterRequest
 	5,  // 11: auth.AuthService.PatientRegister:input_type -> auth.PatientRegisterRequest
 	7,  // 12: auth.AuthService.PatientRegisterInClinic:input_type -> auth.PatientRegisterInClinicRequest
@@ -1798,26 +2131,36 @@ var file_proto_auth_auth_proto_depIdxs = []int32{
 	12, // 15: auth.AuthService.RequestCode:input_type -> auth.GenerateCodeRequest
 	13, // 16: auth.AuthService.VerifyCode:input_type -> auth.VerifyCodeRequest
 	14, // 17: auth.AuthService.Auth:input_type -> auth.AuthRequest
-	16, // 18: auth.AuthService.PermissionCheck:input_type -> auth.PermissionCheckRequest
-	17, // 19: auth.AuthService.GetPatient:input_type -> auth.GetPatientRequest
-	18, // 20: auth.AuthService.GetUserID:input_type -> auth.GetUserIDRequest
-	26, // 21: auth.AuthService.GetDoctorProfile:input_type -> auth.GetProfileRequest
-	26, // 22: auth.AuthService.GetAdminProfile:input_type -> auth.GetProfileRequest
-	1,  // 23: auth.AuthService.EmployeeRegister:output_type -> auth.EmployeeRegisterResponse
-	6,  // 24: auth.AuthService.PatientRegister:output_type -> auth.PatientRegisterResponse
-	8,  // 25: auth.AuthService.PatientRegisterInClinic:output_type -> auth.PatientRegisterInClinicResponse
-	11, // 26: auth.AuthService.EmployeePasswordRecovery:output_type -> auth.DefaultResponse
-	11, // 27: auth.AuthService.PatientPasswordRecovery:output_type -> auth.DefaultResponse
-	11, // 28: auth.AuthService.RequestCode:output_type -> auth.DefaultResponse
-	11, // 29: auth.AuthService.VerifyCode:output_type -> auth.DefaultResponse
-	15, // 30: auth.AuthService.Auth:output_type -> auth.AuthResponse
-	11, // 31: auth.AuthService.PermissionCheck:output_type -> auth.DefaultResponse
-	20, // 32: auth.AuthService.GetPatient:output_type -> auth.GetPatientResponse
-	19, // 33: auth.AuthService.GetUserID:output_type -> auth.GetUserIDResponse
-	24, // 34: auth.AuthService.GetDoctorProfile:output_type -> auth.GetDoctorResponse
-	25, // 35: auth.AuthService.GetAdminProfile:output_type -> auth.GetAdminWithRoleResponse
-	23, // [23:36] is the sub-list for method output_type
-	10, // [10:23] is the sub-list for method input_type
+	20, // 18: auth.AuthService.PermissionCheck:input_type -> auth.PermissionCheckRequest
+	22, // 19: auth.AuthService.GetPatient:input_type -> auth.GetPatientRequest
+	23, // 20: auth.AuthService.GetUserID:input_type -> auth.GetUserIDRequest
+	23, // 21: auth.AuthService.GetUserRole:input_type -> auth.GetUserIDRequest
+	32, // 22: auth.AuthService.GetDoctorProfile:input_type -> auth.GetProfileRequest
+	32, // 23: auth.AuthService.GetAdminProfile:input_type -> auth.GetProfileRequest
+	16, // 24: auth.AuthService.RefreshToken:input_type -> auth.RefreshTokenRequest
+	18, // 25: auth.AuthService.Logout:input_type -> auth.LogoutRequest
+	18, // 26: auth.AuthService.LogoutAllSessions:input_type -> auth.LogoutRequest
+	19, // 27: auth.AuthService.RevokeUserSessions:input_type -> auth.RevokeUserSessionsRequest
+	1,  // 28: auth.AuthService.EmployeeRegister:output_type -> auth.EmployeeRegisterResponse
+	6,  // 29: auth.AuthService.PatientRegister:output_type -> auth.PatientRegisterResponse
+	8,  // 30: auth.AuthService.PatientRegisterInClinic:output_type -> auth.PatientRegisterInClinicResponse
+	11, // 31: auth.AuthService.EmployeePasswordRecovery:output_type -> auth.DefaultResponse
+	11, // 32: auth.AuthService.PatientPasswordRecovery:output_type -> auth.DefaultResponse
+	11, // 33: auth.AuthService.RequestCode:output_type -> auth.DefaultResponse
+	11, // 34: auth.AuthService.VerifyCode:output_type -> auth.DefaultResponse
+	15, // 35: auth.AuthService.Auth:output_type -> auth.AuthResponse
+	21, // 36: auth.AuthService.PermissionCheck:output_type -> auth.PermissionCheckResponse
+	26, // 37: auth.AuthService.GetPatient:output_type -> auth.GetPatientResponse
+	24, // 38: auth.AuthService.GetUserID:output_type -> auth.GetUserIDResponse
+	25, // 39: auth.AuthService.GetUserRole:output_type -> auth.GetUserRoleResponse
+	30, // 40: auth.AuthService.GetDoctorProfile:output_type -> auth.GetDoctorResponse
+	31, // 41: auth.AuthService.GetAdminProfile:output_type -> auth.GetAdminWithRoleResponse
+	17, // 42: auth.AuthService.RefreshToken:output_type -> auth.RefreshTokenResponse
+	11, // 43: auth.AuthService.Logout:output_type -> auth.DefaultResponse
+	11, // 44: auth.AuthService.LogoutAllSessions:output_type -> auth.DefaultResponse
+	11, // 45: auth.AuthService.RevokeUserSessions:output_type -> auth.DefaultResponse
+	28, // [28:46] is the sub-list for method output_type
+	10, // [10:28] is the sub-list for method input_type
 	10, // [10:10] is the sub-list for extension type_name
 	10, // [10:10] is the sub-list for extension extendee
 	0,  // [0:10] is the sub-list for field type_name
@@ -1834,7 +2177,7 @@ func file_proto_auth_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_auth_proto_rawDesc), len(file_proto_auth_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -594,6 +594,76 @@ func (*EmptyRequest) Descriptor() ([]byte, []int) {
 	return file_proto_storage_storage_proto_rawDescGZIP(), []int{8}
 }
 
+// Страница списка: limit = 0 — список целиком (для внутренних вызовов сервисов);
+// sort — поле сортировки из допустимых для метода, пусто — сортировка метода по умолчанию
+type ListPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Desc          bool                   `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPage) Reset() {
+	*x = ListPage{}
+	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPage) ProtoMessage() {}
+
+func (x *ListPage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPage.ProtoReflect.Descriptor instead.
+func (*ListPage) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListPage) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPage) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListPage) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListPage) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
 type Specialization struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -604,7 +674,7 @@ type Specialization struct {
 
 func (x *Specialization) Reset() {
 	*x = Specialization{}
-	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	mi := &file_proto_storage_storage_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -616,7 +686,7 @@ func (x *Specialization) String() string {
 func (*Specialization) ProtoMessage() {}
 
 func (x *Specialization) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[9]
+	mi := &file_proto_storage_storage_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +699,7 @@ func (x *Specialization) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Specialization.ProtoReflect.Descriptor instead.
 func (*Specialization) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{9}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Specialization) GetId() int32 {
@@ -655,7 +725,7 @@ type GetAllSpecsResponse struct {
 
 func (x *GetAllSpecsResponse) Reset() {
 	*x = GetAllSpecsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[10]
+	mi := &file_proto_storage_storage_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -667,7 +737,7 @@ func (x *GetAllSpecsResponse) String() string {
 func (*GetAllSpecsResponse) ProtoMessage() {}
 
 func (x *GetAllSpecsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[10]
+	mi := &file_proto_storage_storage_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,7 +750,7 @@ func (x *GetAllSpecsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllSpecsResponse.ProtoReflect.Descriptor instead.
 func (*GetAllSpecsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{10}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetAllSpecsResponse) GetSpecs() []*Specialization {
@@ -700,7 +770,7 @@ type AddUserRoleRequest struct {
 
 func (x *AddUserRoleRequest) Reset() {
 	*x = AddUserRoleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[11]
+	mi := &file_proto_storage_storage_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -712,7 +782,7 @@ func (x *AddUserRoleRequest) String() string {
 func (*AddUserRoleRequest) ProtoMessage() {}
 
 func (x *AddUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[11]
+	mi := &file_proto_storage_storage_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,7 +795,7 @@ func (x *AddUserRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddUserRoleRequest.ProtoReflect.Descriptor instead.
 func (*AddUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{11}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AddUserRoleRequest) GetUserId() int32 {
@@ -751,7 +821,7 @@ type AddUserRoleResponse struct {
 
 func (x *AddUserRoleResponse) Reset() {
 	*x = AddUserRoleResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[12]
+	mi := &file_proto_storage_storage_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -763,7 +833,7 @@ func (x *AddUserRoleResponse) String() string {
 func (*AddUserRoleResponse) ProtoMessage() {}
 
 func (x *AddUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[12]
+	mi := &file_proto_storage_storage_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,7 +846,7 @@ func (x *AddUserRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddUserRoleResponse.ProtoReflect.Descriptor instead.
 func (*AddUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{12}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AddUserRoleResponse) GetError() string {
@@ -801,7 +871,7 @@ type WeeklyDoctorSchedule struct {
 
 func (x *WeeklyDoctorSchedule) Reset() {
 	*x = WeeklyDoctorSchedule{}
-	mi := &file_proto_storage_storage_proto_msgTypes[13]
+	mi := &file_proto_storage_storage_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -813,7 +883,7 @@ func (x *WeeklyDoctorSchedule) String() string {
 func (*WeeklyDoctorSchedule) ProtoMessage() {}
 
 func (x *WeeklyDoctorSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[13]
+	mi := &file_proto_storage_storage_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,7 +896,7 @@ func (x *WeeklyDoctorSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeeklyDoctorSchedule.ProtoReflect.Descriptor instead.
 func (*WeeklyDoctorSchedule) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{13}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *WeeklyDoctorSchedule) GetId() int32 {
@@ -887,7 +957,7 @@ type GetScheduleByDoctorIdRequest struct {
 
 func (x *GetScheduleByDoctorIdRequest) Reset() {
 	*x = GetScheduleByDoctorIdRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[14]
+	mi := &file_proto_storage_storage_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -899,7 +969,7 @@ func (x *GetScheduleByDoctorIdRequest) String() string {
 func (*GetScheduleByDoctorIdRequest) ProtoMessage() {}
 
 func (x *GetScheduleByDoctorIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[14]
+	mi := &file_proto_storage_storage_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +982,7 @@ func (x *GetScheduleByDoctorIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScheduleByDoctorIdRequest.ProtoReflect.Descriptor instead.
 func (*GetScheduleByDoctorIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{14}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetScheduleByDoctorIdRequest) GetDoctorId() int32 {
@@ -931,7 +1001,7 @@ type GetScheduleByDoctorIdResponse struct {
 
 func (x *GetScheduleByDoctorIdResponse) Reset() {
 	*x = GetScheduleByDoctorIdResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[15]
+	mi := &file_proto_storage_storage_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -943,7 +1013,7 @@ func (x *GetScheduleByDoctorIdResponse) String() string {
 func (*GetScheduleByDoctorIdResponse) ProtoMessage() {}
 
 func (x *GetScheduleByDoctorIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[15]
+	mi := &file_proto_storage_storage_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -956,7 +1026,7 @@ func (x *GetScheduleByDoctorIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetScheduleByDoctorIdResponse.ProtoReflect.Descriptor instead.
 func (*GetScheduleByDoctorIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{15}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetScheduleByDoctorIdResponse) GetDoctorSchedule() []*WeeklyDoctorSchedule {
@@ -975,7 +1045,7 @@ type GetUserByLoginRequest struct {
 
 func (x *GetUserByLoginRequest) Reset() {
 	*x = GetUserByLoginRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[16]
+	mi := &file_proto_storage_storage_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -987,7 +1057,7 @@ func (x *GetUserByLoginRequest) String() string {
 func (*GetUserByLoginRequest) ProtoMessage() {}
 
 func (x *GetUserByLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[16]
+	mi := &file_proto_storage_storage_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1070,7 @@ func (x *GetUserByLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByLoginRequest.ProtoReflect.Descriptor instead.
 func (*GetUserByLoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{16}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetUserByLoginRequest) GetLogin() string {
@@ -1015,13 +1085,14 @@ type GetUserByLoginResponse struct {
 	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Id            int32                  `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // active, deactivated (сотрудник отключён) или archived (пациент в архиве)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetUserByLoginResponse) Reset() {
 	*x = GetUserByLoginResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[17]
+	mi := &file_proto_storage_storage_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1033,7 +1104,7 @@ func (x *GetUserByLoginResponse) String() string {
 func (*GetUserByLoginResponse) ProtoMessage() {}
 
 func (x *GetUserByLoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[17]
+	mi := &file_proto_storage_storage_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,7 +1117,7 @@ func (x *GetUserByLoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByLoginResponse.ProtoReflect.Descriptor instead.
 func (*GetUserByLoginResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{17}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetUserByLoginResponse) GetLogin() string {
@@ -1070,6 +1141,13 @@ func (x *GetUserByLoginResponse) GetId() int32 {
 	return 0
 }
 
+func (x *GetUserByLoginResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type UpdateUserPasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1081,7 +1159,7 @@ type UpdateUserPasswordRequest struct {
 
 func (x *UpdateUserPasswordRequest) Reset() {
 	*x = UpdateUserPasswordRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[18]
+	mi := &file_proto_storage_storage_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1093,7 +1171,7 @@ func (x *UpdateUserPasswordRequest) String() string {
 func (*UpdateUserPasswordRequest) ProtoMessage() {}
 
 func (x *UpdateUserPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[18]
+	mi := &file_proto_storage_storage_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1106,7 +1184,7 @@ func (x *UpdateUserPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserPasswordRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{18}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateUserPasswordRequest) GetId() int32 {
@@ -1139,7 +1217,7 @@ type DefaultResponse struct {
 
 func (x *DefaultResponse) Reset() {
 	*x = DefaultResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[19]
+	mi := &file_proto_storage_storage_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1151,7 +1229,7 @@ func (x *DefaultResponse) String() string {
 func (*DefaultResponse) ProtoMessage() {}
 
 func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[19]
+	mi := &file_proto_storage_storage_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,7 +1242,7 @@ func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
 func (*DefaultResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{19}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DefaultResponse) GetError() string {
@@ -1191,7 +1269,7 @@ type Doctor struct {
 
 func (x *Doctor) Reset() {
 	*x = Doctor{}
-	mi := &file_proto_storage_storage_proto_msgTypes[20]
+	mi := &file_proto_storage_storage_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1203,7 +1281,7 @@ func (x *Doctor) String() string {
 func (*Doctor) ProtoMessage() {}
 
 func (x *Doctor) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[20]
+	mi := &file_proto_storage_storage_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,7 +1294,7 @@ func (x *Doctor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Doctor.ProtoReflect.Descriptor instead.
 func (*Doctor) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{20}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Doctor) GetUserId() int32 {
@@ -1299,7 +1377,7 @@ type UpdateDoctorRequest struct {
 
 func (x *UpdateDoctorRequest) Reset() {
 	*x = UpdateDoctorRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[21]
+	mi := &file_proto_storage_storage_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1311,7 +1389,7 @@ func (x *UpdateDoctorRequest) String() string {
 func (*UpdateDoctorRequest) ProtoMessage() {}
 
 func (x *UpdateDoctorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[21]
+	mi := &file_proto_storage_storage_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1324,7 +1402,7 @@ func (x *UpdateDoctorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDoctorRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDoctorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{21}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateDoctorRequest) GetUserId() int32 {
@@ -1400,7 +1478,7 @@ type AddDoctorSpecRequest struct {
 
 func (x *AddDoctorSpecRequest) Reset() {
 	*x = AddDoctorSpecRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[22]
+	mi := &file_proto_storage_storage_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1412,7 +1490,7 @@ func (x *AddDoctorSpecRequest) String() string {
 func (*AddDoctorSpecRequest) ProtoMessage() {}
 
 func (x *AddDoctorSpecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[22]
+	mi := &file_proto_storage_storage_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1425,7 +1503,7 @@ func (x *AddDoctorSpecRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDoctorSpecRequest.ProtoReflect.Descriptor instead.
 func (*AddDoctorSpecRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{22}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AddDoctorSpecRequest) GetDoctorId() int32 {
@@ -1452,7 +1530,7 @@ type DeleteDoctorSpecRequest struct {
 
 func (x *DeleteDoctorSpecRequest) Reset() {
 	*x = DeleteDoctorSpecRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[23]
+	mi := &file_proto_storage_storage_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1464,7 +1542,7 @@ func (x *DeleteDoctorSpecRequest) String() string {
 func (*DeleteDoctorSpecRequest) ProtoMessage() {}
 
 func (x *DeleteDoctorSpecRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[23]
+	mi := &file_proto_storage_storage_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,7 +1555,7 @@ func (x *DeleteDoctorSpecRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDoctorSpecRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDoctorSpecRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{23}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DeleteDoctorSpecRequest) GetDoctorId() int32 {
@@ -1494,16 +1572,78 @@ func (x *DeleteDoctorSpecRequest) GetSpecId() int32 {
 	return 0
 }
 
+// sort: name (по умолчанию), experience
+type GetDoctorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // поиск по началам слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только отключённые, для восстановления; без него — только работающие
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoctorsRequest) Reset() {
+	*x = GetDoctorsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoctorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoctorsRequest) ProtoMessage() {}
+
+func (x *GetDoctorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoctorsRequest.ProtoReflect.Descriptor instead.
+func (*GetDoctorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetDoctorsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetDoctorsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetDoctorsRequest) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
 type GetDoctorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Doctors       []*Doctor              `protobuf:"bytes,1,rep,name=doctors,proto3" json:"doctors,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных врачей без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetDoctorsResponse) Reset() {
 	*x = GetDoctorsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[24]
+	mi := &file_proto_storage_storage_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1515,7 +1655,7 @@ func (x *GetDoctorsResponse) String() string {
 func (*GetDoctorsResponse) ProtoMessage() {}
 
 func (x *GetDoctorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[24]
+	mi := &file_proto_storage_storage_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,7 +1668,7 @@ func (x *GetDoctorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorsResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{24}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetDoctorsResponse) GetDoctors() []*Doctor {
@@ -1538,6 +1678,13 @@ func (x *GetDoctorsResponse) GetDoctors() []*Doctor {
 	return nil
 }
 
+func (x *GetDoctorsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type GetDoctorSpecsByDoctorIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Specs         []int32                `protobuf:"varint,1,rep,packed,name=specs,proto3" json:"specs,omitempty"`
@@ -1547,7 +1694,7 @@ type GetDoctorSpecsByDoctorIdResponse struct {
 
 func (x *GetDoctorSpecsByDoctorIdResponse) Reset() {
 	*x = GetDoctorSpecsByDoctorIdResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	mi := &file_proto_storage_storage_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1559,7 +1706,7 @@ func (x *GetDoctorSpecsByDoctorIdResponse) String() string {
 func (*GetDoctorSpecsByDoctorIdResponse) ProtoMessage() {}
 
 func (x *GetDoctorSpecsByDoctorIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[25]
+	mi := &file_proto_storage_storage_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1572,7 +1719,7 @@ func (x *GetDoctorSpecsByDoctorIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorSpecsByDoctorIdResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorSpecsByDoctorIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{25}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetDoctorSpecsByDoctorIdResponse) GetSpecs() []int32 {
@@ -1597,7 +1744,7 @@ type Admin struct {
 
 func (x *Admin) Reset() {
 	*x = Admin{}
-	mi := &file_proto_storage_storage_proto_msgTypes[26]
+	mi := &file_proto_storage_storage_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1609,7 +1756,7 @@ func (x *Admin) String() string {
 func (*Admin) ProtoMessage() {}
 
 func (x *Admin) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[26]
+	mi := &file_proto_storage_storage_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1622,7 +1769,7 @@ func (x *Admin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Admin.ProtoReflect.Descriptor instead.
 func (*Admin) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{26}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *Admin) GetUserId() int32 {
@@ -1689,7 +1836,7 @@ type UpdateAdminRequest struct {
 
 func (x *UpdateAdminRequest) Reset() {
 	*x = UpdateAdminRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[27]
+	mi := &file_proto_storage_storage_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1701,7 +1848,7 @@ func (x *UpdateAdminRequest) String() string {
 func (*UpdateAdminRequest) ProtoMessage() {}
 
 func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[27]
+	mi := &file_proto_storage_storage_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,7 +1861,7 @@ func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAdminRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{27}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *UpdateAdminRequest) GetUserId() int32 {
@@ -1776,7 +1923,7 @@ type UpdateAdminRoleRequest struct {
 
 func (x *UpdateAdminRoleRequest) Reset() {
 	*x = UpdateAdminRoleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[28]
+	mi := &file_proto_storage_storage_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1788,7 +1935,7 @@ func (x *UpdateAdminRoleRequest) String() string {
 func (*UpdateAdminRoleRequest) ProtoMessage() {}
 
 func (x *UpdateAdminRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[28]
+	mi := &file_proto_storage_storage_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1801,7 +1948,7 @@ func (x *UpdateAdminRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAdminRoleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAdminRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{28}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *UpdateAdminRoleRequest) GetUserId() int32 {
@@ -1818,16 +1965,78 @@ func (x *UpdateAdminRoleRequest) GetRoleId() int32 {
 	return 0
 }
 
+// sort: name (по умолчанию)
+type GetAdminsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // поиск по началам слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только отключённые, для восстановления; без него — только работающие
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdminsRequest) Reset() {
+	*x = GetAdminsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminsRequest) ProtoMessage() {}
+
+func (x *GetAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminsRequest.ProtoReflect.Descriptor instead.
+func (*GetAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAdminsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetAdminsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *GetAdminsRequest) GetInactive() bool {
+	if x != nil {
+		return x.Inactive
+	}
+	return false
+}
+
 type GetAdminsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Admins        []*Admin               `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных администраторов без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAdminsResponse) Reset() {
 	*x = GetAdminsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[29]
+	mi := &file_proto_storage_storage_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1839,7 +2048,7 @@ func (x *GetAdminsResponse) String() string {
 func (*GetAdminsResponse) ProtoMessage() {}
 
 func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[29]
+	mi := &file_proto_storage_storage_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1852,7 +2061,7 @@ func (x *GetAdminsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAdminsResponse.ProtoReflect.Descriptor instead.
 func (*GetAdminsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{29}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GetAdminsResponse) GetAdmins() []*Admin {
@@ -1862,6 +2071,13 @@ func (x *GetAdminsResponse) GetAdmins() []*Admin {
 	return nil
 }
 
+func (x *GetAdminsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type Patient struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -1878,7 +2094,7 @@ type Patient struct {
 
 func (x *Patient) Reset() {
 	*x = Patient{}
-	mi := &file_proto_storage_storage_proto_msgTypes[30]
+	mi := &file_proto_storage_storage_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1890,7 +2106,7 @@ func (x *Patient) String() string {
 func (*Patient) ProtoMessage() {}
 
 func (x *Patient) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[30]
+	mi := &file_proto_storage_storage_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,7 +2119,7 @@ func (x *Patient) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Patient.ProtoReflect.Descriptor instead.
 func (*Patient) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{30}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *Patient) GetUserId() int32 {
@@ -1978,7 +2194,7 @@ type UpdatePatientRequest struct {
 
 func (x *UpdatePatientRequest) Reset() {
 	*x = UpdatePatientRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[31]
+	mi := &file_proto_storage_storage_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1990,7 +2206,7 @@ func (x *UpdatePatientRequest) String() string {
 func (*UpdatePatientRequest) ProtoMessage() {}
 
 func (x *UpdatePatientRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[31]
+	mi := &file_proto_storage_storage_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2003,7 +2219,7 @@ func (x *UpdatePatientRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePatientRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePatientRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{31}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *UpdatePatientRequest) GetUserId() int32 {
@@ -2020,58 +2236,120 @@ func (x *UpdatePatientRequest) GetFirstName() string {
 	return ""
 }
 
-func (x *UpdatePatientRequest) GetSurname() string {
-	if x != nil {
-		return x.Surname
-	}
-	return ""
+func (x *UpdatePatientRequest) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetSecondName() string {
+	if x != nil {
+		return x.SecondName
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetBirthDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BirthDate
+	}
+	return nil
+}
+
+func (x *UpdatePatientRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UpdatePatientRequest) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+// sort: name (по умолчанию), birth_date
+type GetPatientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`        // дата рождения (02.01.2006 или 2006-01-02), номер телефона или начала слов ФИО
+	Inactive      bool                   `protobuf:"varint,3,opt,name=inactive,proto3" json:"inactive,omitempty"` // только архивные, для восстановления; без него — только пациенты в работе
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientsRequest) Reset() {
+	*x = GetPatientsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdatePatientRequest) GetSecondName() string {
+func (*GetPatientsRequest) ProtoMessage() {}
+
+func (x *GetPatientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[35]
 	if x != nil {
-		return x.SecondName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdatePatientRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use GetPatientsRequest.ProtoReflect.Descriptor instead.
+func (*GetPatientsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *UpdatePatientRequest) GetBirthDate() *timestamppb.Timestamp {
+func (x *GetPatientsRequest) GetPage() *ListPage {
 	if x != nil {
-		return x.BirthDate
+		return x.Page
 	}
 	return nil
 }
 
-func (x *UpdatePatientRequest) GetPhoneNumber() string {
+func (x *GetPatientsRequest) GetQuery() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Query
 	}
 	return ""
 }
 
-func (x *UpdatePatientRequest) GetGender() string {
+func (x *GetPatientsRequest) GetInactive() bool {
 	if x != nil {
-		return x.Gender
+		return x.Inactive
 	}
-	return ""
+	return false
 }
 
 type GetPatientsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Patients      []*Patient             `protobuf:"bytes,1,rep,name=patients,proto3" json:"patients,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных пациентов без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPatientsResponse) Reset() {
 	*x = GetPatientsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[32]
+	mi := &file_proto_storage_storage_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2083,7 +2361,7 @@ func (x *GetPatientsResponse) String() string {
 func (*GetPatientsResponse) ProtoMessage() {}
 
 func (x *GetPatientsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[32]
+	mi := &file_proto_storage_storage_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2096,7 +2374,7 @@ func (x *GetPatientsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientsResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{32}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetPatientsResponse) GetPatients() []*Patient {
@@ -2106,6 +2384,13 @@ func (x *GetPatientsResponse) GetPatients() []*Patient {
 	return nil
 }
 
+func (x *GetPatientsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type WeeklyClinicSchedule struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Id                  int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2120,7 +2405,7 @@ type WeeklyClinicSchedule struct {
 
 func (x *WeeklyClinicSchedule) Reset() {
 	*x = WeeklyClinicSchedule{}
-	mi := &file_proto_storage_storage_proto_msgTypes[33]
+	mi := &file_proto_storage_storage_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2132,7 +2417,7 @@ func (x *WeeklyClinicSchedule) String() string {
 func (*WeeklyClinicSchedule) ProtoMessage() {}
 
 func (x *WeeklyClinicSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[33]
+	mi := &file_proto_storage_storage_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2145,7 +2430,7 @@ func (x *WeeklyClinicSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WeeklyClinicSchedule.ProtoReflect.Descriptor instead.
 func (*WeeklyClinicSchedule) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{33}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *WeeklyClinicSchedule) GetId() int32 {
@@ -2199,7 +2484,7 @@ type GetClinicWeeklyScheduleResponse struct {
 
 func (x *GetClinicWeeklyScheduleResponse) Reset() {
 	*x = GetClinicWeeklyScheduleResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[34]
+	mi := &file_proto_storage_storage_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2211,7 +2496,7 @@ func (x *GetClinicWeeklyScheduleResponse) String() string {
 func (*GetClinicWeeklyScheduleResponse) ProtoMessage() {}
 
 func (x *GetClinicWeeklyScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[34]
+	mi := &file_proto_storage_storage_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +2509,7 @@ func (x *GetClinicWeeklyScheduleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClinicWeeklyScheduleResponse.ProtoReflect.Descriptor instead.
 func (*GetClinicWeeklyScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{34}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetClinicWeeklyScheduleResponse) GetClinicSchedule() []*WeeklyClinicSchedule {
@@ -2243,7 +2528,7 @@ type GetUserRoleRequest struct {
 
 func (x *GetUserRoleRequest) Reset() {
 	*x = GetUserRoleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[35]
+	mi := &file_proto_storage_storage_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2255,7 +2540,7 @@ func (x *GetUserRoleRequest) String() string {
 func (*GetUserRoleRequest) ProtoMessage() {}
 
 func (x *GetUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[35]
+	mi := &file_proto_storage_storage_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2268,7 +2553,7 @@ func (x *GetUserRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRoleRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{35}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetUserRoleRequest) GetUserId() int32 {
@@ -2287,7 +2572,7 @@ type GetUserRoleResponse struct {
 
 func (x *GetUserRoleResponse) Reset() {
 	*x = GetUserRoleResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[36]
+	mi := &file_proto_storage_storage_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2299,7 +2584,7 @@ func (x *GetUserRoleResponse) String() string {
 func (*GetUserRoleResponse) ProtoMessage() {}
 
 func (x *GetUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[36]
+	mi := &file_proto_storage_storage_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2312,7 +2597,7 @@ func (x *GetUserRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRoleResponse.ProtoReflect.Descriptor instead.
 func (*GetUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{36}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetUserRoleResponse) GetRole() int32 {
@@ -2331,7 +2616,7 @@ type UpdateClinicWeeklyScheduleRequest struct {
 
 func (x *UpdateClinicWeeklyScheduleRequest) Reset() {
 	*x = UpdateClinicWeeklyScheduleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[37]
+	mi := &file_proto_storage_storage_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2343,7 +2628,7 @@ func (x *UpdateClinicWeeklyScheduleRequest) String() string {
 func (*UpdateClinicWeeklyScheduleRequest) ProtoMessage() {}
 
 func (x *UpdateClinicWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[37]
+	mi := &file_proto_storage_storage_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2356,7 +2641,7 @@ func (x *UpdateClinicWeeklyScheduleRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateClinicWeeklyScheduleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateClinicWeeklyScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{37}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *UpdateClinicWeeklyScheduleRequest) GetClinicSchedule() []*WeeklyClinicSchedule {
@@ -2375,7 +2660,7 @@ type AddDoctorWeeklyScheduleRequest struct {
 
 func (x *AddDoctorWeeklyScheduleRequest) Reset() {
 	*x = AddDoctorWeeklyScheduleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[38]
+	mi := &file_proto_storage_storage_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2387,7 +2672,7 @@ func (x *AddDoctorWeeklyScheduleRequest) String() string {
 func (*AddDoctorWeeklyScheduleRequest) ProtoMessage() {}
 
 func (x *AddDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[38]
+	mi := &file_proto_storage_storage_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2400,7 +2685,7 @@ func (x *AddDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDoctorWeeklyScheduleRequest.ProtoReflect.Descriptor instead.
 func (*AddDoctorWeeklyScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{38}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AddDoctorWeeklyScheduleRequest) GetDoctorSchedule() []*WeeklyDoctorSchedule {
@@ -2419,7 +2704,7 @@ type UpdateDoctorWeeklyScheduleRequest struct {
 
 func (x *UpdateDoctorWeeklyScheduleRequest) Reset() {
 	*x = UpdateDoctorWeeklyScheduleRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[39]
+	mi := &file_proto_storage_storage_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2431,7 +2716,7 @@ func (x *UpdateDoctorWeeklyScheduleRequest) String() string {
 func (*UpdateDoctorWeeklyScheduleRequest) ProtoMessage() {}
 
 func (x *UpdateDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[39]
+	mi := &file_proto_storage_storage_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2444,7 +2729,7 @@ func (x *UpdateDoctorWeeklyScheduleRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UpdateDoctorWeeklyScheduleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateDoctorWeeklyScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{39}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *UpdateDoctorWeeklyScheduleRequest) GetDoctorSchedule() []*WeeklyDoctorSchedule {
@@ -2464,7 +2749,7 @@ type GetRolePermissionRequest struct {
 
 func (x *GetRolePermissionRequest) Reset() {
 	*x = GetRolePermissionRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[40]
+	mi := &file_proto_storage_storage_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2476,7 +2761,7 @@ func (x *GetRolePermissionRequest) String() string {
 func (*GetRolePermissionRequest) ProtoMessage() {}
 
 func (x *GetRolePermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[40]
+	mi := &file_proto_storage_storage_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2489,7 +2774,7 @@ func (x *GetRolePermissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRolePermissionRequest.ProtoReflect.Descriptor instead.
 func (*GetRolePermissionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{40}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetRolePermissionRequest) GetRoleId() int32 {
@@ -2519,7 +2804,7 @@ type AddClinicDailyOverrideRequest struct {
 
 func (x *AddClinicDailyOverrideRequest) Reset() {
 	*x = AddClinicDailyOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[41]
+	mi := &file_proto_storage_storage_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2531,7 +2816,7 @@ func (x *AddClinicDailyOverrideRequest) String() string {
 func (*AddClinicDailyOverrideRequest) ProtoMessage() {}
 
 func (x *AddClinicDailyOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[41]
+	mi := &file_proto_storage_storage_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,7 +2829,7 @@ func (x *AddClinicDailyOverrideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddClinicDailyOverrideRequest.ProtoReflect.Descriptor instead.
 func (*AddClinicDailyOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{41}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *AddClinicDailyOverrideRequest) GetDate() *timestamppb.Timestamp {
@@ -2596,7 +2881,7 @@ type AddDoctorDailyOverrideRequest struct {
 
 func (x *AddDoctorDailyOverrideRequest) Reset() {
 	*x = AddDoctorDailyOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[42]
+	mi := &file_proto_storage_storage_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2608,7 +2893,7 @@ func (x *AddDoctorDailyOverrideRequest) String() string {
 func (*AddDoctorDailyOverrideRequest) ProtoMessage() {}
 
 func (x *AddDoctorDailyOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[42]
+	mi := &file_proto_storage_storage_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2621,7 +2906,7 @@ func (x *AddDoctorDailyOverrideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddDoctorDailyOverrideRequest.ProtoReflect.Descriptor instead.
 func (*AddDoctorDailyOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{42}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *AddDoctorDailyOverrideRequest) GetDoctorId() int32 {
@@ -2675,7 +2960,7 @@ type GetDoctorBySpecIDRequest struct {
 
 func (x *GetDoctorBySpecIDRequest) Reset() {
 	*x = GetDoctorBySpecIDRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[43]
+	mi := &file_proto_storage_storage_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2687,7 +2972,7 @@ func (x *GetDoctorBySpecIDRequest) String() string {
 func (*GetDoctorBySpecIDRequest) ProtoMessage() {}
 
 func (x *GetDoctorBySpecIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[43]
+	mi := &file_proto_storage_storage_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2700,7 +2985,7 @@ func (x *GetDoctorBySpecIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorBySpecIDRequest.ProtoReflect.Descriptor instead.
 func (*GetDoctorBySpecIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{43}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetDoctorBySpecIDRequest) GetSpecId() int32 {
@@ -2719,7 +3004,7 @@ type GetAppointmentsByDoctorIDRequest struct {
 
 func (x *GetAppointmentsByDoctorIDRequest) Reset() {
 	*x = GetAppointmentsByDoctorIDRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[44]
+	mi := &file_proto_storage_storage_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2731,7 +3016,7 @@ func (x *GetAppointmentsByDoctorIDRequest) String() string {
 func (*GetAppointmentsByDoctorIDRequest) ProtoMessage() {}
 
 func (x *GetAppointmentsByDoctorIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[44]
+	mi := &file_proto_storage_storage_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2744,7 +3029,7 @@ func (x *GetAppointmentsByDoctorIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppointmentsByDoctorIDRequest.ProtoReflect.Descriptor instead.
 func (*GetAppointmentsByDoctorIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{44}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetAppointmentsByDoctorIDRequest) GetDoctorId() int32 {
@@ -2776,7 +3061,7 @@ type Appointment struct {
 
 func (x *Appointment) Reset() {
 	*x = Appointment{}
-	mi := &file_proto_storage_storage_proto_msgTypes[45]
+	mi := &file_proto_storage_storage_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2788,7 +3073,7 @@ func (x *Appointment) String() string {
 func (*Appointment) ProtoMessage() {}
 
 func (x *Appointment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[45]
+	mi := &file_proto_storage_storage_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2801,7 +3086,7 @@ func (x *Appointment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Appointment.ProtoReflect.Descriptor instead.
 func (*Appointment) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{45}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *Appointment) GetId() int32 {
@@ -2911,7 +3196,7 @@ type GetAppointmentsByDoctorIDResponse struct {
 
 func (x *GetAppointmentsByDoctorIDResponse) Reset() {
 	*x = GetAppointmentsByDoctorIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[46]
+	mi := &file_proto_storage_storage_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2923,7 +3208,7 @@ func (x *GetAppointmentsByDoctorIDResponse) String() string {
 func (*GetAppointmentsByDoctorIDResponse) ProtoMessage() {}
 
 func (x *GetAppointmentsByDoctorIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[46]
+	mi := &file_proto_storage_storage_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2936,7 +3221,7 @@ func (x *GetAppointmentsByDoctorIDResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAppointmentsByDoctorIDResponse.ProtoReflect.Descriptor instead.
 func (*GetAppointmentsByDoctorIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{46}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetAppointmentsByDoctorIDResponse) GetAppointments() []*Appointment {
@@ -2955,7 +3240,7 @@ type GetByIDRequest struct {
 
 func (x *GetByIDRequest) Reset() {
 	*x = GetByIDRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[47]
+	mi := &file_proto_storage_storage_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2967,7 +3252,7 @@ func (x *GetByIDRequest) String() string {
 func (*GetByIDRequest) ProtoMessage() {}
 
 func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[47]
+	mi := &file_proto_storage_storage_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2980,7 +3265,7 @@ func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{47}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetByIDRequest) GetId() int32 {
@@ -2999,7 +3284,7 @@ type GetPatientByIDResponse struct {
 
 func (x *GetPatientByIDResponse) Reset() {
 	*x = GetPatientByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[48]
+	mi := &file_proto_storage_storage_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3011,7 +3296,7 @@ func (x *GetPatientByIDResponse) String() string {
 func (*GetPatientByIDResponse) ProtoMessage() {}
 
 func (x *GetPatientByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[48]
+	mi := &file_proto_storage_storage_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3024,7 +3309,7 @@ func (x *GetPatientByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{48}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetPatientByIDResponse) GetPatient() *Patient {
@@ -3043,7 +3328,7 @@ type AddAppointmentRequest struct {
 
 func (x *AddAppointmentRequest) Reset() {
 	*x = AddAppointmentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[49]
+	mi := &file_proto_storage_storage_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3055,7 +3340,7 @@ func (x *AddAppointmentRequest) String() string {
 func (*AddAppointmentRequest) ProtoMessage() {}
 
 func (x *AddAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[49]
+	mi := &file_proto_storage_storage_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3068,7 +3353,7 @@ func (x *AddAppointmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddAppointmentRequest.ProtoReflect.Descriptor instead.
 func (*AddAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{49}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *AddAppointmentRequest) GetAppointment() *Appointment {
@@ -3087,7 +3372,7 @@ type UpdateAppointmentRequest struct {
 
 func (x *UpdateAppointmentRequest) Reset() {
 	*x = UpdateAppointmentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[50]
+	mi := &file_proto_storage_storage_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3099,7 +3384,7 @@ func (x *UpdateAppointmentRequest) String() string {
 func (*UpdateAppointmentRequest) ProtoMessage() {}
 
 func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[50]
+	mi := &file_proto_storage_storage_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3112,7 +3397,7 @@ func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAppointmentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{50}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *UpdateAppointmentRequest) GetAppointment() *Appointment {
@@ -3131,7 +3416,7 @@ type GetAppointmentsByUserIDResponse struct {
 
 func (x *GetAppointmentsByUserIDResponse) Reset() {
 	*x = GetAppointmentsByUserIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[51]
+	mi := &file_proto_storage_storage_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3143,7 +3428,7 @@ func (x *GetAppointmentsByUserIDResponse) String() string {
 func (*GetAppointmentsByUserIDResponse) ProtoMessage() {}
 
 func (x *GetAppointmentsByUserIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[51]
+	mi := &file_proto_storage_storage_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3156,7 +3441,7 @@ func (x *GetAppointmentsByUserIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppointmentsByUserIDResponse.ProtoReflect.Descriptor instead.
 func (*GetAppointmentsByUserIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{51}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetAppointmentsByUserIDResponse) GetAppointment() []*Appointment {
@@ -3175,7 +3460,7 @@ type GetAppointmentByIDResponse struct {
 
 func (x *GetAppointmentByIDResponse) Reset() {
 	*x = GetAppointmentByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[52]
+	mi := &file_proto_storage_storage_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3187,7 +3472,7 @@ func (x *GetAppointmentByIDResponse) String() string {
 func (*GetAppointmentByIDResponse) ProtoMessage() {}
 
 func (x *GetAppointmentByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[52]
+	mi := &file_proto_storage_storage_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3200,7 +3485,7 @@ func (x *GetAppointmentByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppointmentByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetAppointmentByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{52}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetAppointmentByIDResponse) GetAppointment() *Appointment {
@@ -3219,7 +3504,7 @@ type GetDoctorByIDResponse struct {
 
 func (x *GetDoctorByIDResponse) Reset() {
 	*x = GetDoctorByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[53]
+	mi := &file_proto_storage_storage_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3231,7 +3516,7 @@ func (x *GetDoctorByIDResponse) String() string {
 func (*GetDoctorByIDResponse) ProtoMessage() {}
 
 func (x *GetDoctorByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[53]
+	mi := &file_proto_storage_storage_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3244,7 +3529,7 @@ func (x *GetDoctorByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{53}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetDoctorByIDResponse) GetDoctor() *Doctor {
@@ -3263,7 +3548,7 @@ type GetSpecsByDoctorIDResponse struct {
 
 func (x *GetSpecsByDoctorIDResponse) Reset() {
 	*x = GetSpecsByDoctorIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[54]
+	mi := &file_proto_storage_storage_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3275,7 +3560,7 @@ func (x *GetSpecsByDoctorIDResponse) String() string {
 func (*GetSpecsByDoctorIDResponse) ProtoMessage() {}
 
 func (x *GetSpecsByDoctorIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[54]
+	mi := &file_proto_storage_storage_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3288,7 +3573,7 @@ func (x *GetSpecsByDoctorIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSpecsByDoctorIDResponse.ProtoReflect.Descriptor instead.
 func (*GetSpecsByDoctorIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{54}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetSpecsByDoctorIDResponse) GetSpecId() []int32 {
@@ -3307,7 +3592,7 @@ type GetClinicOverrideRequest struct {
 
 func (x *GetClinicOverrideRequest) Reset() {
 	*x = GetClinicOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[55]
+	mi := &file_proto_storage_storage_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3319,7 +3604,7 @@ func (x *GetClinicOverrideRequest) String() string {
 func (*GetClinicOverrideRequest) ProtoMessage() {}
 
 func (x *GetClinicOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[55]
+	mi := &file_proto_storage_storage_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3332,7 +3617,7 @@ func (x *GetClinicOverrideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClinicOverrideRequest.ProtoReflect.Descriptor instead.
 func (*GetClinicOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{55}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetClinicOverrideRequest) GetDate() *timestamppb.Timestamp {
@@ -3354,7 +3639,7 @@ type GetClinicOverrideResponse struct {
 
 func (x *GetClinicOverrideResponse) Reset() {
 	*x = GetClinicOverrideResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[56]
+	mi := &file_proto_storage_storage_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3366,7 +3651,7 @@ func (x *GetClinicOverrideResponse) String() string {
 func (*GetClinicOverrideResponse) ProtoMessage() {}
 
 func (x *GetClinicOverrideResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[56]
+	mi := &file_proto_storage_storage_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3379,7 +3664,7 @@ func (x *GetClinicOverrideResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClinicOverrideResponse.ProtoReflect.Descriptor instead.
 func (*GetClinicOverrideResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{56}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetClinicOverrideResponse) GetDate() *timestamppb.Timestamp {
@@ -3420,7 +3705,7 @@ type GetDoctorOverrideRequest struct {
 
 func (x *GetDoctorOverrideRequest) Reset() {
 	*x = GetDoctorOverrideRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[57]
+	mi := &file_proto_storage_storage_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3432,7 +3717,7 @@ func (x *GetDoctorOverrideRequest) String() string {
 func (*GetDoctorOverrideRequest) ProtoMessage() {}
 
 func (x *GetDoctorOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[57]
+	mi := &file_proto_storage_storage_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3445,7 +3730,7 @@ func (x *GetDoctorOverrideRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorOverrideRequest.ProtoReflect.Descriptor instead.
 func (*GetDoctorOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{57}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetDoctorOverrideRequest) GetDoctorId() int32 {
@@ -3475,7 +3760,7 @@ type GetDoctorOverrideResponse struct {
 
 func (x *GetDoctorOverrideResponse) Reset() {
 	*x = GetDoctorOverrideResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[58]
+	mi := &file_proto_storage_storage_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3487,7 +3772,7 @@ func (x *GetDoctorOverrideResponse) String() string {
 func (*GetDoctorOverrideResponse) ProtoMessage() {}
 
 func (x *GetDoctorOverrideResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[58]
+	mi := &file_proto_storage_storage_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3500,7 +3785,7 @@ func (x *GetDoctorOverrideResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorOverrideResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorOverrideResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{58}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *GetDoctorOverrideResponse) GetDoctorId() int32 {
@@ -3552,7 +3837,7 @@ type DoctorOverride struct {
 
 func (x *DoctorOverride) Reset() {
 	*x = DoctorOverride{}
-	mi := &file_proto_storage_storage_proto_msgTypes[59]
+	mi := &file_proto_storage_storage_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3564,7 +3849,7 @@ func (x *DoctorOverride) String() string {
 func (*DoctorOverride) ProtoMessage() {}
 
 func (x *DoctorOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[59]
+	mi := &file_proto_storage_storage_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3577,7 +3862,7 @@ func (x *DoctorOverride) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DoctorOverride.ProtoReflect.Descriptor instead.
 func (*DoctorOverride) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{59}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *DoctorOverride) GetDoctorId() int32 {
@@ -3631,7 +3916,7 @@ type GetDoctorOverridesResponse struct {
 
 func (x *GetDoctorOverridesResponse) Reset() {
 	*x = GetDoctorOverridesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[60]
+	mi := &file_proto_storage_storage_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3643,7 +3928,7 @@ func (x *GetDoctorOverridesResponse) String() string {
 func (*GetDoctorOverridesResponse) ProtoMessage() {}
 
 func (x *GetDoctorOverridesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[60]
+	mi := &file_proto_storage_storage_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3656,7 +3941,7 @@ func (x *GetDoctorOverridesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDoctorOverridesResponse.ProtoReflect.Descriptor instead.
 func (*GetDoctorOverridesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{60}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetDoctorOverridesResponse) GetOverride() []*DoctorOverride {
@@ -3676,7 +3961,7 @@ type AddMaterialRequest struct {
 
 func (x *AddMaterialRequest) Reset() {
 	*x = AddMaterialRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[61]
+	mi := &file_proto_storage_storage_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3688,7 +3973,7 @@ func (x *AddMaterialRequest) String() string {
 func (*AddMaterialRequest) ProtoMessage() {}
 
 func (x *AddMaterialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[61]
+	mi := &file_proto_storage_storage_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3701,7 +3986,7 @@ func (x *AddMaterialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddMaterialRequest.ProtoReflect.Descriptor instead.
 func (*AddMaterialRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{61}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *AddMaterialRequest) GetName() string {
@@ -3729,7 +4014,7 @@ type AddServiceRequest struct {
 
 func (x *AddServiceRequest) Reset() {
 	*x = AddServiceRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[62]
+	mi := &file_proto_storage_storage_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3741,7 +4026,7 @@ func (x *AddServiceRequest) String() string {
 func (*AddServiceRequest) ProtoMessage() {}
 
 func (x *AddServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[62]
+	mi := &file_proto_storage_storage_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3754,7 +4039,7 @@ func (x *AddServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddServiceRequest.ProtoReflect.Descriptor instead.
 func (*AddServiceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{62}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *AddServiceRequest) GetName() string {
@@ -3789,7 +4074,7 @@ type UpdateMaterialRequest struct {
 
 func (x *UpdateMaterialRequest) Reset() {
 	*x = UpdateMaterialRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[63]
+	mi := &file_proto_storage_storage_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3801,7 +4086,7 @@ func (x *UpdateMaterialRequest) String() string {
 func (*UpdateMaterialRequest) ProtoMessage() {}
 
 func (x *UpdateMaterialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[63]
+	mi := &file_proto_storage_storage_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3814,7 +4099,7 @@ func (x *UpdateMaterialRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMaterialRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMaterialRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{63}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *UpdateMaterialRequest) GetId() int32 {
@@ -3850,7 +4135,7 @@ type UpdateServiceRequest struct {
 
 func (x *UpdateServiceRequest) Reset() {
 	*x = UpdateServiceRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[64]
+	mi := &file_proto_storage_storage_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3862,7 +4147,7 @@ func (x *UpdateServiceRequest) String() string {
 func (*UpdateServiceRequest) ProtoMessage() {}
 
 func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[64]
+	mi := &file_proto_storage_storage_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3875,7 +4160,7 @@ func (x *UpdateServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateServiceRequest.ProtoReflect.Descriptor instead.
 func (*UpdateServiceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{64}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *UpdateServiceRequest) GetId() int32 {
@@ -3917,7 +4202,7 @@ type Material struct {
 
 func (x *Material) Reset() {
 	*x = Material{}
-	mi := &file_proto_storage_storage_proto_msgTypes[65]
+	mi := &file_proto_storage_storage_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3929,7 +4214,7 @@ func (x *Material) String() string {
 func (*Material) ProtoMessage() {}
 
 func (x *Material) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[65]
+	mi := &file_proto_storage_storage_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3942,7 +4227,7 @@ func (x *Material) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Material.ProtoReflect.Descriptor instead.
 func (*Material) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{65}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *Material) GetId() int32 {
@@ -3978,7 +4263,7 @@ type Service struct {
 
 func (x *Service) Reset() {
 	*x = Service{}
-	mi := &file_proto_storage_storage_proto_msgTypes[66]
+	mi := &file_proto_storage_storage_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3990,7 +4275,7 @@ func (x *Service) String() string {
 func (*Service) ProtoMessage() {}
 
 func (x *Service) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[66]
+	mi := &file_proto_storage_storage_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4003,7 +4288,7 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Service.ProtoReflect.Descriptor instead.
 func (*Service) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{66}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *Service) GetId() int32 {
@@ -4043,7 +4328,7 @@ type GetMaterialsResponse struct {
 
 func (x *GetMaterialsResponse) Reset() {
 	*x = GetMaterialsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[67]
+	mi := &file_proto_storage_storage_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4055,7 +4340,7 @@ func (x *GetMaterialsResponse) String() string {
 func (*GetMaterialsResponse) ProtoMessage() {}
 
 func (x *GetMaterialsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[67]
+	mi := &file_proto_storage_storage_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4068,7 +4353,7 @@ func (x *GetMaterialsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMaterialsResponse.ProtoReflect.Descriptor instead.
 func (*GetMaterialsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{67}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *GetMaterialsResponse) GetMaterials() []*Material {
@@ -4087,7 +4372,7 @@ type GetServicesResponse struct {
 
 func (x *GetServicesResponse) Reset() {
 	*x = GetServicesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[68]
+	mi := &file_proto_storage_storage_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4099,7 +4384,7 @@ func (x *GetServicesResponse) String() string {
 func (*GetServicesResponse) ProtoMessage() {}
 
 func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[68]
+	mi := &file_proto_storage_storage_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4112,7 +4397,7 @@ func (x *GetServicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServicesResponse.ProtoReflect.Descriptor instead.
 func (*GetServicesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{68}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *GetServicesResponse) GetServices() []*Service {
@@ -4131,7 +4416,7 @@ type GetByNameRequest struct {
 
 func (x *GetByNameRequest) Reset() {
 	*x = GetByNameRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[69]
+	mi := &file_proto_storage_storage_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4143,7 +4428,7 @@ func (x *GetByNameRequest) String() string {
 func (*GetByNameRequest) ProtoMessage() {}
 
 func (x *GetByNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[69]
+	mi := &file_proto_storage_storage_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4156,7 +4441,7 @@ func (x *GetByNameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByNameRequest.ProtoReflect.Descriptor instead.
 func (*GetByNameRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{69}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *GetByNameRequest) GetName() string {
@@ -4175,7 +4460,7 @@ type GetByIdRequest struct {
 
 func (x *GetByIdRequest) Reset() {
 	*x = GetByIdRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[70]
+	mi := &file_proto_storage_storage_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4187,7 +4472,7 @@ func (x *GetByIdRequest) String() string {
 func (*GetByIdRequest) ProtoMessage() {}
 
 func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[70]
+	mi := &file_proto_storage_storage_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4200,7 +4485,7 @@ func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetByIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{70}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *GetByIdRequest) GetId() int32 {
@@ -4221,7 +4506,7 @@ type GetMaterialByNameResponse struct {
 
 func (x *GetMaterialByNameResponse) Reset() {
 	*x = GetMaterialByNameResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[71]
+	mi := &file_proto_storage_storage_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4233,7 +4518,7 @@ func (x *GetMaterialByNameResponse) String() string {
 func (*GetMaterialByNameResponse) ProtoMessage() {}
 
 func (x *GetMaterialByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[71]
+	mi := &file_proto_storage_storage_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4246,7 +4531,7 @@ func (x *GetMaterialByNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMaterialByNameResponse.ProtoReflect.Descriptor instead.
 func (*GetMaterialByNameResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{71}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *GetMaterialByNameResponse) GetId() int32 {
@@ -4282,7 +4567,7 @@ type GetServiceByNameResponse struct {
 
 func (x *GetServiceByNameResponse) Reset() {
 	*x = GetServiceByNameResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[72]
+	mi := &file_proto_storage_storage_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4294,7 +4579,7 @@ func (x *GetServiceByNameResponse) String() string {
 func (*GetServiceByNameResponse) ProtoMessage() {}
 
 func (x *GetServiceByNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[72]
+	mi := &file_proto_storage_storage_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4307,7 +4592,7 @@ func (x *GetServiceByNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceByNameResponse.ProtoReflect.Descriptor instead.
 func (*GetServiceByNameResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{72}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetServiceByNameResponse) GetId() int32 {
@@ -4347,7 +4632,7 @@ type DeleteRequest struct {
 
 func (x *DeleteRequest) Reset() {
 	*x = DeleteRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[73]
+	mi := &file_proto_storage_storage_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4359,7 +4644,7 @@ func (x *DeleteRequest) String() string {
 func (*DeleteRequest) ProtoMessage() {}
 
 func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[73]
+	mi := &file_proto_storage_storage_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4372,7 +4657,7 @@ func (x *DeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{73}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *DeleteRequest) GetId() int32 {
@@ -4392,7 +4677,7 @@ type ServiceType struct {
 
 func (x *ServiceType) Reset() {
 	*x = ServiceType{}
-	mi := &file_proto_storage_storage_proto_msgTypes[74]
+	mi := &file_proto_storage_storage_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4404,7 +4689,7 @@ func (x *ServiceType) String() string {
 func (*ServiceType) ProtoMessage() {}
 
 func (x *ServiceType) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[74]
+	mi := &file_proto_storage_storage_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4417,7 +4702,7 @@ func (x *ServiceType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServiceType.ProtoReflect.Descriptor instead.
 func (*ServiceType) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{74}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *ServiceType) GetId() int32 {
@@ -4443,7 +4728,7 @@ type GetServicesTypesResponse struct {
 
 func (x *GetServicesTypesResponse) Reset() {
 	*x = GetServicesTypesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[75]
+	mi := &file_proto_storage_storage_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4455,7 +4740,7 @@ func (x *GetServicesTypesResponse) String() string {
 func (*GetServicesTypesResponse) ProtoMessage() {}
 
 func (x *GetServicesTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[75]
+	mi := &file_proto_storage_storage_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4468,7 +4753,7 @@ func (x *GetServicesTypesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServicesTypesResponse.ProtoReflect.Descriptor instead.
 func (*GetServicesTypesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{75}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *GetServicesTypesResponse) GetTypes() []*ServiceType {
@@ -4487,7 +4772,7 @@ type GetServiceTypeByIdRequest struct {
 
 func (x *GetServiceTypeByIdRequest) Reset() {
 	*x = GetServiceTypeByIdRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[76]
+	mi := &file_proto_storage_storage_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4499,7 +4784,7 @@ func (x *GetServiceTypeByIdRequest) String() string {
 func (*GetServiceTypeByIdRequest) ProtoMessage() {}
 
 func (x *GetServiceTypeByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[76]
+	mi := &file_proto_storage_storage_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4512,7 +4797,7 @@ func (x *GetServiceTypeByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceTypeByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetServiceTypeByIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{76}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *GetServiceTypeByIdRequest) GetId() int32 {
@@ -4532,7 +4817,7 @@ type GetServiceTypeByIdResponse struct {
 
 func (x *GetServiceTypeByIdResponse) Reset() {
 	*x = GetServiceTypeByIdResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[77]
+	mi := &file_proto_storage_storage_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4544,7 +4829,7 @@ func (x *GetServiceTypeByIdResponse) String() string {
 func (*GetServiceTypeByIdResponse) ProtoMessage() {}
 
 func (x *GetServiceTypeByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[77]
+	mi := &file_proto_storage_storage_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4557,7 +4842,7 @@ func (x *GetServiceTypeByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceTypeByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetServiceTypeByIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{77}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *GetServiceTypeByIdResponse) GetId() int32 {
@@ -4584,7 +4869,7 @@ type UpdateUserLoginRequest struct {
 
 func (x *UpdateUserLoginRequest) Reset() {
 	*x = UpdateUserLoginRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[78]
+	mi := &file_proto_storage_storage_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4596,7 +4881,7 @@ func (x *UpdateUserLoginRequest) String() string {
 func (*UpdateUserLoginRequest) ProtoMessage() {}
 
 func (x *UpdateUserLoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[78]
+	mi := &file_proto_storage_storage_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4609,7 +4894,7 @@ func (x *UpdateUserLoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserLoginRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserLoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{78}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *UpdateUserLoginRequest) GetUserId() int32 {
@@ -4637,7 +4922,7 @@ type ICDCode struct {
 
 func (x *ICDCode) Reset() {
 	*x = ICDCode{}
-	mi := &file_proto_storage_storage_proto_msgTypes[79]
+	mi := &file_proto_storage_storage_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4649,7 +4934,7 @@ func (x *ICDCode) String() string {
 func (*ICDCode) ProtoMessage() {}
 
 func (x *ICDCode) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[79]
+	mi := &file_proto_storage_storage_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4662,7 +4947,7 @@ func (x *ICDCode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ICDCode.ProtoReflect.Descriptor instead.
 func (*ICDCode) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{79}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *ICDCode) GetId() int32 {
@@ -4698,7 +4983,7 @@ type Diagnose struct {
 
 func (x *Diagnose) Reset() {
 	*x = Diagnose{}
-	mi := &file_proto_storage_storage_proto_msgTypes[80]
+	mi := &file_proto_storage_storage_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4710,7 +4995,7 @@ func (x *Diagnose) String() string {
 func (*Diagnose) ProtoMessage() {}
 
 func (x *Diagnose) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[80]
+	mi := &file_proto_storage_storage_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4723,7 +5008,7 @@ func (x *Diagnose) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Diagnose.ProtoReflect.Descriptor instead.
 func (*Diagnose) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{80}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *Diagnose) GetId() int32 {
@@ -4769,7 +5054,7 @@ type Visit struct {
 
 func (x *Visit) Reset() {
 	*x = Visit{}
-	mi := &file_proto_storage_storage_proto_msgTypes[81]
+	mi := &file_proto_storage_storage_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4781,7 +5066,7 @@ func (x *Visit) String() string {
 func (*Visit) ProtoMessage() {}
 
 func (x *Visit) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[81]
+	mi := &file_proto_storage_storage_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4794,7 +5079,7 @@ func (x *Visit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Visit.ProtoReflect.Descriptor instead.
 func (*Visit) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{81}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *Visit) GetId() int32 {
@@ -4858,7 +5143,7 @@ type PatientAllergiesChronics struct {
 
 func (x *PatientAllergiesChronics) Reset() {
 	*x = PatientAllergiesChronics{}
-	mi := &file_proto_storage_storage_proto_msgTypes[82]
+	mi := &file_proto_storage_storage_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4870,7 +5155,7 @@ func (x *PatientAllergiesChronics) String() string {
 func (*PatientAllergiesChronics) ProtoMessage() {}
 
 func (x *PatientAllergiesChronics) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[82]
+	mi := &file_proto_storage_storage_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4883,7 +5168,7 @@ func (x *PatientAllergiesChronics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatientAllergiesChronics.ProtoReflect.Descriptor instead.
 func (*PatientAllergiesChronics) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{82}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *PatientAllergiesChronics) GetId() int32 {
@@ -4925,7 +5210,7 @@ type AddVisitMaterials struct {
 
 func (x *AddVisitMaterials) Reset() {
 	*x = AddVisitMaterials{}
-	mi := &file_proto_storage_storage_proto_msgTypes[83]
+	mi := &file_proto_storage_storage_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4937,7 +5222,7 @@ func (x *AddVisitMaterials) String() string {
 func (*AddVisitMaterials) ProtoMessage() {}
 
 func (x *AddVisitMaterials) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[83]
+	mi := &file_proto_storage_storage_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4950,7 +5235,7 @@ func (x *AddVisitMaterials) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddVisitMaterials.ProtoReflect.Descriptor instead.
 func (*AddVisitMaterials) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{83}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *AddVisitMaterials) GetVisitId() int32 {
@@ -4983,7 +5268,7 @@ type AddVisitMaterialsRequest struct {
 
 func (x *AddVisitMaterialsRequest) Reset() {
 	*x = AddVisitMaterialsRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[84]
+	mi := &file_proto_storage_storage_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4995,7 +5280,7 @@ func (x *AddVisitMaterialsRequest) String() string {
 func (*AddVisitMaterialsRequest) ProtoMessage() {}
 
 func (x *AddVisitMaterialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[84]
+	mi := &file_proto_storage_storage_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5008,7 +5293,7 @@ func (x *AddVisitMaterialsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddVisitMaterialsRequest.ProtoReflect.Descriptor instead.
 func (*AddVisitMaterialsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{84}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *AddVisitMaterialsRequest) GetMaterials() []*AddVisitMaterials {
@@ -5029,7 +5314,7 @@ type AddVisitServices struct {
 
 func (x *AddVisitServices) Reset() {
 	*x = AddVisitServices{}
-	mi := &file_proto_storage_storage_proto_msgTypes[85]
+	mi := &file_proto_storage_storage_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5041,7 +5326,7 @@ func (x *AddVisitServices) String() string {
 func (*AddVisitServices) ProtoMessage() {}
 
 func (x *AddVisitServices) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[85]
+	mi := &file_proto_storage_storage_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5054,7 +5339,7 @@ func (x *AddVisitServices) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddVisitServices.ProtoReflect.Descriptor instead.
 func (*AddVisitServices) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{85}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *AddVisitServices) GetVisitId() int32 {
@@ -5087,7 +5372,7 @@ type AddVisitServicesRequest struct {
 
 func (x *AddVisitServicesRequest) Reset() {
 	*x = AddVisitServicesRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[86]
+	mi := &file_proto_storage_storage_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5099,7 +5384,7 @@ func (x *AddVisitServicesRequest) String() string {
 func (*AddVisitServicesRequest) ProtoMessage() {}
 
 func (x *AddVisitServicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[86]
+	mi := &file_proto_storage_storage_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5112,7 +5397,7 @@ func (x *AddVisitServicesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddVisitServicesRequest.ProtoReflect.Descriptor instead.
 func (*AddVisitServicesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{86}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *AddVisitServicesRequest) GetServices() []*AddVisitServices {
@@ -5131,7 +5416,7 @@ type AddPatientAllergiesChronicsRequest struct {
 
 func (x *AddPatientAllergiesChronicsRequest) Reset() {
 	*x = AddPatientAllergiesChronicsRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[87]
+	mi := &file_proto_storage_storage_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5143,7 +5428,7 @@ func (x *AddPatientAllergiesChronicsRequest) String() string {
 func (*AddPatientAllergiesChronicsRequest) ProtoMessage() {}
 
 func (x *AddPatientAllergiesChronicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[87]
+	mi := &file_proto_storage_storage_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5156,7 +5441,7 @@ func (x *AddPatientAllergiesChronicsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AddPatientAllergiesChronicsRequest.ProtoReflect.Descriptor instead.
 func (*AddPatientAllergiesChronicsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{87}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *AddPatientAllergiesChronicsRequest) GetNotes() []*PatientAllergiesChronics {
@@ -5180,7 +5465,7 @@ type AddPatientVisitRequest struct {
 
 func (x *AddPatientVisitRequest) Reset() {
 	*x = AddPatientVisitRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[88]
+	mi := &file_proto_storage_storage_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5192,7 +5477,7 @@ func (x *AddPatientVisitRequest) String() string {
 func (*AddPatientVisitRequest) ProtoMessage() {}
 
 func (x *AddPatientVisitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[88]
+	mi := &file_proto_storage_storage_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5205,7 +5490,7 @@ func (x *AddPatientVisitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPatientVisitRequest.ProtoReflect.Descriptor instead.
 func (*AddPatientVisitRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{88}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *AddPatientVisitRequest) GetAppointmentId() int32 {
@@ -5259,7 +5544,7 @@ type AddPatientDiagnosesRequest struct {
 
 func (x *AddPatientDiagnosesRequest) Reset() {
 	*x = AddPatientDiagnosesRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[89]
+	mi := &file_proto_storage_storage_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5271,7 +5556,7 @@ func (x *AddPatientDiagnosesRequest) String() string {
 func (*AddPatientDiagnosesRequest) ProtoMessage() {}
 
 func (x *AddPatientDiagnosesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[89]
+	mi := &file_proto_storage_storage_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5284,7 +5569,7 @@ func (x *AddPatientDiagnosesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPatientDiagnosesRequest.ProtoReflect.Descriptor instead.
 func (*AddPatientDiagnosesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{89}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *AddPatientDiagnosesRequest) GetDiagnoses() []*Diagnose {
@@ -5303,7 +5588,7 @@ type GetPatientDiagnosesResponse struct {
 
 func (x *GetPatientDiagnosesResponse) Reset() {
 	*x = GetPatientDiagnosesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[90]
+	mi := &file_proto_storage_storage_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5315,7 +5600,7 @@ func (x *GetPatientDiagnosesResponse) String() string {
 func (*GetPatientDiagnosesResponse) ProtoMessage() {}
 
 func (x *GetPatientDiagnosesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[90]
+	mi := &file_proto_storage_storage_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5328,7 +5613,7 @@ func (x *GetPatientDiagnosesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientDiagnosesResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientDiagnosesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{90}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *GetPatientDiagnosesResponse) GetDiagnoses() []*Diagnose {
@@ -5347,7 +5632,7 @@ type GetPatientVisitsResponse struct {
 
 func (x *GetPatientVisitsResponse) Reset() {
 	*x = GetPatientVisitsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[91]
+	mi := &file_proto_storage_storage_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5359,7 +5644,7 @@ func (x *GetPatientVisitsResponse) String() string {
 func (*GetPatientVisitsResponse) ProtoMessage() {}
 
 func (x *GetPatientVisitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[91]
+	mi := &file_proto_storage_storage_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5372,7 +5657,7 @@ func (x *GetPatientVisitsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientVisitsResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientVisitsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{91}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *GetPatientVisitsResponse) GetVisits() []*Visit {
@@ -5391,7 +5676,7 @@ type GetPatientAllergiesChronicsResponse struct {
 
 func (x *GetPatientAllergiesChronicsResponse) Reset() {
 	*x = GetPatientAllergiesChronicsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[92]
+	mi := &file_proto_storage_storage_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5403,7 +5688,7 @@ func (x *GetPatientAllergiesChronicsResponse) String() string {
 func (*GetPatientAllergiesChronicsResponse) ProtoMessage() {}
 
 func (x *GetPatientAllergiesChronicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[92]
+	mi := &file_proto_storage_storage_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5416,7 +5701,7 @@ func (x *GetPatientAllergiesChronicsResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetPatientAllergiesChronicsResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientAllergiesChronicsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{92}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *GetPatientAllergiesChronicsResponse) GetPatientAllergiesChronics() []*PatientAllergiesChronics {
@@ -5426,16 +5711,70 @@ func (x *GetPatientAllergiesChronicsResponse) GetPatientAllergiesChronics() []*P
 	return nil
 }
 
+// sort: code (по умолчанию), name
+type GetICDCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"` // начало кода или часть названия
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetICDCodesRequest) Reset() {
+	*x = GetICDCodesRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetICDCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetICDCodesRequest) ProtoMessage() {}
+
+func (x *GetICDCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetICDCodesRequest.ProtoReflect.Descriptor instead.
+func (*GetICDCodesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetICDCodesRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetICDCodesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
 type GetICDCodesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IcdCode       []*ICDCode             `protobuf:"bytes,1,rep,name=icd_code,json=icdCode,proto3" json:"icd_code,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех найденных кодов без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetICDCodesResponse) Reset() {
 	*x = GetICDCodesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[93]
+	mi := &file_proto_storage_storage_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5447,7 +5786,7 @@ func (x *GetICDCodesResponse) String() string {
 func (*GetICDCodesResponse) ProtoMessage() {}
 
 func (x *GetICDCodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[93]
+	mi := &file_proto_storage_storage_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5460,7 +5799,7 @@ func (x *GetICDCodesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetICDCodesResponse.ProtoReflect.Descriptor instead.
 func (*GetICDCodesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{93}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *GetICDCodesResponse) GetIcdCode() []*ICDCode {
@@ -5470,6 +5809,13 @@ func (x *GetICDCodesResponse) GetIcdCode() []*ICDCode {
 	return nil
 }
 
+func (x *GetICDCodesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type AddVisitResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -5479,7 +5825,7 @@ type AddVisitResponse struct {
 
 func (x *AddVisitResponse) Reset() {
 	*x = AddVisitResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[94]
+	mi := &file_proto_storage_storage_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5491,7 +5837,7 @@ func (x *AddVisitResponse) String() string {
 func (*AddVisitResponse) ProtoMessage() {}
 
 func (x *AddVisitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[94]
+	mi := &file_proto_storage_storage_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5504,7 +5850,7 @@ func (x *AddVisitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddVisitResponse.ProtoReflect.Descriptor instead.
 func (*AddVisitResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{94}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *AddVisitResponse) GetId() int32 {
@@ -5525,7 +5871,7 @@ type VisitPayment struct {
 
 func (x *VisitPayment) Reset() {
 	*x = VisitPayment{}
-	mi := &file_proto_storage_storage_proto_msgTypes[95]
+	mi := &file_proto_storage_storage_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5537,7 +5883,7 @@ func (x *VisitPayment) String() string {
 func (*VisitPayment) ProtoMessage() {}
 
 func (x *VisitPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[95]
+	mi := &file_proto_storage_storage_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5550,7 +5896,7 @@ func (x *VisitPayment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VisitPayment.ProtoReflect.Descriptor instead.
 func (*VisitPayment) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{95}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *VisitPayment) GetVisitId() int32 {
@@ -5585,7 +5931,7 @@ type VisitPaymentRequest struct {
 
 func (x *VisitPaymentRequest) Reset() {
 	*x = VisitPaymentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[96]
+	mi := &file_proto_storage_storage_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5597,7 +5943,7 @@ func (x *VisitPaymentRequest) String() string {
 func (*VisitPaymentRequest) ProtoMessage() {}
 
 func (x *VisitPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[96]
+	mi := &file_proto_storage_storage_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5610,40 +5956,86 @@ func (x *VisitPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VisitPaymentRequest.ProtoReflect.Descriptor instead.
 func (*VisitPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{96}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *VisitPaymentRequest) GetVisitId() int32 {
 	if x != nil {
 		return x.VisitId
 	}
-	return 0
+	return 0
+}
+
+func (x *VisitPaymentRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *VisitPaymentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// sort: visit_id (по умолчанию)
+type GetVisitsPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVisitsPaymentsRequest) Reset() {
+	*x = GetVisitsPaymentsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVisitsPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVisitsPaymentsRequest) ProtoMessage() {}
+
+func (x *GetVisitsPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *VisitPaymentRequest) GetPrice() int32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+// Deprecated: Use GetVisitsPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*GetVisitsPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *VisitPaymentRequest) GetStatus() string {
+func (x *GetVisitsPaymentsRequest) GetPage() *ListPage {
 	if x != nil {
-		return x.Status
+		return x.Page
 	}
-	return ""
+	return nil
 }
 
 type GetVisitsPaymentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VisitPayment  []*VisitPayment        `protobuf:"bytes,1,rep,name=visit_payment,json=visitPayment,proto3" json:"visit_payment,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех неподтверждённых оплат без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetVisitsPaymentsResponse) Reset() {
 	*x = GetVisitsPaymentsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[97]
+	mi := &file_proto_storage_storage_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5655,7 +6047,7 @@ func (x *GetVisitsPaymentsResponse) String() string {
 func (*GetVisitsPaymentsResponse) ProtoMessage() {}
 
 func (x *GetVisitsPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[97]
+	mi := &file_proto_storage_storage_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5668,7 +6060,7 @@ func (x *GetVisitsPaymentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVisitsPaymentsResponse.ProtoReflect.Descriptor instead.
 func (*GetVisitsPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{97}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *GetVisitsPaymentsResponse) GetVisitPayment() []*VisitPayment {
@@ -5678,6 +6070,13 @@ func (x *GetVisitsPaymentsResponse) GetVisitPayment() []*VisitPayment {
 	return nil
 }
 
+func (x *GetVisitsPaymentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 // Запрос на подсчет суммы по визиту
 type CalculateVisitTotalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -5688,7 +6087,7 @@ type CalculateVisitTotalRequest struct {
 
 func (x *CalculateVisitTotalRequest) Reset() {
 	*x = CalculateVisitTotalRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[98]
+	mi := &file_proto_storage_storage_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5700,7 +6099,7 @@ func (x *CalculateVisitTotalRequest) String() string {
 func (*CalculateVisitTotalRequest) ProtoMessage() {}
 
 func (x *CalculateVisitTotalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[98]
+	mi := &file_proto_storage_storage_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5713,7 +6112,7 @@ func (x *CalculateVisitTotalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateVisitTotalRequest.ProtoReflect.Descriptor instead.
 func (*CalculateVisitTotalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{98}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *CalculateVisitTotalRequest) GetVisitId() int32 {
@@ -5733,7 +6132,7 @@ type CalculateVisitTotalResponse struct {
 
 func (x *CalculateVisitTotalResponse) Reset() {
 	*x = CalculateVisitTotalResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[99]
+	mi := &file_proto_storage_storage_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5745,7 +6144,7 @@ func (x *CalculateVisitTotalResponse) String() string {
 func (*CalculateVisitTotalResponse) ProtoMessage() {}
 
 func (x *CalculateVisitTotalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[99]
+	mi := &file_proto_storage_storage_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5758,7 +6157,7 @@ func (x *CalculateVisitTotalResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateVisitTotalResponse.ProtoReflect.Descriptor instead.
 func (*CalculateVisitTotalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{99}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *CalculateVisitTotalResponse) GetTotal() int32 {
@@ -5778,7 +6177,7 @@ type AddOrUpdateVisitPaymentRequest struct {
 
 func (x *AddOrUpdateVisitPaymentRequest) Reset() {
 	*x = AddOrUpdateVisitPaymentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[100]
+	mi := &file_proto_storage_storage_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5790,7 +6189,7 @@ func (x *AddOrUpdateVisitPaymentRequest) String() string {
 func (*AddOrUpdateVisitPaymentRequest) ProtoMessage() {}
 
 func (x *AddOrUpdateVisitPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[100]
+	mi := &file_proto_storage_storage_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5803,7 +6202,7 @@ func (x *AddOrUpdateVisitPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddOrUpdateVisitPaymentRequest.ProtoReflect.Descriptor instead.
 func (*AddOrUpdateVisitPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{100}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *AddOrUpdateVisitPaymentRequest) GetPayment() *VisitPayment {
@@ -5822,7 +6221,7 @@ type GetVisitByIDResponse struct {
 
 func (x *GetVisitByIDResponse) Reset() {
 	*x = GetVisitByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[101]
+	mi := &file_proto_storage_storage_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5834,7 +6233,7 @@ func (x *GetVisitByIDResponse) String() string {
 func (*GetVisitByIDResponse) ProtoMessage() {}
 
 func (x *GetVisitByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[101]
+	mi := &file_proto_storage_storage_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5847,7 +6246,7 @@ func (x *GetVisitByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVisitByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetVisitByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{101}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *GetVisitByIDResponse) GetVisit() *Visit {
@@ -5870,7 +6269,7 @@ type ClinicOverride struct {
 
 func (x *ClinicOverride) Reset() {
 	*x = ClinicOverride{}
-	mi := &file_proto_storage_storage_proto_msgTypes[102]
+	mi := &file_proto_storage_storage_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5882,7 +6281,7 @@ func (x *ClinicOverride) String() string {
 func (*ClinicOverride) ProtoMessage() {}
 
 func (x *ClinicOverride) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[102]
+	mi := &file_proto_storage_storage_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5895,7 +6294,7 @@ func (x *ClinicOverride) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClinicOverride.ProtoReflect.Descriptor instead.
 func (*ClinicOverride) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{102}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *ClinicOverride) GetDate() *timestamppb.Timestamp {
@@ -5942,7 +6341,7 @@ type GetClinicOverridesResponse struct {
 
 func (x *GetClinicOverridesResponse) Reset() {
 	*x = GetClinicOverridesResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[103]
+	mi := &file_proto_storage_storage_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5954,7 +6353,7 @@ func (x *GetClinicOverridesResponse) String() string {
 func (*GetClinicOverridesResponse) ProtoMessage() {}
 
 func (x *GetClinicOverridesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[103]
+	mi := &file_proto_storage_storage_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5967,7 +6366,7 @@ func (x *GetClinicOverridesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetClinicOverridesResponse.ProtoReflect.Descriptor instead.
 func (*GetClinicOverridesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{103}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *GetClinicOverridesResponse) GetOverrides() []*ClinicOverride {
@@ -5977,16 +6376,95 @@ func (x *GetClinicOverridesResponse) GetOverrides() []*ClinicOverride {
 	return nil
 }
 
+// sort: date (по умолчанию), created_at. Границы периода включительно, учитывается только дата;
+// пустые границы, statuses и doctor_id = 0 — без отбора
+type GetAppointmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ListPage              `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	Statuses      []string               `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,5,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAppointmentsRequest) Reset() {
+	*x = GetAppointmentsRequest{}
+	mi := &file_proto_storage_storage_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAppointmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppointmentsRequest) ProtoMessage() {}
+
+func (x *GetAppointmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppointmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAppointmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetAppointmentsRequest) GetPage() *ListPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetAppointmentsRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *GetAppointmentsRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *GetAppointmentsRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetAppointmentsRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
 type GetAppointmentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Appointments  []*Appointment         `protobuf:"bytes,1,rep,name=appointments,proto3" json:"appointments,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // число всех отобранных записей без учёта страницы
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetAppointmentsResponse) Reset() {
 	*x = GetAppointmentsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[104]
+	mi := &file_proto_storage_storage_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5998,7 +6476,7 @@ func (x *GetAppointmentsResponse) String() string {
 func (*GetAppointmentsResponse) ProtoMessage() {}
 
 func (x *GetAppointmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[104]
+	mi := &file_proto_storage_storage_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6011,7 +6489,7 @@ func (x *GetAppointmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppointmentsResponse.ProtoReflect.Descriptor instead.
 func (*GetAppointmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{104}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *GetAppointmentsResponse) GetAppointments() []*Appointment {
@@ -6021,6 +6499,13 @@ func (x *GetAppointmentsResponse) GetAppointments() []*Appointment {
 	return nil
 }
 
+func (x *GetAppointmentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 type GetDiagnoseByVisitIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Diagnose      []*Diagnose            `protobuf:"bytes,1,rep,name=diagnose,proto3" json:"diagnose,omitempty"`
@@ -6030,7 +6515,7 @@ type GetDiagnoseByVisitIDResponse struct {
 
 func (x *GetDiagnoseByVisitIDResponse) Reset() {
 	*x = GetDiagnoseByVisitIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[105]
+	mi := &file_proto_storage_storage_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6042,7 +6527,7 @@ func (x *GetDiagnoseByVisitIDResponse) String() string {
 func (*GetDiagnoseByVisitIDResponse) ProtoMessage() {}
 
 func (x *GetDiagnoseByVisitIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[105]
+	mi := &file_proto_storage_storage_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6055,7 +6540,7 @@ func (x *GetDiagnoseByVisitIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiagnoseByVisitIDResponse.ProtoReflect.Descriptor instead.
 func (*GetDiagnoseByVisitIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{105}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *GetDiagnoseByVisitIDResponse) GetDiagnose() []*Diagnose {
@@ -6081,7 +6566,7 @@ type SaveDocumentRequest struct {
 
 func (x *SaveDocumentRequest) Reset() {
 	*x = SaveDocumentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[106]
+	mi := &file_proto_storage_storage_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6093,7 +6578,7 @@ func (x *SaveDocumentRequest) String() string {
 func (*SaveDocumentRequest) ProtoMessage() {}
 
 func (x *SaveDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[106]
+	mi := &file_proto_storage_storage_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6106,7 +6591,7 @@ func (x *SaveDocumentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveDocumentRequest.ProtoReflect.Descriptor instead.
 func (*SaveDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{106}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *SaveDocumentRequest) GetPatientId() string {
@@ -6174,7 +6659,7 @@ type SaveDocumentResponse struct {
 
 func (x *SaveDocumentResponse) Reset() {
 	*x = SaveDocumentResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[107]
+	mi := &file_proto_storage_storage_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6186,7 +6671,7 @@ func (x *SaveDocumentResponse) String() string {
 func (*SaveDocumentResponse) ProtoMessage() {}
 
 func (x *SaveDocumentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[107]
+	mi := &file_proto_storage_storage_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6199,7 +6684,7 @@ func (x *SaveDocumentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveDocumentResponse.ProtoReflect.Descriptor instead.
 func (*SaveDocumentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{107}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *SaveDocumentResponse) GetDocumentId() string {
@@ -6218,7 +6703,7 @@ type GetDocumentMetadataRequest struct {
 
 func (x *GetDocumentMetadataRequest) Reset() {
 	*x = GetDocumentMetadataRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[108]
+	mi := &file_proto_storage_storage_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6230,7 +6715,7 @@ func (x *GetDocumentMetadataRequest) String() string {
 func (*GetDocumentMetadataRequest) ProtoMessage() {}
 
 func (x *GetDocumentMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[108]
+	mi := &file_proto_storage_storage_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6243,7 +6728,7 @@ func (x *GetDocumentMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentMetadataRequest.ProtoReflect.Descriptor instead.
 func (*GetDocumentMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{108}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *GetDocumentMetadataRequest) GetDocumentId() string {
@@ -6270,7 +6755,7 @@ type GetDocumentMetadataResponse struct {
 
 func (x *GetDocumentMetadataResponse) Reset() {
 	*x = GetDocumentMetadataResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[109]
+	mi := &file_proto_storage_storage_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6282,7 +6767,7 @@ func (x *GetDocumentMetadataResponse) String() string {
 func (*GetDocumentMetadataResponse) ProtoMessage() {}
 
 func (x *GetDocumentMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[109]
+	mi := &file_proto_storage_storage_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6295,7 +6780,7 @@ func (x *GetDocumentMetadataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentMetadataResponse.ProtoReflect.Descriptor instead.
 func (*GetDocumentMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{109}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *GetDocumentMetadataResponse) GetDocumentId() string {
@@ -6370,7 +6855,7 @@ type DownloadDocumentRequest struct {
 
 func (x *DownloadDocumentRequest) Reset() {
 	*x = DownloadDocumentRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[110]
+	mi := &file_proto_storage_storage_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6382,7 +6867,7 @@ func (x *DownloadDocumentRequest) String() string {
 func (*DownloadDocumentRequest) ProtoMessage() {}
 
 func (x *DownloadDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[110]
+	mi := &file_proto_storage_storage_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6395,7 +6880,7 @@ func (x *DownloadDocumentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadDocumentRequest.ProtoReflect.Descriptor instead.
 func (*DownloadDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{110}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *DownloadDocumentRequest) GetDocumentId() string {
@@ -6415,7 +6900,7 @@ type DownloadDocumentResponse struct {
 
 func (x *DownloadDocumentResponse) Reset() {
 	*x = DownloadDocumentResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[111]
+	mi := &file_proto_storage_storage_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6427,7 +6912,7 @@ func (x *DownloadDocumentResponse) String() string {
 func (*DownloadDocumentResponse) ProtoMessage() {}
 
 func (x *DownloadDocumentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[111]
+	mi := &file_proto_storage_storage_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6440,7 +6925,7 @@ func (x *DownloadDocumentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadDocumentResponse.ProtoReflect.Descriptor instead.
 func (*DownloadDocumentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{111}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *DownloadDocumentResponse) GetFileName() string {
@@ -6466,7 +6951,7 @@ type GetDocumentsRequest struct {
 
 func (x *GetDocumentsRequest) Reset() {
 	*x = GetDocumentsRequest{}
-	mi := &file_proto_storage_storage_proto_msgTypes[112]
+	mi := &file_proto_storage_storage_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6478,7 +6963,7 @@ func (x *GetDocumentsRequest) String() string {
 func (*GetDocumentsRequest) ProtoMessage() {}
 
 func (x *GetDocumentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[112]
+	mi := &file_proto_storage_storage_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6491,7 +6976,7 @@ func (x *GetDocumentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentsRequest.ProtoReflect.Descriptor instead.
 func (*GetDocumentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{112}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *GetDocumentsRequest) GetPatientId() string {
@@ -6515,7 +7000,7 @@ type DocumentInfo struct {
 
 func (x *DocumentInfo) Reset() {
 	*x = DocumentInfo{}
-	mi := &file_proto_storage_storage_proto_msgTypes[113]
+	mi := &file_proto_storage_storage_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6527,7 +7012,7 @@ func (x *DocumentInfo) String() string {
 func (*DocumentInfo) ProtoMessage() {}
 
 func (x *DocumentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[113]
+	mi := &file_proto_storage_storage_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6540,7 +7025,7 @@ func (x *DocumentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DocumentInfo.ProtoReflect.Descriptor instead.
 func (*DocumentInfo) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{113}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *DocumentInfo) GetId() string {
@@ -6594,7 +7079,7 @@ type GetDocumentsResponse struct {
 
 func (x *GetDocumentsResponse) Reset() {
 	*x = GetDocumentsResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[114]
+	mi := &file_proto_storage_storage_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6606,7 +7091,7 @@ func (x *GetDocumentsResponse) String() string {
 func (*GetDocumentsResponse) ProtoMessage() {}
 
 func (x *GetDocumentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[114]
+	mi := &file_proto_storage_storage_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6619,7 +7104,7 @@ func (x *GetDocumentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentsResponse.ProtoReflect.Descriptor instead.
 func (*GetDocumentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{114}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *GetDocumentsResponse) GetDocuments() []*DocumentInfo {
@@ -6638,7 +7123,7 @@ type GetAdminByIDResponse struct {
 
 func (x *GetAdminByIDResponse) Reset() {
 	*x = GetAdminByIDResponse{}
-	mi := &file_proto_storage_storage_proto_msgTypes[115]
+	mi := &file_proto_storage_storage_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6650,7 +7135,7 @@ func (x *GetAdminByIDResponse) String() string {
 func (*GetAdminByIDResponse) ProtoMessage() {}
 
 func (x *GetAdminByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_storage_storage_proto_msgTypes[115]
+	mi := &file_proto_storage_storage_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6663,7 +7148,7 @@ func (x *GetAdminByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAdminByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetAdminByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_storage_storage_proto_rawDescGZIP(), []int{115}
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *GetAdminByIDResponse) GetAdmin() *Admin {
@@ -6673,6 +7158,132 @@ func (x *GetAdminByIDResponse) GetAdmin() *Admin {
 	return nil
 }
 
+// Запись журнала аудита: кто (actor_id, actor_role), каким методом какого сервиса, к какому ресурсу
+// и чьим данным, с каким результатом (код gRPC-статуса) и когда. hash — звено цепочки хешей журнала
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	ActorId       int32                  `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorRole     int32                  `protobuf:"varint,6,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	Resource      string                 `protobuf:"bytes,7,opt,name=resource,proto3" json:"resource,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,8,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,9,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"` // 0 — вызов не относится к конкретному пациенту
+	Outcome       string                 `protobuf:"bytes,10,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Hash          []byte                 `protobuf:"bytes,11,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_proto_storage_storage_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *AuditEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorId() int32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetActorRole() int32 {
+	if x != nil {
+		return x.ActorRole
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
 var File_proto_storage_storage_proto protoreflect.FileDescriptor
 
 const file_proto_storage_storage_proto_rawDesc = "" +
@@ -6726,7 +7337,12 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x06gender\x18\b \x01(\tR\x06gender\"*\n" +
 	"\x12AddPatientResponse\x12\x14\n" +
 	"\x05error\x18\x01 \x01(\tR\x05error\"\x0e\n" +
-	"\fEmptyRequest\"4\n" +
+	"\fEmptyRequest\"`\n" +
+	"\bListPage\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\bR\x04desc\"4\n" +
 	"\x0eSpecialization\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"D\n" +
@@ -6752,11 +7368,12 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x1dGetScheduleByDoctorIdResponse\x12F\n" +
 	"\x0fdoctor_schedule\x18\x01 \x03(\v2\x1d.storage.WeeklyDoctorScheduleR\x0edoctorSchedule\"-\n" +
 	"\x15GetUserByLoginRequest\x12\x14\n" +
-	"\x05login\x18\x01 \x01(\tR\x05login\"Z\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\"r\n" +
 	"\x16GetUserByLoginResponse\x12\x14\n" +
 	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x0e\n" +
-	"\x02id\x18\x03 \x01(\x05R\x02id\"]\n" +
+	"\x02id\x18\x03 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"]\n" +
 	"\x19UpdateUserPasswordRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05login\x18\x02 \x01(\tR\x05login\x12\x1a\n" +
@@ -6796,9 +7413,14 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\aspec_id\x18\x02 \x01(\x05R\x06specId\"O\n" +
 	"\x17DeleteDoctorSpecRequest\x12\x1b\n" +
 	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\x12\x17\n" +
-	"\aspec_id\x18\x02 \x01(\x05R\x06specId\"?\n" +
+	"\aspec_id\x18\x02 \x01(\x05R\x06specId\"l\n" +
+	"\x11GetDoctorsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"U\n" +
 	"\x12GetDoctorsResponse\x12)\n" +
-	"\adoctors\x18\x01 \x03(\v2\x0f.storage.DoctorR\adoctors\"8\n" +
+	"\adoctors\x18\x01 \x03(\v2\x0f.storage.DoctorR\adoctors\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"8\n" +
 	" GetDoctorSpecsByDoctorIdResponse\x12\x14\n" +
 	"\x05specs\x18\x01 \x03(\x05R\x05specs\"\xcb\x01\n" +
 	"\x05Admin\x12\x17\n" +
@@ -6823,9 +7445,14 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x06gender\x18\a \x01(\tR\x06gender\"J\n" +
 	"\x16UpdateAdminRoleRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x17\n" +
-	"\arole_id\x18\x02 \x01(\x05R\x06roleId\";\n" +
+	"\arole_id\x18\x02 \x01(\x05R\x06roleId\"k\n" +
+	"\x10GetAdminsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"Q\n" +
 	"\x11GetAdminsResponse\x12&\n" +
-	"\x06admins\x18\x01 \x03(\v2\x0e.storage.AdminR\x06admins\"\x88\x02\n" +
+	"\x06admins\x18\x01 \x03(\v2\x0e.storage.AdminR\x06admins\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x88\x02\n" +
 	"\aPatient\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x1d\n" +
 	"\n" +
@@ -6849,9 +7476,14 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\n" +
 	"birth_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tbirthDate\x12!\n" +
 	"\fphone_number\x18\a \x01(\tR\vphoneNumber\x12\x16\n" +
-	"\x06gender\x18\b \x01(\tR\x06gender\"C\n" +
+	"\x06gender\x18\b \x01(\tR\x06gender\"m\n" +
+	"\x12GetPatientsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\binactive\x18\x03 \x01(\bR\binactive\"Y\n" +
 	"\x13GetPatientsResponse\x12,\n" +
-	"\bpatients\x18\x01 \x03(\v2\x10.storage.PatientR\bpatients\"\x84\x02\n" +
+	"\bpatients\x18\x01 \x03(\v2\x10.storage.PatientR\bpatients\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x84\x02\n" +
 	"\x14WeeklyClinicSchedule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
 	"\aweekday\x18\x02 \x01(\x05R\aweekday\x129\n" +
@@ -7086,9 +7718,13 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x18GetPatientVisitsResponse\x12&\n" +
 	"\x06visits\x18\x01 \x03(\v2\x0e.storage.VisitR\x06visits\"\x86\x01\n" +
 	"#GetPatientAllergiesChronicsResponse\x12_\n" +
-	"\x1apatient_allergies_chronics\x18\x01 \x03(\v2!.storage.PatientAllergiesChronicsR\x18patientAllergiesChronics\"B\n" +
+	"\x1apatient_allergies_chronics\x18\x01 \x03(\v2!.storage.PatientAllergiesChronicsR\x18patientAllergiesChronics\"Q\n" +
+	"\x12GetICDCodesRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\"X\n" +
 	"\x13GetICDCodesResponse\x12+\n" +
-	"\bicd_code\x18\x01 \x03(\v2\x10.storage.ICDCodeR\aicdCode\"\"\n" +
+	"\bicd_code\x18\x01 \x03(\v2\x10.storage.ICDCodeR\aicdCode\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\"\n" +
 	"\x10AddVisitResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"W\n" +
 	"\fVisitPayment\x12\x19\n" +
@@ -7098,9 +7734,12 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x13VisitPaymentRequest\x12\x19\n" +
 	"\bvisit_id\x18\x01 \x01(\x05R\avisitId\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x05R\x05price\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"W\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"A\n" +
+	"\x18GetVisitsPaymentsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\"m\n" +
 	"\x19GetVisitsPaymentsResponse\x12:\n" +
-	"\rvisit_payment\x18\x01 \x03(\v2\x15.storage.VisitPaymentR\fvisitPayment\"7\n" +
+	"\rvisit_payment\x18\x01 \x03(\v2\x15.storage.VisitPaymentR\fvisitPayment\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"7\n" +
 	"\x1aCalculateVisitTotalRequest\x12\x19\n" +
 	"\bvisit_id\x18\x01 \x01(\x05R\avisitId\"3\n" +
 	"\x1bCalculateVisitTotalResponse\x12\x14\n" +
@@ -7118,9 +7757,16 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\n" +
 	"is_day_off\x18\x05 \x01(\bR\bisDayOff\"S\n" +
 	"\x1aGetClinicOverridesResponse\x125\n" +
-	"\toverrides\x18\x01 \x03(\v2\x17.storage.ClinicOverrideR\toverrides\"S\n" +
+	"\toverrides\x18\x01 \x03(\v2\x17.storage.ClinicOverrideR\toverrides\"\xe6\x01\n" +
+	"\x16GetAppointmentsRequest\x12%\n" +
+	"\x04page\x18\x01 \x01(\v2\x11.storage.ListPageR\x04page\x127\n" +
+	"\tdate_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\x1a\n" +
+	"\bstatuses\x18\x04 \x03(\tR\bstatuses\x12\x1b\n" +
+	"\tdoctor_id\x18\x05 \x01(\x05R\bdoctorId\"i\n" +
 	"\x17GetAppointmentsResponse\x128\n" +
-	"\fappointments\x18\x01 \x03(\v2\x14.storage.AppointmentR\fappointments\"M\n" +
+	"\fappointments\x18\x01 \x03(\v2\x14.storage.AppointmentR\fappointments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"M\n" +
 	"\x1cGetDiagnoseByVisitIDResponse\x12-\n" +
 	"\bdiagnose\x18\x01 \x03(\v2\x11.storage.DiagnoseR\bdiagnose\"\xbc\x02\n" +
 	"\x13SaveDocumentRequest\x12\x1d\n" +
@@ -7175,17 +7821,35 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x14GetDocumentsResponse\x123\n" +
 	"\tdocuments\x18\x01 \x03(\v2\x15.storage.DocumentInfoR\tdocuments\"<\n" +
 	"\x14GetAdminByIDResponse\x12$\n" +
-	"\x05admin\x18\x01 \x01(\v2\x0e.storage.AdminR\x05admin2\xfd.\n" +
+	"\x05admin\x18\x01 \x01(\v2\x0e.storage.AdminR\x05admin\"\xcf\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\x05R\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_role\x18\x06 \x01(\x05R\tactorRole\x12\x1a\n" +
+	"\bresource\x18\a \x01(\tR\bresource\x12\x1f\n" +
+	"\vresource_id\x18\b \x01(\tR\n" +
+	"resourceId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\t \x01(\x05R\tpatientId\x12\x18\n" +
+	"\aoutcome\x18\n" +
+	" \x01(\tR\aoutcome\x12\x12\n" +
+	"\x04hash\x18\v \x01(\fR\x04hash2\xe8/\n" +
 	"\x0eStorageService\x12<\n" +
 	"\aAddUser\x12\x17.storage.AddUserRequest\x1a\x18.storage.AddUserResponse\x12B\n" +
 	"\tAddDoctor\x12\x19.storage.AddDoctorRequest\x1a\x1a.storage.AddDoctorResponse\x12?\n" +
 	"\bAddAdmin\x12\x18.storage.AddAdminRequest\x1a\x19.storage.AddAdminResponse\x12E\n" +
 	"\n" +
-	"AddPatient\x12\x1a.storage.AddPatientRequest\x1a\x1b.storage.AddPatientResponse\x12@\n" +
+	"AddPatient\x12\x1a.storage.AddPatientRequest\x1a\x1b.storage.AddPatientResponse\x12E\n" +
 	"\n" +
-	"GetDoctors\x12\x15.storage.EmptyRequest\x1a\x1b.storage.GetDoctorsResponse\x12>\n" +
-	"\tGetAdmins\x12\x15.storage.EmptyRequest\x1a\x1a.storage.GetAdminsResponse\x12B\n" +
-	"\vGetPatients\x12\x15.storage.EmptyRequest\x1a\x1c.storage.GetPatientsResponse\x12^\n" +
+	"GetDoctors\x12\x1a.storage.GetDoctorsRequest\x1a\x1b.storage.GetDoctorsResponse\x12B\n" +
+	"\tGetAdmins\x12\x19.storage.GetAdminsRequest\x1a\x1a.storage.GetAdminsResponse\x12H\n" +
+	"\vGetPatients\x12\x1b.storage.GetPatientsRequest\x1a\x1c.storage.GetPatientsResponse\x12^\n" +
 	"\x18GetDoctorSpecsByDoctorId\x12\x17.storage.GetByIdRequest\x1a).storage.GetDoctorSpecsByDoctorIdResponse\x12F\n" +
 	"\fUpdateDoctor\x12\x1c.storage.UpdateDoctorRequest\x1a\x18.storage.DefaultResponse\x12H\n" +
 	"\rAddDoctorSpec\x12\x1d.storage.AddDoctorSpecRequest\x1a\x18.storage.DefaultResponse\x12N\n" +
@@ -7234,8 +7898,8 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x12GetDoctorOverrides\x12\x17.storage.GetByIDRequest\x1a#.storage.GetDoctorOverridesResponse\x12T\n" +
 	"\x13GetPatientDiagnoses\x12\x17.storage.GetByIdRequest\x1a$.storage.GetPatientDiagnosesResponse\x12N\n" +
 	"\x10GetPatientVisits\x12\x17.storage.GetByIdRequest\x1a!.storage.GetPatientVisitsResponse\x12d\n" +
-	"\x1bGetPatientAllergiesChronics\x12\x17.storage.GetByIdRequest\x1a,.storage.GetPatientAllergiesChronicsResponse\x12B\n" +
-	"\vGetICDCodes\x12\x15.storage.EmptyRequest\x1a\x1c.storage.GetICDCodesResponse\x12d\n" +
+	"\x1bGetPatientAllergiesChronics\x12\x17.storage.GetByIdRequest\x1a,.storage.GetPatientAllergiesChronicsResponse\x12H\n" +
+	"\vGetICDCodes\x12\x1b.storage.GetICDCodesRequest\x1a\x1c.storage.GetICDCodesResponse\x12d\n" +
 	"\x1bAddPatientAllergiesChronics\x12+.storage.AddPatientAllergiesChronicsRequest\x1a\x18.storage.DefaultResponse\x12M\n" +
 	"\x0fAddPatientVisit\x12\x1f.storage.AddPatientVisitRequest\x1a\x19.storage.AddVisitResponse\x12P\n" +
 	"\x11AddVisitMaterials\x12!.storage.AddVisitMaterialsRequest\x1a\x18.storage.DefaultResponse\x12N\n" +
@@ -7245,16 +7909,17 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x12UpdateVisitPayment\x12\x1c.storage.VisitPaymentRequest\x1a\x18.storage.DefaultResponse\x12F\n" +
 	"\fGetVisitByID\x12\x17.storage.GetByIdRequest\x1a\x1d.storage.GetVisitByIDResponse\x12`\n" +
 	"\x13CalculateVisitTotal\x12#.storage.CalculateVisitTotalRequest\x1a$.storage.CalculateVisitTotalResponse\x12\\\n" +
-	"\x17AddOrUpdateVisitPayment\x12'.storage.AddOrUpdateVisitPaymentRequest\x1a\x18.storage.DefaultResponse\x12N\n" +
-	"\x11GetVisitsPayments\x12\x15.storage.EmptyRequest\x1a\".storage.GetVisitsPaymentsResponse\x12P\n" +
-	"\x12GetClinicOverrides\x12\x15.storage.EmptyRequest\x1a#.storage.GetClinicOverridesResponse\x12J\n" +
-	"\x0fGetAppointments\x12\x15.storage.EmptyRequest\x1a .storage.GetAppointmentsResponse\x12V\n" +
+	"\x17AddOrUpdateVisitPayment\x12'.storage.AddOrUpdateVisitPaymentRequest\x1a\x18.storage.DefaultResponse\x12Z\n" +
+	"\x11GetVisitsPayments\x12!.storage.GetVisitsPaymentsRequest\x1a\".storage.GetVisitsPaymentsResponse\x12P\n" +
+	"\x12GetClinicOverrides\x12\x15.storage.EmptyRequest\x1a#.storage.GetClinicOverridesResponse\x12T\n" +
+	"\x0fGetAppointments\x12\x1f.storage.GetAppointmentsRequest\x1a .storage.GetAppointmentsResponse\x12V\n" +
 	"\x14GetDiagnoseByVisitID\x12\x17.storage.GetByIDRequest\x1a%.storage.GetDiagnoseByVisitIDResponse\x12K\n" +
 	"\fSaveDocument\x12\x1c.storage.SaveDocumentRequest\x1a\x1d.storage.SaveDocumentResponse\x12`\n" +
 	"\x13GetDocumentMetadata\x12#.storage.GetDocumentMetadataRequest\x1a$.storage.GetDocumentMetadataResponse\x12W\n" +
 	"\x10DownloadDocument\x12 .storage.DownloadDocumentRequest\x1a!.storage.DownloadDocumentResponse\x12V\n" +
 	"\x17GetDocumentsByPatientID\x12\x1c.storage.GetDocumentsRequest\x1a\x1d.storage.GetDocumentsResponse\x12F\n" +
-	"\fGetAdminByID\x12\x17.storage.GetByIDRequest\x1a\x1d.storage.GetAdminByIDResponseB\x19Z\x17storage/proto;storagepbb\x06proto3"
+	"\fGetAdminByID\x12\x17.storage.GetByIDRequest\x1a\x1d.storage.GetAdminByIDResponse\x12>\n" +
+	"\rAddAuditEntry\x12\x13.storage.AuditEntry\x1a\x18.storage.DefaultResponseB\x19Z\x17storage/proto;storagepbb\x06proto3"
 
 var (
 	file_proto_storage_storage_proto_rawDescOnce sync.Once
@@ -7268,7 +7933,7 @@ func file_proto_storage_storage_proto_rawDescGZIP() []byte {
 	return file_proto_storage_storage_proto_rawDescData
 }
 
-var file_proto_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 116)
+var file_proto_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 124)
 var file_proto_storage_storage_proto_goTypes = []any{
 	(*AddUserRequest)(nil),                      // 0: storage.AddUserRequest
 	(*AddUserResponse)(nil),                     // 1: storage.AddUserResponse
@@ -7279,343 +7944,362 @@ var file_proto_storage_storage_proto_goTypes = []any{
 	(*AddPatientRequest)(nil),                   // 6: storage.AddPatientRequest
 	(*AddPatientResponse)(nil),                  // 7: storage.AddPatientResponse
 	(*EmptyRequest)(nil),                        // 8: storage.EmptyRequest
-	(*Specialization)(nil),                      // 9: storage.Specialization
-	(*GetAllSpecsResponse)(nil),                 // 10: storage.GetAllSpecsResponse
-	(*AddUserRoleRequest)(nil),                  // 11: storage.AddUserRoleRequest
-	(*AddUserRoleResponse)(nil),                 // 12: storage.AddUserRoleResponse
-	(*WeeklyDoctorSchedule)(nil),                // 13: storage.WeeklyDoctorSchedule
-	(*GetScheduleByDoctorIdRequest)(nil),        // 14: storage.GetScheduleByDoctorIdRequest
-	(*GetScheduleByDoctorIdResponse)(nil),       // 15: storage.GetScheduleByDoctorIdResponse
-	(*GetUserByLoginRequest)(nil),               // 16: storage.GetUserByLoginRequest
-	(*GetUserByLoginResponse)(nil),              // 17: storage.GetUserByLoginResponse
-	(*UpdateUserPasswordRequest)(nil),           // 18: storage.UpdateUserPasswordRequest
-	(*DefaultResponse)(nil),                     // 19: storage.DefaultResponse
-	(*Doctor)(nil),                              // 20: storage.Doctor
-	(*UpdateDoctorRequest)(nil),                 // 21: storage.UpdateDoctorRequest
-	(*AddDoctorSpecRequest)(nil),                // 22: storage.AddDoctorSpecRequest
-	(*DeleteDoctorSpecRequest)(nil),             // 23: storage.DeleteDoctorSpecRequest
-	(*GetDoctorsResponse)(nil),                  // 24: storage.GetDoctorsResponse
-	(*GetDoctorSpecsByDoctorIdResponse)(nil),    // 25: storage.GetDoctorSpecsByDoctorIdResponse
-	(*Admin)(nil),                               // 26: storage.Admin
-	(*UpdateAdminRequest)(nil),                  // 27: storage.UpdateAdminRequest
-	(*UpdateAdminRoleRequest)(nil),              // 28: storage.UpdateAdminRoleRequest
-	(*GetAdminsResponse)(nil),                   // 29: storage.GetAdminsResponse
-	(*Patient)(nil),                             // 30: storage.Patient
-	(*UpdatePatientRequest)(nil),                // 31: storage.UpdatePatientRequest
-	(*GetPatientsResponse)(nil),                 // 32: storage.GetPatientsResponse
-	(*WeeklyClinicSchedule)(nil),                // 33: storage.WeeklyClinicSchedule
-	(*GetClinicWeeklyScheduleResponse)(nil),     // 34: storage.GetClinicWeeklyScheduleResponse
-	(*GetUserRoleRequest)(nil),                  // 35: storage.GetUserRoleRequest
-	(*GetUserRoleResponse)(nil),                 // 36: storage.GetUserRoleResponse
-	(*UpdateClinicWeeklyScheduleRequest)(nil),   // 37: storage.UpdateClinicWeeklyScheduleRequest
-	(*AddDoctorWeeklyScheduleRequest)(nil),      // 38: storage.AddDoctorWeeklyScheduleRequest
-	(*UpdateDoctorWeeklyScheduleRequest)(nil),   // 39: storage.UpdateDoctorWeeklyScheduleRequest
-	(*GetRolePermissionRequest)(nil),            // 40: storage.GetRolePermissionRequest
-	(*AddClinicDailyOverrideRequest)(nil),       // 41: storage.AddClinicDailyOverrideRequest
-	(*AddDoctorDailyOverrideRequest)(nil),       // 42: storage.AddDoctorDailyOverrideRequest
-	(*GetDoctorBySpecIDRequest)(nil),            // 43: storage.GetDoctorBySpecIDRequest
-	(*GetAppointmentsByDoctorIDRequest)(nil),    // 44: storage.GetAppointmentsByDoctorIDRequest
-	(*Appointment)(nil),                         // 45: storage.Appointment
-	(*GetAppointmentsByDoctorIDResponse)(nil),   // 46: storage.GetAppointmentsByDoctorIDResponse
-	(*GetByIDRequest)(nil),                      // 47: storage.GetByIDRequest
-	(*GetPatientByIDResponse)(nil),              // 48: storage.GetPatientByIDResponse
-	(*AddAppointmentRequest)(nil),               // 49: storage.AddAppointmentRequest
-	(*UpdateAppointmentRequest)(nil),            // 50: storage.UpdateAppointmentRequest
-	(*GetAppointmentsByUserIDResponse)(nil),     // 51: storage.GetAppointmentsByUserIDResponse
-	(*GetAppointmentByIDResponse)(nil),          // 52: storage.GetAppointmentByIDResponse
-	(*GetDoctorByIDResponse)(nil),               // 53: storage.GetDoctorByIDResponse
-	(*GetSpecsByDoctorIDResponse)(nil),          // 54: storage.GetSpecsByDoctorIDResponse
-	(*GetClinicOverrideRequest)(nil),            // 55: storage.GetClinicOverrideRequest
-	(*GetClinicOverrideResponse)(nil),           // 56: storage.GetClinicOverrideResponse
-	(*GetDoctorOverrideRequest)(nil),            // 57: storage.GetDoctorOverrideRequest
-	(*GetDoctorOverrideResponse)(nil),           // 58: storage.GetDoctorOverrideResponse
-	(*DoctorOverride)(nil),                      // 59: storage.DoctorOverride
-	(*GetDoctorOverridesResponse)(nil),          // 60: storage.GetDoctorOverridesResponse
-	(*AddMaterialRequest)(nil),                  // 61: storage.AddMaterialRequest
-	(*AddServiceRequest)(nil),                   // 62: storage.AddServiceRequest
-	(*UpdateMaterialRequest)(nil),               // 63: storage.UpdateMaterialRequest
-	(*UpdateServiceRequest)(nil),                // 64: storage.UpdateServiceRequest
-	(*Material)(nil),                            // 65: storage.Material
-	(*Service)(nil),                             // 66: storage.Service
-	(*GetMaterialsResponse)(nil),                // 67: storage.GetMaterialsResponse
-	(*GetServicesResponse)(nil),                 // 68: storage.GetServicesResponse
-	(*GetByNameRequest)(nil),                    // 69: storage.GetByNameRequest
-	(*GetByIdRequest)(nil),                      // 70: storage.GetByIdRequest
-	(*GetMaterialByNameResponse)(nil),           // 71: storage.GetMaterialByNameResponse
-	(*GetServiceByNameResponse)(nil),            // 72: storage.GetServiceByNameResponse
-	(*DeleteRequest)(nil),                       // 73: storage.DeleteRequest
-	(*ServiceType)(nil),                         // 74: storage.ServiceType
-	(*GetServicesTypesResponse)(nil),            // 75: storage.GetServicesTypesResponse
-	(*GetServiceTypeByIdRequest)(nil),           // 76: storage.GetServiceTypeByIdRequest
-	(*GetServiceTypeByIdResponse)(nil),          // 77: storage.GetServiceTypeByIdResponse
-	(*UpdateUserLoginRequest)(nil),              // 78: storage.UpdateUserLoginRequest
-	(*ICDCode)(nil),                             // 79: storage.ICDCode
-	(*Diagnose)(nil),                            // 80: storage.Diagnose
-	(*Visit)(nil),                               // 81: storage.Visit
-	(*PatientAllergiesChronics)(nil),            // 82: storage.PatientAllergiesChronics
-	(*AddVisitMaterials)(nil),                   // 83: storage.AddVisitMaterials
-	(*AddVisitMaterialsRequest)(nil),            // 84: storage.AddVisitMaterialsRequest
-	(*AddVisitServices)(nil),                    // 85: storage.AddVisitServices
-	(*AddVisitServicesRequest)(nil),             // 86: storage.AddVisitServicesRequest
-	(*AddPatientAllergiesChronicsRequest)(nil),  // 87: storage.AddPatientAllergiesChronicsRequest
-	(*AddPatientVisitRequest)(nil),              // 88: storage.AddPatientVisitRequest
-	(*AddPatientDiagnosesRequest)(nil),          // 89: storage.AddPatientDiagnosesRequest
-	(*GetPatientDiagnosesResponse)(nil),         // 90: storage.GetPatientDiagnosesResponse
-	(*GetPatientVisitsResponse)(nil),            // 91: storage.GetPatientVisitsResponse
-	(*GetPatientAllergiesChronicsResponse)(nil), // 92: storage.GetPatientAllergiesChronicsResponse
-	(*GetICDCodesResponse)(nil),                 // 93: storage.GetICDCodesResponse
-	(*AddVisitResponse)(nil),                    // 94: storage.AddVisitResponse
-	(*VisitPayment)(nil),                        // 95: storage.VisitPayment
-	(*VisitPaymentRequest)(nil),                 // 96: storage.VisitPaymentRequest
-	(*GetVisitsPaymentsResponse)(nil),           // 97: storage.GetVisitsPaymentsResponse
-	(*CalculateVisitTotalRequest)(nil),          // 98: storage.CalculateVisitTotalRequest
-	(*CalculateVisitTotalResponse)(nil),         // 99: storage.CalculateVisitTotalResponse
-	(*AddOrUpdateVisitPaymentRequest)(nil),      // 100: storage.AddOrUpdateVisitPaymentRequest
-	(*GetVisitByIDResponse)(nil),                // 101: storage.GetVisitByIDResponse
-	(*ClinicOverride)(nil),                      // 102: storage.ClinicOverride
-	(*GetClinicOverridesResponse)(nil),          // 103: storage.GetClinicOverridesResponse
-	(*GetAppointmentsResponse)(nil),             // 104: storage.GetAppointmentsResponse
-	(*GetDiagnoseByVisitIDResponse)(nil),        // 105: storage.GetDiagnoseByVisitIDResponse
-	(*SaveDocumentRequest)(nil),                 // 106: storage.SaveDocumentRequest
-	(*SaveDocumentResponse)(nil),                // 107: storage.SaveDocumentResponse
-	(*GetDocumentMetadataRequest)(nil),          // 108: storage.GetDocumentMetadataRequest
-	(*GetDocumentMetadataResponse)(nil),         // 109: storage.GetDocumentMetadataResponse
-	(*DownloadDocumentRequest)(nil),             // 110: storage.DownloadDocumentRequest
-	(*DownloadDocumentResponse)(nil),            // 111: storage.DownloadDocumentResponse
-	(*GetDocumentsRequest)(nil),                 // 112: storage.GetDocumentsRequest
-	(*DocumentInfo)(nil),                        // 113: storage.DocumentInfo
-	(*GetDocumentsResponse)(nil),                // 114: storage.GetDocumentsResponse
-	(*GetAdminByIDResponse)(nil),                // 115: storage.GetAdminByIDResponse
-	(*timestamppb.Timestamp)(nil),               // 116: google.protobuf.Timestamp
+	(*ListPage)(nil),                            // 9: storage.ListPage
+	(*Specialization)(nil),                      // 10: storage.Specialization
+	(*GetAllSpecsResponse)(nil),                 // 11: storage.GetAllSpecsResponse
+	(*AddUserRoleRequest)(nil),                  // 12: storage.AddUserRoleRequest
+	(*AddUserRoleResponse)(nil),                 // 13: storage.AddUserRoleResponse
+	(*WeeklyDoctorSchedule)(nil),                // 14: storage.WeeklyDoctorSchedule
+	(*GetScheduleByDoctorIdRequest)(nil),        // 15: storage.GetScheduleByDoctorIdRequest
+	(*GetScheduleByDoctorIdResponse)(nil),       // 16: storage.GetScheduleByDoctorIdResponse
+	(*GetUserByLoginRequest)(nil),               // 17: storage.GetUserByLoginRequest
+	(*GetUserByLoginResponse)(nil),              // 18: storage.GetUserByLoginResponse
+	(*UpdateUserPasswordRequest)(nil),           // 19: storage.UpdateUserPasswordRequest
+	(*DefaultResponse)(nil),                     // 20: storage.DefaultResponse
+	(*Doctor)(nil),                              // 21: storage.Doctor
+	(*UpdateDoctorRequest)(nil),                 // 22: storage.UpdateDoctorRequest
+	(*AddDoctorSpecRequest)(nil),                // 23: storage.AddDoctorSpecRequest
+	(*DeleteDoctorSpecRequest)(nil),             // 24: storage.DeleteDoctorSpecRequest
+	(*GetDoctorsRequest)(nil),                   // 25: storage.GetDoctorsRequest
+	(*GetDoctorsResponse)(nil),                  // 26: storage.GetDoctorsResponse
+	(*GetDoctorSpecsByDoctorIdResponse)(nil),    // 27: storage.GetDoctorSpecsByDoctorIdResponse
+	(*Admin)(nil),                               // 28: storage.Admin
+	(*UpdateAdminRequest)(nil),                  // 29: storage.UpdateAdminRequest
+	(*UpdateAdminRoleRequest)(nil),              // 30: storage.UpdateAdminRoleRequest
+	(*GetAdminsRequest)(nil),                    // 31: storage.GetAdminsRequest
+	(*GetAdminsResponse)(nil),                   // 32: storage.GetAdminsResponse
+	(*Patient)(nil),                             // 33: storage.Patient
+	(*UpdatePatientRequest)(nil),                // 34: storage.UpdatePatientRequest
+	(*GetPatientsRequest)(nil),                  // 35: storage.GetPatientsRequest
+	(*GetPatientsResponse)(nil),                 // 36: storage.GetPatientsResponse
+	(*WeeklyClinicSchedule)(nil),                // 37: storage.WeeklyClinicSchedule
+	(*GetClinicWeeklyScheduleResponse)(nil),     // 38: storage.GetClinicWeeklyScheduleResponse
+	(*GetUserRoleRequest)(nil),                  // 39: storage.GetUserRoleRequest
+	(*GetUserRoleResponse)(nil),                 // 40: storage.GetUserRoleResponse
+	(*UpdateClinicWeeklyScheduleRequest)(nil),   // 41: storage.UpdateClinicWeeklyScheduleRequest
+	(*AddDoctorWeeklyScheduleRequest)(nil),      // 42: storage.AddDoctorWeeklyScheduleRequest
+	(*UpdateDoctorWeeklyScheduleRequest)(nil),   // 43: storage.UpdateDoctorWeeklyScheduleRequest
+	(*GetRolePermissionRequest)(nil),            // 44: storage.GetRolePermissionRequest
+	(*AddClinicDailyOverrideRequest)(nil),       // 45: storage.AddClinicDailyOverrideRequest
+	(*AddDoctorDailyOverrideRequest)(nil),       // 46: storage.AddDoctorDailyOverrideRequest
+	(*GetDoctorBySpecIDRequest)(nil),            // 47: storage.GetDoctorBySpecIDRequest
+	(*GetAppointmentsByDoctorIDRequest)(nil),    // 48: storage.GetAppointmentsByDoctorIDRequest
+	(*Appointment)(nil),                         // 49: storage.Appointment
+	(*GetAppointmentsByDoctorIDResponse)(nil),   // 50: storage.GetAppointmentsByDoctorIDResponse
+	(*GetByIDRequest)(nil),                      // 51: storage.GetByIDRequest
+	(*GetPatientByIDResponse)(nil),              // 52: storage.GetPatientByIDResponse
+	(*AddAppointmentRequest)(nil),               // 53: storage.AddAppointmentRequest
+	(*UpdateAppointmentRequest)(nil),            // 54: storage.UpdateAppointmentRequest
+	(*GetAppointmentsByUserIDResponse)(nil),     // 55: storage.GetAppointmentsByUserIDResponse
+	(*GetAppointmentByIDResponse)(nil),          // 56: storage.GetAppointmentByIDResponse
+	(*GetDoctorByIDResponse)(nil),               // 57: storage.GetDoctorByIDResponse
+	(*GetSpecsByDoctorIDResponse)(nil),          // 58: storage.GetSpecsByDoctorIDResponse
+	(*GetClinicOverrideRequest)(nil),            // 59: storage.GetClinicOverrideRequest
+	(*GetClinicOverrideResponse)(nil),           // 60: storage.GetClinicOverrideResponse
+	(*GetDoctorOverrideRequest)(nil),            // 61: storage.GetDoctorOverrideRequest
+	(*GetDoctorOverrideResponse)(nil),           // 62: storage.GetDoctorOverrideResponse
+	(*DoctorOverride)(nil),                      // 63: storage.DoctorOverride
+	(*GetDoctorOverridesResponse)(nil),          // 64: storage.GetDoctorOverridesResponse
+	(*AddMaterialRequest)(nil),                  // 65: storage.AddMaterialRequest
+	(*AddServiceRequest)(nil),                   // 66: storage.AddServiceRequest
+	(*UpdateMaterialRequest)(nil),               // 67: storage.UpdateMaterialRequest
+	(*UpdateServiceRequest)(nil),                // 68: storage.UpdateServiceRequest
+	(*Material)(nil),                            // 69: storage.Material
+	(*Service)(nil),                             // 70: storage.Service
+	(*GetMaterialsResponse)(nil),                // 71: storage.GetMaterialsResponse
+	(*GetServicesResponse)(nil),                 // 72: storage.GetServicesResponse
+	(*GetByNameRequest)(nil),                    // 73: storage.GetByNameRequest
+	(*GetByIdRequest)(nil),                      // 74: storage.GetByIdRequest
+	(*GetMaterialByNameResponse)(nil),           // 75: storage.GetMaterialByNameResponse
+	(*GetServiceByNameResponse)(nil),            // 76: storage.GetServiceByNameResponse
+	(*DeleteRequest)(nil),                       // 77: storage.DeleteRequest
+	(*ServiceType)(nil),                         // 78: storage.ServiceType
+	(*GetServicesTypesResponse)(nil),            // 79: storage.GetServicesTypesResponse
+	(*GetServiceTypeByIdRequest)(nil),           // 80: storage.GetServiceTypeByIdRequest
+	(*GetServiceTypeByIdResponse)(nil),          // 81: storage.GetServiceTypeByIdResponse
+	(*UpdateUserLoginRequest)(nil),              // 82: storage.UpdateUserLoginRequest
+	(*ICDCode)(nil),                             // 83: storage.ICDCode
+	(*Diagnose)(nil),                            // 84: storage.Diagnose
+	(*Visit)(nil),                               // 85: storage.Visit
+	(*PatientAllergiesChronics)(nil),            // 86: storage.PatientAllergiesChronics
+	(*AddVisitMaterials)(nil),                   // 87: storage.AddVisitMaterials
+	(*AddVisitMaterialsRequest)(nil),            // 88: storage.AddVisitMaterialsRequest
+	(*AddVisitServices)(nil),                    // 89: storage.AddVisitServices
+	(*AddVisitServicesRequest)(nil),             // 90: storage.AddVisitServicesRequest
+	(*AddPatientAllergiesChronicsRequest)(nil),  // 91: storage.AddPatientAllergiesChronicsRequest
+	(*AddPatientVisitRequest)(nil),              // 92: storage.AddPatientVisitRequest
+	(*AddPatientDiagnosesRequest)(nil),          // 93: storage.AddPatientDiagnosesRequest
+	(*GetPatientDiagnosesResponse)(nil),         // 94: storage.GetPatientDiagnosesResponse
+	(*GetPatientVisitsResponse)(nil),            // 95: storage.GetPatientVisitsResponse
+	(*GetPatientAllergiesChronicsResponse)(nil), // 96: storage.GetPatientAllergiesChronicsResponse
+	(*GetICDCodesRequest)(nil),                  // 97: storage.GetICDCodesRequest
+	(*GetICDCodesResponse)(nil),                 // 98: storage.GetICDCodesResponse
+	(*AddVisitResponse)(nil),                    // 99: storage.AddVisitResponse
+	(*VisitPayment)(nil),                        // 100: storage.VisitPayment
+	(*VisitPaymentRequest)(nil),                 // 101: storage.VisitPaymentRequest
+	(*GetVisitsPaymentsRequest)(nil),            // 102: storage.GetVisitsPaymentsRequest
+	(*GetVisitsPaymentsResponse)(nil),           // 103: storage.GetVisitsPaymentsResponse
+	(*CalculateVisitTotalRequest)(nil),          // 104: storage.CalculateVisitTotalRequest
+	(*CalculateVisitTotalResponse)(nil),         // 105: storage.CalculateVisitTotalResponse
+	(*AddOrUpdateVisitPaymentRequest)(nil),      // 106: storage.AddOrUpdateVisitPaymentRequest
+	(*GetVisitByIDResponse)(nil),                // 107: storage.GetVisitByIDResponse
+	(*ClinicOverride)(nil),                      // 108: storage.ClinicOverride
+	(*GetClinicOverridesResponse)(nil),          // 109: storage.GetClinicOverridesResponse
+	(*GetAppointmentsRequest)(nil),              // 110: storage.GetAppointmentsRequest
+	(*GetAppointmentsResponse)(nil),             // 111: storage.GetAppointmentsResponse
+	(*GetDiagnoseByVisitIDResponse)(nil),        // 112: storage.GetDiagnoseByVisitIDResponse
+	(*SaveDocumentRequest)(nil),                 // 113: storage.SaveDocumentRequest
+	(*SaveDocumentResponse)(nil),                // 114: storage.SaveDocumentResponse
+	(*GetDocumentMetadataRequest)(nil),          // 115: storage.GetDocumentMetadataRequest
+	(*GetDocumentMetadataResponse)(nil),         // 116: storage.GetDocumentMetadataResponse
+	(*DownloadDocumentRequest)(nil),             // 117: storage.DownloadDocumentRequest
+	(*DownloadDocumentResponse)(nil),            // 118: storage.DownloadDocumentResponse
+	(*GetDocumentsRequest)(nil),                 // 119: storage.GetDocumentsRequest
+	(*DocumentInfo)(nil),                        // 120: storage.DocumentInfo
+	(*GetDocumentsResponse)(nil),                // 121: storage.GetDocumentsResponse
+	(*GetAdminByIDResponse)(nil),                // 122: storage.GetAdminByIDResponse
+	(*AuditEntry)(nil),                          // 123: storage.AuditEntry
+	(*timestamppb.Timestamp)(nil),               // 124: google.protobuf.Timestamp
 }
 var file_proto_storage_storage_proto_depIdxs = []int32{
-	116, // 0: storage.AddPatientRequest.birth_date:type_name -> google.protobuf.Timestamp
-	9,   // 1: storage.GetAllSpecsResponse.specs:type_name -> storage.Specialization
-	116, // 2: storage.WeeklyDoctorSchedule.start_time:type_name -> google.protobuf.Timestamp
-	116, // 3: storage.WeeklyDoctorSchedule.end_time:type_name -> google.protobuf.Timestamp
-	13,  // 4: storage.GetScheduleByDoctorIdResponse.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
-	20,  // 5: storage.GetDoctorsResponse.doctors:type_name -> storage.Doctor
-	26,  // 6: storage.GetAdminsResponse.admins:type_name -> storage.Admin
-	116, // 7: storage.Patient.birth_date:type_name -> google.protobuf.Timestamp
-	116, // 8: storage.UpdatePatientRequest.birth_date:type_name -> google.protobuf.Timestamp
-	30,  // 9: storage.GetPatientsResponse.patients:type_name -> storage.Patient
-	116, // 10: storage.WeeklyClinicSchedule.start_time:type_name -> google.protobuf.Timestamp
-	116, // 11: storage.WeeklyClinicSchedule.end_time:type_name -> google.protobuf.Timestamp
-	33,  // 12: storage.GetClinicWeeklyScheduleResponse.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
-	33,  // 13: storage.UpdateClinicWeeklyScheduleRequest.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
-	13,  // 14: storage.AddDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
-	13,  // 15: storage.UpdateDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
-	116, // 16: storage.AddClinicDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	116, // 17: storage.AddClinicDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
-	116, // 18: storage.AddClinicDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
-	116, // 19: storage.AddDoctorDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	116, // 20: storage.AddDoctorDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
-	116, // 21: storage.AddDoctorDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
-	116, // 22: storage.Appointment.date:type_name -> google.protobuf.Timestamp
-	116, // 23: storage.Appointment.time:type_name -> google.protobuf.Timestamp
-	116, // 24: storage.Appointment.birth_date:type_name -> google.protobuf.Timestamp
-	116, // 25: storage.Appointment.created_at:type_name -> google.protobuf.Timestamp
-	116, // 26: storage.Appointment.updated_at:type_name -> google.protobuf.Timestamp
-	45,  // 27: storage.GetAppointmentsByDoctorIDResponse.appointments:type_name -> storage.Appointment
-	30,  // 28: storage.GetPatientByIDResponse.patient:type_name -> storage.Patient
-	45,  // 29: storage.AddAppointmentRequest.appointment:type_name -> storage.Appointment
-	45,  // 30: storage.UpdateAppointmentRequest.appointment:type_name -> storage.Appointment
-	45,  // 31: storage.GetAppointmentsByUserIDResponse.appointment:type_name -> storage.Appointment
-	45,  // 32: storage.GetAppointmentByIDResponse.appointment:type_name -> storage.Appointment
-	20,  // 33: storage.GetDoctorByIDResponse.doctor:type_name -> storage.Doctor
-	116, // 34: storage.GetClinicOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	116, // 35: storage.GetClinicOverrideResponse.date:type_name -> google.protobuf.Timestamp
-	116, // 36: storage.GetClinicOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
-	116, // 37: storage.GetClinicOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
-	116, // 38: storage.GetDoctorOverrideRequest.date:type_name -> google.protobuf.Timestamp
-	116, // 39: storage.GetDoctorOverrideResponse.date:type_name -> google.protobuf.Timestamp
-	116, // 40: storage.GetDoctorOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
-	116, // 41: storage.GetDoctorOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
-	116, // 42: storage.DoctorOverride.date:type_name -> google.protobuf.Timestamp
-	116, // 43: storage.DoctorOverride.start_time:type_name -> google.protobuf.Timestamp
-	116, // 44: storage.DoctorOverride.end_time:type_name -> google.protobuf.Timestamp
-	59,  // 45: storage.GetDoctorOverridesResponse.override:type_name -> storage.DoctorOverride
-	65,  // 46: storage.GetMaterialsResponse.materials:type_name -> storage.Material
-	66,  // 47: storage.GetServicesResponse.services:type_name -> storage.Service
-	74,  // 48: storage.GetServicesTypesResponse.types:type_name -> storage.ServiceType
-	116, // 49: storage.Visit.created_at:type_name -> google.protobuf.Timestamp
-	83,  // 50: storage.AddVisitMaterialsRequest.materials:type_name -> storage.AddVisitMaterials
-	85,  // 51: storage.AddVisitServicesRequest.services:type_name -> storage.AddVisitServices
-	82,  // 52: storage.AddPatientAllergiesChronicsRequest.notes:type_name -> storage.PatientAllergiesChronics
-	116, // 53: storage.AddPatientVisitRequest.created_at:type_name -> google.protobuf.Timestamp
-	80,  // 54: storage.AddPatientDiagnosesRequest.diagnoses:type_name -> storage.Diagnose
-	80,  // 55: storage.GetPatientDiagnosesResponse.diagnoses:type_name -> storage.Diagnose
-	81,  // 56: storage.GetPatientVisitsResponse.visits:type_name -> storage.Visit
-	82,  // 57: storage.GetPatientAllergiesChronicsResponse.patient_allergies_chronics:type_name -> storage.PatientAllergiesChronics
-	79,  // 58: storage.GetICDCodesResponse.icd_code:type_name -> storage.ICDCode
-	95,  // 59: storage.GetVisitsPaymentsResponse.visit_payment:type_name -> storage.VisitPayment
-	95,  // 60: storage.AddOrUpdateVisitPaymentRequest.payment:type_name -> storage.VisitPayment
-	81,  // 61: storage.GetVisitByIDResponse.visit:type_name -> storage.Visit
-	116, // 62: storage.ClinicOverride.date:type_name -> google.protobuf.Timestamp
-	116, // 63: storage.ClinicOverride.start_time:type_name -> google.protobuf.Timestamp
-	116, // 64: storage.ClinicOverride.end_time:type_name -> google.protobuf.Timestamp
-	102, // 65: storage.GetClinicOverridesResponse.overrides:type_name -> storage.ClinicOverride
-	45,  // 66: storage.GetAppointmentsResponse.appointments:type_name -> storage.Appointment
-	80,  // 67: storage.GetDiagnoseByVisitIDResponse.diagnose:type_name -> storage.Diagnose
-	116, // 68: storage.SaveDocumentRequest.study_date:type_name -> google.protobuf.Timestamp
-	116, // 69: storage.GetDocumentMetadataResponse.study_date:type_name -> google.protobuf.Timestamp
-	116, // 70: storage.GetDocumentMetadataResponse.created_at:type_name -> google.protobuf.Timestamp
-	116, // 71: storage.DocumentInfo.created_at:type_name -> google.protobuf.Timestamp
-	113, // 72: storage.GetDocumentsResponse.documents:type_name -> storage.DocumentInfo
-	26,  // 73: storage.GetAdminByIDResponse.admin:type_name -> storage.Admin
-	0,   // 74: storage.StorageService.AddUser:input_type -> storage.AddUserRequest
-	2,   // 75: storage.StorageService.AddDoctor:input_type -> storage.AddDoctorRequest
-	4,   // 76: storage.StorageService.AddAdmin:input_type -> storage.AddAdminRequest
-	6,   // 77: storage.StorageService.AddPatient:input_type -> storage.AddPatientRequest
-	8,   // 78: storage.StorageService.GetDoctors:input_type -> storage.EmptyRequest
-	8,   // 79: storage.StorageService.GetAdmins:input_type -> storage.EmptyRequest
-	8,   // 80: storage.StorageService.GetPatients:input_type -> storage.EmptyRequest
-	70,  // 81: storage.StorageService.GetDoctorSpecsByDoctorId:input_type -> storage.GetByIdRequest
-	21,  // 82: storage.StorageService.UpdateDoctor:input_type -> storage.UpdateDoctorRequest
-	22,  // 83: storage.StorageService.AddDoctorSpec:input_type -> storage.AddDoctorSpecRequest
-	23,  // 84: storage.StorageService.DeleteDoctorSpec:input_type -> storage.DeleteDoctorSpecRequest
-	27,  // 85: storage.StorageService.UpdateAdmin:input_type -> storage.UpdateAdminRequest
-	28,  // 86: storage.StorageService.UpdateAdminRole:input_type -> storage.UpdateAdminRoleRequest
-	31,  // 87: storage.StorageService.UpdatePatient:input_type -> storage.UpdatePatientRequest
-	73,  // 88: storage.StorageService.DeleteUser:input_type -> storage.DeleteRequest
-	78,  // 89: storage.StorageService.UpdateUserLogin:input_type -> storage.UpdateUserLoginRequest
-	8,   // 90: storage.StorageService.GetAllSpecs:input_type -> storage.EmptyRequest
-	11,  // 91: storage.StorageService.AddUserRole:input_type -> storage.AddUserRoleRequest
-	16,  // 92: storage.StorageService.GetUserByLogin:input_type -> storage.GetUserByLoginRequest
-	18,  // 93: storage.StorageService.UpdateUserPassword:input_type -> storage.UpdateUserPasswordRequest
-	8,   // 94: storage.StorageService.GetClinicWeeklySchedule:input_type -> storage.EmptyRequest
-	35,  // 95: storage.StorageService.GetUserRole:input_type -> storage.GetUserRoleRequest
-	14,  // 96: storage.StorageService.GetDoctorWeeklySchedule:input_type -> storage.GetScheduleByDoctorIdRequest
-	37,  // 97: storage.StorageService.UpdateClinicWeeklySchedule:input_type -> storage.UpdateClinicWeeklyScheduleRequest
-	38,  // 98: storage.StorageService.AddDoctorWeeklySchedule:input_type -> storage.AddDoctorWeeklyScheduleRequest
-	39,  // 99: storage.StorageService.UpdateDoctorWeeklySchedule:input_type -> storage.UpdateDoctorWeeklyScheduleRequest
-	40,  // 100: storage.StorageService.GetRolePermission:input_type -> storage.GetRolePermissionRequest
-	43,  // 101: storage.StorageService.GetDoctorsBySpecID:input_type -> storage.GetDoctorBySpecIDRequest
-	44,  // 102: storage.StorageService.GetAppointmentsByDoctorID:input_type -> storage.GetAppointmentsByDoctorIDRequest
-	47,  // 103: storage.StorageService.GetPatientByID:input_type -> storage.GetByIDRequest
-	49,  // 104: storage.StorageService.AddAppointment:input_type -> storage.AddAppointmentRequest
-	47,  // 105: storage.StorageService.GetAppointmentsByUserID:input_type -> storage.GetByIDRequest
-	47,  // 106: storage.StorageService.GetSpecsByDoctorID:input_type -> storage.GetByIDRequest
-	47,  // 107: storage.StorageService.GetDoctorByID:input_type -> storage.GetByIDRequest
-	50,  // 108: storage.StorageService.UpdateAppointment:input_type -> storage.UpdateAppointmentRequest
-	47,  // 109: storage.StorageService.GetAppointmentByID:input_type -> storage.GetByIDRequest
-	41,  // 110: storage.StorageService.AddClinicDailyOverride:input_type -> storage.AddClinicDailyOverrideRequest
-	42,  // 111: storage.StorageService.AddDoctorDailyOverride:input_type -> storage.AddDoctorDailyOverrideRequest
-	55,  // 112: storage.StorageService.GetClinicOverride:input_type -> storage.GetClinicOverrideRequest
-	57,  // 113: storage.StorageService.GetDoctorOverride:input_type -> storage.GetDoctorOverrideRequest
-	61,  // 114: storage.StorageService.AddMaterial:input_type -> storage.AddMaterialRequest
-	62,  // 115: storage.StorageService.AddService:input_type -> storage.AddServiceRequest
-	63,  // 116: storage.StorageService.UpdateMaterial:input_type -> storage.UpdateMaterialRequest
-	64,  // 117: storage.StorageService.UpdateService:input_type -> storage.UpdateServiceRequest
-	8,   // 118: storage.StorageService.GetMaterials:input_type -> storage.EmptyRequest
-	8,   // 119: storage.StorageService.GetServices:input_type -> storage.EmptyRequest
-	8,   // 120: storage.StorageService.GetServicesTypes:input_type -> storage.EmptyRequest
-	76,  // 121: storage.StorageService.GetServiceTypeById:input_type -> storage.GetServiceTypeByIdRequest
-	73,  // 122: storage.StorageService.DeleteMaterial:input_type -> storage.DeleteRequest
-	73,  // 123: storage.StorageService.DeleteService:input_type -> storage.DeleteRequest
-	47,  // 124: storage.StorageService.GetDoctorOverrides:input_type -> storage.GetByIDRequest
-	70,  // 125: storage.StorageService.GetPatientDiagnoses:input_type -> storage.GetByIdRequest
-	70,  // 126: storage.StorageService.GetPatientVisits:input_type -> storage.GetByIdRequest
-	70,  // 127: storage.StorageService.GetPatientAllergiesChronics:input_type -> storage.GetByIdRequest
-	8,   // 128: storage.StorageService.GetICDCodes:input_type -> storage.EmptyRequest
-	87,  // 129: storage.StorageService.AddPatientAllergiesChronics:input_type -> storage.AddPatientAllergiesChronicsRequest
-	88,  // 130: storage.StorageService.AddPatientVisit:input_type -> storage.AddPatientVisitRequest
-	84,  // 131: storage.StorageService.AddVisitMaterials:input_type -> storage.AddVisitMaterialsRequest
-	86,  // 132: storage.StorageService.AddVisitServices:input_type -> storage.AddVisitServicesRequest
-	89,  // 133: storage.StorageService.AddPatientDiagnoses:input_type -> storage.AddPatientDiagnosesRequest
-	96,  // 134: storage.StorageService.AddVisitPayment:input_type -> storage.VisitPaymentRequest
-	96,  // 135: storage.StorageService.UpdateVisitPayment:input_type -> storage.VisitPaymentRequest
-	70,  // 136: storage.StorageService.GetVisitByID:input_type -> storage.GetByIdRequest
-	98,  // 137: storage.StorageService.CalculateVisitTotal:input_type -> storage.CalculateVisitTotalRequest
-	100, // 138: storage.StorageService.AddOrUpdateVisitPayment:input_type -> storage.AddOrUpdateVisitPaymentRequest
-	8,   // 139: storage.StorageService.GetVisitsPayments:input_type -> storage.EmptyRequest
-	8,   // 140: storage.StorageService.GetClinicOverrides:input_type -> storage.EmptyRequest
-	8,   // 141: storage.StorageService.GetAppointments:input_type -> storage.EmptyRequest
-	47,  // 142: storage.StorageService.GetDiagnoseByVisitID:input_type -> storage.GetByIDRequest
-	106, // 143: storage.StorageService.SaveDocument:input_type -> storage.SaveDocumentRequest
-	108, // 144: storage.StorageService.GetDocumentMetadata:input_type -> storage.GetDocumentMetadataRequest
-	110, // 145: storage.StorageService.DownloadDocument:input_type -> storage.DownloadDocumentRequest
-	112, // 146: storage.StorageService.GetDocumentsByPatientID:input_type -> storage.GetDocumentsRequest
-	47,  // 147: storage.StorageService.GetAdminByID:input_type -> storage.GetByIDRequest
-	1,   // 148: storage.StorageService.AddUser:output_type -> storage.AddUserResponse
-	3,   // 149: storage.StorageService.AddDoctor:output_type -> storage.AddDoctorResponse
-	5,   // 150: storage.StorageService.AddAdmin:output_type -> storage.AddAdminResponse
-	7,   // 151: storage.StorageService.AddPatient:output_type -> storage.AddPatientResponse
-	24,  // 152: storage.StorageService.GetDoctors:output_type -> storage.GetDoctorsResponse
-	29,  // 153: storage.StorageService.GetAdmins:output_type -> storage.GetAdminsResponse
-	32,  // 154: storage.StorageService.GetPatients:output_type -> storage.GetPatientsResponse
-	25,  // 155: storage.StorageService.GetDoctorSpecsByDoctorId:output_type -> storage.GetDoctorSpecsByDoctorIdResponse
-	19,  // 156: storage.StorageService.UpdateDoctor:output_type -> storage.DefaultResponse
-	19,  // 157: storage.StorageService.AddDoctorSpec:output_type -> storage.DefaultResponse
-	19,  // 158: storage.StorageService.DeleteDoctorSpec:output_type -> storage.DefaultResponse
-	19,  // 159: storage.StorageService.UpdateAdmin:output_type -> storage.DefaultResponse
-	19,  // 160: storage.StorageService.UpdateAdminRole:output_type -> storage.DefaultResponse
-	19,  // 161: storage.StorageService.UpdatePatient:output_type -> storage.DefaultResponse
-	19,  // 162: storage.StorageService.DeleteUser:output_type -> storage.DefaultResponse
-	19,  // 163: storage.StorageService.UpdateUserLogin:output_type -> storage.DefaultResponse
-	10,  // 164: storage.StorageService.GetAllSpecs:output_type -> storage.GetAllSpecsResponse
-	12,  // 165: storage.StorageService.AddUserRole:output_type -> storage.AddUserRoleResponse
-	17,  // 166: storage.StorageService.GetUserByLogin:output_type -> storage.GetUserByLoginResponse
-	19,  // 167: storage.StorageService.UpdateUserPassword:output_type -> storage.DefaultResponse
-	34,  // 168: storage.StorageService.GetClinicWeeklySchedule:output_type -> storage.GetClinicWeeklyScheduleResponse
-	36,  // 169: storage.StorageService.GetUserRole:output_type -> storage.GetUserRoleResponse
-	15,  // 170: storage.StorageService.GetDoctorWeeklySchedule:output_type -> storage.GetScheduleByDoctorIdResponse
-	19,  // 171: storage.StorageService.UpdateClinicWeeklySchedule:output_type -> storage.DefaultResponse
-	19,  // 172: storage.StorageService.AddDoctorWeeklySchedule:output_type -> storage.DefaultResponse
-	19,  // 173: storage.StorageService.UpdateDoctorWeeklySchedule:output_type -> storage.DefaultResponse
-	19,  // 174: storage.StorageService.GetRolePermission:output_type -> storage.DefaultResponse
-	24,  // 175: storage.StorageService.GetDoctorsBySpecID:output_type -> storage.GetDoctorsResponse
-	46,  // 176: storage.StorageService.GetAppointmentsByDoctorID:output_type -> storage.GetAppointmentsByDoctorIDResponse
-	48,  // 177: storage.StorageService.GetPatientByID:output_type -> storage.GetPatientByIDResponse
-	19,  // 178: storage.StorageService.AddAppointment:output_type -> storage.DefaultResponse
-	51,  // 179: storage.StorageService.GetAppointmentsByUserID:output_type -> storage.GetAppointmentsByUserIDResponse
-	54,  // 180: storage.StorageService.GetSpecsByDoctorID:output_type -> storage.GetSpecsByDoctorIDResponse
-	53,  // 181: storage.StorageService.GetDoctorByID:output_type -> storage.GetDoctorByIDResponse
-	19,  // 182: storage.StorageService.UpdateAppointment:output_type -> storage.DefaultResponse
-	52,  // 183: storage.StorageService.GetAppointmentByID:output_type -> storage.GetAppointmentByIDResponse
-	19,  // 184: storage.StorageService.AddClinicDailyOverride:output_type -> storage.DefaultResponse
-	19,  // 185: storage.StorageService.AddDoctorDailyOverride:output_type -> storage.DefaultResponse
-	56,  // 186: storage.StorageService.GetClinicOverride:output_type -> storage.GetClinicOverrideResponse
-	58,  // 187: storage.StorageService.GetDoctorOverride:output_type -> storage.GetDoctorOverrideResponse
-	19,  // 188: storage.StorageService.AddMaterial:output_type -> storage.DefaultResponse
-	19,  // 189: storage.StorageService.AddService:output_type -> storage.DefaultResponse
-	19,  // 190: storage.StorageService.UpdateMaterial:output_type -> storage.DefaultResponse
-	19,  // 191: storage.StorageService.UpdateService:output_type -> storage.DefaultResponse
-	67,  // 192: storage.StorageService.GetMaterials:output_type -> storage.GetMaterialsResponse
-	68,  // 193: storage.StorageService.GetServices:output_type -> storage.GetServicesResponse
-	75,  // 194: storage.StorageService.GetServicesTypes:output_type -> storage.GetServicesTypesResponse
-	77,  // 195: storage.StorageService.GetServiceTypeById:output_type -> storage.GetServiceTypeByIdResponse
-	19,  // 196: storage.StorageService.DeleteMaterial:output_type -> storage.DefaultResponse
-	19,  // 197: storage.StorageService.DeleteService:output_type -> storage.DefaultResponse
-	60,  // 198: storage.StorageService.GetDoctorOverrides:output_type -> storage.GetDoctorOverridesResponse
-	90,  // 199: storage.StorageService.GetPatientDiagnoses:output_type -> storage.GetPatientDiagnosesResponse
-	91,  // 200: storage.StorageService.GetPatientVisits:output_type -> storage.GetPatientVisitsResponse
-	92,  // 201: storage.StorageService.GetPatientAllergiesChronics:output_type -> storage.GetPatientAllergiesChronicsResponse
-	93,  // 202: storage.StorageService.GetICDCodes:output_type -> storage.GetICDCodesResponse
-	19,  // 203: storage.StorageService.AddPatientAllergiesChronics:output_type -> storage.DefaultResponse
-	94,  // 204: storage.StorageService.AddPatientVisit:output_type -> storage.AddVisitResponse
-	19,  // 205: storage.StorageService.AddVisitMaterials:output_type -> storage.DefaultResponse
-	19,  // 206: storage.StorageService.AddVisitServices:output_type -> storage.DefaultResponse
-	19,  // 207: storage.StorageService.AddPatientDiagnoses:output_type -> storage.DefaultResponse
-	19,  // 208: storage.StorageService.AddVisitPayment:output_type -> storage.DefaultResponse
-	19,  // 209: storage.StorageService.UpdateVisitPayment:output_type -> storage.DefaultResponse
-	101, // 210: storage.StorageService.GetVisitByID:output_type -> storage.GetVisitByIDResponse
-	99,  // 211: storage.StorageService.CalculateVisitTotal:output_type -> storage.CalculateVisitTotalResponse
-	19,  // 212: storage.StorageService.AddOrUpdateVisitPayment:output_type -> storage.DefaultResponse
-	97,  // 213: storage.StorageService.GetVisitsPayments:output_type -> storage.GetVisitsPaymentsResponse
-	103, // 214: storage.StorageService.GetClinicOverrides:output_type -> storage.GetClinicOverridesResponse
-	104, // 215: storage.StorageService.GetAppointments:output_type -> storage.GetAppointmentsResponse
-	105, // 216: storage.StorageService.GetDiagnoseByVisitID:output_type -> storage.GetDiagnoseByVisitIDResponse
-	107, // 217: storage.StorageService.SaveDocument:output_type -> storage.SaveDocumentResponse
-	109, // 218: storage.StorageService.GetDocumentMetadata:output_type -> storage.GetDocumentMetadataResponse
-	111, // 219: storage.StorageService.DownloadDocument:output_type -> storage.DownloadDocumentResponse
-	114, // 220: storage.StorageService.GetDocumentsByPatientID:output_type -> storage.GetDocumentsResponse
-	115, // 221: storage.StorageService.GetAdminByID:output_type -> storage.GetAdminByIDResponse
-	148, // [148:222] is the sub-list for method output_type
-	74,  // [74:148] is the sub-list for method input_type
-	74,  // [74:74] is the sub-list for extension type_name
-	74,  // [74:74] is the sub-list for extension extendee
-	0,   // [0:74] is the sub-list for field type_name
+	124, // 0: storage.AddPatientRequest.birth_date:type_name -> google.protobuf.Timestamp
+	10,  // 1: storage.GetAllSpecsResponse.specs:type_name -> storage.Specialization
+	124, // 2: storage.WeeklyDoctorSchedule.start_time:type_name -> google.protobuf.Timestamp
+	124, // 3: storage.WeeklyDoctorSchedule.end_time:type_name -> google.protobuf.Timestamp
+	14,  // 4: storage.GetScheduleByDoctorIdResponse.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
+	9,   // 5: storage.GetDoctorsRequest.page:type_name -> storage.ListPage
+	21,  // 6: storage.GetDoctorsResponse.doctors:type_name -> storage.Doctor
+	9,   // 7: storage.GetAdminsRequest.page:type_name -> storage.ListPage
+	28,  // 8: storage.GetAdminsResponse.admins:type_name -> storage.Admin
+	124, // 9: storage.Patient.birth_date:type_name -> google.protobuf.Timestamp
+	124, // 10: storage.UpdatePatientRequest.birth_date:type_name -> google.protobuf.Timestamp
+	9,   // 11: storage.GetPatientsRequest.page:type_name -> storage.ListPage
+	33,  // 12: storage.GetPatientsResponse.patients:type_name -> storage.Patient
+	124, // 13: storage.WeeklyClinicSchedule.start_time:type_name -> google.protobuf.Timestamp
+	124, // 14: storage.WeeklyClinicSchedule.end_time:type_name -> google.protobuf.Timestamp
+	37,  // 15: storage.GetClinicWeeklyScheduleResponse.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
+	37,  // 16: storage.UpdateClinicWeeklyScheduleRequest.clinic_schedule:type_name -> storage.WeeklyClinicSchedule
+	14,  // 17: storage.AddDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
+	14,  // 18: storage.UpdateDoctorWeeklyScheduleRequest.doctor_schedule:type_name -> storage.WeeklyDoctorSchedule
+	124, // 19: storage.AddClinicDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	124, // 20: storage.AddClinicDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
+	124, // 21: storage.AddClinicDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
+	124, // 22: storage.AddDoctorDailyOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	124, // 23: storage.AddDoctorDailyOverrideRequest.start_time:type_name -> google.protobuf.Timestamp
+	124, // 24: storage.AddDoctorDailyOverrideRequest.end_time:type_name -> google.protobuf.Timestamp
+	124, // 25: storage.Appointment.date:type_name -> google.protobuf.Timestamp
+	124, // 26: storage.Appointment.time:type_name -> google.protobuf.Timestamp
+	124, // 27: storage.Appointment.birth_date:type_name -> google.protobuf.Timestamp
+	124, // 28: storage.Appointment.created_at:type_name -> google.protobuf.Timestamp
+	124, // 29: storage.Appointment.updated_at:type_name -> google.protobuf.Timestamp
+	49,  // 30: storage.GetAppointmentsByDoctorIDResponse.appointments:type_name -> storage.Appointment
+	33,  // 31: storage.GetPatientByIDResponse.patient:type_name -> storage.Patient
+	49,  // 32: storage.AddAppointmentRequest.appointment:type_name -> storage.Appointment
+	49,  // 33: storage.UpdateAppointmentRequest.appointment:type_name -> storage.Appointment
+	49,  // 34: storage.GetAppointmentsByUserIDResponse.appointment:type_name -> storage.Appointment
+	49,  // 35: storage.GetAppointmentByIDResponse.appointment:type_name -> storage.Appointment
+	21,  // 36: storage.GetDoctorByIDResponse.doctor:type_name -> storage.Doctor
+	124, // 37: storage.GetClinicOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	124, // 38: storage.GetClinicOverrideResponse.date:type_name -> google.protobuf.Timestamp
+	124, // 39: storage.GetClinicOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
+	124, // 40: storage.GetClinicOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
+	124, // 41: storage.GetDoctorOverrideRequest.date:type_name -> google.protobuf.Timestamp
+	124, // 42: storage.GetDoctorOverrideResponse.date:type_name -> google.protobuf.Timestamp
+	124, // 43: storage.GetDoctorOverrideResponse.start_time:type_name -> google.protobuf.Timestamp
+	124, // 44: storage.GetDoctorOverrideResponse.end_time:type_name -> google.protobuf.Timestamp
+	124, // 45: storage.DoctorOverride.date:type_name -> google.protobuf.Timestamp
+	124, // 46: storage.DoctorOverride.start_time:type_name -> google.protobuf.Timestamp
+	124, // 47: storage.DoctorOverride.end_time:type_name -> google.protobuf.Timestamp
+	63,  // 48: storage.GetDoctorOverridesResponse.override:type_name -> storage.DoctorOverride
+	69,  // 49: storage.GetMaterialsResponse.materials:type_name -> storage.Material
+	70,  // 50: storage.GetServicesResponse.services:type_name -> storage.Service
+	78,  // 51: storage.GetServicesTypesResponse.types:type_name -> storage.ServiceType
+	124, // 52: storage.Visit.created_at:type_name -> google.protobuf.Timestamp
+	87,  // 53: storage.AddVisitMaterialsRequest.materials:type_name -> storage.AddVisitMaterials
+	89,  // 54: storage.AddVisitServicesRequest.services:type_name -> storage.AddVisitServices
+	86,  // 55: storage.AddPatientAllergiesChronicsRequest.notes:type_name -> storage.PatientAllergiesChronics
+	124, // 56: storage.AddPatientVisitRequest.created_at:type_name -> google.protobuf.Timestamp
+	84,  // 57: storage.AddPatientDiagnosesRequest.diagnoses:type_name -> storage.Diagnose
+	84,  // 58: storage.GetPatientDiagnosesResponse.diagnoses:type_name -> storage.Diagnose
+	85,  // 59: storage.GetPatientVisitsResponse.visits:type_name -> storage.Visit
+	86,  // 60: storage.GetPatientAllergiesChronicsResponse.patient_allergies_chronics:type_name -> storage.PatientAllergiesChronics
+	9,   // 61: storage.GetICDCodesRequest.page:type_name -> storage.ListPage
+	83,  // 62: storage.GetICDCodesResponse.icd_code:type_name -> storage.ICDCode
+	9,   // 63: storage.GetVisitsPaymentsRequest.page:type_name -> storage.ListPage
+	100, // 64: storage.GetVisitsPaymentsResponse.visit_payment:type_name -> storage.VisitPayment
+	100, // 65: storage.AddOrUpdateVisitPaymentRequest.payment:type_name -> storage.VisitPayment
+	85,  // 66: storage.GetVisitByIDResponse.visit:type_name -> storage.Visit
+	124, // 67: storage.ClinicOverride.date:type_name -> google.protobuf.Timestamp
+	124, // 68: storage.ClinicOverride.start_time:type_name -> google.protobuf.Timestamp
+	124, // 69: storage.ClinicOverride.end_time:type_name -> google.protobuf.Timestamp
+	108, // 70: storage.GetClinicOverridesResponse.overrides:type_name -> storage.ClinicOverride
+	9,   // 71: storage.GetAppointmentsRequest.page:type_name -> storage.ListPage
+	124, // 72: storage.GetAppointmentsRequest.date_from:type_name -> google.protobuf.Timestamp
+	124, // 73: storage.GetAppointmentsRequest.date_to:type_name -> google.protobuf.Timestamp
+	49,  // 74: storage.GetAppointmentsResponse.appointments:type_name -> storage.Appointment
+	84,  // 75: storage.GetDiagnoseByVisitIDResponse.diagnose:type_name -> storage.Diagnose
+	124, // 76: storage.SaveDocumentRequest.study_date:type_name -> google.protobuf.Timestamp
+	124, // 77: storage.GetDocumentMetadataResponse.study_date:type_name -> google.protobuf.Timestamp
+	124, // 78: storage.GetDocumentMetadataResponse.created_at:type_name -> google.protobuf.Timestamp
+	124, // 79: storage.DocumentInfo.created_at:type_name -> google.protobuf.Timestamp
+	120, // 80: storage.GetDocumentsResponse.documents:type_name -> storage.DocumentInfo
+	28,  // 81: storage.GetAdminByIDResponse.admin:type_name -> storage.Admin
+	124, // 82: storage.AuditEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	0,   // 83: storage.StorageService.AddUser:input_type -> storage.AddUserRequest
+	2,   // 84: storage.StorageService.AddDoctor:input_type -> storage.AddDoctorRequest
+	4,   // 85: storage.StorageService.AddAdmin:input_type -> storage.AddAdminRequest
+	6,   // 86: storage.StorageService.AddPatient:input_type -> storage.AddPatientRequest
+	25,  // 87: storage.StorageService.GetDoctors:input_type -> storage.GetDoctorsRequest
+	31,  // 88: storage.StorageService.GetAdmins:input_type -> storage.GetAdminsRequest
+	35,  // 89: storage.StorageService.GetPatients:input_type -> storage.GetPatientsRequest
+	74,  // 90: storage.StorageService.GetDoctorSpecsByDoctorId:input_type -> storage.GetByIdRequest
+	22,  // 91: storage.StorageService.UpdateDoctor:input_type -> storage.UpdateDoctorRequest
+	23,  // 92: storage.StorageService.AddDoctorSpec:input_type -> storage.AddDoctorSpecRequest
+	24,  // 93: storage.StorageService.DeleteDoctorSpec:input_type -> storage.DeleteDoctorSpecRequest
+	29,  // 94: storage.StorageService.UpdateAdmin:input_type -> storage.UpdateAdminRequest
+	30,  // 95: storage.StorageService.UpdateAdminRole:input_type -> storage.UpdateAdminRoleRequest
+	34,  // 96: storage.StorageService.UpdatePatient:input_type -> storage.UpdatePatientRequest
+	77,  // 97: storage.StorageService.DeleteUser:input_type -> storage.DeleteRequest
+	82,  // 98: storage.StorageService.UpdateUserLogin:input_type -> storage.UpdateUserLoginRequest
+	8,   // 99: storage.StorageService.GetAllSpecs:input_type -> storage.EmptyRequest
+	12,  // 100: storage.StorageService.AddUserRole:input_type -> storage.AddUserRoleRequest
+	17,  // 101: storage.StorageService.GetUserByLogin:input_type -> storage.GetUserByLoginRequest
+	19,  // 102: storage.StorageService.UpdateUserPassword:input_type -> storage.UpdateUserPasswordRequest
+	8,   // 103: storage.StorageService.GetClinicWeeklySchedule:input_type -> storage.EmptyRequest
+	39,  // 104: storage.StorageService.GetUserRole:input_type -> storage.GetUserRoleRequest
+	15,  // 105: storage.StorageService.GetDoctorWeeklySchedule:input_type -> storage.GetScheduleByDoctorIdRequest
+	41,  // 106: storage.StorageService.UpdateClinicWeeklySchedule:input_type -> storage.UpdateClinicWeeklyScheduleRequest
+	42,  // 107: storage.StorageService.AddDoctorWeeklySchedule:input_type -> storage.AddDoctorWeeklyScheduleRequest
+	43,  // 108: storage.StorageService.UpdateDoctorWeeklySchedule:input_type -> storage.UpdateDoctorWeeklyScheduleRequest
+	44,  // 109: storage.StorageService.GetRolePermission:input_type -> storage.GetRolePermissionRequest
+	47,  // 110: storage.StorageService.GetDoctorsBySpecID:input_type -> storage.GetDoctorBySpecIDRequest
+	48,  // 111: storage.StorageService.GetAppointmentsByDoctorID:input_type -> storage.GetAppointmentsByDoctorIDRequest
+	51,  // 112: storage.StorageService.GetPatientByID:input_type -> storage.GetByIDRequest
+	53,  // 113: storage.StorageService.AddAppointment:input_type -> storage.AddAppointmentRequest
+	51,  // 114: storage.StorageService.GetAppointmentsByUserID:input_type -> storage.GetByIDRequest
+	51,  // 115: storage.StorageService.GetSpecsByDoctorID:input_type -> storage.GetByIDRequest
+	51,  // 116: storage.StorageService.GetDoctorByID:input_type -> storage.GetByIDRequest
+	54,  // 117: storage.StorageService.UpdateAppointment:input_type -> storage.UpdateAppointmentRequest
+	51,  // 118: storage.StorageService.GetAppointmentByID:input_type -> storage.GetByIDRequest
+	45,  // 119: storage.StorageService.AddClinicDailyOverride:input_type -> storage.AddClinicDailyOverrideRequest
+	46,  // 120: storage.StorageService.AddDoctorDailyOverride:input_type -> storage.AddDoctorDailyOverrideRequest
+	59,  // 121: storage.StorageService.GetClinicOverride:input_type -> storage.GetClinicOverrideRequest
+	61,  // 122: storage.StorageService.GetDoctorOverride:input_type -> storage.GetDoctorOverrideRequest
+	65,  // 123: storage.StorageService.AddMaterial:input_type -> storage.AddMaterialRequest
+	66,  // 124: storage.StorageService.AddService:input_type -> storage.AddServiceRequest
+	67,  // 125: storage.StorageService.UpdateMaterial:input_type -> storage.UpdateMaterialRequest
+	68,  // 126: storage.StorageService.UpdateService:input_type -> storage.UpdateServiceRequest
+	8,   // 127: storage.StorageService.GetMaterials:input_type -> storage.EmptyRequest
+	8,   // 128: storage.StorageService.GetServices:input_type -> storage.EmptyRequest
+	8,   // 129: storage.StorageService.GetServicesTypes:input_type -> storage.EmptyRequest
+	80,  // 130: storage.StorageService.GetServiceTypeById:input_type -> storage.GetServiceTypeByIdRequest
+	77,  // 131: storage.StorageService.DeleteMaterial:input_type -> storage.DeleteRequest
+	77,  // 132: storage.StorageService.DeleteService:input_type -> storage.DeleteRequest
+	51,  // 133: storage.StorageService.GetDoctorOverrides:input_type -> storage.GetByIDRequest
+	74,  // 134: storage.StorageService.GetPatientDiagnoses:input_type -> storage.GetByIdRequest
+	74,  // 135: storage.StorageService.GetPatientVisits:input_type -> storage.GetByIdRequest
+	74,  // 136: storage.StorageService.GetPatientAllergiesChronics:input_type -> storage.GetByIdRequest
+	97,  // 137: storage.StorageService.GetICDCodes:input_type -> storage.GetICDCodesRequest
+	91,  // 138: storage.StorageService.AddPatientAllergiesChronics:input_type -> storage.AddPatientAllergiesChronicsRequest
+	92,  // 139: storage.StorageService.AddPatientVisit:input_type -> storage.AddPatientVisitRequest
+	88,  // 140: storage.StorageService.AddVisitMaterials:input_type -> storage.AddVisitMaterialsRequest
+	90,  // 141: storage.StorageService.AddVisitServices:input_type -> storage.AddVisitServicesRequest
+	93,  // 142: storage.StorageService.AddPatientDiagnoses:input_type -> storage.AddPatientDiagnosesRequest
+	101, // 143: storage.StorageService.AddVisitPayment:input_type -> storage.VisitPaymentRequest
+	101, // 144: storage.StorageService.UpdateVisitPayment:input_type -> storage.VisitPaymentRequest
+	74,  // 145: storage.StorageService.GetVisitByID:input_type -> storage.GetByIdRequest
+	104, // 146: storage.StorageService.CalculateVisitTotal:input_type -> storage.CalculateVisitTotalRequest
+	106, // 147: storage.StorageService.AddOrUpdateVisitPayment:input_type -> storage.AddOrUpdateVisitPaymentRequest
+	102, // 148: storage.StorageService.GetVisitsPayments:input_type -> storage.GetVisitsPaymentsRequest
+	8,   // 149: storage.StorageService.GetClinicOverrides:input_type -> storage.EmptyRequest
+	110, // 150: storage.StorageService.GetAppointments:input_type -> storage.GetAppointmentsRequest
+	51,  // 151: storage.StorageService.GetDiagnoseByVisitID:input_type -> storage.GetByIDRequest
+	113, // 152: storage.StorageService.SaveDocument:input_type -> storage.SaveDocumentRequest
+	115, // 153: storage.StorageService.GetDocumentMetadata:input_type -> storage.GetDocumentMetadataRequest
+	117, // 154: storage.StorageService.DownloadDocument:input_type -> storage.DownloadDocumentRequest
+	119, // 155: storage.StorageService.GetDocumentsByPatientID:input_type -> storage.GetDocumentsRequest
+	51,  // 156: storage.StorageService.GetAdminByID:input_type -> storage.GetByIDRequest
+	123, // 157: storage.StorageService.AddAuditEntry:input_type -> storage.AuditEntry
+	1,   // 158: storage.StorageService.AddUser:output_type -> storage.AddUserResponse
+	3,   // 159: storage.StorageService.AddDoctor:output_type -> storage.AddDoctorResponse
+	5,   // 160: storage.StorageService.AddAdmin:output_type -> storage.AddAdminResponse
+	7,   // 161: storage.StorageService.AddPatient:output_type -> storage.AddPatientResponse
+	26,  // 162: storage.StorageService.GetDoctors:output_type -> storage.GetDoctorsResponse
+	32,  // 163: storage.StorageService.GetAdmins:output_type -> storage.GetAdminsResponse
+	36,  // 164: storage.StorageService.GetPatients:output_type -> storage.GetPatientsResponse
+	27,  // 165: storage.StorageService.GetDoctorSpecsByDoctorId:output_type -> storage.GetDoctorSpecsByDoctorIdResponse
+	20,  // 166: storage.StorageService.UpdateDoctor:output_type -> storage.DefaultResponse
+	20,  // 167: storage.StorageService.AddDoctorSpec:output_type -> storage.DefaultResponse
+	20,  // 168: storage.StorageService.DeleteDoctorSpec:output_type -> storage.DefaultResponse
+	20,  // 169: storage.StorageService.UpdateAdmin:output_type -> storage.DefaultResponse
+	20,  // 170: storage.StorageService.UpdateAdminRole:output_type -> storage.DefaultResponse
+	20,  // 171: storage.StorageService.UpdatePatient:output_type -> storage.DefaultResponse
+	20,  // 172: storage.StorageService.DeleteUser:output_type -> storage.DefaultResponse
+	20,  // 173: storage.StorageService.UpdateUserLogin:output_type -> storage.DefaultResponse
+	11,  // 174: storage.StorageService.GetAllSpecs:output_type -> storage.GetAllSpecsResponse
+	13,  // 175: storage.StorageService.AddUserRole:output_type -> storage.AddUserRoleResponse
+	18,  // 176: storage.StorageService.GetUserByLogin:output_type -> storage.GetUserByLoginResponse
+	20,  // 177: storage.StorageService.UpdateUserPassword:output_type -> storage.DefaultResponse
+	38,  // 178: storage.StorageService.GetClinicWeeklySchedule:output_type -> storage.GetClinicWeeklyScheduleResponse
+	40,  // 179: storage.StorageService.GetUserRole:output_type -> storage.GetUserRoleResponse
+	16,  // 180: storage.StorageService.GetDoctorWeeklySchedule:output_type -> storage.GetScheduleByDoctorIdResponse
+	20,  // 181: storage.StorageService.UpdateClinicWeeklySchedule:output_type -> storage.DefaultResponse
+	20,  // 182: storage.StorageService.AddDoctorWeeklySchedule:output_type -> storage.DefaultResponse
+	20,  // 183: storage.StorageService.UpdateDoctorWeeklySchedule:output_type -> storage.DefaultResponse
+	20,  // 184: storage.StorageService.GetRolePermission:output_type -> storage.DefaultResponse
+	26,  // 185: storage.StorageService.GetDoctorsBySpecID:output_type -> storage.GetDoctorsResponse
+	50,  // 186: storage.StorageService.GetAppointmentsByDoctorID:output_type -> storage.GetAppointmentsByDoctorIDResponse
+	52,  // 187: storage.StorageService.GetPatientByID:output_type -> storage.GetPatientByIDResponse
+	20,  // 188: storage.StorageService.AddAppointment:output_type -> storage.DefaultResponse
+	55,  // 189: storage.StorageService.GetAppointmentsByUserID:output_type -> storage.GetAppointmentsByUserIDResponse
+	58,  // 190: storage.StorageService.GetSpecsByDoctorID:output_type -> storage.GetSpecsByDoctorIDResponse
+	57,  // 191: storage.StorageService.GetDoctorByID:output_type -> storage.GetDoctorByIDResponse
+	20,  // 192: storage.StorageService.UpdateAppointment:output_type -> storage.DefaultResponse
+	56,  // 193: storage.StorageService.GetAppointmentByID:output_type -> storage.GetAppointmentByIDResponse
+	20,  // 194: storage.StorageService.AddClinicDailyOverride:output_type -> storage.DefaultResponse
+	20,  // 195: storage.StorageService.AddDoctorDailyOverride:output_type -> storage.DefaultResponse
+	60,  // 196: storage.StorageService.GetClinicOverride:output_type -> storage.GetClinicOverrideResponse
+	62,  // 197: storage.StorageService.GetDoctorOverride:output_type -> storage.GetDoctorOverrideResponse
+	20,  // 198: storage.StorageService.AddMaterial:output_type -> storage.DefaultResponse
+	20,  // 199: storage.StorageService.AddService:output_type -> storage.DefaultResponse
+	20,  // 200: storage.StorageService.UpdateMaterial:output_type -> storage.DefaultResponse
+	20,  // 201: storage.StorageService.UpdateService:output_type -> storage.DefaultResponse
+	71,  // 202: storage.StorageService.GetMaterials:output_type -> storage.GetMaterialsResponse
+	72,  // 203: storage.StorageService.GetServices:output_type -> storage.GetServicesResponse
+	79,  // 204: storage.StorageService.GetServicesTypes:output_type -> storage.GetServicesTypesResponse
+	81,  // 205: storage.StorageService.GetServiceTypeById:output_type -> storage.GetServiceTypeByIdResponse
+	20,  // 206: storage.StorageService.DeleteMaterial:output_type -> storage.DefaultResponse
+	20,  // 207: storage.StorageService.DeleteService:output_type -> storage.DefaultResponse
+	64,  // 208: storage.StorageService.GetDoctorOverrides:output_type -> storage.GetDoctorOverridesResponse
+	94,  // 209: storage.StorageService.GetPatientDiagnoses:output_type -> storage.GetPatientDiagnosesResponse
+	95,  // 210: storage.StorageService.GetPatientVisits:output_type -> storage.GetPatientVisitsResponse
+	96,  // 211: storage.StorageService.GetPatientAllergiesChronics:output_type -> storage.GetPatientAllergiesChronicsResponse
+	98,  // 212: storage.StorageService.GetICDCodes:output_type -> storage.GetICDCodesResponse
+	20,  // 213: storage.StorageService.AddPatientAllergiesChronics:output_type -> storage.DefaultResponse
+	99,  // 214: storage.StorageService.AddPatientVisit:output_type -> storage.AddVisitResponse
+	20,  // 215: storage.StorageService.AddVisitMaterials:output_type -> storage.DefaultResponse
+	20,  // 216: storage.StorageService.AddVisitServices:output_type -> storage.DefaultResponse
+	20,  // 217: storage.StorageService.AddPatientDiagnoses:output_type -> storage.DefaultResponse
+	20,  // 218: storage.StorageService.AddVisitPayment:output_type -> storage.DefaultResponse
+	20,  // 219: storage.StorageService.UpdateVisitPayment:output_type -> storage.DefaultResponse
+	107, // 220: storage.StorageService.GetVisitByID:output_type -> storage.GetVisitByIDResponse
+	105, // 221: storage.StorageService.CalculateVisitTotal:output_type -> storage.CalculateVisitTotalResponse
+	20,  // 222: storage.StorageService.AddOrUpdateVisitPayment:output_type -> storage.DefaultResponse
+	103, // 223: storage.StorageService.GetVisitsPayments:output_type -> storage.GetVisitsPaymentsResponse
+	109, // 224: storage.StorageService.GetClinicOverrides:output_type -> storage.GetClinicOverridesResponse
+	111, // 225: storage.StorageService.GetAppointments:output_type -> storage.GetAppointmentsResponse
+	112, // 226: storage.StorageService.GetDiagnoseByVisitID:output_type -> storage.GetDiagnoseByVisitIDResponse
+	114, // 227: storage.StorageService.SaveDocument:output_type -> storage.SaveDocumentResponse
+	116, // 228: storage.StorageService.GetDocumentMetadata:output_type -> storage.GetDocumentMetadataResponse
+	118, // 229: storage.StorageService.DownloadDocument:output_type -> storage.DownloadDocumentResponse
+	121, // 230: storage.StorageService.GetDocumentsByPatientID:output_type -> storage.GetDocumentsResponse
+	122, // 231: storage.StorageService.GetAdminByID:output_type -> storage.GetAdminByIDResponse
+	20,  // 232: storage.StorageService.AddAuditEntry:output_type -> storage.DefaultResponse
+	158, // [158:233] is the sub-list for method output_type
+	83,  // [83:158] is the sub-list for method input_type
+	83,  // [83:83] is the sub-list for extension type_name
+	83,  // [83:83] is the sub-list for extension extendee
+	0,   // [0:83] is the sub-list for field type_name
 }
 
 func init() { file_proto_storage_storage_proto_init() }
@@ -7629,7 +8313,7 @@ func file_proto_storage_storage_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_storage_storage_proto_rawDesc), len(file_proto_storage_storage_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   116,
+			NumMessages:   124,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
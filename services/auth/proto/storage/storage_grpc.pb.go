@@ -93,6 +93,7 @@ const (
 	StorageService_DownloadDocument_FullMethodName            = "/storage.StorageService/DownloadDocument"
 	StorageService_GetDocumentsByPatientID_FullMethodName     = "/storage.StorageService/GetDocumentsByPatientID"
 	StorageService_GetAdminByID_FullMethodName                = "/storage.StorageService/GetAdminByID"
+	StorageService_AddAuditEntry_FullMethodName               = "/storage.StorageService/AddAuditEntry"
 )
 
 // StorageServiceClient is the client API for StorageService service.
@@ -104,9 +105,9 @@ type StorageServiceClient interface {
 	AddDoctor(ctx context.Context, in *AddDoctorRequest, opts ...grpc.CallOption) (*AddDoctorResponse, error)
 	AddAdmin(ctx context.Context, in *AddAdminRequest, opts ...grpc.CallOption) (*AddAdminResponse, error)
 	AddPatient(ctx context.Context, in *AddPatientRequest, opts ...grpc.CallOption) (*AddPatientResponse, error)
-	GetDoctors(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error)
-	GetAdmins(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error)
-	GetPatients(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error)
+	GetDoctors(ctx context.Context, in *GetDoctorsRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error)
+	GetAdmins(ctx context.Context, in *GetAdminsRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error)
+	GetPatients(ctx context.Context, in *GetPatientsRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error)
 	GetDoctorSpecsByDoctorId(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetDoctorSpecsByDoctorIdResponse, error)
 	UpdateDoctor(ctx context.Context, in *UpdateDoctorRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	AddDoctorSpec(ctx context.Context, in *AddDoctorSpecRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
@@ -156,7 +157,7 @@ type StorageServiceClient interface {
 	GetPatientDiagnoses(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientDiagnosesResponse, error)
 	GetPatientVisits(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientVisitsResponse, error)
 	GetPatientAllergiesChronics(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetPatientAllergiesChronicsResponse, error)
-	GetICDCodes(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error)
+	GetICDCodes(ctx context.Context, in *GetICDCodesRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error)
 	AddPatientAllergiesChronics(ctx context.Context, in *AddPatientAllergiesChronicsRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	AddPatientVisit(ctx context.Context, in *AddPatientVisitRequest, opts ...grpc.CallOption) (*AddVisitResponse, error)
 	AddVisitMaterials(ctx context.Context, in *AddVisitMaterialsRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
@@ -167,15 +168,17 @@ type StorageServiceClient interface {
 	GetVisitByID(ctx context.Context, in *GetByIdRequest, opts ...grpc.CallOption) (*GetVisitByIDResponse, error)
 	CalculateVisitTotal(ctx context.Context, in *CalculateVisitTotalRequest, opts ...grpc.CallOption) (*CalculateVisitTotalResponse, error)
 	AddOrUpdateVisitPayment(ctx context.Context, in *AddOrUpdateVisitPaymentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
-	GetVisitsPayments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetVisitsPaymentsResponse, error)
+	GetVisitsPayments(ctx context.Context, in *GetVisitsPaymentsRequest, opts ...grpc.CallOption) (*GetVisitsPaymentsResponse, error)
 	GetClinicOverrides(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetClinicOverridesResponse, error)
-	GetAppointments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAppointmentsResponse, error)
+	GetAppointments(ctx context.Context, in *GetAppointmentsRequest, opts ...grpc.CallOption) (*GetAppointmentsResponse, error)
 	GetDiagnoseByVisitID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetDiagnoseByVisitIDResponse, error)
 	SaveDocument(ctx context.Context, in *SaveDocumentRequest, opts ...grpc.CallOption) (*SaveDocumentResponse, error)
 	GetDocumentMetadata(ctx context.Context, in *GetDocumentMetadataRequest, opts ...grpc.CallOption) (*GetDocumentMetadataResponse, error)
 	DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (*DownloadDocumentResponse, error)
 	GetDocumentsByPatientID(ctx context.Context, in *GetDocumentsRequest, opts ...grpc.CallOption) (*GetDocumentsResponse, error)
 	GetAdminByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetAdminByIDResponse, error)
+	// журнал аудита
+	AddAuditEntry(ctx context.Context, in *AuditEntry, opts ...grpc.CallOption) (*DefaultResponse, error)
 }
 
 type storageServiceClient struct {
@@ -226,7 +229,7 @@ func (c *storageServiceClient) AddPatient(ctx context.Context, in *AddPatientReq
 	return out, nil
 }
 
-func (c *storageServiceClient) GetDoctors(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error) {
+func (c *storageServiceClient) GetDoctors(ctx context.Context, in *GetDoctorsRequest, opts ...grpc.CallOption) (*GetDoctorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDoctorsResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetDoctors_FullMethodName, in, out, cOpts...)
@@ -236,7 +239,7 @@ func (c *storageServiceClient) GetDoctors(ctx context.Context, in *EmptyRequest,
 	return out, nil
 }
 
-func (c *storageServiceClient) GetAdmins(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error) {
+func (c *storageServiceClient) GetAdmins(ctx context.Context, in *GetAdminsRequest, opts ...grpc.CallOption) (*GetAdminsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAdminsResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetAdmins_FullMethodName, in, out, cOpts...)
@@ -246,7 +249,7 @@ func (c *storageServiceClient) GetAdmins(ctx context.Context, in *EmptyRequest,
 	return out, nil
 }
 
-func (c *storageServiceClient) GetPatients(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error) {
+func (c *storageServiceClient) GetPatients(ctx context.Context, in *GetPatientsRequest, opts ...grpc.CallOption) (*GetPatientsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPatientsResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetPatients_FullMethodName, in, out, cOpts...)
@@ -726,7 +729,7 @@ func (c *storageServiceClient) GetPatientAllergiesChronics(ctx context.Context,
 	return out, nil
 }
 
-func (c *storageServiceClient) GetICDCodes(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error) {
+func (c *storageServiceClient) GetICDCodes(ctx context.Context, in *GetICDCodesRequest, opts ...grpc.CallOption) (*GetICDCodesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetICDCodesResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetICDCodes_FullMethodName, in, out, cOpts...)
@@ -836,7 +839,7 @@ func (c *storageServiceClient) AddOrUpdateVisitPayment(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *storageServiceClient) GetVisitsPayments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetVisitsPaymentsResponse, error) {
+func (c *storageServiceClient) GetVisitsPayments(ctx context.Context, in *GetVisitsPaymentsRequest, opts ...grpc.CallOption) (*GetVisitsPaymentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetVisitsPaymentsResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetVisitsPayments_FullMethodName, in, out, cOpts...)
@@ -856,7 +859,7 @@ func (c *storageServiceClient) GetClinicOverrides(ctx context.Context, in *Empty
 	return out, nil
 }
 
-func (c *storageServiceClient) GetAppointments(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetAppointmentsResponse, error) {
+func (c *storageServiceClient) GetAppointments(ctx context.Context, in *GetAppointmentsRequest, opts ...grpc.CallOption) (*GetAppointmentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAppointmentsResponse)
 	err := c.cc.Invoke(ctx, StorageService_GetAppointments_FullMethodName, in, out, cOpts...)
@@ -926,6 +929,16 @@ func (c *storageServiceClient) GetAdminByID(ctx context.Context, in *GetByIDRequ
 	return out, nil
 }
 
+func (c *storageServiceClient) AddAuditEntry(ctx context.Context, in *AuditEntry, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddAuditEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageServiceServer is the server API for StorageService service.
 // All implementations must embed UnimplementedStorageServiceServer
 // for forward compatibility.
@@ -935,9 +948,9 @@ type StorageServiceServer interface {
 	AddDoctor(context.Context, *AddDoctorRequest) (*AddDoctorResponse, error)
 	AddAdmin(context.Context, *AddAdminRequest) (*AddAdminResponse, error)
 	AddPatient(context.Context, *AddPatientRequest) (*AddPatientResponse, error)
-	GetDoctors(context.Context, *EmptyRequest) (*GetDoctorsResponse, error)
-	GetAdmins(context.Context, *EmptyRequest) (*GetAdminsResponse, error)
-	GetPatients(context.Context, *EmptyRequest) (*GetPatientsResponse, error)
+	GetDoctors(context.Context, *GetDoctorsRequest) (*GetDoctorsResponse, error)
+	GetAdmins(context.Context, *GetAdminsRequest) (*GetAdminsResponse, error)
+	GetPatients(context.Context, *GetPatientsRequest) (*GetPatientsResponse, error)
 	GetDoctorSpecsByDoctorId(context.Context, *GetByIdRequest) (*GetDoctorSpecsByDoctorIdResponse, error)
 	UpdateDoctor(context.Context, *UpdateDoctorRequest) (*DefaultResponse, error)
 	AddDoctorSpec(context.Context, *AddDoctorSpecRequest) (*DefaultResponse, error)
@@ -987,7 +1000,7 @@ type StorageServiceServer interface {
 	GetPatientDiagnoses(context.Context, *GetByIdRequest) (*GetPatientDiagnosesResponse, error)
 	GetPatientVisits(context.Context, *GetByIdRequest) (*GetPatientVisitsResponse, error)
 	GetPatientAllergiesChronics(context.Context, *GetByIdRequest) (*GetPatientAllergiesChronicsResponse, error)
-	GetICDCodes(context.Context, *EmptyRequest) (*GetICDCodesResponse, error)
+	GetICDCodes(context.Context, *GetICDCodesRequest) (*GetICDCodesResponse, error)
 	AddPatientAllergiesChronics(context.Context, *AddPatientAllergiesChronicsRequest) (*DefaultResponse, error)
 	AddPatientVisit(context.Context, *AddPatientVisitRequest) (*AddVisitResponse, error)
 	AddVisitMaterials(context.Context, *AddVisitMaterialsRequest) (*DefaultResponse, error)
@@ -998,15 +1011,17 @@ type StorageServiceServer interface {
 	GetVisitByID(context.Context, *GetByIdRequest) (*GetVisitByIDResponse, error)
 	CalculateVisitTotal(context.Context, *CalculateVisitTotalRequest) (*CalculateVisitTotalResponse, error)
 	AddOrUpdateVisitPayment(context.Context, *AddOrUpdateVisitPaymentRequest) (*DefaultResponse, error)
-	GetVisitsPayments(context.Context, *EmptyRequest) (*GetVisitsPaymentsResponse, error)
+	GetVisitsPayments(context.Context, *GetVisitsPaymentsRequest) (*GetVisitsPaymentsResponse, error)
 	GetClinicOverrides(context.Context, *EmptyRequest) (*GetClinicOverridesResponse, error)
-	GetAppointments(context.Context, *EmptyRequest) (*GetAppointmentsResponse, error)
+	GetAppointments(context.Context, *GetAppointmentsRequest) (*GetAppointmentsResponse, error)
 	GetDiagnoseByVisitID(context.Context, *GetByIDRequest) (*GetDiagnoseByVisitIDResponse, error)
 	SaveDocument(context.Context, *SaveDocumentRequest) (*SaveDocumentResponse, error)
 	GetDocumentMetadata(context.Context, *GetDocumentMetadataRequest) (*GetDocumentMetadataResponse, error)
 	DownloadDocument(context.Context, *DownloadDocumentRequest) (*DownloadDocumentResponse, error)
 	GetDocumentsByPatientID(context.Context, *GetDocumentsRequest) (*GetDocumentsResponse, error)
 	GetAdminByID(context.Context, *GetByIDRequest) (*GetAdminByIDResponse, error)
+	// журнал аудита
+	AddAuditEntry(context.Context, *AuditEntry) (*DefaultResponse, error)
 	mustEmbedUnimplementedStorageServiceServer()
 }
 
@@ -1029,13 +1044,13 @@ func (UnimplementedStorageServiceServer) AddAdmin(context.Context, *AddAdminRequ
 func (UnimplementedStorageServiceServer) AddPatient(context.Context, *AddPatientRequest) (*AddPatientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPatient not implemented")
 }
-func (UnimplementedStorageServiceServer) GetDoctors(context.Context, *EmptyRequest) (*GetDoctorsResponse, error) {
+func (UnimplementedStorageServiceServer) GetDoctors(context.Context, *GetDoctorsRequest) (*GetDoctorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDoctors not implemented")
 }
-func (UnimplementedStorageServiceServer) GetAdmins(context.Context, *EmptyRequest) (*GetAdminsResponse, error) {
+func (UnimplementedStorageServiceServer) GetAdmins(context.Context, *GetAdminsRequest) (*GetAdminsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAdmins not implemented")
 }
-func (UnimplementedStorageServiceServer) GetPatients(context.Context, *EmptyRequest) (*GetPatientsResponse, error) {
+func (UnimplementedStorageServiceServer) GetPatients(context.Context, *GetPatientsRequest) (*GetPatientsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPatients not implemented")
 }
 func (UnimplementedStorageServiceServer) GetDoctorSpecsByDoctorId(context.Context, *GetByIdRequest) (*GetDoctorSpecsByDoctorIdResponse, error) {
@@ -1179,7 +1194,7 @@ func (UnimplementedStorageServiceServer) GetPatientVisits(context.Context, *GetB
 func (UnimplementedStorageServiceServer) GetPatientAllergiesChronics(context.Context, *GetByIdRequest) (*GetPatientAllergiesChronicsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPatientAllergiesChronics not implemented")
 }
-func (UnimplementedStorageServiceServer) GetICDCodes(context.Context, *EmptyRequest) (*GetICDCodesResponse, error) {
+func (UnimplementedStorageServiceServer) GetICDCodes(context.Context, *GetICDCodesRequest) (*GetICDCodesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetICDCodes not implemented")
 }
 func (UnimplementedStorageServiceServer) AddPatientAllergiesChronics(context.Context, *AddPatientAllergiesChronicsRequest) (*DefaultResponse, error) {
@@ -1212,13 +1227,13 @@ func (UnimplementedStorageServiceServer) CalculateVisitTotal(context.Context, *C
 func (UnimplementedStorageServiceServer) AddOrUpdateVisitPayment(context.Context, *AddOrUpdateVisitPaymentRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddOrUpdateVisitPayment not implemented")
 }
-func (UnimplementedStorageServiceServer) GetVisitsPayments(context.Context, *EmptyRequest) (*GetVisitsPaymentsResponse, error) {
+func (UnimplementedStorageServiceServer) GetVisitsPayments(context.Context, *GetVisitsPaymentsRequest) (*GetVisitsPaymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVisitsPayments not implemented")
 }
 func (UnimplementedStorageServiceServer) GetClinicOverrides(context.Context, *EmptyRequest) (*GetClinicOverridesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClinicOverrides not implemented")
 }
-func (UnimplementedStorageServiceServer) GetAppointments(context.Context, *EmptyRequest) (*GetAppointmentsResponse, error) {
+func (UnimplementedStorageServiceServer) GetAppointments(context.Context, *GetAppointmentsRequest) (*GetAppointmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAppointments not implemented")
 }
 func (UnimplementedStorageServiceServer) GetDiagnoseByVisitID(context.Context, *GetByIDRequest) (*GetDiagnoseByVisitIDResponse, error) {
@@ -1239,6 +1254,9 @@ func (UnimplementedStorageServiceServer) GetDocumentsByPatientID(context.Context
 func (UnimplementedStorageServiceServer) GetAdminByID(context.Context, *GetByIDRequest) (*GetAdminByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAdminByID not implemented")
 }
+func (UnimplementedStorageServiceServer) AddAuditEntry(context.Context, *AuditEntry) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuditEntry not implemented")
+}
 func (UnimplementedStorageServiceServer) mustEmbedUnimplementedStorageServiceServer() {}
 func (UnimplementedStorageServiceServer) testEmbeddedByValue()                        {}
 
@@ -1333,7 +1351,7 @@ func _StorageService_AddPatient_Handler(srv interface{}, ctx context.Context, de
 }
 
 func _StorageService_GetDoctors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetDoctorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1345,13 +1363,13 @@ func _StorageService_GetDoctors_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: StorageService_GetDoctors_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetDoctors(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetDoctors(ctx, req.(*GetDoctorsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _StorageService_GetAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetAdminsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1363,13 +1381,13 @@ func _StorageService_GetAdmins_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: StorageService_GetAdmins_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetAdmins(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetAdmins(ctx, req.(*GetAdminsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _StorageService_GetPatients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetPatientsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1381,7 +1399,7 @@ func _StorageService_GetPatients_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: StorageService_GetPatients_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetPatients(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetPatients(ctx, req.(*GetPatientsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2233,7 +2251,7 @@ func _StorageService_GetPatientAllergiesChronics_Handler(srv interface{}, ctx co
 }
 
 func _StorageService_GetICDCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetICDCodesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2245,7 +2263,7 @@ func _StorageService_GetICDCodes_Handler(srv interface{}, ctx context.Context, d
 		FullMethod: StorageService_GetICDCodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetICDCodes(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetICDCodes(ctx, req.(*GetICDCodesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2431,7 +2449,7 @@ func _StorageService_AddOrUpdateVisitPayment_Handler(srv interface{}, ctx contex
 }
 
 func _StorageService_GetVisitsPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetVisitsPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2443,7 +2461,7 @@ func _StorageService_GetVisitsPayments_Handler(srv interface{}, ctx context.Cont
 		FullMethod: StorageService_GetVisitsPayments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetVisitsPayments(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetVisitsPayments(ctx, req.(*GetVisitsPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2467,7 +2485,7 @@ func _StorageService_GetClinicOverrides_Handler(srv interface{}, ctx context.Con
 }
 
 func _StorageService_GetAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+	in := new(GetAppointmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2479,7 +2497,7 @@ func _StorageService_GetAppointments_Handler(srv interface{}, ctx context.Contex
 		FullMethod: StorageService_GetAppointments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StorageServiceServer).GetAppointments(ctx, req.(*EmptyRequest))
+		return srv.(StorageServiceServer).GetAppointments(ctx, req.(*GetAppointmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2592,6 +2610,24 @@ func _StorageService_GetAdminByID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageService_AddAuditEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddAuditEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddAuditEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddAuditEntry(ctx, req.(*AuditEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StorageService_ServiceDesc is the grpc.ServiceDesc for StorageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2895,6 +2931,10 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAdminByID",
 			Handler:    _StorageService_GetAdminByID_Handler,
 		},
+		{
+			MethodName: "AddAuditEntry",
+			Handler:    _StorageService_AddAuditEntry_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/storage/storage.proto",
@@ -9,41 +9,70 @@ import (
 	"github.com/DariaTarasek/diplom/services/auth/utils"
 )
 
-func (s *AuthService) UserAuth(ctx context.Context, user model.User) (string, string, error) {
+func (s *AuthService) UserAuth(ctx context.Context, user model.User) (TokenPair, string, error) {
 	storageUser, err := s.StorageClient.Client.GetUserByLogin(ctx, &storagepb.GetUserByLoginRequest{Login: deref(user.Login)})
 	if err != nil {
-		return "", "", fmt.Errorf("не удалось получить пользователя из базы: %w", err)
+		return TokenPair{}, "", fmt.Errorf("не удалось получить пользователя из базы: %w", err)
 	}
 	password := deref(user.Password)
 	//fmt.Println(password)
 	if err != nil {
-		return "", "", fmt.Errorf("не удалось хешировать полученный пароль: %w", err)
+		return TokenPair{}, "", fmt.Errorf("не удалось хешировать полученный пароль: %w", err)
 	}
 	if err := utils.ComparePasswords(storageUser.Password, password); err != nil {
-		return "", "", fmt.Errorf("введен неверный пароль: %w", sharederrors.ErrPasswordInvalid)
+		return TokenPair{}, "", fmt.Errorf("введен неверный пароль: %w", sharederrors.ErrPasswordInvalid)
+	}
+	// статус проверяется после пароля, чтобы по логину нельзя было узнать, что учётная запись отключена
+	if err := checkUserActive(storageUser); err != nil {
+		return TokenPair{}, "", err
 	}
 
-	token, err := utils.GenerateToken(int(storageUser.Id))
+	tokens, err := s.createSession(ctx, storageUser.Id)
 	if err != nil {
-		return "", "", fmt.Errorf("не удалось сгенерировать токен: %w", err)
+		return TokenPair{}, "", fmt.Errorf("не удалось создать сессию: %w", err)
 	}
 	role, err := s.StorageClient.Client.GetUserRole(ctx, &storagepb.GetUserRoleRequest{UserId: storageUser.Id})
 	if err != nil {
-		return "", "", fmt.Errorf("не удалось получить роль пользователя: %w", err)
+		return TokenPair{}, "", fmt.Errorf("не удалось получить роль пользователя: %w", err)
 	}
 
-	return token, fetchRole(int(role.Role)), nil
+	return tokens, fetchRole(int(role.Role)), nil
+
+}
+
+// userActive статус учётной записи в работе
+const userActive = "active"
 
+// checkUserActive отказ отключённым сотрудникам и архивным пациентам: они не входят в систему
+// и не восстанавливают пароль
+func checkUserActive(user *storagepb.GetUserByLoginResponse) error {
+	if user.Status != userActive {
+		return fmt.Errorf("пользователь %d в статусе %s: %w", user.Id, user.Status, sharederrors.ErrUserInactive)
+	}
+	return nil
 }
 
 func (s *AuthService) GetUserID(ctx context.Context, token string) (model.UserID, error) {
-	userID, err := utils.ParseToken(token)
+	userID, _, err := s.authenticate(ctx, token)
 	if err != nil {
 		return 0, fmt.Errorf("не удалось разобрать токен: %w", err)
 	}
 	return model.UserID(userID), nil
 }
 
+// GetUserRole идентификатор пользователя по токену и его роль
+func (s *AuthService) GetUserRole(ctx context.Context, token string) (model.UserID, model.RoleID, error) {
+	userID, _, err := s.authenticate(ctx, token)
+	if err != nil {
+		return 0, 0, fmt.Errorf("не удалось разобрать токен: %w", err)
+	}
+	role, err := s.StorageClient.Client.GetUserRole(ctx, &storagepb.GetUserRoleRequest{UserId: userID})
+	if err != nil {
+		return 0, 0, fmt.Errorf("не удалось получить роль пользователя: %w", err)
+	}
+	return model.UserID(userID), model.RoleID(role.Role), nil
+}
+
 func fetchRole(role int) string {
 	switch role {
 	case model.DoctorRole:
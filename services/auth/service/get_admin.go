@@ -5,11 +5,10 @@ import (
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/auth/model"
 	storagepb "github.com/DariaTarasek/diplom/services/auth/proto/storage"
-	"github.com/DariaTarasek/diplom/services/auth/utils"
 )
 
 func (s *AuthService) GetAdminByID(ctx context.Context, token string) (model.AdminWithRole, error) {
-	userID, err := utils.ParseToken(token)
+	userID, _, err := s.authenticate(ctx, token)
 	if err != nil {
 		return model.AdminWithRole{}, fmt.Errorf("не удалось получить id пользователя: %w", err)
 	}
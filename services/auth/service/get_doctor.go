@@ -5,11 +5,10 @@ import (
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/auth/model"
 	storagepb "github.com/DariaTarasek/diplom/services/auth/proto/storage"
-	"github.com/DariaTarasek/diplom/services/auth/utils"
 )
 
 func (s *AuthService) GetDoctorByID(ctx context.Context, token string) (model.Doctor, error) {
-	userID, err := utils.ParseToken(token)
+	userID, _, err := s.authenticate(ctx, token)
 	if err != nil {
 		return model.Doctor{}, fmt.Errorf("не удалось получить id пользователя: %w", err)
 	}
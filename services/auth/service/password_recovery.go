@@ -12,6 +12,9 @@ func (s *AuthService) EmployeePasswordRecovery(ctx context.Context, login string
 	if err != nil {
 		return fmt.Errorf("пользователь не найден: %w", err)
 	}
+	if err := checkUserActive(resp); err != nil {
+		return err
+	}
 
 	password := utils.GeneratePassword()
 	hashedPassword, err := utils.HashPassword(password)
@@ -27,6 +30,9 @@ func (s *AuthService) EmployeePasswordRecovery(ctx context.Context, login string
 	if err != nil {
 		return fmt.Errorf("не удалось обновить пароль: %w", err)
 	}
+	if err := s.RevokeUserSessions(ctx, resp.Id); err != nil {
+		return fmt.Errorf("не удалось завершить сессии пользователя: %w", err)
+	}
 
 	message := fmt.Sprintf("Subject: Восстановление пароля\r\n\r\nВы запросили восстановление пароля в системе клиники.\nВаш новый пароль: %s", password)
 	err = utils.SendPassword(resp.Login, password, message)
@@ -42,6 +48,9 @@ func (s *AuthService) PatientPasswordRecovery(ctx context.Context, login string)
 	if err != nil {
 		return fmt.Errorf("пользователь не найден: %w", err)
 	}
+	if err := checkUserActive(resp); err != nil {
+		return err
+	}
 
 	password := utils.GeneratePassword()
 	hashedPassword, err := utils.HashPassword(password)
@@ -57,6 +66,9 @@ func (s *AuthService) PatientPasswordRecovery(ctx context.Context, login string)
 	if err != nil {
 		return fmt.Errorf("не удалось обновить пароль: %w", err)
 	}
+	if err := s.RevokeUserSessions(ctx, resp.Id); err != nil {
+		return fmt.Errorf("не удалось завершить сессии пользователя: %w", err)
+	}
 
 	// отправка нового пароля по sms
 
@@ -24,7 +24,7 @@ func (s *AuthService) RequestCode(ctx context.Context, phone string) error {
 	attemptsKey := fmt.Sprintf("verif:attempts:%s", phone)
 
 	if s.RedisClient.Exists(ctx, sentKey).Val() > 0 {
-		return fmt.Errorf("код уже был отправлен, повторная отправка возможна через 60 секунд: %w", sharederrors.ErrRateLimited)
+		return sharederrors.ErrRateLimited.Detailf("повторная отправка возможна через 60 секунд")
 	}
 
 	pipe := s.RedisClient.TxPipeline()
@@ -59,7 +59,7 @@ func (s *AuthService) VerifyCode(ctx context.Context, phone string, input string
 		return fmt.Errorf("не удалось получить количество попыток ввода кода: %w", err)
 	}
 	if attempts == maxAttempts {
-		return fmt.Errorf("слишком большое количество попыток. Отправьте код заново: %w", sharederrors.ErrTooManyAttempts)
+		return sharederrors.ErrTooManyAttempts.Detailf("отправьте код заново")
 	}
 
 	code, err := s.RedisClient.Get(ctx, codeKey).Result()
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/auth/sharederrors"
+	"github.com/DariaTarasek/diplom/services/auth/utils"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+// RefreshTokenTTL время жизни refresh-токена. Каждое обновление выдаёт новый токен и продлевает сессию
+const RefreshTokenTTL = 7 * 24 * time.Hour
+
+// RefreshGraceWindow сколько после обновления старый refresh-токен ещё обменивается на уже выданную пару.
+// Браузер отправляет несколько запросов с одной cookie, и все они одновременно обновляют сессию: без этого окна
+// второй из них выглядел бы как повторное использование токена и завершал сессию
+const RefreshGraceWindow = 30 * time.Second
+
+// Ключи redis:
+//
+//	session:<sid>            — hash {user_id, refresh} активной сессии
+//	session:refresh:<hash>   — sid по хешу действующего refresh-токена
+//	session:used:<hash>      — sid по хешу уже использованного refresh-токена (для обнаружения повторного использования)
+//	session:rotated:<hash>   — refresh-токен, выданный взамен токена с этим хешем; живёт RefreshGraceWindow
+//	session:user:<user_id>   — множество sid пользователя
+func sessionKey(sessionID string) string {
+	return fmt.Sprintf("session:%s", sessionID)
+}
+
+func refreshKey(hash string) string {
+	return fmt.Sprintf("session:refresh:%s", hash)
+}
+
+func usedRefreshKey(hash string) string {
+	return fmt.Sprintf("session:used:%s", hash)
+}
+
+func rotatedRefreshKey(hash string) string {
+	return fmt.Sprintf("session:rotated:%s", hash)
+}
+
+func userSessionsKey(userID int32) string {
+	return fmt.Sprintf("session:user:%d", userID)
+}
+
+// TokenPair пара токенов, выдаваемая при входе и при обновлении сессии
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// createSession заводит новую сессию пользователя и выдаёт для неё пару токенов
+func (s *AuthService) createSession(ctx context.Context, userID int32) (TokenPair, error) {
+	sessionID, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return TokenPair{}, err
+	}
+	refreshToken, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return TokenPair{}, err
+	}
+	accessToken, err := utils.GenerateToken(int(userID), sessionID)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	hash := utils.HashToken(refreshToken)
+
+	pipe := s.RedisClient.TxPipeline()
+	pipe.HSet(ctx, sessionKey(sessionID), "user_id", userID, "refresh", hash)
+	pipe.Expire(ctx, sessionKey(sessionID), RefreshTokenTTL)
+	pipe.Set(ctx, refreshKey(hash), sessionID, RefreshTokenTTL)
+	pipe.SAdd(ctx, userSessionsKey(userID), sessionID)
+	pipe.Expire(ctx, userSessionsKey(userID), RefreshTokenTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return TokenPair{}, fmt.Errorf("не удалось сохранить сессию: %w", err)
+	}
+	return TokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+// RefreshSession обменивает refresh-токен на новую пару токенов. Старый refresh-токен становится недействительным:
+// в течение RefreshGraceWindow он возвращает уже выданную взамен пару, а позже его предъявление считается
+// компрометацией и завершает сессию
+func (s *AuthService) RefreshSession(ctx context.Context, refreshToken string) (TokenPair, error) {
+	if refreshToken == "" {
+		return TokenPair{}, sharederrors.ErrRefreshTokenInvalid
+	}
+	hash := utils.HashToken(refreshToken)
+
+	sessionID, err := s.RedisClient.Get(ctx, refreshKey(hash)).Result()
+	if errors.Is(err, redis.Nil) {
+		return s.refreshUsedToken(ctx, hash)
+	}
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("не удалось получить сессию: %w", err)
+	}
+	userID, err := s.sessionUserID(ctx, sessionID)
+	if err != nil {
+		return TokenPair{}, err
+	}
+
+	newRefreshToken, err := utils.GenerateOpaqueToken()
+	if err != nil {
+		return TokenPair{}, err
+	}
+	accessToken, err := utils.GenerateToken(userID, sessionID)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	newHash := utils.HashToken(newRefreshToken)
+
+	// запись замены — точка синхронизации: из параллельных обновлений одного токена новый токен выдаёт только одно,
+	// остальные возвращают его же, чтобы все ответы установили клиенту одинаковые cookie
+	claimed, err := s.RedisClient.SetNX(ctx, rotatedRefreshKey(hash), newRefreshToken, RefreshGraceWindow).Result()
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("не удалось обновить сессию: %w", err)
+	}
+	if !claimed {
+		rotated, err := s.RedisClient.Get(ctx, rotatedRefreshKey(hash)).Result()
+		if errors.Is(err, redis.Nil) {
+			return TokenPair{}, sharederrors.ErrRefreshTokenInvalid
+		}
+		if err != nil {
+			return TokenPair{}, fmt.Errorf("не удалось получить сессию: %w", err)
+		}
+		return TokenPair{AccessToken: accessToken, RefreshToken: rotated}, nil
+	}
+
+	deleted, err := s.RedisClient.Del(ctx, refreshKey(hash)).Result()
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("не удалось обновить сессию: %w", err)
+	}
+	if deleted == 0 {
+		// сессию завершили между чтением и обновлением
+		return TokenPair{}, sharederrors.ErrSessionRevoked
+	}
+
+	pipe := s.RedisClient.TxPipeline()
+	pipe.Set(ctx, usedRefreshKey(hash), sessionID, RefreshTokenTTL)
+	pipe.Set(ctx, refreshKey(newHash), sessionID, RefreshTokenTTL)
+	pipe.HSet(ctx, sessionKey(sessionID), "refresh", newHash)
+	pipe.Expire(ctx, sessionKey(sessionID), RefreshTokenTTL)
+	pipe.Expire(ctx, userSessionsKey(int32(userID)), RefreshTokenTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return TokenPair{}, fmt.Errorf("не удалось обновить сессию: %w", err)
+	}
+	return TokenPair{AccessToken: accessToken, RefreshToken: newRefreshToken}, nil
+}
+
+// refreshUsedToken обрабатывает предъявление уже заменённого refresh-токена: в окне RefreshGraceWindow возвращает
+// пару с выданным взамен токеном, после окна завершает сессию
+func (s *AuthService) refreshUsedToken(ctx context.Context, hash string) (TokenPair, error) {
+	sessionID, err := s.RedisClient.Get(ctx, usedRefreshKey(hash)).Result()
+	if errors.Is(err, redis.Nil) {
+		return TokenPair{}, sharederrors.ErrRefreshTokenInvalid
+	}
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("не удалось получить сессию: %w", err)
+	}
+
+	rotated, err := s.RedisClient.Get(ctx, rotatedRefreshKey(hash)).Result()
+	if errors.Is(err, redis.Nil) {
+		if err := s.revokeSession(ctx, sessionID); err != nil {
+			return TokenPair{}, err
+		}
+		return TokenPair{}, fmt.Errorf("сессия завершена: %w", sharederrors.ErrRefreshTokenReused)
+	}
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("не удалось получить сессию: %w", err)
+	}
+
+	userID, err := s.sessionUserID(ctx, sessionID)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	accessToken, err := utils.GenerateToken(userID, sessionID)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	return TokenPair{AccessToken: accessToken, RefreshToken: rotated}, nil
+}
+
+// sessionUserID пользователь сессии; ErrSessionRevoked, если сессия завершена или истекла
+func (s *AuthService) sessionUserID(ctx context.Context, sessionID string) (int, error) {
+	userIDStr, err := s.RedisClient.HGet(ctx, sessionKey(sessionID), "user_id").Result()
+	if errors.Is(err, redis.Nil) {
+		return 0, sharederrors.ErrSessionRevoked
+	}
+	if err != nil {
+		return 0, fmt.Errorf("не удалось получить сессию: %w", err)
+	}
+	userID, err := strconv.Atoi(userIDStr)
+	if err != nil {
+		return 0, fmt.Errorf("некорректные данные сессии: %w", err)
+	}
+	return userID, nil
+}
+
+// authenticate разбирает access-токен и проверяет, что его сессия не отозвана
+func (s *AuthService) authenticate(ctx context.Context, token string) (int32, string, error) {
+	userID, sessionID, err := utils.ParseToken(token)
+	if err != nil {
+		return 0, "", fmt.Errorf("%w: %v", sharederrors.ErrTokenInvalid, err)
+	}
+	exists, err := s.RedisClient.Exists(ctx, sessionKey(sessionID)).Result()
+	if err != nil {
+		return 0, "", fmt.Errorf("не удалось проверить сессию: %w", err)
+	}
+	if exists == 0 {
+		return 0, "", sharederrors.ErrSessionRevoked
+	}
+	return userID, sessionID, nil
+}
+
+// Logout завершает текущую сессию. Сессия определяется по refresh-токену, а если его нет — по access-токену
+func (s *AuthService) Logout(ctx context.Context, token, refreshToken string) error {
+	if refreshToken != "" {
+		sessionID, err := s.RedisClient.Get(ctx, refreshKey(utils.HashToken(refreshToken))).Result()
+		if err == nil {
+			return s.revokeSession(ctx, sessionID)
+		}
+		if !errors.Is(err, redis.Nil) {
+			return fmt.Errorf("не удалось получить сессию: %w", err)
+		}
+	}
+	_, sessionID, err := utils.ParseToken(token)
+	if err != nil {
+		return fmt.Errorf("%w: %v", sharederrors.ErrTokenInvalid, err)
+	}
+	return s.revokeSession(ctx, sessionID)
+}
+
+// LogoutAllSessions завершает все сессии пользователя, которому принадлежит токен
+func (s *AuthService) LogoutAllSessions(ctx context.Context, token string) error {
+	userID, _, err := s.authenticate(ctx, token)
+	if err != nil {
+		return err
+	}
+	return s.RevokeUserSessions(ctx, userID)
+}
+
+// RevokeUserSessions завершает все сессии пользователя, например после смены пароля или увольнения сотрудника
+func (s *AuthService) RevokeUserSessions(ctx context.Context, userID int32) error {
+	sessionIDs, err := s.RedisClient.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return fmt.Errorf("не удалось получить сессии пользователя: %w", err)
+	}
+	for _, sessionID := range sessionIDs {
+		if err := s.revokeSession(ctx, sessionID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *AuthService) revokeSession(ctx context.Context, sessionID string) error {
+	values, err := s.RedisClient.HGetAll(ctx, sessionKey(sessionID)).Result()
+	if err != nil {
+		return fmt.Errorf("не удалось получить сессию: %w", err)
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	pipe := s.RedisClient.TxPipeline()
+	pipe.Del(ctx, sessionKey(sessionID))
+	if hash, ok := values["refresh"]; ok {
+		pipe.Del(ctx, refreshKey(hash))
+	}
+	if userID, err := strconv.Atoi(values["user_id"]); err == nil {
+		pipe.SRem(ctx, userSessionsKey(int32(userID)), sessionID)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("не удалось завершить сессию: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,382 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/auth/sharederrors"
+	"github.com/DariaTarasek/diplom/services/auth/utils"
+	"github.com/redis/go-redis/v9"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeRedis минимальный сервер redis в памяти: понимает только команды, которыми пользуются сессии,
+// и не учитывает время жизни ключей
+type fakeRedis struct {
+	mu      sync.Mutex
+	strings map[string]string
+	hashes  map[string]map[string]string
+	sets    map[string]map[string]bool
+}
+
+func newTestAuthService(t *testing.T) *AuthService {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	fake := &fakeRedis{
+		strings: map[string]string{},
+		hashes:  map[string]map[string]string{},
+		sets:    map[string]map[string]bool{},
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go fake.serve(conn)
+		}
+	}()
+	client := redis.NewClient(&redis.Options{Addr: ln.Addr().String(), DisableIdentity: true})
+	t.Cleanup(func() {
+		client.Close()
+		ln.Close()
+	})
+	return &AuthService{RedisClient: client}
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	var queued [][]string
+	inMulti := false
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		var reply string
+		switch name := strings.ToUpper(args[0]); {
+		case name == "MULTI":
+			inMulti, queued, reply = true, nil, "+OK\r\n"
+		case name == "EXEC":
+			f.mu.Lock()
+			reply = fmt.Sprintf("*%d\r\n", len(queued))
+			for _, cmd := range queued {
+				reply += f.exec(cmd)
+			}
+			f.mu.Unlock()
+			inMulti, queued = false, nil
+		case inMulti:
+			queued, reply = append(queued, args), "+QUEUED\r\n"
+		default:
+			f.mu.Lock()
+			reply = f.exec(args)
+			f.mu.Unlock()
+		}
+		if _, err := io.WriteString(conn, reply); err != nil {
+			return
+		}
+	}
+}
+
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args[i] = string(buf[:size])
+	}
+	return args, nil
+}
+
+func bulk(s string) string {
+	return fmt.Sprintf("$%d\r\n%s\r\n", len(s), s)
+}
+
+func array(items []string) string {
+	reply := fmt.Sprintf("*%d\r\n", len(items))
+	for _, item := range items {
+		reply += bulk(item)
+	}
+	return reply
+}
+
+func (f *fakeRedis) exists(key string) bool {
+	_, isString := f.strings[key]
+	_, isHash := f.hashes[key]
+	_, isSet := f.sets[key]
+	return isString || isHash || isSet
+}
+
+func (f *fakeRedis) exec(args []string) string {
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "GET":
+		value, ok := f.strings[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return bulk(value)
+	case "SET":
+		for _, opt := range args[3:] {
+			if strings.ToUpper(opt) == "NX" && f.exists(args[1]) {
+				return "$-1\r\n"
+			}
+		}
+		f.strings[args[1]] = args[2]
+		return "+OK\r\n"
+	case "DEL", "EXISTS":
+		n := 0
+		for _, key := range args[1:] {
+			if f.exists(key) {
+				n++
+			}
+			if strings.ToUpper(args[0]) == "DEL" {
+				delete(f.strings, key)
+				delete(f.hashes, key)
+				delete(f.sets, key)
+			}
+		}
+		return fmt.Sprintf(":%d\r\n", n)
+	case "EXPIRE":
+		if f.exists(args[1]) {
+			return ":1\r\n"
+		}
+		return ":0\r\n"
+	case "HSET":
+		hash := f.hashes[args[1]]
+		if hash == nil {
+			hash = map[string]string{}
+			f.hashes[args[1]] = hash
+		}
+		added := 0
+		for i := 2; i+1 < len(args); i += 2 {
+			if _, ok := hash[args[i]]; !ok {
+				added++
+			}
+			hash[args[i]] = args[i+1]
+		}
+		return fmt.Sprintf(":%d\r\n", added)
+	case "HGET":
+		value, ok := f.hashes[args[1]][args[2]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return bulk(value)
+	case "HGETALL":
+		var items []string
+		for field, value := range f.hashes[args[1]] {
+			items = append(items, field, value)
+		}
+		return array(items)
+	case "SADD":
+		set := f.sets[args[1]]
+		if set == nil {
+			set = map[string]bool{}
+			f.sets[args[1]] = set
+		}
+		added := 0
+		for _, member := range args[2:] {
+			if !set[member] {
+				added++
+			}
+			set[member] = true
+		}
+		return fmt.Sprintf(":%d\r\n", added)
+	case "SREM":
+		removed := 0
+		for _, member := range args[2:] {
+			if f.sets[args[1]][member] {
+				removed++
+				delete(f.sets[args[1]], member)
+			}
+		}
+		if len(f.sets[args[1]]) == 0 {
+			delete(f.sets, args[1])
+		}
+		return fmt.Sprintf(":%d\r\n", removed)
+	case "SMEMBERS":
+		var items []string
+		for member := range f.sets[args[1]] {
+			items = append(items, member)
+		}
+		return array(items)
+	default:
+		return fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+	}
+}
+
+// sessionAlive проверяет сессию так же, как проверяются access-токены
+func sessionAlive(t *testing.T, s *AuthService, accessToken string) bool {
+	t.Helper()
+	_, _, err := s.authenticate(context.Background(), accessToken)
+	if err != nil && !errors.Is(err, sharederrors.ErrSessionRevoked) {
+		t.Fatalf("authenticate: %v", err)
+	}
+	return err == nil
+}
+
+func TestRefreshSessionRotation(t *testing.T) {
+	ctx := context.Background()
+	s := newTestAuthService(t)
+	first, err := s.createSession(ctx, 42)
+	if err != nil {
+		t.Fatalf("createSession: %v", err)
+	}
+
+	second, err := s.RefreshSession(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshSession: %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatalf("refresh token was not rotated")
+	}
+	userID, _, err := utils.ParseToken(second.AccessToken)
+	if err != nil || userID != 42 {
+		t.Fatalf("access token user = %d, %v; want 42", userID, err)
+	}
+
+	third, err := s.RefreshSession(ctx, second.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshSession with the rotated token: %v", err)
+	}
+	if !sessionAlive(t, s, third.AccessToken) {
+		t.Errorf("session revoked after a normal rotation")
+	}
+}
+
+func TestRefreshSessionReuse(t *testing.T) {
+	ctx := context.Background()
+	s := newTestAuthService(t)
+	first, err := s.createSession(ctx, 42)
+	if err != nil {
+		t.Fatalf("createSession: %v", err)
+	}
+	second, err := s.RefreshSession(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshSession: %v", err)
+	}
+
+	// в окне RefreshGraceWindow старый токен возвращает уже выданную пару
+	again, err := s.RefreshSession(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatalf("RefreshSession within the grace window: %v", err)
+	}
+	if again.RefreshToken != second.RefreshToken {
+		t.Errorf("grace window returned another refresh token")
+	}
+
+	// окно истекло
+	if err := s.RedisClient.Del(ctx, rotatedRefreshKey(utils.HashToken(first.RefreshToken))).Err(); err != nil {
+		t.Fatalf("del: %v", err)
+	}
+	_, err = s.RefreshSession(ctx, first.RefreshToken)
+	if !errors.Is(err, sharederrors.ErrRefreshTokenReused) {
+		t.Fatalf("err = %v, want ErrRefreshTokenReused", err)
+	}
+	if sessionAlive(t, s, second.AccessToken) {
+		t.Errorf("session is still alive after refresh token reuse")
+	}
+	if _, err := s.RefreshSession(ctx, second.RefreshToken); err == nil {
+		t.Errorf("current refresh token still works after reuse")
+	}
+}
+
+// Несколько запросов браузера с одной cookie обновляют сессию одновременно: все должны получить одну пару,
+// а сессия — остаться действующей
+func TestRefreshSessionConcurrent(t *testing.T) {
+	ctx := context.Background()
+	s := newTestAuthService(t)
+	first, err := s.createSession(ctx, 42)
+	if err != nil {
+		t.Fatalf("createSession: %v", err)
+	}
+
+	const requests = 8
+	pairs := make([]TokenPair, requests)
+	errs := make([]error, requests)
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			pairs[i], errs[i] = s.RefreshSession(ctx, first.RefreshToken)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < requests; i++ {
+		if errs[i] != nil {
+			t.Fatalf("request %d: %v", i, errs[i])
+		}
+		if pairs[i].RefreshToken != pairs[0].RefreshToken {
+			t.Fatalf("request %d got a different refresh token", i)
+		}
+	}
+	if !sessionAlive(t, s, pairs[0].AccessToken) {
+		t.Fatalf("session revoked by concurrent refresh")
+	}
+	if _, err := s.RefreshSession(ctx, pairs[0].RefreshToken); err != nil {
+		t.Errorf("RefreshSession with the shared token: %v", err)
+	}
+}
+
+func TestRevokeUserSessions(t *testing.T) {
+	ctx := context.Background()
+	s := newTestAuthService(t)
+	phone, err := s.createSession(ctx, 42)
+	if err != nil {
+		t.Fatalf("createSession: %v", err)
+	}
+	laptop, err := s.createSession(ctx, 42)
+	if err != nil {
+		t.Fatalf("createSession: %v", err)
+	}
+	other, err := s.createSession(ctx, 7)
+	if err != nil {
+		t.Fatalf("createSession: %v", err)
+	}
+
+	if err := s.RevokeUserSessions(ctx, 42); err != nil {
+		t.Fatalf("RevokeUserSessions: %v", err)
+	}
+	for _, pair := range []TokenPair{phone, laptop} {
+		if sessionAlive(t, s, pair.AccessToken) {
+			t.Errorf("session of the revoked user is still alive")
+		}
+		if _, err := s.RefreshSession(ctx, pair.RefreshToken); !errors.Is(err, sharederrors.ErrRefreshTokenInvalid) {
+			t.Errorf("refresh after revoke: err = %v, want ErrRefreshTokenInvalid", err)
+		}
+	}
+	if !sessionAlive(t, s, other.AccessToken) {
+		t.Errorf("session of another user was revoked")
+	}
+}
@@ -1,11 +1,16 @@
 package sharederrors
 
-import "errors"
+import "github.com/DariaTarasek/diplom/services/apperr"
 
 var (
-	ErrCodeInvalid     = errors.New("неверный код подтверждения")
-	ErrCodeExpired     = errors.New("действие кода подтверждения истекло")
-	ErrTooManyAttempts = errors.New("слишком много попыток ввода кода")
-	ErrRateLimited     = errors.New("код уже отправлен")
-	ErrPasswordInvalid = errors.New("неверный пароль")
+	ErrCodeInvalid         = apperr.Invalid("confirmation_code_invalid", "неверный код подтверждения")
+	ErrCodeExpired         = apperr.Invalid("confirmation_code_expired", "действие кода подтверждения истекло")
+	ErrTooManyAttempts     = apperr.TooManyRequests("too_many_attempts", "слишком много попыток ввода кода")
+	ErrRateLimited         = apperr.TooManyRequests("code_rate_limited", "код уже отправлен")
+	ErrPasswordInvalid     = apperr.Unauthenticated("password_invalid", "неверный пароль")
+	ErrTokenInvalid        = apperr.Unauthenticated("token_invalid", "невалидный токен")
+	ErrSessionRevoked      = apperr.Unauthenticated("session_revoked", "сессия завершена")
+	ErrRefreshTokenInvalid = apperr.Unauthenticated("refresh_token_invalid", "невалидный refresh-токен")
+	ErrRefreshTokenReused  = apperr.Unauthenticated("refresh_token_reused", "refresh-токен уже был использован")
+	ErrUserInactive        = apperr.Forbidden("user_inactive", "учётная запись отключена, обратитесь в клинику")
 )
@@ -1,18 +1,26 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"github.com/golang-jwt/jwt/v5"
 	"os"
 	"time"
 )
 
+// AccessTokenTTL время жизни access-токена. Продление сессии выполняется через refresh-токен
+const AccessTokenTTL = 15 * time.Minute
+
 var jwtSecret = []byte(os.Getenv("SECRET_KEY"))
 
-func GenerateToken(userID int) (string, error) {
+func GenerateToken(userID int, sessionID string) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"user_id": userID,
-		"exp":     time.Now().Add(time.Hour).Unix(),
+		"sid":     sessionID,
+		"exp":     time.Now().Add(AccessTokenTTL).Unix(),
 	})
 	tokenString, err := token.SignedString(jwtSecret)
 	if err != nil {
@@ -20,3 +28,18 @@ func GenerateToken(userID int) (string, error) {
 	}
 	return tokenString, nil
 }
+
+// GenerateOpaqueToken генерирует случайную строку для refresh-токенов и идентификаторов сессий
+func GenerateOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("не удалось сгенерировать случайный токен: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken хеш refresh-токена, в redis хранится только он
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
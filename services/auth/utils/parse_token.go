@@ -5,7 +5,8 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func ParseToken(tokenStr string) (int32, error) {
+// ParseToken возвращает id пользователя и id сессии из access-токена
+func ParseToken(tokenStr string) (int32, string, error) {
 	token, err := jwt.Parse(tokenStr, func(t *jwt.Token) (interface{}, error) {
 		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("неподдерживаемый метод подписи")
@@ -14,17 +15,21 @@ func ParseToken(tokenStr string) (int32, error) {
 	})
 
 	if err != nil {
-		return 0, err
+		return 0, "", err
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok || !token.Valid {
-		return 0, fmt.Errorf("невалидный токен")
+		return 0, "", fmt.Errorf("невалидный токен")
 	}
 
 	userID, ok := claims["user_id"].(float64)
 	if !ok {
-		return 0, fmt.Errorf("user_id не найден")
+		return 0, "", fmt.Errorf("user_id не найден")
 	}
-	return int32(userID), nil
+	sessionID, ok := claims["sid"].(string)
+	if !ok || sessionID == "" {
+		return 0, "", fmt.Errorf("sid не найден")
+	}
+	return int32(userID), sessionID, nil
 }
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"github.com/DariaTarasek/diplom/services/audit"
 	"github.com/DariaTarasek/diplom/services/doctor/clients"
 	grpcserver "github.com/DariaTarasek/diplom/services/doctor/grpc"
 	pb "github.com/DariaTarasek/diplom/services/doctor/proto/doctor"
 	"github.com/DariaTarasek/diplom/services/doctor/service"
+	"github.com/DariaTarasek/diplom/services/medrecord"
 	"google.golang.org/grpc"
 	"log"
 	"net"
@@ -19,14 +22,31 @@ func main() {
 	if err != nil {
 		log.Fatalf("Не удалось создать клиент auth: %s", err)
 	}
-	doctorService := service.NewDoctorService(storageClient, authClient)
+
+	records, err := medrecord.NewRenderer(medrecord.ClinicFromEnv())
+	if err != nil {
+		log.Fatalf("Не удалось подготовить выгрузку медицинских карт: %s", err)
+	}
+
+	doctorService := service.NewDoctorService(storageClient, authClient, records)
 	lis, err := net.Listen("tcp", ":50055")
 	if err != nil {
 		log.Fatalf("Не удалось начать слушать: %v", err)
 	}
 
-	s := grpc.NewServer(grpc.MaxRecvMsgSize(50*1024*1024),
-		grpc.MaxSendMsgSize(50*1024*1024))
+	// вызовы от имени пользователя записываются в журнал аудита с итоговым статусом; ошибки методов
+	// приводятся к доменным статусам, ошибки без доменного смысла — к внутренней без подробностей
+	recorder := audit.RecorderFunc(storageClient.RecordAudit)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			audit.UnaryServerInterceptor("doctor", recorder, grpcserver.AuditTargets),
+			apperr.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			audit.StreamServerInterceptor("doctor", recorder, grpcserver.AuditTargets),
+			apperr.StreamServerInterceptor(),
+		),
+	)
 
 	server := &grpcserver.Server{
 		Service: doctorService,
@@ -0,0 +1,16 @@
+package grpcserver
+
+import "github.com/DariaTarasek/diplom/services/audit"
+
+// AuditTargets что затрагивают методы врача, у которых это не видно по именам полей запроса:
+// id в GetByIdRequest — пациент, приём или план лечения в зависимости от метода
+var AuditTargets = audit.Targets{
+	"GetPatientAllergiesChronics": {Resource: "patient", PatientField: "id"},
+	"GetPatientVisits":            {Resource: "patient", PatientField: "id"},
+	"GetPatientTreatmentPlans":    {Resource: "patient", PatientField: "id"},
+	"GetDentalChart":              {Resource: "patient", PatientField: "id"},
+	"GetPatientAnamnesisHistory":  {Resource: "patient", PatientField: "id"},
+	"GetDocumentsByPatientID":     {Resource: "patient"},
+	"GetAppointmentByID":          {Resource: "appointment"},
+	"CancelTreatmentPlan":         {Resource: "treatment_plan"},
+}
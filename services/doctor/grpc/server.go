@@ -66,7 +66,7 @@ func (s *Server) GetUpcomingAppointments(ctx context.Context, request *pb.GetUpc
 }
 
 func (s *Server) GetPatientAllergiesChronics(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetPatientAllergiesChronicsResponse, error) {
-	notes, err := s.Service.GetPatientAllergiesChronics(ctx, int(req.Id))
+	notes, anamnesis, err := s.Service.GetPatientAllergiesChronics(ctx, req.Token, int(req.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -81,11 +81,103 @@ func (s *Server) GetPatientAllergiesChronics(ctx context.Context, req *pb.GetByI
 		gRPCNotes = append(gRPCNotes, note)
 	}
 	return &pb.GetPatientAllergiesChronicsResponse{
-		PatientAllergiesChronics: gRPCNotes}, nil
+		PatientAllergiesChronics: gRPCNotes,
+		Anamnesis:                anamnesisToPb(anamnesis),
+	}, nil
+}
+
+func (s *Server) SavePatientAnamnesis(ctx context.Context, req *pb.SavePatientAnamnesisRequest) (*pb.AnamnesisResponse, error) {
+	anamnesis, err := s.Service.SavePatientAnamnesis(ctx, req.Token, int(req.PatientId), anamnesisFromPb(req.Anamnesis), int(req.BaseVersion))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AnamnesisResponse{Anamnesis: anamnesisToPb(anamnesis)}, nil
+}
+
+func (s *Server) GetPatientAnamnesisHistory(ctx context.Context, req *pb.GetByIdRequest) (*pb.AnamnesisHistoryResponse, error) {
+	versions, err := s.Service.GetPatientAnamnesisHistory(ctx, req.Token, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.AnamnesisHistoryResponse{Versions: make([]*pb.Anamnesis, 0, len(versions))}
+	for _, v := range versions {
+		resp.Versions = append(resp.Versions, anamnesisToPb(v))
+	}
+	return resp, nil
+}
+
+func (s *Server) ExportMedicalRecord(ctx context.Context, req *pb.ExportMedicalRecordRequest) (*pb.MedicalRecordExportResponse, error) {
+	export, err := s.Service.ExportMedicalRecord(ctx, req.Token, int(req.PatientId), req.Format)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MedicalRecordExportResponse{
+		FileName:    export.FileName,
+		ContentType: export.ContentType,
+		Content:     export.Content,
+	}, nil
+}
+
+func anamnesisFromPb(item *pb.Anamnesis) model.Anamnesis {
+	if item == nil {
+		return model.Anamnesis{}
+	}
+	anamnesis := model.Anamnesis{
+		Medications:        item.Medications,
+		BloodThinners:      item.BloodThinners,
+		CardiacDisease:     item.CardiacDisease,
+		Hypertension:       item.Hypertension,
+		Diabetes:           item.Diabetes,
+		BleedingDisorder:   item.BleedingDisorder,
+		InfectiousDisease:  item.InfectiousDisease,
+		AnesthesiaReaction: item.AnesthesiaReaction,
+		Pregnant:           item.Pregnant,
+		Breastfeeding:      item.Breastfeeding,
+		Smoker:             item.Smoker,
+		OtherConditions:    item.OtherConditions,
+	}
+	for _, a := range item.Allergies {
+		anamnesis.Allergies = append(anamnesis.Allergies, model.AnamnesisAllergy{
+			Allergen: a.Allergen,
+			Severity: a.Severity,
+			Reaction: a.Reaction,
+		})
+	}
+	return anamnesis
+}
+
+func anamnesisToPb(anamnesis model.Anamnesis) *pb.Anamnesis {
+	item := &pb.Anamnesis{
+		Version:            int32(anamnesis.Version),
+		Medications:        anamnesis.Medications,
+		BloodThinners:      anamnesis.BloodThinners,
+		CardiacDisease:     anamnesis.CardiacDisease,
+		Hypertension:       anamnesis.Hypertension,
+		Diabetes:           anamnesis.Diabetes,
+		BleedingDisorder:   anamnesis.BleedingDisorder,
+		InfectiousDisease:  anamnesis.InfectiousDisease,
+		AnesthesiaReaction: anamnesis.AnesthesiaReaction,
+		Pregnant:           anamnesis.Pregnant,
+		Breastfeeding:      anamnesis.Breastfeeding,
+		Smoker:             anamnesis.Smoker,
+		OtherConditions:    anamnesis.OtherConditions,
+		ChangedById:        int32(anamnesis.ChangedByID),
+		ChangedByRole:      anamnesis.ChangedByRole,
+		ChangedBy:          anamnesis.ChangedBy,
+		ChangedAt:          anamnesis.ChangedAt,
+	}
+	for _, a := range anamnesis.Allergies {
+		item.Allergies = append(item.Allergies, &pb.AnamnesisAllergy{
+			Allergen: a.Allergen,
+			Severity: a.Severity,
+			Reaction: a.Reaction,
+		})
+	}
+	return item
 }
 
 func (s *Server) GetAppointmentByID(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetAppointmentByIDResponse, error) {
-	appt, err := s.Service.GetAppointmentByID(ctx, int(req.Id))
+	appt, err := s.Service.GetAppointmentByID(ctx, req.Token, int(req.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -105,15 +197,50 @@ func (s *Server) GetAppointmentByID(ctx context.Context, req *pb.GetByIdRequest)
 		CreatedAt:   timestamppb.New(appt.CreatedAt),
 		UpdatedAt:   timestamppb.New(appt.UpdatedAt),
 	}
-	return &pb.GetAppointmentByIDResponse{Appt: appointment}, nil
+	return &pb.GetAppointmentByIDResponse{Appt: appointment, Alerts: clinicalAlertsToPb(appt.Alerts)}, nil
+}
+
+func (s *Server) CheckClinicalAlerts(ctx context.Context, req *pb.CheckClinicalAlertsRequest) (*pb.ClinicalAlertsResponse, error) {
+	materialIDs := make([]int, 0, len(req.MaterialIds))
+	for _, id := range req.MaterialIds {
+		materialIDs = append(materialIDs, int(id))
+	}
+	serviceIDs := make([]int, 0, len(req.ServiceIds))
+	for _, id := range req.ServiceIds {
+		serviceIDs = append(serviceIDs, int(id))
+	}
+	alerts, err := s.Service.CheckClinicalAlerts(ctx, req.Token, int(req.PatientId), materialIDs, serviceIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ClinicalAlertsResponse{Alerts: clinicalAlertsToPb(alerts)}, nil
+}
+
+func clinicalAlertsToPb(alerts []model.ClinicalAlert) []*pb.ClinicalAlert {
+	result := make([]*pb.ClinicalAlert, 0, len(alerts))
+	for _, alert := range alerts {
+		result = append(result, &pb.ClinicalAlert{
+			RuleId:     int32(alert.RuleID),
+			Severity:   alert.Severity,
+			Title:      alert.Title,
+			Message:    alert.Message,
+			Reason:     alert.Reason,
+			MaterialId: int32(alert.MaterialID),
+			ServiceId:  int32(alert.ServiceID),
+			TargetName: alert.TargetName,
+		})
+	}
+	return result
 }
 
 func ConvertToPointerSlice(input []model.Diagnose) []*pb.Diagnose {
 	result := make([]*pb.Diagnose, 0, len(input))
 	for _, d := range input {
 		diag := pb.Diagnose{
-			IcdCode: d.ICDCode,
-			Notes:   d.Notes,
+			IcdCode:  d.ICDCode,
+			Notes:    d.Notes,
+			Tooth:    int32(d.Tooth),
+			Surfaces: d.Surfaces,
 		}
 		result = append(result, &diag)
 	}
@@ -121,7 +248,7 @@ func ConvertToPointerSlice(input []model.Diagnose) []*pb.Diagnose {
 }
 
 func (s *Server) GetPatientVisits(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetPatientVisitsResponse, error) {
-	visits, err := s.Service.GetPatientVisits(ctx, int(req.Id))
+	visits, err := s.Service.GetPatientVisits(ctx, req.Token, int(req.Id))
 	if err != nil {
 		return nil, err
 	}
@@ -153,7 +280,7 @@ func (s *Server) AddPatientAllergiesChronics(ctx context.Context, req *pb.AddPat
 		}
 		notes = append(notes, note)
 	}
-	err := s.Service.AddPatientAllergiesChronics(ctx, notes)
+	err := s.Service.AddPatientAllergiesChronics(ctx, req.Token, notes)
 	if err != nil {
 		return nil, fmt.Errorf("не удалось добавить мед. запись: %w", err)
 	}
@@ -162,12 +289,17 @@ func (s *Server) AddPatientAllergiesChronics(ctx context.Context, req *pb.AddPat
 
 func (s *Server) AddConsultation(ctx context.Context, req *pb.AddConsultationRequest) (*pb.AddConsultationResponse, error) {
 	visit := model.AddVisit{
-		AppointmentID: int(req.AppointmentId),
-		PatientID:     int(req.PatientId),
-		DoctorID:      int(req.DoctorId),
-		Complaints:    req.Complaints,
-		Treatment:     req.Treatment,
+		AppointmentID:  int(req.AppointmentId),
+		PatientID:      int(req.PatientId),
+		DoctorID:       int(req.DoctorId),
+		Complaints:     req.Complaints,
+		Treatment:      req.Treatment,
+		IdempotencyKey: req.IdempotencyKey,
+	}
+	for _, id := range req.PlanItemIds {
+		visit.PlanItemIDs = append(visit.PlanItemIDs, int(id))
 	}
+	visit.Chart = toothStatesFromPb(req.Chart)
 
 	var materials []model.VisitMaterial
 	for _, m := range req.Materials {
@@ -184,6 +316,8 @@ func (s *Server) AddConsultation(ctx context.Context, req *pb.AddConsultationReq
 			VisitID:   int(s.VisitId),
 			ServiceID: int(s.ServiceId),
 			Amount:    int(s.Amount),
+			Tooth:     int(s.Tooth),
+			Surfaces:  s.Surfaces,
 		})
 	}
 
@@ -193,14 +327,20 @@ func (s *Server) AddConsultation(ctx context.Context, req *pb.AddConsultationReq
 			VisitID:   int(d.VisitId),
 			ICDCodeID: int(d.IcdCodeId),
 			Note:      d.Note,
+			Tooth:     int(d.Tooth),
+			Surfaces:  d.Surfaces,
 		})
 	}
 
-	err := s.Service.AddConsultation(ctx, materials, services, diagnoses, visit, req.Token)
+	visitID, total, alerts, err := s.Service.AddConsultation(ctx, materials, services, diagnoses, visit, req.Token)
 	if err != nil {
 		return nil, fmt.Errorf("не удалось провести приём: %w", err)
 	}
-	return &pb.AddConsultationResponse{}, nil
+	return &pb.AddConsultationResponse{
+		VisitId:    int32(visitID),
+		TotalPrice: int32(total),
+		Alerts:     clinicalAlertsToPb(alerts),
+	}, nil
 }
 
 func (s *Server) AddVisitPayment(ctx context.Context, req *pb.VisitPaymentRequest) (*pb.DefaultResponse, error) {
@@ -228,7 +368,7 @@ func (s *Server) UpdateVisitPayment(ctx context.Context, req *pb.VisitPaymentReq
 }
 
 func (s *Server) GetDocumentsByPatientID(ctx context.Context, request *pb.GetDocumentsRequest) (*pb.GetDocumentsResponse, error) {
-	resp, err := s.Service.GetDocumentsInfo(ctx, int(request.PatientID))
+	resp, err := s.Service.GetDocumentsInfo(ctx, request.Token, int(request.PatientID))
 	if err != nil {
 		return nil, err
 	}
@@ -246,13 +386,155 @@ func (s *Server) GetDocumentsByPatientID(ctx context.Context, request *pb.GetDoc
 	return &pb.GetDocumentsResponse{Documents: docs}, nil
 }
 
-func (s *Server) DownloadDocument(ctx context.Context, request *pb.DownloadDocumentRequest) (*pb.DownloadDocumentResponse, error) {
-	resp, err := s.Service.DownloadDocument(ctx, request.DocumentId)
+func (s *Server) DownloadDocument(request *pb.DownloadDocumentRequest, stream pb.DoctorService_DownloadDocumentServer) error {
+	return s.Service.DownloadDocument(stream.Context(), request.Token, request.DocumentId, request.Offset, func(chunk model.DocumentChunk) error {
+		return stream.Send(&pb.DownloadDocumentResponse{
+			FileName: chunk.FileName,
+			Size:     chunk.Size,
+			Chunk:    chunk.Data,
+		})
+	})
+}
+
+func (s *Server) GetPatientTreatmentPlans(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetPatientTreatmentPlansResponse, error) {
+	plans, err := s.Service.GetPatientTreatmentPlans(ctx, req.Token, int(req.Id))
 	if err != nil {
 		return nil, err
 	}
-	return &pb.DownloadDocumentResponse{
-		FileName:    resp.FileName,
-		FileContent: resp.FileContent,
-	}, nil
+	resp := &pb.GetPatientTreatmentPlansResponse{}
+	for _, plan := range plans {
+		resp.Plans = append(resp.Plans, treatmentPlanToPb(plan))
+	}
+	return resp, nil
+}
+
+func (s *Server) CreateTreatmentPlan(ctx context.Context, req *pb.CreateTreatmentPlanRequest) (*pb.TreatmentPlanResponse, error) {
+	plan, err := s.Service.CreateTreatmentPlan(ctx, req.Token, int(req.PatientId), req.Title, treatmentPlanItemsFromPb(req.Items))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TreatmentPlanResponse{Plan: treatmentPlanToPb(plan)}, nil
+}
+
+func (s *Server) AddTreatmentPlanItems(ctx context.Context, req *pb.AddTreatmentPlanItemsRequest) (*pb.TreatmentPlanResponse, error) {
+	plan, err := s.Service.AddTreatmentPlanItems(ctx, req.Token, int(req.PlanId), treatmentPlanItemsFromPb(req.Items))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TreatmentPlanResponse{Plan: treatmentPlanToPb(plan)}, nil
+}
+
+func (s *Server) UpdateTreatmentPlanItemStatus(ctx context.Context, req *pb.UpdateTreatmentPlanItemStatusRequest) (*pb.TreatmentPlanResponse, error) {
+	plan, err := s.Service.UpdateTreatmentPlanItemStatus(ctx, req.Token, int(req.ItemId), req.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TreatmentPlanResponse{Plan: treatmentPlanToPb(plan)}, nil
+}
+
+func (s *Server) CancelTreatmentPlan(ctx context.Context, req *pb.GetByIdRequest) (*pb.TreatmentPlanResponse, error) {
+	plan, err := s.Service.CancelTreatmentPlan(ctx, req.Token, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TreatmentPlanResponse{Plan: treatmentPlanToPb(plan)}, nil
+}
+
+func (s *Server) GetDentalChart(ctx context.Context, req *pb.GetByIdRequest) (*pb.DentalChartResponse, error) {
+	teeth, err := s.Service.GetDentalChart(ctx, req.Token, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DentalChartResponse{Teeth: toothStatesToPb(teeth)}, nil
+}
+
+func (s *Server) UpdateDentalChart(ctx context.Context, req *pb.UpdateDentalChartRequest) (*pb.DentalChartResponse, error) {
+	teeth, err := s.Service.UpdateDentalChart(ctx, req.Token, int(req.PatientId), toothStatesFromPb(req.Teeth))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DentalChartResponse{Teeth: toothStatesToPb(teeth)}, nil
+}
+
+func (s *Server) GetToothHistory(ctx context.Context, req *pb.GetToothHistoryRequest) (*pb.DentalChartResponse, error) {
+	teeth, err := s.Service.GetToothHistory(ctx, req.Token, int(req.PatientId), int(req.Tooth))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DentalChartResponse{Teeth: toothStatesToPb(teeth)}, nil
+}
+
+func toothStatesFromPb(teeth []*pb.ToothState) []model.ToothState {
+	result := make([]model.ToothState, 0, len(teeth))
+	for _, item := range teeth {
+		result = append(result, model.ToothState{
+			Tooth:    int(item.Tooth),
+			Status:   item.Status,
+			Surfaces: item.Surfaces,
+			Note:     item.Note,
+		})
+	}
+	return result
+}
+
+func toothStatesToPb(teeth []model.ToothState) []*pb.ToothState {
+	result := make([]*pb.ToothState, 0, len(teeth))
+	for _, item := range teeth {
+		result = append(result, &pb.ToothState{
+			Tooth:      int32(item.Tooth),
+			Status:     item.Status,
+			Surfaces:   item.Surfaces,
+			Note:       item.Note,
+			VisitId:    int32(item.VisitID),
+			DoctorId:   int32(item.DoctorID),
+			Doctor:     item.Doctor,
+			RecordedAt: item.RecordedAt,
+		})
+	}
+	return result
+}
+
+func treatmentPlanItemsFromPb(items []*pb.TreatmentPlanItem) []model.TreatmentPlanItem {
+	result := make([]model.TreatmentPlanItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, model.TreatmentPlanItem{
+			Stage:     int(item.Stage),
+			Tooth:     int(item.Tooth),
+			ServiceID: int(item.ServiceId),
+			Quantity:  int(item.Quantity),
+			Note:      item.Note,
+		})
+	}
+	return result
+}
+
+func treatmentPlanToPb(plan model.TreatmentPlan) *pb.TreatmentPlan {
+	resp := &pb.TreatmentPlan{
+		Id:             int32(plan.ID),
+		PatientId:      int32(plan.PatientID),
+		DoctorId:       int32(plan.DoctorID),
+		Doctor:         plan.Doctor,
+		Title:          plan.Title,
+		Status:         plan.Status,
+		AcceptedAt:     plan.AcceptedAt,
+		CreatedAt:      plan.CreatedAt,
+		EstimatedTotal: int32(plan.EstimatedTotal),
+		RemainingCost:  int32(plan.RemainingCost),
+	}
+	for _, item := range plan.Items {
+		resp.Items = append(resp.Items, &pb.TreatmentPlanItem{
+			Id:             int32(item.ID),
+			Stage:          int32(item.Stage),
+			Tooth:          int32(item.Tooth),
+			ServiceId:      int32(item.ServiceID),
+			ServiceName:    item.ServiceName,
+			Quantity:       int32(item.Quantity),
+			EstimatedPrice: int32(item.EstimatedPrice),
+			Status:         item.Status,
+			VisitId:        int32(item.VisitID),
+			Note:           item.Note,
+			CompletedAt:    item.CompletedAt,
+		})
+	}
+	return resp
 }
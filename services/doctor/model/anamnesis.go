@@ -0,0 +1,31 @@
+package model
+
+// Anamnesis версия анкеты здоровья пациента. Version = 0 — анкета не заполнялась.
+// ChangedByRole — patient или doctor, ChangedBy — ФИО врача, если версию сохранил врач
+type Anamnesis struct {
+	Version            int
+	Medications        string
+	BloodThinners      bool
+	CardiacDisease     bool
+	Hypertension       bool
+	Diabetes           bool
+	BleedingDisorder   bool
+	InfectiousDisease  bool
+	AnesthesiaReaction bool
+	Pregnant           bool
+	Breastfeeding      bool
+	Smoker             bool
+	OtherConditions    string
+	Allergies          []AnamnesisAllergy
+	ChangedByID        int
+	ChangedByRole      string
+	ChangedBy          string
+	ChangedAt          string
+}
+
+// AnamnesisAllergy аллергия из анкеты; Severity — mild, moderate или severe
+type AnamnesisAllergy struct {
+	Allergen string
+	Severity string
+	Reaction string
+}
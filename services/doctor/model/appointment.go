@@ -20,6 +20,7 @@ type (
 		Status             string
 		CreatedAt          time.Time
 		UpdatedAt          time.Time
+		Alerts             []ClinicalAlert // предупреждения по карте пациента, которые показываются при открытии приёма
 	}
 	TodayAppointment struct {
 		ID        AppointmentID
@@ -0,0 +1,14 @@
+package model
+
+// ClinicalAlert сработавшее правило клинического предупреждения. Reason — что в карте пациента вызвало
+// предупреждение; MaterialID или ServiceID и TargetName заданы, если предупреждение относится к материалу или услуге
+type ClinicalAlert struct {
+	RuleID     int
+	Severity   string // info, warning, critical
+	Title      string
+	Message    string
+	Reason     string
+	MaterialID int
+	ServiceID  int
+	TargetName string
+}
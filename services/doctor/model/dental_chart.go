@@ -0,0 +1,14 @@
+package model
+
+// ToothState состояние зуба в зубной формуле. Tooth — номер по FDI, Surfaces — затронутые поверхности
+// буквами MOIDBL, пустая строка — зуб целиком
+type ToothState struct {
+	Tooth      int
+	Status     string // healthy, caries, filled, crown, implant, missing
+	Surfaces   string
+	Note       string
+	VisitID    int
+	DoctorID   int
+	Doctor     string
+	RecordedAt string
+}
@@ -0,0 +1,8 @@
+package model
+
+// MedicalRecordExport файл выгрузки медицинской карты пациента
+type MedicalRecordExport struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
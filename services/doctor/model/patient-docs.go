@@ -29,8 +29,10 @@ type (
 		Description string
 		CreatedAt   string
 	}
-	DocumentFile struct {
-		FileName    string
-		FileContent []byte
+	// DocumentChunk часть скачиваемого документа; FileName и Size заполнены только в первой части
+	DocumentChunk struct {
+		FileName string
+		Size     int64
+		Data     []byte
 	}
 )
@@ -0,0 +1,32 @@
+package model
+
+// TreatmentPlan план лечения пациента. EstimatedTotal — стоимость неотменённых позиций, RemainingCost — ещё
+// не выполненных; обе по ценам на день составления плана
+type TreatmentPlan struct {
+	ID             int
+	PatientID      int
+	DoctorID       int
+	Doctor         string
+	Title          string
+	Status         string // proposed, accepted, in_progress, done, cancelled
+	AcceptedAt     string
+	CreatedAt      string
+	Items          []TreatmentPlanItem
+	EstimatedTotal int
+	RemainingCost  int
+}
+
+// TreatmentPlanItem услуга плана на этапе; Tooth — номер зуба по FDI, 0 — без привязки к зубу
+type TreatmentPlanItem struct {
+	ID             int
+	Stage          int
+	Tooth          int
+	ServiceID      int
+	ServiceName    string
+	Quantity       int
+	EstimatedPrice int
+	Status         string // planned, in_progress, done, cancelled
+	VisitID        int
+	Note           string
+	CompletedAt    string
+}
@@ -1,8 +1,10 @@
 package model
 
 type Diagnose struct {
-	ICDCode string
-	Notes   string
+	ICDCode  string
+	Notes    string
+	Tooth    int
+	Surfaces string
 }
 
 type Visit struct {
@@ -24,13 +26,22 @@ type AddVisit struct {
 	DoctorID      int
 	Complaints    string
 	Treatment     string
+	// ключ отправки формы приёма: повтор с тем же ключом не создаёт второй визит
+	IdempotencyKey string
+	// позиции принятых планов лечения пациента, выполненные на приёме
+	PlanItemIDs []int
+	// изменения зубной формулы по итогам приёма
+	Chart []ToothState
 }
 
+// VisitService услуга приёма; Tooth — номер зуба по FDI, 0 — без привязки к зубу
 type VisitService struct {
 	ID        int
 	VisitID   int
 	ServiceID int
 	Amount    int
+	Tooth     int
+	Surfaces  string
 }
 
 type VisitMaterial struct {
@@ -40,11 +51,14 @@ type VisitMaterial struct {
 	Amount     int
 }
 
+// VisitDiagnose диагноз приёма; Tooth — номер зуба по FDI, 0 — без привязки к зубу
 type VisitDiagnose struct {
 	ID        int
 	VisitID   int
 	ICDCodeID int
 	Note      string
+	Tooth     int
+	Surfaces  string
 }
 
 type VisitPayment struct {
@@ -1112,6 +1112,58 @@ func (x *GetUserIDResponse) GetUserId() int32 {
 	return 0
 }
 
+type GetUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          int32                  `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"` // идентификатор роли из таблицы roles
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRoleResponse) Reset() {
+	*x = GetUserRoleResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRoleResponse) ProtoMessage() {}
+
+func (x *GetUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*GetUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserRoleResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserRoleResponse) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
 type GetPatientResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Patient       *PatientData           `protobuf:"bytes,1,opt,name=patient,proto3" json:"patient,omitempty"`
@@ -1121,7 +1173,7 @@ type GetPatientResponse struct {
 
 func (x *GetPatientResponse) Reset() {
 	*x = GetPatientResponse{}
-	mi := &file_proto_auth_auth_proto_msgTypes[20]
+	mi := &file_proto_auth_auth_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1133,7 +1185,7 @@ func (x *GetPatientResponse) String() string {
 func (*GetPatientResponse) ProtoMessage() {}
 
 func (x *GetPatientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[20]
+	mi := &file_proto_auth_auth_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1146,7 +1198,7 @@ func (x *GetPatientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{20}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetPatientResponse) GetPatient() *PatientData {
@@ -1233,9 +1285,12 @@ const file_proto_auth_auth_proto_rawDesc = "" +
 	"\x10GetUserIDRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\",\n" +
 	"\x11GetUserIDResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x05R\x06userId\"A\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\"B\n" +
+	"\x13GetUserRoleResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\x05R\x04role\"A\n" +
 	"\x12GetPatientResponse\x12+\n" +
-	"\apatient\x18\x01 \x01(\v2\x11.auth.PatientDataR\apatient2\xbf\x06\n" +
+	"\apatient\x18\x01 \x01(\v2\x11.auth.PatientDataR\apatient2\x81\a\n" +
 	"\vAuthService\x12Q\n" +
 	"\x10EmployeeRegister\x12\x1d.auth.EmployeeRegisterRequest\x1a\x1e.auth.EmployeeRegisterResponse\x12N\n" +
 	"\x0fPatientRegister\x12\x1c.auth.PatientRegisterRequest\x1a\x1d.auth.PatientRegisterResponse\x12f\n" +
@@ -1249,7 +1304,8 @@ const file_proto_auth_auth_proto_rawDesc = "" +
 	"\x0fPermissionCheck\x12\x1c.auth.PermissionCheckRequest\x1a\x15.auth.DefaultResponse\x12?\n" +
 	"\n" +
 	"GetPatient\x12\x17.auth.GetPatientRequest\x1a\x18.auth.GetPatientResponse\x12<\n" +
-	"\tGetUserID\x12\x16.auth.GetUserIDRequest\x1a\x17.auth.GetUserIDResponseB\x13Z\x11auth/proto;authpbb\x06proto3"
+	"\tGetUserID\x12\x16.auth.GetUserIDRequest\x1a\x17.auth.GetUserIDResponse\x12@\n" +
+	"\vGetUserRole\x12\x16.auth.GetUserIDRequest\x1a\x19.auth.GetUserRoleResponseB\x13Z\x11auth/proto;authpbb\x06proto3"
 
 var (
 	file_proto_auth_auth_proto_rawDescOnce sync.Once
@@ -1263,7 +1319,7 @@ func file_proto_auth_auth_proto_rawDescGZIP() []byte {
 	return file_proto_auth_auth_proto_rawDescData
 }
 
-var file_proto_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_proto_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_proto_auth_auth_proto_goTypes = []any{
 	(*UserData)(nil),                        // 0: auth.UserData
 	(*EmployeeRegisterResponse)(nil),        // 1: auth.EmployeeRegisterResponse
@@ -1285,13 +1341,14 @@ var file_proto_auth_auth_proto_goTypes = []any{
 	(*GetPatientRequest)(nil),               // 17: auth.GetPatientRequest
 	(*GetUserIDRequest)(nil),                // 18: auth.GetUserIDRequest
 	(*GetUserIDResponse)(nil),               // 19: auth.GetUserIDResponse
-	(*GetPatientResponse)(nil),              // 20: auth.GetPatientResponse
-	(*timestamppb.Timestamp)(nil),           // 21: google.protobuf.Timestamp
+	(*GetUserRoleResponse)(nil),             // 20: auth.GetUserRoleResponse
+	(*GetPatientResponse)(nil),              // 21: auth.GetPatientResponse
+	(*timestamppb.Timestamp)(nil),           // 22: google.protobuf.Timestamp
 }
 var file_proto_auth_auth_proto_depIdxs = []int32{
 	0,  // 0: auth.EmployeeRegisterRequest.user:type_name -> auth.UserData
 	2,  // 1: auth.EmployeeRegisterRequest.employee:type_name -> auth.EmployeeData
-	21, // 2: auth.PatientData.birth_date:type_name -> google.protobuf.Timestamp
+	22, // 2: auth.PatientData.birth_date:type_name -> google.protobuf.Timestamp
 	0,  // 3: auth.PatientRegisterRequest.user:type_name -> auth.UserData
 	4,  // 4: auth.PatientRegisterRequest.patient:type_name -> auth.PatientData
 	0,  // 5: auth.PatientRegisterInClinicRequest.user:type_name -> auth.UserData
@@ -1308,19 +1365,21 @@ var file_proto_auth_auth_proto_depIdxs = []int32{
 	16, // 16: auth.AuthService.PermissionCheck:input_type -> auth.PermissionCheckRequest
 	17, // 17: auth.AuthService.GetPatient:input_type -> auth.GetPatientRequest
 	18, // 18: auth.AuthService.GetUserID:input_type -> auth.GetUserIDRequest
-	1,  // 19: auth.AuthService.EmployeeRegister:output_type -> auth.EmployeeRegisterResponse
-	6,  // 20: auth.AuthService.PatientRegister:output_type -> auth.PatientRegisterResponse
-	8,  // 21: auth.AuthService.PatientRegisterInClinic:output_type -> auth.PatientRegisterInClinicResponse
-	11, // 22: auth.AuthService.EmployeePasswordRecovery:output_type -> auth.DefaultResponse
-	11, // 23: auth.AuthService.PatientPasswordRecovery:output_type -> auth.DefaultResponse
-	11, // 24: auth.AuthService.RequestCode:output_type -> auth.DefaultResponse
-	11, // 25: auth.AuthService.VerifyCode:output_type -> auth.DefaultResponse
-	15, // 26: auth.AuthService.Auth:output_type -> auth.AuthResponse
-	11, // 27: auth.AuthService.PermissionCheck:output_type -> auth.DefaultResponse
-	20, // 28: auth.AuthService.GetPatient:output_type -> auth.GetPatientResponse
-	19, // 29: auth.AuthService.GetUserID:output_type -> auth.GetUserIDResponse
-	19, // [19:30] is the sub-list for method output_type
-	8,  // [8:19] is the sub-list for method input_type
+	18, // 19: auth.AuthService.GetUserRole:input_type -> auth.GetUserIDRequest
+	1,  // 20: auth.AuthService.EmployeeRegister:output_type -> auth.EmployeeRegisterResponse
+	6,  // 21: auth.AuthService.PatientRegister:output_type -> auth.PatientRegisterResponse
+	8,  // 22: auth.AuthService.PatientRegisterInClinic:output_type -> auth.PatientRegisterInClinicResponse
+	11, // 23: auth.AuthService.EmployeePasswordRecovery:output_type -> auth.DefaultResponse
+	11, // 24: auth.AuthService.PatientPasswordRecovery:output_type -> auth.DefaultResponse
+	11, // 25: auth.AuthService.RequestCode:output_type -> auth.DefaultResponse
+	11, // 26: auth.AuthService.VerifyCode:output_type -> auth.DefaultResponse
+	15, // 27: auth.AuthService.Auth:output_type -> auth.AuthResponse
+	11, // 28: auth.AuthService.PermissionCheck:output_type -> auth.DefaultResponse
+	21, // 29: auth.AuthService.GetPatient:output_type -> auth.GetPatientResponse
+	19, // 30: auth.AuthService.GetUserID:output_type -> auth.GetUserIDResponse
+	20, // 31: auth.AuthService.GetUserRole:output_type -> auth.GetUserRoleResponse
+	20, // [20:32] is the sub-list for method output_type
+	8,  // [8:20] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -1337,7 +1396,7 @@ func file_proto_auth_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_auth_proto_rawDesc), len(file_proto_auth_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
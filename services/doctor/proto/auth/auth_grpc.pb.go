@@ -32,6 +32,7 @@ const (
 	AuthService_PermissionCheck_FullMethodName          = "/auth.AuthService/PermissionCheck"
 	AuthService_GetPatient_FullMethodName               = "/auth.AuthService/GetPatient"
 	AuthService_GetUserID_FullMethodName                = "/auth.AuthService/GetUserID"
+	AuthService_GetUserRole_FullMethodName              = "/auth.AuthService/GetUserRole"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -49,6 +50,7 @@ type AuthServiceClient interface {
 	PermissionCheck(ctx context.Context, in *PermissionCheckRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	GetPatient(ctx context.Context, in *GetPatientRequest, opts ...grpc.CallOption) (*GetPatientResponse, error)
 	GetUserID(ctx context.Context, in *GetUserIDRequest, opts ...grpc.CallOption) (*GetUserIDResponse, error)
+	GetUserRole(ctx context.Context, in *GetUserIDRequest, opts ...grpc.CallOption) (*GetUserRoleResponse, error)
 }
 
 type authServiceClient struct {
@@ -169,6 +171,16 @@ func (c *authServiceClient) GetUserID(ctx context.Context, in *GetUserIDRequest,
 	return out, nil
 }
 
+func (c *authServiceClient) GetUserRole(ctx context.Context, in *GetUserIDRequest, opts ...grpc.CallOption) (*GetUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserRoleResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -184,6 +196,7 @@ type AuthServiceServer interface {
 	PermissionCheck(context.Context, *PermissionCheckRequest) (*DefaultResponse, error)
 	GetPatient(context.Context, *GetPatientRequest) (*GetPatientResponse, error)
 	GetUserID(context.Context, *GetUserIDRequest) (*GetUserIDResponse, error)
+	GetUserRole(context.Context, *GetUserIDRequest) (*GetUserRoleResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -227,6 +240,9 @@ func (UnimplementedAuthServiceServer) GetPatient(context.Context, *GetPatientReq
 func (UnimplementedAuthServiceServer) GetUserID(context.Context, *GetUserIDRequest) (*GetUserIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserID not implemented")
 }
+func (UnimplementedAuthServiceServer) GetUserRole(context.Context, *GetUserIDRequest) (*GetUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserRole not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -446,6 +462,24 @@ func _AuthService_GetUserID_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_GetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetUserRole(ctx, req.(*GetUserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -497,6 +531,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserID",
 			Handler:    _AuthService_GetUserID_Handler,
 		},
+		{
+			MethodName: "GetUserRole",
+			Handler:    _AuthService_GetUserRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/auth/auth.proto",
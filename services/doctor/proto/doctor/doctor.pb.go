@@ -173,6 +173,7 @@ func (x *Appointment) GetUpdatedAt() *timestamppb.Timestamp {
 type GetAppointmentByIDResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Appt          *Appointment           `protobuf:"bytes,1,opt,name=appt,proto3" json:"appt,omitempty"`
+	Alerts        []*ClinicalAlert       `protobuf:"bytes,2,rep,name=alerts,proto3" json:"alerts,omitempty"` // предупреждения по карте пациента
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -214,6 +215,227 @@ func (x *GetAppointmentByIDResponse) GetAppt() *Appointment {
 	return nil
 }
 
+func (x *GetAppointmentByIDResponse) GetAlerts() []*ClinicalAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+// Сработавшее правило клинического предупреждения; reason — что в карте пациента его вызвало.
+// material_id или service_id заданы, если предупреждение относится к выбранному материалу или услуге
+type ClinicalAlert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int32                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"` // info, warning, critical
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	MaterialId    int32                  `protobuf:"varint,6,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	ServiceId     int32                  `protobuf:"varint,7,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	TargetName    string                 `protobuf:"bytes,8,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClinicalAlert) Reset() {
+	*x = ClinicalAlert{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClinicalAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClinicalAlert) ProtoMessage() {}
+
+func (x *ClinicalAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClinicalAlert.ProtoReflect.Descriptor instead.
+func (*ClinicalAlert) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClinicalAlert) GetRuleId() int32 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *ClinicalAlert) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ClinicalAlert) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ClinicalAlert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ClinicalAlert) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ClinicalAlert) GetMaterialId() int32 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *ClinicalAlert) GetServiceId() int32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *ClinicalAlert) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+type CheckClinicalAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PatientId     int32                  `protobuf:"varint,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	MaterialIds   []int32                `protobuf:"varint,2,rep,packed,name=material_ids,json=materialIds,proto3" json:"material_ids,omitempty"`
+	ServiceIds    []int32                `protobuf:"varint,3,rep,packed,name=service_ids,json=serviceIds,proto3" json:"service_ids,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckClinicalAlertsRequest) Reset() {
+	*x = CheckClinicalAlertsRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckClinicalAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckClinicalAlertsRequest) ProtoMessage() {}
+
+func (x *CheckClinicalAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckClinicalAlertsRequest.ProtoReflect.Descriptor instead.
+func (*CheckClinicalAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CheckClinicalAlertsRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *CheckClinicalAlertsRequest) GetMaterialIds() []int32 {
+	if x != nil {
+		return x.MaterialIds
+	}
+	return nil
+}
+
+func (x *CheckClinicalAlertsRequest) GetServiceIds() []int32 {
+	if x != nil {
+		return x.ServiceIds
+	}
+	return nil
+}
+
+func (x *CheckClinicalAlertsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ClinicalAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*ClinicalAlert       `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClinicalAlertsResponse) Reset() {
+	*x = ClinicalAlertsResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClinicalAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClinicalAlertsResponse) ProtoMessage() {}
+
+func (x *ClinicalAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClinicalAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ClinicalAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ClinicalAlertsResponse) GetAlerts() []*ClinicalAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
 type GetTodayAppointmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -223,7 +445,7 @@ type GetTodayAppointmentsRequest struct {
 
 func (x *GetTodayAppointmentsRequest) Reset() {
 	*x = GetTodayAppointmentsRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[2]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -235,7 +457,7 @@ func (x *GetTodayAppointmentsRequest) String() string {
 func (*GetTodayAppointmentsRequest) ProtoMessage() {}
 
 func (x *GetTodayAppointmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[2]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,7 +470,7 @@ func (x *GetTodayAppointmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTodayAppointmentsRequest.ProtoReflect.Descriptor instead.
 func (*GetTodayAppointmentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{2}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetTodayAppointmentsRequest) GetToken() string {
@@ -267,7 +489,7 @@ type GetUpcomingAppointmentsRequest struct {
 
 func (x *GetUpcomingAppointmentsRequest) Reset() {
 	*x = GetUpcomingAppointmentsRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[3]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -279,7 +501,7 @@ func (x *GetUpcomingAppointmentsRequest) String() string {
 func (*GetUpcomingAppointmentsRequest) ProtoMessage() {}
 
 func (x *GetUpcomingAppointmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[3]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +514,7 @@ func (x *GetUpcomingAppointmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpcomingAppointmentsRequest.ProtoReflect.Descriptor instead.
 func (*GetUpcomingAppointmentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{3}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetUpcomingAppointmentsRequest) GetToken() string {
@@ -311,7 +533,7 @@ type GetUpcomingAppointmentsResponse struct {
 
 func (x *GetUpcomingAppointmentsResponse) Reset() {
 	*x = GetUpcomingAppointmentsResponse{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[4]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -323,7 +545,7 @@ func (x *GetUpcomingAppointmentsResponse) String() string {
 func (*GetUpcomingAppointmentsResponse) ProtoMessage() {}
 
 func (x *GetUpcomingAppointmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[4]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +558,7 @@ func (x *GetUpcomingAppointmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpcomingAppointmentsResponse.ProtoReflect.Descriptor instead.
 func (*GetUpcomingAppointmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{4}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetUpcomingAppointmentsResponse) GetSchedule() *ScheduleTable {
@@ -357,7 +579,7 @@ type ScheduleTable struct {
 
 func (x *ScheduleTable) Reset() {
 	*x = ScheduleTable{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[5]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -369,7 +591,7 @@ func (x *ScheduleTable) String() string {
 func (*ScheduleTable) ProtoMessage() {}
 
 func (x *ScheduleTable) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[5]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,7 +604,7 @@ func (x *ScheduleTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleTable.ProtoReflect.Descriptor instead.
 func (*ScheduleTable) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{5}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ScheduleTable) GetDates() []string {
@@ -417,7 +639,7 @@ type ScheduleCell struct {
 
 func (x *ScheduleCell) Reset() {
 	*x = ScheduleCell{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[6]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -429,7 +651,7 @@ func (x *ScheduleCell) String() string {
 func (*ScheduleCell) ProtoMessage() {}
 
 func (x *ScheduleCell) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[6]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +664,7 @@ func (x *ScheduleCell) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScheduleCell.ProtoReflect.Descriptor instead.
 func (*ScheduleCell) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{6}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ScheduleCell) GetDate() string {
@@ -477,7 +699,7 @@ type UpcomingAppointment struct {
 
 func (x *UpcomingAppointment) Reset() {
 	*x = UpcomingAppointment{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[7]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -489,7 +711,7 @@ func (x *UpcomingAppointment) String() string {
 func (*UpcomingAppointment) ProtoMessage() {}
 
 func (x *UpcomingAppointment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[7]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,7 +724,7 @@ func (x *UpcomingAppointment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpcomingAppointment.ProtoReflect.Descriptor instead.
 func (*UpcomingAppointment) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{7}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpcomingAppointment) GetId() int64 {
@@ -535,7 +757,7 @@ type AppointmentsResponse struct {
 
 func (x *AppointmentsResponse) Reset() {
 	*x = AppointmentsResponse{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[8]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -547,7 +769,7 @@ func (x *AppointmentsResponse) String() string {
 func (*AppointmentsResponse) ProtoMessage() {}
 
 func (x *AppointmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[8]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,7 +782,7 @@ func (x *AppointmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppointmentsResponse.ProtoReflect.Descriptor instead.
 func (*AppointmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{8}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AppointmentsResponse) GetAppointments() []*Appointment {
@@ -583,7 +805,7 @@ type TodayAppointment struct {
 
 func (x *TodayAppointment) Reset() {
 	*x = TodayAppointment{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[9]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -595,7 +817,7 @@ func (x *TodayAppointment) String() string {
 func (*TodayAppointment) ProtoMessage() {}
 
 func (x *TodayAppointment) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[9]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,7 +830,7 @@ func (x *TodayAppointment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TodayAppointment.ProtoReflect.Descriptor instead.
 func (*TodayAppointment) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{9}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TodayAppointment) GetId() int32 {
@@ -655,7 +877,7 @@ type GetTodayAppointmentsResponse struct {
 
 func (x *GetTodayAppointmentsResponse) Reset() {
 	*x = GetTodayAppointmentsResponse{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[10]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -667,7 +889,7 @@ func (x *GetTodayAppointmentsResponse) String() string {
 func (*GetTodayAppointmentsResponse) ProtoMessage() {}
 
 func (x *GetTodayAppointmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[10]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,7 +902,7 @@ func (x *GetTodayAppointmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTodayAppointmentsResponse.ProtoReflect.Descriptor instead.
 func (*GetTodayAppointmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{10}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetTodayAppointmentsResponse) GetAppointments() []*TodayAppointment {
@@ -702,7 +924,7 @@ type PatientAllergiesChronics struct {
 
 func (x *PatientAllergiesChronics) Reset() {
 	*x = PatientAllergiesChronics{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[11]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -714,7 +936,7 @@ func (x *PatientAllergiesChronics) String() string {
 func (*PatientAllergiesChronics) ProtoMessage() {}
 
 func (x *PatientAllergiesChronics) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[11]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,7 +949,7 @@ func (x *PatientAllergiesChronics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatientAllergiesChronics.ProtoReflect.Descriptor instead.
 func (*PatientAllergiesChronics) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{11}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PatientAllergiesChronics) GetId() int32 {
@@ -761,13 +983,14 @@ func (x *PatientAllergiesChronics) GetTitle() string {
 type GetPatientAllergiesChronicsResponse struct {
 	state                    protoimpl.MessageState      `protogen:"open.v1"`
 	PatientAllergiesChronics []*PatientAllergiesChronics `protobuf:"bytes,1,rep,name=patient_allergies_chronics,json=patientAllergiesChronics,proto3" json:"patient_allergies_chronics,omitempty"`
+	Anamnesis                *Anamnesis                  `protobuf:"bytes,2,opt,name=anamnesis,proto3" json:"anamnesis,omitempty"` // текущая версия анкеты здоровья
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
 
 func (x *GetPatientAllergiesChronicsResponse) Reset() {
 	*x = GetPatientAllergiesChronicsResponse{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[12]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -779,7 +1002,7 @@ func (x *GetPatientAllergiesChronicsResponse) String() string {
 func (*GetPatientAllergiesChronicsResponse) ProtoMessage() {}
 
 func (x *GetPatientAllergiesChronicsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[12]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +1015,7 @@ func (x *GetPatientAllergiesChronicsResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetPatientAllergiesChronicsResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientAllergiesChronicsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{12}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetPatientAllergiesChronicsResponse) GetPatientAllergiesChronics() []*PatientAllergiesChronics {
@@ -802,28 +1025,38 @@ func (x *GetPatientAllergiesChronicsResponse) GetPatientAllergiesChronics() []*P
 	return nil
 }
 
-type GetByIdRequest struct {
+func (x *GetPatientAllergiesChronicsResponse) GetAnamnesis() *Anamnesis {
+	if x != nil {
+		return x.Anamnesis
+	}
+	return nil
+}
+
+// Аллергия из анкеты здоровья; severity — mild, moderate, severe
+type AnamnesisAllergy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Allergen      string                 `protobuf:"bytes,1,opt,name=allergen,proto3" json:"allergen,omitempty"`
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"`
+	Reaction      string                 `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByIdRequest) Reset() {
-	*x = GetByIdRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[13]
+func (x *AnamnesisAllergy) Reset() {
+	*x = AnamnesisAllergy{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByIdRequest) String() string {
+func (x *AnamnesisAllergy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByIdRequest) ProtoMessage() {}
+func (*AnamnesisAllergy) ProtoMessage() {}
 
-func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[13]
+func (x *AnamnesisAllergy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,39 +1067,72 @@ func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetByIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AnamnesisAllergy.ProtoReflect.Descriptor instead.
+func (*AnamnesisAllergy) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetByIdRequest) GetId() int32 {
+func (x *AnamnesisAllergy) GetAllergen() string {
 	if x != nil {
-		return x.Id
+		return x.Allergen
 	}
-	return 0
+	return ""
 }
 
-type AppointmentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AnamnesisAllergy) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
 }
 
-func (x *AppointmentResponse) Reset() {
-	*x = AppointmentResponse{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[14]
+func (x *AnamnesisAllergy) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+// Версия анкеты здоровья пациента; version = 0 — анкета не заполнялась
+type Anamnesis struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Version            int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Medications        string                 `protobuf:"bytes,2,opt,name=medications,proto3" json:"medications,omitempty"`
+	BloodThinners      bool                   `protobuf:"varint,3,opt,name=blood_thinners,json=bloodThinners,proto3" json:"blood_thinners,omitempty"`
+	CardiacDisease     bool                   `protobuf:"varint,4,opt,name=cardiac_disease,json=cardiacDisease,proto3" json:"cardiac_disease,omitempty"`
+	Hypertension       bool                   `protobuf:"varint,5,opt,name=hypertension,proto3" json:"hypertension,omitempty"`
+	Diabetes           bool                   `protobuf:"varint,6,opt,name=diabetes,proto3" json:"diabetes,omitempty"`
+	BleedingDisorder   bool                   `protobuf:"varint,7,opt,name=bleeding_disorder,json=bleedingDisorder,proto3" json:"bleeding_disorder,omitempty"`
+	InfectiousDisease  bool                   `protobuf:"varint,8,opt,name=infectious_disease,json=infectiousDisease,proto3" json:"infectious_disease,omitempty"`
+	AnesthesiaReaction bool                   `protobuf:"varint,9,opt,name=anesthesia_reaction,json=anesthesiaReaction,proto3" json:"anesthesia_reaction,omitempty"`
+	Pregnant           bool                   `protobuf:"varint,10,opt,name=pregnant,proto3" json:"pregnant,omitempty"`
+	Breastfeeding      bool                   `protobuf:"varint,11,opt,name=breastfeeding,proto3" json:"breastfeeding,omitempty"`
+	Smoker             bool                   `protobuf:"varint,12,opt,name=smoker,proto3" json:"smoker,omitempty"`
+	OtherConditions    string                 `protobuf:"bytes,13,opt,name=other_conditions,json=otherConditions,proto3" json:"other_conditions,omitempty"`
+	Allergies          []*AnamnesisAllergy    `protobuf:"bytes,14,rep,name=allergies,proto3" json:"allergies,omitempty"`
+	ChangedById        int32                  `protobuf:"varint,15,opt,name=changed_by_id,json=changedById,proto3" json:"changed_by_id,omitempty"`
+	ChangedByRole      string                 `protobuf:"bytes,16,opt,name=changed_by_role,json=changedByRole,proto3" json:"changed_by_role,omitempty"` // patient или doctor
+	ChangedBy          string                 `protobuf:"bytes,17,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`               // ФИО врача; для изменений пациента пусто
+	ChangedAt          string                 `protobuf:"bytes,18,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Anamnesis) Reset() {
+	*x = Anamnesis{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AppointmentResponse) String() string {
+func (x *Anamnesis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppointmentResponse) ProtoMessage() {}
+func (*Anamnesis) ProtoMessage() {}
 
-func (x *AppointmentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[14]
+func (x *Anamnesis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,160 +1143,162 @@ func (x *AppointmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppointmentResponse.ProtoReflect.Descriptor instead.
-func (*AppointmentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Anamnesis.ProtoReflect.Descriptor instead.
+func (*Anamnesis) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{17}
 }
 
-type Diagnose struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IcdCode       string                 `protobuf:"bytes,1,opt,name=icd_code,json=icdCode,proto3" json:"icd_code,omitempty"`
-	Notes         string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Anamnesis) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
 }
 
-func (x *Diagnose) Reset() {
-	*x = Diagnose{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Anamnesis) GetMedications() string {
+	if x != nil {
+		return x.Medications
+	}
+	return ""
 }
 
-func (x *Diagnose) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Anamnesis) GetBloodThinners() bool {
+	if x != nil {
+		return x.BloodThinners
+	}
+	return false
 }
 
-func (*Diagnose) ProtoMessage() {}
+func (x *Anamnesis) GetCardiacDisease() bool {
+	if x != nil {
+		return x.CardiacDisease
+	}
+	return false
+}
 
-func (x *Diagnose) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[15]
+func (x *Anamnesis) GetHypertension() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Hypertension
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use Diagnose.ProtoReflect.Descriptor instead.
-func (*Diagnose) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{15}
+func (x *Anamnesis) GetDiabetes() bool {
+	if x != nil {
+		return x.Diabetes
+	}
+	return false
 }
 
-func (x *Diagnose) GetIcdCode() string {
+func (x *Anamnesis) GetBleedingDisorder() bool {
 	if x != nil {
-		return x.IcdCode
+		return x.BleedingDisorder
 	}
-	return ""
+	return false
 }
 
-func (x *Diagnose) GetNotes() string {
+func (x *Anamnesis) GetInfectiousDisease() bool {
 	if x != nil {
-		return x.Notes
+		return x.InfectiousDisease
 	}
-	return ""
+	return false
 }
 
-type VisitDiagnose struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	VisitId       int32                  `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	IcdCodeId     int32                  `protobuf:"varint,3,opt,name=icd_code_id,json=icdCodeId,proto3" json:"icd_code_id,omitempty"`
-	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Anamnesis) GetAnesthesiaReaction() bool {
+	if x != nil {
+		return x.AnesthesiaReaction
+	}
+	return false
 }
 
-func (x *VisitDiagnose) Reset() {
-	*x = VisitDiagnose{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Anamnesis) GetPregnant() bool {
+	if x != nil {
+		return x.Pregnant
+	}
+	return false
 }
 
-func (x *VisitDiagnose) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Anamnesis) GetBreastfeeding() bool {
+	if x != nil {
+		return x.Breastfeeding
+	}
+	return false
 }
 
-func (*VisitDiagnose) ProtoMessage() {}
+func (x *Anamnesis) GetSmoker() bool {
+	if x != nil {
+		return x.Smoker
+	}
+	return false
+}
 
-func (x *VisitDiagnose) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[16]
+func (x *Anamnesis) GetOtherConditions() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OtherConditions
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use VisitDiagnose.ProtoReflect.Descriptor instead.
-func (*VisitDiagnose) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{16}
+func (x *Anamnesis) GetAllergies() []*AnamnesisAllergy {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
 }
 
-func (x *VisitDiagnose) GetId() int32 {
+func (x *Anamnesis) GetChangedById() int32 {
 	if x != nil {
-		return x.Id
+		return x.ChangedById
 	}
 	return 0
 }
 
-func (x *VisitDiagnose) GetVisitId() int32 {
+func (x *Anamnesis) GetChangedByRole() string {
 	if x != nil {
-		return x.VisitId
+		return x.ChangedByRole
 	}
-	return 0
+	return ""
 }
 
-func (x *VisitDiagnose) GetIcdCodeId() int32 {
+func (x *Anamnesis) GetChangedBy() string {
 	if x != nil {
-		return x.IcdCodeId
+		return x.ChangedBy
 	}
-	return 0
+	return ""
 }
 
-func (x *VisitDiagnose) GetNote() string {
+func (x *Anamnesis) GetChangedAt() string {
 	if x != nil {
-		return x.Note
+		return x.ChangedAt
 	}
 	return ""
 }
 
-type Visit struct {
+type SavePatientAnamnesisRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ApptId        int32                  `protobuf:"varint,2,opt,name=appt_id,json=apptId,proto3" json:"appt_id,omitempty"`
-	PatientId     int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
-	Doctor        string                 `protobuf:"bytes,4,opt,name=doctor,proto3" json:"doctor,omitempty"`
-	Complaints    string                 `protobuf:"bytes,5,opt,name=complaints,proto3" json:"complaints,omitempty"`
-	Treatment     string                 `protobuf:"bytes,6,opt,name=treatment,proto3" json:"treatment,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	Diagnoses     []*Diagnose            `protobuf:"bytes,8,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Anamnesis     *Anamnesis             `protobuf:"bytes,3,opt,name=anamnesis,proto3" json:"anamnesis,omitempty"`
+	BaseVersion   int32                  `protobuf:"varint,4,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"` // версия, которую видел врач; при расхождении сохранение отклоняется
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Visit) Reset() {
-	*x = Visit{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[17]
+func (x *SavePatientAnamnesisRequest) Reset() {
+	*x = SavePatientAnamnesisRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Visit) String() string {
+func (x *SavePatientAnamnesisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Visit) ProtoMessage() {}
+func (*SavePatientAnamnesisRequest) ProtoMessage() {}
 
-func (x *Visit) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[17]
+func (x *SavePatientAnamnesisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,89 +1309,105 @@ func (x *Visit) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Visit.ProtoReflect.Descriptor instead.
-func (*Visit) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use SavePatientAnamnesisRequest.ProtoReflect.Descriptor instead.
+func (*SavePatientAnamnesisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Visit) GetId() int32 {
+func (x *SavePatientAnamnesisRequest) GetToken() string {
 	if x != nil {
-		return x.Id
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *Visit) GetApptId() int32 {
+func (x *SavePatientAnamnesisRequest) GetPatientId() int32 {
 	if x != nil {
-		return x.ApptId
+		return x.PatientId
 	}
 	return 0
 }
 
-func (x *Visit) GetPatientId() int32 {
+func (x *SavePatientAnamnesisRequest) GetAnamnesis() *Anamnesis {
 	if x != nil {
-		return x.PatientId
+		return x.Anamnesis
 	}
-	return 0
+	return nil
 }
 
-func (x *Visit) GetDoctor() string {
+func (x *SavePatientAnamnesisRequest) GetBaseVersion() int32 {
 	if x != nil {
-		return x.Doctor
+		return x.BaseVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *Visit) GetComplaints() string {
-	if x != nil {
-		return x.Complaints
-	}
-	return ""
+type AnamnesisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anamnesis     *Anamnesis             `protobuf:"bytes,1,opt,name=anamnesis,proto3" json:"anamnesis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Visit) GetTreatment() string {
-	if x != nil {
-		return x.Treatment
-	}
-	return ""
+func (x *AnamnesisResponse) Reset() {
+	*x = AnamnesisResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Visit) GetCreatedAt() string {
+func (x *AnamnesisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnamnesisResponse) ProtoMessage() {}
+
+func (x *AnamnesisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[19]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Visit) GetDiagnoses() []*Diagnose {
+// Deprecated: Use AnamnesisResponse.ProtoReflect.Descriptor instead.
+func (*AnamnesisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AnamnesisResponse) GetAnamnesis() *Anamnesis {
 	if x != nil {
-		return x.Diagnoses
+		return x.Anamnesis
 	}
 	return nil
 }
 
-type GetPatientVisitsResponse struct {
+type AnamnesisHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Visits        []*Visit               `protobuf:"bytes,1,rep,name=visits,proto3" json:"visits,omitempty"`
+	Versions      []*Anamnesis           `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPatientVisitsResponse) Reset() {
-	*x = GetPatientVisitsResponse{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[18]
+func (x *AnamnesisHistoryResponse) Reset() {
+	*x = AnamnesisHistoryResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPatientVisitsResponse) String() string {
+func (x *AnamnesisHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPatientVisitsResponse) ProtoMessage() {}
+func (*AnamnesisHistoryResponse) ProtoMessage() {}
 
-func (x *GetPatientVisitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[18]
+func (x *AnamnesisHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1134,42 +1418,41 @@ func (x *GetPatientVisitsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPatientVisitsResponse.ProtoReflect.Descriptor instead.
-func (*GetPatientVisitsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use AnamnesisHistoryResponse.ProtoReflect.Descriptor instead.
+func (*AnamnesisHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetPatientVisitsResponse) GetVisits() []*Visit {
+func (x *AnamnesisHistoryResponse) GetVersions() []*Anamnesis {
 	if x != nil {
-		return x.Visits
+		return x.Versions
 	}
 	return nil
 }
 
-type AddVisitMaterials struct {
+type GetByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	MaterialId    int32                  `protobuf:"varint,2,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
-	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddVisitMaterials) Reset() {
-	*x = AddVisitMaterials{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[19]
+func (x *GetByIdRequest) Reset() {
+	*x = GetByIdRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddVisitMaterials) String() string {
+func (x *GetByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddVisitMaterials) ProtoMessage() {}
+func (*GetByIdRequest) ProtoMessage() {}
 
-func (x *AddVisitMaterials) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[19]
+func (x *GetByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,54 +1463,1260 @@ func (x *AddVisitMaterials) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddVisitMaterials.ProtoReflect.Descriptor instead.
-func (*AddVisitMaterials) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *AddVisitMaterials) GetVisitId() int32 {
-	if x != nil {
-		return x.VisitId
-	}
-	return 0
+// Deprecated: Use GetByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AddVisitMaterials) GetMaterialId() int32 {
+func (x *GetByIdRequest) GetId() int32 {
 	if x != nil {
-		return x.MaterialId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *AddVisitMaterials) GetAmount() int32 {
+func (x *GetByIdRequest) GetToken() string {
 	if x != nil {
-		return x.Amount
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type AddVisitMaterialsRequest struct {
+type AppointmentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Materials     []*AddVisitMaterials   `protobuf:"bytes,1,rep,name=materials,proto3" json:"materials,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddVisitMaterialsRequest) Reset() {
-	*x = AddVisitMaterialsRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[20]
+func (x *AppointmentResponse) Reset() {
+	*x = AppointmentResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppointmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppointmentResponse) ProtoMessage() {}
+
+func (x *AppointmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppointmentResponse.ProtoReflect.Descriptor instead.
+func (*AppointmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{22}
+}
+
+type Diagnose struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IcdCode       string                 `protobuf:"bytes,1,opt,name=icd_code,json=icdCode,proto3" json:"icd_code,omitempty"`
+	Notes         string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+	Tooth         int32                  `protobuf:"varint,3,opt,name=tooth,proto3" json:"tooth,omitempty"`
+	Surfaces      string                 `protobuf:"bytes,4,opt,name=surfaces,proto3" json:"surfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Diagnose) Reset() {
+	*x = Diagnose{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Diagnose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnose) ProtoMessage() {}
+
+func (x *Diagnose) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnose.ProtoReflect.Descriptor instead.
+func (*Diagnose) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Diagnose) GetIcdCode() string {
+	if x != nil {
+		return x.IcdCode
+	}
+	return ""
+}
+
+func (x *Diagnose) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Diagnose) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *Diagnose) GetSurfaces() string {
+	if x != nil {
+		return x.Surfaces
+	}
+	return ""
+}
+
+type VisitDiagnose struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VisitId       int32                  `protobuf:"varint,2,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	IcdCodeId     int32                  `protobuf:"varint,3,opt,name=icd_code_id,json=icdCodeId,proto3" json:"icd_code_id,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Tooth         int32                  `protobuf:"varint,5,opt,name=tooth,proto3" json:"tooth,omitempty"`      // номер зуба по FDI, 0 — диагноз не относится к зубу
+	Surfaces      string                 `protobuf:"bytes,6,opt,name=surfaces,proto3" json:"surfaces,omitempty"` // поверхности зуба буквами MOIDBL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisitDiagnose) Reset() {
+	*x = VisitDiagnose{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitDiagnose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitDiagnose) ProtoMessage() {}
+
+func (x *VisitDiagnose) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitDiagnose.ProtoReflect.Descriptor instead.
+func (*VisitDiagnose) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VisitDiagnose) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VisitDiagnose) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *VisitDiagnose) GetIcdCodeId() int32 {
+	if x != nil {
+		return x.IcdCodeId
+	}
+	return 0
+}
+
+func (x *VisitDiagnose) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *VisitDiagnose) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *VisitDiagnose) GetSurfaces() string {
+	if x != nil {
+		return x.Surfaces
+	}
+	return ""
+}
+
+type Visit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApptId        int32                  `protobuf:"varint,2,opt,name=appt_id,json=apptId,proto3" json:"appt_id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Doctor        string                 `protobuf:"bytes,4,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	Complaints    string                 `protobuf:"bytes,5,opt,name=complaints,proto3" json:"complaints,omitempty"`
+	Treatment     string                 `protobuf:"bytes,6,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Diagnoses     []*Diagnose            `protobuf:"bytes,8,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Visit) Reset() {
+	*x = Visit{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Visit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Visit) ProtoMessage() {}
+
+func (x *Visit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Visit.ProtoReflect.Descriptor instead.
+func (*Visit) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Visit) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Visit) GetApptId() int32 {
+	if x != nil {
+		return x.ApptId
+	}
+	return 0
+}
+
+func (x *Visit) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *Visit) GetDoctor() string {
+	if x != nil {
+		return x.Doctor
+	}
+	return ""
+}
+
+func (x *Visit) GetComplaints() string {
+	if x != nil {
+		return x.Complaints
+	}
+	return ""
+}
+
+func (x *Visit) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *Visit) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Visit) GetDiagnoses() []*Diagnose {
+	if x != nil {
+		return x.Diagnoses
+	}
+	return nil
+}
+
+type GetPatientVisitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Visits        []*Visit               `protobuf:"bytes,1,rep,name=visits,proto3" json:"visits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPatientVisitsResponse) Reset() {
+	*x = GetPatientVisitsResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPatientVisitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPatientVisitsResponse) ProtoMessage() {}
+
+func (x *GetPatientVisitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPatientVisitsResponse.ProtoReflect.Descriptor instead.
+func (*GetPatientVisitsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetPatientVisitsResponse) GetVisits() []*Visit {
+	if x != nil {
+		return x.Visits
+	}
+	return nil
+}
+
+type AddVisitMaterials struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	MaterialId    int32                  `protobuf:"varint,2,opt,name=material_id,json=materialId,proto3" json:"material_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVisitMaterials) Reset() {
+	*x = AddVisitMaterials{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVisitMaterials) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVisitMaterials) ProtoMessage() {}
+
+func (x *AddVisitMaterials) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVisitMaterials.ProtoReflect.Descriptor instead.
+func (*AddVisitMaterials) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AddVisitMaterials) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *AddVisitMaterials) GetMaterialId() int32 {
+	if x != nil {
+		return x.MaterialId
+	}
+	return 0
+}
+
+func (x *AddVisitMaterials) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type AddVisitMaterialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Materials     []*AddVisitMaterials   `protobuf:"bytes,1,rep,name=materials,proto3" json:"materials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVisitMaterialsRequest) Reset() {
+	*x = AddVisitMaterialsRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVisitMaterialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVisitMaterialsRequest) ProtoMessage() {}
+
+func (x *AddVisitMaterialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVisitMaterialsRequest.ProtoReflect.Descriptor instead.
+func (*AddVisitMaterialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AddVisitMaterialsRequest) GetMaterials() []*AddVisitMaterials {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+type AddVisitServices struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	ServiceId     int32                  `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Tooth         int32                  `protobuf:"varint,4,opt,name=tooth,proto3" json:"tooth,omitempty"` // номер зуба по FDI, 0 — услуга не относится к зубу
+	Surfaces      string                 `protobuf:"bytes,5,opt,name=surfaces,proto3" json:"surfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVisitServices) Reset() {
+	*x = AddVisitServices{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVisitServices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVisitServices) ProtoMessage() {}
+
+func (x *AddVisitServices) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVisitServices.ProtoReflect.Descriptor instead.
+func (*AddVisitServices) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddVisitServices) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *AddVisitServices) GetServiceId() int32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *AddVisitServices) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AddVisitServices) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *AddVisitServices) GetSurfaces() string {
+	if x != nil {
+		return x.Surfaces
+	}
+	return ""
+}
+
+type AddVisitServicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*AddVisitServices    `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVisitServicesRequest) Reset() {
+	*x = AddVisitServicesRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVisitServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVisitServicesRequest) ProtoMessage() {}
+
+func (x *AddVisitServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVisitServicesRequest.ProtoReflect.Descriptor instead.
+func (*AddVisitServicesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddVisitServicesRequest) GetServices() []*AddVisitServices {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type AddPatientAllergiesChronicsRequest struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Notes         []*PatientAllergiesChronics `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	Token         string                      `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPatientAllergiesChronicsRequest) Reset() {
+	*x = AddPatientAllergiesChronicsRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPatientAllergiesChronicsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPatientAllergiesChronicsRequest) ProtoMessage() {}
+
+func (x *AddPatientAllergiesChronicsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPatientAllergiesChronicsRequest.ProtoReflect.Descriptor instead.
+func (*AddPatientAllergiesChronicsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AddPatientAllergiesChronicsRequest) GetNotes() []*PatientAllergiesChronics {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *AddPatientAllergiesChronicsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AddPatientVisitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId int32                  `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Complaints    string                 `protobuf:"bytes,5,opt,name=complaints,proto3" json:"complaints,omitempty"`
+	Treatment     string                 `protobuf:"bytes,6,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPatientVisitRequest) Reset() {
+	*x = AddPatientVisitRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPatientVisitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPatientVisitRequest) ProtoMessage() {}
+
+func (x *AddPatientVisitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPatientVisitRequest.ProtoReflect.Descriptor instead.
+func (*AddPatientVisitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddPatientVisitRequest) GetAppointmentId() int32 {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return 0
+}
+
+func (x *AddPatientVisitRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *AddPatientVisitRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *AddPatientVisitRequest) GetComplaints() string {
+	if x != nil {
+		return x.Complaints
+	}
+	return ""
+}
+
+func (x *AddPatientVisitRequest) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *AddPatientVisitRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddPatientDiagnosesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diagnoses     []*VisitDiagnose       `protobuf:"bytes,1,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPatientDiagnosesRequest) Reset() {
+	*x = AddPatientDiagnosesRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPatientDiagnosesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPatientDiagnosesRequest) ProtoMessage() {}
+
+func (x *AddPatientDiagnosesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPatientDiagnosesRequest.ProtoReflect.Descriptor instead.
+func (*AddPatientDiagnosesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddPatientDiagnosesRequest) GetDiagnoses() []*VisitDiagnose {
+	if x != nil {
+		return x.Diagnoses
+	}
+	return nil
+}
+
+type DefaultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefaultResponse) Reset() {
+	*x = DefaultResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultResponse) ProtoMessage() {}
+
+func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
+func (*DefaultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DefaultResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddVisitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddVisitResponse) Reset() {
+	*x = AddVisitResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddVisitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVisitResponse) ProtoMessage() {}
+
+func (x *AddVisitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVisitResponse.ProtoReflect.Descriptor instead.
+func (*AddVisitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AddVisitResponse) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type VisitPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	Price         int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VisitPaymentRequest) Reset() {
+	*x = VisitPaymentRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VisitPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VisitPaymentRequest) ProtoMessage() {}
+
+func (x *VisitPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VisitPaymentRequest.ProtoReflect.Descriptor instead.
+func (*VisitPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VisitPaymentRequest) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *VisitPaymentRequest) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *VisitPaymentRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type AddConsultationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId  int32                  `protobuf:"varint,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	PatientId      int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId       int32                  `protobuf:"varint,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Complaints     string                 `protobuf:"bytes,4,opt,name=complaints,proto3" json:"complaints,omitempty"`
+	Treatment      string                 `protobuf:"bytes,5,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	Diagnoses      []*VisitDiagnose       `protobuf:"bytes,6,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
+	Services       []*AddVisitServices    `protobuf:"bytes,7,rep,name=services,proto3" json:"services,omitempty"`
+	Materials      []*AddVisitMaterials   `protobuf:"bytes,8,rep,name=materials,proto3" json:"materials,omitempty"`
+	Token          string                 `protobuf:"bytes,9,opt,name=token,proto3" json:"token,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,10,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`  // UUID отправки формы; повтор с тем же ключом не создаёт второй визит
+	PlanItemIds    []int32                `protobuf:"varint,11,rep,packed,name=plan_item_ids,json=planItemIds,proto3" json:"plan_item_ids,omitempty"` // позиции принятых планов лечения, выполненные на приёме
+	Chart          []*ToothState          `protobuf:"bytes,12,rep,name=chart,proto3" json:"chart,omitempty"`                                          // изменения зубной формулы по итогам приёма
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddConsultationRequest) Reset() {
+	*x = AddConsultationRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddConsultationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddConsultationRequest) ProtoMessage() {}
+
+func (x *AddConsultationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddConsultationRequest.ProtoReflect.Descriptor instead.
+func (*AddConsultationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AddConsultationRequest) GetAppointmentId() int32 {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return 0
+}
+
+func (x *AddConsultationRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *AddConsultationRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *AddConsultationRequest) GetComplaints() string {
+	if x != nil {
+		return x.Complaints
+	}
+	return ""
+}
+
+func (x *AddConsultationRequest) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *AddConsultationRequest) GetDiagnoses() []*VisitDiagnose {
+	if x != nil {
+		return x.Diagnoses
+	}
+	return nil
+}
+
+func (x *AddConsultationRequest) GetServices() []*AddVisitServices {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *AddConsultationRequest) GetMaterials() []*AddVisitMaterials {
+	if x != nil {
+		return x.Materials
+	}
+	return nil
+}
+
+func (x *AddConsultationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AddConsultationRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *AddConsultationRequest) GetPlanItemIds() []int32 {
+	if x != nil {
+		return x.PlanItemIds
+	}
+	return nil
+}
+
+func (x *AddConsultationRequest) GetChart() []*ToothState {
+	if x != nil {
+		return x.Chart
+	}
+	return nil
+}
+
+type AddConsultationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	TotalPrice    int32                  `protobuf:"varint,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Alerts        []*ClinicalAlert       `protobuf:"bytes,3,rep,name=alerts,proto3" json:"alerts,omitempty"` // предупреждения по использованным материалам и услугам
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddConsultationResponse) Reset() {
+	*x = AddConsultationResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddConsultationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddConsultationResponse) ProtoMessage() {}
+
+func (x *AddConsultationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddConsultationResponse.ProtoReflect.Descriptor instead.
+func (*AddConsultationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AddConsultationResponse) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *AddConsultationResponse) GetTotalPrice() int32 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *AddConsultationResponse) GetAlerts() []*ClinicalAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+// Позиция плана лечения; tooth — номер зуба по FDI, 0 — услуга без привязки к зубу.
+// status — planned, in_progress, done, cancelled
+type TreatmentPlanItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stage          int32                  `protobuf:"varint,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	Tooth          int32                  `protobuf:"varint,3,opt,name=tooth,proto3" json:"tooth,omitempty"`
+	ServiceId      int32                  `protobuf:"varint,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceName    string                 `protobuf:"bytes,5,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Quantity       int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	EstimatedPrice int32                  `protobuf:"varint,7,opt,name=estimated_price,json=estimatedPrice,proto3" json:"estimated_price,omitempty"` // цена за единицу на день составления плана
+	Status         string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	VisitId        int32                  `protobuf:"varint,9,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	Note           string                 `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
+	CompletedAt    string                 `protobuf:"bytes,11,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TreatmentPlanItem) Reset() {
+	*x = TreatmentPlanItem{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentPlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentPlanItem) ProtoMessage() {}
+
+func (x *TreatmentPlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentPlanItem.ProtoReflect.Descriptor instead.
+func (*TreatmentPlanItem) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TreatmentPlanItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetServiceId() int32 {
+	if x != nil {
+		return x.ServiceId
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *TreatmentPlanItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetEstimatedPrice() int32 {
+	if x != nil {
+		return x.EstimatedPrice
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TreatmentPlanItem) GetVisitId() int32 {
+	if x != nil {
+		return x.VisitId
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TreatmentPlanItem) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+// План лечения; status — proposed, accepted, in_progress, done, cancelled
+type TreatmentPlan struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PatientId      int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	DoctorId       int32                  `protobuf:"varint,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Doctor         string                 `protobuf:"bytes,4,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	Title          string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	AcceptedAt     string                 `protobuf:"bytes,7,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Items          []*TreatmentPlanItem   `protobuf:"bytes,9,rep,name=items,proto3" json:"items,omitempty"`
+	EstimatedTotal int32                  `protobuf:"varint,10,opt,name=estimated_total,json=estimatedTotal,proto3" json:"estimated_total,omitempty"`
+	RemainingCost  int32                  `protobuf:"varint,11,opt,name=remaining_cost,json=remainingCost,proto3" json:"remaining_cost,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TreatmentPlan) Reset() {
+	*x = TreatmentPlan{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddVisitMaterialsRequest) String() string {
+func (x *TreatmentPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddVisitMaterialsRequest) ProtoMessage() {}
+func (*TreatmentPlan) ProtoMessage() {}
 
-func (x *AddVisitMaterialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[20]
+func (x *TreatmentPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,100 +2727,113 @@ func (x *AddVisitMaterialsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddVisitMaterialsRequest.ProtoReflect.Descriptor instead.
-func (*AddVisitMaterialsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use TreatmentPlan.ProtoReflect.Descriptor instead.
+func (*TreatmentPlan) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AddVisitMaterialsRequest) GetMaterials() []*AddVisitMaterials {
+func (x *TreatmentPlan) GetId() int32 {
 	if x != nil {
-		return x.Materials
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type AddVisitServices struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	ServiceId     int32                  `protobuf:"varint,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TreatmentPlan) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
 }
 
-func (x *AddVisitServices) Reset() {
-	*x = AddVisitServices{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TreatmentPlan) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
 }
 
-func (x *AddVisitServices) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TreatmentPlan) GetDoctor() string {
+	if x != nil {
+		return x.Doctor
+	}
+	return ""
 }
 
-func (*AddVisitServices) ProtoMessage() {}
+func (x *TreatmentPlan) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
 
-func (x *AddVisitServices) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[21]
+func (x *TreatmentPlan) GetStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AddVisitServices.ProtoReflect.Descriptor instead.
-func (*AddVisitServices) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{21}
+func (x *TreatmentPlan) GetAcceptedAt() string {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return ""
 }
 
-func (x *AddVisitServices) GetVisitId() int32 {
+func (x *TreatmentPlan) GetCreatedAt() string {
 	if x != nil {
-		return x.VisitId
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *AddVisitServices) GetServiceId() int32 {
+func (x *TreatmentPlan) GetItems() []*TreatmentPlanItem {
 	if x != nil {
-		return x.ServiceId
+		return x.Items
+	}
+	return nil
+}
+
+func (x *TreatmentPlan) GetEstimatedTotal() int32 {
+	if x != nil {
+		return x.EstimatedTotal
 	}
 	return 0
 }
 
-func (x *AddVisitServices) GetAmount() int32 {
+func (x *TreatmentPlan) GetRemainingCost() int32 {
 	if x != nil {
-		return x.Amount
+		return x.RemainingCost
 	}
 	return 0
 }
 
-type AddVisitServicesRequest struct {
+type CreateTreatmentPlanRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Services      []*AddVisitServices    `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Items         []*TreatmentPlanItem   `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddVisitServicesRequest) Reset() {
-	*x = AddVisitServicesRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[22]
+func (x *CreateTreatmentPlanRequest) Reset() {
+	*x = CreateTreatmentPlanRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddVisitServicesRequest) String() string {
+func (x *CreateTreatmentPlanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddVisitServicesRequest) ProtoMessage() {}
+func (*CreateTreatmentPlanRequest) ProtoMessage() {}
 
-func (x *AddVisitServicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[22]
+func (x *CreateTreatmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1342,40 +2844,63 @@ func (x *AddVisitServicesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddVisitServicesRequest.ProtoReflect.Descriptor instead.
-func (*AddVisitServicesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CreateTreatmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateTreatmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *AddVisitServicesRequest) GetServices() []*AddVisitServices {
+func (x *CreateTreatmentPlanRequest) GetToken() string {
 	if x != nil {
-		return x.Services
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateTreatmentPlanRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *CreateTreatmentPlanRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTreatmentPlanRequest) GetItems() []*TreatmentPlanItem {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-type AddPatientAllergiesChronicsRequest struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Notes         []*PatientAllergiesChronics `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+type AddTreatmentPlanItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        int32                  `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Items         []*TreatmentPlanItem   `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPatientAllergiesChronicsRequest) Reset() {
-	*x = AddPatientAllergiesChronicsRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[23]
+func (x *AddTreatmentPlanItemsRequest) Reset() {
+	*x = AddTreatmentPlanItemsRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPatientAllergiesChronicsRequest) String() string {
+func (x *AddTreatmentPlanItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPatientAllergiesChronicsRequest) ProtoMessage() {}
+func (*AddTreatmentPlanItemsRequest) ProtoMessage() {}
 
-func (x *AddPatientAllergiesChronicsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[23]
+func (x *AddTreatmentPlanItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,45 +2911,56 @@ func (x *AddPatientAllergiesChronicsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPatientAllergiesChronicsRequest.ProtoReflect.Descriptor instead.
-func (*AddPatientAllergiesChronicsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use AddTreatmentPlanItemsRequest.ProtoReflect.Descriptor instead.
+func (*AddTreatmentPlanItemsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *AddPatientAllergiesChronicsRequest) GetNotes() []*PatientAllergiesChronics {
+func (x *AddTreatmentPlanItemsRequest) GetPlanId() int32 {
 	if x != nil {
-		return x.Notes
+		return x.PlanId
+	}
+	return 0
+}
+
+func (x *AddTreatmentPlanItemsRequest) GetItems() []*TreatmentPlanItem {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-type AddPatientVisitRequest struct {
+func (x *AddTreatmentPlanItemsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UpdateTreatmentPlanItemStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppointmentId int32                  `protobuf:"varint,2,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
-	PatientId     int32                  `protobuf:"varint,3,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
-	DoctorId      int32                  `protobuf:"varint,4,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-	Complaints    string                 `protobuf:"bytes,5,opt,name=complaints,proto3" json:"complaints,omitempty"`
-	Treatment     string                 `protobuf:"bytes,6,opt,name=treatment,proto3" json:"treatment,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ItemId        int32                  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPatientVisitRequest) Reset() {
-	*x = AddPatientVisitRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[24]
+func (x *UpdateTreatmentPlanItemStatusRequest) Reset() {
+	*x = UpdateTreatmentPlanItemStatusRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPatientVisitRequest) String() string {
+func (x *UpdateTreatmentPlanItemStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPatientVisitRequest) ProtoMessage() {}
+func (*UpdateTreatmentPlanItemStatusRequest) ProtoMessage() {}
 
-func (x *AddPatientVisitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[24]
+func (x *UpdateTreatmentPlanItemStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,75 +2971,54 @@ func (x *AddPatientVisitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPatientVisitRequest.ProtoReflect.Descriptor instead.
-func (*AddPatientVisitRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *AddPatientVisitRequest) GetAppointmentId() int32 {
-	if x != nil {
-		return x.AppointmentId
-	}
-	return 0
-}
-
-func (x *AddPatientVisitRequest) GetPatientId() int32 {
-	if x != nil {
-		return x.PatientId
-	}
-	return 0
+// Deprecated: Use UpdateTreatmentPlanItemStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTreatmentPlanItemStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *AddPatientVisitRequest) GetDoctorId() int32 {
+func (x *UpdateTreatmentPlanItemStatusRequest) GetItemId() int32 {
 	if x != nil {
-		return x.DoctorId
+		return x.ItemId
 	}
 	return 0
 }
 
-func (x *AddPatientVisitRequest) GetComplaints() string {
+func (x *UpdateTreatmentPlanItemStatusRequest) GetStatus() string {
 	if x != nil {
-		return x.Complaints
+		return x.Status
 	}
 	return ""
 }
 
-func (x *AddPatientVisitRequest) GetTreatment() string {
+func (x *UpdateTreatmentPlanItemStatusRequest) GetToken() string {
 	if x != nil {
-		return x.Treatment
+		return x.Token
 	}
 	return ""
 }
 
-func (x *AddPatientVisitRequest) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-type AddPatientDiagnosesRequest struct {
+type TreatmentPlanResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Diagnoses     []*VisitDiagnose       `protobuf:"bytes,1,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
+	Plan          *TreatmentPlan         `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPatientDiagnosesRequest) Reset() {
-	*x = AddPatientDiagnosesRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[25]
+func (x *TreatmentPlanResponse) Reset() {
+	*x = TreatmentPlanResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPatientDiagnosesRequest) String() string {
+func (x *TreatmentPlanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPatientDiagnosesRequest) ProtoMessage() {}
+func (*TreatmentPlanResponse) ProtoMessage() {}
 
-func (x *AddPatientDiagnosesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[25]
+func (x *TreatmentPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,40 +3029,40 @@ func (x *AddPatientDiagnosesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPatientDiagnosesRequest.ProtoReflect.Descriptor instead.
-func (*AddPatientDiagnosesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use TreatmentPlanResponse.ProtoReflect.Descriptor instead.
+func (*TreatmentPlanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *AddPatientDiagnosesRequest) GetDiagnoses() []*VisitDiagnose {
+func (x *TreatmentPlanResponse) GetPlan() *TreatmentPlan {
 	if x != nil {
-		return x.Diagnoses
+		return x.Plan
 	}
 	return nil
 }
 
-type DefaultResponse struct {
+type GetPatientTreatmentPlansResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Plans         []*TreatmentPlan       `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DefaultResponse) Reset() {
-	*x = DefaultResponse{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[26]
+func (x *GetPatientTreatmentPlansResponse) Reset() {
+	*x = GetPatientTreatmentPlansResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DefaultResponse) String() string {
+func (x *GetPatientTreatmentPlansResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultResponse) ProtoMessage() {}
+func (*GetPatientTreatmentPlansResponse) ProtoMessage() {}
 
-func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[26]
+func (x *GetPatientTreatmentPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1558,40 +3073,48 @@ func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
-func (*DefaultResponse) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use GetPatientTreatmentPlansResponse.ProtoReflect.Descriptor instead.
+func (*GetPatientTreatmentPlansResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *DefaultResponse) GetError() string {
+func (x *GetPatientTreatmentPlansResponse) GetPlans() []*TreatmentPlan {
 	if x != nil {
-		return x.Error
+		return x.Plans
 	}
-	return ""
+	return nil
 }
 
-type AddVisitResponse struct {
+// Состояние зуба по FDI: healthy, caries, filled, crown, implant, missing; surfaces — поверхности буквами MOIDBL
+type ToothState struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tooth         int32                  `protobuf:"varint,1,opt,name=tooth,proto3" json:"tooth,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Surfaces      string                 `protobuf:"bytes,3,opt,name=surfaces,proto3" json:"surfaces,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	VisitId       int32                  `protobuf:"varint,5,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,6,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Doctor        string                 `protobuf:"bytes,7,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	RecordedAt    string                 `protobuf:"bytes,8,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddVisitResponse) Reset() {
-	*x = AddVisitResponse{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[27]
+func (x *ToothState) Reset() {
+	*x = ToothState{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddVisitResponse) String() string {
+func (x *ToothState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddVisitResponse) ProtoMessage() {}
+func (*ToothState) ProtoMessage() {}
 
-func (x *AddVisitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[27]
+func (x *ToothState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,108 +3125,89 @@ func (x *AddVisitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddVisitResponse.ProtoReflect.Descriptor instead.
-func (*AddVisitResponse) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ToothState.ProtoReflect.Descriptor instead.
+func (*ToothState) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *AddVisitResponse) GetId() int32 {
+func (x *ToothState) GetTooth() int32 {
 	if x != nil {
-		return x.Id
+		return x.Tooth
 	}
 	return 0
 }
 
-type VisitPaymentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	Price         int32                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *VisitPaymentRequest) Reset() {
-	*x = VisitPaymentRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *VisitPaymentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ToothState) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (*VisitPaymentRequest) ProtoMessage() {}
-
-func (x *VisitPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[28]
+func (x *ToothState) GetSurfaces() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Surfaces
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use VisitPaymentRequest.ProtoReflect.Descriptor instead.
-func (*VisitPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{28}
+func (x *ToothState) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
 }
 
-func (x *VisitPaymentRequest) GetVisitId() int32 {
+func (x *ToothState) GetVisitId() int32 {
 	if x != nil {
 		return x.VisitId
 	}
 	return 0
 }
 
-func (x *VisitPaymentRequest) GetPrice() int32 {
+func (x *ToothState) GetDoctorId() int32 {
 	if x != nil {
-		return x.Price
+		return x.DoctorId
 	}
 	return 0
 }
 
-func (x *VisitPaymentRequest) GetStatus() string {
+func (x *ToothState) GetDoctor() string {
 	if x != nil {
-		return x.Status
+		return x.Doctor
 	}
 	return ""
 }
 
-type AddConsultationRequest struct {
+func (x *ToothState) GetRecordedAt() string {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return ""
+}
+
+type DentalChartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AppointmentId int32                  `protobuf:"varint,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
-	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
-	DoctorId      int32                  `protobuf:"varint,3,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
-	Complaints    string                 `protobuf:"bytes,4,opt,name=complaints,proto3" json:"complaints,omitempty"`
-	Treatment     string                 `protobuf:"bytes,5,opt,name=treatment,proto3" json:"treatment,omitempty"`
-	Diagnoses     []*VisitDiagnose       `protobuf:"bytes,6,rep,name=diagnoses,proto3" json:"diagnoses,omitempty"`
-	Services      []*AddVisitServices    `protobuf:"bytes,7,rep,name=services,proto3" json:"services,omitempty"`
-	Materials     []*AddVisitMaterials   `protobuf:"bytes,8,rep,name=materials,proto3" json:"materials,omitempty"`
-	Token         string                 `protobuf:"bytes,9,opt,name=token,proto3" json:"token,omitempty"`
+	Teeth         []*ToothState          `protobuf:"bytes,1,rep,name=teeth,proto3" json:"teeth,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddConsultationRequest) Reset() {
-	*x = AddConsultationRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[29]
+func (x *DentalChartResponse) Reset() {
+	*x = DentalChartResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddConsultationRequest) String() string {
+func (x *DentalChartResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddConsultationRequest) ProtoMessage() {}
+func (*DentalChartResponse) ProtoMessage() {}
 
-func (x *AddConsultationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[29]
+func (x *DentalChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,97 +3218,102 @@ func (x *AddConsultationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddConsultationRequest.ProtoReflect.Descriptor instead.
-func (*AddConsultationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DentalChartResponse.ProtoReflect.Descriptor instead.
+func (*DentalChartResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *AddConsultationRequest) GetAppointmentId() int32 {
+func (x *DentalChartResponse) GetTeeth() []*ToothState {
 	if x != nil {
-		return x.AppointmentId
+		return x.Teeth
 	}
-	return 0
+	return nil
 }
 
-func (x *AddConsultationRequest) GetPatientId() int32 {
-	if x != nil {
-		return x.PatientId
-	}
-	return 0
+type UpdateDentalChartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	PatientId     int32                  `protobuf:"varint,2,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Teeth         []*ToothState          `protobuf:"bytes,3,rep,name=teeth,proto3" json:"teeth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddConsultationRequest) GetDoctorId() int32 {
-	if x != nil {
-		return x.DoctorId
-	}
-	return 0
+func (x *UpdateDentalChartRequest) Reset() {
+	*x = UpdateDentalChartRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddConsultationRequest) GetComplaints() string {
-	if x != nil {
-		return x.Complaints
-	}
-	return ""
+func (x *UpdateDentalChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AddConsultationRequest) GetTreatment() string {
+func (*UpdateDentalChartRequest) ProtoMessage() {}
+
+func (x *UpdateDentalChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[48]
 	if x != nil {
-		return x.Treatment
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AddConsultationRequest) GetDiagnoses() []*VisitDiagnose {
-	if x != nil {
-		return x.Diagnoses
-	}
-	return nil
+// Deprecated: Use UpdateDentalChartRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDentalChartRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *AddConsultationRequest) GetServices() []*AddVisitServices {
+func (x *UpdateDentalChartRequest) GetToken() string {
 	if x != nil {
-		return x.Services
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-func (x *AddConsultationRequest) GetMaterials() []*AddVisitMaterials {
+func (x *UpdateDentalChartRequest) GetPatientId() int32 {
 	if x != nil {
-		return x.Materials
+		return x.PatientId
 	}
-	return nil
+	return 0
 }
 
-func (x *AddConsultationRequest) GetToken() string {
+func (x *UpdateDentalChartRequest) GetTeeth() []*ToothState {
 	if x != nil {
-		return x.Token
+		return x.Teeth
 	}
-	return ""
+	return nil
 }
 
-type AddConsultationResponse struct {
+type GetToothHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VisitId       int32                  `protobuf:"varint,1,opt,name=visit_id,json=visitId,proto3" json:"visit_id,omitempty"`
-	TotalPrice    int32                  `protobuf:"varint,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	PatientId     int32                  `protobuf:"varint,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Tooth         int32                  `protobuf:"varint,2,opt,name=tooth,proto3" json:"tooth,omitempty"` // 0 — по всем зубам
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddConsultationResponse) Reset() {
-	*x = AddConsultationResponse{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[30]
+func (x *GetToothHistoryRequest) Reset() {
+	*x = GetToothHistoryRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddConsultationResponse) String() string {
+func (x *GetToothHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddConsultationResponse) ProtoMessage() {}
+func (*GetToothHistoryRequest) ProtoMessage() {}
 
-func (x *AddConsultationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[30]
+func (x *GetToothHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1815,35 +3324,43 @@ func (x *AddConsultationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddConsultationResponse.ProtoReflect.Descriptor instead.
-func (*AddConsultationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetToothHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetToothHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *AddConsultationResponse) GetVisitId() int32 {
+func (x *GetToothHistoryRequest) GetPatientId() int32 {
 	if x != nil {
-		return x.VisitId
+		return x.PatientId
 	}
 	return 0
 }
 
-func (x *AddConsultationResponse) GetTotalPrice() int32 {
+func (x *GetToothHistoryRequest) GetTooth() int32 {
 	if x != nil {
-		return x.TotalPrice
+		return x.Tooth
 	}
 	return 0
 }
 
+func (x *GetToothHistoryRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
 type GetDocumentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PatientID     int32                  `protobuf:"varint,1,opt,name=patientID,proto3" json:"patientID,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetDocumentsRequest) Reset() {
 	*x = GetDocumentsRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[31]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1855,7 +3372,7 @@ func (x *GetDocumentsRequest) String() string {
 func (*GetDocumentsRequest) ProtoMessage() {}
 
 func (x *GetDocumentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[31]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,7 +3385,7 @@ func (x *GetDocumentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentsRequest.ProtoReflect.Descriptor instead.
 func (*GetDocumentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{31}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetDocumentsRequest) GetPatientID() int32 {
@@ -1878,6 +3395,13 @@ func (x *GetDocumentsRequest) GetPatientID() int32 {
 	return 0
 }
 
+func (x *GetDocumentsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
 // Ответ с массивом документов
 type GetDocumentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1888,7 +3412,7 @@ type GetDocumentsResponse struct {
 
 func (x *GetDocumentsResponse) Reset() {
 	*x = GetDocumentsResponse{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[32]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1900,7 +3424,7 @@ func (x *GetDocumentsResponse) String() string {
 func (*GetDocumentsResponse) ProtoMessage() {}
 
 func (x *GetDocumentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[32]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1913,7 +3437,7 @@ func (x *GetDocumentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentsResponse.ProtoReflect.Descriptor instead.
 func (*GetDocumentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{32}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetDocumentsResponse) GetDocuments() []*DocumentInfo {
@@ -1936,7 +3460,7 @@ type DocumentInfo struct {
 
 func (x *DocumentInfo) Reset() {
 	*x = DocumentInfo{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[33]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1948,7 +3472,7 @@ func (x *DocumentInfo) String() string {
 func (*DocumentInfo) ProtoMessage() {}
 
 func (x *DocumentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[33]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1961,7 +3485,7 @@ func (x *DocumentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DocumentInfo.ProtoReflect.Descriptor instead.
 func (*DocumentInfo) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{33}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *DocumentInfo) GetId() string {
@@ -1996,13 +3520,15 @@ func (x *DocumentInfo) GetCreatedAt() string {
 type DownloadDocumentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"` // UUID документа
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                          // с какого байта продолжить скачивание
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DownloadDocumentRequest) Reset() {
 	*x = DownloadDocumentRequest{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[34]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2014,7 +3540,7 @@ func (x *DownloadDocumentRequest) String() string {
 func (*DownloadDocumentRequest) ProtoMessage() {}
 
 func (x *DownloadDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[34]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2027,7 +3553,7 @@ func (x *DownloadDocumentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadDocumentRequest.ProtoReflect.Descriptor instead.
 func (*DownloadDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{34}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *DownloadDocumentRequest) GetDocumentId() string {
@@ -2037,18 +3563,33 @@ func (x *DownloadDocumentRequest) GetDocumentId() string {
 	return ""
 }
 
-// Ответ со скачанным файлом
+func (x *DownloadDocumentRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadDocumentRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Часть скачиваемого файла; file_name и size заполнены только в первом сообщении потока
 type DownloadDocumentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`          // Имя файла
-	FileContent   []byte                 `protobuf:"bytes,2,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"` // Содержимое файла
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // Имя файла
+	Chunk         []byte                 `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`                       // Очередная часть содержимого
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                        // Полный размер файла
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DownloadDocumentResponse) Reset() {
 	*x = DownloadDocumentResponse{}
-	mi := &file_proto_doctor_doctor_proto_msgTypes[35]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2060,7 +3601,7 @@ func (x *DownloadDocumentResponse) String() string {
 func (*DownloadDocumentResponse) ProtoMessage() {}
 
 func (x *DownloadDocumentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_doctor_doctor_proto_msgTypes[35]
+	mi := &file_proto_doctor_doctor_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2073,7 +3614,7 @@ func (x *DownloadDocumentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadDocumentResponse.ProtoReflect.Descriptor instead.
 func (*DownloadDocumentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{35}
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *DownloadDocumentResponse) GetFileName() string {
@@ -2083,9 +3624,137 @@ func (x *DownloadDocumentResponse) GetFileName() string {
 	return ""
 }
 
-func (x *DownloadDocumentResponse) GetFileContent() []byte {
+func (x *DownloadDocumentResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *DownloadDocumentResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// Выгрузка медицинской карты пациента; format — pdf (печатная форма) или json (пакет в духе FHIR)
+type ExportMedicalRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PatientId     int32                  `protobuf:"varint,1,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMedicalRecordRequest) Reset() {
+	*x = ExportMedicalRecordRequest{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMedicalRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMedicalRecordRequest) ProtoMessage() {}
+
+func (x *ExportMedicalRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMedicalRecordRequest.ProtoReflect.Descriptor instead.
+func (*ExportMedicalRecordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ExportMedicalRecordRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *ExportMedicalRecordRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportMedicalRecordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type MedicalRecordExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MedicalRecordExportResponse) Reset() {
+	*x = MedicalRecordExportResponse{}
+	mi := &file_proto_doctor_doctor_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MedicalRecordExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicalRecordExportResponse) ProtoMessage() {}
+
+func (x *MedicalRecordExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_doctor_doctor_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicalRecordExportResponse.ProtoReflect.Descriptor instead.
+func (*MedicalRecordExportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_doctor_doctor_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MedicalRecordExportResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *MedicalRecordExportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MedicalRecordExportResponse) GetContent() []byte {
 	if x != nil {
-		return x.FileContent
+		return x.Content
 	}
 	return nil
 }
@@ -2116,9 +3785,31 @@ const file_proto_doctor_doctor_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"E\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"t\n" +
 	"\x1aGetAppointmentByIDResponse\x12'\n" +
-	"\x04appt\x18\x01 \x01(\v2\x13.doctor.AppointmentR\x04appt\"3\n" +
+	"\x04appt\x18\x01 \x01(\v2\x13.doctor.AppointmentR\x04appt\x12-\n" +
+	"\x06alerts\x18\x02 \x03(\v2\x15.doctor.ClinicalAlertR\x06alerts\"\xed\x01\n" +
+	"\rClinicalAlert\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x05R\x06ruleId\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vmaterial_id\x18\x06 \x01(\x05R\n" +
+	"materialId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\a \x01(\x05R\tserviceId\x12\x1f\n" +
+	"\vtarget_name\x18\b \x01(\tR\n" +
+	"targetName\"\x95\x01\n" +
+	"\x1aCheckClinicalAlertsRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\x05R\tpatientId\x12!\n" +
+	"\fmaterial_ids\x18\x02 \x03(\x05R\vmaterialIds\x12\x1f\n" +
+	"\vservice_ids\x18\x03 \x03(\x05R\n" +
+	"serviceIds\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"G\n" +
+	"\x16ClinicalAlertsResponse\x12-\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x15.doctor.ClinicalAlertR\x06alerts\"3\n" +
 	"\x1bGetTodayAppointmentsRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\"6\n" +
 	"\x1eGetUpcomingAppointmentsRequest\x12\x14\n" +
@@ -2153,20 +3844,62 @@ const file_proto_doctor_doctor_proto_rawDesc = "" +
 	"\n" +
 	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
-	"\x05title\x18\x04 \x01(\tR\x05title\"\x85\x01\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\"\xb6\x01\n" +
 	"#GetPatientAllergiesChronicsResponse\x12^\n" +
-	"\x1apatient_allergies_chronics\x18\x01 \x03(\v2 .doctor.PatientAllergiesChronicsR\x18patientAllergiesChronics\" \n" +
+	"\x1apatient_allergies_chronics\x18\x01 \x03(\v2 .doctor.PatientAllergiesChronicsR\x18patientAllergiesChronics\x12/\n" +
+	"\tanamnesis\x18\x02 \x01(\v2\x11.doctor.AnamnesisR\tanamnesis\"f\n" +
+	"\x10AnamnesisAllergy\x12\x1a\n" +
+	"\ballergen\x18\x01 \x01(\tR\ballergen\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x1a\n" +
+	"\breaction\x18\x03 \x01(\tR\breaction\"\xab\x05\n" +
+	"\tAnamnesis\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12 \n" +
+	"\vmedications\x18\x02 \x01(\tR\vmedications\x12%\n" +
+	"\x0eblood_thinners\x18\x03 \x01(\bR\rbloodThinners\x12'\n" +
+	"\x0fcardiac_disease\x18\x04 \x01(\bR\x0ecardiacDisease\x12\"\n" +
+	"\fhypertension\x18\x05 \x01(\bR\fhypertension\x12\x1a\n" +
+	"\bdiabetes\x18\x06 \x01(\bR\bdiabetes\x12+\n" +
+	"\x11bleeding_disorder\x18\a \x01(\bR\x10bleedingDisorder\x12-\n" +
+	"\x12infectious_disease\x18\b \x01(\bR\x11infectiousDisease\x12/\n" +
+	"\x13anesthesia_reaction\x18\t \x01(\bR\x12anesthesiaReaction\x12\x1a\n" +
+	"\bpregnant\x18\n" +
+	" \x01(\bR\bpregnant\x12$\n" +
+	"\rbreastfeeding\x18\v \x01(\bR\rbreastfeeding\x12\x16\n" +
+	"\x06smoker\x18\f \x01(\bR\x06smoker\x12)\n" +
+	"\x10other_conditions\x18\r \x01(\tR\x0fotherConditions\x126\n" +
+	"\tallergies\x18\x0e \x03(\v2\x18.doctor.AnamnesisAllergyR\tallergies\x12\"\n" +
+	"\rchanged_by_id\x18\x0f \x01(\x05R\vchangedById\x12&\n" +
+	"\x0fchanged_by_role\x18\x10 \x01(\tR\rchangedByRole\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x11 \x01(\tR\tchangedBy\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x12 \x01(\tR\tchangedAt\"\xa6\x01\n" +
+	"\x1bSavePatientAnamnesisRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12/\n" +
+	"\tanamnesis\x18\x03 \x01(\v2\x11.doctor.AnamnesisR\tanamnesis\x12!\n" +
+	"\fbase_version\x18\x04 \x01(\x05R\vbaseVersion\"D\n" +
+	"\x11AnamnesisResponse\x12/\n" +
+	"\tanamnesis\x18\x01 \x01(\v2\x11.doctor.AnamnesisR\tanamnesis\"I\n" +
+	"\x18AnamnesisHistoryResponse\x12-\n" +
+	"\bversions\x18\x01 \x03(\v2\x11.doctor.AnamnesisR\bversions\"6\n" +
 	"\x0eGetByIdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"\x15\n" +
-	"\x13AppointmentResponse\";\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x15\n" +
+	"\x13AppointmentResponse\"m\n" +
 	"\bDiagnose\x12\x19\n" +
 	"\bicd_code\x18\x01 \x01(\tR\aicdCode\x12\x14\n" +
-	"\x05notes\x18\x02 \x01(\tR\x05notes\"n\n" +
+	"\x05notes\x18\x02 \x01(\tR\x05notes\x12\x14\n" +
+	"\x05tooth\x18\x03 \x01(\x05R\x05tooth\x12\x1a\n" +
+	"\bsurfaces\x18\x04 \x01(\tR\bsurfaces\"\xa0\x01\n" +
 	"\rVisitDiagnose\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
 	"\bvisit_id\x18\x02 \x01(\x05R\avisitId\x12\x1e\n" +
 	"\vicd_code_id\x18\x03 \x01(\x05R\ticdCodeId\x12\x12\n" +
-	"\x04note\x18\x04 \x01(\tR\x04note\"\xf3\x01\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x14\n" +
+	"\x05tooth\x18\x05 \x01(\x05R\x05tooth\x12\x1a\n" +
+	"\bsurfaces\x18\x06 \x01(\tR\bsurfaces\"\xf3\x01\n" +
 	"\x05Visit\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\aappt_id\x18\x02 \x01(\x05R\x06apptId\x12\x1d\n" +
@@ -2187,16 +3920,19 @@ const file_proto_doctor_doctor_proto_rawDesc = "" +
 	"materialId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x05R\x06amount\"S\n" +
 	"\x18AddVisitMaterialsRequest\x127\n" +
-	"\tmaterials\x18\x01 \x03(\v2\x19.doctor.AddVisitMaterialsR\tmaterials\"d\n" +
+	"\tmaterials\x18\x01 \x03(\v2\x19.doctor.AddVisitMaterialsR\tmaterials\"\x96\x01\n" +
 	"\x10AddVisitServices\x12\x19\n" +
 	"\bvisit_id\x18\x01 \x01(\x05R\avisitId\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\x02 \x01(\x05R\tserviceId\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x05R\x06amount\"O\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x14\n" +
+	"\x05tooth\x18\x04 \x01(\x05R\x05tooth\x12\x1a\n" +
+	"\bsurfaces\x18\x05 \x01(\tR\bsurfaces\"O\n" +
 	"\x17AddVisitServicesRequest\x124\n" +
-	"\bservices\x18\x01 \x03(\v2\x18.doctor.AddVisitServicesR\bservices\"\\\n" +
+	"\bservices\x18\x01 \x03(\v2\x18.doctor.AddVisitServicesR\bservices\"r\n" +
 	"\"AddPatientAllergiesChronicsRequest\x126\n" +
-	"\x05notes\x18\x01 \x03(\v2 .doctor.PatientAllergiesChronicsR\x05notes\"\xf4\x01\n" +
+	"\x05notes\x18\x01 \x03(\v2 .doctor.PatientAllergiesChronicsR\x05notes\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\xf4\x01\n" +
 	"\x16AddPatientVisitRequest\x12%\n" +
 	"\x0eappointment_id\x18\x02 \x01(\x05R\rappointmentId\x12\x1d\n" +
 	"\n" +
@@ -2217,7 +3953,7 @@ const file_proto_doctor_doctor_proto_rawDesc = "" +
 	"\x13VisitPaymentRequest\x12\x19\n" +
 	"\bvisit_id\x18\x01 \x01(\x05R\avisitId\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x05R\x05price\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"\xf3\x02\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"\xea\x03\n" +
 	"\x16AddConsultationRequest\x12%\n" +
 	"\x0eappointment_id\x18\x01 \x01(\x05R\rappointmentId\x12\x1d\n" +
 	"\n" +
@@ -2230,13 +3966,90 @@ const file_proto_doctor_doctor_proto_rawDesc = "" +
 	"\tdiagnoses\x18\x06 \x03(\v2\x15.doctor.VisitDiagnoseR\tdiagnoses\x124\n" +
 	"\bservices\x18\a \x03(\v2\x18.doctor.AddVisitServicesR\bservices\x127\n" +
 	"\tmaterials\x18\b \x03(\v2\x19.doctor.AddVisitMaterialsR\tmaterials\x12\x14\n" +
-	"\x05token\x18\t \x01(\tR\x05token\"U\n" +
+	"\x05token\x18\t \x01(\tR\x05token\x12'\n" +
+	"\x0fidempotency_key\x18\n" +
+	" \x01(\tR\x0eidempotencyKey\x12\"\n" +
+	"\rplan_item_ids\x18\v \x03(\x05R\vplanItemIds\x12(\n" +
+	"\x05chart\x18\f \x03(\v2\x12.doctor.ToothStateR\x05chart\"\x84\x01\n" +
 	"\x17AddConsultationResponse\x12\x19\n" +
 	"\bvisit_id\x18\x01 \x01(\x05R\avisitId\x12\x1f\n" +
 	"\vtotal_price\x18\x02 \x01(\x05R\n" +
-	"totalPrice\"3\n" +
+	"totalPrice\x12-\n" +
+	"\x06alerts\x18\x03 \x03(\v2\x15.doctor.ClinicalAlertR\x06alerts\"\xc0\x02\n" +
+	"\x11TreatmentPlanItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05stage\x18\x02 \x01(\x05R\x05stage\x12\x14\n" +
+	"\x05tooth\x18\x03 \x01(\x05R\x05tooth\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x04 \x01(\x05R\tserviceId\x12!\n" +
+	"\fservice_name\x18\x05 \x01(\tR\vserviceName\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12'\n" +
+	"\x0festimated_price\x18\a \x01(\x05R\x0eestimatedPrice\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x19\n" +
+	"\bvisit_id\x18\t \x01(\x05R\avisitId\x12\x12\n" +
+	"\x04note\x18\n" +
+	" \x01(\tR\x04note\x12!\n" +
+	"\fcompleted_at\x18\v \x01(\tR\vcompletedAt\"\xe2\x02\n" +
+	"\rTreatmentPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\x1b\n" +
+	"\tdoctor_id\x18\x03 \x01(\x05R\bdoctorId\x12\x16\n" +
+	"\x06doctor\x18\x04 \x01(\tR\x06doctor\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1f\n" +
+	"\vaccepted_at\x18\a \x01(\tR\n" +
+	"acceptedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12/\n" +
+	"\x05items\x18\t \x03(\v2\x19.doctor.TreatmentPlanItemR\x05items\x12'\n" +
+	"\x0festimated_total\x18\n" +
+	" \x01(\x05R\x0eestimatedTotal\x12%\n" +
+	"\x0eremaining_cost\x18\v \x01(\x05R\rremainingCost\"\x98\x01\n" +
+	"\x1aCreateTreatmentPlanRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12/\n" +
+	"\x05items\x18\x04 \x03(\v2\x19.doctor.TreatmentPlanItemR\x05items\"~\n" +
+	"\x1cAddTreatmentPlanItemsRequest\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\x05R\x06planId\x12/\n" +
+	"\x05items\x18\x02 \x03(\v2\x19.doctor.TreatmentPlanItemR\x05items\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"m\n" +
+	"$UpdateTreatmentPlanItemStatusRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\x05R\x06itemId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"B\n" +
+	"\x15TreatmentPlanResponse\x12)\n" +
+	"\x04plan\x18\x01 \x01(\v2\x15.doctor.TreatmentPlanR\x04plan\"O\n" +
+	" GetPatientTreatmentPlansResponse\x12+\n" +
+	"\x05plans\x18\x01 \x03(\v2\x15.doctor.TreatmentPlanR\x05plans\"\xdb\x01\n" +
+	"\n" +
+	"ToothState\x12\x14\n" +
+	"\x05tooth\x18\x01 \x01(\x05R\x05tooth\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\bsurfaces\x18\x03 \x01(\tR\bsurfaces\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x19\n" +
+	"\bvisit_id\x18\x05 \x01(\x05R\avisitId\x12\x1b\n" +
+	"\tdoctor_id\x18\x06 \x01(\x05R\bdoctorId\x12\x16\n" +
+	"\x06doctor\x18\a \x01(\tR\x06doctor\x12\x1f\n" +
+	"\vrecorded_at\x18\b \x01(\tR\n" +
+	"recordedAt\"?\n" +
+	"\x13DentalChartResponse\x12(\n" +
+	"\x05teeth\x18\x01 \x03(\v2\x12.doctor.ToothStateR\x05teeth\"y\n" +
+	"\x18UpdateDentalChartRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x02 \x01(\x05R\tpatientId\x12(\n" +
+	"\x05teeth\x18\x03 \x03(\v2\x12.doctor.ToothStateR\x05teeth\"c\n" +
+	"\x16GetToothHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x01 \x01(\x05R\tpatientId\x12\x14\n" +
+	"\x05tooth\x18\x02 \x01(\x05R\x05tooth\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"I\n" +
 	"\x13GetDocumentsRequest\x12\x1c\n" +
-	"\tpatientID\x18\x01 \x01(\x05R\tpatientID\"J\n" +
+	"\tpatientID\x18\x01 \x01(\x05R\tpatientID\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"J\n" +
 	"\x14GetDocumentsResponse\x122\n" +
 	"\tdocuments\x18\x01 \x03(\v2\x14.doctor.DocumentInfoR\tdocuments\"|\n" +
 	"\fDocumentInfo\x12\x0e\n" +
@@ -2244,14 +4057,25 @@ const file_proto_doctor_doctor_proto_rawDesc = "" +
 	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\":\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"h\n" +
 	"\x17DownloadDocumentRequest\x12\x1f\n" +
 	"\vdocument_id\x18\x01 \x01(\tR\n" +
-	"documentId\"Z\n" +
+	"documentId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"a\n" +
 	"\x18DownloadDocumentResponse\x12\x1b\n" +
-	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
-	"\ffile_content\x18\x02 \x01(\fR\vfileContent2\x9b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"i\n" +
+	"\x1aExportMedicalRecordRequest\x12\x1d\n" +
 	"\n" +
+	"patient_id\x18\x01 \x01(\x05R\tpatientId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"w\n" +
+	"\x1bMedicalRecordExportResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent2\xc5\x12\n" +
 	"\rDoctorService\x12a\n" +
 	"\x14GetTodayAppointments\x12#.doctor.GetTodayAppointmentsRequest\x1a$.doctor.GetTodayAppointmentsResponse\x12j\n" +
 	"\x17GetUpcomingAppointments\x12&.doctor.GetUpcomingAppointmentsRequest\x1a'.doctor.GetUpcomingAppointmentsResponse\x12b\n" +
@@ -2265,9 +4089,21 @@ const file_proto_doctor_doctor_proto_rawDesc = "" +
 	"\x13AddPatientDiagnoses\x12\".doctor.AddPatientDiagnosesRequest\x1a\x17.doctor.DefaultResponse\x12G\n" +
 	"\x0fAddVisitPayment\x12\x1b.doctor.VisitPaymentRequest\x1a\x17.doctor.DefaultResponse\x12J\n" +
 	"\x12UpdateVisitPayment\x12\x1b.doctor.VisitPaymentRequest\x1a\x17.doctor.DefaultResponse\x12R\n" +
-	"\x0fAddConsultation\x12\x1e.doctor.AddConsultationRequest\x1a\x1f.doctor.AddConsultationResponse\x12T\n" +
-	"\x17GetDocumentsByPatientID\x12\x1b.doctor.GetDocumentsRequest\x1a\x1c.doctor.GetDocumentsResponse\x12U\n" +
-	"\x10DownloadDocument\x12\x1f.doctor.DownloadDocumentRequest\x1a .doctor.DownloadDocumentResponseB\x17Z\x15doctor/proto;doctorpbb\x06proto3"
+	"\x0fAddConsultation\x12\x1e.doctor.AddConsultationRequest\x1a\x1f.doctor.AddConsultationResponse\x12Y\n" +
+	"\x13CheckClinicalAlerts\x12\".doctor.CheckClinicalAlertsRequest\x1a\x1e.doctor.ClinicalAlertsResponse\x12\\\n" +
+	"\x18GetPatientTreatmentPlans\x12\x16.doctor.GetByIdRequest\x1a(.doctor.GetPatientTreatmentPlansResponse\x12X\n" +
+	"\x13CreateTreatmentPlan\x12\".doctor.CreateTreatmentPlanRequest\x1a\x1d.doctor.TreatmentPlanResponse\x12\\\n" +
+	"\x15AddTreatmentPlanItems\x12$.doctor.AddTreatmentPlanItemsRequest\x1a\x1d.doctor.TreatmentPlanResponse\x12l\n" +
+	"\x1dUpdateTreatmentPlanItemStatus\x12,.doctor.UpdateTreatmentPlanItemStatusRequest\x1a\x1d.doctor.TreatmentPlanResponse\x12L\n" +
+	"\x13CancelTreatmentPlan\x12\x16.doctor.GetByIdRequest\x1a\x1d.doctor.TreatmentPlanResponse\x12E\n" +
+	"\x0eGetDentalChart\x12\x16.doctor.GetByIdRequest\x1a\x1b.doctor.DentalChartResponse\x12R\n" +
+	"\x11UpdateDentalChart\x12 .doctor.UpdateDentalChartRequest\x1a\x1b.doctor.DentalChartResponse\x12N\n" +
+	"\x0fGetToothHistory\x12\x1e.doctor.GetToothHistoryRequest\x1a\x1b.doctor.DentalChartResponse\x12V\n" +
+	"\x14SavePatientAnamnesis\x12#.doctor.SavePatientAnamnesisRequest\x1a\x19.doctor.AnamnesisResponse\x12V\n" +
+	"\x1aGetPatientAnamnesisHistory\x12\x16.doctor.GetByIdRequest\x1a .doctor.AnamnesisHistoryResponse\x12^\n" +
+	"\x13ExportMedicalRecord\x12\".doctor.ExportMedicalRecordRequest\x1a#.doctor.MedicalRecordExportResponse\x12T\n" +
+	"\x17GetDocumentsByPatientID\x12\x1b.doctor.GetDocumentsRequest\x1a\x1c.doctor.GetDocumentsResponse\x12W\n" +
+	"\x10DownloadDocument\x12\x1f.doctor.DownloadDocumentRequest\x1a .doctor.DownloadDocumentResponse0\x01B\x17Z\x15doctor/proto;doctorpbb\x06proto3"
 
 var (
 	file_proto_doctor_doctor_proto_rawDescOnce sync.Once
@@ -2281,105 +4117,166 @@ func file_proto_doctor_doctor_proto_rawDescGZIP() []byte {
 	return file_proto_doctor_doctor_proto_rawDescData
 }
 
-var file_proto_doctor_doctor_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_proto_doctor_doctor_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_proto_doctor_doctor_proto_goTypes = []any{
-	(*Appointment)(nil),                         // 0: doctor.Appointment
-	(*GetAppointmentByIDResponse)(nil),          // 1: doctor.GetAppointmentByIDResponse
-	(*GetTodayAppointmentsRequest)(nil),         // 2: doctor.GetTodayAppointmentsRequest
-	(*GetUpcomingAppointmentsRequest)(nil),      // 3: doctor.GetUpcomingAppointmentsRequest
-	(*GetUpcomingAppointmentsResponse)(nil),     // 4: doctor.GetUpcomingAppointmentsResponse
-	(*ScheduleTable)(nil),                       // 5: doctor.ScheduleTable
-	(*ScheduleCell)(nil),                        // 6: doctor.ScheduleCell
-	(*UpcomingAppointment)(nil),                 // 7: doctor.UpcomingAppointment
-	(*AppointmentsResponse)(nil),                // 8: doctor.AppointmentsResponse
-	(*TodayAppointment)(nil),                    // 9: doctor.TodayAppointment
-	(*GetTodayAppointmentsResponse)(nil),        // 10: doctor.GetTodayAppointmentsResponse
-	(*PatientAllergiesChronics)(nil),            // 11: doctor.PatientAllergiesChronics
-	(*GetPatientAllergiesChronicsResponse)(nil), // 12: doctor.GetPatientAllergiesChronicsResponse
-	(*GetByIdRequest)(nil),                      // 13: doctor.GetByIdRequest
-	(*AppointmentResponse)(nil),                 // 14: doctor.AppointmentResponse
-	(*Diagnose)(nil),                            // 15: doctor.Diagnose
-	(*VisitDiagnose)(nil),                       // 16: doctor.VisitDiagnose
-	(*Visit)(nil),                               // 17: doctor.Visit
-	(*GetPatientVisitsResponse)(nil),            // 18: doctor.GetPatientVisitsResponse
-	(*AddVisitMaterials)(nil),                   // 19: doctor.AddVisitMaterials
-	(*AddVisitMaterialsRequest)(nil),            // 20: doctor.AddVisitMaterialsRequest
-	(*AddVisitServices)(nil),                    // 21: doctor.AddVisitServices
-	(*AddVisitServicesRequest)(nil),             // 22: doctor.AddVisitServicesRequest
-	(*AddPatientAllergiesChronicsRequest)(nil),  // 23: doctor.AddPatientAllergiesChronicsRequest
-	(*AddPatientVisitRequest)(nil),              // 24: doctor.AddPatientVisitRequest
-	(*AddPatientDiagnosesRequest)(nil),          // 25: doctor.AddPatientDiagnosesRequest
-	(*DefaultResponse)(nil),                     // 26: doctor.DefaultResponse
-	(*AddVisitResponse)(nil),                    // 27: doctor.AddVisitResponse
-	(*VisitPaymentRequest)(nil),                 // 28: doctor.VisitPaymentRequest
-	(*AddConsultationRequest)(nil),              // 29: doctor.AddConsultationRequest
-	(*AddConsultationResponse)(nil),             // 30: doctor.AddConsultationResponse
-	(*GetDocumentsRequest)(nil),                 // 31: doctor.GetDocumentsRequest
-	(*GetDocumentsResponse)(nil),                // 32: doctor.GetDocumentsResponse
-	(*DocumentInfo)(nil),                        // 33: doctor.DocumentInfo
-	(*DownloadDocumentRequest)(nil),             // 34: doctor.DownloadDocumentRequest
-	(*DownloadDocumentResponse)(nil),            // 35: doctor.DownloadDocumentResponse
-	(*timestamppb.Timestamp)(nil),               // 36: google.protobuf.Timestamp
+	(*Appointment)(nil),                          // 0: doctor.Appointment
+	(*GetAppointmentByIDResponse)(nil),           // 1: doctor.GetAppointmentByIDResponse
+	(*ClinicalAlert)(nil),                        // 2: doctor.ClinicalAlert
+	(*CheckClinicalAlertsRequest)(nil),           // 3: doctor.CheckClinicalAlertsRequest
+	(*ClinicalAlertsResponse)(nil),               // 4: doctor.ClinicalAlertsResponse
+	(*GetTodayAppointmentsRequest)(nil),          // 5: doctor.GetTodayAppointmentsRequest
+	(*GetUpcomingAppointmentsRequest)(nil),       // 6: doctor.GetUpcomingAppointmentsRequest
+	(*GetUpcomingAppointmentsResponse)(nil),      // 7: doctor.GetUpcomingAppointmentsResponse
+	(*ScheduleTable)(nil),                        // 8: doctor.ScheduleTable
+	(*ScheduleCell)(nil),                         // 9: doctor.ScheduleCell
+	(*UpcomingAppointment)(nil),                  // 10: doctor.UpcomingAppointment
+	(*AppointmentsResponse)(nil),                 // 11: doctor.AppointmentsResponse
+	(*TodayAppointment)(nil),                     // 12: doctor.TodayAppointment
+	(*GetTodayAppointmentsResponse)(nil),         // 13: doctor.GetTodayAppointmentsResponse
+	(*PatientAllergiesChronics)(nil),             // 14: doctor.PatientAllergiesChronics
+	(*GetPatientAllergiesChronicsResponse)(nil),  // 15: doctor.GetPatientAllergiesChronicsResponse
+	(*AnamnesisAllergy)(nil),                     // 16: doctor.AnamnesisAllergy
+	(*Anamnesis)(nil),                            // 17: doctor.Anamnesis
+	(*SavePatientAnamnesisRequest)(nil),          // 18: doctor.SavePatientAnamnesisRequest
+	(*AnamnesisResponse)(nil),                    // 19: doctor.AnamnesisResponse
+	(*AnamnesisHistoryResponse)(nil),             // 20: doctor.AnamnesisHistoryResponse
+	(*GetByIdRequest)(nil),                       // 21: doctor.GetByIdRequest
+	(*AppointmentResponse)(nil),                  // 22: doctor.AppointmentResponse
+	(*Diagnose)(nil),                             // 23: doctor.Diagnose
+	(*VisitDiagnose)(nil),                        // 24: doctor.VisitDiagnose
+	(*Visit)(nil),                                // 25: doctor.Visit
+	(*GetPatientVisitsResponse)(nil),             // 26: doctor.GetPatientVisitsResponse
+	(*AddVisitMaterials)(nil),                    // 27: doctor.AddVisitMaterials
+	(*AddVisitMaterialsRequest)(nil),             // 28: doctor.AddVisitMaterialsRequest
+	(*AddVisitServices)(nil),                     // 29: doctor.AddVisitServices
+	(*AddVisitServicesRequest)(nil),              // 30: doctor.AddVisitServicesRequest
+	(*AddPatientAllergiesChronicsRequest)(nil),   // 31: doctor.AddPatientAllergiesChronicsRequest
+	(*AddPatientVisitRequest)(nil),               // 32: doctor.AddPatientVisitRequest
+	(*AddPatientDiagnosesRequest)(nil),           // 33: doctor.AddPatientDiagnosesRequest
+	(*DefaultResponse)(nil),                      // 34: doctor.DefaultResponse
+	(*AddVisitResponse)(nil),                     // 35: doctor.AddVisitResponse
+	(*VisitPaymentRequest)(nil),                  // 36: doctor.VisitPaymentRequest
+	(*AddConsultationRequest)(nil),               // 37: doctor.AddConsultationRequest
+	(*AddConsultationResponse)(nil),              // 38: doctor.AddConsultationResponse
+	(*TreatmentPlanItem)(nil),                    // 39: doctor.TreatmentPlanItem
+	(*TreatmentPlan)(nil),                        // 40: doctor.TreatmentPlan
+	(*CreateTreatmentPlanRequest)(nil),           // 41: doctor.CreateTreatmentPlanRequest
+	(*AddTreatmentPlanItemsRequest)(nil),         // 42: doctor.AddTreatmentPlanItemsRequest
+	(*UpdateTreatmentPlanItemStatusRequest)(nil), // 43: doctor.UpdateTreatmentPlanItemStatusRequest
+	(*TreatmentPlanResponse)(nil),                // 44: doctor.TreatmentPlanResponse
+	(*GetPatientTreatmentPlansResponse)(nil),     // 45: doctor.GetPatientTreatmentPlansResponse
+	(*ToothState)(nil),                           // 46: doctor.ToothState
+	(*DentalChartResponse)(nil),                  // 47: doctor.DentalChartResponse
+	(*UpdateDentalChartRequest)(nil),             // 48: doctor.UpdateDentalChartRequest
+	(*GetToothHistoryRequest)(nil),               // 49: doctor.GetToothHistoryRequest
+	(*GetDocumentsRequest)(nil),                  // 50: doctor.GetDocumentsRequest
+	(*GetDocumentsResponse)(nil),                 // 51: doctor.GetDocumentsResponse
+	(*DocumentInfo)(nil),                         // 52: doctor.DocumentInfo
+	(*DownloadDocumentRequest)(nil),              // 53: doctor.DownloadDocumentRequest
+	(*DownloadDocumentResponse)(nil),             // 54: doctor.DownloadDocumentResponse
+	(*ExportMedicalRecordRequest)(nil),           // 55: doctor.ExportMedicalRecordRequest
+	(*MedicalRecordExportResponse)(nil),          // 56: doctor.MedicalRecordExportResponse
+	(*timestamppb.Timestamp)(nil),                // 57: google.protobuf.Timestamp
 }
 var file_proto_doctor_doctor_proto_depIdxs = []int32{
-	36, // 0: doctor.Appointment.date:type_name -> google.protobuf.Timestamp
-	36, // 1: doctor.Appointment.time:type_name -> google.protobuf.Timestamp
-	36, // 2: doctor.Appointment.birth_date:type_name -> google.protobuf.Timestamp
-	36, // 3: doctor.Appointment.created_at:type_name -> google.protobuf.Timestamp
-	36, // 4: doctor.Appointment.updated_at:type_name -> google.protobuf.Timestamp
+	57, // 0: doctor.Appointment.date:type_name -> google.protobuf.Timestamp
+	57, // 1: doctor.Appointment.time:type_name -> google.protobuf.Timestamp
+	57, // 2: doctor.Appointment.birth_date:type_name -> google.protobuf.Timestamp
+	57, // 3: doctor.Appointment.created_at:type_name -> google.protobuf.Timestamp
+	57, // 4: doctor.Appointment.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 5: doctor.GetAppointmentByIDResponse.appt:type_name -> doctor.Appointment
-	5,  // 6: doctor.GetUpcomingAppointmentsResponse.schedule:type_name -> doctor.ScheduleTable
-	6,  // 7: doctor.ScheduleTable.table:type_name -> doctor.ScheduleCell
-	7,  // 8: doctor.ScheduleCell.appointment:type_name -> doctor.UpcomingAppointment
-	0,  // 9: doctor.AppointmentsResponse.appointments:type_name -> doctor.Appointment
-	9,  // 10: doctor.GetTodayAppointmentsResponse.appointments:type_name -> doctor.TodayAppointment
-	11, // 11: doctor.GetPatientAllergiesChronicsResponse.patient_allergies_chronics:type_name -> doctor.PatientAllergiesChronics
-	15, // 12: doctor.Visit.diagnoses:type_name -> doctor.Diagnose
-	17, // 13: doctor.GetPatientVisitsResponse.visits:type_name -> doctor.Visit
-	19, // 14: doctor.AddVisitMaterialsRequest.materials:type_name -> doctor.AddVisitMaterials
-	21, // 15: doctor.AddVisitServicesRequest.services:type_name -> doctor.AddVisitServices
-	11, // 16: doctor.AddPatientAllergiesChronicsRequest.notes:type_name -> doctor.PatientAllergiesChronics
-	36, // 17: doctor.AddPatientVisitRequest.created_at:type_name -> google.protobuf.Timestamp
-	16, // 18: doctor.AddPatientDiagnosesRequest.diagnoses:type_name -> doctor.VisitDiagnose
-	16, // 19: doctor.AddConsultationRequest.diagnoses:type_name -> doctor.VisitDiagnose
-	21, // 20: doctor.AddConsultationRequest.services:type_name -> doctor.AddVisitServices
-	19, // 21: doctor.AddConsultationRequest.materials:type_name -> doctor.AddVisitMaterials
-	33, // 22: doctor.GetDocumentsResponse.documents:type_name -> doctor.DocumentInfo
-	2,  // 23: doctor.DoctorService.GetTodayAppointments:input_type -> doctor.GetTodayAppointmentsRequest
-	3,  // 24: doctor.DoctorService.GetUpcomingAppointments:input_type -> doctor.GetUpcomingAppointmentsRequest
-	13, // 25: doctor.DoctorService.GetPatientAllergiesChronics:input_type -> doctor.GetByIdRequest
-	13, // 26: doctor.DoctorService.GetAppointmentByID:input_type -> doctor.GetByIdRequest
-	13, // 27: doctor.DoctorService.GetPatientVisits:input_type -> doctor.GetByIdRequest
-	23, // 28: doctor.DoctorService.AddPatientAllergiesChronics:input_type -> doctor.AddPatientAllergiesChronicsRequest
-	24, // 29: doctor.DoctorService.AddPatientVisit:input_type -> doctor.AddPatientVisitRequest
-	20, // 30: doctor.DoctorService.AddVisitMaterials:input_type -> doctor.AddVisitMaterialsRequest
-	22, // 31: doctor.DoctorService.AddVisitServices:input_type -> doctor.AddVisitServicesRequest
-	25, // 32: doctor.DoctorService.AddPatientDiagnoses:input_type -> doctor.AddPatientDiagnosesRequest
-	28, // 33: doctor.DoctorService.AddVisitPayment:input_type -> doctor.VisitPaymentRequest
-	28, // 34: doctor.DoctorService.UpdateVisitPayment:input_type -> doctor.VisitPaymentRequest
-	29, // 35: doctor.DoctorService.AddConsultation:input_type -> doctor.AddConsultationRequest
-	31, // 36: doctor.DoctorService.GetDocumentsByPatientID:input_type -> doctor.GetDocumentsRequest
-	34, // 37: doctor.DoctorService.DownloadDocument:input_type -> doctor.DownloadDocumentRequest
-	10, // 38: doctor.DoctorService.GetTodayAppointments:output_type -> doctor.GetTodayAppointmentsResponse
-	4,  // 39: doctor.DoctorService.GetUpcomingAppointments:output_type -> doctor.GetUpcomingAppointmentsResponse
-	12, // 40: doctor.DoctorService.GetPatientAllergiesChronics:output_type -> doctor.GetPatientAllergiesChronicsResponse
-	1,  // 41: doctor.DoctorService.GetAppointmentByID:output_type -> doctor.GetAppointmentByIDResponse
-	18, // 42: doctor.DoctorService.GetPatientVisits:output_type -> doctor.GetPatientVisitsResponse
-	26, // 43: doctor.DoctorService.AddPatientAllergiesChronics:output_type -> doctor.DefaultResponse
-	27, // 44: doctor.DoctorService.AddPatientVisit:output_type -> doctor.AddVisitResponse
-	26, // 45: doctor.DoctorService.AddVisitMaterials:output_type -> doctor.DefaultResponse
-	26, // 46: doctor.DoctorService.AddVisitServices:output_type -> doctor.DefaultResponse
-	26, // 47: doctor.DoctorService.AddPatientDiagnoses:output_type -> doctor.DefaultResponse
-	26, // 48: doctor.DoctorService.AddVisitPayment:output_type -> doctor.DefaultResponse
-	26, // 49: doctor.DoctorService.UpdateVisitPayment:output_type -> doctor.DefaultResponse
-	30, // 50: doctor.DoctorService.AddConsultation:output_type -> doctor.AddConsultationResponse
-	32, // 51: doctor.DoctorService.GetDocumentsByPatientID:output_type -> doctor.GetDocumentsResponse
-	35, // 52: doctor.DoctorService.DownloadDocument:output_type -> doctor.DownloadDocumentResponse
-	38, // [38:53] is the sub-list for method output_type
-	23, // [23:38] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	2,  // 6: doctor.GetAppointmentByIDResponse.alerts:type_name -> doctor.ClinicalAlert
+	2,  // 7: doctor.ClinicalAlertsResponse.alerts:type_name -> doctor.ClinicalAlert
+	8,  // 8: doctor.GetUpcomingAppointmentsResponse.schedule:type_name -> doctor.ScheduleTable
+	9,  // 9: doctor.ScheduleTable.table:type_name -> doctor.ScheduleCell
+	10, // 10: doctor.ScheduleCell.appointment:type_name -> doctor.UpcomingAppointment
+	0,  // 11: doctor.AppointmentsResponse.appointments:type_name -> doctor.Appointment
+	12, // 12: doctor.GetTodayAppointmentsResponse.appointments:type_name -> doctor.TodayAppointment
+	14, // 13: doctor.GetPatientAllergiesChronicsResponse.patient_allergies_chronics:type_name -> doctor.PatientAllergiesChronics
+	17, // 14: doctor.GetPatientAllergiesChronicsResponse.anamnesis:type_name -> doctor.Anamnesis
+	16, // 15: doctor.Anamnesis.allergies:type_name -> doctor.AnamnesisAllergy
+	17, // 16: doctor.SavePatientAnamnesisRequest.anamnesis:type_name -> doctor.Anamnesis
+	17, // 17: doctor.AnamnesisResponse.anamnesis:type_name -> doctor.Anamnesis
+	17, // 18: doctor.AnamnesisHistoryResponse.versions:type_name -> doctor.Anamnesis
+	23, // 19: doctor.Visit.diagnoses:type_name -> doctor.Diagnose
+	25, // 20: doctor.GetPatientVisitsResponse.visits:type_name -> doctor.Visit
+	27, // 21: doctor.AddVisitMaterialsRequest.materials:type_name -> doctor.AddVisitMaterials
+	29, // 22: doctor.AddVisitServicesRequest.services:type_name -> doctor.AddVisitServices
+	14, // 23: doctor.AddPatientAllergiesChronicsRequest.notes:type_name -> doctor.PatientAllergiesChronics
+	57, // 24: doctor.AddPatientVisitRequest.created_at:type_name -> google.protobuf.Timestamp
+	24, // 25: doctor.AddPatientDiagnosesRequest.diagnoses:type_name -> doctor.VisitDiagnose
+	24, // 26: doctor.AddConsultationRequest.diagnoses:type_name -> doctor.VisitDiagnose
+	29, // 27: doctor.AddConsultationRequest.services:type_name -> doctor.AddVisitServices
+	27, // 28: doctor.AddConsultationRequest.materials:type_name -> doctor.AddVisitMaterials
+	46, // 29: doctor.AddConsultationRequest.chart:type_name -> doctor.ToothState
+	2,  // 30: doctor.AddConsultationResponse.alerts:type_name -> doctor.ClinicalAlert
+	39, // 31: doctor.TreatmentPlan.items:type_name -> doctor.TreatmentPlanItem
+	39, // 32: doctor.CreateTreatmentPlanRequest.items:type_name -> doctor.TreatmentPlanItem
+	39, // 33: doctor.AddTreatmentPlanItemsRequest.items:type_name -> doctor.TreatmentPlanItem
+	40, // 34: doctor.TreatmentPlanResponse.plan:type_name -> doctor.TreatmentPlan
+	40, // 35: doctor.GetPatientTreatmentPlansResponse.plans:type_name -> doctor.TreatmentPlan
+	46, // 36: doctor.DentalChartResponse.teeth:type_name -> doctor.ToothState
+	46, // 37: doctor.UpdateDentalChartRequest.teeth:type_name -> doctor.ToothState
+	52, // 38: doctor.GetDocumentsResponse.documents:type_name -> doctor.DocumentInfo
+	5,  // 39: doctor.DoctorService.GetTodayAppointments:input_type -> doctor.GetTodayAppointmentsRequest
+	6,  // 40: doctor.DoctorService.GetUpcomingAppointments:input_type -> doctor.GetUpcomingAppointmentsRequest
+	21, // 41: doctor.DoctorService.GetPatientAllergiesChronics:input_type -> doctor.GetByIdRequest
+	21, // 42: doctor.DoctorService.GetAppointmentByID:input_type -> doctor.GetByIdRequest
+	21, // 43: doctor.DoctorService.GetPatientVisits:input_type -> doctor.GetByIdRequest
+	31, // 44: doctor.DoctorService.AddPatientAllergiesChronics:input_type -> doctor.AddPatientAllergiesChronicsRequest
+	32, // 45: doctor.DoctorService.AddPatientVisit:input_type -> doctor.AddPatientVisitRequest
+	28, // 46: doctor.DoctorService.AddVisitMaterials:input_type -> doctor.AddVisitMaterialsRequest
+	30, // 47: doctor.DoctorService.AddVisitServices:input_type -> doctor.AddVisitServicesRequest
+	33, // 48: doctor.DoctorService.AddPatientDiagnoses:input_type -> doctor.AddPatientDiagnosesRequest
+	36, // 49: doctor.DoctorService.AddVisitPayment:input_type -> doctor.VisitPaymentRequest
+	36, // 50: doctor.DoctorService.UpdateVisitPayment:input_type -> doctor.VisitPaymentRequest
+	37, // 51: doctor.DoctorService.AddConsultation:input_type -> doctor.AddConsultationRequest
+	3,  // 52: doctor.DoctorService.CheckClinicalAlerts:input_type -> doctor.CheckClinicalAlertsRequest
+	21, // 53: doctor.DoctorService.GetPatientTreatmentPlans:input_type -> doctor.GetByIdRequest
+	41, // 54: doctor.DoctorService.CreateTreatmentPlan:input_type -> doctor.CreateTreatmentPlanRequest
+	42, // 55: doctor.DoctorService.AddTreatmentPlanItems:input_type -> doctor.AddTreatmentPlanItemsRequest
+	43, // 56: doctor.DoctorService.UpdateTreatmentPlanItemStatus:input_type -> doctor.UpdateTreatmentPlanItemStatusRequest
+	21, // 57: doctor.DoctorService.CancelTreatmentPlan:input_type -> doctor.GetByIdRequest
+	21, // 58: doctor.DoctorService.GetDentalChart:input_type -> doctor.GetByIdRequest
+	48, // 59: doctor.DoctorService.UpdateDentalChart:input_type -> doctor.UpdateDentalChartRequest
+	49, // 60: doctor.DoctorService.GetToothHistory:input_type -> doctor.GetToothHistoryRequest
+	18, // 61: doctor.DoctorService.SavePatientAnamnesis:input_type -> doctor.SavePatientAnamnesisRequest
+	21, // 62: doctor.DoctorService.GetPatientAnamnesisHistory:input_type -> doctor.GetByIdRequest
+	55, // 63: doctor.DoctorService.ExportMedicalRecord:input_type -> doctor.ExportMedicalRecordRequest
+	50, // 64: doctor.DoctorService.GetDocumentsByPatientID:input_type -> doctor.GetDocumentsRequest
+	53, // 65: doctor.DoctorService.DownloadDocument:input_type -> doctor.DownloadDocumentRequest
+	13, // 66: doctor.DoctorService.GetTodayAppointments:output_type -> doctor.GetTodayAppointmentsResponse
+	7,  // 67: doctor.DoctorService.GetUpcomingAppointments:output_type -> doctor.GetUpcomingAppointmentsResponse
+	15, // 68: doctor.DoctorService.GetPatientAllergiesChronics:output_type -> doctor.GetPatientAllergiesChronicsResponse
+	1,  // 69: doctor.DoctorService.GetAppointmentByID:output_type -> doctor.GetAppointmentByIDResponse
+	26, // 70: doctor.DoctorService.GetPatientVisits:output_type -> doctor.GetPatientVisitsResponse
+	34, // 71: doctor.DoctorService.AddPatientAllergiesChronics:output_type -> doctor.DefaultResponse
+	35, // 72: doctor.DoctorService.AddPatientVisit:output_type -> doctor.AddVisitResponse
+	34, // 73: doctor.DoctorService.AddVisitMaterials:output_type -> doctor.DefaultResponse
+	34, // 74: doctor.DoctorService.AddVisitServices:output_type -> doctor.DefaultResponse
+	34, // 75: doctor.DoctorService.AddPatientDiagnoses:output_type -> doctor.DefaultResponse
+	34, // 76: doctor.DoctorService.AddVisitPayment:output_type -> doctor.DefaultResponse
+	34, // 77: doctor.DoctorService.UpdateVisitPayment:output_type -> doctor.DefaultResponse
+	38, // 78: doctor.DoctorService.AddConsultation:output_type -> doctor.AddConsultationResponse
+	4,  // 79: doctor.DoctorService.CheckClinicalAlerts:output_type -> doctor.ClinicalAlertsResponse
+	45, // 80: doctor.DoctorService.GetPatientTreatmentPlans:output_type -> doctor.GetPatientTreatmentPlansResponse
+	44, // 81: doctor.DoctorService.CreateTreatmentPlan:output_type -> doctor.TreatmentPlanResponse
+	44, // 82: doctor.DoctorService.AddTreatmentPlanItems:output_type -> doctor.TreatmentPlanResponse
+	44, // 83: doctor.DoctorService.UpdateTreatmentPlanItemStatus:output_type -> doctor.TreatmentPlanResponse
+	44, // 84: doctor.DoctorService.CancelTreatmentPlan:output_type -> doctor.TreatmentPlanResponse
+	47, // 85: doctor.DoctorService.GetDentalChart:output_type -> doctor.DentalChartResponse
+	47, // 86: doctor.DoctorService.UpdateDentalChart:output_type -> doctor.DentalChartResponse
+	47, // 87: doctor.DoctorService.GetToothHistory:output_type -> doctor.DentalChartResponse
+	19, // 88: doctor.DoctorService.SavePatientAnamnesis:output_type -> doctor.AnamnesisResponse
+	20, // 89: doctor.DoctorService.GetPatientAnamnesisHistory:output_type -> doctor.AnamnesisHistoryResponse
+	56, // 90: doctor.DoctorService.ExportMedicalRecord:output_type -> doctor.MedicalRecordExportResponse
+	51, // 91: doctor.DoctorService.GetDocumentsByPatientID:output_type -> doctor.GetDocumentsResponse
+	54, // 92: doctor.DoctorService.DownloadDocument:output_type -> doctor.DownloadDocumentResponse
+	66, // [66:93] is the sub-list for method output_type
+	39, // [39:66] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_proto_doctor_doctor_proto_init() }
@@ -2393,7 +4290,7 @@ func file_proto_doctor_doctor_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_doctor_doctor_proto_rawDesc), len(file_proto_doctor_doctor_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   36,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/access"
+	authpb "github.com/DariaTarasek/diplom/services/doctor/proto/auth"
+	storagepb "github.com/DariaTarasek/diplom/services/doctor/proto/storage"
+)
+
+// storageRelations отношения врача к пациенту из storage для политики доступа
+type storageRelations struct {
+	client storagepb.StorageServiceClient
+}
+
+func (r storageRelations) DoctorRelation(ctx context.Context, doctorID, patientID int) (access.Relation, error) {
+	rel, err := r.client.GetDoctorPatientRelation(ctx, &storagepb.DoctorPatientRelationRequest{
+		DoctorId:  int32(doctorID),
+		PatientId: int32(patientID),
+	})
+	if err != nil {
+		return access.Relation{}, err
+	}
+	return access.Relation{Treating: rel.Treating, Granted: rel.Granted}, nil
+}
+
+// authorize решает по политике access, может ли владелец токена выполнить action над resource: врач работает
+// с картой пациентов, которых лечит или к которым ему выдан допуск. Отказ — access.ErrForbidden, клиент
+// получает его с кодом PermissionDenied
+func (s *DoctorService) authorize(ctx context.Context, token string, action access.Action, resource access.Resource) error {
+	user, err := s.AuthClient.Client.GetUserRole(ctx, &authpb.GetUserIDRequest{Token: token})
+	if err != nil {
+		return fmt.Errorf("не удалось определить пользователя: %w", err)
+	}
+	subject := access.Subject{UserID: int(user.UserId), Role: access.Role(user.Role)}
+	return access.Authorize(ctx, subject, action, resource, storageRelations{client: s.StorageClient.Client})
+}
+
+// authorizePatient доступ к медицинской карте пациента patientID
+func (s *DoctorService) authorizePatient(ctx context.Context, token string, patientID int) error {
+	return s.authorize(ctx, token, access.ReadRecord, access.Resource{PatientID: patientID})
+}
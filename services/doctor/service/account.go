@@ -6,6 +6,7 @@ import (
 	"github.com/DariaTarasek/diplom/services/doctor/model"
 	authpb "github.com/DariaTarasek/diplom/services/doctor/proto/auth"
 	storagepb "github.com/DariaTarasek/diplom/services/doctor/proto/storage"
+	"github.com/DariaTarasek/diplom/services/scheduling"
 	"sort"
 	"time"
 	"unicode"
@@ -26,13 +27,17 @@ func (s *DoctorService) GetTodayAppointments(ctx context.Context, token string)
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить записи: %w", err)
 	}
+	loc, err := s.clinicLocation(ctx)
+	if err != nil {
+		return nil, err
+	}
 	todays := make([]model.TodayAppointment, 0)
-	now := time.Now().Truncate(24 * time.Hour)
+	today := scheduling.Today(time.Now(), loc)
 	for _, app := range apps.Appointments {
 		if app.Status == "cancelled" || app.Status == "completed" {
 			continue
 		}
-		if !app.Date.AsTime().Equal(now) {
+		if !scheduling.Day(app.Date.AsTime(), loc).Equal(today) {
 			continue
 		}
 		todayDate := app.Date.AsTime().Format("02.01.2006")
@@ -56,24 +61,9 @@ func (s *DoctorService) GetUpcomingAppointments(ctx context.Context, token strin
 		return model.ScheduleTable{}, fmt.Errorf("ошибка получения ID пользователя: %w", err)
 	}
 
-	scheduleResp, err := s.StorageClient.Client.GetDoctorWeeklySchedule(ctx, &storagepb.GetScheduleByDoctorIdRequest{
-		DoctorId: doctorIDResp.UserId,
-	})
+	schedule, err := s.loadDoctorSchedule(ctx, doctorIDResp.UserId)
 	if err != nil {
-		return model.ScheduleTable{}, fmt.Errorf("не удалось получить расписание врача: %w", err)
-	}
-
-	overridesResp, err := s.StorageClient.Client.GetDoctorOverrides(ctx, &storagepb.GetByIDRequest{Id: doctorIDResp.UserId})
-	if err != nil {
-		return model.ScheduleTable{}, fmt.Errorf("не удалось получить перегрузки врача: %w", err)
-	}
-	overridesMap := make(map[string]*storagepb.DoctorOverride)
-	for _, override := range overridesResp.Override {
-		if override.Date == nil {
-			continue
-		}
-		dateStr := override.Date.AsTime().Format("02.01.2006")
-		overridesMap[dateStr] = override
+		return model.ScheduleTable{}, err
 	}
 
 	appointmentsResp, err := s.StorageClient.Client.GetAppointmentsByDoctorID(ctx, &storagepb.GetAppointmentsByDoctorIDRequest{
@@ -83,31 +73,14 @@ func (s *DoctorService) GetUpcomingAppointments(ctx context.Context, token strin
 		return model.ScheduleTable{}, fmt.Errorf("не удалось получить записи: %w", err)
 	}
 
-	// Сборка расписания врача
-	var doctorSchedule []model.DoctorSchedule
-	for _, item := range scheduleResp.DoctorSchedule {
-		start, end := item.StartTime.AsTime(), item.EndTime.AsTime()
-		slotDuration := int(item.SlotDurationMinutes)
-
-		doctorSchedule = append(doctorSchedule, model.DoctorSchedule{
-			ID:                  int(item.Id),
-			DoctorID:            model.UserID(item.DoctorId),
-			Weekday:             int(item.Weekday),
-			StartTime:           &start,
-			EndTime:             &end,
-			SlotDurationMinutes: &slotDuration,
-			IsDayOff:            &item.IsDayOff,
-		})
-	}
-
 	// Преобразуем список приёмов
 	appointmentsMap := map[string]map[string]*model.UpcomingAppointment{}
 	for _, app := range appointmentsResp.Appointments {
 		if app.Status == "cancelled" {
 			continue
 		}
-		dateStr := fmt.Sprintf("%s\n(%s)", app.Date.AsTime().Format("02.01.2006"), weekdayToRus(app.Date.AsTime().Weekday()))
-		timeStr := app.Time.AsTime().Format("15:04")
+		dateStr := dateLabel(app.Date.AsTime())
+		timeStr := app.Time.AsTime().Format(timeLayout)
 
 		if appointmentsMap[dateStr] == nil {
 			appointmentsMap[dateStr] = make(map[string]*model.UpcomingAppointment)
@@ -132,67 +105,17 @@ func (s *DoctorService) GetUpcomingAppointments(ctx context.Context, token strin
 	}
 
 	uniqueTimes := map[string]bool{}
-	today := time.Now()
-	weekdayToday := int(today.Weekday())
-	if weekdayToday == 0 {
-		weekdayToday = 7
-	}
-	monday := today.AddDate(0, 0, -weekdayToday+1)
-	totalDays := weeksAhead * 7
-
-	for i := 0; i < totalDays; i++ {
-		date := monday.AddDate(0, 0, i)
-		weekday := int(date.Weekday())
-		if weekday == 0 {
-			weekday = 7
-		}
-		dateStr := fmt.Sprintf("%s\n(%s)", date.Format("02.01.2006"), weekdayToRus(date.Weekday()))
+	monday := scheduling.WeekStart(time.Now().In(schedule.Location))
+	for i := 0; i < weeksAhead*7; i++ {
+		hours := schedule.HoursOn(monday.AddDate(0, 0, i))
+		dateStr := dateLabel(hours.Date)
 		result.Dates = append(result.Dates, dateStr)
 		result.Table[dateStr] = make(map[string]*model.UpcomingAppointment)
 
-		override, hasOverride := overridesMap[date.Format("02.01.2006")]
-		if hasOverride {
-			if override.IsDayOff {
-				// Перегрузка говорит, что выходной — пропускаем этот день
-				continue
-			}
-
-			// Используем override-расписание
-			start := time.Date(date.Year(), date.Month(), date.Day(),
-				override.StartTime.AsTime().Hour(), override.StartTime.AsTime().Minute(), 0, 0, time.Local)
-			end := time.Date(date.Year(), date.Month(), date.Day(),
-				override.EndTime.AsTime().Hour(), override.EndTime.AsTime().Minute(), 0, 0, time.Local)
-
-			for t := start; t.Before(end); t = t.Add(time.Duration(derefInt(doctorSchedule[1].SlotDurationMinutes)) * time.Minute) { // Можно заменить 30 на override-слот, если он появится
-				timeStr := t.Format("15:04")
-				uniqueTimes[timeStr] = true
-				if appointment, exists := appointmentsMap[dateStr][timeStr]; exists {
-					result.Table[dateStr][timeStr] = appointment
-				} else {
-					result.Table[dateStr][timeStr] = nil
-				}
-			}
-			continue // Переход к следующему дню
-		}
-
-		for _, sched := range doctorSchedule {
-			if sched.Weekday != weekday || *sched.IsDayOff {
-				continue
-			}
-
-			start := time.Date(date.Year(), date.Month(), date.Day(), sched.StartTime.Hour(), sched.StartTime.Minute(), 0, 0, time.Local)
-			end := time.Date(date.Year(), date.Month(), date.Day(), sched.EndTime.Hour(), sched.EndTime.Minute(), 0, 0, time.Local)
-
-			for t := start; t.Before(end); t = t.Add(time.Duration(*sched.SlotDurationMinutes) * time.Minute) {
-				timeStr := t.Format("15:04")
-				uniqueTimes[timeStr] = true
-
-				if appointment, exists := appointmentsMap[dateStr][timeStr]; exists {
-					result.Table[dateStr][timeStr] = appointment
-				} else {
-					result.Table[dateStr][timeStr] = nil
-				}
-			}
+		for _, t := range hours.SlotStarts() {
+			timeStr := t.Format(timeLayout)
+			uniqueTimes[timeStr] = true
+			result.Table[dateStr][timeStr] = appointmentsMap[dateStr][timeStr]
 		}
 	}
 
@@ -205,6 +128,10 @@ func (s *DoctorService) GetUpcomingAppointments(ctx context.Context, token strin
 	return result, nil
 }
 
+func dateLabel(date time.Time) string {
+	return fmt.Sprintf("%s\n(%s)", date.Format("02.01.2006"), weekdayToRus(date.Weekday()))
+}
+
 func getAndCapitalizeFirstLetter(str string) string {
 	if str == "" {
 		return ""
@@ -246,10 +173,3 @@ func deref(s *string) string {
 	}
 	return *s
 }
-
-func derefInt(i *int) int {
-	if i == nil {
-		return 0
-	}
-	return *i
-}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/doctor/model"
+	authpb "github.com/DariaTarasek/diplom/services/doctor/proto/auth"
+	storagepb "github.com/DariaTarasek/diplom/services/doctor/proto/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	maxAnamnesisText      = 2000
+	maxAnamnesisAllergies = 30
+	maxAllergenLength     = 200
+	maxAllergyReaction    = 500
+)
+
+// SavePatientAnamnesis сохраняет анкету здоровья пациента новой версией от имени врача. baseVersion — версия,
+// которую врач открыл; если пациент или другой врач успели её изменить, сохранение отклоняется
+func (s *DoctorService) SavePatientAnamnesis(ctx context.Context, token string, patientID int, anamnesis model.Anamnesis, baseVersion int) (model.Anamnesis, error) {
+	if err := validateAnamnesis(&anamnesis); err != nil {
+		return model.Anamnesis{}, err
+	}
+	if err := s.authorizePatient(ctx, token, patientID); err != nil {
+		return model.Anamnesis{}, err
+	}
+	doctorID, err := s.AuthClient.Client.GetUserID(ctx, &authpb.GetUserIDRequest{Token: token})
+	if err != nil {
+		return model.Anamnesis{}, fmt.Errorf("не удалось получить врача, изменяющего анкету: %w", err)
+	}
+	req := anamnesisToStorage(anamnesis)
+	req.PatientId = int32(patientID)
+	req.ChangedBy = doctorID.UserId
+	req.ChangedByRole = "doctor"
+	resp, err := s.StorageClient.Client.SaveAnamnesis(ctx, &storagepb.SaveAnamnesisRequest{
+		Anamnesis:   req,
+		BaseVersion: int32(baseVersion),
+	})
+	if err != nil {
+		return model.Anamnesis{}, fmt.Errorf("не удалось сохранить анкету здоровья: %w", err)
+	}
+	return s.anamnesisFromPb(ctx, resp.Anamnesis, make(map[int32]string))
+}
+
+// GetPatientAnamnesisHistory все версии анкеты здоровья пациента, новые первыми
+func (s *DoctorService) GetPatientAnamnesisHistory(ctx context.Context, token string, patientID int) ([]model.Anamnesis, error) {
+	if err := s.authorizePatient(ctx, token, patientID); err != nil {
+		return nil, err
+	}
+	resp, err := s.StorageClient.Client.GetAnamnesisHistory(ctx, &storagepb.GetByIdRequest{Id: int32(patientID)})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить историю анкеты здоровья: %w", err)
+	}
+	doctors := make(map[int32]string)
+	versions := make([]model.Anamnesis, 0, len(resp.Versions))
+	for _, item := range resp.Versions {
+		version, err := s.anamnesisFromPb(ctx, item, doctors)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// validateAnamnesis проверяет ответы анкеты и обрезает пробелы в текстовых полях
+func validateAnamnesis(anamnesis *model.Anamnesis) error {
+	anamnesis.Medications = strings.TrimSpace(anamnesis.Medications)
+	anamnesis.OtherConditions = strings.TrimSpace(anamnesis.OtherConditions)
+	if len([]rune(anamnesis.Medications)) > maxAnamnesisText || len([]rune(anamnesis.OtherConditions)) > maxAnamnesisText {
+		return status.Errorf(codes.InvalidArgument, "ответ в анкете не должен превышать %d символов", maxAnamnesisText)
+	}
+	if len(anamnesis.Allergies) > maxAnamnesisAllergies {
+		return status.Errorf(codes.InvalidArgument, "в анкете можно указать не больше %d аллергий", maxAnamnesisAllergies)
+	}
+	for i := range anamnesis.Allergies {
+		allergy := &anamnesis.Allergies[i]
+		allergy.Allergen = strings.TrimSpace(allergy.Allergen)
+		allergy.Reaction = strings.TrimSpace(allergy.Reaction)
+		if allergy.Allergen == "" || len([]rune(allergy.Allergen)) > maxAllergenLength {
+			return status.Errorf(codes.InvalidArgument, "аллерген должен содержать от 1 до %d символов", maxAllergenLength)
+		}
+		if len([]rune(allergy.Reaction)) > maxAllergyReaction {
+			return status.Errorf(codes.InvalidArgument, "описание реакции не должно превышать %d символов", maxAllergyReaction)
+		}
+		switch allergy.Severity {
+		case "mild", "moderate", "severe":
+		default:
+			return status.Errorf(codes.InvalidArgument, "неизвестная тяжесть аллергии %q: ожидается mild, moderate или severe", allergy.Severity)
+		}
+	}
+	return nil
+}
+
+func anamnesisToStorage(anamnesis model.Anamnesis) *storagepb.Anamnesis {
+	item := &storagepb.Anamnesis{
+		Medications:        anamnesis.Medications,
+		BloodThinners:      anamnesis.BloodThinners,
+		CardiacDisease:     anamnesis.CardiacDisease,
+		Hypertension:       anamnesis.Hypertension,
+		Diabetes:           anamnesis.Diabetes,
+		BleedingDisorder:   anamnesis.BleedingDisorder,
+		InfectiousDisease:  anamnesis.InfectiousDisease,
+		AnesthesiaReaction: anamnesis.AnesthesiaReaction,
+		Pregnant:           anamnesis.Pregnant,
+		Breastfeeding:      anamnesis.Breastfeeding,
+		Smoker:             anamnesis.Smoker,
+		OtherConditions:    anamnesis.OtherConditions,
+	}
+	for _, allergy := range anamnesis.Allergies {
+		item.Allergies = append(item.Allergies, &storagepb.AnamnesisAllergy{
+			Allergen: allergy.Allergen,
+			Severity: allergy.Severity,
+			Reaction: allergy.Reaction,
+		})
+	}
+	return item
+}
+
+// anamnesisFromPb версия анкеты из ответа storage с ФИО врача, если её сохранил врач
+func (s *DoctorService) anamnesisFromPb(ctx context.Context, item *storagepb.Anamnesis, doctors map[int32]string) (model.Anamnesis, error) {
+	anamnesis := model.Anamnesis{
+		Version:            int(item.Version),
+		Medications:        item.Medications,
+		BloodThinners:      item.BloodThinners,
+		CardiacDisease:     item.CardiacDisease,
+		Hypertension:       item.Hypertension,
+		Diabetes:           item.Diabetes,
+		BleedingDisorder:   item.BleedingDisorder,
+		InfectiousDisease:  item.InfectiousDisease,
+		AnesthesiaReaction: item.AnesthesiaReaction,
+		Pregnant:           item.Pregnant,
+		Breastfeeding:      item.Breastfeeding,
+		Smoker:             item.Smoker,
+		OtherConditions:    item.OtherConditions,
+		ChangedByID:        int(item.ChangedBy),
+		ChangedByRole:      item.ChangedByRole,
+	}
+	if item.CreatedAt != nil {
+		anamnesis.ChangedAt = item.CreatedAt.AsTime().Format("02.01.2006 15:04")
+	}
+	if item.ChangedByRole == "doctor" && item.ChangedBy != 0 {
+		doctor, err := s.doctorName(ctx, item.ChangedBy, doctors)
+		if err != nil {
+			return model.Anamnesis{}, fmt.Errorf("не удалось получить врача, изменившего анкету: %w", err)
+		}
+		anamnesis.ChangedBy = doctor
+	}
+	for _, allergy := range item.Allergies {
+		anamnesis.Allergies = append(anamnesis.Allergies, model.AnamnesisAllergy{
+			Allergen: allergy.Allergen,
+			Severity: allergy.Severity,
+			Reaction: allergy.Reaction,
+		})
+	}
+	return anamnesis, nil
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/doctor/model"
+	storagepb "github.com/DariaTarasek/diplom/services/doctor/proto/storage"
+	"sort"
+	"strings"
+)
+
+// anamnesisFlagTitles пункты анкеты здоровья, на которые могут ссылаться правила вида anamnesis_flag
+var anamnesisFlagTitles = map[string]string{
+	"blood_thinners":      "принимает препараты, снижающие свёртываемость крови",
+	"cardiac_disease":     "заболевания сердца",
+	"hypertension":        "гипертония",
+	"diabetes":            "сахарный диабет",
+	"bleeding_disorder":   "нарушение свёртываемости крови",
+	"infectious_disease":  "инфекционные заболевания",
+	"anesthesia_reaction": "реакция на анестезию",
+	"pregnant":            "беременность",
+	"breastfeeding":       "грудное вскармливание",
+	"smoker":              "курение",
+}
+
+// alertSeverityOrder порядок важности предупреждений: критичные показываются первыми
+var alertSeverityOrder = map[string]int{"critical": 0, "warning": 1, "info": 2}
+
+// patientAlertFacts сведения из карты пациента, по которым проверяются правила предупреждений
+type patientAlertFacts struct {
+	allergies  []string        // аллергии из заметок врача и анкеты здоровья
+	conditions []string        // хронические и сопутствующие заболевания, принимаемые препараты
+	flags      map[string]bool // отмеченные пункты анкеты здоровья
+}
+
+// CheckClinicalAlerts предупреждения по выбранным на приёме материалам и услугам, противоречащим карте пациента
+func (s *DoctorService) CheckClinicalAlerts(ctx context.Context, token string, patientID int, materialIDs, serviceIDs []int) ([]model.ClinicalAlert, error) {
+	if err := s.authorizePatient(ctx, token, patientID); err != nil {
+		return nil, err
+	}
+	return s.clinicalAlerts(ctx, patientID, false, materialIDs, serviceIDs)
+}
+
+// clinicalAlerts проверяет карту пациента по включённым правилам. visit — проверять правила, которые показываются
+// при открытии приёма; materialIDs и serviceIDs — материалы и услуги, выбранные врачом
+func (s *DoctorService) clinicalAlerts(ctx context.Context, patientID int, visit bool, materialIDs, serviceIDs []int) ([]model.ClinicalAlert, error) {
+	resp, err := s.StorageClient.Client.GetClinicalAlertRules(ctx, &storagepb.GetClinicalAlertRulesRequest{ActiveOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить правила клинических предупреждений: %w", err)
+	}
+	materials := make(map[int32]bool, len(materialIDs))
+	for _, id := range materialIDs {
+		materials[int32(id)] = true
+	}
+	services := make(map[int32]bool, len(serviceIDs))
+	for _, id := range serviceIDs {
+		services[int32(id)] = true
+	}
+	var rules []*storagepb.ClinicalAlertRule
+	for _, rule := range resp.Rules {
+		switch rule.TargetType {
+		case "visit":
+			if visit {
+				rules = append(rules, rule)
+			}
+		case "material":
+			if materials[rule.MaterialId] {
+				rules = append(rules, rule)
+			}
+		case "service":
+			if services[rule.ServiceId] {
+				rules = append(rules, rule)
+			}
+		}
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	facts, err := s.patientAlertFacts(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+	var alerts []model.ClinicalAlert
+	for _, rule := range rules {
+		reason, ok := matchAlertRule(rule, facts)
+		if !ok {
+			continue
+		}
+		alerts = append(alerts, model.ClinicalAlert{
+			RuleID:     int(rule.Id),
+			Severity:   rule.Severity,
+			Title:      rule.Title,
+			Message:    rule.Message,
+			Reason:     reason,
+			MaterialID: int(rule.MaterialId),
+			ServiceID:  int(rule.ServiceId),
+			TargetName: rule.TargetName,
+		})
+	}
+	sort.SliceStable(alerts, func(i, j int) bool {
+		return alertSeverityOrder[alerts[i].Severity] < alertSeverityOrder[alerts[j].Severity]
+	})
+	return alerts, nil
+}
+
+// patientAlertFacts собирает аллергии, заболевания и пункты анкеты из заметок врача и текущей версии анкеты здоровья
+func (s *DoctorService) patientAlertFacts(ctx context.Context, patientID int) (patientAlertFacts, error) {
+	notesResp, err := s.StorageClient.Client.GetPatientAllergiesChronics(ctx, &storagepb.GetByIdRequest{Id: int32(patientID)})
+	if err != nil {
+		return patientAlertFacts{}, fmt.Errorf("не удалось получить аллергии и хронические заболевания пациента: %w", err)
+	}
+	anamnesisResp, err := s.StorageClient.Client.GetAnamnesis(ctx, &storagepb.GetByIdRequest{Id: int32(patientID)})
+	if err != nil {
+		return patientAlertFacts{}, fmt.Errorf("не удалось получить анкету здоровья пациента: %w", err)
+	}
+
+	facts := patientAlertFacts{flags: make(map[string]bool)}
+	for _, note := range notesResp.PatientAllergiesChronics {
+		if note.Type == "allergy" {
+			facts.allergies = append(facts.allergies, note.Title)
+		} else {
+			facts.conditions = append(facts.conditions, note.Title)
+		}
+	}
+	a := anamnesisResp.Anamnesis
+	if a == nil || a.Version == 0 {
+		return facts, nil
+	}
+	for _, allergy := range a.Allergies {
+		facts.allergies = append(facts.allergies, allergy.Allergen)
+	}
+	for _, text := range []string{a.OtherConditions, a.Medications} {
+		if text != "" {
+			facts.conditions = append(facts.conditions, text)
+		}
+	}
+	facts.flags["blood_thinners"] = a.BloodThinners
+	facts.flags["cardiac_disease"] = a.CardiacDisease
+	facts.flags["hypertension"] = a.Hypertension
+	facts.flags["diabetes"] = a.Diabetes
+	facts.flags["bleeding_disorder"] = a.BleedingDisorder
+	facts.flags["infectious_disease"] = a.InfectiousDisease
+	facts.flags["anesthesia_reaction"] = a.AnesthesiaReaction
+	facts.flags["pregnant"] = a.Pregnant
+	facts.flags["breastfeeding"] = a.Breastfeeding
+	facts.flags["smoker"] = a.Smoker
+	return facts, nil
+}
+
+// matchAlertRule проверяет условие правила по карте пациента и возвращает, что в карте его выполнило.
+// Аллергии и заболевания сравниваются по вхождению без учёта регистра: правило «лидокаин» срабатывает
+// на аллергию «Лидокаин и другие амидные анестетики»
+func matchAlertRule(rule *storagepb.ClinicalAlertRule, facts patientAlertFacts) (string, bool) {
+	switch rule.ConditionType {
+	case "allergy":
+		if found, ok := findFold(facts.allergies, rule.ConditionValue); ok {
+			return "аллергия: " + found, true
+		}
+	case "condition":
+		if found, ok := findFold(facts.conditions, rule.ConditionValue); ok {
+			return "в карте: " + found, true
+		}
+	case "anamnesis_flag":
+		if facts.flags[rule.ConditionValue] {
+			return "анкета здоровья: " + anamnesisFlagTitles[rule.ConditionValue], true
+		}
+	}
+	return "", false
+}
+
+// findFold первая строка, содержащая value без учёта регистра
+func findFold(items []string, value string) (string, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return "", false
+	}
+	for _, item := range items {
+		if strings.Contains(strings.ToLower(item), value) {
+			return item, true
+		}
+	}
+	return "", false
+}
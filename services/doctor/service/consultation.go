@@ -3,17 +3,21 @@ package service
 import (
 	"context"
 	"fmt"
+	"github.com/DariaTarasek/diplom/services/access"
 	"github.com/DariaTarasek/diplom/services/doctor/model"
 	authpb "github.com/DariaTarasek/diplom/services/doctor/proto/auth"
 	storagepb "github.com/DariaTarasek/diplom/services/doctor/proto/storage"
-	"google.golang.org/protobuf/types/known/timestamppb"
-	"time"
+	"github.com/google/uuid"
 )
 
-func (s *DoctorService) GetPatientAllergiesChronics(ctx context.Context, id int) ([]model.AllergiesChronics, error) {
+// GetPatientAllergiesChronics аллергии и хронические заболевания пациента вместе с текущей версией анкеты здоровья
+func (s *DoctorService) GetPatientAllergiesChronics(ctx context.Context, token string, id int) ([]model.AllergiesChronics, model.Anamnesis, error) {
+	if err := s.authorizePatient(ctx, token, id); err != nil {
+		return nil, model.Anamnesis{}, err
+	}
 	notesResp, err := s.StorageClient.Client.GetPatientAllergiesChronics(ctx, &storagepb.GetByIdRequest{Id: int32(id)})
 	if err != nil {
-		return nil, fmt.Errorf("не удалось получить список аллергий и хронческих заболеваний: %w", err)
+		return nil, model.Anamnesis{}, fmt.Errorf("не удалось получить список аллергий и хронческих заболеваний: %w", err)
 	}
 	var notes []model.AllergiesChronics
 	for _, item := range notesResp.PatientAllergiesChronics {
@@ -25,14 +29,29 @@ func (s *DoctorService) GetPatientAllergiesChronics(ctx context.Context, id int)
 		}
 		notes = append(notes, note)
 	}
-	return notes, nil
+
+	anamnesisResp, err := s.StorageClient.Client.GetAnamnesis(ctx, &storagepb.GetByIdRequest{Id: int32(id)})
+	if err != nil {
+		return nil, model.Anamnesis{}, fmt.Errorf("не удалось получить анкету здоровья пациента: %w", err)
+	}
+	anamnesis, err := s.anamnesisFromPb(ctx, anamnesisResp.Anamnesis, make(map[int32]string))
+	if err != nil {
+		return nil, model.Anamnesis{}, err
+	}
+	return notes, anamnesis, nil
 }
 
-func (s *DoctorService) GetAppointmentByID(ctx context.Context, id int) (model.Appointment, error) {
+// GetAppointmentByID запись на приём с предупреждениями по карте пациента. Врачу доступны свои записи
+// и записи пациентов, которых он лечит или к картам которых у него есть допуск
+func (s *DoctorService) GetAppointmentByID(ctx context.Context, token string, id int) (model.Appointment, error) {
 	apptResp, err := s.StorageClient.Client.GetAppointmentByID(ctx, &storagepb.GetByIDRequest{Id: int32(id)})
 	if err != nil {
 		return model.Appointment{}, fmt.Errorf("не удалось получить запись: %w", err)
 	}
+	resource := access.Resource{PatientID: int(apptResp.Appointment.PatientId), DoctorID: int(apptResp.Appointment.DoctorId)}
+	if err := s.authorize(ctx, token, access.ReadRecord, resource); err != nil {
+		return model.Appointment{}, err
+	}
 
 	patientId := model.UserID(apptResp.Appointment.PatientId)
 	appt := model.Appointment{
@@ -52,12 +71,19 @@ func (s *DoctorService) GetAppointmentByID(ctx context.Context, id int) (model.A
 		UpdatedAt:          apptResp.Appointment.UpdatedAt.AsTime(),
 	}
 
+	appt.Alerts, err = s.clinicalAlerts(ctx, int(patientId), true, nil, nil)
+	if err != nil {
+		return model.Appointment{}, err
+	}
 	return appt, nil
 }
 
 // GetPatientVisits Получение визитов и диагнозов пациента
-func (s *DoctorService) GetPatientVisits(ctx context.Context, id int) ([]model.Visit, error) {
-	icdResp, err := s.StorageClient.Client.GetICDCodes(ctx, &storagepb.EmptyRequest{})
+func (s *DoctorService) GetPatientVisits(ctx context.Context, token string, id int) ([]model.Visit, error) {
+	if err := s.authorizePatient(ctx, token, id); err != nil {
+		return nil, err
+	}
+	icdResp, err := s.StorageClient.Client.GetICDCodes(ctx, &storagepb.GetICDCodesRequest{})
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить список МКБ-кодов: %w", err)
 	}
@@ -81,8 +107,10 @@ func (s *DoctorService) GetPatientVisits(ctx context.Context, id int) ([]model.V
 		var diagnoses []model.Diagnose
 		for _, d := range diagnosesResp.Diagnoses {
 			diagnose := model.Diagnose{
-				ICDCode: icdCodes[int(d.IcdCodeId)],
-				Notes:   d.Note,
+				ICDCode:  icdCodes[int(d.IcdCodeId)],
+				Notes:    d.Note,
+				Tooth:    int(d.Tooth),
+				Surfaces: d.Surfaces,
 			}
 			diagnoses = append(diagnoses, diagnose)
 		}
@@ -107,98 +135,118 @@ func (s *DoctorService) GetPatientVisits(ctx context.Context, id int) ([]model.V
 	}
 	return visits, nil
 }
+
+// AddConsultation завершает приём: storage одной транзакцией отмечает запись завершённой и сохраняет визит,
+// диагнозы, услуги, материалы, платёж и изменения зубной формулы, а выполненные позиции планов лечения
+// отмечает сделанными.
+// Возвращает ID визита, итоговую сумму и предупреждения по материалам и услугам, противоречащим карте пациента:
+// они не мешают сохранению, но показываются врачу
 func (s *DoctorService) AddConsultation(ctx context.Context, materials []model.VisitMaterial,
-	services []model.VisitService, diagnoses []model.VisitDiagnose, visit model.AddVisit, token string) error {
-	apptID := visit.AppointmentID
-	appointment, err := s.StorageClient.Client.GetAppointmentByID(ctx, &storagepb.GetByIDRequest{Id: int32(apptID)})
-	appointmentReq := appointment.Appointment
-	appointmentReq.Status = "completed"
-	fmt.Println(appointmentReq)
-	appointmentReq.UpdatedAt = timestamppb.New(time.Now())
-	_, err = s.StorageClient.Client.UpdateAppointment(ctx, &storagepb.UpdateAppointmentRequest{Appointment: appointmentReq})
+	services []model.VisitService, diagnoses []model.VisitDiagnose, visit model.AddVisit, token string) (int, int, []model.ClinicalAlert, error) {
+	for i := range diagnoses {
+		surfaces, err := validateToothRef(diagnoses[i].Tooth, diagnoses[i].Surfaces)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		diagnoses[i].Surfaces = surfaces
+	}
+	for i := range services {
+		surfaces, err := validateToothRef(services[i].Tooth, services[i].Surfaces)
+		if err != nil {
+			return 0, 0, nil, err
+		}
+		services[i].Surfaces = surfaces
+	}
+	if err := validateToothStates(visit.Chart); err != nil {
+		return 0, 0, nil, err
+	}
+	apptResp, err := s.StorageClient.Client.GetAppointmentByID(ctx, &storagepb.GetByIDRequest{Id: int32(visit.AppointmentID)})
+	if err != nil {
+		return 0, 0, nil, fmt.Errorf("не удалось получить запись: %w", err)
+	}
+	resource := access.Resource{PatientID: int(apptResp.Appointment.PatientId), DoctorID: int(apptResp.Appointment.DoctorId)}
+	if err := s.authorize(ctx, token, access.ReadRecord, resource); err != nil {
+		return 0, 0, nil, err
+	}
 
 	doctorID, err := s.AuthClient.Client.GetUserID(ctx, &authpb.GetUserIDRequest{Token: token})
 	if err != nil {
-		return fmt.Errorf("не удалось получить доктора, проводившего прием: %w", err)
+		return 0, 0, nil, fmt.Errorf("не удалось получить доктора, проводившего прием: %w", err)
 	}
 
-	resp, err := s.StorageClient.Client.AddPatientVisit(ctx, &storagepb.AddPatientVisitRequest{
-		AppointmentId: int32(visit.AppointmentID),
-		PatientId:     int32(visit.PatientID),
-		DoctorId:      doctorID.UserId,
-		Complaints:    visit.Complaints,
-		Treatment:     visit.Treatment,
-	})
+	materialIDs := make([]int, 0, len(materials))
+	for _, item := range materials {
+		materialIDs = append(materialIDs, item.MaterialID)
+	}
+	serviceIDs := make([]int, 0, len(services))
+	for _, item := range services {
+		serviceIDs = append(serviceIDs, item.ServiceID)
+	}
+	alerts, err := s.clinicalAlerts(ctx, visit.PatientID, false, materialIDs, serviceIDs)
 	if err != nil {
-		return fmt.Errorf("не удалось добавить визит пациента: %w", err)
+		return 0, 0, nil, err
+	}
+
+	// без ключа от клиента повтор не распознать, но второй визит по той же записи storage всё равно не создаст
+	idempotencyKey := visit.IdempotencyKey
+	if idempotencyKey == "" {
+		idempotencyKey = uuid.NewString()
 	}
-	visitID := resp.Id
 
-	var diagnosesReq []*storagepb.Diagnose
+	req := &storagepb.CompleteConsultationRequest{
+		IdempotencyKey: idempotencyKey,
+		AppointmentId:  int32(visit.AppointmentID),
+		PatientId:      int32(visit.PatientID),
+		DoctorId:       doctorID.UserId,
+		Complaints:     visit.Complaints,
+		Treatment:      visit.Treatment,
+	}
 	for _, item := range diagnoses {
-		req := &storagepb.Diagnose{
-			VisitId:   visitID,
+		req.Diagnoses = append(req.Diagnoses, &storagepb.Diagnose{
 			IcdCodeId: int32(item.ICDCodeID),
 			Note:      item.Note,
-		}
-		diagnosesReq = append(diagnosesReq, req)
+			Tooth:     int32(item.Tooth),
+			Surfaces:  item.Surfaces,
+		})
 	}
-	_, err = s.StorageClient.Client.AddPatientDiagnoses(ctx, &storagepb.AddPatientDiagnosesRequest{Diagnoses: diagnosesReq})
-	if err != nil {
-		return fmt.Errorf("не удалось добавить диагнозы: %w", err)
-	}
-
-	var servicesReq []*storagepb.AddVisitServices
 	for _, item := range services {
-		req := &storagepb.AddVisitServices{
-			VisitId:   visitID,
+		req.Services = append(req.Services, &storagepb.AddVisitServices{
 			ServiceId: int32(item.ServiceID),
 			Amount:    int32(item.Amount),
-		}
-		servicesReq = append(servicesReq, req)
-	}
-	_, err = s.StorageClient.Client.AddVisitServices(ctx, &storagepb.AddVisitServicesRequest{Services: servicesReq})
-	if err != nil {
-		return fmt.Errorf("не удалось добавить проведенные на приеме услуги: %w", err)
+			Tooth:     int32(item.Tooth),
+			Surfaces:  item.Surfaces,
+		})
 	}
-
-	var materialsReq []*storagepb.AddVisitMaterials
 	for _, item := range materials {
-		req := &storagepb.AddVisitMaterials{
-			VisitId:    visitID,
+		req.Materials = append(req.Materials, &storagepb.AddVisitMaterials{
 			MaterialId: int32(item.MaterialID),
 			Amount:     int32(item.Amount),
-		}
-		materialsReq = append(materialsReq, req)
+		})
 	}
-	_, err = s.StorageClient.Client.AddVisitMaterials(ctx, &storagepb.AddVisitMaterialsRequest{Materials: materialsReq})
-	if err != nil {
-		return fmt.Errorf("не удалось добавить затраченные на приеме материалы: %w", err)
+	for _, id := range visit.PlanItemIDs {
+		req.PlanItemIds = append(req.PlanItemIds, int32(id))
 	}
+	req.Chart = toothStatesToStorage(visit.Chart)
 
-	totalResp, err := s.StorageClient.Client.CalculateVisitTotal(ctx, &storagepb.CalculateVisitTotalRequest{
-		VisitId: visitID,
-	})
+	resp, err := s.StorageClient.Client.CompleteConsultation(ctx, req)
 	if err != nil {
-		return fmt.Errorf("не удалось рассчитать итоговую сумму приёма: %w", err)
+		return 0, 0, nil, fmt.Errorf("не удалось сохранить приём: %w", err)
 	}
-
-	_, err = s.StorageClient.Client.AddOrUpdateVisitPayment(ctx, &storagepb.AddOrUpdateVisitPaymentRequest{
-		Payment: &storagepb.VisitPayment{
-			VisitId: visitID,
-			Price:   totalResp.Total,
-			Status:  "unconfirmed",
-		},
-	})
-	if err != nil {
-		return fmt.Errorf("не удалось сохранить платёж по приёму: %w", err)
-	}
-	return nil
+	return int(resp.VisitId), int(resp.TotalPrice), alerts, nil
 }
 
-func (s *DoctorService) AddPatientAllergiesChronics(ctx context.Context, notes []model.AllergiesChronics) error {
+// AddPatientAllergiesChronics добавляет записи об аллергиях и хронических заболеваниях; записи могут относиться
+// к разным пациентам, доступ проверяется к карте каждого
+func (s *DoctorService) AddPatientAllergiesChronics(ctx context.Context, token string, notes []model.AllergiesChronics) error {
+	checked := make(map[int]bool)
 	var notesReq []*storagepb.PatientAllergiesChronics
 	for _, item := range notes {
+		if !checked[item.PatientID] {
+			if err := s.authorizePatient(ctx, token, item.PatientID); err != nil {
+				return err
+			}
+			checked[item.PatientID] = true
+		}
 		noteReq := &storagepb.PatientAllergiesChronics{
 			PatientId: int32(item.PatientID),
 			Type:      item.Type,
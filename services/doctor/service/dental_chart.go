@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/doctor/model"
+	authpb "github.com/DariaTarasek/diplom/services/doctor/proto/auth"
+	storagepb "github.com/DariaTarasek/diplom/services/doctor/proto/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// toothSurfaces поверхности зуба в порядке записи: медиальная, окклюзионная, режущий край, дистальная,
+// вестибулярная, оральная
+const toothSurfaces = "MOIDBL"
+
+// GetDentalChart текущая зубная формула пациента; зубов без записей в ней нет, они считаются здоровыми
+func (s *DoctorService) GetDentalChart(ctx context.Context, token string, patientID int) ([]model.ToothState, error) {
+	if err := s.authorizePatient(ctx, token, patientID); err != nil {
+		return nil, err
+	}
+	resp, err := s.StorageClient.Client.GetDentalChart(ctx, &storagepb.GetByIdRequest{Id: int32(patientID)})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить зубную формулу пациента: %w", err)
+	}
+	return s.toothStatesFromPb(ctx, resp.Teeth)
+}
+
+// UpdateDentalChart записывает состояние зубов вне приёма, например по результатам осмотра.
+// Прежние записи остаются в истории; возвращается обновлённая формула
+func (s *DoctorService) UpdateDentalChart(ctx context.Context, token string, patientID int, teeth []model.ToothState) ([]model.ToothState, error) {
+	if len(teeth) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "не указаны зубы для записи в зубную формулу")
+	}
+	if err := validateToothStates(teeth); err != nil {
+		return nil, err
+	}
+	if err := s.authorizePatient(ctx, token, patientID); err != nil {
+		return nil, err
+	}
+	doctorID, err := s.AuthClient.Client.GetUserID(ctx, &authpb.GetUserIDRequest{Token: token})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить врача, изменяющего зубную формулу: %w", err)
+	}
+	resp, err := s.StorageClient.Client.RecordToothStates(ctx, &storagepb.RecordToothStatesRequest{
+		PatientId: int32(patientID),
+		DoctorId:  doctorID.UserId,
+		Records:   toothStatesToStorage(teeth),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось изменить зубную формулу: %w", err)
+	}
+	return s.toothStatesFromPb(ctx, resp.Teeth)
+}
+
+// GetToothHistory история зубной формулы пациента, новые записи первыми; tooth = 0 — по всем зубам
+func (s *DoctorService) GetToothHistory(ctx context.Context, token string, patientID, tooth int) ([]model.ToothState, error) {
+	if tooth != 0 && !validTooth(tooth) {
+		return nil, status.Errorf(codes.InvalidArgument, "некорректный номер зуба %d: ожидается номер по FDI (11-48, 51-85)", tooth)
+	}
+	if err := s.authorizePatient(ctx, token, patientID); err != nil {
+		return nil, err
+	}
+	resp, err := s.StorageClient.Client.GetToothHistory(ctx, &storagepb.GetToothHistoryRequest{
+		PatientId: int32(patientID),
+		Tooth:     int32(tooth),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить историю зубной формулы: %w", err)
+	}
+	return s.toothStatesFromPb(ctx, resp.Teeth)
+}
+
+// validateToothStates проверяет записи зубной формулы и приводит поверхности к виду MOIDBL
+func validateToothStates(teeth []model.ToothState) error {
+	seen := make(map[int]struct{}, len(teeth))
+	for i := range teeth {
+		tooth := &teeth[i]
+		if tooth.Tooth == 0 {
+			return status.Error(codes.InvalidArgument, "в записи зубной формулы не указан зуб")
+		}
+		if _, ok := seen[tooth.Tooth]; ok {
+			return status.Errorf(codes.InvalidArgument, "зуб %d указан несколько раз", tooth.Tooth)
+		}
+		seen[tooth.Tooth] = struct{}{}
+		switch tooth.Status {
+		case "healthy", "caries", "filled", "crown", "implant", "missing":
+		default:
+			return status.Errorf(codes.InvalidArgument, "неизвестное состояние зуба %q", tooth.Status)
+		}
+		surfaces, err := validateToothRef(tooth.Tooth, tooth.Surfaces)
+		if err != nil {
+			return err
+		}
+		// поверхности имеют смысл только для кариеса и пломбы, остальные состояния относятся к зубу целиком
+		if surfaces != "" && tooth.Status != "caries" && tooth.Status != "filled" {
+			return status.Errorf(codes.InvalidArgument, "поверхности указываются только для кариеса и пломбы, зуб %d", tooth.Tooth)
+		}
+		tooth.Surfaces = surfaces
+		tooth.Note = strings.TrimSpace(tooth.Note)
+	}
+	return nil
+}
+
+// validateToothRef проверяет ссылку диагноза, услуги или записи формулы на зуб и возвращает поверхности
+// в порядке MOIDBL. tooth = 0 — запись не относится к зубу, тогда и поверхностей быть не должно
+func validateToothRef(tooth int, surfaces string) (string, error) {
+	if tooth == 0 {
+		if strings.TrimSpace(surfaces) != "" {
+			return "", status.Error(codes.InvalidArgument, "поверхности указаны без номера зуба")
+		}
+		return "", nil
+	}
+	if !validTooth(tooth) {
+		return "", status.Errorf(codes.InvalidArgument, "некорректный номер зуба %d: ожидается номер по FDI (11-48, 51-85)", tooth)
+	}
+	present := make(map[rune]bool, len(toothSurfaces))
+	for _, r := range strings.ToUpper(strings.TrimSpace(surfaces)) {
+		if !strings.ContainsRune(toothSurfaces, r) {
+			return "", status.Errorf(codes.InvalidArgument, "неизвестная поверхность зуба %q: допустимы буквы %s", r, toothSurfaces)
+		}
+		present[r] = true
+	}
+	var normalized strings.Builder
+	for _, r := range toothSurfaces {
+		if present[r] {
+			normalized.WriteRune(r)
+		}
+	}
+	return normalized.String(), nil
+}
+
+func toothStatesToStorage(teeth []model.ToothState) []*storagepb.ToothRecord {
+	result := make([]*storagepb.ToothRecord, 0, len(teeth))
+	for _, tooth := range teeth {
+		result = append(result, &storagepb.ToothRecord{
+			Tooth:    int32(tooth.Tooth),
+			Status:   tooth.Status,
+			Surfaces: tooth.Surfaces,
+			Note:     tooth.Note,
+		})
+	}
+	return result
+}
+
+// toothStatesFromPb записи зубной формулы из ответа storage с ФИО врачей
+func (s *DoctorService) toothStatesFromPb(ctx context.Context, records []*storagepb.ToothRecord) ([]model.ToothState, error) {
+	doctors := make(map[int32]string)
+	teeth := make([]model.ToothState, 0, len(records))
+	for _, item := range records {
+		tooth := model.ToothState{
+			Tooth:      int(item.Tooth),
+			Status:     item.Status,
+			Surfaces:   item.Surfaces,
+			Note:       item.Note,
+			VisitID:    int(item.VisitId),
+			DoctorID:   int(item.DoctorId),
+			RecordedAt: item.RecordedAt.AsTime().Format("02.01.2006"),
+		}
+		if item.DoctorId != 0 {
+			doctor, err := s.doctorName(ctx, item.DoctorId, doctors)
+			if err != nil {
+				return nil, err
+			}
+			tooth.Doctor = doctor
+		}
+		teeth = append(teeth, tooth)
+	}
+	return teeth, nil
+}
+
+// doctorName ФИО врача; cache — уже полученные ФИО по ID, чтобы не запрашивать врача для каждой записи
+func (s *DoctorService) doctorName(ctx context.Context, doctorID int32, cache map[int32]string) (string, error) {
+	if doctor, ok := cache[doctorID]; ok {
+		return doctor, nil
+	}
+	doctorResp, err := s.StorageClient.Client.GetDoctorByID(ctx, &storagepb.GetByIDRequest{Id: doctorID})
+	if err != nil {
+		return "", fmt.Errorf("не удалось получить врача: %w", err)
+	}
+	doctor := fmt.Sprintf("%s %s %s", doctorResp.Doctor.SecondName, doctorResp.Doctor.FirstName, doctorResp.Doctor.Surname)
+	cache[doctorID] = doctor
+	return doctor, nil
+}
@@ -1,15 +1,20 @@
 package service
 
-import "github.com/DariaTarasek/diplom/services/doctor/clients"
+import (
+	"github.com/DariaTarasek/diplom/services/doctor/clients"
+	"github.com/DariaTarasek/diplom/services/medrecord"
+)
 
 type DoctorService struct {
 	StorageClient *clients.StorageClient
 	AuthClient    *clients.AuthClient
+	Records       *medrecord.Renderer
 }
 
-func NewDoctorService(client *clients.StorageClient, auth *clients.AuthClient) *DoctorService {
+func NewDoctorService(client *clients.StorageClient, auth *clients.AuthClient, records *medrecord.Renderer) *DoctorService {
 	return &DoctorService{
 		StorageClient: client,
 		AuthClient:    auth,
+		Records:       records,
 	}
 }
@@ -2,13 +2,18 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/doctor/model"
 	storagepb "github.com/DariaTarasek/diplom/services/doctor/proto/storage"
+	"io"
 	"strconv"
 )
 
-func (s *DoctorService) GetDocumentsInfo(ctx context.Context, patientID int) ([]model.DocumentInfo, error) {
+func (s *DoctorService) GetDocumentsInfo(ctx context.Context, token string, patientID int) ([]model.DocumentInfo, error) {
+	if err := s.authorizePatient(ctx, token, patientID); err != nil {
+		return nil, err
+	}
 
 	uid := strconv.Itoa(patientID)
 	resp, err := s.StorageClient.Client.GetDocumentsByPatientID(ctx, &storagepb.GetDocumentsRequest{PatientId: uid})
@@ -29,17 +34,34 @@ func (s *DoctorService) GetDocumentsInfo(ctx context.Context, patientID int) ([]
 	return docsInfo, nil
 }
 
-func (s *DoctorService) DownloadDocument(ctx context.Context, documentID string) (model.DocumentFile, error) {
-
-	// Запрашиваем документ по ID через storage-сервис
-	resp, err := s.StorageClient.Client.DownloadDocument(ctx, &storagepb.DownloadDocumentRequest{DocumentId: documentID})
+// DownloadDocument передаёт документ из storage в send частями, начиная с байта offset. Доступ проверяется
+// к карте пациента, которому принадлежит документ
+func (s *DoctorService) DownloadDocument(ctx context.Context, token, documentID string, offset int64, send func(model.DocumentChunk) error) error {
+	meta, err := s.StorageClient.Client.GetDocumentMetadata(ctx, &storagepb.GetDocumentMetadataRequest{DocumentId: documentID})
 	if err != nil {
-		return model.DocumentFile{}, fmt.Errorf("не удалось скачать документ: %w", err)
+		return fmt.Errorf("не удалось получить документ: %w", err)
+	}
+	patientID, err := strconv.Atoi(meta.PatientId)
+	if err != nil {
+		return fmt.Errorf("некорректный идентификатор владельца документа: %w", err)
+	}
+	if err := s.authorizePatient(ctx, token, patientID); err != nil {
+		return err
+	}
+	stream, err := s.StorageClient.Client.DownloadDocument(ctx, &storagepb.DownloadDocumentRequest{DocumentId: documentID, Offset: offset})
+	if err != nil {
+		return fmt.Errorf("не удалось скачать документ: %w", err)
+	}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("не удалось скачать документ: %w", err)
+		}
+		if err := send(model.DocumentChunk{FileName: chunk.FileName, Size: chunk.Size, Data: chunk.Data}); err != nil {
+			return err
+		}
 	}
-
-	// Собираем и возвращаем структуру модели
-	return model.DocumentFile{
-		FileName:    resp.FileName,
-		FileContent: resp.FileContent,
-	}, nil
 }
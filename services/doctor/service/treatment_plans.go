@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/doctor/model"
+	authpb "github.com/DariaTarasek/diplom/services/doctor/proto/auth"
+	storagepb "github.com/DariaTarasek/diplom/services/doctor/proto/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	maxTreatmentPlanTitle    = 200
+	maxTreatmentPlanStages   = 20
+	maxTreatmentItemQuantity = 100
+)
+
+// GetPatientTreatmentPlans планы лечения пациента, новые первыми
+func (s *DoctorService) GetPatientTreatmentPlans(ctx context.Context, token string, patientID int) ([]model.TreatmentPlan, error) {
+	if err := s.authorizePatient(ctx, token, patientID); err != nil {
+		return nil, err
+	}
+	resp, err := s.StorageClient.Client.GetPatientTreatmentPlans(ctx, &storagepb.GetByIdRequest{Id: int32(patientID)})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить планы лечения пациента: %w", err)
+	}
+	doctors := make(map[int32]string)
+	plans := make([]model.TreatmentPlan, 0, len(resp.Plans))
+	for _, item := range resp.Plans {
+		plan, err := s.treatmentPlanFromPb(ctx, item, doctors)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// CreateTreatmentPlan составляет план лечения от имени врача; позиции оцениваются по текущему прейскуранту,
+// пациент видит план и принимает его в личном кабинете
+func (s *DoctorService) CreateTreatmentPlan(ctx context.Context, token string, patientID int, title string, items []model.TreatmentPlanItem) (model.TreatmentPlan, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || len([]rune(title)) > maxTreatmentPlanTitle {
+		return model.TreatmentPlan{}, status.Errorf(codes.InvalidArgument, "название плана лечения должно содержать от 1 до %d символов", maxTreatmentPlanTitle)
+	}
+	if err := validateTreatmentPlanItems(items); err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	if err := s.authorizePatient(ctx, token, patientID); err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	doctorID, err := s.AuthClient.Client.GetUserID(ctx, &authpb.GetUserIDRequest{Token: token})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось получить врача, составляющего план: %w", err)
+	}
+	resp, err := s.StorageClient.Client.CreateTreatmentPlan(ctx, &storagepb.CreateTreatmentPlanRequest{
+		PatientId: int32(patientID),
+		DoctorId:  doctorID.UserId,
+		Title:     title,
+		Items:     treatmentPlanItemsToStorage(items),
+	})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось составить план лечения: %w", err)
+	}
+	return s.treatmentPlanFromPb(ctx, resp.Plan, make(map[int32]string))
+}
+
+// AddTreatmentPlanItems добавляет позиции в план, который пациент ещё не принял
+func (s *DoctorService) AddTreatmentPlanItems(ctx context.Context, token string, planID int, items []model.TreatmentPlanItem) (model.TreatmentPlan, error) {
+	if len(items) == 0 {
+		return model.TreatmentPlan{}, status.Error(codes.InvalidArgument, "не указаны позиции плана лечения")
+	}
+	if err := validateTreatmentPlanItems(items); err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	plan, err := s.StorageClient.Client.GetTreatmentPlan(ctx, &storagepb.GetByIdRequest{Id: int32(planID)})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось получить план лечения: %w", err)
+	}
+	if err := s.authorizePatient(ctx, token, int(plan.Plan.PatientId)); err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	resp, err := s.StorageClient.Client.AddTreatmentPlanItems(ctx, &storagepb.AddTreatmentPlanItemsRequest{
+		PlanId: int32(planID),
+		Items:  treatmentPlanItemsToStorage(items),
+	})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось добавить позиции плана лечения: %w", err)
+	}
+	return s.treatmentPlanFromPb(ctx, resp.Plan, make(map[int32]string))
+}
+
+// UpdateTreatmentPlanItemStatus меняет статус позиции плана: planned, in_progress, done или cancelled
+func (s *DoctorService) UpdateTreatmentPlanItemStatus(ctx context.Context, token string, itemID int, itemStatus string) (model.TreatmentPlan, error) {
+	switch itemStatus {
+	case "planned", "in_progress", "done", "cancelled":
+	default:
+		return model.TreatmentPlan{}, status.Errorf(codes.InvalidArgument, "неизвестный статус позиции плана лечения %q", itemStatus)
+	}
+	plan, err := s.StorageClient.Client.GetTreatmentPlanByItemID(ctx, &storagepb.GetByIdRequest{Id: int32(itemID)})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось получить план лечения позиции: %w", err)
+	}
+	if err := s.authorizePatient(ctx, token, int(plan.Plan.PatientId)); err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	resp, err := s.StorageClient.Client.UpdateTreatmentPlanItemStatus(ctx, &storagepb.UpdateTreatmentPlanItemStatusRequest{
+		ItemId: int32(itemID),
+		Status: itemStatus,
+	})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось изменить статус позиции плана лечения: %w", err)
+	}
+	return s.treatmentPlanFromPb(ctx, resp.Plan, make(map[int32]string))
+}
+
+// CancelTreatmentPlan отменяет план вместе с невыполненными позициями
+func (s *DoctorService) CancelTreatmentPlan(ctx context.Context, token string, planID int) (model.TreatmentPlan, error) {
+	plan, err := s.StorageClient.Client.GetTreatmentPlan(ctx, &storagepb.GetByIdRequest{Id: int32(planID)})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось получить план лечения: %w", err)
+	}
+	if err := s.authorizePatient(ctx, token, int(plan.Plan.PatientId)); err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	resp, err := s.StorageClient.Client.CancelTreatmentPlan(ctx, &storagepb.GetByIdRequest{Id: int32(planID)})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось отменить план лечения: %w", err)
+	}
+	return s.treatmentPlanFromPb(ctx, resp.Plan, make(map[int32]string))
+}
+
+// validateTreatmentPlanItems проверяет позиции плана; не указанные этап и количество считаются равными 1
+func validateTreatmentPlanItems(items []model.TreatmentPlanItem) error {
+	for i := range items {
+		item := &items[i]
+		if item.Stage == 0 {
+			item.Stage = 1
+		}
+		if item.Quantity == 0 {
+			item.Quantity = 1
+		}
+		if item.ServiceID <= 0 {
+			return status.Error(codes.InvalidArgument, "в позиции плана лечения не указана услуга")
+		}
+		if item.Stage < 1 || item.Stage > maxTreatmentPlanStages {
+			return status.Errorf(codes.InvalidArgument, "номер этапа должен быть от 1 до %d", maxTreatmentPlanStages)
+		}
+		if item.Quantity < 1 || item.Quantity > maxTreatmentItemQuantity {
+			return status.Errorf(codes.InvalidArgument, "количество должно быть от 1 до %d", maxTreatmentItemQuantity)
+		}
+		if item.Tooth != 0 && !validTooth(item.Tooth) {
+			return status.Errorf(codes.InvalidArgument, "некорректный номер зуба %d: ожидается номер по FDI (11-48, 51-85)", item.Tooth)
+		}
+	}
+	return nil
+}
+
+// validTooth номер зуба по FDI: квадранты 1-4 постоянных зубов по 8 зубов, квадранты 5-8 молочных по 5
+func validTooth(tooth int) bool {
+	quadrant, position := tooth/10, tooth%10
+	switch {
+	case quadrant >= 1 && quadrant <= 4:
+		return position >= 1 && position <= 8
+	case quadrant >= 5 && quadrant <= 8:
+		return position >= 1 && position <= 5
+	}
+	return false
+}
+
+func treatmentPlanItemsToStorage(items []model.TreatmentPlanItem) []*storagepb.NewTreatmentPlanItem {
+	result := make([]*storagepb.NewTreatmentPlanItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, &storagepb.NewTreatmentPlanItem{
+			Stage:     int32(item.Stage),
+			Tooth:     int32(item.Tooth),
+			ServiceId: int32(item.ServiceID),
+			Quantity:  int32(item.Quantity),
+			Note:      strings.TrimSpace(item.Note),
+		})
+	}
+	return result
+}
+
+// treatmentPlanFromPb план из ответа storage с ФИО врача; doctors — уже полученные ФИО по ID врача
+func (s *DoctorService) treatmentPlanFromPb(ctx context.Context, item *storagepb.TreatmentPlan, doctors map[int32]string) (model.TreatmentPlan, error) {
+	plan := model.TreatmentPlan{
+		ID:             int(item.Id),
+		PatientID:      int(item.PatientId),
+		DoctorID:       int(item.DoctorId),
+		Title:          item.Title,
+		Status:         item.Status,
+		CreatedAt:      item.CreatedAt.AsTime().Format("02.01.2006"),
+		EstimatedTotal: int(item.EstimatedTotal),
+		RemainingCost:  int(item.RemainingCost),
+	}
+	if item.AcceptedAt != nil {
+		plan.AcceptedAt = item.AcceptedAt.AsTime().Format("02.01.2006")
+	}
+	if item.DoctorId != 0 {
+		doctor, err := s.doctorName(ctx, item.DoctorId, doctors)
+		if err != nil {
+			return model.TreatmentPlan{}, fmt.Errorf("не удалось получить врача, составившего план: %w", err)
+		}
+		plan.Doctor = doctor
+	}
+	for _, i := range item.Items {
+		planItem := model.TreatmentPlanItem{
+			ID:             int(i.Id),
+			Stage:          int(i.Stage),
+			Tooth:          int(i.Tooth),
+			ServiceID:      int(i.ServiceId),
+			ServiceName:    i.ServiceName,
+			Quantity:       int(i.Quantity),
+			EstimatedPrice: int(i.EstimatedPrice),
+			Status:         i.Status,
+			VisitID:        int(i.VisitId),
+			Note:           i.Note,
+		}
+		if i.CompletedAt != nil {
+			planItem.CompletedAt = i.CompletedAt.AsTime().Format("02.01.2006")
+		}
+		plan.Items = append(plan.Items, planItem)
+	}
+	return plan, nil
+}
@@ -0,0 +1,447 @@
+package medrecord
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Кодовые системы FHIR, на которые ссылается пакет
+const (
+	systemICD10      = "http://hl7.org/fhir/sid/icd-10"
+	systemTooth      = "http://terminology.hl7.org/CodeSystem/ex-tooth" // номера зубов по FDI (ISO 3950)
+	systemSurface    = "http://terminology.hl7.org/CodeSystem/FDI-surface"
+	systemClinical   = "http://terminology.hl7.org/CodeSystem/allergyintolerance-clinical"
+	systemCondition  = "http://terminology.hl7.org/CodeSystem/condition-clinical"
+	systemCategory   = "http://terminology.hl7.org/CodeSystem/condition-category"
+	systemActCode    = "http://terminology.hl7.org/CodeSystem/v3-ActCode"
+	extensionBaseURL = "urn:diplom:fhir:"
+)
+
+// fhirSurfaces коды поверхностей FDI-surface для букв зубной формулы: вестибулярная поверхность
+// в FDI-surface обозначается V
+var fhirSurfaces = map[rune]string{'M': "M", 'O': "O", 'I': "I", 'D': "D", 'B': "V", 'L': "L"}
+
+type (
+	bundle struct {
+		ResourceType string        `json:"resourceType"`
+		ID           string        `json:"id"`
+		Type         string        `json:"type"`
+		Timestamp    string        `json:"timestamp"`
+		Entry        []bundleEntry `json:"entry"`
+	}
+	bundleEntry struct {
+		FullURL  string `json:"fullUrl"`
+		Resource any    `json:"resource"`
+	}
+	reference struct {
+		Reference string `json:"reference,omitempty"`
+		Display   string `json:"display,omitempty"`
+	}
+	coding struct {
+		System  string `json:"system,omitempty"`
+		Code    string `json:"code"`
+		Display string `json:"display,omitempty"`
+	}
+	concept struct {
+		Coding []coding `json:"coding,omitempty"`
+		Text   string   `json:"text,omitempty"`
+	}
+	annotation struct {
+		Text string `json:"text"`
+	}
+	extension struct {
+		URL          string      `json:"url"`
+		ValueString  string      `json:"valueString,omitempty"`
+		ValueInteger int         `json:"valueInteger,omitempty"`
+		Extension    []extension `json:"extension,omitempty"`
+	}
+
+	fhirPatient struct {
+		ResourceType string         `json:"resourceType"`
+		ID           string         `json:"id"`
+		Name         []humanName    `json:"name"`
+		Gender       string         `json:"gender"`
+		BirthDate    string         `json:"birthDate,omitempty"`
+		Telecom      []contactPoint `json:"telecom,omitempty"`
+	}
+	humanName struct {
+		Text   string   `json:"text"`
+		Family string   `json:"family"`
+		Given  []string `json:"given"`
+	}
+	contactPoint struct {
+		System string `json:"system"`
+		Value  string `json:"value"`
+	}
+
+	allergyIntolerance struct {
+		ResourceType   string            `json:"resourceType"`
+		ID             string            `json:"id"`
+		ClinicalStatus concept           `json:"clinicalStatus"`
+		Criticality    string            `json:"criticality,omitempty"`
+		Code           concept           `json:"code"`
+		Patient        reference         `json:"patient"`
+		RecordedDate   string            `json:"recordedDate,omitempty"`
+		Reaction       []allergyReaction `json:"reaction,omitempty"`
+	}
+	allergyReaction struct {
+		Manifestation []concept `json:"manifestation"`
+		Severity      string    `json:"severity,omitempty"`
+	}
+
+	condition struct {
+		ResourceType   string       `json:"resourceType"`
+		ID             string       `json:"id"`
+		ClinicalStatus concept      `json:"clinicalStatus"`
+		Category       []concept    `json:"category"`
+		Code           concept      `json:"code"`
+		BodySite       []concept    `json:"bodySite,omitempty"`
+		Subject        reference    `json:"subject"`
+		Encounter      *reference   `json:"encounter,omitempty"`
+		RecordedDate   string       `json:"recordedDate,omitempty"`
+		Note           []annotation `json:"note,omitempty"`
+	}
+
+	questionnaireResponse struct {
+		ResourceType string              `json:"resourceType"`
+		ID           string              `json:"id"`
+		Status       string              `json:"status"`
+		Subject      reference           `json:"subject"`
+		Authored     string              `json:"authored"`
+		Item         []questionnaireItem `json:"item"`
+	}
+	questionnaireItem struct {
+		LinkID string                `json:"linkId"`
+		Text   string                `json:"text"`
+		Answer []questionnaireAnswer `json:"answer,omitempty"`
+		Item   []questionnaireItem   `json:"item,omitempty"`
+	}
+	questionnaireAnswer struct {
+		ValueBoolean *bool  `json:"valueBoolean,omitempty"`
+		ValueString  string `json:"valueString,omitempty"`
+	}
+
+	encounter struct {
+		ResourceType string        `json:"resourceType"`
+		ID           string        `json:"id"`
+		Status       string        `json:"status"`
+		Class        coding        `json:"class"`
+		Subject      reference     `json:"subject"`
+		Participant  []participant `json:"participant,omitempty"`
+		Period       period        `json:"period"`
+		ReasonCode   []concept     `json:"reasonCode,omitempty"`
+		Extension    []extension   `json:"extension,omitempty"`
+	}
+	participant struct {
+		Individual reference `json:"individual"`
+	}
+	period struct {
+		Start string `json:"start"`
+	}
+
+	procedure struct {
+		ResourceType      string      `json:"resourceType"`
+		ID                string      `json:"id"`
+		Status            string      `json:"status"`
+		Code              concept     `json:"code"`
+		Subject           reference   `json:"subject"`
+		Encounter         reference   `json:"encounter"`
+		PerformedDateTime string      `json:"performedDateTime"`
+		BodySite          []concept   `json:"bodySite,omitempty"`
+		Extension         []extension `json:"extension,omitempty"`
+	}
+
+	documentReference struct {
+		ResourceType string       `json:"resourceType"`
+		ID           string       `json:"id"`
+		Status       string       `json:"status"`
+		Type         *concept     `json:"type,omitempty"`
+		Subject      reference    `json:"subject"`
+		Date         string       `json:"date"`
+		Description  string       `json:"description,omitempty"`
+		Content      []docContent `json:"content"`
+	}
+	docContent struct {
+		Attachment attachment `json:"attachment"`
+	}
+	attachment struct {
+		Title    string `json:"title"`
+		Creation string `json:"creation,omitempty"`
+	}
+)
+
+// Bundle медицинская карта пакетом FHIR R4 типа collection: Patient, AllergyIntolerance, Condition
+// (хронические заболевания и диагнозы приёмов), QuestionnaireResponse с анкетой здоровья, Encounter на
+// каждый приём, Procedure на каждую услугу и DocumentReference на каждый документ. Материалы приёма
+// записываются расширением Encounter, у FHIR для них нет подходящего ресурса
+func Bundle(rec Record) ([]byte, error) {
+	b := &bundleBuilder{patientRef: "Patient/" + strconv.Itoa(rec.Patient.ID)}
+	b.patient(rec.Patient)
+	for i, note := range rec.Notes {
+		b.note(i+1, note)
+	}
+	if rec.Anamnesis != nil {
+		b.anamnesis(*rec.Anamnesis)
+	}
+	for _, visit := range rec.Visits {
+		b.visit(visit)
+	}
+	for _, doc := range rec.Documents {
+		b.document(doc)
+	}
+
+	content, err := json.MarshalIndent(bundle{
+		ResourceType: "Bundle",
+		ID:           fmt.Sprintf("medical-record-%d-%d", rec.Patient.ID, rec.GeneratedAt.Unix()),
+		Type:         "collection",
+		Timestamp:    rec.GeneratedAt.Format(time.RFC3339),
+		Entry:        b.entries,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сформировать пакет медицинской карты: %w", err)
+	}
+	return content, nil
+}
+
+// bundleBuilder собирает записи пакета; ссылки между ресурсами — относительные, вида Encounter/15
+type bundleBuilder struct {
+	patientRef string
+	entries    []bundleEntry
+}
+
+func (b *bundleBuilder) add(resourceType, id string, resource any) {
+	b.entries = append(b.entries, bundleEntry{FullURL: resourceType + "/" + id, Resource: resource})
+}
+
+func (b *bundleBuilder) patient(p Patient) {
+	given := []string{p.FirstName}
+	if p.Surname != "" {
+		given = append(given, p.Surname)
+	}
+	resource := fhirPatient{
+		ResourceType: "Patient",
+		ID:           strconv.Itoa(p.ID),
+		Name:         []humanName{{Text: p.FullName(), Family: p.SecondName, Given: given}},
+		Gender:       fhirGender(p.Gender),
+	}
+	if !p.BirthDate.IsZero() {
+		resource.BirthDate = p.BirthDate.Format("2006-01-02")
+	}
+	if p.Phone != "" {
+		resource.Telecom = append(resource.Telecom, contactPoint{System: "phone", Value: p.Phone})
+	}
+	if p.Email != "" {
+		resource.Telecom = append(resource.Telecom, contactPoint{System: "email", Value: p.Email})
+	}
+	b.add("Patient", resource.ID, resource)
+}
+
+// note запись врача: аллергия становится AllergyIntolerance, хроническое заболевание — Condition
+func (b *bundleBuilder) note(n int, note Note) {
+	if note.Type == "allergy" {
+		id := "note-" + strconv.Itoa(n)
+		b.add("AllergyIntolerance", id, allergyIntolerance{
+			ResourceType:   "AllergyIntolerance",
+			ID:             id,
+			ClinicalStatus: concept{Coding: []coding{{System: systemClinical, Code: "active"}}},
+			Code:           concept{Text: note.Title},
+			Patient:        reference{Reference: b.patientRef},
+		})
+		return
+	}
+	id := "note-" + strconv.Itoa(n)
+	b.add("Condition", id, condition{
+		ResourceType:   "Condition",
+		ID:             id,
+		ClinicalStatus: concept{Coding: []coding{{System: systemCondition, Code: "active"}}},
+		Category:       []concept{{Coding: []coding{{System: systemCategory, Code: "problem-list-item"}}}},
+		Code:           concept{Text: note.Title},
+		Subject:        reference{Reference: b.patientRef},
+	})
+}
+
+// anamnesis анкета здоровья: ответы — QuestionnaireResponse, аллергии из анкеты — AllergyIntolerance
+func (b *bundleBuilder) anamnesis(a Anamnesis) {
+	authored := a.ChangedAt.Format(time.RFC3339)
+	for i, allergy := range a.Allergies {
+		id := fmt.Sprintf("anamnesis-%d-allergy-%d", a.Version, i+1)
+		resource := allergyIntolerance{
+			ResourceType:   "AllergyIntolerance",
+			ID:             id,
+			ClinicalStatus: concept{Coding: []coding{{System: systemClinical, Code: "active"}}},
+			Criticality:    "low",
+			Code:           concept{Text: allergy.Allergen},
+			Patient:        reference{Reference: b.patientRef},
+			RecordedDate:   authored,
+		}
+		if allergy.Severity == "severe" {
+			resource.Criticality = "high"
+		}
+		reaction := allergyReaction{Severity: allergy.Severity}
+		if allergy.Reaction != "" {
+			reaction.Manifestation = []concept{{Text: allergy.Reaction}}
+		} else {
+			reaction.Manifestation = []concept{{Text: "реакция не описана"}}
+		}
+		resource.Reaction = []allergyReaction{reaction}
+		b.add("AllergyIntolerance", id, resource)
+	}
+
+	var items []questionnaireItem
+	for _, flag := range a.flags() {
+		value := flag.value
+		items = append(items, questionnaireItem{
+			LinkID: flag.code,
+			Text:   flag.title,
+			Answer: []questionnaireAnswer{{ValueBoolean: &value}},
+		})
+	}
+	if a.Medications != "" {
+		items = append(items, questionnaireItem{
+			LinkID: "medications",
+			Text:   "Принимаемые лекарства",
+			Answer: []questionnaireAnswer{{ValueString: a.Medications}},
+		})
+	}
+	if a.OtherConditions != "" {
+		items = append(items, questionnaireItem{
+			LinkID: "other_conditions",
+			Text:   "Другие заболевания",
+			Answer: []questionnaireAnswer{{ValueString: a.OtherConditions}},
+		})
+	}
+	id := "anamnesis-" + strconv.Itoa(a.Version)
+	b.add("QuestionnaireResponse", id, questionnaireResponse{
+		ResourceType: "QuestionnaireResponse",
+		ID:           id,
+		Status:       "completed",
+		Subject:      reference{Reference: b.patientRef},
+		Authored:     authored,
+		Item:         items,
+	})
+}
+
+// visit приём: Encounter, его диагнозы и услуги
+func (b *bundleBuilder) visit(v Visit) {
+	id := strconv.Itoa(v.ID)
+	encounterRef := reference{Reference: "Encounter/" + id}
+	date := v.Date.Format(time.RFC3339)
+
+	resource := encounter{
+		ResourceType: "Encounter",
+		ID:           id,
+		Status:       "finished",
+		Class:        coding{System: systemActCode, Code: "AMB", Display: "ambulatory"},
+		Subject:      reference{Reference: b.patientRef},
+		Period:       period{Start: date},
+	}
+	if v.Doctor != "" {
+		resource.Participant = []participant{{Individual: reference{
+			Reference: "Practitioner/" + strconv.Itoa(v.DoctorID),
+			Display:   v.Doctor,
+		}}}
+	}
+	if v.Complaints != "" {
+		resource.ReasonCode = []concept{{Text: v.Complaints}}
+	}
+	if v.TreatmentPlan != "" {
+		resource.Extension = append(resource.Extension, extension{URL: extensionBaseURL + "treatment-plan", ValueString: v.TreatmentPlan})
+	}
+	for _, m := range v.Materials {
+		resource.Extension = append(resource.Extension, extension{
+			URL: extensionBaseURL + "used-material",
+			Extension: []extension{
+				{URL: "material", ValueString: m.Name},
+				{URL: "quantity", ValueInteger: m.Quantity},
+			},
+		})
+	}
+	b.add("Encounter", id, resource)
+
+	for i, d := range v.Diagnoses {
+		diagnosisID := fmt.Sprintf("visit-%d-diagnosis-%d", v.ID, i+1)
+		code := concept{Text: d.Name}
+		if d.Code != "" {
+			code.Coding = []coding{{System: systemICD10, Code: d.Code, Display: d.Name}}
+		}
+		resource := condition{
+			ResourceType:   "Condition",
+			ID:             diagnosisID,
+			ClinicalStatus: concept{Coding: []coding{{System: systemCondition, Code: "active"}}},
+			Category:       []concept{{Coding: []coding{{System: systemCategory, Code: "encounter-diagnosis"}}}},
+			Code:           code,
+			BodySite:       toothSite(d.Tooth, d.Surfaces),
+			Subject:        reference{Reference: b.patientRef},
+			Encounter:      &encounterRef,
+			RecordedDate:   date,
+		}
+		if d.Note != "" {
+			resource.Note = []annotation{{Text: d.Note}}
+		}
+		b.add("Condition", diagnosisID, resource)
+	}
+
+	for i, s := range v.Services {
+		procedureID := fmt.Sprintf("visit-%d-service-%d", v.ID, i+1)
+		resource := procedure{
+			ResourceType:      "Procedure",
+			ID:                procedureID,
+			Status:            "completed",
+			Code:              concept{Coding: []coding{{System: extensionBaseURL + "service", Code: strconv.Itoa(s.ID), Display: s.Name}}, Text: s.Name},
+			Subject:           reference{Reference: b.patientRef},
+			Encounter:         encounterRef,
+			PerformedDateTime: date,
+			BodySite:          toothSite(s.Tooth, s.Surfaces),
+		}
+		if s.Quantity > 1 {
+			resource.Extension = []extension{{URL: extensionBaseURL + "quantity", ValueInteger: s.Quantity}}
+		}
+		b.add("Procedure", procedureID, resource)
+	}
+}
+
+func (b *bundleBuilder) document(d Document) {
+	resource := documentReference{
+		ResourceType: "DocumentReference",
+		ID:           d.ID,
+		Status:       "current",
+		Subject:      reference{Reference: b.patientRef},
+		Date:         d.CreatedAt.Format(time.RFC3339),
+		Description:  d.Description,
+		Content:      []docContent{{Attachment: attachment{Title: d.FileName}}},
+	}
+	if d.Modality != "" {
+		resource.Type = &concept{Text: d.Modality}
+	}
+	if d.StudyDate != nil {
+		resource.Content[0].Attachment.Creation = d.StudyDate.Format("2006-01-02")
+	}
+	b.add("DocumentReference", d.ID, resource)
+}
+
+// toothSite зуб по FDI и его поверхности; пусто, если запись не относится к зубу
+func toothSite(tooth int, surfaces string) []concept {
+	if tooth == 0 {
+		return nil
+	}
+	site := concept{Coding: []coding{{System: systemTooth, Code: strconv.Itoa(tooth)}}}
+	for _, r := range strings.ToUpper(surfaces) {
+		if code, ok := fhirSurfaces[r]; ok {
+			site.Coding = append(site.Coding, coding{System: systemSurface, Code: code})
+		}
+	}
+	return []concept{site}
+}
+
+func fhirGender(gender string) string {
+	switch gender {
+	case "м":
+		return "male"
+	case "ж":
+		return "female"
+	}
+	return "unknown"
+}
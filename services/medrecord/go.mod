@@ -0,0 +1,5 @@
+module github.com/DariaTarasek/diplom/services/medrecord
+
+go 1.23.2
+
+require github.com/jung-kurt/gofpdf v1.16.2
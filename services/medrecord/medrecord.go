@@ -0,0 +1,197 @@
+// Package medrecord выгрузка медицинской карты пациента: печатная форма в PDF и структурированный
+// JSON-пакет в духе FHIR R4 для передачи в другие медицинские системы. Пакет не зависит от protobuf
+// сервисов: врач и пациент заполняют Record из ответа storage и получают готовый файл
+package medrecord
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Форматы выгрузки
+const (
+	FormatPDF  = "pdf"
+	FormatJSON = "json"
+)
+
+// Clinic сведения о клинике в шапке выгрузки
+type Clinic struct {
+	Name    string
+	Address string
+	Phone   string
+}
+
+// ClinicFromEnv сведения из переменных CLINIC_NAME, CLINIC_ADDRESS и CLINIC_PHONE
+func ClinicFromEnv() Clinic {
+	clinic := Clinic{
+		Name:    os.Getenv("CLINIC_NAME"),
+		Address: os.Getenv("CLINIC_ADDRESS"),
+		Phone:   os.Getenv("CLINIC_PHONE"),
+	}
+	if clinic.Name == "" {
+		clinic.Name = "Стоматологическая клиника"
+	}
+	return clinic
+}
+
+// Record медицинская карта пациента на момент выгрузки. Приёмы — от старых к новым
+type Record struct {
+	Patient   Patient
+	Notes     []Note
+	Anamnesis *Anamnesis // nil — пациент анкету не заполнял
+	Visits    []Visit
+	Documents []Document
+	// GeneratedAt время формирования выгрузки; печатается в документе и попадает в пакет
+	GeneratedAt time.Time
+}
+
+// Patient данные пациента; Gender — «м» или «ж», как в карточке
+type Patient struct {
+	ID         int
+	FirstName  string
+	SecondName string
+	Surname    string
+	BirthDate  time.Time
+	Gender     string
+	Phone      string
+	Email      string
+}
+
+// FullName фамилия, имя и отчество
+func (p Patient) FullName() string {
+	name := p.SecondName + " " + p.FirstName
+	if p.Surname != "" {
+		name += " " + p.Surname
+	}
+	return name
+}
+
+// Note запись врача об аллергии (Type = allergy) или хроническом заболевании (Type = chronic)
+type Note struct {
+	Type  string
+	Title string
+}
+
+// Anamnesis текущая версия анкеты здоровья
+type Anamnesis struct {
+	Version            int
+	Medications        string
+	BloodThinners      bool
+	CardiacDisease     bool
+	Hypertension       bool
+	Diabetes           bool
+	BleedingDisorder   bool
+	InfectiousDisease  bool
+	AnesthesiaReaction bool
+	Pregnant           bool
+	Breastfeeding      bool
+	Smoker             bool
+	OtherConditions    string
+	Allergies          []Allergy
+	ChangedAt          time.Time
+}
+
+// Allergy аллергия из анкеты здоровья; Severity — mild, moderate или severe
+type Allergy struct {
+	Allergen string
+	Severity string
+	Reaction string
+}
+
+// Visit проведённый приём
+type Visit struct {
+	ID            int
+	Date          time.Time
+	DoctorID      int
+	Doctor        string
+	Complaints    string
+	TreatmentPlan string
+	Diagnoses     []Diagnosis
+	Services      []Service
+	Materials     []Material
+}
+
+// Diagnosis диагноз приёма по МКБ-10; Tooth — номер зуба по FDI, 0 — диагноз не относится к зубу
+type Diagnosis struct {
+	Code     string
+	Name     string
+	Note     string
+	Tooth    int
+	Surfaces string
+}
+
+// Service оказанная на приёме услуга; Tooth — номер зуба по FDI или 0
+type Service struct {
+	ID       int
+	Name     string
+	Quantity int
+	Tooth    int
+	Surfaces string
+}
+
+// Material израсходованный на приёме материал
+type Material struct {
+	ID       int
+	Name     string
+	Quantity int
+}
+
+// Document документ из карты пациента: снимок, заключение или выписка. Сам файл в выгрузку не входит
+type Document struct {
+	ID          string
+	FileName    string
+	Modality    string
+	StudyDate   *time.Time
+	Description string
+	CreatedAt   time.Time
+}
+
+// anamnesisFlag пункт анкеты с ответом «да/нет»
+type anamnesisFlag struct {
+	code  string
+	title string
+	value bool
+}
+
+// flags пункты анкеты в порядке вопросов
+func (a Anamnesis) flags() []anamnesisFlag {
+	return []anamnesisFlag{
+		{"blood_thinners", "Принимает препараты, снижающие свёртываемость крови", a.BloodThinners},
+		{"cardiac_disease", "Заболевания сердца", a.CardiacDisease},
+		{"hypertension", "Гипертония", a.Hypertension},
+		{"diabetes", "Сахарный диабет", a.Diabetes},
+		{"bleeding_disorder", "Нарушение свёртываемости крови", a.BleedingDisorder},
+		{"infectious_disease", "Инфекционные заболевания", a.InfectiousDisease},
+		{"anesthesia_reaction", "Реакция на анестезию", a.AnesthesiaReaction},
+		{"pregnant", "Беременность", a.Pregnant},
+		{"breastfeeding", "Грудное вскармливание", a.Breastfeeding},
+		{"smoker", "Курение", a.Smoker},
+	}
+}
+
+// FileName имя файла выгрузки для пациента в формате format
+func FileName(patientID int, generated time.Time, format string) string {
+	return fmt.Sprintf("medical-record-%d-%s.%s", patientID, generated.Format("20060102"), format)
+}
+
+// ContentType MIME-тип файла выгрузки
+func ContentType(format string) string {
+	if format == FormatPDF {
+		return "application/pdf"
+	}
+	return "application/fhir+json"
+}
+
+// severityName тяжесть аллергии по-русски
+func severityName(severity string) string {
+	switch severity {
+	case "mild":
+		return "лёгкая"
+	case "moderate":
+		return "средняя"
+	case "severe":
+		return "тяжёлая"
+	}
+	return severity
+}
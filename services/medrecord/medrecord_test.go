@@ -0,0 +1,171 @@
+package medrecord
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var pageCount = regexp.MustCompile(`/Count (\d+)`)
+
+func testRecord(visits int) Record {
+	visitDate := time.Date(2026, 2, 3, 9, 30, 0, 0, time.UTC)
+	studyDate := time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)
+	rec := Record{
+		Patient: Patient{
+			ID:         42,
+			FirstName:  "Мария",
+			SecondName: "Иванова",
+			Surname:    "Петровна",
+			BirthDate:  time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+			Gender:     "ж",
+			Phone:      "79161234567",
+		},
+		Notes: []Note{
+			{Type: "allergy", Title: "Пенициллин"},
+			{Type: "chronic", Title: "Бронхиальная астма"},
+		},
+		Anamnesis: &Anamnesis{
+			Version:      3,
+			Hypertension: true,
+			Medications:  "Эналаприл 10 мг",
+			Allergies:    []Allergy{{Allergen: "Лидокаин", Severity: "severe", Reaction: "отёк Квинке"}},
+			ChangedAt:    time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC),
+		},
+		Documents: []Document{{
+			ID:        "5b0e8f2e-3c1d-4f0b-9a57-2f7c9d1e4a10",
+			FileName:  "opg.dcm",
+			Modality:  "PX",
+			StudyDate: &studyDate,
+			CreatedAt: studyDate,
+		}},
+		GeneratedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+	for i := 0; i < visits; i++ {
+		rec.Visits = append(rec.Visits, Visit{
+			ID:            100 + i,
+			Date:          visitDate.AddDate(0, 0, 7*i),
+			DoctorID:      7,
+			Doctor:        "Петров Сергей Иванович",
+			Complaints:    "Боль при накусывании на зуб 36, усиливается от холодного",
+			TreatmentPlan: "Лечение кариеса, повторный осмотр через полгода",
+			Diagnoses:     []Diagnosis{{Code: "K02.1", Name: "Кариес дентина", Tooth: 36, Surfaces: "MOD"}},
+			Services:      []Service{{ID: 12, Name: "Пломба из светоотверждаемого композита", Quantity: 1, Tooth: 36, Surfaces: "MOD"}},
+			Materials:     []Material{{ID: 3, Name: "Композит Filtek", Quantity: 2}},
+		})
+	}
+	return rec
+}
+
+func TestRenderPDF(t *testing.T) {
+	r, err := NewRenderer(Clinic{Name: "Клиника", Address: "г. Москва, ул. Ленина, 1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// много приёмов: карта переносится на следующие страницы
+	rec := testRecord(30)
+	first, err := r.RenderPDF(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(first, []byte("%PDF-")) {
+		t.Fatalf("not a PDF: %q", first[:min(len(first), 16)])
+	}
+	if m := pageCount.FindSubmatch(first); m == nil || string(m[1]) == "1" {
+		t.Error("expected the visits to span several pages")
+	}
+
+	second, err := r.RenderPDF(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Error("rendering the same record twice gave different files")
+	}
+
+	// пустая карта: нет анкеты, приёмов и документов
+	empty, err := r.RenderPDF(Record{Patient: rec.Patient, GeneratedAt: rec.GeneratedAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(empty, []byte("%PDF-")) {
+		t.Fatal("empty record: not a PDF")
+	}
+}
+
+func TestBundle(t *testing.T) {
+	content, err := Bundle(testRecord(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		ResourceType string `json:"resourceType"`
+		Type         string `json:"type"`
+		Entry        []struct {
+			Resource map[string]any `json:"resource"`
+		} `json:"entry"`
+	}
+	if err := json.Unmarshal(content, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ResourceType != "Bundle" || got.Type != "collection" {
+		t.Fatalf("unexpected bundle header: %s %s", got.ResourceType, got.Type)
+	}
+
+	counts := make(map[string]int)
+	for _, e := range got.Entry {
+		counts[e.Resource["resourceType"].(string)]++
+	}
+	want := map[string]int{
+		"Patient":               1,
+		"AllergyIntolerance":    2, // запись врача и аллергия из анкеты
+		"Condition":             3, // хроническое заболевание и диагноз каждого из двух приёмов
+		"QuestionnaireResponse": 1,
+		"Encounter":             2,
+		"Procedure":             2,
+		"DocumentReference":     1,
+	}
+	for resourceType, n := range want {
+		if counts[resourceType] != n {
+			t.Errorf("%s: got %d resources, want %d", resourceType, counts[resourceType], n)
+		}
+	}
+
+	patient := got.Entry[0].Resource
+	if patient["gender"] != "female" || patient["birthDate"] != "1990-05-17" {
+		t.Errorf("unexpected patient: %v", patient)
+	}
+	for _, e := range got.Entry {
+		r := e.Resource
+		switch r["resourceType"] {
+		case "AllergyIntolerance":
+			if r["id"] == "anamnesis-3-allergy-1" && r["criticality"] != "high" {
+				t.Errorf("severe allergy should have high criticality: %v", r)
+			}
+		case "Condition":
+			if r["id"] != "visit-100-diagnosis-1" {
+				continue
+			}
+			coding := r["code"].(map[string]any)["coding"].([]any)[0].(map[string]any)
+			if coding["system"] != systemICD10 || coding["code"] != "K02.1" {
+				t.Errorf("unexpected diagnosis code: %v", coding)
+			}
+			site := r["bodySite"].([]any)[0].(map[string]any)["coding"].([]any)
+			if len(site) != 4 { // зуб и три поверхности
+				t.Errorf("unexpected body site: %v", site)
+			}
+		}
+	}
+}
+
+func TestExportUnknownFormat(t *testing.T) {
+	r, err := NewRenderer(ClinicFromEnv())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Export(testRecord(1), "xml"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
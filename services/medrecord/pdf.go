@@ -0,0 +1,342 @@
+package medrecord
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"github.com/jung-kurt/gofpdf"
+	"strconv"
+	"strings"
+)
+
+// Шрифты DejaVu Sans Condensed (https://dejavu-fonts.github.io/License.html) встроены в пакет, чтобы
+// кириллица печаталась без установленных в системе шрифтов
+//
+//go:embed fonts/*.ttf
+var fonts embed.FS
+
+const (
+	fontFamily = "DejaVu"
+	lineHeight = 5.5
+	// ширина колонок таблиц приёма в мм; наименование занимает остаток ширины страницы
+	colTooth    = 22.0
+	colQuantity = 18.0
+	colCode     = 22.0
+)
+
+// Renderer формирует выгрузки медицинской карты со сведениями о клинике
+type Renderer struct {
+	Clinic Clinic
+	// шрифты читаются один раз, выгрузки формируются конкурентно
+	regular []byte
+	bold    []byte
+}
+
+// NewRenderer загружает встроенные шрифты
+func NewRenderer(clinic Clinic) (*Renderer, error) {
+	regular, err := fonts.ReadFile("fonts/DejaVuSansCondensed.ttf")
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать шрифт выгрузки: %w", err)
+	}
+	bold, err := fonts.ReadFile("fonts/DejaVuSansCondensed-Bold.ttf")
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать шрифт выгрузки: %w", err)
+	}
+	return &Renderer{Clinic: clinic, regular: regular, bold: bold}, nil
+}
+
+// Export выгрузка карты в формате format: pdf или json
+func (r *Renderer) Export(rec Record, format string) ([]byte, error) {
+	switch format {
+	case FormatPDF:
+		return r.RenderPDF(rec)
+	case FormatJSON:
+		return Bundle(rec)
+	}
+	return nil, fmt.Errorf("неизвестный формат выгрузки %q: ожидается pdf или json", format)
+}
+
+// RenderPDF печатная форма медицинской карты: данные пациента, аллергии и заболевания, анкета здоровья,
+// приёмы с диагнозами, услугами и материалами и список документов
+func (r *Renderer) RenderPDF(rec Record) ([]byte, error) {
+	title := "Медицинская карта пациента"
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(fontFamily, "", r.regular)
+	pdf.AddUTF8FontFromBytes(fontFamily, "B", r.bold)
+	pdf.SetTitle(title+" "+rec.Patient.FullName(), true)
+	pdf.SetAuthor(r.Clinic.Name, true)
+	// дата создания из выгрузки, а не текущее время: одна и та же карта даёт тот же файл
+	pdf.SetCreationDate(rec.GeneratedAt)
+	pdf.SetCatalogSort(true)
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont(fontFamily, "", 8)
+		pdf.CellFormat(0, 4, fmt.Sprintf("%s — сформировано %s, стр. %d", rec.Patient.FullName(),
+			rec.GeneratedAt.Format("02.01.2006 15:04"), pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	pdf.AddPage()
+
+	r.header(pdf)
+	pdf.SetFont(fontFamily, "B", 13)
+	pdf.CellFormat(0, 8, title, "", 1, "C", false, 0, "")
+	pdf.Ln(2)
+
+	patientSection(pdf, rec.Patient)
+	notesSection(pdf, rec.Notes)
+	anamnesisSection(pdf, rec.Anamnesis)
+	visitsSection(pdf, rec.Visits)
+	documentsSection(pdf, rec.Documents)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("не удалось сформировать PDF: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *Renderer) header(pdf *gofpdf.Fpdf) {
+	pdf.SetFont(fontFamily, "B", 14)
+	pdf.CellFormat(0, 7, r.Clinic.Name, "", 1, "L", false, 0, "")
+	pdf.SetFont(fontFamily, "", 9)
+	var details []string
+	if r.Clinic.Address != "" {
+		details = append(details, r.Clinic.Address)
+	}
+	if r.Clinic.Phone != "" {
+		details = append(details, "тел. "+r.Clinic.Phone)
+	}
+	if len(details) > 0 {
+		pdf.MultiCell(0, 4.5, strings.Join(details, ", "), "", "L", false)
+	}
+	pdf.Ln(4)
+}
+
+func section(pdf *gofpdf.Fpdf, title string) {
+	pdf.Ln(3)
+	pdf.SetFont(fontFamily, "B", 11)
+	pdf.SetFillColor(235, 235, 235)
+	pdf.CellFormat(0, 7, title, "", 1, "L", true, 0, "")
+	pdf.Ln(1)
+	pdf.SetFont(fontFamily, "", 10)
+}
+
+// field строка «подпись: значение»; длинные значения переносятся
+func field(pdf *gofpdf.Fpdf, label, value string) {
+	if value == "" {
+		return
+	}
+	pdf.SetFont(fontFamily, "B", 10)
+	labelWidth := pdf.GetStringWidth(label+": ") + 1
+	pdf.CellFormat(labelWidth, lineHeight, label+":", "", 0, "L", false, 0, "")
+	pdf.SetFont(fontFamily, "", 10)
+	pdf.MultiCell(0, lineHeight, value, "", "L", false)
+}
+
+func text(pdf *gofpdf.Fpdf, value string) {
+	pdf.SetFont(fontFamily, "", 10)
+	pdf.MultiCell(0, lineHeight, value, "", "L", false)
+}
+
+func patientSection(pdf *gofpdf.Fpdf, p Patient) {
+	section(pdf, "Пациент")
+	field(pdf, "ФИО", p.FullName())
+	if !p.BirthDate.IsZero() {
+		field(pdf, "Дата рождения", p.BirthDate.Format("02.01.2006"))
+	}
+	switch p.Gender {
+	case "м":
+		field(pdf, "Пол", "мужской")
+	case "ж":
+		field(pdf, "Пол", "женский")
+	}
+	field(pdf, "Телефон", p.Phone)
+	field(pdf, "Эл. почта", p.Email)
+}
+
+func notesSection(pdf *gofpdf.Fpdf, notes []Note) {
+	var allergies, chronics []string
+	for _, note := range notes {
+		if note.Type == "allergy" {
+			allergies = append(allergies, note.Title)
+		} else {
+			chronics = append(chronics, note.Title)
+		}
+	}
+	section(pdf, "Аллергии и хронические заболевания")
+	if len(notes) == 0 {
+		text(pdf, "Не отмечены")
+		return
+	}
+	field(pdf, "Аллергии", strings.Join(allergies, "; "))
+	field(pdf, "Хронические заболевания", strings.Join(chronics, "; "))
+}
+
+func anamnesisSection(pdf *gofpdf.Fpdf, a *Anamnesis) {
+	section(pdf, "Анкета здоровья")
+	if a == nil {
+		text(pdf, "Пациент анкету не заполнял")
+		return
+	}
+	field(pdf, "Версия", fmt.Sprintf("%d от %s", a.Version, a.ChangedAt.Format("02.01.2006")))
+	var marked []string
+	for _, flag := range a.flags() {
+		if flag.value {
+			marked = append(marked, flag.title)
+		}
+	}
+	if len(marked) == 0 {
+		field(pdf, "Отмечено", "нет")
+	} else {
+		field(pdf, "Отмечено", strings.Join(marked, "; "))
+	}
+	field(pdf, "Принимаемые лекарства", a.Medications)
+	field(pdf, "Другие заболевания", a.OtherConditions)
+	for _, allergy := range a.Allergies {
+		value := allergy.Allergen + " (" + severityName(allergy.Severity) + ")"
+		if allergy.Reaction != "" {
+			value += ": " + allergy.Reaction
+		}
+		field(pdf, "Аллергия", value)
+	}
+}
+
+func visitsSection(pdf *gofpdf.Fpdf, visits []Visit) {
+	section(pdf, "Приёмы")
+	if len(visits) == 0 {
+		text(pdf, "Приёмов не было")
+		return
+	}
+	for i, v := range visits {
+		if i > 0 {
+			pdf.Ln(3)
+		}
+		pdf.SetFont(fontFamily, "B", 10)
+		heading := "Приём " + v.Date.Format("02.01.2006 15:04")
+		if v.Doctor != "" {
+			heading += ", врач " + v.Doctor
+		}
+		pdf.MultiCell(0, lineHeight+0.5, heading, "B", "L", false)
+		pdf.Ln(1)
+		field(pdf, "Жалобы", v.Complaints)
+		field(pdf, "План лечения", v.TreatmentPlan)
+
+		if len(v.Diagnoses) > 0 {
+			rows := make([][]string, 0, len(v.Diagnoses))
+			for _, d := range v.Diagnoses {
+				name := d.Name
+				if d.Note != "" {
+					name += " — " + d.Note
+				}
+				rows = append(rows, []string{d.Code, name, toothLabel(d.Tooth, d.Surfaces)})
+			}
+			table(pdf, "Диагнозы", []string{"МКБ-10", "Диагноз", "Зуб"}, []float64{colCode, 0, colTooth}, rows)
+		}
+		if len(v.Services) > 0 {
+			rows := make([][]string, 0, len(v.Services))
+			for _, s := range v.Services {
+				rows = append(rows, []string{s.Name, toothLabel(s.Tooth, s.Surfaces), strconv.Itoa(s.Quantity)})
+			}
+			table(pdf, "Услуги", []string{"Услуга", "Зуб", "Кол-во"}, []float64{0, colTooth, colQuantity}, rows)
+		}
+		if len(v.Materials) > 0 {
+			rows := make([][]string, 0, len(v.Materials))
+			for _, m := range v.Materials {
+				rows = append(rows, []string{m.Name, strconv.Itoa(m.Quantity)})
+			}
+			table(pdf, "Материалы", []string{"Материал", "Кол-во"}, []float64{0, colQuantity}, rows)
+		}
+	}
+}
+
+func documentsSection(pdf *gofpdf.Fpdf, docs []Document) {
+	section(pdf, "Документы")
+	if len(docs) == 0 {
+		text(pdf, "Документов нет")
+		return
+	}
+	rows := make([][]string, 0, len(docs))
+	for _, d := range docs {
+		date := d.CreatedAt.Format("02.01.2006")
+		if d.StudyDate != nil {
+			date = d.StudyDate.Format("02.01.2006")
+		}
+		name := d.FileName
+		if d.Description != "" {
+			name += " — " + d.Description
+		}
+		rows = append(rows, []string{date, d.Modality, name})
+	}
+	table(pdf, "", []string{"Дата", "Вид", "Документ"}, []float64{colCode, colCode, 0}, rows)
+}
+
+// table таблица с подписью; ширина 0 — колонка занимает остаток страницы. Длинные значения переносятся,
+// строка растёт по высоте, а на новой странице заголовок таблицы повторяется
+func table(pdf *gofpdf.Fpdf, caption string, header []string, widths []float64, rows [][]string) {
+	pageWidth, pageHeight := pdf.GetPageSize()
+	left, _, right, bottom := pdf.GetMargins()
+	widths = append([]float64(nil), widths...)
+	rest := pageWidth - left - right
+	for _, w := range widths {
+		rest -= w
+	}
+	for i, w := range widths {
+		if w == 0 {
+			widths[i] = rest
+		}
+	}
+
+	if caption != "" {
+		pdf.Ln(1)
+		pdf.SetFont(fontFamily, "B", 10)
+		pdf.CellFormat(0, lineHeight, caption, "", 1, "L", false, 0, "")
+	}
+	tableHeader := func() {
+		pdf.SetFont(fontFamily, "B", 9)
+		pdf.SetFillColor(245, 245, 245)
+		for i, title := range header {
+			pdf.CellFormat(widths[i], 6, title, "1", 0, "C", true, 0, "")
+		}
+		pdf.Ln(-1)
+		pdf.SetFont(fontFamily, "", 9)
+	}
+	tableHeader()
+
+	for _, row := range rows {
+		cells := make([][]string, len(row))
+		lines := 1
+		for i, value := range row {
+			cells[i] = pdf.SplitText(value, widths[i]-2)
+			if len(cells[i]) == 0 {
+				cells[i] = []string{""}
+			}
+			lines = max(lines, len(cells[i]))
+		}
+		h := float64(lines) * lineHeight
+		if pdf.GetY()+h > pageHeight-bottom {
+			pdf.AddPage()
+			tableHeader()
+		}
+		x, y := pdf.GetXY()
+		for i := range row {
+			pdf.Rect(x, y, widths[i], h, "D")
+			pdf.SetXY(x+1, y)
+			pdf.MultiCell(widths[i]-2, lineHeight, strings.Join(cells[i], "\n"), "", "L", false)
+			x += widths[i]
+		}
+		pdf.SetXY(left, y+h)
+	}
+	pdf.Ln(1)
+}
+
+// toothLabel номер зуба с поверхностями: «36 MOD»; пусто, если запись не относится к зубу
+func toothLabel(tooth int, surfaces string) string {
+	if tooth == 0 {
+		return ""
+	}
+	if surfaces == "" {
+		return strconv.Itoa(tooth)
+	}
+	return strconv.Itoa(tooth) + " " + surfaces
+}
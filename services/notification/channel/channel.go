@@ -0,0 +1,17 @@
+// Package channel каналы доставки уведомлений. Имя канала совпадает с каналом уведомления в очереди хранилища
+package channel
+
+import "context"
+
+// Message уведомление, готовое к отправке
+type Message struct {
+	Recipient string `json:"recipient"`         // номер телефона или email
+	Subject   string `json:"subject,omitempty"` // тема письма; в СМС не используется
+	Body      string `json:"body"`
+}
+
+// Channel канал доставки уведомлений
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, msg Message) error
+}
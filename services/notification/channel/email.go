@@ -0,0 +1,82 @@
+package channel
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+// Email отправка писем через SMTP с STARTTLS
+type Email struct {
+	host     string
+	port     string
+	from     string
+	password string
+}
+
+func NewEmail(host, port, from, password string) *Email {
+	return &Email{host: host, port: port, from: from, password: password}
+}
+
+func (c *Email) Name() string {
+	return "email"
+}
+
+func (c *Email) Send(ctx context.Context, msg Message) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(c.host, c.port))
+	if err != nil {
+		return fmt.Errorf("не удалось подключиться к SMTP-серверу: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("не удалось начать сеанс SMTP: %w", err)
+	}
+	defer client.Close()
+
+	if err := client.StartTLS(nil); err != nil {
+		return fmt.Errorf("не удалось включить TLS: %w", err)
+	}
+	if err := client.Auth(smtp.PlainAuth("", c.from, c.password, c.host)); err != nil {
+		return fmt.Errorf("не удалось пройти аутентификацию на SMTP-сервере: %w", err)
+	}
+	if err := client.Mail(c.from); err != nil {
+		return fmt.Errorf("SMTP-сервер не принял отправителя: %w", err)
+	}
+	if err := client.Rcpt(msg.Recipient); err != nil {
+		return fmt.Errorf("SMTP-сервер не принял получателя: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("не удалось начать передачу письма: %w", err)
+	}
+	if _, err := w.Write(c.compose(msg)); err != nil {
+		return fmt.Errorf("не удалось передать письмо: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("SMTP-сервер не принял письмо: %w", err)
+	}
+	return client.Quit()
+}
+
+// compose письмо с заголовками; тема кодируется, так как содержит кириллицу
+func (c *Email) compose(msg Message) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", c.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.Recipient)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	buf.WriteString(msg.Body)
+	return buf.Bytes()
+}
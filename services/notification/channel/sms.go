@@ -0,0 +1,35 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	smsaero_golang "github.com/smsaero/smsaero_golang/smsaero"
+	"strconv"
+	"strings"
+)
+
+// SMS отправка через SMS Aero
+type SMS struct {
+	client *smsaero_golang.Client
+}
+
+func NewSMS(username, apiKey string) *SMS {
+	client := smsaero_golang.NewSmsAeroClient(username, apiKey, smsaero_golang.WithPhoneValidation(false))
+	return &SMS{client: client}
+}
+
+func (c *SMS) Name() string {
+	return "sms"
+}
+
+func (c *SMS) Send(_ context.Context, msg Message) error {
+	phone, err := strconv.Atoi(strings.TrimPrefix(msg.Recipient, "+"))
+	if err != nil {
+		return fmt.Errorf("не удалось преобразовать номер телефона для отправки СМС: %w", err)
+	}
+	_, err = c.client.SendSms(phone, msg.Body)
+	if err != nil {
+		return fmt.Errorf("не удалось отправить СМС: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"github.com/DariaTarasek/diplom/services/notification/channel"
+	"github.com/DariaTarasek/diplom/services/notification/clients"
+	"github.com/DariaTarasek/diplom/services/notification/dispatcher"
+	"github.com/DariaTarasek/diplom/services/notification/templates"
+	"github.com/joho/godotenv"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// dispatchInterval как часто проверяется очередь уведомлений
+const dispatchInterval = 30 * time.Second
+
+func main() {
+	err := godotenv.Load()
+	if err != nil {
+		log.Fatalf("Не удалось получить переменные среды: %s", err)
+	}
+	storageClient, err := clients.NewStorageClient("localhost:50051")
+	if err != nil {
+		log.Fatalf("Не удалось создать клиент storage: %s", err)
+	}
+	tpl, err := templates.Load()
+	if err != nil {
+		log.Fatalf("Не удалось загрузить шаблоны уведомлений: %s", err)
+	}
+	channels, closeChannels := newChannels()
+	defer closeChannels()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Println("Сервис уведомлений запущен.")
+	dispatcher.New(storageClient.Client, tpl, channels...).Run(ctx, dispatchInterval)
+	log.Println("Сервис уведомлений остановлен.")
+}
+
+// newChannels каналы из NOTIFICATION_CHANNELS (по умолчанию sms,email). Если задан NOTIFICATION_LOG_FILE,
+// уведомления всех каналов пишутся в этот файл вместо реальной отправки
+func newChannels() ([]channel.Channel, func()) {
+	names := strings.Split(getEnv("NOTIFICATION_CHANNELS", "sms,email"), ",")
+
+	if path := os.Getenv("NOTIFICATION_LOG_FILE"); path != "" {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			log.Fatalf("Не удалось открыть файл для уведомлений: %s", err)
+		}
+		sink := channel.NewLog("log", f)
+		channels := make([]channel.Channel, 0, len(names))
+		for _, name := range names {
+			channels = append(channels, sink.WithName(strings.TrimSpace(name)))
+		}
+		return channels, func() { f.Close() }
+	}
+
+	var channels []channel.Channel
+	for _, name := range names {
+		switch strings.TrimSpace(name) {
+		case "sms":
+			channels = append(channels, channel.NewSMS(os.Getenv("SMSAERO_USERNAME"), os.Getenv("SMSAERO_APIKEY")))
+		case "email":
+			channels = append(channels, channel.NewEmail(
+				getEnv("SMTP_HOST", "smtp.gmail.com"),
+				getEnv("SMTP_PORT", "587"),
+				os.Getenv("EMAIL_ADDRESS"),
+				os.Getenv("EMAIL_PASSWORD"),
+			))
+		default:
+			log.Fatalf("Неизвестный канал уведомлений: %q", name)
+		}
+	}
+	return channels, func() {}
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,109 @@
+// Package dispatcher забирает готовые уведомления из очереди хранилища и отправляет их по каналам.
+// Очередь хранится в БД, поэтому после перезапуска рассылка продолжается с того же места; уведомление,
+// отправка которого была прервана падением, повторно не отправляется, чтобы пациент не получил дубль
+package dispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/notification/channel"
+	storagepb "github.com/DariaTarasek/diplom/services/notification/proto/storage"
+	"github.com/DariaTarasek/diplom/services/notification/templates"
+	"log"
+	"time"
+)
+
+const (
+	batchSize = 50
+	// sendTimeout время на отправку одного уведомления
+	sendTimeout = 30 * time.Second
+)
+
+type Dispatcher struct {
+	storage   storagepb.StorageServiceClient
+	channels  map[string]channel.Channel
+	templates *templates.Set
+}
+
+func New(storage storagepb.StorageServiceClient, tpl *templates.Set, channels ...channel.Channel) *Dispatcher {
+	byName := make(map[string]channel.Channel, len(channels))
+	for _, ch := range channels {
+		byName[ch.Name()] = ch
+	}
+	return &Dispatcher{storage: storage, channels: byName, templates: tpl}
+}
+
+// Run отправляет уведомления каждые interval, пока не отменён ctx
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for {
+			n, err := d.RunOnce(ctx)
+			if err != nil {
+				log.Printf("не удалось отправить уведомления: %v", err)
+			}
+			// полная пачка — в очереди, скорее всего, есть ещё
+			if err != nil || n < batchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce захватывает и отправляет одну пачку уведомлений. Возвращает количество захваченных уведомлений
+func (d *Dispatcher) RunOnce(ctx context.Context) (int, error) {
+	names := make([]string, 0, len(d.channels))
+	for name := range d.channels {
+		names = append(names, name)
+	}
+	// аренда с запасом больше времени на отправку всей пачки: пока она не истекла, хранилище
+	// не считает отправку прерванной
+	lease := batchSize*sendTimeout + time.Minute
+	resp, err := d.storage.ClaimNotifications(ctx, &storagepb.ClaimNotificationsRequest{
+		Channels:     names,
+		Limit:        batchSize,
+		LeaseSeconds: int32(lease.Seconds()),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("не удалось получить уведомления из очереди: %w", err)
+	}
+
+	for _, n := range resp.Notifications {
+		var sendErr string
+		if err := d.send(ctx, n); err != nil {
+			log.Printf("уведомление %d (%s, %s): %v", n.Id, n.Kind, n.Channel, err)
+			sendErr = err.Error()
+		}
+		_, err := d.storage.CompleteNotification(ctx, &storagepb.CompleteNotificationRequest{Id: n.Id, Error: sendErr})
+		if err != nil {
+			log.Printf("не удалось записать результат отправки уведомления %d: %v", n.Id, err)
+		}
+	}
+	return len(resp.Notifications), nil
+}
+
+func (d *Dispatcher) send(ctx context.Context, n *storagepb.Notification) error {
+	ch, ok := d.channels[n.Channel]
+	if !ok {
+		return fmt.Errorf("канал %q не настроен", n.Channel)
+	}
+	var payload templates.Payload
+	if err := json.Unmarshal([]byte(n.Payload), &payload); err != nil {
+		return fmt.Errorf("некорректные данные уведомления: %w", err)
+	}
+	subject, body, err := d.templates.Render(n.Kind, n.Channel, payload)
+	if err != nil {
+		return err
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+	return ch.Send(sendCtx, channel.Message{Recipient: n.Recipient, Subject: subject, Body: body})
+}
@@ -0,0 +1,18 @@
+module github.com/DariaTarasek/diplom/services/notification
+
+go 1.23.2
+
+require (
+	github.com/joho/godotenv v1.5.1
+	github.com/smsaero/smsaero_golang v1.3.1
+	google.golang.org/grpc v1.72.1
+	google.golang.org/protobuf v1.36.5
+)
+
+require (
+	github.com/nyaruka/phonenumbers v1.3.6 // indirect
+	golang.org/x/net v0.35.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	golang.org/x/text v0.25.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
+)
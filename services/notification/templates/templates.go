@@ -0,0 +1,75 @@
+// Package templates тексты уведомлений. Каждому виду уведомления соответствует файл <вид>.tmpl
+// с шаблонами "subject" (тема письма) и по одному шаблону на канал ("sms", "email")
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+)
+
+//go:embed *.tmpl
+var files embed.FS
+
+// Payload данные уведомления из очереди хранилища
+type Payload struct {
+	PatientName string `json:"patient_name"`
+	DoctorName  string `json:"doctor_name"`
+	Date        string `json:"date"`
+	Time        string `json:"time"`
+	ExpiresAt   string `json:"expires_at"`
+}
+
+// Set шаблоны всех видов уведомлений
+type Set struct {
+	byKind map[string]*template.Template
+}
+
+// Load разбирает встроенные шаблоны
+func Load() (*Set, error) {
+	names, err := files.ReadDir(".")
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать шаблоны уведомлений: %w", err)
+	}
+	set := &Set{byKind: make(map[string]*template.Template, len(names))}
+	for _, f := range names {
+		kind := strings.TrimSuffix(f.Name(), path.Ext(f.Name()))
+		tpl, err := template.New(kind).Option("missingkey=error").ParseFS(files, f.Name())
+		if err != nil {
+			return nil, fmt.Errorf("не удалось разобрать шаблон уведомления %s: %w", f.Name(), err)
+		}
+		set.byKind[kind] = tpl
+	}
+	return set, nil
+}
+
+// Render тема и текст уведомления kind для канала channel
+func (s *Set) Render(kind, channel string, data Payload) (subject, body string, err error) {
+	tpl, ok := s.byKind[kind]
+	if !ok {
+		return "", "", fmt.Errorf("нет шаблона для уведомления %q", kind)
+	}
+	if tpl.Lookup(channel) == nil {
+		return "", "", fmt.Errorf("нет шаблона уведомления %q для канала %q", kind, channel)
+	}
+	subject, err = execute(tpl, "subject", data)
+	if err != nil {
+		return "", "", err
+	}
+	body, err = execute(tpl, channel, data)
+	if err != nil {
+		return "", "", err
+	}
+	return subject, body, nil
+}
+
+func execute(tpl *template.Template, name string, data Payload) (string, error) {
+	var buf bytes.Buffer
+	if err := tpl.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", fmt.Errorf("не удалось заполнить шаблон уведомления: %w", err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"github.com/DariaTarasek/diplom/services/audit"
+	"github.com/DariaTarasek/diplom/services/medrecord"
 	"github.com/DariaTarasek/diplom/services/patient/clients"
 	grpcserver "github.com/DariaTarasek/diplom/services/patient/grpc"
 	pb "github.com/DariaTarasek/diplom/services/patient/proto/patient"
@@ -20,14 +23,30 @@ func main() {
 		log.Fatalf("Не удалось создать клиент auth: %s", err.Error())
 	}
 
-	patientService := service.NewPatientService(storageClient, authClient)
+	records, err := medrecord.NewRenderer(medrecord.ClinicFromEnv())
+	if err != nil {
+		log.Fatalf("Не удалось подготовить выгрузку медицинских карт: %s", err)
+	}
+
+	patientService := service.NewPatientService(storageClient, authClient, records)
 	lis, err := net.Listen("tcp", ":50054")
 	if err != nil {
 		log.Fatalf("Не удалось начать слушать: %v", err)
 	}
 
-	s := grpc.NewServer(grpc.MaxRecvMsgSize(50*1024*1024),
-		grpc.MaxSendMsgSize(50*1024*1024))
+	// вызовы от имени пользователя записываются в журнал аудита с итоговым статусом; ошибки методов
+	// приводятся к доменным статусам, ошибки без доменного смысла — к внутренней без подробностей
+	recorder := audit.RecorderFunc(storageClient.RecordAudit)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			audit.UnaryServerInterceptor("patient", recorder, nil),
+			apperr.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			audit.StreamServerInterceptor("patient", recorder, nil),
+			apperr.StreamServerInterceptor(),
+		),
+	)
 
 	server := &grpcserver.Server{
 		Service: patientService,
@@ -3,6 +3,11 @@ module github.com/DariaTarasek/diplom/services/patient
 go 1.23.2
 
 require (
+	github.com/DariaTarasek/diplom/services/access v0.0.0-00010101000000-000000000000
+	github.com/DariaTarasek/diplom/services/apperr v0.0.0-00010101000000-000000000000
+	github.com/DariaTarasek/diplom/services/audit v0.0.0-00010101000000-000000000000
+	github.com/DariaTarasek/diplom/services/medrecord v0.0.0-00010101000000-000000000000
+	github.com/DariaTarasek/diplom/services/scheduling v0.0.0-00010101000000-000000000000
 	github.com/google/uuid v1.6.0
 	github.com/suyashkumar/dicom v1.0.7
 	google.golang.org/grpc v1.72.2
@@ -10,8 +15,19 @@ require (
 )
 
 require (
+	github.com/jung-kurt/gofpdf v1.16.2 // indirect
 	golang.org/x/net v0.35.0 // indirect
 	golang.org/x/sys v0.30.0 // indirect
 	golang.org/x/text v0.22.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
 )
+
+replace github.com/DariaTarasek/diplom/services/access => ../access
+
+replace github.com/DariaTarasek/diplom/services/apperr => ../apperr
+
+replace github.com/DariaTarasek/diplom/services/medrecord => ../medrecord
+
+replace github.com/DariaTarasek/diplom/services/scheduling => ../scheduling
+
+replace github.com/DariaTarasek/diplom/services/audit => ../audit
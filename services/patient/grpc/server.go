@@ -2,11 +2,15 @@ package grpcserver
 
 import (
 	"context"
+	"errors"
 	"github.com/DariaTarasek/diplom/services/patient/model"
 	pb "github.com/DariaTarasek/diplom/services/patient/proto/patient"
 	"github.com/DariaTarasek/diplom/services/patient/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"os"
 )
 
 type Server struct {
@@ -15,7 +19,7 @@ type Server struct {
 }
 
 func (s *Server) GetAppointmentSlots(ctx context.Context, request *pb.GetAppointmentSlotsRequest) (*pb.GetAppointmentSlotsResponse, error) {
-	slots, err := s.Service.MakeDoctorAppointmentSlots(ctx, int(request.DoctorId))
+	slots, err := s.Service.MakeDoctorAppointmentSlots(ctx, int(request.DoctorId), toInts(request.ServiceIds))
 	if err != nil {
 		return nil, err
 	}
@@ -42,8 +46,41 @@ func (s *Server) AddAppointment(ctx context.Context, request *pb.AddAppointmentR
 		PatientBirthDate:   request.Appointment.BirthDate.AsTime(),
 		PatientGender:      request.Appointment.Gender,
 		PatientPhoneNumber: request.Appointment.PhoneNumber,
+		HoldID:             request.HoldId,
+		ServiceIDs:         toInts(request.ServiceIds),
 	}
-	err := s.Service.AddAppointment(ctx, appointment)
+	err := s.Service.AddAppointment(ctx, request.Token, appointment)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) HoldAppointmentSlot(ctx context.Context, request *pb.HoldAppointmentSlotRequest) (*pb.HoldAppointmentSlotResponse, error) {
+	var patientID *model.UserID
+	if request.PatientId != 0 {
+		pID := model.UserID(request.PatientId)
+		patientID = &pID
+	}
+	hold, err := s.Service.HoldAppointmentSlot(ctx, request.Token, model.AppointmentSlotHold{
+		ID:         request.HoldId,
+		DoctorID:   model.UserID(request.DoctorId),
+		PatientID:  patientID,
+		Date:       request.Date.AsTime(),
+		Time:       request.Time.AsTime(),
+		ServiceIDs: toInts(request.ServiceIds),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.HoldAppointmentSlotResponse{
+		HoldId:    hold.ID,
+		ExpiresAt: timestamppb.New(hold.ExpiresAt),
+	}, nil
+}
+
+func (s *Server) ReleaseAppointmentSlot(ctx context.Context, request *pb.ReleaseAppointmentSlotRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.ReleaseAppointmentSlot(ctx, request.Token, request.HoldId)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +108,7 @@ func (s *Server) GetUpcomingAppointments(ctx context.Context, request *pb.GetUpc
 }
 
 func (s *Server) UpdateAppointment(ctx context.Context, request *pb.UpdateAppointmentRequest) (*pb.DefaultResponse, error) {
-	err := s.Service.UpdateAppointment(ctx, model.Appointment{
+	err := s.Service.UpdateAppointment(ctx, request.Token, model.Appointment{
 		ID:   model.AppointmentID(request.Appointment.Id),
 		Date: request.Appointment.Date.AsTime(),
 		Time: request.Appointment.Time.AsTime(),
@@ -82,8 +119,76 @@ func (s *Server) UpdateAppointment(ctx context.Context, request *pb.UpdateAppoin
 	return &pb.DefaultResponse{}, nil
 }
 
-func (s *Server) CancelAppointment(ctx context.Context, request *pb.GetByIDRequest) (*pb.DefaultResponse, error) {
-	err := s.Service.CancelAppointment(ctx, model.AppointmentID(request.Id))
+func (s *Server) CancelAppointment(ctx context.Context, request *pb.CancelAppointmentRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.CancelAppointment(ctx, request.Token, model.AppointmentID(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) JoinWaitingList(ctx context.Context, request *pb.JoinWaitingListRequest) (*pb.JoinWaitingListResponse, error) {
+	entryID, err := s.Service.JoinWaitingList(ctx, request.Token, model.WaitingListRequest{
+		DoctorID:         model.UserID(request.DoctorId),
+		SpecializationID: int(request.SpecializationId),
+		DateFrom:         request.DateFrom.AsTime(),
+		DateTo:           request.DateTo.AsTime(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.JoinWaitingListResponse{EntryId: int32(entryID)}, nil
+}
+
+func (s *Server) GetWaitingList(ctx context.Context, request *pb.GetWaitingListRequest) (*pb.GetWaitingListResponse, error) {
+	entries, offers, err := s.Service.GetWaitingList(ctx, request.Token)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetWaitingListResponse{}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &pb.WaitingListEntry{
+			Id:               int32(e.ID),
+			DoctorId:         int32(e.DoctorID),
+			Doctor:           e.Doctor,
+			SpecializationId: int32(e.SpecializationID),
+			Specialty:        e.Specialty,
+			DateFrom:         e.DateFrom,
+			DateTo:           e.DateTo,
+			Status:           e.Status,
+		})
+	}
+	for _, o := range offers {
+		resp.Offers = append(resp.Offers, &pb.WaitingListOffer{
+			Id:        o.ID,
+			DoctorId:  int32(o.DoctorID),
+			Doctor:    o.Doctor,
+			Date:      o.Date,
+			Time:      o.Time,
+			ExpiresAt: o.ExpiresAt,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) LeaveWaitingList(ctx context.Context, request *pb.LeaveWaitingListRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.LeaveWaitingList(ctx, request.Token, int(request.EntryId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) AcceptWaitingListOffer(ctx context.Context, request *pb.WaitingListOfferRequest) (*pb.AcceptWaitingListOfferResponse, error) {
+	appointmentID, err := s.Service.AcceptWaitingListOffer(ctx, request.Token, request.OfferId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AcceptWaitingListOfferResponse{AppointmentId: int32(appointmentID)}, nil
+}
+
+func (s *Server) DeclineWaitingListOffer(ctx context.Context, request *pb.WaitingListOfferRequest) (*pb.DefaultResponse, error) {
+	err := s.Service.DeclineWaitingListOffer(ctx, request.Token, request.OfferId)
 	if err != nil {
 		return nil, err
 	}
@@ -110,21 +215,55 @@ func (s *Server) GetHistoryVisits(ctx context.Context, request *pb.GetHistoryVis
 	return &pb.GetHistoryVisitsResponse{Visits: historyVisits}, nil
 }
 
-func (s *Server) UploadTest(ctx context.Context, req *pb.UploadTestRequest) (*pb.UploadTestResponse, error) {
-	docID, err := s.Service.UploadTest(ctx, model.UploadTestInput{
-		Token:       req.Token,
-		FileName:    req.FileName,
-		FileContent: req.FileContent,
-		Description: req.Description,
-	})
+// UploadTest принимает снимок потоком во временный файл, затем разбирает его и передаёт в storage
+func (s *Server) UploadTest(stream pb.PatientService_UploadTestServer) error {
+	req, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "поток загрузки пуст")
+	}
+	if err != nil {
+		return err
+	}
+	input := model.UploadTestInput{Token: req.Token, FileName: req.FileName}
+
+	file, err := os.CreateTemp("", "test-*.dcm")
+	if err != nil {
+		return status.Errorf(codes.Internal, "не удалось создать временный файл: %v", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	for {
+		if req.Description != "" {
+			input.Description = req.Description
+		}
+		input.Size += int64(len(req.Chunk))
+		if input.Size > service.MaxTestSize {
+			return status.Errorf(codes.InvalidArgument, "файл больше %d МБ", service.MaxTestSize>>20)
+		}
+		if _, err := file.Write(req.Chunk); err != nil {
+			return status.Errorf(codes.Internal, "не удалось записать временный файл: %v", err)
+		}
+
+		req, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	input.File = file
+
+	docID, err := s.Service.UploadTest(stream.Context(), input)
 	if err != nil {
 		// маппинг ошибок в gRPC status
-		return nil, status.Errorf(codes.InvalidArgument, "upload failed: %v", err)
+		return status.Errorf(codes.InvalidArgument, "upload failed: %v", err)
 	}
 
-	return &pb.UploadTestResponse{
+	return stream.SendAndClose(&pb.UploadTestResponse{
 		DocumentId: docID.String(),
-	}, nil
+	})
 }
 
 func (s *Server) GetDocumentsByPatientID(ctx context.Context, request *pb.GetDocumentsRequest) (*pb.GetDocumentsResponse, error) {
@@ -146,13 +285,169 @@ func (s *Server) GetDocumentsByPatientID(ctx context.Context, request *pb.GetDoc
 	return &pb.GetDocumentsResponse{Documents: docs}, nil
 }
 
-func (s *Server) DownloadDocument(ctx context.Context, request *pb.DownloadDocumentRequest) (*pb.DownloadDocumentResponse, error) {
-	resp, err := s.Service.DownloadDocument(ctx, request.DocumentId)
+func (s *Server) DownloadDocument(request *pb.DownloadDocumentRequest, stream pb.PatientService_DownloadDocumentServer) error {
+	return s.Service.DownloadDocument(stream.Context(), request.Token, request.DocumentId, request.Offset, func(chunk model.DocumentChunk) error {
+		return stream.Send(&pb.DownloadDocumentResponse{
+			FileName: chunk.FileName,
+			Size:     chunk.Size,
+			Chunk:    chunk.Data,
+		})
+	})
+}
+
+func toInts(ids []int32) []int {
+	result := make([]int, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, int(id))
+	}
+	return result
+}
+
+func (s *Server) GetNotificationSettings(ctx context.Context, request *pb.NotificationSettingsRequest) (*pb.NotificationSettings, error) {
+	optOut, err := s.Service.GetNotificationOptOut(ctx, request.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.NotificationSettings{OptOut: optOut}, nil
+}
+
+func (s *Server) UpdateNotificationSettings(ctx context.Context, request *pb.NotificationSettings) (*pb.DefaultResponse, error) {
+	err := s.Service.UpdateNotificationOptOut(ctx, request.Token, request.OptOut)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) GetTreatmentPlans(ctx context.Context, request *pb.GetTreatmentPlansRequest) (*pb.GetTreatmentPlansResponse, error) {
+	plans, remaining, err := s.Service.GetTreatmentPlans(ctx, request.Token)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.DownloadDocumentResponse{
-		FileName:    resp.FileName,
-		FileContent: resp.FileContent,
+	resp := &pb.GetTreatmentPlansResponse{RemainingCost: int32(remaining)}
+	for _, plan := range plans {
+		resp.Plans = append(resp.Plans, treatmentPlanToPb(plan))
+	}
+	return resp, nil
+}
+
+func (s *Server) AcceptTreatmentPlan(ctx context.Context, request *pb.AcceptTreatmentPlanRequest) (*pb.AcceptTreatmentPlanResponse, error) {
+	plan, err := s.Service.AcceptTreatmentPlan(ctx, request.Token, int(request.PlanId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AcceptTreatmentPlanResponse{Plan: treatmentPlanToPb(plan)}, nil
+}
+
+func (s *Server) GetAnamnesis(ctx context.Context, request *pb.GetAnamnesisRequest) (*pb.AnamnesisResponse, error) {
+	anamnesis, err := s.Service.GetAnamnesis(ctx, request.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AnamnesisResponse{Anamnesis: anamnesisToPb(anamnesis)}, nil
+}
+
+func (s *Server) ExportMedicalRecord(ctx context.Context, request *pb.ExportMedicalRecordRequest) (*pb.MedicalRecordExportResponse, error) {
+	export, err := s.Service.ExportMedicalRecord(ctx, request.Token, request.Format)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MedicalRecordExportResponse{
+		FileName:    export.FileName,
+		ContentType: export.ContentType,
+		Content:     export.Content,
 	}, nil
 }
+
+func (s *Server) SaveAnamnesis(ctx context.Context, request *pb.SaveAnamnesisRequest) (*pb.AnamnesisResponse, error) {
+	anamnesis, err := s.Service.SaveAnamnesis(ctx, request.Token, anamnesisFromPb(request.Anamnesis), int(request.BaseVersion))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AnamnesisResponse{Anamnesis: anamnesisToPb(anamnesis)}, nil
+}
+
+func anamnesisFromPb(item *pb.Anamnesis) model.Anamnesis {
+	if item == nil {
+		return model.Anamnesis{}
+	}
+	anamnesis := model.Anamnesis{
+		Medications:        item.Medications,
+		BloodThinners:      item.BloodThinners,
+		CardiacDisease:     item.CardiacDisease,
+		Hypertension:       item.Hypertension,
+		Diabetes:           item.Diabetes,
+		BleedingDisorder:   item.BleedingDisorder,
+		InfectiousDisease:  item.InfectiousDisease,
+		AnesthesiaReaction: item.AnesthesiaReaction,
+		Pregnant:           item.Pregnant,
+		Breastfeeding:      item.Breastfeeding,
+		Smoker:             item.Smoker,
+		OtherConditions:    item.OtherConditions,
+	}
+	for _, a := range item.Allergies {
+		anamnesis.Allergies = append(anamnesis.Allergies, model.AnamnesisAllergy{
+			Allergen: a.Allergen,
+			Severity: a.Severity,
+			Reaction: a.Reaction,
+		})
+	}
+	return anamnesis
+}
+
+func anamnesisToPb(anamnesis model.Anamnesis) *pb.Anamnesis {
+	item := &pb.Anamnesis{
+		Version:            int32(anamnesis.Version),
+		Medications:        anamnesis.Medications,
+		BloodThinners:      anamnesis.BloodThinners,
+		CardiacDisease:     anamnesis.CardiacDisease,
+		Hypertension:       anamnesis.Hypertension,
+		Diabetes:           anamnesis.Diabetes,
+		BleedingDisorder:   anamnesis.BleedingDisorder,
+		InfectiousDisease:  anamnesis.InfectiousDisease,
+		AnesthesiaReaction: anamnesis.AnesthesiaReaction,
+		Pregnant:           anamnesis.Pregnant,
+		Breastfeeding:      anamnesis.Breastfeeding,
+		Smoker:             anamnesis.Smoker,
+		OtherConditions:    anamnesis.OtherConditions,
+		ChangedByRole:      anamnesis.ChangedByRole,
+		ChangedBy:          anamnesis.ChangedBy,
+		ChangedAt:          anamnesis.ChangedAt,
+	}
+	for _, a := range anamnesis.Allergies {
+		item.Allergies = append(item.Allergies, &pb.AnamnesisAllergy{
+			Allergen: a.Allergen,
+			Severity: a.Severity,
+			Reaction: a.Reaction,
+		})
+	}
+	return item
+}
+
+func treatmentPlanToPb(plan model.TreatmentPlan) *pb.TreatmentPlan {
+	resp := &pb.TreatmentPlan{
+		Id:             int32(plan.ID),
+		DoctorId:       int32(plan.DoctorID),
+		Doctor:         plan.Doctor,
+		Title:          plan.Title,
+		Status:         plan.Status,
+		AcceptedAt:     plan.AcceptedAt,
+		CreatedAt:      plan.CreatedAt,
+		EstimatedTotal: int32(plan.EstimatedTotal),
+		RemainingCost:  int32(plan.RemainingCost),
+	}
+	for _, item := range plan.Items {
+		resp.Items = append(resp.Items, &pb.TreatmentPlanItem{
+			Id:             int32(item.ID),
+			Stage:          int32(item.Stage),
+			Tooth:          int32(item.Tooth),
+			ServiceName:    item.ServiceName,
+			Quantity:       int32(item.Quantity),
+			EstimatedPrice: int32(item.EstimatedPrice),
+			Status:         item.Status,
+			Note:           item.Note,
+			CompletedAt:    item.CompletedAt,
+		})
+	}
+	return resp
+}
@@ -25,6 +25,17 @@ type (
 		Status             string
 		CreatedAt          time.Time
 		UpdatedAt          time.Time
+		HoldID             string
+		ServiceIDs         []int
+	}
+	AppointmentSlotHold struct {
+		ID         string
+		DoctorID   UserID
+		PatientID  *UserID
+		Date       time.Time
+		Time       time.Time
+		ExpiresAt  time.Time
+		ServiceIDs []int
 	}
 	UpcomingAppointment struct {
 		ID        AppointmentID
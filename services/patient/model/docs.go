@@ -2,14 +2,17 @@ package model
 
 import (
 	"github.com/google/uuid"
+	"io"
 	"time"
 )
 
 type (
+	// UploadTestInput загружаемый снимок: содержимое уже принято во временный файл целиком
 	UploadTestInput struct {
 		Token       string
 		FileName    string
-		FileContent []byte
+		File        io.ReaderAt
+		Size        int64
 		Description string
 	}
 	PatientDocument struct {
@@ -29,8 +32,10 @@ type (
 		Description string
 		CreatedAt   string
 	}
-	DocumentFile struct {
-		FileName    string
-		FileContent []byte
+	// DocumentChunk часть скачиваемого документа; FileName и Size заполнены только в первой части
+	DocumentChunk struct {
+		FileName string
+		Size     int64
+		Data     []byte
 	}
 )
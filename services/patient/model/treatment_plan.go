@@ -0,0 +1,30 @@
+package model
+
+type (
+	// TreatmentPlan план лечения, предложенный пациенту. RemainingCost — стоимость ещё не выполненных позиций
+	// по ценам на день составления плана
+	TreatmentPlan struct {
+		ID             int
+		DoctorID       UserID
+		Doctor         string
+		Title          string
+		Status         string // proposed, accepted, in_progress, done, cancelled
+		AcceptedAt     string
+		CreatedAt      string
+		Items          []TreatmentPlanItem
+		EstimatedTotal int
+		RemainingCost  int
+	}
+	// TreatmentPlanItem услуга плана на этапе; Tooth — номер зуба по FDI, 0 — без привязки к зубу
+	TreatmentPlanItem struct {
+		ID             int
+		Stage          int
+		Tooth          int
+		ServiceName    string
+		Quantity       int
+		EstimatedPrice int
+		Status         string // planned, in_progress, done, cancelled
+		Note           string
+		CompletedAt    string
+	}
+)
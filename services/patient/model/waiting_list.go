@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+type (
+	// WaitingListRequest заявка пациента в лист ожидания: врач или специальность и диапазон дат
+	WaitingListRequest struct {
+		DoctorID         UserID
+		SpecializationID int
+		DateFrom         time.Time
+		DateTo           time.Time
+	}
+	WaitingListEntry struct {
+		ID               int
+		DoctorID         UserID
+		Doctor           string
+		SpecializationID int
+		Specialty        string
+		DateFrom         string
+		DateTo           string
+		Status           string
+	}
+	// WaitingListOffer предложенный пациенту освободившийся слот; ExpiresAt — до какого момента можно его принять
+	WaitingListOffer struct {
+		ID        string
+		DoctorID  UserID
+		Doctor    string
+		Date      string
+		Time      string
+		ExpiresAt string
+	}
+)
@@ -1024,6 +1024,102 @@ func (x *GetPatientRequest) GetToken() string {
 	return ""
 }
 
+type GetUserIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserIDRequest) Reset() {
+	*x = GetUserIDRequest{}
+	mi := &file_proto_auth_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserIDRequest) ProtoMessage() {}
+
+func (x *GetUserIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserIDRequest.ProtoReflect.Descriptor instead.
+func (*GetUserIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetUserIDRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          int32                  `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"` // идентификатор роли из таблицы roles
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRoleResponse) Reset() {
+	*x = GetUserRoleResponse{}
+	mi := &file_proto_auth_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRoleResponse) ProtoMessage() {}
+
+func (x *GetUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_auth_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*GetUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserRoleResponse) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetUserRoleResponse) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
 type GetPatientResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Patient       *PatientData           `protobuf:"bytes,1,opt,name=patient,proto3" json:"patient,omitempty"`
@@ -1033,7 +1129,7 @@ type GetPatientResponse struct {
 
 func (x *GetPatientResponse) Reset() {
 	*x = GetPatientResponse{}
-	mi := &file_proto_auth_auth_proto_msgTypes[18]
+	mi := &file_proto_auth_auth_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1045,7 +1141,7 @@ func (x *GetPatientResponse) String() string {
 func (*GetPatientResponse) ProtoMessage() {}
 
 func (x *GetPatientResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_auth_auth_proto_msgTypes[18]
+	mi := &file_proto_auth_auth_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1154,7 @@ func (x *GetPatientResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPatientResponse.ProtoReflect.Descriptor instead.
 func (*GetPatientResponse) Descriptor() ([]byte, []int) {
-	return file_proto_auth_auth_proto_rawDescGZIP(), []int{18}
+	return file_proto_auth_auth_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetPatientResponse) GetPatient() *PatientData {
@@ -1141,9 +1237,14 @@ const file_proto_auth_auth_proto_rawDesc = "" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
 	"\aperm_id\x18\x02 \x01(\x05R\x06permId\")\n" +
 	"\x11GetPatientRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"A\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"(\n" +
+	"\x10GetUserIDRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"B\n" +
+	"\x13GetUserRoleResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\x05R\x04role\"A\n" +
 	"\x12GetPatientResponse\x12+\n" +
-	"\apatient\x18\x01 \x01(\v2\x11.auth.PatientDataR\apatient2\x81\x06\n" +
+	"\apatient\x18\x01 \x01(\v2\x11.auth.PatientDataR\apatient2\xc3\x06\n" +
 	"\vAuthService\x12Q\n" +
 	"\x10EmployeeRegister\x12\x1d.auth.EmployeeRegisterRequest\x1a\x1e.auth.EmployeeRegisterResponse\x12N\n" +
 	"\x0fPatientRegister\x12\x1c.auth.PatientRegisterRequest\x1a\x1d.auth.PatientRegisterResponse\x12f\n" +
@@ -1156,7 +1257,8 @@ const file_proto_auth_auth_proto_rawDesc = "" +
 	"\x04Auth\x12\x11.auth.AuthRequest\x1a\x12.auth.AuthResponse\x12F\n" +
 	"\x0fPermissionCheck\x12\x1c.auth.PermissionCheckRequest\x1a\x15.auth.DefaultResponse\x12?\n" +
 	"\n" +
-	"GetPatient\x12\x17.auth.GetPatientRequest\x1a\x18.auth.GetPatientResponseB\x13Z\x11auth/proto;authpbb\x06proto3"
+	"GetPatient\x12\x17.auth.GetPatientRequest\x1a\x18.auth.GetPatientResponse\x12@\n" +
+	"\vGetUserRole\x12\x16.auth.GetUserIDRequest\x1a\x19.auth.GetUserRoleResponseB\x13Z\x11auth/proto;authpbb\x06proto3"
 
 var (
 	file_proto_auth_auth_proto_rawDescOnce sync.Once
@@ -1170,7 +1272,7 @@ func file_proto_auth_auth_proto_rawDescGZIP() []byte {
 	return file_proto_auth_auth_proto_rawDescData
 }
 
-var file_proto_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_proto_auth_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_proto_auth_auth_proto_goTypes = []any{
 	(*UserData)(nil),                        // 0: auth.UserData
 	(*EmployeeRegisterResponse)(nil),        // 1: auth.EmployeeRegisterResponse
@@ -1190,13 +1292,15 @@ var file_proto_auth_auth_proto_goTypes = []any{
 	(*AuthResponse)(nil),                    // 15: auth.AuthResponse
 	(*PermissionCheckRequest)(nil),          // 16: auth.PermissionCheckRequest
 	(*GetPatientRequest)(nil),               // 17: auth.GetPatientRequest
-	(*GetPatientResponse)(nil),              // 18: auth.GetPatientResponse
-	(*timestamppb.Timestamp)(nil),           // 19: google.protobuf.Timestamp
+	(*GetUserIDRequest)(nil),                // 18: auth.GetUserIDRequest
+	(*GetUserRoleResponse)(nil),             // 19: auth.GetUserRoleResponse
+	(*GetPatientResponse)(nil),              // 20: auth.GetPatientResponse
+	(*timestamppb.Timestamp)(nil),           // 21: google.protobuf.Timestamp
 }
 var file_proto_auth_auth_proto_depIdxs = []int32{
 	0,  // 0: auth.EmployeeRegisterRequest.user:type_name -> auth.UserData
 	2,  // 1: auth.EmployeeRegisterRequest.employee:type_name -> auth.EmployeeData
-	19, // 2: auth.PatientData.birth_date:type_name -> google.protobuf.Timestamp
+	21, // 2: auth.PatientData.birth_date:type_name -> google.protobuf.Timestamp
 	0,  // 3: auth.PatientRegisterRequest.user:type_name -> auth.UserData
 	4,  // 4: auth.PatientRegisterRequest.patient:type_name -> auth.PatientData
 	0,  // 5: auth.PatientRegisterInClinicRequest.user:type_name -> auth.UserData
@@ -1212,18 +1316,20 @@ var file_proto_auth_auth_proto_depIdxs = []int32{
 	14, // 15: auth.AuthService.Auth:input_type -> auth.AuthRequest
 	16, // 16: auth.AuthService.PermissionCheck:input_type -> auth.PermissionCheckRequest
 	17, // 17: auth.AuthService.GetPatient:input_type -> auth.GetPatientRequest
-	1,  // 18: auth.AuthService.EmployeeRegister:output_type -> auth.EmployeeRegisterResponse
-	6,  // 19: auth.AuthService.PatientRegister:output_type -> auth.PatientRegisterResponse
-	8,  // 20: auth.AuthService.PatientRegisterInClinic:output_type -> auth.PatientRegisterInClinicResponse
-	11, // 21: auth.AuthService.EmployeePasswordRecovery:output_type -> auth.DefaultResponse
-	11, // 22: auth.AuthService.PatientPasswordRecovery:output_type -> auth.DefaultResponse
-	11, // 23: auth.AuthService.RequestCode:output_type -> auth.DefaultResponse
-	11, // 24: auth.AuthService.VerifyCode:output_type -> auth.DefaultResponse
-	15, // 25: auth.AuthService.Auth:output_type -> auth.AuthResponse
-	11, // 26: auth.AuthService.PermissionCheck:output_type -> auth.DefaultResponse
-	18, // 27: auth.AuthService.GetPatient:output_type -> auth.GetPatientResponse
-	18, // [18:28] is the sub-list for method output_type
-	8,  // [8:18] is the sub-list for method input_type
+	18, // 18: auth.AuthService.GetUserRole:input_type -> auth.GetUserIDRequest
+	1,  // 19: auth.AuthService.EmployeeRegister:output_type -> auth.EmployeeRegisterResponse
+	6,  // 20: auth.AuthService.PatientRegister:output_type -> auth.PatientRegisterResponse
+	8,  // 21: auth.AuthService.PatientRegisterInClinic:output_type -> auth.PatientRegisterInClinicResponse
+	11, // 22: auth.AuthService.EmployeePasswordRecovery:output_type -> auth.DefaultResponse
+	11, // 23: auth.AuthService.PatientPasswordRecovery:output_type -> auth.DefaultResponse
+	11, // 24: auth.AuthService.RequestCode:output_type -> auth.DefaultResponse
+	11, // 25: auth.AuthService.VerifyCode:output_type -> auth.DefaultResponse
+	15, // 26: auth.AuthService.Auth:output_type -> auth.AuthResponse
+	11, // 27: auth.AuthService.PermissionCheck:output_type -> auth.DefaultResponse
+	20, // 28: auth.AuthService.GetPatient:output_type -> auth.GetPatientResponse
+	19, // 29: auth.AuthService.GetUserRole:output_type -> auth.GetUserRoleResponse
+	19, // [19:30] is the sub-list for method output_type
+	8,  // [8:19] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -1240,7 +1346,7 @@ func file_proto_auth_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_auth_auth_proto_rawDesc), len(file_proto_auth_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
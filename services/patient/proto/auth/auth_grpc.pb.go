@@ -31,6 +31,7 @@ const (
 	AuthService_Auth_FullMethodName                     = "/auth.AuthService/Auth"
 	AuthService_PermissionCheck_FullMethodName          = "/auth.AuthService/PermissionCheck"
 	AuthService_GetPatient_FullMethodName               = "/auth.AuthService/GetPatient"
+	AuthService_GetUserRole_FullMethodName              = "/auth.AuthService/GetUserRole"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -47,6 +48,7 @@ type AuthServiceClient interface {
 	Auth(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 	PermissionCheck(ctx context.Context, in *PermissionCheckRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	GetPatient(ctx context.Context, in *GetPatientRequest, opts ...grpc.CallOption) (*GetPatientResponse, error)
+	GetUserRole(ctx context.Context, in *GetUserIDRequest, opts ...grpc.CallOption) (*GetUserRoleResponse, error)
 }
 
 type authServiceClient struct {
@@ -157,6 +159,16 @@ func (c *authServiceClient) GetPatient(ctx context.Context, in *GetPatientReques
 	return out, nil
 }
 
+func (c *authServiceClient) GetUserRole(ctx context.Context, in *GetUserIDRequest, opts ...grpc.CallOption) (*GetUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserRoleResponse)
+	err := c.cc.Invoke(ctx, AuthService_GetUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
@@ -171,6 +183,7 @@ type AuthServiceServer interface {
 	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
 	PermissionCheck(context.Context, *PermissionCheckRequest) (*DefaultResponse, error)
 	GetPatient(context.Context, *GetPatientRequest) (*GetPatientResponse, error)
+	GetUserRole(context.Context, *GetUserIDRequest) (*GetUserRoleResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -211,6 +224,9 @@ func (UnimplementedAuthServiceServer) PermissionCheck(context.Context, *Permissi
 func (UnimplementedAuthServiceServer) GetPatient(context.Context, *GetPatientRequest) (*GetPatientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPatient not implemented")
 }
+func (UnimplementedAuthServiceServer) GetUserRole(context.Context, *GetUserIDRequest) (*GetUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserRole not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -412,6 +428,24 @@ func _AuthService_GetPatient_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_GetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetUserRole(ctx, req.(*GetUserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -459,6 +493,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPatient",
 			Handler:    _AuthService_GetPatient_Handler,
 		},
+		{
+			MethodName: "GetUserRole",
+			Handler:    _AuthService_GetUserRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/auth/auth.proto",
@@ -25,6 +25,7 @@ const (
 type GetAppointmentSlotsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DoctorId      int32                  `protobuf:"varint,1,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	ServiceIds    []int32                `protobuf:"varint,2,rep,packed,name=service_ids,json=serviceIds,proto3" json:"service_ids,omitempty"` // услуги приёма; по их длительности ищется достаточный свободный промежуток
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,6 +67,13 @@ func (x *GetAppointmentSlotsRequest) GetDoctorId() int32 {
 	return 0
 }
 
+func (x *GetAppointmentSlotsRequest) GetServiceIds() []int32 {
+	if x != nil {
+		return x.ServiceIds
+	}
+	return nil
+}
+
 type GetAppointmentSlotsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Slots         []*DaySlots            `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
@@ -313,6 +321,9 @@ func (x *Appointment) GetUpdatedAt() *timestamppb.Timestamp {
 type AddAppointmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	HoldId        string                 `protobuf:"bytes,2,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	ServiceIds    []int32                `protobuf:"varint,3,rep,packed,name=service_ids,json=serviceIds,proto3" json:"service_ids,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"` // нужен только с hold_id: записаться по удержанию может лишь тот, кто его удерживает
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -354,6 +365,223 @@ func (x *AddAppointmentRequest) GetAppointment() *Appointment {
 	return nil
 }
 
+func (x *AddAppointmentRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *AddAppointmentRequest) GetServiceIds() []int32 {
+	if x != nil {
+		return x.ServiceIds
+	}
+	return nil
+}
+
+func (x *AddAppointmentRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type HoldAppointmentSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"` // при повторном запросе удержание продлевается
+	DoctorId      int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	PatientId     int32                  `protobuf:"varint,5,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"` // для пациента не используется: он удерживает слот только для себя
+	ServiceIds    []int32                `protobuf:"varint,6,rep,packed,name=service_ids,json=serviceIds,proto3" json:"service_ids,omitempty"`
+	Token         string                 `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldAppointmentSlotRequest) Reset() {
+	*x = HoldAppointmentSlotRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldAppointmentSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldAppointmentSlotRequest) ProtoMessage() {}
+
+func (x *HoldAppointmentSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldAppointmentSlotRequest.ProtoReflect.Descriptor instead.
+func (*HoldAppointmentSlotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HoldAppointmentSlotRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *HoldAppointmentSlotRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *HoldAppointmentSlotRequest) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *HoldAppointmentSlotRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *HoldAppointmentSlotRequest) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *HoldAppointmentSlotRequest) GetServiceIds() []int32 {
+	if x != nil {
+		return x.ServiceIds
+	}
+	return nil
+}
+
+func (x *HoldAppointmentSlotRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type HoldAppointmentSlotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HoldAppointmentSlotResponse) Reset() {
+	*x = HoldAppointmentSlotResponse{}
+	mi := &file_proto_patient_patient_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldAppointmentSlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldAppointmentSlotResponse) ProtoMessage() {}
+
+func (x *HoldAppointmentSlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldAppointmentSlotResponse.ProtoReflect.Descriptor instead.
+func (*HoldAppointmentSlotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *HoldAppointmentSlotResponse) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *HoldAppointmentSlotResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ReleaseAppointmentSlotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldId        string                 `protobuf:"bytes,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseAppointmentSlotRequest) Reset() {
+	*x = ReleaseAppointmentSlotRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseAppointmentSlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseAppointmentSlotRequest) ProtoMessage() {}
+
+func (x *ReleaseAppointmentSlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseAppointmentSlotRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseAppointmentSlotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReleaseAppointmentSlotRequest) GetHoldId() string {
+	if x != nil {
+		return x.HoldId
+	}
+	return ""
+}
+
+func (x *ReleaseAppointmentSlotRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
 type DefaultResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -362,7 +590,7 @@ type DefaultResponse struct {
 
 func (x *DefaultResponse) Reset() {
 	*x = DefaultResponse{}
-	mi := &file_proto_patient_patient_proto_msgTypes[5]
+	mi := &file_proto_patient_patient_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -374,7 +602,7 @@ func (x *DefaultResponse) String() string {
 func (*DefaultResponse) ProtoMessage() {}
 
 func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[5]
+	mi := &file_proto_patient_patient_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,7 +615,7 @@ func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
 func (*DefaultResponse) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{5}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{8}
 }
 
 type UpcomingAppointments struct {
@@ -404,7 +632,7 @@ type UpcomingAppointments struct {
 
 func (x *UpcomingAppointments) Reset() {
 	*x = UpcomingAppointments{}
-	mi := &file_proto_patient_patient_proto_msgTypes[6]
+	mi := &file_proto_patient_patient_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -416,7 +644,7 @@ func (x *UpcomingAppointments) String() string {
 func (*UpcomingAppointments) ProtoMessage() {}
 
 func (x *UpcomingAppointments) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[6]
+	mi := &file_proto_patient_patient_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -429,7 +657,7 @@ func (x *UpcomingAppointments) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpcomingAppointments.ProtoReflect.Descriptor instead.
 func (*UpcomingAppointments) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{6}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpcomingAppointments) GetId() int32 {
@@ -477,13 +705,14 @@ func (x *UpcomingAppointments) GetSpecialty() string {
 type UpdateAppointmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Appointment   *Appointment           `protobuf:"bytes,1,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateAppointmentRequest) Reset() {
 	*x = UpdateAppointmentRequest{}
-	mi := &file_proto_patient_patient_proto_msgTypes[7]
+	mi := &file_proto_patient_patient_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -495,7 +724,7 @@ func (x *UpdateAppointmentRequest) String() string {
 func (*UpdateAppointmentRequest) ProtoMessage() {}
 
 func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[7]
+	mi := &file_proto_patient_patient_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +737,7 @@ func (x *UpdateAppointmentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAppointmentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAppointmentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{7}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateAppointmentRequest) GetAppointment() *Appointment {
@@ -518,6 +747,65 @@ func (x *UpdateAppointmentRequest) GetAppointment() *Appointment {
 	return nil
 }
 
+func (x *UpdateAppointmentRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CancelAppointmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAppointmentRequest) Reset() {
+	*x = CancelAppointmentRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAppointmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAppointmentRequest) ProtoMessage() {}
+
+func (x *CancelAppointmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAppointmentRequest.ProtoReflect.Descriptor instead.
+func (*CancelAppointmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelAppointmentRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelAppointmentRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
 type GetUpcomingAppointmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -527,7 +815,7 @@ type GetUpcomingAppointmentsRequest struct {
 
 func (x *GetUpcomingAppointmentsRequest) Reset() {
 	*x = GetUpcomingAppointmentsRequest{}
-	mi := &file_proto_patient_patient_proto_msgTypes[8]
+	mi := &file_proto_patient_patient_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -539,7 +827,7 @@ func (x *GetUpcomingAppointmentsRequest) String() string {
 func (*GetUpcomingAppointmentsRequest) ProtoMessage() {}
 
 func (x *GetUpcomingAppointmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[8]
+	mi := &file_proto_patient_patient_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +840,7 @@ func (x *GetUpcomingAppointmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpcomingAppointmentsRequest.ProtoReflect.Descriptor instead.
 func (*GetUpcomingAppointmentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{8}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetUpcomingAppointmentsRequest) GetToken() string {
@@ -571,7 +859,7 @@ type GetUpcomingAppointmentsResponse struct {
 
 func (x *GetUpcomingAppointmentsResponse) Reset() {
 	*x = GetUpcomingAppointmentsResponse{}
-	mi := &file_proto_patient_patient_proto_msgTypes[9]
+	mi := &file_proto_patient_patient_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -583,7 +871,7 @@ func (x *GetUpcomingAppointmentsResponse) String() string {
 func (*GetUpcomingAppointmentsResponse) ProtoMessage() {}
 
 func (x *GetUpcomingAppointmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[9]
+	mi := &file_proto_patient_patient_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,7 +884,7 @@ func (x *GetUpcomingAppointmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpcomingAppointmentsResponse.ProtoReflect.Descriptor instead.
 func (*GetUpcomingAppointmentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{9}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetUpcomingAppointmentsResponse) GetAppointments() []*UpcomingAppointments {
@@ -615,7 +903,7 @@ type GetByIDRequest struct {
 
 func (x *GetByIDRequest) Reset() {
 	*x = GetByIDRequest{}
-	mi := &file_proto_patient_patient_proto_msgTypes[10]
+	mi := &file_proto_patient_patient_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -627,7 +915,7 @@ func (x *GetByIDRequest) String() string {
 func (*GetByIDRequest) ProtoMessage() {}
 
 func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[10]
+	mi := &file_proto_patient_patient_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +928,7 @@ func (x *GetByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{10}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetByIDRequest) GetId() int32 {
@@ -659,7 +947,7 @@ type GetHistoryVisitsRequest struct {
 
 func (x *GetHistoryVisitsRequest) Reset() {
 	*x = GetHistoryVisitsRequest{}
-	mi := &file_proto_patient_patient_proto_msgTypes[11]
+	mi := &file_proto_patient_patient_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -671,7 +959,7 @@ func (x *GetHistoryVisitsRequest) String() string {
 func (*GetHistoryVisitsRequest) ProtoMessage() {}
 
 func (x *GetHistoryVisitsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[11]
+	mi := &file_proto_patient_patient_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,7 +972,7 @@ func (x *GetHistoryVisitsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoryVisitsRequest.ProtoReflect.Descriptor instead.
 func (*GetHistoryVisitsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{11}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetHistoryVisitsRequest) GetToken() string {
@@ -708,7 +996,7 @@ type HistoryVisit struct {
 
 func (x *HistoryVisit) Reset() {
 	*x = HistoryVisit{}
-	mi := &file_proto_patient_patient_proto_msgTypes[12]
+	mi := &file_proto_patient_patient_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -720,7 +1008,7 @@ func (x *HistoryVisit) String() string {
 func (*HistoryVisit) ProtoMessage() {}
 
 func (x *HistoryVisit) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[12]
+	mi := &file_proto_patient_patient_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +1021,7 @@ func (x *HistoryVisit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryVisit.ProtoReflect.Descriptor instead.
 func (*HistoryVisit) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{12}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *HistoryVisit) GetId() int32 {
@@ -787,7 +1075,7 @@ type GetHistoryVisitsResponse struct {
 
 func (x *GetHistoryVisitsResponse) Reset() {
 	*x = GetHistoryVisitsResponse{}
-	mi := &file_proto_patient_patient_proto_msgTypes[13]
+	mi := &file_proto_patient_patient_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -799,7 +1087,7 @@ func (x *GetHistoryVisitsResponse) String() string {
 func (*GetHistoryVisitsResponse) ProtoMessage() {}
 
 func (x *GetHistoryVisitsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[13]
+	mi := &file_proto_patient_patient_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1100,7 @@ func (x *GetHistoryVisitsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoryVisitsResponse.ProtoReflect.Descriptor instead.
 func (*GetHistoryVisitsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{13}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetHistoryVisitsResponse) GetVisits() []*HistoryVisit {
@@ -822,11 +1110,13 @@ func (x *GetHistoryVisitsResponse) GetVisits() []*HistoryVisit {
 	return nil
 }
 
+// Часть загружаемого снимка. token и file_name читаются из первого сообщения потока, description —
+// из любого: в форме описание может идти после файла
 type UploadTestRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                                // токен авторизации
-	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`          // имя файла
-	FileContent   []byte                 `protobuf:"bytes,3,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"` // содержимое DICOM-файла
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                       // токен авторизации
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // имя файла
+	Chunk         []byte                 `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`                       // очередная часть DICOM-файла
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -834,7 +1124,7 @@ type UploadTestRequest struct {
 
 func (x *UploadTestRequest) Reset() {
 	*x = UploadTestRequest{}
-	mi := &file_proto_patient_patient_proto_msgTypes[14]
+	mi := &file_proto_patient_patient_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -846,7 +1136,7 @@ func (x *UploadTestRequest) String() string {
 func (*UploadTestRequest) ProtoMessage() {}
 
 func (x *UploadTestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[14]
+	mi := &file_proto_patient_patient_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +1149,7 @@ func (x *UploadTestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadTestRequest.ProtoReflect.Descriptor instead.
 func (*UploadTestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{14}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UploadTestRequest) GetToken() string {
@@ -876,9 +1166,9 @@ func (x *UploadTestRequest) GetFileName() string {
 	return ""
 }
 
-func (x *UploadTestRequest) GetFileContent() []byte {
+func (x *UploadTestRequest) GetChunk() []byte {
 	if x != nil {
-		return x.FileContent
+		return x.Chunk
 	}
 	return nil
 }
@@ -899,7 +1189,7 @@ type UploadTestResponse struct {
 
 func (x *UploadTestResponse) Reset() {
 	*x = UploadTestResponse{}
-	mi := &file_proto_patient_patient_proto_msgTypes[15]
+	mi := &file_proto_patient_patient_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -911,7 +1201,7 @@ func (x *UploadTestResponse) String() string {
 func (*UploadTestResponse) ProtoMessage() {}
 
 func (x *UploadTestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[15]
+	mi := &file_proto_patient_patient_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,7 +1214,7 @@ func (x *UploadTestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadTestResponse.ProtoReflect.Descriptor instead.
 func (*UploadTestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{15}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UploadTestResponse) GetDocumentId() string {
@@ -943,7 +1233,7 @@ type GetDocumentsRequest struct {
 
 func (x *GetDocumentsRequest) Reset() {
 	*x = GetDocumentsRequest{}
-	mi := &file_proto_patient_patient_proto_msgTypes[16]
+	mi := &file_proto_patient_patient_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -955,7 +1245,7 @@ func (x *GetDocumentsRequest) String() string {
 func (*GetDocumentsRequest) ProtoMessage() {}
 
 func (x *GetDocumentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[16]
+	mi := &file_proto_patient_patient_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,7 +1258,7 @@ func (x *GetDocumentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentsRequest.ProtoReflect.Descriptor instead.
 func (*GetDocumentsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{16}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetDocumentsRequest) GetToken() string {
@@ -988,7 +1278,7 @@ type GetDocumentsResponse struct {
 
 func (x *GetDocumentsResponse) Reset() {
 	*x = GetDocumentsResponse{}
-	mi := &file_proto_patient_patient_proto_msgTypes[17]
+	mi := &file_proto_patient_patient_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1000,7 +1290,7 @@ func (x *GetDocumentsResponse) String() string {
 func (*GetDocumentsResponse) ProtoMessage() {}
 
 func (x *GetDocumentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[17]
+	mi := &file_proto_patient_patient_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,7 +1303,7 @@ func (x *GetDocumentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDocumentsResponse.ProtoReflect.Descriptor instead.
 func (*GetDocumentsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{17}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetDocumentsResponse) GetDocuments() []*DocumentInfo {
@@ -1036,7 +1326,7 @@ type DocumentInfo struct {
 
 func (x *DocumentInfo) Reset() {
 	*x = DocumentInfo{}
-	mi := &file_proto_patient_patient_proto_msgTypes[18]
+	mi := &file_proto_patient_patient_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1048,7 +1338,7 @@ func (x *DocumentInfo) String() string {
 func (*DocumentInfo) ProtoMessage() {}
 
 func (x *DocumentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[18]
+	mi := &file_proto_patient_patient_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1061,7 +1351,7 @@ func (x *DocumentInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DocumentInfo.ProtoReflect.Descriptor instead.
 func (*DocumentInfo) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{18}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *DocumentInfo) GetId() string {
@@ -1096,13 +1386,15 @@ func (x *DocumentInfo) GetCreatedAt() string {
 type DownloadDocumentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"` // UUID документа
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                          // с какого байта продолжить скачивание
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DownloadDocumentRequest) Reset() {
 	*x = DownloadDocumentRequest{}
-	mi := &file_proto_patient_patient_proto_msgTypes[19]
+	mi := &file_proto_patient_patient_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1114,7 +1406,7 @@ func (x *DownloadDocumentRequest) String() string {
 func (*DownloadDocumentRequest) ProtoMessage() {}
 
 func (x *DownloadDocumentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[19]
+	mi := &file_proto_patient_patient_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,7 +1419,7 @@ func (x *DownloadDocumentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadDocumentRequest.ProtoReflect.Descriptor instead.
 func (*DownloadDocumentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{19}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DownloadDocumentRequest) GetDocumentId() string {
@@ -1137,18 +1429,33 @@ func (x *DownloadDocumentRequest) GetDocumentId() string {
 	return ""
 }
 
-// Ответ со скачанным файлом
+func (x *DownloadDocumentRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadDocumentRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Часть скачиваемого файла; file_name и size заполнены только в первом сообщении потока
 type DownloadDocumentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`          // Имя файла
-	FileContent   []byte                 `protobuf:"bytes,2,opt,name=file_content,json=fileContent,proto3" json:"file_content,omitempty"` // Содержимое файла
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // Имя файла
+	Chunk         []byte                 `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`                       // Очередная часть содержимого
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`                        // Полный размер файла
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DownloadDocumentResponse) Reset() {
 	*x = DownloadDocumentResponse{}
-	mi := &file_proto_patient_patient_proto_msgTypes[20]
+	mi := &file_proto_patient_patient_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1160,7 +1467,7 @@ func (x *DownloadDocumentResponse) String() string {
 func (*DownloadDocumentResponse) ProtoMessage() {}
 
 func (x *DownloadDocumentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_patient_patient_proto_msgTypes[20]
+	mi := &file_proto_patient_patient_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1173,7 +1480,7 @@ func (x *DownloadDocumentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadDocumentResponse.ProtoReflect.Descriptor instead.
 func (*DownloadDocumentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_patient_patient_proto_rawDescGZIP(), []int{20}
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *DownloadDocumentResponse) GetFileName() string {
@@ -1183,111 +1490,1837 @@ func (x *DownloadDocumentResponse) GetFileName() string {
 	return ""
 }
 
-func (x *DownloadDocumentResponse) GetFileContent() []byte {
+func (x *DownloadDocumentResponse) GetChunk() []byte {
 	if x != nil {
-		return x.FileContent
+		return x.Chunk
 	}
 	return nil
 }
 
-var File_proto_patient_patient_proto protoreflect.FileDescriptor
+func (x *DownloadDocumentResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
 
-const file_proto_patient_patient_proto_rawDesc = "" +
-	"\n" +
-	"\x1bproto/patient/patient.proto\x12\apatient\x1a\x1fgoogle/protobuf/timestamp.proto\"9\n" +
-	"\x1aGetAppointmentSlotsRequest\x12\x1b\n" +
-	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\"F\n" +
-	"\x1bGetAppointmentSlotsResponse\x12'\n" +
-	"\x05slots\x18\x01 \x03(\v2\x11.patient.DaySlotsR\x05slots\"4\n" +
-	"\bDaySlots\x12\x12\n" +
-	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
-	"\x05slots\x18\x02 \x03(\tR\x05slots\"\x97\x04\n" +
-	"\vAppointment\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
-	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12.\n" +
-	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12.\n" +
-	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1d\n" +
-	"\n" +
-	"patient_id\x18\x05 \x01(\x05R\tpatientId\x12\x1f\n" +
-	"\vsecond_name\x18\x06 \x01(\tR\n" +
-	"secondName\x12\x1d\n" +
-	"\n" +
-	"first_name\x18\a \x01(\tR\tfirstName\x12\x18\n" +
-	"\asurname\x18\b \x01(\tR\asurname\x129\n" +
-	"\n" +
-	"birth_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tbirthDate\x12\x16\n" +
-	"\x06gender\x18\n" +
-	" \x01(\tR\x06gender\x12!\n" +
-	"\fphone_number\x18\v \x01(\tR\vphoneNumber\x12\x16\n" +
-	"\x06status\x18\f \x01(\tR\x06status\x129\n" +
-	"\n" +
-	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"O\n" +
-	"\x15AddAppointmentRequest\x126\n" +
-	"\vappointment\x18\x01 \x01(\v2\x14.patient.AppointmentR\vappointment\"\x11\n" +
-	"\x0fDefaultResponse\"\xa1\x01\n" +
-	"\x14UpcomingAppointments\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04date\x18\x02 \x01(\tR\x04date\x12\x12\n" +
-	"\x04time\x18\x03 \x01(\tR\x04time\x12\x1b\n" +
-	"\tdoctor_id\x18\x04 \x01(\x05R\bdoctorId\x12\x16\n" +
-	"\x06doctor\x18\x05 \x01(\tR\x06doctor\x12\x1c\n" +
-	"\tspecialty\x18\x06 \x01(\tR\tspecialty\"R\n" +
-	"\x18UpdateAppointmentRequest\x126\n" +
-	"\vappointment\x18\x01 \x01(\v2\x14.patient.AppointmentR\vappointment\"6\n" +
-	"\x1eGetUpcomingAppointmentsRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"d\n" +
-	"\x1fGetUpcomingAppointmentsResponse\x12A\n" +
-	"\fappointments\x18\x01 \x03(\v2\x1d.patient.UpcomingAppointmentsR\fappointments\" \n" +
-	"\x0eGetByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\"/\n" +
-	"\x17GetHistoryVisitsRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"\xa1\x01\n" +
-	"\fHistoryVisit\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
-	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
-	"\tdoctor_id\x18\x03 \x01(\x05R\bdoctorId\x12\x16\n" +
-	"\x06doctor\x18\x04 \x01(\tR\x06doctor\x12\x1a\n" +
-	"\bdiagnose\x18\x05 \x01(\tR\bdiagnose\x12\x1c\n" +
-	"\ttreatment\x18\x06 \x01(\tR\ttreatment\"I\n" +
-	"\x18GetHistoryVisitsResponse\x12-\n" +
-	"\x06visits\x18\x01 \x03(\v2\x15.patient.HistoryVisitR\x06visits\"\x8b\x01\n" +
-	"\x11UploadTestRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
-	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
-	"\ffile_content\x18\x03 \x01(\fR\vfileContent\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\"5\n" +
-	"\x12UploadTestResponse\x12\x1f\n" +
-	"\vdocument_id\x18\x01 \x01(\tR\n" +
-	"documentId\"+\n" +
-	"\x13GetDocumentsRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"K\n" +
-	"\x14GetDocumentsResponse\x123\n" +
-	"\tdocuments\x18\x01 \x03(\v2\x15.patient.DocumentInfoR\tdocuments\"|\n" +
-	"\fDocumentInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
-	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\":\n" +
-	"\x17DownloadDocumentRequest\x12\x1f\n" +
-	"\vdocument_id\x18\x01 \x01(\tR\n" +
-	"documentId\"Z\n" +
-	"\x18DownloadDocumentResponse\x12\x1b\n" +
+type JoinWaitingListRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Token            string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	DoctorId         int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"` // врач; 0 — любой врач специальности
+	SpecializationId int32                  `protobuf:"varint,3,opt,name=specialization_id,json=specializationId,proto3" json:"specialization_id,omitempty"`
+	DateFrom         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *JoinWaitingListRequest) Reset() {
+	*x = JoinWaitingListRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitingListRequest) ProtoMessage() {}
+
+func (x *JoinWaitingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitingListRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitingListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *JoinWaitingListRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *JoinWaitingListRequest) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *JoinWaitingListRequest) GetSpecializationId() int32 {
+	if x != nil {
+		return x.SpecializationId
+	}
+	return 0
+}
+
+func (x *JoinWaitingListRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *JoinWaitingListRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+type JoinWaitingListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       int32                  `protobuf:"varint,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitingListResponse) Reset() {
+	*x = JoinWaitingListResponse{}
+	mi := &file_proto_patient_patient_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitingListResponse) ProtoMessage() {}
+
+func (x *JoinWaitingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitingListResponse.ProtoReflect.Descriptor instead.
+func (*JoinWaitingListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *JoinWaitingListResponse) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+type WaitingListEntry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DoctorId         int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Doctor           string                 `protobuf:"bytes,3,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	SpecializationId int32                  `protobuf:"varint,4,opt,name=specialization_id,json=specializationId,proto3" json:"specialization_id,omitempty"`
+	Specialty        string                 `protobuf:"bytes,5,opt,name=specialty,proto3" json:"specialty,omitempty"`
+	DateFrom         string                 `protobuf:"bytes,6,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo           string                 `protobuf:"bytes,7,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	Status           string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WaitingListEntry) Reset() {
+	*x = WaitingListEntry{}
+	mi := &file_proto_patient_patient_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitingListEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitingListEntry) ProtoMessage() {}
+
+func (x *WaitingListEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitingListEntry.ProtoReflect.Descriptor instead.
+func (*WaitingListEntry) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WaitingListEntry) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WaitingListEntry) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *WaitingListEntry) GetDoctor() string {
+	if x != nil {
+		return x.Doctor
+	}
+	return ""
+}
+
+func (x *WaitingListEntry) GetSpecializationId() int32 {
+	if x != nil {
+		return x.SpecializationId
+	}
+	return 0
+}
+
+func (x *WaitingListEntry) GetSpecialty() string {
+	if x != nil {
+		return x.Specialty
+	}
+	return ""
+}
+
+func (x *WaitingListEntry) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *WaitingListEntry) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *WaitingListEntry) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// предложение освободившегося слота; принять его можно до expires_at
+type WaitingListOffer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DoctorId      int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Doctor        string                 `protobuf:"bytes,3,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Time          string                 `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitingListOffer) Reset() {
+	*x = WaitingListOffer{}
+	mi := &file_proto_patient_patient_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitingListOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitingListOffer) ProtoMessage() {}
+
+func (x *WaitingListOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitingListOffer.ProtoReflect.Descriptor instead.
+func (*WaitingListOffer) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WaitingListOffer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitingListOffer) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *WaitingListOffer) GetDoctor() string {
+	if x != nil {
+		return x.Doctor
+	}
+	return ""
+}
+
+func (x *WaitingListOffer) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *WaitingListOffer) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *WaitingListOffer) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type GetWaitingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitingListRequest) Reset() {
+	*x = GetWaitingListRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitingListRequest) ProtoMessage() {}
+
+func (x *GetWaitingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitingListRequest.ProtoReflect.Descriptor instead.
+func (*GetWaitingListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetWaitingListRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetWaitingListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WaitingListEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Offers        []*WaitingListOffer    `protobuf:"bytes,2,rep,name=offers,proto3" json:"offers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitingListResponse) Reset() {
+	*x = GetWaitingListResponse{}
+	mi := &file_proto_patient_patient_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitingListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitingListResponse) ProtoMessage() {}
+
+func (x *GetWaitingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitingListResponse.ProtoReflect.Descriptor instead.
+func (*GetWaitingListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetWaitingListResponse) GetEntries() []*WaitingListEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetWaitingListResponse) GetOffers() []*WaitingListOffer {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+type LeaveWaitingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	EntryId       int32                  `protobuf:"varint,2,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitingListRequest) Reset() {
+	*x = LeaveWaitingListRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitingListRequest) ProtoMessage() {}
+
+func (x *LeaveWaitingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitingListRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitingListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LeaveWaitingListRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LeaveWaitingListRequest) GetEntryId() int32 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+type WaitingListOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	OfferId       string                 `protobuf:"bytes,2,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitingListOfferRequest) Reset() {
+	*x = WaitingListOfferRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitingListOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitingListOfferRequest) ProtoMessage() {}
+
+func (x *WaitingListOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitingListOfferRequest.ProtoReflect.Descriptor instead.
+func (*WaitingListOfferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WaitingListOfferRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *WaitingListOfferRequest) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
+type AcceptWaitingListOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppointmentId int32                  `protobuf:"varint,1,opt,name=appointment_id,json=appointmentId,proto3" json:"appointment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptWaitingListOfferResponse) Reset() {
+	*x = AcceptWaitingListOfferResponse{}
+	mi := &file_proto_patient_patient_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptWaitingListOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptWaitingListOfferResponse) ProtoMessage() {}
+
+func (x *AcceptWaitingListOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptWaitingListOfferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptWaitingListOfferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AcceptWaitingListOfferResponse) GetAppointmentId() int32 {
+	if x != nil {
+		return x.AppointmentId
+	}
+	return 0
+}
+
+// Позиция плана лечения; tooth — номер зуба по FDI, 0 — без привязки к зубу.
+// status — planned, in_progress, done, cancelled
+type TreatmentPlanItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Stage          int32                  `protobuf:"varint,2,opt,name=stage,proto3" json:"stage,omitempty"`
+	Tooth          int32                  `protobuf:"varint,3,opt,name=tooth,proto3" json:"tooth,omitempty"`
+	ServiceName    string                 `protobuf:"bytes,4,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Quantity       int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	EstimatedPrice int32                  `protobuf:"varint,6,opt,name=estimated_price,json=estimatedPrice,proto3" json:"estimated_price,omitempty"` // цена за единицу на день составления плана
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Note           string                 `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	CompletedAt    string                 `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TreatmentPlanItem) Reset() {
+	*x = TreatmentPlanItem{}
+	mi := &file_proto_patient_patient_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentPlanItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentPlanItem) ProtoMessage() {}
+
+func (x *TreatmentPlanItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentPlanItem.ProtoReflect.Descriptor instead.
+func (*TreatmentPlanItem) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TreatmentPlanItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetTooth() int32 {
+	if x != nil {
+		return x.Tooth
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *TreatmentPlanItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetEstimatedPrice() int32 {
+	if x != nil {
+		return x.EstimatedPrice
+	}
+	return 0
+}
+
+func (x *TreatmentPlanItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TreatmentPlanItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *TreatmentPlanItem) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+// План лечения; status — proposed (ждёт принятия пациентом), accepted, in_progress, done, cancelled
+type TreatmentPlan struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DoctorId       int32                  `protobuf:"varint,2,opt,name=doctor_id,json=doctorId,proto3" json:"doctor_id,omitempty"`
+	Doctor         string                 `protobuf:"bytes,3,opt,name=doctor,proto3" json:"doctor,omitempty"`
+	Title          string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	AcceptedAt     string                 `protobuf:"bytes,6,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Items          []*TreatmentPlanItem   `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	EstimatedTotal int32                  `protobuf:"varint,9,opt,name=estimated_total,json=estimatedTotal,proto3" json:"estimated_total,omitempty"`
+	RemainingCost  int32                  `protobuf:"varint,10,opt,name=remaining_cost,json=remainingCost,proto3" json:"remaining_cost,omitempty"` // сколько осталось оплатить по невыполненным позициям
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TreatmentPlan) Reset() {
+	*x = TreatmentPlan{}
+	mi := &file_proto_patient_patient_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreatmentPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreatmentPlan) ProtoMessage() {}
+
+func (x *TreatmentPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreatmentPlan.ProtoReflect.Descriptor instead.
+func (*TreatmentPlan) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TreatmentPlan) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TreatmentPlan) GetDoctorId() int32 {
+	if x != nil {
+		return x.DoctorId
+	}
+	return 0
+}
+
+func (x *TreatmentPlan) GetDoctor() string {
+	if x != nil {
+		return x.Doctor
+	}
+	return ""
+}
+
+func (x *TreatmentPlan) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TreatmentPlan) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TreatmentPlan) GetAcceptedAt() string {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return ""
+}
+
+func (x *TreatmentPlan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *TreatmentPlan) GetItems() []*TreatmentPlanItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *TreatmentPlan) GetEstimatedTotal() int32 {
+	if x != nil {
+		return x.EstimatedTotal
+	}
+	return 0
+}
+
+func (x *TreatmentPlan) GetRemainingCost() int32 {
+	if x != nil {
+		return x.RemainingCost
+	}
+	return 0
+}
+
+type GetTreatmentPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTreatmentPlansRequest) Reset() {
+	*x = GetTreatmentPlansRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTreatmentPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreatmentPlansRequest) ProtoMessage() {}
+
+func (x *GetTreatmentPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreatmentPlansRequest.ProtoReflect.Descriptor instead.
+func (*GetTreatmentPlansRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetTreatmentPlansRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetTreatmentPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*TreatmentPlan       `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	RemainingCost int32                  `protobuf:"varint,2,opt,name=remaining_cost,json=remainingCost,proto3" json:"remaining_cost,omitempty"` // по всем принятым и проводимым планам
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTreatmentPlansResponse) Reset() {
+	*x = GetTreatmentPlansResponse{}
+	mi := &file_proto_patient_patient_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTreatmentPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreatmentPlansResponse) ProtoMessage() {}
+
+func (x *GetTreatmentPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreatmentPlansResponse.ProtoReflect.Descriptor instead.
+func (*GetTreatmentPlansResponse) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTreatmentPlansResponse) GetPlans() []*TreatmentPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+func (x *GetTreatmentPlansResponse) GetRemainingCost() int32 {
+	if x != nil {
+		return x.RemainingCost
+	}
+	return 0
+}
+
+type AcceptTreatmentPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	PlanId        int32                  `protobuf:"varint,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTreatmentPlanRequest) Reset() {
+	*x = AcceptTreatmentPlanRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTreatmentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTreatmentPlanRequest) ProtoMessage() {}
+
+func (x *AcceptTreatmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTreatmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTreatmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AcceptTreatmentPlanRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptTreatmentPlanRequest) GetPlanId() int32 {
+	if x != nil {
+		return x.PlanId
+	}
+	return 0
+}
+
+type AcceptTreatmentPlanResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *TreatmentPlan         `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTreatmentPlanResponse) Reset() {
+	*x = AcceptTreatmentPlanResponse{}
+	mi := &file_proto_patient_patient_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTreatmentPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTreatmentPlanResponse) ProtoMessage() {}
+
+func (x *AcceptTreatmentPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTreatmentPlanResponse.ProtoReflect.Descriptor instead.
+func (*AcceptTreatmentPlanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AcceptTreatmentPlanResponse) GetPlan() *TreatmentPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+type AnamnesisAllergy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allergen      string                 `protobuf:"bytes,1,opt,name=allergen,proto3" json:"allergen,omitempty"`
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"` // mild, moderate, severe
+	Reaction      string                 `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnamnesisAllergy) Reset() {
+	*x = AnamnesisAllergy{}
+	mi := &file_proto_patient_patient_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnamnesisAllergy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnamnesisAllergy) ProtoMessage() {}
+
+func (x *AnamnesisAllergy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnamnesisAllergy.ProtoReflect.Descriptor instead.
+func (*AnamnesisAllergy) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AnamnesisAllergy) GetAllergen() string {
+	if x != nil {
+		return x.Allergen
+	}
+	return ""
+}
+
+func (x *AnamnesisAllergy) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *AnamnesisAllergy) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+// Версия анкеты здоровья; version = 0 — анкета не заполнялась
+type Anamnesis struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Version            int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Medications        string                 `protobuf:"bytes,2,opt,name=medications,proto3" json:"medications,omitempty"`
+	BloodThinners      bool                   `protobuf:"varint,3,opt,name=blood_thinners,json=bloodThinners,proto3" json:"blood_thinners,omitempty"`
+	CardiacDisease     bool                   `protobuf:"varint,4,opt,name=cardiac_disease,json=cardiacDisease,proto3" json:"cardiac_disease,omitempty"`
+	Hypertension       bool                   `protobuf:"varint,5,opt,name=hypertension,proto3" json:"hypertension,omitempty"`
+	Diabetes           bool                   `protobuf:"varint,6,opt,name=diabetes,proto3" json:"diabetes,omitempty"`
+	BleedingDisorder   bool                   `protobuf:"varint,7,opt,name=bleeding_disorder,json=bleedingDisorder,proto3" json:"bleeding_disorder,omitempty"`
+	InfectiousDisease  bool                   `protobuf:"varint,8,opt,name=infectious_disease,json=infectiousDisease,proto3" json:"infectious_disease,omitempty"`
+	AnesthesiaReaction bool                   `protobuf:"varint,9,opt,name=anesthesia_reaction,json=anesthesiaReaction,proto3" json:"anesthesia_reaction,omitempty"`
+	Pregnant           bool                   `protobuf:"varint,10,opt,name=pregnant,proto3" json:"pregnant,omitempty"`
+	Breastfeeding      bool                   `protobuf:"varint,11,opt,name=breastfeeding,proto3" json:"breastfeeding,omitempty"`
+	Smoker             bool                   `protobuf:"varint,12,opt,name=smoker,proto3" json:"smoker,omitempty"`
+	OtherConditions    string                 `protobuf:"bytes,13,opt,name=other_conditions,json=otherConditions,proto3" json:"other_conditions,omitempty"`
+	Allergies          []*AnamnesisAllergy    `protobuf:"bytes,14,rep,name=allergies,proto3" json:"allergies,omitempty"`
+	ChangedByRole      string                 `protobuf:"bytes,15,opt,name=changed_by_role,json=changedByRole,proto3" json:"changed_by_role,omitempty"` // patient или doctor
+	ChangedBy          string                 `protobuf:"bytes,16,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`               // ФИО врача; для изменений самого пациента пусто
+	ChangedAt          string                 `protobuf:"bytes,17,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Anamnesis) Reset() {
+	*x = Anamnesis{}
+	mi := &file_proto_patient_patient_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Anamnesis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anamnesis) ProtoMessage() {}
+
+func (x *Anamnesis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anamnesis.ProtoReflect.Descriptor instead.
+func (*Anamnesis) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Anamnesis) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Anamnesis) GetMedications() string {
+	if x != nil {
+		return x.Medications
+	}
+	return ""
+}
+
+func (x *Anamnesis) GetBloodThinners() bool {
+	if x != nil {
+		return x.BloodThinners
+	}
+	return false
+}
+
+func (x *Anamnesis) GetCardiacDisease() bool {
+	if x != nil {
+		return x.CardiacDisease
+	}
+	return false
+}
+
+func (x *Anamnesis) GetHypertension() bool {
+	if x != nil {
+		return x.Hypertension
+	}
+	return false
+}
+
+func (x *Anamnesis) GetDiabetes() bool {
+	if x != nil {
+		return x.Diabetes
+	}
+	return false
+}
+
+func (x *Anamnesis) GetBleedingDisorder() bool {
+	if x != nil {
+		return x.BleedingDisorder
+	}
+	return false
+}
+
+func (x *Anamnesis) GetInfectiousDisease() bool {
+	if x != nil {
+		return x.InfectiousDisease
+	}
+	return false
+}
+
+func (x *Anamnesis) GetAnesthesiaReaction() bool {
+	if x != nil {
+		return x.AnesthesiaReaction
+	}
+	return false
+}
+
+func (x *Anamnesis) GetPregnant() bool {
+	if x != nil {
+		return x.Pregnant
+	}
+	return false
+}
+
+func (x *Anamnesis) GetBreastfeeding() bool {
+	if x != nil {
+		return x.Breastfeeding
+	}
+	return false
+}
+
+func (x *Anamnesis) GetSmoker() bool {
+	if x != nil {
+		return x.Smoker
+	}
+	return false
+}
+
+func (x *Anamnesis) GetOtherConditions() string {
+	if x != nil {
+		return x.OtherConditions
+	}
+	return ""
+}
+
+func (x *Anamnesis) GetAllergies() []*AnamnesisAllergy {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
+
+func (x *Anamnesis) GetChangedByRole() string {
+	if x != nil {
+		return x.ChangedByRole
+	}
+	return ""
+}
+
+func (x *Anamnesis) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *Anamnesis) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+type GetAnamnesisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnamnesisRequest) Reset() {
+	*x = GetAnamnesisRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnamnesisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnamnesisRequest) ProtoMessage() {}
+
+func (x *GetAnamnesisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnamnesisRequest.ProtoReflect.Descriptor instead.
+func (*GetAnamnesisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetAnamnesisRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SaveAnamnesisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Anamnesis     *Anamnesis             `protobuf:"bytes,2,opt,name=anamnesis,proto3" json:"anamnesis,omitempty"`
+	BaseVersion   int32                  `protobuf:"varint,3,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"` // версия, которую видел пациент; при расхождении сохранение отклоняется
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveAnamnesisRequest) Reset() {
+	*x = SaveAnamnesisRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveAnamnesisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveAnamnesisRequest) ProtoMessage() {}
+
+func (x *SaveAnamnesisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveAnamnesisRequest.ProtoReflect.Descriptor instead.
+func (*SaveAnamnesisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SaveAnamnesisRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SaveAnamnesisRequest) GetAnamnesis() *Anamnesis {
+	if x != nil {
+		return x.Anamnesis
+	}
+	return nil
+}
+
+func (x *SaveAnamnesisRequest) GetBaseVersion() int32 {
+	if x != nil {
+		return x.BaseVersion
+	}
+	return 0
+}
+
+type AnamnesisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anamnesis     *Anamnesis             `protobuf:"bytes,1,opt,name=anamnesis,proto3" json:"anamnesis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnamnesisResponse) Reset() {
+	*x = AnamnesisResponse{}
+	mi := &file_proto_patient_patient_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnamnesisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnamnesisResponse) ProtoMessage() {}
+
+func (x *AnamnesisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnamnesisResponse.ProtoReflect.Descriptor instead.
+func (*AnamnesisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AnamnesisResponse) GetAnamnesis() *Anamnesis {
+	if x != nil {
+		return x.Anamnesis
+	}
+	return nil
+}
+
+type NotificationSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSettingsRequest) Reset() {
+	*x = NotificationSettingsRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSettingsRequest) ProtoMessage() {}
+
+func (x *NotificationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*NotificationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *NotificationSettingsRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type NotificationSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	OptOut        bool                   `protobuf:"varint,2,opt,name=opt_out,json=optOut,proto3" json:"opt_out,omitempty"` // пациент отказался от уведомлений о записях и напоминаний
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSettings) Reset() {
+	*x = NotificationSettings{}
+	mi := &file_proto_patient_patient_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSettings) ProtoMessage() {}
+
+func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSettings.ProtoReflect.Descriptor instead.
+func (*NotificationSettings) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *NotificationSettings) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *NotificationSettings) GetOptOut() bool {
+	if x != nil {
+		return x.OptOut
+	}
+	return false
+}
+
+// Выгрузка своей медицинской карты; format — pdf (печатная форма) или json (пакет в духе FHIR)
+type ExportMedicalRecordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMedicalRecordRequest) Reset() {
+	*x = ExportMedicalRecordRequest{}
+	mi := &file_proto_patient_patient_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMedicalRecordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMedicalRecordRequest) ProtoMessage() {}
+
+func (x *ExportMedicalRecordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMedicalRecordRequest.ProtoReflect.Descriptor instead.
+func (*ExportMedicalRecordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExportMedicalRecordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ExportMedicalRecordRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type MedicalRecordExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MedicalRecordExportResponse) Reset() {
+	*x = MedicalRecordExportResponse{}
+	mi := &file_proto_patient_patient_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MedicalRecordExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicalRecordExportResponse) ProtoMessage() {}
+
+func (x *MedicalRecordExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_patient_patient_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicalRecordExportResponse.ProtoReflect.Descriptor instead.
+func (*MedicalRecordExportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_patient_patient_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MedicalRecordExportResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *MedicalRecordExportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MedicalRecordExportResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+var File_proto_patient_patient_proto protoreflect.FileDescriptor
+
+const file_proto_patient_patient_proto_rawDesc = "" +
+	"\n" +
+	"\x1bproto/patient/patient.proto\x12\apatient\x1a\x1fgoogle/protobuf/timestamp.proto\"Z\n" +
+	"\x1aGetAppointmentSlotsRequest\x12\x1b\n" +
+	"\tdoctor_id\x18\x01 \x01(\x05R\bdoctorId\x12\x1f\n" +
+	"\vservice_ids\x18\x02 \x03(\x05R\n" +
+	"serviceIds\"F\n" +
+	"\x1bGetAppointmentSlotsResponse\x12'\n" +
+	"\x05slots\x18\x01 \x03(\v2\x11.patient.DaySlotsR\x05slots\"4\n" +
+	"\bDaySlots\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05slots\x18\x02 \x03(\tR\x05slots\"\x97\x04\n" +
+	"\vAppointment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x05 \x01(\x05R\tpatientId\x12\x1f\n" +
+	"\vsecond_name\x18\x06 \x01(\tR\n" +
+	"secondName\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\a \x01(\tR\tfirstName\x12\x18\n" +
+	"\asurname\x18\b \x01(\tR\asurname\x129\n" +
+	"\n" +
+	"birth_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tbirthDate\x12\x16\n" +
+	"\x06gender\x18\n" +
+	" \x01(\tR\x06gender\x12!\n" +
+	"\fphone_number\x18\v \x01(\tR\vphoneNumber\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9f\x01\n" +
+	"\x15AddAppointmentRequest\x126\n" +
+	"\vappointment\x18\x01 \x01(\v2\x14.patient.AppointmentR\vappointment\x12\x17\n" +
+	"\ahold_id\x18\x02 \x01(\tR\x06holdId\x12\x1f\n" +
+	"\vservice_ids\x18\x03 \x03(\x05R\n" +
+	"serviceIds\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"\x88\x02\n" +
+	"\x1aHoldAppointmentSlotRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12.\n" +
+	"\x04date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\x05 \x01(\x05R\tpatientId\x12\x1f\n" +
+	"\vservice_ids\x18\x06 \x03(\x05R\n" +
+	"serviceIds\x12\x14\n" +
+	"\x05token\x18\a \x01(\tR\x05token\"q\n" +
+	"\x1bHoldAppointmentSlotResponse\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"N\n" +
+	"\x1dReleaseAppointmentSlotRequest\x12\x17\n" +
+	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x11\n" +
+	"\x0fDefaultResponse\"\xa1\x01\n" +
+	"\x14UpcomingAppointments\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\tR\x04time\x12\x1b\n" +
+	"\tdoctor_id\x18\x04 \x01(\x05R\bdoctorId\x12\x16\n" +
+	"\x06doctor\x18\x05 \x01(\tR\x06doctor\x12\x1c\n" +
+	"\tspecialty\x18\x06 \x01(\tR\tspecialty\"h\n" +
+	"\x18UpdateAppointmentRequest\x126\n" +
+	"\vappointment\x18\x01 \x01(\v2\x14.patient.AppointmentR\vappointment\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"@\n" +
+	"\x18CancelAppointmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"6\n" +
+	"\x1eGetUpcomingAppointmentsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"d\n" +
+	"\x1fGetUpcomingAppointmentsResponse\x12A\n" +
+	"\fappointments\x18\x01 \x03(\v2\x1d.patient.UpcomingAppointmentsR\fappointments\" \n" +
+	"\x0eGetByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"/\n" +
+	"\x17GetHistoryVisitsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xa1\x01\n" +
+	"\fHistoryVisit\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
+	"\tdoctor_id\x18\x03 \x01(\x05R\bdoctorId\x12\x16\n" +
+	"\x06doctor\x18\x04 \x01(\tR\x06doctor\x12\x1a\n" +
+	"\bdiagnose\x18\x05 \x01(\tR\bdiagnose\x12\x1c\n" +
+	"\ttreatment\x18\x06 \x01(\tR\ttreatment\"I\n" +
+	"\x18GetHistoryVisitsResponse\x12-\n" +
+	"\x06visits\x18\x01 \x03(\v2\x15.patient.HistoryVisitR\x06visits\"~\n" +
+	"\x11UploadTestRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x14\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"5\n" +
+	"\x12UploadTestResponse\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\"+\n" +
+	"\x13GetDocumentsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"K\n" +
+	"\x14GetDocumentsResponse\x123\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x15.patient.DocumentInfoR\tdocuments\"|\n" +
+	"\fDocumentInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"h\n" +
+	"\x17DownloadDocumentRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\tR\n" +
+	"documentId\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"a\n" +
+	"\x18DownloadDocumentResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"\xe6\x01\n" +
+	"\x16JoinWaitingListRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12+\n" +
+	"\x11specialization_id\x18\x03 \x01(\x05R\x10specializationId\x127\n" +
+	"\tdate_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\"4\n" +
+	"\x17JoinWaitingListResponse\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\x05R\aentryId\"\xf0\x01\n" +
+	"\x10WaitingListEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12\x16\n" +
+	"\x06doctor\x18\x03 \x01(\tR\x06doctor\x12+\n" +
+	"\x11specialization_id\x18\x04 \x01(\x05R\x10specializationId\x12\x1c\n" +
+	"\tspecialty\x18\x05 \x01(\tR\tspecialty\x12\x1b\n" +
+	"\tdate_from\x18\x06 \x01(\tR\bdateFrom\x12\x17\n" +
+	"\adate_to\x18\a \x01(\tR\x06dateTo\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"\x9e\x01\n" +
+	"\x10WaitingListOffer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12\x16\n" +
+	"\x06doctor\x18\x03 \x01(\tR\x06doctor\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x12\n" +
+	"\x04time\x18\x05 \x01(\tR\x04time\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\"-\n" +
+	"\x15GetWaitingListRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x80\x01\n" +
+	"\x16GetWaitingListResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.patient.WaitingListEntryR\aentries\x121\n" +
+	"\x06offers\x18\x02 \x03(\v2\x19.patient.WaitingListOfferR\x06offers\"J\n" +
+	"\x17LeaveWaitingListRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\bentry_id\x18\x02 \x01(\x05R\aentryId\"J\n" +
+	"\x17WaitingListOfferRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\boffer_id\x18\x02 \x01(\tR\aofferId\"G\n" +
+	"\x1eAcceptWaitingListOfferResponse\x12%\n" +
+	"\x0eappointment_id\x18\x01 \x01(\x05R\rappointmentId\"\x86\x02\n" +
+	"\x11TreatmentPlanItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05stage\x18\x02 \x01(\x05R\x05stage\x12\x14\n" +
+	"\x05tooth\x18\x03 \x01(\x05R\x05tooth\x12!\n" +
+	"\fservice_name\x18\x04 \x01(\tR\vserviceName\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12'\n" +
+	"\x0festimated_price\x18\x06 \x01(\x05R\x0eestimatedPrice\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x12\n" +
+	"\x04note\x18\b \x01(\tR\x04note\x12!\n" +
+	"\fcompleted_at\x18\t \x01(\tR\vcompletedAt\"\xc4\x02\n" +
+	"\rTreatmentPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1b\n" +
+	"\tdoctor_id\x18\x02 \x01(\x05R\bdoctorId\x12\x16\n" +
+	"\x06doctor\x18\x03 \x01(\tR\x06doctor\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1f\n" +
+	"\vaccepted_at\x18\x06 \x01(\tR\n" +
+	"acceptedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x120\n" +
+	"\x05items\x18\b \x03(\v2\x1a.patient.TreatmentPlanItemR\x05items\x12'\n" +
+	"\x0festimated_total\x18\t \x01(\x05R\x0eestimatedTotal\x12%\n" +
+	"\x0eremaining_cost\x18\n" +
+	" \x01(\x05R\rremainingCost\"0\n" +
+	"\x18GetTreatmentPlansRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"p\n" +
+	"\x19GetTreatmentPlansResponse\x12,\n" +
+	"\x05plans\x18\x01 \x03(\v2\x16.patient.TreatmentPlanR\x05plans\x12%\n" +
+	"\x0eremaining_cost\x18\x02 \x01(\x05R\rremainingCost\"K\n" +
+	"\x1aAcceptTreatmentPlanRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
+	"\aplan_id\x18\x02 \x01(\x05R\x06planId\"I\n" +
+	"\x1bAcceptTreatmentPlanResponse\x12*\n" +
+	"\x04plan\x18\x01 \x01(\v2\x16.patient.TreatmentPlanR\x04plan\"f\n" +
+	"\x10AnamnesisAllergy\x12\x1a\n" +
+	"\ballergen\x18\x01 \x01(\tR\ballergen\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x1a\n" +
+	"\breaction\x18\x03 \x01(\tR\breaction\"\x88\x05\n" +
+	"\tAnamnesis\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12 \n" +
+	"\vmedications\x18\x02 \x01(\tR\vmedications\x12%\n" +
+	"\x0eblood_thinners\x18\x03 \x01(\bR\rbloodThinners\x12'\n" +
+	"\x0fcardiac_disease\x18\x04 \x01(\bR\x0ecardiacDisease\x12\"\n" +
+	"\fhypertension\x18\x05 \x01(\bR\fhypertension\x12\x1a\n" +
+	"\bdiabetes\x18\x06 \x01(\bR\bdiabetes\x12+\n" +
+	"\x11bleeding_disorder\x18\a \x01(\bR\x10bleedingDisorder\x12-\n" +
+	"\x12infectious_disease\x18\b \x01(\bR\x11infectiousDisease\x12/\n" +
+	"\x13anesthesia_reaction\x18\t \x01(\bR\x12anesthesiaReaction\x12\x1a\n" +
+	"\bpregnant\x18\n" +
+	" \x01(\bR\bpregnant\x12$\n" +
+	"\rbreastfeeding\x18\v \x01(\bR\rbreastfeeding\x12\x16\n" +
+	"\x06smoker\x18\f \x01(\bR\x06smoker\x12)\n" +
+	"\x10other_conditions\x18\r \x01(\tR\x0fotherConditions\x127\n" +
+	"\tallergies\x18\x0e \x03(\v2\x19.patient.AnamnesisAllergyR\tallergies\x12&\n" +
+	"\x0fchanged_by_role\x18\x0f \x01(\tR\rchangedByRole\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x10 \x01(\tR\tchangedBy\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x11 \x01(\tR\tchangedAt\"+\n" +
+	"\x13GetAnamnesisRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x81\x01\n" +
+	"\x14SaveAnamnesisRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x120\n" +
+	"\tanamnesis\x18\x02 \x01(\v2\x12.patient.AnamnesisR\tanamnesis\x12!\n" +
+	"\fbase_version\x18\x03 \x01(\x05R\vbaseVersion\"E\n" +
+	"\x11AnamnesisResponse\x120\n" +
+	"\tanamnesis\x18\x01 \x01(\v2\x12.patient.AnamnesisR\tanamnesis\"3\n" +
+	"\x1bNotificationSettingsRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"E\n" +
+	"\x14NotificationSettings\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x17\n" +
+	"\aopt_out\x18\x02 \x01(\bR\x06optOut\"J\n" +
+	"\x1aExportMedicalRecordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"w\n" +
+	"\x1bMedicalRecordExportResponse\x12\x1b\n" +
 	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
-	"\ffile_content\x18\x02 \x01(\fR\vfileContent2\x97\x06\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent2\x85\x10\n" +
 	"\x0ePatientService\x12`\n" +
 	"\x13GetAppointmentSlots\x12#.patient.GetAppointmentSlotsRequest\x1a$.patient.GetAppointmentSlotsResponse\x12J\n" +
-	"\x0eAddAppointment\x12\x1e.patient.AddAppointmentRequest\x1a\x18.patient.DefaultResponse\x12l\n" +
+	"\x0eAddAppointment\x12\x1e.patient.AddAppointmentRequest\x1a\x18.patient.DefaultResponse\x12`\n" +
+	"\x13HoldAppointmentSlot\x12#.patient.HoldAppointmentSlotRequest\x1a$.patient.HoldAppointmentSlotResponse\x12Z\n" +
+	"\x16ReleaseAppointmentSlot\x12&.patient.ReleaseAppointmentSlotRequest\x1a\x18.patient.DefaultResponse\x12l\n" +
 	"\x17GetUpcomingAppointments\x12'.patient.GetUpcomingAppointmentsRequest\x1a(.patient.GetUpcomingAppointmentsResponse\x12P\n" +
-	"\x11UpdateAppointment\x12!.patient.UpdateAppointmentRequest\x1a\x18.patient.DefaultResponse\x12F\n" +
-	"\x11CancelAppointment\x12\x17.patient.GetByIDRequest\x1a\x18.patient.DefaultResponse\x12W\n" +
-	"\x10GetHistoryVisits\x12 .patient.GetHistoryVisitsRequest\x1a!.patient.GetHistoryVisitsResponse\x12E\n" +
+	"\x11UpdateAppointment\x12!.patient.UpdateAppointmentRequest\x1a\x18.patient.DefaultResponse\x12P\n" +
+	"\x11CancelAppointment\x12!.patient.CancelAppointmentRequest\x1a\x18.patient.DefaultResponse\x12T\n" +
+	"\x0fJoinWaitingList\x12\x1f.patient.JoinWaitingListRequest\x1a .patient.JoinWaitingListResponse\x12Q\n" +
+	"\x0eGetWaitingList\x12\x1e.patient.GetWaitingListRequest\x1a\x1f.patient.GetWaitingListResponse\x12N\n" +
+	"\x10LeaveWaitingList\x12 .patient.LeaveWaitingListRequest\x1a\x18.patient.DefaultResponse\x12c\n" +
+	"\x16AcceptWaitingListOffer\x12 .patient.WaitingListOfferRequest\x1a'.patient.AcceptWaitingListOfferResponse\x12U\n" +
+	"\x17DeclineWaitingListOffer\x12 .patient.WaitingListOfferRequest\x1a\x18.patient.DefaultResponse\x12^\n" +
+	"\x17GetNotificationSettings\x12$.patient.NotificationSettingsRequest\x1a\x1d.patient.NotificationSettings\x12U\n" +
+	"\x1aUpdateNotificationSettings\x12\x1d.patient.NotificationSettings\x1a\x18.patient.DefaultResponse\x12W\n" +
+	"\x10GetHistoryVisits\x12 .patient.GetHistoryVisitsRequest\x1a!.patient.GetHistoryVisitsResponse\x12Z\n" +
+	"\x11GetTreatmentPlans\x12!.patient.GetTreatmentPlansRequest\x1a\".patient.GetTreatmentPlansResponse\x12`\n" +
+	"\x13AcceptTreatmentPlan\x12#.patient.AcceptTreatmentPlanRequest\x1a$.patient.AcceptTreatmentPlanResponse\x12H\n" +
+	"\fGetAnamnesis\x12\x1c.patient.GetAnamnesisRequest\x1a\x1a.patient.AnamnesisResponse\x12J\n" +
+	"\rSaveAnamnesis\x12\x1d.patient.SaveAnamnesisRequest\x1a\x1a.patient.AnamnesisResponse\x12`\n" +
+	"\x13ExportMedicalRecord\x12#.patient.ExportMedicalRecordRequest\x1a$.patient.MedicalRecordExportResponse\x12G\n" +
 	"\n" +
-	"UploadTest\x12\x1a.patient.UploadTestRequest\x1a\x1b.patient.UploadTestResponse\x12V\n" +
-	"\x17GetDocumentsByPatientID\x12\x1c.patient.GetDocumentsRequest\x1a\x1d.patient.GetDocumentsResponse\x12W\n" +
-	"\x10DownloadDocument\x12 .patient.DownloadDocumentRequest\x1a!.patient.DownloadDocumentResponseB\x19Z\x17patient/proto;patientpbb\x06proto3"
+	"UploadTest\x12\x1a.patient.UploadTestRequest\x1a\x1b.patient.UploadTestResponse(\x01\x12V\n" +
+	"\x17GetDocumentsByPatientID\x12\x1c.patient.GetDocumentsRequest\x1a\x1d.patient.GetDocumentsResponse\x12Y\n" +
+	"\x10DownloadDocument\x12 .patient.DownloadDocumentRequest\x1a!.patient.DownloadDocumentResponse0\x01B\x19Z\x17patient/proto;patientpbb\x06proto3"
 
 var (
 	file_proto_patient_patient_proto_rawDescOnce sync.Once
@@ -1301,66 +3334,135 @@ func file_proto_patient_patient_proto_rawDescGZIP() []byte {
 	return file_proto_patient_patient_proto_rawDescData
 }
 
-var file_proto_patient_patient_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_proto_patient_patient_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_proto_patient_patient_proto_goTypes = []any{
 	(*GetAppointmentSlotsRequest)(nil),      // 0: patient.GetAppointmentSlotsRequest
 	(*GetAppointmentSlotsResponse)(nil),     // 1: patient.GetAppointmentSlotsResponse
 	(*DaySlots)(nil),                        // 2: patient.DaySlots
 	(*Appointment)(nil),                     // 3: patient.Appointment
 	(*AddAppointmentRequest)(nil),           // 4: patient.AddAppointmentRequest
-	(*DefaultResponse)(nil),                 // 5: patient.DefaultResponse
-	(*UpcomingAppointments)(nil),            // 6: patient.UpcomingAppointments
-	(*UpdateAppointmentRequest)(nil),        // 7: patient.UpdateAppointmentRequest
-	(*GetUpcomingAppointmentsRequest)(nil),  // 8: patient.GetUpcomingAppointmentsRequest
-	(*GetUpcomingAppointmentsResponse)(nil), // 9: patient.GetUpcomingAppointmentsResponse
-	(*GetByIDRequest)(nil),                  // 10: patient.GetByIDRequest
-	(*GetHistoryVisitsRequest)(nil),         // 11: patient.GetHistoryVisitsRequest
-	(*HistoryVisit)(nil),                    // 12: patient.HistoryVisit
-	(*GetHistoryVisitsResponse)(nil),        // 13: patient.GetHistoryVisitsResponse
-	(*UploadTestRequest)(nil),               // 14: patient.UploadTestRequest
-	(*UploadTestResponse)(nil),              // 15: patient.UploadTestResponse
-	(*GetDocumentsRequest)(nil),             // 16: patient.GetDocumentsRequest
-	(*GetDocumentsResponse)(nil),            // 17: patient.GetDocumentsResponse
-	(*DocumentInfo)(nil),                    // 18: patient.DocumentInfo
-	(*DownloadDocumentRequest)(nil),         // 19: patient.DownloadDocumentRequest
-	(*DownloadDocumentResponse)(nil),        // 20: patient.DownloadDocumentResponse
-	(*timestamppb.Timestamp)(nil),           // 21: google.protobuf.Timestamp
+	(*HoldAppointmentSlotRequest)(nil),      // 5: patient.HoldAppointmentSlotRequest
+	(*HoldAppointmentSlotResponse)(nil),     // 6: patient.HoldAppointmentSlotResponse
+	(*ReleaseAppointmentSlotRequest)(nil),   // 7: patient.ReleaseAppointmentSlotRequest
+	(*DefaultResponse)(nil),                 // 8: patient.DefaultResponse
+	(*UpcomingAppointments)(nil),            // 9: patient.UpcomingAppointments
+	(*UpdateAppointmentRequest)(nil),        // 10: patient.UpdateAppointmentRequest
+	(*CancelAppointmentRequest)(nil),        // 11: patient.CancelAppointmentRequest
+	(*GetUpcomingAppointmentsRequest)(nil),  // 12: patient.GetUpcomingAppointmentsRequest
+	(*GetUpcomingAppointmentsResponse)(nil), // 13: patient.GetUpcomingAppointmentsResponse
+	(*GetByIDRequest)(nil),                  // 14: patient.GetByIDRequest
+	(*GetHistoryVisitsRequest)(nil),         // 15: patient.GetHistoryVisitsRequest
+	(*HistoryVisit)(nil),                    // 16: patient.HistoryVisit
+	(*GetHistoryVisitsResponse)(nil),        // 17: patient.GetHistoryVisitsResponse
+	(*UploadTestRequest)(nil),               // 18: patient.UploadTestRequest
+	(*UploadTestResponse)(nil),              // 19: patient.UploadTestResponse
+	(*GetDocumentsRequest)(nil),             // 20: patient.GetDocumentsRequest
+	(*GetDocumentsResponse)(nil),            // 21: patient.GetDocumentsResponse
+	(*DocumentInfo)(nil),                    // 22: patient.DocumentInfo
+	(*DownloadDocumentRequest)(nil),         // 23: patient.DownloadDocumentRequest
+	(*DownloadDocumentResponse)(nil),        // 24: patient.DownloadDocumentResponse
+	(*JoinWaitingListRequest)(nil),          // 25: patient.JoinWaitingListRequest
+	(*JoinWaitingListResponse)(nil),         // 26: patient.JoinWaitingListResponse
+	(*WaitingListEntry)(nil),                // 27: patient.WaitingListEntry
+	(*WaitingListOffer)(nil),                // 28: patient.WaitingListOffer
+	(*GetWaitingListRequest)(nil),           // 29: patient.GetWaitingListRequest
+	(*GetWaitingListResponse)(nil),          // 30: patient.GetWaitingListResponse
+	(*LeaveWaitingListRequest)(nil),         // 31: patient.LeaveWaitingListRequest
+	(*WaitingListOfferRequest)(nil),         // 32: patient.WaitingListOfferRequest
+	(*AcceptWaitingListOfferResponse)(nil),  // 33: patient.AcceptWaitingListOfferResponse
+	(*TreatmentPlanItem)(nil),               // 34: patient.TreatmentPlanItem
+	(*TreatmentPlan)(nil),                   // 35: patient.TreatmentPlan
+	(*GetTreatmentPlansRequest)(nil),        // 36: patient.GetTreatmentPlansRequest
+	(*GetTreatmentPlansResponse)(nil),       // 37: patient.GetTreatmentPlansResponse
+	(*AcceptTreatmentPlanRequest)(nil),      // 38: patient.AcceptTreatmentPlanRequest
+	(*AcceptTreatmentPlanResponse)(nil),     // 39: patient.AcceptTreatmentPlanResponse
+	(*AnamnesisAllergy)(nil),                // 40: patient.AnamnesisAllergy
+	(*Anamnesis)(nil),                       // 41: patient.Anamnesis
+	(*GetAnamnesisRequest)(nil),             // 42: patient.GetAnamnesisRequest
+	(*SaveAnamnesisRequest)(nil),            // 43: patient.SaveAnamnesisRequest
+	(*AnamnesisResponse)(nil),               // 44: patient.AnamnesisResponse
+	(*NotificationSettingsRequest)(nil),     // 45: patient.NotificationSettingsRequest
+	(*NotificationSettings)(nil),            // 46: patient.NotificationSettings
+	(*ExportMedicalRecordRequest)(nil),      // 47: patient.ExportMedicalRecordRequest
+	(*MedicalRecordExportResponse)(nil),     // 48: patient.MedicalRecordExportResponse
+	(*timestamppb.Timestamp)(nil),           // 49: google.protobuf.Timestamp
 }
 var file_proto_patient_patient_proto_depIdxs = []int32{
 	2,  // 0: patient.GetAppointmentSlotsResponse.slots:type_name -> patient.DaySlots
-	21, // 1: patient.Appointment.date:type_name -> google.protobuf.Timestamp
-	21, // 2: patient.Appointment.time:type_name -> google.protobuf.Timestamp
-	21, // 3: patient.Appointment.birth_date:type_name -> google.protobuf.Timestamp
-	21, // 4: patient.Appointment.created_at:type_name -> google.protobuf.Timestamp
-	21, // 5: patient.Appointment.updated_at:type_name -> google.protobuf.Timestamp
+	49, // 1: patient.Appointment.date:type_name -> google.protobuf.Timestamp
+	49, // 2: patient.Appointment.time:type_name -> google.protobuf.Timestamp
+	49, // 3: patient.Appointment.birth_date:type_name -> google.protobuf.Timestamp
+	49, // 4: patient.Appointment.created_at:type_name -> google.protobuf.Timestamp
+	49, // 5: patient.Appointment.updated_at:type_name -> google.protobuf.Timestamp
 	3,  // 6: patient.AddAppointmentRequest.appointment:type_name -> patient.Appointment
-	3,  // 7: patient.UpdateAppointmentRequest.appointment:type_name -> patient.Appointment
-	6,  // 8: patient.GetUpcomingAppointmentsResponse.appointments:type_name -> patient.UpcomingAppointments
-	12, // 9: patient.GetHistoryVisitsResponse.visits:type_name -> patient.HistoryVisit
-	18, // 10: patient.GetDocumentsResponse.documents:type_name -> patient.DocumentInfo
-	0,  // 11: patient.PatientService.GetAppointmentSlots:input_type -> patient.GetAppointmentSlotsRequest
-	4,  // 12: patient.PatientService.AddAppointment:input_type -> patient.AddAppointmentRequest
-	8,  // 13: patient.PatientService.GetUpcomingAppointments:input_type -> patient.GetUpcomingAppointmentsRequest
-	7,  // 14: patient.PatientService.UpdateAppointment:input_type -> patient.UpdateAppointmentRequest
-	10, // 15: patient.PatientService.CancelAppointment:input_type -> patient.GetByIDRequest
-	11, // 16: patient.PatientService.GetHistoryVisits:input_type -> patient.GetHistoryVisitsRequest
-	14, // 17: patient.PatientService.UploadTest:input_type -> patient.UploadTestRequest
-	16, // 18: patient.PatientService.GetDocumentsByPatientID:input_type -> patient.GetDocumentsRequest
-	19, // 19: patient.PatientService.DownloadDocument:input_type -> patient.DownloadDocumentRequest
-	1,  // 20: patient.PatientService.GetAppointmentSlots:output_type -> patient.GetAppointmentSlotsResponse
-	5,  // 21: patient.PatientService.AddAppointment:output_type -> patient.DefaultResponse
-	9,  // 22: patient.PatientService.GetUpcomingAppointments:output_type -> patient.GetUpcomingAppointmentsResponse
-	5,  // 23: patient.PatientService.UpdateAppointment:output_type -> patient.DefaultResponse
-	5,  // 24: patient.PatientService.CancelAppointment:output_type -> patient.DefaultResponse
-	13, // 25: patient.PatientService.GetHistoryVisits:output_type -> patient.GetHistoryVisitsResponse
-	15, // 26: patient.PatientService.UploadTest:output_type -> patient.UploadTestResponse
-	17, // 27: patient.PatientService.GetDocumentsByPatientID:output_type -> patient.GetDocumentsResponse
-	20, // 28: patient.PatientService.DownloadDocument:output_type -> patient.DownloadDocumentResponse
-	20, // [20:29] is the sub-list for method output_type
-	11, // [11:20] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	49, // 7: patient.HoldAppointmentSlotRequest.date:type_name -> google.protobuf.Timestamp
+	49, // 8: patient.HoldAppointmentSlotRequest.time:type_name -> google.protobuf.Timestamp
+	49, // 9: patient.HoldAppointmentSlotResponse.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 10: patient.UpdateAppointmentRequest.appointment:type_name -> patient.Appointment
+	9,  // 11: patient.GetUpcomingAppointmentsResponse.appointments:type_name -> patient.UpcomingAppointments
+	16, // 12: patient.GetHistoryVisitsResponse.visits:type_name -> patient.HistoryVisit
+	22, // 13: patient.GetDocumentsResponse.documents:type_name -> patient.DocumentInfo
+	49, // 14: patient.JoinWaitingListRequest.date_from:type_name -> google.protobuf.Timestamp
+	49, // 15: patient.JoinWaitingListRequest.date_to:type_name -> google.protobuf.Timestamp
+	27, // 16: patient.GetWaitingListResponse.entries:type_name -> patient.WaitingListEntry
+	28, // 17: patient.GetWaitingListResponse.offers:type_name -> patient.WaitingListOffer
+	34, // 18: patient.TreatmentPlan.items:type_name -> patient.TreatmentPlanItem
+	35, // 19: patient.GetTreatmentPlansResponse.plans:type_name -> patient.TreatmentPlan
+	35, // 20: patient.AcceptTreatmentPlanResponse.plan:type_name -> patient.TreatmentPlan
+	40, // 21: patient.Anamnesis.allergies:type_name -> patient.AnamnesisAllergy
+	41, // 22: patient.SaveAnamnesisRequest.anamnesis:type_name -> patient.Anamnesis
+	41, // 23: patient.AnamnesisResponse.anamnesis:type_name -> patient.Anamnesis
+	0,  // 24: patient.PatientService.GetAppointmentSlots:input_type -> patient.GetAppointmentSlotsRequest
+	4,  // 25: patient.PatientService.AddAppointment:input_type -> patient.AddAppointmentRequest
+	5,  // 26: patient.PatientService.HoldAppointmentSlot:input_type -> patient.HoldAppointmentSlotRequest
+	7,  // 27: patient.PatientService.ReleaseAppointmentSlot:input_type -> patient.ReleaseAppointmentSlotRequest
+	12, // 28: patient.PatientService.GetUpcomingAppointments:input_type -> patient.GetUpcomingAppointmentsRequest
+	10, // 29: patient.PatientService.UpdateAppointment:input_type -> patient.UpdateAppointmentRequest
+	11, // 30: patient.PatientService.CancelAppointment:input_type -> patient.CancelAppointmentRequest
+	25, // 31: patient.PatientService.JoinWaitingList:input_type -> patient.JoinWaitingListRequest
+	29, // 32: patient.PatientService.GetWaitingList:input_type -> patient.GetWaitingListRequest
+	31, // 33: patient.PatientService.LeaveWaitingList:input_type -> patient.LeaveWaitingListRequest
+	32, // 34: patient.PatientService.AcceptWaitingListOffer:input_type -> patient.WaitingListOfferRequest
+	32, // 35: patient.PatientService.DeclineWaitingListOffer:input_type -> patient.WaitingListOfferRequest
+	45, // 36: patient.PatientService.GetNotificationSettings:input_type -> patient.NotificationSettingsRequest
+	46, // 37: patient.PatientService.UpdateNotificationSettings:input_type -> patient.NotificationSettings
+	15, // 38: patient.PatientService.GetHistoryVisits:input_type -> patient.GetHistoryVisitsRequest
+	36, // 39: patient.PatientService.GetTreatmentPlans:input_type -> patient.GetTreatmentPlansRequest
+	38, // 40: patient.PatientService.AcceptTreatmentPlan:input_type -> patient.AcceptTreatmentPlanRequest
+	42, // 41: patient.PatientService.GetAnamnesis:input_type -> patient.GetAnamnesisRequest
+	43, // 42: patient.PatientService.SaveAnamnesis:input_type -> patient.SaveAnamnesisRequest
+	47, // 43: patient.PatientService.ExportMedicalRecord:input_type -> patient.ExportMedicalRecordRequest
+	18, // 44: patient.PatientService.UploadTest:input_type -> patient.UploadTestRequest
+	20, // 45: patient.PatientService.GetDocumentsByPatientID:input_type -> patient.GetDocumentsRequest
+	23, // 46: patient.PatientService.DownloadDocument:input_type -> patient.DownloadDocumentRequest
+	1,  // 47: patient.PatientService.GetAppointmentSlots:output_type -> patient.GetAppointmentSlotsResponse
+	8,  // 48: patient.PatientService.AddAppointment:output_type -> patient.DefaultResponse
+	6,  // 49: patient.PatientService.HoldAppointmentSlot:output_type -> patient.HoldAppointmentSlotResponse
+	8,  // 50: patient.PatientService.ReleaseAppointmentSlot:output_type -> patient.DefaultResponse
+	13, // 51: patient.PatientService.GetUpcomingAppointments:output_type -> patient.GetUpcomingAppointmentsResponse
+	8,  // 52: patient.PatientService.UpdateAppointment:output_type -> patient.DefaultResponse
+	8,  // 53: patient.PatientService.CancelAppointment:output_type -> patient.DefaultResponse
+	26, // 54: patient.PatientService.JoinWaitingList:output_type -> patient.JoinWaitingListResponse
+	30, // 55: patient.PatientService.GetWaitingList:output_type -> patient.GetWaitingListResponse
+	8,  // 56: patient.PatientService.LeaveWaitingList:output_type -> patient.DefaultResponse
+	33, // 57: patient.PatientService.AcceptWaitingListOffer:output_type -> patient.AcceptWaitingListOfferResponse
+	8,  // 58: patient.PatientService.DeclineWaitingListOffer:output_type -> patient.DefaultResponse
+	46, // 59: patient.PatientService.GetNotificationSettings:output_type -> patient.NotificationSettings
+	8,  // 60: patient.PatientService.UpdateNotificationSettings:output_type -> patient.DefaultResponse
+	17, // 61: patient.PatientService.GetHistoryVisits:output_type -> patient.GetHistoryVisitsResponse
+	37, // 62: patient.PatientService.GetTreatmentPlans:output_type -> patient.GetTreatmentPlansResponse
+	39, // 63: patient.PatientService.AcceptTreatmentPlan:output_type -> patient.AcceptTreatmentPlanResponse
+	44, // 64: patient.PatientService.GetAnamnesis:output_type -> patient.AnamnesisResponse
+	44, // 65: patient.PatientService.SaveAnamnesis:output_type -> patient.AnamnesisResponse
+	48, // 66: patient.PatientService.ExportMedicalRecord:output_type -> patient.MedicalRecordExportResponse
+	19, // 67: patient.PatientService.UploadTest:output_type -> patient.UploadTestResponse
+	21, // 68: patient.PatientService.GetDocumentsByPatientID:output_type -> patient.GetDocumentsResponse
+	24, // 69: patient.PatientService.DownloadDocument:output_type -> patient.DownloadDocumentResponse
+	47, // [47:70] is the sub-list for method output_type
+	24, // [24:47] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_proto_patient_patient_proto_init() }
@@ -1374,7 +3476,7 @@ func file_proto_patient_patient_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_patient_patient_proto_rawDesc), len(file_proto_patient_patient_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
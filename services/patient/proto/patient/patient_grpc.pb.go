@@ -19,15 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PatientService_GetAppointmentSlots_FullMethodName     = "/patient.PatientService/GetAppointmentSlots"
-	PatientService_AddAppointment_FullMethodName          = "/patient.PatientService/AddAppointment"
-	PatientService_GetUpcomingAppointments_FullMethodName = "/patient.PatientService/GetUpcomingAppointments"
-	PatientService_UpdateAppointment_FullMethodName       = "/patient.PatientService/UpdateAppointment"
-	PatientService_CancelAppointment_FullMethodName       = "/patient.PatientService/CancelAppointment"
-	PatientService_GetHistoryVisits_FullMethodName        = "/patient.PatientService/GetHistoryVisits"
-	PatientService_UploadTest_FullMethodName              = "/patient.PatientService/UploadTest"
-	PatientService_GetDocumentsByPatientID_FullMethodName = "/patient.PatientService/GetDocumentsByPatientID"
-	PatientService_DownloadDocument_FullMethodName        = "/patient.PatientService/DownloadDocument"
+	PatientService_GetAppointmentSlots_FullMethodName        = "/patient.PatientService/GetAppointmentSlots"
+	PatientService_AddAppointment_FullMethodName             = "/patient.PatientService/AddAppointment"
+	PatientService_HoldAppointmentSlot_FullMethodName        = "/patient.PatientService/HoldAppointmentSlot"
+	PatientService_ReleaseAppointmentSlot_FullMethodName     = "/patient.PatientService/ReleaseAppointmentSlot"
+	PatientService_GetUpcomingAppointments_FullMethodName    = "/patient.PatientService/GetUpcomingAppointments"
+	PatientService_UpdateAppointment_FullMethodName          = "/patient.PatientService/UpdateAppointment"
+	PatientService_CancelAppointment_FullMethodName          = "/patient.PatientService/CancelAppointment"
+	PatientService_JoinWaitingList_FullMethodName            = "/patient.PatientService/JoinWaitingList"
+	PatientService_GetWaitingList_FullMethodName             = "/patient.PatientService/GetWaitingList"
+	PatientService_LeaveWaitingList_FullMethodName           = "/patient.PatientService/LeaveWaitingList"
+	PatientService_AcceptWaitingListOffer_FullMethodName     = "/patient.PatientService/AcceptWaitingListOffer"
+	PatientService_DeclineWaitingListOffer_FullMethodName    = "/patient.PatientService/DeclineWaitingListOffer"
+	PatientService_GetNotificationSettings_FullMethodName    = "/patient.PatientService/GetNotificationSettings"
+	PatientService_UpdateNotificationSettings_FullMethodName = "/patient.PatientService/UpdateNotificationSettings"
+	PatientService_GetHistoryVisits_FullMethodName           = "/patient.PatientService/GetHistoryVisits"
+	PatientService_GetTreatmentPlans_FullMethodName          = "/patient.PatientService/GetTreatmentPlans"
+	PatientService_AcceptTreatmentPlan_FullMethodName        = "/patient.PatientService/AcceptTreatmentPlan"
+	PatientService_GetAnamnesis_FullMethodName               = "/patient.PatientService/GetAnamnesis"
+	PatientService_SaveAnamnesis_FullMethodName              = "/patient.PatientService/SaveAnamnesis"
+	PatientService_ExportMedicalRecord_FullMethodName        = "/patient.PatientService/ExportMedicalRecord"
+	PatientService_UploadTest_FullMethodName                 = "/patient.PatientService/UploadTest"
+	PatientService_GetDocumentsByPatientID_FullMethodName    = "/patient.PatientService/GetDocumentsByPatientID"
+	PatientService_DownloadDocument_FullMethodName           = "/patient.PatientService/DownloadDocument"
 )
 
 // PatientServiceClient is the client API for PatientService service.
@@ -36,13 +50,27 @@ const (
 type PatientServiceClient interface {
 	GetAppointmentSlots(ctx context.Context, in *GetAppointmentSlotsRequest, opts ...grpc.CallOption) (*GetAppointmentSlotsResponse, error)
 	AddAppointment(ctx context.Context, in *AddAppointmentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	HoldAppointmentSlot(ctx context.Context, in *HoldAppointmentSlotRequest, opts ...grpc.CallOption) (*HoldAppointmentSlotResponse, error)
+	ReleaseAppointmentSlot(ctx context.Context, in *ReleaseAppointmentSlotRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
 	GetUpcomingAppointments(ctx context.Context, in *GetUpcomingAppointmentsRequest, opts ...grpc.CallOption) (*GetUpcomingAppointmentsResponse, error)
 	UpdateAppointment(ctx context.Context, in *UpdateAppointmentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
-	CancelAppointment(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	CancelAppointment(ctx context.Context, in *CancelAppointmentRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	JoinWaitingList(ctx context.Context, in *JoinWaitingListRequest, opts ...grpc.CallOption) (*JoinWaitingListResponse, error)
+	GetWaitingList(ctx context.Context, in *GetWaitingListRequest, opts ...grpc.CallOption) (*GetWaitingListResponse, error)
+	LeaveWaitingList(ctx context.Context, in *LeaveWaitingListRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	AcceptWaitingListOffer(ctx context.Context, in *WaitingListOfferRequest, opts ...grpc.CallOption) (*AcceptWaitingListOfferResponse, error)
+	DeclineWaitingListOffer(ctx context.Context, in *WaitingListOfferRequest, opts ...grpc.CallOption) (*DefaultResponse, error)
+	GetNotificationSettings(ctx context.Context, in *NotificationSettingsRequest, opts ...grpc.CallOption) (*NotificationSettings, error)
+	UpdateNotificationSettings(ctx context.Context, in *NotificationSettings, opts ...grpc.CallOption) (*DefaultResponse, error)
 	GetHistoryVisits(ctx context.Context, in *GetHistoryVisitsRequest, opts ...grpc.CallOption) (*GetHistoryVisitsResponse, error)
-	UploadTest(ctx context.Context, in *UploadTestRequest, opts ...grpc.CallOption) (*UploadTestResponse, error)
+	GetTreatmentPlans(ctx context.Context, in *GetTreatmentPlansRequest, opts ...grpc.CallOption) (*GetTreatmentPlansResponse, error)
+	AcceptTreatmentPlan(ctx context.Context, in *AcceptTreatmentPlanRequest, opts ...grpc.CallOption) (*AcceptTreatmentPlanResponse, error)
+	GetAnamnesis(ctx context.Context, in *GetAnamnesisRequest, opts ...grpc.CallOption) (*AnamnesisResponse, error)
+	SaveAnamnesis(ctx context.Context, in *SaveAnamnesisRequest, opts ...grpc.CallOption) (*AnamnesisResponse, error)
+	ExportMedicalRecord(ctx context.Context, in *ExportMedicalRecordRequest, opts ...grpc.CallOption) (*MedicalRecordExportResponse, error)
+	UploadTest(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadTestRequest, UploadTestResponse], error)
 	GetDocumentsByPatientID(ctx context.Context, in *GetDocumentsRequest, opts ...grpc.CallOption) (*GetDocumentsResponse, error)
-	DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (*DownloadDocumentResponse, error)
+	DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDocumentResponse], error)
 }
 
 type patientServiceClient struct {
@@ -73,6 +101,26 @@ func (c *patientServiceClient) AddAppointment(ctx context.Context, in *AddAppoin
 	return out, nil
 }
 
+func (c *patientServiceClient) HoldAppointmentSlot(ctx context.Context, in *HoldAppointmentSlotRequest, opts ...grpc.CallOption) (*HoldAppointmentSlotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HoldAppointmentSlotResponse)
+	err := c.cc.Invoke(ctx, PatientService_HoldAppointmentSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) ReleaseAppointmentSlot(ctx context.Context, in *ReleaseAppointmentSlotRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, PatientService_ReleaseAppointmentSlot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *patientServiceClient) GetUpcomingAppointments(ctx context.Context, in *GetUpcomingAppointmentsRequest, opts ...grpc.CallOption) (*GetUpcomingAppointmentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUpcomingAppointmentsResponse)
@@ -93,7 +141,7 @@ func (c *patientServiceClient) UpdateAppointment(ctx context.Context, in *Update
 	return out, nil
 }
 
-func (c *patientServiceClient) CancelAppointment(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+func (c *patientServiceClient) CancelAppointment(ctx context.Context, in *CancelAppointmentRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DefaultResponse)
 	err := c.cc.Invoke(ctx, PatientService_CancelAppointment_FullMethodName, in, out, cOpts...)
@@ -103,6 +151,76 @@ func (c *patientServiceClient) CancelAppointment(ctx context.Context, in *GetByI
 	return out, nil
 }
 
+func (c *patientServiceClient) JoinWaitingList(ctx context.Context, in *JoinWaitingListRequest, opts ...grpc.CallOption) (*JoinWaitingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinWaitingListResponse)
+	err := c.cc.Invoke(ctx, PatientService_JoinWaitingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) GetWaitingList(ctx context.Context, in *GetWaitingListRequest, opts ...grpc.CallOption) (*GetWaitingListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWaitingListResponse)
+	err := c.cc.Invoke(ctx, PatientService_GetWaitingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) LeaveWaitingList(ctx context.Context, in *LeaveWaitingListRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, PatientService_LeaveWaitingList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) AcceptWaitingListOffer(ctx context.Context, in *WaitingListOfferRequest, opts ...grpc.CallOption) (*AcceptWaitingListOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptWaitingListOfferResponse)
+	err := c.cc.Invoke(ctx, PatientService_AcceptWaitingListOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) DeclineWaitingListOffer(ctx context.Context, in *WaitingListOfferRequest, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, PatientService_DeclineWaitingListOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) GetNotificationSettings(ctx context.Context, in *NotificationSettingsRequest, opts ...grpc.CallOption) (*NotificationSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationSettings)
+	err := c.cc.Invoke(ctx, PatientService_GetNotificationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) UpdateNotificationSettings(ctx context.Context, in *NotificationSettings, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, PatientService_UpdateNotificationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *patientServiceClient) GetHistoryVisits(ctx context.Context, in *GetHistoryVisitsRequest, opts ...grpc.CallOption) (*GetHistoryVisitsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetHistoryVisitsResponse)
@@ -113,16 +231,69 @@ func (c *patientServiceClient) GetHistoryVisits(ctx context.Context, in *GetHist
 	return out, nil
 }
 
-func (c *patientServiceClient) UploadTest(ctx context.Context, in *UploadTestRequest, opts ...grpc.CallOption) (*UploadTestResponse, error) {
+func (c *patientServiceClient) GetTreatmentPlans(ctx context.Context, in *GetTreatmentPlansRequest, opts ...grpc.CallOption) (*GetTreatmentPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTreatmentPlansResponse)
+	err := c.cc.Invoke(ctx, PatientService_GetTreatmentPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) AcceptTreatmentPlan(ctx context.Context, in *AcceptTreatmentPlanRequest, opts ...grpc.CallOption) (*AcceptTreatmentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptTreatmentPlanResponse)
+	err := c.cc.Invoke(ctx, PatientService_AcceptTreatmentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) GetAnamnesis(ctx context.Context, in *GetAnamnesisRequest, opts ...grpc.CallOption) (*AnamnesisResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UploadTestResponse)
-	err := c.cc.Invoke(ctx, PatientService_UploadTest_FullMethodName, in, out, cOpts...)
+	out := new(AnamnesisResponse)
+	err := c.cc.Invoke(ctx, PatientService_GetAnamnesis_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
+func (c *patientServiceClient) SaveAnamnesis(ctx context.Context, in *SaveAnamnesisRequest, opts ...grpc.CallOption) (*AnamnesisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnamnesisResponse)
+	err := c.cc.Invoke(ctx, PatientService_SaveAnamnesis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) ExportMedicalRecord(ctx context.Context, in *ExportMedicalRecordRequest, opts ...grpc.CallOption) (*MedicalRecordExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MedicalRecordExportResponse)
+	err := c.cc.Invoke(ctx, PatientService_ExportMedicalRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *patientServiceClient) UploadTest(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadTestRequest, UploadTestResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PatientService_ServiceDesc.Streams[0], PatientService_UploadTest_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadTestRequest, UploadTestResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PatientService_UploadTestClient = grpc.ClientStreamingClient[UploadTestRequest, UploadTestResponse]
+
 func (c *patientServiceClient) GetDocumentsByPatientID(ctx context.Context, in *GetDocumentsRequest, opts ...grpc.CallOption) (*GetDocumentsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDocumentsResponse)
@@ -133,29 +304,52 @@ func (c *patientServiceClient) GetDocumentsByPatientID(ctx context.Context, in *
 	return out, nil
 }
 
-func (c *patientServiceClient) DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (*DownloadDocumentResponse, error) {
+func (c *patientServiceClient) DownloadDocument(ctx context.Context, in *DownloadDocumentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadDocumentResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DownloadDocumentResponse)
-	err := c.cc.Invoke(ctx, PatientService_DownloadDocument_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &PatientService_ServiceDesc.Streams[1], PatientService_DownloadDocument_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[DownloadDocumentRequest, DownloadDocumentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PatientService_DownloadDocumentClient = grpc.ServerStreamingClient[DownloadDocumentResponse]
+
 // PatientServiceServer is the server API for PatientService service.
 // All implementations must embed UnimplementedPatientServiceServer
 // for forward compatibility.
 type PatientServiceServer interface {
 	GetAppointmentSlots(context.Context, *GetAppointmentSlotsRequest) (*GetAppointmentSlotsResponse, error)
 	AddAppointment(context.Context, *AddAppointmentRequest) (*DefaultResponse, error)
+	HoldAppointmentSlot(context.Context, *HoldAppointmentSlotRequest) (*HoldAppointmentSlotResponse, error)
+	ReleaseAppointmentSlot(context.Context, *ReleaseAppointmentSlotRequest) (*DefaultResponse, error)
 	GetUpcomingAppointments(context.Context, *GetUpcomingAppointmentsRequest) (*GetUpcomingAppointmentsResponse, error)
 	UpdateAppointment(context.Context, *UpdateAppointmentRequest) (*DefaultResponse, error)
-	CancelAppointment(context.Context, *GetByIDRequest) (*DefaultResponse, error)
+	CancelAppointment(context.Context, *CancelAppointmentRequest) (*DefaultResponse, error)
+	JoinWaitingList(context.Context, *JoinWaitingListRequest) (*JoinWaitingListResponse, error)
+	GetWaitingList(context.Context, *GetWaitingListRequest) (*GetWaitingListResponse, error)
+	LeaveWaitingList(context.Context, *LeaveWaitingListRequest) (*DefaultResponse, error)
+	AcceptWaitingListOffer(context.Context, *WaitingListOfferRequest) (*AcceptWaitingListOfferResponse, error)
+	DeclineWaitingListOffer(context.Context, *WaitingListOfferRequest) (*DefaultResponse, error)
+	GetNotificationSettings(context.Context, *NotificationSettingsRequest) (*NotificationSettings, error)
+	UpdateNotificationSettings(context.Context, *NotificationSettings) (*DefaultResponse, error)
 	GetHistoryVisits(context.Context, *GetHistoryVisitsRequest) (*GetHistoryVisitsResponse, error)
-	UploadTest(context.Context, *UploadTestRequest) (*UploadTestResponse, error)
+	GetTreatmentPlans(context.Context, *GetTreatmentPlansRequest) (*GetTreatmentPlansResponse, error)
+	AcceptTreatmentPlan(context.Context, *AcceptTreatmentPlanRequest) (*AcceptTreatmentPlanResponse, error)
+	GetAnamnesis(context.Context, *GetAnamnesisRequest) (*AnamnesisResponse, error)
+	SaveAnamnesis(context.Context, *SaveAnamnesisRequest) (*AnamnesisResponse, error)
+	ExportMedicalRecord(context.Context, *ExportMedicalRecordRequest) (*MedicalRecordExportResponse, error)
+	UploadTest(grpc.ClientStreamingServer[UploadTestRequest, UploadTestResponse]) error
 	GetDocumentsByPatientID(context.Context, *GetDocumentsRequest) (*GetDocumentsResponse, error)
-	DownloadDocument(context.Context, *DownloadDocumentRequest) (*DownloadDocumentResponse, error)
+	DownloadDocument(*DownloadDocumentRequest, grpc.ServerStreamingServer[DownloadDocumentResponse]) error
 	mustEmbedUnimplementedPatientServiceServer()
 }
 
@@ -172,26 +366,68 @@ func (UnimplementedPatientServiceServer) GetAppointmentSlots(context.Context, *G
 func (UnimplementedPatientServiceServer) AddAppointment(context.Context, *AddAppointmentRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAppointment not implemented")
 }
+func (UnimplementedPatientServiceServer) HoldAppointmentSlot(context.Context, *HoldAppointmentSlotRequest) (*HoldAppointmentSlotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldAppointmentSlot not implemented")
+}
+func (UnimplementedPatientServiceServer) ReleaseAppointmentSlot(context.Context, *ReleaseAppointmentSlotRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseAppointmentSlot not implemented")
+}
 func (UnimplementedPatientServiceServer) GetUpcomingAppointments(context.Context, *GetUpcomingAppointmentsRequest) (*GetUpcomingAppointmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingAppointments not implemented")
 }
 func (UnimplementedPatientServiceServer) UpdateAppointment(context.Context, *UpdateAppointmentRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppointment not implemented")
 }
-func (UnimplementedPatientServiceServer) CancelAppointment(context.Context, *GetByIDRequest) (*DefaultResponse, error) {
+func (UnimplementedPatientServiceServer) CancelAppointment(context.Context, *CancelAppointmentRequest) (*DefaultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelAppointment not implemented")
 }
+func (UnimplementedPatientServiceServer) JoinWaitingList(context.Context, *JoinWaitingListRequest) (*JoinWaitingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitingList not implemented")
+}
+func (UnimplementedPatientServiceServer) GetWaitingList(context.Context, *GetWaitingListRequest) (*GetWaitingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitingList not implemented")
+}
+func (UnimplementedPatientServiceServer) LeaveWaitingList(context.Context, *LeaveWaitingListRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitingList not implemented")
+}
+func (UnimplementedPatientServiceServer) AcceptWaitingListOffer(context.Context, *WaitingListOfferRequest) (*AcceptWaitingListOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptWaitingListOffer not implemented")
+}
+func (UnimplementedPatientServiceServer) DeclineWaitingListOffer(context.Context, *WaitingListOfferRequest) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineWaitingListOffer not implemented")
+}
+func (UnimplementedPatientServiceServer) GetNotificationSettings(context.Context, *NotificationSettingsRequest) (*NotificationSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationSettings not implemented")
+}
+func (UnimplementedPatientServiceServer) UpdateNotificationSettings(context.Context, *NotificationSettings) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationSettings not implemented")
+}
 func (UnimplementedPatientServiceServer) GetHistoryVisits(context.Context, *GetHistoryVisitsRequest) (*GetHistoryVisitsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHistoryVisits not implemented")
 }
-func (UnimplementedPatientServiceServer) UploadTest(context.Context, *UploadTestRequest) (*UploadTestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadTest not implemented")
+func (UnimplementedPatientServiceServer) GetTreatmentPlans(context.Context, *GetTreatmentPlansRequest) (*GetTreatmentPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTreatmentPlans not implemented")
+}
+func (UnimplementedPatientServiceServer) AcceptTreatmentPlan(context.Context, *AcceptTreatmentPlanRequest) (*AcceptTreatmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTreatmentPlan not implemented")
+}
+func (UnimplementedPatientServiceServer) GetAnamnesis(context.Context, *GetAnamnesisRequest) (*AnamnesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnamnesis not implemented")
+}
+func (UnimplementedPatientServiceServer) SaveAnamnesis(context.Context, *SaveAnamnesisRequest) (*AnamnesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveAnamnesis not implemented")
+}
+func (UnimplementedPatientServiceServer) ExportMedicalRecord(context.Context, *ExportMedicalRecordRequest) (*MedicalRecordExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMedicalRecord not implemented")
+}
+func (UnimplementedPatientServiceServer) UploadTest(grpc.ClientStreamingServer[UploadTestRequest, UploadTestResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadTest not implemented")
 }
 func (UnimplementedPatientServiceServer) GetDocumentsByPatientID(context.Context, *GetDocumentsRequest) (*GetDocumentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentsByPatientID not implemented")
 }
-func (UnimplementedPatientServiceServer) DownloadDocument(context.Context, *DownloadDocumentRequest) (*DownloadDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownloadDocument not implemented")
+func (UnimplementedPatientServiceServer) DownloadDocument(*DownloadDocumentRequest, grpc.ServerStreamingServer[DownloadDocumentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadDocument not implemented")
 }
 func (UnimplementedPatientServiceServer) mustEmbedUnimplementedPatientServiceServer() {}
 func (UnimplementedPatientServiceServer) testEmbeddedByValue()                        {}
@@ -250,6 +486,42 @@ func _PatientService_AddAppointment_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PatientService_HoldAppointmentSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldAppointmentSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).HoldAppointmentSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_HoldAppointmentSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).HoldAppointmentSlot(ctx, req.(*HoldAppointmentSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_ReleaseAppointmentSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseAppointmentSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).ReleaseAppointmentSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_ReleaseAppointmentSlot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).ReleaseAppointmentSlot(ctx, req.(*ReleaseAppointmentSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PatientService_GetUpcomingAppointments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUpcomingAppointmentsRequest)
 	if err := dec(in); err != nil {
@@ -287,7 +559,7 @@ func _PatientService_UpdateAppointment_Handler(srv interface{}, ctx context.Cont
 }
 
 func _PatientService_CancelAppointment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByIDRequest)
+	in := new(CancelAppointmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -299,7 +571,133 @@ func _PatientService_CancelAppointment_Handler(srv interface{}, ctx context.Cont
 		FullMethod: PatientService_CancelAppointment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PatientServiceServer).CancelAppointment(ctx, req.(*GetByIDRequest))
+		return srv.(PatientServiceServer).CancelAppointment(ctx, req.(*CancelAppointmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_JoinWaitingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).JoinWaitingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_JoinWaitingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).JoinWaitingList(ctx, req.(*JoinWaitingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_GetWaitingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).GetWaitingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_GetWaitingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).GetWaitingList(ctx, req.(*GetWaitingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_LeaveWaitingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).LeaveWaitingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_LeaveWaitingList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).LeaveWaitingList(ctx, req.(*LeaveWaitingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_AcceptWaitingListOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitingListOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).AcceptWaitingListOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_AcceptWaitingListOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).AcceptWaitingListOffer(ctx, req.(*WaitingListOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_DeclineWaitingListOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitingListOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).DeclineWaitingListOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_DeclineWaitingListOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).DeclineWaitingListOffer(ctx, req.(*WaitingListOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_GetNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).GetNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_GetNotificationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).GetNotificationSettings(ctx, req.(*NotificationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_UpdateNotificationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).UpdateNotificationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_UpdateNotificationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).UpdateNotificationSettings(ctx, req.(*NotificationSettings))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -322,60 +720,132 @@ func _PatientService_GetHistoryVisits_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PatientService_UploadTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UploadTestRequest)
+func _PatientService_GetTreatmentPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTreatmentPlansRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PatientServiceServer).UploadTest(ctx, in)
+		return srv.(PatientServiceServer).GetTreatmentPlans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PatientService_UploadTest_FullMethodName,
+		FullMethod: PatientService_GetTreatmentPlans_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PatientServiceServer).UploadTest(ctx, req.(*UploadTestRequest))
+		return srv.(PatientServiceServer).GetTreatmentPlans(ctx, req.(*GetTreatmentPlansRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PatientService_GetDocumentsByPatientID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDocumentsRequest)
+func _PatientService_AcceptTreatmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTreatmentPlanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PatientServiceServer).GetDocumentsByPatientID(ctx, in)
+		return srv.(PatientServiceServer).AcceptTreatmentPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PatientService_GetDocumentsByPatientID_FullMethodName,
+		FullMethod: PatientService_AcceptTreatmentPlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PatientServiceServer).GetDocumentsByPatientID(ctx, req.(*GetDocumentsRequest))
+		return srv.(PatientServiceServer).AcceptTreatmentPlan(ctx, req.(*AcceptTreatmentPlanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PatientService_DownloadDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownloadDocumentRequest)
+func _PatientService_GetAnamnesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnamnesisRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PatientServiceServer).DownloadDocument(ctx, in)
+		return srv.(PatientServiceServer).GetAnamnesis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PatientService_DownloadDocument_FullMethodName,
+		FullMethod: PatientService_GetAnamnesis_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PatientServiceServer).DownloadDocument(ctx, req.(*DownloadDocumentRequest))
+		return srv.(PatientServiceServer).GetAnamnesis(ctx, req.(*GetAnamnesisRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PatientService_SaveAnamnesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveAnamnesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).SaveAnamnesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_SaveAnamnesis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).SaveAnamnesis(ctx, req.(*SaveAnamnesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_ExportMedicalRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMedicalRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).ExportMedicalRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_ExportMedicalRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).ExportMedicalRecord(ctx, req.(*ExportMedicalRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_UploadTest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PatientServiceServer).UploadTest(&grpc.GenericServerStream[UploadTestRequest, UploadTestResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PatientService_UploadTestServer = grpc.ClientStreamingServer[UploadTestRequest, UploadTestResponse]
+
+func _PatientService_GetDocumentsByPatientID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PatientServiceServer).GetDocumentsByPatientID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PatientService_GetDocumentsByPatientID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PatientServiceServer).GetDocumentsByPatientID(ctx, req.(*GetDocumentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PatientService_DownloadDocument_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDocumentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PatientServiceServer).DownloadDocument(m, &grpc.GenericServerStream[DownloadDocumentRequest, DownloadDocumentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PatientService_DownloadDocumentServer = grpc.ServerStreamingServer[DownloadDocumentResponse]
+
 // PatientService_ServiceDesc is the grpc.ServiceDesc for PatientService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -391,6 +861,14 @@ var PatientService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddAppointment",
 			Handler:    _PatientService_AddAppointment_Handler,
 		},
+		{
+			MethodName: "HoldAppointmentSlot",
+			Handler:    _PatientService_HoldAppointmentSlot_Handler,
+		},
+		{
+			MethodName: "ReleaseAppointmentSlot",
+			Handler:    _PatientService_ReleaseAppointmentSlot_Handler,
+		},
 		{
 			MethodName: "GetUpcomingAppointments",
 			Handler:    _PatientService_GetUpcomingAppointments_Handler,
@@ -403,23 +881,74 @@ var PatientService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelAppointment",
 			Handler:    _PatientService_CancelAppointment_Handler,
 		},
+		{
+			MethodName: "JoinWaitingList",
+			Handler:    _PatientService_JoinWaitingList_Handler,
+		},
+		{
+			MethodName: "GetWaitingList",
+			Handler:    _PatientService_GetWaitingList_Handler,
+		},
+		{
+			MethodName: "LeaveWaitingList",
+			Handler:    _PatientService_LeaveWaitingList_Handler,
+		},
+		{
+			MethodName: "AcceptWaitingListOffer",
+			Handler:    _PatientService_AcceptWaitingListOffer_Handler,
+		},
+		{
+			MethodName: "DeclineWaitingListOffer",
+			Handler:    _PatientService_DeclineWaitingListOffer_Handler,
+		},
+		{
+			MethodName: "GetNotificationSettings",
+			Handler:    _PatientService_GetNotificationSettings_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationSettings",
+			Handler:    _PatientService_UpdateNotificationSettings_Handler,
+		},
 		{
 			MethodName: "GetHistoryVisits",
 			Handler:    _PatientService_GetHistoryVisits_Handler,
 		},
 		{
-			MethodName: "UploadTest",
-			Handler:    _PatientService_UploadTest_Handler,
+			MethodName: "GetTreatmentPlans",
+			Handler:    _PatientService_GetTreatmentPlans_Handler,
+		},
+		{
+			MethodName: "AcceptTreatmentPlan",
+			Handler:    _PatientService_AcceptTreatmentPlan_Handler,
+		},
+		{
+			MethodName: "GetAnamnesis",
+			Handler:    _PatientService_GetAnamnesis_Handler,
+		},
+		{
+			MethodName: "SaveAnamnesis",
+			Handler:    _PatientService_SaveAnamnesis_Handler,
+		},
+		{
+			MethodName: "ExportMedicalRecord",
+			Handler:    _PatientService_ExportMedicalRecord_Handler,
 		},
 		{
 			MethodName: "GetDocumentsByPatientID",
 			Handler:    _PatientService_GetDocumentsByPatientID_Handler,
 		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadTest",
+			Handler:       _PatientService_UploadTest_Handler,
+			ClientStreams: true,
+		},
 		{
-			MethodName: "DownloadDocument",
-			Handler:    _PatientService_DownloadDocument_Handler,
+			StreamName:    "DownloadDocument",
+			Handler:       _PatientService_DownloadDocument_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/patient/patient.proto",
 }
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/access"
+	authpb "github.com/DariaTarasek/diplom/services/patient/proto/auth"
+	storagepb "github.com/DariaTarasek/diplom/services/patient/proto/storage"
+)
+
+// storageRelations отношения врача к пациенту из storage для политики доступа
+type storageRelations struct {
+	client storagepb.StorageServiceClient
+}
+
+func (r storageRelations) DoctorRelation(ctx context.Context, doctorID, patientID int) (access.Relation, error) {
+	rel, err := r.client.GetDoctorPatientRelation(ctx, &storagepb.DoctorPatientRelationRequest{
+		DoctorId:  int32(doctorID),
+		PatientId: int32(patientID),
+	})
+	if err != nil {
+		return access.Relation{}, err
+	}
+	return access.Relation{Treating: rel.Treating, Granted: rel.Granted}, nil
+}
+
+// authorize проверяет, может ли владелец токена выполнить action над данными пациента. Отказ — access.ErrForbidden,
+// клиент получает его с кодом PermissionDenied
+func (s *PatientService) authorize(ctx context.Context, token string, action access.Action, resource access.Resource) error {
+	user, err := s.AuthClient.Client.GetUserRole(ctx, &authpb.GetUserIDRequest{Token: token})
+	if err != nil {
+		return fmt.Errorf("не удалось определить пользователя: %w", err)
+	}
+	subject := access.Subject{UserID: int(user.UserId), Role: access.Role(user.Role)}
+	return access.Authorize(ctx, subject, action, resource, storageRelations{client: s.StorageClient.Client})
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/patient/model"
+	authpb "github.com/DariaTarasek/diplom/services/patient/proto/auth"
+	storagepb "github.com/DariaTarasek/diplom/services/patient/proto/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	maxAnamnesisText      = 2000
+	maxAnamnesisAllergies = 30
+	maxAllergenLength     = 200
+	maxAllergyReaction    = 500
+)
+
+// GetAnamnesis текущая версия анкеты здоровья пациента
+func (s *PatientService) GetAnamnesis(ctx context.Context, token string) (model.Anamnesis, error) {
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return model.Anamnesis{}, fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	resp, err := s.StorageClient.Client.GetAnamnesis(ctx, &storagepb.GetByIdRequest{Id: user.Patient.UserId})
+	if err != nil {
+		return model.Anamnesis{}, fmt.Errorf("не удалось получить анкету здоровья: %w", err)
+	}
+	return s.anamnesisFromPb(ctx, resp.Anamnesis)
+}
+
+// SaveAnamnesis пациент заполняет или обновляет анкету здоровья; каждое сохранение — новая версия.
+// baseVersion — версия, которую пациент открыл; если врач успел её изменить, сохранение отклоняется
+func (s *PatientService) SaveAnamnesis(ctx context.Context, token string, anamnesis model.Anamnesis, baseVersion int) (model.Anamnesis, error) {
+	if err := validateAnamnesis(&anamnesis); err != nil {
+		return model.Anamnesis{}, err
+	}
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return model.Anamnesis{}, fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	req := anamnesisToStorage(anamnesis)
+	req.PatientId = user.Patient.UserId
+	req.ChangedBy = user.Patient.UserId
+	req.ChangedByRole = "patient"
+	resp, err := s.StorageClient.Client.SaveAnamnesis(ctx, &storagepb.SaveAnamnesisRequest{
+		Anamnesis:   req,
+		BaseVersion: int32(baseVersion),
+	})
+	if err != nil {
+		return model.Anamnesis{}, fmt.Errorf("не удалось сохранить анкету здоровья: %w", err)
+	}
+	return s.anamnesisFromPb(ctx, resp.Anamnesis)
+}
+
+// validateAnamnesis проверяет ответы анкеты и обрезает пробелы в текстовых полях
+func validateAnamnesis(anamnesis *model.Anamnesis) error {
+	anamnesis.Medications = strings.TrimSpace(anamnesis.Medications)
+	anamnesis.OtherConditions = strings.TrimSpace(anamnesis.OtherConditions)
+	if len([]rune(anamnesis.Medications)) > maxAnamnesisText || len([]rune(anamnesis.OtherConditions)) > maxAnamnesisText {
+		return status.Errorf(codes.InvalidArgument, "ответ в анкете не должен превышать %d символов", maxAnamnesisText)
+	}
+	if len(anamnesis.Allergies) > maxAnamnesisAllergies {
+		return status.Errorf(codes.InvalidArgument, "в анкете можно указать не больше %d аллергий", maxAnamnesisAllergies)
+	}
+	for i := range anamnesis.Allergies {
+		allergy := &anamnesis.Allergies[i]
+		allergy.Allergen = strings.TrimSpace(allergy.Allergen)
+		allergy.Reaction = strings.TrimSpace(allergy.Reaction)
+		if allergy.Allergen == "" || len([]rune(allergy.Allergen)) > maxAllergenLength {
+			return status.Errorf(codes.InvalidArgument, "аллерген должен содержать от 1 до %d символов", maxAllergenLength)
+		}
+		if len([]rune(allergy.Reaction)) > maxAllergyReaction {
+			return status.Errorf(codes.InvalidArgument, "описание реакции не должно превышать %d символов", maxAllergyReaction)
+		}
+		switch allergy.Severity {
+		case "mild", "moderate", "severe":
+		default:
+			return status.Errorf(codes.InvalidArgument, "неизвестная тяжесть аллергии %q: ожидается mild, moderate или severe", allergy.Severity)
+		}
+	}
+	return nil
+}
+
+func anamnesisToStorage(anamnesis model.Anamnesis) *storagepb.Anamnesis {
+	item := &storagepb.Anamnesis{
+		Medications:        anamnesis.Medications,
+		BloodThinners:      anamnesis.BloodThinners,
+		CardiacDisease:     anamnesis.CardiacDisease,
+		Hypertension:       anamnesis.Hypertension,
+		Diabetes:           anamnesis.Diabetes,
+		BleedingDisorder:   anamnesis.BleedingDisorder,
+		InfectiousDisease:  anamnesis.InfectiousDisease,
+		AnesthesiaReaction: anamnesis.AnesthesiaReaction,
+		Pregnant:           anamnesis.Pregnant,
+		Breastfeeding:      anamnesis.Breastfeeding,
+		Smoker:             anamnesis.Smoker,
+		OtherConditions:    anamnesis.OtherConditions,
+	}
+	for _, allergy := range anamnesis.Allergies {
+		item.Allergies = append(item.Allergies, &storagepb.AnamnesisAllergy{
+			Allergen: allergy.Allergen,
+			Severity: allergy.Severity,
+			Reaction: allergy.Reaction,
+		})
+	}
+	return item
+}
+
+// anamnesisFromPb версия анкеты из ответа storage с ФИО врача, если её сохранил врач
+func (s *PatientService) anamnesisFromPb(ctx context.Context, item *storagepb.Anamnesis) (model.Anamnesis, error) {
+	anamnesis := model.Anamnesis{
+		Version:            int(item.Version),
+		Medications:        item.Medications,
+		BloodThinners:      item.BloodThinners,
+		CardiacDisease:     item.CardiacDisease,
+		Hypertension:       item.Hypertension,
+		Diabetes:           item.Diabetes,
+		BleedingDisorder:   item.BleedingDisorder,
+		InfectiousDisease:  item.InfectiousDisease,
+		AnesthesiaReaction: item.AnesthesiaReaction,
+		Pregnant:           item.Pregnant,
+		Breastfeeding:      item.Breastfeeding,
+		Smoker:             item.Smoker,
+		OtherConditions:    item.OtherConditions,
+		ChangedByRole:      item.ChangedByRole,
+	}
+	if item.CreatedAt != nil {
+		anamnesis.ChangedAt = item.CreatedAt.AsTime().Format("02.01.2006 15:04")
+	}
+	if item.ChangedByRole == "doctor" && item.ChangedBy != 0 {
+		doctorResp, err := s.StorageClient.Client.GetDoctorByID(ctx, &storagepb.GetByIDRequest{Id: item.ChangedBy})
+		if err != nil {
+			return model.Anamnesis{}, fmt.Errorf("не удалось получить врача, изменившего анкету: %w", err)
+		}
+		anamnesis.ChangedBy = fmt.Sprintf("%s %s %s", doctorResp.Doctor.SecondName, doctorResp.Doctor.FirstName, doctorResp.Doctor.Surname)
+	}
+	for _, allergy := range item.Allergies {
+		anamnesis.Allergies = append(anamnesis.Allergies, model.AnamnesisAllergy{
+			Allergen: allergy.Allergen,
+			Severity: allergy.Severity,
+			Reaction: allergy.Reaction,
+		})
+	}
+	return anamnesis, nil
+}
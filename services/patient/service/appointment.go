@@ -3,12 +3,12 @@ package service
 import (
 	"context"
 	"fmt"
+	"github.com/DariaTarasek/diplom/services/access"
 	"github.com/DariaTarasek/diplom/services/patient/model"
 	authpb "github.com/DariaTarasek/diplom/services/patient/proto/auth"
 	storagepb "github.com/DariaTarasek/diplom/services/patient/proto/storage"
+	"github.com/DariaTarasek/diplom/services/scheduling"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"log"
-	"sort"
 	"time"
 	"unicode"
 )
@@ -17,236 +17,183 @@ const (
 	dayAhead   = 30
 	weeksAhead = 4
 	timeLayout = "15:04"
+	// slotHoldTTL время, на которое слот закрепляется за пациентом, пока он заполняет форму записи
+	slotHoldTTL = 10 * time.Minute
 )
 
-type Override struct {
-	StartTime time.Time
-	EndTime   time.Time
-	SlotMins  int
-	IsDayOff  bool
-}
-
-func (s *PatientService) MakeDoctorAppointmentSlots(ctx context.Context, doctorID int) ([]model.ScheduleEntry, error) {
-	resp, err := s.StorageClient.Client.GetDoctorWeeklySchedule(ctx, &storagepb.GetScheduleByDoctorIdRequest{DoctorId: int32(doctorID)})
-	if err != nil {
-		return nil, fmt.Errorf("не удалось получить расписание врача: %w", err)
-	}
-	docOverrides, err := s.StorageClient.Client.GetDoctorOverrides(ctx, &storagepb.GetByIDRequest{Id: int32(doctorID)})
+// MakeDoctorAppointmentSlots свободные начала приёмов у врача на weeksAhead недель вперёд. Если выбраны услуги,
+// возвращаются только те начала, от которых хватает свободного времени на все услуги подряд
+func (s *PatientService) MakeDoctorAppointmentSlots(ctx context.Context, doctorID int, serviceIDs []int) ([]model.ScheduleEntry, error) {
+	schedule, err := s.loadDoctorSchedule(ctx, int32(doctorID))
 	if err != nil {
-		return nil, fmt.Errorf("не удалось получить переопределения врача: %w", err)
+		return nil, err
 	}
-	clinicOverrides, err := s.StorageClient.Client.GetClinicOverrides(ctx, &storagepb.EmptyRequest{})
+	duration, err := s.servicesDuration(ctx, serviceIDs)
 	if err != nil {
-		return nil, fmt.Errorf("не удалось получить переопределения клиники: %w", err)
-	}
-
-	clinicOverridesMap := make(map[string]Override)
-	for _, o := range clinicOverrides.Overrides {
-		dateStr := o.Date.AsTime().Format("02.01.2006")
-		clinicOverridesMap[dateStr] = Override{
-			StartTime: o.StartTime.AsTime(),
-			EndTime:   o.EndTime.AsTime(),
-			SlotMins:  int(o.SlotDurationMinutes),
-			IsDayOff:  o.IsDayOff,
-		}
-	}
-
-	doctorOverridesMap := make(map[string]Override)
-	for _, o := range docOverrides.Override {
-		dateStr := o.Date.AsTime().Format("02.01.2006")
-		doctorOverridesMap[dateStr] = Override{
-			StartTime: o.StartTime.AsTime(),
-			EndTime:   o.EndTime.AsTime(),
-			SlotMins:  int(o.SlotDurationMinutes),
-			IsDayOff:  o.IsDayOff,
-		}
-	}
-
-	var doctorSchedule []model.DoctorSchedule
-	for _, item := range resp.DoctorSchedule {
-		start, end := item.StartTime.AsTime(), item.EndTime.AsTime()
-		slotDuration := int(item.SlotDurationMinutes)
-		doctorDay := model.DoctorSchedule{
-			ID:                  int(item.Id),
-			DoctorID:            model.UserID(item.DoctorId),
-			Weekday:             int(item.Weekday),
-			StartTime:           &start,
-			EndTime:             &end,
-			SlotDurationMinutes: &slotDuration,
-			IsDayOff:            &item.IsDayOff,
-		}
-		doctorSchedule = append(doctorSchedule, doctorDay)
+		return nil, err
 	}
 
 	apps, err := s.StorageClient.Client.GetAppointmentsByDoctorID(ctx, &storagepb.GetAppointmentsByDoctorIDRequest{DoctorId: int32(doctorID)})
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить существующие записи к врачу: %w", err)
 	}
-
-	var appointments []model.Appointment
-	for _, app := range apps.Appointments {
-		patientID := model.UserID(app.PatientId)
-		appointment := model.Appointment{
-			ID:                 model.AppointmentID(app.Id),
-			DoctorID:           model.UserID(app.DoctorId),
-			PatientID:          &patientID,
-			Date:               app.Date.AsTime(),
-			Time:               app.Time.AsTime(),
-			PatientSecondName:  app.SecondName,
-			PatientFirstName:   app.FirstName,
-			PatientSurname:     &app.Surname,
-			PatientBirthDate:   app.BirthDate.AsTime(),
-			PatientGender:      app.Gender,
-			PatientPhoneNumber: app.PhoneNumber,
-			Status:             app.Status,
-			CreatedAt:          app.CreatedAt.AsTime(),
-			UpdatedAt:          app.UpdatedAt.AsTime(),
-		}
-		appointments = append(appointments, appointment)
+	holds, err := s.StorageClient.Client.GetAppointmentSlotHolds(ctx, &storagepb.GetAppointmentsByDoctorIDRequest{DoctorId: int32(doctorID)})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить удержания слотов врача: %w", err)
 	}
 
-	busy := make(map[string]map[string]bool)
-	for _, app := range appointments {
+	var busy []scheduling.Booking
+	for _, app := range apps.Appointments {
 		if app.Status == "cancelled" {
 			continue
 		}
-		dateStr := app.Date.Format("02.01.2006")
-		timeStr := app.Time.Format("15:04")
-		if busy[dateStr] == nil {
-			busy[dateStr] = make(map[string]bool)
-		}
-		busy[dateStr][timeStr] = true
+		busy = append(busy, scheduling.Booking{
+			Start:   scheduling.Wall(app.Date.AsTime(), app.Time.AsTime(), schedule.Location),
+			Minutes: int(app.DurationMinutes),
+		})
 	}
-
-	var tempSlots []struct {
-		Date      time.Time
-		DateLabel string
-		Slots     []string
+	for _, hold := range holds.Holds {
+		busy = append(busy, scheduling.Booking{
+			Start:   scheduling.Wall(hold.Date.AsTime(), hold.Time.AsTime(), schedule.Location),
+			Minutes: int(hold.DurationMinutes),
+		})
 	}
 
-	today := time.Now()
-	monday := today.AddDate(0, 0, -int(today.Weekday())+1)
-
-	totalDays := weeksAhead * 7
-	for i := 0; i < totalDays; i++ {
-		date := monday.AddDate(0, 0, i)
-		dateStr := date.Format("02.01.2006")
-		dateBusyStr := date.Format("02.01.2006")
-		weekday := date.Weekday()
+	now := time.Now().In(schedule.Location)
+	from := scheduling.WeekStart(now)
+	days := schedule.FreeSlots(scheduling.Query{
+		From:            from,
+		To:              from.AddDate(0, 0, weeksAhead*7-1),
+		DurationMinutes: duration,
+		Busy:            busy,
+		Now:             now,
+	})
 
+	var result []model.ScheduleEntry
+	for _, day := range days {
 		var slots []string
+		for _, slot := range day.Slots {
+			slots = append(slots, slot.Format(timeLayout))
+		}
+		result = append(result, model.ScheduleEntry{
+			Label: fmt.Sprintf("%s\n(%s)", day.Hours.Date.Format("02.01.2006"), weekdayToRus(day.Hours.Date.Weekday())),
+			Slots: slots,
+		})
+	}
+	return result, nil
+}
 
-		clinicOverride, hasClinic := clinicOverridesMap[dateStr]
-		doctorOverride, hasDoctor := doctorOverridesMap[dateStr]
-
+// AddAppointment создаёт запись. Занятость слота проверяется в хранилище в одной транзакции со вставкой,
+// при конфликте возвращается ошибка с кодом codes.AlreadyExists. Пациент записывается только на себя: карта
+// пациента берётся из токена, а у записи гостя или сотрудника её нет, какой бы PatientID ни пришёл в запросе.
+// Записаться по удержанию слота может только тот, кто его удерживает, поэтому с HoldID нужен токен
+func (s *PatientService) AddAppointment(ctx context.Context, token string, appointment model.Appointment) error {
+	duration, err := s.appointmentDuration(ctx, appointment.DoctorID, appointment.Date, appointment.ServiceIDs)
+	if err != nil {
+		return err
+	}
+	appointment.PatientID = nil
+	var heldBy int32
+	if token != "" || appointment.HoldID != "" {
+		caller, err := s.slotHolder(ctx, token)
 		switch {
-		// Приоритет: выходной клиники => нет слотов
-		case hasClinic && clinicOverride.IsDayOff:
-			slots = append(slots, []string{}...)
-
-		// Если врач взял выходной — игнорируем, даже если клиника работает
-		case hasDoctor && doctorOverride.IsDayOff:
-			slots = append(slots, []string{}...)
-
-		// Если есть обе перегрузки
-		case hasClinic && hasDoctor:
-			clinicStart := time.Date(date.Year(), date.Month(), date.Day(), clinicOverride.StartTime.Hour(), clinicOverride.StartTime.Minute(), 0, 0, time.Local)
-			clinicEnd := time.Date(date.Year(), date.Month(), date.Day(), clinicOverride.EndTime.Hour(), clinicOverride.EndTime.Minute(), 0, 0, time.Local)
-
-			doctorStart := time.Date(date.Year(), date.Month(), date.Day(), doctorOverride.StartTime.Hour(), doctorOverride.StartTime.Minute(), 0, 0, time.Local)
-			doctorEnd := time.Date(date.Year(), date.Month(), date.Day(), doctorOverride.EndTime.Hour(), doctorOverride.EndTime.Minute(), 0, 0, time.Local)
-
-			if !doctorStart.Before(clinicStart) && !doctorEnd.After(clinicEnd) {
-				// Врач внутри клиники — используем врача
-				slots = append(slots, generateSlots(doctorStart, doctorEnd, doctorOverride.SlotMins, busy[dateBusyStr])...)
-			} else {
-				// Врач вне рамок — используем клинику
-				slots = append(slots, generateSlots(clinicStart, clinicEnd, clinicOverride.SlotMins, busy[dateBusyStr])...)
-			}
-
-		// Только перегрузка врача
-		case hasDoctor:
-			start := time.Date(date.Year(), date.Month(), date.Day(), doctorOverride.StartTime.Hour(), doctorOverride.StartTime.Minute(), 0, 0, time.Local)
-			end := time.Date(date.Year(), date.Month(), date.Day(), doctorOverride.EndTime.Hour(), doctorOverride.EndTime.Minute(), 0, 0, time.Local)
-			slots = append(slots, generateSlots(start, end, doctorOverride.SlotMins, busy[dateBusyStr])...)
-
-		// Только перегрузка клиники
-		case hasClinic:
-			start := time.Date(date.Year(), date.Month(), date.Day(), clinicOverride.StartTime.Hour(), clinicOverride.StartTime.Minute(), 0, 0, time.Local)
-			end := time.Date(date.Year(), date.Month(), date.Day(), clinicOverride.EndTime.Hour(), clinicOverride.EndTime.Minute(), 0, 0, time.Local)
-			slots = append(slots, generateSlots(start, end, clinicOverride.SlotMins, busy[dateBusyStr])...)
-
-		// Никаких перегрузок — обычное расписание
+		case err != nil && appointment.HoldID != "":
+			return err
+		case err != nil:
+			// просроченный или чужой токен без удержания — запись как от гостя
 		default:
-			log.Printf("weekday=%d, doctorSchedule count=%d", weekday, len(doctorSchedule))
-			for _, day := range doctorSchedule {
-				log.Printf("checking day: weekday=%d, target=%d, isDayOff=%v", day.Weekday, weekday, derefBool(day.IsDayOff))
-				log.Printf("doctor day: Weekday=%d Start=%v End=%v", day.Weekday, day.StartTime, day.EndTime)
-				if day.Weekday == int(weekday) && !derefBool(day.IsDayOff) {
-					slotMins := derefInt(day.SlotDurationMinutes)
-					if slotMins == 0 {
-						slotMins = 30
-					}
-					start := time.Date(date.Year(), date.Month(), date.Day(), day.StartTime.Hour(), day.StartTime.Minute(), 0, 0, time.Local)
-					end := time.Date(date.Year(), date.Month(), date.Day(), day.EndTime.Hour(), day.EndTime.Minute(), 0, 0, time.Local)
-					log.Printf("Generating slots for weekday=%d from %v to %v", weekday, start, end)
-					slots = append(slots, generateSlots(start, end, slotMins, busy[dateBusyStr])...)
-				}
+			heldBy = caller.UserId
+			if access.Role(caller.Role) == access.RolePatient {
+				patientID := model.UserID(caller.UserId)
+				appointment.PatientID = &patientID
 			}
 		}
-
-		label := fmt.Sprintf("%s\n(%s)", date.Format("02.01.2006"), weekdayToRus(weekday))
-		tempSlots = append(tempSlots, struct {
-			Date      time.Time
-			DateLabel string
-			Slots     []string
-		}{
-			Date:      date,
-			DateLabel: label,
-			Slots:     slots,
-		})
 	}
-
-	sort.Slice(tempSlots, func(i, j int) bool {
-		return tempSlots[i].Date.Before(tempSlots[j].Date)
+	appointmentPB := &storagepb.Appointment{
+		DoctorId:        int32(appointment.DoctorID),
+		Date:            timestamppb.New(appointment.Date),
+		Time:            timestamppb.New(appointment.Time),
+		PatientId:       int32(derefUserID(appointment.PatientID)),
+		SecondName:      appointment.PatientSecondName,
+		FirstName:       appointment.PatientFirstName,
+		Surname:         deref(appointment.PatientSurname),
+		BirthDate:       timestamppb.New(appointment.PatientBirthDate),
+		Gender:          appointment.PatientGender,
+		PhoneNumber:     appointment.PatientPhoneNumber,
+		Status:          "unconfirmed",
+		CreatedAt:       timestamppb.New(time.Now()),
+		UpdatedAt:       timestamppb.New(time.Now()),
+		DurationMinutes: int32(duration),
+	}
+	_, err = s.StorageClient.Client.AddAppointment(ctx, &storagepb.AddAppointmentRequest{
+		Appointment: appointmentPB,
+		HoldId:      appointment.HoldID,
+		HeldBy:      heldBy,
 	})
-
-	var result []model.ScheduleEntry
-	for _, slot := range tempSlots {
-		result = append(result, model.ScheduleEntry{
-			Label: slot.DateLabel,
-			Slots: slot.Slots,
-		})
+	if err != nil {
+		return err
 	}
+	return nil
+}
 
-	//log.Println(result)
-	return result, nil
+// HoldAppointmentSlot закрепляет слот за владельцем токена на slotHoldTTL, чтобы его не заняли, пока заполняется
+// форма записи. Пациент удерживает слот только для себя, сотрудник клиники — для пациента, которого записывает
+func (s *PatientService) HoldAppointmentSlot(ctx context.Context, token string, hold model.AppointmentSlotHold) (model.AppointmentSlotHold, error) {
+	holder, err := s.slotHolder(ctx, token)
+	if err != nil {
+		return model.AppointmentSlotHold{}, err
+	}
+	if access.Role(holder.Role) == access.RolePatient {
+		patientID := model.UserID(holder.UserId)
+		hold.PatientID = &patientID
+	}
+	duration, err := s.appointmentDuration(ctx, hold.DoctorID, hold.Date, hold.ServiceIDs)
+	if err != nil {
+		return model.AppointmentSlotHold{}, err
+	}
+	resp, err := s.StorageClient.Client.HoldAppointmentSlot(ctx, &storagepb.HoldAppointmentSlotRequest{
+		HoldId:          hold.ID,
+		DoctorId:        int32(hold.DoctorID),
+		Date:            timestamppb.New(hold.Date),
+		Time:            timestamppb.New(hold.Time),
+		PatientId:       int32(derefUserID(hold.PatientID)),
+		TtlSeconds:      int32(slotHoldTTL.Seconds()),
+		DurationMinutes: int32(duration),
+		HeldBy:          holder.UserId,
+	})
+	if err != nil {
+		return model.AppointmentSlotHold{}, fmt.Errorf("не удалось удержать слот: %w", err)
+	}
+	hold.ID = resp.Hold.HoldId
+	hold.ExpiresAt = resp.Hold.ExpiresAt.AsTime()
+	return hold, nil
 }
 
-func (s *PatientService) AddAppointment(ctx context.Context, appointment model.Appointment) error {
-	// TODO: Добавить сюда проверку, что GetAppointment(date, time) не существует
-	appointmentPB := &storagepb.Appointment{
-		DoctorId:    int32(appointment.DoctorID),
-		Date:        timestamppb.New(appointment.Date),
-		Time:        timestamppb.New(appointment.Time),
-		PatientId:   int32(derefUserID(appointment.PatientID)),
-		SecondName:  appointment.PatientSecondName,
-		FirstName:   appointment.PatientFirstName,
-		Surname:     deref(appointment.PatientSurname),
-		BirthDate:   timestamppb.New(appointment.PatientBirthDate),
-		Gender:      appointment.PatientGender,
-		PhoneNumber: appointment.PatientPhoneNumber,
-		Status:      "unconfirmed",
-		CreatedAt:   timestamppb.New(time.Now()),
-		UpdatedAt:   timestamppb.New(time.Now()),
-	}
-	_, err := s.StorageClient.Client.AddAppointment(ctx, &storagepb.AddAppointmentRequest{Appointment: appointmentPB})
+// ReleaseAppointmentSlot снимает удержание слота, если пациент передумал записываться; снять можно только своё удержание
+func (s *PatientService) ReleaseAppointmentSlot(ctx context.Context, token string, holdID string) error {
+	holder, err := s.slotHolder(ctx, token)
 	if err != nil {
 		return err
 	}
+	_, err = s.StorageClient.Client.ReleaseAppointmentSlot(ctx, &storagepb.ReleaseAppointmentSlotRequest{
+		HoldId: holdID,
+		HeldBy: holder.UserId,
+	})
+	if err != nil {
+		return fmt.Errorf("не удалось снять удержание слота: %w", err)
+	}
 	return nil
 }
 
+// slotHolder пользователь, от имени которого удерживается слот
+func (s *PatientService) slotHolder(ctx context.Context, token string) (*authpb.GetUserRoleResponse, error) {
+	user, err := s.AuthClient.Client.GetUserRole(ctx, &authpb.GetUserIDRequest{Token: token})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось определить пользователя, удерживающего слот: %w", err)
+	}
+	return user, nil
+}
+
 func (s *PatientService) GetUpcomingAppointments(ctx context.Context, token string) ([]model.UpcomingAppointment, error) {
 	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
 	if err != nil {
@@ -265,18 +212,17 @@ func (s *PatientService) GetUpcomingAppointments(ctx context.Context, token stri
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить все специальности")
 	}
+	loc, err := s.clinicLocation(ctx)
+	if err != nil {
+		return nil, err
+	}
 	now := time.Now()
 	for _, app := range apps.Appointment {
 		if app.Status == "cancelled" || app.Status == "completed" {
 			continue
 		}
-		appointmentDate := app.Date.AsTime() // например, 06.06.2025 00:00
-		appointmentTime := app.Time.AsTime() // например, 0001-01-01 16:00
-		appointmentDateTime := time.Date(
-			appointmentDate.Year(), appointmentDate.Month(), appointmentDate.Day(),
-			appointmentTime.Hour(), appointmentTime.Minute(), appointmentTime.Second(), 0,
-			time.Local,
-		)
+		// дата и время записи хранятся по часам клиники, например 06.06.2025 и 16:00
+		appointmentDateTime := scheduling.Wall(app.Date.AsTime(), app.Time.AsTime(), loc)
 
 		if appointmentDateTime.Before(now) {
 			continue
@@ -311,11 +257,16 @@ func (s *PatientService) GetUpcomingAppointments(ctx context.Context, token stri
 	return upcoming, nil
 }
 
-func (s *PatientService) UpdateAppointment(ctx context.Context, appointment model.Appointment) error {
+// UpdateAppointment переносит запись на другое время. Перенести можно только свою запись; администратор
+// переносит любую
+func (s *PatientService) UpdateAppointment(ctx context.Context, token string, appointment model.Appointment) error {
 	currApp, err := s.StorageClient.Client.GetAppointmentByID(ctx, &storagepb.GetByIDRequest{Id: int32(appointment.ID)})
 	if err != nil {
 		return fmt.Errorf("не удалось получить запись: %w", err)
 	}
+	if err := s.authorize(ctx, token, access.ManageAppointment, appointmentResource(currApp.Appointment)); err != nil {
+		return err
+	}
 	updateApp := &storagepb.Appointment{
 		Id:        int32(appointment.ID),
 		Date:      timestamppb.New(appointment.Date),
@@ -330,11 +281,15 @@ func (s *PatientService) UpdateAppointment(ctx context.Context, appointment mode
 	return nil
 }
 
-func (s *PatientService) CancelAppointment(ctx context.Context, id model.AppointmentID) error {
+// CancelAppointment отменяет запись. Отменить можно только свою запись; администратор отменяет любую
+func (s *PatientService) CancelAppointment(ctx context.Context, token string, id model.AppointmentID) error {
 	currApp, err := s.StorageClient.Client.GetAppointmentByID(ctx, &storagepb.GetByIDRequest{Id: int32(id)})
 	if err != nil {
 		return fmt.Errorf("не удалось получить запись: %w", err)
 	}
+	if err := s.authorize(ctx, token, access.ManageAppointment, appointmentResource(currApp.Appointment)); err != nil {
+		return err
+	}
 	updateApp := &storagepb.Appointment{
 		Id:        int32(id),
 		Date:      currApp.Appointment.Date,
@@ -349,6 +304,11 @@ func (s *PatientService) CancelAppointment(ctx context.Context, id model.Appoint
 	return nil
 }
 
+// appointmentResource запись на приём как объект проверки доступа
+func appointmentResource(app *storagepb.Appointment) access.Resource {
+	return access.Resource{PatientID: int(app.PatientId), DoctorID: int(app.DoctorId)}
+}
+
 func weekdayToRus(weekday time.Weekday) string {
 	switch weekday {
 	case time.Monday:
@@ -383,20 +343,6 @@ func derefUserID(u *model.UserID) model.UserID {
 	return *u
 }
 
-func derefInt(i *int) int {
-	if i == nil {
-		return 0
-	}
-	return *i
-}
-
-func derefBool(b *bool) bool {
-	if b == nil {
-		return false
-	}
-	return *b
-}
-
 func getAndCapitalizeFirstLetter(str string) string {
 	if str == "" {
 		return ""
@@ -404,22 +350,3 @@ func getAndCapitalizeFirstLetter(str string) string {
 	runes := []rune(str)
 	return string(unicode.ToUpper(runes[0]))
 }
-
-func generateSlots(start, end time.Time, slotMins int, busy map[string]bool) []string {
-	if !start.Before(end) {
-		log.Printf("WARNING: start >= end: %v >= %v", start, end)
-		return nil
-	}
-	var result []string
-	for t := start; t.Before(end); t = t.Add(time.Duration(slotMins) * time.Minute) {
-		if t.Before(time.Now()) {
-			continue
-		}
-		timeStr := t.Format("15:04")
-		if busy != nil && busy[timeStr] {
-			continue
-		}
-		result = append(result, timeStr)
-	}
-	return result
-}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"github.com/DariaTarasek/diplom/services/access"
+	"github.com/DariaTarasek/diplom/services/patient/clients"
+	"github.com/DariaTarasek/diplom/services/patient/model"
+	authpb "github.com/DariaTarasek/diplom/services/patient/proto/auth"
+	storagepb "github.com/DariaTarasek/diplom/services/patient/proto/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+// fakeAuth пользователи по токену
+type fakeAuth struct {
+	authpb.AuthServiceClient
+	users map[string]*authpb.GetUserRoleResponse
+}
+
+func (f *fakeAuth) GetUserRole(_ context.Context, in *authpb.GetUserIDRequest, _ ...grpc.CallOption) (*authpb.GetUserRoleResponse, error) {
+	user, ok := f.users[in.Token]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "токен недействителен")
+	}
+	return user, nil
+}
+
+// fakeStorage запоминает последнюю созданную запись; у всех услуг длительность 30 минут
+type fakeStorage struct {
+	storagepb.StorageServiceClient
+	added *storagepb.AddAppointmentRequest
+}
+
+func (f *fakeStorage) GetServiceByID(_ context.Context, in *storagepb.GetByIdRequest, _ ...grpc.CallOption) (*storagepb.GetMaterialServiceByIDResponse, error) {
+	return &storagepb.GetMaterialServiceByIDResponse{Id: in.Id, DurationMinutes: 30}, nil
+}
+
+func (f *fakeStorage) AddAppointment(_ context.Context, in *storagepb.AddAppointmentRequest, _ ...grpc.CallOption) (*storagepb.DefaultResponse, error) {
+	f.added = in
+	return &storagepb.DefaultResponse{}, nil
+}
+
+// Карта пациента у записи берётся только из токена пациента: врач, записавший себя к чужому пациенту,
+// не должен получить запись, которая даёт ему доступ к карте
+func TestAddAppointmentPatientFromToken(t *testing.T) {
+	auth := &fakeAuth{users: map[string]*authpb.GetUserRoleResponse{
+		"patient": {UserId: 42, Role: int32(access.RolePatient)},
+		"doctor":  {UserId: 7, Role: int32(access.RoleDoctor)},
+		"admin":   {UserId: 2, Role: int32(access.RoleAdmin)},
+	}}
+	storage := &fakeStorage{}
+	s := NewPatientService(&clients.StorageClient{Client: storage}, &clients.AuthClient{Client: auth}, nil)
+
+	claimed := model.UserID(43)
+	tests := []struct {
+		name    string
+		token   string
+		holdID  string
+		patient int32
+		wantErr bool
+	}{
+		{name: "гость", token: "", patient: 0},
+		{name: "пациент записывается на себя", token: "patient", patient: 42},
+		{name: "врач не может записать себя к чужому пациенту", token: "doctor", patient: 0},
+		{name: "администратор", token: "admin", patient: 0},
+		{name: "недействительный токен без удержания", token: "expired", patient: 0},
+		{name: "удержание без токена", token: "", holdID: "hold", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage.added = nil
+			err := s.AddAppointment(context.Background(), tt.token, model.Appointment{
+				DoctorID:   7,
+				Date:       time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+				Time:       time.Date(0, 1, 1, 10, 0, 0, 0, time.UTC),
+				PatientID:  &claimed,
+				ServiceIDs: []int{1},
+				HoldID:     tt.holdID,
+			})
+			if tt.wantErr {
+				if err == nil || storage.added != nil {
+					t.Fatalf("expected an error and no appointment, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := storage.added.Appointment.PatientId; got != tt.patient {
+				t.Errorf("patient_id = %d, want %d", got, tt.patient)
+			}
+		})
+	}
+}
@@ -5,26 +5,44 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/DariaTarasek/diplom/services/access"
 	"github.com/DariaTarasek/diplom/services/patient/model"
 	authpb "github.com/DariaTarasek/diplom/services/patient/proto/auth"
 	storagepb "github.com/DariaTarasek/diplom/services/patient/proto/storage"
 	"github.com/google/uuid"
 	"github.com/suyashkumar/dicom"
 	"github.com/suyashkumar/dicom/pkg/tag"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"image"
 	"image/color"
 	"image/jpeg"
+	"io"
 	"strconv"
 	"strings"
 	"time"
 )
 
+const (
+	// MaxTestSize предельный размер загружаемого снимка, совпадает с ограничением storage
+	MaxTestSize = 4 << 30
+	// previewMaxSize превью строим только для файлов до этого размера: библиотека DICOM держит все кадры в памяти
+	previewMaxSize = 64 << 20
+	// documentChunkSize размер части файла в потоке к storage
+	documentChunkSize = 1 << 20
+	// uploadAttempts сколько раз передаём файл в storage, продолжая с принятого объёма после обрыва
+	uploadAttempts = 3
+)
+
 func (s *PatientService) UploadTest(ctx context.Context, input model.UploadTestInput) (uuid.UUID, error) {
 	// 1. Проверка, что это DICOM
-	if !isDICOM(input.FileContent) {
+	if !isDICOM(input.File) {
 		return uuid.Nil, errors.New("файл не является файлом DICOM")
 	}
+	if input.Size > MaxTestSize {
+		return uuid.Nil, fmt.Errorf("файл больше %d МБ", MaxTestSize>>20)
+	}
 
 	if !hasExtension(input.FileName) {
 		input.FileName += ".dcm"
@@ -40,35 +58,108 @@ func (s *PatientService) UploadTest(ctx context.Context, input model.UploadTestI
 	patientID := int(authResp.Patient.UserId)
 
 	// 3. Извлекаем метаданные из DICOM
-	meta, err := extractDICOMMetadata(input.FileContent)
+	meta, err := extractDICOMMetadata(input.File, input.Size)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("не удалось извлечь информацию из файла DICOM: %w", err)
 	}
 
-	// 4. Генерируем превью
-	preview, err := generatePreviewJPEG(input.FileContent)
-	if err != nil {
-		// Можно логировать, но не критично
-		preview = nil
+	// 4. Генерируем превью; для больших исследований его нет
+	var preview []byte
+	if input.Size <= previewMaxSize {
+		preview, err = generatePreviewJPEG(input.File, input.Size)
+		if err != nil {
+			// Можно логировать, но не критично
+			preview = nil
+		}
 	}
 
 	patID := strconv.Itoa(patientID)
-	// 5. Вызываем storage.SaveDocument
-	storageResp, err := s.StorageClient.Client.SaveDocument(ctx, &storagepb.SaveDocumentRequest{
-		PatientId:       patID,
-		FileName:        input.FileName,
-		FileContent:     input.FileContent,
-		Modality:        meta.Modality,
-		StudyDate:       timestamppb.New(derefTime(meta.StudyDate)),
-		Description:     input.Description,
-		PreviewJpeg:     preview,
-		PreviewFileName: input.FileName[:len(input.FileName)-4] + ".jpg",
+	// 5. Создаём загрузку в storage и передаём файл частями
+	startResp, err := s.StorageClient.Client.StartDocumentUpload(ctx, &storagepb.StartDocumentUploadRequest{
+		PatientId:   patID,
+		FileName:    input.FileName,
+		Size:        input.Size,
+		Modality:    meta.Modality,
+		StudyDate:   timestamppb.New(derefTime(meta.StudyDate)),
+		Description: input.Description,
+		PreviewJpeg: preview,
 	})
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("storage error: %w", err)
 	}
+	documentID, err := s.uploadDocument(ctx, startResp.UploadId, input.File, input.Size)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("не удалось передать файл в storage: %w", err)
+	}
 
-	return uuid.MustParse(storageResp.DocumentId), nil
+	return uuid.Parse(documentID)
+}
+
+// uploadDocument передаёт файл в storage частями. Если поток оборвался, узнаёт у storage, сколько байт
+// уже принято, и продолжает с этого места
+func (s *PatientService) uploadDocument(ctx context.Context, uploadID string, r io.ReaderAt, size int64) (string, error) {
+	var offset int64
+	for attempt := 1; ; attempt++ {
+		documentID, err := s.sendDocumentChunks(ctx, uploadID, r, offset, size)
+		if err == nil {
+			return documentID, nil
+		}
+		if attempt == uploadAttempts || !resumableUploadError(err) {
+			return "", err
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(time.Duration(attempt) * 500 * time.Millisecond):
+		}
+		uploadStatus, statusErr := s.StorageClient.Client.GetDocumentUploadStatus(ctx, &storagepb.DocumentUploadStatusRequest{UploadId: uploadID})
+		if statusErr != nil {
+			return "", err
+		}
+		if uploadStatus.DocumentId != "" {
+			return uploadStatus.DocumentId, nil
+		}
+		offset = uploadStatus.Received
+	}
+}
+
+func (s *PatientService) sendDocumentChunks(ctx context.Context, uploadID string, r io.ReaderAt, offset, size int64) (string, error) {
+	stream, err := s.StorageClient.Client.UploadDocument(ctx)
+	if err != nil {
+		return "", err
+	}
+	buf := make([]byte, documentChunkSize)
+	for offset < size {
+		n, err := r.ReadAt(buf[:min(int64(len(buf)), size-offset)], offset)
+		if n == 0 {
+			stream.CloseSend()
+			return "", fmt.Errorf("не удалось прочитать файл с позиции %d: %w", offset, err)
+		}
+		if err := stream.Send(&storagepb.UploadDocumentChunk{UploadId: uploadID, Offset: offset, Data: buf[:n]}); err != nil {
+			// причину обрыва storage возвращает в CloseAndRecv
+			break
+		}
+		offset += int64(n)
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", err
+	}
+	if resp.DocumentId == "" {
+		return "", fmt.Errorf("storage принял %d из %d байт", resp.Received, resp.Size)
+	}
+	return resp.DocumentId, nil
+}
+
+// resumableUploadError ошибки, после которых загрузку можно продолжить: обрыв соединения, загрузка ещё
+// занята оборванным потоком или смещение разошлось с принятым storage объёмом
+func resumableUploadError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.FailedPrecondition:
+		return true
+	}
+	return false
 }
 
 func (s *PatientService) GetDocumentsInfo(ctx context.Context, token string) ([]model.DocumentInfo, error) {
@@ -96,30 +187,49 @@ func (s *PatientService) GetDocumentsInfo(ctx context.Context, token string) ([]
 	return docsInfo, nil
 }
 
-func (s *PatientService) DownloadDocument(ctx context.Context, documentID string) (model.DocumentFile, error) {
-
-	// Запрашиваем документ по ID через storage-сервис
-	resp, err := s.StorageClient.Client.DownloadDocument(ctx, &storagepb.DownloadDocumentRequest{DocumentId: documentID})
+// DownloadDocument передаёт документ из storage в send частями, начиная с байта offset. Пациент скачивает
+// только свои документы
+func (s *PatientService) DownloadDocument(ctx context.Context, token, documentID string, offset int64, send func(model.DocumentChunk) error) error {
+	meta, err := s.StorageClient.Client.GetDocumentMetadata(ctx, &storagepb.GetDocumentMetadataRequest{DocumentId: documentID})
 	if err != nil {
-		return model.DocumentFile{}, fmt.Errorf("не удалось скачать документ: %w", err)
+		return fmt.Errorf("не удалось получить документ: %w", err)
+	}
+	patientID, err := strconv.Atoi(meta.PatientId)
+	if err != nil {
+		return fmt.Errorf("некорректный идентификатор владельца документа: %w", err)
+	}
+	if err := s.authorize(ctx, token, access.ReadRecord, access.Resource{PatientID: patientID}); err != nil {
+		return err
+	}
+	stream, err := s.StorageClient.Client.DownloadDocument(ctx, &storagepb.DownloadDocumentRequest{DocumentId: documentID, Offset: offset})
+	if err != nil {
+		return fmt.Errorf("не удалось скачать документ: %w", err)
+	}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("не удалось скачать документ: %w", err)
+		}
+		if err := send(model.DocumentChunk{FileName: chunk.FileName, Size: chunk.Size, Data: chunk.Data}); err != nil {
+			return err
+		}
 	}
-
-	// Собираем и возвращаем структуру модели
-	return model.DocumentFile{
-		FileName:    resp.FileName,
-		FileContent: resp.FileContent,
-	}, nil
 }
 
-func isDICOM(data []byte) bool {
-	if len(data) < 132 {
+func isDICOM(r io.ReaderAt) bool {
+	magic := make([]byte, 4)
+	if _, err := r.ReadAt(magic, 128); err != nil {
 		return false
 	}
-	return string(data[128:132]) == "DICM"
+	return string(magic) == "DICM"
 }
 
-func extractDICOMMetadata(data []byte) (*model.PatientDocument, error) {
-	dataset, err := dicom.ParseUntilEOF(bytes.NewReader(data), nil)
+// extractDICOMMetadata читает теги исследования, пропуская пиксельные данные
+func extractDICOMMetadata(r io.ReaderAt, size int64) (*model.PatientDocument, error) {
+	dataset, err := dicom.Parse(io.NewSectionReader(r, 0, size), size, nil, dicom.SkipPixelData())
 	if err != nil {
 		return nil, err
 	}
@@ -149,9 +259,9 @@ func extractDICOMMetadata(data []byte) (*model.PatientDocument, error) {
 	}, nil
 }
 
-func generatePreviewJPEG(fileContent []byte) ([]byte, error) {
-	// Parsing DICOM from bytes
-	dataset, err := dicom.Parse(bytes.NewReader(fileContent), int64(len(fileContent)), nil)
+func generatePreviewJPEG(r io.ReaderAt, size int64) ([]byte, error) {
+	// Parsing DICOM from file
+	dataset, err := dicom.Parse(io.NewSectionReader(r, 0, size), size, nil)
 	if err != nil {
 		return nil, fmt.Errorf("ошибка при парсинге DICOM: %v", err)
 	}
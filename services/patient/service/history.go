@@ -18,7 +18,7 @@ func (s *PatientService) GetHistoryVisits(ctx context.Context, token string) ([]
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить проведенные приемы пользователя: %w", err)
 	}
-	icdCodesResp, err := s.StorageClient.Client.GetICDCodes(ctx, &storagepb.EmptyRequest{})
+	icdCodesResp, err := s.StorageClient.Client.GetICDCodes(ctx, &storagepb.GetICDCodesRequest{})
 	if err != nil {
 		return nil, fmt.Errorf("не удалось получить МКБ коды: %w", err)
 	}
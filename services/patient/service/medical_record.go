@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/medrecord"
+	"github.com/DariaTarasek/diplom/services/patient/model"
+	authpb "github.com/DariaTarasek/diplom/services/patient/proto/auth"
+	storagepb "github.com/DariaTarasek/diplom/services/patient/proto/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// ExportMedicalRecord пациент выгружает свою медицинскую карту: PDF для печати или пакет JSON в духе FHIR,
+// чтобы передать его в другую клинику
+func (s *PatientService) ExportMedicalRecord(ctx context.Context, token, format string) (model.MedicalRecordExport, error) {
+	if format != medrecord.FormatPDF && format != medrecord.FormatJSON {
+		return model.MedicalRecordExport{}, status.Errorf(codes.InvalidArgument, "неизвестный формат выгрузки %q: ожидается pdf или json", format)
+	}
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return model.MedicalRecordExport{}, fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	resp, err := s.StorageClient.Client.GetMedicalRecord(ctx, &storagepb.GetByIdRequest{Id: user.Patient.UserId})
+	if err != nil {
+		return model.MedicalRecordExport{}, fmt.Errorf("не удалось получить медицинскую карту: %w", err)
+	}
+	loc, err := s.clinicLocation(ctx)
+	if err != nil {
+		return model.MedicalRecordExport{}, err
+	}
+	record := medicalRecordFromPb(resp, time.Now().In(loc))
+	content, err := s.Records.Export(record, format)
+	if err != nil {
+		return model.MedicalRecordExport{}, err
+	}
+	return model.MedicalRecordExport{
+		FileName:    medrecord.FileName(record.Patient.ID, record.GeneratedAt, format),
+		ContentType: medrecord.ContentType(format),
+		Content:     content,
+	}, nil
+}
+
+func medicalRecordFromPb(item *storagepb.MedicalRecord, generated time.Time) medrecord.Record {
+	record := medrecord.Record{
+		Patient: medrecord.Patient{
+			ID:         int(item.Patient.UserId),
+			FirstName:  item.Patient.FirstName,
+			SecondName: item.Patient.SecondName,
+			Surname:    item.Patient.Surname,
+			BirthDate:  item.Patient.BirthDate.AsTime(),
+			Gender:     item.Patient.Gender,
+			Phone:      item.Patient.PhoneNumber,
+			Email:      item.Patient.Email,
+		},
+		GeneratedAt: generated,
+	}
+	for _, note := range item.Notes {
+		record.Notes = append(record.Notes, medrecord.Note{Type: note.Type, Title: note.Title})
+	}
+	if a := item.Anamnesis; a != nil && a.Version > 0 {
+		anamnesis := &medrecord.Anamnesis{
+			Version:            int(a.Version),
+			Medications:        a.Medications,
+			BloodThinners:      a.BloodThinners,
+			CardiacDisease:     a.CardiacDisease,
+			Hypertension:       a.Hypertension,
+			Diabetes:           a.Diabetes,
+			BleedingDisorder:   a.BleedingDisorder,
+			InfectiousDisease:  a.InfectiousDisease,
+			AnesthesiaReaction: a.AnesthesiaReaction,
+			Pregnant:           a.Pregnant,
+			Breastfeeding:      a.Breastfeeding,
+			Smoker:             a.Smoker,
+			OtherConditions:    a.OtherConditions,
+			ChangedAt:          a.CreatedAt.AsTime(),
+		}
+		for _, allergy := range a.Allergies {
+			anamnesis.Allergies = append(anamnesis.Allergies, medrecord.Allergy{
+				Allergen: allergy.Allergen,
+				Severity: allergy.Severity,
+				Reaction: allergy.Reaction,
+			})
+		}
+		record.Anamnesis = anamnesis
+	}
+	for _, v := range item.Visits {
+		visit := medrecord.Visit{
+			ID:            int(v.Id),
+			Date:          v.CreatedAt.AsTime(),
+			DoctorID:      int(v.DoctorId),
+			Doctor:        v.Doctor,
+			Complaints:    v.Complaints,
+			TreatmentPlan: v.Treatment,
+		}
+		for _, d := range v.Diagnoses {
+			visit.Diagnoses = append(visit.Diagnoses, medrecord.Diagnosis{
+				Code:     d.Code,
+				Name:     d.Name,
+				Note:     d.Note,
+				Tooth:    int(d.Tooth),
+				Surfaces: d.Surfaces,
+			})
+		}
+		for _, srv := range v.Services {
+			visit.Services = append(visit.Services, medrecord.Service{
+				ID:       int(srv.ServiceId),
+				Name:     srv.Name,
+				Quantity: int(srv.Quantity),
+				Tooth:    int(srv.Tooth),
+				Surfaces: srv.Surfaces,
+			})
+		}
+		for _, m := range v.Materials {
+			visit.Materials = append(visit.Materials, medrecord.Material{
+				ID:       int(m.MaterialId),
+				Name:     m.Name,
+				Quantity: int(m.Quantity),
+			})
+		}
+		record.Visits = append(record.Visits, visit)
+	}
+	for _, doc := range item.Documents {
+		document := medrecord.Document{
+			ID:          doc.Id,
+			FileName:    doc.FileName,
+			Modality:    doc.Modality,
+			Description: doc.Description,
+			CreatedAt:   doc.CreatedAt.AsTime(),
+		}
+		if studyDate, err := time.Parse("2006-01-02", doc.StudyDate); err == nil {
+			document.StudyDate = &studyDate
+		}
+		record.Documents = append(record.Documents, document)
+	}
+	return record
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	authpb "github.com/DariaTarasek/diplom/services/patient/proto/auth"
+	storagepb "github.com/DariaTarasek/diplom/services/patient/proto/storage"
+)
+
+// GetNotificationOptOut отказался ли пациент от уведомлений о записях и напоминаний
+func (s *PatientService) GetNotificationOptOut(ctx context.Context, token string) (bool, error) {
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return false, fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	settings, err := s.StorageClient.Client.GetPatientNotificationSettings(ctx, &storagepb.GetByIDRequest{Id: user.Patient.UserId})
+	if err != nil {
+		return false, fmt.Errorf("не удалось получить настройки уведомлений: %w", err)
+	}
+	return settings.OptOut, nil
+}
+
+// UpdateNotificationOptOut включает или отключает уведомления пациента. Уже поставленные в очередь
+// уведомления при отказе не отправляются
+func (s *PatientService) UpdateNotificationOptOut(ctx context.Context, token string, optOut bool) error {
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	_, err = s.StorageClient.Client.UpdatePatientNotificationSettings(ctx, &storagepb.PatientNotificationSettings{
+		PatientId: user.Patient.UserId,
+		OptOut:    optOut,
+	})
+	if err != nil {
+		return fmt.Errorf("не удалось изменить настройки уведомлений: %w", err)
+	}
+	return nil
+}
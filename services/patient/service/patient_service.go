@@ -1,17 +1,20 @@
 package service
 
 import (
+	"github.com/DariaTarasek/diplom/services/medrecord"
 	"github.com/DariaTarasek/diplom/services/patient/clients"
 )
 
 type PatientService struct {
 	StorageClient *clients.StorageClient
 	AuthClient    *clients.AuthClient
+	Records       *medrecord.Renderer
 }
 
-func NewPatientService(client *clients.StorageClient, auth *clients.AuthClient) *PatientService {
+func NewPatientService(client *clients.StorageClient, auth *clients.AuthClient, records *medrecord.Renderer) *PatientService {
 	return &PatientService{
 		StorageClient: client,
 		AuthClient:    auth,
+		Records:       records,
 	}
 }
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/patient/model"
+	storagepb "github.com/DariaTarasek/diplom/services/patient/proto/storage"
+	"github.com/DariaTarasek/diplom/services/scheduling"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// clinicLocation часовой пояс клиники из настроек хранилища. В нём трактуются даты и время расписаний и записей
+func (s *PatientService) clinicLocation(ctx context.Context) (*time.Location, error) {
+	settings, err := s.StorageClient.Client.GetClinicSettings(ctx, &storagepb.EmptyRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить настройки клиники: %w", err)
+	}
+	return scheduling.LoadLocation(settings.TimeZone)
+}
+
+// loadDoctorSchedule собирает постоянные расписания, переопределения и правила клиники и врача для расчёта слотов
+func (s *PatientService) loadDoctorSchedule(ctx context.Context, doctorID int32) (scheduling.Schedule, error) {
+	clinicWeekly, err := s.StorageClient.Client.GetClinicWeeklySchedule(ctx, &storagepb.EmptyRequest{})
+	if err != nil {
+		return scheduling.Schedule{}, fmt.Errorf("не удалось получить расписание клиники: %w", err)
+	}
+	clinicOverrides, err := s.StorageClient.Client.GetClinicOverrides(ctx, &storagepb.EmptyRequest{})
+	if err != nil {
+		return scheduling.Schedule{}, fmt.Errorf("не удалось получить переопределения клиники: %w", err)
+	}
+	doctorWeekly, err := s.StorageClient.Client.GetDoctorWeeklySchedule(ctx, &storagepb.GetScheduleByDoctorIdRequest{DoctorId: doctorID})
+	if err != nil {
+		return scheduling.Schedule{}, fmt.Errorf("не удалось получить расписание врача: %w", err)
+	}
+	doctorOverrides, err := s.StorageClient.Client.GetDoctorOverrides(ctx, &storagepb.GetByIDRequest{Id: doctorID})
+	if err != nil {
+		return scheduling.Schedule{}, fmt.Errorf("не удалось получить переопределения врача: %w", err)
+	}
+	clinicRules, err := s.StorageClient.Client.GetScheduleRules(ctx, &storagepb.GetScheduleRulesRequest{})
+	if err != nil {
+		return scheduling.Schedule{}, fmt.Errorf("не удалось получить правила расписания клиники: %w", err)
+	}
+	doctorRules, err := s.StorageClient.Client.GetScheduleRules(ctx, &storagepb.GetScheduleRulesRequest{DoctorId: doctorID})
+	if err != nil {
+		return scheduling.Schedule{}, fmt.Errorf("не удалось получить правила расписания врача: %w", err)
+	}
+
+	loc, err := s.clinicLocation(ctx)
+	if err != nil {
+		return scheduling.Schedule{}, err
+	}
+
+	schedule := scheduling.Schedule{
+		Location:    loc,
+		ClinicRules: scheduleRules(clinicRules.Rules),
+		Doctor:      true,
+		DoctorRules: scheduleRules(doctorRules.Rules),
+	}
+	for _, day := range clinicWeekly.ClinicSchedule {
+		schedule.ClinicWeekly = append(schedule.ClinicWeekly, scheduling.WeeklyDay{
+			Weekday:     time.Weekday(day.Weekday),
+			Start:       day.StartTime.AsTime(),
+			End:         day.EndTime.AsTime(),
+			SlotMinutes: int(day.SlotDurationMinutes),
+			IsDayOff:    day.IsDayOff,
+		})
+	}
+	for _, o := range clinicOverrides.Overrides {
+		schedule.ClinicOverrides = append(schedule.ClinicOverrides, scheduling.Override{
+			Date:        o.Date.AsTime(),
+			Start:       o.StartTime.AsTime(),
+			End:         o.EndTime.AsTime(),
+			SlotMinutes: int(o.SlotDurationMinutes),
+			IsDayOff:    o.IsDayOff,
+		})
+	}
+	for _, day := range doctorWeekly.DoctorSchedule {
+		schedule.DoctorWeekly = append(schedule.DoctorWeekly, scheduling.WeeklyDay{
+			Weekday:     time.Weekday(day.Weekday),
+			Start:       day.StartTime.AsTime(),
+			End:         day.EndTime.AsTime(),
+			SlotMinutes: int(day.SlotDurationMinutes),
+			IsDayOff:    day.IsDayOff,
+		})
+	}
+	for _, o := range doctorOverrides.Override {
+		schedule.DoctorOverrides = append(schedule.DoctorOverrides, scheduling.Override{
+			Date:        o.Date.AsTime(),
+			Start:       o.StartTime.AsTime(),
+			End:         o.EndTime.AsTime(),
+			SlotMinutes: int(o.SlotDurationMinutes),
+			IsDayOff:    o.IsDayOff,
+		})
+	}
+	return schedule, nil
+}
+
+// scheduleRules правила расписания из хранилища в порядке приоритета; правила неизвестного вида пропускаются
+func scheduleRules(rules []*storagepb.ScheduleRule) []scheduling.Rule {
+	result := make([]scheduling.Rule, 0, len(rules))
+	for _, r := range rules {
+		recurrence, ok := scheduling.ParseRecurrence(r.Recurrence)
+		if !ok {
+			continue
+		}
+		rule := scheduling.Rule{
+			Recurrence:  recurrence,
+			From:        r.DateFrom.AsTime(),
+			Weekday:     time.Weekday(r.Weekday),
+			Nth:         int(r.Nth),
+			Start:       r.StartTime.AsTime(),
+			End:         r.EndTime.AsTime(),
+			SlotMinutes: int(r.SlotDurationMinutes),
+			IsDayOff:    r.IsDayOff,
+		}
+		if r.DateTo != nil {
+			rule.To = r.DateTo.AsTime()
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+// servicesDuration суммарная длительность услуг; 0, если услуги не выбраны
+func (s *PatientService) servicesDuration(ctx context.Context, serviceIDs []int) (int, error) {
+	total := 0
+	for _, id := range serviceIDs {
+		service, err := s.StorageClient.Client.GetServiceByID(ctx, &storagepb.GetByIdRequest{Id: int32(id)})
+		if err != nil {
+			return 0, fmt.Errorf("не удалось получить услугу %d: %w", id, err)
+		}
+		total += int(service.DurationMinutes)
+	}
+	return total, nil
+}
+
+// appointmentDuration длительность записи: суммарная длительность услуг, а без услуг — один слот врача в этот день
+func (s *PatientService) appointmentDuration(ctx context.Context, doctorID model.UserID, date time.Time, serviceIDs []int) (int, error) {
+	duration, err := s.servicesDuration(ctx, serviceIDs)
+	if err != nil {
+		return 0, err
+	}
+	if duration > 0 {
+		return duration, nil
+	}
+	schedule, err := s.loadDoctorSchedule(ctx, int32(doctorID))
+	if err != nil {
+		return 0, err
+	}
+	hours := schedule.HoursOn(date)
+	if !hours.Open {
+		return 0, status.Error(codes.FailedPrecondition, "врач не принимает в выбранный день")
+	}
+	return hours.SlotMinutes, nil
+}
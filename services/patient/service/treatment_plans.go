@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/patient/model"
+	authpb "github.com/DariaTarasek/diplom/services/patient/proto/auth"
+	storagepb "github.com/DariaTarasek/diplom/services/patient/proto/storage"
+)
+
+// GetTreatmentPlans планы лечения пациента и сколько осталось по принятым планам
+func (s *PatientService) GetTreatmentPlans(ctx context.Context, token string) ([]model.TreatmentPlan, int, error) {
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return nil, 0, fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	resp, err := s.StorageClient.Client.GetPatientTreatmentPlans(ctx, &storagepb.GetByIdRequest{Id: user.Patient.UserId})
+	if err != nil {
+		return nil, 0, fmt.Errorf("не удалось получить планы лечения: %w", err)
+	}
+	doctors := make(map[int32]string)
+	plans := make([]model.TreatmentPlan, 0, len(resp.Plans))
+	remaining := 0
+	for _, item := range resp.Plans {
+		plan, err := s.treatmentPlanFromPb(ctx, item, doctors)
+		if err != nil {
+			return nil, 0, err
+		}
+		if plan.Status == "accepted" || plan.Status == "in_progress" {
+			remaining += plan.RemainingCost
+		}
+		plans = append(plans, plan)
+	}
+	return plans, remaining, nil
+}
+
+// AcceptTreatmentPlan пациент соглашается с предложенным планом лечения и его стоимостью
+func (s *PatientService) AcceptTreatmentPlan(ctx context.Context, token string, planID int) (model.TreatmentPlan, error) {
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	resp, err := s.StorageClient.Client.AcceptTreatmentPlan(ctx, &storagepb.AcceptTreatmentPlanRequest{
+		PlanId:    int32(planID),
+		PatientId: user.Patient.UserId,
+	})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось принять план лечения: %w", err)
+	}
+	return s.treatmentPlanFromPb(ctx, resp.Plan, make(map[int32]string))
+}
+
+// treatmentPlanFromPb план из ответа storage с ФИО врача; doctors — уже полученные ФИО по ID врача
+func (s *PatientService) treatmentPlanFromPb(ctx context.Context, item *storagepb.TreatmentPlan, doctors map[int32]string) (model.TreatmentPlan, error) {
+	plan := model.TreatmentPlan{
+		ID:             int(item.Id),
+		DoctorID:       model.UserID(item.DoctorId),
+		Title:          item.Title,
+		Status:         item.Status,
+		CreatedAt:      item.CreatedAt.AsTime().Format("02.01.2006"),
+		EstimatedTotal: int(item.EstimatedTotal),
+		RemainingCost:  int(item.RemainingCost),
+	}
+	if item.AcceptedAt != nil {
+		plan.AcceptedAt = item.AcceptedAt.AsTime().Format("02.01.2006")
+	}
+	if item.DoctorId != 0 {
+		doctor, ok := doctors[item.DoctorId]
+		if !ok {
+			doctorResp, err := s.StorageClient.Client.GetDoctorByID(ctx, &storagepb.GetByIDRequest{Id: item.DoctorId})
+			if err != nil {
+				return model.TreatmentPlan{}, fmt.Errorf("не удалось получить врача, составившего план: %w", err)
+			}
+			doctor = fmt.Sprintf("%s %s %s", doctorResp.Doctor.SecondName, doctorResp.Doctor.FirstName, doctorResp.Doctor.Surname)
+			doctors[item.DoctorId] = doctor
+		}
+		plan.Doctor = doctor
+	}
+	for _, i := range item.Items {
+		planItem := model.TreatmentPlanItem{
+			ID:             int(i.Id),
+			Stage:          int(i.Stage),
+			Tooth:          int(i.Tooth),
+			ServiceName:    i.ServiceName,
+			Quantity:       int(i.Quantity),
+			EstimatedPrice: int(i.EstimatedPrice),
+			Status:         i.Status,
+			Note:           i.Note,
+		}
+		if i.CompletedAt != nil {
+			planItem.CompletedAt = i.CompletedAt.AsTime().Format("02.01.2006")
+		}
+		plan.Items = append(plan.Items, planItem)
+	}
+	return plan, nil
+}
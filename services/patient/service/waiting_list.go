@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/patient/model"
+	authpb "github.com/DariaTarasek/diplom/services/patient/proto/auth"
+	storagepb "github.com/DariaTarasek/diplom/services/patient/proto/storage"
+	"github.com/DariaTarasek/diplom/services/scheduling"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// maxWaitingListDays на сколько дней вперёд можно встать в лист ожидания
+const maxWaitingListDays = 90
+
+// JoinWaitingList ставит пациента в лист ожидания к врачу или к любому врачу специальности на диапазон дат.
+// Когда подходящий слот освободится, пациенту придёт предложение, которое нужно принять до его истечения
+func (s *PatientService) JoinWaitingList(ctx context.Context, token string, req model.WaitingListRequest) (int, error) {
+	if req.DoctorID == 0 && req.SpecializationID == 0 {
+		return 0, status.Error(codes.InvalidArgument, "нужно выбрать врача или специальность")
+	}
+	loc, err := s.clinicLocation(ctx)
+	if err != nil {
+		return 0, err
+	}
+	from := scheduling.Day(req.DateFrom, loc)
+	to := scheduling.Day(req.DateTo, loc)
+	today := scheduling.Today(time.Now(), loc)
+	if to.Before(from) {
+		return 0, status.Error(codes.InvalidArgument, "дата окончания раньше даты начала")
+	}
+	if to.Before(today) {
+		return 0, status.Error(codes.InvalidArgument, "диапазон дат уже прошёл")
+	}
+	if to.After(today.AddDate(0, 0, maxWaitingListDays)) {
+		return 0, status.Errorf(codes.InvalidArgument, "встать в лист ожидания можно не более чем на %d дней вперёд", maxWaitingListDays)
+	}
+	if from.Before(today) {
+		from = today
+	}
+
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return 0, fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	resp, err := s.StorageClient.Client.AddWaitingListEntry(ctx, &storagepb.AddWaitingListEntryRequest{
+		Entry: &storagepb.WaitingListEntry{
+			PatientId:        user.Patient.UserId,
+			DoctorId:         int32(req.DoctorID),
+			SpecializationId: int32(req.SpecializationID),
+			DateFrom:         timestamppb.New(from),
+			DateTo:           timestamppb.New(to),
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("не удалось добавить в лист ожидания: %w", err)
+	}
+	return int(resp.Id), nil
+}
+
+// GetWaitingList записи пациента в листе ожидания и действующие предложения слотов
+func (s *PatientService) GetWaitingList(ctx context.Context, token string) ([]model.WaitingListEntry, []model.WaitingListOffer, error) {
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return nil, nil, fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	list, err := s.StorageClient.Client.GetWaitingListByPatientID(ctx, &storagepb.GetByIDRequest{Id: user.Patient.UserId})
+	if err != nil {
+		return nil, nil, fmt.Errorf("не удалось получить лист ожидания: %w", err)
+	}
+	allSpecs, err := s.StorageClient.Client.GetAllSpecs(ctx, &storagepb.EmptyRequest{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("не удалось получить все специальности: %w", err)
+	}
+	specsMap := make(map[int32]string, len(allSpecs.Specs))
+	for _, spec := range allSpecs.Specs {
+		specsMap[spec.Id] = spec.Name
+	}
+	loc, err := s.clinicLocation(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doctorNames := make(map[int32]string)
+	doctorName := func(id int32) (string, error) {
+		if id == 0 {
+			return "", nil
+		}
+		if name, ok := doctorNames[id]; ok {
+			return name, nil
+		}
+		doc, err := s.StorageClient.Client.GetDoctorByID(ctx, &storagepb.GetByIDRequest{Id: id})
+		if err != nil {
+			return "", fmt.Errorf("не удалось получить врача для листа ожидания: %w", err)
+		}
+		name := fmt.Sprintf("%s %s.%s.", doc.Doctor.SecondName, getAndCapitalizeFirstLetter(doc.Doctor.FirstName), getAndCapitalizeFirstLetter(doc.Doctor.Surname))
+		doctorNames[id] = name
+		return name, nil
+	}
+
+	entries := make([]model.WaitingListEntry, 0, len(list.Entries))
+	for _, e := range list.Entries {
+		name, err := doctorName(e.DoctorId)
+		if err != nil {
+			return nil, nil, err
+		}
+		entries = append(entries, model.WaitingListEntry{
+			ID:               int(e.Id),
+			DoctorID:         model.UserID(e.DoctorId),
+			Doctor:           name,
+			SpecializationID: int(e.SpecializationId),
+			Specialty:        specsMap[e.SpecializationId],
+			DateFrom:         e.DateFrom.AsTime().Format("02.01.2006"),
+			DateTo:           e.DateTo.AsTime().Format("02.01.2006"),
+			Status:           e.Status,
+		})
+	}
+	offers := make([]model.WaitingListOffer, 0, len(list.Offers))
+	for _, o := range list.Offers {
+		name, err := doctorName(o.DoctorId)
+		if err != nil {
+			return nil, nil, err
+		}
+		offers = append(offers, model.WaitingListOffer{
+			ID:        o.Id,
+			DoctorID:  model.UserID(o.DoctorId),
+			Doctor:    name,
+			Date:      o.Date.AsTime().Format("02.01.2006"),
+			Time:      o.Time.AsTime().Format(timeLayout),
+			ExpiresAt: o.ExpiresAt.AsTime().In(loc).Format("02.01.2006 15:04"),
+		})
+	}
+	return entries, offers, nil
+}
+
+// LeaveWaitingList убирает запись пациента из листа ожидания
+func (s *PatientService) LeaveWaitingList(ctx context.Context, token string, entryID int) error {
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	_, err = s.StorageClient.Client.CancelWaitingListEntry(ctx, &storagepb.CancelWaitingListEntryRequest{
+		Id:        int32(entryID),
+		PatientId: user.Patient.UserId,
+	})
+	if err != nil {
+		return fmt.Errorf("не удалось убрать запись из листа ожидания: %w", err)
+	}
+	return nil
+}
+
+// AcceptWaitingListOffer записывает пациента на предложенный слот. Данные пациента берутся из его профиля
+func (s *PatientService) AcceptWaitingListOffer(ctx context.Context, token, offerID string) (model.AppointmentID, error) {
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return 0, fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	patient := user.Patient
+	resp, err := s.StorageClient.Client.AcceptWaitingListOffer(ctx, &storagepb.AcceptWaitingListOfferRequest{
+		OfferId: offerID,
+		Appointment: &storagepb.Appointment{
+			PatientId:   patient.UserId,
+			SecondName:  patient.SecondName,
+			FirstName:   patient.FirstName,
+			Surname:     patient.Surname,
+			BirthDate:   patient.BirthDate,
+			Gender:      patient.Gender,
+			PhoneNumber: patient.PhoneNumber,
+			Status:      "unconfirmed",
+			CreatedAt:   timestamppb.New(time.Now()),
+			UpdatedAt:   timestamppb.New(time.Now()),
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("не удалось принять предложение записи: %w", err)
+	}
+	return model.AppointmentID(resp.AppointmentId), nil
+}
+
+// DeclineWaitingListOffer отказ от предложенного слота; пациент остаётся в листе ожидания
+func (s *PatientService) DeclineWaitingListOffer(ctx context.Context, token, offerID string) error {
+	user, err := s.AuthClient.Client.GetPatient(ctx, &authpb.GetPatientRequest{Token: token})
+	if err != nil {
+		return fmt.Errorf("не удалось получить пользователя: %w", err)
+	}
+	_, err = s.StorageClient.Client.DeclineWaitingListOffer(ctx, &storagepb.DeclineWaitingListOfferRequest{
+		OfferId:   offerID,
+		PatientId: user.Patient.UserId,
+	})
+	if err != nil {
+		return fmt.Errorf("не удалось отказаться от предложения записи: %w", err)
+	}
+	return nil
+}
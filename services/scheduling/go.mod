@@ -0,0 +1,3 @@
+module github.com/DariaTarasek/diplom/services/scheduling
+
+go 1.23.2
@@ -0,0 +1,91 @@
+package scheduling
+
+import "time"
+
+// Recurrence как повторяется правило-исключение
+type Recurrence int
+
+const (
+	// EveryDay каждый день диапазона From–To: отпуск врача, праздничные каникулы
+	EveryDay Recurrence = iota
+	// MonthlyWeekday Nth-й день недели Weekday каждого месяца, например «каждый первый понедельник».
+	// Nth == -1 означает последний такой день месяца
+	MonthlyWeekday
+	// Annual ежегодно в месяц и день даты From, например государственный праздник
+	Annual
+)
+
+// recurrenceNames названия видов повторения, под которыми правила хранятся в хранилище
+var recurrenceNames = map[string]Recurrence{
+	"range":           EveryDay,
+	"monthly_weekday": MonthlyWeekday,
+	"annual":          Annual,
+}
+
+// ParseRecurrence вид повторения по названию из хранилища: range, monthly_weekday, annual
+func ParseRecurrence(name string) (Recurrence, bool) {
+	r, ok := recurrenceNames[name]
+	return r, ok
+}
+
+// Rule исключение из постоянного расписания, действующее сразу на много дат: на диапазон или по повторяющемуся
+// правилу. Часы и длительность слота задаются так же, как в Override
+type Rule struct {
+	Recurrence Recurrence
+	// From первая дата действия правила; для Annual задаёт также месяц и день
+	From time.Time
+	// To последняя дата действия включительно; нулевое значение — правило бессрочно
+	To          time.Time
+	Weekday     time.Weekday
+	Nth         int
+	Start       time.Time
+	End         time.Time
+	SlotMinutes int
+	IsDayOff    bool
+}
+
+// Matches действует ли правило в день day. Учитываются только год, месяц и день
+func (r Rule) Matches(day time.Time) bool {
+	d := dateOnly(day)
+	if d.Before(dateOnly(r.From)) {
+		return false
+	}
+	if !r.To.IsZero() && d.After(dateOnly(r.To)) {
+		return false
+	}
+
+	switch r.Recurrence {
+	case EveryDay:
+		return true
+	case MonthlyWeekday:
+		if d.Weekday() != r.Weekday {
+			return false
+		}
+		if r.Nth == -1 {
+			return d.AddDate(0, 0, 7).Month() != d.Month()
+		}
+		return (d.Day()-1)/7+1 == r.Nth
+	case Annual:
+		return d.Month() == r.From.Month() && d.Day() == r.From.Day()
+	}
+	return false
+}
+
+// dateOnly дата без времени и пояса, чтобы даты из разных поясов сравнивались по календарю
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Fits помещается ли приём длительностью minutes, начинающийся в start, в рабочие часы.
+// Если minutes <= 0, длительностью считается один слот дня
+func (s Schedule) Fits(start time.Time, minutes int) bool {
+	start = start.In(s.location())
+	h := s.HoursOn(start)
+	if !h.Open {
+		return false
+	}
+	if minutes <= 0 {
+		minutes = h.SlotMinutes
+	}
+	return !start.Before(h.Start) && !start.Add(time.Duration(minutes)*time.Minute).After(h.End)
+}
@@ -0,0 +1,205 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRuleMatches(t *testing.T) {
+	tests := []struct {
+		name string
+		rule Rule
+		date string
+		want bool
+	}{
+		{
+			name: "диапазон: первый день",
+			rule: Rule{Recurrence: EveryDay, From: date("2025-07-01"), To: date("2025-07-14")},
+			date: "2025-07-01", want: true,
+		},
+		{
+			name: "диапазон: последний день включительно",
+			rule: Rule{Recurrence: EveryDay, From: date("2025-07-01"), To: date("2025-07-14")},
+			date: "2025-07-14", want: true,
+		},
+		{
+			name: "диапазон: после окончания",
+			rule: Rule{Recurrence: EveryDay, From: date("2025-07-01"), To: date("2025-07-14")},
+			date: "2025-07-15", want: false,
+		},
+		{
+			name: "первый понедельник месяца",
+			rule: Rule{Recurrence: MonthlyWeekday, From: date("2025-01-01"), Weekday: time.Monday, Nth: 1},
+			date: "2025-09-01", want: true,
+		},
+		{
+			name: "второй понедельник — не первый",
+			rule: Rule{Recurrence: MonthlyWeekday, From: date("2025-01-01"), Weekday: time.Monday, Nth: 1},
+			date: "2025-09-08", want: false,
+		},
+		{
+			name: "последняя пятница месяца",
+			rule: Rule{Recurrence: MonthlyWeekday, From: date("2025-01-01"), Weekday: time.Friday, Nth: -1},
+			date: "2025-05-30", want: true,
+		},
+		{
+			name: "предпоследняя пятница — не последняя",
+			rule: Rule{Recurrence: MonthlyWeekday, From: date("2025-01-01"), Weekday: time.Friday, Nth: -1},
+			date: "2025-05-23", want: false,
+		},
+		{
+			name: "повторяющееся правило до начала действия",
+			rule: Rule{Recurrence: MonthlyWeekday, From: date("2025-10-01"), Weekday: time.Monday, Nth: 1},
+			date: "2025-09-01", want: false,
+		},
+		{
+			name: "ежегодный праздник в следующем году",
+			rule: Rule{Recurrence: Annual, From: date("2025-01-01")},
+			date: "2027-01-01", want: true,
+		},
+		{
+			name: "ежегодный праздник: другой день",
+			rule: Rule{Recurrence: Annual, From: date("2025-01-01")},
+			date: "2027-01-02", want: false,
+		},
+		{
+			name: "ежегодный праздник после окончания действия",
+			rule: Rule{Recurrence: Annual, From: date("2025-01-01"), To: date("2026-12-31")},
+			date: "2027-01-01", want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Matches(date(tt.date)); got != tt.want {
+				t.Errorf("Matches(%s) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHoursOnWithRules(t *testing.T) {
+	vacation := Rule{Recurrence: EveryDay, From: date("2025-06-02"), To: date("2025-06-15"), IsDayOff: true}
+	shortFirstMonday := Rule{Recurrence: MonthlyWeekday, From: date("2025-01-01"), Weekday: time.Monday, Nth: 1,
+		Start: clock("09:00"), End: clock("13:00")}
+	newYear := Rule{Recurrence: Annual, From: date("2025-01-01"), IsDayOff: true}
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		date     string
+		want     WorkingHours
+	}{
+		{
+			name: "отпуск врача закрывает день",
+			schedule: Schedule{
+				Doctor:       true,
+				ClinicWeekly: weekdays("09:00", "18:00", 30),
+				DoctorWeekly: weekdays("10:00", "16:00", 30),
+				DoctorRules:  []Rule{vacation},
+			},
+			date: "2025-06-10",
+		},
+		{
+			name: "после отпуска — постоянное расписание врача",
+			schedule: Schedule{
+				Doctor:       true,
+				ClinicWeekly: weekdays("09:00", "18:00", 30),
+				DoctorWeekly: weekdays("10:00", "16:00", 30),
+				DoctorRules:  []Rule{vacation},
+			},
+			date: "2025-06-16",
+			want: WorkingHours{Open: true, Start: at("2025-06-16", "10:00"), End: at("2025-06-16", "16:00"), SlotMinutes: 30},
+		},
+		{
+			name: "повторяющееся правило клиники сокращает день",
+			schedule: Schedule{
+				ClinicWeekly: weekdays("09:00", "18:00", 30),
+				ClinicRules:  []Rule{shortFirstMonday},
+			},
+			date: monday,
+			want: WorkingHours{Open: true, Start: at(monday, "09:00"), End: at(monday, "13:00"), SlotMinutes: 30},
+		},
+		{
+			name: "праздник клиники закрывает день и для врача",
+			schedule: Schedule{
+				Doctor:       true,
+				ClinicWeekly: weekdays("09:00", "18:00", 30),
+				ClinicRules:  []Rule{newYear},
+				DoctorWeekly: weekdays("10:00", "16:00", 30),
+			},
+			date: "2026-01-01",
+		},
+		{
+			name: "переопределение на дату важнее правила",
+			schedule: Schedule{
+				Doctor:       true,
+				ClinicWeekly: weekdays("09:00", "18:00", 30),
+				DoctorWeekly: weekdays("10:00", "16:00", 30),
+				DoctorRules:  []Rule{vacation},
+				DoctorOverrides: []Override{
+					{Date: date("2025-06-10"), Start: clock("12:00"), End: clock("14:00")},
+				},
+			},
+			date: "2025-06-10",
+			want: WorkingHours{Open: true, Start: at("2025-06-10", "12:00"), End: at("2025-06-10", "14:00"), SlotMinutes: 30},
+		},
+		{
+			name: "из нескольких правил действует первое",
+			schedule: Schedule{
+				ClinicWeekly: weekdays("09:00", "18:00", 30),
+				ClinicRules:  []Rule{{Recurrence: EveryDay, From: date(monday), To: date(monday), IsDayOff: true}, shortFirstMonday},
+			},
+			date: monday,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.schedule.Location = time.UTC
+			got := tt.schedule.HoursOn(date(tt.date))
+			tt.want.Date = Day(date(tt.date), time.UTC)
+			if got.Open != tt.want.Open || !got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End) || got.SlotMinutes != tt.want.SlotMinutes {
+				t.Errorf("HoursOn(%s) = %+v, want %+v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFits(t *testing.T) {
+	schedule := Schedule{
+		Location:     time.UTC,
+		ClinicWeekly: weekdays("09:00", "18:00", 30),
+		ClinicRules: []Rule{{Recurrence: MonthlyWeekday, From: date("2025-01-01"), Weekday: time.Monday, Nth: 1,
+			Start: clock("09:00"), End: clock("13:00")}},
+	}
+	tests := []struct {
+		name    string
+		start   time.Time
+		minutes int
+		want    bool
+	}{
+		{name: "внутри сокращённого дня", start: at(monday, "12:00"), minutes: 60, want: true},
+		{name: "выходит за конец сокращённого дня", start: at(monday, "12:30"), minutes: 60, want: false},
+		{name: "после конца сокращённого дня", start: at(monday, "15:00"), minutes: 30, want: false},
+		{name: "один слот по умолчанию", start: at(monday, "12:30"), want: true},
+		{name: "до начала рабочего дня", start: at("2025-06-03", "08:30"), minutes: 30, want: false},
+		{name: "выходной", start: at("2025-06-07", "10:00"), minutes: 30, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schedule.Fits(tt.start, tt.minutes); got != tt.want {
+				t.Errorf("Fits(%v, %d) = %v, want %v", tt.start, tt.minutes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRecurrence(t *testing.T) {
+	for name, want := range map[string]Recurrence{"range": EveryDay, "monthly_weekday": MonthlyWeekday, "annual": Annual} {
+		if got, ok := ParseRecurrence(name); !ok || got != want {
+			t.Errorf("ParseRecurrence(%q) = %v, %v, want %v", name, got, ok, want)
+		}
+	}
+	if _, ok := ParseRecurrence("weekly"); ok {
+		t.Error(`ParseRecurrence("weekly") должен вернуть false`)
+	}
+}
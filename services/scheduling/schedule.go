@@ -0,0 +1,237 @@
+// Package scheduling — общий расчёт рабочих часов и свободных слотов.
+// Используется сервисами пациента, врача и администратора, чтобы все видели один и тот же календарь.
+//
+// Правила приоритета при вычислении рабочих часов на дату:
+//  1. Выходной по переопределению или правилу клиники — день закрыт для всех.
+//  2. Выходной по переопределению или правилу врача — день закрыт для врача.
+//  3. Часы клиники: переопределение клиники на дату, иначе первое действующее в этот день правило клиники
+//     (диапазон или повторяющееся), иначе постоянное расписание клиники на день недели.
+//     Выходной в постоянном расписании клиники закрывает день.
+//  4. Часы врача: переопределение врача на дату, иначе первое действующее правило врача, иначе постоянное
+//     расписание врача на день недели. Если у врача ничего из этого нет, день для него нерабочий. Если расписание строится без врача
+//     (сетка клиники, Schedule.Doctor == false), используются часы клиники.
+//  5. Часы врача ограничиваются часами клиники, если они известны на эту дату.
+//  6. Длительность слота: переопределение врача, правило врача, постоянное расписание врача, переопределение
+//     клиники, правило клиники, постоянное расписание клиники, DefaultSlotMinutes — первое ненулевое значение
+//     среди тех, что действуют на эту дату. Переопределение без длительности часы меняет, а длительность берёт
+//     из следующего источника.
+//
+// Даты и время расписаний и записей хранятся без часового пояса и означают показания часов в клинике.
+// Перед расчётом они переводятся в моменты времени в часовом поясе клиники (Schedule.Location),
+// поэтому результат не зависит от пояса сервера, а слоты в дни перехода на летнее время
+// остаются на тех же показаниях часов.
+package scheduling
+
+import "time"
+
+// DefaultSlotMinutes длительность слота, если она не задана ни в одном расписании
+const DefaultSlotMinutes = 30
+
+// WeeklyDay день постоянного расписания. У Start и End значимы только часы и минуты
+type WeeklyDay struct {
+	Weekday     time.Weekday
+	Start       time.Time
+	End         time.Time
+	SlotMinutes int
+	IsDayOff    bool
+}
+
+// Override переопределение расписания на конкретную дату. У Date значимы только год, месяц и день
+type Override struct {
+	Date        time.Time
+	Start       time.Time
+	End         time.Time
+	SlotMinutes int
+	IsDayOff    bool
+}
+
+// Schedule исходные данные для расчёта: расписания клиники и, если расчёт ведётся для врача, расписания врача
+type Schedule struct {
+	// Location часовой пояс клиники, в котором заданы часы расписаний; по умолчанию time.Local
+	Location        *time.Location
+	ClinicWeekly    []WeeklyDay
+	ClinicOverrides []Override
+	// ClinicRules и DoctorRules проверяются по порядку, действует первое подходящее правило
+	ClinicRules []Rule
+	// Doctor расчёт ведётся для врача: без его расписаний на дату день нерабочий; без Doctor — сетка клиники
+	Doctor          bool
+	DoctorWeekly    []WeeklyDay
+	DoctorOverrides []Override
+	DoctorRules     []Rule
+}
+
+// WorkingHours рабочие часы на дату. Если Open == false, остальные поля, кроме Date, не заполнены
+type WorkingHours struct {
+	Date        time.Time
+	Open        bool
+	Start       time.Time
+	End         time.Time
+	SlotMinutes int
+}
+
+// hours промежуточный результат по одному источнику расписания
+type hours struct {
+	start, end  time.Time
+	slotMinutes int
+	dayOff      bool
+}
+
+func (s Schedule) location() *time.Location {
+	if s.Location == nil {
+		return time.Local
+	}
+	return s.Location
+}
+
+// HoursOn рабочие часы на дату date по правилам приоритета, описанным в документации пакета
+func (s Schedule) HoursOn(date time.Time) WorkingHours {
+	day := Day(date, s.location())
+	result := WorkingHours{Date: day}
+
+	clinic, clinicKnown := resolve(day, s.ClinicWeekly, s.ClinicOverrides, s.ClinicRules)
+	if clinicKnown && clinic.dayOff {
+		return result
+	}
+
+	effective := clinic
+	if s.Doctor {
+		doctor, doctorKnown := resolve(day, s.DoctorWeekly, s.DoctorOverrides, s.DoctorRules)
+		if !doctorKnown || doctor.dayOff {
+			return result
+		}
+		effective = doctor
+		if clinicKnown {
+			if clinic.start.After(effective.start) {
+				effective.start = clinic.start
+			}
+			if clinic.end.Before(effective.end) {
+				effective.end = clinic.end
+			}
+		}
+		effective.slotMinutes = slotMinutes(day, s.DoctorWeekly, s.DoctorOverrides, s.DoctorRules)
+		if effective.slotMinutes <= 0 {
+			effective.slotMinutes = slotMinutes(day, s.ClinicWeekly, s.ClinicOverrides, s.ClinicRules)
+		}
+	} else if !clinicKnown {
+		return result
+	} else {
+		effective.slotMinutes = slotMinutes(day, s.ClinicWeekly, s.ClinicOverrides, s.ClinicRules)
+	}
+
+	if !effective.start.Before(effective.end) {
+		return result
+	}
+	if effective.slotMinutes <= 0 {
+		effective.slotMinutes = DefaultSlotMinutes
+	}
+
+	result.Open = true
+	result.Start = effective.start
+	result.End = effective.end
+	result.SlotMinutes = effective.slotMinutes
+	return result
+}
+
+// resolve часы одного источника (клиники или врача) на день: переопределение важнее правила,
+// правило важнее постоянного расписания. Второе значение false, если на этот день источник ничего не задаёт
+func resolve(day time.Time, weekly []WeeklyDay, overrides []Override, rules []Rule) (hours, bool) {
+	for _, o := range overrides {
+		if sameDate(o.Date, day) {
+			return hours{
+				start:       At(day, o.Start),
+				end:         At(day, o.End),
+				slotMinutes: o.SlotMinutes,
+				dayOff:      o.IsDayOff,
+			}, true
+		}
+	}
+	for _, r := range rules {
+		if r.Matches(day) {
+			return hours{
+				start:       At(day, r.Start),
+				end:         At(day, r.End),
+				slotMinutes: r.SlotMinutes,
+				dayOff:      r.IsDayOff,
+			}, true
+		}
+	}
+	for _, w := range weekly {
+		if w.Weekday == day.Weekday() {
+			return hours{
+				start:       At(day, w.Start),
+				end:         At(day, w.End),
+				slotMinutes: w.SlotMinutes,
+				dayOff:      w.IsDayOff,
+			}, true
+		}
+	}
+	return hours{}, false
+}
+
+// slotMinutes длительность слота из одного источника на день: первое ненулевое значение в переопределении,
+// первом подходящем правиле и постоянном расписании. 0, если источник длительность на этот день не задаёт
+func slotMinutes(day time.Time, weekly []WeeklyDay, overrides []Override, rules []Rule) int {
+	for _, o := range overrides {
+		if sameDate(o.Date, day) {
+			if o.SlotMinutes > 0 {
+				return o.SlotMinutes
+			}
+			break
+		}
+	}
+	for _, r := range rules {
+		if r.Matches(day) {
+			if r.SlotMinutes > 0 {
+				return r.SlotMinutes
+			}
+			break
+		}
+	}
+	for _, w := range weekly {
+		if w.Weekday == day.Weekday() {
+			return w.SlotMinutes
+		}
+	}
+	return 0
+}
+
+// SlotStarts начала всех слотов рабочего дня, независимо от занятости. Слоты идут с шагом по показаниям часов,
+// поэтому при переводе часов они не сдвигаются; начала, попавшие в пропущенный час, совпадают со следующими и отбрасываются
+func (h WorkingHours) SlotStarts() []time.Time {
+	if !h.Open {
+		return nil
+	}
+	var starts []time.Time
+	for i := 0; ; i++ {
+		t := time.Date(h.Start.Year(), h.Start.Month(), h.Start.Day(),
+			h.Start.Hour(), h.Start.Minute()+i*h.SlotMinutes, 0, 0, h.Start.Location())
+		if !t.Before(h.End) {
+			break
+		}
+		if len(starts) > 0 && !t.After(starts[len(starts)-1]) {
+			continue
+		}
+		starts = append(starts, t)
+	}
+	return starts
+}
+
+// Day полночь даты date в часовом поясе loc. Берутся год, месяц и день date как есть, без перевода пояса
+func Day(date time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+}
+
+// At момент времени clock (часы и минуты) в день day, в часовом поясе day
+func At(day, clock time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, day.Location())
+}
+
+// WeekStart понедельник недели, в которую попадает t
+func WeekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return Day(t, t.Location()).AddDate(0, 0, -offset)
+}
+
+func sameDate(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month() && a.Day() == b.Day()
+}
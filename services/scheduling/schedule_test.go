@@ -0,0 +1,330 @@
+package scheduling
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func clock(s string) time.Time {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func date(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func at(d, c string) time.Time {
+	return At(Day(date(d), time.UTC), clock(c))
+}
+
+// 2025-06-02 — понедельник
+const monday = "2025-06-02"
+
+func weekdays(start, end string, slot int) []WeeklyDay {
+	var days []WeeklyDay
+	for wd := time.Monday; wd <= time.Friday; wd++ {
+		days = append(days, WeeklyDay{Weekday: wd, Start: clock(start), End: clock(end), SlotMinutes: slot})
+	}
+	days = append(days,
+		WeeklyDay{Weekday: time.Saturday, IsDayOff: true},
+		WeeklyDay{Weekday: time.Sunday, IsDayOff: true},
+	)
+	return days
+}
+
+func TestHoursOn(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule Schedule
+		date     string
+		want     WorkingHours
+	}{
+		{
+			name:     "только клиника: постоянное расписание",
+			schedule: Schedule{ClinicWeekly: weekdays("09:00", "18:00", 30)},
+			date:     monday,
+			want:     WorkingHours{Open: true, Start: at(monday, "09:00"), End: at(monday, "18:00"), SlotMinutes: 30},
+		},
+		{
+			name:     "только клиника: выходной по постоянному расписанию",
+			schedule: Schedule{ClinicWeekly: weekdays("09:00", "18:00", 30)},
+			date:     "2025-06-07",
+		},
+		{
+			name: "только клиника: переопределение важнее постоянного расписания",
+			schedule: Schedule{
+				ClinicWeekly:    weekdays("09:00", "18:00", 30),
+				ClinicOverrides: []Override{{Date: date(monday), Start: clock("10:00"), End: clock("14:00"), SlotMinutes: 20}},
+			},
+			date: monday,
+			want: WorkingHours{Open: true, Start: at(monday, "10:00"), End: at(monday, "14:00"), SlotMinutes: 20},
+		},
+		{
+			name: "только клиника: переопределение открывает выходной",
+			schedule: Schedule{
+				ClinicWeekly:    weekdays("09:00", "18:00", 30),
+				ClinicOverrides: []Override{{Date: date("2025-06-07"), Start: clock("10:00"), End: clock("13:00")}},
+			},
+			date: "2025-06-07",
+			want: WorkingHours{Open: true, Start: at("2025-06-07", "10:00"), End: at("2025-06-07", "13:00"), SlotMinutes: 30},
+		},
+		{
+			name:     "нет данных ни о клинике, ни о враче",
+			schedule: Schedule{},
+			date:     monday,
+		},
+		{
+			name: "врач: постоянное расписание внутри часов клиники",
+			schedule: Schedule{
+				Doctor:       true,
+				ClinicWeekly: weekdays("08:00", "20:00", 30),
+				DoctorWeekly: weekdays("09:00", "15:00", 45),
+			},
+			date: monday,
+			want: WorkingHours{Open: true, Start: at(monday, "09:00"), End: at(monday, "15:00"), SlotMinutes: 45},
+		},
+		{
+			name: "врач: часы обрезаются по часам клиники",
+			schedule: Schedule{
+				Doctor:       true,
+				ClinicWeekly: weekdays("10:00", "16:00", 30),
+				DoctorWeekly: weekdays("08:00", "18:00", 30),
+			},
+			date: monday,
+			want: WorkingHours{Open: true, Start: at(monday, "10:00"), End: at(monday, "16:00"), SlotMinutes: 30},
+		},
+		{
+			name: "врач: выходной клиники по переопределению закрывает день",
+			schedule: Schedule{
+				Doctor:          true,
+				ClinicWeekly:    weekdays("08:00", "20:00", 30),
+				ClinicOverrides: []Override{{Date: date(monday), IsDayOff: true}},
+				DoctorWeekly:    weekdays("09:00", "15:00", 30),
+				DoctorOverrides: []Override{{Date: date(monday), Start: clock("09:00"), End: clock("12:00")}},
+			},
+			date: monday,
+		},
+		{
+			name: "врач: выходной врача по переопределению",
+			schedule: Schedule{
+				Doctor:          true,
+				ClinicWeekly:    weekdays("08:00", "20:00", 30),
+				DoctorWeekly:    weekdays("09:00", "15:00", 30),
+				DoctorOverrides: []Override{{Date: date(monday), IsDayOff: true}},
+			},
+			date: monday,
+		},
+		{
+			name: "врач: переопределение врача важнее его постоянного расписания",
+			schedule: Schedule{
+				Doctor:          true,
+				ClinicWeekly:    weekdays("08:00", "20:00", 30),
+				DoctorWeekly:    weekdays("09:00", "15:00", 30),
+				DoctorOverrides: []Override{{Date: date(monday), Start: clock("12:00"), End: clock("19:00"), SlotMinutes: 60}},
+			},
+			date: monday,
+			want: WorkingHours{Open: true, Start: at(monday, "12:00"), End: at(monday, "19:00"), SlotMinutes: 60},
+		},
+		{
+			name: "врач: переопределение без длительности берёт её из постоянного расписания врача",
+			schedule: Schedule{
+				Doctor:          true,
+				ClinicWeekly:    weekdays("08:00", "20:00", 30),
+				DoctorWeekly:    weekdays("09:00", "15:00", 45),
+				DoctorOverrides: []Override{{Date: date(monday), Start: clock("12:00"), End: clock("19:00")}},
+			},
+			date: monday,
+			want: WorkingHours{Open: true, Start: at(monday, "12:00"), End: at(monday, "19:00"), SlotMinutes: 45},
+		},
+		{
+			name: "врач: без длительности у врача берётся переопределение клиники",
+			schedule: Schedule{
+				Doctor:          true,
+				ClinicWeekly:    weekdays("08:00", "20:00", 30),
+				ClinicOverrides: []Override{{Date: date(monday), Start: clock("08:00"), End: clock("20:00"), SlotMinutes: 20}},
+				DoctorWeekly:    weekdays("09:00", "15:00", 0),
+			},
+			date: monday,
+			want: WorkingHours{Open: true, Start: at(monday, "09:00"), End: at(monday, "15:00"), SlotMinutes: 20},
+		},
+		{
+			name:     "врач: нет ни одного расписания — часы клиники ему не достаются",
+			schedule: Schedule{Doctor: true, ClinicWeekly: weekdays("08:00", "20:00", 30)},
+			date:     monday,
+		},
+		{
+			name: "врач: нет расписания на день недели",
+			schedule: Schedule{
+				Doctor:       true,
+				ClinicWeekly: weekdays("08:00", "20:00", 30),
+				DoctorWeekly: []WeeklyDay{{Weekday: time.Tuesday, Start: clock("09:00"), End: clock("15:00")}},
+			},
+			date: monday,
+		},
+		{
+			name: "врач: работает в выходной клиники",
+			schedule: Schedule{
+				Doctor:          true,
+				ClinicWeekly:    weekdays("08:00", "20:00", 30),
+				DoctorOverrides: []Override{{Date: date("2025-06-07"), Start: clock("09:00"), End: clock("12:00")}},
+			},
+			date: "2025-06-07",
+		},
+		{
+			name: "врач: часы не пересекаются с часами клиники",
+			schedule: Schedule{
+				Doctor:          true,
+				ClinicOverrides: []Override{{Date: date(monday), Start: clock("08:00"), End: clock("12:00")}},
+				DoctorWeekly:    weekdays("13:00", "18:00", 30),
+			},
+			date: monday,
+		},
+		{
+			name: "врач: длительность слота берётся у клиники",
+			schedule: Schedule{
+				Doctor:          true,
+				ClinicOverrides: []Override{{Date: date(monday), Start: clock("08:00"), End: clock("20:00"), SlotMinutes: 15}},
+				DoctorWeekly:    weekdays("09:00", "12:00", 0),
+			},
+			date: monday,
+			want: WorkingHours{Open: true, Start: at(monday, "09:00"), End: at(monday, "12:00"), SlotMinutes: 15},
+		},
+		{
+			name:     "врач: длительность слота по умолчанию",
+			schedule: Schedule{Doctor: true, DoctorWeekly: weekdays("09:00", "12:00", 0)},
+			date:     monday,
+			want:     WorkingHours{Open: true, Start: at(monday, "09:00"), End: at(monday, "12:00"), SlotMinutes: DefaultSlotMinutes},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.schedule.Location = time.UTC
+			tt.want.Date = Day(date(tt.date), time.UTC)
+			got := tt.schedule.HoursOn(date(tt.date))
+			if got != tt.want {
+				t.Errorf("HoursOn() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFreeSlots(t *testing.T) {
+	schedule := Schedule{
+		Doctor:       true,
+		Location:     time.UTC,
+		ClinicWeekly: weekdays("09:00", "18:00", 30),
+		DoctorWeekly: weekdays("09:00", "11:00", 30),
+	}
+
+	tests := []struct {
+		name  string
+		query Query
+		want  []string
+	}{
+		{
+			name:  "все слоты свободны",
+			query: Query{From: date(monday), To: date(monday)},
+			want:  []string{"09:00", "09:30", "10:00", "10:30"},
+		},
+		{
+			name: "занятые слоты исключаются",
+			query: Query{From: date(monday), To: date(monday), Busy: []Booking{
+				{Start: at(monday, "09:30")},
+				{Start: at("2025-06-03", "10:00")},
+			}},
+			want: []string{"09:00", "10:00", "10:30"},
+		},
+		{
+			name: "длинная запись занимает несколько слотов",
+			query: Query{From: date(monday), To: date(monday), Busy: []Booking{
+				{Start: at(monday, "09:00"), Minutes: 60},
+			}},
+			want: []string{"10:00", "10:30"},
+		},
+		{
+			name:  "приём не помещается в конец дня",
+			query: Query{From: date(monday), To: date(monday), DurationMinutes: 60},
+			want:  []string{"09:00", "09:30", "10:00"},
+		},
+		{
+			name: "приём не должен пересекаться с занятым слотом",
+			query: Query{From: date(monday), To: date(monday), DurationMinutes: 60, Busy: []Booking{
+				{Start: at(monday, "10:00")},
+			}},
+			want: []string{"09:00"},
+		},
+		{
+			name:  "прошедшие слоты исключаются",
+			query: Query{From: date(monday), To: date(monday), Now: at(monday, "09:45")},
+			want:  []string{"10:00", "10:30"},
+		},
+		{
+			name:  "выходной день",
+			query: Query{From: date("2025-06-07"), To: date("2025-06-07")},
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days := schedule.FreeSlots(tt.query)
+			if len(days) != 1 {
+				t.Fatalf("FreeSlots() вернул %d дней, want 1", len(days))
+			}
+			var got []string
+			for _, slot := range days[0].Slots {
+				got = append(got, slot.Format("15:04"))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("FreeSlots() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFreeSlotsRange(t *testing.T) {
+	schedule := Schedule{Location: time.UTC, ClinicWeekly: weekdays("09:00", "10:00", 30)}
+	days := schedule.FreeSlots(Query{From: date(monday), To: date("2025-06-08")})
+	if len(days) != 7 {
+		t.Fatalf("FreeSlots() вернул %d дней, want 7", len(days))
+	}
+	for i, day := range days {
+		wantOpen := i < 5
+		if day.Hours.Open != wantOpen {
+			t.Errorf("день %d: Open = %v, want %v", i, day.Hours.Open, wantOpen)
+		}
+		if wantOpen && len(day.Slots) != 2 {
+			t.Errorf("день %d: %d слотов, want 2", i, len(day.Slots))
+		}
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	tests := []struct {
+		date string
+		want string
+	}{
+		{date: "2025-06-02", want: "2025-06-02"},
+		{date: "2025-06-05", want: "2025-06-02"},
+		{date: "2025-06-08", want: "2025-06-02"},
+		{date: "2025-06-09", want: "2025-06-09"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			got := WeekStart(date(tt.date)).Format("2006-01-02")
+			if got != tt.want {
+				t.Errorf("WeekStart(%s) = %s, want %s", tt.date, got, tt.want)
+			}
+		})
+	}
+}
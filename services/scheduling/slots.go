@@ -0,0 +1,98 @@
+package scheduling
+
+import "time"
+
+// Booking занятый промежуток. Если Minutes == 0, занятым считается один слот дня, на который приходится Start
+type Booking struct {
+	Start   time.Time
+	Minutes int
+}
+
+// Query параметры поиска свободных слотов
+type Query struct {
+	// From и To — первая и последняя даты диапазона (включительно)
+	From time.Time
+	To   time.Time
+	// DurationMinutes длительность приёма; 0 — длительность слота дня
+	DurationMinutes int
+	Busy            []Booking
+	// Now слоты, начинающиеся раньше Now, не возвращаются; нулевое значение отключает проверку
+	Now time.Time
+}
+
+// DaySlots рабочие часы дня и свободные в этот день начала приёмов
+type DaySlots struct {
+	Hours WorkingHours
+	Slots []time.Time
+}
+
+// FreeSlots свободные слоты по дням диапазона. Дни возвращаются все, включая нерабочие (с пустым Slots),
+// чтобы календарь отображался без пропусков. Слот свободен, если приём нужной длительности целиком
+// помещается в рабочие часы и не пересекается ни с одним занятым промежутком
+func (s Schedule) FreeSlots(q Query) []DaySlots {
+	loc := s.location()
+	from := Day(q.From, loc)
+	to := Day(q.To, loc)
+
+	var result []DaySlots
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		h := s.HoursOn(day)
+		daySlots := DaySlots{Hours: h}
+		if !h.Open {
+			result = append(result, daySlots)
+			continue
+		}
+
+		minutes := q.DurationMinutes
+		if minutes <= 0 {
+			minutes = h.SlotMinutes
+		}
+		duration := time.Duration(minutes) * time.Minute
+		busy := busyIntervals(q.Busy, day, h.SlotMinutes)
+
+		for _, start := range h.SlotStarts() {
+			end := start.Add(duration)
+			if end.After(h.End) {
+				break
+			}
+			if !q.Now.IsZero() && start.Before(q.Now) {
+				continue
+			}
+			if overlapsAny(start, end, busy) {
+				continue
+			}
+			daySlots.Slots = append(daySlots.Slots, start)
+		}
+		result = append(result, daySlots)
+	}
+	return result
+}
+
+type interval struct {
+	start, end time.Time
+}
+
+// busyIntervals занятые промежутки, приходящиеся на день day
+func busyIntervals(bookings []Booking, day time.Time, slotMinutes int) []interval {
+	var busy []interval
+	for _, b := range bookings {
+		if !sameDate(b.Start.In(day.Location()), day) {
+			continue
+		}
+		minutes := b.Minutes
+		if minutes <= 0 {
+			minutes = slotMinutes
+		}
+		busy = append(busy, interval{start: b.Start, end: b.Start.Add(time.Duration(minutes) * time.Minute)})
+	}
+	return busy
+}
+
+func overlapsAny(start, end time.Time, busy []interval) bool {
+	for _, b := range busy {
+		if start.Before(b.end) && b.start.Before(end) {
+			return true
+		}
+	}
+	return false
+}
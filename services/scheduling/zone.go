@@ -0,0 +1,35 @@
+package scheduling
+
+import (
+	"errors"
+	"fmt"
+	"time"
+	_ "time/tzdata" // база часовых поясов встраивается в бинарник: на сервере её может не быть
+)
+
+// ErrInvalidTimeZone часовой пояс не задан или отсутствует в базе IANA
+var ErrInvalidTimeZone = errors.New("некорректный часовой пояс клиники")
+
+// LoadLocation часовой пояс клиники по имени IANA. Пустое имя и Local не принимаются:
+// они означают пояс сервера, а расчёт не должен от него зависеть
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" || name == "Local" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimeZone, name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimeZone, name)
+	}
+	return loc, nil
+}
+
+// Today полночь текущей даты клиники: now переводится в пояс loc, и берётся её календарный день
+func Today(now time.Time, loc *time.Location) time.Time {
+	return Day(now.In(loc), loc)
+}
+
+// Wall момент времени по показаниям часов клиники: дата date и время clock, хранящиеся без пояса,
+// трактуются в поясе loc
+func Wall(date, clock time.Time, loc *time.Location) time.Time {
+	return At(Day(date, loc), clock)
+}
@@ -0,0 +1,197 @@
+package scheduling
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func mustLoad(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q): %v", name, err)
+	}
+	return loc
+}
+
+func everyDay(start, end string, slot int) []WeeklyDay {
+	var days []WeeklyDay
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		days = append(days, WeeklyDay{Weekday: wd, Start: clock(start), End: clock(end), SlotMinutes: slot})
+	}
+	return days
+}
+
+// withLocal подменяет пояс сервера на время теста, чтобы результат не зависел от машины, где он запущен
+func withLocal(t *testing.T, name string) {
+	t.Helper()
+	prev := time.Local
+	time.Local = mustLoad(t, name)
+	t.Cleanup(func() { time.Local = prev })
+}
+
+func wallClock(starts []time.Time) []string {
+	var got []string
+	for _, s := range starts {
+		got = append(got, s.Format("15:04"))
+	}
+	return got
+}
+
+func TestSlotStartsAcrossDST(t *testing.T) {
+	tests := []struct {
+		name       string
+		zone       string
+		date       string
+		start, end string
+		slot       int
+		want       []string
+		wantOffset int // смещение первого слота от UTC в часах
+	}{
+		{
+			name: "Берлин: суббота перед переходом на летнее время",
+			zone: "Europe/Berlin", date: "2025-03-29", start: "09:00", end: "12:00", slot: 60,
+			want: []string{"09:00", "10:00", "11:00"}, wantOffset: 1,
+		},
+		{
+			name: "Берлин: день перехода на летнее время",
+			zone: "Europe/Berlin", date: "2025-03-30", start: "09:00", end: "12:00", slot: 60,
+			want: []string{"09:00", "10:00", "11:00"}, wantOffset: 2,
+		},
+		{
+			name: "Берлин: пропущенный час при переходе на летнее время",
+			zone: "Europe/Berlin", date: "2025-03-30", start: "00:00", end: "05:00", slot: 60,
+			want: []string{"00:00", "01:00", "03:00", "04:00"}, wantOffset: 1,
+		},
+		{
+			name: "Берлин: день перехода на зимнее время",
+			zone: "Europe/Berlin", date: "2025-10-26", start: "09:00", end: "12:00", slot: 60,
+			want: []string{"09:00", "10:00", "11:00"}, wantOffset: 1,
+		},
+		{
+			name: "Берлин: повторяющийся час при переходе на зимнее время",
+			zone: "Europe/Berlin", date: "2025-10-26", start: "00:00", end: "05:00", slot: 60,
+			want: []string{"00:00", "01:00", "02:00", "03:00", "04:00"}, wantOffset: 2,
+		},
+		{
+			name: "Нью-Йорк: переход на летнее время",
+			zone: "America/New_York", date: "2025-03-09", start: "08:00", end: "10:00", slot: 30,
+			want: []string{"08:00", "08:30", "09:00", "09:30"}, wantOffset: -4,
+		},
+		{
+			name: "Нью-Йорк: переход на зимнее время",
+			zone: "America/New_York", date: "2025-11-02", start: "08:00", end: "10:00", slot: 30,
+			want: []string{"08:00", "08:30", "09:00", "09:30"}, wantOffset: -5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := mustLoad(t, tt.zone)
+			schedule := Schedule{Location: loc, ClinicWeekly: everyDay(tt.start, tt.end, tt.slot)}
+			starts := schedule.HoursOn(date(tt.date)).SlotStarts()
+
+			if got := wallClock(starts); !slices.Equal(got, tt.want) {
+				t.Fatalf("SlotStarts() = %v, want %v", got, tt.want)
+			}
+			for i := 1; i < len(starts); i++ {
+				if !starts[i].After(starts[i-1]) {
+					t.Errorf("слоты не возрастают: %v после %v", starts[i], starts[i-1])
+				}
+			}
+			if _, offset := starts[0].Zone(); offset != tt.wantOffset*3600 {
+				t.Errorf("смещение первого слота = %d ч, want %d ч", offset/3600, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestFreeSlotsAcrossDSTWeek(t *testing.T) {
+	loc := mustLoad(t, "Europe/Berlin")
+	schedule := Schedule{Location: loc, ClinicWeekly: everyDay("09:00", "10:00", 30)}
+
+	// запись, сохранённая до перевода часов, остаётся на 09:30 по часам клиники
+	busy := []Booking{{Start: Wall(date("2025-03-31"), clock("09:30"), loc)}}
+	days := schedule.FreeSlots(Query{From: date("2025-03-29"), To: date("2025-03-31"), Busy: busy})
+	if len(days) != 3 {
+		t.Fatalf("FreeSlots() вернул %d дней, want 3", len(days))
+	}
+	want := [][]string{{"09:00", "09:30"}, {"09:00", "09:30"}, {"09:00"}}
+	for i, day := range days {
+		if got := wallClock(day.Slots); !slices.Equal(got, want[i]) {
+			t.Errorf("день %s: слоты %v, want %v", day.Hours.Date.Format("2006-01-02"), got, want[i])
+		}
+	}
+}
+
+func TestFreeSlotsNonLocalServerZone(t *testing.T) {
+	// сервер в UTC-10, клиника во Владивостоке (UTC+10): календарные даты сервера и клиники различаются
+	withLocal(t, "Pacific/Honolulu")
+	loc := mustLoad(t, "Asia/Vladivostok")
+	schedule := Schedule{
+		Doctor:       true,
+		Location:     loc,
+		ClinicWeekly: weekdays("09:00", "18:00", 30),
+		DoctorWeekly: weekdays("09:00", "11:00", 30),
+	}
+
+	// даты и время из хранилища приходят как полночь и время суток в UTC
+	busy := []Booking{{Start: Wall(date(monday), clock("10:00"), loc)}}
+	// 09:40 понедельника во Владивостоке — ещё воскресенье в UTC и в поясе сервера
+	now := time.Date(2025, 6, 1, 23, 40, 0, 0, time.UTC)
+
+	today := Today(now, loc)
+	if today.Format("2006-01-02") != monday {
+		t.Fatalf("Today() = %s, want %s", today.Format("2006-01-02"), monday)
+	}
+
+	days := schedule.FreeSlots(Query{From: today, To: today, Busy: busy, Now: now})
+	if len(days) != 1 {
+		t.Fatalf("FreeSlots() вернул %d дней, want 1", len(days))
+	}
+	if got, want := wallClock(days[0].Slots), []string{"10:30"}; !slices.Equal(got, want) {
+		t.Errorf("FreeSlots() = %v, want %v", got, want)
+	}
+	if days[0].Slots[0].Location() != loc {
+		t.Errorf("слот в поясе %s, want %s", days[0].Slots[0].Location(), loc)
+	}
+}
+
+func TestWall(t *testing.T) {
+	loc := mustLoad(t, "Asia/Vladivostok")
+	got := Wall(date(monday), clock("09:15"), loc)
+	want := time.Date(2025, 6, 2, 9, 15, 0, 0, loc)
+	if !got.Equal(want) {
+		t.Errorf("Wall() = %v, want %v", got, want)
+	}
+	if got.UTC().Day() != 1 {
+		t.Errorf("Wall() в UTC = %v, ожидалось предыдущее число", got.UTC())
+	}
+}
+
+func TestLoadLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{name: "Europe/Moscow"},
+		{name: "Asia/Vladivostok"},
+		{name: "UTC"},
+		{name: "", wantErr: true},
+		{name: "Local", wantErr: true},
+		{name: "Mars/Olympus", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadLocation(tt.name)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("LoadLocation(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidTimeZone) {
+				t.Errorf("LoadLocation(%q) error = %v, want ErrInvalidTimeZone", tt.name, err)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"github.com/DariaTarasek/diplom/services/audit"
 	"github.com/DariaTarasek/diplom/services/statistics/clients"
 	grpcserver "github.com/DariaTarasek/diplom/services/statistics/grpc"
 	pb "github.com/DariaTarasek/diplom/services/statistics/proto/statistics"
@@ -22,7 +24,19 @@ func main() {
 		log.Fatalf("Не удалось начать слушать: %v", err)
 	}
 
-	s := grpc.NewServer()
+	// вызовы от имени пользователя записываются в журнал аудита с итоговым статусом; ошибки методов
+	// приводятся к доменным статусам, ошибки без доменного смысла — к внутренней без подробностей
+	recorder := audit.RecorderFunc(storageClient.RecordAudit)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			audit.UnaryServerInterceptor("statistics", recorder, nil),
+			apperr.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			audit.StreamServerInterceptor("statistics", recorder, nil),
+			apperr.StreamServerInterceptor(),
+		),
+	)
 
 	server := &grpcserver.Server{
 		Service: statisticsService,
@@ -9,6 +9,7 @@ package storagepb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -821,11 +822,181 @@ func (x *GetDoctorResponse) GetDoctor() *Doctor {
 	return nil
 }
 
+type DefaultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         string                 `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefaultResponse) Reset() {
+	*x = DefaultResponse{}
+	mi := &file_proto_storage_storage_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefaultResponse) ProtoMessage() {}
+
+func (x *DefaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefaultResponse.ProtoReflect.Descriptor instead.
+func (*DefaultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DefaultResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Запись журнала аудита: кто (actor_id, actor_role), каким методом какого сервиса, к какому ресурсу
+// и чьим данным, с каким результатом (код gRPC-статуса) и когда. hash — звено цепочки хешей журнала
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Service       string                 `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	ActorId       int32                  `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorRole     int32                  `protobuf:"varint,6,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	Resource      string                 `protobuf:"bytes,7,opt,name=resource,proto3" json:"resource,omitempty"`
+	ResourceId    string                 `protobuf:"bytes,8,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	PatientId     int32                  `protobuf:"varint,9,opt,name=patient_id,json=patientId,proto3" json:"patient_id,omitempty"` // 0 — вызов не относится к конкретному пациенту
+	Outcome       string                 `protobuf:"bytes,10,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Hash          []byte                 `protobuf:"bytes,11,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_proto_storage_storage_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_storage_storage_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_proto_storage_storage_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuditEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *AuditEntry) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetActorId() int32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetActorRole() int32 {
+	if x != nil {
+		return x.ActorRole
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetResourceId() string {
+	if x != nil {
+		return x.ResourceId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetPatientId() int32 {
+	if x != nil {
+		return x.PatientId
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
 var File_proto_storage_storage_proto protoreflect.FileDescriptor
 
 const file_proto_storage_storage_proto_rawDesc = "" +
 	"\n" +
-	"\x1bproto/storage/storage.proto\x12\astorage\"\x0e\n" +
+	"\x1bproto/storage/storage.proto\x12\astorage\x1a\x1fgoogle/protobuf/timestamp.proto\"\x0e\n" +
 	"\fEmptyRequest\"\x1f\n" +
 	"\vIntResponse\x12\x10\n" +
 	"\x03int\x18\x01 \x01(\x05R\x03int\"C\n" +
@@ -875,7 +1046,27 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"experience\x12\x16\n" +
 	"\x06gender\x18\t \x01(\tR\x06gender\"<\n" +
 	"\x11GetDoctorResponse\x12'\n" +
-	"\x06doctor\x18\x01 \x01(\v2\x0f.storage.DoctorR\x06doctor2\xb5\a\n" +
+	"\x06doctor\x18\x01 \x01(\v2\x0f.storage.DoctorR\x06doctor\"'\n" +
+	"\x0fDefaultResponse\x12\x14\n" +
+	"\x05error\x18\x01 \x01(\tR\x05error\"\xcf\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12;\n" +
+	"\voccurred_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x18\n" +
+	"\aservice\x18\x03 \x01(\tR\aservice\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\x05R\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_role\x18\x06 \x01(\x05R\tactorRole\x12\x1a\n" +
+	"\bresource\x18\a \x01(\tR\bresource\x12\x1f\n" +
+	"\vresource_id\x18\b \x01(\tR\n" +
+	"resourceId\x12\x1d\n" +
+	"\n" +
+	"patient_id\x18\t \x01(\x05R\tpatientId\x12\x18\n" +
+	"\aoutcome\x18\n" +
+	" \x01(\tR\aoutcome\x12\x12\n" +
+	"\x04hash\x18\v \x01(\fR\x04hash2\xf5\a\n" +
 	"\x0eStorageService\x12?\n" +
 	"\x10GetTotalPatients\x12\x15.storage.EmptyRequest\x1a\x14.storage.IntResponse\x12=\n" +
 	"\x0eGetTotalVisits\x12\x15.storage.EmptyRequest\x1a\x14.storage.IntResponse\x12F\n" +
@@ -889,7 +1080,8 @@ const file_proto_storage_storage_proto_rawDesc = "" +
 	"\x0eGetTotalIncome\x12\x15.storage.EmptyRequest\x1a\x16.storage.FloatResponse\x12A\n" +
 	"\x10GetMonthlyIncome\x12\x15.storage.EmptyRequest\x1a\x16.storage.FloatResponse\x12F\n" +
 	"\x15GetClinicAverageCheck\x12\x15.storage.EmptyRequest\x1a\x16.storage.FloatResponse\x12D\n" +
-	"\rGetDoctorByID\x12\x17.storage.GetByIDRequest\x1a\x1a.storage.GetDoctorResponseB\x19Z\x17storage/proto;storagepbb\x06proto3"
+	"\rGetDoctorByID\x12\x17.storage.GetByIDRequest\x1a\x1a.storage.GetDoctorResponse\x12>\n" +
+	"\rAddAuditEntry\x12\x13.storage.AuditEntry\x1a\x18.storage.DefaultResponseB\x19Z\x17storage/proto;storagepbb\x06proto3"
 
 var (
 	file_proto_storage_storage_proto_rawDescOnce sync.Once
@@ -903,7 +1095,7 @@ func file_proto_storage_storage_proto_rawDescGZIP() []byte {
 	return file_proto_storage_storage_proto_rawDescData
 }
 
-var file_proto_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_storage_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_proto_storage_storage_proto_goTypes = []any{
 	(*EmptyRequest)(nil),                // 0: storage.EmptyRequest
 	(*IntResponse)(nil),                 // 1: storage.IntResponse
@@ -921,6 +1113,9 @@ var file_proto_storage_storage_proto_goTypes = []any{
 	(*GetByIDRequest)(nil),              // 13: storage.GetByIDRequest
 	(*Doctor)(nil),                      // 14: storage.Doctor
 	(*GetDoctorResponse)(nil),           // 15: storage.GetDoctorResponse
+	(*DefaultResponse)(nil),             // 16: storage.DefaultResponse
+	(*AuditEntry)(nil),                  // 17: storage.AuditEntry
+	(*timestamppb.Timestamp)(nil),       // 18: google.protobuf.Timestamp
 }
 var file_proto_storage_storage_proto_depIdxs = []int32{
 	2,  // 0: storage.ServiceStatsResponse.service_stats:type_name -> storage.ServiceStats
@@ -929,37 +1124,40 @@ var file_proto_storage_storage_proto_depIdxs = []int32{
 	8,  // 3: storage.DoctorUniquePatientResponse.patients:type_name -> storage.DoctorUniquePatient
 	10, // 4: storage.AgeGroupStatResponse.age_groups:type_name -> storage.AgeGroupStat
 	14, // 5: storage.GetDoctorResponse.doctor:type_name -> storage.Doctor
-	0,  // 6: storage.StorageService.GetTotalPatients:input_type -> storage.EmptyRequest
-	0,  // 7: storage.StorageService.GetTotalVisits:input_type -> storage.EmptyRequest
-	0,  // 8: storage.StorageService.GetTopServices:input_type -> storage.EmptyRequest
-	0,  // 9: storage.StorageService.GetDoctorAvgVisit:input_type -> storage.EmptyRequest
-	0,  // 10: storage.StorageService.GetDoctorAvgCheck:input_type -> storage.EmptyRequest
-	0,  // 11: storage.StorageService.GetDoctorUniquePatient:input_type -> storage.EmptyRequest
-	0,  // 12: storage.StorageService.GetAgeGroupStat:input_type -> storage.EmptyRequest
-	0,  // 13: storage.StorageService.GetNewPatientsThisMonth:input_type -> storage.EmptyRequest
-	0,  // 14: storage.StorageService.GetAvgVisitsPerPatient:input_type -> storage.EmptyRequest
-	0,  // 15: storage.StorageService.GetTotalIncome:input_type -> storage.EmptyRequest
-	0,  // 16: storage.StorageService.GetMonthlyIncome:input_type -> storage.EmptyRequest
-	0,  // 17: storage.StorageService.GetClinicAverageCheck:input_type -> storage.EmptyRequest
-	13, // 18: storage.StorageService.GetDoctorByID:input_type -> storage.GetByIDRequest
-	1,  // 19: storage.StorageService.GetTotalPatients:output_type -> storage.IntResponse
-	1,  // 20: storage.StorageService.GetTotalVisits:output_type -> storage.IntResponse
-	3,  // 21: storage.StorageService.GetTopServices:output_type -> storage.ServiceStatsResponse
-	5,  // 22: storage.StorageService.GetDoctorAvgVisit:output_type -> storage.DoctorAvgVisitResponse
-	7,  // 23: storage.StorageService.GetDoctorAvgCheck:output_type -> storage.DoctorAvgCheckResponse
-	9,  // 24: storage.StorageService.GetDoctorUniquePatient:output_type -> storage.DoctorUniquePatientResponse
-	11, // 25: storage.StorageService.GetAgeGroupStat:output_type -> storage.AgeGroupStatResponse
-	1,  // 26: storage.StorageService.GetNewPatientsThisMonth:output_type -> storage.IntResponse
-	12, // 27: storage.StorageService.GetAvgVisitsPerPatient:output_type -> storage.FloatResponse
-	12, // 28: storage.StorageService.GetTotalIncome:output_type -> storage.FloatResponse
-	12, // 29: storage.StorageService.GetMonthlyIncome:output_type -> storage.FloatResponse
-	12, // 30: storage.StorageService.GetClinicAverageCheck:output_type -> storage.FloatResponse
-	15, // 31: storage.StorageService.GetDoctorByID:output_type -> storage.GetDoctorResponse
-	19, // [19:32] is the sub-list for method output_type
-	6,  // [6:19] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	18, // 6: storage.AuditEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: storage.StorageService.GetTotalPatients:input_type -> storage.EmptyRequest
+	0,  // 8: storage.StorageService.GetTotalVisits:input_type -> storage.EmptyRequest
+	0,  // 9: storage.StorageService.GetTopServices:input_type -> storage.EmptyRequest
+	0,  // 10: storage.StorageService.GetDoctorAvgVisit:input_type -> storage.EmptyRequest
+	0,  // 11: storage.StorageService.GetDoctorAvgCheck:input_type -> storage.EmptyRequest
+	0,  // 12: storage.StorageService.GetDoctorUniquePatient:input_type -> storage.EmptyRequest
+	0,  // 13: storage.StorageService.GetAgeGroupStat:input_type -> storage.EmptyRequest
+	0,  // 14: storage.StorageService.GetNewPatientsThisMonth:input_type -> storage.EmptyRequest
+	0,  // 15: storage.StorageService.GetAvgVisitsPerPatient:input_type -> storage.EmptyRequest
+	0,  // 16: storage.StorageService.GetTotalIncome:input_type -> storage.EmptyRequest
+	0,  // 17: storage.StorageService.GetMonthlyIncome:input_type -> storage.EmptyRequest
+	0,  // 18: storage.StorageService.GetClinicAverageCheck:input_type -> storage.EmptyRequest
+	13, // 19: storage.StorageService.GetDoctorByID:input_type -> storage.GetByIDRequest
+	17, // 20: storage.StorageService.AddAuditEntry:input_type -> storage.AuditEntry
+	1,  // 21: storage.StorageService.GetTotalPatients:output_type -> storage.IntResponse
+	1,  // 22: storage.StorageService.GetTotalVisits:output_type -> storage.IntResponse
+	3,  // 23: storage.StorageService.GetTopServices:output_type -> storage.ServiceStatsResponse
+	5,  // 24: storage.StorageService.GetDoctorAvgVisit:output_type -> storage.DoctorAvgVisitResponse
+	7,  // 25: storage.StorageService.GetDoctorAvgCheck:output_type -> storage.DoctorAvgCheckResponse
+	9,  // 26: storage.StorageService.GetDoctorUniquePatient:output_type -> storage.DoctorUniquePatientResponse
+	11, // 27: storage.StorageService.GetAgeGroupStat:output_type -> storage.AgeGroupStatResponse
+	1,  // 28: storage.StorageService.GetNewPatientsThisMonth:output_type -> storage.IntResponse
+	12, // 29: storage.StorageService.GetAvgVisitsPerPatient:output_type -> storage.FloatResponse
+	12, // 30: storage.StorageService.GetTotalIncome:output_type -> storage.FloatResponse
+	12, // 31: storage.StorageService.GetMonthlyIncome:output_type -> storage.FloatResponse
+	12, // 32: storage.StorageService.GetClinicAverageCheck:output_type -> storage.FloatResponse
+	15, // 33: storage.StorageService.GetDoctorByID:output_type -> storage.GetDoctorResponse
+	16, // 34: storage.StorageService.AddAuditEntry:output_type -> storage.DefaultResponse
+	21, // [21:35] is the sub-list for method output_type
+	7,  // [7:21] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_proto_storage_storage_proto_init() }
@@ -973,7 +1171,7 @@ func file_proto_storage_storage_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_storage_storage_proto_rawDesc), len(file_proto_storage_storage_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -32,6 +32,7 @@ const (
 	StorageService_GetMonthlyIncome_FullMethodName        = "/storage.StorageService/GetMonthlyIncome"
 	StorageService_GetClinicAverageCheck_FullMethodName   = "/storage.StorageService/GetClinicAverageCheck"
 	StorageService_GetDoctorByID_FullMethodName           = "/storage.StorageService/GetDoctorByID"
+	StorageService_AddAuditEntry_FullMethodName           = "/storage.StorageService/AddAuditEntry"
 )
 
 // StorageServiceClient is the client API for StorageService service.
@@ -52,6 +53,8 @@ type StorageServiceClient interface {
 	GetMonthlyIncome(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*FloatResponse, error)
 	GetClinicAverageCheck(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*FloatResponse, error)
 	GetDoctorByID(ctx context.Context, in *GetByIDRequest, opts ...grpc.CallOption) (*GetDoctorResponse, error)
+	// журнал аудита
+	AddAuditEntry(ctx context.Context, in *AuditEntry, opts ...grpc.CallOption) (*DefaultResponse, error)
 }
 
 type storageServiceClient struct {
@@ -192,6 +195,16 @@ func (c *storageServiceClient) GetDoctorByID(ctx context.Context, in *GetByIDReq
 	return out, nil
 }
 
+func (c *storageServiceClient) AddAuditEntry(ctx context.Context, in *AuditEntry, opts ...grpc.CallOption) (*DefaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefaultResponse)
+	err := c.cc.Invoke(ctx, StorageService_AddAuditEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StorageServiceServer is the server API for StorageService service.
 // All implementations must embed UnimplementedStorageServiceServer
 // for forward compatibility.
@@ -210,6 +223,8 @@ type StorageServiceServer interface {
 	GetMonthlyIncome(context.Context, *EmptyRequest) (*FloatResponse, error)
 	GetClinicAverageCheck(context.Context, *EmptyRequest) (*FloatResponse, error)
 	GetDoctorByID(context.Context, *GetByIDRequest) (*GetDoctorResponse, error)
+	// журнал аудита
+	AddAuditEntry(context.Context, *AuditEntry) (*DefaultResponse, error)
 	mustEmbedUnimplementedStorageServiceServer()
 }
 
@@ -259,6 +274,9 @@ func (UnimplementedStorageServiceServer) GetClinicAverageCheck(context.Context,
 func (UnimplementedStorageServiceServer) GetDoctorByID(context.Context, *GetByIDRequest) (*GetDoctorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDoctorByID not implemented")
 }
+func (UnimplementedStorageServiceServer) AddAuditEntry(context.Context, *AuditEntry) (*DefaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuditEntry not implemented")
+}
 func (UnimplementedStorageServiceServer) mustEmbedUnimplementedStorageServiceServer() {}
 func (UnimplementedStorageServiceServer) testEmbeddedByValue()                        {}
 
@@ -514,6 +532,24 @@ func _StorageService_GetDoctorByID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StorageService_AddAuditEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StorageServiceServer).AddAuditEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StorageService_AddAuditEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StorageServiceServer).AddAuditEntry(ctx, req.(*AuditEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StorageService_ServiceDesc is the grpc.ServiceDesc for StorageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -573,6 +609,10 @@ var StorageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDoctorByID",
 			Handler:    _StorageService_GetDoctorByID_Handler,
 		},
+		{
+			MethodName: "AddAuditEntry",
+			Handler:    _StorageService_AddAuditEntry_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/storage/storage.proto",
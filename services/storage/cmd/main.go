@@ -1,33 +1,60 @@
 package main
 
 import (
-	"fmt"
+	"context"
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"github.com/DariaTarasek/diplom/services/audit"
 	grpcserver "github.com/DariaTarasek/diplom/services/storage/grpc"
+	"github.com/DariaTarasek/diplom/services/storage/internal/blob"
 	"github.com/DariaTarasek/diplom/services/storage/internal/db"
-	"github.com/DariaTarasek/diplom/services/storage/internal/storagefs"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
 	"github.com/DariaTarasek/diplom/services/storage/internal/store"
+	"github.com/DariaTarasek/diplom/services/storage/internal/uploads"
 	pb "github.com/DariaTarasek/diplom/services/storage/proto"
 	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
 	"log"
 	"net"
 	"os"
+	"path/filepath"
+	"strconv"
+	"time"
 )
 
+// waitingListExpiryInterval как часто истёкшие предложения листа ожидания передаются следующим в очереди
+const waitingListExpiryInterval = time.Minute
+
+// documentUploadTTL сколько хранится загрузка документа частями: незавершённую загрузку можно продолжить
+// в течение этого времени, потом промежуточный файл удаляется
+const documentUploadTTL = 24 * time.Hour
+
+// defaultPatientRetentionYears срок хранения медицинской документации архивного пациента: 25 лет с последнего
+// обращения (приказ Минздрава России № 530н). Переопределяется переменной PATIENT_RETENTION_YEARS
+const defaultPatientRetentionYears = 25
+
+// patientPurgeBatch сколько архивных пациентов удаляется за один проход, чтобы не держать БД долго
+const patientPurgeBatch = 100
+
 func main() {
 	err := godotenv.Load()
 	if err != nil {
 		log.Fatalf(".env файл не найден.")
 	}
 
-	dsn := buildDSN()
+	dsn, err := db.DSNFromEnv()
+	if err != nil {
+		log.Fatalf("Ошибка конфигурации БД: %s", err.Error())
+	}
 	conn, err := db.Connect(dsn)
 	if err != nil {
 		log.Fatalf("Ошибка подключения к БД: %s", err.Error())
 	}
 	defer conn.Close()
 
-	migrationDSN := buildMigrationDSN()
+	migrationDSN, err := db.MigrationDSNFromEnv()
+	if err != nil {
+		log.Fatalf("Ошибка конфигурации БД: %s", err.Error())
+	}
 	err = db.RunMigrations(migrationDSN)
 	if err != nil {
 		log.Fatalf("Миграция не была применена: %s", err.Error())
@@ -38,22 +65,52 @@ func main() {
 		log.Fatalf("не удалось начать слушать: %v", err)
 	}
 
-	s := grpc.NewServer(grpc.MaxRecvMsgSize(50*1024*1024),
-		grpc.MaxSendMsgSize(50*1024*1024))
-
 	st := store.NewStore(conn) // инициализация хранилища
 	if err != nil {
 		log.Fatalf("не удалось инициализировать store: %v", err)
 	}
-	fs := storagefs.NewFileStorage("/docs")
+
+	// вызовы от имени пользователя записываются в журнал аудита с итоговым статусом; ошибки методов
+	// приводятся к доменным статусам: sql.ErrNoRows — «не найдено», прочие без доменного смысла —
+	// внутренняя ошибка без текста SQL
+	recorder := auditRecorder(st)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			audit.UnaryServerInterceptor("storage", recorder, nil),
+			apperr.UnaryServerInterceptor(store.TranslateError),
+		),
+		grpc.ChainStreamInterceptor(
+			audit.StreamServerInterceptor("storage", recorder, nil),
+			apperr.StreamServerInterceptor(store.TranslateError),
+		),
+	)
+	docs, err := blob.NewFromEnv()
+	if err != nil {
+		log.Fatalf("не удалось инициализировать хранилище документов: %v", err)
+	}
+
+	uploadDir := os.Getenv("DOCS_UPLOAD_DIR")
+	if uploadDir == "" {
+		uploadDir = filepath.Join(os.TempDir(), "document-uploads")
+	}
+	docUploads, err := uploads.NewDir(uploadDir)
+	if err != nil {
+		log.Fatalf("не удалось инициализировать каталог загрузок: %v", err)
+	}
 
 	server := &grpcserver.Server{
-		Store: st,
-		FS:    fs,
+		Store:   st,
+		Docs:    docs,
+		Uploads: docUploads,
 	}
 
 	pb.RegisterStorageServiceServer(s, server)
 
+	go expireWaitingListOffers(st)
+	go expireDocumentUploads(st, docUploads)
+	go applyScheduledPrices(st)
+	go purgeArchivedPatients(st, docs, patientRetentionYearsFromEnv())
+
 	log.Println("Storage gRPC server started on :50051")
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("не удалось запустить сервер: %v", err)
@@ -61,34 +118,107 @@ func main() {
 	log.Println("Сервис БД запущен.")
 }
 
-func mustGetEnv(key string) string {
-	value := os.Getenv(key)
-	if value == "" {
-		log.Fatalf("Переменная окружения %s не установлена.", key)
+// auditRecorder записывает журнал аудита хранилища напрямую в БД
+func auditRecorder(st *store.Store) audit.Recorder {
+	return audit.RecorderFunc(func(ctx context.Context, e audit.Entry) error {
+		entry := model.AuditEntry{
+			OccurredAt: e.OccurredAt,
+			Service:    e.Service,
+			Action:     e.Action,
+			ActorID:    model.UserID(e.ActorID),
+			ActorRole:  e.ActorRole,
+			Resource:   e.Resource,
+			ResourceID: e.ResourceID,
+			Outcome:    e.Outcome,
+		}
+		if e.PatientID != nil {
+			patientID := model.UserID(*e.PatientID)
+			entry.PatientID = &patientID
+		}
+		return st.AddAuditEntry(ctx, entry)
+	})
+}
+
+func expireWaitingListOffers(st *store.Store) {
+	ticker := time.NewTicker(waitingListExpiryInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if _, err := st.ExpireWaitingListOffers(context.Background()); err != nil {
+			log.Printf("не удалось обработать истёкшие предложения листа ожидания: %v", err)
+		}
 	}
-	return value
 }
 
-func getDSNParams() map[string]string {
-	result := make(map[string]string, 6)
-	result["user"] = mustGetEnv("DB_USER")
-	result["password"] = mustGetEnv("DB_PASSWORD")
-	result["host"] = mustGetEnv("DB_HOST")
-	result["port"] = mustGetEnv("DB_PORT")
-	result["name"] = mustGetEnv("DB_NAME")
-	result["sslMode"] = mustGetEnv("DB_SSLMODE")
-	return result
+func expireDocumentUploads(st *store.Store, docUploads *uploads.Dir) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for range ticker.C {
+		ids, err := st.DeleteExpiredDocumentUploads(context.Background(), time.Now().Add(-documentUploadTTL))
+		if err != nil {
+			log.Printf("не удалось удалить устаревшие загрузки документов: %v", err)
+			continue
+		}
+		for _, id := range ids {
+			if err := docUploads.Remove(id.String()); err != nil {
+				log.Printf("загрузка %s: %v", id, err)
+			}
+		}
+	}
+}
+
+// applyScheduledPrices переносит в карточки услуг и материалов цены, дата которых наступила.
+// Первый проход сразу при старте: сервис мог быть остановлен в момент смены цены
+func applyScheduledPrices(st *store.Store) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for ; ; <-ticker.C {
+		n, err := st.ApplyScheduledPrices(context.Background())
+		if err != nil {
+			log.Printf("не удалось применить запланированные цены: %v", err)
+			continue
+		}
+		if n > 0 {
+			log.Printf("применены запланированные цены: %d", n)
+		}
+	}
 }
 
-// DSN - data source name
-func buildDSN() string {
-	params := getDSNParams()
-	return fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s sslmode=%s",
-		params["user"], params["password"], params["host"], params["port"], params["name"], params["sslMode"])
+// purgeArchivedPatients раз в сутки удаляет архивных пациентов с истёкшим сроком хранения вместе с их документами
+// в хранилище; каждое удаление записывается в журнал аудита. Первый проход сразу при старте
+func purgeArchivedPatients(st *store.Store, docs blob.Store, retentionYears int) {
+	ticker := time.NewTicker(24 * time.Hour)
+	defer ticker.Stop()
+	for ; ; <-ticker.C {
+		for {
+			n, keys, err := st.PurgeArchivedPatients(context.Background(), time.Now().AddDate(-retentionYears, 0, 0), patientPurgeBatch)
+			for _, key := range keys {
+				if err := docs.Delete(context.Background(), key); err != nil {
+					log.Printf("не удалось удалить объект %s из хранилища документов: %v", key, err)
+				}
+			}
+			if err != nil {
+				log.Printf("не удалось удалить архивных пациентов с истёкшим сроком хранения: %v", err)
+				break
+			}
+			if n > 0 {
+				log.Printf("удалены архивные пациенты с истёкшим сроком хранения: %d", n)
+			}
+			if n < patientPurgeBatch {
+				break
+			}
+		}
+	}
 }
 
-func buildMigrationDSN() string {
-	params := getDSNParams()
-	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
-		params["user"], params["password"], params["host"], params["port"], params["name"], params["sslMode"])
+// patientRetentionYearsFromEnv срок хранения данных архивного пациента в годах из PATIENT_RETENTION_YEARS
+func patientRetentionYearsFromEnv() int {
+	years := defaultPatientRetentionYears
+	if v := os.Getenv("PATIENT_RETENTION_YEARS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			log.Fatalf("PATIENT_RETENTION_YEARS должно быть положительным числом лет: %q", v)
+		}
+		years = n
+	}
+	return years
 }
@@ -0,0 +1,120 @@
+// Команда migratedocs переносит файлы документов пациентов, сохранённые до появления хранилища документов
+// (patient_documents.storage_path — путь на диске вида /docs/<patientID>/<filename>), в текущее хранилище,
+// заданное DOCS_STORAGE, и записывает в строки контентные ключи и контрольные суммы.
+//
+// Запуск из каталога сервиса storage (нужен тот же .env): go run ./cmd/migratedocs [-dry-run] [-remove-source]
+// Повторный запуск безопасен: перенесённые строки пропускаются, одинаковые файлы не загружаются повторно.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"github.com/DariaTarasek/diplom/services/storage/internal/blob"
+	"github.com/DariaTarasek/diplom/services/storage/internal/db"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/DariaTarasek/diplom/services/storage/internal/store"
+	"github.com/joho/godotenv"
+	"log"
+	"os"
+)
+
+func main() {
+	dryRun := flag.Bool("dry-run", false, "только показать, какие документы будут перенесены")
+	removeSource := flag.Bool("remove-source", false, "удалять исходные файлы после успешного переноса")
+	flag.Parse()
+
+	if err := godotenv.Load(); err != nil {
+		log.Fatalf(".env файл не найден.")
+	}
+	dsn, err := db.DSNFromEnv()
+	if err != nil {
+		log.Fatalf("Ошибка конфигурации БД: %s", err)
+	}
+	conn, err := db.Connect(dsn)
+	if err != nil {
+		log.Fatalf("Ошибка подключения к БД: %s", err)
+	}
+	defer conn.Close()
+	docs, err := blob.NewFromEnv()
+	if err != nil {
+		log.Fatalf("не удалось инициализировать хранилище документов: %v", err)
+	}
+	st := store.NewStore(conn)
+
+	ctx := context.Background()
+	legacy, err := st.GetLegacyDocuments(ctx)
+	if err != nil {
+		log.Fatalf("не удалось получить документы для переноса: %v", err)
+	}
+	log.Printf("Документов для переноса: %d", len(legacy))
+
+	failed := 0
+	for i := range legacy {
+		doc := &legacy[i]
+		if *dryRun {
+			log.Printf("%s: %s", doc.ID, doc.StoragePath)
+			continue
+		}
+		sources, err := migrateDocument(ctx, st, docs, doc)
+		if err != nil {
+			failed++
+			log.Printf("%s: не удалось перенести %s: %v", doc.ID, doc.StoragePath, err)
+			continue
+		}
+		log.Printf("%s: перенесён в %s", doc.ID, doc.StoragePath)
+		if *removeSource {
+			for _, path := range sources {
+				if err := os.Remove(path); err != nil {
+					log.Printf("%s: не удалось удалить исходный файл %s: %v", doc.ID, path, err)
+				}
+			}
+		}
+	}
+	if failed > 0 {
+		log.Fatalf("Не удалось перенести документов: %d из %d", failed, len(legacy))
+	}
+	log.Println("Перенос документов завершён.")
+}
+
+// migrateDocument загружает файл документа и его превью в хранилище и обновляет строку.
+// Возвращает пути исходных файлов на диске. Если файла превью нет, у документа остаётся пустое превью
+func migrateDocument(ctx context.Context, st *store.Store, docs blob.Store, doc *model.PatientDocument) ([]string, error) {
+	file, err := writeFile(ctx, docs, doc.StoragePath)
+	if err != nil {
+		return nil, err
+	}
+	sources := []string{doc.StoragePath}
+
+	previewKey := ""
+	if doc.PreviewPath != "" {
+		preview, err := writeFile(ctx, docs, doc.PreviewPath)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			log.Printf("%s: файл превью %s не найден, превью не переносится", doc.ID, doc.PreviewPath)
+		case err != nil:
+			return nil, err
+		default:
+			previewKey = preview.Key
+			sources = append(sources, doc.PreviewPath)
+		}
+	}
+
+	doc.StoragePath = file.Key
+	doc.PreviewPath = previewKey
+	doc.Checksum = &file.Checksum
+	doc.SizeBytes = &file.Size
+	if err := st.UpdateDocumentStorage(ctx, doc); err != nil {
+		return nil, err
+	}
+	return sources, nil
+}
+
+func writeFile(ctx context.Context, docs blob.Store, path string) (blob.Object, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return blob.Object{}, err
+	}
+	defer f.Close()
+	return blob.Write(ctx, docs, f)
+}
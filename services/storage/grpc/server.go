@@ -1,24 +1,31 @@
 package grpcserver
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/blob"
 	"github.com/DariaTarasek/diplom/services/storage/internal/model"
-	"github.com/DariaTarasek/diplom/services/storage/internal/storagefs"
 	"github.com/DariaTarasek/diplom/services/storage/internal/store"
+	"github.com/DariaTarasek/diplom/services/storage/internal/uploads"
 	pb "github.com/DariaTarasek/diplom/services/storage/proto"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"log"
+	"os"
 	"strconv"
 	"time"
 )
 
 type Server struct {
 	pb.UnimplementedStorageServiceServer
-	Store *store.Store
-	FS    *storagefs.FileStorage
+	Store   *store.Store
+	Docs    blob.Store
+	Uploads *uploads.Dir
 }
 
 func deref(s *string) string {
@@ -85,20 +92,21 @@ func (s *Server) GetAppointmentsByDoctorID(ctx context.Context, req *pb.GetAppoi
 	var appointments []*pb.Appointment
 	for _, app := range apps {
 		appointment := &pb.Appointment{
-			Id:          int32(app.ID),
-			DoctorId:    int32(app.DoctorID),
-			Date:        timestamppb.New(app.Date),
-			Time:        timestamppb.New(app.Time),
-			PatientId:   int32(derefUserID(app.PatientID)),
-			SecondName:  app.PatientSecondName,
-			FirstName:   app.PatientFirstName,
-			Surname:     deref(app.PatientSurname),
-			BirthDate:   timestamppb.New(app.PatientBirthDate),
-			Gender:      app.PatientGender,
-			PhoneNumber: app.PatientPhoneNumber,
-			Status:      app.Status,
-			CreatedAt:   timestamppb.New(app.CreatedAt),
-			UpdatedAt:   timestamppb.New(app.UpdatedAt),
+			Id:              int32(app.ID),
+			DoctorId:        int32(app.DoctorID),
+			Date:            timestamppb.New(app.Date),
+			Time:            timestamppb.New(app.Time),
+			PatientId:       int32(derefUserID(app.PatientID)),
+			SecondName:      app.PatientSecondName,
+			FirstName:       app.PatientFirstName,
+			Surname:         deref(app.PatientSurname),
+			BirthDate:       timestamppb.New(app.PatientBirthDate),
+			Gender:          app.PatientGender,
+			PhoneNumber:     app.PatientPhoneNumber,
+			Status:          app.Status,
+			CreatedAt:       timestamppb.New(app.CreatedAt),
+			UpdatedAt:       timestamppb.New(app.UpdatedAt),
+			DurationMinutes: int32(app.DurationMinutes),
 		}
 		appointments = append(appointments, appointment)
 	}
@@ -172,6 +180,7 @@ func (s *Server) GetUserByLogin(ctx context.Context, req *pb.GetUserByLoginReque
 		Login:    deref(user.Login),
 		Password: deref(user.Password),
 		Id:       int32(user.ID),
+		Status:   string(user.Status),
 	}, nil
 }
 
@@ -194,8 +203,8 @@ func (s *Server) UpdateUserPassword(ctx context.Context, req *pb.UpdateUserPassw
 	return &pb.DefaultResponse{}, nil
 }
 
-func (s *Server) GetDoctors(ctx context.Context, req *pb.EmptyRequest) (*pb.GetDoctorsResponse, error) {
-	items, err := s.Store.GetDoctors(ctx)
+func (s *Server) GetDoctors(ctx context.Context, req *pb.GetDoctorsRequest) (*pb.GetDoctorsResponse, error) {
+	items, total, err := s.Store.GetDoctors(ctx, model.PersonFilter{Query: req.Query, Inactive: req.Inactive}, listPageFromPb(req.Page))
 	if err != nil {
 		return nil, err
 	}
@@ -214,8 +223,19 @@ func (s *Server) GetDoctors(ctx context.Context, req *pb.EmptyRequest) (*pb.GetD
 		}
 		doctors = append(doctors, doctor)
 	}
-	return &pb.GetDoctorsResponse{Doctors: doctors}, nil
+	return &pb.GetDoctorsResponse{Doctors: doctors, Total: int32(total)}, nil
 }
+
+// listPageFromPb страница списка из запроса; без страницы — список целиком
+func listPageFromPb(page *pb.ListPage) model.ListPage {
+	return model.ListPage{
+		Limit:  int(page.GetLimit()),
+		Offset: int(page.GetOffset()),
+		Sort:   page.GetSort(),
+		Desc:   page.GetDesc(),
+	}
+}
+
 func (s *Server) GetDoctorSpecsByDoctorId(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetDoctorSpecsByDoctorIdResponse, error) {
 	items, err := s.Store.GetDoctorSpecializations(ctx, model.UserID(req.Id))
 	if err != nil {
@@ -228,8 +248,8 @@ func (s *Server) GetDoctorSpecsByDoctorId(ctx context.Context, req *pb.GetByIdRe
 	return &pb.GetDoctorSpecsByDoctorIdResponse{Specs: specs}, nil
 }
 
-func (s *Server) GetAdmins(ctx context.Context, req *pb.EmptyRequest) (*pb.GetAdminsResponse, error) {
-	items, err := s.Store.GetAdmins(ctx)
+func (s *Server) GetAdmins(ctx context.Context, req *pb.GetAdminsRequest) (*pb.GetAdminsResponse, error) {
+	items, total, err := s.Store.GetAdmins(ctx, model.PersonFilter{Query: req.Query, Inactive: req.Inactive}, listPageFromPb(req.Page))
 	if err != nil {
 		return nil, err
 	}
@@ -246,11 +266,11 @@ func (s *Server) GetAdmins(ctx context.Context, req *pb.EmptyRequest) (*pb.GetAd
 		}
 		admins = append(admins, admin)
 	}
-	return &pb.GetAdminsResponse{Admins: admins}, nil
+	return &pb.GetAdminsResponse{Admins: admins, Total: int32(total)}, nil
 }
 
-func (s *Server) GetPatients(ctx context.Context, req *pb.EmptyRequest) (*pb.GetPatientsResponse, error) {
-	items, err := s.Store.GetPatients(ctx)
+func (s *Server) GetPatients(ctx context.Context, req *pb.GetPatientsRequest) (*pb.GetPatientsResponse, error) {
+	items, total, err := s.Store.GetPatients(ctx, model.PersonFilter{Query: req.Query, Inactive: req.Inactive}, listPageFromPb(req.Page))
 	if err != nil {
 		return nil, err
 	}
@@ -268,7 +288,7 @@ func (s *Server) GetPatients(ctx context.Context, req *pb.EmptyRequest) (*pb.Get
 		}
 		patients = append(patients, patient)
 	}
-	return &pb.GetPatientsResponse{Patients: patients}, nil
+	return &pb.GetPatientsResponse{Patients: patients, Total: int32(total)}, nil
 }
 
 func (s *Server) GetClinicWeeklySchedule(ctx context.Context, req *pb.EmptyRequest) (*pb.GetClinicWeeklyScheduleResponse, error) {
@@ -347,20 +367,21 @@ func (s *Server) GetAppointmentsByUserID(ctx context.Context, request *pb.GetByI
 	appointments := make([]*pb.Appointment, 0, len(apps))
 	for _, app := range apps {
 		appointment := &pb.Appointment{
-			Id:          int32(app.ID),
-			DoctorId:    int32(app.DoctorID),
-			Date:        timestamppb.New(app.Date),
-			Time:        timestamppb.New(app.Time),
-			PatientId:   int32(derefUserID(app.PatientID)),
-			SecondName:  app.PatientSecondName,
-			FirstName:   app.PatientFirstName,
-			Surname:     deref(app.PatientSurname),
-			BirthDate:   timestamppb.New(app.PatientBirthDate),
-			Gender:      app.PatientGender,
-			PhoneNumber: app.PatientPhoneNumber,
-			Status:      app.Status,
-			CreatedAt:   timestamppb.New(app.CreatedAt),
-			UpdatedAt:   timestamppb.New(app.UpdatedAt),
+			Id:              int32(app.ID),
+			DoctorId:        int32(app.DoctorID),
+			Date:            timestamppb.New(app.Date),
+			Time:            timestamppb.New(app.Time),
+			PatientId:       int32(derefUserID(app.PatientID)),
+			SecondName:      app.PatientSecondName,
+			FirstName:       app.PatientFirstName,
+			Surname:         deref(app.PatientSurname),
+			BirthDate:       timestamppb.New(app.PatientBirthDate),
+			Gender:          app.PatientGender,
+			PhoneNumber:     app.PatientPhoneNumber,
+			Status:          app.Status,
+			CreatedAt:       timestamppb.New(app.CreatedAt),
+			UpdatedAt:       timestamppb.New(app.UpdatedAt),
+			DurationMinutes: int32(app.DurationMinutes),
 		}
 		appointments = append(appointments, appointment)
 	}
@@ -450,7 +471,7 @@ func (s *Server) GetDoctorsBySpecID(ctx context.Context, req *pb.GetDoctorBySpec
 		}
 		doctors = append(doctors, doctor)
 	}
-	return &pb.GetDoctorsResponse{Doctors: doctors}, nil
+	return &pb.GetDoctorsResponse{Doctors: doctors, Total: int32(len(doctors))}, nil
 }
 
 func (s *Server) GetDoctorByID(ctx context.Context, req *pb.GetByIDRequest) (*pb.GetDoctorByIDResponse, error) {
@@ -479,16 +500,123 @@ func (s *Server) GetMaterials(ctx context.Context, req *pb.EmptyRequest) (*pb.Ge
 	}
 	var materials []*pb.Material
 	for _, item := range items {
-		material := &pb.Material{
-			Id:    int32(item.ID),
-			Name:  item.Name,
-			Price: int32(item.Price),
-		}
-		materials = append(materials, material)
+		materials = append(materials, materialToPb(item))
 	}
 	return &pb.GetMaterialsResponse{Materials: materials}, nil
 }
 
+func materialToPb(item model.Material) *pb.Material {
+	return &pb.Material{
+		Id:                int32(item.ID),
+		Name:              item.Name,
+		Price:             int32(item.Price),
+		Unit:              item.Unit,
+		LowStockThreshold: int32(item.LowStockThreshold),
+		StockQuantity:     int32(item.StockQuantity),
+	}
+}
+
+func (s *Server) ReceiveMaterialBatch(ctx context.Context, req *pb.ReceiveMaterialBatchRequest) (*pb.MaterialBatch, error) {
+	batch := model.MaterialBatch{
+		MaterialID:       model.MaterialID(req.MaterialId),
+		BatchNumber:      req.BatchNumber,
+		QuantityReceived: int(req.Quantity),
+		Comment:          req.Comment,
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := dateOnly(req.ExpiresAt.AsTime())
+		batch.ExpiresAt = &expiresAt
+	}
+	created, err := s.Store.ReceiveMaterialBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+	return materialBatchToPb(created), nil
+}
+
+func (s *Server) AdjustMaterialStock(ctx context.Context, req *pb.AdjustMaterialStockRequest) (*pb.DefaultResponse, error) {
+	adj := model.StockAdjustment{
+		MaterialID: model.MaterialID(req.MaterialId),
+		Quantity:   int(req.Quantity),
+		Reason:     req.Reason,
+	}
+	if req.BatchId != 0 {
+		batchID := model.MaterialBatchID(req.BatchId)
+		adj.BatchID = &batchID
+	}
+	if err := s.Store.AdjustMaterialStock(ctx, adj); err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) GetMaterialStock(ctx context.Context, req *pb.GetByIdRequest) (*pb.MaterialStockResponse, error) {
+	stock, err := s.Store.GetMaterialStock(ctx, model.MaterialID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.MaterialStockResponse{Material: materialToPb(stock.Material)}
+	for _, batch := range stock.Batches {
+		resp.Batches = append(resp.Batches, materialBatchToPb(batch))
+	}
+	for _, m := range stock.Movements {
+		movement := &pb.StockMovement{
+			Id:         int32(m.ID),
+			MaterialId: int32(m.MaterialID),
+			Kind:       m.Kind,
+			Quantity:   int32(m.Quantity),
+			Reason:     m.Reason,
+			CreatedAt:  timestamppb.New(m.CreatedAt),
+		}
+		if m.BatchID != nil {
+			movement.BatchId = int32(*m.BatchID)
+		}
+		if m.VisitID != nil {
+			movement.VisitId = int32(*m.VisitID)
+		}
+		resp.Movements = append(resp.Movements, movement)
+	}
+	return resp, nil
+}
+
+func (s *Server) GetStockReport(ctx context.Context, req *pb.StockReportRequest) (*pb.StockReportResponse, error) {
+	if req.ExpiringBefore == nil {
+		return nil, status.Error(codes.InvalidArgument, "не задана дата для проверки сроков годности")
+	}
+	report, err := s.Store.GetStockReport(ctx, dateOnly(req.ExpiringBefore.AsTime()))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.StockReportResponse{}
+	for _, item := range report.LowStock {
+		resp.LowStock = append(resp.LowStock, materialToPb(item))
+	}
+	for _, item := range report.Expiring {
+		resp.Expiring = append(resp.Expiring, &pb.ExpiringBatch{
+			Batch:        materialBatchToPb(item.MaterialBatch),
+			MaterialName: item.MaterialName,
+			Unit:         item.Unit,
+		})
+	}
+	return resp, nil
+}
+
+func materialBatchToPb(batch model.MaterialBatch) *pb.MaterialBatch {
+	item := &pb.MaterialBatch{
+		Id:               int32(batch.ID),
+		MaterialId:       int32(batch.MaterialID),
+		BatchNumber:      batch.BatchNumber,
+		QuantityReceived: int32(batch.QuantityReceived),
+		QuantityLeft:     int32(batch.QuantityLeft),
+		ReceivedAt:       timestamppb.New(batch.ReceivedAt),
+		Comment:          batch.Comment,
+	}
+	if batch.ExpiresAt != nil {
+		item.ExpiresAt = timestamppb.New(*batch.ExpiresAt)
+	}
+	return item
+}
+
 func (s *Server) GetServices(ctx context.Context, req *pb.EmptyRequest) (*pb.GetServicesResponse, error) {
 	items, err := s.Store.GetServices(ctx)
 	if err != nil {
@@ -497,10 +625,11 @@ func (s *Server) GetServices(ctx context.Context, req *pb.EmptyRequest) (*pb.Get
 	var services []*pb.Service
 	for _, item := range items {
 		service := &pb.Service{
-			Id:    int32(item.ID),
-			Name:  item.Name,
-			Price: int32(*item.Price),
-			Type:  int32(item.Category),
+			Id:              int32(item.ID),
+			Name:            item.Name,
+			Price:           int32(*item.Price),
+			Type:            int32(item.Category),
+			DurationMinutes: int32(item.DurationMinutes),
 		}
 		services = append(services, service)
 	}
@@ -536,8 +665,10 @@ func (s *Server) GetServiceTypeById(ctx context.Context, req *pb.GetServiceTypeB
 
 func (s *Server) AddMaterial(ctx context.Context, req *pb.AddMaterialRequest) (*pb.DefaultResponse, error) {
 	_, err := s.Store.AddMaterial(ctx, model.Material{
-		Name:  req.Name,
-		Price: int(req.Price),
+		Name:              req.Name,
+		Price:             int(req.Price),
+		Unit:              req.Unit,
+		LowStockThreshold: int(req.LowStockThreshold),
 	})
 	if err != nil {
 		return nil, err
@@ -548,9 +679,10 @@ func (s *Server) AddMaterial(ctx context.Context, req *pb.AddMaterialRequest) (*
 func (s *Server) AddService(ctx context.Context, req *pb.AddServiceRequest) (*pb.DefaultResponse, error) {
 	price := int(req.Price)
 	_, err := s.Store.AddService(ctx, model.Service{
-		Name:     req.Name,
-		Price:    &price,
-		Category: model.ServiceTypeID(req.Type),
+		Name:            req.Name,
+		Price:           &price,
+		Category:        model.ServiceTypeID(req.Type),
+		DurationMinutes: int(req.DurationMinutes),
 	})
 	if err != nil {
 		return nil, err
@@ -560,9 +692,11 @@ func (s *Server) AddService(ctx context.Context, req *pb.AddServiceRequest) (*pb
 
 func (s *Server) UpdateMaterial(ctx context.Context, req *pb.UpdateMaterialRequest) (*pb.DefaultResponse, error) {
 	err := s.Store.UpdateMaterial(ctx, model.MaterialID(req.Id), model.Material{
-		ID:    model.MaterialID(req.Id),
-		Name:  req.Name,
-		Price: int(req.Price),
+		ID:                model.MaterialID(req.Id),
+		Name:              req.Name,
+		Price:             int(req.Price),
+		Unit:              req.Unit,
+		LowStockThreshold: int(req.LowStockThreshold),
 	})
 	if err != nil {
 		return nil, err
@@ -573,10 +707,11 @@ func (s *Server) UpdateMaterial(ctx context.Context, req *pb.UpdateMaterialReque
 func (s *Server) UpdateService(ctx context.Context, req *pb.UpdateServiceRequest) (*pb.DefaultResponse, error) {
 	price := int(req.Price)
 	err := s.Store.UpdateService(ctx, model.ServiceID(req.Id), model.Service{
-		ID:       model.ServiceID(req.Id),
-		Name:     req.Name,
-		Price:    &price,
-		Category: model.ServiceTypeID(req.Type),
+		ID:              model.ServiceID(req.Id),
+		Name:            req.Name,
+		Price:           &price,
+		Category:        model.ServiceTypeID(req.Type),
+		DurationMinutes: int(req.DurationMinutes),
 	})
 	if err != nil {
 		return nil, err
@@ -600,6 +735,64 @@ func (s *Server) DeleteService(ctx context.Context, req *pb.DeleteRequest) (*pb.
 	return &pb.DefaultResponse{}, nil
 }
 
+func (s *Server) GetPriceHistory(ctx context.Context, req *pb.GetPriceHistoryRequest) (*pb.GetPriceHistoryResponse, error) {
+	entries, err := s.Store.GetPriceHistory(ctx, model.PriceKind(req.Kind), int(req.ItemId))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetPriceHistoryResponse{Entries: make([]*pb.PriceListEntry, 0, len(entries))}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &pb.PriceListEntry{
+			Id:            int32(entry.ID),
+			ItemId:        int32(entry.ItemID),
+			Price:         int32(entry.Price),
+			EffectiveFrom: timestamppb.New(entry.EffectiveFrom),
+			CreatedAt:     timestamppb.New(entry.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) AddPriceListEntry(ctx context.Context, req *pb.AddPriceListEntryRequest) (*pb.AddPriceListEntryResponse, error) {
+	if req.Entry == nil || req.Entry.EffectiveFrom == nil {
+		return nil, status.Error(codes.InvalidArgument, "не указана дата начала действия цены")
+	}
+	id, err := s.Store.AddPriceListEntry(ctx, model.PriceKind(req.Kind), model.PriceListEntry{
+		ItemID:        int(req.Entry.ItemId),
+		Price:         int(req.Entry.Price),
+		EffectiveFrom: dateOnly(req.Entry.EffectiveFrom.AsTime()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddPriceListEntryResponse{Id: int32(id)}, nil
+}
+
+func (s *Server) DeletePriceListEntry(ctx context.Context, req *pb.DeletePriceListEntryRequest) (*pb.DefaultResponse, error) {
+	err := s.Store.DeletePriceListEntry(ctx, model.PriceKind(req.Kind), int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) GetPriceOnDate(ctx context.Context, req *pb.GetPriceOnDateRequest) (*pb.GetPriceOnDateResponse, error) {
+	if req.Date == nil {
+		return nil, status.Error(codes.InvalidArgument, "не указана дата")
+	}
+	price, err := s.Store.GetPriceOnDate(ctx, model.PriceKind(req.Kind), int(req.ItemId), dateOnly(req.Date.AsTime()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetPriceOnDateResponse{Price: int32(price)}, nil
+}
+
+// dateOnly дата без времени: даты прайс-листа передаются полночью UTC
+func dateOnly(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
 func (s *Server) AddClinicDailyOverride(ctx context.Context, req *pb.AddClinicDailyOverrideRequest) (*pb.DefaultResponse, error) {
 	start := req.StartTime.AsTime()
 	end := req.EndTime.AsTime()
@@ -664,6 +857,22 @@ func (s *Server) GetDoctorOverride(ctx context.Context, req *pb.GetDoctorOverrid
 	}, nil
 }
 
+func (s *Server) GetClinicSettings(ctx context.Context, req *pb.EmptyRequest) (*pb.ClinicSettings, error) {
+	settings, err := s.Store.GetClinicSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ClinicSettings{TimeZone: settings.TimeZone}, nil
+}
+
+func (s *Server) UpdateClinicTimeZone(ctx context.Context, req *pb.UpdateClinicTimeZoneRequest) (*pb.DefaultResponse, error) {
+	err := s.Store.UpdateClinicTimeZone(ctx, req.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
 func (s *Server) UpdatePatient(ctx context.Context, req *pb.UpdatePatientRequest) (*pb.DefaultResponse, error) {
 	err := s.Store.UpdatePatient(ctx, model.UserID(req.UserId), model.Patient{
 		FirstName:   req.FirstName,
@@ -761,6 +970,22 @@ func (s *Server) DeleteUser(ctx context.Context, req *pb.DeleteRequest) (*pb.Def
 	return &pb.DefaultResponse{}, nil
 }
 
+func (s *Server) RetireUser(ctx context.Context, req *pb.ChangeUserStatusRequest) (*pb.ChangeUserStatusResponse, error) {
+	status, err := s.Store.RetireUser(ctx, model.UserID(req.UserId), model.UserID(req.ChangedBy))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChangeUserStatusResponse{Status: string(status)}, nil
+}
+
+func (s *Server) RestoreUser(ctx context.Context, req *pb.ChangeUserStatusRequest) (*pb.ChangeUserStatusResponse, error) {
+	status, err := s.Store.RestoreUser(ctx, model.UserID(req.UserId), model.UserID(req.ChangedBy))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChangeUserStatusResponse{Status: string(status)}, nil
+}
+
 func (s *Server) UpdateUserLogin(ctx context.Context, req *pb.UpdateUserLoginRequest) (*pb.DefaultResponse, error) {
 	err := s.Store.UpdateUserLogin(ctx, model.UserID(req.UserId), req.Login)
 	if err != nil {
@@ -808,84 +1033,184 @@ func (s *Server) GetClinicOverrides(ctx context.Context, request *pb.EmptyReques
 	return &pb.GetClinicOverridesResponse{Overrides: clinicOverrides}, nil
 }
 
-func (s *Server) GetAppointments(ctx context.Context, request *pb.EmptyRequest) (*pb.GetAppointmentsResponse, error) {
-	apps, err := s.Store.GetAppointments(ctx)
+func (s *Server) GetAppointments(ctx context.Context, request *pb.GetAppointmentsRequest) (*pb.GetAppointmentsResponse, error) {
+	filter := model.AppointmentFilter{Statuses: request.Statuses, DoctorID: model.UserID(request.DoctorId)}
+	if request.DateFrom != nil {
+		from := request.DateFrom.AsTime()
+		filter.DateFrom = &from
+	}
+	if request.DateTo != nil {
+		to := request.DateTo.AsTime()
+		filter.DateTo = &to
+	}
+	apps, total, err := s.Store.GetAppointments(ctx, filter, listPageFromPb(request.Page))
 	if err != nil {
 		return nil, err
 	}
 	appointments := make([]*pb.Appointment, 0, len(apps))
 	for _, app := range apps {
 		appointment := &pb.Appointment{
-			Id:          int32(app.ID),
-			DoctorId:    int32(app.DoctorID),
-			Date:        timestamppb.New(app.Date),
-			Time:        timestamppb.New(app.Time),
-			PatientId:   int32(derefUserID(app.PatientID)),
-			SecondName:  app.PatientSecondName,
-			FirstName:   app.PatientFirstName,
-			Surname:     deref(app.PatientSurname),
-			BirthDate:   timestamppb.New(app.PatientBirthDate),
-			Gender:      app.PatientGender,
-			PhoneNumber: app.PatientPhoneNumber,
-			Status:      app.Status,
-			CreatedAt:   timestamppb.New(app.CreatedAt),
-			UpdatedAt:   timestamppb.New(app.UpdatedAt),
+			Id:              int32(app.ID),
+			DoctorId:        int32(app.DoctorID),
+			Date:            timestamppb.New(app.Date),
+			Time:            timestamppb.New(app.Time),
+			PatientId:       int32(derefUserID(app.PatientID)),
+			SecondName:      app.PatientSecondName,
+			FirstName:       app.PatientFirstName,
+			Surname:         deref(app.PatientSurname),
+			BirthDate:       timestamppb.New(app.PatientBirthDate),
+			Gender:          app.PatientGender,
+			PhoneNumber:     app.PatientPhoneNumber,
+			Status:          app.Status,
+			CreatedAt:       timestamppb.New(app.CreatedAt),
+			UpdatedAt:       timestamppb.New(app.UpdatedAt),
+			DurationMinutes: int32(app.DurationMinutes),
 		}
 		appointments = append(appointments, appointment)
 	}
-	return &pb.GetAppointmentsResponse{Appointments: appointments}, nil
+	return &pb.GetAppointmentsResponse{Appointments: appointments, Total: int32(total)}, nil
 }
 
 func (s *Server) AddAppointment(ctx context.Context, request *pb.AddAppointmentRequest) (*pb.DefaultResponse, error) {
+	appointment := appointmentFromPb(request.Appointment)
+	var holdID *uuid.UUID
+	if request.HoldId != "" {
+		id, err := uuid.Parse(request.HoldId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "невалидный UUID удержания: %v", err)
+		}
+		holdID = &id
+	}
+	var heldBy *model.UserID
+	if request.HeldBy != 0 {
+		id := model.UserID(request.HeldBy)
+		heldBy = &id
+	}
+	_, err := s.Store.AddAppointment(ctx, appointment, holdID, heldBy)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) HoldAppointmentSlot(ctx context.Context, request *pb.HoldAppointmentSlotRequest) (*pb.HoldAppointmentSlotResponse, error) {
+	var holdID uuid.UUID
+	if request.HoldId != "" {
+		id, err := uuid.Parse(request.HoldId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "невалидный UUID удержания: %v", err)
+		}
+		holdID = id
+	}
+	if request.TtlSeconds <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "время удержания слота должно быть положительным")
+	}
+	if request.HeldBy == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "не указан пользователь, удерживающий слот")
+	}
+	heldBy := model.UserID(request.HeldBy)
 	var patientID *model.UserID
-	if request.Appointment.PatientId == 0 {
-		patientID = nil
-	} else {
-		pID := model.UserID(request.Appointment.PatientId)
+	if request.PatientId != 0 {
+		pID := model.UserID(request.PatientId)
 		patientID = &pID
 	}
-	appointment := model.Appointment{
-		DoctorID:           model.UserID(request.Appointment.DoctorId),
-		PatientID:          patientID,
-		Date:               request.Appointment.Date.AsTime(),
-		Time:               request.Appointment.Time.AsTime(),
-		PatientSecondName:  request.Appointment.SecondName,
-		PatientFirstName:   request.Appointment.FirstName,
-		PatientSurname:     &request.Appointment.Surname,
-		PatientBirthDate:   request.Appointment.BirthDate.AsTime(),
-		PatientGender:      request.Appointment.Gender,
-		PatientPhoneNumber: request.Appointment.PhoneNumber,
-		Status:             request.Appointment.Status,
-		CreatedAt:          request.Appointment.CreatedAt.AsTime(),
-		UpdatedAt:          request.Appointment.UpdatedAt.AsTime(),
+	hold, err := s.Store.HoldAppointmentSlot(ctx, model.AppointmentSlotHold{
+		ID:              holdID,
+		DoctorID:        model.UserID(request.DoctorId),
+		Date:            request.Date.AsTime(),
+		Time:            request.Time.AsTime(),
+		PatientID:       patientID,
+		HeldBy:          &heldBy,
+		DurationMinutes: int(request.DurationMinutes),
+	}, time.Duration(request.TtlSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.HoldAppointmentSlotResponse{Hold: slotHoldToPb(hold)}, nil
+}
+
+func (s *Server) ReleaseAppointmentSlot(ctx context.Context, request *pb.ReleaseAppointmentSlotRequest) (*pb.DefaultResponse, error) {
+	holdID, err := uuid.Parse(request.HoldId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "невалидный UUID удержания: %v", err)
 	}
-	_, err := s.Store.AddAppointment(ctx, appointment)
+	err = s.Store.ReleaseAppointmentSlot(ctx, holdID, model.UserID(request.HeldBy))
 	if err != nil {
 		return nil, err
 	}
 	return &pb.DefaultResponse{}, nil
 }
 
+func (s *Server) GetAppointmentSlotHolds(ctx context.Context, request *pb.GetAppointmentsByDoctorIDRequest) (*pb.GetAppointmentSlotHoldsResponse, error) {
+	holds, err := s.Store.GetActiveSlotHoldsByDoctorID(ctx, model.UserID(request.DoctorId))
+	if err != nil {
+		return nil, err
+	}
+	pbHolds := make([]*pb.AppointmentSlotHold, 0, len(holds))
+	for _, hold := range holds {
+		pbHolds = append(pbHolds, slotHoldToPb(hold))
+	}
+	return &pb.GetAppointmentSlotHoldsResponse{Holds: pbHolds}, nil
+}
+
+func slotHoldToPb(hold model.AppointmentSlotHold) *pb.AppointmentSlotHold {
+	return &pb.AppointmentSlotHold{
+		HoldId:          hold.ID.String(),
+		DoctorId:        int32(hold.DoctorID),
+		Date:            timestamppb.New(hold.Date),
+		Time:            timestamppb.New(hold.Time),
+		PatientId:       int32(derefUserID(hold.PatientID)),
+		ExpiresAt:       timestamppb.New(hold.ExpiresAt),
+		DurationMinutes: int32(hold.DurationMinutes),
+	}
+}
+
+// appointmentSlotError переводит конфликт слота в codes.AlreadyExists, чтобы клиенты могли отличить его от прочих ошибок
+func appointmentFromPb(a *pb.Appointment) model.Appointment {
+	var patientID *model.UserID
+	if a.PatientId != 0 {
+		pID := model.UserID(a.PatientId)
+		patientID = &pID
+	}
+	return model.Appointment{
+		DoctorID:           model.UserID(a.DoctorId),
+		PatientID:          patientID,
+		Date:               a.Date.AsTime(),
+		Time:               a.Time.AsTime(),
+		PatientSecondName:  a.SecondName,
+		PatientFirstName:   a.FirstName,
+		PatientSurname:     &a.Surname,
+		PatientBirthDate:   a.BirthDate.AsTime(),
+		PatientGender:      a.Gender,
+		PatientPhoneNumber: a.PhoneNumber,
+		Status:             a.Status,
+		CreatedAt:          a.CreatedAt.AsTime(),
+		UpdatedAt:          a.UpdatedAt.AsTime(),
+		DurationMinutes:    int(a.DurationMinutes),
+	}
+}
+
 func (s *Server) GetAppointmentByID(ctx context.Context, request *pb.GetByIDRequest) (*pb.GetAppointmentByIDResponse, error) {
 	app, err := s.Store.GetAppointmentByID(ctx, model.AppointmentID(request.Id))
 	if err != nil {
 		return nil, err
 	}
 	Appointment := &pb.Appointment{
-		Id:          int32(app.ID),
-		DoctorId:    int32(app.DoctorID),
-		Date:        timestamppb.New(app.Date),
-		Time:        timestamppb.New(app.Time),
-		PatientId:   int32(derefUserID(app.PatientID)),
-		SecondName:  app.PatientSecondName,
-		FirstName:   app.PatientFirstName,
-		Surname:     deref(app.PatientSurname),
-		BirthDate:   timestamppb.New(app.PatientBirthDate),
-		Gender:      app.PatientGender,
-		PhoneNumber: app.PatientPhoneNumber,
-		Status:      app.Status,
-		CreatedAt:   timestamppb.New(app.CreatedAt),
-		UpdatedAt:   timestamppb.New(app.UpdatedAt),
+		Id:              int32(app.ID),
+		DoctorId:        int32(app.DoctorID),
+		Date:            timestamppb.New(app.Date),
+		Time:            timestamppb.New(app.Time),
+		PatientId:       int32(derefUserID(app.PatientID)),
+		SecondName:      app.PatientSecondName,
+		FirstName:       app.PatientFirstName,
+		Surname:         deref(app.PatientSurname),
+		BirthDate:       timestamppb.New(app.PatientBirthDate),
+		Gender:          app.PatientGender,
+		PhoneNumber:     app.PatientPhoneNumber,
+		Status:          app.Status,
+		CreatedAt:       timestamppb.New(app.CreatedAt),
+		UpdatedAt:       timestamppb.New(app.UpdatedAt),
+		DurationMinutes: int32(app.DurationMinutes),
 	}
 	return &pb.GetAppointmentByIDResponse{Appointment: Appointment}, nil
 }
@@ -904,8 +1229,8 @@ func derefBool(b *bool) bool {
 	return *b
 }
 
-func (s *Server) GetICDCodes(ctx context.Context, req *pb.EmptyRequest) (*pb.GetICDCodesResponse, error) {
-	items, err := s.Store.GetICDCodes(ctx)
+func (s *Server) GetICDCodes(ctx context.Context, req *pb.GetICDCodesRequest) (*pb.GetICDCodesResponse, error) {
+	items, total, err := s.Store.GetICDCodes(ctx, model.ICDFilter{Query: req.Query}, listPageFromPb(req.Page))
 	if err != nil {
 		return nil, err
 	}
@@ -918,7 +1243,7 @@ func (s *Server) GetICDCodes(ctx context.Context, req *pb.EmptyRequest) (*pb.Get
 		}
 		codes = append(codes, code)
 	}
-	return &pb.GetICDCodesResponse{IcdCode: codes}, nil
+	return &pb.GetICDCodesResponse{IcdCode: codes, Total: int32(total)}, nil
 }
 
 // GetPatientDiagnoses Получение диагнозов по айди визита
@@ -934,6 +1259,8 @@ func (s *Server) GetPatientDiagnoses(ctx context.Context, req *pb.GetByIdRequest
 			VisitId:   int32(item.VisitID),
 			IcdCodeId: int32(item.ICDCodeID),
 			Note:      item.DiagnosisNote,
+			Tooth:     toothToPb(item.Tooth),
+			Surfaces:  item.Surfaces,
 		}
 		pbDiagnoses = append(pbDiagnoses, diagnose)
 	}
@@ -1039,6 +1366,8 @@ func (s *Server) AddVisitServices(ctx context.Context, req *pb.AddVisitServicesR
 			VisitID:   model.VisitID(item.VisitId),
 			ServiceID: model.ServiceID(item.ServiceId),
 			Quantity:  int(item.Amount),
+			Tooth:     toothFromPb(item.Tooth),
+			Surfaces:  item.Surfaces,
 		}
 		services = append(services, service)
 	}
@@ -1056,6 +1385,8 @@ func (s *Server) AddPatientDiagnoses(ctx context.Context, req *pb.AddPatientDiag
 			VisitID:       model.VisitID(item.VisitId),
 			ICDCodeID:     model.ICDCodeID(item.IcdCodeId),
 			DiagnosisNote: item.Note,
+			Tooth:         toothFromPb(item.Tooth),
+			Surfaces:      item.Surfaces,
 		}
 		diagnoses = append(diagnoses, diagnose)
 	}
@@ -1119,163 +1450,920 @@ func (s *Server) AddOrUpdateVisitPayment(ctx context.Context, req *pb.AddOrUpdat
 	return &pb.DefaultResponse{}, nil
 }
 
-func (s *Server) GetVisitsPayments(ctx context.Context, request *pb.EmptyRequest) (*pb.GetVisitsPaymentsResponse, error) {
-	resp, err := s.Store.GetUnconfirmedVisitsPayments(ctx)
+// CompleteConsultation сохраняет приём целиком: визит, диагнозы, услуги, материалы и платёж
+func (s *Server) CompleteConsultation(ctx context.Context, req *pb.CompleteConsultationRequest) (*pb.CompleteConsultationResponse, error) {
+	key, err := uuid.Parse(req.IdempotencyKey)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "невалидный ключ идемпотентности: %v", err)
 	}
-	visitPayments := make([]*pb.VisitPayment, 0, len(resp))
-	for _, item := range resp {
-		visitPayment := &pb.VisitPayment{
-			VisitId: int32(item.VisitID),
-			Price:   item.Price,
-			Status:  item.Status,
-		}
-		visitPayments = append(visitPayments, visitPayment)
+
+	consultation := model.Consultation{
+		Visit: model.Visit{
+			AppointmentID: model.AppointmentID(req.AppointmentId),
+			PatientID:     model.UserID(req.PatientId),
+			DoctorID:      model.UserID(req.DoctorId),
+			Complaints:    req.Complaints,
+			TreatmentPlan: req.Treatment,
+		},
 	}
-	return &pb.GetVisitsPaymentsResponse{VisitPayment: visitPayments}, nil
-}
+	for _, item := range req.Diagnoses {
+		consultation.Diagnoses = append(consultation.Diagnoses, model.Diagnose{
+			ICDCodeID:     model.ICDCodeID(item.IcdCodeId),
+			DiagnosisNote: item.Note,
+			Tooth:         toothFromPb(item.Tooth),
+			Surfaces:      item.Surfaces,
+		})
+	}
+	for _, item := range req.Services {
+		consultation.Services = append(consultation.Services, model.AppointmentService{
+			ServiceID: model.ServiceID(item.ServiceId),
+			Quantity:  int(item.Amount),
+			Tooth:     toothFromPb(item.Tooth),
+			Surfaces:  item.Surfaces,
+		})
+	}
+	for _, item := range req.Materials {
+		consultation.Materials = append(consultation.Materials, model.AppointmentMaterial{
+			MaterialID:   model.MaterialID(item.MaterialId),
+			QuantityUsed: int(item.Amount),
+		})
+	}
+	for _, id := range req.PlanItemIds {
+		consultation.PlanItemIDs = append(consultation.PlanItemIDs, model.TreatmentPlanItemID(id))
+	}
+	consultation.Chart = toothRecordsFromPb(req.Chart)
 
-func (s *Server) GetVisitByID(ctx context.Context, request *pb.GetByIdRequest) (*pb.GetVisitByIDResponse, error) {
-	resp, err := s.Store.GetVisitByID(ctx, model.VisitID(request.Id))
+	visitID, total, err := s.Store.CompleteConsultation(ctx, key, consultation)
 	if err != nil {
 		return nil, err
 	}
-	visit := &pb.Visit{
-		Id:            int32(resp.ID),
-		AppointmentId: int32(resp.AppointmentID),
-		PatientId:     int32(resp.PatientID),
-		DoctorId:      int32(resp.DoctorID),
-		Complaints:    resp.Complaints,
-		Treatment:     resp.TreatmentPlan,
-		CreatedAt:     timestamppb.New(resp.CreatedAt),
-	}
-	return &pb.GetVisitByIDResponse{Visit: visit}, nil
+	return &pb.CompleteConsultationResponse{VisitId: int32(visitID), TotalPrice: total}, nil
 }
 
-func (s *Server) GetVisitMaterials(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetVisitMaterialsAndServicesResponse, error) {
-	resp, err := s.Store.GetAppointmentMaterials(ctx, model.VisitID(req.Id))
+func (s *Server) CreateTreatmentPlan(ctx context.Context, req *pb.CreateTreatmentPlanRequest) (*pb.TreatmentPlanResponse, error) {
+	plan := model.TreatmentPlan{
+		PatientID: model.UserID(req.PatientId),
+		Title:     req.Title,
+		Items:     treatmentPlanItemsFromPb(req.Items),
+	}
+	if req.DoctorId != 0 {
+		doctorID := model.UserID(req.DoctorId)
+		plan.DoctorID = &doctorID
+	}
+	created, err := s.Store.CreateTreatmentPlan(ctx, plan)
 	if err != nil {
 		return nil, err
 	}
-	var materials []*pb.VisitMaterialAndService
-	for _, item := range resp {
-		material := &pb.VisitMaterialAndService{
-			Id:       int32(item.ID),
-			VisitId:  int32(item.VisitID),
-			ItemId:   int32(item.MaterialID),
-			Quantity: int32(item.QuantityUsed),
-		}
-		materials = append(materials, material)
-	}
-	return &pb.GetVisitMaterialsAndServicesResponse{VisitMaterialsServices: materials}, nil
+	return &pb.TreatmentPlanResponse{Plan: treatmentPlanToPb(created)}, nil
 }
 
-func (s *Server) GetVisitServices(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetVisitMaterialsAndServicesResponse, error) {
-	resp, err := s.Store.GetAppointmentServices(ctx, model.VisitID(req.Id))
+func (s *Server) AddTreatmentPlanItems(ctx context.Context, req *pb.AddTreatmentPlanItemsRequest) (*pb.TreatmentPlanResponse, error) {
+	plan, err := s.Store.AddTreatmentPlanItems(ctx, model.TreatmentPlanID(req.PlanId), treatmentPlanItemsFromPb(req.Items))
 	if err != nil {
 		return nil, err
 	}
-	var services []*pb.VisitMaterialAndService
-	for _, item := range resp {
-		service := &pb.VisitMaterialAndService{
-			Id:       int32(item.ID),
-			VisitId:  int32(item.VisitID),
-			ItemId:   int32(item.ServiceID),
-			Quantity: int32(item.Quantity),
-		}
-		services = append(services, service)
+	return &pb.TreatmentPlanResponse{Plan: treatmentPlanToPb(plan)}, nil
+}
+
+func (s *Server) UpdateTreatmentPlanItemStatus(ctx context.Context, req *pb.UpdateTreatmentPlanItemStatusRequest) (*pb.TreatmentPlanResponse, error) {
+	plan, err := s.Store.UpdateTreatmentPlanItemStatus(ctx, model.TreatmentPlanItemID(req.ItemId), req.Status)
+	if err != nil {
+		return nil, err
 	}
-	return &pb.GetVisitMaterialsAndServicesResponse{VisitMaterialsServices: services}, nil
+	return &pb.TreatmentPlanResponse{Plan: treatmentPlanToPb(plan)}, nil
 }
 
-func (s *Server) GetMaterialByID(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetMaterialServiceByIDResponse, error) {
-	resp, err := s.Store.GetMaterialByID(ctx, model.MaterialID(req.Id))
+func (s *Server) AcceptTreatmentPlan(ctx context.Context, req *pb.AcceptTreatmentPlanRequest) (*pb.TreatmentPlanResponse, error) {
+	plan, err := s.Store.AcceptTreatmentPlan(ctx, model.TreatmentPlanID(req.PlanId), model.UserID(req.PatientId))
 	if err != nil {
 		return nil, err
 	}
-	return &pb.GetMaterialServiceByIDResponse{
-		Id:    int32(resp.ID),
-		Name:  resp.Name,
-		Price: int32(resp.Price),
-	}, nil
+	return &pb.TreatmentPlanResponse{Plan: treatmentPlanToPb(plan)}, nil
 }
 
-func (s *Server) GetServiceByID(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetMaterialServiceByIDResponse, error) {
-	resp, err := s.Store.GetServiceByID(ctx, model.ServiceID(req.Id))
+func (s *Server) CancelTreatmentPlan(ctx context.Context, req *pb.GetByIdRequest) (*pb.TreatmentPlanResponse, error) {
+	plan, err := s.Store.CancelTreatmentPlan(ctx, model.TreatmentPlanID(req.Id))
 	if err != nil {
 		return nil, err
 	}
-	return &pb.GetMaterialServiceByIDResponse{
-		Id:    int32(resp.ID),
-		Name:  resp.Name,
-		Price: int32(*resp.Price),
-	}, nil
+	return &pb.TreatmentPlanResponse{Plan: treatmentPlanToPb(plan)}, nil
 }
 
-func (s *Server) GetTotalPatients(ctx context.Context, req *pb.EmptyRequest) (*pb.IntResponse, error) {
-	resp, err := s.Store.GetTotalPatients(ctx)
+func (s *Server) GetTreatmentPlan(ctx context.Context, req *pb.GetByIdRequest) (*pb.TreatmentPlanResponse, error) {
+	plan, err := s.Store.GetTreatmentPlan(ctx, model.TreatmentPlanID(req.Id))
 	if err != nil {
 		return nil, err
 	}
-	return &pb.IntResponse{Int: int32(resp)}, nil
+	return &pb.TreatmentPlanResponse{Plan: treatmentPlanToPb(plan)}, nil
 }
-func (s *Server) GetTotalVisits(ctx context.Context, req *pb.EmptyRequest) (*pb.IntResponse, error) {
-	resp, err := s.Store.GetTotalVisits(ctx)
+
+func (s *Server) GetTreatmentPlanByItemID(ctx context.Context, req *pb.GetByIdRequest) (*pb.TreatmentPlanResponse, error) {
+	plan, err := s.Store.GetTreatmentPlanByItemID(ctx, model.TreatmentPlanItemID(req.Id))
 	if err != nil {
 		return nil, err
 	}
-	return &pb.IntResponse{Int: int32(resp)}, nil
+	return &pb.TreatmentPlanResponse{Plan: treatmentPlanToPb(plan)}, nil
 }
 
-func (s *Server) GetTopServices(ctx context.Context, req *pb.EmptyRequest) (*pb.ServiceStatsResponse, error) {
-	resp, err := s.Store.GetTopServices(ctx)
+func (s *Server) GetPatientTreatmentPlans(ctx context.Context, req *pb.GetByIdRequest) (*pb.TreatmentPlansResponse, error) {
+	plans, err := s.Store.GetPatientTreatmentPlans(ctx, model.UserID(req.Id))
 	if err != nil {
 		return nil, err
 	}
+	resp := &pb.TreatmentPlansResponse{}
+	for _, plan := range plans {
+		resp.Plans = append(resp.Plans, treatmentPlanToPb(plan))
+	}
+	return resp, nil
+}
 
-	var topServices []*pb.ServiceStats
-	for _, item := range resp {
-		service := &pb.ServiceStats{
-			Name:       item.Name,
-			UsageCount: int32(item.UsageCount),
+func treatmentPlanItemsFromPb(items []*pb.NewTreatmentPlanItem) []model.TreatmentPlanItem {
+	result := make([]model.TreatmentPlanItem, 0, len(items))
+	for _, item := range items {
+		planItem := model.TreatmentPlanItem{
+			Stage:     int(item.Stage),
+			ServiceID: model.ServiceID(item.ServiceId),
+			Quantity:  int(item.Quantity),
+			Note:      item.Note,
+			Tooth:     toothFromPb(item.Tooth),
 		}
-		topServices = append(topServices, service)
+		result = append(result, planItem)
+	}
+	return result
+}
+
+func treatmentPlanToPb(plan model.TreatmentPlan) *pb.TreatmentPlan {
+	item := &pb.TreatmentPlan{
+		Id:             int32(plan.ID),
+		PatientId:      int32(plan.PatientID),
+		Title:          plan.Title,
+		Status:         plan.Status,
+		CreatedAt:      timestamppb.New(plan.CreatedAt),
+		UpdatedAt:      timestamppb.New(plan.UpdatedAt),
+		EstimatedTotal: int32(plan.EstimatedTotal),
+		RemainingCost:  int32(plan.RemainingCost),
+	}
+	if plan.DoctorID != nil {
+		item.DoctorId = int32(*plan.DoctorID)
+	}
+	if plan.AcceptedAt != nil {
+		item.AcceptedAt = timestamppb.New(*plan.AcceptedAt)
+	}
+	for _, i := range plan.Items {
+		planItem := &pb.TreatmentPlanItem{
+			Id:             int32(i.ID),
+			PlanId:         int32(i.PlanID),
+			Stage:          int32(i.Stage),
+			ServiceId:      int32(i.ServiceID),
+			ServiceName:    i.ServiceName,
+			Quantity:       int32(i.Quantity),
+			EstimatedPrice: int32(i.EstimatedPrice),
+			Status:         i.Status,
+			Note:           i.Note,
+			Tooth:          toothToPb(i.Tooth),
+		}
+		if i.VisitID != nil {
+			planItem.VisitId = int32(*i.VisitID)
+		}
+		if i.CompletedAt != nil {
+			planItem.CompletedAt = timestamppb.New(*i.CompletedAt)
+		}
+		item.Items = append(item.Items, planItem)
 	}
-
-	return &pb.ServiceStatsResponse{ServiceStats: topServices}, nil
+	return item
 }
 
-func (s *Server) GetDoctorAvgVisit(ctx context.Context, req *pb.EmptyRequest) (*pb.DoctorAvgVisitResponse, error) {
-	resp, err := s.Store.GetDoctorWeeklyAverages(ctx)
+func (s *Server) RecordToothStates(ctx context.Context, req *pb.RecordToothStatesRequest) (*pb.DentalChartResponse, error) {
+	var doctorID *model.UserID
+	if req.DoctorId != 0 {
+		id := model.UserID(req.DoctorId)
+		doctorID = &id
+	}
+	chart, err := s.Store.RecordToothStates(ctx, model.UserID(req.PatientId), doctorID, toothRecordsFromPb(req.Records))
 	if err != nil {
 		return nil, err
 	}
+	return &pb.DentalChartResponse{Teeth: toothRecordsToPb(chart)}, nil
+}
 
-	var doctorAvgVisits []*pb.DoctorAvgVisit
-	for _, item := range resp {
-		avg := &pb.DoctorAvgVisit{
-			DoctorId:        int32(item.DoctorID),
-			AvgWeeklyVisits: float32(item.AvgWeeklyVisits),
-		}
-		doctorAvgVisits = append(doctorAvgVisits, avg)
+func (s *Server) GetDentalChart(ctx context.Context, req *pb.GetByIdRequest) (*pb.DentalChartResponse, error) {
+	chart, err := s.Store.GetDentalChart(ctx, model.UserID(req.Id))
+	if err != nil {
+		return nil, err
 	}
-
-	return &pb.DoctorAvgVisitResponse{Visits: doctorAvgVisits}, nil
+	return &pb.DentalChartResponse{Teeth: toothRecordsToPb(chart)}, nil
 }
 
-func (s *Server) GetDoctorAvgCheck(ctx context.Context, req *pb.EmptyRequest) (*pb.DoctorAvgCheckResponse, error) {
-	resp, err := s.Store.GetDoctorAvgCheck(ctx)
+func (s *Server) GetToothHistory(ctx context.Context, req *pb.GetToothHistoryRequest) (*pb.DentalChartResponse, error) {
+	records, err := s.Store.GetToothHistory(ctx, model.UserID(req.PatientId), int(req.Tooth))
 	if err != nil {
 		return nil, err
 	}
+	return &pb.DentalChartResponse{Teeth: toothRecordsToPb(records)}, nil
+}
 
-	var doctorAvgChecks []*pb.DoctorCheck
-	for _, item := range resp {
-		avg := &pb.DoctorCheck{
-			DoctorId: int32(item.DoctorID),
-			AvgCheck: float32(item.AvgCheck),
-		}
+func toothRecordsFromPb(records []*pb.ToothRecord) []model.ToothRecord {
+	result := make([]model.ToothRecord, 0, len(records))
+	for _, item := range records {
+		result = append(result, model.ToothRecord{
+			Tooth:    int(item.Tooth),
+			Status:   item.Status,
+			Surfaces: item.Surfaces,
+			Note:     item.Note,
+		})
+	}
+	return result
+}
+
+func toothRecordsToPb(records []model.ToothRecord) []*pb.ToothRecord {
+	result := make([]*pb.ToothRecord, 0, len(records))
+	for _, r := range records {
+		item := &pb.ToothRecord{
+			Id:         int32(r.ID),
+			PatientId:  int32(r.PatientID),
+			Tooth:      int32(r.Tooth),
+			Status:     r.Status,
+			Surfaces:   r.Surfaces,
+			Note:       r.Note,
+			RecordedAt: timestamppb.New(r.RecordedAt),
+		}
+		if r.VisitID != nil {
+			item.VisitId = int32(*r.VisitID)
+		}
+		if r.DoctorID != nil {
+			item.DoctorId = int32(*r.DoctorID)
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+// toothFromPb номер зуба из proto, где 0 означает «не относится к зубу»
+func toothFromPb(tooth int32) *int {
+	if tooth == 0 {
+		return nil
+	}
+	t := int(tooth)
+	return &t
+}
+
+func toothToPb(tooth *int) int32 {
+	if tooth == nil {
+		return 0
+	}
+	return int32(*tooth)
+}
+
+func (s *Server) SaveAnamnesis(ctx context.Context, req *pb.SaveAnamnesisRequest) (*pb.AnamnesisResponse, error) {
+	if req.Anamnesis == nil {
+		return nil, status.Error(codes.InvalidArgument, "анкета не передана")
+	}
+	item := req.Anamnesis
+	anamnesis := model.Anamnesis{
+		PatientID:          model.UserID(item.PatientId),
+		Medications:        item.Medications,
+		BloodThinners:      item.BloodThinners,
+		CardiacDisease:     item.CardiacDisease,
+		Hypertension:       item.Hypertension,
+		Diabetes:           item.Diabetes,
+		BleedingDisorder:   item.BleedingDisorder,
+		InfectiousDisease:  item.InfectiousDisease,
+		AnesthesiaReaction: item.AnesthesiaReaction,
+		Pregnant:           item.Pregnant,
+		Breastfeeding:      item.Breastfeeding,
+		Smoker:             item.Smoker,
+		OtherConditions:    item.OtherConditions,
+		ChangedByRole:      item.ChangedByRole,
+	}
+	if item.ChangedBy != 0 {
+		changedBy := model.UserID(item.ChangedBy)
+		anamnesis.ChangedBy = &changedBy
+	}
+	for _, a := range item.Allergies {
+		anamnesis.Allergies = append(anamnesis.Allergies, model.AnamnesisAllergy{
+			Allergen: a.Allergen,
+			Severity: a.Severity,
+			Reaction: a.Reaction,
+		})
+	}
+	saved, err := s.Store.SaveAnamnesis(ctx, anamnesis, int(req.BaseVersion))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AnamnesisResponse{Anamnesis: anamnesisToPb(saved)}, nil
+}
+
+func (s *Server) GetAnamnesis(ctx context.Context, req *pb.GetByIdRequest) (*pb.AnamnesisResponse, error) {
+	anamnesis, err := s.Store.GetAnamnesis(ctx, model.UserID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AnamnesisResponse{Anamnesis: anamnesisToPb(anamnesis)}, nil
+}
+
+func (s *Server) GetAnamnesisHistory(ctx context.Context, req *pb.GetByIdRequest) (*pb.AnamnesisHistoryResponse, error) {
+	versions, err := s.Store.GetAnamnesisHistory(ctx, model.UserID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.AnamnesisHistoryResponse{Versions: make([]*pb.Anamnesis, 0, len(versions))}
+	for _, v := range versions {
+		resp.Versions = append(resp.Versions, anamnesisToPb(v))
+	}
+	return resp, nil
+}
+
+func anamnesisToPb(a model.Anamnesis) *pb.Anamnesis {
+	item := &pb.Anamnesis{
+		Id:                 int32(a.ID),
+		PatientId:          int32(a.PatientID),
+		Version:            int32(a.Version),
+		Medications:        a.Medications,
+		BloodThinners:      a.BloodThinners,
+		CardiacDisease:     a.CardiacDisease,
+		Hypertension:       a.Hypertension,
+		Diabetes:           a.Diabetes,
+		BleedingDisorder:   a.BleedingDisorder,
+		InfectiousDisease:  a.InfectiousDisease,
+		AnesthesiaReaction: a.AnesthesiaReaction,
+		Pregnant:           a.Pregnant,
+		Breastfeeding:      a.Breastfeeding,
+		Smoker:             a.Smoker,
+		OtherConditions:    a.OtherConditions,
+		ChangedByRole:      a.ChangedByRole,
+	}
+	if a.ChangedBy != nil {
+		item.ChangedBy = int32(*a.ChangedBy)
+	}
+	if a.Version > 0 {
+		item.CreatedAt = timestamppb.New(a.CreatedAt)
+	}
+	for _, allergy := range a.Allergies {
+		item.Allergies = append(item.Allergies, &pb.AnamnesisAllergy{
+			Allergen: allergy.Allergen,
+			Severity: allergy.Severity,
+			Reaction: allergy.Reaction,
+		})
+	}
+	return item
+}
+
+func (s *Server) GetClinicalAlertRules(ctx context.Context, req *pb.GetClinicalAlertRulesRequest) (*pb.GetClinicalAlertRulesResponse, error) {
+	rules, err := s.Store.GetClinicalAlertRules(ctx, req.ActiveOnly)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetClinicalAlertRulesResponse{Rules: make([]*pb.ClinicalAlertRule, 0, len(rules))}
+	for _, rule := range rules {
+		item := &pb.ClinicalAlertRule{
+			Id:             int32(rule.ID),
+			Title:          rule.Title,
+			ConditionType:  rule.ConditionType,
+			ConditionValue: rule.ConditionValue,
+			TargetType:     rule.TargetType,
+			TargetName:     rule.TargetName,
+			Severity:       rule.Severity,
+			Message:        rule.Message,
+			IsActive:       rule.IsActive,
+			CreatedAt:      timestamppb.New(rule.CreatedAt),
+		}
+		if rule.MaterialID != nil {
+			item.MaterialId = int32(*rule.MaterialID)
+		}
+		if rule.ServiceID != nil {
+			item.ServiceId = int32(*rule.ServiceID)
+		}
+		resp.Rules = append(resp.Rules, item)
+	}
+	return resp, nil
+}
+
+func (s *Server) AddClinicalAlertRule(ctx context.Context, req *pb.ClinicalAlertRule) (*pb.AddClinicalAlertRuleResponse, error) {
+	id, err := s.Store.AddClinicalAlertRule(ctx, clinicalAlertRuleFromPb(req))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddClinicalAlertRuleResponse{Id: int32(id)}, nil
+}
+
+func (s *Server) UpdateClinicalAlertRule(ctx context.Context, req *pb.ClinicalAlertRule) (*pb.DefaultResponse, error) {
+	err := s.Store.UpdateClinicalAlertRule(ctx, clinicalAlertRuleFromPb(req))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) DeleteClinicalAlertRule(ctx context.Context, req *pb.DeleteRequest) (*pb.DefaultResponse, error) {
+	err := s.Store.DeleteClinicalAlertRule(ctx, model.ClinicalAlertRuleID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func clinicalAlertRuleFromPb(req *pb.ClinicalAlertRule) model.ClinicalAlertRule {
+	rule := model.ClinicalAlertRule{
+		ID:             model.ClinicalAlertRuleID(req.Id),
+		Title:          req.Title,
+		ConditionType:  req.ConditionType,
+		ConditionValue: req.ConditionValue,
+		TargetType:     req.TargetType,
+		Severity:       req.Severity,
+		Message:        req.Message,
+		IsActive:       req.IsActive,
+	}
+	if req.MaterialId != 0 {
+		materialID := model.MaterialID(req.MaterialId)
+		rule.MaterialID = &materialID
+	}
+	if req.ServiceId != 0 {
+		serviceID := model.ServiceID(req.ServiceId)
+		rule.ServiceID = &serviceID
+	}
+	return rule
+}
+
+// GetMedicalRecord Медицинская карта пациента для выгрузки
+func (s *Server) GetMedicalRecord(ctx context.Context, req *pb.GetByIdRequest) (*pb.MedicalRecord, error) {
+	record, err := s.Store.GetMedicalRecord(ctx, model.UserID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	p := record.Patient
+	resp := &pb.MedicalRecord{
+		Patient: &pb.Patient{
+			UserId:      int32(p.ID),
+			FirstName:   p.FirstName,
+			SecondName:  p.SecondName,
+			Surname:     deref(p.Surname),
+			Email:       deref(p.Email),
+			BirthDate:   timestamppb.New(p.BirthDate),
+			PhoneNumber: deref(p.PhoneNumber),
+			Gender:      p.Gender,
+		},
+		Anamnesis: anamnesisToPb(record.Anamnesis),
+	}
+	for _, note := range record.Notes {
+		resp.Notes = append(resp.Notes, &pb.PatientAllergiesChronics{
+			Id:        int32(note.ID),
+			PatientId: int32(note.PatientID),
+			Type:      note.Type,
+			Title:     note.Title,
+		})
+	}
+	for _, v := range record.Visits {
+		visit := &pb.MedicalRecordVisit{
+			Id:         int32(v.ID),
+			DoctorId:   int32(derefUserID(v.DoctorID)),
+			Doctor:     v.Doctor,
+			Complaints: v.Complaints,
+			Treatment:  v.TreatmentPlan,
+			CreatedAt:  timestamppb.New(v.CreatedAt),
+		}
+		for _, d := range v.Diagnoses {
+			visit.Diagnoses = append(visit.Diagnoses, &pb.MedicalRecordDiagnosis{
+				Code:     d.Code,
+				Name:     d.Name,
+				Note:     d.Note,
+				Tooth:    int32(derefInt(d.Tooth)),
+				Surfaces: d.Surfaces,
+			})
+		}
+		for _, srv := range v.Services {
+			visit.Services = append(visit.Services, &pb.MedicalRecordService{
+				ServiceId: int32(srv.ServiceID),
+				Name:      srv.Name,
+				Quantity:  int32(srv.Quantity),
+				Tooth:     int32(derefInt(srv.Tooth)),
+				Surfaces:  srv.Surfaces,
+			})
+		}
+		for _, m := range v.Materials {
+			visit.Materials = append(visit.Materials, &pb.MedicalRecordMaterial{
+				MaterialId: int32(m.MaterialID),
+				Name:       m.Name,
+				Quantity:   int32(m.Quantity),
+			})
+		}
+		resp.Visits = append(resp.Visits, visit)
+	}
+	for _, doc := range record.Documents {
+		info := &pb.DocumentInfo{
+			Id:          doc.ID.String(),
+			FileName:    doc.FileName,
+			Description: doc.Description,
+			Modality:    doc.Modality,
+			CreatedAt:   timestamppb.New(doc.CreatedAt),
+		}
+		if doc.StudyDate != nil {
+			info.StudyDate = doc.StudyDate.Format("2006-01-02")
+		}
+		resp.Documents = append(resp.Documents, info)
+	}
+	return resp, nil
+}
+
+// GetDoctorPatientRelation Лечит ли врач пациента и есть ли у него допуск к карте; по нему сервисы врача
+// и пациента решают, можно ли врачу открыть карту
+func (s *Server) GetDoctorPatientRelation(ctx context.Context, req *pb.DoctorPatientRelationRequest) (*pb.DoctorPatientRelation, error) {
+	rel, err := s.Store.GetDoctorPatientRelation(ctx, model.UserID(req.DoctorId), model.UserID(req.PatientId), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DoctorPatientRelation{Treating: rel.Treating, Granted: rel.Granted}, nil
+}
+
+func (s *Server) GetPatientAccessGrants(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetPatientAccessGrantsResponse, error) {
+	grants, err := s.Store.GetPatientAccessGrants(ctx, model.UserID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetPatientAccessGrantsResponse{Grants: make([]*pb.PatientAccessGrant, 0, len(grants))}
+	for _, g := range grants {
+		item := &pb.PatientAccessGrant{
+			Id:        int32(g.ID),
+			PatientId: int32(g.PatientID),
+			DoctorId:  int32(g.DoctorID),
+			Doctor:    g.Doctor,
+			Reason:    g.Reason,
+			CreatedAt: timestamppb.New(g.CreatedAt),
+		}
+		if g.GrantedBy != nil {
+			item.GrantedBy = int32(*g.GrantedBy)
+		}
+		if g.ExpiresAt != nil {
+			item.ExpiresAt = timestamppb.New(*g.ExpiresAt)
+		}
+		if g.RevokedAt != nil {
+			item.RevokedAt = timestamppb.New(*g.RevokedAt)
+		}
+		resp.Grants = append(resp.Grants, item)
+	}
+	return resp, nil
+}
+
+func (s *Server) AddPatientAccessGrant(ctx context.Context, req *pb.PatientAccessGrant) (*pb.AddPatientAccessGrantResponse, error) {
+	grant := model.PatientAccessGrant{
+		PatientID: model.UserID(req.PatientId),
+		DoctorID:  model.UserID(req.DoctorId),
+		Reason:    req.Reason,
+	}
+	if req.GrantedBy != 0 {
+		grantedBy := model.UserID(req.GrantedBy)
+		grant.GrantedBy = &grantedBy
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		grant.ExpiresAt = &expiresAt
+	}
+	id, err := s.Store.AddPatientAccessGrant(ctx, grant)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddPatientAccessGrantResponse{Id: int32(id)}, nil
+}
+
+func (s *Server) RevokePatientAccessGrant(ctx context.Context, req *pb.DeleteRequest) (*pb.DefaultResponse, error) {
+	err := s.Store.RevokePatientAccessGrant(ctx, model.PatientAccessGrantID(req.Id), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) IssueInvoice(ctx context.Context, req *pb.GetByIdRequest) (*pb.InvoiceResponse, error) {
+	invoice, err := s.Store.IssueInvoice(ctx, model.VisitID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InvoiceResponse{Invoice: invoiceToPb(invoice)}, nil
+}
+
+func (s *Server) GetInvoice(ctx context.Context, req *pb.GetByIdRequest) (*pb.InvoiceResponse, error) {
+	invoice, err := s.Store.GetInvoice(ctx, model.InvoiceID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InvoiceResponse{Invoice: invoiceToPb(invoice)}, nil
+}
+
+func (s *Server) SetInvoiceDiscount(ctx context.Context, req *pb.SetInvoiceDiscountRequest) (*pb.InvoiceResponse, error) {
+	var discount *model.Discount
+	if req.Kind != "" {
+		discount = &model.Discount{Kind: req.Kind, Value: int(req.Value), Reason: req.Reason}
+	}
+	invoice, err := s.Store.SetInvoiceDiscount(ctx, model.InvoiceID(req.InvoiceId), discount)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InvoiceResponse{Invoice: invoiceToPb(invoice)}, nil
+}
+
+func (s *Server) AddInvoicePayment(ctx context.Context, req *pb.AddInvoicePaymentRequest) (*pb.InvoiceResponse, error) {
+	invoice, err := s.Store.AddInvoicePayment(ctx, model.InvoiceID(req.InvoiceId), model.InvoicePayment{
+		Amount:  int(req.Amount),
+		Method:  req.Method,
+		Comment: req.Comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InvoiceResponse{Invoice: invoiceToPb(invoice)}, nil
+}
+
+func (s *Server) RefundInvoicePayment(ctx context.Context, req *pb.RefundInvoicePaymentRequest) (*pb.InvoiceResponse, error) {
+	invoice, err := s.Store.RefundInvoicePayment(ctx, model.InvoicePaymentID(req.PaymentId), int(req.Amount), req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InvoiceResponse{Invoice: invoiceToPb(invoice)}, nil
+}
+
+func (s *Server) GetPatientBalance(ctx context.Context, req *pb.GetByIdRequest) (*pb.PatientBalanceResponse, error) {
+	balance, err := s.Store.GetPatientBalance(ctx, model.UserID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.PatientBalanceResponse{
+		PatientId:   int32(balance.PatientID),
+		Invoiced:    int32(balance.Invoiced),
+		Paid:        int32(balance.Paid),
+		Outstanding: int32(balance.Outstanding),
+	}
+	for _, invoice := range balance.OpenInvoices {
+		resp.OpenInvoices = append(resp.OpenInvoices, invoiceToPb(invoice))
+	}
+	return resp, nil
+}
+
+func (s *Server) ReserveInvoiceDocument(ctx context.Context, req *pb.GetByIdRequest) (*pb.BillingDocument, error) {
+	doc, err := s.Store.ReserveInvoiceDocument(ctx, model.InvoiceID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return billingDocumentToPb(doc), nil
+}
+
+func (s *Server) ReserveReceiptDocument(ctx context.Context, req *pb.GetByIdRequest) (*pb.BillingDocument, error) {
+	doc, err := s.Store.ReserveReceiptDocument(ctx, model.InvoicePaymentID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return billingDocumentToPb(doc), nil
+}
+
+func (s *Server) AttachBillingDocument(ctx context.Context, req *pb.AttachBillingDocumentRequest) (*pb.DefaultResponse, error) {
+	documentID, err := uuid.Parse(req.DocumentId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "невалидный ID документа: %v", err)
+	}
+	if err := s.Store.AttachBillingDocument(ctx, int(req.Id), documentID); err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func billingDocumentToPb(doc model.BillingDocument) *pb.BillingDocument {
+	item := &pb.BillingDocument{
+		Id:        int32(doc.ID),
+		Kind:      doc.Kind,
+		Number:    int32(doc.Number),
+		InvoiceId: int32(doc.InvoiceID),
+		CreatedAt: timestamppb.New(doc.CreatedAt),
+	}
+	if doc.PaymentID != nil {
+		item.PaymentId = int32(*doc.PaymentID)
+	}
+	if doc.DocumentID != nil {
+		item.DocumentId = doc.DocumentID.String()
+	}
+	return item
+}
+
+func invoiceToPb(invoice model.Invoice) *pb.Invoice {
+	item := &pb.Invoice{
+		Id:             int32(invoice.ID),
+		VisitId:        int32(invoice.VisitID),
+		Subtotal:       int32(invoice.Subtotal),
+		DiscountValue:  int32(invoice.DiscountValue),
+		DiscountAmount: int32(invoice.DiscountAmount),
+		Total:          int32(invoice.Total),
+		Paid:           int32(invoice.Paid),
+		Balance:        int32(invoice.Balance()),
+		Status:         invoice.Status,
+		CreatedAt:      timestamppb.New(invoice.CreatedAt),
+	}
+	if invoice.PatientID != nil {
+		item.PatientId = int32(*invoice.PatientID)
+	}
+	if invoice.DiscountKind != nil {
+		item.DiscountKind = *invoice.DiscountKind
+	}
+	if invoice.DiscountReason != nil {
+		item.DiscountReason = *invoice.DiscountReason
+	}
+	for _, line := range invoice.Lines {
+		item.Lines = append(item.Lines, &pb.InvoiceLine{
+			Id:           int32(line.ID),
+			Kind:         string(line.Kind),
+			ItemId:       int32(line.ItemID),
+			Name:         line.Name,
+			Quantity:     int32(line.Quantity),
+			PricePerUnit: int32(line.PricePerUnit),
+			TotalPrice:   int32(line.TotalPrice),
+		})
+	}
+	for _, payment := range invoice.Payments {
+		p := &pb.InvoicePayment{
+			Id:        int32(payment.ID),
+			Kind:      payment.Kind,
+			Amount:    int32(payment.Amount),
+			Method:    payment.Method,
+			Comment:   payment.Comment,
+			CreatedAt: timestamppb.New(payment.CreatedAt),
+		}
+		if payment.RefundOf != nil {
+			p.RefundOf = int32(*payment.RefundOf)
+		}
+		item.Payments = append(item.Payments, p)
+	}
+	return item
+}
+
+func (s *Server) GetVisitsPayments(ctx context.Context, request *pb.GetVisitsPaymentsRequest) (*pb.GetVisitsPaymentsResponse, error) {
+	resp, total, err := s.Store.GetUnconfirmedVisitsPayments(ctx, listPageFromPb(request.Page))
+	if err != nil {
+		return nil, err
+	}
+	visitPayments := make([]*pb.VisitPayment, 0, len(resp))
+	for _, item := range resp {
+		visitPayment := &pb.VisitPayment{
+			VisitId: int32(item.VisitID),
+			Price:   item.Price,
+			Status:  item.Status,
+		}
+		visitPayments = append(visitPayments, visitPayment)
+	}
+	return &pb.GetVisitsPaymentsResponse{VisitPayment: visitPayments, Total: int32(total)}, nil
+}
+
+func (s *Server) GetVisitByID(ctx context.Context, request *pb.GetByIdRequest) (*pb.GetVisitByIDResponse, error) {
+	resp, err := s.Store.GetVisitByID(ctx, model.VisitID(request.Id))
+	if err != nil {
+		return nil, err
+	}
+	visit := &pb.Visit{
+		Id:            int32(resp.ID),
+		AppointmentId: int32(resp.AppointmentID),
+		PatientId:     int32(resp.PatientID),
+		DoctorId:      int32(resp.DoctorID),
+		Complaints:    resp.Complaints,
+		Treatment:     resp.TreatmentPlan,
+		CreatedAt:     timestamppb.New(resp.CreatedAt),
+	}
+	return &pb.GetVisitByIDResponse{Visit: visit}, nil
+}
+
+func (s *Server) GetVisitMaterials(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetVisitMaterialsAndServicesResponse, error) {
+	resp, err := s.Store.GetAppointmentMaterials(ctx, model.VisitID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	var materials []*pb.VisitMaterialAndService
+	for _, item := range resp {
+		material := &pb.VisitMaterialAndService{
+			Id:           int32(item.ID),
+			VisitId:      int32(item.VisitID),
+			ItemId:       int32(item.MaterialID),
+			Quantity:     int32(item.QuantityUsed),
+			PricePerUnit: optionalInt32(item.PricePerUnit),
+			TotalPrice:   optionalInt32(item.TotalPrice),
+		}
+		materials = append(materials, material)
+	}
+	return &pb.GetVisitMaterialsAndServicesResponse{VisitMaterialsServices: materials}, nil
+}
+
+func (s *Server) GetVisitServices(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetVisitMaterialsAndServicesResponse, error) {
+	resp, err := s.Store.GetAppointmentServices(ctx, model.VisitID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	var services []*pb.VisitMaterialAndService
+	for _, item := range resp {
+		service := &pb.VisitMaterialAndService{
+			Id:           int32(item.ID),
+			VisitId:      int32(item.VisitID),
+			ItemId:       int32(item.ServiceID),
+			Quantity:     int32(item.Quantity),
+			PricePerUnit: optionalInt32(item.PricePerUnit),
+			TotalPrice:   optionalInt32(item.TotalPrice),
+			Tooth:        toothToPb(item.Tooth),
+			Surfaces:     item.Surfaces,
+		}
+		services = append(services, service)
+	}
+	return &pb.GetVisitMaterialsAndServicesResponse{VisitMaterialsServices: services}, nil
+}
+
+// optionalInt32 значение для необязательного поля proto
+func optionalInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	n := int32(*v)
+	return &n
+}
+
+func (s *Server) GetMaterialByID(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetMaterialServiceByIDResponse, error) {
+	resp, err := s.Store.GetMaterialByID(ctx, model.MaterialID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMaterialServiceByIDResponse{
+		Id:    int32(resp.ID),
+		Name:  resp.Name,
+		Price: int32(resp.Price),
+	}, nil
+}
+
+func (s *Server) GetServiceByID(ctx context.Context, req *pb.GetByIdRequest) (*pb.GetMaterialServiceByIDResponse, error) {
+	resp, err := s.Store.GetServiceByID(ctx, model.ServiceID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetMaterialServiceByIDResponse{
+		Id:              int32(resp.ID),
+		Name:            resp.Name,
+		Price:           int32(*resp.Price),
+		DurationMinutes: int32(resp.DurationMinutes),
+	}, nil
+}
+
+func (s *Server) GetTotalPatients(ctx context.Context, req *pb.EmptyRequest) (*pb.IntResponse, error) {
+	resp, err := s.Store.GetTotalPatients(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.IntResponse{Int: int32(resp)}, nil
+}
+func (s *Server) GetTotalVisits(ctx context.Context, req *pb.EmptyRequest) (*pb.IntResponse, error) {
+	resp, err := s.Store.GetTotalVisits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.IntResponse{Int: int32(resp)}, nil
+}
+
+func (s *Server) GetTopServices(ctx context.Context, req *pb.EmptyRequest) (*pb.ServiceStatsResponse, error) {
+	resp, err := s.Store.GetTopServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var topServices []*pb.ServiceStats
+	for _, item := range resp {
+		service := &pb.ServiceStats{
+			Name:       item.Name,
+			UsageCount: int32(item.UsageCount),
+		}
+		topServices = append(topServices, service)
+	}
+
+	return &pb.ServiceStatsResponse{ServiceStats: topServices}, nil
+}
+
+func (s *Server) GetDoctorAvgVisit(ctx context.Context, req *pb.EmptyRequest) (*pb.DoctorAvgVisitResponse, error) {
+	resp, err := s.Store.GetDoctorWeeklyAverages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var doctorAvgVisits []*pb.DoctorAvgVisit
+	for _, item := range resp {
+		avg := &pb.DoctorAvgVisit{
+			DoctorId:        int32(item.DoctorID),
+			AvgWeeklyVisits: float32(item.AvgWeeklyVisits),
+		}
+		doctorAvgVisits = append(doctorAvgVisits, avg)
+	}
+
+	return &pb.DoctorAvgVisitResponse{Visits: doctorAvgVisits}, nil
+}
+
+func (s *Server) GetDoctorAvgCheck(ctx context.Context, req *pb.EmptyRequest) (*pb.DoctorAvgCheckResponse, error) {
+	resp, err := s.Store.GetDoctorAvgCheck(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var doctorAvgChecks []*pb.DoctorCheck
+	for _, item := range resp {
+		avg := &pb.DoctorCheck{
+			DoctorId: int32(item.DoctorID),
+			AvgCheck: float32(item.AvgCheck),
+		}
 		doctorAvgChecks = append(doctorAvgChecks, avg)
 	}
 
@@ -1626,66 +2714,268 @@ func (s *Server) GetDiagnoseByVisitID(ctx context.Context, request *pb.GetByIDRe
 			VisitId:   int32(item.VisitID),
 			IcdCodeId: int32(item.ICDCodeID),
 			Note:      item.DiagnosisNote,
+			Tooth:     toothToPb(item.Tooth),
+			Surfaces:  item.Surfaces,
 		}
 		diagnoses = append(diagnoses, diagnose)
 	}
 	return &pb.GetDiagnoseByVisitIDResponse{Diagnose: diagnoses}, nil
 }
 
-func (s *Server) SaveDocument(ctx context.Context, req *pb.SaveDocumentRequest) (*pb.SaveDocumentResponse, error) {
-	docID := uuid.New()
+// maxDocumentSize наибольший размер загружаемого документа, documentChunkSize — размер части при скачивании
+const (
+	maxDocumentSize   = 4 << 30
+	documentChunkSize = 1 << 20
+)
 
-	// Сохраняем файл
-	storagePath, err := s.FS.SaveFile(req.PatientId, req.FileName, req.FileContent)
+func (s *Server) StartDocumentUpload(ctx context.Context, req *pb.StartDocumentUploadRequest) (*pb.StartDocumentUploadResponse, error) {
+	patientID, err := strconv.Atoi(req.PatientId)
 	if err != nil {
-		return nil, fmt.Errorf("не удалось сохранить файл: %w", err)
+		return nil, status.Errorf(codes.InvalidArgument, "невалидный ID пациента: %v", err)
 	}
-	jpgPath, err := s.FS.SaveFile(req.PatientId, req.PreviewFileName, req.PreviewJpeg)
-	if err != nil {
-		return nil, fmt.Errorf("не удалось сохранить файл предпросмотра: %w", err)
+	if req.Size <= 0 || req.Size > maxDocumentSize {
+		return nil, status.Errorf(codes.InvalidArgument, "размер документа должен быть от 1 байта до %d МБ", maxDocumentSize>>20)
 	}
 
-	// Сохраняем метаданные
-	patientID, _ := strconv.Atoi(req.PatientId)
-	studyDate := req.StudyDate.AsTime()
-	doc := model.PatientDocument{
-		ID:          docID,
+	upload := model.DocumentUpload{
+		ID:          uuid.New(),
 		PatientID:   model.UserID(patientID),
 		FileName:    req.FileName,
 		Modality:    req.Modality,
-		StudyDate:   &studyDate,
 		Description: req.Description,
-		StoragePath: storagePath,
-		PreviewPath: jpgPath,
-		CreatedAt:   time.Now(),
+		SizeBytes:   req.Size,
+	}
+	if req.StudyDate != nil {
+		studyDate := req.StudyDate.AsTime()
+		upload.StudyDate = &studyDate
+	}
+	if len(req.PreviewJpeg) > 0 {
+		preview, err := blob.Write(ctx, s.Docs, bytes.NewReader(req.PreviewJpeg))
+		if err != nil {
+			return nil, fmt.Errorf("не удалось сохранить файл предпросмотра: %w", err)
+		}
+		upload.PreviewPath = preview.Key
+	}
+	if err := s.Store.AddDocumentUpload(ctx, upload); err != nil {
+		return nil, err
+	}
+	return &pb.StartDocumentUploadResponse{UploadId: upload.ID.String()}, nil
+}
+
+// UploadDocument принимает части файла по порядку. Когда принят весь файл, он переносится в хранилище
+// документов и сохраняется документ; его ID возвращается в ответе
+func (s *Server) UploadDocument(stream pb.StorageService_UploadDocumentServer) error {
+	ctx := stream.Context()
+	chunk, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "поток загрузки пуст")
+	}
+	if err != nil {
+		return err
+	}
+	upload, err := s.documentUpload(ctx, chunk.UploadId)
+	if err != nil {
+		return err
+	}
+	if upload.DocumentID != nil {
+		return stream.SendAndClose(&pb.DocumentUploadStatus{Received: upload.SizeBytes, Size: upload.SizeBytes, DocumentId: upload.DocumentID.String()})
+	}
+
+	w, err := s.Uploads.Writer(upload.ID.String())
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	for {
+		if chunk.UploadId != upload.ID.String() {
+			return status.Error(codes.InvalidArgument, "части разных загрузок в одном потоке")
+		}
+		if w.Size()+int64(len(chunk.Data)) > upload.SizeBytes {
+			return status.Errorf(codes.InvalidArgument, "передано больше объявленного размера %d байт", upload.SizeBytes)
+		}
+		err := w.Write(chunk.Offset, chunk.Data)
+		if err != nil {
+			return err
+		}
+
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	resp := &pb.DocumentUploadStatus{Received: w.Size(), Size: upload.SizeBytes}
+	if w.Size() == upload.SizeBytes {
+		docID, err := s.completeDocumentUpload(ctx, upload)
+		if err != nil {
+			return err
+		}
+		resp.DocumentId = docID.String()
+	}
+	return stream.SendAndClose(resp)
+}
+
+func (s *Server) GetDocumentUploadStatus(ctx context.Context, req *pb.DocumentUploadStatusRequest) (*pb.DocumentUploadStatus, error) {
+	upload, err := s.documentUpload(ctx, req.UploadId)
+	if err != nil {
+		return nil, err
+	}
+	if upload.DocumentID != nil {
+		return &pb.DocumentUploadStatus{Received: upload.SizeBytes, Size: upload.SizeBytes, DocumentId: upload.DocumentID.String()}, nil
+	}
+	received, err := s.Uploads.Received(upload.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DocumentUploadStatus{Received: received, Size: upload.SizeBytes}, nil
+}
+
+func (s *Server) documentUpload(ctx context.Context, uploadID string) (model.DocumentUpload, error) {
+	id, err := uuid.Parse(uploadID)
+	if err != nil {
+		return model.DocumentUpload{}, status.Errorf(codes.InvalidArgument, "невалидный ID загрузки: %v", err)
+	}
+	upload, err := s.Store.GetDocumentUpload(ctx, id)
+	return upload, err
+}
+
+// completeDocumentUpload переносит принятый файл в хранилище документов и сохраняет документ
+func (s *Server) completeDocumentUpload(ctx context.Context, upload model.DocumentUpload) (uuid.UUID, error) {
+	f, err := s.Uploads.Open(upload.ID.String())
+	if err != nil {
+		return uuid.Nil, err
 	}
-	if err := s.Store.SaveDocument(ctx, &doc); err != nil {
-		return nil, fmt.Errorf("не удалось сохранить метаданные документа: %w", err)
+	defer f.Close()
+	file, err := blob.Write(ctx, s.Docs, f)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("не удалось сохранить файл: %w", err)
 	}
 
-	return &pb.SaveDocumentResponse{DocumentId: docID.String()}, nil
+	doc := model.PatientDocument{
+		ID:          uuid.New(),
+		PatientID:   upload.PatientID,
+		FileName:    upload.FileName,
+		Modality:    upload.Modality,
+		StudyDate:   upload.StudyDate,
+		Description: upload.Description,
+		StoragePath: file.Key,
+		PreviewPath: upload.PreviewPath,
+		Checksum:    &file.Checksum,
+		SizeBytes:   &file.Size,
+		CreatedAt:   time.Now(),
+	}
+	if err := s.Store.CompleteDocumentUpload(ctx, upload.ID, &doc); err != nil {
+		return uuid.Nil, fmt.Errorf("не удалось сохранить метаданные документа: %w", err)
+	}
+	if err := s.Uploads.Remove(upload.ID.String()); err != nil {
+		log.Printf("загрузка %s: %v", upload.ID, err)
+	}
+	return doc.ID, nil
 }
 
-func (s *Server) DownloadDocument(ctx context.Context, req *pb.DownloadDocumentRequest) (*pb.DownloadDocumentResponse, error) {
+// GetDocumentMetadata Метаданные документа без содержимого: по patient_id сервисы проверяют, чей это документ
+func (s *Server) GetDocumentMetadata(ctx context.Context, req *pb.GetDocumentMetadataRequest) (*pb.GetDocumentMetadataResponse, error) {
 	docID, err := uuid.Parse(req.DocumentId)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "невалидный UUID: %v", err)
 	}
+	doc, err := s.Store.GetDocumentByID(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetDocumentMetadataResponse{
+		DocumentId:  doc.ID.String(),
+		PatientId:   strconv.Itoa(int(doc.PatientID)),
+		FileName:    doc.FileName,
+		Modality:    doc.Modality,
+		Description: doc.Description,
+		StoragePath: doc.StoragePath,
+		PreviewPath: doc.PreviewPath,
+		CreatedAt:   timestamppb.New(doc.CreatedAt),
+	}
+	if doc.StudyDate != nil {
+		resp.StudyDate = timestamppb.New(*doc.StudyDate)
+	}
+	return resp, nil
+}
+
+// DownloadDocument отдаёт файл частями начиная с байта req.Offset; имя, размер и контрольная сумма
+// передаются в первом сообщении
+func (s *Server) DownloadDocument(req *pb.DownloadDocumentRequest, stream pb.StorageService_DownloadDocumentServer) error {
+	ctx := stream.Context()
+	docID, err := uuid.Parse(req.DocumentId)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "невалидный UUID: %v", err)
+	}
 
 	doc, err := s.Store.GetDocumentByID(ctx, docID)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "документ не найден: %v", err)
+		return err
+	}
+	size, err := documentSize(doc)
+	if err != nil {
+		return status.Errorf(codes.Internal, "не удалось определить размер файла: %v", err)
+	}
+	if req.Offset < 0 || req.Offset > size {
+		return status.Errorf(codes.OutOfRange, "смещение %d за пределами файла размером %d байт", req.Offset, size)
 	}
 
-	data, fileName, err := s.FS.GetDocumentFile(doc)
+	rc, err := s.openDocument(ctx, doc, req.Offset)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "не удалось прочитать файл: %v", err)
+		return status.Errorf(codes.Internal, "не удалось открыть файл: %v", err)
 	}
+	defer rc.Close()
 
-	return &pb.DownloadDocumentResponse{
-		FileName:    fileName,
-		FileContent: data,
-	}, nil
+	msg := &pb.DownloadDocumentChunk{FileName: doc.FileName, Size: size, Checksum: deref(doc.Checksum)}
+	buf := make([]byte, documentChunkSize)
+	for first := true; ; first = false {
+		n, err := io.ReadFull(rc, buf)
+		if n > 0 || first {
+			msg.Data = buf[:n]
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+			msg = &pb.DownloadDocumentChunk{}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "не удалось прочитать файл: %v", err)
+		}
+	}
+}
+
+// openDocument поток содержимого документа с байта offset с проверкой контрольной суммы. Документы,
+// ещё не перенесённые инструментом cmd/migratedocs, читаются по старому пути на диске
+func (s *Server) openDocument(ctx context.Context, doc *model.PatientDocument, offset int64) (io.ReadCloser, error) {
+	if doc.Checksum == nil {
+		f, err := os.Open(doc.StoragePath)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			f.Close()
+			return nil, err
+		}
+		return f, nil
+	}
+	return blob.Open(ctx, s.Docs, doc.StoragePath, *doc.Checksum, offset)
+}
+
+func documentSize(doc *model.PatientDocument) (int64, error) {
+	if doc.SizeBytes != nil {
+		return *doc.SizeBytes, nil
+	}
+	info, err := os.Stat(doc.StoragePath)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
 }
 
 func (s *Server) GetDocumentsByPatientID(ctx context.Context, req *pb.GetDocumentsRequest) (*pb.GetDocumentsResponse, error) {
@@ -1732,3 +3022,323 @@ func (s *Server) GetAdminByID(ctx context.Context, request *pb.GetByIDRequest) (
 	}
 	return &pb.GetAdminByIDResponse{Admin: admin}, nil
 }
+
+func (s *Server) AddWaitingListEntry(ctx context.Context, req *pb.AddWaitingListEntryRequest) (*pb.AddWaitingListEntryResponse, error) {
+	entry := model.WaitingListEntry{
+		PatientID: model.UserID(req.Entry.PatientId),
+		DateFrom:  req.Entry.DateFrom.AsTime(),
+		DateTo:    req.Entry.DateTo.AsTime(),
+	}
+	if req.Entry.DoctorId != 0 {
+		doctorID := model.UserID(req.Entry.DoctorId)
+		entry.DoctorID = &doctorID
+	}
+	if req.Entry.SpecializationId != 0 {
+		specID := int(req.Entry.SpecializationId)
+		entry.SpecializationID = &specID
+	}
+	id, err := s.Store.AddWaitingListEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddWaitingListEntryResponse{Id: int32(id)}, nil
+}
+
+func (s *Server) GetWaitingListByPatientID(ctx context.Context, req *pb.GetByIDRequest) (*pb.GetWaitingListResponse, error) {
+	entries, err := s.Store.GetWaitingListByPatientID(ctx, model.UserID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	offers, err := s.Store.GetWaitingListOffersByPatientID(ctx, model.UserID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetWaitingListResponse{
+		Entries: make([]*pb.WaitingListEntry, 0, len(entries)),
+		Offers:  make([]*pb.WaitingListOffer, 0, len(offers)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &pb.WaitingListEntry{
+			Id:               int32(entry.ID),
+			PatientId:        int32(entry.PatientID),
+			DoctorId:         int32(derefUserID(entry.DoctorID)),
+			SpecializationId: int32(derefInt(entry.SpecializationID)),
+			DateFrom:         timestamppb.New(entry.DateFrom),
+			DateTo:           timestamppb.New(entry.DateTo),
+			Status:           entry.Status,
+			CreatedAt:        timestamppb.New(entry.CreatedAt),
+		})
+	}
+	for _, offer := range offers {
+		resp.Offers = append(resp.Offers, &pb.WaitingListOffer{
+			Id:              offer.ID.String(),
+			EntryId:         int32(offer.EntryID),
+			DoctorId:        int32(offer.DoctorID),
+			Date:            timestamppb.New(offer.Date),
+			Time:            timestamppb.New(offer.Time),
+			DurationMinutes: int32(offer.DurationMinutes),
+			Status:          offer.Status,
+			ExpiresAt:       timestamppb.New(offer.ExpiresAt),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) CancelWaitingListEntry(ctx context.Context, req *pb.CancelWaitingListEntryRequest) (*pb.DefaultResponse, error) {
+	err := s.Store.CancelWaitingListEntry(ctx, int(req.Id), model.UserID(req.PatientId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) AcceptWaitingListOffer(ctx context.Context, req *pb.AcceptWaitingListOfferRequest) (*pb.AcceptWaitingListOfferResponse, error) {
+	offerID, err := uuid.Parse(req.OfferId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "невалидный UUID предложения: %v", err)
+	}
+	appointmentID, err := s.Store.AcceptWaitingListOffer(ctx, offerID, appointmentFromPb(req.Appointment))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AcceptWaitingListOfferResponse{AppointmentId: int32(appointmentID)}, nil
+}
+
+func (s *Server) DeclineWaitingListOffer(ctx context.Context, req *pb.DeclineWaitingListOfferRequest) (*pb.DefaultResponse, error) {
+	offerID, err := uuid.Parse(req.OfferId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "невалидный UUID предложения: %v", err)
+	}
+	err = s.Store.DeclineWaitingListOffer(ctx, offerID, model.UserID(req.PatientId))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) ClaimNotifications(ctx context.Context, req *pb.ClaimNotificationsRequest) (*pb.ClaimNotificationsResponse, error) {
+	if len(req.Channels) == 0 || req.Limit <= 0 || req.LeaseSeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "нужно указать каналы, количество и время аренды уведомлений")
+	}
+	notifications, err := s.Store.ClaimNotifications(ctx, req.Channels, int(req.Limit), time.Duration(req.LeaseSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ClaimNotificationsResponse{Notifications: make([]*pb.Notification, 0, len(notifications))}
+	for _, n := range notifications {
+		resp.Notifications = append(resp.Notifications, &pb.Notification{
+			Id:        n.ID,
+			Kind:      n.Kind,
+			Channel:   n.Channel,
+			PatientId: int32(derefUserID(n.PatientID)),
+			Recipient: n.Recipient,
+			Payload:   string(n.Payload),
+			SendAt:    timestamppb.New(n.SendAt),
+			Attempts:  int32(n.Attempts),
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) CompleteNotification(ctx context.Context, req *pb.CompleteNotificationRequest) (*pb.DefaultResponse, error) {
+	err := s.Store.CompleteNotification(ctx, req.Id, req.Error)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) GetPatientNotificationSettings(ctx context.Context, req *pb.GetByIDRequest) (*pb.PatientNotificationSettings, error) {
+	settings, err := s.Store.GetPatientNotificationSettings(ctx, model.UserID(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PatientNotificationSettings{PatientId: int32(settings.PatientID), OptOut: settings.OptOut}, nil
+}
+
+func (s *Server) UpdatePatientNotificationSettings(ctx context.Context, req *pb.PatientNotificationSettings) (*pb.DefaultResponse, error) {
+	err := s.Store.UpdatePatientNotificationSettings(ctx, model.PatientNotificationSettings{
+		PatientID: model.UserID(req.PatientId),
+		OptOut:    req.OptOut,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) GetScheduleRules(ctx context.Context, req *pb.GetScheduleRulesRequest) (*pb.GetScheduleRulesResponse, error) {
+	var doctorID *model.UserID
+	if req.DoctorId != 0 {
+		id := model.UserID(req.DoctorId)
+		doctorID = &id
+	}
+	rules, err := s.Store.GetScheduleRules(ctx, doctorID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetScheduleRulesResponse{Rules: make([]*pb.ScheduleRule, 0, len(rules))}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, scheduleRuleToPb(rule))
+	}
+	return resp, nil
+}
+
+func (s *Server) AddScheduleRule(ctx context.Context, req *pb.AddScheduleRuleRequest) (*pb.AddScheduleRuleResponse, error) {
+	if req.Rule == nil {
+		return nil, status.Error(codes.InvalidArgument, "правило расписания не передано")
+	}
+	id, err := s.Store.AddScheduleRule(ctx, scheduleRuleFromPb(req.Rule))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddScheduleRuleResponse{Id: int32(id)}, nil
+}
+
+func (s *Server) ImportScheduleRules(ctx context.Context, req *pb.ImportScheduleRulesRequest) (*pb.ImportScheduleRulesResponse, error) {
+	rules := make([]model.ScheduleRule, 0, len(req.Rules))
+	for _, rule := range req.Rules {
+		rules = append(rules, scheduleRuleFromPb(rule))
+	}
+	added, err := s.Store.ImportScheduleRules(ctx, rules)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ImportScheduleRulesResponse{Added: int32(added)}, nil
+}
+
+func (s *Server) DeleteScheduleRule(ctx context.Context, req *pb.DeleteRequest) (*pb.DefaultResponse, error) {
+	err := s.Store.DeleteScheduleRule(ctx, int(req.Id))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func scheduleRuleToPb(rule model.ScheduleRule) *pb.ScheduleRule {
+	res := &pb.ScheduleRule{
+		Id:                  int32(rule.ID),
+		DoctorId:            int32(derefUserID(rule.DoctorID)),
+		Recurrence:          rule.Recurrence,
+		DateFrom:            timestamppb.New(rule.DateFrom),
+		Weekday:             int32(derefInt(rule.Weekday)),
+		Nth:                 int32(derefInt(rule.Nth)),
+		StartTime:           timestamppb.New(derefTime(rule.StartTime)),
+		EndTime:             timestamppb.New(derefTime(rule.EndTime)),
+		SlotDurationMinutes: int32(derefInt(rule.SlotDurationMinutes)),
+		IsDayOff:            rule.IsDayOff,
+		Title:               rule.Title,
+	}
+	if rule.DateTo != nil {
+		res.DateTo = timestamppb.New(*rule.DateTo)
+	}
+	return res
+}
+
+// scheduleRuleFromPb правило для сохранения: часы задаются только для рабочих дней, день недели — только для monthly_weekday
+func scheduleRuleFromPb(req *pb.ScheduleRule) model.ScheduleRule {
+	rule := model.ScheduleRule{
+		Recurrence: req.Recurrence,
+		DateFrom:   req.DateFrom.AsTime(),
+		IsDayOff:   req.IsDayOff,
+		Title:      req.Title,
+	}
+	if req.DoctorId != 0 {
+		doctorID := model.UserID(req.DoctorId)
+		rule.DoctorID = &doctorID
+	}
+	if req.DateTo != nil {
+		dateTo := req.DateTo.AsTime()
+		rule.DateTo = &dateTo
+	}
+	if req.Recurrence == model.ScheduleRuleMonthlyWeekday {
+		weekday, nth := int(req.Weekday), int(req.Nth)
+		rule.Weekday = &weekday
+		rule.Nth = &nth
+	}
+	if !req.IsDayOff {
+		start, end := req.StartTime.AsTime(), req.EndTime.AsTime()
+		rule.StartTime = &start
+		rule.EndTime = &end
+	}
+	if req.SlotDurationMinutes > 0 {
+		slot := int(req.SlotDurationMinutes)
+		rule.SlotDurationMinutes = &slot
+	}
+	return rule
+}
+
+func (s *Server) AddAuditEntry(ctx context.Context, req *pb.AuditEntry) (*pb.DefaultResponse, error) {
+	err := s.Store.AddAuditEntry(ctx, auditEntryFromPb(req))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DefaultResponse{}, nil
+}
+
+func (s *Server) GetAuditLog(ctx context.Context, req *pb.GetAuditLogRequest) (*pb.GetAuditLogResponse, error) {
+	filter := model.AuditFilter{PatientID: model.UserID(req.PatientId), ActorID: model.UserID(req.ActorId)}
+	if req.DateFrom != nil {
+		from := req.DateFrom.AsTime()
+		filter.DateFrom = &from
+	}
+	if req.DateTo != nil {
+		to := req.DateTo.AsTime()
+		filter.DateTo = &to
+	}
+	entries, total, err := s.Store.GetAuditLog(ctx, filter, listPageFromPb(req.Page))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetAuditLogResponse{Entries: make([]*pb.AuditEntry, 0, len(entries)), Total: int32(total)}
+	for _, entry := range entries {
+		item := &pb.AuditEntry{
+			Id:         entry.ID,
+			OccurredAt: timestamppb.New(entry.OccurredAt),
+			Service:    entry.Service,
+			Action:     entry.Action,
+			ActorId:    int32(entry.ActorID),
+			ActorRole:  int32(entry.ActorRole),
+			Resource:   entry.Resource,
+			ResourceId: entry.ResourceID,
+			Outcome:    entry.Outcome,
+			Hash:       entry.Hash,
+		}
+		if entry.PatientID != nil {
+			item.PatientId = int32(*entry.PatientID)
+		}
+		resp.Entries = append(resp.Entries, item)
+	}
+	return resp, nil
+}
+
+func (s *Server) VerifyAuditLog(ctx context.Context, _ *pb.EmptyRequest) (*pb.VerifyAuditLogResponse, error) {
+	check, err := s.Store.VerifyAuditLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VerifyAuditLogResponse{
+		Checked:  int32(check.Checked),
+		BrokenId: check.BrokenID,
+		LastHash: check.LastHash,
+	}, nil
+}
+
+// auditEntryFromPb запись журнала аудита из запроса; нулевой пациент — вызов не относится к пациенту
+func auditEntryFromPb(req *pb.AuditEntry) model.AuditEntry {
+	entry := model.AuditEntry{
+		OccurredAt: req.OccurredAt.AsTime(),
+		Service:    req.Service,
+		Action:     req.Action,
+		ActorID:    model.UserID(req.ActorId),
+		ActorRole:  int(req.ActorRole),
+		Resource:   req.Resource,
+		ResourceID: req.ResourceId,
+		Outcome:    req.Outcome,
+	}
+	if req.PatientId != 0 {
+		patientID := model.UserID(req.PatientId)
+		entry.PatientID = &patientID
+	}
+	return entry
+}
@@ -0,0 +1,126 @@
+// Package blob хранилище содержимого файлов пациентов (снимков, превью) за общим интерфейсом.
+// Драйверы: локальная файловая система и S3-совместимое хранилище (AWS S3, MinIO).
+//
+// Объекты адресуются по содержимому: ключ строится из SHA-256 файла, поэтому файлы с одинаковым именем
+// больше не перезаписывают друг друга, а одинаковые файлы хранятся один раз. Контрольная сумма
+// сохраняется в БД и проверяется при чтении.
+package blob
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+)
+
+var (
+	// ErrNotFound объекта с таким ключом нет в хранилище
+	ErrNotFound = errors.New("объект не найден в хранилище")
+	// ErrChecksumMismatch содержимое объекта не совпало с сохранённой контрольной суммой
+	ErrChecksumMismatch = errors.New("контрольная сумма объекта не совпадает")
+)
+
+// Store драйвер хранилища объектов. Put перезаписывает объект целиком; Get возвращает поток содержимого
+// начиная с байта offset, который вызывающий обязан закрыть
+type Store interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	Get(ctx context.Context, key string, offset int64) (io.ReadCloser, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Object сохранённый объект: ключ в хранилище, SHA-256 содержимого в hex и размер в байтах
+type Object struct {
+	Key      string
+	Checksum string
+	Size     int64
+}
+
+// Key ключ объекта по контрольной сумме: sha256/ab/abcdef…, первые два символа разносят объекты по каталогам
+func Key(checksum string) string {
+	return "sha256/" + checksum[:2] + "/" + checksum
+}
+
+// ChecksumFromKey контрольная сумма из ключа, построенного Key; false, если ключ не контентный
+func ChecksumFromKey(key string) (string, bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 3 || parts[0] != "sha256" || len(parts[2]) != sha256.Size*2 || parts[1] != parts[2][:2] {
+		return "", false
+	}
+	return parts[2], true
+}
+
+// Write сохраняет содержимое r под контентным ключом. Поток читается один раз: содержимое копируется
+// во временный файл с подсчётом SHA-256, затем загружается в хранилище. Если такой объект уже есть,
+// повторно он не загружается
+func Write(ctx context.Context, store Store, r io.Reader) (Object, error) {
+	tmp, err := os.CreateTemp("", "blob-*")
+	if err != nil {
+		return Object{}, fmt.Errorf("не удалось создать временный файл: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, h), r)
+	if err != nil {
+		return Object{}, fmt.Errorf("не удалось прочитать содержимое: %w", err)
+	}
+	obj := Object{Checksum: hex.EncodeToString(h.Sum(nil)), Size: size}
+	obj.Key = Key(obj.Checksum)
+
+	exists, err := store.Exists(ctx, obj.Key)
+	if err != nil {
+		return Object{}, err
+	}
+	if exists {
+		return obj, nil
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return Object{}, fmt.Errorf("не удалось перемотать временный файл: %w", err)
+	}
+	if err := store.Put(ctx, obj.Key, tmp, size); err != nil {
+		return Object{}, err
+	}
+	return obj, nil
+}
+
+// Open поток объекта с байта offset. При чтении с начала поток, дочитанный до конца, сверяет содержимое
+// с checksum и вместо io.EOF возвращает ErrChecksumMismatch, если они не совпали. Пустой checksum или
+// ненулевой offset — проверка не выполняется
+func Open(ctx context.Context, store Store, key, checksum string, offset int64) (io.ReadCloser, error) {
+	rc, err := store.Get(ctx, key, offset)
+	if err != nil {
+		return nil, err
+	}
+	if checksum == "" || offset > 0 {
+		return rc, nil
+	}
+	return &verifyingReader{rc: rc, h: sha256.New(), want: checksum}, nil
+}
+
+type verifyingReader struct {
+	rc   io.ReadCloser
+	h    hash.Hash
+	want string
+}
+
+func (v *verifyingReader) Read(p []byte) (int, error) {
+	n, err := v.rc.Read(p)
+	v.h.Write(p[:n])
+	if errors.Is(err, io.EOF) {
+		if got := hex.EncodeToString(v.h.Sum(nil)); got != v.want {
+			return n, fmt.Errorf("%w: ожидалась %s, получена %s", ErrChecksumMismatch, v.want, got)
+		}
+	}
+	return n, err
+}
+
+func (v *verifyingReader) Close() error {
+	return v.rc.Close()
+}
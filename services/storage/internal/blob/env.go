@@ -0,0 +1,29 @@
+package blob
+
+import (
+	"fmt"
+	"os"
+)
+
+// NewFromEnv драйвер по переменной DOCS_STORAGE: fs (по умолчанию) — каталог DOCS_DIR (по умолчанию /docs),
+// s3 — S3-совместимое хранилище по S3_ENDPOINT, S3_REGION, S3_BUCKET, S3_ACCESS_KEY, S3_SECRET_KEY
+func NewFromEnv() (Store, error) {
+	switch driver := os.Getenv("DOCS_STORAGE"); driver {
+	case "", "fs":
+		dir := os.Getenv("DOCS_DIR")
+		if dir == "" {
+			dir = "/docs"
+		}
+		return NewFileSystem(dir), nil
+	case "s3":
+		return NewS3(S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Region:    os.Getenv("S3_REGION"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+		})
+	default:
+		return nil, fmt.Errorf("неизвестный драйвер хранилища документов %q", driver)
+	}
+}
@@ -0,0 +1,101 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// FileSystem объекты в каталоге на диске хоста storage; ключ — относительный путь внутри каталога
+type FileSystem struct {
+	root string
+}
+
+func NewFileSystem(root string) *FileSystem {
+	return &FileSystem{root: root}
+}
+
+// Put пишет объект во временный файл рядом с целевым и переименовывает его, чтобы читатели
+// никогда не видели недописанный файл
+func (s *FileSystem) Put(_ context.Context, key string, r io.Reader, _ int64) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("не удалось создать директорию: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("не удалось создать временный файл: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("не удалось записать файл: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("не удалось записать файл: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("не удалось сохранить файл: %w", err)
+	}
+	return nil
+}
+
+func (s *FileSystem) Get(_ context.Context, key string, offset int64) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("не удалось открыть файл: %w", err)
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("не удалось перейти к смещению %d: %w", offset, err)
+	}
+	return f, nil
+}
+
+func (s *FileSystem) Exists(_ context.Context, key string) (bool, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("не удалось проверить файл: %w", err)
+	}
+	return true, nil
+}
+
+func (s *FileSystem) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("не удалось удалить файл: %w", err)
+	}
+	return nil
+}
+
+// path путь к объекту; ключи, выходящие за пределы каталога хранилища, отклоняются
+func (s *FileSystem) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("недопустимый ключ объекта %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
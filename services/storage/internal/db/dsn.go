@@ -0,0 +1,46 @@
+package db
+
+import (
+	"fmt"
+	"os"
+)
+
+// DSNFromEnv строка подключения к БД (data source name) из переменных окружения DB_*
+func DSNFromEnv() (string, error) {
+	params, err := dsnParams()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s sslmode=%s",
+		params["user"], params["password"], params["host"], params["port"], params["name"], params["sslMode"]), nil
+}
+
+// MigrationDSNFromEnv строка подключения для golang-migrate из переменных окружения DB_*
+func MigrationDSNFromEnv() (string, error) {
+	params, err := dsnParams()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
+		params["user"], params["password"], params["host"], params["port"], params["name"], params["sslMode"]), nil
+}
+
+func dsnParams() (map[string]string, error) {
+	keys := map[string]string{
+		"user":     "DB_USER",
+		"password": "DB_PASSWORD",
+		"host":     "DB_HOST",
+		"port":     "DB_PORT",
+		"name":     "DB_NAME",
+		"sslMode":  "DB_SSLMODE",
+	}
+	result := make(map[string]string, len(keys))
+	for param, key := range keys {
+		value := os.Getenv(key)
+		if value == "" {
+			return nil, fmt.Errorf("переменная окружения %s не установлена", key)
+		}
+		result[param] = value
+	}
+	return result, nil
+}
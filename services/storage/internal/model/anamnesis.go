@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// Тяжесть аллергической реакции
+const (
+	AllergyMild     = "mild"
+	AllergyModerate = "moderate"
+	AllergySevere   = "severe"
+)
+
+type (
+	AnamnesisID int
+	// Anamnesis версия анкеты здоровья пациента. Version = 0 — пациент анкету ещё не заполнял.
+	// ChangedBy и ChangedByRole (patient или doctor) — кто сохранил версию
+	Anamnesis struct {
+		ID                 AnamnesisID `db:"id"`
+		PatientID          UserID      `db:"patient_id"`
+		Version            int         `db:"version"`
+		Medications        string      `db:"medications"`
+		BloodThinners      bool        `db:"blood_thinners"`
+		CardiacDisease     bool        `db:"cardiac_disease"`
+		Hypertension       bool        `db:"hypertension"`
+		Diabetes           bool        `db:"diabetes"`
+		BleedingDisorder   bool        `db:"bleeding_disorder"`
+		InfectiousDisease  bool        `db:"infectious_disease"`
+		AnesthesiaReaction bool        `db:"anesthesia_reaction"`
+		Pregnant           bool        `db:"pregnant"`
+		Breastfeeding      bool        `db:"breastfeeding"`
+		Smoker             bool        `db:"smoker"`
+		OtherConditions    string      `db:"other_conditions"`
+		ChangedBy          *UserID     `db:"changed_by"`
+		ChangedByRole      string      `db:"changed_by_role"`
+		CreatedAt          time.Time   `db:"created_at"`
+		Allergies          []AnamnesisAllergy
+	}
+	AnamnesisAllergy struct {
+		ID          int         `db:"id"`
+		AnamnesisID AnamnesisID `db:"anamnesis_id"`
+		Allergen    string      `db:"allergen"`
+		Severity    string      `db:"severity"`
+		Reaction    string      `db:"reaction"`
+	}
+)
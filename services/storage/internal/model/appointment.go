@@ -19,4 +19,5 @@ type Appointment struct {
 	Status             string        `db:"status"`
 	CreatedAt          time.Time     `db:"created_at"`
 	UpdatedAt          time.Time     `db:"updated_at"`
+	DurationMinutes    int           `db:"duration_minutes"`
 }
@@ -5,4 +5,7 @@ type AppointmentMaterial struct {
 	VisitID      VisitID    `db:"visit_id"`
 	MaterialID   MaterialID `db:"material_id"`
 	QuantityUsed int        `db:"quantity_used"`
+	// цена на день записи визита; NULL у строк услуг без цены в прайс-листе
+	PricePerUnit *int `db:"price_per_unit"`
+	TotalPrice   *int `db:"total_price"`
 }
@@ -5,4 +5,10 @@ type AppointmentService struct {
 	VisitID   VisitID   `db:"visit_id"`
 	ServiceID ServiceID `db:"service_id"`
 	Quantity  int       `db:"quantity"`
+	// цена на день записи визита; NULL у строк услуг без цены в прайс-листе
+	PricePerUnit *int `db:"price_per_unit"`
+	TotalPrice   *int `db:"total_price"`
+	// зуб по FDI и его поверхности, если услуга оказана на конкретном зубе
+	Tooth    *int   `db:"tooth"`
+	Surfaces string `db:"surfaces"`
 }
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type AppointmentSlotHold struct {
+	ID        uuid.UUID `db:"id"`
+	DoctorID  UserID    `db:"doctor_id"`
+	Date      time.Time `db:"date"`
+	Time      time.Time `db:"time"`
+	PatientID *UserID   `db:"patient_id"`
+	// HeldBy пользователь, который удерживает слот; nil у удержаний, созданных до учёта владельца
+	HeldBy    *UserID   `db:"held_by"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+	// DurationMinutes длительность удерживаемого приёма, начиная с Time
+	DurationMinutes int `db:"duration_minutes"`
+}
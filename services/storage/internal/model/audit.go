@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+type (
+	// AuditEntry запись журнала аудита. PatientID == nil — вызов не относится к конкретному пациенту;
+	// Hash — звено цепочки хешей Chain: журнал ведётся несколькими независимыми цепочками
+	AuditEntry struct {
+		ID         int64     `db:"id"`
+		OccurredAt time.Time `db:"occurred_at"`
+		Service    string    `db:"service"`
+		Action     string    `db:"action"`
+		ActorID    UserID    `db:"actor_id"`
+		ActorRole  int       `db:"actor_role"`
+		Resource   string    `db:"resource"`
+		ResourceID string    `db:"resource_id"`
+		PatientID  *UserID   `db:"patient_id"`
+		Outcome    string    `db:"outcome"`
+		Chain      int16     `db:"chain"`
+		Hash       []byte    `db:"hash"`
+	}
+	// AuditFilter отбор записей журнала: по пациенту, по пользователю, выполнявшему вызов, и по датам
+	// включительно. Нулевые идентификаторы и nil — без отбора
+	AuditFilter struct {
+		PatientID UserID
+		ActorID   UserID
+		DateFrom  *time.Time
+		DateTo    *time.Time
+	}
+	// AuditChainCheck результат проверки цепочек хешей: Checked — сколько записей проверено, BrokenID —
+	// первая запись, хеш которой не сходится (0 — цепочки целы), LastHash — сводный хеш последних записей
+	// цепочек для сверки с сохранённым вне БД
+	AuditChainCheck struct {
+		Checked  int
+		BrokenID int64
+		LastHash []byte
+	}
+)
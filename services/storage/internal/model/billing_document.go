@@ -0,0 +1,27 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Виды печатных документов по счёту
+const (
+	BillingDocumentInvoice = "invoice"
+	BillingDocumentReceipt = "receipt"
+)
+
+type (
+	// BillingDocument номер печатного документа по счёту: счёта на оплату или чека на оплату/возврат (PaymentID).
+	// DocumentID == nil, пока PDF не сохранён в документы пациента
+	BillingDocument struct {
+		ID         int               `db:"id"`
+		Kind       string            `db:"kind"`
+		Number     int               `db:"number"`
+		InvoiceID  InvoiceID         `db:"invoice_id"`
+		PaymentID  *InvoicePaymentID `db:"payment_id"`
+		DocumentID *uuid.UUID        `db:"document_id"`
+		VoidedAt   *time.Time        `db:"voided_at"`
+		CreatedAt  time.Time         `db:"created_at"`
+	}
+)
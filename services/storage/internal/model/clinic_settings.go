@@ -0,0 +1,8 @@
+package model
+
+// ClinicSettings общие настройки клиники
+type ClinicSettings struct {
+	// TimeZone часовой пояс клиники в формате IANA, например Europe/Moscow.
+	// Даты и время расписания и записей хранятся без пояса и трактуются в нём
+	TimeZone string `db:"time_zone"`
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// Условия, по которым срабатывает правило клинического предупреждения
+const (
+	AlertConditionAllergy       = "allergy"
+	AlertConditionCondition     = "condition"
+	AlertConditionAnamnesisFlag = "anamnesis_flag"
+)
+
+// Когда показывается предупреждение: при открытии приёма или при выборе материала либо услуги
+const (
+	AlertTargetVisit    = "visit"
+	AlertTargetMaterial = "material"
+	AlertTargetService  = "service"
+)
+
+type ClinicalAlertRuleID int
+
+// ClinicalAlertRule правило клинического предупреждения. TargetName — название материала или услуги правила
+type ClinicalAlertRule struct {
+	ID             ClinicalAlertRuleID `db:"id"`
+	Title          string              `db:"title"`
+	ConditionType  string              `db:"condition_type"`
+	ConditionValue string              `db:"condition_value"`
+	TargetType     string              `db:"target_type"`
+	MaterialID     *MaterialID         `db:"material_id"`
+	ServiceID      *ServiceID          `db:"service_id"`
+	TargetName     string              `db:"target_name"`
+	Severity       string              `db:"severity"` // info, warning, critical
+	Message        string              `db:"message"`
+	IsActive       bool                `db:"is_active"`
+	CreatedAt      time.Time           `db:"created_at"`
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// Состояния зуба в зубной формуле
+const (
+	ToothHealthy = "healthy"
+	ToothCaries  = "caries"
+	ToothFilled  = "filled"
+	ToothCrown   = "crown"
+	ToothImplant = "implant"
+	ToothMissing = "missing"
+)
+
+type (
+	ToothRecordID int
+	// ToothRecord запись истории зубной формулы: состояние зуба Tooth по FDI на момент RecordedAt.
+	// Surfaces — затронутые поверхности буквами MOIDBL, пустая строка — зуб целиком
+	ToothRecord struct {
+		ID         ToothRecordID `db:"id"`
+		PatientID  UserID        `db:"patient_id"`
+		Tooth      int           `db:"tooth"`
+		Status     string        `db:"status"`
+		Surfaces   string        `db:"surfaces"`
+		Note       string        `db:"note"`
+		VisitID    *VisitID      `db:"visit_id"`
+		DoctorID   *UserID       `db:"doctor_id"`
+		RecordedAt time.Time     `db:"recorded_at"`
+	}
+)
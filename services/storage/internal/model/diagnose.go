@@ -8,10 +8,13 @@ type (
 		Name        string    `db:"name"`
 		Description string    `db:"description"`
 	}
+	// Diagnose диагноз приёма; Tooth — номер зуба по FDI, nil для диагнозов без привязки к зубу
 	Diagnose struct {
 		ID            int       `db:"id"`
 		VisitID       VisitID   `db:"visit_id"`
 		ICDCodeID     ICDCodeID `db:"icd_code_id"`
 		DiagnosisNote string    `db:"diagnosis_note"`
+		Tooth         *int      `db:"tooth"`
+		Surfaces      string    `db:"surfaces"`
 	}
 )
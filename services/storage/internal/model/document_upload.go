@@ -0,0 +1,20 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// DocumentUpload загрузка документа частями: метаданные будущего документа до приёма файла целиком
+type DocumentUpload struct {
+	ID          uuid.UUID  `db:"id"`
+	PatientID   UserID     `db:"patient_id"`
+	FileName    string     `db:"file_name"`
+	Modality    string     `db:"modality"`
+	StudyDate   *time.Time `db:"study_date"`
+	Description string     `db:"description"`
+	SizeBytes   int64      `db:"size_bytes"`
+	PreviewPath string     `db:"preview_path"`
+	DocumentID  *uuid.UUID `db:"document_id"` // заполнен, когда документ сохранён
+	CreatedAt   time.Time  `db:"created_at"`
+}
@@ -0,0 +1,61 @@
+package model
+
+import "time"
+
+// Виды движения материала по складу
+const (
+	StockMovementReceipt     = "receipt"
+	StockMovementConsumption = "consumption"
+	StockMovementAdjustment  = "adjustment"
+)
+
+type (
+	MaterialBatchID int
+	// MaterialBatch партия поступления материала; QuantityLeft уменьшается при списании
+	MaterialBatch struct {
+		ID               MaterialBatchID `db:"id"`
+		MaterialID       MaterialID      `db:"material_id"`
+		BatchNumber      string          `db:"batch_number"`
+		QuantityReceived int             `db:"quantity_received"`
+		QuantityLeft     int             `db:"quantity_left"`
+		ExpiresAt        *time.Time      `db:"expires_at"`
+		ReceivedAt       time.Time       `db:"received_at"`
+		Comment          string          `db:"comment"`
+	}
+	// StockMovement запись журнала движения; Quantity со знаком: поступление положительно, списание отрицательно
+	StockMovement struct {
+		ID         int              `db:"id"`
+		MaterialID MaterialID       `db:"material_id"`
+		BatchID    *MaterialBatchID `db:"batch_id"`
+		Kind       string           `db:"kind"`
+		Quantity   int              `db:"quantity"`
+		VisitID    *VisitID         `db:"visit_id"`
+		Reason     string           `db:"reason"`
+		CreatedAt  time.Time        `db:"created_at"`
+	}
+	// StockAdjustment корректировка остатка по итогам инвентаризации, списание брака или просроченного.
+	// Без BatchID уменьшение распределяется по партиям как обычное списание, а увеличение партий не касается
+	StockAdjustment struct {
+		MaterialID MaterialID
+		BatchID    *MaterialBatchID
+		Quantity   int
+		Reason     string
+	}
+	// MaterialStock остаток материала, партии с ненулевым остатком и последние движения
+	MaterialStock struct {
+		Material  Material
+		Batches   []MaterialBatch
+		Movements []StockMovement
+	}
+	// ExpiringBatch партия с остатком, срок годности которой истёк или скоро истекает
+	ExpiringBatch struct {
+		MaterialBatch
+		MaterialName string `db:"material_name"`
+		Unit         string `db:"unit"`
+	}
+	// StockReport материалы с низким остатком и партии с истекающим сроком годности
+	StockReport struct {
+		LowStock []Material
+		Expiring []ExpiringBatch
+	}
+)
@@ -0,0 +1,83 @@
+package model
+
+import "time"
+
+// Статусы счёта
+const (
+	InvoiceIssued        = "issued"
+	InvoicePartiallyPaid = "partially_paid"
+	InvoicePaid          = "paid"
+	InvoiceRefunded      = "refunded"
+)
+
+// Движения по счёту
+const (
+	InvoicePaymentKindPayment = "payment"
+	InvoicePaymentKindRefund  = "refund"
+)
+
+type (
+	InvoiceID        int
+	InvoicePaymentID int
+	// Invoice счёт на приём. Paid — внесено за вычетом возвратов, вычисляется по движениям
+	Invoice struct {
+		ID             InvoiceID `db:"id"`
+		VisitID        VisitID   `db:"visit_id"`
+		PatientID      *UserID   `db:"patient_id"`
+		Subtotal       int       `db:"subtotal"`
+		DiscountKind   *string   `db:"discount_kind"` // percent или fixed; nil — без скидки
+		DiscountValue  int       `db:"discount_value"`
+		DiscountReason *string   `db:"discount_reason"` // loyalty, staff, promo
+		DiscountAmount int       `db:"discount_amount"`
+		Total          int       `db:"total"`
+		Paid           int       `db:"paid"`
+		Status         string    `db:"status"`
+		CreatedAt      time.Time `db:"created_at"`
+		UpdatedAt      time.Time `db:"updated_at"`
+
+		Lines    []InvoiceLine    `db:"-"`
+		Payments []InvoicePayment `db:"-"`
+	}
+	// InvoiceLine строка счёта: услуга или материал визита по цене на день приёма. Kind adjustment — корректировка
+	// суммы приёма, перенесённая из оплат до введения счетов; ItemID у неё 0
+	InvoiceLine struct {
+		ID           int       `db:"id"`
+		InvoiceID    InvoiceID `db:"invoice_id"`
+		Kind         PriceKind `db:"kind"`
+		ItemID       int       `db:"item_id"`
+		Name         string    `db:"name"`
+		Quantity     int       `db:"quantity"`
+		PricePerUnit int       `db:"price_per_unit"`
+		TotalPrice   int       `db:"total_price"`
+	}
+	// InvoicePayment оплата или возврат по счёту; у возврата RefundOf — оплата, по которой он сделан
+	InvoicePayment struct {
+		ID        InvoicePaymentID  `db:"id"`
+		InvoiceID InvoiceID         `db:"invoice_id"`
+		Kind      string            `db:"kind"`
+		Amount    int               `db:"amount"`
+		Method    string            `db:"method"` // cash, card, transfer; unknown — оплата до введения счетов
+		RefundOf  *InvoicePaymentID `db:"refund_of"`
+		Comment   string            `db:"comment"`
+		CreatedAt time.Time         `db:"created_at"`
+	}
+	// Discount скидка на счёт: процент (Value 1..100) или фиксированная сумма
+	Discount struct {
+		Kind   string
+		Value  int
+		Reason string
+	}
+	// PatientBalance расчёты с пациентом по всем счетам; OpenInvoices — счета с непогашенным остатком
+	PatientBalance struct {
+		PatientID    UserID
+		Invoiced     int
+		Paid         int
+		Outstanding  int
+		OpenInvoices []Invoice
+	}
+)
+
+// Balance остаток к оплате
+func (i Invoice) Balance() int {
+	return i.Total - i.Paid
+}
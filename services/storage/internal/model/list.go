@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+type (
+	// ListPage страница списка. Limit == 0 — список целиком (внутренние вызовы сервисов). Sort — поле
+	// сортировки из допустимых для списка, пустое — сортировка списка по умолчанию
+	ListPage struct {
+		Limit  int
+		Offset int
+		Sort   string
+		Desc   bool
+	}
+	// PersonFilter отбор пациентов, врачей и администраторов. Query — поиск по ФИО; для пациентов также
+	// по номеру телефона или дате рождения. Без Inactive в список попадают только пользователи в работе,
+	// с Inactive — только отключённые сотрудники и архивные пациенты, чтобы их можно было восстановить
+	PersonFilter struct {
+		Query    string
+		Inactive bool
+	}
+	// ICDFilter отбор кодов МКБ: Query — начало кода или часть названия
+	ICDFilter struct {
+		Query string
+	}
+	// AppointmentFilter отбор записей на приём. DateFrom и DateTo — границы дат приёма включительно,
+	// nil — без границы; пустые Statuses и нулевой DoctorID — без отбора
+	AppointmentFilter struct {
+		DateFrom *time.Time
+		DateTo   *time.Time
+		Statuses []string
+		DoctorID UserID
+	}
+)
@@ -2,8 +2,13 @@ package model
 
 type MaterialID int
 
+// Material материал. StockQuantity — остаток на складе в единицах Unit; отрицательный остаток означает,
+// что на приёмы списано больше, чем оприходовано
 type Material struct {
-	ID    MaterialID `db:"id"`
-	Name  string     `db:"name"`
-	Price int        `db:"price"`
+	ID                MaterialID `db:"id"`
+	Name              string     `db:"name"`
+	Price             int        `db:"price"`
+	Unit              string     `db:"unit"`
+	LowStockThreshold int        `db:"low_stock_threshold"` // 0 — без предупреждения о низком остатке
+	StockQuantity     int        `db:"stock_quantity"`
 }
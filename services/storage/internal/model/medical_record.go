@@ -0,0 +1,53 @@
+package model
+
+import "time"
+
+type (
+	// MedicalRecord медицинская карта пациента для выгрузки: данные пациента, записи врача об аллергиях
+	// и хронических заболеваниях, текущая анкета здоровья (Version = 0 — не заполнялась), приёмы от старых
+	// к новым и метаданные документов
+	MedicalRecord struct {
+		Patient   Patient
+		Notes     []PatientMedicalNote
+		Anamnesis Anamnesis
+		Visits    []MedicalRecordVisit
+		Documents []PatientDocument
+	}
+	// MedicalRecordVisit приём с ФИО врача, диагнозами, услугами и материалами
+	MedicalRecordVisit struct {
+		ID            VisitID   `db:"id"`
+		DoctorID      *UserID   `db:"doctor_id"`
+		Doctor        string    `db:"doctor"`
+		Complaints    string    `db:"complaints"`
+		TreatmentPlan string    `db:"treatment_plan"`
+		CreatedAt     time.Time `db:"created_at"`
+		Diagnoses     []MedicalRecordDiagnosis
+		Services      []MedicalRecordService
+		Materials     []MedicalRecordMaterial
+	}
+	// MedicalRecordDiagnosis диагноз приёма с кодом и названием по МКБ-10
+	MedicalRecordDiagnosis struct {
+		VisitID  VisitID `db:"visit_id"`
+		Code     string  `db:"code"`
+		Name     string  `db:"name"`
+		Note     string  `db:"diagnosis_note"`
+		Tooth    *int    `db:"tooth"`
+		Surfaces string  `db:"surfaces"`
+	}
+	// MedicalRecordService услуга приёма с названием из справочника
+	MedicalRecordService struct {
+		VisitID   VisitID   `db:"visit_id"`
+		ServiceID ServiceID `db:"service_id"`
+		Name      string    `db:"name"`
+		Quantity  int       `db:"quantity"`
+		Tooth     *int      `db:"tooth"`
+		Surfaces  string    `db:"surfaces"`
+	}
+	// MedicalRecordMaterial израсходованный на приёме материал с названием из справочника
+	MedicalRecordMaterial struct {
+		VisitID    VisitID    `db:"visit_id"`
+		MaterialID MaterialID `db:"material_id"`
+		Name       string     `db:"name"`
+		Quantity   int        `db:"quantity_used"`
+	}
+)
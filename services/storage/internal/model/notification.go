@@ -0,0 +1,48 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Notification уведомление в исходящей очереди
+type Notification struct {
+	ID            int64           `db:"id"`
+	DedupeKey     string          `db:"dedupe_key"`
+	Kind          string          `db:"kind"`
+	Channel       string          `db:"channel"`
+	PatientID     *UserID         `db:"patient_id"`
+	AppointmentID *AppointmentID  `db:"appointment_id"`
+	Recipient     string          `db:"recipient"`
+	Payload       json.RawMessage `db:"payload"`
+	SendAt        time.Time       `db:"send_at"`
+	// ExpiresAt после этого момента уведомление теряет смысл и не отправляется (например, напоминание после приёма)
+	ExpiresAt   *time.Time `db:"expires_at"`
+	Status      string     `db:"status"`
+	Attempts    int        `db:"attempts"`
+	LastError   *string    `db:"last_error"`
+	LockedUntil *time.Time `db:"locked_until"`
+	SentAt      *time.Time `db:"sent_at"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
+
+// PatientNotificationSettings настройки уведомлений пациента
+type PatientNotificationSettings struct {
+	PatientID UserID `db:"patient_id"`
+	OptOut    bool   `db:"opt_out"`
+}
+
+// Виды уведомлений; для каждого в сервисе уведомлений есть шаблон
+const (
+	NotificationBookingConfirmed       = "booking_confirmed"
+	NotificationAppointmentRescheduled = "appointment_rescheduled"
+	NotificationReminder24h            = "reminder_24h"
+	NotificationReminder2h             = "reminder_2h"
+	NotificationSlotOffered            = "slot_offered"
+)
+
+// Каналы доставки уведомлений
+const (
+	NotificationChannelSMS   = "sms"
+	NotificationChannelEmail = "email"
+)
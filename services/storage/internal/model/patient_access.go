@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+type (
+	PatientAccessGrantID int
+	// PatientAccessGrant допуск врача к медицинской карте пациента, которого он не лечит. ExpiresAt == nil —
+	// бессрочный допуск, RevokedAt != nil — отозванный. Doctor — ФИО врача для списка допусков
+	PatientAccessGrant struct {
+		ID        PatientAccessGrantID `db:"id"`
+		PatientID UserID               `db:"patient_id"`
+		DoctorID  UserID               `db:"doctor_id"`
+		Doctor    string               `db:"doctor"`
+		Reason    string               `db:"reason"`
+		GrantedBy *UserID              `db:"granted_by"`
+		ExpiresAt *time.Time           `db:"expires_at"`
+		RevokedAt *time.Time           `db:"revoked_at"`
+		CreatedAt time.Time            `db:"created_at"`
+	}
+	// DoctorPatientRelation отношение врача к пациенту: Treating — у врача есть подтверждённая или завершённая
+	// запись пациента, Granted — действующий допуск к карте
+	DoctorPatientRelation struct {
+		Treating bool `db:"treating"`
+		Granted  bool `db:"granted"`
+	}
+)
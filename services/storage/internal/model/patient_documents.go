@@ -6,6 +6,8 @@ import (
 )
 
 type (
+	// PatientDocument метаданные документа пациента. StoragePath и PreviewPath — ключи объектов в хранилище
+	// документов; Checksum == nil у строк, ещё не перенесённых из старого хранения на диске
 	PatientDocument struct {
 		ID          uuid.UUID  `db:"id"`
 		PatientID   UserID     `db:"patient_id"`
@@ -15,6 +17,8 @@ type (
 		Description string     `db:"description"`
 		StoragePath string     `db:"storage_path"`
 		PreviewPath string     `db:"preview_path"`
+		Checksum    *string    `db:"checksum"`
+		SizeBytes   *int64     `db:"size_bytes"`
 		CreatedAt   time.Time  `db:"created_at"`
 	}
 )
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// PriceKind прайс-лист, к которому относится цена
+type PriceKind string
+
+const (
+	PriceKindService  PriceKind = "service"
+	PriceKindMaterial PriceKind = "material"
+)
+
+// PriceListEntry цена позиции прайс-листа, действующая с EffectiveFrom до следующей записи той же позиции
+type PriceListEntry struct {
+	ID            int       `db:"id"`
+	ItemID        int       `db:"item_id"`
+	Price         int       `db:"price"`
+	EffectiveFrom time.Time `db:"effective_from"`
+	CreatedAt     time.Time `db:"created_at"`
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// Виды повторения правил расписания
+const (
+	ScheduleRuleRange          = "range"
+	ScheduleRuleMonthlyWeekday = "monthly_weekday"
+	ScheduleRuleAnnual         = "annual"
+)
+
+// ScheduleRule исключение из постоянного расписания клиники (DoctorID == nil) или врача на диапазон дат
+// или по повторяющемуся правилу
+type ScheduleRule struct {
+	ID                  int        `db:"id"`
+	DoctorID            *UserID    `db:"doctor_id"`
+	Recurrence          string     `db:"recurrence"`
+	DateFrom            time.Time  `db:"date_from"`
+	DateTo              *time.Time `db:"date_to"`
+	Weekday             *int       `db:"weekday"`
+	Nth                 *int       `db:"nth"`
+	StartTime           *time.Time `db:"start_time"`
+	EndTime             *time.Time `db:"end_time"`
+	SlotDurationMinutes *int       `db:"slot_duration_minutes"`
+	IsDayOff            bool       `db:"is_day_off"`
+	Title               string     `db:"title"`
+	CreatedAt           time.Time  `db:"created_at"`
+}
@@ -8,9 +8,10 @@ type (
 		Name string        `db:"name"`
 	}
 	Service struct {
-		ID       ServiceID     `db:"id"`
-		Name     string        `db:"name"`
-		Price    *int          `db:"price"`
-		Category ServiceTypeID `db:"type"`
+		ID              ServiceID     `db:"id"`
+		Name            string        `db:"name"`
+		Price           *int          `db:"price"`
+		Category        ServiceTypeID `db:"type"`
+		DurationMinutes int           `db:"duration_minutes"`
 	}
 )
@@ -0,0 +1,56 @@
+package model
+
+import "time"
+
+// Статусы плана лечения
+const (
+	TreatmentPlanProposed   = "proposed"
+	TreatmentPlanAccepted   = "accepted"
+	TreatmentPlanInProgress = "in_progress"
+	TreatmentPlanDone       = "done"
+	TreatmentPlanCancelled  = "cancelled"
+)
+
+// Статусы позиции плана лечения
+const (
+	TreatmentItemPlanned    = "planned"
+	TreatmentItemInProgress = "in_progress"
+	TreatmentItemDone       = "done"
+	TreatmentItemCancelled  = "cancelled"
+)
+
+type (
+	TreatmentPlanID     int
+	TreatmentPlanItemID int
+	// TreatmentPlan план лечения пациента. EstimatedTotal — стоимость всех неотменённых позиций,
+	// RemainingCost — ещё не выполненных; обе считаются по ценам на день составления плана
+	TreatmentPlan struct {
+		ID             TreatmentPlanID `db:"id"`
+		PatientID      UserID          `db:"patient_id"`
+		DoctorID       *UserID         `db:"doctor_id"`
+		Title          string          `db:"title"`
+		Status         string          `db:"status"`
+		AcceptedAt     *time.Time      `db:"accepted_at"`
+		CreatedAt      time.Time       `db:"created_at"`
+		UpdatedAt      time.Time       `db:"updated_at"`
+		Items          []TreatmentPlanItem
+		EstimatedTotal int
+		RemainingCost  int
+	}
+
+	// TreatmentPlanItem услуга плана на этапе; Tooth — номер зуба по FDI, nil для услуг без привязки к зубу
+	TreatmentPlanItem struct {
+		ID             TreatmentPlanItemID `db:"id"`
+		PlanID         TreatmentPlanID     `db:"plan_id"`
+		Stage          int                 `db:"stage"`
+		Tooth          *int                `db:"tooth"`
+		ServiceID      ServiceID           `db:"service_id"`
+		ServiceName    string              `db:"service_name"`
+		Quantity       int                 `db:"quantity"`
+		EstimatedPrice int                 `db:"estimated_price"`
+		Status         string              `db:"status"`
+		VisitID        *VisitID            `db:"visit_id"`
+		Note           string              `db:"note"`
+		CompletedAt    *time.Time          `db:"completed_at"`
+	}
+)
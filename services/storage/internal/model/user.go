@@ -1,9 +1,24 @@
 package model
 
+import "time"
+
 type UserID int
 
+// UserStatus статус учётной записи. Пользователи с медицинскими данными не удаляются, а выводятся из работы
+type UserStatus string
+
+const (
+	UserActive      UserStatus = "active"      // в работе
+	UserDeactivated UserStatus = "deactivated" // сотрудник отключён и не может войти
+	UserArchived    UserStatus = "archived"    // пациент в архиве: скрыт из списков, карта сохраняется
+)
+
+// User учётная запись. StatusChangedBy — кто последним менял статус
 type User struct {
-	ID       UserID  `db:"id"`
-	Login    *string `db:"login"`
-	Password *string `db:"password"`
+	ID              UserID     `db:"id"`
+	Login           *string    `db:"login"`
+	Password        *string    `db:"password"`
+	Status          UserStatus `db:"status"`
+	StatusChangedAt *time.Time `db:"status_changed_at"`
+	StatusChangedBy *UserID    `db:"status_changed_by"`
 }
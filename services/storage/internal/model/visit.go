@@ -1,6 +1,9 @@
 package model
 
-import "time"
+import (
+	"github.com/google/uuid"
+	"time"
+)
 
 type (
 	VisitID int
@@ -13,6 +16,18 @@ type (
 		TreatmentPlan string        `db:"treatment_plan"`
 		CreatedAt     time.Time     `db:"created_at"`
 		UpdatedAt     time.Time     `db:"updated_at"`
+
+		IdempotencyKey *uuid.UUID `db:"idempotency_key"`
+	}
+	// Consultation всё, что врач записывает по итогам приёма: визит, диагнозы, услуги, материалы,
+	// выполненные на приёме позиции планов лечения и изменения зубной формулы
+	Consultation struct {
+		Visit       Visit
+		Diagnoses   []Diagnose
+		Services    []AppointmentService
+		Materials   []AppointmentMaterial
+		PlanItemIDs []TreatmentPlanItemID
+		Chart       []ToothRecord
 	}
 	VisitPayment struct {
 		VisitID VisitID `db:"visit_id"`
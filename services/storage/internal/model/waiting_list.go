@@ -0,0 +1,32 @@
+package model
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// WaitingListEntry запись пациента в листе ожидания. Задаётся врач или специальность (тогда подходит любой её врач)
+type WaitingListEntry struct {
+	ID               int       `db:"id"`
+	PatientID        UserID    `db:"patient_id"`
+	DoctorID         *UserID   `db:"doctor_id"`
+	SpecializationID *int      `db:"specialization_id"`
+	DateFrom         time.Time `db:"date_from"`
+	DateTo           time.Time `db:"date_to"`
+	Status           string    `db:"status"`
+	CreatedAt        time.Time `db:"created_at"`
+}
+
+// WaitingListOffer предложение освободившегося слота пациенту из листа ожидания
+type WaitingListOffer struct {
+	ID              uuid.UUID `db:"id"`
+	EntryID         int       `db:"entry_id"`
+	HoldID          uuid.UUID `db:"hold_id"`
+	DoctorID        UserID    `db:"doctor_id"`
+	Date            time.Time `db:"date"`
+	Time            time.Time `db:"time"`
+	DurationMinutes int       `db:"duration_minutes"`
+	Status          string    `db:"status"`
+	ExpiresAt       time.Time `db:"expires_at"`
+	CreatedAt       time.Time `db:"created_at"`
+}
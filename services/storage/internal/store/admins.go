@@ -8,14 +8,21 @@ import (
 	"github.com/Masterminds/squirrel"
 )
 
-// GetAdmins Получение списка всех администраторов
-func (s *Store) GetAdmins(ctx context.Context) ([]model.Admin, error) {
-	query, args, err := s.builder.
-		Select("*").
-		From("administrators").
-		ToSql()
+// adminOrder допустимые поля сортировки списка администраторов
+var adminOrder = listOrder{
+	"name": {"second_name", "first_name", "surname", "user_id"},
+}
+
+// GetAdmins Получение страницы списка администраторов и числа всех администраторов, найденных по filter
+func (s *Store) GetAdmins(ctx context.Context, filter model.PersonFilter, page model.ListPage) ([]model.Admin, int, error) {
+	where := squirrel.And{userStatusCondition(filter.Inactive), fullNameSearch(filter.Query)}
+	builder, err := paginate(s.builder.Select("*").From("administrators").Where(where), page, adminOrder, "name")
+	if err != nil {
+		return nil, 0, err
+	}
+	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("не удалось сформировать запрос для получения списка администраторов: %w", err)
+		return nil, 0, fmt.Errorf("не удалось сформировать запрос для получения списка администраторов: %w", err)
 	}
 
 	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
@@ -24,10 +31,14 @@ func (s *Store) GetAdmins(ctx context.Context) ([]model.Admin, error) {
 	var admins []model.Admin
 	err = s.db.SelectContext(dbCtx, &admins, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("не удалось выполнить запрос для получения списка администраторов: %w", err)
+		return nil, 0, fmt.Errorf("не удалось выполнить запрос для получения списка администраторов: %w", err)
 	}
 
-	return admins, nil
+	total, err := s.listTotal(ctx, "administrators", where, page, len(admins))
+	if err != nil {
+		return nil, 0, err
+	}
+	return admins, total, nil
 }
 
 // GetAdminByID Получение администратора по id
@@ -0,0 +1,147 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦ PATIENT_ANAMNESIS И PATIENT_ANAMNESIS_ALLERGIES
+
+const anamnesisColumns = `id, patient_id, version, medications, blood_thinners, cardiac_disease, hypertension, diabetes,
+	bleeding_disorder, infectious_disease, anesthesia_reaction, pregnant, breastfeeding, smoker, other_conditions,
+	changed_by, changed_by_role, created_at`
+
+// SaveAnamnesis Сохранение анкеты здоровья новой версией. baseVersion — версия, которую видел автор изменений
+// (0 — анкета заполняется впервые); если с тех пор анкету уже изменили, возвращается ErrAnamnesisVersionConflict,
+// чтобы одно изменение не затёрло другое
+func (s *Store) SaveAnamnesis(ctx context.Context, anamnesis model.Anamnesis, baseVersion int) (model.Anamnesis, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.Anamnesis{}, fmt.Errorf("не удалось начать транзакцию для сохранения анкеты: %w", err)
+	}
+	defer tx.Rollback()
+
+	// блокировка пациента упорядочивает одновременные сохранения анкеты
+	var patientID model.UserID
+	err = tx.GetContext(dbCtx, &patientID, "SELECT user_id FROM patients WHERE user_id = $1 FOR UPDATE", anamnesis.PatientID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Anamnesis{}, ErrPatientNotFound
+	}
+	if err != nil {
+		return model.Anamnesis{}, fmt.Errorf("не удалось получить пациента для сохранения анкеты: %w", err)
+	}
+	var current int
+	err = tx.GetContext(dbCtx, &current, "SELECT COALESCE(MAX(version), 0) FROM patient_anamnesis WHERE patient_id = $1", patientID)
+	if err != nil {
+		return model.Anamnesis{}, fmt.Errorf("не удалось получить текущую версию анкеты: %w", err)
+	}
+	if current != baseVersion {
+		return model.Anamnesis{}, ErrAnamnesisVersionConflict
+	}
+
+	var id model.AnamnesisID
+	err = tx.GetContext(dbCtx, &id, `
+		INSERT INTO patient_anamnesis (patient_id, version, medications, blood_thinners, cardiac_disease, hypertension,
+			diabetes, bleeding_disorder, infectious_disease, anesthesia_reaction, pregnant, breastfeeding, smoker,
+			other_conditions, changed_by, changed_by_role)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		RETURNING id`,
+		patientID, current+1, anamnesis.Medications, anamnesis.BloodThinners, anamnesis.CardiacDisease,
+		anamnesis.Hypertension, anamnesis.Diabetes, anamnesis.BleedingDisorder, anamnesis.InfectiousDisease,
+		anamnesis.AnesthesiaReaction, anamnesis.Pregnant, anamnesis.Breastfeeding, anamnesis.Smoker,
+		anamnesis.OtherConditions, anamnesis.ChangedBy, anamnesis.ChangedByRole)
+	if err != nil {
+		if isCheckViolation(err) {
+			return model.Anamnesis{}, invalidRow(ErrInvalidAnamnesis, err)
+		}
+		return model.Anamnesis{}, fmt.Errorf("не удалось сохранить анкету: %w", err)
+	}
+	for _, allergy := range anamnesis.Allergies {
+		_, err := tx.ExecContext(dbCtx, `
+			INSERT INTO patient_anamnesis_allergies (anamnesis_id, allergen, severity, reaction)
+			VALUES ($1, $2, $3, $4)`, id, allergy.Allergen, allergy.Severity, allergy.Reaction)
+		if err != nil {
+			if isCheckViolation(err) {
+				return model.Anamnesis{}, ErrInvalidAnamnesis.Detailf("аллергия %q", allergy.Allergen)
+			}
+			return model.Anamnesis{}, fmt.Errorf("не удалось сохранить аллергию анкеты: %w", err)
+		}
+	}
+	saved, err := anamnesisVersions(dbCtx, tx, patientID, true)
+	if err != nil {
+		return model.Anamnesis{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.Anamnesis{}, fmt.Errorf("не удалось зафиксировать транзакцию для сохранения анкеты: %w", err)
+	}
+	return saved[0], nil
+}
+
+// GetAnamnesis Текущая версия анкеты пациента; если анкета не заполнялась, у результата Version = 0
+func (s *Store) GetAnamnesis(ctx context.Context, patientID model.UserID) (model.Anamnesis, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	versions, err := anamnesisVersions(dbCtx, s.db, patientID, true)
+	if err != nil {
+		return model.Anamnesis{}, err
+	}
+	if len(versions) == 0 {
+		return model.Anamnesis{PatientID: patientID}, nil
+	}
+	return versions[0], nil
+}
+
+// GetAnamnesisHistory Все версии анкеты пациента, новые первыми
+func (s *Store) GetAnamnesisHistory(ctx context.Context, patientID model.UserID) ([]model.Anamnesis, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	return anamnesisVersions(dbCtx, s.db, patientID, false)
+}
+
+// anamnesisVersions версии анкеты пациента с аллергиями, новые первыми; latest — только последняя
+func anamnesisVersions(ctx context.Context, q sqlx.QueryerContext, patientID model.UserID, latest bool) ([]model.Anamnesis, error) {
+	query := `SELECT ` + anamnesisColumns + ` FROM patient_anamnesis WHERE patient_id = $1 ORDER BY version DESC`
+	if latest {
+		query += " LIMIT 1"
+	}
+	var versions []model.Anamnesis
+	if err := sqlx.SelectContext(ctx, q, &versions, query, patientID); err != nil {
+		return nil, fmt.Errorf("не удалось получить анкету пациента: %w", err)
+	}
+	if len(versions) == 0 {
+		return versions, nil
+	}
+
+	ids := make([]int64, 0, len(versions))
+	for _, v := range versions {
+		ids = append(ids, int64(v.ID))
+	}
+	var allergies []model.AnamnesisAllergy
+	err := sqlx.SelectContext(ctx, q, &allergies, `
+		SELECT id, anamnesis_id, allergen, severity, reaction
+		FROM patient_anamnesis_allergies
+		WHERE anamnesis_id = ANY($1)
+		ORDER BY id`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить аллергии из анкеты пациента: %w", err)
+	}
+	byVersion := make(map[model.AnamnesisID][]model.AnamnesisAllergy, len(versions))
+	for _, a := range allergies {
+		byVersion[a.AnamnesisID] = append(byVersion[a.AnamnesisID], a)
+	}
+	for i := range versions {
+		versions[i].Allergies = byVersion[versions[i].ID]
+	}
+	return versions, nil
+}
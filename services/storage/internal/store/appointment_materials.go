@@ -30,7 +30,7 @@ func (s *Store) GetAppointmentMaterials(ctx context.Context, id model.VisitID) (
 	return appMaterials, nil
 }
 
-// AddAppointmentMaterials Добавление записей о материалах, использованных во время приема
+// AddAppointmentMaterials Добавление записей о материалах, использованных во время приема, и списание их со склада
 func (s *Store) AddAppointmentMaterials(ctx context.Context, materials []model.AppointmentMaterial) error {
 	if len(materials) == 0 {
 		return nil
@@ -39,18 +39,28 @@ func (s *Store) AddAppointmentMaterials(ctx context.Context, materials []model.A
 	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
 
+	// цены фиксируются на день записи по часам клиники
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return err
+	}
+
 	tx, err := s.db.BeginTxx(dbCtx, nil)
 	if err != nil {
 		return fmt.Errorf("не удалось начать транзакцию для добавления материалов, использованных во время приема: %w", err)
 	}
 	defer tx.Rollback()
 
+	if err := priceVisitMaterials(dbCtx, tx, materials, today); err != nil {
+		return err
+	}
+
 	insert := s.builder.
 		Insert("appointment_materials").
-		Columns("visit_id", "material_id", "quantity_used")
+		Columns("visit_id", "material_id", "quantity_used", "price_per_unit", "total_price")
 
 	for _, m := range materials {
-		insert = insert.Values(m.VisitID, m.MaterialID, m.QuantityUsed)
+		insert = insert.Values(m.VisitID, m.MaterialID, m.QuantityUsed, m.PricePerUnit, m.TotalPrice)
 	}
 
 	query, args, err := insert.ToSql()
@@ -62,6 +72,15 @@ func (s *Store) AddAppointmentMaterials(ctx context.Context, materials []model.A
 	if err != nil {
 		return fmt.Errorf("не удалось выполнить запрос добавления материалов, использованных во время приема: %w", err)
 	}
+	byVisit := make(map[model.VisitID][]model.AppointmentMaterial)
+	for _, m := range materials {
+		byVisit[m.VisitID] = append(byVisit[m.VisitID], m)
+	}
+	for visitID, visitMaterials := range byVisit {
+		if err := consumeVisitMaterials(dbCtx, tx, visitID, visitMaterials, today); err != nil {
+			return err
+		}
+	}
 
 	if err = tx.Commit(); err != nil {
 		return fmt.Errorf("не удалось зафиксировать транзакцию для добавления материалов, использованных во время приема: %w", err)
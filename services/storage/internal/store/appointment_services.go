@@ -39,18 +39,28 @@ func (s *Store) AddAppointmentServices(ctx context.Context, services []model.App
 	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
 
+	// цены фиксируются на день записи по часам клиники
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return err
+	}
+
 	tx, err := s.db.BeginTxx(dbCtx, nil)
 	if err != nil {
 		return fmt.Errorf("не удалось начать транзакцию для добавления оказанных во время приема услуг: %w", err)
 	}
 	defer tx.Rollback()
 
+	if err := priceVisitServices(dbCtx, tx, services, today); err != nil {
+		return err
+	}
+
 	insert := s.builder.
 		Insert("appointment_services").
-		Columns("visit_id", "service_id", "quantity")
+		Columns("visit_id", "service_id", "quantity", "price_per_unit", "total_price", "tooth", "surfaces")
 
 	for _, m := range services {
-		insert = insert.Values(m.VisitID, m.ServiceID, m.Quantity)
+		insert = insert.Values(m.VisitID, m.ServiceID, m.Quantity, m.PricePerUnit, m.TotalPrice, m.Tooth, m.Surfaces)
 	}
 
 	query, args, err := insert.ToSql()
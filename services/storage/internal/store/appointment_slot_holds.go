@@ -0,0 +1,224 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// defaultAppointmentMinutes длительность записи по умолчанию, совпадает с DEFAULT столбца appointments.duration_minutes
+const defaultAppointmentMinutes = 30
+
+// HoldAppointmentSlot Временное удержание слота врача пользователем hold.HeldBy. Повторный запрос с тем же holdID
+// продлевает удержание, если его держит тот же пользователь
+func (s *Store) HoldAppointmentSlot(ctx context.Context, hold model.AppointmentSlotHold, ttl time.Duration) (model.AppointmentSlotHold, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.AppointmentSlotHold{}, fmt.Errorf("не удалось начать транзакцию для удержания слота: %w", err)
+	}
+	defer tx.Rollback()
+
+	if hold.ID != uuid.Nil {
+		if err := checkHoldOwner(dbCtx, tx, hold.ID, hold.HeldBy); err != nil {
+			return model.AppointmentSlotHold{}, err
+		}
+	}
+	result, err := s.holdSlot(dbCtx, tx, hold, ttl)
+	if err != nil {
+		return model.AppointmentSlotHold{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return model.AppointmentSlotHold{}, fmt.Errorf("не удалось зафиксировать транзакцию для удержания слота: %w", err)
+	}
+	return result, nil
+}
+
+// holdSlot удерживает слот в транзакции tx: слот не должен быть занят записью или удержанием другого пациента
+func (s *Store) holdSlot(ctx context.Context, tx *sqlx.Tx, hold model.AppointmentSlotHold, ttl time.Duration) (model.AppointmentSlotHold, error) {
+	var holdID *uuid.UUID
+	if hold.ID != uuid.Nil {
+		holdID = &hold.ID
+	}
+	hold.DurationMinutes = durationOrDefault(hold.DurationMinutes)
+	err := checkSlotNotHeld(ctx, tx, hold.DoctorID, hold.Date, hold.Time, hold.DurationMinutes, holdID)
+	if err != nil {
+		return model.AppointmentSlotHold{}, err
+	}
+
+	var booked bool
+	err = tx.GetContext(ctx, &booked, `
+		SELECT EXISTS (
+			SELECT 1 FROM appointments
+			WHERE doctor_id = $1 AND status <> 'cancelled'
+			  AND tsrange(date + "time", date + "time" + make_interval(mins => duration_minutes))
+			      && tsrange($2::date + $3::time, $2::date + $3::time + make_interval(mins => $4::int))
+		)`, hold.DoctorID, hold.Date, hold.Time, hold.DurationMinutes)
+	if err != nil {
+		return model.AppointmentSlotHold{}, fmt.Errorf("не удалось проверить занятость слота: %w", err)
+	}
+	if booked {
+		return model.AppointmentSlotHold{}, ErrAppointmentSlotTaken
+	}
+
+	if hold.ID == uuid.Nil {
+		hold.ID = uuid.New()
+	}
+	query, args, err := s.builder.
+		Insert("appointment_slot_holds").
+		Columns("id", "doctor_id", "date", "time", "duration_minutes", "patient_id", "held_by", "expires_at").
+		Values(hold.ID, hold.DoctorID, hold.Date, hold.Time, hold.DurationMinutes, hold.PatientID, hold.HeldBy, squirrel.Expr("now() + ?::interval", fmt.Sprintf("%d seconds", int(ttl.Seconds())))).
+		Suffix(`ON CONFLICT (doctor_id, date, "time") DO UPDATE
+			SET expires_at = EXCLUDED.expires_at, duration_minutes = EXCLUDED.duration_minutes
+			WHERE appointment_slot_holds.id = EXCLUDED.id`).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return model.AppointmentSlotHold{}, fmt.Errorf("не удалось сформировать запрос для удержания слота: %w", err)
+	}
+
+	var result model.AppointmentSlotHold
+	err = tx.GetContext(ctx, &result, query, args...)
+	if err != nil {
+		// слот или пересекающийся с ним промежуток успел удержать параллельный запрос
+		if errors.Is(err, sql.ErrNoRows) || isSlotConflict(err) {
+			return model.AppointmentSlotHold{}, ErrAppointmentSlotTaken
+		}
+		return model.AppointmentSlotHold{}, fmt.Errorf("не удалось выполнить запрос для удержания слота: %w", err)
+	}
+	return result, nil
+}
+
+// ReleaseAppointmentSlot Снятие удержания слота пользователем heldBy. Уже истёкшее или снятое удержание
+// не ошибка; удержание другого пользователя — ErrSlotHoldNotFound
+func (s *Store) ReleaseAppointmentSlot(ctx context.Context, id uuid.UUID, heldBy model.UserID) error {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию для снятия удержания слота: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := checkHoldOwner(dbCtx, tx, id, &heldBy); err != nil {
+		return err
+	}
+	query, args, err := s.builder.
+		Delete("appointment_slot_holds").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для снятия удержания слота: %w", err)
+	}
+	_, err = tx.ExecContext(dbCtx, query, args...)
+	if err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для снятия удержания слота: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("не удалось зафиксировать транзакцию для снятия удержания слота: %w", err)
+	}
+	return nil
+}
+
+// checkHoldOwner проверяет, что действующее удержание id держит пользователь heldBy, и блокирует его до конца
+// транзакции. Удержания нет или оно истекло — не ошибка: занятость слота проверяется отдельно
+func checkHoldOwner(ctx context.Context, tx *sqlx.Tx, id uuid.UUID, heldBy *model.UserID) error {
+	var owner *model.UserID
+	err := tx.GetContext(ctx, &owner, `
+		SELECT held_by FROM appointment_slot_holds
+		WHERE id = $1 AND expires_at > now()
+		FOR UPDATE`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("не удалось получить удержание слота: %w", err)
+	}
+	if owner == nil || heldBy == nil || *owner != *heldBy {
+		return ErrSlotHoldNotFound
+	}
+	return nil
+}
+
+// GetActiveSlotHoldsByDoctorID Получение действующих удержаний слотов врача
+func (s *Store) GetActiveSlotHoldsByDoctorID(ctx context.Context, id model.UserID) ([]model.AppointmentSlotHold, error) {
+	query, args, err := s.builder.
+		Select("*").
+		From("appointment_slot_holds").
+		Where(squirrel.Eq{"doctor_id": id}).
+		Where("expires_at > now()").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сформировать запрос для получения удержаний слотов: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var holds []model.AppointmentSlotHold
+	err = s.db.SelectContext(dbCtx, &holds, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось выполнить запрос для получения удержаний слотов: %w", err)
+	}
+	return holds, nil
+}
+
+// checkSlotNotHeld удаляет истёкшие удержания врача и проверяет, что промежуток [slotTime, slotTime+minutes)
+// не пересекается с удержаниями других пациентов. Найденные удержания блокируются до конца транзакции; удержание,
+// которое параллельно вставляет другая транзакция, отсекает ограничение appointment_slot_holds_doctor_no_overlap
+func checkSlotNotHeld(ctx context.Context, tx *sqlx.Tx, doctorID model.UserID, date, slotTime time.Time, minutes int, holdID *uuid.UUID) error {
+	_, err := tx.ExecContext(ctx, `
+		DELETE FROM appointment_slot_holds
+		WHERE doctor_id = $1 AND expires_at <= now()`,
+		doctorID)
+	if err != nil {
+		return fmt.Errorf("не удалось удалить истёкшие удержания слотов: %w", err)
+	}
+
+	var heldBy []uuid.UUID
+	err = tx.SelectContext(ctx, &heldBy, `
+		SELECT id FROM appointment_slot_holds
+		WHERE doctor_id = $1
+		  AND tsrange(date + "time", date + "time" + make_interval(mins => duration_minutes))
+		      && tsrange($2::date + $3::time, $2::date + $3::time + make_interval(mins => $4::int))
+		FOR UPDATE`,
+		doctorID, date, slotTime, minutes)
+	if err != nil {
+		return fmt.Errorf("не удалось проверить удержание слота: %w", err)
+	}
+	for _, id := range heldBy {
+		if holdID == nil || *holdID != id {
+			return ErrAppointmentSlotTaken
+		}
+	}
+	return nil
+}
+
+// durationOrDefault длительность приёма; для старых клиентов, не передающих длительность, — значение по умолчанию из БД
+func durationOrDefault(minutes int) int {
+	if minutes <= 0 {
+		return defaultAppointmentMinutes
+	}
+	return minutes
+}
+
+func sameDate(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month() && a.Day() == b.Day()
+}
+
+func sameClock(a, b time.Time) bool {
+	return a.Hour() == b.Hour() && a.Minute() == b.Minute()
+}
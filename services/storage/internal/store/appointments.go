@@ -4,18 +4,33 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"github.com/DariaTarasek/diplom/services/apperr"
 	"github.com/DariaTarasek/diplom/services/storage/internal/model"
 	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"time"
 )
 
-// GetAppointments Получение списка всех записей
-func (s *Store) GetAppointments(ctx context.Context) ([]model.Appointment, error) {
-	query, args, err := s.builder.
-		Select("*").
-		From("appointments").
-		ToSql()
+// appointmentOrder допустимые поля сортировки списка записей на приём
+var appointmentOrder = listOrder{
+	"date":       {"date", `"time"`, "id"},
+	"created_at": {"created_at", "id"},
+}
+
+// GetAppointments Получение страницы списка записей и числа всех записей, отобранных по filter
+func (s *Store) GetAppointments(ctx context.Context, filter model.AppointmentFilter, page model.ListPage) ([]model.Appointment, int, error) {
+	where, err := appointmentFilter(filter)
 	if err != nil {
-		return nil, fmt.Errorf("не удалось сформировать запрос для получения списка записей: %w", err)
+		return nil, 0, err
+	}
+	builder, err := paginate(s.builder.Select("*").From("appointments").Where(where), page, appointmentOrder, "date")
+	if err != nil {
+		return nil, 0, err
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("не удалось сформировать запрос для получения списка записей: %w", err)
 	}
 
 	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
@@ -24,10 +39,35 @@ func (s *Store) GetAppointments(ctx context.Context) ([]model.Appointment, error
 	var appointment []model.Appointment
 	err = s.db.SelectContext(dbCtx, &appointment, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("не удалось выполнить запрос для получения списка записей: %w", err)
+		return nil, 0, fmt.Errorf("не удалось выполнить запрос для получения списка записей: %w", err)
 	}
 
-	return appointment, nil
+	total, err := s.listTotal(ctx, "appointments", where, page, len(appointment))
+	if err != nil {
+		return nil, 0, err
+	}
+	return appointment, total, nil
+}
+
+// appointmentFilter условие отбора записей на приём
+func appointmentFilter(filter model.AppointmentFilter) (squirrel.And, error) {
+	where := squirrel.And{}
+	if filter.DateFrom != nil && filter.DateTo != nil && filter.DateTo.Before(*filter.DateFrom) {
+		return nil, ErrInvalidListQuery.WithFields(apperr.Field{Name: "date_to", Message: "конец периода раньше начала"})
+	}
+	if filter.DateFrom != nil {
+		where = append(where, squirrel.GtOrEq{"date": filter.DateFrom.Format(time.DateOnly)})
+	}
+	if filter.DateTo != nil {
+		where = append(where, squirrel.LtOrEq{"date": filter.DateTo.Format(time.DateOnly)})
+	}
+	if len(filter.Statuses) > 0 {
+		where = append(where, squirrel.Eq{"status": filter.Statuses})
+	}
+	if filter.DoctorID != 0 {
+		where = append(where, squirrel.Eq{"doctor_id": filter.DoctorID})
+	}
+	return where, nil
 }
 
 // GetAppointmentsByDoctorID Получение списка всех записей врача
@@ -98,8 +138,38 @@ func (s *Store) GetAppointmentByID(ctx context.Context, id model.AppointmentID)
 	return appointment, nil
 }
 
-// AddAppointment Добавление новой записи
-func (s *Store) AddAppointment(ctx context.Context, appointment model.Appointment) (model.AppointmentID, error) {
+// AddAppointment Добавление новой записи. Слот не должен быть занят другой записью или чужим удержанием;
+// удержание с holdID, если оно передано, должно принадлежать heldBy и снимается в той же транзакции
+func (s *Store) AddAppointment(ctx context.Context, appointment model.Appointment, holdID *uuid.UUID, heldBy *model.UserID) (model.AppointmentID, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.AppointmentID(0), fmt.Errorf("не удалось начать транзакцию для добавления новой записи: %w", err)
+	}
+	defer tx.Rollback()
+
+	if holdID != nil {
+		if err := checkHoldOwner(dbCtx, tx, *holdID, heldBy); err != nil {
+			return model.AppointmentID(0), err
+		}
+	}
+	appointmentID, err := s.addAppointment(dbCtx, tx, appointment, holdID)
+	if err != nil {
+		return model.AppointmentID(0), err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return model.AppointmentID(0), fmt.Errorf("не удалось зафиксировать транзакцию для добавления новой записи: %w", err)
+	}
+	return appointmentID, nil
+}
+
+// addAppointment вставляет запись в транзакции tx с проверкой занятости слота, снимает удержание holdID
+// и ставит в очередь подтверждение записи и напоминания
+func (s *Store) addAppointment(ctx context.Context, tx *sqlx.Tx, appointment model.Appointment, holdID *uuid.UUID) (model.AppointmentID, error) {
 	fields := map[string]any{
 		"patient_id":   appointment.PatientID,
 		"doctor_id":    appointment.DoctorID,
@@ -115,6 +185,9 @@ func (s *Store) AddAppointment(ctx context.Context, appointment model.Appointmen
 		"created_at":   appointment.CreatedAt,
 		"updated_at":   appointment.UpdatedAt,
 	}
+	if appointment.DurationMinutes > 0 {
+		fields["duration_minutes"] = appointment.DurationMinutes
+	}
 	query, args, err := s.builder.
 		Insert("appointments").
 		SetMap(fields).
@@ -124,18 +197,41 @@ func (s *Store) AddAppointment(ctx context.Context, appointment model.Appointmen
 		return model.AppointmentID(0), fmt.Errorf("не удалось сформировать запрос для добавления новой записи: %w", err)
 	}
 
-	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
-	defer cancel()
+	err = checkSlotNotHeld(ctx, tx, appointment.DoctorID, appointment.Date, appointment.Time, durationOrDefault(appointment.DurationMinutes), holdID)
+	if err != nil {
+		return model.AppointmentID(0), err
+	}
 
 	var appointmentID model.AppointmentID
-	err = s.db.QueryRowxContext(dbCtx, query, args...).Scan(&appointmentID)
+	err = tx.QueryRowxContext(ctx, query, args...).Scan(&appointmentID)
 	if err != nil {
+		if isSlotConflict(err) {
+			return model.AppointmentID(0), ErrAppointmentSlotTaken
+		}
 		return model.AppointmentID(0), fmt.Errorf("не удалось выполнить запрос для добавления новой записи: %w", err)
 	}
+
+	if holdID != nil {
+		_, err = tx.ExecContext(ctx, "DELETE FROM appointment_slot_holds WHERE id = $1", *holdID)
+		if err != nil {
+			return model.AppointmentID(0), fmt.Errorf("не удалось снять удержание слота: %w", err)
+		}
+	}
+
+	loc, err := s.clinicLocation(ctx)
+	if err != nil {
+		return model.AppointmentID(0), err
+	}
+	appointment.ID = appointmentID
+	err = enqueueAppointmentNotifications(ctx, tx, appointment, model.NotificationBookingConfirmed, loc)
+	if err != nil {
+		return model.AppointmentID(0), err
+	}
 	return appointmentID, nil
 }
 
-// UpdateAppointment Изменение данных записи
+// UpdateAppointment Изменение данных записи. При переносе новый слот не должен быть занят или удержан,
+// пациенту уходит уведомление о переносе. Освободившийся при отмене или переносе слот предлагается листу ожидания
 func (s *Store) UpdateAppointment(ctx context.Context, id model.AppointmentID, appointment model.Appointment) error {
 	fields := map[string]any{
 		"date":       appointment.Date,
@@ -159,9 +255,26 @@ func (s *Store) UpdateAppointment(ctx context.Context, id model.AppointmentID, a
 	if err != nil {
 		return fmt.Errorf("не удалось начать транзакцию для изменения данных записи: %w", err)
 	}
+	defer tx.Rollback()
+
+	var current model.Appointment
+	err = tx.GetContext(dbCtx, &current, "SELECT * FROM appointments WHERE id = $1 FOR UPDATE", id)
+	if err != nil {
+		return fmt.Errorf("не удалось получить запись для изменения: %w", err)
+	}
+	slotChanged := !sameDate(current.Date, appointment.Date) || !sameClock(current.Time, appointment.Time)
+	if slotChanged && appointment.Status != "cancelled" {
+		err = checkSlotNotHeld(dbCtx, tx, current.DoctorID, appointment.Date, appointment.Time, current.DurationMinutes, nil)
+		if err != nil {
+			return err
+		}
+	}
 
 	res, err := tx.ExecContext(dbCtx, query, args...)
 	if err != nil {
+		if isSlotConflict(err) {
+			return ErrAppointmentSlotTaken
+		}
 		return fmt.Errorf("не удалось выполнить запрос для изменения данных записи: %w", err)
 	}
 
@@ -174,10 +287,41 @@ func (s *Store) UpdateAppointment(ctx context.Context, id model.AppointmentID, a
 		return fmt.Errorf("данные записи не изменены")
 	}
 
+	// при отмене неотправленные уведомления теряют смысл, при переносе напоминания ставятся на новое время
+	if current.Status != "cancelled" && (appointment.Status == "cancelled" || slotChanged) {
+		err = cancelAppointmentNotifications(dbCtx, tx, id)
+		if err != nil {
+			return err
+		}
+	}
+	if slotChanged && appointment.Status != "cancelled" {
+		loc, err := s.clinicLocation(dbCtx)
+		if err != nil {
+			return err
+		}
+		moved := current
+		moved.Date = appointment.Date
+		moved.Time = appointment.Time
+		err = enqueueAppointmentNotifications(dbCtx, tx, moved, model.NotificationAppointmentRescheduled, loc)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return fmt.Errorf("не удалось зафиксировать транзакцию для изменения данных записи: %w", err)
 	}
+
+	// отмена или перенос освобождают прежний слот — он предлагается пациентам из листа ожидания
+	if current.Status != "cancelled" && (appointment.Status == "cancelled" || slotChanged) {
+		s.offerSlotLogged(ctx, freedSlot{
+			DoctorID:        current.DoctorID,
+			Date:            current.Date,
+			Time:            current.Time,
+			DurationMinutes: current.DurationMinutes,
+		})
+	}
 	return nil
 }
 
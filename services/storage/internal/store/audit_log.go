@@ -0,0 +1,236 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"github.com/DariaTarasek/diplom/services/audit"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"sort"
+	"time"
+)
+
+// auditChainLock ключ рекомендательных блокировок цепочек журнала: вторая часть ключа — номер цепочки.
+// Под блокировкой записи цепочки добавляются по одной: хеш новой записи считается от хеша последней в цепочке
+const auditChainLock = 45_000_001
+
+// auditChains на сколько независимых цепочек хешей делится журнал. Записи ждут друг друга только внутри
+// своей цепочки, поэтому обращения к данным разных пациентов не выстраиваются в одну очередь
+const auditChains = 16
+
+// auditVerifyBatch сколько записей журнала читать за раз при проверке цепочки
+const auditVerifyBatch = 1000
+
+var auditOrder = listOrder{
+	"occurred_at": {"occurred_at", "id"},
+}
+
+// AddAuditEntry добавляет запись в журнал аудита, продолжая цепочку хешей
+func (s *Store) AddAuditEntry(ctx context.Context, entry model.AuditEntry) error {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию для записи в журнал аудита: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := s.appendAuditEntry(dbCtx, tx, entry); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("не удалось зафиксировать транзакцию для записи в журнал аудита: %w", err)
+	}
+	return nil
+}
+
+// appendAuditEntry добавляет запись в журнал аудита в транзакции tx. Цепочка записи блокируется до конца
+// транзакции, чтобы её записи выстраивались по одной
+func (s *Store) appendAuditEntry(ctx context.Context, tx *sqlx.Tx, entry model.AuditEntry) error {
+	entry.Chain = auditChain(entry)
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1, $2)", auditChainLock, entry.Chain); err != nil {
+		return fmt.Errorf("не удалось заблокировать журнал аудита: %w", err)
+	}
+	var prev []byte
+	err := tx.GetContext(ctx, &prev, "SELECT hash FROM audit_log WHERE chain = $1 ORDER BY id DESC LIMIT 1", entry.Chain)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("не удалось получить последнюю запись журнала аудита: %w", err)
+	}
+
+	// время хранится с точностью до микросекунды, хеш считается от него же
+	entry.OccurredAt = entry.OccurredAt.UTC().Truncate(time.Microsecond)
+	entry.Hash = audit.Hash(prev, auditEntry(entry))
+	query, args, err := s.builder.
+		Insert("audit_log").
+		SetMap(map[string]any{
+			"occurred_at": entry.OccurredAt,
+			"service":     entry.Service,
+			"action":      entry.Action,
+			"actor_id":    entry.ActorID,
+			"actor_role":  entry.ActorRole,
+			"resource":    entry.Resource,
+			"resource_id": entry.ResourceID,
+			"patient_id":  entry.PatientID,
+			"outcome":     entry.Outcome,
+			"chain":       entry.Chain,
+			"hash":        entry.Hash,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для записи в журнал аудита: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для записи в журнал аудита: %w", err)
+	}
+	return nil
+}
+
+// GetAuditLog Получение страницы журнала аудита и числа всех записей, найденных по filter
+func (s *Store) GetAuditLog(ctx context.Context, filter model.AuditFilter, page model.ListPage) ([]model.AuditEntry, int, error) {
+	where, err := auditFilter(filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	builder, err := paginate(s.builder.Select("*").From("audit_log").Where(where), page, auditOrder, "occurred_at")
+	if err != nil {
+		return nil, 0, err
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, 0, fmt.Errorf("не удалось сформировать запрос для получения журнала аудита: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var entries []model.AuditEntry
+	err = s.db.SelectContext(dbCtx, &entries, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("не удалось выполнить запрос для получения журнала аудита: %w", err)
+	}
+
+	total, err := s.listTotal(ctx, "audit_log", where, page, len(entries))
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
+
+// auditFilter условие отбора записей журнала. Даты — календарные дни в часовом поясе клиники
+func auditFilter(filter model.AuditFilter) (squirrel.And, error) {
+	where := squirrel.And{}
+	if filter.DateFrom != nil && filter.DateTo != nil && filter.DateTo.Before(*filter.DateFrom) {
+		return nil, ErrInvalidListQuery.WithFields(apperr.Field{Name: "date_to", Message: "конец периода раньше начала"})
+	}
+	if filter.DateFrom != nil {
+		where = append(where, squirrel.Expr("occurred_at >= ?::timestamp AT TIME ZONE (SELECT time_zone FROM clinic_settings)",
+			filter.DateFrom.Format(time.DateOnly)))
+	}
+	if filter.DateTo != nil {
+		where = append(where, squirrel.Expr("occurred_at < (?::date + 1)::timestamp AT TIME ZONE (SELECT time_zone FROM clinic_settings)",
+			filter.DateTo.Format(time.DateOnly)))
+	}
+	if filter.PatientID != 0 {
+		where = append(where, squirrel.Eq{"patient_id": filter.PatientID})
+	}
+	if filter.ActorID != 0 {
+		where = append(where, squirrel.Eq{"actor_id": filter.ActorID})
+	}
+	return where, nil
+}
+
+// auditChain цепочка записи журнала: по пациенту, а если его нет — по пользователю, выполнявшему вызов
+func auditChain(entry model.AuditEntry) int16 {
+	if entry.PatientID != nil {
+		return int16(*entry.PatientID % auditChains)
+	}
+	return int16(entry.ActorID % auditChains)
+}
+
+// VerifyAuditLog проверяет цепочки хешей журнала аудита от первой записи до последней
+func (s *Store) VerifyAuditLog(ctx context.Context) (model.AuditChainCheck, error) {
+	var check model.AuditChainCheck
+	tails := map[int16][]byte{}
+	var lastID int64
+	for {
+		query, args, err := s.builder.
+			Select("*").
+			From("audit_log").
+			Where(squirrel.Gt{"id": lastID}).
+			OrderBy("id").
+			Limit(auditVerifyBatch).
+			ToSql()
+		if err != nil {
+			return model.AuditChainCheck{}, fmt.Errorf("не удалось сформировать запрос для проверки журнала аудита: %w", err)
+		}
+
+		dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+		var entries []model.AuditEntry
+		err = s.db.SelectContext(dbCtx, &entries, query, args...)
+		cancel()
+		if err != nil {
+			return model.AuditChainCheck{}, fmt.Errorf("не удалось выполнить запрос для проверки журнала аудита: %w", err)
+		}
+
+		for _, entry := range entries {
+			if !bytes.Equal(audit.Hash(tails[entry.Chain], auditEntry(entry)), entry.Hash) {
+				check.BrokenID = entry.ID
+				check.LastHash = auditHead(tails)
+				return check, nil
+			}
+			check.Checked++
+			tails[entry.Chain] = entry.Hash
+			lastID = entry.ID
+		}
+		if len(entries) < auditVerifyBatch {
+			check.LastHash = auditHead(tails)
+			return check, nil
+		}
+	}
+}
+
+// auditHead сводный хеш журнала: SHA-256 от хешей последних записей цепочек по порядку их номеров.
+// Меняется при добавлении записи в любую цепочку; nil, если журнал пуст
+func auditHead(tails map[int16][]byte) []byte {
+	if len(tails) == 0 {
+		return nil
+	}
+	chains := make([]int16, 0, len(tails))
+	for chain := range tails {
+		chains = append(chains, chain)
+	}
+	sort.Slice(chains, func(i, j int) bool { return chains[i] < chains[j] })
+	h := sha256.New()
+	for _, chain := range chains {
+		fmt.Fprintf(h, "%d:", chain)
+		h.Write(tails[chain])
+	}
+	return h.Sum(nil)
+}
+
+// auditEntry содержимое записи журнала, от которого считается её хеш
+func auditEntry(entry model.AuditEntry) audit.Entry {
+	var patientID *int
+	if entry.PatientID != nil {
+		id := int(*entry.PatientID)
+		patientID = &id
+	}
+	return audit.Entry{
+		OccurredAt: entry.OccurredAt,
+		Service:    entry.Service,
+		Action:     entry.Action,
+		ActorID:    int(entry.ActorID),
+		ActorRole:  entry.ActorRole,
+		Resource:   entry.Resource,
+		ResourceID: entry.ResourceID,
+		PatientID:  patientID,
+		Outcome:    entry.Outcome,
+	}
+}
@@ -0,0 +1,136 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦЫ BILLING_DOCUMENTS
+
+const billingDocumentColumns = "id, kind, number, invoice_id, payment_id, document_id, voided_at, created_at"
+
+// ReserveInvoiceDocument Номер счёта на оплату. Пока счёт не менялся, возвращается уже выданный номер;
+// после изменения скидки прежний номер аннулирован и выдаётся следующий
+func (s *Store) ReserveInvoiceDocument(ctx context.Context, invoiceID model.InvoiceID) (model.BillingDocument, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось начать транзакцию для выдачи номера счёта: %w", err)
+	}
+	defer tx.Rollback()
+
+	// блокировка счёта упорядочивает выдачу номера и изменение скидки
+	if _, err := getInvoice(dbCtx, tx, invoiceID, true); err != nil {
+		return model.BillingDocument{}, err
+	}
+	doc, err := reserveBillingDocument(dbCtx, tx, model.BillingDocumentInvoice, invoiceID, nil)
+	if err != nil {
+		return model.BillingDocument{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось зафиксировать транзакцию для выдачи номера счёта: %w", err)
+	}
+	return doc, nil
+}
+
+// ReserveReceiptDocument Номер чека на оплату или возврат; у каждого движения по счёту один чек
+func (s *Store) ReserveReceiptDocument(ctx context.Context, paymentID model.InvoicePaymentID) (model.BillingDocument, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось начать транзакцию для выдачи номера чека: %w", err)
+	}
+	defer tx.Rollback()
+
+	var invoiceID model.InvoiceID
+	err = tx.GetContext(dbCtx, &invoiceID, "SELECT invoice_id FROM invoice_payments WHERE id = $1", paymentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.BillingDocument{}, ErrInvoicePaymentNotFound
+	}
+	if err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось получить оплату для выдачи номера чека: %w", err)
+	}
+	if _, err := getInvoice(dbCtx, tx, invoiceID, true); err != nil {
+		return model.BillingDocument{}, err
+	}
+	doc, err := reserveBillingDocument(dbCtx, tx, model.BillingDocumentReceipt, invoiceID, &paymentID)
+	if err != nil {
+		return model.BillingDocument{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось зафиксировать транзакцию для выдачи номера чека: %w", err)
+	}
+	return doc, nil
+}
+
+// AttachBillingDocument Связывает номер документа с сохранённым PDF в документах пациента
+func (s *Store) AttachBillingDocument(ctx context.Context, id int, documentID uuid.UUID) error {
+	query, args, err := s.builder.
+		Update("billing_documents").
+		Set("document_id", documentID).
+		Where("id = ?", id).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для сохранения документа по счёту: %w", err)
+	}
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return ErrDocumentNotFound
+		}
+		return fmt.Errorf("не удалось выполнить запрос для сохранения документа по счёту: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrBillingDocumentNotFound
+	}
+	return nil
+}
+
+// reserveBillingDocument действующий номер документа или следующий номер из последовательности вида документа;
+// kind — одна из констант model.BillingDocument*
+func reserveBillingDocument(ctx context.Context, tx *sqlx.Tx, kind string, invoiceID model.InvoiceID, paymentID *model.InvoicePaymentID) (model.BillingDocument, error) {
+	query := "SELECT " + billingDocumentColumns + " FROM billing_documents WHERE invoice_id = $1 AND kind = 'invoice' AND voided_at IS NULL"
+	args := []any{invoiceID}
+	if paymentID != nil {
+		query = "SELECT " + billingDocumentColumns + " FROM billing_documents WHERE payment_id = $1 AND kind = 'receipt'"
+		args = []any{*paymentID}
+	}
+	var doc model.BillingDocument
+	err := tx.GetContext(ctx, &doc, query, args...)
+	if err == nil {
+		return doc, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return model.BillingDocument{}, fmt.Errorf("не удалось получить номер документа по счёту: %w", err)
+	}
+
+	sequence := "billing_" + kind + "_number_seq"
+	err = tx.GetContext(ctx, &doc, `
+		INSERT INTO billing_documents (kind, number, invoice_id, payment_id)
+		VALUES ($1, nextval('`+sequence+`'), $2, $3)
+		RETURNING `+billingDocumentColumns, kind, invoiceID, paymentID)
+	if err != nil {
+		return model.BillingDocument{}, fmt.Errorf("не удалось выдать номер документа по счёту: %w", err)
+	}
+	return doc, nil
+}
+
+// voidInvoiceDocuments аннулирует выданный номер счёта: напечатанный счёт больше не совпадает с суммой
+func voidInvoiceDocuments(ctx context.Context, tx *sqlx.Tx, invoiceID model.InvoiceID) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE billing_documents SET voided_at = now()
+		WHERE invoice_id = $1 AND kind = 'invoice' AND voided_at IS NULL`, invoiceID)
+	if err != nil {
+		return fmt.Errorf("не удалось аннулировать счёт на оплату: %w", err)
+	}
+	return nil
+}
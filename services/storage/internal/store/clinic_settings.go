@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"time"
+	_ "time/tzdata"
+)
+
+// ErrInvalidTimeZone часовой пояс не найден в базе IANA
+var ErrInvalidTimeZone = apperr.Invalid("invalid_time_zone", "некорректный часовой пояс")
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦЫ CLINIC_SETTINGS
+
+// GetClinicSettings Получение настроек клиники
+func (s *Store) GetClinicSettings(ctx context.Context) (model.ClinicSettings, error) {
+	query, args, err := s.builder.
+		Select("time_zone").
+		From("clinic_settings").
+		ToSql()
+	if err != nil {
+		return model.ClinicSettings{}, fmt.Errorf("не удалось сформировать запрос для получения настроек клиники: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var settings model.ClinicSettings
+	err = s.db.GetContext(dbCtx, &settings, query, args...)
+	if err != nil {
+		return model.ClinicSettings{}, fmt.Errorf("не удалось выполнить запрос для получения настроек клиники: %w", err)
+	}
+
+	return settings, nil
+}
+
+// UpdateClinicTimeZone Изменение часового пояса клиники
+func (s *Store) UpdateClinicTimeZone(ctx context.Context, timeZone string) error {
+	if _, err := time.LoadLocation(timeZone); err != nil || timeZone == "" || timeZone == "Local" {
+		return ErrInvalidTimeZone.Detailf("%q", timeZone)
+	}
+
+	query, args, err := s.builder.
+		Insert("clinic_settings").
+		Columns("id", "time_zone").
+		Values(true, timeZone).
+		Suffix("ON CONFLICT (id) DO UPDATE SET time_zone = EXCLUDED.time_zone").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для изменения часового пояса клиники: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	_, err = s.db.ExecContext(dbCtx, query, args...)
+	if err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для изменения часового пояса клиники: %w", err)
+	}
+
+	return nil
+}
+
+// clinicLocation часовой пояс клиники, в котором хранятся даты и время записей
+func (s *Store) clinicLocation(ctx context.Context) (*time.Location, error) {
+	settings, err := s.GetClinicSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(settings.TimeZone)
+	if err != nil {
+		return nil, ErrInvalidTimeZone.Detailf("%q", settings.TimeZone)
+	}
+	return loc, nil
+}
+
+// slotStart момент начала приёма: дата и время хранятся без пояса как время по часам клиники
+func slotStart(date, clock time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+}
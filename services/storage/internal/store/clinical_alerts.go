@@ -0,0 +1,141 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/Masterminds/squirrel"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦЫ CLINICAL_ALERT_RULES
+
+// GetClinicalAlertRules Правила клинических предупреждений с названиями материалов и услуг; activeOnly — только
+// включённые правила, по которым проверяется приём
+func (s *Store) GetClinicalAlertRules(ctx context.Context, activeOnly bool) ([]model.ClinicalAlertRule, error) {
+	builder := s.builder.
+		Select("r.id", "r.title", "r.condition_type", "r.condition_value", "r.target_type", "r.material_id",
+			"r.service_id", "COALESCE(m.name, sv.name, '') AS target_name", "r.severity", "r.message",
+			"r.is_active", "r.created_at").
+		From("clinical_alert_rules r").
+		LeftJoin("materials m ON m.id = r.material_id").
+		LeftJoin("services sv ON sv.id = r.service_id").
+		OrderBy("r.id")
+	if activeOnly {
+		builder = builder.Where("r.is_active")
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сформировать запрос для получения правил предупреждений: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var rules []model.ClinicalAlertRule
+	err = s.db.SelectContext(dbCtx, &rules, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось выполнить запрос для получения правил предупреждений: %w", err)
+	}
+	return rules, nil
+}
+
+// AddClinicalAlertRule Добавление правила клинического предупреждения
+func (s *Store) AddClinicalAlertRule(ctx context.Context, rule model.ClinicalAlertRule) (model.ClinicalAlertRuleID, error) {
+	query, args, err := s.builder.
+		Insert("clinical_alert_rules").
+		Columns("title", "condition_type", "condition_value", "target_type", "material_id", "service_id",
+			"severity", "message", "is_active").
+		Values(rule.Title, rule.ConditionType, rule.ConditionValue, rule.TargetType, rule.MaterialID, rule.ServiceID,
+			rule.Severity, rule.Message, rule.IsActive).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("не удалось сформировать запрос для добавления правила предупреждения: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var id model.ClinicalAlertRuleID
+	err = s.db.QueryRowxContext(dbCtx, query, args...).Scan(&id)
+	if err != nil {
+		return 0, clinicalAlertRuleError(err)
+	}
+	return id, nil
+}
+
+// UpdateClinicalAlertRule Изменение правила клинического предупреждения
+func (s *Store) UpdateClinicalAlertRule(ctx context.Context, rule model.ClinicalAlertRule) error {
+	query, args, err := s.builder.
+		Update("clinical_alert_rules").
+		SetMap(map[string]interface{}{
+			"title":           rule.Title,
+			"condition_type":  rule.ConditionType,
+			"condition_value": rule.ConditionValue,
+			"target_type":     rule.TargetType,
+			"material_id":     rule.MaterialID,
+			"service_id":      rule.ServiceID,
+			"severity":        rule.Severity,
+			"message":         rule.Message,
+			"is_active":       rule.IsActive,
+		}).
+		Where(squirrel.Eq{"id": rule.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для изменения правила предупреждения: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	res, err := s.db.ExecContext(dbCtx, query, args...)
+	if err != nil {
+		return clinicalAlertRuleError(err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("не удалось получить количество измененных строк после изменения правила предупреждения: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrClinicalAlertRuleNotFound
+	}
+	return nil
+}
+
+// DeleteClinicalAlertRule Удаление правила клинического предупреждения
+func (s *Store) DeleteClinicalAlertRule(ctx context.Context, id model.ClinicalAlertRuleID) error {
+	query, args, err := s.builder.
+		Delete("clinical_alert_rules").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для удаления правила предупреждения: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	res, err := s.db.ExecContext(dbCtx, query, args...)
+	if err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для удаления правила предупреждения: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("не удалось получить количество измененных строк после удаления правила предупреждения: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrClinicalAlertRuleNotFound
+	}
+	return nil
+}
+
+// clinicalAlertRuleError ошибки ограничений таблицы правил в виде ошибок хранилища
+func clinicalAlertRuleError(err error) error {
+	if isCheckViolation(err) {
+		return invalidRow(ErrInvalidClinicalAlertRule, err)
+	}
+	if isForeignKeyViolation(err) {
+		return ErrClinicalAlertTargetNotFound
+	}
+	return fmt.Errorf("не удалось сохранить правило предупреждения: %w", err)
+}
@@ -0,0 +1,207 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// МЕТОДЫ ЗАВЕРШЕНИЯ ПРИЁМА
+
+// CompleteConsultation Завершение приёма одной транзакцией: запись переводится в completed, сохраняются визит,
+// диагнозы, услуги, материалы (они же списываются со склада), выполненные позиции планов лечения, изменения
+// зубной формулы и выставляется счёт на приём. Оплату приёма в visit_payments заводит не этот метод,
+// а пересчёт счёта (refreshInvoice). Повторный вызов с тем же ключом идемпотентности возвращает уже
+// созданный визит и его сумму, ничего не записывая
+func (s *Store) CompleteConsultation(ctx context.Context, key uuid.UUID, consultation model.Consultation) (model.VisitID, int32, error) {
+	// цены услуг и материалов фиксируются на день приёма по часам клиники
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return 0, 0, fmt.Errorf("не удалось начать транзакцию для завершения приёма: %w", err)
+	}
+	defer tx.Rollback()
+
+	// блокировка записи упорядочивает одновременные попытки завершить один приём
+	visit := consultation.Visit
+	var appointment struct {
+		Status    string        `db:"status"`
+		PatientID sql.NullInt32 `db:"patient_id"`
+		DoctorID  model.UserID  `db:"doctor_id"`
+	}
+	err = tx.GetContext(dbCtx, &appointment,
+		"SELECT status, patient_id, doctor_id FROM appointments WHERE id = $1 FOR UPDATE", visit.AppointmentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, 0, ErrAppointmentNotFound
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("не удалось получить запись для завершения приёма: %w", err)
+	}
+	// приём завершает врач записи, и визит, зубная формула и счёт оформляются на пациента записи,
+	// а не на переданного клиентом
+	if appointment.DoctorID != visit.DoctorID {
+		return 0, 0, ErrAppointmentOtherDoctor
+	}
+	if visit.PatientID != model.UserID(appointment.PatientID.Int32) {
+		return 0, 0, ErrAppointmentPatientMismatch
+	}
+	appointmentStatus := appointment.Status
+
+	var existing struct {
+		ID             model.VisitID       `db:"id"`
+		AppointmentID  model.AppointmentID `db:"appointment_id"`
+		IdempotencyKey *uuid.UUID          `db:"idempotency_key"`
+		Price          sql.NullInt32       `db:"price"`
+	}
+	err = tx.GetContext(dbCtx, &existing, `
+		SELECT v.id, v.appointment_id, v.idempotency_key, p.price
+		FROM appointment_visits v
+		LEFT JOIN visit_payments p ON p.visit_id = v.id
+		WHERE v.idempotency_key = $1 OR v.appointment_id = $2
+		ORDER BY v.idempotency_key = $1 DESC NULLS LAST
+		LIMIT 1`, key, visit.AppointmentID)
+	switch {
+	case err == nil:
+		if existing.IdempotencyKey == nil || *existing.IdempotencyKey != key {
+			return 0, 0, ErrAppointmentAlreadyCompleted
+		}
+		if existing.AppointmentID != visit.AppointmentID {
+			return 0, 0, ErrIdempotencyKeyReused
+		}
+		return existing.ID, existing.Price.Int32, nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return 0, 0, fmt.Errorf("не удалось проверить, завершён ли приём: %w", err)
+	}
+	if appointmentStatus == "cancelled" {
+		return 0, 0, ErrAppointmentCancelled
+	}
+
+	now := time.Now()
+	_, err = tx.ExecContext(dbCtx, "UPDATE appointments SET status = 'completed', updated_at = $2 WHERE id = $1", visit.AppointmentID, now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("не удалось отметить запись завершённой: %w", err)
+	}
+
+	query, args, err := s.builder.
+		Insert("appointment_visits").
+		SetMap(map[string]any{
+			"appointment_id":  visit.AppointmentID,
+			"patient_id":      visit.PatientID,
+			"doctor_id":       visit.DoctorID,
+			"complaints":      visit.Complaints,
+			"treatment_plan":  visit.TreatmentPlan,
+			"created_at":      now,
+			"updated_at":      now,
+			"idempotency_key": key,
+		}).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, 0, fmt.Errorf("не удалось сформировать запрос для добавления приема: %w", err)
+	}
+	var visitID model.VisitID
+	if err := tx.QueryRowxContext(dbCtx, query, args...).Scan(&visitID); err != nil {
+		return 0, 0, fmt.Errorf("не удалось выполнить запрос для добавления приема: %w", err)
+	}
+
+	if len(consultation.Diagnoses) > 0 {
+		insert := s.builder.
+			Insert("appointment_diagnoses").
+			Columns("visit_id", "icd_code_id", "diagnosis_note", "tooth", "surfaces")
+		for _, d := range consultation.Diagnoses {
+			insert = insert.Values(visitID, d.ICDCodeID, d.DiagnosisNote, d.Tooth, d.Surfaces)
+		}
+		if err := execInsert(dbCtx, tx, insert, "диагнозов"); err != nil {
+			if isCheckViolation(err) {
+				return 0, 0, ErrInvalidTooth.Detailf("в диагнозе")
+			}
+			return 0, 0, err
+		}
+	}
+	if len(consultation.Services) > 0 {
+		if err := priceVisitServices(dbCtx, tx, consultation.Services, today); err != nil {
+			return 0, 0, err
+		}
+		insert := s.builder.
+			Insert("appointment_services").
+			Columns("visit_id", "service_id", "quantity", "price_per_unit", "total_price", "tooth", "surfaces")
+		for _, m := range consultation.Services {
+			insert = insert.Values(visitID, m.ServiceID, m.Quantity, m.PricePerUnit, m.TotalPrice, m.Tooth, m.Surfaces)
+		}
+		if err := execInsert(dbCtx, tx, insert, "оказанных во время приема услуг"); err != nil {
+			if isCheckViolation(err) {
+				return 0, 0, ErrInvalidTooth.Detailf("в оказанной услуге")
+			}
+			return 0, 0, err
+		}
+	}
+	if len(consultation.Materials) > 0 {
+		if err := priceVisitMaterials(dbCtx, tx, consultation.Materials, today); err != nil {
+			return 0, 0, err
+		}
+		insert := s.builder.
+			Insert("appointment_materials").
+			Columns("visit_id", "material_id", "quantity_used", "price_per_unit", "total_price")
+		for _, m := range consultation.Materials {
+			insert = insert.Values(visitID, m.MaterialID, m.QuantityUsed, m.PricePerUnit, m.TotalPrice)
+		}
+		if err := execInsert(dbCtx, tx, insert, "материалов, использованных во время приема"); err != nil {
+			return 0, 0, err
+		}
+		if err := consumeVisitMaterials(dbCtx, tx, visitID, consultation.Materials, today); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if len(consultation.PlanItemIDs) > 0 {
+		if err := completeTreatmentPlanItems(dbCtx, tx, visitID, visit.PatientID, consultation.PlanItemIDs); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if len(consultation.Chart) > 0 {
+		doctorID := visit.DoctorID
+		if err := insertToothRecords(dbCtx, tx, visit.PatientID, &doctorID, &visitID, consultation.Chart); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	invoiceID, err := issueInvoice(dbCtx, tx, visitID)
+	if err != nil {
+		return 0, 0, err
+	}
+	invoice, err := refreshInvoice(dbCtx, tx, invoiceID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, 0, fmt.Errorf("не удалось зафиксировать транзакцию для завершения приёма: %w", err)
+	}
+	return visitID, int32(invoice.Total), nil
+}
+
+// execInsert выполняет вставку в транзакции; what — что добавляется, для текста ошибки
+func execInsert(ctx context.Context, tx *sqlx.Tx, insert squirrel.InsertBuilder, what string) error {
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать SQL-запрос для добавления %s: %w", what, err)
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("не удалось выполнить запрос добавления %s: %w", what, err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/jmoiron/sqlx"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦЫ DENTAL_CHART_ENTRIES
+
+const toothRecordColumns = "id, patient_id, tooth, status, surfaces, note, visit_id, doctor_id, recorded_at"
+
+// RecordToothStates Изменение зубной формулы вне приёма, например по результатам первичного осмотра.
+// Прежние записи не меняются, поэтому история зуба сохраняется; возвращается обновлённая формула
+func (s *Store) RecordToothStates(ctx context.Context, patientID model.UserID, doctorID *model.UserID, records []model.ToothRecord) ([]model.ToothRecord, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось начать транзакцию для изменения зубной формулы: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := insertToothRecords(dbCtx, tx, patientID, doctorID, nil, records); err != nil {
+		return nil, err
+	}
+	chart, err := dentalChart(dbCtx, tx, patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("не удалось зафиксировать транзакцию для изменения зубной формулы: %w", err)
+	}
+	return chart, nil
+}
+
+// GetDentalChart Текущая зубная формула пациента: последняя запись по каждому зубу. Зубов без записей
+// в ответе нет, они считаются здоровыми
+func (s *Store) GetDentalChart(ctx context.Context, patientID model.UserID) ([]model.ToothRecord, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	return dentalChart(dbCtx, s.db, patientID)
+}
+
+// GetToothHistory История зубной формулы пациента, новые записи первыми; tooth = 0 — по всем зубам
+func (s *Store) GetToothHistory(ctx context.Context, patientID model.UserID, tooth int) ([]model.ToothRecord, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var records []model.ToothRecord
+	err := s.db.SelectContext(dbCtx, &records, `
+		SELECT `+toothRecordColumns+`
+		FROM dental_chart_entries
+		WHERE patient_id = $1 AND ($2 = 0 OR tooth = $2)
+		ORDER BY recorded_at DESC, id DESC`, patientID, tooth)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить историю зубной формулы: %w", err)
+	}
+	return records, nil
+}
+
+// insertToothRecords добавляет записи зубной формулы пациента; visitID пуст, если формула меняется вне приёма
+func insertToothRecords(ctx context.Context, tx *sqlx.Tx, patientID model.UserID, doctorID *model.UserID, visitID *model.VisitID, records []model.ToothRecord) error {
+	for _, record := range records {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO dental_chart_entries (patient_id, tooth, status, surfaces, note, visit_id, doctor_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			patientID, record.Tooth, record.Status, record.Surfaces, record.Note, visitID, doctorID)
+		if err != nil {
+			if isCheckViolation(err) {
+				return ErrInvalidTooth.Detailf("зуб %d", record.Tooth)
+			}
+			if isForeignKeyViolation(err) {
+				return ErrPatientNotFound
+			}
+			return fmt.Errorf("не удалось добавить запись зубной формулы: %w", err)
+		}
+	}
+	return nil
+}
+
+// dentalChart последняя запись по каждому зубу пациента
+func dentalChart(ctx context.Context, q sqlx.QueryerContext, patientID model.UserID) ([]model.ToothRecord, error) {
+	var chart []model.ToothRecord
+	err := sqlx.SelectContext(ctx, q, &chart, `
+		SELECT DISTINCT ON (tooth) `+toothRecordColumns+`
+		FROM dental_chart_entries
+		WHERE patient_id = $1
+		ORDER BY tooth, recorded_at DESC, id DESC`, patientID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить зубную формулу пациента: %w", err)
+	}
+	return chart, nil
+}
@@ -6,16 +6,25 @@ import (
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/storage/internal/model"
 	"github.com/Masterminds/squirrel"
+	"strings"
 )
 
-// GetICDCodes Получение списка всех кодов МКБ
-func (s *Store) GetICDCodes(ctx context.Context) ([]model.ICD, error) {
-	query, args, err := s.builder.
-		Select("*").
-		From("icd_codes").
-		ToSql()
+// icdOrder допустимые поля сортировки списка кодов МКБ
+var icdOrder = listOrder{
+	"code": {"code"},
+	"name": {"name", "code"},
+}
+
+// GetICDCodes Получение страницы списка кодов МКБ и числа всех кодов МКБ, найденных по filter
+func (s *Store) GetICDCodes(ctx context.Context, filter model.ICDFilter, page model.ListPage) ([]model.ICD, int, error) {
+	where := icdSearch(filter.Query)
+	builder, err := paginate(s.builder.Select("*").From("icd_codes").Where(where), page, icdOrder, "code")
+	if err != nil {
+		return nil, 0, err
+	}
+	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("не удалось сформировать запрос для получения списка кодов МКБ: %w", err)
+		return nil, 0, fmt.Errorf("не удалось сформировать запрос для получения списка кодов МКБ: %w", err)
 	}
 
 	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
@@ -24,10 +33,26 @@ func (s *Store) GetICDCodes(ctx context.Context) ([]model.ICD, error) {
 	var icd []model.ICD
 	err = s.db.SelectContext(dbCtx, &icd, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("не удалось выполнить запрос для получения списка кодов МКБ: %w", err)
+		return nil, 0, fmt.Errorf("не удалось выполнить запрос для получения списка кодов МКБ: %w", err)
 	}
 
-	return icd, nil
+	total, err := s.listTotal(ctx, "icd_codes", where, page, len(icd))
+	if err != nil {
+		return nil, 0, err
+	}
+	return icd, total, nil
+}
+
+// icdSearch условие поиска кодов МКБ: код начинается с запроса или название содержит его
+func icdSearch(q string) squirrel.Sqlizer {
+	q = strings.TrimSpace(q)
+	if q == "" {
+		return squirrel.And{}
+	}
+	return squirrel.Or{
+		squirrel.ILike{"code": escapeLike(q) + "%"},
+		squirrel.ILike{"name": likePattern(q)},
+	}
 }
 
 func (s *Store) GetICDCodeByID(ctx context.Context, id model.ICDCodeID) (model.ICD, error) {
@@ -130,10 +155,10 @@ func (s *Store) AddDiagnoses(ctx context.Context, diagnoses []model.Diagnose) er
 
 	builder := s.builder.
 		Insert("appointment_diagnoses").
-		Columns("visit_id", "icd_code_id", "diagnosis_note")
+		Columns("visit_id", "icd_code_id", "diagnosis_note", "tooth", "surfaces")
 
 	for _, d := range diagnoses {
-		builder = builder.Values(d.VisitID, d.ICDCodeID, d.DiagnosisNote)
+		builder = builder.Values(d.VisitID, d.ICDCodeID, d.DiagnosisNote, d.Tooth, d.Surfaces)
 	}
 	query, args, err := builder.ToSql()
 	if err != nil {
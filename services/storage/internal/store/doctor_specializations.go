@@ -26,6 +26,7 @@ func (s *Store) GetDoctorsBySpecializationID(ctx context.Context, id model.SpecI
 		From("doctor_specializations ds").
 		Join("doctors d ON ds.doctor_id = d.user_id").
 		Where(squirrel.Eq{"ds.specialization_id": id}).
+		Where("d.user_id IN (SELECT id FROM users WHERE status = ?)", model.UserActive). // отключённых врачей пациентам не показываем
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("не удалось сформировать запрос для получения врачей по специализации: %w", err)
@@ -8,14 +8,22 @@ import (
 	"github.com/Masterminds/squirrel"
 )
 
-// GetDoctors Получение списка всех врачей
-func (s *Store) GetDoctors(ctx context.Context) ([]model.Doctor, error) {
-	query, args, err := s.builder.
-		Select("*").
-		From("doctors").
-		ToSql()
+// doctorOrder допустимые поля сортировки списка врачей
+var doctorOrder = listOrder{
+	"name":       {"second_name", "first_name", "surname", "user_id"},
+	"experience": {"experience", "user_id"},
+}
+
+// GetDoctors Получение страницы списка врачей и числа всех врачей, найденных по filter
+func (s *Store) GetDoctors(ctx context.Context, filter model.PersonFilter, page model.ListPage) ([]model.Doctor, int, error) {
+	where := squirrel.And{userStatusCondition(filter.Inactive), fullNameSearch(filter.Query)}
+	builder, err := paginate(s.builder.Select("*").From("doctors").Where(where), page, doctorOrder, "name")
+	if err != nil {
+		return nil, 0, err
+	}
+	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("не удалось сформировать запрос для получения списка врачей: %w", err)
+		return nil, 0, fmt.Errorf("не удалось сформировать запрос для получения списка врачей: %w", err)
 	}
 
 	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
@@ -24,10 +32,14 @@ func (s *Store) GetDoctors(ctx context.Context) ([]model.Doctor, error) {
 	var doctors []model.Doctor
 	err = s.db.SelectContext(dbCtx, &doctors, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("не удалось выполнить запрос для получения списка врачей: %w", err)
+		return nil, 0, fmt.Errorf("не удалось выполнить запрос для получения списка врачей: %w", err)
 	}
 
-	return doctors, nil
+	total, err := s.listTotal(ctx, "doctors", where, page, len(doctors))
+	if err != nil {
+		return nil, 0, err
+	}
+	return doctors, total, nil
 }
 
 // GetDoctorByID Получение врача по id
@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"time"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦЫ DOCUMENT_UPLOADS
+
+// AddDocumentUpload Создание загрузки документа
+func (s *Store) AddDocumentUpload(ctx context.Context, upload model.DocumentUpload) error {
+	query, args, err := s.builder.
+		Insert("document_uploads").
+		SetMap(map[string]any{
+			"id":           upload.ID,
+			"patient_id":   upload.PatientID,
+			"file_name":    upload.FileName,
+			"modality":     upload.Modality,
+			"study_date":   upload.StudyDate,
+			"description":  upload.Description,
+			"size_bytes":   upload.SizeBytes,
+			"preview_path": upload.PreviewPath,
+		}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для создания загрузки документа: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	if _, err := s.db.ExecContext(dbCtx, query, args...); err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для создания загрузки документа: %w", err)
+	}
+	return nil
+}
+
+// GetDocumentUpload Получение загрузки документа
+func (s *Store) GetDocumentUpload(ctx context.Context, id uuid.UUID) (model.DocumentUpload, error) {
+	query, args, err := s.builder.
+		Select("*").
+		From("document_uploads").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return model.DocumentUpload{}, fmt.Errorf("не удалось сформировать запрос для получения загрузки документа: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var upload model.DocumentUpload
+	err = s.db.GetContext(dbCtx, &upload, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.DocumentUpload{}, ErrDocumentUploadNotFound
+	}
+	if err != nil {
+		return model.DocumentUpload{}, fmt.Errorf("не удалось выполнить запрос для получения загрузки документа: %w", err)
+	}
+	return upload, nil
+}
+
+// CompleteDocumentUpload Сохранение документа по завершённой загрузке. Документ и отметка о завершении
+// записываются в одной транзакции, поэтому повторное завершение не создаёт второй документ
+func (s *Store) CompleteDocumentUpload(ctx context.Context, uploadID uuid.UUID, doc *model.PatientDocument) error {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию для завершения загрузки документа: %w", err)
+	}
+	defer tx.Rollback()
+
+	var documentID *uuid.UUID
+	err = tx.GetContext(dbCtx, &documentID, "SELECT document_id FROM document_uploads WHERE id = $1 FOR UPDATE", uploadID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrDocumentUploadNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("не удалось заблокировать загрузку документа: %w", err)
+	}
+	if documentID != nil {
+		doc.ID = *documentID
+		return nil
+	}
+
+	query, args, err := insertDocumentQuery(s.builder, doc)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(dbCtx, query, args...); err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для сохранения данных о документе: %w", err)
+	}
+	if _, err := tx.ExecContext(dbCtx, "UPDATE document_uploads SET document_id = $1 WHERE id = $2", doc.ID, uploadID); err != nil {
+		return fmt.Errorf("не удалось отметить загрузку документа завершённой: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("не удалось зафиксировать транзакцию для завершения загрузки документа: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpiredDocumentUploads Удаление загрузок, созданных раньше before. Возвращает их идентификаторы,
+// чтобы удалить промежуточные файлы
+func (s *Store) DeleteExpiredDocumentUploads(ctx context.Context, before time.Time) ([]uuid.UUID, error) {
+	query, args, err := s.builder.
+		Delete("document_uploads").
+		Where(squirrel.Lt{"created_at": before}).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сформировать запрос для удаления устаревших загрузок документов: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var ids []uuid.UUID
+	if err := s.db.SelectContext(dbCtx, &ids, query, args...); err != nil {
+		return nil, fmt.Errorf("не удалось выполнить запрос для удаления устаревших загрузок документов: %w", err)
+	}
+	return ids, nil
+}
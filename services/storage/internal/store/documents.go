@@ -2,13 +2,16 @@ package store
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/storage/internal/model"
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
 )
 
-func (s *Store) SaveDocument(ctx context.Context, doc *model.PatientDocument) error {
+// insertDocumentQuery запрос на добавление метаданных документа
+func insertDocumentQuery(builder squirrel.StatementBuilderType, doc *model.PatientDocument) (string, []any, error) {
 	fields := map[string]any{
 		"id":           doc.ID,
 		"patient_id":   doc.PatientID,
@@ -18,20 +21,18 @@ func (s *Store) SaveDocument(ctx context.Context, doc *model.PatientDocument) er
 		"description":  doc.Description,
 		"storage_path": doc.StoragePath,
 		"preview_path": doc.PreviewPath,
+		"checksum":     doc.Checksum,
+		"size_bytes":   doc.SizeBytes,
 		"created_at":   doc.CreatedAt,
 	}
-	query, args, err := s.builder.
+	query, args, err := builder.
 		Insert("patient_documents").
 		SetMap(fields).
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("не удалось создать запрос для сохранения данных о документе: %w", err)
-	}
-	_, err = s.db.ExecContext(ctx, query, args...)
-	if err != nil {
-		return fmt.Errorf("не удалось выполнить запрос для сохранения данных о документе: %w", err)
+		return "", nil, fmt.Errorf("не удалось создать запрос для сохранения данных о документе: %w", err)
 	}
-	return nil
+	return query, args, nil
 }
 
 func (s *Store) GetDocumentsByPatient(ctx context.Context, patientID model.UserID) ([]model.PatientDocument, error) {
@@ -63,8 +64,52 @@ func (s *Store) GetDocumentByID(ctx context.Context, id uuid.UUID) (*model.Patie
 	}
 
 	var doc model.PatientDocument
-	if err := s.db.GetContext(ctx, &doc, query, args...); err != nil {
+	err = s.db.GetContext(ctx, &doc, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDocumentNotFound
+	}
+	if err != nil {
 		return nil, fmt.Errorf("не удалось выполнить запрос для получения информации о документе: %w", err)
 	}
 	return &doc, nil
 }
+
+// GetLegacyDocuments документы, файлы которых ещё лежат по путям на диске, а не в хранилище документов
+func (s *Store) GetLegacyDocuments(ctx context.Context) ([]model.PatientDocument, error) {
+	query, args, err := s.builder.
+		Select("*").
+		From("patient_documents").
+		Where("checksum IS NULL").
+		OrderBy("created_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сформировать запрос для получения не перенесённых документов: %w", err)
+	}
+
+	var docs []model.PatientDocument
+	if err := s.db.SelectContext(ctx, &docs, query, args...); err != nil {
+		return nil, fmt.Errorf("не удалось выполнить запрос для получения не перенесённых документов: %w", err)
+	}
+	return docs, nil
+}
+
+// UpdateDocumentStorage записывает ключи объектов и контрольную сумму документа после переноса в хранилище документов
+func (s *Store) UpdateDocumentStorage(ctx context.Context, doc *model.PatientDocument) error {
+	query, args, err := s.builder.
+		Update("patient_documents").
+		SetMap(map[string]any{
+			"storage_path": doc.StoragePath,
+			"preview_path": doc.PreviewPath,
+			"checksum":     doc.Checksum,
+			"size_bytes":   doc.SizeBytes,
+		}).
+		Where(squirrel.Eq{"id": doc.ID}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для обновления хранения документа: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для обновления хранения документа: %w", err)
+	}
+	return nil
+}
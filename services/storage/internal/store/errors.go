@@ -0,0 +1,219 @@
+package store
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"github.com/lib/pq"
+	"strings"
+)
+
+// ErrNotFound запись не найдена; в неё переводится sql.ErrNoRows методов без собственной ошибки «не найдено»
+var ErrNotFound = apperr.NotFound("not_found", "запись не найдена")
+
+// TranslateError переводит в доменные ошибки то, что методы хранилища возвращают без типизации;
+// используется перехватчиком gRPC-сервера
+func TranslateError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: %v", ErrNotFound, err)
+	}
+	return err
+}
+
+// ErrAppointmentSlotTaken слот врача уже занят другой записью или удерживается другим пациентом
+var ErrAppointmentSlotTaken = apperr.AlreadyExists("appointment_slot_taken", "выбранное время уже занято")
+
+// ErrSlotHoldNotFound удержания слота нет или оно принадлежит другому пользователю
+var ErrSlotHoldNotFound = apperr.NotFound("slot_hold_not_found", "удержание слота не найдено")
+
+const (
+	pqUniqueViolation     = "23505"
+	pqExclusionViolation  = "23P01"
+	pqCheckViolation      = "23514"
+	pqForeignKeyViolation = "23503"
+	pqRestrictViolation   = "23001"
+)
+
+// isSlotConflict ошибка нарушения уникальности слота или ограничения на пересечение записей врача
+func isSlotConflict(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && (pqErr.Code == pqUniqueViolation || pqErr.Code == pqExclusionViolation)
+}
+
+var (
+	// ErrWaitingListEntryNotFound запись листа ожидания не найдена или принадлежит другому пациенту
+	ErrWaitingListEntryNotFound = apperr.NotFound("waiting_list_entry_not_found", "запись в листе ожидания не найдена")
+	// ErrWaitingListOfferNotFound предложение не найдено или адресовано другому пациенту
+	ErrWaitingListOfferNotFound = apperr.NotFound("waiting_list_offer_not_found", "предложение записи не найдено")
+	// ErrWaitingListOfferUnavailable предложение истекло или уже принято либо отклонено
+	ErrWaitingListOfferUnavailable = apperr.Conflict("waiting_list_offer_unavailable", "предложение записи истекло или уже обработано")
+)
+
+// ErrNotificationNotClaimed уведомление не находится в отправке: аренда истекла или результат уже записан
+var ErrNotificationNotClaimed = apperr.Conflict("notification_not_claimed", "уведомление не захвачено на отправку")
+
+var (
+	// ErrInvalidScheduleRule правило расписания нарушает ограничения таблицы (даты, день недели, часы)
+	ErrInvalidScheduleRule = apperr.Invalid("invalid_schedule_rule", "некорректное правило расписания")
+	// ErrScheduleRuleNotFound правило расписания не найдено
+	ErrScheduleRuleNotFound = apperr.NotFound("schedule_rule_not_found", "правило расписания не найдено")
+)
+
+// isCheckViolation ошибка нарушения CHECK-ограничения
+func isCheckViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pqCheckViolation
+}
+
+// invalidRow ошибка invalid для нарушенного CHECK-ограничения. Ограничение одного столбца (имя вида
+// <таблица>_<столбец>_check) передаётся клиенту как поле с ошибкой, текст ошибки PostgreSQL остаётся в журнале
+func invalidRow(invalid *apperr.Error, err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		name, ok := strings.CutSuffix(pqErr.Constraint, "_check")
+		if column, found := strings.CutPrefix(name, pqErr.Table+"_"); ok && found && column != "" {
+			invalid = invalid.WithFields(apperr.Field{Name: column, Message: invalid.Message})
+		}
+	}
+	return fmt.Errorf("%w: %v", invalid, err)
+}
+
+// ErrDocumentUploadNotFound загрузка документа не найдена или уже удалена по истечении срока
+var ErrDocumentUploadNotFound = apperr.NotFound("document_upload_not_found", "загрузка документа не найдена")
+
+var (
+	// ErrAppointmentNotFound запись на приём не найдена
+	ErrAppointmentNotFound = apperr.NotFound("appointment_not_found", "запись на приём не найдена")
+	// ErrAppointmentCancelled запись отменена, приём по ней провести нельзя
+	ErrAppointmentCancelled = apperr.Conflict("appointment_cancelled", "запись на приём отменена")
+	// ErrAppointmentAlreadyCompleted по записи уже сохранён приём с другим ключом идемпотентности
+	ErrAppointmentAlreadyCompleted = apperr.Conflict("appointment_already_completed", "приём по этой записи уже завершён")
+	// ErrIdempotencyKeyReused ключ идемпотентности уже использован для другой записи
+	ErrIdempotencyKeyReused = apperr.Invalid("idempotency_key_reused", "ключ идемпотентности уже использован для другого приёма")
+	// ErrAppointmentOtherDoctor приём по записи завершает не её врач
+	ErrAppointmentOtherDoctor = apperr.Forbidden("appointment_other_doctor", "запись на приём к другому врачу")
+	// ErrAppointmentPatientMismatch пациент приёма не совпадает с пациентом записи
+	ErrAppointmentPatientMismatch = apperr.Invalid("appointment_patient_mismatch", "пациент приёма не совпадает с пациентом записи")
+)
+
+var (
+	// ErrUnknownPriceKind прайс-лист не услуг и не материалов
+	ErrUnknownPriceKind = apperr.Invalid("unknown_price_kind", "неизвестный прайс-лист")
+	// ErrPriceItemNotFound услуга или материал, для которых задаётся цена, не найдены
+	ErrPriceItemNotFound = apperr.NotFound("price_item_not_found", "позиция прайс-листа не найдена")
+	// ErrInvalidPrice цена нарушает ограничения таблицы истории цен
+	ErrInvalidPrice = apperr.Invalid("invalid_price", "некорректная цена")
+	// ErrPriceNotFound на указанную дату у позиции не было цены
+	ErrPriceNotFound = apperr.NotFound("price_not_found", "на эту дату цена не задана")
+	// ErrPriceListEntryNotFound цена в истории не найдена
+	ErrPriceListEntryNotFound = apperr.NotFound("price_list_entry_not_found", "цена не найдена в истории")
+	// ErrPriceInEffect цена уже вступила в силу, отменить можно только запланированное изменение
+	ErrPriceInEffect = apperr.Conflict("price_in_effect", "цена уже действует, отменить можно только будущее изменение")
+	// ErrPriceBackdated цену нельзя установить задним числом: по прошедшим дням уже выставлены счета
+	ErrPriceBackdated = apperr.Invalid("price_backdated", "цену нельзя установить задним числом")
+)
+
+var (
+	// ErrInvoiceNotFound счёт не найден
+	ErrInvoiceNotFound = apperr.NotFound("invoice_not_found", "счёт не найден")
+	// ErrVisitNotFound визит, на который выставляется счёт, не найден
+	ErrVisitNotFound = apperr.NotFound("visit_not_found", "приём не найден")
+	// ErrInvoiceHasPayments скидку нельзя менять после первой оплаты: остаток уже принят в расчёт
+	ErrInvoiceHasPayments = apperr.Conflict("invoice_has_payments", "по счёту уже есть оплаты, скидку изменить нельзя")
+	// ErrInvalidDiscount процент вне 1..100 или фиксированная скидка больше суммы счёта
+	ErrInvalidDiscount = apperr.Invalid("invalid_discount", "некорректная скидка")
+	// ErrInvalidPayment сумма или способ оплаты нарушают ограничения таблицы движений по счёту
+	ErrInvalidPayment = apperr.Invalid("invalid_payment", "некорректная оплата")
+	// ErrOverpayment оплата больше остатка по счёту
+	ErrOverpayment = apperr.Conflict("overpayment", "сумма оплаты больше остатка по счёту")
+	// ErrInvoicePaymentNotFound оплата, по которой оформляется возврат, не найдена
+	ErrInvoicePaymentNotFound = apperr.NotFound("invoice_payment_not_found", "оплата не найдена")
+	// ErrRefundExceedsPayment возврат больше невозвращённой части оплаты
+	ErrRefundExceedsPayment = apperr.Conflict("refund_exceeds_payment", "сумма возврата больше невозвращённой части оплаты")
+	// ErrBillingDocumentNotFound номер документа по счёту не найден
+	ErrBillingDocumentNotFound = apperr.NotFound("billing_document_not_found", "документ по счёту не найден")
+	// ErrDocumentNotFound документ пациента не найден
+	ErrDocumentNotFound = apperr.NotFound("document_not_found", "документ не найден")
+)
+
+var (
+	// ErrMaterialNotFound материал не найден
+	ErrMaterialNotFound = apperr.NotFound("material_not_found", "материал не найден")
+	// ErrMaterialBatchNotFound партия материала не найдена
+	ErrMaterialBatchNotFound = apperr.NotFound("material_batch_not_found", "партия материала не найдена")
+	// ErrInvalidStockAdjustment корректировка уводит остаток партии в минус или выше поступившего количества
+	ErrInvalidStockAdjustment = apperr.Invalid("invalid_stock_adjustment", "некорректная корректировка остатка")
+	// ErrMaterialExpired расход на приёме покрывается только просроченными партиями материала
+	ErrMaterialExpired = apperr.Conflict("material_expired", "на складе остались только просроченные партии материала")
+)
+
+// isForeignKeyViolation ошибка нарушения внешнего ключа
+func isForeignKeyViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pqForeignKeyViolation
+}
+
+var (
+	// ErrTreatmentPlanNotFound план лечения не найден или принадлежит другому пациенту
+	ErrTreatmentPlanNotFound = apperr.NotFound("treatment_plan_not_found", "план лечения не найден")
+	// ErrTreatmentPlanItemNotFound позиция плана лечения не найдена
+	ErrTreatmentPlanItemNotFound = apperr.NotFound("treatment_plan_item_not_found", "позиция плана лечения не найдена")
+	// ErrInvalidTreatmentPlan план или позиция нарушают ограничения: этап, номер зуба по FDI, количество, услуга
+	ErrInvalidTreatmentPlan = apperr.Invalid("invalid_treatment_plan", "некорректный план лечения")
+	// ErrTreatmentPlanNotEditable позиции добавляются только в ещё не принятый пациентом план
+	ErrTreatmentPlanNotEditable = apperr.Conflict("treatment_plan_not_editable", "план лечения уже принят пациентом, изменить его состав нельзя")
+	// ErrTreatmentPlanNotProposed принять можно только предложенный план
+	ErrTreatmentPlanNotProposed = apperr.Conflict("treatment_plan_not_proposed", "план лечения уже принят или отменён")
+	// ErrTreatmentPlanNotAccepted начать или выполнить позицию можно только после принятия плана пациентом
+	ErrTreatmentPlanNotAccepted = apperr.Conflict("treatment_plan_not_accepted", "план лечения ещё не принят пациентом")
+	// ErrTreatmentPlanClosed план выполнен или отменён
+	ErrTreatmentPlanClosed = apperr.Conflict("treatment_plan_closed", "план лечения выполнен или отменён")
+	// ErrTreatmentPlanItemUnavailable позиция не из принятого плана пациента или уже выполнена
+	ErrTreatmentPlanItemUnavailable = apperr.Conflict("treatment_plan_item_unavailable", "позиция плана лечения не найдена в принятых планах пациента или уже выполнена")
+)
+
+var (
+	// ErrInvalidTooth номер зуба не по FDI, неизвестное состояние зуба или поверхности не из MOIDBL
+	ErrInvalidTooth = apperr.Invalid("invalid_tooth", "некорректный номер зуба, его состояние или поверхности")
+	// ErrPatientNotFound пациент не найден
+	ErrPatientNotFound = apperr.NotFound("patient_not_found", "пациент не найден")
+)
+
+var (
+	// ErrAnamnesisVersionConflict анкету изменили после того, как автор изменений её открыл
+	ErrAnamnesisVersionConflict = apperr.Conflict("anamnesis_version_conflict", "анкету уже изменили, обновите её и повторите сохранение")
+	// ErrInvalidAnamnesis анкета нарушает ограничения таблиц: тяжесть аллергии, длина названия аллергена, автор
+	ErrInvalidAnamnesis = apperr.Invalid("invalid_anamnesis", "некорректная анкета здоровья")
+)
+
+var (
+	// ErrClinicalAlertRuleNotFound правило клинического предупреждения не найдено
+	ErrClinicalAlertRuleNotFound = apperr.NotFound("clinical_alert_rule_not_found", "правило предупреждения не найдено")
+	// ErrInvalidClinicalAlertRule правило нарушает ограничения таблицы: вид условия, цель, важность
+	ErrInvalidClinicalAlertRule = apperr.Invalid("invalid_clinical_alert_rule", "некорректное правило предупреждения")
+	// ErrClinicalAlertTargetNotFound материал или услуга правила не найдены
+	ErrClinicalAlertTargetNotFound = apperr.NotFound("clinical_alert_target_not_found", "материал или услуга правила предупреждения не найдены")
+)
+
+var (
+	// ErrPatientAccessGrantNotFound допуск к карте не найден или уже отозван
+	ErrPatientAccessGrantNotFound = apperr.NotFound("patient_access_grant_not_found", "допуск к медицинской карте не найден или уже отозван")
+	// ErrInvalidPatientAccessGrant допуск нарушает ограничения таблицы: пустая причина, срок в прошлом
+	ErrInvalidPatientAccessGrant = apperr.Invalid("invalid_patient_access_grant", "некорректный допуск к медицинской карте")
+	// ErrPatientAccessGrantTarget пациент или врач допуска не найдены
+	ErrPatientAccessGrantTarget = apperr.NotFound("patient_access_grant_target_not_found", "пациент или врач допуска не найдены")
+)
+
+var (
+	// ErrUserNotFound пользователь не найден
+	ErrUserNotFound = apperr.NotFound("user_not_found", "пользователь не найден")
+	// ErrUserHasRecords у пользователя есть медицинские данные: удалить его нельзя, только вывести из работы
+	ErrUserHasRecords = apperr.Conflict("user_has_records", "у пользователя есть медицинские данные, удалить его нельзя")
+)
+
+// isRestrictViolation ошибка запрета удаления строки, на которую ссылаются другие данные
+func isRestrictViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == pqRestrictViolation
+}
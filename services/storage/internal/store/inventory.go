@@ -0,0 +1,324 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/jmoiron/sqlx"
+	"sort"
+	"time"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦ MATERIAL_BATCHES И MATERIAL_STOCK_MOVEMENTS
+
+const (
+	batchColumns    = "id, material_id, batch_number, quantity_received, quantity_left, expires_at, received_at, comment"
+	movementColumns = "id, material_id, batch_id, kind, quantity, visit_id, reason, created_at"
+	// movementsShown сколько последних движений возвращается вместе с остатком материала
+	movementsShown = 100
+)
+
+// ReceiveMaterialBatch Поступление партии материала: партия добавляется, остаток растёт
+func (s *Store) ReceiveMaterialBatch(ctx context.Context, batch model.MaterialBatch) (model.MaterialBatch, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.MaterialBatch{}, fmt.Errorf("не удалось начать транзакцию для поступления материала: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockMaterials(dbCtx, tx, []model.MaterialID{batch.MaterialID}); err != nil {
+		return model.MaterialBatch{}, err
+	}
+
+	var created model.MaterialBatch
+	err = tx.GetContext(dbCtx, &created, `
+		INSERT INTO material_batches (material_id, batch_number, quantity_received, quantity_left, expires_at, comment)
+		VALUES ($1, $2, $3, $3, $4, $5)
+		RETURNING `+batchColumns,
+		batch.MaterialID, batch.BatchNumber, batch.QuantityReceived, batch.ExpiresAt, batch.Comment)
+	if err != nil {
+		if isCheckViolation(err) {
+			return model.MaterialBatch{}, ErrInvalidStockAdjustment.Detailf("количество должно быть больше нуля")
+		}
+		return model.MaterialBatch{}, fmt.Errorf("не удалось добавить партию материала: %w", err)
+	}
+	if err := addStockMovement(dbCtx, tx, model.StockMovement{
+		MaterialID: batch.MaterialID,
+		BatchID:    &created.ID,
+		Kind:       model.StockMovementReceipt,
+		Quantity:   batch.QuantityReceived,
+		Reason:     batch.Comment,
+	}); err != nil {
+		return model.MaterialBatch{}, err
+	}
+	if err := changeStock(dbCtx, tx, batch.MaterialID, batch.QuantityReceived); err != nil {
+		return model.MaterialBatch{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.MaterialBatch{}, fmt.Errorf("не удалось зафиксировать транзакцию для поступления материала: %w", err)
+	}
+	return created, nil
+}
+
+// AdjustMaterialStock Корректировка остатка материала, в том числе по конкретной партии
+func (s *Store) AdjustMaterialStock(ctx context.Context, adj model.StockAdjustment) error {
+	if adj.Quantity == 0 {
+		return ErrInvalidStockAdjustment.Detailf("количество не может быть нулевым")
+	}
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return err
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию для корректировки остатка: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockMaterials(dbCtx, tx, []model.MaterialID{adj.MaterialID}); err != nil {
+		return err
+	}
+
+	switch {
+	case adj.BatchID != nil:
+		var batch model.MaterialBatch
+		err := tx.GetContext(dbCtx, &batch, "SELECT "+batchColumns+" FROM material_batches WHERE id = $1 AND material_id = $2 FOR UPDATE",
+			*adj.BatchID, adj.MaterialID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrMaterialBatchNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("не удалось получить партию материала: %w", err)
+		}
+		left := batch.QuantityLeft + adj.Quantity
+		if left < 0 || left > batch.QuantityReceived {
+			return ErrInvalidStockAdjustment.Detailf("остаток партии %d, поступило %d", batch.QuantityLeft, batch.QuantityReceived)
+		}
+		if _, err := tx.ExecContext(dbCtx, "UPDATE material_batches SET quantity_left = $2 WHERE id = $1", batch.ID, left); err != nil {
+			return fmt.Errorf("не удалось изменить остаток партии: %w", err)
+		}
+		if err := addStockMovement(dbCtx, tx, model.StockMovement{
+			MaterialID: adj.MaterialID,
+			BatchID:    &batch.ID,
+			Kind:       model.StockMovementAdjustment,
+			Quantity:   adj.Quantity,
+			Reason:     adj.Reason,
+		}); err != nil {
+			return err
+		}
+		if err := changeStock(dbCtx, tx, adj.MaterialID, adj.Quantity); err != nil {
+			return err
+		}
+	case adj.Quantity < 0:
+		if err := writeOff(dbCtx, tx, adj.MaterialID, -adj.Quantity, model.StockMovementAdjustment, nil, adj.Reason, today); err != nil {
+			return err
+		}
+	default:
+		if err := addStockMovement(dbCtx, tx, model.StockMovement{
+			MaterialID: adj.MaterialID,
+			Kind:       model.StockMovementAdjustment,
+			Quantity:   adj.Quantity,
+			Reason:     adj.Reason,
+		}); err != nil {
+			return err
+		}
+		if err := changeStock(dbCtx, tx, adj.MaterialID, adj.Quantity); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("не удалось зафиксировать транзакцию для корректировки остатка: %w", err)
+	}
+	return nil
+}
+
+// GetMaterialStock Остаток материала, партии с ненулевым остатком и последние движения
+func (s *Store) GetMaterialStock(ctx context.Context, id model.MaterialID) (model.MaterialStock, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var stock model.MaterialStock
+	err := s.db.GetContext(dbCtx, &stock.Material, "SELECT * FROM materials WHERE id = $1", id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.MaterialStock{}, ErrMaterialNotFound
+	}
+	if err != nil {
+		return model.MaterialStock{}, fmt.Errorf("не удалось получить материал: %w", err)
+	}
+	err = s.db.SelectContext(dbCtx, &stock.Batches, `
+		SELECT `+batchColumns+` FROM material_batches
+		WHERE material_id = $1 AND quantity_left > 0
+		ORDER BY expires_at NULLS LAST, received_at, id`, id)
+	if err != nil {
+		return model.MaterialStock{}, fmt.Errorf("не удалось получить партии материала: %w", err)
+	}
+	err = s.db.SelectContext(dbCtx, &stock.Movements, `
+		SELECT `+movementColumns+` FROM material_stock_movements
+		WHERE material_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2`, id, movementsShown)
+	if err != nil {
+		return model.MaterialStock{}, fmt.Errorf("не удалось получить движение материала: %w", err)
+	}
+	return stock, nil
+}
+
+// GetStockReport Материалы с остатком не выше порога или ушедшим в минус и партии с остатком,
+// срок годности которых истекает не позже expiringBefore, включая уже просроченные
+func (s *Store) GetStockReport(ctx context.Context, expiringBefore time.Time) (model.StockReport, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var report model.StockReport
+	err := s.db.SelectContext(dbCtx, &report.LowStock, `
+		SELECT * FROM materials
+		WHERE stock_quantity < 0 OR (low_stock_threshold > 0 AND stock_quantity <= low_stock_threshold)
+		ORDER BY stock_quantity - low_stock_threshold, name`)
+	if err != nil {
+		return model.StockReport{}, fmt.Errorf("не удалось получить материалы с низким остатком: %w", err)
+	}
+	err = s.db.SelectContext(dbCtx, &report.Expiring, `
+		SELECT b.id, b.material_id, b.batch_number, b.quantity_received, b.quantity_left, b.expires_at,
+		       b.received_at, b.comment, m.name AS material_name, m.unit
+		FROM material_batches b
+		JOIN materials m ON m.id = b.material_id
+		WHERE b.quantity_left > 0 AND b.expires_at <= $1
+		ORDER BY b.expires_at, m.name`, expiringBefore)
+	if err != nil {
+		return model.StockReport{}, fmt.Errorf("не удалось получить партии с истекающим сроком годности: %w", err)
+	}
+	return report, nil
+}
+
+// consumeVisitMaterials списывает со склада материалы, записанные на приём. Остатка может не хватить:
+// приём всё равно проводится, а остаток уходит в минус до оприходования. Исключение — когда на складе
+// есть только просроченные партии: такой расход отклоняется, пока их не спишут корректировкой
+func consumeVisitMaterials(ctx context.Context, tx *sqlx.Tx, visitID model.VisitID, materials []model.AppointmentMaterial, today time.Time) error {
+	used := make(map[model.MaterialID]int, len(materials))
+	for _, m := range materials {
+		if m.QuantityUsed > 0 {
+			used[m.MaterialID] += m.QuantityUsed
+		}
+	}
+	ids := make([]model.MaterialID, 0, len(used))
+	for id := range used {
+		ids = append(ids, id)
+	}
+	if err := lockMaterials(ctx, tx, ids); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := writeOff(ctx, tx, id, used[id], model.StockMovementConsumption, &visitID, "", today); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lockMaterials блокирует строки материалов в порядке id, чтобы одновременные списания не взаимоблокировались
+func lockMaterials(ctx context.Context, tx *sqlx.Tx, ids []model.MaterialID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	query, args, err := sqlx.In("SELECT id FROM materials WHERE id IN (?) ORDER BY id FOR UPDATE", ids)
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для блокировки материалов: %w", err)
+	}
+	var locked []model.MaterialID
+	if err := tx.SelectContext(ctx, &locked, tx.Rebind(query), args...); err != nil {
+		return fmt.Errorf("не удалось заблокировать материалы: %w", err)
+	}
+	if len(locked) != len(ids) {
+		return ErrMaterialNotFound
+	}
+	return nil
+}
+
+// writeOff списывает quantity материала по партиям: сначала непросроченные с ближайшим сроком годности,
+// затем просроченные. Расход на приёме просроченные партии не затрагивает: если непросроченных не хватает,
+// а просроченные есть, списание отклоняется с ErrMaterialExpired. Не покрытое партиями количество записывается
+// в журнал без партии
+func writeOff(ctx context.Context, tx *sqlx.Tx, materialID model.MaterialID, quantity int, kind string,
+	visitID *model.VisitID, reason string, today time.Time) error {
+	var batches []model.MaterialBatch
+	err := tx.SelectContext(ctx, &batches, `
+		SELECT `+batchColumns+` FROM material_batches
+		WHERE material_id = $1 AND quantity_left > 0
+		ORDER BY (expires_at IS NOT NULL AND expires_at < $2), expires_at NULLS LAST, received_at, id
+		FOR UPDATE`, materialID, today)
+	if err != nil {
+		return fmt.Errorf("не удалось получить партии материала для списания: %w", err)
+	}
+
+	remaining := quantity
+	expired := 0
+	for _, batch := range batches {
+		if remaining == 0 {
+			break
+		}
+		if kind == model.StockMovementConsumption && batch.ExpiresAt != nil && batch.ExpiresAt.Before(today) {
+			expired += batch.QuantityLeft
+			continue
+		}
+		take := min(batch.QuantityLeft, remaining)
+		if _, err := tx.ExecContext(ctx, "UPDATE material_batches SET quantity_left = quantity_left - $2 WHERE id = $1", batch.ID, take); err != nil {
+			return fmt.Errorf("не удалось списать материал с партии: %w", err)
+		}
+		if err := addStockMovement(ctx, tx, model.StockMovement{
+			MaterialID: materialID,
+			BatchID:    &batch.ID,
+			Kind:       kind,
+			Quantity:   -take,
+			VisitID:    visitID,
+			Reason:     reason,
+		}); err != nil {
+			return err
+		}
+		remaining -= take
+	}
+	if remaining > 0 && expired > 0 {
+		return ErrMaterialExpired.Detailf("материал %d: не хватает %d, просроченный остаток %d", materialID, remaining, expired)
+	}
+	if remaining > 0 {
+		if err := addStockMovement(ctx, tx, model.StockMovement{
+			MaterialID: materialID,
+			Kind:       kind,
+			Quantity:   -remaining,
+			VisitID:    visitID,
+			Reason:     reason,
+		}); err != nil {
+			return err
+		}
+	}
+	return changeStock(ctx, tx, materialID, -quantity)
+}
+
+func addStockMovement(ctx context.Context, tx *sqlx.Tx, m model.StockMovement) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO material_stock_movements (material_id, batch_id, kind, quantity, visit_id, reason)
+		VALUES ($1, $2, $3, $4, $5, $6)`, m.MaterialID, m.BatchID, m.Kind, m.Quantity, m.VisitID, m.Reason)
+	if err != nil {
+		return fmt.Errorf("не удалось записать движение материала: %w", err)
+	}
+	return nil
+}
+
+func changeStock(ctx context.Context, tx *sqlx.Tx, materialID model.MaterialID, delta int) error {
+	_, err := tx.ExecContext(ctx, "UPDATE materials SET stock_quantity = stock_quantity + $2 WHERE id = $1", materialID, delta)
+	if err != nil {
+		return fmt.Errorf("не удалось изменить остаток материала: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,400 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦ INVOICES, INVOICE_LINES И INVOICE_PAYMENTS
+
+// invoiceColumns колонки счёта вместе с внесённой суммой за вычетом возвратов
+const invoiceColumns = `
+	i.id, i.visit_id, i.patient_id, i.subtotal, i.discount_kind, i.discount_value, i.discount_reason,
+	i.discount_amount, i.total, i.status, i.created_at, i.updated_at,
+	COALESCE((
+		SELECT SUM(CASE WHEN p.kind = 'payment' THEN p.amount ELSE -p.amount END)
+		FROM invoice_payments p WHERE p.invoice_id = i.id
+	), 0) AS paid`
+
+// IssueInvoice Счёт на приём. Если счёт уже выставлен, возвращается он; иначе выставляется по услугам
+// и материалам визита без скидки
+func (s *Store) IssueInvoice(ctx context.Context, visitID model.VisitID) (model.Invoice, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось начать транзакцию для выставления счёта: %w", err)
+	}
+	defer tx.Rollback()
+
+	// блокировка визита упорядочивает одновременное выставление счёта на один приём
+	var exists bool
+	err = tx.GetContext(dbCtx, &exists, "SELECT true FROM appointment_visits WHERE id = $1 FOR UPDATE", visitID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Invoice{}, ErrVisitNotFound
+	}
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось получить приём для выставления счёта: %w", err)
+	}
+
+	var id model.InvoiceID
+	err = tx.GetContext(dbCtx, &id, "SELECT id FROM invoices WHERE visit_id = $1", visitID)
+	if errors.Is(err, sql.ErrNoRows) {
+		id, err = issueInvoice(dbCtx, tx, visitID)
+	}
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось выставить счёт: %w", err)
+	}
+	invoice, err := getInvoice(dbCtx, tx, id, false)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось зафиксировать транзакцию для выставления счёта: %w", err)
+	}
+	return invoice, nil
+}
+
+// GetInvoice Счёт со строками и движениями денег
+func (s *Store) GetInvoice(ctx context.Context, id model.InvoiceID) (model.Invoice, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	return getInvoice(dbCtx, s.db, id, false)
+}
+
+// SetInvoiceDiscount Установка скидки на счёт; nil снимает скидку. Менять скидку можно только до первой оплаты;
+// выданный номер счёта на оплату при этом аннулируется
+func (s *Store) SetInvoiceDiscount(ctx context.Context, id model.InvoiceID, discount *model.Discount) (model.Invoice, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось начать транзакцию для установки скидки: %w", err)
+	}
+	defer tx.Rollback()
+
+	invoice, err := getInvoice(dbCtx, tx, id, true)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+	if len(invoice.Payments) > 0 {
+		return model.Invoice{}, ErrInvoiceHasPayments
+	}
+
+	fields := map[string]any{
+		"discount_kind":   nil,
+		"discount_value":  0,
+		"discount_reason": nil,
+		"discount_amount": 0,
+		"total":           invoice.Subtotal,
+		"updated_at":      squirrel.Expr("now()"),
+	}
+	if discount != nil {
+		amount, err := discountAmount(invoice.Subtotal, *discount)
+		if err != nil {
+			return model.Invoice{}, err
+		}
+		fields["discount_kind"] = discount.Kind
+		fields["discount_value"] = discount.Value
+		fields["discount_reason"] = discount.Reason
+		fields["discount_amount"] = amount
+		fields["total"] = invoice.Subtotal - amount
+	}
+	query, args, err := s.builder.
+		Update("invoices").
+		SetMap(fields).
+		Where("id = ?", id).
+		ToSql()
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось сформировать запрос для установки скидки: %w", err)
+	}
+	if _, err := tx.ExecContext(dbCtx, query, args...); err != nil {
+		if isCheckViolation(err) {
+			return model.Invoice{}, invalidRow(ErrInvalidDiscount, err)
+		}
+		return model.Invoice{}, fmt.Errorf("не удалось выполнить запрос для установки скидки: %w", err)
+	}
+
+	if err := voidInvoiceDocuments(dbCtx, tx, id); err != nil {
+		return model.Invoice{}, err
+	}
+	invoice, err = refreshInvoice(dbCtx, tx, id)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось зафиксировать транзакцию для установки скидки: %w", err)
+	}
+	return invoice, nil
+}
+
+// AddInvoicePayment Оплата по счёту, в том числе частичная. Оплата больше остатка не принимается
+func (s *Store) AddInvoicePayment(ctx context.Context, id model.InvoiceID, payment model.InvoicePayment) (model.Invoice, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось начать транзакцию для оплаты счёта: %w", err)
+	}
+	defer tx.Rollback()
+
+	invoice, err := getInvoice(dbCtx, tx, id, true)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+	if payment.Amount > invoice.Balance() {
+		return model.Invoice{}, ErrOverpayment.Detailf("остаток %d", invoice.Balance())
+	}
+
+	insert := s.builder.
+		Insert("invoice_payments").
+		Columns("invoice_id", "kind", "amount", "method", "comment").
+		Values(id, model.InvoicePaymentKindPayment, payment.Amount, payment.Method, payment.Comment)
+	if err := execPaymentInsert(dbCtx, tx, insert); err != nil {
+		return model.Invoice{}, err
+	}
+
+	invoice, err = refreshInvoice(dbCtx, tx, id)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось зафиксировать транзакцию для оплаты счёта: %w", err)
+	}
+	return invoice, nil
+}
+
+// RefundInvoicePayment Возврат по оплате тем же способом. Возвратов по одной оплате может быть несколько,
+// в сумме не больше самой оплаты
+func (s *Store) RefundInvoicePayment(ctx context.Context, paymentID model.InvoicePaymentID, amount int, comment string) (model.Invoice, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось начать транзакцию для возврата: %w", err)
+	}
+	defer tx.Rollback()
+
+	var original model.InvoicePayment
+	err = tx.GetContext(dbCtx, &original, `
+		SELECT id, invoice_id, kind, amount, method, refund_of, comment, created_at
+		FROM invoice_payments WHERE id = $1 AND kind = 'payment'`, paymentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Invoice{}, ErrInvoicePaymentNotFound
+	}
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось получить оплату для возврата: %w", err)
+	}
+	// блокировка счёта упорядочивает возвраты по одной оплате
+	if _, err := getInvoice(dbCtx, tx, original.InvoiceID, true); err != nil {
+		return model.Invoice{}, err
+	}
+
+	var refunded int
+	err = tx.GetContext(dbCtx, &refunded, "SELECT COALESCE(SUM(amount), 0) FROM invoice_payments WHERE refund_of = $1", paymentID)
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось получить сумму возвратов по оплате: %w", err)
+	}
+	if amount > original.Amount-refunded {
+		return model.Invoice{}, ErrRefundExceedsPayment.Detailf("доступно %d", original.Amount-refunded)
+	}
+
+	insert := s.builder.
+		Insert("invoice_payments").
+		Columns("invoice_id", "kind", "amount", "method", "refund_of", "comment").
+		Values(original.InvoiceID, model.InvoicePaymentKindRefund, amount, original.Method, paymentID, comment)
+	if err := execPaymentInsert(dbCtx, tx, insert); err != nil {
+		return model.Invoice{}, err
+	}
+
+	invoice, err := refreshInvoice(dbCtx, tx, original.InvoiceID)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+	if err = tx.Commit(); err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось зафиксировать транзакцию для возврата: %w", err)
+	}
+	return invoice, nil
+}
+
+// GetPatientBalance Расчёты с пациентом: выставлено, внесено и остаток по всем счетам
+func (s *Store) GetPatientBalance(ctx context.Context, patientID model.UserID) (model.PatientBalance, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var invoices []model.Invoice
+	err := s.db.SelectContext(dbCtx, &invoices, "SELECT "+invoiceColumns+" FROM invoices i WHERE i.patient_id = $1 ORDER BY i.created_at", patientID)
+	if err != nil {
+		return model.PatientBalance{}, fmt.Errorf("не удалось получить счета пациента: %w", err)
+	}
+
+	balance := model.PatientBalance{PatientID: patientID}
+	for _, invoice := range invoices {
+		balance.Invoiced += invoice.Total
+		balance.Paid += invoice.Paid
+		if invoice.Balance() > 0 {
+			balance.OpenInvoices = append(balance.OpenInvoices, invoice)
+		}
+	}
+	balance.Outstanding = balance.Invoiced - balance.Paid
+	return balance, nil
+}
+
+// issueInvoice выставляет счёт по услугам и материалам визита по ценам, зафиксированным в его строках
+func issueInvoice(ctx context.Context, tx *sqlx.Tx, visitID model.VisitID) (model.InvoiceID, error) {
+	var id model.InvoiceID
+	err := tx.GetContext(ctx, &id, `
+		INSERT INTO invoices (visit_id, patient_id, subtotal, total)
+		SELECT id, patient_id, 0, 0 FROM appointment_visits WHERE id = $1
+		RETURNING id`, visitID)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось добавить счёт: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO invoice_lines (invoice_id, kind, item_id, name, quantity, price_per_unit, total_price)
+		SELECT $1, 'service', s.id, s.name, vs.quantity,
+		       COALESCE(vs.price_per_unit, s.price, 0), COALESCE(vs.total_price, s.price * vs.quantity, 0)
+		FROM appointment_services vs
+		JOIN services s ON s.id = vs.service_id
+		WHERE vs.visit_id = $2 AND vs.quantity > 0
+		UNION ALL
+		SELECT $1, 'material', m.id, m.name, vm.quantity_used,
+		       COALESCE(vm.price_per_unit, m.price), COALESCE(vm.total_price, m.price * vm.quantity_used)
+		FROM appointment_materials vm
+		JOIN materials m ON m.id = vm.material_id
+		WHERE vm.visit_id = $2 AND vm.quantity_used > 0`, id, visitID)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось добавить строки счёта: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE invoices
+		SET subtotal = t.sum, total = t.sum
+		FROM (SELECT COALESCE(SUM(total_price), 0) AS sum FROM invoice_lines WHERE invoice_id = $1) t
+		WHERE id = $1`, id)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось посчитать сумму счёта: %w", err)
+	}
+	return id, nil
+}
+
+// getInvoice счёт со строками и движениями; forUpdate блокирует счёт до конца транзакции
+func getInvoice(ctx context.Context, q sqlx.QueryerContext, id model.InvoiceID, forUpdate bool) (model.Invoice, error) {
+	query := "SELECT " + invoiceColumns + " FROM invoices i WHERE i.id = $1"
+	if forUpdate {
+		query += " FOR UPDATE OF i"
+	}
+	var invoice model.Invoice
+	err := sqlx.GetContext(ctx, q, &invoice, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Invoice{}, ErrInvoiceNotFound
+	}
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось получить счёт: %w", err)
+	}
+
+	err = sqlx.SelectContext(ctx, q, &invoice.Lines, `
+		SELECT id, invoice_id, kind, item_id, name, quantity, price_per_unit, total_price
+		FROM invoice_lines WHERE invoice_id = $1 ORDER BY id`, id)
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось получить строки счёта: %w", err)
+	}
+	err = sqlx.SelectContext(ctx, q, &invoice.Payments, `
+		SELECT id, invoice_id, kind, amount, method, refund_of, comment, created_at
+		FROM invoice_payments WHERE invoice_id = $1 ORDER BY created_at, id`, id)
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось получить оплаты по счёту: %w", err)
+	}
+	return invoice, nil
+}
+
+// refreshInvoice пересчитывает статус счёта по движениям денег и переносит сумму и статус оплаты
+// в visit_payments, откуда их читают список неоплаченных приёмов и старые клиенты
+func refreshInvoice(ctx context.Context, tx *sqlx.Tx, id model.InvoiceID) (model.Invoice, error) {
+	invoice, err := getInvoice(ctx, tx, id, false)
+	if err != nil {
+		return model.Invoice{}, err
+	}
+	invoice.Status = invoiceStatus(invoice)
+
+	_, err = tx.ExecContext(ctx, "UPDATE invoices SET status = $2, updated_at = now() WHERE id = $1", id, invoice.Status)
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось обновить статус счёта: %w", err)
+	}
+
+	paymentStatus := "unconfirmed"
+	if invoice.Status == model.InvoicePaid {
+		paymentStatus = "confirmed"
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO visit_payments (visit_id, price, status) VALUES ($1, $2, $3)
+		ON CONFLICT (visit_id) DO UPDATE SET price = EXCLUDED.price, status = EXCLUDED.status`,
+		invoice.VisitID, invoice.Total, paymentStatus)
+	if err != nil {
+		return model.Invoice{}, fmt.Errorf("не удалось обновить оплату приёма: %w", err)
+	}
+	return invoice, nil
+}
+
+// invoiceStatus статус счёта по внесённой сумме: полностью возвращённый счёт отличается от неоплаченного
+func invoiceStatus(invoice model.Invoice) string {
+	refunded := false
+	for _, p := range invoice.Payments {
+		if p.Kind == model.InvoicePaymentKindRefund {
+			refunded = true
+		}
+	}
+	switch {
+	case invoice.Paid <= 0 && refunded:
+		return model.InvoiceRefunded
+	case invoice.Paid >= invoice.Total:
+		return model.InvoicePaid
+	case invoice.Paid > 0:
+		return model.InvoicePartiallyPaid
+	}
+	return model.InvoiceIssued
+}
+
+// discountAmount сумма скидки в рублях; процент округляется до рубля
+func discountAmount(subtotal int, discount model.Discount) (int, error) {
+	switch discount.Kind {
+	case "percent":
+		if discount.Value < 1 || discount.Value > 100 {
+			return 0, ErrInvalidDiscount.Detailf("процент должен быть от 1 до 100")
+		}
+		return (subtotal*discount.Value + 50) / 100, nil
+	case "fixed":
+		if discount.Value < 1 || discount.Value > subtotal {
+			return 0, ErrInvalidDiscount.Detailf("сумма скидки должна быть от 1 до %d", subtotal)
+		}
+		return discount.Value, nil
+	}
+	return 0, ErrInvalidDiscount.Detailf("неизвестный вид скидки %q", discount.Kind)
+}
+
+// execPaymentInsert записывает движение по счёту; нарушение ограничений таблицы — некорректная оплата
+func execPaymentInsert(ctx context.Context, tx *sqlx.Tx, insert squirrel.InsertBuilder) error {
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для добавления оплаты: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		if isCheckViolation(err) {
+			return invalidRow(ErrInvalidPayment, err)
+		}
+		return fmt.Errorf("не удалось выполнить запрос для добавления оплаты: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/Masterminds/squirrel"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidListQuery некорректные параметры списка: отрицательный размер страницы или смещение,
+// недопустимое поле сортировки, пустой период отбора
+var ErrInvalidListQuery = apperr.Invalid("invalid_list_query", "некорректные параметры списка")
+
+// listOrder столбцы ORDER BY для каждого допустимого поля сортировки списка. Последний столбец уникален,
+// чтобы порядок строк не менялся между страницами
+type listOrder map[string][]string
+
+// paginate добавляет к запросу списка сортировку и границы страницы
+func paginate(q squirrel.SelectBuilder, page model.ListPage, order listOrder, defaultSort string) (squirrel.SelectBuilder, error) {
+	if page.Limit < 0 {
+		return q, ErrInvalidListQuery.WithFields(apperr.Field{Name: "limit", Message: "размер страницы не может быть отрицательным"})
+	}
+	if page.Offset < 0 {
+		return q, ErrInvalidListQuery.WithFields(apperr.Field{Name: "offset", Message: "смещение не может быть отрицательным"})
+	}
+	sort := page.Sort
+	if sort == "" {
+		sort = defaultSort
+	}
+	columns, ok := order[sort]
+	if !ok {
+		return q, ErrInvalidListQuery.WithFields(apperr.Field{Name: "sort", Message: fmt.Sprintf("недопустимое поле сортировки %q", sort)})
+	}
+	orderBy := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if page.Desc {
+			column += " DESC"
+		}
+		orderBy = append(orderBy, column)
+	}
+	q = q.OrderBy(orderBy...)
+	if page.Limit > 0 {
+		q = q.Limit(uint64(page.Limit))
+	}
+	if page.Offset > 0 {
+		q = q.Offset(uint64(page.Offset))
+	}
+	return q, nil
+}
+
+// listTotal число строк таблицы под условием where без учёта страницы. Если список получен целиком
+// или первая страница неполная, число уже известно и запрос не выполняется
+func (s *Store) listTotal(ctx context.Context, table string, where squirrel.Sqlizer, page model.ListPage, fetched int) (int, error) {
+	if page.Limit == 0 || (page.Offset == 0 && fetched < page.Limit) {
+		return fetched, nil
+	}
+	query, args, err := s.builder.
+		Select("count(*)").
+		From(table).
+		Where(where).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("не удалось сформировать запрос для подсчёта строк списка: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var total int
+	if err := s.db.GetContext(dbCtx, &total, query, args...); err != nil {
+		return 0, fmt.Errorf("не удалось выполнить запрос для подсчёта строк списка: %w", err)
+	}
+	return total, nil
+}
+
+// userStatusCondition условие на статус учётной записи в таблицах пациентов, врачей и администраторов:
+// только пользователи в работе или, если inactive, только выведенные из неё
+func userStatusCondition(inactive bool) squirrel.Sqlizer {
+	if inactive {
+		return squirrel.Expr("user_id IN (SELECT id FROM users WHERE status <> ?)", model.UserActive)
+	}
+	return squirrel.Expr("user_id IN (SELECT id FROM users WHERE status = ?)", model.UserActive)
+}
+
+// fullNameVector документ полнотекстового поиска по ФИО в таблицах пациентов, врачей и администраторов.
+// По этому выражению построен индекс patients_full_name_search_idx, поэтому менять их нужно вместе
+const fullNameVector = "to_tsvector('simple', second_name || ' ' || first_name || ' ' || coalesce(surname, ''))"
+
+// fullNameSearch условие поиска по началам слов ФИО: «иванов ив» находит Иванова Ивана. Запрос без букв
+// и цифр (например, из одних знаков препинания) выборку не ограничивает: пустой to_tsquery PostgreSQL отвергает
+func fullNameSearch(q string) squirrel.Sqlizer {
+	query := tsPrefixQuery(q)
+	if query == "" {
+		return squirrel.And{}
+	}
+	return squirrel.Expr(fullNameVector+" @@ to_tsquery('simple', ?)", query)
+}
+
+// likePattern шаблон LIKE для поиска подстроки
+func likePattern(s string) string {
+	return "%" + escapeLike(s) + "%"
+}
+
+// escapeLike экранирует символы шаблона LIKE в пользовательском вводе
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// tsPrefixQuery запрос для to_tsquery, в котором каждое слово ищется по началу: «иван петр» —
+// «иван:* & петр:*». Из слов удаляется всё, кроме букв и цифр, чтобы ввод не ломал синтаксис запроса.
+// Пустая строка, если слов не осталось
+func tsPrefixQuery(s string) string {
+	var terms []string
+	for _, word := range strings.Fields(s) {
+		word = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return unicode.ToLower(r)
+			}
+			return -1
+		}, word)
+		if word != "" {
+			terms = append(terms, word+":*")
+		}
+	}
+	return strings.Join(terms, " & ")
+}
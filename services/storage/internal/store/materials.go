@@ -56,8 +56,10 @@ func (s *Store) GetMaterialByID(ctx context.Context, id model.MaterialID) (model
 // AddMaterial Добавление нового материала
 func (s *Store) AddMaterial(ctx context.Context, material model.Material) (model.MaterialID, error) {
 	fields := map[string]any{
-		"name":  material.Name,
-		"price": material.Price,
+		"name":                material.Name,
+		"price":               material.Price,
+		"unit":                material.Unit,
+		"low_stock_threshold": material.LowStockThreshold,
 	}
 	query, args, err := s.builder.
 		Insert("materials").
@@ -76,15 +78,25 @@ func (s *Store) AddMaterial(ctx context.Context, material model.Material) (model
 	if err != nil {
 		return 0, fmt.Errorf("не удалось выполнить запрос для добавления нового материала: %w", err)
 	}
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := recordPrice(dbCtx, s.db, model.PriceKindMaterial, int(materialID), material.Price, today); err != nil {
+		return 0, err
+	}
 
 	return materialID, nil
 }
 
 // UpdateMaterial Изменение данных материала
 func (s *Store) UpdateMaterial(ctx context.Context, id model.MaterialID, material model.Material) error {
+	// остаток меняется только движениями по складу
 	fields := map[string]any{
-		"name":  material.Name,
-		"price": material.Price,
+		"name":                material.Name,
+		"price":               material.Price,
+		"unit":                material.Unit,
+		"low_stock_threshold": material.LowStockThreshold,
 	}
 	query, args, err := s.builder.
 		Update("materials").
@@ -95,6 +107,12 @@ func (s *Store) UpdateMaterial(ctx context.Context, id model.MaterialID, materia
 		return fmt.Errorf("не удалось сформировать запрос для обновления данных материала: %w", err)
 	}
 
+	// правка цены в карточке действует с сегодняшнего дня и попадает в историю цен
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return err
+	}
+
 	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
 
@@ -102,6 +120,7 @@ func (s *Store) UpdateMaterial(ctx context.Context, id model.MaterialID, materia
 	if err != nil {
 		return fmt.Errorf("не удалось начать транзакцию для изменения данных материала: %w", err)
 	}
+	defer tx.Rollback()
 
 	res, err := tx.ExecContext(dbCtx, query, args...)
 	if err != nil {
@@ -116,6 +135,9 @@ func (s *Store) UpdateMaterial(ctx context.Context, id model.MaterialID, materia
 	if rowsAffected == 0 {
 		return fmt.Errorf("данные материала не изменены")
 	}
+	if err := recordPrice(dbCtx, tx, model.PriceKindMaterial, int(id), material.Price, today); err != nil {
+		return err
+	}
 
 	err = tx.Commit()
 	if err != nil {
@@ -0,0 +1,142 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// МЕДИЦИНСКАЯ КАРТА ПАЦИЕНТА ДЛЯ ВЫГРУЗКИ
+
+// GetMedicalRecord Медицинская карта пациента целиком. Все части читаются в одной транзакции только для
+// чтения с уровнем изоляции REPEATABLE READ, чтобы в выгрузку не попал приём без своих диагнозов или услуг
+func (s *Store) GetMedicalRecord(ctx context.Context, patientID model.UserID) (model.MedicalRecord, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return model.MedicalRecord{}, fmt.Errorf("не удалось начать транзакцию для выгрузки медицинской карты: %w", err)
+	}
+	defer tx.Rollback()
+
+	var record model.MedicalRecord
+	err = tx.GetContext(dbCtx, &record.Patient, `
+		SELECT user_id, first_name, second_name, surname, email, birth_date, phone_number, gender
+		FROM patients
+		WHERE user_id = $1`, patientID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.MedicalRecord{}, ErrPatientNotFound
+	}
+	if err != nil {
+		return model.MedicalRecord{}, fmt.Errorf("не удалось получить пациента для выгрузки медицинской карты: %w", err)
+	}
+
+	err = tx.SelectContext(dbCtx, &record.Notes, `
+		SELECT id, patient_id, type, title
+		FROM patient_medical_notes
+		WHERE patient_id = $1
+		ORDER BY type, id`, patientID)
+	if err != nil {
+		return model.MedicalRecord{}, fmt.Errorf("не удалось получить аллергии и заболевания пациента: %w", err)
+	}
+
+	anamnesis, err := anamnesisVersions(dbCtx, tx, patientID, true)
+	if err != nil {
+		return model.MedicalRecord{}, err
+	}
+	if len(anamnesis) > 0 {
+		record.Anamnesis = anamnesis[0]
+	}
+
+	err = tx.SelectContext(dbCtx, &record.Visits, `
+		SELECT v.id, v.doctor_id, COALESCE(v.complaints, '') AS complaints,
+		       COALESCE(v.treatment_plan, '') AS treatment_plan, v.created_at,
+		       COALESCE(concat_ws(' ', d.second_name, d.first_name, d.surname), '') AS doctor
+		FROM appointment_visits v
+		LEFT JOIN doctors d ON d.user_id = v.doctor_id
+		WHERE v.patient_id = $1
+		ORDER BY v.created_at, v.id`, patientID)
+	if err != nil {
+		return model.MedicalRecord{}, fmt.Errorf("не удалось получить приёмы пациента: %w", err)
+	}
+	if err := medicalRecordVisitDetails(dbCtx, tx, record.Visits); err != nil {
+		return model.MedicalRecord{}, err
+	}
+
+	err = tx.SelectContext(dbCtx, &record.Documents, `
+		SELECT id, patient_id, file_name, COALESCE(modality, '') AS modality, study_date,
+		       COALESCE(description, '') AS description, created_at
+		FROM patient_documents
+		WHERE patient_id = $1
+		ORDER BY created_at, id`, patientID)
+	if err != nil {
+		return model.MedicalRecord{}, fmt.Errorf("не удалось получить документы пациента: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.MedicalRecord{}, fmt.Errorf("не удалось зафиксировать транзакцию для выгрузки медицинской карты: %w", err)
+	}
+	return record, nil
+}
+
+// medicalRecordVisitDetails диагнозы, услуги и материалы приёмов тремя запросами на все приёмы сразу
+func medicalRecordVisitDetails(ctx context.Context, tx *sqlx.Tx, visits []model.MedicalRecordVisit) error {
+	if len(visits) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(visits))
+	byID := make(map[model.VisitID]*model.MedicalRecordVisit, len(visits))
+	for i := range visits {
+		ids = append(ids, int64(visits[i].ID))
+		byID[visits[i].ID] = &visits[i]
+	}
+
+	var diagnoses []model.MedicalRecordDiagnosis
+	err := tx.SelectContext(ctx, &diagnoses, `
+		SELECT ad.visit_id, COALESCE(icd.code, '') AS code, COALESCE(icd.name, '') AS name,
+		       COALESCE(ad.diagnosis_note, '') AS diagnosis_note, ad.tooth, ad.surfaces
+		FROM appointment_diagnoses ad
+		LEFT JOIN icd_codes icd ON icd.id = ad.icd_code_id
+		WHERE ad.visit_id = ANY($1)
+		ORDER BY ad.id`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("не удалось получить диагнозы приёмов: %w", err)
+	}
+	for _, d := range diagnoses {
+		byID[d.VisitID].Diagnoses = append(byID[d.VisitID].Diagnoses, d)
+	}
+
+	var services []model.MedicalRecordService
+	err = tx.SelectContext(ctx, &services, `
+		SELECT aps.visit_id, aps.service_id, s.name, aps.quantity, aps.tooth, aps.surfaces
+		FROM appointment_services aps
+		JOIN services s ON s.id = aps.service_id
+		WHERE aps.visit_id = ANY($1)
+		ORDER BY aps.id`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("не удалось получить услуги приёмов: %w", err)
+	}
+	for _, srv := range services {
+		byID[srv.VisitID].Services = append(byID[srv.VisitID].Services, srv)
+	}
+
+	var materials []model.MedicalRecordMaterial
+	err = tx.SelectContext(ctx, &materials, `
+		SELECT am.visit_id, am.material_id, m.name, am.quantity_used
+		FROM appointment_materials am
+		JOIN materials m ON m.id = am.material_id
+		WHERE am.visit_id = ANY($1)
+		ORDER BY am.id`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("не удалось получить материалы приёмов: %w", err)
+	}
+	for _, m := range materials {
+		byID[m.VisitID].Materials = append(byID[m.VisitID].Materials, m)
+	}
+	return nil
+}
@@ -0,0 +1,369 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"strings"
+	"time"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦ NOTIFICATION_OUTBOX И PATIENT_NOTIFICATION_SETTINGS
+
+const (
+	// maxNotificationAttempts после стольких неудачных попыток уведомление помечается неотправленным
+	maxNotificationAttempts = 5
+	// notificationRetryBase задержка перед первой повторной попыткой; каждая следующая вдвое дольше
+	notificationRetryBase = time.Minute
+)
+
+// reminderOffsets за сколько до начала приёма отправляются напоминания
+var reminderOffsets = []struct {
+	kind   string
+	before time.Duration
+}{
+	{kind: model.NotificationReminder24h, before: 24 * time.Hour},
+	{kind: model.NotificationReminder2h, before: 2 * time.Hour},
+}
+
+// notificationPayload данные для подстановки в шаблон уведомления
+type notificationPayload struct {
+	PatientName string `json:"patient_name"`
+	DoctorName  string `json:"doctor_name"`
+	Date        string `json:"date"`
+	Time        string `json:"time"`
+	ExpiresAt   string `json:"expires_at,omitempty"`
+}
+
+// notificationRecipient адрес пациента в одном из каналов
+type notificationRecipient struct {
+	channel string
+	address string
+}
+
+// ClaimNotifications Захват готовых к отправке уведомлений каналов channels на время lease.
+// Уведомления, захваченные ранее и не завершённые до конца аренды, повторно не отправляются, а помечаются
+// неотправленными: отправитель мог упасть уже после доставки, и повтор привёл бы к дублю
+func (s *Store) ClaimNotifications(ctx context.Context, channels []string, limit int, lease time.Duration) ([]model.Notification, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось начать транзакцию для захвата уведомлений: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(dbCtx, `
+		UPDATE notification_outbox
+		SET status = 'failed', locked_until = NULL, last_error = 'отправка прервана, результат неизвестен'
+		WHERE status = 'sending' AND locked_until <= now()`)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось закрыть прерванные отправки уведомлений: %w", err)
+	}
+	_, err = tx.ExecContext(dbCtx, `
+		UPDATE notification_outbox SET status = 'expired'
+		WHERE status = 'pending' AND expires_at <= now()`)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось закрыть устаревшие уведомления: %w", err)
+	}
+	_, err = tx.ExecContext(dbCtx, `
+		UPDATE notification_outbox n SET status = 'skipped'
+		FROM patient_notification_settings p
+		WHERE n.status = 'pending' AND n.send_at <= now() AND p.patient_id = n.patient_id AND p.opt_out`)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось пропустить уведомления пациентов, отказавшихся от рассылки: %w", err)
+	}
+
+	var notifications []model.Notification
+	err = tx.SelectContext(dbCtx, &notifications, `
+		UPDATE notification_outbox
+		SET status = 'sending', attempts = attempts + 1, locked_until = now() + make_interval(secs => $3)
+		WHERE id IN (
+		    SELECT id FROM notification_outbox
+		    WHERE status = 'pending' AND send_at <= now() AND channel = ANY($1)
+		    ORDER BY send_at, id
+		    LIMIT $2
+		    FOR UPDATE SKIP LOCKED)
+		RETURNING *`,
+		pq.Array(channels), limit, lease.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("не удалось захватить уведомления на отправку: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось зафиксировать транзакцию для захвата уведомлений: %w", err)
+	}
+	return notifications, nil
+}
+
+// CompleteNotification Запись результата отправки. При ошибке уведомление возвращается в очередь с растущей
+// задержкой, пока не исчерпаны попытки
+func (s *Store) CompleteNotification(ctx context.Context, id int64, sendErr string) error {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var res sql.Result
+	var err error
+	if sendErr == "" {
+		res, err = s.db.ExecContext(dbCtx, `
+			UPDATE notification_outbox
+			SET status = 'sent', sent_at = now(), locked_until = NULL, last_error = NULL
+			WHERE id = $1 AND status = 'sending'`, id)
+	} else {
+		res, err = s.db.ExecContext(dbCtx, `
+			UPDATE notification_outbox
+			SET status = CASE WHEN attempts >= $3 THEN 'failed' ELSE 'pending' END,
+			    send_at = now() + make_interval(secs => $4 * power(2, attempts - 1)),
+			    locked_until = NULL,
+			    last_error = $2
+			WHERE id = $1 AND status = 'sending'`,
+			id, sendErr, maxNotificationAttempts, notificationRetryBase.Seconds())
+	}
+	if err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для записи результата отправки уведомления: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("не удалось получить количество измененных строк после записи результата отправки уведомления: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrNotificationNotClaimed
+	}
+	return nil
+}
+
+// GetPatientNotificationSettings Получение настроек уведомлений пациента; по умолчанию уведомления включены
+func (s *Store) GetPatientNotificationSettings(ctx context.Context, patientID model.UserID) (model.PatientNotificationSettings, error) {
+	query, args, err := s.builder.
+		Select("patient_id", "opt_out").
+		From("patient_notification_settings").
+		Where("patient_id = ?", patientID).
+		ToSql()
+	if err != nil {
+		return model.PatientNotificationSettings{}, fmt.Errorf("не удалось сформировать запрос для получения настроек уведомлений: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var settings model.PatientNotificationSettings
+	err = s.db.GetContext(dbCtx, &settings, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.PatientNotificationSettings{PatientID: patientID}, nil
+	}
+	if err != nil {
+		return model.PatientNotificationSettings{}, fmt.Errorf("не удалось выполнить запрос для получения настроек уведомлений: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdatePatientNotificationSettings Изменение настроек уведомлений пациента
+func (s *Store) UpdatePatientNotificationSettings(ctx context.Context, settings model.PatientNotificationSettings) error {
+	query, args, err := s.builder.
+		Insert("patient_notification_settings").
+		Columns("patient_id", "opt_out").
+		Values(settings.PatientID, settings.OptOut).
+		Suffix("ON CONFLICT (patient_id) DO UPDATE SET opt_out = EXCLUDED.opt_out, updated_at = now()").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для изменения настроек уведомлений: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	_, err = s.db.ExecContext(dbCtx, query, args...)
+	if err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для изменения настроек уведомлений: %w", err)
+	}
+	return nil
+}
+
+// enqueueAppointmentNotifications ставит в очередь уведомление kind о записи и напоминания перед приёмом.
+// Вызывается в транзакции, создающей или переносящей запись, поэтому уведомления появляются только вместе с ней
+func enqueueAppointmentNotifications(ctx context.Context, tx *sqlx.Tx, appointment model.Appointment, kind string, loc *time.Location) error {
+	recipients, err := patientRecipients(ctx, tx, appointment.PatientID, appointment.PatientPhoneNumber)
+	if err != nil {
+		return err
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+	doctorName, err := doctorFullName(ctx, tx, appointment.DoctorID)
+	if err != nil {
+		return err
+	}
+
+	start := slotStart(appointment.Date, appointment.Time, loc)
+	payload, err := json.Marshal(notificationPayload{
+		PatientName: fullName(appointment.PatientFirstName, appointment.PatientSurname),
+		DoctorName:  doctorName,
+		Date:        start.Format("02.01.2006"),
+		Time:        start.Format("15:04"),
+	})
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать данные уведомления: %w", err)
+	}
+
+	// время приёма входит в ключ: после переноса напоминания ставятся заново, а повторная постановка
+	// на то же время не создаёт дублей
+	subject := fmt.Sprintf("appointment:%d:%s", appointment.ID, start.UTC().Format(time.RFC3339))
+	now := time.Now()
+	for _, r := range recipients {
+		n := model.Notification{
+			Kind:          kind,
+			Channel:       r.channel,
+			PatientID:     appointment.PatientID,
+			AppointmentID: &appointment.ID,
+			Recipient:     r.address,
+			Payload:       payload,
+			SendAt:        now,
+			ExpiresAt:     &start,
+		}
+		if err := enqueueNotification(ctx, tx, n, subject); err != nil {
+			return err
+		}
+		for _, reminder := range reminderOffsets {
+			sendAt := start.Add(-reminder.before)
+			if !sendAt.After(now) {
+				continue
+			}
+			n.Kind = reminder.kind
+			n.SendAt = sendAt
+			if err := enqueueNotification(ctx, tx, n, subject); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// enqueueSlotOffered уведомляет пациента из листа ожидания о предложенном ему слоте
+func enqueueSlotOffered(ctx context.Context, tx *sqlx.Tx, offerID uuid.UUID, patientID model.UserID, hold model.AppointmentSlotHold, loc *time.Location) error {
+	recipients, err := patientRecipients(ctx, tx, &patientID, "")
+	if err != nil {
+		return err
+	}
+	if len(recipients) == 0 {
+		return nil
+	}
+	doctorName, err := doctorFullName(ctx, tx, hold.DoctorID)
+	if err != nil {
+		return err
+	}
+	var firstName string
+	var surname *string
+	err = tx.QueryRowxContext(ctx, "SELECT first_name, surname FROM patients WHERE user_id = $1", patientID).Scan(&firstName, &surname)
+	if err != nil {
+		return fmt.Errorf("не удалось получить пациента для уведомления: %w", err)
+	}
+
+	start := slotStart(hold.Date, hold.Time, loc)
+	payload, err := json.Marshal(notificationPayload{
+		PatientName: fullName(firstName, surname),
+		DoctorName:  doctorName,
+		Date:        start.Format("02.01.2006"),
+		Time:        start.Format("15:04"),
+		ExpiresAt:   hold.ExpiresAt.In(loc).Format("02.01.2006 15:04"),
+	})
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать данные уведомления: %w", err)
+	}
+
+	expiresAt := hold.ExpiresAt
+	for _, r := range recipients {
+		n := model.Notification{
+			Kind:      model.NotificationSlotOffered,
+			Channel:   r.channel,
+			PatientID: &patientID,
+			Recipient: r.address,
+			Payload:   payload,
+			SendAt:    time.Now(),
+			ExpiresAt: &expiresAt,
+		}
+		if err := enqueueNotification(ctx, tx, n, "offer:"+offerID.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cancelAppointmentNotifications отменяет ещё не отправленные уведомления по записи
+func cancelAppointmentNotifications(ctx context.Context, tx *sqlx.Tx, appointmentID model.AppointmentID) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE notification_outbox SET status = 'cancelled'
+		WHERE appointment_id = $1 AND status = 'pending'`, appointmentID)
+	if err != nil {
+		return fmt.Errorf("не удалось отменить уведомления по записи: %w", err)
+	}
+	return nil
+}
+
+// enqueueNotification добавляет уведомление в очередь. Ключ дедупликации составляется из вида, канала и subject;
+// уже существующее уведомление с тем же ключом заменяется, только если оно было отменено
+func enqueueNotification(ctx context.Context, tx *sqlx.Tx, n model.Notification, subject string) error {
+	dedupeKey := fmt.Sprintf("%s:%s:%s", n.Kind, n.Channel, subject)
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO notification_outbox (dedupe_key, kind, channel, patient_id, appointment_id, recipient, payload, send_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (dedupe_key) DO UPDATE
+		SET recipient = EXCLUDED.recipient, payload = EXCLUDED.payload, send_at = EXCLUDED.send_at,
+		    expires_at = EXCLUDED.expires_at, status = 'pending', attempts = 0, last_error = NULL
+		WHERE notification_outbox.status = 'cancelled'`,
+		dedupeKey, n.Kind, n.Channel, n.PatientID, n.AppointmentID, n.Recipient, string(n.Payload), n.SendAt, n.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("не удалось поставить уведомление в очередь: %w", err)
+	}
+	return nil
+}
+
+// patientRecipients адреса пациента: телефон для СМС и email из профиля, если он есть.
+// phone — номер из записи; если он пуст, берётся номер из профиля
+func patientRecipients(ctx context.Context, tx *sqlx.Tx, patientID *model.UserID, phone string) ([]notificationRecipient, error) {
+	var email *string
+	if patientID != nil {
+		var profilePhone *string
+		err := tx.QueryRowxContext(ctx, "SELECT phone_number, email FROM patients WHERE user_id = $1", *patientID).Scan(&profilePhone, &email)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("не удалось получить контакты пациента: %w", err)
+		}
+		if phone == "" && profilePhone != nil {
+			phone = *profilePhone
+		}
+	}
+
+	var recipients []notificationRecipient
+	if phone = strings.TrimSpace(phone); phone != "" {
+		recipients = append(recipients, notificationRecipient{channel: model.NotificationChannelSMS, address: phone})
+	}
+	if email != nil && strings.TrimSpace(*email) != "" {
+		recipients = append(recipients, notificationRecipient{channel: model.NotificationChannelEmail, address: strings.TrimSpace(*email)})
+	}
+	return recipients, nil
+}
+
+// doctorFullName ФИО врача для текста уведомления
+func doctorFullName(ctx context.Context, tx *sqlx.Tx, doctorID model.UserID) (string, error) {
+	var doctor model.Doctor
+	err := tx.GetContext(ctx, &doctor, "SELECT user_id, first_name, second_name, surname FROM doctors WHERE user_id = $1", doctorID)
+	if err != nil {
+		return "", fmt.Errorf("не удалось получить врача для уведомления: %w", err)
+	}
+	return doctor.SecondName + " " + fullName(doctor.FirstName, doctor.Surname), nil
+}
+
+// fullName имя и отчество, если оно указано
+func fullName(firstName string, surname *string) string {
+	if surname == nil || *surname == "" {
+		return firstName
+	}
+	return firstName + " " + *surname
+}
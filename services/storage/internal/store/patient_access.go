@@ -0,0 +1,118 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/Masterminds/squirrel"
+	"time"
+)
+
+// МЕТОДЫ ДЛЯ ПРОВЕРКИ ДОСТУПА К ДАННЫМ ПАЦИЕНТА И ТАБЛИЦЫ PATIENT_ACCESS_GRANTS
+
+// GetDoctorPatientRelation Лечит ли врач пациента (есть подтверждённая клиникой или завершённая запись) и есть
+// ли у него действующий допуск к карте на момент now. Неподтверждённую запись может создать кто угодно, поэтому
+// она врачу доступа к карте не даёт
+func (s *Store) GetDoctorPatientRelation(ctx context.Context, doctorID, patientID model.UserID, now time.Time) (model.DoctorPatientRelation, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var rel model.DoctorPatientRelation
+	err := s.db.GetContext(dbCtx, &rel, `
+		SELECT EXISTS (
+		           SELECT 1 FROM appointments
+		           WHERE doctor_id = $1 AND patient_id = $2 AND status IN ('confirmed', 'completed')
+		       ) AS treating,
+		       EXISTS (
+		           SELECT 1 FROM patient_access_grants
+		           WHERE doctor_id = $1 AND patient_id = $2 AND revoked_at IS NULL
+		             AND (expires_at IS NULL OR expires_at > $3)
+		       ) AS granted`, doctorID, patientID, now)
+	if err != nil {
+		return model.DoctorPatientRelation{}, fmt.Errorf("не удалось проверить отношение врача к пациенту: %w", err)
+	}
+	return rel, nil
+}
+
+// GetPatientAccessGrants Допуски к карте пациента с ФИО врачей, новые первыми, включая истёкшие и отозванные
+func (s *Store) GetPatientAccessGrants(ctx context.Context, patientID model.UserID) ([]model.PatientAccessGrant, error) {
+	query, args, err := s.builder.
+		Select("g.id", "g.patient_id", "g.doctor_id",
+			"concat_ws(' ', d.second_name, d.first_name, d.surname) AS doctor", "g.reason", "g.granted_by",
+			"g.expires_at", "g.revoked_at", "g.created_at").
+		From("patient_access_grants g").
+		Join("doctors d ON d.user_id = g.doctor_id").
+		Where(squirrel.Eq{"g.patient_id": patientID}).
+		OrderBy("g.created_at DESC", "g.id DESC").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сформировать запрос для получения допусков к карте: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var grants []model.PatientAccessGrant
+	err = s.db.SelectContext(dbCtx, &grants, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось выполнить запрос для получения допусков к карте: %w", err)
+	}
+	return grants, nil
+}
+
+// AddPatientAccessGrant Выдача врачу допуска к карте пациента
+func (s *Store) AddPatientAccessGrant(ctx context.Context, grant model.PatientAccessGrant) (model.PatientAccessGrantID, error) {
+	query, args, err := s.builder.
+		Insert("patient_access_grants").
+		Columns("patient_id", "doctor_id", "reason", "granted_by", "expires_at").
+		Values(grant.PatientID, grant.DoctorID, grant.Reason, grant.GrantedBy, grant.ExpiresAt).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("не удалось сформировать запрос для выдачи допуска к карте: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var id model.PatientAccessGrantID
+	err = s.db.QueryRowxContext(dbCtx, query, args...).Scan(&id)
+	if isCheckViolation(err) {
+		return 0, invalidRow(ErrInvalidPatientAccessGrant, err)
+	}
+	if isForeignKeyViolation(err) {
+		return 0, ErrPatientAccessGrantTarget
+	}
+	if err != nil {
+		return 0, fmt.Errorf("не удалось выдать допуск к карте: %w", err)
+	}
+	return id, nil
+}
+
+// RevokePatientAccessGrant Отзыв ещё не отозванного допуска к карте
+func (s *Store) RevokePatientAccessGrant(ctx context.Context, id model.PatientAccessGrantID, now time.Time) error {
+	query, args, err := s.builder.
+		Update("patient_access_grants").
+		Set("revoked_at", now).
+		Where(squirrel.Eq{"id": id, "revoked_at": nil}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для отзыва допуска к карте: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	res, err := s.db.ExecContext(dbCtx, query, args...)
+	if err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для отзыва допуска к карте: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("не удалось получить количество измененных строк после отзыва допуска к карте: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrPatientAccessGrantNotFound
+	}
+	return nil
+}
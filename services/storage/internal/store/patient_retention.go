@@ -0,0 +1,160 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"strconv"
+	"time"
+)
+
+// purgeAuditAction действие в журнале аудита, которым отмечается удаление данных пациента по сроку хранения.
+// Удаление выполняет сам сервис, поэтому у записи нет пользователя: actor_id и actor_role равны 0
+const purgeAuditAction = "PurgeArchivedPatient"
+
+// PurgeArchivedPatients удаляет архивных пациентов, у которых истёк срок хранения: пациент в архиве с момента
+// раньше before и с тех пор у него не было и нет приёмов. Удаляются учётная запись, медицинская карта, приёмы, счета
+// и документы; каждое удаление выполняется в своей транзакции вместе с записью в журнал аудита. За вызов
+// обрабатывается не больше limit пациентов. Возвращает число удалённых пациентов и ключи объектов хранилища
+// документов, на которые больше не ссылается ни один документ: их нужно удалить из хранилища
+func (s *Store) PurgeArchivedPatients(ctx context.Context, before time.Time, limit int) (int, []string, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var ids []model.UserID
+	err := s.db.SelectContext(dbCtx, &ids, `
+		SELECT u.id FROM users u
+		JOIN patients p ON p.user_id = u.id
+		WHERE u.status = $1 AND u.status_changed_at < $2
+		  AND NOT EXISTS (SELECT 1 FROM appointments a WHERE a.patient_id = u.id AND a.date >= $2::date)
+		ORDER BY u.status_changed_at, u.id
+		LIMIT $3`, model.UserArchived, before, limit)
+	if err != nil {
+		return 0, nil, fmt.Errorf("не удалось получить пациентов с истёкшим сроком хранения: %w", err)
+	}
+
+	var purged int
+	var orphanKeys []string
+	for _, id := range ids {
+		ok, keys, err := s.purgePatient(ctx, id, before)
+		if err != nil {
+			return purged, orphanKeys, fmt.Errorf("пациент %d: %w", id, err)
+		}
+		if ok {
+			purged++
+		}
+		orphanKeys = append(orphanKeys, keys...)
+	}
+	return purged, orphanKeys, nil
+}
+
+// purgePatient удаляет пациента id со всеми данными, если он всё ещё в архиве с момента раньше before,
+// и записывает удаление в журнал аудита. Возвращает, удалён ли пациент, и ключи объектов, на которые больше
+// не ссылаются документы
+func (s *Store) purgePatient(ctx context.Context, id model.UserID, before time.Time) (bool, []string, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return false, nil, fmt.Errorf("не удалось начать транзакцию для удаления пациента: %w", err)
+	}
+	defer tx.Rollback()
+
+	// пациента могли вернуть из архива после выборки
+	var status model.UserStatus
+	err = tx.GetContext(dbCtx, &status, `
+		SELECT status FROM users WHERE id = $1 AND status = $2 AND status_changed_at < $3 FOR UPDATE`,
+		id, model.UserArchived, before)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil, nil
+	}
+	if err != nil {
+		return false, nil, fmt.Errorf("не удалось проверить статус пациента: %w", err)
+	}
+
+	// документы, не перенесённые из старого хранения на диске (checksum IS NULL), в хранилище документов не лежат
+	var docs []struct {
+		StoragePath string `db:"storage_path"`
+		PreviewPath string `db:"preview_path"`
+		Stored      bool   `db:"stored"`
+	}
+	err = tx.SelectContext(dbCtx, &docs, `
+		DELETE FROM patient_documents WHERE patient_id = $1
+		RETURNING storage_path, COALESCE(preview_path, '') AS preview_path, checksum IS NOT NULL AS stored`, id)
+	if err != nil {
+		return false, nil, fmt.Errorf("не удалось удалить документы пациента: %w", err)
+	}
+	var keys []string
+	for _, doc := range docs {
+		if !doc.Stored {
+			continue
+		}
+		keys = append(keys, doc.StoragePath)
+		if doc.PreviewPath != "" {
+			keys = append(keys, doc.PreviewPath)
+		}
+	}
+	orphanKeys, err := unreferencedDocumentKeys(dbCtx, tx, keys)
+	if err != nil {
+		return false, nil, err
+	}
+
+	// медицинские данные удаляются явно: триггер users_forbid_delete_with_records срабатывает до каскадного
+	// удаления и не даст удалить учётную запись, пока они есть. Визиты каскадом удаляют услуги, материалы,
+	// диагнозы и оплаты, счета — строки и движения; остальное удаляется каскадом вместе с учётной записью
+	for _, query := range []string{
+		"DELETE FROM appointment_visits WHERE patient_id = $1",
+		"DELETE FROM appointments WHERE patient_id = $1",
+		"DELETE FROM invoices WHERE patient_id = $1",
+		"DELETE FROM patient_medical_notes WHERE patient_id = $1",
+		"DELETE FROM patient_anamnesis WHERE patient_id = $1",
+		"DELETE FROM dental_chart_entries WHERE patient_id = $1",
+		"DELETE FROM treatment_plans WHERE patient_id = $1",
+		"DELETE FROM users WHERE id = $1",
+	} {
+		if _, err := tx.ExecContext(dbCtx, query, id); err != nil {
+			return false, nil, fmt.Errorf("не удалось удалить данные пациента: %w", err)
+		}
+	}
+
+	err = s.appendAuditEntry(dbCtx, tx, model.AuditEntry{
+		OccurredAt: time.Now(),
+		Service:    "storage",
+		Action:     purgeAuditAction,
+		Resource:   "patient",
+		ResourceID: strconv.Itoa(int(id)),
+		PatientID:  &id,
+		Outcome:    "OK",
+	})
+	if err != nil {
+		return false, nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, nil, fmt.Errorf("не удалось зафиксировать транзакцию для удаления пациента: %w", err)
+	}
+	return true, orphanKeys, nil
+}
+
+// unreferencedDocumentKeys ключи из keys, на которые не ссылается ни один документ. Хранилище документов
+// адресуется по содержимому, и один объект может принадлежать документам разных пациентов
+func unreferencedDocumentKeys(ctx context.Context, tx *sqlx.Tx, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	var orphans []string
+	err := tx.SelectContext(ctx, &orphans, `
+		SELECT DISTINCT k FROM unnest($1::text[]) AS k
+		WHERE NOT EXISTS (SELECT 1 FROM patient_documents WHERE storage_path = k OR preview_path = k)`,
+		pq.Array(keys))
+	if err != nil {
+		return nil, fmt.Errorf("не удалось проверить, используются ли объекты документов: %w", err)
+	}
+	return orphans, nil
+}
@@ -6,16 +6,26 @@ import (
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/storage/internal/model"
 	"github.com/Masterminds/squirrel"
+	"strings"
+	"time"
 )
 
-// GetPatients Получение списка всех пациентов
-func (s *Store) GetPatients(ctx context.Context) ([]model.Patient, error) {
-	query, args, err := s.builder.
-		Select("*").
-		From("patients").
-		ToSql()
+// patientOrder допустимые поля сортировки списка пациентов
+var patientOrder = listOrder{
+	"name":       {"second_name", "first_name", "surname", "user_id"},
+	"birth_date": {"birth_date", "user_id"},
+}
+
+// GetPatients Получение страницы списка пациентов и числа всех пациентов, найденных по filter
+func (s *Store) GetPatients(ctx context.Context, filter model.PersonFilter, page model.ListPage) ([]model.Patient, int, error) {
+	where := squirrel.And{userStatusCondition(filter.Inactive), patientSearch(filter.Query)}
+	builder, err := paginate(s.builder.Select("*").From("patients").Where(where), page, patientOrder, "name")
+	if err != nil {
+		return nil, 0, err
+	}
+	query, args, err := builder.ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("не удалось сформировать запрос для получения списка пациентов: %w", err)
+		return nil, 0, fmt.Errorf("не удалось сформировать запрос для получения списка пациентов: %w", err)
 	}
 
 	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
@@ -24,10 +34,53 @@ func (s *Store) GetPatients(ctx context.Context) ([]model.Patient, error) {
 	var patients []model.Patient
 	err = s.db.SelectContext(dbCtx, &patients, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("не удалось выполнить запрос для получения списка пациентов: %w", err)
+		return nil, 0, fmt.Errorf("не удалось выполнить запрос для получения списка пациентов: %w", err)
 	}
 
-	return patients, nil
+	total, err := s.listTotal(ctx, "patients", where, page, len(patients))
+	if err != nil {
+		return nil, 0, err
+	}
+	return patients, total, nil
+}
+
+// patientSearch условие поиска пациентов: по дате рождения, если запрос — дата (02.01.2006 или 2006-01-02);
+// по номеру телефона, если в запросе только цифры и знаки номера; иначе полнотекстовый поиск по началам слов ФИО
+func patientSearch(q string) squirrel.Sqlizer {
+	q = strings.TrimSpace(q)
+	if q == "" {
+		return squirrel.And{}
+	}
+	for _, layout := range []string{"02.01.2006", "2006-01-02"} {
+		if date, err := time.Parse(layout, q); err == nil {
+			return squirrel.Eq{"birth_date": date}
+		}
+	}
+	if digits, ok := phoneDigits(q); ok {
+		return squirrel.Like{"phone_number": likePattern(digits)}
+	}
+	return fullNameSearch(q)
+}
+
+// phoneDigits цифры номера телефона из поискового запроса вида «+7 (999) 123-45-67». Номера хранятся
+// с кодом страны 7, поэтому полный номер с 8 в начале приводится к нему
+func phoneDigits(q string) (string, bool) {
+	digits := strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9':
+			return r
+		case r == '+' || r == '-' || r == '(' || r == ')' || r == ' ':
+			return -1
+		}
+		return 'x'
+	}, q)
+	if digits == "" || strings.ContainsRune(digits, 'x') {
+		return "", false
+	}
+	if len(digits) == 11 && digits[0] == '8' {
+		digits = "7" + digits[1:]
+	}
+	return digits, true
 }
 
 // GetPatientByID Получение пациента по ID
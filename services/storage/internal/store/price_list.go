@@ -0,0 +1,335 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦ SERVICE_PRICES И MATERIAL_PRICES
+
+// priceTables таблица истории цен, таблица позиций и колонка позиции в истории для прайс-листа
+func priceTables(kind model.PriceKind) (history, items, column string, err error) {
+	switch kind {
+	case model.PriceKindService:
+		return "service_prices", "services", "service_id", nil
+	case model.PriceKindMaterial:
+		return "material_prices", "materials", "material_id", nil
+	}
+	return "", "", "", ErrUnknownPriceKind.Detailf("%q", kind)
+}
+
+// clinicToday сегодняшняя дата по часам клиники; с ней сравниваются даты начала действия цен
+func (s *Store) clinicToday(ctx context.Context) (time.Time, error) {
+	loc, err := s.clinicLocation(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	now := time.Now().In(loc)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC), nil
+}
+
+// GetPriceHistory История цен позиции прайс-листа, включая запланированные изменения
+func (s *Store) GetPriceHistory(ctx context.Context, kind model.PriceKind, itemID int) ([]model.PriceListEntry, error) {
+	history, _, column, err := priceTables(kind)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := s.builder.
+		Select("id", column+" AS item_id", "price", "effective_from", "created_at").
+		From(history).
+		Where(column+" = ?", itemID).
+		OrderBy("effective_from").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сформировать запрос для получения истории цен: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var entries []model.PriceListEntry
+	err = s.db.SelectContext(dbCtx, &entries, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось выполнить запрос для получения истории цен: %w", err)
+	}
+	return entries, nil
+}
+
+// GetPriceOnDate Цена позиции, действовавшая в указанный день
+func (s *Store) GetPriceOnDate(ctx context.Context, kind model.PriceKind, itemID int, date time.Time) (int, error) {
+	history, _, column, err := priceTables(kind)
+	if err != nil {
+		return 0, err
+	}
+	query, args, err := s.builder.
+		Select("price").
+		From(history).
+		Where(column+" = ?", itemID).
+		Where("effective_from <= ?", date).
+		OrderBy("effective_from DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("не удалось сформировать запрос для получения цены на дату: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var price int
+	err = s.db.GetContext(dbCtx, &price, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrPriceNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("не удалось выполнить запрос для получения цены на дату: %w", err)
+	}
+	return price, nil
+}
+
+// AddPriceListEntry Установка цены позиции с даты entry.EffectiveFrom, не раньше сегодняшней. Цена на ту же дату
+// заменяется. Цена с сегодняшнего дня сразу становится текущей ценой позиции
+func (s *Store) AddPriceListEntry(ctx context.Context, kind model.PriceKind, entry model.PriceListEntry) (int, error) {
+	history, _, column, err := priceTables(kind)
+	if err != nil {
+		return 0, err
+	}
+	query, args, err := s.builder.
+		Insert(history).
+		Columns(column, "price", "effective_from").
+		Values(entry.ItemID, entry.Price, entry.EffectiveFrom).
+		Suffix("ON CONFLICT (" + column + ", effective_from) DO UPDATE SET price = EXCLUDED.price, created_at = now() RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("не удалось сформировать запрос для добавления цены: %w", err)
+	}
+
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if entry.EffectiveFrom.Before(today) {
+		return 0, ErrPriceBackdated
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("не удалось начать транзакцию для добавления цены: %w", err)
+	}
+	defer tx.Rollback()
+
+	var id int
+	err = tx.QueryRowxContext(dbCtx, query, args...).Scan(&id)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return 0, ErrPriceItemNotFound
+		}
+		if isCheckViolation(err) {
+			return 0, ErrInvalidPrice
+		}
+		return 0, fmt.Errorf("не удалось выполнить запрос для добавления цены: %w", err)
+	}
+	if _, err := applyCurrentPrices(dbCtx, tx, kind, today); err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("не удалось зафиксировать транзакцию для добавления цены: %w", err)
+	}
+	return id, nil
+}
+
+// DeletePriceListEntry Отмена запланированного изменения цены. Уже вступившие в силу цены не удаляются:
+// по ним выставлены счета
+func (s *Store) DeletePriceListEntry(ctx context.Context, kind model.PriceKind, id int) error {
+	history, _, _, err := priceTables(kind)
+	if err != nil {
+		return err
+	}
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return err
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var effectiveFrom time.Time
+	err = s.db.GetContext(dbCtx, &effectiveFrom, "SELECT effective_from FROM "+history+" WHERE id = $1", id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrPriceListEntryNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("не удалось получить цену для удаления: %w", err)
+	}
+	if !effectiveFrom.After(today) {
+		return ErrPriceInEffect
+	}
+
+	_, err = s.db.ExecContext(dbCtx, "DELETE FROM "+history+" WHERE id = $1 AND effective_from > $2", id, today)
+	if err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для удаления цены: %w", err)
+	}
+	return nil
+}
+
+// ApplyScheduledPrices Перенос вступивших в силу запланированных цен в текущие цены услуг и материалов.
+// Возвращает число позиций, у которых изменилась цена
+func (s *Store) ApplyScheduledPrices(ctx context.Context) (int, error) {
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("не удалось начать транзакцию для применения цен: %w", err)
+	}
+	defer tx.Rollback()
+
+	var total int
+	for _, kind := range []model.PriceKind{model.PriceKindService, model.PriceKindMaterial} {
+		n, err := applyCurrentPrices(dbCtx, tx, kind, today)
+		if err != nil {
+			return 0, err
+		}
+		total += n
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("не удалось зафиксировать транзакцию для применения цен: %w", err)
+	}
+	return total, nil
+}
+
+// applyCurrentPrices выставляет позициям прайс-листа цену, действующую на дату today
+func applyCurrentPrices(ctx context.Context, tx *sqlx.Tx, kind model.PriceKind, today time.Time) (int, error) {
+	history, items, column, err := priceTables(kind)
+	if err != nil {
+		return 0, err
+	}
+	res, err := tx.ExecContext(ctx, `
+		UPDATE `+items+` i
+		SET price = p.price
+		FROM (
+			SELECT DISTINCT ON (`+column+`) `+column+` AS item_id, price
+			FROM `+history+`
+			WHERE effective_from <= $1
+			ORDER BY `+column+`, effective_from DESC
+		) p
+		WHERE i.id = p.item_id AND i.price IS DISTINCT FROM p.price`, today)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось обновить текущие цены: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("не удалось получить количество обновлённых цен: %w", err)
+	}
+	return int(n), nil
+}
+
+// recordPrice записывает в историю цену позиции с даты date, если она отличается от действующей на эту дату.
+// Так правка цены в карточке услуги или материала тоже попадает в историю
+func recordPrice(ctx context.Context, db sqlx.ExecerContext, kind model.PriceKind, itemID int, price int, date time.Time) error {
+	history, _, column, err := priceTables(kind)
+	if err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, `
+		INSERT INTO `+history+` (`+column+`, price, effective_from)
+		SELECT $1::integer, $2::integer, $3::date
+		WHERE $2::integer IS DISTINCT FROM (
+			SELECT price FROM `+history+`
+			WHERE `+column+` = $1::integer AND effective_from <= $3::date
+			ORDER BY effective_from DESC
+			LIMIT 1
+		)
+		ON CONFLICT (`+column+`, effective_from) DO UPDATE SET price = EXCLUDED.price, created_at = now()`,
+		itemID, price, date)
+	if err != nil {
+		return fmt.Errorf("не удалось записать цену в историю: %w", err)
+	}
+	return nil
+}
+
+// pricesOnDate цены позиций на дату date. Позиции без истории на эту дату получают текущую цену;
+// у услуги без цены её нет в результате
+func pricesOnDate(ctx context.Context, q sqlx.QueryerContext, kind model.PriceKind, itemIDs []int, date time.Time) (map[int]int, error) {
+	history, items, column, err := priceTables(kind)
+	if err != nil {
+		return nil, err
+	}
+	var rows []struct {
+		ID    int  `db:"id"`
+		Price *int `db:"price"`
+	}
+	err = sqlx.SelectContext(ctx, q, &rows, `
+		SELECT i.id, COALESCE((
+			SELECT p.price FROM `+history+` p
+			WHERE p.`+column+` = i.id AND p.effective_from <= $2
+			ORDER BY p.effective_from DESC
+			LIMIT 1
+		), i.price) AS price
+		FROM `+items+` i
+		WHERE i.id = ANY($1)`, pq.Array(itemIDs), date)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить цены на дату: %w", err)
+	}
+	prices := make(map[int]int, len(rows))
+	for _, row := range rows {
+		if row.Price != nil {
+			prices[row.ID] = *row.Price
+		}
+	}
+	return prices, nil
+}
+
+// priceVisitServices проставляет строкам услуг визита цену на дату date
+func priceVisitServices(ctx context.Context, q sqlx.QueryerContext, services []model.AppointmentService, date time.Time) error {
+	ids := make([]int, 0, len(services))
+	for _, item := range services {
+		ids = append(ids, int(item.ServiceID))
+	}
+	prices, err := pricesOnDate(ctx, q, model.PriceKindService, ids, date)
+	if err != nil {
+		return err
+	}
+	for i, item := range services {
+		if price, ok := prices[int(item.ServiceID)]; ok {
+			total := price * item.Quantity
+			services[i].PricePerUnit, services[i].TotalPrice = &price, &total
+		}
+	}
+	return nil
+}
+
+// priceVisitMaterials проставляет строкам материалов визита цену на дату date
+func priceVisitMaterials(ctx context.Context, q sqlx.QueryerContext, materials []model.AppointmentMaterial, date time.Time) error {
+	ids := make([]int, 0, len(materials))
+	for _, item := range materials {
+		ids = append(ids, int(item.MaterialID))
+	}
+	prices, err := pricesOnDate(ctx, q, model.PriceKindMaterial, ids, date)
+	if err != nil {
+		return err
+	}
+	for i, item := range materials {
+		if price, ok := prices[int(item.MaterialID)]; ok {
+			total := price * item.QuantityUsed
+			materials[i].PricePerUnit, materials[i].TotalPrice = &price, &total
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦЫ SCHEDULE_RULES
+
+// GetScheduleRules Получение правил расписания клиники (doctorID == nil) или врача.
+// Диапазоны идут раньше повторяющихся правил, среди них более новые раньше: при расчёте действует первое подходящее
+func (s *Store) GetScheduleRules(ctx context.Context, doctorID *model.UserID) ([]model.ScheduleRule, error) {
+	builder := s.builder.
+		Select("*").
+		From("schedule_rules").
+		OrderBy("CASE recurrence WHEN 'range' THEN 0 ELSE 1 END", "created_at DESC", "id DESC")
+	if doctorID == nil {
+		builder = builder.Where("doctor_id IS NULL")
+	} else {
+		builder = builder.Where(squirrel.Eq{"doctor_id": *doctorID})
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сформировать запрос для получения правил расписания: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var rules []model.ScheduleRule
+	err = s.db.SelectContext(dbCtx, &rules, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось выполнить запрос для получения правил расписания: %w", err)
+	}
+	return rules, nil
+}
+
+// AddScheduleRule Добавление правила расписания
+func (s *Store) AddScheduleRule(ctx context.Context, rule model.ScheduleRule) (int, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var id int
+	err := s.db.QueryRowxContext(dbCtx, insertScheduleRuleQuery+" RETURNING id", scheduleRuleArgs(rule)...).Scan(&id)
+	if err != nil {
+		if isCheckViolation(err) {
+			return 0, invalidRow(ErrInvalidScheduleRule, err)
+		}
+		return 0, fmt.Errorf("не удалось выполнить запрос для добавления правила расписания: %w", err)
+	}
+	return id, nil
+}
+
+// ImportScheduleRules Массовое добавление правил, например календаря праздников. Правила, которые уже есть
+// (то же повторение и те же даты, для ежегодных — тот же день года), пропускаются, поэтому календарь можно
+// загружать повторно. Возвращает количество добавленных правил
+func (s *Store) ImportScheduleRules(ctx context.Context, rules []model.ScheduleRule) (int, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("не удалось начать транзакцию для импорта правил расписания: %w", err)
+	}
+	defer tx.Rollback()
+
+	added := 0
+	for _, rule := range rules {
+		ok, err := importScheduleRule(dbCtx, tx, rule)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			added++
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("не удалось зафиксировать транзакцию для импорта правил расписания: %w", err)
+	}
+	return added, nil
+}
+
+// DeleteScheduleRule Удаление правила расписания
+func (s *Store) DeleteScheduleRule(ctx context.Context, id int) error {
+	query, args, err := s.builder.
+		Delete("schedule_rules").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("не удалось сформировать запрос для удаления правила расписания: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	res, err := s.db.ExecContext(dbCtx, query, args...)
+	if err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для удаления правила расписания: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("не удалось получить количество измененных строк после удаления правила расписания: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrScheduleRuleNotFound
+	}
+	return nil
+}
+
+const insertScheduleRuleQuery = `
+	INSERT INTO schedule_rules (doctor_id, recurrence, date_from, date_to, weekday, nth,
+	                            start_time, end_time, slot_duration_minutes, is_day_off, title)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+func scheduleRuleArgs(rule model.ScheduleRule) []any {
+	return []any{
+		rule.DoctorID, rule.Recurrence, rule.DateFrom, rule.DateTo, rule.Weekday, rule.Nth,
+		rule.StartTime, rule.EndTime, rule.SlotDurationMinutes, rule.IsDayOff, rule.Title,
+	}
+}
+
+// importScheduleRule добавляет правило, если такого ещё нет. Возвращает false, если правило пропущено
+func importScheduleRule(ctx context.Context, tx *sqlx.Tx, rule model.ScheduleRule) (bool, error) {
+	var exists bool
+	err := tx.GetContext(ctx, &exists, `
+		SELECT EXISTS (
+		    SELECT 1 FROM schedule_rules
+		    WHERE doctor_id IS NOT DISTINCT FROM $1 AND recurrence = $2
+		      AND CASE WHEN $2 = 'annual'
+		          THEN date_to IS NULL
+		               AND EXTRACT(MONTH FROM date_from) = EXTRACT(MONTH FROM $3::date)
+		               AND EXTRACT(DAY FROM date_from) = EXTRACT(DAY FROM $3::date)
+		          ELSE date_from = $3::date AND date_to IS NOT DISTINCT FROM $4::date
+		      END)`,
+		rule.DoctorID, rule.Recurrence, rule.DateFrom, rule.DateTo)
+	if err != nil {
+		return false, fmt.Errorf("не удалось проверить наличие правила расписания: %w", err)
+	}
+	if exists {
+		return false, nil
+	}
+
+	_, err = tx.ExecContext(ctx, insertScheduleRuleQuery, scheduleRuleArgs(rule)...)
+	if err != nil {
+		if isCheckViolation(err) {
+			return false, fmt.Errorf("%w: %v", ErrInvalidScheduleRule.Detailf("%q (%s)", rule.Title, rule.DateFrom.Format("2006-01-02")), err)
+		}
+		return false, fmt.Errorf("не удалось выполнить запрос для импорта правила расписания: %w", err)
+	}
+	return true, nil
+}
@@ -211,6 +211,9 @@ func (s *Store) AddService(ctx context.Context, service model.Service) (model.Se
 		"price": service.Price,
 		"type":  service.Category,
 	}
+	if service.DurationMinutes > 0 {
+		fields["duration_minutes"] = service.DurationMinutes
+	}
 	query, args, err := s.builder.
 		Insert("services").
 		SetMap(fields).
@@ -228,6 +231,15 @@ func (s *Store) AddService(ctx context.Context, service model.Service) (model.Se
 	if err != nil {
 		return 0, fmt.Errorf("не удалось выполнить запрос для добавления новой услуги: %w", err)
 	}
+	if service.Price != nil {
+		today, err := s.clinicToday(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if err := recordPrice(dbCtx, s.db, model.PriceKindService, int(id), *service.Price, today); err != nil {
+			return 0, err
+		}
+	}
 
 	return id, nil
 }
@@ -239,6 +251,9 @@ func (s *Store) UpdateService(ctx context.Context, id model.ServiceID, service m
 		"price": service.Price,
 		"type":  service.Category,
 	}
+	if service.DurationMinutes > 0 {
+		fields["duration_minutes"] = service.DurationMinutes
+	}
 	query, args, err := s.builder.
 		Update("services").
 		Where(squirrel.Eq{"id": id}).
@@ -248,6 +263,12 @@ func (s *Store) UpdateService(ctx context.Context, id model.ServiceID, service m
 		return fmt.Errorf("не удалось сформировать запрос для изменения услуги: %w", err)
 	}
 
+	// правка цены в карточке действует с сегодняшнего дня и попадает в историю цен
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return err
+	}
+
 	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
 	defer cancel()
 
@@ -255,6 +276,7 @@ func (s *Store) UpdateService(ctx context.Context, id model.ServiceID, service m
 	if err != nil {
 		return fmt.Errorf("не удалось начать транзакцию для изменения услуги: %w", err)
 	}
+	defer tx.Rollback()
 
 	res, err := tx.ExecContext(dbCtx, query, args...)
 	if err != nil {
@@ -269,6 +291,11 @@ func (s *Store) UpdateService(ctx context.Context, id model.ServiceID, service m
 	if rowsAffected == 0 {
 		return fmt.Errorf("услуга не изменена")
 	}
+	if service.Price != nil {
+		if err := recordPrice(dbCtx, tx, model.PriceKindService, int(id), *service.Price, today); err != nil {
+			return err
+		}
+	}
 
 	err = tx.Commit()
 	if err != nil {
@@ -94,13 +94,16 @@ func (s *Store) GetDoctorWeeklyAverages(ctx context.Context) ([]model.DoctorAvgV
 	return stats, nil
 }
 
+// paymentsSum сумма движений по счетам: оплаты с плюсом, возвраты с минусом
+const paymentsSum = "COALESCE(SUM(CASE WHEN kind = 'payment' THEN amount ELSE -amount END), 0)"
+
 // GetDoctorAvgCheck Средний чек врача
 func (s *Store) GetDoctorAvgCheck(ctx context.Context) ([]model.DoctorCheckStat, error) {
 	query, args, err := s.builder.
-		Select("av.doctor_id", "ROUND(AVG(vp.price), 2) AS avg_check").
+		Select("av.doctor_id", "ROUND(AVG(i.total), 2) AS avg_check").
 		From("appointment_visits av").
-		Join("visit_payments vp ON vp.visit_id = av.id").
-		Where("vp.status = 'confirmed'").
+		Join("invoices i ON i.visit_id = av.id").
+		Where("i.status = 'paid'").
 		GroupBy("av.doctor_id").
 		ToSql()
 	if err != nil {
@@ -212,12 +215,11 @@ func (s *Store) GetAgeDistribution(ctx context.Context) ([]model.AgeGroupStat, e
 	return stats, nil
 }
 
-// GetTotalIncome Общая выручка за всё время
+// GetTotalIncome Общая выручка за всё время: поступившие оплаты за вычетом возвратов
 func (s *Store) GetTotalIncome(ctx context.Context) (float64, error) {
 	query, args, err := s.builder.
-		Select("SUM(price) AS total_income").
-		From("visit_payments").
-		Where("status = 'confirmed'").
+		Select(paymentsSum + " AS total_income").
+		From("invoice_payments").
 		ToSql()
 	if err != nil {
 		return 0, fmt.Errorf("не удалось сформировать запрос: %w", err)
@@ -231,14 +233,12 @@ func (s *Store) GetTotalIncome(ctx context.Context) (float64, error) {
 	return income, nil
 }
 
-// GetMonthlyIncome Выручка за текущий месяц
+// GetMonthlyIncome Выручка за текущий месяц по дате поступления оплат и возвратов
 func (s *Store) GetMonthlyIncome(ctx context.Context) (float64, error) {
 	query, args, err := s.builder.
-		Select("SUM(vp.price) AS monthly_income").
-		From("visit_payments vp").
-		Join("appointment_visits av ON vp.visit_id = av.id").
-		Where("vp.status = 'confirmed'").
-		Where("DATE_TRUNC('month', av.created_at) = DATE_TRUNC('month', CURRENT_DATE)").
+		Select(paymentsSum + " AS monthly_income").
+		From("invoice_payments").
+		Where("DATE_TRUNC('month', created_at) = DATE_TRUNC('month', now())").
 		ToSql()
 	if err != nil {
 		return 0, fmt.Errorf("не удалось сформировать запрос: %w", err)
@@ -252,12 +252,12 @@ func (s *Store) GetMonthlyIncome(ctx context.Context) (float64, error) {
 	return income, nil
 }
 
-// GetClinicAverageCheck Средний чек по клинике
+// GetClinicAverageCheck Средний чек по клинике: сумма оплаченных счетов с учётом скидок
 func (s *Store) GetClinicAverageCheck(ctx context.Context) (float64, error) {
 	query, args, err := s.builder.
-		Select("ROUND(AVG(price), 2) AS avg_check").
-		From("visit_payments").
-		Where("status = 'confirmed'").
+		Select("COALESCE(ROUND(AVG(total), 2), 0) AS avg_check").
+		From("invoices").
+		Where("status = 'paid'").
 		ToSql()
 	if err != nil {
 		return 0, fmt.Errorf("не удалось сформировать запрос: %w", err)
@@ -0,0 +1,480 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦ TREATMENT_PLANS И TREATMENT_PLAN_ITEMS
+
+const treatmentPlanItemColumns = `i.id, i.plan_id, i.stage, i.tooth, i.service_id, s.name AS service_name, i.quantity,
+	i.estimated_price, i.status, i.visit_id, i.note, i.completed_at`
+
+// CreateTreatmentPlan Составление плана лечения; цены позиций берутся из прейскуранта на сегодня по часам клиники
+func (s *Store) CreateTreatmentPlan(ctx context.Context, plan model.TreatmentPlan) (model.TreatmentPlan, error) {
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return model.TreatmentPlan{}, err
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось начать транзакцию для составления плана лечения: %w", err)
+	}
+	defer tx.Rollback()
+
+	var planID model.TreatmentPlanID
+	err = tx.GetContext(dbCtx, &planID, `
+		INSERT INTO treatment_plans (patient_id, doctor_id, title)
+		VALUES ($1, $2, $3)
+		RETURNING id`, plan.PatientID, plan.DoctorID, plan.Title)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return model.TreatmentPlan{}, ErrInvalidTreatmentPlan.Detailf("пациент или врач не найден")
+		}
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось добавить план лечения: %w", err)
+	}
+	if err := insertTreatmentPlanItems(dbCtx, tx, planID, plan.Items, today); err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	created, err := getTreatmentPlan(dbCtx, tx, planID)
+	if err != nil {
+		return model.TreatmentPlan{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось зафиксировать транзакцию для составления плана лечения: %w", err)
+	}
+	return created, nil
+}
+
+// AddTreatmentPlanItems Добавление позиций в план. Принятый пациентом план не меняется: его стоимость уже согласована
+func (s *Store) AddTreatmentPlanItems(ctx context.Context, planID model.TreatmentPlanID, items []model.TreatmentPlanItem) (model.TreatmentPlan, error) {
+	today, err := s.clinicToday(ctx)
+	if err != nil {
+		return model.TreatmentPlan{}, err
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось начать транзакцию для изменения плана лечения: %w", err)
+	}
+	defer tx.Rollback()
+
+	planStatus, err := lockTreatmentPlan(dbCtx, tx, planID)
+	if err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	if planStatus != model.TreatmentPlanProposed {
+		return model.TreatmentPlan{}, ErrTreatmentPlanNotEditable
+	}
+	if err := insertTreatmentPlanItems(dbCtx, tx, planID, items, today); err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	if _, err := tx.ExecContext(dbCtx, "UPDATE treatment_plans SET updated_at = now() WHERE id = $1", planID); err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось обновить план лечения: %w", err)
+	}
+	plan, err := getTreatmentPlan(dbCtx, tx, planID)
+	if err != nil {
+		return model.TreatmentPlan{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось зафиксировать транзакцию для изменения плана лечения: %w", err)
+	}
+	return plan, nil
+}
+
+// UpdateTreatmentPlanItemStatus Изменение статуса позиции плана врачом. Начать или выполнить позицию можно только
+// в принятом пациентом плане; статус плана пересчитывается по позициям
+func (s *Store) UpdateTreatmentPlanItemStatus(ctx context.Context, itemID model.TreatmentPlanItemID, status string) (model.TreatmentPlan, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось начать транзакцию для изменения позиции плана лечения: %w", err)
+	}
+	defer tx.Rollback()
+
+	var planID model.TreatmentPlanID
+	err = tx.GetContext(dbCtx, &planID, "SELECT plan_id FROM treatment_plan_items WHERE id = $1", itemID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.TreatmentPlan{}, ErrTreatmentPlanItemNotFound
+	}
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось получить позицию плана лечения: %w", err)
+	}
+	planStatus, err := lockTreatmentPlan(dbCtx, tx, planID)
+	if err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	switch {
+	case planStatus == model.TreatmentPlanDone || planStatus == model.TreatmentPlanCancelled:
+		return model.TreatmentPlan{}, ErrTreatmentPlanClosed
+	case planStatus == model.TreatmentPlanProposed &&
+		(status == model.TreatmentItemInProgress || status == model.TreatmentItemDone):
+		return model.TreatmentPlan{}, ErrTreatmentPlanNotAccepted
+	}
+
+	// выполненная вручную позиция не привязывается к приёму; при возврате в работу привязка снимается
+	_, err = tx.ExecContext(dbCtx, `
+		UPDATE treatment_plan_items
+		SET status = $2,
+		    completed_at = CASE WHEN $2 = 'done' THEN COALESCE(completed_at, now()) END,
+		    visit_id = CASE WHEN $2 = 'done' THEN visit_id END
+		WHERE id = $1`, itemID, status)
+	if err != nil {
+		if isCheckViolation(err) {
+			return model.TreatmentPlan{}, ErrInvalidTreatmentPlan.Detailf("неизвестный статус позиции %q", status)
+		}
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось изменить статус позиции плана лечения: %w", err)
+	}
+	if err := refreshTreatmentPlanStatus(dbCtx, tx, planID); err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	plan, err := getTreatmentPlan(dbCtx, tx, planID)
+	if err != nil {
+		return model.TreatmentPlan{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось зафиксировать транзакцию для изменения позиции плана лечения: %w", err)
+	}
+	return plan, nil
+}
+
+// AcceptTreatmentPlan Пациент принимает предложенный ему план лечения
+func (s *Store) AcceptTreatmentPlan(ctx context.Context, planID model.TreatmentPlanID, patientID model.UserID) (model.TreatmentPlan, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось начать транзакцию для принятия плана лечения: %w", err)
+	}
+	defer tx.Rollback()
+
+	var plan struct {
+		PatientID model.UserID `db:"patient_id"`
+		Status    string       `db:"status"`
+		Items     int          `db:"items"`
+	}
+	err = tx.GetContext(dbCtx, &plan, `
+		SELECT p.patient_id, p.status,
+		       (SELECT count(*) FROM treatment_plan_items i WHERE i.plan_id = p.id AND i.status <> 'cancelled') AS items
+		FROM treatment_plans p
+		WHERE p.id = $1
+		FOR UPDATE`, planID)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && plan.PatientID != patientID) {
+		return model.TreatmentPlan{}, ErrTreatmentPlanNotFound
+	}
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось получить план лечения: %w", err)
+	}
+	if plan.Status != model.TreatmentPlanProposed {
+		return model.TreatmentPlan{}, ErrTreatmentPlanNotProposed
+	}
+	if plan.Items == 0 {
+		return model.TreatmentPlan{}, ErrInvalidTreatmentPlan.Detailf("в плане нет ни одной позиции")
+	}
+	_, err = tx.ExecContext(dbCtx, `
+		UPDATE treatment_plans SET status = 'accepted', accepted_at = now(), updated_at = now()
+		WHERE id = $1`, planID)
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось принять план лечения: %w", err)
+	}
+	accepted, err := getTreatmentPlan(dbCtx, tx, planID)
+	if err != nil {
+		return model.TreatmentPlan{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось зафиксировать транзакцию для принятия плана лечения: %w", err)
+	}
+	return accepted, nil
+}
+
+// CancelTreatmentPlan Отмена плана лечения вместе с невыполненными позициями; выполненные остаются в истории
+func (s *Store) CancelTreatmentPlan(ctx context.Context, planID model.TreatmentPlanID) (model.TreatmentPlan, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось начать транзакцию для отмены плана лечения: %w", err)
+	}
+	defer tx.Rollback()
+
+	planStatus, err := lockTreatmentPlan(dbCtx, tx, planID)
+	if err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	if planStatus == model.TreatmentPlanDone || planStatus == model.TreatmentPlanCancelled {
+		return model.TreatmentPlan{}, ErrTreatmentPlanClosed
+	}
+	_, err = tx.ExecContext(dbCtx, `
+		UPDATE treatment_plan_items SET status = 'cancelled'
+		WHERE plan_id = $1 AND status IN ('planned', 'in_progress')`, planID)
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось отменить позиции плана лечения: %w", err)
+	}
+	_, err = tx.ExecContext(dbCtx, "UPDATE treatment_plans SET status = 'cancelled', updated_at = now() WHERE id = $1", planID)
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось отменить план лечения: %w", err)
+	}
+	plan, err := getTreatmentPlan(dbCtx, tx, planID)
+	if err != nil {
+		return model.TreatmentPlan{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось зафиксировать транзакцию для отмены плана лечения: %w", err)
+	}
+	return plan, nil
+}
+
+// GetTreatmentPlan Получение плана лечения с позициями
+func (s *Store) GetTreatmentPlan(ctx context.Context, planID model.TreatmentPlanID) (model.TreatmentPlan, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	return getTreatmentPlan(dbCtx, s.db, planID)
+}
+
+// GetTreatmentPlanByItemID План лечения, в который входит позиция itemID
+func (s *Store) GetTreatmentPlanByItemID(ctx context.Context, itemID model.TreatmentPlanItemID) (model.TreatmentPlan, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var planID model.TreatmentPlanID
+	err := s.db.GetContext(dbCtx, &planID, "SELECT plan_id FROM treatment_plan_items WHERE id = $1", itemID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.TreatmentPlan{}, ErrTreatmentPlanItemNotFound
+	}
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось получить позицию плана лечения: %w", err)
+	}
+	return getTreatmentPlan(dbCtx, s.db, planID)
+}
+
+// GetPatientTreatmentPlans Планы лечения пациента, новые первыми
+func (s *Store) GetPatientTreatmentPlans(ctx context.Context, patientID model.UserID) ([]model.TreatmentPlan, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var plans []model.TreatmentPlan
+	err := s.db.SelectContext(dbCtx, &plans, `
+		SELECT id, patient_id, doctor_id, title, status, accepted_at, created_at, updated_at
+		FROM treatment_plans
+		WHERE patient_id = $1
+		ORDER BY created_at DESC, id DESC`, patientID)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить планы лечения пациента: %w", err)
+	}
+	if len(plans) == 0 {
+		return plans, nil
+	}
+	ids := make([]model.TreatmentPlanID, 0, len(plans))
+	for _, plan := range plans {
+		ids = append(ids, plan.ID)
+	}
+	items, err := treatmentPlanItems(dbCtx, s.db, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range plans {
+		fillTreatmentPlan(&plans[i], items[plans[i].ID])
+	}
+	return plans, nil
+}
+
+// completeTreatmentPlanItems отмечает позиции планов пациента выполненными на приёме. Позиция должна быть
+// в принятом плане этого пациента и ещё не выполнена, иначе приём не сохраняется
+func completeTreatmentPlanItems(ctx context.Context, tx *sqlx.Tx, visitID model.VisitID, patientID model.UserID, itemIDs []model.TreatmentPlanItemID) error {
+	unique := make(map[model.TreatmentPlanItemID]struct{}, len(itemIDs))
+	ids := make([]int64, 0, len(itemIDs))
+	for _, id := range itemIDs {
+		if _, ok := unique[id]; !ok {
+			unique[id] = struct{}{}
+			ids = append(ids, int64(id))
+		}
+	}
+
+	// планы блокируются до изменения позиций, чтобы пересчёт статуса видел все одновременные отметки
+	var planIDs []model.TreatmentPlanID
+	err := tx.SelectContext(ctx, &planIDs, `
+		SELECT id FROM treatment_plans
+		WHERE id IN (SELECT plan_id FROM treatment_plan_items WHERE id = ANY($1))
+		ORDER BY id
+		FOR UPDATE`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("не удалось заблокировать планы лечения: %w", err)
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE treatment_plan_items i
+		SET status = 'done', visit_id = $1, completed_at = now()
+		FROM treatment_plans p
+		WHERE p.id = i.plan_id AND i.id = ANY($2) AND p.patient_id = $3
+		  AND p.status IN ('accepted', 'in_progress') AND i.status IN ('planned', 'in_progress')`,
+		visitID, pq.Array(ids), patientID)
+	if err != nil {
+		return fmt.Errorf("не удалось отметить позиции плана лечения выполненными: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("не удалось отметить позиции плана лечения выполненными: %w", err)
+	}
+	if int(n) != len(ids) {
+		return ErrTreatmentPlanItemUnavailable
+	}
+	for _, planID := range planIDs {
+		if err := refreshTreatmentPlanStatus(ctx, tx, planID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertTreatmentPlanItems добавляет позиции плана по ценам услуг на день date
+func insertTreatmentPlanItems(ctx context.Context, tx *sqlx.Tx, planID model.TreatmentPlanID, items []model.TreatmentPlanItem, date time.Time) error {
+	if len(items) == 0 {
+		return nil
+	}
+	serviceIDs := make([]int, 0, len(items))
+	for _, item := range items {
+		serviceIDs = append(serviceIDs, int(item.ServiceID))
+	}
+	prices, err := pricesOnDate(ctx, tx, model.PriceKindService, serviceIDs, date)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		price, ok := prices[int(item.ServiceID)]
+		if !ok {
+			return ErrInvalidTreatmentPlan.Detailf("услуга %d не найдена или не имеет цены", item.ServiceID)
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO treatment_plan_items (plan_id, stage, tooth, service_id, quantity, estimated_price, note)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			planID, item.Stage, item.Tooth, item.ServiceID, item.Quantity, price, item.Note)
+		if err != nil {
+			if isCheckViolation(err) {
+				return ErrInvalidTreatmentPlan.Detailf("проверьте этап, номер зуба и количество")
+			}
+			return fmt.Errorf("не удалось добавить позицию плана лечения: %w", err)
+		}
+	}
+	return nil
+}
+
+// lockTreatmentPlan блокирует план до конца транзакции и возвращает его статус
+func lockTreatmentPlan(ctx context.Context, tx *sqlx.Tx, planID model.TreatmentPlanID) (string, error) {
+	var status string
+	err := tx.GetContext(ctx, &status, "SELECT status FROM treatment_plans WHERE id = $1 FOR UPDATE", planID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrTreatmentPlanNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("не удалось получить план лечения: %w", err)
+	}
+	return status, nil
+}
+
+// refreshTreatmentPlanStatus пересчитывает статус принятого плана по позициям: выполнены все неотменённые — done,
+// начата или выполнена хотя бы одна — in_progress, иначе accepted. Предложенный и отменённый планы не меняются
+func refreshTreatmentPlanStatus(ctx context.Context, tx *sqlx.Tx, planID model.TreatmentPlanID) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE treatment_plans p
+		SET status = CASE
+		        WHEN c.total > 0 AND c.done = c.total THEN 'done'
+		        WHEN c.started > 0 THEN 'in_progress'
+		        ELSE 'accepted'
+		    END,
+		    updated_at = now()
+		FROM (
+		    SELECT count(*) FILTER (WHERE status <> 'cancelled') AS total,
+		           count(*) FILTER (WHERE status = 'done') AS done,
+		           count(*) FILTER (WHERE status IN ('in_progress', 'done')) AS started
+		    FROM treatment_plan_items
+		    WHERE plan_id = $1
+		) c
+		WHERE p.id = $1 AND p.status IN ('accepted', 'in_progress', 'done')`, planID)
+	if err != nil {
+		return fmt.Errorf("не удалось обновить статус плана лечения: %w", err)
+	}
+	return nil
+}
+
+func getTreatmentPlan(ctx context.Context, q sqlx.QueryerContext, planID model.TreatmentPlanID) (model.TreatmentPlan, error) {
+	var plan model.TreatmentPlan
+	err := sqlx.GetContext(ctx, q, &plan, `
+		SELECT id, patient_id, doctor_id, title, status, accepted_at, created_at, updated_at
+		FROM treatment_plans
+		WHERE id = $1`, planID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.TreatmentPlan{}, ErrTreatmentPlanNotFound
+	}
+	if err != nil {
+		return model.TreatmentPlan{}, fmt.Errorf("не удалось получить план лечения: %w", err)
+	}
+	items, err := treatmentPlanItems(ctx, q, []model.TreatmentPlanID{planID})
+	if err != nil {
+		return model.TreatmentPlan{}, err
+	}
+	fillTreatmentPlan(&plan, items[planID])
+	return plan, nil
+}
+
+// treatmentPlanItems позиции планов по этапам в порядке добавления
+func treatmentPlanItems(ctx context.Context, q sqlx.QueryerContext, planIDs []model.TreatmentPlanID) (map[model.TreatmentPlanID][]model.TreatmentPlanItem, error) {
+	ids := make([]int64, 0, len(planIDs))
+	for _, id := range planIDs {
+		ids = append(ids, int64(id))
+	}
+	var items []model.TreatmentPlanItem
+	err := sqlx.SelectContext(ctx, q, &items, `
+		SELECT `+treatmentPlanItemColumns+`
+		FROM treatment_plan_items i
+		JOIN services s ON s.id = i.service_id
+		WHERE i.plan_id = ANY($1)
+		ORDER BY i.plan_id, i.stage, i.id`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить позиции планов лечения: %w", err)
+	}
+	byPlan := make(map[model.TreatmentPlanID][]model.TreatmentPlanItem, len(planIDs))
+	for _, item := range items {
+		byPlan[item.PlanID] = append(byPlan[item.PlanID], item)
+	}
+	return byPlan, nil
+}
+
+// fillTreatmentPlan добавляет к плану позиции и считает стоимость: всего по неотменённым позициям
+// и оставшуюся по невыполненным
+func fillTreatmentPlan(plan *model.TreatmentPlan, items []model.TreatmentPlanItem) {
+	plan.Items = items
+	plan.EstimatedTotal, plan.RemainingCost = 0, 0
+	for _, item := range items {
+		cost := item.EstimatedPrice * item.Quantity
+		switch item.Status {
+		case model.TreatmentItemPlanned, model.TreatmentItemInProgress:
+			plan.EstimatedTotal += cost
+			plan.RemainingCost += cost
+		case model.TreatmentItemDone:
+			plan.EstimatedTotal += cost
+		}
+	}
+}
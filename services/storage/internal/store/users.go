@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/storage/internal/model"
 	"github.com/Masterminds/squirrel"
@@ -197,7 +198,8 @@ func (s *Store) UpdateUserLogin(ctx context.Context, id model.UserID, login stri
 	return nil
 }
 
-// DeleteUser Удаление пользователя по id
+// DeleteUser Удаление пользователя по id. Удалить можно только пользователя без медицинских данных (например,
+// при откате неудачной регистрации), остальных выводят из работы через RetireUser
 func (s *Store) DeleteUser(ctx context.Context, id model.UserID) error {
 	query, args, err := s.builder.
 		Delete("users").
@@ -217,6 +219,9 @@ func (s *Store) DeleteUser(ctx context.Context, id model.UserID) error {
 	defer tx.Rollback()
 
 	res, err := tx.ExecContext(dbCtx, query, args...)
+	if isRestrictViolation(err) {
+		return fmt.Errorf("%w: %v", ErrUserHasRecords, err)
+	}
 	if err != nil {
 		return fmt.Errorf("не удалось выполнить запрос для удаления пользователя: %w", err)
 	}
@@ -225,7 +230,7 @@ func (s *Store) DeleteUser(ctx context.Context, id model.UserID) error {
 		return fmt.Errorf("не удалось получить количество измененных строк после удаления пользователя: %w", err)
 	}
 	if rowsAffected == 0 {
-		return fmt.Errorf("пользователь не удален")
+		return ErrUserNotFound
 	}
 	err = tx.Commit()
 	if err != nil {
@@ -233,3 +238,57 @@ func (s *Store) DeleteUser(ctx context.Context, id model.UserID) error {
 	}
 	return nil
 }
+
+// RetireUser выводит пользователя из работы: пациент переводится в архив, сотрудник отключается.
+// Для уже выведенного пользователя ничего не меняет. Возвращает статус пользователя после вызова
+func (s *Store) RetireUser(ctx context.Context, id, changedBy model.UserID) (model.UserStatus, error) {
+	retired := squirrel.Expr("CASE WHEN EXISTS (SELECT 1 FROM patients WHERE user_id = users.id) THEN ? ELSE ? END",
+		model.UserArchived, model.UserDeactivated)
+	return s.setUserStatus(ctx, id, changedBy, retired, squirrel.Eq{"status": model.UserActive})
+}
+
+// RestoreUser возвращает в работу отключённого сотрудника или архивного пациента. Для пользователя в работе
+// ничего не меняет. Возвращает статус пользователя после вызова
+func (s *Store) RestoreUser(ctx context.Context, id, changedBy model.UserID) (model.UserStatus, error) {
+	return s.setUserStatus(ctx, id, changedBy, model.UserActive, squirrel.NotEq{"status": model.UserActive})
+}
+
+// setUserStatus меняет статус пользователя, если он удовлетворяет from, и запоминает, кто и когда его поменял
+func (s *Store) setUserStatus(ctx context.Context, id, changedBy model.UserID, status any, from squirrel.Sqlizer) (model.UserStatus, error) {
+	var by *model.UserID
+	if changedBy != 0 {
+		by = &changedBy
+	}
+	query, args, err := s.builder.
+		Update("users").
+		Set("status", status).
+		Set("status_changed_at", squirrel.Expr("now()")).
+		Set("status_changed_by", by).
+		Where(squirrel.Eq{"id": id}).
+		Where(from).
+		Suffix("RETURNING status").
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("не удалось сформировать запрос для изменения статуса пользователя: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var newStatus model.UserStatus
+	err = s.db.QueryRowxContext(dbCtx, query, args...).Scan(&newStatus)
+	if errors.Is(err, sql.ErrNoRows) {
+		user, err := s.GetUserByID(ctx, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrUserNotFound
+		}
+		if err != nil {
+			return "", err
+		}
+		return user.Status, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("не удалось выполнить запрос для изменения статуса пользователя: %w", err)
+	}
+	return newStatus, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/DariaTarasek/diplom/services/storage/internal/model"
 	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
 )
 
 func (s *Store) GetVisits(ctx context.Context) ([]model.Visit, error) {
@@ -268,9 +269,15 @@ func (s *Store) UpdateVisitPayment(ctx context.Context, visitID model.VisitID, p
 }
 
 func (s *Store) CalculateVisitTotal(ctx context.Context, visitID model.VisitID) (int, error) {
+	return s.visitTotal(ctx, s.db, visitID)
+}
+
+// visitTotal сумма услуг и материалов визита по ценам, зафиксированным в его строках;
+// q — соединение или транзакция, в которой они записаны
+func (s *Store) visitTotal(ctx context.Context, q sqlx.QueryerContext, visitID model.VisitID) (int, error) {
 	var totalServices int
 	queryServices, args, err := s.builder.
-		Select("COALESCE(SUM(s.price * vs.quantity), 0)").
+		Select("COALESCE(SUM(COALESCE(vs.total_price, s.price * vs.quantity)), 0)").
 		From("appointment_services vs").
 		Join("services s ON vs.service_id = s.id").
 		Where(squirrel.Eq{"vs.visit_id": visitID}).
@@ -279,14 +286,14 @@ func (s *Store) CalculateVisitTotal(ctx context.Context, visitID model.VisitID)
 	if err != nil {
 		return 0, fmt.Errorf("ошибка построения запроса услуг: %w", err)
 	}
-	err = s.db.QueryRowContext(ctx, queryServices, args...).Scan(&totalServices)
+	err = q.QueryRowxContext(ctx, queryServices, args...).Scan(&totalServices)
 	if err != nil {
 		return 0, fmt.Errorf("ошибка подсчёта суммы услуг: %w", err)
 	}
 
 	var totalMaterials int
 	queryMaterials, args, err := s.builder.
-		Select("COALESCE(SUM(m.price * vm.quantity_used), 0)").
+		Select("COALESCE(SUM(COALESCE(vm.total_price, m.price * vm.quantity_used)), 0)").
 		From("appointment_materials vm").
 		Join("materials m ON vm.material_id = m.id").
 		Where(squirrel.Eq{"vm.visit_id": visitID}).
@@ -295,7 +302,7 @@ func (s *Store) CalculateVisitTotal(ctx context.Context, visitID model.VisitID)
 	if err != nil {
 		return 0, fmt.Errorf("ошибка построения запроса материалов: %w", err)
 	}
-	err = s.db.QueryRowContext(ctx, queryMaterials, args...).Scan(&totalMaterials)
+	err = q.QueryRowxContext(ctx, queryMaterials, args...).Scan(&totalMaterials)
 	if err != nil {
 		return 0, fmt.Errorf("ошибка подсчёта суммы материалов: %w", err)
 	}
@@ -350,20 +357,31 @@ func (s *Store) AddOrUpdateVisitPayment(ctx context.Context, payment model.Visit
 	return nil
 }
 
-func (s *Store) GetUnconfirmedVisitsPayments(ctx context.Context) ([]model.VisitPayment, error) {
-	query, args, err := s.builder.
-		Select("*").
-		From("visit_payments").
-		Where(squirrel.Eq{"status": "unconfirmed"}).
-		ToSql()
+// GetUnconfirmedVisitsPayments Получение страницы неподтверждённых оплат приёмов и числа всех таких оплат
+func (s *Store) GetUnconfirmedVisitsPayments(ctx context.Context, page model.ListPage) ([]model.VisitPayment, int, error) {
+	where := squirrel.Eq{"status": "unconfirmed"}
+	builder, err := paginate(s.builder.Select("*").From("visit_payments").Where(where), page, visitPaymentOrder, "visit_id")
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, 0, err
 	}
 
 	var payments []model.VisitPayment
 	if err := s.db.SelectContext(ctx, &payments, query, args...); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return payments, nil
+	total, err := s.listTotal(ctx, "visit_payments", where, page, len(payments))
+	if err != nil {
+		return nil, 0, err
+	}
+	return payments, total, nil
+}
+
+// visitPaymentOrder допустимые поля сортировки списка оплат приёмов
+var visitPaymentOrder = listOrder{
+	"visit_id": {"visit_id"},
 }
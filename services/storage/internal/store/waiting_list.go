@@ -0,0 +1,400 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/storage/internal/model"
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"log"
+	"time"
+)
+
+// МЕТОДЫ ДЛЯ ТАБЛИЦ WAITING_LIST_ENTRIES И WAITING_LIST_OFFERS
+
+// waitingListOfferTTL сколько освободившийся слот ждёт ответа пациента, прежде чем перейти следующему в очереди
+const waitingListOfferTTL = 2 * time.Hour
+
+// freedSlot слот, освободившийся после отмены или переноса записи
+type freedSlot struct {
+	DoctorID        model.UserID
+	Date            time.Time
+	Time            time.Time
+	DurationMinutes int
+}
+
+// AddWaitingListEntry Добавление пациента в лист ожидания
+func (s *Store) AddWaitingListEntry(ctx context.Context, entry model.WaitingListEntry) (int, error) {
+	query, args, err := s.builder.
+		Insert("waiting_list_entries").
+		Columns("patient_id", "doctor_id", "specialization_id", "date_from", "date_to").
+		Values(entry.PatientID, entry.DoctorID, entry.SpecializationID, entry.DateFrom, entry.DateTo).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("не удалось сформировать запрос для добавления в лист ожидания: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var id int
+	err = s.db.QueryRowxContext(dbCtx, query, args...).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось выполнить запрос для добавления в лист ожидания: %w", err)
+	}
+	return id, nil
+}
+
+// GetWaitingListByPatientID Получение действующих записей пациента в листе ожидания
+func (s *Store) GetWaitingListByPatientID(ctx context.Context, patientID model.UserID) ([]model.WaitingListEntry, error) {
+	query, args, err := s.builder.
+		Select("*").
+		From("waiting_list_entries").
+		Where(squirrel.Eq{"patient_id": patientID, "status": []string{"waiting", "offered"}}).
+		OrderBy("created_at", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сформировать запрос для получения листа ожидания пациента: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var entries []model.WaitingListEntry
+	err = s.db.SelectContext(dbCtx, &entries, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось выполнить запрос для получения листа ожидания пациента: %w", err)
+	}
+	return entries, nil
+}
+
+// GetWaitingListOffersByPatientID Получение действующих предложений слотов пациенту
+func (s *Store) GetWaitingListOffersByPatientID(ctx context.Context, patientID model.UserID) ([]model.WaitingListOffer, error) {
+	query, args, err := s.builder.
+		Select("o.*").
+		From("waiting_list_offers o").
+		Join("waiting_list_entries e ON e.id = o.entry_id").
+		Where(squirrel.Eq{"e.patient_id": patientID, "o.status": "pending"}).
+		Where("o.expires_at > now()").
+		OrderBy("o.expires_at").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("не удалось сформировать запрос для получения предложений записи: %w", err)
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	var offers []model.WaitingListOffer
+	err = s.db.SelectContext(dbCtx, &offers, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось выполнить запрос для получения предложений записи: %w", err)
+	}
+	return offers, nil
+}
+
+// CancelWaitingListEntry Удаление пациента из листа ожидания. Действующее предложение по записи отклоняется
+// и переходит следующему в очереди
+func (s *Store) CancelWaitingListEntry(ctx context.Context, id int, patientID model.UserID) error {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию для удаления из листа ожидания: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(dbCtx, `
+		UPDATE waiting_list_entries SET status = 'cancelled'
+		WHERE id = $1 AND patient_id = $2 AND status IN ('waiting', 'offered')`,
+		id, patientID)
+	if err != nil {
+		return fmt.Errorf("не удалось выполнить запрос для удаления из листа ожидания: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("не удалось получить количество измененных строк после удаления из листа ожидания: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrWaitingListEntryNotFound
+	}
+
+	var offers []model.WaitingListOffer
+	err = tx.SelectContext(dbCtx, &offers, `
+		SELECT * FROM waiting_list_offers
+		WHERE entry_id = $1 AND status = 'pending'
+		FOR UPDATE`, id)
+	if err != nil {
+		return fmt.Errorf("не удалось получить предложения записи: %w", err)
+	}
+	for _, offer := range offers {
+		if err := closeOffer(dbCtx, tx, offer, "declined"); err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("не удалось зафиксировать транзакцию для удаления из листа ожидания: %w", err)
+	}
+	for _, offer := range offers {
+		s.offerSlotLogged(ctx, offeredSlot(offer))
+	}
+	return nil
+}
+
+// AcceptWaitingListOffer Принятие предложения: по удержанному слоту создаётся запись пациента.
+// Врач, дата, время и длительность записи берутся из предложения
+func (s *Store) AcceptWaitingListOffer(ctx context.Context, offerID uuid.UUID, appointment model.Appointment) (model.AppointmentID, error) {
+	if appointment.PatientID == nil {
+		return model.AppointmentID(0), ErrWaitingListOfferNotFound
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return model.AppointmentID(0), fmt.Errorf("не удалось начать транзакцию для принятия предложения записи: %w", err)
+	}
+	defer tx.Rollback()
+
+	offer, err := lockPendingOffer(dbCtx, tx, offerID, *appointment.PatientID)
+	if err != nil {
+		return model.AppointmentID(0), err
+	}
+
+	appointment.DoctorID = offer.DoctorID
+	appointment.Date = offer.Date
+	appointment.Time = offer.Time
+	appointment.DurationMinutes = offer.DurationMinutes
+	appointmentID, err := s.addAppointment(dbCtx, tx, appointment, &offer.HoldID)
+	if err != nil {
+		return model.AppointmentID(0), err
+	}
+
+	_, err = tx.ExecContext(dbCtx, "UPDATE waiting_list_offers SET status = 'accepted' WHERE id = $1", offer.ID)
+	if err != nil {
+		return model.AppointmentID(0), fmt.Errorf("не удалось отметить предложение записи принятым: %w", err)
+	}
+	_, err = tx.ExecContext(dbCtx, "UPDATE waiting_list_entries SET status = 'booked' WHERE id = $1", offer.EntryID)
+	if err != nil {
+		return model.AppointmentID(0), fmt.Errorf("не удалось обновить запись в листе ожидания: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return model.AppointmentID(0), fmt.Errorf("не удалось зафиксировать транзакцию для принятия предложения записи: %w", err)
+	}
+	return appointmentID, nil
+}
+
+// DeclineWaitingListOffer Отказ от предложения: пациент остаётся в листе ожидания, слот переходит следующему в очереди
+func (s *Store) DeclineWaitingListOffer(ctx context.Context, offerID uuid.UUID, patientID model.UserID) error {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию для отказа от предложения записи: %w", err)
+	}
+	defer tx.Rollback()
+
+	offer, err := lockPendingOffer(dbCtx, tx, offerID, patientID)
+	if err != nil {
+		return err
+	}
+	if err := closeOffer(dbCtx, tx, offer, "declined"); err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("не удалось зафиксировать транзакцию для отказа от предложения записи: %w", err)
+	}
+	s.offerSlotLogged(ctx, offeredSlot(offer))
+	return nil
+}
+
+// ExpireWaitingListOffers Закрытие предложений, на которые не ответили вовремя; их слоты переходят следующим в очереди.
+// Возвращает количество истёкших предложений
+func (s *Store) ExpireWaitingListOffers(ctx context.Context) (int, error) {
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return 0, fmt.Errorf("не удалось начать транзакцию для закрытия истёкших предложений записи: %w", err)
+	}
+	defer tx.Rollback()
+
+	var offers []model.WaitingListOffer
+	err = tx.SelectContext(dbCtx, &offers, `
+		SELECT * FROM waiting_list_offers
+		WHERE status = 'pending' AND expires_at <= now()
+		FOR UPDATE SKIP LOCKED`)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось получить истёкшие предложения записи: %w", err)
+	}
+	for _, offer := range offers {
+		if err := closeOffer(dbCtx, tx, offer, "expired"); err != nil {
+			return 0, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, fmt.Errorf("не удалось зафиксировать транзакцию для закрытия истёкших предложений записи: %w", err)
+	}
+	for _, offer := range offers {
+		s.offerSlotLogged(ctx, offeredSlot(offer))
+	}
+	return len(offers), nil
+}
+
+// offerSlotLogged предлагает слот листу ожидания. Ошибка только логируется: основное действие
+// (отмена, перенос, отказ) уже выполнено и не должно из-за неё откатываться
+func (s *Store) offerSlotLogged(ctx context.Context, slot freedSlot) {
+	if err := s.offerSlot(ctx, slot); err != nil {
+		log.Printf("не удалось предложить освободившийся слот листу ожидания: %v", err)
+	}
+}
+
+// offerSlot предлагает слот первому подходящему пациенту из листа ожидания: слот удерживается за ним
+// до ответа, но не дольше waitingListOfferTTL и не дольше, чем до начала приёма
+func (s *Store) offerSlot(ctx context.Context, slot freedSlot) error {
+	loc, err := s.clinicLocation(ctx)
+	if err != nil {
+		return err
+	}
+	start := slotStart(slot.Date, slot.Time, loc)
+	ttl := min(waitingListOfferTTL, time.Until(start))
+	if ttl < time.Minute {
+		return nil
+	}
+
+	dbCtx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	tx, err := s.db.BeginTxx(dbCtx, &sql.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("не удалось начать транзакцию для предложения слота: %w", err)
+	}
+	defer tx.Rollback()
+
+	var entry model.WaitingListEntry
+	err = tx.GetContext(dbCtx, &entry, `
+		SELECT e.* FROM waiting_list_entries e
+		WHERE e.status = 'waiting'
+		  AND $2::date BETWEEN e.date_from AND e.date_to
+		  AND (e.doctor_id = $1 OR (e.doctor_id IS NULL AND e.specialization_id IN (
+		      SELECT specialization_id FROM doctor_specializations WHERE doctor_id = $1)))
+		  AND NOT EXISTS (
+		      SELECT 1 FROM waiting_list_offers o
+		      WHERE o.entry_id = e.id AND o.doctor_id = $1 AND o.date = $2::date AND o."time" = $3::time)
+		ORDER BY e.created_at, e.id
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED`,
+		slot.DoctorID, slot.Date, slot.Time)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("не удалось найти пациента в листе ожидания: %w", err)
+	}
+
+	patientID := entry.PatientID
+	hold, err := s.holdSlot(dbCtx, tx, model.AppointmentSlotHold{
+		DoctorID:        slot.DoctorID,
+		Date:            slot.Date,
+		Time:            slot.Time,
+		PatientID:       &patientID,
+		HeldBy:          &patientID,
+		DurationMinutes: slot.DurationMinutes,
+	}, ttl)
+	if errors.Is(err, ErrAppointmentSlotTaken) {
+		// слот уже заняли напрямую — предлагать нечего
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var offerID uuid.UUID
+	err = tx.QueryRowxContext(dbCtx, `
+		INSERT INTO waiting_list_offers (entry_id, hold_id, doctor_id, date, "time", duration_minutes, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`,
+		entry.ID, hold.ID, hold.DoctorID, hold.Date, hold.Time, hold.DurationMinutes, hold.ExpiresAt).Scan(&offerID)
+	if err != nil {
+		return fmt.Errorf("не удалось сохранить предложение слота: %w", err)
+	}
+	_, err = tx.ExecContext(dbCtx, "UPDATE waiting_list_entries SET status = 'offered' WHERE id = $1", entry.ID)
+	if err != nil {
+		return fmt.Errorf("не удалось обновить запись в листе ожидания: %w", err)
+	}
+	err = enqueueSlotOffered(dbCtx, tx, offerID, entry.PatientID, hold, loc)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("не удалось зафиксировать транзакцию для предложения слота: %w", err)
+	}
+	return nil
+}
+
+// lockPendingOffer блокирует действующее предложение пациента до конца транзакции
+func lockPendingOffer(ctx context.Context, tx *sqlx.Tx, offerID uuid.UUID, patientID model.UserID) (model.WaitingListOffer, error) {
+	var offer model.WaitingListOffer
+	err := tx.GetContext(ctx, &offer, `
+		SELECT o.* FROM waiting_list_offers o
+		JOIN waiting_list_entries e ON e.id = o.entry_id
+		WHERE o.id = $1 AND e.patient_id = $2
+		FOR UPDATE OF o, e`,
+		offerID, patientID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.WaitingListOffer{}, ErrWaitingListOfferNotFound
+	}
+	if err != nil {
+		return model.WaitingListOffer{}, fmt.Errorf("не удалось получить предложение записи: %w", err)
+	}
+	if offer.Status != "pending" || !offer.ExpiresAt.After(time.Now()) {
+		return model.WaitingListOffer{}, ErrWaitingListOfferUnavailable
+	}
+	return offer, nil
+}
+
+// closeOffer закрывает предложение со статусом status, снимает удержание слота и возвращает пациента в очередь,
+// если его запись в листе ожидания ещё действует
+func closeOffer(ctx context.Context, tx *sqlx.Tx, offer model.WaitingListOffer, status string) error {
+	_, err := tx.ExecContext(ctx, "UPDATE waiting_list_offers SET status = $2 WHERE id = $1", offer.ID, status)
+	if err != nil {
+		return fmt.Errorf("не удалось закрыть предложение записи: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE waiting_list_entries SET status = 'waiting' WHERE id = $1 AND status = 'offered'", offer.EntryID)
+	if err != nil {
+		return fmt.Errorf("не удалось вернуть пациента в лист ожидания: %w", err)
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM appointment_slot_holds WHERE id = $1", offer.HoldID)
+	if err != nil {
+		return fmt.Errorf("не удалось снять удержание слота: %w", err)
+	}
+	return nil
+}
+
+// offeredSlot слот, который был предложен пациенту
+func offeredSlot(offer model.WaitingListOffer) freedSlot {
+	return freedSlot{
+		DoctorID:        offer.DoctorID,
+		Date:            offer.Date,
+		Time:            offer.Time,
+		DurationMinutes: offer.DurationMinutes,
+	}
+}
@@ -0,0 +1,124 @@
+// Package uploads промежуточные файлы документов, загружаемых частями. Части дописываются в файл
+// <каталог>/<id загрузки>.part строго по порядку, поэтому объём принятого — это размер файла,
+// и после обрыва загрузку можно продолжить с этого места.
+package uploads
+
+import (
+	"errors"
+	"fmt"
+	"github.com/DariaTarasek/diplom/services/apperr"
+	"google.golang.org/grpc/codes"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var (
+	// ErrBusy в загрузку уже пишет другой поток
+	ErrBusy = apperr.New(codes.Aborted, "upload_busy", "загрузка уже выполняется")
+	// ErrOffsetMismatch часть пришла не с того смещения, с которого продолжается файл
+	ErrOffsetMismatch = apperr.Conflict("upload_offset_mismatch", "смещение части не совпадает с принятым объёмом")
+)
+
+// Dir каталог промежуточных файлов
+type Dir struct {
+	root   string
+	mu     sync.Mutex
+	active map[string]bool
+}
+
+func NewDir(root string) (*Dir, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("не удалось создать каталог загрузок: %w", err)
+	}
+	return &Dir{root: root, active: map[string]bool{}}, nil
+}
+
+func (d *Dir) path(id string) string {
+	return filepath.Join(d.root, id+".part")
+}
+
+// Received сколько байт загрузки уже принято
+func (d *Dir) Received(id string) (int64, error) {
+	info, err := os.Stat(d.path(id))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("не удалось проверить файл загрузки: %w", err)
+	}
+	return info.Size(), nil
+}
+
+// Open промежуточный файл для чтения
+func (d *Dir) Open(id string) (*os.File, error) {
+	f, err := os.Open(d.path(id))
+	if err != nil {
+		return nil, fmt.Errorf("не удалось открыть файл загрузки: %w", err)
+	}
+	return f, nil
+}
+
+// Remove удаляет промежуточный файл
+func (d *Dir) Remove(id string) error {
+	if err := os.Remove(d.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("не удалось удалить файл загрузки: %w", err)
+	}
+	return nil
+}
+
+// Writer открывает загрузку на дозапись. Одновременно в загрузку может писать только один поток,
+// остальные получают ErrBusy
+func (d *Dir) Writer(id string) (*Writer, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.active[id] {
+		return nil, ErrBusy
+	}
+	f, err := os.OpenFile(d.path(id), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось открыть файл загрузки: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("не удалось проверить файл загрузки: %w", err)
+	}
+	d.active[id] = true
+	return &Writer{dir: d, id: id, f: f, size: info.Size()}, nil
+}
+
+// Writer дозапись частей в промежуточный файл
+type Writer struct {
+	dir  *Dir
+	id   string
+	f    *os.File
+	size int64
+}
+
+// Size сколько байт принято
+func (w *Writer) Size() int64 {
+	return w.size
+}
+
+// Write дописывает часть, начинающуюся со смещения offset
+func (w *Writer) Write(offset int64, data []byte) error {
+	if offset != w.size {
+		return ErrOffsetMismatch.Detailf("ожидалось %d, получено %d", w.size, offset)
+	}
+	n, err := w.f.Write(data)
+	w.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("не удалось записать часть загрузки: %w", err)
+	}
+	return nil
+}
+
+// Close закрывает файл и освобождает загрузку для других потоков
+func (w *Writer) Close() error {
+	err := w.f.Close()
+	w.dir.mu.Lock()
+	delete(w.dir.active, w.id)
+	w.dir.mu.Unlock()
+	return err
+}
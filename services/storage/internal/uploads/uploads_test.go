@@ -0,0 +1,66 @@
+package uploads
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestWriterResumesAfterBrokenStream(t *testing.T) {
+	dir, err := NewDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDir: %v", err)
+	}
+
+	w, err := dir.Writer("upload")
+	if err != nil {
+		t.Fatalf("Writer: %v", err)
+	}
+	if err := w.Write(0, []byte("DICM ")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if _, err := dir.Writer("upload"); !errors.Is(err, ErrBusy) {
+		t.Fatalf("второй Writer: err = %v, want ErrBusy", err)
+	}
+	// поток оборвался после первой части
+	w.Close()
+
+	if received, err := dir.Received("upload"); err != nil || received != 5 {
+		t.Fatalf("Received = %d, %v, want 5, nil", received, err)
+	}
+
+	w, err = dir.Writer("upload")
+	if err != nil {
+		t.Fatalf("Writer после обрыва: %v", err)
+	}
+	defer w.Close()
+	if err := w.Write(0, []byte("DICM ")); !errors.Is(err, ErrOffsetMismatch) {
+		t.Fatalf("повтор принятой части: err = %v, want ErrOffsetMismatch", err)
+	}
+	if err := w.Write(w.Size(), []byte("snapshot")); err != nil {
+		t.Fatalf("Write с принятого объёма: %v", err)
+	}
+
+	f, err := dir.Open("upload")
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer f.Close()
+	got, err := io.ReadAll(f)
+	if err != nil || string(got) != "DICM snapshot" {
+		t.Fatalf("содержимое = %q, %v, want %q", got, err, "DICM snapshot")
+	}
+}
+
+func TestReceivedWithoutFile(t *testing.T) {
+	dir, err := NewDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewDir: %v", err)
+	}
+	if received, err := dir.Received("missing"); err != nil || received != 0 {
+		t.Fatalf("Received = %d, %v, want 0, nil", received, err)
+	}
+	if err := dir.Remove("missing"); err != nil {
+		t.Fatalf("Remove несуществующей загрузки: %v", err)
+	}
+}
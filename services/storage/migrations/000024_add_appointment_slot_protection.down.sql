@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS appointment_slot_holds;
+DROP INDEX IF EXISTS appointments_doctor_slot_key;
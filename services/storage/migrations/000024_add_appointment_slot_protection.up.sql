@@ -0,0 +1,40 @@
+-- Дубли, появившиеся до введения ограничения, автоматически не отменяем: какую из записей оставить, решает
+-- администратор. Пока дубли есть, миграция падает и перечисляет их
+DO $$
+DECLARE
+    conflicts TEXT;
+BEGIN
+    SELECT string_agg(format('врач %s, %s %s: записи %s', doctor_id, date, "time", ids), E'\n')
+    INTO conflicts
+    FROM (
+        SELECT doctor_id, date, "time", string_agg(id::text, ', ' ORDER BY created_at, id) AS ids
+        FROM appointments
+        WHERE status <> 'cancelled'
+        GROUP BY doctor_id, date, "time"
+        HAVING count(*) > 1
+    ) d;
+    IF conflicts IS NOT NULL THEN
+        RAISE EXCEPTION 'на один слот приходится несколько действующих записей, отмените лишние и повторите миграцию:%', E'\n' || conflicts
+            USING ERRCODE = 'unique_violation';
+    END IF;
+END;
+$$;
+
+-- Не больше одной действующей записи к врачу на дату и время
+CREATE UNIQUE INDEX IF NOT EXISTS appointments_doctor_slot_key
+    ON appointments (doctor_id, date, "time")
+    WHERE status <> 'cancelled';
+
+-- Временное удержание слота, пока пациент заполняет форму записи
+CREATE TABLE appointment_slot_holds (
+                                        id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                        doctor_id INTEGER NOT NULL REFERENCES doctors(user_id) ON DELETE CASCADE,
+                                        date DATE NOT NULL,
+                                        "time" TIME NOT NULL,
+                                        patient_id INTEGER REFERENCES patients(user_id) ON DELETE CASCADE,
+                                        expires_at TIMESTAMPTZ NOT NULL,
+                                        created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS appointment_slot_holds_doctor_slot_key
+    ON appointment_slot_holds (doctor_id, date, "time");
@@ -0,0 +1,4 @@
+ALTER TABLE appointment_slot_holds DROP COLUMN IF EXISTS duration_minutes;
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS appointments_doctor_no_overlap;
+ALTER TABLE appointments DROP COLUMN IF EXISTS duration_minutes;
+ALTER TABLE services DROP COLUMN IF EXISTS duration_minutes;
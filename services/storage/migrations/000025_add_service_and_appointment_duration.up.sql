@@ -0,0 +1,47 @@
+-- Длительность услуги по умолчанию
+ALTER TABLE services
+    ADD COLUMN duration_minutes INTEGER NOT NULL DEFAULT 30 CHECK (duration_minutes > 0);
+
+-- Длительность записи. Для существующих записей берётся длительность слота врача (или клиники) в этот день недели
+ALTER TABLE appointments
+    ADD COLUMN duration_minutes INTEGER CHECK (duration_minutes > 0);
+
+UPDATE appointments a
+SET duration_minutes = COALESCE(
+        (SELECT d.slot_duration_minutes FROM doctor_weekly_schedule d
+         WHERE d.doctor_id = a.doctor_id AND d.weekday = EXTRACT(DOW FROM a.date) AND d.slot_duration_minutes > 0
+         LIMIT 1),
+        (SELECT c.slot_duration_minutes FROM clinic_weekly_schedule c
+         WHERE c.weekday = EXTRACT(DOW FROM a.date) AND c.slot_duration_minutes > 0
+         LIMIT 1),
+        30);
+
+-- Если расписание менялось, старые записи могли бы пересечься со следующей — укорачиваем их до начала следующей
+WITH ordered AS (
+    SELECT id,
+           date + "time" AS starts_at,
+           LEAD(date + "time") OVER (PARTITION BY doctor_id ORDER BY date, "time") AS next_starts_at
+    FROM appointments
+    WHERE status <> 'cancelled'
+)
+UPDATE appointments a
+SET duration_minutes = (EXTRACT(EPOCH FROM (o.next_starts_at - o.starts_at)) / 60)::int
+FROM ordered o
+WHERE a.id = o.id
+  AND o.next_starts_at < o.starts_at + make_interval(mins => a.duration_minutes);
+
+ALTER TABLE appointments
+    ALTER COLUMN duration_minutes SET DEFAULT 30,
+    ALTER COLUMN duration_minutes SET NOT NULL;
+
+-- Действующие записи к одному врачу не должны пересекаться по времени
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE appointments
+    ADD CONSTRAINT appointments_doctor_no_overlap EXCLUDE USING gist (
+        doctor_id WITH =,
+        tsrange(date + "time", date + "time" + make_interval(mins => duration_minutes)) WITH &&
+    ) WHERE (status <> 'cancelled');
+
+ALTER TABLE appointment_slot_holds
+    ADD COLUMN duration_minutes INTEGER NOT NULL DEFAULT 30 CHECK (duration_minutes > 0);
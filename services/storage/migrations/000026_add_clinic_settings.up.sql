@@ -0,0 +1,7 @@
+-- настройки клиники хранятся одной строкой
+CREATE TABLE IF NOT EXISTS clinic_settings (
+    id        BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    time_zone TEXT    NOT NULL DEFAULT 'Europe/Moscow'
+);
+
+INSERT INTO clinic_settings (id) VALUES (TRUE) ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS waiting_list_offers;
+DROP TABLE IF EXISTS waiting_list_entries;
@@ -0,0 +1,41 @@
+-- Лист ожидания: пациент ждёт освободившийся слот у врача или у любого врача специальности в пределах дат
+CREATE TABLE waiting_list_entries (
+                                      id SERIAL PRIMARY KEY,
+                                      patient_id INTEGER NOT NULL REFERENCES patients(user_id) ON DELETE CASCADE,
+                                      doctor_id INTEGER REFERENCES doctors(user_id) ON DELETE CASCADE,
+                                      specialization_id INTEGER REFERENCES specializations(id) ON DELETE CASCADE,
+                                      date_from DATE NOT NULL,
+                                      date_to DATE NOT NULL,
+                                      status VARCHAR(20) NOT NULL DEFAULT 'waiting',
+                                      created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                      CONSTRAINT waiting_list_entries_target CHECK (doctor_id IS NOT NULL OR specialization_id IS NOT NULL),
+                                      CONSTRAINT waiting_list_entries_dates CHECK (date_from <= date_to),
+                                      CONSTRAINT waiting_list_entries_status CHECK (status IN ('waiting', 'offered', 'booked', 'cancelled'))
+);
+
+CREATE INDEX IF NOT EXISTS waiting_list_entries_queue_idx
+    ON waiting_list_entries (created_at, id)
+    WHERE status = 'waiting';
+
+-- Предложение освободившегося слота пациенту из листа ожидания. Слот удерживается на время предложения
+CREATE TABLE waiting_list_offers (
+                                     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+                                     entry_id INTEGER NOT NULL REFERENCES waiting_list_entries(id) ON DELETE CASCADE,
+                                     hold_id UUID NOT NULL,
+                                     doctor_id INTEGER NOT NULL REFERENCES doctors(user_id) ON DELETE CASCADE,
+                                     date DATE NOT NULL,
+                                     "time" TIME NOT NULL,
+                                     duration_minutes INTEGER NOT NULL,
+                                     status VARCHAR(20) NOT NULL DEFAULT 'pending',
+                                     expires_at TIMESTAMPTZ NOT NULL,
+                                     created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                     CONSTRAINT waiting_list_offers_status CHECK (status IN ('pending', 'accepted', 'declined', 'expired'))
+);
+
+-- Один и тот же слот не предлагается одной записи листа ожидания дважды
+CREATE UNIQUE INDEX IF NOT EXISTS waiting_list_offers_entry_slot_key
+    ON waiting_list_offers (entry_id, doctor_id, date, "time");
+
+CREATE INDEX IF NOT EXISTS waiting_list_offers_pending_idx
+    ON waiting_list_offers (expires_at)
+    WHERE status = 'pending';
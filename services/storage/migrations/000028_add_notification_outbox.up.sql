@@ -0,0 +1,38 @@
+-- Исходящие уведомления. Строка создаётся в одной транзакции с событием (запись, перенос, предложение слота),
+-- поэтому уведомление не теряется; dedupe_key исключает повторную постановку одного и того же уведомления
+CREATE TABLE notification_outbox (
+                                     id BIGSERIAL PRIMARY KEY,
+                                     dedupe_key TEXT NOT NULL,
+                                     kind VARCHAR(40) NOT NULL,
+                                     channel VARCHAR(20) NOT NULL,
+                                     patient_id INTEGER REFERENCES patients(user_id) ON DELETE CASCADE,
+                                     appointment_id INTEGER REFERENCES appointments(id) ON DELETE CASCADE,
+                                     recipient TEXT NOT NULL,
+                                     payload JSONB NOT NULL DEFAULT '{}',
+                                     send_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                     expires_at TIMESTAMPTZ,
+                                     status VARCHAR(20) NOT NULL DEFAULT 'pending',
+                                     attempts INTEGER NOT NULL DEFAULT 0,
+                                     last_error TEXT,
+                                     locked_until TIMESTAMPTZ,
+                                     sent_at TIMESTAMPTZ,
+                                     created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                     CONSTRAINT notification_outbox_status CHECK (status IN ('pending', 'sending', 'sent', 'failed', 'cancelled', 'skipped', 'expired'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS notification_outbox_dedupe_key ON notification_outbox (dedupe_key);
+
+CREATE INDEX IF NOT EXISTS notification_outbox_due_idx
+    ON notification_outbox (send_at, id)
+    WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS notification_outbox_appointment_idx
+    ON notification_outbox (appointment_id)
+    WHERE status = 'pending';
+
+-- Отказ пациента от уведомлений
+CREATE TABLE patient_notification_settings (
+                                               patient_id INTEGER PRIMARY KEY REFERENCES patients(user_id) ON DELETE CASCADE,
+                                               opt_out BOOLEAN NOT NULL DEFAULT FALSE,
+                                               updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
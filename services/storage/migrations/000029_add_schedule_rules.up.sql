@@ -0,0 +1,25 @@
+-- Исключения из постоянного расписания сразу на много дат: диапазон (отпуск), N-й день недели месяца,
+-- ежегодная дата (праздник). doctor_id IS NULL — правило клиники
+CREATE TABLE schedule_rules (
+                                id SERIAL PRIMARY KEY,
+                                doctor_id INTEGER REFERENCES doctors(user_id) ON DELETE CASCADE,
+                                recurrence VARCHAR(20) NOT NULL,
+                                date_from DATE NOT NULL,
+                                date_to DATE,
+                                weekday SMALLINT,
+                                nth SMALLINT,
+                                start_time TIME,
+                                end_time TIME,
+                                slot_duration_minutes INTEGER,
+                                is_day_off BOOLEAN NOT NULL DEFAULT FALSE,
+                                title TEXT NOT NULL DEFAULT '',
+                                created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                CONSTRAINT schedule_rules_recurrence CHECK (recurrence IN ('range', 'monthly_weekday', 'annual')),
+                                CONSTRAINT schedule_rules_dates CHECK (date_to IS NULL OR date_to >= date_from),
+                                CONSTRAINT schedule_rules_range CHECK (recurrence <> 'range' OR date_to IS NOT NULL),
+                                CONSTRAINT schedule_rules_monthly CHECK (recurrence <> 'monthly_weekday'
+                                    OR (weekday BETWEEN 0 AND 6 AND nth IN (1, 2, 3, 4, 5, -1))),
+                                CONSTRAINT schedule_rules_hours CHECK (is_day_off OR (start_time IS NOT NULL AND end_time IS NOT NULL AND start_time < end_time))
+);
+
+CREATE INDEX IF NOT EXISTS schedule_rules_doctor_idx ON schedule_rules (doctor_id);
@@ -0,0 +1,2 @@
+ALTER TABLE patient_documents DROP COLUMN IF EXISTS size_bytes;
+ALTER TABLE patient_documents DROP COLUMN IF EXISTS checksum;
@@ -0,0 +1,4 @@
+-- storage_path теперь ключ объекта в хранилище документов (sha256/ab/<sha256>), checksum — SHA-256 содержимого.
+-- У строк, ещё не перенесённых инструментом cmd/migratedocs, checksum IS NULL, а storage_path — путь на диске
+ALTER TABLE patient_documents ADD COLUMN checksum TEXT;
+ALTER TABLE patient_documents ADD COLUMN size_bytes BIGINT;
@@ -0,0 +1,16 @@
+-- Загрузки документов частями. Принятые части лежат в промежуточном файле на хосте storage, строка хранит
+-- метаданные будущего документа; document_id заполняется, когда файл принят целиком и документ сохранён
+CREATE TABLE document_uploads (
+                                  id UUID PRIMARY KEY,
+                                  patient_id INTEGER NOT NULL REFERENCES patients(user_id) ON DELETE CASCADE,
+                                  file_name TEXT NOT NULL,
+                                  modality TEXT NOT NULL DEFAULT '',
+                                  study_date DATE,
+                                  description TEXT NOT NULL DEFAULT '',
+                                  size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+                                  preview_path TEXT NOT NULL DEFAULT '',
+                                  document_id UUID REFERENCES patient_documents(id) ON DELETE SET NULL,
+                                  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS document_uploads_created_at_idx ON document_uploads (created_at);
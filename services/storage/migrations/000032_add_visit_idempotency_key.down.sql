@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS appointment_visits_idempotency_key_idx;
+ALTER TABLE appointment_visits DROP COLUMN IF EXISTS idempotency_key;
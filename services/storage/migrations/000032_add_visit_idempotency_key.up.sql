@@ -0,0 +1,6 @@
+-- Ключ идемпотентности завершения приёма: повторная отправка формы с тем же ключом возвращает уже созданный визит
+ALTER TABLE appointment_visits ADD COLUMN idempotency_key UUID;
+
+CREATE UNIQUE INDEX IF NOT EXISTS appointment_visits_idempotency_key_idx
+    ON appointment_visits (idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS material_prices;
+DROP TABLE IF EXISTS service_prices;
+ALTER TABLE appointment_materials DROP COLUMN IF EXISTS total_price;
+ALTER TABLE appointment_materials DROP COLUMN IF EXISTS price_per_unit;
+ALTER TABLE appointment_services DROP COLUMN IF EXISTS total_price;
+ALTER TABLE appointment_services DROP COLUMN IF EXISTS price_per_unit;
@@ -0,0 +1,44 @@
+-- Цены фиксируются в строках визита при его записи: правка прайс-листа больше не меняет старые счета.
+-- У услуг эти колонки были удалены миграцией 000008 и возвращаются
+ALTER TABLE appointment_services ADD COLUMN price_per_unit INTEGER;
+ALTER TABLE appointment_services ADD COLUMN total_price INTEGER;
+ALTER TABLE appointment_materials ADD COLUMN price_per_unit INTEGER;
+ALTER TABLE appointment_materials ADD COLUMN total_price INTEGER;
+
+-- Для уже записанных визитов исторических цен нет — берём текущие, как их и считали до сих пор
+UPDATE appointment_services vs
+SET price_per_unit = s.price, total_price = s.price * vs.quantity
+FROM services s
+WHERE s.id = vs.service_id AND vs.price_per_unit IS NULL;
+
+UPDATE appointment_materials vm
+SET price_per_unit = m.price, total_price = m.price * vm.quantity_used
+FROM materials m
+WHERE m.id = vm.material_id AND vm.price_per_unit IS NULL;
+
+-- История прайс-листа: цена действует с effective_from до следующей записи той же позиции.
+-- Будущая дата — запланированное изменение цены
+CREATE TABLE service_prices (
+                                id SERIAL PRIMARY KEY,
+                                service_id INTEGER NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+                                price INTEGER NOT NULL CHECK (price >= 0),
+                                effective_from DATE NOT NULL,
+                                created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                UNIQUE (service_id, effective_from)
+);
+
+CREATE TABLE material_prices (
+                                 id SERIAL PRIMARY KEY,
+                                 material_id INTEGER NOT NULL REFERENCES materials(id) ON DELETE CASCADE,
+                                 price INTEGER NOT NULL CHECK (price >= 0),
+                                 effective_from DATE NOT NULL,
+                                 created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                 UNIQUE (material_id, effective_from)
+);
+
+-- Текущие цены считаются действующими с начала истории
+INSERT INTO service_prices (service_id, price, effective_from)
+SELECT id, price, DATE '1970-01-01' FROM services WHERE price IS NOT NULL;
+
+INSERT INTO material_prices (material_id, price, effective_from)
+SELECT id, price, DATE '1970-01-01' FROM materials;
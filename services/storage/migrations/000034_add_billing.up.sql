@@ -0,0 +1,98 @@
+-- Счёт на приём. Строки копируются из услуг и материалов визита по зафиксированным ценам;
+-- скидка применяется ко всему счёту: процент или фиксированная сумма
+CREATE TABLE invoices (
+                          id SERIAL PRIMARY KEY,
+                          visit_id INTEGER NOT NULL UNIQUE REFERENCES appointment_visits(id) ON DELETE CASCADE,
+                          patient_id INTEGER REFERENCES patients(user_id) ON DELETE CASCADE,
+                          subtotal INTEGER NOT NULL CHECK (subtotal >= 0),
+                          discount_kind VARCHAR(10) CHECK (discount_kind IN ('percent', 'fixed')),
+                          discount_value INTEGER NOT NULL DEFAULT 0 CHECK (discount_value >= 0),
+                          discount_reason VARCHAR(10) CHECK (discount_reason IN ('loyalty', 'staff', 'promo')),
+                          discount_amount INTEGER NOT NULL DEFAULT 0 CHECK (discount_amount >= 0),
+                          total INTEGER NOT NULL CHECK (total >= 0),
+                          status VARCHAR(20) NOT NULL DEFAULT 'issued'
+                              CHECK (status IN ('issued', 'partially_paid', 'paid', 'refunded')),
+                          created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                          updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_invoices_patient ON invoices (patient_id);
+
+CREATE TABLE invoice_lines (
+                               id SERIAL PRIMARY KEY,
+                               invoice_id INTEGER NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+                               kind VARCHAR(10) NOT NULL CHECK (kind IN ('service', 'material', 'adjustment')),
+                               item_id INTEGER NOT NULL,
+                               name VARCHAR(255) NOT NULL,
+                               quantity INTEGER NOT NULL CHECK (quantity > 0),
+                               price_per_unit INTEGER NOT NULL CHECK (price_per_unit >= 0),
+                               total_price INTEGER NOT NULL CHECK (total_price >= 0)
+);
+
+CREATE INDEX idx_invoice_lines_invoice ON invoice_lines (invoice_id);
+
+-- Движение денег по счёту: оплата или возврат. Возврат ссылается на оплату, по которой он сделан,
+-- и проводится тем же способом
+CREATE TABLE invoice_payments (
+                                  id SERIAL PRIMARY KEY,
+                                  invoice_id INTEGER NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+                                  kind VARCHAR(10) NOT NULL CHECK (kind IN ('payment', 'refund')),
+                                  amount INTEGER NOT NULL CHECK (amount > 0),
+                                  method VARCHAR(10) NOT NULL CHECK (method IN ('cash', 'card', 'transfer', 'unknown')),
+                                  refund_of INTEGER REFERENCES invoice_payments(id) ON DELETE CASCADE,
+                                  comment TEXT NOT NULL DEFAULT '',
+                                  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                  CHECK ((kind = 'refund') = (refund_of IS NOT NULL))
+);
+
+CREATE INDEX idx_invoice_payments_invoice ON invoice_payments (invoice_id);
+CREATE INDEX idx_invoice_payments_created_at ON invoice_payments (created_at);
+
+-- Счета для уже проведённых приёмов. Сумма берётся из visit_payments: администратор мог её исправить.
+-- Если сумма меньше строк визита, разница записывается как скидка без причины, если больше — отдельной
+-- строкой корректировки (см. ниже), которая входит в subtotal
+INSERT INTO invoices (visit_id, patient_id, subtotal, discount_amount, total, status, created_at, updated_at)
+SELECT vp.visit_id,
+       v.patient_id,
+       GREATEST(l.subtotal, vp.price),
+       GREATEST(l.subtotal - vp.price, 0),
+       vp.price,
+       CASE WHEN vp.status = 'confirmed' OR vp.price = 0 THEN 'paid' ELSE 'issued' END,
+       COALESCE(v.created_at, now()),
+       COALESCE(v.updated_at, now())
+FROM visit_payments vp
+JOIN appointment_visits v ON v.id = vp.visit_id
+CROSS JOIN LATERAL (
+    SELECT COALESCE((SELECT SUM(total_price) FROM appointment_services WHERE visit_id = vp.visit_id), 0) +
+           COALESCE((SELECT SUM(total_price) FROM appointment_materials WHERE visit_id = vp.visit_id), 0) AS subtotal
+) l;
+
+INSERT INTO invoice_lines (invoice_id, kind, item_id, name, quantity, price_per_unit, total_price)
+SELECT i.id, 'service', s.id, s.name, vs.quantity, COALESCE(vs.price_per_unit, 0), COALESCE(vs.total_price, 0)
+FROM invoices i
+JOIN appointment_services vs ON vs.visit_id = i.visit_id
+JOIN services s ON s.id = vs.service_id
+WHERE vs.quantity > 0;
+
+INSERT INTO invoice_lines (invoice_id, kind, item_id, name, quantity, price_per_unit, total_price)
+SELECT i.id, 'material', m.id, m.name, vm.quantity_used, COALESCE(vm.price_per_unit, 0), COALESCE(vm.total_price, 0)
+FROM invoices i
+JOIN appointment_materials vm ON vm.visit_id = i.visit_id
+JOIN materials m ON m.id = vm.material_id
+WHERE vm.quantity_used > 0;
+
+-- Сумма из visit_payments превышала строки визита: превышение — строка корректировки без позиции прайс-листа
+INSERT INTO invoice_lines (invoice_id, kind, item_id, name, quantity, price_per_unit, total_price)
+SELECT i.id, 'adjustment', 0, 'корректировка суммы приёма до введения счетов', 1, t.surplus, t.surplus
+FROM invoices i
+CROSS JOIN LATERAL (
+    SELECT i.subtotal - COALESCE((SELECT SUM(total_price) FROM invoice_lines WHERE invoice_id = i.id), 0) AS surplus
+) t
+WHERE t.surplus > 0;
+
+-- Подтверждённые оплаты до введения счетов: способ оплаты не сохранялся, поэтому он записывается как unknown,
+-- чтобы эти оплаты не попадали в суммы по наличным, карте или переводу. Новые оплаты этим способом не принимаются
+INSERT INTO invoice_payments (invoice_id, kind, amount, method, comment, created_at)
+SELECT id, 'payment', total, 'unknown', 'оплата до введения счетов, способ не известен', updated_at
+FROM invoices
+WHERE status = 'paid' AND total > 0;
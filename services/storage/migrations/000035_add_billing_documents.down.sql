@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS billing_documents;
+DROP SEQUENCE IF EXISTS billing_receipt_number_seq;
+DROP SEQUENCE IF EXISTS billing_invoice_number_seq;
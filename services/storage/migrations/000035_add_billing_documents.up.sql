@@ -0,0 +1,22 @@
+-- Печатные документы по счетам: счёт на оплату и кассовый чек на каждую оплату или возврат.
+-- У счетов и чеков свои сквозные номера; номер выдаётся до формирования файла, document_id
+-- заполняется, когда PDF сохранён в документы пациента. Счёт, на который изменили скидку,
+-- аннулируется, и при следующем запросе выдаётся новый номер
+CREATE SEQUENCE billing_invoice_number_seq;
+CREATE SEQUENCE billing_receipt_number_seq;
+
+CREATE TABLE billing_documents (
+                                   id SERIAL PRIMARY KEY,
+                                   kind VARCHAR(10) NOT NULL CHECK (kind IN ('invoice', 'receipt')),
+                                   number INTEGER NOT NULL,
+                                   invoice_id INTEGER NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+                                   payment_id INTEGER REFERENCES invoice_payments(id) ON DELETE CASCADE,
+                                   document_id UUID REFERENCES patient_documents(id) ON DELETE SET NULL,
+                                   voided_at TIMESTAMPTZ,
+                                   created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                   UNIQUE (kind, number),
+                                   CHECK ((kind = 'receipt') = (payment_id IS NOT NULL))
+);
+
+CREATE UNIQUE INDEX idx_billing_documents_invoice ON billing_documents (invoice_id) WHERE kind = 'invoice' AND voided_at IS NULL;
+CREATE UNIQUE INDEX idx_billing_documents_receipt ON billing_documents (payment_id) WHERE kind = 'receipt';
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS material_stock_movements;
+DROP TABLE IF EXISTS material_batches;
+ALTER TABLE materials DROP COLUMN IF EXISTS stock_quantity;
+ALTER TABLE materials DROP COLUMN IF EXISTS low_stock_threshold;
+ALTER TABLE materials DROP COLUMN IF EXISTS unit;
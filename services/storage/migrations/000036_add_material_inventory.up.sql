@@ -0,0 +1,36 @@
+-- Складской учёт материалов. stock_quantity — текущий остаток в единицах unit; он может уйти в минус,
+-- если материал списан на приём раньше, чем оприходован: приём из-за учёта не блокируется.
+-- Порог low_stock_threshold = 0 отключает предупреждение о низком остатке
+ALTER TABLE materials ADD COLUMN unit VARCHAR(20) NOT NULL DEFAULT 'шт';
+ALTER TABLE materials ADD COLUMN low_stock_threshold INTEGER NOT NULL DEFAULT 0 CHECK (low_stock_threshold >= 0);
+ALTER TABLE materials ADD COLUMN stock_quantity INTEGER NOT NULL DEFAULT 0;
+
+-- Партия поступления. quantity_left уменьшается при списании: сначала партии с ближайшим сроком годности
+CREATE TABLE material_batches (
+                                  id SERIAL PRIMARY KEY,
+                                  material_id INTEGER NOT NULL REFERENCES materials(id) ON DELETE CASCADE,
+                                  batch_number VARCHAR(50) NOT NULL DEFAULT '',
+                                  quantity_received INTEGER NOT NULL CHECK (quantity_received > 0),
+                                  quantity_left INTEGER NOT NULL CHECK (quantity_left >= 0),
+                                  expires_at DATE,
+                                  received_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                  comment TEXT NOT NULL DEFAULT '',
+                                  CHECK (quantity_left <= quantity_received)
+);
+
+CREATE INDEX idx_material_batches_left ON material_batches (material_id, expires_at) WHERE quantity_left > 0;
+
+-- Журнал движения: поступление (+), списание на приём (-) и корректировка (±). batch_id пуст,
+-- если движение не пришлось на партию, например списание сверх оприходованного
+CREATE TABLE material_stock_movements (
+                                          id SERIAL PRIMARY KEY,
+                                          material_id INTEGER NOT NULL REFERENCES materials(id) ON DELETE CASCADE,
+                                          batch_id INTEGER REFERENCES material_batches(id) ON DELETE SET NULL,
+                                          kind VARCHAR(12) NOT NULL CHECK (kind IN ('receipt', 'consumption', 'adjustment')),
+                                          quantity INTEGER NOT NULL CHECK (quantity <> 0),
+                                          visit_id INTEGER REFERENCES appointment_visits(id) ON DELETE SET NULL,
+                                          reason TEXT NOT NULL DEFAULT '',
+                                          created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_material_stock_movements_material ON material_stock_movements (material_id, created_at);
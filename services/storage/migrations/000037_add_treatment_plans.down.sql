@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS treatment_plan_items;
+DROP TABLE IF EXISTS treatment_plans;
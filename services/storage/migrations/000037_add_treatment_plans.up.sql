@@ -0,0 +1,39 @@
+-- План лечения пациента на несколько приёмов. Пациент принимает предложенный план (proposed -> accepted);
+-- дальше статус следует за позициями: in_progress, когда начата хотя бы одна, done, когда выполнены все
+CREATE TABLE treatment_plans (
+                                 id SERIAL PRIMARY KEY,
+                                 patient_id INTEGER NOT NULL REFERENCES patients(user_id) ON DELETE CASCADE,
+                                 doctor_id INTEGER REFERENCES doctors(user_id) ON DELETE SET NULL,
+                                 title VARCHAR(200) NOT NULL,
+                                 status VARCHAR(20) NOT NULL DEFAULT 'proposed',
+                                 accepted_at TIMESTAMPTZ,
+                                 created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                 updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                 CONSTRAINT treatment_plans_status CHECK (status IN ('proposed', 'accepted', 'in_progress', 'done', 'cancelled'))
+);
+
+CREATE INDEX idx_treatment_plans_patient ON treatment_plans (patient_id, created_at);
+
+-- Позиция плана: услуга на этапе, при необходимости для зуба по FDI (11-48 постоянные, 51-85 молочные).
+-- estimated_price — цена за единицу по прейскуранту на день составления плана; visit_id — приём, на котором
+-- позиция выполнена
+CREATE TABLE treatment_plan_items (
+                                      id SERIAL PRIMARY KEY,
+                                      plan_id INTEGER NOT NULL REFERENCES treatment_plans(id) ON DELETE CASCADE,
+                                      stage INTEGER NOT NULL DEFAULT 1 CHECK (stage BETWEEN 1 AND 20),
+                                      tooth SMALLINT,
+                                      service_id INTEGER NOT NULL REFERENCES services(id) ON DELETE RESTRICT,
+                                      quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0),
+                                      estimated_price INTEGER NOT NULL CHECK (estimated_price >= 0),
+                                      status VARCHAR(20) NOT NULL DEFAULT 'planned',
+                                      visit_id INTEGER REFERENCES appointment_visits(id) ON DELETE SET NULL,
+                                      note TEXT NOT NULL DEFAULT '',
+                                      completed_at TIMESTAMPTZ,
+                                      CONSTRAINT treatment_plan_items_status CHECK (status IN ('planned', 'in_progress', 'done', 'cancelled')),
+                                      CONSTRAINT treatment_plan_items_tooth CHECK (
+                                          tooth IS NULL
+                                          OR (tooth BETWEEN 11 AND 48 AND tooth % 10 BETWEEN 1 AND 8)
+                                          OR (tooth BETWEEN 51 AND 85 AND tooth % 10 BETWEEN 1 AND 5))
+);
+
+CREATE INDEX idx_treatment_plan_items_plan ON treatment_plan_items (plan_id, stage, id);
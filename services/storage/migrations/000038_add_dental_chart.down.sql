@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS dental_chart_entries;
+ALTER TABLE appointment_services DROP COLUMN IF EXISTS surfaces;
+ALTER TABLE appointment_services DROP COLUMN IF EXISTS tooth;
+ALTER TABLE appointment_diagnoses DROP COLUMN IF EXISTS surfaces;
+ALTER TABLE appointment_diagnoses DROP COLUMN IF EXISTS tooth;
@@ -0,0 +1,45 @@
+-- Зубная формула пациента по FDI: 11-48 постоянные зубы, 51-85 молочные. Поверхности записываются буквами
+-- в порядке M (медиальная), O (окклюзионная), I (режущий край), D (дистальная), B (вестибулярная), L (оральная),
+-- например 'MOD'; пустая строка — зуб целиком
+
+-- Диагнозы и услуги приёма могут относиться к зубу и его поверхностям
+ALTER TABLE appointment_diagnoses ADD COLUMN tooth SMALLINT;
+ALTER TABLE appointment_diagnoses ADD COLUMN surfaces VARCHAR(6) NOT NULL DEFAULT '';
+ALTER TABLE appointment_diagnoses ADD CONSTRAINT appointment_diagnoses_tooth CHECK (
+    tooth IS NULL
+    OR (tooth BETWEEN 11 AND 48 AND tooth % 10 BETWEEN 1 AND 8)
+    OR (tooth BETWEEN 51 AND 85 AND tooth % 10 BETWEEN 1 AND 5));
+ALTER TABLE appointment_diagnoses ADD CONSTRAINT appointment_diagnoses_surfaces
+    CHECK (surfaces ~ '^M?O?I?D?B?L?$' AND (tooth IS NOT NULL OR surfaces = ''));
+
+ALTER TABLE appointment_services ADD COLUMN tooth SMALLINT;
+ALTER TABLE appointment_services ADD COLUMN surfaces VARCHAR(6) NOT NULL DEFAULT '';
+ALTER TABLE appointment_services ADD CONSTRAINT appointment_services_tooth CHECK (
+    tooth IS NULL
+    OR (tooth BETWEEN 11 AND 48 AND tooth % 10 BETWEEN 1 AND 8)
+    OR (tooth BETWEEN 51 AND 85 AND tooth % 10 BETWEEN 1 AND 5));
+ALTER TABLE appointment_services ADD CONSTRAINT appointment_services_surfaces
+    CHECK (surfaces ~ '^M?O?I?D?B?L?$' AND (tooth IS NOT NULL OR surfaces = ''));
+
+-- История зубной формулы: каждая запись фиксирует состояние зуба на момент осмотра и не изменяется.
+-- Текущая формула — последняя запись по каждому зубу; зубы без записей считаются здоровыми.
+-- visit_id — приём, на котором записано состояние, пуст при изменении формулы вне приёма
+CREATE TABLE dental_chart_entries (
+                                      id SERIAL PRIMARY KEY,
+                                      patient_id INTEGER NOT NULL REFERENCES patients(user_id) ON DELETE CASCADE,
+                                      tooth SMALLINT NOT NULL,
+                                      status VARCHAR(10) NOT NULL,
+                                      surfaces VARCHAR(6) NOT NULL DEFAULT '',
+                                      note TEXT NOT NULL DEFAULT '',
+                                      visit_id INTEGER REFERENCES appointment_visits(id) ON DELETE SET NULL,
+                                      doctor_id INTEGER REFERENCES doctors(user_id) ON DELETE SET NULL,
+                                      recorded_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                      CONSTRAINT dental_chart_entries_tooth CHECK (
+                                          (tooth BETWEEN 11 AND 48 AND tooth % 10 BETWEEN 1 AND 8)
+                                          OR (tooth BETWEEN 51 AND 85 AND tooth % 10 BETWEEN 1 AND 5)),
+                                      CONSTRAINT dental_chart_entries_status
+                                          CHECK (status IN ('healthy', 'caries', 'filled', 'crown', 'implant', 'missing')),
+                                      CONSTRAINT dental_chart_entries_surfaces CHECK (surfaces ~ '^M?O?I?D?B?L?$')
+);
+
+CREATE INDEX idx_dental_chart_entries_patient ON dental_chart_entries (patient_id, tooth, recorded_at DESC, id DESC);
@@ -0,0 +1,35 @@
+-- Анкета здоровья пациента (анамнез) с фиксированным набором вопросов. Анкета не редактируется на месте:
+-- каждое сохранение пациентом или врачом добавляет новую версию, текущая — с наибольшим version.
+-- changed_by и changed_by_role — кто сохранил версию
+CREATE TABLE patient_anamnesis (
+                                   id SERIAL PRIMARY KEY,
+                                   patient_id INTEGER NOT NULL REFERENCES patients(user_id) ON DELETE CASCADE,
+                                   version INTEGER NOT NULL CHECK (version > 0),
+                                   medications TEXT NOT NULL DEFAULT '',
+                                   blood_thinners BOOLEAN NOT NULL DEFAULT false,
+                                   cardiac_disease BOOLEAN NOT NULL DEFAULT false,
+                                   hypertension BOOLEAN NOT NULL DEFAULT false,
+                                   diabetes BOOLEAN NOT NULL DEFAULT false,
+                                   bleeding_disorder BOOLEAN NOT NULL DEFAULT false,
+                                   infectious_disease BOOLEAN NOT NULL DEFAULT false,
+                                   anesthesia_reaction BOOLEAN NOT NULL DEFAULT false,
+                                   pregnant BOOLEAN NOT NULL DEFAULT false,
+                                   breastfeeding BOOLEAN NOT NULL DEFAULT false,
+                                   smoker BOOLEAN NOT NULL DEFAULT false,
+                                   other_conditions TEXT NOT NULL DEFAULT '',
+                                   changed_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                                   changed_by_role VARCHAR(10) NOT NULL CHECK (changed_by_role IN ('patient', 'doctor')),
+                                   created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                   UNIQUE (patient_id, version)
+);
+
+-- Аллергии версии анкеты с тяжестью реакции
+CREATE TABLE patient_anamnesis_allergies (
+                                             id SERIAL PRIMARY KEY,
+                                             anamnesis_id INTEGER NOT NULL REFERENCES patient_anamnesis(id) ON DELETE CASCADE,
+                                             allergen VARCHAR(200) NOT NULL,
+                                             severity VARCHAR(10) NOT NULL CHECK (severity IN ('mild', 'moderate', 'severe')),
+                                             reaction TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_patient_anamnesis_allergies ON patient_anamnesis_allergies (anamnesis_id);
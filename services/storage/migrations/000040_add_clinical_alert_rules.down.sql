@@ -0,0 +1 @@
+DROP TABLE IF EXISTS clinical_alert_rules;
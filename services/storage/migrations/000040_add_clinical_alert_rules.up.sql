@@ -0,0 +1,38 @@
+-- Правила клинических предупреждений, которые ведёт старший администратор. Правило срабатывает, если в карте
+-- пациента есть условие condition_type/condition_value:
+--   allergy — аллергия, в названии которой есть condition_value (заметки врача и анкета здоровья);
+--   condition — хроническое заболевание, сопутствующее заболевание или препарат, содержащие condition_value;
+--   anamnesis_flag — отмеченный в анкете пункт, condition_value — его код (blood_thinners, pregnant и т.д.).
+-- Предупреждение показывается при открытии приёма (target_type = visit) или при выборе указанного
+-- материала либо услуги (material, service)
+CREATE TABLE clinical_alert_rules (
+                                      id SERIAL PRIMARY KEY,
+                                      title VARCHAR(200) NOT NULL CHECK (title <> ''),
+                                      condition_type VARCHAR(20) NOT NULL CHECK (condition_type IN ('allergy', 'condition', 'anamnesis_flag')),
+                                      condition_value VARCHAR(100) NOT NULL CHECK (condition_value <> ''),
+                                      target_type VARCHAR(10) NOT NULL CHECK (target_type IN ('visit', 'material', 'service')),
+                                      material_id INTEGER REFERENCES materials(id) ON DELETE CASCADE,
+                                      service_id INTEGER REFERENCES services(id) ON DELETE CASCADE,
+                                      severity VARCHAR(10) NOT NULL CHECK (severity IN ('info', 'warning', 'critical')),
+                                      message TEXT NOT NULL DEFAULT '',
+                                      is_active BOOLEAN NOT NULL DEFAULT true,
+                                      created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                      CHECK (
+                                          (target_type = 'visit' AND material_id IS NULL AND service_id IS NULL) OR
+                                          (target_type = 'material' AND material_id IS NOT NULL AND service_id IS NULL) OR
+                                          (target_type = 'service' AND service_id IS NOT NULL AND material_id IS NULL)
+                                      )
+);
+
+-- Базовые предупреждения по анкете здоровья, которые показываются при открытии приёма
+INSERT INTO clinical_alert_rules (title, condition_type, condition_value, target_type, severity, message) VALUES
+    ('Приём антикоагулянтов', 'anamnesis_flag', 'blood_thinners', 'visit', 'critical',
+     'Пациент принимает препараты, снижающие свёртываемость крови: перед удалением и хирургическими вмешательствами уточните схему приёма'),
+    ('Нарушение свёртываемости крови', 'anamnesis_flag', 'bleeding_disorder', 'visit', 'critical',
+     'Повышенный риск кровотечения при вмешательствах'),
+    ('Реакция на анестезию в анамнезе', 'anamnesis_flag', 'anesthesia_reaction', 'visit', 'critical',
+     'Ранее была реакция на местную анестезию: уточните препарат перед обезболиванием'),
+    ('Беременность', 'anamnesis_flag', 'pregnant', 'visit', 'warning',
+     'Учитывайте беременность при выборе анестетика и назначении рентгенографии'),
+    ('Заболевания сердца', 'anamnesis_flag', 'cardiac_disease', 'visit', 'warning',
+     'Анестетики с вазоконстриктором применяйте с осторожностью');
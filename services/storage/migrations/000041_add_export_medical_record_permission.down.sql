@@ -0,0 +1,2 @@
+DELETE FROM role_permission WHERE permission_id = 21;
+DELETE FROM permissions WHERE id = 21;
@@ -0,0 +1,9 @@
+-- Выгрузка медицинской карты пациента персоналом клиники. Пациент выгружает свою карту с правами личного кабинета
+INSERT INTO permissions (id, name) VALUES
+                                       (21, 'perm:export_medical_record');
+
+
+INSERT INTO role_permission (role_id, permission_id) VALUES
+                                                         (1, 21),
+                                                         (2, 21),
+                                                         (3, 21);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS appointments_doctor_patient_idx;
+DROP TABLE IF EXISTS patient_access_grants;
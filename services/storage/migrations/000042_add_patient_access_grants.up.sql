@@ -0,0 +1,17 @@
+-- Допуски врачей к медицинской карте пациентов, которых они не лечат: консультация коллеги, второе мнение,
+-- замена лечащего врача. Допуск выдаёт администратор; он действует до expires_at (NULL — бессрочно)
+-- или до отзыва (revoked_at). Лечащим считается врач, у которого есть неотменённая запись пациента
+CREATE TABLE patient_access_grants (
+                                       id SERIAL PRIMARY KEY,
+                                       patient_id INTEGER NOT NULL REFERENCES patients(user_id) ON DELETE CASCADE,
+                                       doctor_id INTEGER NOT NULL REFERENCES doctors(user_id) ON DELETE CASCADE,
+                                       reason TEXT NOT NULL CHECK (reason <> ''),
+                                       granted_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+                                       expires_at TIMESTAMPTZ,
+                                       revoked_at TIMESTAMPTZ,
+                                       created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+                                       CHECK (expires_at IS NULL OR expires_at > created_at)
+);
+
+CREATE INDEX patient_access_grants_doctor_patient_idx ON patient_access_grants (doctor_id, patient_id);
+CREATE INDEX IF NOT EXISTS appointments_doctor_patient_idx ON appointments (doctor_id, patient_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS appointments_date_idx;
+DROP INDEX IF EXISTS patients_full_name_idx;
+DROP INDEX IF EXISTS patients_full_name_search_idx;
@@ -0,0 +1,6 @@
+-- Индексы для постраничных списков: поиск пациентов по началам слов ФИО (выражение совпадает
+-- с fullNameVector в хранилище), сортировка пациентов по ФИО и отбор записей на приём по периоду
+CREATE INDEX IF NOT EXISTS patients_full_name_search_idx ON patients
+    USING GIN (to_tsvector('simple', second_name || ' ' || first_name || ' ' || coalesce(surname, '')));
+CREATE INDEX IF NOT EXISTS patients_full_name_idx ON patients (second_name, first_name, surname, user_id);
+CREATE INDEX IF NOT EXISTS appointments_date_idx ON appointments (date, "time", id);
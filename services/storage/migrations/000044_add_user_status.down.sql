@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS users_forbid_delete_with_records ON users;
+DROP FUNCTION IF EXISTS users_forbid_delete_with_records();
+DELETE FROM role_permission WHERE permission_id = 22;
+DELETE FROM permissions WHERE id = 22;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS status_changed_by,
+    DROP COLUMN IF EXISTS status_changed_at,
+    DROP COLUMN IF EXISTS status;
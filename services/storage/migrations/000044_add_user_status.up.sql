@@ -0,0 +1,38 @@
+-- Пользователи не удаляются, а выводятся из работы: сотрудник отключается (deactivated) и не может войти,
+-- пациент архивируется (archived) и скрывается из списков. Медицинская карта, приёмы и документы остаются,
+-- старший администратор может вернуть пользователя в работу. status_changed_by — кто последним менял статус
+ALTER TABLE users
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'deactivated', 'archived')),
+    ADD COLUMN status_changed_at TIMESTAMPTZ,
+    ADD COLUMN status_changed_by INTEGER REFERENCES users(id) ON DELETE SET NULL;
+
+-- Восстановление отключённых сотрудников и архивных пациентов
+INSERT INTO permissions (id, name) VALUES
+                                       (22, 'perm:user_restore');
+
+INSERT INTO role_permission (role_id, permission_id) VALUES
+                                                         (1, 22);
+
+-- Удаление пользователя каскадом стирает его медицинские данные, поэтому удалить можно только пользователя
+-- без них: например, при откате неудачной регистрации. Архивных пациентов по истечении срока хранения удаляет
+-- Store.PurgeArchivedPatients: сначала медицинские данные, затем учётную запись, с записью в журнал аудита
+CREATE FUNCTION users_forbid_delete_with_records() RETURNS trigger AS $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM appointments WHERE patient_id = OLD.id OR doctor_id = OLD.id)
+        OR EXISTS (SELECT 1 FROM appointment_visits WHERE patient_id = OLD.id)
+        OR EXISTS (SELECT 1 FROM patient_medical_notes WHERE patient_id = OLD.id)
+        OR EXISTS (SELECT 1 FROM patient_documents WHERE patient_id = OLD.id)
+        OR EXISTS (SELECT 1 FROM patient_anamnesis WHERE patient_id = OLD.id)
+        OR EXISTS (SELECT 1 FROM dental_chart_entries WHERE patient_id = OLD.id)
+        OR EXISTS (SELECT 1 FROM treatment_plans WHERE patient_id = OLD.id)
+        OR EXISTS (SELECT 1 FROM invoices WHERE patient_id = OLD.id) THEN
+        RAISE EXCEPTION 'у пользователя % есть медицинские данные, удалить его нельзя', OLD.id
+            USING ERRCODE = 'restrict_violation';
+    END IF;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER users_forbid_delete_with_records
+    BEFORE DELETE ON users
+    FOR EACH ROW EXECUTE FUNCTION users_forbid_delete_with_records();
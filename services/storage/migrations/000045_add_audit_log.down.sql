@@ -0,0 +1,4 @@
+DELETE FROM role_permission WHERE permission_id = 23;
+DELETE FROM permissions WHERE id = 23;
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
@@ -0,0 +1,44 @@
+-- Журнал аудита обращений к медицинским и персональным данным: кто (actor_id, actor_role), каким методом
+-- какого сервиса (service, action), к какому ресурсу и чьим данным (resource, resource_id, patient_id),
+-- с каким результатом (outcome — код gRPC-статуса) и когда. Журнал только пополняется. hash — SHA-256
+-- от хеша предыдущей записи и содержимого этой (см. audit.Hash), поэтому изменение или удаление записи
+-- в обход триггеров обнаруживается проверкой цепочки. Внешних ключей нет: записи переживают пользователей
+CREATE TABLE audit_log (
+                           id BIGSERIAL PRIMARY KEY,
+                           occurred_at TIMESTAMPTZ NOT NULL,
+                           service VARCHAR(32) NOT NULL,
+                           action VARCHAR(200) NOT NULL,
+                           actor_id INTEGER NOT NULL,
+                           actor_role INTEGER NOT NULL,
+                           resource VARCHAR(64) NOT NULL DEFAULT '',
+                           resource_id VARCHAR(64) NOT NULL DEFAULT '',
+                           patient_id INTEGER,
+                           outcome VARCHAR(32) NOT NULL,
+                           hash BYTEA NOT NULL
+);
+
+CREATE INDEX audit_log_occurred_at_idx ON audit_log (occurred_at, id);
+CREATE INDEX audit_log_patient_idx ON audit_log (patient_id, occurred_at) WHERE patient_id IS NOT NULL;
+CREATE INDEX audit_log_actor_idx ON audit_log (actor_id, occurred_at);
+
+CREATE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'журнал аудита нельзя изменять или очищать'
+        USING ERRCODE = 'restrict_violation';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
+
+-- Просмотр журнала аудита и проверка его целостности
+INSERT INTO permissions (id, name) VALUES
+                                       (23, 'perm:audit_log_view');
+
+INSERT INTO role_permission (role_id, permission_id) VALUES
+                                                         (1, 23);
@@ -0,0 +1 @@
+ALTER TABLE appointment_slot_holds DROP COLUMN IF EXISTS held_by;
@@ -0,0 +1,4 @@
+-- Кто удерживает слот: пациент или сотрудник, оформляющий запись. Продлить, снять удержание или записаться
+-- по нему может только он; удержания, созданные до миграции, владельца не имеют и просто истекают
+ALTER TABLE appointment_slot_holds
+    ADD COLUMN IF NOT EXISTS held_by INTEGER REFERENCES users(id) ON DELETE CASCADE;
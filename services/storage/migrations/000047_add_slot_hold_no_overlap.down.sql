@@ -0,0 +1 @@
+ALTER TABLE appointment_slot_holds DROP CONSTRAINT IF EXISTS appointment_slot_holds_doctor_no_overlap;
@@ -0,0 +1,9 @@
+-- Удержания одного врача не должны пересекаться по времени: проверка в checkSlotNotHeld не видит удержаний,
+-- которые вставляет параллельная транзакция. Истёкшие удержания ничего не держат, их можно удалить
+DELETE FROM appointment_slot_holds WHERE expires_at <= now();
+
+ALTER TABLE appointment_slot_holds
+    ADD CONSTRAINT appointment_slot_holds_doctor_no_overlap EXCLUDE USING gist (
+        doctor_id WITH =,
+        tsrange(date + "time", date + "time" + make_interval(mins => duration_minutes)) WITH &&
+    );
@@ -0,0 +1,6 @@
+-- Журнал аудита ведётся несколькими независимыми цепочками хешей (chain): записи добавляются по одной только
+-- внутри цепочки, и обращения к данным разных пациентов не ждут друг друга. Цепочка выбирается по пациенту,
+-- а без пациента — по пользователю. Существующие записи составляют цепочку 0
+ALTER TABLE audit_log ADD COLUMN chain SMALLINT NOT NULL DEFAULT 0;
+
+CREATE INDEX audit_log_chain_idx ON audit_log (chain, id);